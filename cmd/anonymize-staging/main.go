@@ -0,0 +1,99 @@
+// Command anonymize-staging copies the production database into staging with the personal data
+// replaced, so staging runs the performance tests on data of the production size and shape.
+//
+// It empties the tables it copies in the target first. The schema of the target must be up to date.
+//
+//	SOURCE_DATABASE_URL  the database to copy, a read replica of production is best
+//	TARGET_DATABASE_URL  the staging database, emptied and filled
+//	ANONYMIZE_KEY        the secret the replacements derive from, of 16 bytes at least; keeping it the same
+//	                     between runs keeps the replacements the same
+//	STAGING_PASSWORD     the password every copied user logs in with
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/anonymize"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/passhash"
+	_ "github.com/lib/pq"
+)
+
+const minKeyLength = 16
+
+func main() {
+	source := flag.String("source", os.Getenv("SOURCE_DATABASE_URL"), "URL of the database to copy")
+	target := flag.String("target", os.Getenv("TARGET_DATABASE_URL"), "URL of the staging database to fill")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, *source, *target, os.Getenv("ANONYMIZE_KEY"), os.Getenv("STAGING_PASSWORD")); err != nil {
+		slog.Error("Staging copy failed", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	slog.Info("Staging copy complete")
+}
+
+func run(ctx context.Context, sourceURL, targetURL, key, password string) error {
+	switch {
+	case sourceURL == "" || targetURL == "":
+		return errors.New("both the source and the target database are required")
+	case sourceURL == targetURL:
+		return errors.New("the source and the target database must differ")
+	case len(key) < minKeyLength:
+		return fmt.Errorf("ANONYMIZE_KEY must be at least %d bytes", minKeyLength)
+	case password == "":
+		return errors.New("STAGING_PASSWORD is required")
+	}
+
+	hasher, err := passhash.New(passhash.DefaultParams)
+	if err != nil {
+		return fmt.Errorf("failed to create password hasher: %w", err)
+	}
+
+	passwordHash, err := hasher.Hash(password)
+	if err != nil {
+		return fmt.Errorf("failed to hash staging password: %w", err)
+	}
+
+	source, err := open(ctx, sourceURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to source database: %w", err)
+	}
+	defer source.Close()
+
+	target, err := open(ctx, targetURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to target database: %w", err)
+	}
+	defer target.Close()
+
+	copier := anonymize.NewCopier(source, target, anonymize.New([]byte(key), passwordHash))
+
+	return copier.Copy(ctx, anonymize.Tables)
+}
+
+func open(ctx context.Context, url string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	return db, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+)
+
+// runConfigCommand runs the config subcommands and returns the exit code:
+//
+//	config print-effective [-config files]  prints the config the service would load, every file and
+//	                                        environment variable applied, with the secrets redacted
+func runConfigCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "print-effective" {
+		fmt.Fprintln(stderr, "usage: config print-effective [-config files]")
+
+		return 2
+	}
+
+	flags := flag.NewFlagSet("config print-effective", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	configFlag := flags.String("config", "", "config files to read, comma separated, each over the previous one")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	paths, err := config.ResolveLayers(*configFlag)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return 1
+	}
+
+	// Printed even when invalid, it is what the service would trip on
+	cfg, err := config.LoadLayers(paths...)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return 1
+	}
+
+	effective, err := cfg.Effective()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return 1
+	}
+
+	fmt.Fprintln(stdout, "# Config files, each read over the previous one, environment variables applied last:")
+
+	for _, path := range paths {
+		fmt.Fprintf(stdout, "#   %s\n", path)
+	}
+
+	_, _ = stdout.Write(effective)
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return 1
+	}
+
+	return 0
+}
@@ -8,18 +8,31 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
 	_ "github.com/aaravmahajanofficial/scalable-ecommerce-platform/docs"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/health"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/logging"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/waitingroom"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/passhash"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/pwned"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storage"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storefront"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -33,7 +46,7 @@ import (
 
 //	@title						Scalable E-commerce Platform API
 //	@version					1.0
-//	@description				This is the API server for the Scalable E-commerce Platform. It provides endpoints for managing users, products, carts, orders, payments, and notifications.
+//	@description				This is the API server for the Scalable E-commerce Platform. It provides endpoints for managing users, products, carts, orders, payments, and notifications. Rate limited endpoints return X-RateLimit-Limit (requests allowed per window), X-RateLimit-Remaining (requests left once the current one is counted) and X-RateLimit-Reset (seconds until the window frees a request again), on throttled responses too. Integrations get scoped tokens from /oauth/token with the OAuth2 client credentials grant, they open the routes of their scopes only. During high-demand drops product pages, cart items and checkout may answer 429 IN_WAITING_ROOM with a ticket in the X-Waiting-Room-Token header, sending it back on the next requests keeps the place in line. Dates written for people, in emails, on documents and as the days of report ranges, follow the Accept-Language header and the IANA timezone of the X-Timezone header, the store settings otherwise.
 //	@termsOfService				http://swagger.io/terms/
 //	@contact.name				Aarav Mahajan
 //	@contact.url				https://github.com/aaravmahajanofficial
@@ -72,9 +85,24 @@ func initTracer(cfg *config.Config) (func(ctx context.Context) error, error) {
 		samplingRatio = 1.0
 	}
 
-	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio))
+	routeSampler, err := tracing.NewRouteSampler(cfg.OTel.RouteSampling, sdktrace.TraceIDRatioBased(samplingRatio))
+	if err != nil {
+		return nil, fmt.Errorf("invalid route sampling: %w", err)
+	}
+
+	// Unsampled spans are still recorded, slow requests can force their trace to be exported
+	sampler := tracing.NewRecordingSampler(sdktrace.ParentBased(routeSampler))
+	processor := tracing.NewForceSampleProcessor(sdktrace.NewBatchSpanProcessor(exporter))
 
-	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res), sdktrace.WithSampler(sampler))
+	// Business identifiers are pseudonymised before they reach the spans and the propagated baggage
+	tracing.SetIdentifierHashing(cfg.OTel.IdentifierHashKey)
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(tracing.NewBaggageProcessor()),
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler),
+	)
 	otel.SetTracerProvider(tp)
 
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
@@ -83,6 +111,8 @@ func initTracer(cfg *config.Config) (func(ctx context.Context) error, error) {
 		slog.String("service_name", cfg.OTel.ServiceName),
 		slog.String("exporter_endpoint", cfg.OTel.ExporterEndpoint),
 		slog.Float64("sampling_ratio", samplingRatio),
+		slog.Any("route_sampling", cfg.OTel.RouteSampling),
+		slog.Bool("hashed_identifiers", cfg.OTel.IdentifierHashKey != ""),
 	)
 
 	return func(ctx context.Context) error {
@@ -94,6 +124,10 @@ func initTracer(cfg *config.Config) (func(ctx context.Context) error, error) {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		os.Exit(runConfigCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// Logger setup
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 	slog.SetDefault(logger)
@@ -101,6 +135,33 @@ func main() {
 	// Load config
 	cfg := config.MustLoad()
 
+	configuredLevel, err := logging.ParseLevel(cfg.LogLevel())
+	if err != nil {
+		slog.Error("❌ Invalid log level", "error", err.Error())
+		os.Exit(1)
+	}
+
+	logLevel := logging.NewLevel(configuredLevel)
+
+	logger, err = logging.New(os.Stdout, cfg.Log.Format, logLevel)
+	if err != nil {
+		slog.Error("❌ Invalid log format", "error", err.Error())
+		os.Exit(1)
+	}
+
+	slog.SetDefault(logger)
+
+	payloadLogger, err := middleware.NewPayloadLogger(&cfg.PayloadLog)
+	if err != nil {
+		slog.Error("❌ Invalid payload log configuration", "error", err.Error())
+		os.Exit(1)
+	}
+
+	if err := utils.SetIDVersion(cfg.IDs.Version); err != nil {
+		slog.Error("❌ Invalid ID configuration", "error", err.Error())
+		os.Exit(1)
+	}
+
 	tracerShutdown, err := initTracer(cfg)
 	if err != nil {
 		slog.Error("❌ Failed to initialize OpenTelemetry Tracer", "error", err.Error())
@@ -117,13 +178,6 @@ func main() {
 		}
 	}()
 
-	// Swagger setup
-	swaggerHost := cfg.HTTPServer.Addr
-	if swaggerHost == "" {
-		swaggerHost = "local:8085"
-		slog.Warn("Server address not found in config (cfg.Addr), defaulting Swagger host to " + swaggerHost)
-	}
-
 	// --- Redis Client Initialization ---
 	redisClient, err := repository.NewRedisClient(cfg)
 	if err != nil {
@@ -142,8 +196,8 @@ func main() {
 	}()
 
 	// --- Cache Initialization ---
-	redisCache := cache.NewRedisCache(redisClient, &cfg.Cache)
-	slog.Info("Cache Initialized", slog.String("type", "redis"), slog.String("defaultTTL", cfg.Cache.DefaultTTL.String()))
+	appCache := cache.NewTieredCache(cache.NewRedisCache(redisClient, &cfg.Cache, clock.New()), redisClient, &cfg.Cache, clock.New())
+	slog.Info("Cache Initialized", slog.String("type", "redis"), slog.String("defaultTTL", cfg.Cache.DefaultTTL.String()), slog.Int("localNamespaces", len(cfg.Cache.LocalTTLs)))
 
 	// --- Rate Limiter Initialization ---
 	rateLimiter := repository.NewRateLimitRepo(redisClient, cfg)
@@ -151,7 +205,7 @@ func main() {
 	slog.Info("Rate Limiter Initialized", slog.String("type", "redis"))
 
 	// --- Database and Repositories Initialization ---
-	repos, err := repository.New(cfg, redisClient, redisCache, rateLimiter)
+	repos, err := repository.New(cfg, redisClient, appCache, rateLimiter)
 	if err != nil {
 		slog.Error("❌ Error initializing repositories", "error", err.Error())
 		os.Exit(1)
@@ -168,27 +222,323 @@ func main() {
 	}()
 
 	jwtKey := []byte(cfg.Security.JWTKey)
-	stripeClient := stripe.NewStripeClient(cfg.Stripe.APIKey, cfg.Stripe.WebhookSecret)
-	sendGridClient := sendgrid.NewEmailService(cfg.SendGrid.APIKey, cfg.SendGrid.FromEmail, cfg.SendGrid.FromName)
+	stripeClient := stripe.NewStripeClient(cfg.Stripe.APIKey, cfg.Stripe.WebhookSecret, stripe.CheckoutURLs{
+		SuccessURL: cfg.Stripe.CheckoutSuccessURL,
+		CancelURL:  cfg.Stripe.CheckoutCancelURL,
+	})
+
+	objectStore, err := storage.NewFileStore(cfg.Storage.Path)
+	if err != nil {
+		slog.Error("❌ Error initializing object storage", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	sendGridClient := sendgrid.NewEmailService(cfg.SendGrid.APIKey, cfg.SendGrid.FromEmail, cfg.SendGrid.FromName, cfg.SendGrid.WebhookPublicKey, sendgrid.AttachmentOptions{
+		Store:        objectStore,
+		MaxSize:      cfg.SendGrid.AttachmentMaxSize,
+		MaxTotalSize: cfg.SendGrid.AttachmentMaxTotalSize,
+		AllowedTypes: cfg.SendGrid.AttachmentTypes,
+	})
+
+	emailThrottle, err := sendgrid.NewThrottle(cfg.SendGrid.Plan, cfg.SendGrid.ThrottleBurst)
+	if err != nil {
+		slog.Error("❌ Error initializing email throttle", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// Stores the catalog can be imported from, only the ones with credentials are offered
+	storefrontHTTPClient := &http.Client{Timeout: cfg.Catalog.RequestTimeout}
+	catalogSources := map[models.CatalogImportSource]storefront.Client{}
+
+	if cfg.Catalog.ShopifyStoreURL != "" && cfg.Catalog.ShopifyAccessToken != "" {
+		catalogSources[models.CatalogImportSourceShopify] = storefront.NewShopifyClient(cfg.Catalog.ShopifyStoreURL, cfg.Catalog.ShopifyAccessToken, storefrontHTTPClient)
+	}
+
+	if cfg.Catalog.WooCommerceStoreURL != "" && cfg.Catalog.WooCommerceConsumerKey != "" {
+		catalogSources[models.CatalogImportSourceWooCommerce] = storefront.NewWooCommerceClient(cfg.Catalog.WooCommerceStoreURL, cfg.Catalog.WooCommerceConsumerKey, cfg.Catalog.WooCommerceConsumerSecret, storefrontHTTPClient)
+	}
+
+	// Passwords are only checked against known breaches when enabled, the check sends the API a hash prefix
+	var breachedPasswords pwned.Client
+	if cfg.Passwords.BreachCheck {
+		breachedPasswords = pwned.NewClient(cfg.Passwords.BreachCheckURL, &http.Client{Timeout: cfg.Passwords.BreachCheckTimeout})
+	}
+
+	passwordHasher, err := passhash.New(passhash.Params{
+		Memory:      cfg.Hashing.Memory,
+		Iterations:  cfg.Hashing.Iterations,
+		Parallelism: cfg.Hashing.Parallelism,
+	})
+	if err != nil {
+		slog.Error("❌ Error initializing password hashing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
 	// Service Init
-	userService := service.NewUserService(repos.User, repos.RateLimiter, jwtKey)
-	productService := service.NewProductService(repos.Product)
-	cartService := service.NewCartService(repos.Cart)
-	orderService := service.NewOrderService(repos.Order, repos.Cart, repos.Product)
-	paymentService := service.NewPaymentService(repos.Payment, stripeClient)
-	notificationService := service.NewNotificationService(repos.Notification, repos.User, sendGridClient)
+	wallClock := clock.New()
+	webhookNonces := webhookauth.NewRedisNonceStore(redisClient)
+	carrierWebhooks := webhookauth.NewVerifier("carrier", cfg.Carriers.Secrets, cfg.Carriers.Tolerance, webhookNonces, wallClock)
+
+	productService := service.NewProductService(repos.Product, repos.Inventory, repos.Cache, wallClock)
+	cacheAdminService := service.NewCacheAdminService(repos.Cache)
+	cartService := service.NewCartService(repos.Cart, repos.Product, repos.Order, wallClock)
+	warehouseService := service.NewWarehouseService(repos.Warehouse, repos.Product, repos.Inventory)
+	shippingService := service.NewShippingService(repos.Shipping, repos.Product, service.ShippingPolicy{
+		AllowedCountries:     cfg.Shipping.AllowedCountries,
+		BlockedStates:        cfg.Shipping.BlockedStates,
+		BlockPOBoxes:         cfg.Shipping.BlockPOBoxes,
+		EmbargoedPostalCodes: cfg.Shipping.EmbargoedPostalCodes,
+	})
+	campaignService := service.NewCampaignService(repos.Campaign, repos.CampaignStock, repos.Product, repos.Cache, wallClock)
+	velocityPolicy := service.VelocityPolicy{Enabled: cfg.Velocity.Enabled, Shadow: cfg.Velocity.Shadow}
+	for _, rule := range cfg.Velocity.Rules {
+		velocityPolicy.Rules = append(velocityPolicy.Rules, service.VelocityRule{
+			Name:      rule.Name,
+			Key:       models.VelocityKey(rule.Key),
+			MaxOrders: rule.MaxOrders,
+			Window:    rule.Window,
+			Action:    models.VelocityAction(rule.Action),
+		})
+	}
+
+	if err := velocityPolicy.Validate(); err != nil {
+		slog.Error("❌ Invalid order velocity rules", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	if velocityPolicy.Enabled {
+		slog.Info("Order velocity rules enabled", slog.Int("rules", len(velocityPolicy.Rules)), slog.Bool("shadow", velocityPolicy.Shadow))
+	}
+
+	fraudService := service.NewFraudService(repos.FraudReview, repos.Velocity, repos.Device, repos.Order, campaignService, velocityPolicy, wallClock)
+	orderService := service.NewOrderService(repos.Order, repos.Cart, repos.Product, repos.Inventory, warehouseService, shippingService, campaignService, fraudService, repos.Pickup, repos.Vendor, service.OrderPolicy{
+		GiftWrapFee:         cfg.Orders.GiftWrapFee,
+		BulkStatusMaxOrders: cfg.Orders.BulkStatusMaxOrders,
+		BulkStatusBatchSize: cfg.Orders.BulkStatusBatchSize,
+		PickWaveMaxOrders:   cfg.Orders.PickWaveMaxOrders,
+		DuplicateWindow:     cfg.Orders.DuplicateWindow,
+	}, wallClock)
+	storeSettingsService := service.NewStoreSettingsService(repos.StoreSettings, repos.Cache, models.StoreSettings{
+		StoreName:        cfg.Store.Name,
+		LegalEntity:      cfg.Store.LegalEntity,
+		CreditNoteSeries: cfg.Store.CreditNoteSeries,
+		DefaultCurrency:  cfg.Store.DefaultCurrency,
+		DefaultLocale:    cfg.Store.DefaultLocale,
+		DefaultTimezone:  cfg.Store.DefaultTimezone,
+	})
+	stocktakeService := service.NewStocktakeService(repos.Stocktake, repos.Warehouse)
+	supportSearchService := service.NewSupportSearchService(repos.SupportSearch)
+	fulfillmentWaveService := service.NewFulfillmentWaveService(repos.FulfillmentWave, repos.Warehouse, orderService, service.FulfillmentWavePolicy{
+		MaxOrders: cfg.Orders.PickWaveMaxOrders,
+	})
+	orderDocumentService := service.NewOrderDocumentService(repos.Order, repos.OrderDocument, storeSettingsService)
+	orderEditService := service.NewOrderEditService(repos.Order, repos.Product, repos.Inventory, warehouseService, shippingService, repos.Payment, repos.Audit, orderDocumentService, stripeClient, wallClock)
+	orderPaymentService := service.NewOrderPaymentService(repos.Order, repos.Payment, stripeClient, fraudService, service.OrderPaymentPolicy{
+		MaxRetries:   cfg.Orders.PaymentMaxRetries,
+		RetryLockout: cfg.Orders.PaymentRetryLockout,
+	}, wallClock)
+	trackingKey := jwtKey
+	if cfg.Tracking.Key != "" {
+		trackingKey = []byte(cfg.Tracking.Key)
+	}
+
+	orderTrackingService := service.NewOrderTrackingService(repos.Order, repos.RateLimiter, service.OrderTrackingPolicy{
+		Key:        trackingKey,
+		URL:        cfg.Tracking.URL,
+		RateLimit:  cfg.Tracking.RateLimit,
+		RateWindow: cfg.Tracking.RateWindow,
+	})
+	notificationService := service.NewNotificationService(repos.Notification, repos.User, sendGridClient, service.NotificationRetryPolicy{
+		MaxAttempts: cfg.Notification.MaxAttempts,
+		BaseDelay:   cfg.Notification.BaseDelay,
+		MaxDelay:    cfg.Notification.MaxDelay,
+	}, emailThrottle, webhookNonces, storeSettingsService, wallClock)
+	tokenPolicy := service.TokenPolicy{
+		Key:      jwtKey,
+		Issuer:   cfg.Security.JWTIssuer,
+		Audience: cfg.Security.JWTAudience,
+	}
+	userService := service.NewUserService(repos.User, repos.RateLimiter, repos.Audit, repos.Vendor, repos.Session, notificationService, service.PasswordPolicy{
+		MinLength:        cfg.Passwords.MinLength,
+		RequireUppercase: cfg.Passwords.RequireUppercase,
+		RequireLowercase: cfg.Passwords.RequireLowercase,
+		RequireDigit:     cfg.Passwords.RequireDigit,
+		RequireSymbol:    cfg.Passwords.RequireSymbol,
+		Breaches:         breachedPasswords,
+	}, passwordHasher, tokenPolicy, wallClock)
+	emailChangeKey := jwtKey
+	if cfg.EmailChange.Key != "" {
+		emailChangeKey = []byte(cfg.EmailChange.Key)
+	}
+
+	emailChangeService := service.NewEmailChangeService(repos.User, repos.EmailChange, repos.Audit, notificationService, passwordHasher, service.EmailChangePolicy{
+		Key: emailChangeKey,
+		URL: cfg.EmailChange.URL,
+		TTL: cfg.EmailChange.TTL,
+	}, wallClock)
+	apiClientService := service.NewAPIClientService(repos.APIClient, tokenPolicy, service.APIClientPolicy{
+		TokenTTL:      cfg.OAuth.TokenTTL,
+		RotationGrace: cfg.OAuth.SecretRotationGrace,
+	}, wallClock)
+	pickupService := service.NewPickupService(repos.Pickup, repos.Order, repos.User, notificationService, orderTrackingService, fraudService)
+	disputeService := service.NewDisputeService(repos.Dispute, repos.Payment, repos.Order, notificationService, cfg.Admin.NotificationEmails)
+	orderLapseService := service.NewOrderLapseService(repos.Order, campaignService, repos.User, notificationService, orderTrackingService, service.OrderLapsePolicy{
+		PaymentWindow: cfg.Orders.PaymentWindow,
+		BatchSize:     cfg.Orders.LapseBatchSize,
+	}, wallClock)
+	paymentCleanupService := service.NewPaymentCleanupService(repos.Payment, stripeClient, service.PaymentCleanupPolicy{
+		MinAge:    cfg.Cleanup.MinAge,
+		BatchSize: cfg.Cleanup.BatchSize,
+	}, wallClock)
+	retentionService := service.NewRetentionService(repos.Retention, service.RetentionPolicy{
+		CartMaxAge:      cfg.Retention.CartMaxAge,
+		OrderPIIAge:     cfg.Retention.OrderPIIAge,
+		BatchSize:       cfg.Retention.BatchSize,
+		ScheduledDryRun: cfg.Retention.DryRun,
+	}, wallClock)
+	ledgerService := service.NewLedgerService(repos.Ledger)
+	analyticsService := service.NewAnalyticsService(repos.Analytics)
+	productQuestionService := service.NewProductQuestionService(repos.ProductQuestion, repos.Product, repos.User, repos.RateLimiter, notificationService, wallClock, service.ProductQuestionPolicy{
+		UserLimit:       cfg.Questions.UserLimit,
+		ProductLimit:    cfg.Questions.ProductLimit,
+		RateWindow:      cfg.Questions.RateWindow,
+		BurstLimit:      cfg.Questions.BurstLimit,
+		BurstWindow:     cfg.Questions.BurstWindow,
+		DuplicateWindow: cfg.Questions.DuplicateWindow,
+	})
+	paymentService := service.NewPaymentService(repos.Payment, repos.Webhook, stripeClient, disputeService, orderLapseService, ledgerService)
+	orderRefundService := service.NewOrderRefundService(repos.Order, repos.OrderRefund, repos.Audit, ledgerService, orderDocumentService, stripeClient)
+	webhookQueue := service.NewWebhookQueue(paymentService, repos.WebhookInbox, repos.Webhook, cfg.Webhooks.Workers, cfg.Webhooks.QueueSize)
+	invariantService := service.NewInvariantService(repos.Invariant)
+	broadcastService := service.NewBroadcastService(repos.Broadcast, notificationService, service.BroadcastPolicy{
+		RatePerSecond: cfg.Broadcast.RatePerSecond,
+		BatchSize:     cfg.Broadcast.BatchSize,
+		QueueSize:     cfg.Broadcast.QueueSize,
+	}, wallClock)
+	catalogImportService := service.NewCatalogImportService(repos.CatalogImport, repos.Product, repos.Inventory, repos.Cache, catalogSources, service.CatalogImportPolicy{
+		BatchSize: cfg.Catalog.BatchSize,
+		QueueSize: cfg.Catalog.QueueSize,
+	}, wallClock)
+	catalogSnapshotService := service.NewCatalogSnapshotService(repos.Product, service.CatalogSnapshotPolicy{MaxDelta: cfg.Snapshot.MaxDelta})
+	vendorService := service.NewVendorService(repos.Vendor, repos.User, stripeClient, ledgerService, service.VendorPolicy{
+		DefaultCommissionRate: cfg.Vendors.DefaultCommissionRate,
+		PayoutCurrency:        cfg.Vendors.PayoutCurrency,
+		MinPayout:             cfg.Vendors.MinPayout,
+	}, wallClock)
 
 	// Handler Init
-	userHandler := handlers.NewUserHandler(userService)
+	userHandler := handlers.NewUserHandler(userService, cfg.Sessions.CountryHeader)
+	emailChangeHandler := handlers.NewEmailChangeHandler(emailChangeService)
 	productHandler := handlers.NewProductHandler(productService)
 	cartHandler := handlers.NewCartHandler(cartService)
 	orderHandler := handlers.NewOrderHandler(orderService)
-	paymentHandler := handlers.NewPaymentHandler(paymentService)
+	orderEditHandler := handlers.NewOrderEditHandler(orderEditService)
+	orderRefundHandler := handlers.NewOrderRefundHandler(orderRefundService)
+	orderDocumentHandler := handlers.NewOrderDocumentHandler(orderDocumentService)
+	orderPaymentHandler := handlers.NewOrderPaymentHandler(orderPaymentService)
+	trackingHandler := handlers.NewTrackingHandler(orderTrackingService)
+	cacheHandler := handlers.NewCacheHandler(cacheAdminService)
+	logLevelHandler := handlers.NewLogLevelHandler(logLevel)
+	payloadLogHandler := handlers.NewPayloadLogHandler(payloadLogger)
+	shippingHandler := handlers.NewShippingHandler(shippingService)
+	paymentHandler := handlers.NewPaymentHandler(paymentService, webhookQueue)
 	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	invariantHandler := handlers.NewInvariantHandler(invariantService)
+	ledgerHandler := handlers.NewLedgerHandler(ledgerService)
+	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
+	retentionHandler := handlers.NewRetentionHandler(retentionService)
+	storeSettingsHandler := handlers.NewStoreSettingsHandler(storeSettingsService)
+	disputeHandler := handlers.NewDisputeHandler(disputeService)
+	warehouseHandler := handlers.NewWarehouseHandler(warehouseService)
+	pickupHandler := handlers.NewPickupHandler(pickupService)
+	fulfillmentWaveHandler := handlers.NewFulfillmentWaveHandler(fulfillmentWaveService)
+	stocktakeHandler := handlers.NewStocktakeHandler(stocktakeService)
+	supportSearchHandler := handlers.NewSupportSearchHandler(supportSearchService)
+	broadcastHandler := handlers.NewBroadcastHandler(broadcastService)
+	catalogImportHandler := handlers.NewCatalogImportHandler(catalogImportService)
+	catalogSnapshotHandler := handlers.NewCatalogSnapshotHandler(catalogSnapshotService, cfg.Snapshot.MaxAge)
+	vendorHandler := handlers.NewVendorHandler(vendorService, productService)
+	apiClientHandler := handlers.NewAPIClientHandler(apiClientService)
+	campaignHandler := handlers.NewCampaignHandler(campaignService)
+	fraudHandler := handlers.NewFraudHandler(fraudService)
+	productQuestionHandler := handlers.NewProductQuestionHandler(productQuestionService)
 
 	// Middleware Init
-	authMiddleware := middleware.NewAuthMiddleware(jwtKey)
+	authMiddleware := middleware.NewAuthMiddleware(jwtKey, middleware.TokenValidation{
+		Issuer:   cfg.Security.JWTIssuer,
+		Audience: cfg.Security.JWTAudience,
+		Leeway:   cfg.Security.JWTClockSkew,
+	}, repos.Session)
+
+	// Background jobs, stopped on shutdown
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	webhooksDone := make(chan struct{})
+
+	go func() {
+		webhookQueue.Run(jobsCtx, cfg.Webhooks.RedeliverInterval)
+		close(webhooksDone)
+	}()
+
+	if cfg.Invariants.Interval > 0 {
+		go invariantService.RunScheduled(jobsCtx, cfg.Invariants.Interval)
+
+		slog.Info("Invariants checker scheduled", slog.Duration("interval", cfg.Invariants.Interval))
+	}
+
+	if cfg.Notification.Interval > 0 {
+		go notificationService.RunRetryWorker(jobsCtx, cfg.Notification.Interval, cfg.Notification.BatchSize)
+
+		slog.Info("Notification retry worker scheduled", slog.Duration("interval", cfg.Notification.Interval))
+	}
+
+	if cfg.Vendors.PayoutInterval > 0 {
+		go vendorService.RunPayouts(jobsCtx, cfg.Vendors.PayoutInterval)
+
+		slog.Info("Vendor payouts scheduled", slog.Duration("interval", cfg.Vendors.PayoutInterval))
+	}
+
+	if cfg.Orders.LapseInterval > 0 {
+		go orderLapseService.Run(jobsCtx, cfg.Orders.LapseInterval)
+
+		slog.Info("Unpaid orders sweep scheduled", slog.Duration("interval", cfg.Orders.LapseInterval), slog.Duration("paymentWindow", cfg.Orders.PaymentWindow))
+	}
+
+	if cfg.Analytics.RefreshInterval > 0 {
+		go analyticsService.Run(jobsCtx, cfg.Analytics.RefreshInterval)
+
+		slog.Info("Analytics refresh scheduled", slog.Duration("interval", cfg.Analytics.RefreshInterval))
+	}
+
+	if cfg.Cleanup.Interval > 0 {
+		go paymentCleanupService.Run(jobsCtx, cfg.Cleanup.Interval)
+
+		slog.Info("Orphaned payments cleanup scheduled", slog.Duration("interval", cfg.Cleanup.Interval), slog.Duration("minAge", cfg.Cleanup.MinAge))
+	}
+
+	if cfg.Retention.Interval > 0 {
+		go retentionService.Run(jobsCtx, cfg.Retention.Interval)
+
+		slog.Info("Data retention scheduled", slog.Duration("interval", cfg.Retention.Interval), slog.Bool("dryRun", cfg.Retention.DryRun),
+			slog.Duration("cartMaxAge", cfg.Retention.CartMaxAge), slog.Duration("orderPIIAge", cfg.Retention.OrderPIIAge))
+	}
+
+	if cfg.Products.PublishInterval > 0 {
+		go productService.RunPublisher(jobsCtx, cfg.Products.PublishInterval)
+
+		slog.Info("Scheduled product publishing enabled", slog.Duration("interval", cfg.Products.PublishInterval))
+	}
+
+	if cfg.Campaigns.Interval > 0 {
+		go campaignService.Run(jobsCtx, cfg.Campaigns.Interval)
+
+		slog.Info("Campaigns enabled", slog.Duration("interval", cfg.Campaigns.Interval))
+	}
+
+	go appCache.Run(jobsCtx)
+	go broadcastService.Run(jobsCtx, cfg.Broadcast.ResumeInterval)
+	go catalogImportService.Run(jobsCtx, cfg.Catalog.ResumeInterval)
 
 	slog.Info("Storage Initialized", slog.String("env", cfg.Env), slog.String("version", "1.0.0"))
 
@@ -198,7 +548,7 @@ func main() {
 		StripeClient: &stripeClient,
 	}
 
-	readinessHandler, err := health.NewReadinessHandler(cfg, healthEndpoints)
+	healthChecker, err := health.NewChecker(cfg, healthEndpoints)
 	if err != nil {
 		slog.Error("❌ Failed to initialize readiness checker", "error", err.Error())
 		os.Exit(1)
@@ -208,81 +558,321 @@ func main() {
 
 	slog.Info("✅ Health checks initialized")
 
+	// Bounds the in-flight requests per user on checkout and report generation
+	concurrencyLimiter := middleware.NewConcurrencyLimiter(cfg.Concurrency.MaxPerPrincipal)
+
+	// Holds the visitors of high-demand drops in line on product pages, cart items and checkout
+	waitingRoomKey := jwtKey
+	if cfg.WaitingRoom.Key != "" {
+		waitingRoomKey = []byte(cfg.WaitingRoom.Key)
+	}
+
+	waitingRoom := middleware.NewWaitingRoom(&cfg.WaitingRoom, waitingroom.NewRoom(waitingRoomKey, cfg.WaitingRoom.Rate, cfg.WaitingRoom.TicketTTL, cfg.WaitingRoom.PassTTL, waitingroom.NewRedisQueue(redisClient), wallClock))
+	if cfg.WaitingRoom.Enabled {
+		slog.Info("Waiting room enabled", slog.Any("routes", cfg.WaitingRoom.Routes), slog.Int("products", len(cfg.WaitingRoom.Products)), slog.Int("rate", cfg.WaitingRoom.Rate))
+	}
+
 	// Setup router for handling api routes only
 	apiMux := http.NewServeMux()
 
 	apiMux.HandleFunc("POST /api/v1/users/register", userHandler.Register())
 	apiMux.HandleFunc("POST /api/v1/users/login", userHandler.Login())
+	apiMux.HandleFunc("POST /api/v1/users/email-change/confirm", emailChangeHandler.ConfirmEmailChange())
+	apiMux.HandleFunc("POST /api/v1/oauth/token", apiClientHandler.Token())
 	apiMux.HandleFunc("GET /api/v1/users/profile", authMiddleware.Authenticate(userHandler.Profile()))
+	apiMux.HandleFunc("PUT /api/v1/users/me/password", authMiddleware.Authenticate(authMiddleware.DenyImpersonation(userHandler.ChangePassword())))
+	apiMux.HandleFunc("POST /api/v1/users/me/email", authMiddleware.Authenticate(authMiddleware.DenyImpersonation(emailChangeHandler.RequestEmailChange())))
+	apiMux.HandleFunc("GET /api/v1/users/me/sessions", authMiddleware.Authenticate(userHandler.ListSessions()))
+	apiMux.HandleFunc("DELETE /api/v1/users/me/sessions/{id}", authMiddleware.Authenticate(authMiddleware.DenyImpersonation(userHandler.RevokeSession())))
+	apiMux.HandleFunc("GET /api/v1/users/me/orders/export", authMiddleware.Authenticate(orderHandler.ExportOrders()))
+	apiMux.HandleFunc("POST /api/v1/support/users/{id}/impersonate", authMiddleware.Authenticate(authMiddleware.RequireAnyRole([]models.UserRole{models.RoleStaff, models.RoleAdmin}, userHandler.Impersonate())))
+	apiMux.HandleFunc("GET /api/v1/admin/users/{id}/timeline", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, userHandler.Timeline())))
 	apiMux.HandleFunc("POST /api/v1/products", authMiddleware.Authenticate(productHandler.CreateProduct()))
-	apiMux.HandleFunc("GET /api/v1/products/{id}", authMiddleware.Authenticate(productHandler.GetProduct()))
+	apiMux.HandleFunc("GET /api/v1/products/lookup", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, productHandler.LookupProduct()))
+	apiMux.HandleFunc("GET /api/v1/products/facets", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, productHandler.GetProductFacets()))
+	apiMux.HandleFunc("GET /api/v1/products/{id}", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, waitingRoom.Hold(productHandler.GetProduct())))
+	apiMux.HandleFunc("GET /api/v1/products/{id}/availability", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, productHandler.GetProductAvailability()))
+	apiMux.HandleFunc("GET /api/v1/products/{id}/questions", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, productQuestionHandler.ListProductQuestions()))
+	apiMux.HandleFunc("POST /api/v1/products/{id}/questions", authMiddleware.Authenticate(productQuestionHandler.AskQuestion()))
 	apiMux.HandleFunc("PUT /api/v1/products/{id}", authMiddleware.Authenticate(productHandler.UpdateProduct()))
-	apiMux.HandleFunc("GET /api/v1/products", authMiddleware.Authenticate(productHandler.ListProducts()))
+	apiMux.HandleFunc("GET /api/v1/products", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, productHandler.ListProducts()))
+	apiMux.HandleFunc("GET /api/v1/catalog/snapshot", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, catalogSnapshotHandler.GetSnapshot()))
+	apiMux.HandleFunc("GET /api/v1/campaigns", authMiddleware.AuthenticateScope(models.ScopeCatalogRead, campaignHandler.ListActiveCampaigns()))
 	apiMux.HandleFunc("GET /api/v1/carts", authMiddleware.Authenticate(cartHandler.GetCart()))
-	apiMux.HandleFunc("POST /api/v1/carts/items", authMiddleware.Authenticate(cartHandler.AddItem()))
-	apiMux.HandleFunc("PUT /api/v1/carts/items", authMiddleware.Authenticate(cartHandler.UpdateQuantity()))
-	apiMux.HandleFunc("POST /api/v1/orders", authMiddleware.Authenticate(orderHandler.CreateOrder()))
+	apiMux.HandleFunc("POST /api/v1/carts/items", authMiddleware.Authenticate(waitingRoom.Hold(cartHandler.AddItem())))
+	apiMux.HandleFunc("PUT /api/v1/carts/items", authMiddleware.Authenticate(waitingRoom.Hold(cartHandler.UpdateQuantity())))
+	apiMux.HandleFunc("POST /api/v1/shipping/quote", authMiddleware.Authenticate(shippingHandler.Quote()))
+	apiMux.HandleFunc("POST /api/v1/orders", authMiddleware.Authenticate(waitingRoom.Hold(concurrencyLimiter.Limit(orderHandler.CreateOrder()))))
 	apiMux.HandleFunc("GET /api/v1/orders/{id}", authMiddleware.Authenticate(orderHandler.GetOrder()))
+	apiMux.HandleFunc("GET /api/v1/orders/{id}/timeline", authMiddleware.Authenticate(orderHandler.GetOrderTimeline()))
 	apiMux.HandleFunc("GET /api/v1/orders", authMiddleware.Authenticate(orderHandler.ListOrders()))
 	apiMux.HandleFunc("PATCH /api/v1/orders/{id}/status", authMiddleware.Authenticate(orderHandler.UpdateOrderStatus()))
-	apiMux.HandleFunc("POST /api/v1/payments", authMiddleware.Authenticate(paymentHandler.CreatePayment()))
+	apiMux.HandleFunc("POST /api/v1/orders/{id}/retry-payment", authMiddleware.Authenticate(orderPaymentHandler.RetryPayment()))
+	apiMux.HandleFunc("GET /api/v1/orders/{id}/documents", authMiddleware.Authenticate(orderDocumentHandler.ListOrderDocuments()))
+	apiMux.HandleFunc("GET /api/v1/track/{token}", trackingHandler.TrackOrder())
+	apiMux.HandleFunc("GET /api/v1/pickup-locations", authMiddleware.Authenticate(pickupHandler.ListLocations()))
+	apiMux.HandleFunc("POST /api/v1/pickups/verify", authMiddleware.Authenticate(authMiddleware.RequireAnyRole([]models.UserRole{models.RoleStaff, models.RoleAdmin}, pickupHandler.VerifyPickup())))
+	apiMux.HandleFunc("POST /api/v1/vendors", authMiddleware.Authenticate(vendorHandler.RegisterVendor()))
+	apiMux.HandleFunc("GET /api/v1/vendors/me", authMiddleware.Authenticate(vendorHandler.GetMyVendor()))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/dashboard", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.GetDashboard())))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/products", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.ListVendorProducts())))
+	apiMux.HandleFunc("POST /api/v1/vendors/me/products", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.CreateVendorProduct())))
+	apiMux.HandleFunc("PUT /api/v1/vendors/me/products/{id}", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.UpdateVendorProduct())))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/questions", authMiddleware.Authenticate(authMiddleware.RequireVendor(productQuestionHandler.ListVendorQuestions())))
+	apiMux.HandleFunc("POST /api/v1/vendors/me/questions/{id}/answer", authMiddleware.Authenticate(authMiddleware.RequireVendor(productQuestionHandler.AnswerVendorQuestion())))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/orders", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.ListVendorOrders())))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/payouts", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.ListVendorPayouts())))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/earnings", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.GetEarnings())))
+	apiMux.HandleFunc("GET /api/v1/vendors/me/ledger", authMiddleware.Authenticate(authMiddleware.RequireVendor(vendorHandler.ListLedgerEntries())))
+	apiMux.HandleFunc("POST /api/v1/payments", authMiddleware.Authenticate(authMiddleware.DenyImpersonation(concurrencyLimiter.Limit(paymentHandler.CreatePayment()))))
+	apiMux.HandleFunc("POST /api/v1/payments/checkout-session", authMiddleware.Authenticate(authMiddleware.DenyImpersonation(concurrencyLimiter.Limit(paymentHandler.CreateCheckoutSession()))))
 	apiMux.HandleFunc("GET /api/v1/payments/{id}", authMiddleware.Authenticate(paymentHandler.GetPayment()))
 	apiMux.HandleFunc("GET /api/v1/payments", authMiddleware.Authenticate(paymentHandler.ListPayments()))
-	apiMux.HandleFunc("POST /api/v1/payments/webhook", authMiddleware.Authenticate(paymentHandler.HandleStripeWebhook()))
-	apiMux.HandleFunc("POST /api/v1/notifications/email", authMiddleware.Authenticate(notificationHandler.SendEmail()))
+	apiMux.HandleFunc("POST /api/v1/payments/webhook", paymentHandler.HandleStripeWebhook())
+	apiMux.HandleFunc("POST /api/v1/notifications/email", authMiddleware.Authenticate(authMiddleware.DenyImpersonation(notificationHandler.SendEmail())))
 	apiMux.HandleFunc("GET /api/v1/notifications", authMiddleware.Authenticate(notificationHandler.ListNotifications()))
-
-	// Main router
+	apiMux.HandleFunc("POST /api/v1/notifications/sendgrid/events", notificationHandler.HandleSendGridEvents())
+	apiMux.HandleFunc("POST /api/v1/webhooks/carrier", middleware.VerifyWebhook(carrierWebhooks, orderHandler.HandleCarrierEvent()))
+	apiMux.HandleFunc("GET /api/v1/admin/notifications", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.SearchNotifications())))
+	apiMux.HandleFunc("GET /api/v1/admin/notifications/export", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.ExportNotifications())))
+	apiMux.HandleFunc("GET /api/v1/admin/search", authMiddleware.Authenticate(authMiddleware.RequireAnyRole([]models.UserRole{models.RoleStaff, models.RoleAdmin}, supportSearchHandler.Search())))
+	apiMux.HandleFunc("GET /api/v1/admin/notifications/search", authMiddleware.Authenticate(authMiddleware.RequireAnyRole([]models.UserRole{models.RoleStaff, models.RoleAdmin}, notificationHandler.FindRecipientNotifications())))
+	apiMux.HandleFunc("POST /api/v1/admin/notifications/{id}/retry", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.RetryNotification())))
+	apiMux.HandleFunc("POST /api/v1/admin/notifications/{id}/resend", authMiddleware.Authenticate(authMiddleware.RequireAnyRole([]models.UserRole{models.RoleStaff, models.RoleAdmin}, notificationHandler.ResendNotification())))
+	apiMux.HandleFunc("GET /api/v1/admin/email-suppressions", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.ListSuppressions())))
+	apiMux.HandleFunc("POST /api/v1/admin/email-suppressions", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.AddSuppression())))
+	apiMux.HandleFunc("POST /api/v1/admin/email-suppressions/import", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.ImportSuppressions())))
+	apiMux.HandleFunc("DELETE /api/v1/admin/email-suppressions/{email}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, notificationHandler.RemoveSuppression())))
+	apiMux.HandleFunc("POST /api/v1/admin/notifications/broadcast", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, broadcastHandler.CreateBroadcast())))
+	apiMux.HandleFunc("GET /api/v1/admin/notifications/broadcasts/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, broadcastHandler.GetBroadcast())))
+	apiMux.HandleFunc("POST /api/v1/admin/notifications/broadcasts/{id}/cancel", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, broadcastHandler.CancelBroadcast())))
+	apiMux.HandleFunc("POST /api/v1/admin/catalog/imports", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, catalogImportHandler.CreateImport())))
+	apiMux.HandleFunc("GET /api/v1/admin/catalog/imports/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, catalogImportHandler.GetImport())))
+	apiMux.HandleFunc("GET /api/v1/admin/catalog/imports/{id}/items", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, catalogImportHandler.ListImportItems())))
+	apiMux.HandleFunc("POST /api/v1/admin/cache/{namespace}/invalidate", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, cacheHandler.InvalidateNamespace())))
+	apiMux.HandleFunc("GET /api/v1/admin/log-level", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, logLevelHandler.GetLogLevel())))
+	apiMux.HandleFunc("PUT /api/v1/admin/log-level", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, logLevelHandler.UpdateLogLevel())))
+	apiMux.HandleFunc("DELETE /api/v1/admin/log-level", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, logLevelHandler.ResetLogLevel())))
+	apiMux.HandleFunc("GET /api/v1/admin/payload-logging", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, payloadLogHandler.GetPayloadLogRules())))
+	apiMux.HandleFunc("PUT /api/v1/admin/payload-logging", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, payloadLogHandler.UpdatePayloadLogRules())))
+	apiMux.HandleFunc("DELETE /api/v1/admin/payload-logging", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, payloadLogHandler.DeletePayloadLogRules())))
+	apiMux.HandleFunc("POST /api/v1/admin/invariants/check", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(invariantHandler.RunChecks()))))
+	apiMux.HandleFunc("GET /api/v1/admin/invariants/report", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, invariantHandler.GetReport())))
+	apiMux.HandleFunc("GET /api/v1/admin/reports/sales", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(paymentHandler.GetSalesReport()))))
+	apiMux.HandleFunc("GET /api/v1/admin/reports/ledger", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(ledgerHandler.GetLedgerReport()))))
+	apiMux.HandleFunc("GET /api/v1/admin/analytics/customers", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, analyticsHandler.GetCustomerAnalytics())))
+	apiMux.HandleFunc("GET /api/v1/admin/analytics/cohorts", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, analyticsHandler.GetCohortReport())))
+	apiMux.HandleFunc("GET /api/v1/admin/analytics/cohorts/export", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, analyticsHandler.ExportCohortReport())))
+	apiMux.HandleFunc("POST /api/v1/admin/analytics/refresh", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(analyticsHandler.RefreshAnalytics()))))
+	apiMux.HandleFunc("POST /api/v1/admin/retention/run", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, retentionHandler.RunRetention())))
+	apiMux.HandleFunc("GET /api/v1/admin/store-settings", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, storeSettingsHandler.GetStoreSettings())))
+	apiMux.HandleFunc("PUT /api/v1/admin/store-settings", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, storeSettingsHandler.UpdateStoreSettings())))
+	apiMux.HandleFunc("GET /api/v1/admin/reports/payments/export", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(paymentHandler.ExportPayments()))))
+	apiMux.HandleFunc("GET /api/v1/admin/disputes", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, disputeHandler.ListDisputes())))
+	apiMux.HandleFunc("GET /api/v1/admin/webhooks/dead-letters", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, paymentHandler.ListWebhookDeadLetters())))
+	apiMux.HandleFunc("POST /api/v1/admin/webhooks/dead-letters/{id}/resolve", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, paymentHandler.ResolveWebhookDeadLetter())))
+	apiMux.HandleFunc("POST /api/v1/admin/warehouses", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, warehouseHandler.CreateWarehouse())))
+	apiMux.HandleFunc("GET /api/v1/admin/warehouses", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, warehouseHandler.ListWarehouses())))
+	apiMux.HandleFunc("GET /api/v1/admin/warehouses/{id}/stock", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, warehouseHandler.GetWarehouseStock())))
+	apiMux.HandleFunc("PUT /api/v1/admin/warehouses/{id}/stock", authMiddleware.AuthenticateScope(models.ScopeCatalogWrite, authMiddleware.RequireRole(models.RoleAdmin, warehouseHandler.SetWarehouseStock())))
+	apiMux.HandleFunc("POST /api/v1/admin/stocktakes", authMiddleware.AuthenticateScope(models.ScopeCatalogWrite, authMiddleware.RequireRole(models.RoleAdmin, stocktakeHandler.CreateStocktake())))
+	apiMux.HandleFunc("GET /api/v1/admin/stocktakes", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, stocktakeHandler.ListStocktakes())))
+	apiMux.HandleFunc("GET /api/v1/admin/stocktakes/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, stocktakeHandler.GetStocktake())))
+	apiMux.HandleFunc("PUT /api/v1/admin/stocktakes/{id}/counts", authMiddleware.AuthenticateScope(models.ScopeCatalogWrite, authMiddleware.RequireRole(models.RoleAdmin, stocktakeHandler.SubmitCounts())))
+	apiMux.HandleFunc("GET /api/v1/admin/stocktakes/{id}/variances", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, stocktakeHandler.GetVariances())))
+	apiMux.HandleFunc("POST /api/v1/admin/stocktakes/{id}/apply", authMiddleware.AuthenticateScope(models.ScopeCatalogWrite, authMiddleware.RequireRole(models.RoleAdmin, stocktakeHandler.ApplyStocktake())))
+	apiMux.HandleFunc("GET /api/v1/admin/products/changes", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productHandler.ListProductChanges())))
+	apiMux.HandleFunc("POST /api/v1/admin/products/prices", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productHandler.UpdatePrices())))
+	apiMux.HandleFunc("PATCH /api/v1/admin/products/quick-edit", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productHandler.QuickEditProducts())))
+	apiMux.HandleFunc("PUT /api/v1/admin/products/{id}/related", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productHandler.SetRelatedProducts())))
+	apiMux.HandleFunc("PUT /api/v1/admin/products/{id}/purchase-limit", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productHandler.SetPurchaseLimit())))
+	apiMux.HandleFunc("PUT /api/v1/admin/products/{id}/shipping-restriction", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, shippingHandler.SetProductRestriction())))
+	apiMux.HandleFunc("GET /api/v1/admin/products/{id}/shipping-restriction", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, shippingHandler.GetProductRestriction())))
+	apiMux.HandleFunc("DELETE /api/v1/admin/products/{id}/shipping-restriction", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, shippingHandler.DeleteProductRestriction())))
+	apiMux.HandleFunc("GET /api/v1/admin/products/{id}/stock", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, warehouseHandler.GetProductStock())))
+	apiMux.HandleFunc("PATCH /api/v1/admin/orders/status-bulk", authMiddleware.AuthenticateScope(models.ScopeOrdersWrite, authMiddleware.RequireRole(models.RoleAdmin, orderHandler.BulkUpdateOrderStatus())))
+	apiMux.HandleFunc("PATCH /api/v1/admin/orders/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, orderEditHandler.EditOrder())))
+	apiMux.HandleFunc("POST /api/v1/admin/orders/{id}/refunds", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, orderRefundHandler.RefundOrder())))
+	apiMux.HandleFunc("GET /api/v1/admin/orders/{id}/refunds", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, orderRefundHandler.ListOrderRefunds())))
+	apiMux.HandleFunc("GET /api/v1/admin/orders/{id}/allocations", authMiddleware.AuthenticateScope(models.ScopeOrdersRead, authMiddleware.RequireRole(models.RoleAdmin, warehouseHandler.GetOrderAllocations())))
+	apiMux.HandleFunc("GET /api/v1/admin/orders/{id}/packing-slip", authMiddleware.AuthenticateScope(models.ScopeOrdersRead, authMiddleware.RequireRole(models.RoleAdmin, orderHandler.GetPackingSlip())))
+	apiMux.HandleFunc("POST /api/v1/admin/orders/packing-slips", authMiddleware.AuthenticateScope(models.ScopeOrdersRead, authMiddleware.RequireRole(models.RoleAdmin, orderHandler.GetPackingSlips())))
+	apiMux.HandleFunc("POST /api/v1/admin/fulfillment-waves", authMiddleware.AuthenticateScope(models.ScopeOrdersWrite, authMiddleware.RequireRole(models.RoleAdmin, fulfillmentWaveHandler.CreateWave())))
+	apiMux.HandleFunc("GET /api/v1/admin/fulfillment-waves", authMiddleware.AuthenticateScope(models.ScopeOrdersRead, authMiddleware.RequireRole(models.RoleAdmin, fulfillmentWaveHandler.ListWaves())))
+	apiMux.HandleFunc("GET /api/v1/admin/fulfillment-waves/{id}", authMiddleware.AuthenticateScope(models.ScopeOrdersRead, authMiddleware.RequireRole(models.RoleAdmin, fulfillmentWaveHandler.GetWave())))
+	apiMux.HandleFunc("GET /api/v1/admin/fulfillment-waves/{id}/pick-list", authMiddleware.AuthenticateScope(models.ScopeOrdersRead, authMiddleware.RequireRole(models.RoleAdmin, fulfillmentWaveHandler.GetPickList())))
+	apiMux.HandleFunc("POST /api/v1/admin/fulfillment-waves/{id}/complete", authMiddleware.AuthenticateScope(models.ScopeOrdersWrite, authMiddleware.RequireRole(models.RoleAdmin, fulfillmentWaveHandler.CompleteWave())))
+	apiMux.HandleFunc("POST /api/v1/admin/pickup-locations", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, pickupHandler.CreateLocation())))
+	apiMux.HandleFunc("GET /api/v1/admin/pickup-locations", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, pickupHandler.ListAllLocations())))
+	apiMux.HandleFunc("PATCH /api/v1/admin/pickup-locations/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, pickupHandler.UpdateLocation())))
+	apiMux.HandleFunc("POST /api/v1/admin/orders/{id}/pickup-ready", authMiddleware.AuthenticateScope(models.ScopeOrdersWrite, authMiddleware.RequireRole(models.RoleAdmin, pickupHandler.MarkReady())))
+	apiMux.HandleFunc("GET /api/v1/admin/vendors", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, vendorHandler.ListVendors())))
+	apiMux.HandleFunc("PATCH /api/v1/admin/vendors/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, vendorHandler.UpdateVendor())))
+	apiMux.HandleFunc("POST /api/v1/admin/vendors/payouts", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(vendorHandler.CalculatePayouts()))))
+	apiMux.HandleFunc("GET /api/v1/admin/vendors/payouts", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, vendorHandler.ListPayouts())))
+	apiMux.HandleFunc("POST /api/v1/admin/vendors/payout-batches", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, concurrencyLimiter.Limit(vendorHandler.CreatePayoutBatch()))))
+	apiMux.HandleFunc("GET /api/v1/admin/vendors/payout-batches/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, vendorHandler.GetPayoutBatch())))
+
+	apiMux.HandleFunc("POST /api/v1/admin/campaigns", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, campaignHandler.CreateCampaign())))
+	apiMux.HandleFunc("GET /api/v1/admin/campaigns", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, campaignHandler.ListCampaigns())))
+	apiMux.HandleFunc("GET /api/v1/admin/campaigns/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, campaignHandler.GetCampaign())))
+	apiMux.HandleFunc("POST /api/v1/admin/campaigns/{id}/cancel", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, campaignHandler.CancelCampaign())))
+	apiMux.HandleFunc("GET /api/v1/admin/fraud-reviews", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, fraudHandler.ListReviews())))
+	apiMux.HandleFunc("GET /api/v1/admin/fraud-reviews/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, fraudHandler.GetReview())))
+	apiMux.HandleFunc("POST /api/v1/admin/fraud-reviews/{id}/resolve", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, fraudHandler.ResolveReview())))
+	apiMux.HandleFunc("GET /api/v1/admin/questions", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productQuestionHandler.ListQuestions())))
+	apiMux.HandleFunc("POST /api/v1/admin/questions/{id}/moderate", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productQuestionHandler.ModerateQuestion())))
+	apiMux.HandleFunc("POST /api/v1/admin/questions/{id}/answer", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, productQuestionHandler.AnswerQuestion())))
+	apiMux.HandleFunc("GET /api/v1/admin/devices/{fingerprint}/accounts", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, fraudHandler.ListLinkedAccounts())))
+
+	apiMux.HandleFunc("POST /api/v1/admin/api-clients", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, apiClientHandler.CreateClient())))
+	apiMux.HandleFunc("GET /api/v1/admin/api-clients", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, apiClientHandler.ListClients())))
+	apiMux.HandleFunc("POST /api/v1/admin/api-clients/{id}/rotate-secret", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, apiClientHandler.RotateSecret())))
+	apiMux.HandleFunc("DELETE /api/v1/admin/api-clients/{id}", authMiddleware.Authenticate(authMiddleware.RequireRole(models.RoleAdmin, apiClientHandler.RevokeClient())))
+
+	// Main router, the public port only serves the API
 	mainMux := http.NewServeMux()
 
+	// Ops router, served on a port of its own
+	opsMux := http.NewServeMux()
+	opsAuth := middleware.BasicAuth(cfg.OpsServer.BasicAuthUsername, cfg.OpsServer.BasicAuthPassword)
+
 	// Metrics handler
-	mainMux.Handle("/metrics", metrics.Handler())
+	if err := metrics.RegisterSLOs(cfg.Metrics.SLOs); err != nil {
+		slog.Error("❌ Failed to register SLOs", "error", err.Error())
+		os.Exit(1)
+	}
 
-	// Liveness check endpoint
-	mainMux.Handle("/livez", livenessHandler)
+	opsMux.Handle("/metrics", opsAuth(metrics.Handler()))
+
+	// Liveness check endpoint, left open for the kubelet
+	opsMux.Handle("/livez", livenessHandler)
 	slog.Info("⚕️ Liveness probe available", slog.String("path", "/livez"))
 
-	// Readiness check endpoint
-	mainMux.Handle("/readyz", readinessHandler)
+	// Readiness check endpoint, left open for the kubelet, failing while the instance drains
+	drainer := health.NewDrainer()
+	opsMux.Handle("/readyz", drainer.Readiness(healthChecker.ReadinessHandler()))
 	slog.Info("⚕️ Readiness probe available", slog.String("path", "/readyz"))
 
-	// Swagger UI enpoint
-	mainMux.Handle("/swagger/", httpSwagger.WrapHandler)
-	slog.Info("Swagger UI available at http://" + swaggerHost + "/swagger/index.html")
+	// Takes the instance out of the load balancer ahead of a rollout, reached on each instance directly
+	opsMux.Handle("POST /admin/drain", opsAuth(drainer.DrainHandler(cfg.HTTPServer.DrainTimeout)))
+	opsMux.Handle("GET /admin/drain", opsAuth(drainer.StatusHandler()))
+	opsMux.Handle("DELETE /admin/drain", opsAuth(drainer.ResumeHandler()))
+
+	// Every dependency with its criticality, degraded ones included
+	opsMux.Handle("GET /healthz/verbose", opsAuth(healthChecker.VerboseHandler()))
+
+	// Validates the config file as the next start would load it
+	opsMux.Handle("GET /debug/config-validate", opsAuth(health.ConfigValidateHandler(cfg)))
+
+	// The spec is always there for internal tooling, the Swagger UI only where enabled
+	opsMux.Handle("GET /swagger/doc.json", opsAuth(handlers.OpenAPISpec()))
+
+	if cfg.SwaggerUIEnabled() {
+		opsMux.Handle("/swagger/", opsAuth(httpSwagger.WrapHandler))
+		slog.Info("Swagger UI available at " + cfg.OpsServer.Addr + "/swagger/index.html")
+	} else {
+		slog.Info("Swagger UI disabled, spec available at "+cfg.OpsServer.Addr+"/swagger/doc.json", slog.String("env", cfg.Env))
+	}
+
+	if !middleware.IsSupportedAPIVersion(cfg.API.DefaultVersion) {
+		slog.Error("❌ Unsupported default API version", slog.String("version", cfg.API.DefaultVersion))
+		os.Exit(1)
+	}
+
+	if _, err := time.LoadLocation(cfg.Store.DefaultTimezone); err != nil {
+		slog.Error("❌ Unknown default store timezone", slog.String("timezone", cfg.Store.DefaultTimezone))
+		os.Exit(1)
+	}
 
 	var apiHandler http.Handler = apiMux // raw router as base handler
 
 	// Middleware chaining -> Reverse order of execution,
-	apiHandler = middleware.Logging(apiHandler) // Log all info
-	apiHandler = metrics.Middleware(apiHandler)
+	apiHandler = middleware.RateLimitHeaders(apiHandler)                          // Report the rate limits the handlers check
+	apiHandler = middleware.NewLoadShedder(&cfg.LoadShedding).Handler(apiHandler) // Shed catalog reads under overload
+	apiHandler = middleware.SlowRequest(cfg.HTTPServer.SlowRequestThreshold)(apiHandler)
+	apiHandler = middleware.Locale(storeSettingsService.DefaultLocale)(apiHandler) // Dates in the language and timezone of the client
+	apiHandler = middleware.APIVersion(cfg.API.DefaultVersion)(apiHandler)
+	apiHandler = payloadLogger.Handler(apiHandler) // Redacted bodies of the routes and users picked for debugging
+	apiHandler = middleware.Logging(apiHandler)    // Log all info
+	apiHandler = metrics.Middleware(apiMux)(apiHandler)
+	apiHandler = middleware.TraceID(apiHandler)
 	apiHandler = otelhttp.NewHandler(apiHandler, cfg.OTel.ServiceName) //  Wraps actual business logic
 
 	mainMux.Handle("/api/v1/", apiHandler)
 
+	// Access logs cover every route (incl. probes), sampling is configured per route
+	accessLogSink, err := middleware.OpenAccessLogSink(cfg.AccessLog.Output)
+	if err != nil {
+		slog.Error("❌ Failed to open access log sink", "error", err.Error())
+		os.Exit(1)
+	}
+
+	defer accessLogSink.Close()
+
+	accessLogger := middleware.NewAccessLogger(&cfg.AccessLog, accessLogSink)
+
+	slog.Info("Access logging configured",
+		slog.Bool("enabled", cfg.AccessLog.Enabled),
+		slog.String("format", cfg.AccessLog.Format),
+		slog.String("output", cfg.AccessLog.Output),
+	)
+
 	// Setup http server
 	server := http.Server{
 		Addr:         cfg.HTTPServer.Addr,
-		Handler:      mainMux,
+		Handler:      accessLogger.Handler(mainMux),
+		ReadTimeout:  cfg.HTTPServer.ReadTimeout,
+		WriteTimeout: cfg.HTTPServer.WriteTimeout,
+		IdleTimeout:  cfg.HTTPServer.IdleTimeout,
+	}
+
+	drainer.Attach(&server)
+
+	// Setup ops server, probes and scrapes are small and quick
+	opsServer := http.Server{
+		Addr:         cfg.OpsServer.Addr,
+		Handler:      accessLogger.Handler(opsMux),
 		ReadTimeout:  cfg.HTTPServer.ReadTimeout,
 		WriteTimeout: cfg.HTTPServer.WriteTimeout,
 		IdleTimeout:  cfg.HTTPServer.IdleTimeout,
 	}
 
 	slog.Info("🚀 Server is starting...", slog.String("address", cfg.HTTPServer.Addr))
-	slog.Info("📊 Metrics available", slog.String("path", "/metrics"))
+	slog.Info("📊 Metrics available", slog.String("address", cfg.OpsServer.Addr), slog.String("path", "/metrics"),
+		slog.Bool("basicAuth", cfg.OpsServer.BasicAuthUsername != ""))
 
-	done := make(chan os.Signal, 1)
-	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
-	go func() { // Starts the HTTP server in a new goroutine so it doesn't block the main thread.
-		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
-			slog.Error("❌ Server failed to start", "error", err.Error())
-			close(done)
-		}
-	}()
+	// failed is closed by the first server failing, the signal channel is only ever written by the signal package
+	failed := make(chan struct{})
+
+	var failOnce sync.Once
+
+	// Starts a server in a new goroutine so it doesn't block the main thread, either failing stops the app
+	serve := func(name string, srv *http.Server) {
+		go func() {
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("❌ Server failed to start", slog.String("server", name), slog.String("error", err.Error()))
+				failOnce.Do(func() { close(failed) })
+			}
+		}()
+	}
+
+	serve("api", &server)
+	serve("ops", &opsServer)
 
 	slog.Info("✅ Server started successfully")
-	<-done // blocking, until no signal is added to "done" channel, after the some signal is received the code after this point would be executed
+
+	// blocking until a signal is received or a server fails
+	select {
+	case <-signals:
+	case <-failed:
+	}
+
+	// a second signal during the shutdown gets the default behaviour, which stops the process right away
+	signal.Stop(signals)
 
 	// Graceful shutdown
 	slog.Info("⏳ Server shutting down...")
@@ -295,4 +885,18 @@ func main() {
 	} else {
 		slog.Info("✅ Server shutdown complete")
 	}
+
+	// The probes and metrics stay up while the API drains
+	if err := opsServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("⚠️ Ops server shutdown failed", "error", err)
+	}
+
+	// Jobs are stopped once no more requests come in, so that every accepted webhook gets processed
+	stopJobs()
+
+	select {
+	case <-webhooksDone:
+	case <-shutdownCtx.Done():
+		slog.Error("⚠️ Timed out draining the webhook queue")
+	}
 }
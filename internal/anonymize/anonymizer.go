@@ -0,0 +1,152 @@
+// Package anonymize copies the database of production into staging with the personal data replaced.
+//
+// Replacements are deterministic: a value is replaced by the same fake value wherever it appears, in
+// every table and on every run with the same key, so what joins on it still joins and the data keeps its
+// shape, like how many customers share a city or an email domain. Without the key a replacement can't be
+// traced back to the value it replaced. Identifiers are copied as they are, which keeps the references
+// between the tables intact.
+package anonymize
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+)
+
+var (
+	firstNames = []string{"Alex", "Sam", "Jordan", "Taylor", "Morgan", "Casey", "Riley", "Jamie", "Avery", "Quinn", "Rowan", "Drew", "Harper", "Emerson", "Finley", "Reese", "Skyler", "Dakota", "Hayden", "Parker"}
+	lastNames  = []string{"Smith", "Garcia", "Chen", "Okafor", "Novak", "Silva", "Kim", "Patel", "Müller", "Rossi", "Dubois", "Jensen", "Tanaka", "Kowalski", "Haddad", "Nguyen", "Larsen", "Moreau", "Costa", "Brennan"}
+	streets    = []string{"Maple Street", "Oak Avenue", "Cedar Lane", "Birch Road", "Elm Street", "Willow Way", "Pine Court", "Harbor Drive", "Meadow Lane", "Station Road", "Mill Street", "Park Avenue"}
+	cities     = []string{"Springfield", "Riverton", "Fairview", "Lakewood", "Greenville", "Brookfield", "Ashford", "Kingsport", "Westbury", "Clearwater", "Milton", "Oakridge", "Bayview", "Northgate", "Hillcrest"}
+)
+
+const filler = "lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod tempor incididunt ut labore "
+
+// Anonymizer replaces personal data with fake data derived from it and the key, and every password with
+// the hash of the staging password.
+type Anonymizer struct {
+	key          []byte
+	passwordHash string
+}
+
+func New(key []byte, passwordHash string) *Anonymizer {
+	return &Anonymizer{key: key, passwordHash: passwordHash}
+}
+
+// sum keys the value by its kind, the same value replaced as a name and as a city gives unrelated results.
+func (a *Anonymizer) sum(kind, value string) []byte {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(kind + ":" + value))
+
+	return mac.Sum(nil)
+}
+
+func (a *Anonymizer) pick(kind, value string, pool []string) string {
+	return pool[binary.BigEndian.Uint64(a.sum(kind, value))%uint64(len(pool))]
+}
+
+// Email replaces the mailbox and maps the domain to a fake one, the customers of a domain stay together.
+// Addresses differing only in case are replaced alike, as they reach the same mailbox.
+func (a *Anonymizer) Email(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	mailbox := "user-" + hex.EncodeToString(a.sum("email", email))[:12]
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return mailbox + "@anonymized.example"
+	}
+
+	return mailbox + "@" + hex.EncodeToString(a.sum("domain", email[at+1:]))[:8] + ".example"
+}
+
+// Name replaces a full name with a first and last name, a single name with a first name only.
+func (a *Anonymizer) Name(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return name
+	}
+
+	first := a.pick("first_name", name, firstNames)
+	if !strings.ContainsAny(name, " \t") {
+		return first
+	}
+
+	return first + " " + a.pick("last_name", name, lastNames)
+}
+
+// Address replaces the street, city and postal code. The country and state are kept, the shipping rules
+// and the reports go by them, and the postal code keeps its first two characters and its format.
+func (a *Anonymizer) Address(address models.Address) models.Address {
+	key := strings.ToLower(address.Street + "|" + address.City + "|" + address.PostalCode)
+	number := binary.BigEndian.Uint16(a.sum("street_number", key))%9999 + 1
+
+	address.Street = fmt.Sprintf("%d %s", number, a.pick("street", key, streets))
+	address.City = a.pick("city", strings.ToLower(address.City), cities)
+	address.PostalCode = a.postalCode(address.PostalCode)
+
+	return address
+}
+
+func (a *Anonymizer) postalCode(code string) string {
+	sum := a.sum("postal_code", strings.ToUpper(code))
+	runes := []rune(code)
+
+	for i := 2; i < len(runes); i++ {
+		b := sum[i%len(sum)]
+
+		switch r := runes[i]; {
+		case r >= '0' && r <= '9':
+			runes[i] = rune('0' + b%10)
+		case r >= 'A' && r <= 'Z':
+			runes[i] = rune('A' + b%26)
+		case r >= 'a' && r <= 'z':
+			runes[i] = rune('a' + b%26)
+		}
+	}
+
+	return string(runes)
+}
+
+// IP maps an IPv4 address into 10.0.0.0/8 and an IPv6 one into fd00::/8, the same address to the same one.
+func (a *Anonymizer) IP(ip string) string {
+	sum := a.sum("ip", ip)
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return a.Token(ip)
+	}
+
+	if addr.Unmap().Is4() {
+		return netip.AddrFrom4([4]byte{10, sum[0], sum[1], sum[2]}).String()
+	}
+
+	var v6 [16]byte
+	v6[0] = 0xfd
+	copy(v6[1:], sum)
+
+	return netip.AddrFrom16(v6).String()
+}
+
+// Token replaces an opaque identifier, like a device fingerprint, with a hex one of the same length.
+func (a *Anonymizer) Token(token string) string {
+	replaced := hex.EncodeToString(a.sum("token", token))
+
+	return replaced[:min(len(token), len(replaced))]
+}
+
+// Text replaces free text written by a customer or about one with filler of the same length.
+func (a *Anonymizer) Text(text string) string {
+	length := len([]rune(text))
+
+	return strings.TrimSpace(strings.Repeat(filler, length/len(filler)+1)[:length])
+}
+
+func (a *Anonymizer) PasswordHash() string {
+	return a.passwordHash
+}
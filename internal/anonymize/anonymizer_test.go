@@ -0,0 +1,78 @@
+package anonymize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/anonymize"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizer(t *testing.T) {
+	anonymizer := anonymize.New([]byte("0123456789abcdef"), "staging-hash")
+
+	t.Run("Email_DeterministicAndKeepsDomains", func(t *testing.T) {
+		// Act
+		first := anonymizer.Email("Jane.Doe@Gmail.com")
+		again := anonymizer.Email("jane.doe@gmail.com")
+		other := anonymizer.Email("john@gmail.com")
+
+		// Assert
+		assert.Equal(t, first, again)
+		assert.NotEqual(t, first, other)
+		assert.NotContains(t, first, "jane")
+		assert.True(t, strings.HasSuffix(first, ".example"))
+		assert.Equal(t, first[strings.Index(first, "@"):], other[strings.Index(other, "@"):])
+	})
+
+	t.Run("Email_OtherKeyGivesOtherReplacement", func(t *testing.T) {
+		// Act
+		other := anonymize.New([]byte("fedcba9876543210"), "staging-hash")
+
+		// Assert
+		assert.NotEqual(t, anonymizer.Email("jane@gmail.com"), other.Email("jane@gmail.com"))
+	})
+
+	t.Run("Name_KeepsShape", func(t *testing.T) {
+		// Assert
+		assert.Len(t, strings.Fields(anonymizer.Name("Jane Doe")), 2)
+		assert.Len(t, strings.Fields(anonymizer.Name("Cher")), 1)
+		assert.Equal(t, anonymizer.Name("Jane Doe"), anonymizer.Name("Jane Doe"))
+		assert.Empty(t, anonymizer.Name(""))
+	})
+
+	t.Run("Address_KeepsRegionAndFormat", func(t *testing.T) {
+		// Arrange
+		address := models.Address{Street: "221B Baker Street", City: "London", State: "Greater London", PostalCode: "NW1 6XE", Country: "GB"}
+
+		// Act
+		replaced := anonymizer.Address(address)
+
+		// Assert
+		assert.NotEqual(t, address.Street, replaced.Street)
+		assert.NotEqual(t, address.City, replaced.City)
+		assert.Equal(t, address.State, replaced.State)
+		assert.Equal(t, address.Country, replaced.Country)
+		assert.Len(t, replaced.PostalCode, len(address.PostalCode))
+		assert.Equal(t, "NW", replaced.PostalCode[:2])
+		assert.Equal(t, " ", replaced.PostalCode[3:4])
+		assert.Equal(t, replaced.City, anonymizer.Address(models.Address{City: "london"}).City)
+	})
+
+	t.Run("IP_StaysInPrivateRanges", func(t *testing.T) {
+		// Assert
+		assert.True(t, strings.HasPrefix(anonymizer.IP("203.0.113.7"), "10."))
+		assert.True(t, strings.HasPrefix(anonymizer.IP("2001:db8::1"), "fd"))
+		assert.Equal(t, anonymizer.IP("203.0.113.7"), anonymizer.IP("203.0.113.7"))
+	})
+
+	t.Run("Text_KeepsLength", func(t *testing.T) {
+		// Act
+		replaced := anonymizer.Text("Happy birthday, love from Jane!")
+
+		// Assert
+		assert.LessOrEqual(t, len(replaced), len("Happy birthday, love from Jane!"))
+		assert.NotContains(t, replaced, "Jane")
+	})
+}
@@ -0,0 +1,143 @@
+package anonymize
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Copier copies tables from the source database into the target one, anonymizing them on the way: the
+// personal data is never written to the target.
+type Copier struct {
+	source     *sql.DB
+	target     *sql.DB
+	anonymizer *Anonymizer
+}
+
+func NewCopier(source, target *sql.DB, anonymizer *Anonymizer) *Copier {
+	return &Copier{source: source, target: target, anonymizer: anonymizer}
+}
+
+// Copy replaces the content of the tables in the target with the anonymized content of the source, in
+// a single transaction, so staging is never left half copied. It is not bound by a timeout, copying
+// takes as long as the production data is large.
+func (c *Copier) Copy(ctx context.Context, tables []Table) error {
+	tx, err := c.target.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	names := make([]string, 0, len(tables))
+	for _, table := range tables {
+		names = append(names, pq.QuoteIdentifier(table.Name))
+	}
+
+	if _, err := tx.ExecContext(ctx, "TRUNCATE "+strings.Join(names, ", ")+" CASCADE"); err != nil {
+		return fmt.Errorf("failed to empty the target tables: %w", err)
+	}
+
+	for _, table := range tables {
+		copied, err := c.copyTable(ctx, tx, table)
+		if err != nil {
+			return err
+		}
+
+		slog.Info("Table copied", slog.String("table", table.Name), slog.Int("rows", copied))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Copier) copyTable(ctx context.Context, tx *sql.Tx, table Table) (int, error) {
+	rows, err := c.source.QueryContext(ctx, "SELECT * FROM "+pq.QuoteIdentifier(table.Name))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read table %s: %w", table.Name, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.ColumnTypes()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the columns of table %s: %w", table.Name, err)
+	}
+
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name()
+	}
+
+	// a column renamed in the schema but not here would be copied as it is, personal data included
+	for name := range table.Columns {
+		if !containsColumn(names, name) {
+			return 0, fmt.Errorf("table %s has no column %s to anonymize", table.Name, name)
+		}
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table.Name, names...))
+	if err != nil {
+		return 0, fmt.Errorf("failed to start copying table %s: %w", table.Name, err)
+	}
+	defer stmt.Close()
+
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	copied := 0
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return copied, fmt.Errorf("failed to scan row of table %s: %w", table.Name, err)
+		}
+
+		for i, column := range columns {
+			// the driver reads UUIDs, JSON, numerics and arrays as bytes, they are copied back as text
+			if b, ok := values[i].([]byte); ok && column.DatabaseTypeName() != "BYTEA" {
+				values[i] = string(b)
+			}
+
+			if rule, ok := table.Columns[names[i]]; ok {
+				if values[i], err = rule(c.anonymizer, values[i]); err != nil {
+					return copied, fmt.Errorf("failed to anonymize column %s of table %s: %w", names[i], table.Name, err)
+				}
+			}
+		}
+
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return copied, fmt.Errorf("failed to copy row of table %s: %w", table.Name, err)
+		}
+
+		copied++
+	}
+
+	if err := rows.Err(); err != nil {
+		return copied, fmt.Errorf("error iterating table %s: %w", table.Name, err)
+	}
+
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return copied, fmt.Errorf("failed to finish copying table %s: %w", table.Name, err)
+	}
+
+	return copied, nil
+}
+
+func containsColumn(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package anonymize_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/anonymize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopier(t *testing.T) {
+	anonymizer := anonymize.New([]byte("0123456789abcdef"), "staging-hash")
+	tables := []anonymize.Table{
+		{Name: "users", Columns: map[string]anonymize.Rule{"email": anonymize.Email, "password": anonymize.Password}},
+		{Name: "orders", Columns: map[string]anonymize.Rule{"shipping_address": anonymize.AddressJSON}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		source, sourceMock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer source.Close()
+
+		target, targetMock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer target.Close()
+
+		targetMock.ExpectBegin()
+		targetMock.ExpectExec(`TRUNCATE "users", "orders" CASCADE`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		sourceMock.ExpectQuery(`SELECT \* FROM "users"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password"}).AddRow([]byte("u-1"), "jane@gmail.com", "secret-hash"))
+		targetMock.ExpectPrepare(`COPY "users"`)
+		targetMock.ExpectExec(`COPY "users"`).
+			WithArgs("u-1", anonymizer.Email("jane@gmail.com"), "staging-hash").WillReturnResult(sqlmock.NewResult(0, 1))
+		targetMock.ExpectExec(`COPY "users"`).WithoutArgs().WillReturnResult(sqlmock.NewResult(0, 0))
+
+		sourceMock.ExpectQuery(`SELECT \* FROM "orders"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "shipping_address"}).AddRow("o-1", nil))
+		targetMock.ExpectPrepare(`COPY "orders"`)
+		targetMock.ExpectExec(`COPY "orders"`).WithArgs("o-1", nil).WillReturnResult(sqlmock.NewResult(0, 1))
+		targetMock.ExpectExec(`COPY "orders"`).WithoutArgs().WillReturnResult(sqlmock.NewResult(0, 0))
+		targetMock.ExpectCommit()
+
+		// Act
+		err = anonymize.NewCopier(source, target, anonymizer).Copy(t.Context(), tables)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, sourceMock.ExpectationsWereMet())
+		assert.NoError(t, targetMock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure_MissingColumnRollsBack", func(t *testing.T) {
+		// Arrange
+		source, sourceMock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer source.Close()
+
+		target, targetMock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer target.Close()
+
+		targetMock.ExpectBegin()
+		targetMock.ExpectExec("TRUNCATE").WillReturnResult(sqlmock.NewResult(0, 0))
+		sourceMock.ExpectQuery(`SELECT \* FROM "users"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email_address", "password"}))
+		targetMock.ExpectRollback()
+
+		// Act
+		err = anonymize.NewCopier(source, target, anonymizer).Copy(t.Context(), tables)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has no column email")
+		assert.NoError(t, targetMock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure_TruncateError", func(t *testing.T) {
+		// Arrange
+		source, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer source.Close()
+
+		target, targetMock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer target.Close()
+
+		targetMock.ExpectBegin()
+		targetMock.ExpectExec("TRUNCATE").WillReturnError(errors.New("permission denied"))
+		targetMock.ExpectRollback()
+
+		// Act
+		err = anonymize.NewCopier(source, target, anonymizer).Copy(t.Context(), tables)
+
+		// Assert
+		require.Error(t, err)
+		assert.NoError(t, targetMock.ExpectationsWereMet())
+	})
+}
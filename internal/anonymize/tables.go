@@ -0,0 +1,119 @@
+package anonymize
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+)
+
+// Rule replaces the value of a column, given as a string or nil for NULL.
+type Rule func(a *Anonymizer, value any) (any, error)
+
+func stringRule(replace func(a *Anonymizer, value string) string) Rule {
+	return func(a *Anonymizer, value any) (any, error) {
+		s, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+
+		return replace(a, s), nil
+	}
+}
+
+// The rules of the columns holding personal data.
+var (
+	Email    = stringRule((*Anonymizer).Email)
+	Name     = stringRule((*Anonymizer).Name)
+	IP       = stringRule((*Anonymizer).IP)
+	Token    = stringRule((*Anonymizer).Token)
+	Text     = stringRule((*Anonymizer).Text)
+	Password = stringRule(func(a *Anonymizer, _ string) string { return a.PasswordHash() })
+
+	// EmptyJSON drops a JSON document of details that may hold anything, like an email being changed.
+	EmptyJSON = stringRule(func(_ *Anonymizer, _ string) string { return "{}" })
+
+	// AddressJSON replaces an address stored as JSON.
+	AddressJSON Rule = func(a *Anonymizer, value any) (any, error) {
+		s, ok := value.(string)
+		if !ok || s == "null" {
+			return value, nil
+		}
+
+		var address models.Address
+		if err := json.Unmarshal([]byte(s), &address); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal address: %w", err)
+		}
+
+		replaced, err := json.Marshal(a.Address(address))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal address: %w", err)
+		}
+
+		return string(replaced), nil
+	}
+)
+
+// Table is a table copied into staging, with the rules of its columns holding personal data. The other
+// columns are copied as they are.
+type Table struct {
+	Name    string
+	Columns map[string]Rule
+}
+
+// Tables lists the tables copied into staging, each after the tables it references. A table left out
+// is not copied at all: the machine clients, whose secrets have no business in staging, the received
+// and dead webhook events, whose raw payloads carry personal data, and the analytics views, which the
+// analytics refresh builds again. A new table, or a new column holding personal data, needs its entry here.
+var Tables = []Table{
+	{Name: "users", Columns: map[string]Rule{"email": Email, "name": Name, "password": Password}},
+	{Name: "user_tags"},
+	{Name: "categories"},
+	{Name: "vendors", Columns: map[string]Rule{"contact_email": Email}},
+	{Name: "warehouses"},
+	{Name: "pickup_locations"},
+	{Name: "products"},
+	{Name: "product_images"},
+	{Name: "product_relations"},
+	{Name: "product_shipping_restrictions"},
+	{Name: "product_changes"},
+	{Name: "product_questions", Columns: map[string]Rule{"question": Text, "content_hash": Token}},
+	{Name: "warehouse_stock"},
+	{Name: "campaigns"},
+	{Name: "campaign_items"},
+	{Name: "carts"},
+	{Name: "orders", Columns: map[string]Rule{"shipping_address": AddressJSON, "gift_message": Text}},
+	{Name: "order_items"},
+	{Name: "order_status_history"},
+	{Name: "order_pickups"},
+	{Name: "order_devices", Columns: map[string]Rule{"fingerprint": Token, "components": EmptyJSON, "ip_address": IP}},
+	{Name: "fraud_reviews", Columns: map[string]Rule{"note": Text}},
+	{Name: "payments", Columns: map[string]Rule{"description": Text}},
+	{Name: "disputes"},
+	{Name: "order_refunds", Columns: map[string]Rule{"reason": Text}},
+	{Name: "order_refund_lines"},
+	{Name: "order_documents"},
+	{Name: "order_document_sequences"},
+	{Name: "ledger_transactions"},
+	{Name: "ledger_entries"},
+	{Name: "stock_movements"},
+	{Name: "stock_allocations"},
+	{Name: "fulfillment_waves"},
+	{Name: "fulfillment_wave_orders"},
+	{Name: "stocktakes"},
+	{Name: "stocktake_lines"},
+	{Name: "vendor_orders"},
+	{Name: "payout_batches"},
+	{Name: "payout_batch_items"},
+	{Name: "vendor_payouts"},
+	{Name: "vendor_ledger_entries"},
+	{Name: "user_sessions", Columns: map[string]Rule{"ip_address": IP}},
+	{Name: "audit_log", Columns: map[string]Rule{"details": EmptyJSON}},
+	{Name: "email_changes", Columns: map[string]Rule{"old_email": Email, "new_email": Email}},
+	{Name: "email_suppressions", Columns: map[string]Rule{"email": Email}},
+	{Name: "notifications", Columns: map[string]Rule{"recipient": Email, "subject": Text, "content": Text, "metadata": EmptyJSON}},
+	{Name: "broadcasts"},
+	{Name: "catalog_imports"},
+	{Name: "catalog_import_items"},
+	{Name: "store_settings"},
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type AnalyticsHandler struct {
+	analyticsService service.AnalyticsService
+}
+
+func NewAnalyticsHandler(analyticsService service.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{analyticsService: analyticsService}
+}
+
+// GetCustomerAnalytics godoc
+//
+//	@Summary		Get customer lifetime value (Admin)
+//	@Description	Sums up the paid orders of every customer to date: the average lifetime value and order value, and the repeat purchase rate, the share of customers who paid for more than one order. Read from views refreshed on a schedule.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.CustomerAnalytics	"Successfully retrieved customer analytics"
+//	@Failure		401	{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/analytics/customers [get]
+func (h *AnalyticsHandler) GetCustomerAnalytics() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		analytics, err := h.analyticsService.GetCustomerAnalytics(r.Context())
+		if err != nil {
+			logger.Error("Failed to get customer analytics", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Customer analytics retrieved successfully", slog.Int("customers", analytics.Customers))
+		response.Success(w, http.StatusOK, analytics)
+	}
+}
+
+// GetCohortReport godoc
+//
+//	@Summary		Get monthly cohorts (Admin)
+//	@Description	Groups the customers by the month of their first paid order, with the customers still buying and the revenue of every month since. Covers the cohorts of the range, defaults to the last 12 months. Read from views refreshed on a schedule.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{object}	models.CohortReport		"Successfully retrieved cohort report"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/analytics/cohorts [get]
+func (h *AnalyticsHandler) GetCohortReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parseCohortRange(r)
+		if err != nil {
+			logger.Warn("Invalid cohort report range", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.Time("from", from), slog.Time("to", to))
+
+		report, err := h.analyticsService.GetCohortReport(r.Context(), from, to)
+		if err != nil {
+			logger.Error("Failed to build cohort report", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Cohort report retrieved successfully", slog.Int("cohorts", len(report.Cohorts)))
+		response.Success(w, http.StatusOK, report)
+	}
+}
+
+// ExportCohortReport godoc
+//
+//	@Summary		Export monthly cohorts as CSV (Admin)
+//	@Description	The cohort report as CSV, a row per cohort and month since its first orders. Defaults to the cohorts of the last 12 months.
+//	@Tags			Admin
+//	@Produce		text/csv
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{string}	string					"CSV export of the cohorts"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/analytics/cohorts/export [get]
+func (h *AnalyticsHandler) ExportCohortReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parseCohortRange(r)
+		if err != nil {
+			logger.Warn("Invalid cohort export range", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.Time("from", from), slog.Time("to", to))
+
+		report, err := h.analyticsService.GetCohortReport(r.Context(), from, to)
+		if err != nil {
+			logger.Error("Failed to export cohort report", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		export := newCSVExport(w, fmt.Sprintf("cohorts_%s_%s.csv", from.Format(time.DateOnly), to.Format(time.DateOnly)),
+			[]string{"cohort_month", "cohort_size", "cohort_lifetime_value", "order_month", "months_since", "active_customers", "retention_rate", "revenue"})
+
+		for _, cohort := range report.Cohorts {
+			for _, month := range cohort.Activity {
+				err := export.Write([]string{
+					cohort.Month.Format("2006-01"),
+					strconv.Itoa(cohort.Size),
+					strconv.FormatFloat(cohort.LifetimeValue, 'f', 2, 64),
+					month.OrderMonth.Format("2006-01"),
+					strconv.Itoa(month.MonthsSince),
+					strconv.Itoa(month.ActiveCustomers),
+					strconv.FormatFloat(month.RetentionRate, 'f', 4, 64),
+					strconv.FormatFloat(month.Revenue, 'f', 2, 64),
+				})
+				if err != nil {
+					logger.Error("Cohort export aborted", slog.Any("error", err), slog.Int("rows", export.Rows()))
+
+					return
+				}
+			}
+		}
+
+		if err := export.Close(); err != nil {
+			logger.Error("Failed to write cohort export", slog.Any("error", err))
+
+			return
+		}
+
+		logger.Info("Cohort report exported successfully", slog.Int("rows", export.Rows()))
+	}
+}
+
+// RefreshAnalytics godoc
+//
+//	@Summary		Refresh the analytics (Admin)
+//	@Description	Refreshes the views the analytics read right away, instead of waiting for the scheduled refresh. Answers once the refresh is done.
+//	@Tags			Admin
+//	@Success		204	"Analytics refreshed"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/analytics/refresh [post]
+func (h *AnalyticsHandler) RefreshAnalytics() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		start := time.Now()
+		if err := h.analyticsService.RefreshAnalytics(r.Context()); err != nil {
+			logger.Error("Failed to refresh analytics", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Analytics refreshed successfully", slog.Duration("duration", time.Since(start)))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// parseCohortRange defaults to the cohorts of the current month and the 11 before it. The views bucket
+// the months in UTC, so the range is UTC whatever the timezone of the request.
+func parseCohortRange(r *http.Request) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+
+	return parseDateRange(r, to.AddDate(0, -12, 0), to, time.UTC)
+}
@@ -0,0 +1,144 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCustomerAnalytics(t *testing.T) {
+	mockAnalyticsService := mocks.NewMockAnalyticsService(t)
+	analyticsHandler := handlers.NewAnalyticsHandler(mockAnalyticsService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockAnalyticsService.On("GetCustomerAnalytics", mock.Anything).
+			Return(&models.CustomerAnalytics{Customers: 4, RepeatCustomers: 1, RepeatPurchaseRate: 0.25}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/analytics/customers", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		analyticsHandler.GetCustomerAnalytics().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"repeat_purchase_rate":0.25`)
+	})
+
+	t.Run("Failure - Service error", func(t *testing.T) {
+		// Arrange
+		mockAnalyticsService.On("GetCustomerAnalytics", mock.Anything).
+			Return(nil, appErrors.DatabaseError("Failed to get customer analytics")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/analytics/customers", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		analyticsHandler.GetCustomerAnalytics().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestCohortReport(t *testing.T) {
+	mockAnalyticsService := mocks.NewMockAnalyticsService(t)
+	analyticsHandler := handlers.NewAnalyticsHandler(mockAnalyticsService)
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := jan.AddDate(0, 2, 0)
+	to := jan.AddDate(1, 0, 0)
+	report := &models.CohortReport{From: jan, To: to, Cohorts: []models.Cohort{
+		{Month: jan, Size: 10, LifetimeValue: 68, Activity: []models.CohortActivity{
+			{CohortMonth: jan, OrderMonth: jan, ActiveCustomers: 10, RetentionRate: 1, Revenue: 500},
+			{CohortMonth: jan, OrderMonth: mar, MonthsSince: 2, ActiveCustomers: 4, RetentionRate: 0.4, Revenue: 180},
+		}},
+	}}
+
+	t.Run("Success - Explicit range", func(t *testing.T) {
+		// Arrange
+		mockAnalyticsService.On("GetCohortReport", mock.Anything, jan, to).Return(report, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/analytics/cohorts?from=2025-01-01&to=2026-01-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		analyticsHandler.GetCohortReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"lifetime_value":68`)
+	})
+
+	t.Run("Success - CSV export", func(t *testing.T) {
+		// Arrange
+		mockAnalyticsService.On("GetCohortReport", mock.Anything, jan, to).Return(report, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/analytics/cohorts/export?from=2025-01-01&to=2026-01-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		analyticsHandler.ExportCohortReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "cohort_month,cohort_size,cohort_lifetime_value,order_month,months_since,active_customers,retention_rate,revenue\n"+
+			"2025-01,10,68.00,2025-01,0,10,1.0000,500.00\n"+
+			"2025-01,10,68.00,2025-03,2,4,0.4000,180.00\n", rr.Body.String())
+	})
+
+	t.Run("Failure - Invalid date", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/analytics/cohorts?from=january", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		analyticsHandler.GetCohortReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Export service error answers JSON", func(t *testing.T) {
+		// Arrange
+		mockAnalyticsService.On("GetCohortReport", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil, appErrors.DatabaseError("Failed to get cohort activity")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/analytics/cohorts/export", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		analyticsHandler.ExportCohortReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "application/json")
+	})
+}
+
+func TestRefreshAnalytics(t *testing.T) {
+	// Arrange
+	mockAnalyticsService := mocks.NewMockAnalyticsService(t)
+	analyticsHandler := handlers.NewAnalyticsHandler(mockAnalyticsService)
+	mockAnalyticsService.On("RefreshAnalytics", mock.Anything).Return(nil).Once()
+
+	req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/analytics/refresh", nil, uuid.New(), nil)
+	rr := httptest.NewRecorder()
+
+	// Act
+	analyticsHandler.RefreshAnalytics().ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
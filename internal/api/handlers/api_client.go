@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+const grantTypeClientCredentials = "client_credentials"
+
+type APIClientHandler struct {
+	apiClientService service.APIClientService
+	validator        *validator.Validate
+}
+
+func NewAPIClientHandler(apiClientService service.APIClientService) *APIClientHandler {
+	return &APIClientHandler{apiClientService: apiClientService, validator: validator.New()}
+}
+
+// CreateClient godoc
+//
+//	@Summary		Register a machine client (Admin)
+//	@Description	Registers an integration allowed to get tokens with the OAuth2 client credentials grant, for the given scopes. The client secret is only returned here.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			client	body		models.CreateAPIClientRequest	true	"Client Details"
+//	@Success		201		{object}	models.APIClientCredentials		"Successfully registered client"
+//	@Failure		400		{object}	response.ErrorResponse			"Validation error or invalid input"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/api-clients [post]
+func (h *APIClientHandler) CreateClient() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized API client registration attempt: missing user claims")
+			response.Error(w, appErrors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		var req models.CreateAPIClientRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to register API client", slog.String("name", req.Name), slog.Any("scopes", req.Scopes))
+
+		credentials, err := h.apiClientService.CreateClient(r.Context(), claims, &req)
+		if err != nil {
+			logger.Error("Failed to register API client", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("API client registered successfully", slog.String("clientId", credentials.Client.ID.String()))
+		response.Success(w, http.StatusCreated, credentials)
+	}
+}
+
+// ListClients godoc
+//
+//	@Summary		List machine clients (Admin)
+//	@Description	Retrieves every registered machine client, revoked ones included. Secrets are never returned.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{array}		models.APIClient		"Successfully retrieved clients"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/api-clients [get]
+func (h *APIClientHandler) ListClients() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		clients, err := h.apiClientService.ListClients(r.Context())
+		if err != nil {
+			logger.Error("Failed to list API clients", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("API clients listed successfully", slog.Int("count", len(clients)))
+		response.Success(w, http.StatusOK, clients)
+	}
+}
+
+// RotateSecret godoc
+//
+//	@Summary		Rotate the secret of a machine client (Admin)
+//	@Description	Issues a new client secret. The previous one keeps working for the rotation grace period so the integration can switch over.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string						true	"Client ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.APIClientCredentials	"Successfully rotated secret"
+//	@Failure		400	{object}	response.ErrorResponse		"Invalid client ID format"
+//	@Failure		401	{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse		"Client not found"
+//	@Failure		409	{object}	response.ErrorResponse		"Client is revoked"
+//	@Failure		500	{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/api-clients/{id}/rotate-secret [post]
+func (h *APIClientHandler) RotateSecret() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid API client ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("clientId", id.String()))
+
+		credentials, err := h.apiClientService.RotateSecret(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to rotate API client secret", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("API client secret rotated successfully")
+		response.Success(w, http.StatusOK, credentials)
+	}
+}
+
+// RevokeClient godoc
+//
+//	@Summary		Revoke a machine client (Admin)
+//	@Description	Stops the client from getting new tokens. The tokens it already holds expire within their lifetime.
+//	@Tags			Admin
+//	@Param			id	path	string	true	"Client ID (UUID)"	Format(uuid)
+//	@Success		204	"Client revoked"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid client ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Client not found or already revoked"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/api-clients/{id} [delete]
+func (h *APIClientHandler) RevokeClient() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid API client ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("clientId", id.String()))
+
+		if err := h.apiClientService.RevokeClient(r.Context(), id); err != nil {
+			logger.Error("Failed to revoke API client", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("API client revoked successfully")
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Token godoc
+//
+//	@Summary		Get a machine client token
+//	@Description	OAuth2 client credentials grant (RFC 6749, section 4.4). The client authenticates with HTTP Basic or the client_id and client_secret form fields. Responses use the OAuth2 shape, not the usual envelope.
+//	@Tags			OAuth
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			grant_type		formData	string						true	"Must be client_credentials"
+//	@Param			client_id		formData	string						false	"Client ID, when not sent with HTTP Basic"
+//	@Param			client_secret	formData	string						false	"Client secret, when not sent with HTTP Basic"
+//	@Param			scope			formData	string						false	"Space separated subset of the granted scopes, all of them when empty"
+//	@Success		200				{object}	models.ClientTokenResponse	"Access token"
+//	@Failure		400				{object}	models.OAuthError			"invalid_request, unsupported_grant_type or invalid_scope"
+//	@Failure		401				{object}	models.OAuthError			"invalid_client"
+//	@Failure		500				{object}	models.OAuthError			"server_error"
+//	@Router			/oauth/token [post]
+func (h *APIClientHandler) Token() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := r.ParseForm(); err != nil {
+			logger.Warn("Invalid token request body", slog.String("error", err.Error()))
+			writeOAuthError(w, http.StatusBadRequest, "invalid_request", "The request body is not a valid form")
+
+			return
+		}
+
+		if grantType := r.PostForm.Get("grant_type"); grantType != grantTypeClientCredentials {
+			logger.Warn("Unsupported grant type", slog.String("grantType", grantType))
+			writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "Only the client_credentials grant is supported")
+
+			return
+		}
+
+		clientID, secret, ok := r.BasicAuth()
+		if !ok {
+			clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+
+		if clientID == "" || secret == "" {
+			logger.Warn("Token request without client credentials")
+			writeOAuthError(w, http.StatusBadRequest, "invalid_request", "Client credentials are required")
+
+			return
+		}
+
+		logger = logger.With(slog.String("clientId", clientID))
+
+		token, err := h.apiClientService.IssueToken(r.Context(), clientID, secret, r.PostForm.Get("scope"))
+		if err != nil {
+			logger.Warn("Failed to issue client token", slog.String("error", err.Error()))
+
+			appErr, _ := appErrors.IsAppError(err)
+
+			switch {
+			case appErr != nil && appErr.Code == appErrors.ErrCodeInvalidClient:
+				w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+				writeOAuthError(w, http.StatusUnauthorized, "invalid_client", appErr.Message)
+			case appErr != nil && appErr.Code == appErrors.ErrCodeInvalidScope:
+				writeOAuthError(w, http.StatusBadRequest, "invalid_scope", appErr.Detail)
+			default:
+				writeOAuthError(w, http.StatusInternalServerError, "server_error", "")
+			}
+
+			return
+		}
+
+		logger.Info("Client token issued successfully", slog.String("scope", token.Scope))
+
+		if err := response.WriteJSON(w, http.StatusOK, token); err != nil {
+			logger.Error("Failed to write token response", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	if err := response.WriteJSON(w, status, models.OAuthError{Error: code, ErrorDescription: description}); err != nil {
+		slog.Error("failed to write OAuth error response", "error", err)
+	}
+}
@@ -0,0 +1,244 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAPIClient(t *testing.T) {
+	// Arrange
+	mockAPIClientService := mocks.NewMockAPIClientService(t)
+	apiClientHandler := handlers.NewAPIClientHandler(mockAPIClientService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateAPIClientRequest{Name: "ERP", Scopes: []string{models.ScopeOrdersWrite}}
+		credentials := &models.APIClientCredentials{Client: &models.APIClient{ID: uuid.New(), Name: "ERP", SecretHash: "hash"}, ClientSecret: "secret"}
+		mockAPIClientService.On("CreateClient", mock.Anything, mock.AnythingOfType("*models.Claims"), &reqBody).Return(credentials, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/api-clients", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.CreateClient().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"client_secret":"secret"`)
+		assert.NotContains(t, rr.Body.String(), "hash")
+	})
+
+	t.Run("Failure - Unknown scope", func(t *testing.T) {
+		// Arrange
+		reqBodyBytes, err := json.Marshal(models.CreateAPIClientRequest{Name: "ERP", Scopes: []string{"users:write"}})
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/api-clients", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.CreateClient().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestRotateAPIClientSecret(t *testing.T) {
+	// Arrange
+	mockAPIClientService := mocks.NewMockAPIClientService(t)
+	apiClientHandler := handlers.NewAPIClientHandler(mockAPIClientService)
+	clientID := uuid.New()
+
+	t.Run("Failure - Revoked client", func(t *testing.T) {
+		// Arrange
+		mockAPIClientService.On("RotateSecret", mock.Anything, clientID).Return(nil, appErrors.InvalidTransitionError("API client is revoked")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/api-clients/"+clientID.String()+"/rotate-secret", nil, uuid.New(), map[string]string{"id": clientID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.RotateSecret().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestRevokeAPIClient(t *testing.T) {
+	// Arrange
+	mockAPIClientService := mocks.NewMockAPIClientService(t)
+	apiClientHandler := handlers.NewAPIClientHandler(mockAPIClientService)
+	clientID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockAPIClientService.On("RevokeClient", mock.Anything, clientID).Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/admin/api-clients/"+clientID.String(), nil, uuid.New(), map[string]string{"id": clientID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.RevokeClient().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+}
+
+func TestClientToken(t *testing.T) {
+	// Arrange
+	mockAPIClientService := mocks.NewMockAPIClientService(t)
+	apiClientHandler := handlers.NewAPIClientHandler(mockAPIClientService)
+	clientID := uuid.New().String()
+
+	newTokenRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return req
+	}
+
+	decodeError := func(t *testing.T, rr *httptest.ResponseRecorder) models.OAuthError {
+		t.Helper()
+
+		var oauthErr models.OAuthError
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &oauthErr))
+
+		return oauthErr
+	}
+
+	t.Run("Success - HTTP Basic credentials", func(t *testing.T) {
+		// Arrange
+		token := &models.ClientTokenResponse{AccessToken: "token", TokenType: "Bearer", ExpiresIn: 900, Scope: models.ScopeCatalogRead}
+		mockAPIClientService.On("IssueToken", mock.Anything, clientID, "secret", models.ScopeCatalogRead).Return(token, nil).Once()
+
+		req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "scope": {models.ScopeCatalogRead}})
+		req.SetBasicAuth(clientID, "secret")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+		var resp models.ClientTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, *token, resp)
+	})
+
+	t.Run("Success - Form credentials", func(t *testing.T) {
+		// Arrange
+		mockAPIClientService.On("IssueToken", mock.Anything, clientID, "secret", "").Return(&models.ClientTokenResponse{AccessToken: "token"}, nil).Once()
+
+		req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "client_id": {clientID}, "client_secret": {"secret"}})
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Unsupported grant type", func(t *testing.T) {
+		// Arrange
+		req := newTokenRequest(url.Values{"grant_type": {"password"}, "client_id": {clientID}, "client_secret": {"secret"}})
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "unsupported_grant_type", decodeError(t, rr).Error)
+	})
+
+	t.Run("Failure - Missing credentials", func(t *testing.T) {
+		// Arrange
+		req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}})
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "invalid_request", decodeError(t, rr).Error)
+	})
+
+	t.Run("Failure - Invalid client", func(t *testing.T) {
+		// Arrange
+		mockAPIClientService.On("IssueToken", mock.Anything, clientID, "wrong", "").Return(nil, appErrors.InvalidClientError()).Once()
+
+		req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}})
+		req.SetBasicAuth(clientID, "wrong")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.NotEmpty(t, rr.Header().Get("WWW-Authenticate"))
+		assert.Equal(t, "invalid_client", decodeError(t, rr).Error)
+	})
+
+	t.Run("Failure - Invalid scope", func(t *testing.T) {
+		// Arrange
+		mockAPIClientService.On("IssueToken", mock.Anything, clientID, "secret", models.ScopeCatalogWrite).
+			Return(nil, appErrors.InvalidScopeError(`Scope "catalog:write" is not granted`)).Once()
+
+		req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "scope": {models.ScopeCatalogWrite}})
+		req.SetBasicAuth(clientID, "secret")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, models.OAuthError{Error: "invalid_scope", ErrorDescription: `Scope "catalog:write" is not granted`}, decodeError(t, rr))
+	})
+
+	t.Run("Failure - Server error", func(t *testing.T) {
+		// Arrange
+		mockAPIClientService.On("IssueToken", mock.Anything, clientID, "secret", "").Return(nil, errors.New("db down")).Once()
+
+		req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}})
+		req.SetBasicAuth(clientID, "secret")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		apiClientHandler.Token().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "server_error", decodeError(t, rr).Error)
+	})
+}
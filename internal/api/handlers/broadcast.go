@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type BroadcastHandler struct {
+	broadcastService service.BroadcastService
+	validator        *validator.Validate
+}
+
+func NewBroadcastHandler(broadcastService service.BroadcastService) *BroadcastHandler {
+	return &BroadcastHandler{broadcastService: broadcastService, validator: validator.New()}
+}
+
+// CreateBroadcast godoc
+//
+//	@Summary		Broadcast a notification (Admin)
+//	@Description	Emails a templated message to an audience: all users, the users with a tag or the purchasers of a product. Subject and content are Go templates rendered with the Name and Email fields of each recipient. The broadcast is sent in the background, its progress is available on the broadcast.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			broadcast	body		models.CreateBroadcastRequest	true	"Broadcast Details"
+//	@Success		202			{object}	models.Broadcast				"Broadcast accepted"
+//	@Failure		400			{object}	response.ErrorResponse			"Validation error or invalid template"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/broadcast [post]
+func (h *BroadcastHandler) CreateBroadcast() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized broadcast attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.CreateBroadcastRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to create broadcast", slog.String("audience", string(req.Audience.Type)))
+
+		broadcast, err := h.broadcastService.CreateBroadcast(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to create broadcast", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Broadcast created successfully", slog.String("broadcastId", broadcast.ID.String()), slog.Int("recipients", broadcast.TotalRecipients))
+		response.Success(w, http.StatusAccepted, broadcast)
+	}
+}
+
+// GetBroadcast godoc
+//
+//	@Summary		Get a broadcast (Admin)
+//	@Description	Retrieves a broadcast with its progress.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Broadcast ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.Broadcast		"Successfully retrieved broadcast"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid broadcast ID"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Broadcast not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/broadcasts/{id} [get]
+func (h *BroadcastHandler) GetBroadcast() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid broadcast ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("broadcastId", id.String()))
+
+		broadcast, err := h.broadcastService.GetBroadcast(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to fetch broadcast", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Broadcast retrieved successfully")
+		response.Success(w, http.StatusOK, broadcast)
+	}
+}
+
+// CancelBroadcast godoc
+//
+//	@Summary		Cancel a broadcast (Admin)
+//	@Description	Stops a pending or running broadcast. A running broadcast stops after the batch in progress.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Broadcast ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.Broadcast		"Successfully cancelled broadcast"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid broadcast ID or broadcast already finished"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Broadcast not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/broadcasts/{id}/cancel [post]
+func (h *BroadcastHandler) CancelBroadcast() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid broadcast ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("broadcastId", id.String()))
+
+		broadcast, err := h.broadcastService.CancelBroadcast(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to cancel broadcast", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Broadcast cancelled successfully")
+		response.Success(w, http.StatusOK, broadcast)
+	}
+}
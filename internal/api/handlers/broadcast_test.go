@@ -0,0 +1,107 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBroadcast(t *testing.T) {
+	// Arrange
+	mockBroadcastService := mocks.NewMockBroadcastService(t)
+	broadcastHandler := handlers.NewBroadcastHandler(mockBroadcastService)
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateBroadcastRequest{
+			Subject:  "Hi {{.Name}}",
+			Content:  "Members get 20% off this week",
+			Audience: models.BroadcastAudience{Type: models.BroadcastAudienceTag, Tag: "vip"},
+		}
+		mockBroadcastService.On("CreateBroadcast", mock.Anything, adminID, &reqBody).
+			Return(&models.Broadcast{ID: uuid.New(), Status: models.BroadcastStatusPending, TotalRecipients: 12}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/broadcast", bytes.NewBuffer(reqBodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		broadcastHandler.CreateBroadcast().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("Failure - Tag audience without tag", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateBroadcastRequest{
+			Subject:  "Hi",
+			Content:  "Sale",
+			Audience: models.BroadcastAudience{Type: models.BroadcastAudienceTag},
+		}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/broadcast", bytes.NewBuffer(reqBodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		broadcastHandler.CreateBroadcast().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCancelBroadcast(t *testing.T) {
+	// Arrange
+	mockBroadcastService := mocks.NewMockBroadcastService(t)
+	broadcastHandler := handlers.NewBroadcastHandler(mockBroadcastService)
+	broadcastID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockBroadcastService.On("CancelBroadcast", mock.Anything, broadcastID).
+			Return(&models.Broadcast{ID: broadcastID, Status: models.BroadcastStatusCancelled}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/broadcasts/"+broadcastID.String()+"/cancel", nil, uuid.New(), map[string]string{"id": broadcastID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		broadcastHandler.CancelBroadcast().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Already finished", func(t *testing.T) {
+		// Arrange
+		mockBroadcastService.On("CancelBroadcast", mock.Anything, broadcastID).
+			Return(nil, appErrors.BadRequestError("Broadcast has already finished")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/broadcasts/"+broadcastID.String()+"/cancel", nil, uuid.New(), map[string]string{"id": broadcastID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		broadcastHandler.CancelBroadcast().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
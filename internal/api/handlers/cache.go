@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type CacheHandler struct {
+	cacheAdminService service.CacheAdminService
+}
+
+func NewCacheHandler(cacheAdminService service.CacheAdminService) *CacheHandler {
+	return &CacheHandler{cacheAdminService: cacheAdminService}
+}
+
+// InvalidateNamespace godoc
+//
+//	@Summary		Invalidate a cache namespace
+//	@Description	Drops every cached key of the namespace at once by bumping its version, e.g. product_facets after a deploy changing its format. The other instances pick the new version up within a few seconds. Requires admin role.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			namespace	path		string						true	"Cache namespace, the part of the keys before the first colon"
+//	@Success		200			{object}	models.CacheInvalidation	"Namespace invalidated"
+//	@Failure		400			{object}	response.ErrorResponse		"Invalid namespace"
+//	@Failure		401			{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse		"Forbidden"
+//	@Failure		500			{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/cache/{namespace}/invalidate [post]
+func (h *CacheHandler) InvalidateNamespace() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		namespace := r.PathValue("namespace")
+		logger = logger.With(slog.String("namespace", namespace))
+
+		invalidation, err := h.cacheAdminService.InvalidateNamespace(r.Context(), namespace)
+		if err != nil {
+			logger.Error("Failed to invalidate cache namespace", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Cache namespace invalidated", slog.Int64("version", invalidation.Version))
+		response.Success(w, http.StatusOK, invalidation)
+	}
+}
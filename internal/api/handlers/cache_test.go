@@ -0,0 +1,52 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInvalidateCacheNamespace(t *testing.T) {
+	// Arrange
+	mockCacheAdminService := mocks.NewMockCacheAdminService(t)
+	cacheHandler := handlers.NewCacheHandler(mockCacheAdminService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockCacheAdminService.On("InvalidateNamespace", mock.Anything, "product_facets").
+			Return(&models.CacheInvalidation{Namespace: "product_facets", Version: 8}, nil).Once()
+
+		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/admin/cache/product_facets/invalidate", nil, map[string]string{"namespace": "product_facets"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		cacheHandler.InvalidateNamespace().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"version":8`)
+	})
+
+	t.Run("Failure - Invalid namespace", func(t *testing.T) {
+		// Arrange
+		mockCacheAdminService.On("InvalidateNamespace", mock.Anything, "Product-Facets").
+			Return(nil, appErrors.BadRequestError("Invalid cache namespace")).Once()
+
+		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/admin/cache/Product-Facets/invalidate", nil, map[string]string{"namespace": "Product-Facets"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		cacheHandler.InvalidateNamespace().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
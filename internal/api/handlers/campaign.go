@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type CampaignHandler struct {
+	campaignService service.CampaignService
+	validator       *validator.Validate
+}
+
+func NewCampaignHandler(campaignService service.CampaignService) *CampaignHandler {
+	return &CampaignHandler{campaignService: campaignService, validator: validator.New()}
+}
+
+// CreateCampaign godoc
+//
+//	@Summary		Schedule a flash sale campaign (Admin)
+//	@Description	Schedules a campaign that sells its products at the campaign prices from starts_at to ends_at, up to the units allocated to each. Prices are set when it starts and restored when it ends. A product is in one campaign at a time, vendor products cannot be in one.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			campaign	body		models.CreateCampaignRequest	true	"Campaign Details"
+//	@Success		201			{object}	models.Campaign					"Successfully scheduled campaign"
+//	@Failure		400			{object}	response.ErrorResponse			"Validation error, unknown product, price not discounted or allocation above stock"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		409			{object}	response.ErrorResponse			"Products already in a campaign over this period"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/campaigns [post]
+func (h *CampaignHandler) CreateCampaign() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.CreateCampaignRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to create campaign", slog.String("name", req.Name), slog.Int("items", len(req.Items)))
+
+		campaign, err := h.campaignService.CreateCampaign(r.Context(), &req)
+		if err != nil {
+			logger.Error("Failed to create campaign", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Campaign created successfully", slog.String("campaignId", campaign.ID.String()))
+		response.Success(w, http.StatusCreated, campaign)
+	}
+}
+
+// GetCampaign godoc
+//
+//	@Summary		Get a campaign (Admin)
+//	@Description	Retrieves a campaign with its items, and the units left of each while it is active.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Campaign ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.Campaign			"Successfully retrieved campaign"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid campaign ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Campaign not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Failure		503	{object}	response.ErrorResponse	"Campaign stock unavailable"
+//	@Security		BearerAuth
+//	@Router			/admin/campaigns/{id} [get]
+func (h *CampaignHandler) GetCampaign() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid campaign ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		campaign, err := h.campaignService.GetCampaign(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get campaign", slog.String("campaignId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, campaign)
+	}
+}
+
+// ListCampaigns godoc
+//
+//	@Summary		List campaigns (Admin)
+//	@Description	Retrieves a paginated list of the campaigns, latest start first, optionally only those in a given status.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status		query		string												false	"Campaign status"									Enums(scheduled, active, ended, cancelled)
+//	@Param			page		query		int													false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int													false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.Campaign}	"Successfully retrieved campaigns"
+//	@Failure		400			{object}	response.ErrorResponse								"Invalid status"
+//	@Failure		401			{object}	response.ErrorResponse								"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse								"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse								"Internal server error"
+//	@Failure		503			{object}	response.ErrorResponse								"Campaign stock unavailable"
+//	@Security		BearerAuth
+//	@Router			/admin/campaigns [get]
+func (h *CampaignHandler) ListCampaigns() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := models.CampaignStatus(r.URL.Query().Get("status"))
+
+		switch status {
+		case "", models.CampaignStatusScheduled, models.CampaignStatusActive, models.CampaignStatusEnded, models.CampaignStatusCancelled:
+		default:
+			response.Error(w, errors.BadRequestError("Invalid campaign status"))
+
+			return
+		}
+
+		h.listCampaigns(w, r, status)
+	}
+}
+
+// ListActiveCampaigns godoc
+//
+//	@Summary		List the running flash sales
+//	@Description	Retrieves a paginated list of the active campaigns, with their campaign prices and the units left of each product.
+//	@Tags			Products
+//	@Produce		json
+//	@Param			page		query		int													false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int													false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.Campaign}	"Successfully retrieved campaigns"
+//	@Failure		401			{object}	response.ErrorResponse								"Authentication required"
+//	@Failure		500			{object}	response.ErrorResponse								"Internal server error"
+//	@Failure		503			{object}	response.ErrorResponse								"Campaign stock unavailable"
+//	@Security		BearerAuth
+//	@Router			/campaigns [get]
+func (h *CampaignHandler) ListActiveCampaigns() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.listCampaigns(w, r, models.CampaignStatusActive)
+	}
+}
+
+func (h *CampaignHandler) listCampaigns(w http.ResponseWriter, r *http.Request, status models.CampaignStatus) {
+	logger := middleware.LoggerFromContext(r.Context())
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if err != nil || pageSize < 1 || pageSize > 50 {
+		pageSize = 20
+	}
+
+	campaigns, total, err := h.campaignService.ListCampaigns(r.Context(), status, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to list campaigns", slog.String("status", string(status)), slog.String("error", err.Error()))
+		response.Error(w, err)
+
+		return
+	}
+
+	response.Success(w, http.StatusOK, models.PaginatedResponse{
+		Data:     campaigns,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// CancelCampaign godoc
+//
+//	@Summary		Cancel a campaign (Admin)
+//	@Description	Calls off a scheduled campaign, or ends a running one early and restores the prices of its products.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Campaign ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.Campaign			"Successfully cancelled campaign"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid campaign ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Campaign not found"
+//	@Failure		409	{object}	response.ErrorResponse	"Campaign already ended or cancelled"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Failure		503	{object}	response.ErrorResponse	"Campaign stock unavailable"
+//	@Security		BearerAuth
+//	@Router			/admin/campaigns/{id}/cancel [post]
+func (h *CampaignHandler) CancelCampaign() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid campaign ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("campaignId", id.String()))
+
+		campaign, err := h.campaignService.CancelCampaign(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to cancel campaign", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Campaign cancelled successfully")
+		response.Success(w, http.StatusOK, campaign)
+	}
+}
@@ -0,0 +1,154 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCampaign(t *testing.T) {
+	// Arrange
+	mockCampaignService := mocks.NewMockCampaignService(t)
+	campaignHandler := handlers.NewCampaignHandler(mockCampaignService)
+	startsAt := time.Now().Add(time.Hour).UTC()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateCampaignRequest{
+			Name:     "Weekend flash sale",
+			StartsAt: startsAt,
+			EndsAt:   startsAt.Add(48 * time.Hour),
+			Items:    []models.CreateCampaignItemRequest{{ProductID: uuid.New(), CampaignPrice: 9.99, Allocation: 100}},
+		}
+		mockCampaignService.On("CreateCampaign", mock.Anything, mock.AnythingOfType("*models.CreateCampaignRequest")).
+			Return(&models.Campaign{ID: uuid.New(), Status: models.CampaignStatusScheduled}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/campaigns", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.CreateCampaign().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Ends before it starts", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateCampaignRequest{
+			Name:     "Weekend flash sale",
+			StartsAt: startsAt,
+			EndsAt:   startsAt.Add(-time.Minute),
+			Items:    []models.CreateCampaignItemRequest{{ProductID: uuid.New(), CampaignPrice: 9.99, Allocation: 100}},
+		}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/campaigns", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.CreateCampaign().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestListCampaigns(t *testing.T) {
+	// Arrange
+	mockCampaignService := mocks.NewMockCampaignService(t)
+	campaignHandler := handlers.NewCampaignHandler(mockCampaignService)
+
+	t.Run("Success - Filtered by status", func(t *testing.T) {
+		// Arrange
+		mockCampaignService.On("ListCampaigns", mock.Anything, models.CampaignStatusScheduled, 2, 5).Return([]*models.Campaign{}, 6, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/campaigns?status=scheduled&page=2&pageSize=5", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.ListCampaigns().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Unknown status", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/campaigns?status=paused", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.ListCampaigns().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Success - Customers see the active campaigns", func(t *testing.T) {
+		// Arrange
+		mockCampaignService.On("ListCampaigns", mock.Anything, models.CampaignStatusActive, 1, 20).Return([]*models.Campaign{}, 0, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/campaigns?status=cancelled", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.ListActiveCampaigns().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestCancelCampaign(t *testing.T) {
+	// Arrange
+	mockCampaignService := mocks.NewMockCampaignService(t)
+	campaignHandler := handlers.NewCampaignHandler(mockCampaignService)
+	campaignID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockCampaignService.On("CancelCampaign", mock.Anything, campaignID).Return(&models.Campaign{ID: campaignID, Status: models.CampaignStatusCancelled}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/campaigns/"+campaignID.String()+"/cancel", nil, uuid.New(), map[string]string{"id": campaignID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.CancelCampaign().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Already ended", func(t *testing.T) {
+		// Arrange
+		mockCampaignService.On("CancelCampaign", mock.Anything, campaignID).Return(nil, appErrors.InvalidTransitionError("Campaign already ended")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/campaigns/"+campaignID.String()+"/cancel", nil, uuid.New(), map[string]string{"id": campaignID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		campaignHandler.CancelCampaign().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
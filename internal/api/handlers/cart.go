@@ -76,6 +76,8 @@ func (h *CartHandler) GetCart() http.HandlerFunc {
 //	@Failure		400		{object}	response.ErrorResponse	"Validation error or invalid product ID/quantity"
 //	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
 //	@Failure		404		{object}	response.ErrorResponse	"Product not found"
+//	@Failure		409		{object}	response.ErrorResponse	"PRODUCT_NOT_FOR_SALE, the product is not active, or PURCHASE_LIMIT_EXCEEDED, the meta field holds the limit and the units already purchased"
+//	@Failure		429		{object}	response.ErrorResponse	"IN_WAITING_ROOM during a high-demand drop, the meta field holds the place in line and the ticket to send back in the X-Waiting-Room-Token header"
 //	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/carts/items [post]
@@ -152,6 +154,8 @@ func (h *CartHandler) AddItem() http.HandlerFunc {
 //	@Failure		400		{object}	response.ErrorResponse			"Validation error or invalid product ID/quantity"
 //	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
 //	@Failure		404		{object}	response.ErrorResponse			"Cart or item not found"
+//	@Failure		409		{object}	response.ErrorResponse			"PURCHASE_LIMIT_EXCEEDED, the meta field holds the limit and the units already purchased"
+//	@Failure		429		{object}	response.ErrorResponse			"IN_WAITING_ROOM during a high-demand drop, the meta field holds the place in line and the ticket to send back in the X-Waiting-Room-Token header"
 //	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/carts/items [put]
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type CatalogImportHandler struct {
+	catalogImportService service.CatalogImportService
+	validator            *validator.Validate
+}
+
+func NewCatalogImportHandler(catalogImportService service.CatalogImportService) *CatalogImportHandler {
+	return &CatalogImportHandler{catalogImportService: catalogImportService, validator: validator.New()}
+}
+
+// CreateImport godoc
+//
+//	@Summary		Import the catalog of a store (Admin)
+//	@Description	Imports the products, variants and images of the configured Shopify or WooCommerce store in the background. Every variant becomes a product, found again on later imports by its SKU. A dry run only reports what the import would create or change.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			import	body		models.CreateCatalogImportRequest	true	"Import Details"
+//	@Success		202		{object}	models.CatalogImport				"Import accepted"
+//	@Failure		400		{object}	response.ErrorResponse				"Validation error or source not configured"
+//	@Failure		401		{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/catalog/imports [post]
+func (h *CatalogImportHandler) CreateImport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized catalog import attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.CreateCatalogImportRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to create catalog import", slog.String("source", string(req.Source)), slog.Bool("dryRun", req.DryRun))
+
+		catalogImport, err := h.catalogImportService.CreateImport(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to create catalog import", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Catalog import created successfully", slog.String("importId", catalogImport.ID.String()))
+		response.Success(w, http.StatusAccepted, catalogImport)
+	}
+}
+
+// GetImport godoc
+//
+//	@Summary		Get a catalog import (Admin)
+//	@Description	Retrieves a catalog import with its status and the number of products created, updated, unchanged, skipped and failed.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Import ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.CatalogImport	"Successfully retrieved catalog import"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid import ID"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Catalog import not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/catalog/imports/{id} [get]
+func (h *CatalogImportHandler) GetImport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid catalog import ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("importId", id.String()))
+
+		catalogImport, err := h.catalogImportService.GetImport(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to fetch catalog import", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Catalog import retrieved successfully")
+		response.Success(w, http.StatusOK, catalogImport)
+	}
+}
+
+// ListImportItems godoc
+//
+//	@Summary		Get the diff report of a catalog import (Admin)
+//	@Description	Lists what the import did, or would do in a dry run, with every variant of the source, with the changed fields of updated products.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id			path		string														true	"Import ID (UUID)"				Format(uuid)
+//	@Param			action		query		string														false	"Only items with this action"	Enums(create, update, unchanged, skip, fail)
+//	@Param			page		query		int															false	"Page number"					default(1)
+//	@Param			pageSize	query		int															false	"Items per page"				default(10)
+//	@Success		200			{object}	models.PaginatedResponse{data=[]models.CatalogImportItem}	"Successfully retrieved import report"
+//	@Failure		400			{object}	response.ErrorResponse										"Invalid import ID or action"
+//	@Failure		401			{object}	response.ErrorResponse										"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse										"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse										"Catalog import not found"
+//	@Failure		500			{object}	response.ErrorResponse										"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/catalog/imports/{id}/items [get]
+func (h *CatalogImportHandler) ListImportItems() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid catalog import ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("importId", id.String()))
+
+		action := models.CatalogImportAction(r.URL.Query().Get("action"))
+
+		switch action {
+		case "", models.CatalogImportActionCreate, models.CatalogImportActionUpdate, models.CatalogImportActionUnchanged,
+			models.CatalogImportActionSkip, models.CatalogImportActionFail:
+		default:
+			logger.Warn("Invalid catalog import action", slog.String("action", string(action)))
+			response.Error(w, errors.BadRequestError("Invalid action"))
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		items, total, err := h.catalogImportService.ListImportItems(r.Context(), id, action, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list catalog import items", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Catalog import items listed successfully", slog.Int("count", len(items)), slog.Int("total", total))
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     items,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
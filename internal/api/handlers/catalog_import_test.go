@@ -0,0 +1,119 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateImport(t *testing.T) {
+	// Arrange
+	mockCatalogImportService := mocks.NewMockCatalogImportService(t)
+	catalogImportHandler := handlers.NewCatalogImportHandler(mockCatalogImportService)
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateCatalogImportRequest{
+			Source:  models.CatalogImportSourceShopify,
+			DryRun:  true,
+			Mapping: models.CatalogImportMapping{DefaultCategoryID: uuid.New(), SKUPrefix: "SH-"},
+		}
+		mockCatalogImportService.On("CreateImport", mock.Anything, adminID, &reqBody).
+			Return(&models.CatalogImport{ID: uuid.New(), Source: reqBody.Source, DryRun: true, Status: models.CatalogImportStatusPending}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/catalog/imports", bytes.NewBuffer(reqBodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		catalogImportHandler.CreateImport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("Failure - Unknown source", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateCatalogImportRequest{
+			Source:  "magento",
+			Mapping: models.CatalogImportMapping{DefaultCategoryID: uuid.New()},
+		}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/catalog/imports", bytes.NewBuffer(reqBodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		catalogImportHandler.CreateImport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestListImportItems(t *testing.T) {
+	// Arrange
+	mockCatalogImportService := mocks.NewMockCatalogImportService(t)
+	catalogImportHandler := handlers.NewCatalogImportHandler(mockCatalogImportService)
+	importID := uuid.New()
+
+	t.Run("Success - Filtered by action", func(t *testing.T) {
+		// Arrange
+		items := []*models.CatalogImportItem{{ID: uuid.New(), ImportID: importID, SKU: "SH-MUG", Action: models.CatalogImportActionUpdate}}
+		mockCatalogImportService.On("ListImportItems", mock.Anything, importID, models.CatalogImportActionUpdate, 2, 20).
+			Return(items, 21, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/catalog/imports/"+importID.String()+"/items?action=update&page=2&pageSize=20", nil, uuid.New(), map[string]string{"id": importID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		catalogImportHandler.ListImportItems().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid action", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/catalog/imports/"+importID.String()+"/items?action=delete", nil, uuid.New(), map[string]string{"id": importID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		catalogImportHandler.ListImportItems().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Import not found", func(t *testing.T) {
+		// Arrange
+		mockCatalogImportService.On("ListImportItems", mock.Anything, importID, models.CatalogImportAction(""), 1, 10).
+			Return(nil, 0, appErrors.NotFoundError("Catalog import not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/catalog/imports/"+importID.String()+"/items", nil, uuid.New(), map[string]string{"id": importID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		catalogImportHandler.ListImportItems().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
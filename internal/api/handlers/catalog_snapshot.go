@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type CatalogSnapshotHandler struct {
+	snapshotService service.CatalogSnapshotService
+	maxAge          time.Duration
+}
+
+// NewCatalogSnapshotHandler lets shared caches keep a snapshot for maxAge before revalidating it.
+func NewCatalogSnapshotHandler(snapshotService service.CatalogSnapshotService, maxAge time.Duration) *CatalogSnapshotHandler {
+	return &CatalogSnapshotHandler{snapshotService: snapshotService, maxAge: maxAge}
+}
+
+// GetSnapshot godoc
+//
+//	@Summary		Get a catalog snapshot
+//	@Description	Dumps the categories and the products for sale in a compact JSON document for CDN and edge workers. The snapshot is versioned by the product change feed: passing the version held as since returns a delta with the products changed since and the ones taken off sale (deleted), a full snapshot is returned instead when full is true. Responses carry an ETag and answer 304 to a matching If-None-Match. The document is served as is, not wrapped in the API response, and full snapshots are streamed.
+//	@Tags			Products
+//	@Produce		json
+//	@Param			since			query		string					false	"Version of the snapshot held, omitted for a full snapshot"
+//	@Param			If-None-Match	header		string					false	"ETag of the snapshot held"
+//	@Success		200				{object}	models.CatalogSnapshot	"Catalog snapshot"
+//	@Success		304				{string}	string					"Snapshot not modified"
+//	@Failure		400				{object}	response.ErrorResponse	"Invalid version"
+//	@Failure		401				{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		500				{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/catalog/snapshot [get]
+func (h *CatalogSnapshotHandler) GetSnapshot() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		since := r.URL.Query().Get("since")
+
+		snapshot, err := h.snapshotService.GetSnapshot(r.Context(), since)
+		if err != nil {
+			logger.Error("Failed to get catalog snapshot", slog.String("since", since), slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("version", snapshot.Version), slog.Bool("full", snapshot.Full))
+
+		etag := snapshot.ETag()
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.maxAge.Seconds())))
+
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		writer := newSnapshotWriter(w, snapshot)
+
+		if snapshot.Full {
+			err = h.snapshotService.StreamProducts(r.Context(), writer.Write)
+		} else {
+			for i := range snapshot.Products {
+				if err = writer.Write(&snapshot.Products[i]); err != nil {
+					break
+				}
+			}
+		}
+
+		if err != nil && !writer.Started() {
+			logger.Error("Failed to stream catalog snapshot", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		if err != nil {
+			// The response is already under way, all that is left is to cut it short
+			logger.Error("Catalog snapshot aborted", slog.Any("error", err), slog.Int("products", writer.Products()))
+
+			return
+		}
+
+		if err := writer.Close(); err != nil {
+			logger.Error("Failed to write catalog snapshot", slog.Any("error", err))
+
+			return
+		}
+
+		logger.Info("Catalog snapshot served", slog.Int("products", writer.Products()), slog.Int("deleted", len(snapshot.Deleted)))
+	}
+}
+
+// etagMatches reports whether the If-None-Match header holds the tag, compared weakly as RFC 9110 asks.
+func etagMatches(header, etag string) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// snapshotWriter streams a snapshot as one JSON document, its products written one at a time. Like
+// csvExport, nothing is sent before the first product, so a snapshot failing upfront can still answer
+// with a JSON error.
+type snapshotWriter struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	encoder    *json.Encoder
+	snapshot   *models.CatalogSnapshot
+	started    bool
+	products   int
+}
+
+func newSnapshotWriter(w http.ResponseWriter, snapshot *models.CatalogSnapshot) *snapshotWriter {
+	controller := http.NewResponseController(w)
+	_ = controller.SetWriteDeadline(time.Now().Add(exportWriteTimeout)) // not supported by every writer, the server timeout applies then
+
+	return &snapshotWriter{w: w, controller: controller, encoder: json.NewEncoder(w), snapshot: snapshot}
+}
+
+// start writes the fields of the snapshot preceding the products, in the order of CatalogSnapshot.
+func (s *snapshotWriter) start() error {
+	s.started = true
+
+	s.w.Header().Set("Content-Type", "application/json")
+	s.w.WriteHeader(http.StatusOK)
+
+	head := struct {
+		Version    string                    `json:"version"`
+		Since      string                    `json:"since,omitempty"`
+		Full       bool                      `json:"full"`
+		Categories []models.SnapshotCategory `json:"categories"`
+	}{s.snapshot.Version, s.snapshot.Since, s.snapshot.Full, s.snapshot.Categories}
+
+	encoded, err := json.Marshal(head)
+	if err != nil {
+		return err
+	}
+
+	// the object is left open for the products to follow
+	_, err = fmt.Fprintf(s.w, `%s,"products":[`, encoded[:len(encoded)-1])
+
+	return err
+}
+
+func (s *snapshotWriter) Write(product *models.SnapshotProduct) error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	if s.products > 0 {
+		if _, err := s.w.Write([]byte(",")); err != nil {
+			return err
+		}
+	}
+
+	if err := s.encoder.Encode(product); err != nil {
+		return err
+	}
+
+	s.products++
+
+	if s.products%exportFlushEvery != 0 {
+		return nil
+	}
+
+	_ = s.controller.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+
+	return s.controller.Flush()
+}
+
+// Started reports whether the response is under way, errors can no longer be sent as JSON then.
+func (s *snapshotWriter) Started() bool {
+	return s.started
+}
+
+func (s *snapshotWriter) Products() int {
+	return s.products
+}
+
+// Close ends the document with the deleted products, a snapshot without products is started here.
+func (s *snapshotWriter) Close() error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := json.Marshal(s.snapshot.Deleted)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(s.w, `],"deleted":%s}`, deleted)
+
+	return err
+}
@@ -0,0 +1,137 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCatalogSnapshot(t *testing.T) {
+	// Arrange
+	mockSnapshotService := mocks.NewMockCatalogSnapshotService(t)
+	snapshotHandler := handlers.NewCatalogSnapshotHandler(mockSnapshotService, time.Minute)
+	categories := []models.SnapshotCategory{{ID: uuid.New(), Name: "Shoes"}}
+
+	t.Run("Success - Full snapshot streamed", func(t *testing.T) {
+		// Arrange
+		products := []models.SnapshotProduct{{ID: uuid.New(), Name: "Sneaker", Price: 89, Version: 3}, {ID: uuid.New(), Name: "Boot", Price: 120, Version: 1}}
+		mockSnapshotService.On("GetSnapshot", mock.Anything, "").
+			Return(&models.CatalogSnapshot{Version: "57", Full: true, Categories: categories, Products: []models.SnapshotProduct{}, Deleted: []uuid.UUID{}}, nil).Once()
+		mockSnapshotService.On("StreamProducts", mock.Anything, mock.Anything).
+			Return(func(_ context.Context, fn func(*models.SnapshotProduct) error) error {
+				for i := range products {
+					if err := fn(&products[i]); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/catalog/snapshot", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		snapshotHandler.GetSnapshot().ServeHTTP(rr, req)
+
+		// Assert
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.NotEmpty(t, rr.Header().Get("ETag"))
+		assert.Equal(t, "public, max-age=60", rr.Header().Get("Cache-Control"))
+
+		var snapshot models.CatalogSnapshot
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &snapshot))
+		assert.Equal(t, "57", snapshot.Version)
+		assert.True(t, snapshot.Full)
+		assert.Equal(t, categories, snapshot.Categories)
+		require.Len(t, snapshot.Products, 2)
+		assert.Equal(t, products[1].ID, snapshot.Products[1].ID)
+		assert.Empty(t, snapshot.Deleted)
+	})
+
+	t.Run("Success - Delta without changes", func(t *testing.T) {
+		// Arrange
+		deleted := uuid.New()
+		mockSnapshotService.On("GetSnapshot", mock.Anything, "41").
+			Return(&models.CatalogSnapshot{Version: "57", Since: "41", Categories: categories, Products: []models.SnapshotProduct{}, Deleted: []uuid.UUID{deleted}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/catalog/snapshot?since=41", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		snapshotHandler.GetSnapshot().ServeHTTP(rr, req)
+
+		// Assert
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var snapshot models.CatalogSnapshot
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &snapshot))
+		assert.False(t, snapshot.Full)
+		assert.Equal(t, "41", snapshot.Since)
+		assert.Empty(t, snapshot.Products)
+		assert.Equal(t, []uuid.UUID{deleted}, snapshot.Deleted)
+	})
+
+	t.Run("Success - Not modified", func(t *testing.T) {
+		// Arrange
+		snapshot := &models.CatalogSnapshot{Version: "57", Full: true, Categories: categories}
+		mockSnapshotService.On("GetSnapshot", mock.Anything, "").Return(snapshot, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/catalog/snapshot", nil, uuid.New(), nil)
+		req.Header.Set("If-None-Match", `"stale", W/`+snapshot.ETag())
+		rr := httptest.NewRecorder()
+
+		// Act
+		snapshotHandler.GetSnapshot().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Empty(t, rr.Body.String())
+	})
+
+	t.Run("Failure - Invalid version", func(t *testing.T) {
+		// Arrange
+		mockSnapshotService.On("GetSnapshot", mock.Anything, "latest").
+			Return(nil, appErrors.BadRequestError("Invalid catalog snapshot version")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/catalog/snapshot?since=latest", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		snapshotHandler.GetSnapshot().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Stream fails before the first product", func(t *testing.T) {
+		// Arrange
+		mockSnapshotService.On("GetSnapshot", mock.Anything, "").
+			Return(&models.CatalogSnapshot{Version: "57", Full: true, Categories: categories}, nil).Once()
+		mockSnapshotService.On("StreamProducts", mock.Anything, mock.Anything).
+			Return(appErrors.DatabaseError("Failed to stream catalog snapshot")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/catalog/snapshot", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		snapshotHandler.GetSnapshot().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Contains(t, rr.Body.String(), appErrors.ErrCodeDatabaseError)
+	})
+}
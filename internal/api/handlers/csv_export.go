@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	exportFlushEvery   = 500              // CSV rows written between two flushes to the client
+	exportWriteTimeout = 30 * time.Second // pushed back on every flush, exports outlive the server write timeout
+)
+
+// csvExport streams CSV records to the client. The response headers and the CSV header are only
+// sent with the first record, so that an export failing upfront can still answer with a JSON error.
+type csvExport struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	writer     *csv.Writer
+	filename   string
+	header     []string
+	started    bool
+	rows       int
+}
+
+func newCSVExport(w http.ResponseWriter, filename string, header []string) *csvExport {
+	controller := http.NewResponseController(w)
+	_ = controller.SetWriteDeadline(time.Now().Add(exportWriteTimeout)) // not supported by every writer, the server timeout applies then
+
+	return &csvExport{
+		w:          w,
+		controller: controller,
+		writer:     csv.NewWriter(w),
+		filename:   filename,
+		header:     header,
+	}
+}
+
+func (e *csvExport) start() error {
+	e.started = true
+
+	e.w.Header().Set("Content-Type", "text/csv")
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename=%q`, e.filename))
+
+	return e.writer.Write(e.header)
+}
+
+func (e *csvExport) Write(record []string) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	if err := e.writer.Write(record); err != nil {
+		return err
+	}
+
+	e.rows++
+
+	if e.rows%exportFlushEvery != 0 {
+		return nil
+	}
+
+	e.writer.Flush()
+
+	if err := e.writer.Error(); err != nil {
+		return err
+	}
+
+	_ = e.controller.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+
+	return e.controller.Flush()
+}
+
+// Started reports whether the response is under way, errors can no longer be sent as JSON then.
+func (e *csvExport) Started() bool {
+	return e.started
+}
+
+func (e *csvExport) Rows() int {
+	return e.rows
+}
+
+// Close writes the CSV header of an empty export and flushes the pending records.
+func (e *csvExport) Close() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+func formatOptionalAmount(amount *int64) string {
+	if amount == nil {
+		return ""
+	}
+
+	return strconv.FormatInt(*amount, 10)
+}
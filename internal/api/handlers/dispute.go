@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type DisputeHandler struct {
+	disputeService service.DisputeService
+}
+
+func NewDisputeHandler(disputeService service.DisputeService) *DisputeHandler {
+	return &DisputeHandler{disputeService: disputeService}
+}
+
+// ListDisputes godoc
+//
+//	@Summary		List payment disputes (Admin)
+//	@Description	Retrieves a paginated list of chargebacks received from Stripe, the ones with the closest evidence deadline first.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			page		query		int												false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int												false	"Number of items per page (default: 10, max: 100)"	minimum(1)	maximum(100)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.Dispute}	"Successfully retrieved list of disputes"
+//	@Failure		401			{object}	response.ErrorResponse							"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse							"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse							"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/disputes [get]
+func (h *DisputeHandler) ListDisputes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		logger = logger.With(slog.Int("page", page), slog.Int("pageSize", pageSize))
+
+		disputes, total, err := h.disputeService.ListDisputes(r.Context(), page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list disputes", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Disputes listed successfully", slog.Int("count", len(disputes)), slog.Int("total", total))
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     disputes,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDisputes(t *testing.T) {
+	// Arrange
+	mockDisputeService := mocks.NewMockDisputeService(t)
+	disputeHandler := handlers.NewDisputeHandler(mockDisputeService)
+
+	t.Run("Success - Default pagination", func(t *testing.T) {
+		// Arrange
+		disputes := []*models.Dispute{{ID: "dp_1", PaymentID: "pi_1", Status: "needs_response"}}
+		mockDisputeService.On("ListDisputes", mock.Anything, 1, 10).Return(disputes, 1, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/disputes", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		disputeHandler.ListDisputes().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp *response.APIResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+
+		data, ok := resp.Data.(map[string]any)
+		require.True(t, ok)
+		assert.InDelta(t, 1, data["total"], 0)
+	})
+
+	t.Run("Success - Custom pagination", func(t *testing.T) {
+		// Arrange
+		mockDisputeService.On("ListDisputes", mock.Anything, 2, 5).Return([]*models.Dispute{}, 6, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/disputes?page=2&pageSize=5", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		disputeHandler.ListDisputes().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Service Error", func(t *testing.T) {
+		// Arrange
+		mockDisputeService.On("ListDisputes", mock.Anything, 1, 10).Return(nil, 0, appErrors.DatabaseError("Failed to fetch disputes")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/disputes", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		disputeHandler.ListDisputes().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
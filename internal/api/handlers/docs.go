@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/swaggo/swag"
+)
+
+// OpenAPISpec serves the machine-readable API spec to internal tooling, whether or not the Swagger UI
+// is served next to it.
+func OpenAPISpec() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		doc, err := swag.ReadDoc()
+		if err != nil {
+			slog.Error("Failed to read the API spec", slog.String("error", err.Error()))
+			http.Error(w, "API spec unavailable", http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write([]byte(doc))
+	}
+}
@@ -0,0 +1,30 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	_ "github.com/aaravmahajanofficial/scalable-ecommerce-platform/docs"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPISpec(t *testing.T) {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/swagger/doc.json", http.NoBody)
+	rr := httptest.NewRecorder()
+
+	// Act
+	handlers.OpenAPISpec().ServeHTTP(rr, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Header().Get("Content-Type"), "application/json")
+
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &spec))
+	assert.Contains(t, spec, "paths")
+}
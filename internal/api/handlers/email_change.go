@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type EmailChangeHandler struct {
+	emailChangeService service.EmailChangeService
+	validator          *validator.Validate
+}
+
+func NewEmailChangeHandler(emailChangeService service.EmailChangeService) *EmailChangeHandler {
+	return &EmailChangeHandler{emailChangeService: emailChangeService, validator: validator.New()}
+}
+
+// RequestEmailChange godoc
+//
+//	@Summary		Request an email change
+//	@Description	Starts changing the email of the authenticated user once the current password is confirmed. A confirmation link is sent to both the current and the new address, the email only changes once both are confirmed before the change expires, and every session is then revoked. A new request replaces the pending one. Not allowed while impersonating.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			change	body		models.EmailChangeRequest	true	"New email and current password"
+//	@Success		202		{object}	models.EmailChange			"Email change pending confirmation"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or same email"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required or current password incorrect"
+//	@Failure		403		{object}	response.ErrorResponse		"Not allowed while impersonating"
+//	@Failure		409		{object}	response.ErrorResponse		"Email already registered"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/users/me/email [post]
+func (h *EmailChangeHandler) RequestEmailChange() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized access attempt: missing user claims in context")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		var req models.EmailChangeRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		change, err := h.emailChangeService.RequestEmailChange(r.Context(), claims, &req)
+		if err != nil {
+			logger.Warn("Failed to request email change", slog.String("userId", claims.UserID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Email change requested", slog.String("userId", claims.UserID.String()), slog.String("emailChangeId", change.ID.String()))
+		response.Success(w, http.StatusAccepted, change)
+	}
+}
+
+// ConfirmEmailChange godoc
+//
+//	@Summary		Confirm an email change
+//	@Description	Confirms a pending email change with the token of the link sent to the current or the new address, without logging in. The change completes with the second confirmation: the email is replaced, every session of the user is revoked and both addresses are notified. Confirming a completed change returns it as is.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			confirmation	body		models.ConfirmEmailChangeRequest	true	"Confirmation token"
+//	@Success		200				{object}	models.EmailChange					"Email change, completed once completed_at is set"
+//	@Failure		400				{object}	response.ErrorResponse				"Validation error or change expired"
+//	@Failure		404				{object}	response.ErrorResponse				"Invalid token or change not found"
+//	@Failure		409				{object}	response.ErrorResponse				"New email registered meanwhile"
+//	@Failure		500				{object}	response.ErrorResponse				"Internal server error"
+//	@Router			/users/email-change/confirm [post]
+func (h *EmailChangeHandler) ConfirmEmailChange() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.ConfirmEmailChangeRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		change, err := h.emailChangeService.ConfirmEmailChange(r.Context(), req.Token)
+		if err != nil {
+			logger.Warn("Email change confirmation refused", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Email change confirmed",
+			slog.String("emailChangeId", change.ID.String()),
+			slog.Bool("completed", change.CompletedAt != nil))
+		response.Success(w, http.StatusOK, change)
+	}
+}
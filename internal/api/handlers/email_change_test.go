@@ -0,0 +1,93 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEmailChangeHandler_RequestEmailChange(t *testing.T) {
+	mockEmailChangeService := mocks.NewMockEmailChangeService(t)
+	emailChangeHandler := handlers.NewEmailChangeHandler(mockEmailChangeService)
+	userID := uuid.New()
+
+	t.Run("Success - Change pending confirmation", func(t *testing.T) {
+		// Arrange
+		mockEmailChangeService.On("RequestEmailChange", mock.Anything, mock.MatchedBy(func(c *models.Claims) bool { return c.UserID == userID }), &models.EmailChangeRequest{
+			NewEmail:        "new@example.com",
+			CurrentPassword: "password1",
+		}).Return(&models.EmailChange{ID: uuid.New(), UserID: userID, NewEmail: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+
+		body := `{"new_email":"new@example.com","current_password":"password1"}`
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/users/me/email", strings.NewReader(body), userID, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		emailChangeHandler.RequestEmailChange().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Contains(t, w.Body.String(), `"new_email":"new@example.com"`)
+	})
+
+	t.Run("Failure - Invalid email", func(t *testing.T) {
+		// Arrange
+		body := `{"new_email":"not-an-email","current_password":"password1"}`
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/users/me/email", strings.NewReader(body), userID, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		emailChangeHandler.RequestEmailChange().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestEmailChangeHandler_ConfirmEmailChange(t *testing.T) {
+	mockEmailChangeService := mocks.NewMockEmailChangeService(t)
+	emailChangeHandler := handlers.NewEmailChangeHandler(mockEmailChangeService)
+
+	t.Run("Success - Without logging in", func(t *testing.T) {
+		// Arrange
+		completedAt := time.Now()
+		mockEmailChangeService.On("ConfirmEmailChange", mock.Anything, "tok_valid").
+			Return(&models.EmailChange{ID: uuid.New(), CompletedAt: &completedAt}, nil).Once()
+
+		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/users/email-change/confirm", strings.NewReader(`{"token":"tok_valid"}`), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		emailChangeHandler.ConfirmEmailChange().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"completed_at"`)
+	})
+
+	t.Run("Failure - Invalid token", func(t *testing.T) {
+		// Arrange
+		mockEmailChangeService.On("ConfirmEmailChange", mock.Anything, "tok_forged").
+			Return(nil, appErrors.NotFoundError("Email change not found")).Once()
+
+		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/users/email-change/confirm", strings.NewReader(`{"token":"tok_forged"}`), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		emailChangeHandler.ConfirmEmailChange().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type FraudHandler struct {
+	fraudService service.FraudService
+	validator    *validator.Validate
+}
+
+func NewFraudHandler(fraudService service.FraudService) *FraudHandler {
+	return &FraudHandler{fraudService: fraudService, validator: validator.New()}
+}
+
+// ListReviews godoc
+//
+//	@Summary		List fraud reviews (Admin)
+//	@Description	Retrieves a paginated list of the orders flagged by the velocity rules at checkout, oldest first, optionally only those in a given status. A pending review holds the fulfillment of its order.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status		query		string												false	"Review status"										Enums(pending, approved, rejected)
+//	@Param			page		query		int													false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int													false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.FraudReview}	"Successfully retrieved fraud reviews"
+//	@Failure		400			{object}	response.ErrorResponse								"Invalid status"
+//	@Failure		401			{object}	response.ErrorResponse								"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse								"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse								"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fraud-reviews [get]
+func (h *FraudHandler) ListReviews() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		status := models.FraudReviewStatus(r.URL.Query().Get("status"))
+
+		switch status {
+		case "", models.FraudReviewStatusPending, models.FraudReviewStatusApproved, models.FraudReviewStatusRejected:
+		default:
+			response.Error(w, errors.BadRequestError("Invalid fraud review status"))
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 50 {
+			pageSize = 20
+		}
+
+		reviews, total, err := h.fraudService.ListReviews(r.Context(), status, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list fraud reviews", slog.String("status", string(status)), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     reviews,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// GetReview godoc
+//
+//	@Summary		Get the fraud review of an order (Admin)
+//	@Description	Retrieves the review of an order flagged at checkout, with the velocity rules it tripped.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.FraudReview		"Successfully retrieved fraud review"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid order ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Fraud review not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fraud-reviews/{id} [get]
+func (h *FraudHandler) GetReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		review, err := h.fraudService.GetReview(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get fraud review", slog.String("orderId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, review)
+	}
+}
+
+// ResolveReview godoc
+//
+//	@Summary		Resolve the fraud review of an order (Admin)
+//	@Description	Approves a pending review, releasing the order for fulfillment, or rejects it, cancelling the order unless it already left the warehouse.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string								true	"Order ID (UUID)"	Format(uuid)
+//	@Param			decision	body		models.ResolveFraudReviewRequest	true	"Review decision"
+//	@Success		200			{object}	models.FraudReview					"Successfully resolved fraud review"
+//	@Failure		400			{object}	response.ErrorResponse				"Invalid order ID format or validation error"
+//	@Failure		401			{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse				"Fraud review or order not found"
+//	@Failure		409			{object}	response.ErrorResponse				"Fraud review already resolved"
+//	@Failure		500			{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fraud-reviews/{id}/resolve [post]
+func (h *FraudHandler) ResolveReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized fraud review attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.ResolveFraudReviewRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		review, err := h.fraudService.ResolveReview(r.Context(), id, claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to resolve fraud review", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Fraud review resolved", slog.String("status", string(review.Status)))
+		response.Success(w, http.StatusOK, review)
+	}
+}
+
+// ListLinkedAccounts godoc
+//
+//	@Summary		List the accounts linked by a device (Admin)
+//	@Description	Retrieves a paginated list of the accounts that placed orders from a device, the most recently seen first. The fingerprint is the one sent at checkout and shown on the fraud reviews.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			fingerprint	path		string													true	"Device fingerprint"
+//	@Param			page		query		int														false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int														false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.LinkedAccount}	"Successfully retrieved linked accounts"
+//	@Failure		400			{object}	response.ErrorResponse									"Invalid fingerprint"
+//	@Failure		401			{object}	response.ErrorResponse									"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse									"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse									"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/devices/{fingerprint}/accounts [get]
+func (h *FraudHandler) ListLinkedAccounts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		fingerprint := r.PathValue("fingerprint")
+		if fingerprint == "" || len(fingerprint) > 128 {
+			response.Error(w, errors.BadRequestError("Invalid device fingerprint"))
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 50 {
+			pageSize = 20
+		}
+
+		accounts, total, err := h.fraudService.ListLinkedAccounts(r.Context(), fingerprint, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list linked accounts", slog.String("fingerprint", fingerprint), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     accounts,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListFraudReviews(t *testing.T) {
+	// Arrange
+	mockFraudService := mocks.NewMockFraudService(t)
+	fraudHandler := handlers.NewFraudHandler(mockFraudService)
+
+	t.Run("Success - Filtered by status", func(t *testing.T) {
+		// Arrange
+		mockFraudService.On("ListReviews", mock.Anything, models.FraudReviewStatusPending, 1, 20).Return([]*models.FraudReview{}, 0, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/fraud-reviews?status=pending", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ListReviews().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Unknown status", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/fraud-reviews?status=escalated", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ListReviews().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestResolveFraudReview(t *testing.T) {
+	// Arrange
+	mockFraudService := mocks.NewMockFraudService(t)
+	fraudHandler := handlers.NewFraudHandler(mockFraudService)
+	orderID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockFraudService.On("ResolveReview", mock.Anything, orderID, adminID, &models.ResolveFraudReviewRequest{Status: models.FraudReviewStatusRejected, Note: "Reseller bot"}).
+			Return(&models.FraudReview{OrderID: orderID, Status: models.FraudReviewStatusRejected}, nil).Once()
+
+		body := bytes.NewBufferString(`{"status":"rejected","note":"Reseller bot"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fraud-reviews/"+orderID.String()+"/resolve", body, adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ResolveReview().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Pending is not a decision", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"status":"pending"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fraud-reviews/"+orderID.String()+"/resolve", body, adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ResolveReview().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Already resolved", func(t *testing.T) {
+		// Arrange
+		mockFraudService.On("ResolveReview", mock.Anything, orderID, adminID, mock.Anything).
+			Return(nil, appErrors.InvalidTransitionError("Fraud review is already approved")).Once()
+
+		body := bytes.NewBufferString(`{"status":"approved"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fraud-reviews/"+orderID.String()+"/resolve", body, adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ResolveReview().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestListLinkedAccounts(t *testing.T) {
+	// Arrange
+	mockFraudService := mocks.NewMockFraudService(t)
+	fraudHandler := handlers.NewFraudHandler(mockFraudService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		accounts := []*models.LinkedAccount{{CustomerID: uuid.New(), Email: "first@example.com", Orders: 3}}
+		mockFraudService.On("ListLinkedAccounts", mock.Anything, "fp_123", 2, 10).Return(accounts, 11, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/devices/fp_123/accounts?page=2&pageSize=10", nil, uuid.New(), map[string]string{"fingerprint": "fp_123"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ListLinkedAccounts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "first@example.com")
+	})
+
+	t.Run("Failure - Fingerprint too long", func(t *testing.T) {
+		// Arrange
+		fingerprint := strings.Repeat("f", 129)
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/devices/"+fingerprint+"/accounts", nil, uuid.New(), map[string]string{"fingerprint": fingerprint})
+		rr := httptest.NewRecorder()
+
+		// Act
+		fraudHandler.ListLinkedAccounts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type FulfillmentWaveHandler struct {
+	waveService service.FulfillmentWaveService
+	validator   *validator.Validate
+}
+
+func NewFulfillmentWaveHandler(waveService service.FulfillmentWaveService) *FulfillmentWaveHandler {
+	return &FulfillmentWaveHandler{waveService: waveService, validator: validator.New()}
+}
+
+// CreateWave godoc
+//
+//	@Summary		Create a fulfillment wave (Admin)
+//	@Description	Groups the oldest paid orders not shipped yet whose stock is all allocated in the warehouse, of one fulfillment type (default: shipping), into a wave picked together. Orders held by a fraud review or already in an open wave are left out.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			wave	body		models.CreateFulfillmentWaveRequest	true	"Wave details"
+//	@Success		201		{object}	models.FulfillmentWave				"Fulfillment wave created"
+//	@Failure		400		{object}	response.ErrorResponse				"Validation error or no order ready to pick"
+//	@Failure		401		{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse				"Warehouse not found"
+//	@Failure		500		{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fulfillment-waves [post]
+func (h *FulfillmentWaveHandler) CreateWave() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized fulfillment wave attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.CreateFulfillmentWaveRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		wave, err := h.waveService.CreateWave(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to create fulfillment wave", slog.String("warehouseId", req.WarehouseID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Fulfillment wave created", slog.String("waveId", wave.ID.String()), slog.Int("orders", len(wave.OrderIDs)))
+		response.Success(w, http.StatusCreated, wave)
+	}
+}
+
+// ListWaves godoc
+//
+//	@Summary		List fulfillment waves (Admin)
+//	@Description	Retrieves a paginated list of fulfillment waves, the most recent first, optionally only those in a given status.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status		query		string													false	"Wave status"										Enums(open, completed)
+//	@Param			page		query		int														false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int														false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.FulfillmentWave}	"Successfully retrieved fulfillment waves"
+//	@Failure		400			{object}	response.ErrorResponse									"Invalid status"
+//	@Failure		401			{object}	response.ErrorResponse									"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse									"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse									"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fulfillment-waves [get]
+func (h *FulfillmentWaveHandler) ListWaves() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		status := models.FulfillmentWaveStatus(r.URL.Query().Get("status"))
+
+		switch status {
+		case "", models.FulfillmentWaveOpen, models.FulfillmentWaveCompleted:
+		default:
+			response.Error(w, errors.BadRequestError("Invalid fulfillment wave status"))
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 50 {
+			pageSize = 20
+		}
+
+		waves, total, err := h.waveService.ListWaves(r.Context(), status, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list fulfillment waves", slog.String("status", string(status)), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     waves,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// GetWave godoc
+//
+//	@Summary		Get a fulfillment wave (Admin)
+//	@Description	Retrieves a fulfillment wave with its orders, oldest first.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Wave ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.FulfillmentWave	"Successfully retrieved fulfillment wave"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid wave ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Fulfillment wave not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fulfillment-waves/{id} [get]
+func (h *FulfillmentWaveHandler) GetWave() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid wave ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		wave, err := h.waveService.GetWave(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get fulfillment wave", slog.String("waveId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, wave)
+	}
+}
+
+// GetPickList godoc
+//
+//	@Summary		Get the pick list of a fulfillment wave (Admin)
+//	@Description	Consolidates the items of the orders of the wave into one line per product, the allocated units summed and sorted by SKU. The packing slips of the orders split the picked units back into parcels.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Wave ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.PickList			"Successfully built pick list"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid wave ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Fulfillment wave not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fulfillment-waves/{id}/pick-list [get]
+func (h *FulfillmentWaveHandler) GetPickList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid wave ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		pickList, err := h.waveService.GetPickList(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to build pick list", slog.String("waveId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, pickList)
+	}
+}
+
+// CompleteWave godoc
+//
+//	@Summary		Complete a fulfillment wave (Admin)
+//	@Description	Moves the orders of the wave to shipping in bulk and closes the wave. Each order is checked like in a bulk status update, the ones that could not move are reported and free to join another wave.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string									true	"Wave ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.CompleteFulfillmentWaveResponse	"Fulfillment wave completed"
+//	@Failure		400	{object}	response.ErrorResponse					"Invalid wave ID format"
+//	@Failure		401	{object}	response.ErrorResponse					"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse					"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse					"Fulfillment wave not found"
+//	@Failure		409	{object}	response.ErrorResponse					"Fulfillment wave already completed"
+//	@Failure		500	{object}	response.ErrorResponse					"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/fulfillment-waves/{id}/complete [post]
+func (h *FulfillmentWaveHandler) CompleteWave() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid wave ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("waveId", id.String()))
+
+		result, err := h.waveService.CompleteWave(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to complete fulfillment wave", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Fulfillment wave completed", slog.Int("succeeded", result.Results.Succeeded), slog.Int("failed", result.Results.Failed))
+		response.Success(w, http.StatusOK, result)
+	}
+}
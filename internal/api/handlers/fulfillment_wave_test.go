@@ -0,0 +1,177 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateFulfillmentWave(t *testing.T) {
+	// Arrange
+	mockWaveService := mocks.NewMockFulfillmentWaveService(t)
+	waveHandler := handlers.NewFulfillmentWaveHandler(mockWaveService)
+	warehouseID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("CreateWave", mock.Anything, adminID, &models.CreateFulfillmentWaveRequest{WarehouseID: warehouseID, FulfillmentType: models.FulfillmentPickup, MaxOrders: 50}).
+			Return(&models.FulfillmentWave{ID: uuid.New(), WarehouseID: warehouseID, OrderIDs: []uuid.UUID{uuid.New()}}, nil).Once()
+
+		body := bytes.NewBufferString(`{"warehouse_id":"` + warehouseID.String() + `","fulfillment_type":"pickup","max_orders":50}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fulfillment-waves", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.CreateWave().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Unknown fulfillment type", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"warehouse_id":"` + warehouseID.String() + `","fulfillment_type":"drone"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fulfillment-waves", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.CreateWave().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - No order ready", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("CreateWave", mock.Anything, adminID, mock.Anything).
+			Return(nil, appErrors.BadRequestError("No orders are ready to pick in this warehouse")).Once()
+
+		body := bytes.NewBufferString(`{"warehouse_id":"` + warehouseID.String() + `"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fulfillment-waves", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.CreateWave().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestListFulfillmentWaves(t *testing.T) {
+	// Arrange
+	mockWaveService := mocks.NewMockFulfillmentWaveService(t)
+	waveHandler := handlers.NewFulfillmentWaveHandler(mockWaveService)
+
+	t.Run("Success - Filtered by status", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("ListWaves", mock.Anything, models.FulfillmentWaveOpen, 1, 20).Return([]*models.FulfillmentWave{}, 0, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/fulfillment-waves?status=open", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.ListWaves().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Unknown status", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/fulfillment-waves?status=picking", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.ListWaves().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetPickList(t *testing.T) {
+	// Arrange
+	mockWaveService := mocks.NewMockFulfillmentWaveService(t)
+	waveHandler := handlers.NewFulfillmentWaveHandler(mockWaveService)
+	waveID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("GetPickList", mock.Anything, waveID).
+			Return(&models.PickList{WaveID: waveID, Lines: []models.PickListLine{{SKU: "SKU-1", Quantity: 4, OrderCount: 2}}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/fulfillment-waves/"+waveID.String()+"/pick-list", nil, uuid.New(), map[string]string{"id": waveID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.GetPickList().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"sku":"SKU-1"`)
+	})
+
+	t.Run("Failure - Wave not found", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("GetPickList", mock.Anything, waveID).Return(nil, appErrors.NotFoundError("Fulfillment wave not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/fulfillment-waves/"+waveID.String()+"/pick-list", nil, uuid.New(), map[string]string{"id": waveID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.GetPickList().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestCompleteFulfillmentWave(t *testing.T) {
+	// Arrange
+	mockWaveService := mocks.NewMockFulfillmentWaveService(t)
+	waveHandler := handlers.NewFulfillmentWaveHandler(mockWaveService)
+	waveID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("CompleteWave", mock.Anything, waveID).Return(&models.CompleteFulfillmentWaveResponse{
+			Wave:    &models.FulfillmentWave{ID: waveID, Status: models.FulfillmentWaveCompleted},
+			Results: &models.BulkUpdateOrderStatusResponse{Status: models.OrderStatusShipping, Succeeded: 2},
+		}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fulfillment-waves/"+waveID.String()+"/complete", nil, uuid.New(), map[string]string{"id": waveID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.CompleteWave().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Already completed", func(t *testing.T) {
+		// Arrange
+		mockWaveService.On("CompleteWave", mock.Anything, waveID).Return(nil, appErrors.InvalidTransitionError("Fulfillment wave is already completed")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/fulfillment-waves/"+waveID.String()+"/complete", nil, uuid.New(), map[string]string{"id": waveID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		waveHandler.CompleteWave().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type InvariantHandler struct {
+	invariantService service.InvariantService
+}
+
+func NewInvariantHandler(invariantService service.InvariantService) *InvariantHandler {
+	return &InvariantHandler{invariantService: invariantService}
+}
+
+// RunChecks godoc
+//
+//	@Summary		Run the invariants checker (Admin)
+//	@Description	Verifies stock against the movements ledger, order totals against their items, payments against orders and that every ledger transaction balances. Violations are also exposed as metrics.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.InvariantReport	"Invariants report"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/invariants/check [post]
+func (h *InvariantHandler) RunChecks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		logger.Info("Attempting to run invariants check")
+
+		report, err := h.invariantService.RunChecks(r.Context())
+		if err != nil {
+			logger.Error("Failed to run invariants check", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Invariants check completed", slog.Int("violations", len(report.Violations)))
+		response.Success(w, http.StatusOK, report)
+	}
+}
+
+// GetReport godoc
+//
+//	@Summary		Get the last invariants report (Admin)
+//	@Description	Returns the report of the last invariants check, whether it was scheduled or triggered manually.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.InvariantReport	"Invariants report"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"No check has run yet"
+//	@Security		BearerAuth
+//	@Router			/admin/invariants/report [get]
+func (h *InvariantHandler) GetReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		report, err := h.invariantService.GetLastReport(r.Context())
+		if err != nil {
+			logger.Warn("Invariants report not available", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, report)
+	}
+}
@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunInvariantChecks(t *testing.T) {
+	// Arrange
+	mockInvariantService := mocks.NewMockInvariantService(t)
+	invariantHandler := handlers.NewInvariantHandler(mockInvariantService)
+
+	t.Run("Success - Report returned", func(t *testing.T) {
+		// Arrange
+		report := &models.InvariantReport{
+			Counts: map[models.InvariantCheck]int{models.InvariantOrderTotal: 1},
+			Violations: []models.InvariantViolation{
+				{Check: models.InvariantOrderTotal, EntityID: uuid.NewString(), Expected: "10.00", Actual: "12.00"},
+			},
+		}
+		mockInvariantService.On("RunChecks", mock.Anything).Return(report, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/invariants/check", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		invariantHandler.RunChecks().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp *response.APIResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+
+		dataBytes, err := json.Marshal(resp.Data)
+		require.NoError(t, err)
+
+		var respReport models.InvariantReport
+		require.NoError(t, json.Unmarshal(dataBytes, &respReport))
+		assert.Equal(t, report.Violations, respReport.Violations)
+	})
+
+	t.Run("Failure - Service Error", func(t *testing.T) {
+		// Arrange
+		mockInvariantService.On("RunChecks", mock.Anything).Return(nil, appErrors.DatabaseError("Failed to run invariant check")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/invariants/check", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		invariantHandler.RunChecks().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestGetInvariantReport(t *testing.T) {
+	// Arrange
+	mockInvariantService := mocks.NewMockInvariantService(t)
+	invariantHandler := handlers.NewInvariantHandler(mockInvariantService)
+
+	t.Run("Failure - No report yet", func(t *testing.T) {
+		// Arrange
+		mockInvariantService.On("GetLastReport", mock.Anything).Return(nil, appErrors.NotFoundError("No invariants check has run yet")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/invariants/report", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		invariantHandler.GetReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type LedgerHandler struct {
+	ledgerService service.LedgerService
+}
+
+func NewLedgerHandler(ledgerService service.LedgerService) *LedgerHandler {
+	return &LedgerHandler{ledgerService: ledgerService}
+}
+
+// GetLedgerReport godoc
+//
+//	@Summary		Get ledger report (Admin)
+//	@Description	Totals the debits and credits of every ledger account per currency over a date range, in the smallest currency unit. The ledger records captures, refunds, Stripe fees and vendor payouts as balanced double-entry transactions, it is the source of truth of the finance reports. Defaults to the last 30 days.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{object}	models.LedgerReport		"Successfully retrieved ledger report"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/reports/ledger [get]
+func (h *LedgerHandler) GetLedgerReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parseReportRange(r)
+		if err != nil {
+			logger.Warn("Invalid ledger report range", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.Time("from", from), slog.Time("to", to))
+
+		report, err := h.ledgerService.GetLedgerReport(r.Context(), from, to)
+		if err != nil {
+			logger.Error("Failed to build ledger report", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Ledger report retrieved successfully", slog.Int("balances", len(report.Balances)))
+		response.Success(w, http.StatusOK, report)
+	}
+}
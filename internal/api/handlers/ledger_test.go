@@ -0,0 +1,71 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetLedgerReport(t *testing.T) {
+	mockLedgerService := mocks.NewMockLedgerService(t)
+	ledgerHandler := handlers.NewLedgerHandler(mockLedgerService)
+
+	t.Run("Success - Explicit range", func(t *testing.T) {
+		// Arrange
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		report := &models.LedgerReport{
+			From:     from,
+			To:       to,
+			Balances: []models.LedgerBalance{{Account: models.LedgerAccountSales, Currency: "usd", Credit: 5000, Balance: -5000}},
+		}
+		mockLedgerService.On("GetLedgerReport", mock.Anything, from, to).Return(report, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/ledger?from=2025-01-01&to=2025-02-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		ledgerHandler.GetLedgerReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"account":"sales"`)
+	})
+
+	t.Run("Failure - Invalid date", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/ledger?to=tomorrow", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		ledgerHandler.GetLedgerReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Service error", func(t *testing.T) {
+		// Arrange
+		mockLedgerService.On("GetLedgerReport", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil, appErrors.DatabaseError("Failed to get ledger balances")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/ledger", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		ledgerHandler.GetLedgerReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
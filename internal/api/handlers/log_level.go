@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/logging"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type LogLevelHandler struct {
+	level     *logging.Level
+	validator *validator.Validate
+}
+
+func NewLogLevelHandler(level *logging.Level) *LogLevelHandler {
+	return &LogLevelHandler{level: level, validator: validator.New()}
+}
+
+// GetLogLevel godoc
+//
+//	@Summary		Get the log level
+//	@Description	Returns the level the instance serving the request logs at, and the configured one. Requires admin role.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.LogLevel			"Current log level"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden"
+//	@Security		BearerAuth
+//	@Router			/admin/log-level [get]
+func (h *LogLevelHandler) GetLogLevel() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		response.Success(w, http.StatusOK, h.current())
+	}
+}
+
+// UpdateLogLevel godoc
+//
+//	@Summary		Change the log level
+//	@Description	Changes the level the instance serving the request logs at, e.g. to debug while investigating an issue live. The change only reaches that instance and lasts until it is reset or the instance restarts. Requires admin role.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			level	body		models.UpdateLogLevelRequest	true	"New log level"
+//	@Success		200		{object}	models.LogLevel					"Log level changed"
+//	@Failure		400		{object}	response.ErrorResponse			"Validation error"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden"
+//	@Security		BearerAuth
+//	@Router			/admin/log-level [put]
+func (h *LogLevelHandler) UpdateLogLevel() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.UpdateLogLevelRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		level, err := logging.ParseLevel(req.Level)
+		if err != nil {
+			response.Error(w, errors.BadRequestError(err.Error()))
+
+			return
+		}
+
+		previous := h.level.Level()
+		h.level.Set(level)
+
+		// logged at warn so the change shows up whatever the new level
+		logger.Warn("Log level changed", slog.String("from", previous.String()), slog.String("to", level.String()))
+		response.Success(w, http.StatusOK, h.current())
+	}
+}
+
+// ResetLogLevel godoc
+//
+//	@Summary		Reset the log level
+//	@Description	Sets the level the instance serving the request logs at back to the configured one. Requires admin role.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.LogLevel			"Log level reset"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden"
+//	@Security		BearerAuth
+//	@Router			/admin/log-level [delete]
+func (h *LogLevelHandler) ResetLogLevel() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.level.Reset()
+
+		middleware.LoggerFromContext(r.Context()).Warn("Log level reset", slog.String("to", h.level.Level().String()))
+		response.Success(w, http.StatusOK, h.current())
+	}
+}
+
+func (h *LogLevelHandler) current() models.LogLevel {
+	return models.LogLevel{
+		Level:      strings.ToLower(h.level.Level().String()),
+		Configured: strings.ToLower(h.level.Configured().String()),
+	}
+}
@@ -0,0 +1,72 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/logging"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevel(t *testing.T) {
+	// Arrange
+	level := logging.NewLevel(slog.LevelInfo)
+	logLevelHandler := handlers.NewLogLevelHandler(level)
+	adminID := uuid.New()
+
+	t.Run("Success - Raised to debug", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"level":"debug"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/log-level", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		logLevelHandler.UpdateLogLevel().ServeHTTP(rr, req)
+
+		// Assert
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, slog.LevelDebug, level.Level())
+
+		var resp struct {
+			Data models.LogLevel `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, models.LogLevel{Level: "debug", Configured: "info"}, resp.Data)
+	})
+
+	t.Run("Failure - Unknown level", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"level":"trace"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/log-level", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		logLevelHandler.UpdateLogLevel().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, slog.LevelDebug, level.Level())
+	})
+
+	t.Run("Success - Reset", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/admin/log-level", nil, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		logLevelHandler.ResetLogLevel().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, slog.LevelInfo, level.Level())
+	})
+}
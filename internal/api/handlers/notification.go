@@ -1,17 +1,23 @@
 package handlers
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 )
 
 type NotificationHandler struct {
@@ -140,3 +146,529 @@ func (h *NotificationHandler) ListNotifications() http.HandlerFunc {
 		})
 	}
 }
+
+// SearchNotifications godoc
+//
+//	@Summary		Search notifications (Admin)
+//	@Description	Retrieves a paginated list of the notifications matching the filters, the latest first, with the number of matching notifications per status. The counts ignore the status filter, so that the delivery of a broadcast is broken down in one request. Dates are either RFC 3339 timestamps or YYYY-MM-DD days starting at midnight in the request timezone.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status			query		string								false	"Only the notifications in this status"	Enums(pending, sent, failed, queued, permanently_failed, delivered, bounced, spam_reported, suppressed)
+//	@Param			type			query		string								false	"Only the notifications of this type"	Enums(email, sms, push)
+//	@Param			recipient		query		string								false	"Only the notifications sent to this address, whatever its case"
+//	@Param			broadcast_id	query		string								false	"Only the notifications of this broadcast"	Format(uuid)
+//	@Param			since			query		string								false	"Created at or after"
+//	@Param			until			query		string								false	"Created before"
+//	@Param			page			query		int									false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize		query		int									false	"Number of items per page (default: 20, max: 100)"	minimum(1)	maximum(100)
+//	@Success		200				{object}	models.NotificationSearchResponse	"Matching notifications"
+//	@Failure		400				{object}	response.ErrorResponse				"Invalid filter"
+//	@Failure		401				{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403				{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		500				{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications [get]
+func (h *NotificationHandler) SearchNotifications() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		filter, err := parseNotificationFilter(r)
+		if err != nil {
+			logger.Warn("Invalid notification filter", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		result, err := h.notificationService.SearchNotifications(r.Context(), filter, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to search notifications", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Notifications searched successfully", slog.Int("count", len(result.Notifications)), slog.Int("total", result.Total))
+		response.Success(w, http.StatusOK, result)
+	}
+}
+
+// ExportNotifications godoc
+//
+//	@Summary		Export notifications as CSV (Admin)
+//	@Description	Streams every notification matching the filters as CSV, oldest first, without the content. Takes the filters of the notification search. Rows are written as they are read, so the export is not held in memory.
+//	@Tags			Admin
+//	@Produce		text/csv
+//	@Param			status			query		string					false	"Only the notifications in this status"	Enums(pending, sent, failed, queued, permanently_failed, delivered, bounced, spam_reported, suppressed)
+//	@Param			type			query		string					false	"Only the notifications of this type"	Enums(email, sms, push)
+//	@Param			recipient		query		string					false	"Only the notifications sent to this address, whatever its case"
+//	@Param			broadcast_id	query		string					false	"Only the notifications of this broadcast"	Format(uuid)
+//	@Param			since			query		string					false	"Created at or after"
+//	@Param			until			query		string					false	"Created before"
+//	@Success		200				{string}	string					"CSV export of the notifications"
+//	@Failure		400				{object}	response.ErrorResponse	"Invalid filter"
+//	@Failure		401				{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403				{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500				{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/export [get]
+func (h *NotificationHandler) ExportNotifications() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		filter, err := parseNotificationFilter(r)
+		if err != nil {
+			logger.Warn("Invalid notification filter", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		export := newCSVExport(w, fmt.Sprintf("notifications_%s.csv", time.Now().UTC().Format("20060102T150405Z")),
+			[]string{"id", "type", "recipient", "subject", "status", "error_message", "retry_count", "created_at", "updated_at"})
+
+		err = h.notificationService.ExportNotifications(r.Context(), filter, func(n *models.Notification) error {
+			return export.Write([]string{
+				n.ID.String(),
+				string(n.Type),
+				n.Recipient,
+				n.Subject,
+				string(n.Status),
+				n.ErrorMessage,
+				strconv.Itoa(n.RetryCount),
+				n.CreatedAt.UTC().Format(time.RFC3339),
+				n.UpdatedAt.UTC().Format(time.RFC3339),
+			})
+		})
+		if err != nil && !export.Started() {
+			logger.Error("Failed to export notifications", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		if err != nil {
+			// The response is already under way, all that is left is to cut it short
+			logger.Error("Notifications export aborted", slog.Any("error", err), slog.Int("rows", export.Rows()))
+
+			return
+		}
+
+		if err := export.Close(); err != nil {
+			logger.Error("Failed to write notifications export", slog.Any("error", err))
+
+			return
+		}
+
+		logger.Info("Notifications exported successfully", slog.Int("rows", export.Rows()))
+	}
+}
+
+// parseNotificationFilter reads the filters of the notification search and export.
+func parseNotificationFilter(r *http.Request) (*models.NotificationFilter, error) {
+	query := r.URL.Query()
+
+	filter := &models.NotificationFilter{
+		Status:    models.NotificationStatus(query.Get("status")),
+		Type:      models.NotificationType(query.Get("type")),
+		Recipient: query.Get("recipient"),
+	}
+
+	if v := query.Get("broadcast_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, errors.BadRequestError("Invalid 'broadcast_id', expected a UUID")
+		}
+
+		filter.BroadcastID = &id
+	}
+
+	location := locale.FromContext(r.Context()).Location
+
+	for name, dest := range map[string]**time.Time{"since": &filter.Since, "until": &filter.Until} {
+		v := query.Get(name)
+		if v == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			parsed, err = time.ParseInLocation(time.DateOnly, v, location)
+		}
+
+		if err != nil {
+			return nil, errors.BadRequestError(fmt.Sprintf("Invalid '%s', expected an RFC 3339 timestamp or YYYY-MM-DD", name))
+		}
+
+		*dest = &parsed
+	}
+
+	return filter, nil
+}
+
+// RetryNotification godoc
+//
+//	@Summary		Retry a failed notification (Admin)
+//	@Description	Resends a failed notification right away, including one that ran out of automatic retries. Only the subject and the plain text content are resent.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Notification ID"	Format(uuid)
+//	@Success		200	{object}	models.Notification		"Notification sent"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid ID format or notification not in a failed state"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Notification not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error or email sending provider error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/{id}/retry [post]
+func (h *NotificationHandler) RetryNotification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid notification ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("notificationId", id.String()))
+
+		notification, err := h.notificationService.RetryNotification(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to retry notification", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Notification retried successfully", slog.Int("retryCount", notification.RetryCount))
+		response.Success(w, http.StatusOK, notification)
+	}
+}
+
+// FindRecipientNotifications godoc
+//
+//	@Summary		Find the notifications sent to a customer (Admin/Staff)
+//	@Description	Lists the latest 100 notifications sent to an address, whatever its case, with their status and error message, and tells whether the address is suppressed. Answers "did you email me my invoice?" in one request.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			recipient	query		string							true	"Email address of the customer"
+//	@Success		200			{object}	models.RecipientNotifications	"Notifications sent to the address"
+//	@Failure		400			{object}	response.ErrorResponse			"Missing or invalid recipient"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/search [get]
+func (h *NotificationHandler) FindRecipientNotifications() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		recipient := r.URL.Query().Get("recipient")
+		if err := h.validator.Var(recipient, "required,email"); err != nil {
+			logger.Warn("Invalid recipient for notification search")
+			response.Error(w, errors.BadRequestError("Query parameter 'recipient' must be an email address"))
+
+			return
+		}
+
+		result, err := h.notificationService.FindRecipientNotifications(r.Context(), recipient)
+		if err != nil {
+			logger.Error("Failed to find recipient notifications", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Recipient notifications found", slog.Int("total", result.Total), slog.Bool("suppressed", result.Suppression != nil))
+		response.Success(w, http.StatusOK, result)
+	}
+}
+
+// ResendNotification godoc
+//
+//	@Summary		Resend a notification (Admin/Staff)
+//	@Description	Sends an email notification again as a new notification, whatever the status of the original, e.g. for a customer who lost it. Only the subject, the plain text content and the metadata are resent, attachments are not. A suppressed address gets the new notification recorded as suppressed instead.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string						true	"Notification ID"	Format(uuid)
+//	@Success		201	{object}	models.NotificationResponse	"Notification resent"
+//	@Failure		400	{object}	response.ErrorResponse		"Invalid ID format or not an email notification"
+//	@Failure		401	{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse		"Notification not found"
+//	@Failure		500	{object}	response.ErrorResponse		"Internal server error or email sending provider error"
+//	@Security		BearerAuth
+//	@Router			/admin/notifications/{id}/resend [post]
+func (h *NotificationHandler) ResendNotification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid notification ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("notificationId", id.String()))
+
+		resent, err := h.notificationService.ResendNotification(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to resend notification", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Notification resent successfully", slog.String("resentId", resent.ID.String()), slog.String("status", string(resent.Status)))
+		response.Success(w, http.StatusCreated, resent)
+	}
+}
+
+// maxSendGridEventsSize bounds the body of an event webhook delivery, SendGrid batches up to a thousand
+// events of a few hundred bytes each.
+const maxSendGridEventsSize = 5 << 20
+
+// HandleSendGridEvents godoc
+//
+//	@Summary		Handle SendGrid delivery events
+//	@Description	Receives the SendGrid event webhook (delivered, bounce, spam report) and updates the status of the matching notifications. Hard-bounced addresses and the addresses reporting spam are suppressed from future sends. This endpoint does not require application-level authentication but relies on SendGrid's signed event webhook.
+//	@Tags			Notifications (Internal)
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Twilio-Email-Event-Webhook-Signature	header		string					true				"Event webhook signature"
+//	@Param			X-Twilio-Email-Event-Webhook-Timestamp	header		string					true				"Event webhook timestamp"
+//	@Param			payload									body		[]object				true				"Raw SendGrid events (JSON array)"
+//	@Success		200										{object}	map[string]bool			`{"success": true}`	"Events processed"
+//	@Failure		400										{object}	response.ErrorResponse	"Bad request (e.g., missing signature, body over 5MB, invalid payload)"
+//	@Failure		401										{object}	response.ErrorResponse	"Event webhook signature verification failed, or the delivery was already received"
+//	@Failure		500										{object}	response.ErrorResponse	"Internal server error, SendGrid retries the delivery"
+//	@Router			/notifications/sendgrid/events [post]
+func (h *NotificationHandler) HandleSendGridEvents() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		signature := r.Header.Get(sendgrid.EventSignatureHeader)
+		timestamp := r.Header.Get(sendgrid.EventTimestampHeader)
+
+		if signature == "" || timestamp == "" {
+			logger.Warn("Missing SendGrid event webhook signature")
+			response.Error(w, errors.BadRequestError("Event webhook signature and timestamp are required"))
+
+			return
+		}
+
+		// the body is read before its signature can be checked, the route being public it is bounded
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSendGridEventsSize))
+		if err != nil {
+			logger.Error("Error reading SendGrid events body", slog.Any("error", err))
+			response.Error(w, errors.BadRequestError("Failed to read request body"))
+
+			return
+		}
+
+		if err := h.notificationService.HandleDeliveryEvents(r.Context(), payload, signature, timestamp); err != nil {
+			logger.Error("Failed to handle SendGrid events", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("SendGrid events processed")
+		response.Success(w, http.StatusOK, map[string]bool{"success": true})
+	}
+}
+
+// maxSuppressionExportSize bounds the body of a suppression import, SendGrid exports hold an address per row.
+const maxSuppressionExportSize = 20 << 20
+
+// ListSuppressions godoc
+//
+//	@Summary		List suppressed email addresses (Admin)
+//	@Description	Retrieves a paginated list of the addresses no email is sent to, the latest first. Addresses are suppressed after a hard bounce or a spam complaint reported by SendGrid, or by hand.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			source		query		string														false	"Only the suppressions of this source"				Enums(bounce, complaint, manual)
+//	@Param			page		query		int															false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int															false	"Number of items per page (default: 20, max: 100)"	minimum(1)	maximum(100)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.EmailSuppression}	"Successfully retrieved list of suppressions"
+//	@Failure		400			{object}	response.ErrorResponse										"Invalid source"
+//	@Failure		401			{object}	response.ErrorResponse										"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse										"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse										"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/email-suppressions [get]
+func (h *NotificationHandler) ListSuppressions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 20
+		}
+
+		source := models.SuppressionSource(r.URL.Query().Get("source"))
+
+		logger = logger.With(slog.String("source", string(source)), slog.Int("page", page), slog.Int("pageSize", pageSize))
+
+		suppressions, total, err := h.notificationService.ListSuppressions(r.Context(), source, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list suppressions", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Suppressions listed successfully", slog.Int("count", len(suppressions)), slog.Int("total", total))
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     suppressions,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// AddSuppression godoc
+//
+//	@Summary		Suppress an email address (Admin)
+//	@Description	Blocks every further email to the address, e.g. on request of its owner. The notifications already queued for it are suppressed instead of sent.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			suppression	body		models.AddSuppressionRequest	true	"Address to suppress"
+//	@Success		201			{object}	models.EmailSuppression			"Address suppressed"
+//	@Failure		400			{object}	response.ErrorResponse			"Validation error"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		409			{object}	response.ErrorResponse			"Address already suppressed"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/email-suppressions [post]
+func (h *NotificationHandler) AddSuppression() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized suppression attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.AddSuppressionRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		suppression, err := h.notificationService.AddSuppression(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to suppress email", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Email address suppressed by hand")
+		response.Success(w, http.StatusCreated, suppression)
+	}
+}
+
+// RemoveSuppression godoc
+//
+//	@Summary		Remove a suppressed email address (Admin)
+//	@Description	Lets the address receive emails again, whatever the source of its suppression. The notifications suppressed so far are not resent.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			email	path		string					true				"Suppressed email address"
+//	@Success		200		{object}	map[string]bool			`{"success": true}`	"Suppression removed"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse	"Address not suppressed"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/email-suppressions/{email} [delete]
+func (h *NotificationHandler) RemoveSuppression() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		if err := h.notificationService.RemoveSuppression(r.Context(), r.PathValue("email")); err != nil {
+			logger.Error("Failed to remove suppression", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Email suppression removed")
+		response.Success(w, http.StatusOK, map[string]bool{"success": true})
+	}
+}
+
+// ImportSuppressions godoc
+//
+//	@Summary		Import a SendGrid suppression export (Admin)
+//	@Description	Suppresses the addresses of a suppression list exported from SendGrid, either the CSV download of the app (an email column, optionally a reason column) or the JSON array of the suppression API. The addresses suppressed before are skipped, the rows that are not an email address are reported. The body is limited to 20 MB.
+//	@Tags			Admin
+//	@Accept			text/csv
+//	@Accept			json
+//	@Produce		json
+//	@Param			source	query		string							false	"Source recorded on the imported addresses (default: bounce)"	Enums(bounce, complaint, manual)
+//	@Param			export	body		string							true	"SendGrid suppression export"
+//	@Success		200		{object}	models.SuppressionImportResult	"Export imported"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid source or export"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/email-suppressions/import [post]
+func (h *NotificationHandler) ImportSuppressions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized suppression import attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		source := models.SuppressionSource(r.URL.Query().Get("source"))
+		if source == "" {
+			source = models.SuppressionBounce
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()), slog.String("source", string(source)))
+
+		export := http.MaxBytesReader(w, r.Body, maxSuppressionExportSize)
+		defer export.Close()
+
+		result, err := h.notificationService.ImportSuppressions(r.Context(), claims.UserID, source, export)
+		if err != nil {
+			logger.Error("Failed to import suppressions", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Suppressions imported successfully", slog.Int("read", result.Read), slog.Int("imported", result.Imported),
+			slog.Int("skipped", result.Skipped), slog.Int("invalid", len(result.Invalid)))
+		response.Success(w, http.StatusOK, result)
+	}
+}
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -319,3 +321,366 @@ func TestListNotifications(t *testing.T) {
 		mockNotificationService.AssertExpectations(t)
 	})
 }
+
+func TestRetryNotification(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mockNotificationService.On("RetryNotification", mock.Anything, id).
+			Return(&models.Notification{ID: id, Status: models.StatusSent, RetryCount: 1}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/"+id.String()+"/retry", nil, uuid.New(), nil)
+		req.SetPathValue("id", id.String())
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.RetryNotification().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp response.APIResponse
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+	})
+
+	t.Run("Failure - Not a failed notification", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mockNotificationService.On("RetryNotification", mock.Anything, id).
+			Return(nil, appErrors.BadRequestError("Only failed notifications can be retried")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/"+id.String()+"/retry", nil, uuid.New(), nil)
+		req.SetPathValue("id", id.String())
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.RetryNotification().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Invalid ID", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/abc/retry", nil, uuid.New(), nil)
+		req.SetPathValue("id", "abc")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.RetryNotification().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandleSendGridEvents(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+	payload := []byte(`[{"email":"user@example.com","event":"delivered"}]`)
+
+	newEventsRequest := func(signature, timestamp string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/notifications/sendgrid/events", bytes.NewReader(payload))
+		if signature != "" {
+			req.Header.Set(sendgrid.EventSignatureHeader, signature)
+		}
+
+		if timestamp != "" {
+			req.Header.Set(sendgrid.EventTimestampHeader, timestamp)
+		}
+
+		return req
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockNotificationService.On("HandleDeliveryEvents", mock.Anything, payload, "sig", "123").Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.HandleSendGridEvents().ServeHTTP(rr, newEventsRequest("sig", "123"))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid signature", func(t *testing.T) {
+		// Arrange
+		mockNotificationService.On("HandleDeliveryEvents", mock.Anything, payload, "bad", "123").
+			Return(appErrors.UnauthorizedError("Event webhook signature verification failed")).Once()
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.HandleSendGridEvents().ServeHTTP(rr, newEventsRequest("bad", "123"))
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Failure - Missing signature", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.HandleSendGridEvents().ServeHTTP(rr, newEventsRequest("", "123"))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Body too large", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPost, "/notifications/sendgrid/events", bytes.NewReader(bytes.Repeat([]byte(" "), 5<<20+1)))
+		req.Header.Set(sendgrid.EventSignatureHeader, "sig")
+		req.Header.Set(sendgrid.EventTimestampHeader, "123")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.HandleSendGridEvents().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestEmailSuppressionHandlers(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+	adminID := uuid.New()
+
+	t.Run("ListSuppressions - Source and page passed on", func(t *testing.T) {
+		// Arrange
+		suppressions := []*models.EmailSuppression{{Email: "user@example.com", Source: models.SuppressionComplaint}}
+		mockNotificationService.On("ListSuppressions", mock.Anything, models.SuppressionComplaint, 2, 20).Return(suppressions, 21, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/email-suppressions?source=complaint&page=2", nil, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.ListSuppressions().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("AddSuppression - Invalid email", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/email-suppressions", bytes.NewBufferString(`{"email":"not an address"}`), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.AddSuppression().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("AddSuppression - Already suppressed", func(t *testing.T) {
+		// Arrange
+		mockNotificationService.On("AddSuppression", mock.Anything, adminID, &models.AddSuppressionRequest{Email: "user@example.com"}).
+			Return(nil, appErrors.DuplicateEntryError("Email address is already suppressed")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/email-suppressions", bytes.NewBufferString(`{"email":"user@example.com"}`), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.AddSuppression().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("RemoveSuppression - Success", func(t *testing.T) {
+		// Arrange
+		mockNotificationService.On("RemoveSuppression", mock.Anything, "user@example.com").Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/admin/email-suppressions/user@example.com", nil, adminID, map[string]string{"email": "user@example.com"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.RemoveSuppression().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("ImportSuppressions - Defaults to bounces", func(t *testing.T) {
+		// Arrange
+		result := &models.SuppressionImportResult{Source: models.SuppressionBounce, Read: 1, Imported: 1, Invalid: []string{}}
+		mockNotificationService.On("ImportSuppressions", mock.Anything, adminID, models.SuppressionBounce, mock.Anything).Return(result, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/email-suppressions/import", bytes.NewBufferString("email\nuser@example.com\n"), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.ImportSuppressions().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestSearchNotifications(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+
+	t.Run("Success - Filters passed on", func(t *testing.T) {
+		// Arrange
+		broadcastID := uuid.New()
+		since := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		until := time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)
+		filter := &models.NotificationFilter{Status: models.StatusFailed, Type: models.NotificationTypeEmail, Recipient: "user@example.com", BroadcastID: &broadcastID, Since: &since, Until: &until}
+		result := &models.NotificationSearchResponse{
+			Notifications: []*models.Notification{},
+			StatusCounts:  map[models.NotificationStatus]int{models.StatusFailed: 2, models.StatusDelivered: 40},
+			Page:          2,
+			PageSize:      50,
+		}
+		mockNotificationService.On("SearchNotifications", mock.Anything, filter, 2, 50).Return(result, nil).Once()
+
+		target := "/admin/notifications?status=failed&type=email&recipient=user@example.com&broadcast_id=" + broadcastID.String() +
+			"&since=2025-03-01&until=2025-03-02T12:00:00Z&page=2&pageSize=50"
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, target, nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.SearchNotifications().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status_counts":{"delivered":40,"failed":2}`)
+	})
+
+	t.Run("Failure - Invalid date", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/notifications?since=yesterday", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.SearchNotifications().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestExportNotifications(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		createdAt := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+		filter := &models.NotificationFilter{Status: models.StatusBounced}
+
+		mockNotificationService.On("ExportNotifications", mock.Anything, filter, mock.Anything).
+			Return(func(_ context.Context, _ *models.NotificationFilter, fn func(*models.Notification) error) error {
+				return fn(&models.Notification{ID: id, Type: models.NotificationTypeEmail, Recipient: "user@example.com", Subject: "Sale", Status: models.StatusBounced,
+					ErrorMessage: "550 unknown user", RetryCount: 1, CreatedAt: createdAt, UpdatedAt: createdAt})
+			}).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/notifications/export?status=bounced", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.ExportNotifications().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "id,type,recipient,subject,status,error_message,retry_count,created_at,updated_at\n"+
+			id.String()+",email,user@example.com,Sale,bounced,550 unknown user,1,2025-03-01T08:00:00Z,2025-03-01T08:00:00Z\n", rr.Body.String())
+	})
+
+	t.Run("Failure - Invalid filter", func(t *testing.T) {
+		// Arrange
+		mockNotificationService.On("ExportNotifications", mock.Anything, &models.NotificationFilter{Status: "lost"}, mock.Anything).
+			Return(appErrors.BadRequestError("Invalid notification status")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/notifications/export?status=lost", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.ExportNotifications().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"success":false`)
+	})
+}
+
+func TestFindRecipientNotifications(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		result := &models.RecipientNotifications{Recipient: "user@example.com", Notifications: []*models.Notification{}, Total: 0}
+		mockNotificationService.On("FindRecipientNotifications", mock.Anything, "user@example.com").Return(result, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/notifications/search?recipient=user@example.com", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.FindRecipientNotifications().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Missing recipient", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/notifications/search", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.FindRecipientNotifications().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestResendNotification(t *testing.T) {
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	notificationHandler := handlers.NewNotificationHandler(mockNotificationService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mockNotificationService.On("ResendNotification", mock.Anything, id).
+			Return(&models.NotificationResponse{ID: uuid.New(), Type: models.NotificationTypeEmail, Status: models.StatusSent}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/"+id.String()+"/resend", nil, uuid.New(), map[string]string{"id": id.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.ResendNotification().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Invalid ID", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/notifications/abc/resend", nil, uuid.New(), map[string]string{"id": "abc"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		notificationHandler.ResendNotification().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
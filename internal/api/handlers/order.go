@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"log/slog"
+	"net"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
@@ -26,16 +29,20 @@ func NewOrderHandler(orderService service.OrderService) *OrderHandler {
 // CreateOrder godoc
 //
 //	@Summary		Create a new order
-//	@Description	Creates a new order from the user's current cart items and provided shipping details. Requires authentication.
+//	@Description	Creates a new order from the user's current cart items and provided shipping details. An optional device fingerprint from the storefront is kept with the order for the fraud reviews. An order of the same items and total as one placed minutes ago is refused as a duplicate, unless allow_duplicate is set once the customer confirmed it. Requires authentication.
 //	@Tags			Orders
 //	@Accept			json
 //	@Produce		json
 //	@Param			order	body		models.CreateOrderRequest	true	"Order Creation Details (includes shipping, uses current cart)"
 //	@Success		201		{object}	models.Order				"Successfully created order"
-//	@Failure		400		{object}	response.ErrorResponse		"Validation error, empty cart, or insufficient stock"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or empty cart"
 //	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
 //	@Failure		404		{object}	response.ErrorResponse		"Cart not found (should be created implicitly if needed)"
+//	@Failure		409		{object}	response.ErrorResponse		"INSUFFICIENT_STOCK (also when a campaign allocation is sold out), PRICE_CHANGED, PRODUCT_NOT_FOR_SALE or PURCHASE_LIMIT_EXCEEDED, the meta field holds the product and the stock left, its current price, its status or its limit. DUPLICATE_ORDER with the order already placed in the meta field"
+//	@Failure		422		{object}	response.ErrorResponse		"Shipping restricted, see the error code"
+//	@Failure		429		{object}	response.ErrorResponse		"IN_WAITING_ROOM during a high-demand drop, the meta field holds the place in line and the ticket to send back in the X-Waiting-Room-Token header, or ORDER_VELOCITY_EXCEEDED when too many orders were placed recently"
 //	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Failure		503		{object}	response.ErrorResponse		"Campaign stock unavailable"
 //	@Security		BearerAuth
 //	@Router			/orders [post]
 func (h *OrderHandler) CreateOrder() http.HandlerFunc {
@@ -60,6 +67,13 @@ func (h *OrderHandler) CreateOrder() http.HandlerFunc {
 			return
 		}
 
+		clientIP, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			clientIP = r.RemoteAddr
+		}
+
+		req.ClientIP = clientIP
+
 		order, err := h.orderService.CreateOrder(r.Context(), &req)
 		if err != nil {
 			logger.Error("Failed to create order", slog.Any("error", err))
@@ -69,7 +83,7 @@ func (h *OrderHandler) CreateOrder() http.HandlerFunc {
 		}
 
 		logger.Info("Order created successfully", slog.String("orderId", order.ID.String()))
-		response.Success(w, http.StatusCreated, order)
+		response.Success(w, http.StatusCreated, orderView(r.Context(), order))
 	}
 }
 
@@ -133,7 +147,68 @@ func (h *OrderHandler) GetOrder() http.HandlerFunc {
 		}
 
 		logger.Info("Order retrieved successfully")
-		response.Success(w, http.StatusOK, order)
+		response.Success(w, http.StatusOK, orderView(r.Context(), order))
+	}
+}
+
+// GetOrderTimeline godoc
+//
+//	@Summary		Get the timeline of an order
+//	@Description	Merges the history of an order into one list of events, oldest first: when it was placed, its status changes, its payments, refunds and notifications. Customers see their own orders. Admins, staff and machine clients see any order, with the internal events too: the warehouse picking it, the changes made by admins and the fraud review with the note of the reviewer.
+//	@Tags			Orders
+//	@Produce		json
+//	@Param			id	path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.OrderTimeline	"Successfully retrieved order timeline"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid order ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - User does not own this order"
+//	@Failure		404	{object}	response.ErrorResponse	"Order not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/orders/{id}/timeline [get]
+func (h *OrderHandler) GetOrderTimeline() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized order timeline access attempt: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order id", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()))
+
+		timeline, err := h.orderService.GetOrderTimeline(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get order timeline", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		if timeline.CustomerID != claims.UserID && !seesInternalIDs(r.Context()) {
+			logger.Warn("Attempted to access another user's order timeline",
+				slog.String("requesterId", claims.UserID.String()),
+				slog.String("ownerId", timeline.CustomerID.String()))
+			response.Error(w, errors.ForbiddenError("You don't have permission to access this order"))
+
+			return
+		}
+
+		logger.Info("Order timeline retrieved successfully", slog.Int("events", len(timeline.Events)))
+		response.Success(w, http.StatusOK, orderTimelineView(r.Context(), timeline))
 	}
 }
 
@@ -187,7 +262,7 @@ func (h *OrderHandler) ListOrders() http.HandlerFunc {
 
 		logger.Info("Orders listed successfully", slog.Int("count", len(orders)), slog.Int("total", total))
 		response.Success(w, http.StatusOK, models.PaginatedResponse{
-			Data:     orders,
+			Data:     ordersView(r.Context(), orders),
 			Total:    total,
 			Page:     page,
 			PageSize: pageSize,
@@ -195,6 +270,92 @@ func (h *OrderHandler) ListOrders() http.HandlerFunc {
 	}
 }
 
+// ExportOrders godoc
+//
+//	@Summary		Download the order history as CSV
+//	@Description	Streams the orders of the authenticated user as CSV, one row per order item, oldest first. Without a range the full history is exported.
+//	@Tags			Orders
+//	@Produce		text/csv
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{string}	string					"CSV export of the orders"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/users/me/orders/export [get]
+func (h *OrderHandler) ExportOrders() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized order export attempt: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		loc := locale.FromContext(r.Context())
+
+		from, to, err := parseDateRange(r, time.Time{}, loc.StartOfDay(time.Now()).AddDate(0, 0, 1), loc.Location)
+		if err != nil {
+			logger.Warn("Invalid order export range", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		export := newCSVExport(w, "orders.csv",
+			[]string{"order_id", "created_at", "status", "payment_status", "fulfillment_type", "total_amount", "product_id", "quantity", "unit_price", "is_gift"})
+
+		err = h.orderService.ExportOrders(r.Context(), claims.UserID, from, to, func(order *models.Order) error {
+			for _, item := range order.Items {
+				err := export.Write([]string{
+					order.ID.String(),
+					order.CreatedAt.UTC().Format(time.RFC3339),
+					string(order.Status),
+					string(order.PaymentStatus),
+					string(order.FulfillmentType),
+					strconv.FormatFloat(order.TotalAmount, 'f', 2, 64),
+					item.ProductID.String(),
+					strconv.Itoa(item.Quantity),
+					strconv.FormatFloat(item.UnitPrice, 'f', 2, 64),
+					strconv.FormatBool(item.IsGift),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil && !export.Started() {
+			logger.Error("Failed to export orders", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		if err != nil {
+			// The response is already under way, all that is left is to cut it short
+			logger.Error("Order export aborted", slog.Any("error", err), slog.Int("rows", export.Rows()))
+
+			return
+		}
+
+		if err := export.Close(); err != nil {
+			logger.Error("Failed to write order export", slog.Any("error", err))
+
+			return
+		}
+
+		logger.Info("Orders exported successfully", slog.Int("rows", export.Rows()))
+	}
+}
+
 // UpdateOrderStatus godoc
 //
 //	@Summary		Update order status (Admin/Internal)
@@ -209,6 +370,7 @@ func (h *OrderHandler) ListOrders() http.HandlerFunc {
 //	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
 //	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions to update status"	//	If	applicable
 //	@Failure		404		{object}	response.ErrorResponse			"Order not found"
+//	@Failure		409		{object}	response.ErrorResponse			"The order cannot move to this status from its current one, or FRAUD_REVIEW_PENDING when it is held for a fraud review"
 //	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/orders/{id}/status [patch]
@@ -255,6 +417,201 @@ func (h *OrderHandler) UpdateOrderStatus() http.HandlerFunc {
 		}
 
 		logger.Info("Order status updated successfully")
-		response.Success(w, http.StatusOK, order)
+		response.Success(w, http.StatusOK, orderView(r.Context(), order))
+	}
+}
+
+// HandleCarrierEvent godoc
+//
+//	@Summary		Handle carrier callbacks
+//	@Description	Receives the shipment progress of an order from a carrier and moves the order to the status reported. Carriers sign their deliveries with a shared secret, an HMAC-SHA256 of "<timestamp>.<payload>" sent as "t=<timestamp>,v1=<hex signature>", and a delivery is accepted once only within the timestamp tolerance. This endpoint relies on the signature instead of application-level authentication.
+//	@Tags			Orders (Internal)
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Webhook-Signature	header		string					true				"Signature of the delivery"
+//	@Param			event				body		models.CarrierEvent		true				"Shipment progress of the order"
+//	@Success		200					{object}	map[string]bool			`{"success": true}`	"Order status updated"
+//	@Failure		400					{object}	response.ErrorResponse	"Bad request (e.g., body over 1MB, invalid payload)"
+//	@Failure		401					{object}	response.ErrorResponse	"Missing, invalid, stale or replayed signature"
+//	@Failure		404					{object}	response.ErrorResponse	"Order not found"
+//	@Failure		409					{object}	response.ErrorResponse	"The order cannot move to this status from its current one, or it is held for a fraud review"
+//	@Failure		500					{object}	response.ErrorResponse	"Internal server error, the carrier may deliver the event again"
+//	@Failure		503					{object}	response.ErrorResponse	"Signature verification unavailable"
+//	@Router			/webhooks/carrier [post]
+func (h *OrderHandler) HandleCarrierEvent() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var event models.CarrierEvent
+		if !utils.ParseAndValidate(r, w, &event, h.validator) {
+			logger.Warn("Invalid carrier event")
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", event.OrderID.String()), slog.String("newStatus", string(event.Status)))
+
+		if _, err := h.orderService.UpdateOrderStatus(r.Context(), event.OrderID, event.Status); err != nil {
+			logger.Error("Failed to handle carrier event", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Carrier event handled")
+		response.Success(w, http.StatusOK, map[string]bool{"success": true})
+	}
+}
+
+// BulkUpdateOrderStatus godoc
+//
+//	@Summary		Update the status of many orders (Admin)
+//	@Description	Moves up to the configured number of orders to the same status, for fulfillment systems. Each order is checked against the order state machine on its own, an order held for a fraud review is not fulfilled, and the orders are updated in batches, the result of every order is returned so the ones that failed can be retried.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.BulkUpdateOrderStatusRequest		true	"Order IDs and New Status"
+//	@Success		200		{object}	models.BulkUpdateOrderStatusResponse	"Result of each order"
+//	@Failure		400		{object}	response.ErrorResponse					"Validation error or too many orders"
+//	@Failure		401		{object}	response.ErrorResponse					"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse					"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse					"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/status-bulk [patch]
+func (h *OrderHandler) BulkUpdateOrderStatus() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.BulkUpdateOrderStatusRequest
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			logger.Warn("Invalid bulk order status input")
+
+			return
+		}
+
+		logger = logger.With(slog.String("newStatus", string(req.Status)), slog.Int("orders", len(req.OrderIDs)))
+
+		resp, err := h.orderService.BulkUpdateOrderStatus(r.Context(), &req)
+		if err != nil {
+			logger.Error("Failed to update order statuses", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order statuses updated", slog.Int("succeeded", resp.Succeeded), slog.Int("failed", resp.Failed))
+		response.Success(w, http.StatusOK, resp)
+	}
+}
+
+// GetPackingSlip godoc
+//
+//	@Summary		Get the packing slip of an order (Admin)
+//	@Description	Retrieves the slip shipped with the order, with the warehouses the stock allocation picks each item from. Prices are omitted for gift orders and gifted items. With format=html the slip is a printable page with Code 128 barcodes of the order and of the item SKUs.
+//	@Tags			Admin
+//	@Produce		json,html
+//	@Param			id		path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Param			format	query		string					false	"Response format"	Enums(json, html)
+//	@Success		200		{object}	models.PackingSlip		"Successfully retrieved packing slip"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid order ID format"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse	"Order not found"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/{id}/packing-slip [get]
+func (h *OrderHandler) GetPackingSlip() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order id", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()))
+
+		slip, err := h.orderService.GetPackingSlip(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get packing slip", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		if wantsHTML(r) {
+			if err := writePackingSlips(w, []*models.PackingSlip{slip}); err != nil {
+				logger.Error("Failed to render packing slip", slog.String("error", err.Error()))
+				response.Error(w, err)
+
+				return
+			}
+
+			logger.Info("Packing slip printed successfully")
+
+			return
+		}
+
+		logger.Info("Packing slip retrieved successfully")
+		response.Success(w, http.StatusOK, slip)
+	}
+}
+
+// GetPackingSlips godoc
+//
+//	@Summary		Get the packing slips of a pick wave (Admin)
+//	@Description	Retrieves the slips of the orders of a pick wave in the order given, with the warehouses the stock allocation picks each item from. An order not found fails the whole wave. With format=html the slips are one printable document, a slip per page.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json,html
+//	@Param			request	body		models.PackingSlipsRequest	true	"Orders of the pick wave"
+//	@Param			format	query		string						false	"Response format"	Enums(json, html)
+//	@Success		200		{object}	models.PackingSlipsResponse	"Successfully retrieved packing slips"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or too many orders"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse		"Order not found"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/packing-slips [post]
+func (h *OrderHandler) GetPackingSlips() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.PackingSlipsRequest
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			logger.Warn("Invalid packing slips input")
+
+			return
+		}
+
+		logger = logger.With(slog.Int("orders", len(req.OrderIDs)))
+
+		slips, err := h.orderService.GetPackingSlips(r.Context(), req.OrderIDs)
+		if err != nil {
+			logger.Error("Failed to get packing slips", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		if wantsHTML(r) {
+			if err := writePackingSlips(w, slips); err != nil {
+				logger.Error("Failed to render packing slips", slog.String("error", err.Error()))
+				response.Error(w, err)
+
+				return
+			}
+
+			logger.Info("Packing slips printed successfully")
+
+			return
+		}
+
+		logger.Info("Packing slips retrieved successfully")
+		response.Success(w, http.StatusOK, models.PackingSlipsResponse{Slips: slips})
 	}
 }
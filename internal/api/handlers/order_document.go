@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type OrderDocumentHandler struct {
+	orderDocumentService service.OrderDocumentService
+}
+
+func NewOrderDocumentHandler(orderDocumentService service.OrderDocumentService) *OrderDocumentHandler {
+	return &OrderDocumentHandler{orderDocumentService: orderDocumentService}
+}
+
+// ListOrderDocuments godoc
+//
+//	@Summary		List order documents
+//	@Description	Lists the accounting documents issued for an order of the authenticated user, oldest first. A credit note is issued for every refund of order lines and for the difference refunded after an order edit, numbered in a sequence per legal entity.
+//	@Tags			Orders
+//	@Produce		json
+//	@Param			id	path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Success		200	{array}		models.OrderDocument	"Order documents"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid order ID"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Order of another user"
+//	@Failure		404	{object}	response.ErrorResponse	"Order not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/orders/{id}/documents [get]
+func (h *OrderDocumentHandler) ListOrderDocuments() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized order documents access attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		docs, err := h.orderDocumentService.ListOrderDocuments(r.Context(), claims.UserID, id)
+		if err != nil {
+			logger.Error("Failed to list order documents", slog.String("orderId", id.String()), slog.String("userID", claims.UserID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, docs)
+	}
+}
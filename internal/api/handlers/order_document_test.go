@@ -0,0 +1,57 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListOrderDocuments(t *testing.T) {
+	// Arrange
+	mockOrderDocumentService := mocks.NewMockOrderDocumentService(t)
+	orderDocumentHandler := handlers.NewOrderDocumentHandler(mockOrderDocumentService)
+	userID := uuid.New()
+	orderID := uuid.New()
+	path := "/orders/" + orderID.String() + "/documents"
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockOrderDocumentService.On("ListOrderDocuments", mock.Anything, userID, orderID).
+			Return([]models.OrderDocument{{ID: uuid.New(), OrderID: orderID, Type: models.OrderDocumentCreditNote, Number: "CN-000001"}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, path, nil, userID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderDocumentHandler.ListOrderDocuments().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "CN-000001")
+	})
+
+	t.Run("Failure - Order of another user", func(t *testing.T) {
+		// Arrange
+		otherUserID := uuid.New()
+		mockOrderDocumentService.On("ListOrderDocuments", mock.Anything, otherUserID, orderID).
+			Return(nil, appErrors.ForbiddenError("You don't have permission to access this order")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, path, nil, otherUserID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderDocumentHandler.ListOrderDocuments().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
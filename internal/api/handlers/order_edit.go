@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type OrderEditHandler struct {
+	orderEditService service.OrderEditService
+	validator        *validator.Validate
+}
+
+func NewOrderEditHandler(orderEditService service.OrderEditService) *OrderEditHandler {
+	return &OrderEditHandler{orderEditService: orderEditService, validator: validator.New()}
+}
+
+// EditOrder godoc
+//
+//	@Summary		Edit an order before it ships (Admin)
+//	@Description	Adds and removes items of a pending or confirmed order, or changes its shipping address. Added items are sold at the current price of the product and the stock follows the edit. When the order is already paid, the difference of its total is charged with an additional payment, confirmed with the returned client secret, or partially refunded. Every edit is recorded in the audit log of the customer.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Param			edit	body		models.EditOrderRequest	true	"Order Changes"
+//	@Success		200		{object}	models.OrderEditResult	"Order edited successfully"
+//	@Failure		400		{object}	response.ErrorResponse	"Validation error or order already shipped"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse	"Order or product not found"
+//	@Failure		409		{object}	response.ErrorResponse	"INSUFFICIENT_STOCK, the meta field holds the product and the stock left"
+//	@Failure		422		{object}	response.ErrorResponse	"Shipping restricted, see the error code"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error or payment provider failure"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/{id} [patch]
+func (h *OrderEditHandler) EditOrder() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized order edit attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.EditOrderRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to edit order", slog.Int("added", len(req.AddItems)), slog.Int("removed", len(req.RemoveItemIDs)), slog.Bool("addressChanged", req.ShippingAddress != nil))
+
+		result, err := h.orderEditService.EditOrder(r.Context(), claims.UserID, id, &req)
+		if err != nil {
+			logger.Error("Failed to edit order", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order edited successfully", slog.Int64("delta", result.Delta.Amount), slog.String("settlement", string(result.Settlement)))
+		response.Success(w, http.StatusOK, result)
+	}
+}
@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditOrder(t *testing.T) {
+	// Arrange
+	mockOrderEditService := mocks.NewMockOrderEditService(t)
+	orderEditHandler := handlers.NewOrderEditHandler(mockOrderEditService)
+	adminID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.EditOrderRequest{
+			AddItems:      []models.EditOrderItem{{ProductID: uuid.New(), Quantity: 1}},
+			RemoveItemIDs: []uuid.UUID{uuid.New()},
+		}
+		mockOrderEditService.On("EditOrder", mock.Anything, adminID, orderID, &reqBody).
+			Return(&models.OrderEditResult{
+				Order:      &models.Order{ID: orderID},
+				Delta:      models.Money{Amount: -500, Currency: models.CatalogCurrency},
+				Settlement: models.OrderSettlementRefund,
+				PaymentID:  "re_diff",
+			}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/orders/"+orderID.String(), bytes.NewBuffer(reqBodyBytes), adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderEditHandler.EditOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid address", func(t *testing.T) {
+		// Arrange
+		reqBody := models.EditOrderRequest{ShippingAddress: &models.Address{Street: "2 New St", City: "Hamburg"}}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/orders/"+orderID.String(), bytes.NewBuffer(reqBodyBytes), adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderEditHandler.EditOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Order already shipped", func(t *testing.T) {
+		// Arrange
+		reqBody := models.EditOrderRequest{RemoveItemIDs: []uuid.UUID{uuid.New()}}
+		mockOrderEditService.On("EditOrder", mock.Anything, adminID, orderID, &reqBody).
+			Return(nil, appErrors.BadRequestError("Only orders that have not shipped can be edited")).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/orders/"+orderID.String(), bytes.NewBuffer(reqBodyBytes), adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderEditHandler.EditOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type OrderPaymentHandler struct {
+	orderPaymentService service.OrderPaymentService
+	validator           *validator.Validate
+}
+
+func NewOrderPaymentHandler(orderPaymentService service.OrderPaymentService) *OrderPaymentHandler {
+	return &OrderPaymentHandler{orderPaymentService: orderPaymentService, validator: validator.New()}
+}
+
+// RetryPayment godoc
+//
+//	@Summary		Retry the failed payment of an order
+//	@Description	Creates a fresh payment intent for the total of a pending order whose payment failed, confirmed with the returned client secret. The failed payment intent is cancelled. Once the retry limit is reached, further retries are locked out for a while.
+//	@Tags			Orders
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Order ID (UUID)"	Format(uuid)
+//	@Param			payment	body		models.RetryPaymentRequest	true	"Payment Method"
+//	@Success		200		{object}	models.RetryPaymentResponse	"Payment retried successfully"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error, order not pending or payment not failed"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		402		{object}	response.ErrorResponse		"PAYMENT_DECLINED, the meta field holds the decline code"
+//	@Failure		403		{object}	response.ErrorResponse		"Order of another customer"
+//	@Failure		404		{object}	response.ErrorResponse		"Order not found"
+//	@Failure		429		{object}	response.ErrorResponse		"Too many payment retries, locked out for a while, or ORDER_VELOCITY_EXCEEDED when the card paid for too many orders recently"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error or payment provider failure"
+//	@Security		BearerAuth
+//	@Router			/orders/{id}/retry-payment [post]
+func (h *OrderPaymentHandler) RetryPayment() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized payment retry attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.RetryPaymentRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		result, err := h.orderPaymentService.RetryPayment(r.Context(), claims.UserID, id, &req)
+		if err != nil {
+			logger.Warn("Failed to retry order payment", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order payment retried successfully", slog.String("paymentId", result.Payment.ID), slog.Int("retriesLeft", result.RetriesLeft))
+
+		result.Payment = paymentView(r.Context(), result.Payment)
+		response.Success(w, http.StatusOK, result)
+	}
+}
@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPayment(t *testing.T) {
+	// Arrange
+	mockOrderPaymentService := mocks.NewMockOrderPaymentService(t)
+	orderPaymentHandler := handlers.NewOrderPaymentHandler(mockOrderPaymentService)
+	customerID := uuid.New()
+	orderID := uuid.New()
+	reqBody := models.RetryPaymentRequest{PaymentMethod: "card", Token: "pm_new"}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockOrderPaymentService.On("RetryPayment", mock.Anything, customerID, orderID, &reqBody).
+			Return(&models.RetryPaymentResponse{
+				OrderID:      orderID,
+				Payment:      &models.Payment{ID: "pi_retry"},
+				ClientSecret: "pi_retry_secret",
+				RetriesLeft:  2,
+			}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/orders/"+orderID.String()+"/retry-payment", bytes.NewBuffer(reqBodyBytes), customerID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderPaymentHandler.RetryPayment().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "pi_retry_secret")
+	})
+
+	t.Run("Failure - Missing token", func(t *testing.T) {
+		// Arrange
+		reqBodyBytes, err := json.Marshal(models.RetryPaymentRequest{PaymentMethod: "card"})
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/orders/"+orderID.String()+"/retry-payment", bytes.NewBuffer(reqBodyBytes), customerID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderPaymentHandler.RetryPayment().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Locked out", func(t *testing.T) {
+		// Arrange
+		mockOrderPaymentService.On("RetryPayment", mock.Anything, customerID, orderID, &reqBody).
+			Return(nil, appErrors.TooManyRequestsError("Too many payment retries")).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/orders/"+orderID.String()+"/retry-payment", bytes.NewBuffer(reqBodyBytes), customerID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderPaymentHandler.RetryPayment().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	})
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type OrderRefundHandler struct {
+	orderRefundService service.OrderRefundService
+	validator          *validator.Validate
+}
+
+func NewOrderRefundHandler(orderRefundService service.OrderRefundService) *OrderRefundHandler {
+	return &OrderRefundHandler{orderRefundService: orderRefundService, validator: validator.New()}
+}
+
+// RefundOrder godoc
+//
+//	@Summary		Refund order items (Admin)
+//	@Description	Refunds units of the items of a paid order. Every unit gives back its unit price plus its share of the charges of the order beyond its items, like the gift wrap, in proportion to its value, and the refund that leaves no unit to refund gives back what the rounding kept. The refunded units are tracked on the items, the refund is recorded in the ledger line by line and in the audit log of the customer, and a credit note is issued for it.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Order ID (UUID)"	Format(uuid)
+//	@Param			refund	body		models.RefundOrderRequest	true	"Items and quantities to refund"
+//	@Success		201		{object}	models.OrderRefund			"Order refunded"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error, order not paid or units already refunded"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse		"Order not found"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error or payment provider failure"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/{id}/refunds [post]
+func (h *OrderRefundHandler) RefundOrder() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized order refund attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.RefundOrderRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		refund, err := h.orderRefundService.RefundOrder(r.Context(), claims.UserID, id, &req)
+		if err != nil {
+			logger.Error("Failed to refund order", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order refunded successfully", slog.String("refundId", refund.ID.String()), slog.Int64("amount", refund.Amount))
+		response.Success(w, http.StatusCreated, refund)
+	}
+}
+
+// ListOrderRefunds godoc
+//
+//	@Summary		List order refunds (Admin)
+//	@Description	Lists the refunds of an order with their lines, oldest first, including the ones the payment provider refused.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Success		200	{array}		models.OrderRefund		"Order refunds"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid order ID"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Order not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/{id}/refunds [get]
+func (h *OrderRefundHandler) ListOrderRefunds() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		refunds, err := h.orderRefundService.ListOrderRefunds(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to list order refunds", slog.String("orderId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, refunds)
+	}
+}
@@ -0,0 +1,120 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefundOrder(t *testing.T) {
+	// Arrange
+	mockOrderRefundService := mocks.NewMockOrderRefundService(t)
+	orderRefundHandler := handlers.NewOrderRefundHandler(mockOrderRefundService)
+	adminID := uuid.New()
+	orderID := uuid.New()
+	path := "/admin/orders/" + orderID.String() + "/refunds"
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: uuid.New(), Quantity: 1}}, Reason: "Chipped"}
+		mockOrderRefundService.On("RefundOrder", mock.Anything, adminID, orderID, &reqBody).
+			Return(&models.OrderRefund{ID: uuid.New(), OrderID: orderID, Status: models.OrderRefundSucceeded, Amount: 1112}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, path, bytes.NewBuffer(reqBodyBytes), adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderRefundHandler.RefundOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - No lines", func(t *testing.T) {
+		// Arrange
+		reqBodyBytes, err := json.Marshal(models.RefundOrderRequest{})
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, path, bytes.NewBuffer(reqBodyBytes), adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderRefundHandler.RefundOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Units already refunded", func(t *testing.T) {
+		// Arrange
+		reqBody := models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: uuid.New(), Quantity: 3}}}
+		mockOrderRefundService.On("RefundOrder", mock.Anything, adminID, orderID, &reqBody).
+			Return(nil, appErrors.BadRequestError("Items already refunded")).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, path, bytes.NewBuffer(reqBodyBytes), adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderRefundHandler.RefundOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestListOrderRefunds(t *testing.T) {
+	// Arrange
+	mockOrderRefundService := mocks.NewMockOrderRefundService(t)
+	orderRefundHandler := handlers.NewOrderRefundHandler(mockOrderRefundService)
+	adminID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockOrderRefundService.On("ListOrderRefunds", mock.Anything, orderID).
+			Return([]models.OrderRefund{{ID: uuid.New(), OrderID: orderID, Amount: 1112}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/orders/"+orderID.String()+"/refunds", nil, adminID, map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderRefundHandler.ListOrderRefunds().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Order not found", func(t *testing.T) {
+		// Arrange
+		missingID := uuid.New()
+		mockOrderRefundService.On("ListOrderRefunds", mock.Anything, missingID).
+			Return(nil, appErrors.NotFoundError("Order not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/orders/"+missingID.String()+"/refunds", nil, adminID, map[string]string{"id": missingID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderRefundHandler.ListOrderRefunds().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
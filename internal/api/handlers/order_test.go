@@ -2,19 +2,24 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -31,7 +36,7 @@ func TestCreateOrder(t *testing.T) {
 		// Arrange
 		createReq := models.CreateOrderRequest{
 			CustomerID: userID,
-			ShippingAddress: models.Address{
+			ShippingAddress: &models.Address{
 				Street:     "123 Test Street",
 				City:       "Test City",
 				State:      "TS",
@@ -109,10 +114,65 @@ func TestCreateOrder(t *testing.T) {
 		mockOrderService.AssertExpectations(t)
 	})
 
+	t.Run("Failure - Device fingerprint without an ID", func(t *testing.T) {
+		// Arrange
+		createReq := models.CreateOrderRequest{
+			CustomerID:      userID,
+			ShippingAddress: &models.Address{Street: "123 Test Street", City: "Test City", State: "TS", PostalCode: "12345", Country: "US"},
+			Items:           []models.OrderItem{{ProductID: uuid.New(), Quantity: 1, UnitPrice: 50.0}},
+			Device:          &models.DeviceFingerprint{Components: map[string]any{"timezone": "Europe/Berlin"}},
+		}
+
+		bodyBytes, err := json.Marshal(createReq)
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/orders", bytes.NewReader(bodyBytes), userID, nil)
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.CreateOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Insufficient stock", func(t *testing.T) {
+		// Arrange
+		productID := uuid.New()
+		createReq := models.CreateOrderRequest{
+			CustomerID:      userID,
+			ShippingAddress: &models.Address{Street: "123 Test Street", City: "Test City", State: "TS", PostalCode: "12345", Country: "US"},
+			Items:           []models.OrderItem{{ProductID: productID, Quantity: 4, UnitPrice: 50.0}},
+		}
+
+		mockOrderService.On("CreateOrder", mock.Anything, mock.AnythingOfType("*models.CreateOrderRequest")).
+			Return(nil, appErrors.InsufficientStockError(productID, 3)).Once()
+
+		bodyBytes, err := json.Marshal(createReq)
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/orders", bytes.NewReader(bodyBytes), userID, nil)
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.CreateOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+
+		var resp response.APIResponse
+		err = json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.Equal(t, appErrors.ErrCodeInsufficientStock, resp.Error.Code)
+		assert.Equal(t, map[string]any{"product_id": productID.String(), "available": 3.0}, resp.Error.Meta)
+	})
+
 	t.Run("Failure - Unauthorized", func(t *testing.T) {
 		// Arrange
 		createReq := models.CreateOrderRequest{
-			ShippingAddress: models.Address{
+			ShippingAddress: &models.Address{
 				Street:     "123 Test Street",
 				City:       "Test City",
 				State:      "TS",
@@ -165,7 +225,7 @@ func TestCreateOrder(t *testing.T) {
 		// Arrange
 		createReq := models.CreateOrderRequest{
 			CustomerID: uuid.New(),
-			ShippingAddress: models.Address{
+			ShippingAddress: &models.Address{
 				Street:     "123 Test Street",
 				City:       "Test City",
 				State:      "TS",
@@ -250,6 +310,26 @@ func TestGetOrder(t *testing.T) {
 		mockOrderService.AssertExpectations(t)
 	})
 
+	t.Run("Success - Payment intent redacted by role", func(t *testing.T) {
+		for role, visible := range map[models.UserRole]bool{models.RoleCustomer: false, models.RoleStaff: true, models.RoleAdmin: true} {
+			// Arrange
+			order := &models.Order{ID: orderID, CustomerID: userID, Status: models.OrderStatusConfirmed, PaymentIntentID: "pi_123"}
+			mockOrderService.On("GetOrderByID", mock.Anything, orderID).Return(order, nil).Once()
+
+			req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/orders/%s", orderID), nil, userID, map[string]string{"id": orderID.String()})
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, &models.Claims{UserID: userID, Role: role}))
+			rr := httptest.NewRecorder()
+
+			// Act
+			orderHandler.GetOrder().ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, visible, strings.Contains(rr.Body.String(), "pi_123"), string(role))
+			assert.Equal(t, "pi_123", order.PaymentIntentID, "the order of the service is left as is")
+		}
+	})
+
 	t.Run("Failure - Unauthorized", func(t *testing.T) {
 		// Arrange
 		req := testutils.CreateTestRequestWithoutContext(http.MethodGet, fmt.Sprintf("/orders/%s", orderID), nil, nil)
@@ -723,3 +803,460 @@ func TestUpdateOrderStatus(t *testing.T) {
 		mockOrderService.AssertExpectations(t)
 	})
 }
+
+func TestBulkUpdateOrderStatus(t *testing.T) {
+	mockOrderService := mocks.NewMockOrderService(t)
+	orderHandler := handlers.NewOrderHandler(mockOrderService)
+	adminID := uuid.New()
+	orderIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.Run("Success - Results per order", func(t *testing.T) {
+		// Arrange
+		reqBody := models.BulkUpdateOrderStatusRequest{OrderIDs: orderIDs, Status: models.OrderStatusShipping}
+		expected := &models.BulkUpdateOrderStatusResponse{
+			Status:    models.OrderStatusShipping,
+			Succeeded: 1,
+			Failed:    1,
+			Results: []models.BulkOrderStatusResult{
+				{OrderID: orderIDs[0], Success: true},
+				{OrderID: orderIDs[1], Code: appErrors.ErrCodeNotFound, Message: "Order not found"},
+			},
+		}
+		mockOrderService.On("BulkUpdateOrderStatus", mock.Anything, &reqBody).Return(expected, nil).Once()
+
+		bodyBytes, err := json.Marshal(reqBody)
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/orders/status-bulk", bytes.NewReader(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.BulkUpdateOrderStatus().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp struct {
+			Data models.BulkUpdateOrderStatusResponse `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, *expected, resp.Data)
+	})
+
+	t.Run("Failure - Duplicate order IDs", func(t *testing.T) {
+		// Arrange
+		reqBody := models.BulkUpdateOrderStatusRequest{OrderIDs: []uuid.UUID{orderIDs[0], orderIDs[0]}, Status: models.OrderStatusShipping}
+
+		bodyBytes, err := json.Marshal(reqBody)
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/orders/status-bulk", bytes.NewReader(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.BulkUpdateOrderStatus().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandleCarrierEvent(t *testing.T) {
+	mockOrderService := mocks.NewMockOrderService(t)
+	orderHandler := handlers.NewOrderHandler(mockOrderService)
+	now := time.Now()
+	verifier := webhookauth.NewVerifier("carrier", []string{"secret"}, webhookauth.DefaultTolerance, nil, clock.NewFake(now))
+	handler := middleware.VerifyWebhook(verifier, orderHandler.HandleCarrierEvent())
+	orderID := uuid.New()
+
+	newRequest := func(t *testing.T, event models.CarrierEvent, signature string) *http.Request {
+		t.Helper()
+
+		bodyBytes, err := json.Marshal(event)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/carrier", bytes.NewReader(bodyBytes))
+		req.Header.Set(webhookauth.SignatureHeader, signature)
+
+		return req
+	}
+
+	sign := func(t *testing.T, event models.CarrierEvent) string {
+		t.Helper()
+
+		bodyBytes, err := json.Marshal(event)
+		assert.NoError(t, err)
+
+		return webhookauth.Sign("secret", bodyBytes, now)
+	}
+
+	t.Run("Success - Order moved to the reported status", func(t *testing.T) {
+		// Arrange
+		event := models.CarrierEvent{OrderID: orderID, Status: models.OrderStatusDelivered}
+		mockOrderService.On("UpdateOrderStatus", mock.Anything, orderID, models.OrderStatusDelivered).
+			Return(&models.Order{ID: orderID, Status: models.OrderStatusDelivered}, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, newRequest(t, event, sign(t, event)))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Signed for another body", func(t *testing.T) {
+		// Arrange
+		event := models.CarrierEvent{OrderID: orderID, Status: models.OrderStatusDelivered}
+		signature := sign(t, models.CarrierEvent{OrderID: orderID, Status: models.OrderStatusShipping})
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, newRequest(t, event, signature))
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Failure - Status a carrier cannot report", func(t *testing.T) {
+		// Arrange
+		event := models.CarrierEvent{OrderID: orderID, Status: models.OrderStatusCancelled}
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, newRequest(t, event, sign(t, event)))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Transition refused", func(t *testing.T) {
+		// Arrange
+		event := models.CarrierEvent{OrderID: orderID, Status: models.OrderStatusShipping}
+		mockOrderService.On("UpdateOrderStatus", mock.Anything, orderID, models.OrderStatusShipping).
+			Return(nil, appErrors.InvalidTransitionError("Invalid status transition")).Once()
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, newRequest(t, event, sign(t, event)))
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestGetPackingSlip(t *testing.T) {
+	mockOrderService := mocks.NewMockOrderService(t)
+	orderHandler := handlers.NewOrderHandler(mockOrderService)
+	orderID := uuid.New()
+	pathParams := map[string]string{"id": orderID.String()}
+
+	t.Run("Success - Gift order without prices", func(t *testing.T) {
+		// Arrange
+		slip := &models.PackingSlip{
+			OrderID:     orderID,
+			GiftWrap:    true,
+			GiftMessage: "Happy birthday!",
+			Items:       []models.PackingSlipItem{{ProductID: uuid.New(), Quantity: 1, IsGift: true}},
+		}
+		mockOrderService.On("GetPackingSlip", mock.Anything, orderID).Return(slip, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/admin/orders/%s/packing-slip", orderID), nil, uuid.New(), pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetPackingSlip().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Happy birthday!")
+		assert.NotContains(t, rr.Body.String(), "unit_price")
+		assert.NotContains(t, rr.Body.String(), "total_amount")
+	})
+
+	t.Run("Failure - Order not found", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("GetPackingSlip", mock.Anything, orderID).Return(nil, appErrors.NotFoundError("Order not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/admin/orders/%s/packing-slip", orderID), nil, uuid.New(), pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetPackingSlip().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Success - Printable page with barcodes and pick locations", func(t *testing.T) {
+		// Arrange
+		unitPrice := 12.5
+		slip := &models.PackingSlip{
+			OrderID:     orderID,
+			GiftMessage: "<b>Happy birthday!</b>",
+			Items: []models.PackingSlipItem{{
+				SKU:       "MUG-001",
+				Name:      "Mug",
+				Quantity:  2,
+				UnitPrice: &unitPrice,
+				Picks:     []models.PackingSlipPick{{WarehouseID: uuid.New(), WarehouseName: "East", Quantity: 2}},
+			}},
+		}
+		mockOrderService.On("GetPackingSlip", mock.Anything, orderID).Return(slip, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/admin/orders/%s/packing-slip?format=html", orderID), nil, uuid.New(), pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetPackingSlip().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+
+		body := rr.Body.String()
+		assert.Equal(t, 2, strings.Count(body, "<svg"), "a barcode for the order and one for the item")
+		assert.Contains(t, body, "East: 2")
+		assert.Contains(t, body, "12.50")
+		assert.Contains(t, body, "&lt;b&gt;Happy birthday!&lt;/b&gt;", "the gift message is escaped")
+	})
+}
+
+func TestGetPackingSlips(t *testing.T) {
+	mockOrderService := mocks.NewMockOrderService(t)
+	orderHandler := handlers.NewOrderHandler(mockOrderService)
+	adminID := uuid.New()
+	orderIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.Run("Success - A page per order of the wave", func(t *testing.T) {
+		// Arrange
+		slips := []*models.PackingSlip{{OrderID: orderIDs[0]}, {OrderID: orderIDs[1]}}
+		mockOrderService.On("GetPackingSlips", mock.Anything, orderIDs).Return(slips, nil).Once()
+
+		bodyBytes, err := json.Marshal(models.PackingSlipsRequest{OrderIDs: orderIDs})
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/orders/packing-slips?format=html", bytes.NewReader(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetPackingSlips().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, 2, strings.Count(rr.Body.String(), `<section class="slip">`))
+	})
+
+	t.Run("Success - JSON by default", func(t *testing.T) {
+		// Arrange
+		slips := []*models.PackingSlip{{OrderID: orderIDs[0]}, {OrderID: orderIDs[1]}}
+		mockOrderService.On("GetPackingSlips", mock.Anything, orderIDs).Return(slips, nil).Once()
+
+		bodyBytes, err := json.Marshal(models.PackingSlipsRequest{OrderIDs: orderIDs})
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/orders/packing-slips", bytes.NewReader(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetPackingSlips().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"slips"`)
+	})
+
+	t.Run("Failure - Duplicate orders", func(t *testing.T) {
+		// Arrange
+		bodyBytes, err := json.Marshal(models.PackingSlipsRequest{OrderIDs: []uuid.UUID{orderIDs[0], orderIDs[0]}})
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/orders/packing-slips", bytes.NewReader(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetPackingSlips().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestExportOrders(t *testing.T) {
+	mockOrderService := mocks.NewMockOrderService(t)
+	orderHandler := handlers.NewOrderHandler(mockOrderService)
+	userID := uuid.New()
+	orderID := uuid.MustParse("0195c1b2-8e00-7000-8000-000000000001")
+	productID := uuid.MustParse("0195c1b2-8e00-7000-8000-000000000002")
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("ExportOrders", mock.Anything, userID, from, to, mock.Anything).
+			Return(func(_ context.Context, _ uuid.UUID, _, _ time.Time, fn func(*models.Order) error) error {
+				return fn(&models.Order{
+					ID:              orderID,
+					CustomerID:      userID,
+					Status:          models.OrderStatusPending,
+					PaymentStatus:   models.PaymentStatusPending,
+					FulfillmentType: models.FulfillmentShipping,
+					TotalAmount:     25,
+					CreatedAt:       from,
+					Items: []models.OrderItem{
+						{ProductID: productID, Quantity: 2, UnitPrice: 10},
+						{ProductID: productID, Quantity: 1, UnitPrice: 5, IsGift: true},
+					},
+				})
+			}).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/users/me/orders/export?from=2025-01-01&to=2025-02-01", nil, userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.ExportOrders().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "order_id,created_at,status,payment_status,fulfillment_type,total_amount,product_id,quantity,unit_price,is_gift\n"+
+			orderID.String()+",2025-01-01T00:00:00Z,pending,pending,shipping,25.00,"+productID.String()+",2,10.00,false\n"+
+			orderID.String()+",2025-01-01T00:00:00Z,pending,pending,shipping,25.00,"+productID.String()+",1,5.00,true\n", rr.Body.String())
+	})
+
+	t.Run("Success - Full history by default", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("ExportOrders", mock.Anything, userID, time.Time{}, mock.AnythingOfType("time.Time"), mock.Anything).Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/users/me/orders/export", nil, userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.ExportOrders().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "order_id,created_at,status,payment_status,fulfillment_type,total_amount,product_id,quantity,unit_price,is_gift\n", rr.Body.String())
+	})
+
+	t.Run("Failure - Query error before the first row", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("ExportOrders", mock.Anything, userID, from, to, mock.Anything).Return(appErrors.DatabaseError("Failed to export orders")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/users/me/orders/export?from=2025-01-01&to=2025-02-01", nil, userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.ExportOrders().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Failure - Invalid date", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/users/me/orders/export?from=01-01-2025", nil, userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.ExportOrders().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Unauthorized", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/users/me/orders/export", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.ExportOrders().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestGetOrderTimeline(t *testing.T) {
+	mockOrderService := mocks.NewMockOrderService(t)
+	orderHandler := handlers.NewOrderHandler(mockOrderService)
+	orderID := uuid.New()
+	customerID := uuid.New()
+	pathParams := map[string]string{"id": orderID.String()}
+	timeline := func() *models.OrderTimeline {
+		return &models.OrderTimeline{
+			OrderID:    orderID,
+			CustomerID: customerID,
+			Status:     models.OrderStatusShipping,
+			Events: []models.OrderTimelineEvent{
+				{Type: models.OrderTimelinePlaced, ReferenceID: orderID.String()},
+				{Type: models.OrderTimelinePayment, ReferenceID: "pi_123", Status: "succeeded"},
+				{Type: models.OrderTimelineFraudReviewed, Status: "approved", Summary: "Called the customer", Internal: true},
+				{Type: models.OrderTimelineStatusChanged, Status: string(models.OrderStatusShipping)},
+			},
+		}
+	}
+
+	t.Run("Success - Customer sees the redacted timeline of their order", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("GetOrderTimeline", mock.Anything, orderID).Return(timeline(), nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/orders/%s/timeline", orderID), nil, customerID, pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetOrderTimeline().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		body := rr.Body.String()
+		assert.Contains(t, body, `"type":"status_changed"`)
+		assert.NotContains(t, body, "pi_123")
+		assert.NotContains(t, body, "Called the customer")
+	})
+
+	t.Run("Success - Staff sees every event of any order", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("GetOrderTimeline", mock.Anything, orderID).Return(timeline(), nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/orders/%s/timeline", orderID), nil, uuid.New(), pathParams)
+		req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, &models.Claims{UserID: uuid.New(), Role: models.RoleStaff}))
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetOrderTimeline().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "pi_123")
+		assert.Contains(t, rr.Body.String(), "Called the customer")
+	})
+
+	t.Run("Failure - Another customer's order", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("GetOrderTimeline", mock.Anything, orderID).Return(timeline(), nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/orders/%s/timeline", orderID), nil, uuid.New(), pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetOrderTimeline().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Failure - Order not found", func(t *testing.T) {
+		// Arrange
+		mockOrderService.On("GetOrderTimeline", mock.Anything, orderID).Return(nil, appErrors.NotFoundError("Order not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, fmt.Sprintf("/orders/%s/timeline", orderID), nil, customerID, pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		orderHandler.GetOrderTimeline().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type PayloadLogHandler struct {
+	payloadLogger *middleware.PayloadLogger
+	validator     *validator.Validate
+}
+
+func NewPayloadLogHandler(payloadLogger *middleware.PayloadLogger) *PayloadLogHandler {
+	return &PayloadLogHandler{payloadLogger: payloadLogger, validator: validator.New()}
+}
+
+// GetPayloadLogRules godoc
+//
+//	@Summary		Get the payload logging rules
+//	@Description	Returns the routes and users whose redacted request and response bodies the instance serving the request logs, nothing when it logs none. Requires admin role.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.PayloadLogRules	"Payload logging rules in force"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden"
+//	@Security		BearerAuth
+//	@Router			/admin/payload-logging [get]
+func (h *PayloadLogHandler) GetPayloadLogRules() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		rules := h.payloadLogger.Rules()
+		if rules == nil {
+			rules = &models.PayloadLogRules{}
+		}
+
+		response.Success(w, http.StatusOK, rules)
+	}
+}
+
+// UpdatePayloadLogRules godoc
+//
+//	@Summary		Log the payloads of routes or users
+//	@Description	Logs the request and response bodies of the requests under the route prefixes or made by the users for the given minutes, to reproduce an issue a customer reported. Passwords, tokens, secrets and card data are redacted, large bodies are logged by their size only. The rules replace those before and only reach the instance serving the request. Requires admin role.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			rules	body		models.UpdatePayloadLogRulesRequest	true	"Routes and users to log the payloads of"
+//	@Success		200		{object}	models.PayloadLogRules				"Payload logging rules in force"
+//	@Failure		400		{object}	response.ErrorResponse				"Validation error"
+//	@Failure		401		{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse				"Forbidden"
+//	@Security		BearerAuth
+//	@Router			/admin/payload-logging [put]
+func (h *PayloadLogHandler) UpdatePayloadLogRules() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.UpdatePayloadLogRulesRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		if len(req.Routes) == 0 && len(req.UserIDs) == 0 {
+			response.Error(w, errors.BadRequestError("Name the routes or the users to log the payloads of"))
+
+			return
+		}
+
+		expiresAt := time.Now().Add(time.Duration(req.Minutes) * time.Minute)
+		rules := &models.PayloadLogRules{Routes: req.Routes, UserIDs: req.UserIDs, ExpiresAt: &expiresAt}
+
+		h.payloadLogger.SetRules(rules)
+
+		logger.Warn("Payload logging enabled", slog.Any("routes", rules.Routes), slog.Any("userIds", rules.UserIDs), slog.Time("expiresAt", expiresAt))
+		response.Success(w, http.StatusOK, rules)
+	}
+}
+
+// DeletePayloadLogRules godoc
+//
+//	@Summary		Stop logging payloads
+//	@Description	Stops logging request and response bodies on the instance serving the request, including for the routes and users of the configuration until it restarts. Requires admin role.
+//	@Tags			Admin
+//	@Success		204	"Payload logging stopped"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden"
+//	@Security		BearerAuth
+//	@Router			/admin/payload-logging [delete]
+func (h *PayloadLogHandler) DeletePayloadLogRules() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.payloadLogger.SetRules(nil)
+
+		middleware.LoggerFromContext(r.Context()).Warn("Payload logging stopped")
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
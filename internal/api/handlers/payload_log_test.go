@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadLogRules(t *testing.T) {
+	// Arrange
+	payloadLogger, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{MaxBytes: 1024})
+	require.NoError(t, err)
+
+	payloadLogHandler := handlers.NewPayloadLogHandler(payloadLogger)
+	adminID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("Success - User logged for a while", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"user_ids":["` + userID.String() + `"],"minutes":30}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/payload-logging", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		payloadLogHandler.UpdatePayloadLogRules().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		rules := payloadLogger.Rules()
+		require.NotNil(t, rules)
+		assert.Equal(t, []uuid.UUID{userID}, rules.UserIDs)
+		assert.NotNil(t, rules.ExpiresAt)
+	})
+
+	t.Run("Failure - Nothing to log", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"minutes":30}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/payload-logging", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		payloadLogHandler.UpdatePayloadLogRules().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Route outside the API", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"routes":["/metrics"],"minutes":30}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/payload-logging", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		payloadLogHandler.UpdatePayloadLogRules().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Success - Stopped", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/admin/payload-logging", nil, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		payloadLogHandler.DeletePayloadLogRules().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Nil(t, payloadLogger.Rules())
+	})
+}
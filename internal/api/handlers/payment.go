@@ -1,13 +1,16 @@
 package handlers
 
 import (
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
@@ -17,11 +20,12 @@ import (
 
 type PaymentHandler struct {
 	paymentService service.PaymentService
+	webhookQueue   service.WebhookQueue
 	validator      *validator.Validate
 }
 
-func NewPaymentHandler(paymentService service.PaymentService) *PaymentHandler {
-	return &PaymentHandler{paymentService: paymentService, validator: validator.New()}
+func NewPaymentHandler(paymentService service.PaymentService, webhookQueue service.WebhookQueue) *PaymentHandler {
+	return &PaymentHandler{paymentService: paymentService, webhookQueue: webhookQueue, validator: validator.New()}
 }
 
 // CreatePayment godoc
@@ -35,6 +39,7 @@ func NewPaymentHandler(paymentService service.PaymentService) *PaymentHandler {
 //	@Success		200		{object}	models.PaymentResponse	"Successfully initiated payment, includes client secret"
 //	@Failure		400		{object}	response.ErrorResponse	"Validation error or invalid input"
 //	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		402		{object}	response.ErrorResponse	"PAYMENT_DECLINED, the meta field holds the decline code of the card issuer"
 //	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Attempting to pay for another user's order"
 //	@Failure		404		{object}	response.ErrorResponse	"Order not found or already paid"
 //	@Failure		500		{object}	response.ErrorResponse	"Internal server error or payment provider error"
@@ -85,10 +90,72 @@ func (h *PaymentHandler) CreatePayment() http.HandlerFunc {
 		logger.Info("Payment initiated successfully",
 			slog.String("paymentIntentId", payment.ClientSecret),
 			slog.String("paymentDBId", payment.Payment.ID))
+
+		payment.Payment = paymentView(r.Context(), payment.Payment)
 		response.Success(w, http.StatusOK, payment)
 	}
 }
 
+// CreateCheckoutSession godoc
+//
+//	@Summary		Start a hosted checkout
+//	@Description	Creates a Stripe Checkout Session as an alternative to payment intents and returns the URL of the hosted payment page. The payment is settled through the checkout.session.completed webhook. Requires authentication.
+//	@Tags			Payments
+//	@Accept			json
+//	@Produce		json
+//	@Param			checkout	body		models.CheckoutSessionRequest	true	"Checkout Session Details (Customer ID, Amount, Currency, Description)"
+//	@Success		200			{object}	models.CheckoutSessionResponse	"Successfully created checkout session, includes the hosted page URL"
+//	@Failure		400			{object}	response.ErrorResponse			"Validation error or invalid input"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Attempting to pay for another customer"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error or payment provider error"
+//	@Security		BearerAuth
+//	@Router			/payments/checkout-session [post]
+func (h *PaymentHandler) CreateCheckoutSession() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized checkout session creation attempt: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.CheckoutSessionRequest
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			logger.Warn("Invalid checkout session input")
+
+			return
+		}
+
+		logger = logger.With(slog.String("customerID", req.CustomerID), slog.Int64("amount", req.Amount))
+
+		if req.CustomerID != claims.UserID.String() {
+			logger.Warn("User attempted to create checkout session for another customer ID",
+				slog.String("requesterId", claims.UserID.String()),
+				slog.String("requestedCustomerID", req.CustomerID))
+			response.Error(w, errors.ForbiddenError("You can only make payments for your own orders"))
+
+			return
+		}
+
+		checkout, err := h.paymentService.CreateCheckoutSession(r.Context(), &req)
+		if err != nil {
+			logger.Error("Failed to create checkout session", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Checkout session created successfully", slog.String("sessionId", checkout.SessionID))
+		response.Success(w, http.StatusOK, checkout)
+	}
+}
+
 // GetPayment godoc
 //
 //	@Summary		Get payment details by ID
@@ -138,7 +205,7 @@ func (h *PaymentHandler) GetPayment() http.HandlerFunc {
 		}
 
 		logger.Info("Payment details retrieved successfully")
-		response.Success(w, http.StatusOK, payment)
+		response.Success(w, http.StatusOK, paymentView(r.Context(), payment))
 	}
 }
 
@@ -192,7 +259,7 @@ func (h *PaymentHandler) ListPayments() http.HandlerFunc {
 
 		logger.Info("Payments listed successfully", slog.Int("count", len(payments)), slog.Int("total", total))
 		response.Success(w, http.StatusOK, models.PaginatedResponse{
-			Data:     payments,
+			Data:     paymentsView(r.Context(), payments),
 			Total:    total,
 			Page:     page,
 			PageSize: pageSize,
@@ -200,27 +267,141 @@ func (h *PaymentHandler) ListPayments() http.HandlerFunc {
 	}
 }
 
+// GetSalesReport godoc
+//
+//	@Summary		Get sales report (Admin)
+//	@Description	Totals the succeeded payments per currency over a date range, with gross, Stripe fees and net revenue. Defaults to the last 30 days.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{object}	models.SalesReport		"Successfully retrieved sales report"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/reports/sales [get]
+func (h *PaymentHandler) GetSalesReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parseReportRange(r)
+		if err != nil {
+			logger.Warn("Invalid sales report range", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.Time("from", from), slog.Time("to", to))
+
+		report, err := h.paymentService.GetSalesReport(r.Context(), from, to)
+		if err != nil {
+			logger.Error("Failed to build sales report", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Sales report retrieved successfully", slog.Int("currencies", len(report.Summaries)))
+		response.Success(w, http.StatusOK, report)
+	}
+}
+
+// ExportPayments godoc
+//
+//	@Summary		Export payments as CSV (Admin)
+//	@Description	Streams every payment created over a date range as CSV, oldest first. Defaults to the last 30 days. Rows are written as they are read, so the export is not held in memory.
+//	@Tags			Admin
+//	@Produce		text/csv
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{string}	string					"CSV export of the payments"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/reports/payments/export [get]
+func (h *PaymentHandler) ExportPayments() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parseReportRange(r)
+		if err != nil {
+			logger.Warn("Invalid payments export range", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.Time("from", from), slog.Time("to", to))
+
+		export := newCSVExport(w, fmt.Sprintf("payments_%s_%s.csv", from.Format(time.DateOnly), to.Format(time.DateOnly)),
+			[]string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "status", "payment_method", "created_at"})
+
+		err = h.paymentService.ExportPayments(r.Context(), from, to, func(p models.Payment) error {
+			return export.Write([]string{
+				p.ID,
+				p.CustomerID,
+				strconv.FormatInt(p.Amount, 10),
+				formatOptionalAmount(p.FeeAmount),
+				formatOptionalAmount(p.NetAmount),
+				p.Currency,
+				string(p.Status),
+				p.PaymentMethod,
+				p.CreatedAt.UTC().Format(time.RFC3339),
+			})
+		})
+		if err != nil && !export.Started() {
+			logger.Error("Failed to export payments", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		if err != nil {
+			// The response is already under way, all that is left is to cut it short
+			logger.Error("Payments export aborted", slog.Any("error", err), slog.Int("rows", export.Rows()))
+
+			return
+		}
+
+		if err := export.Close(); err != nil {
+			logger.Error("Failed to write payments export", slog.Any("error", err))
+
+			return
+		}
+
+		logger.Info("Payments exported successfully", slog.Int("rows", export.Rows()))
+	}
+}
+
+// maxStripeWebhookSize bounds the body of a Stripe webhook delivery, a single event object well under a
+// megabyte.
+const maxStripeWebhookSize = 1 << 20
+
 // HandleStripeWebhook godoc
 //
 //	@Summary		Handle incoming Stripe webhooks
-//	@Description	Receives and processes webhook events from Stripe (e.g., payment success, failure) to update internal payment and order statuses. This endpoint should not require application-level authentication but relies on Stripe's signature verification.
+//	@Description	Receives webhook events from Stripe (e.g., payment success, failure). The signature is verified right away, the event is then stored and processed asynchronously to update internal payment and order statuses. A stored event is delivered again until it is processed, or moved to the dead letters once it kept failing. This endpoint does not require application-level authentication but relies on Stripe's signature verification.
 //	@Tags			Payments (Internal)
 //	@Accept			json
 //	@Produce		json
 //	@Param			Stripe-Signature	header		string					true				"Stripe webhook signature for verification"
 //	@Param			payload				body		object					true				"Raw Stripe event payload (JSON)"
-//	@Success		200					{object}	map[string]bool			`{"success": true}`	"Webhook received and processed successfully"
-//	@Failure		400					{object}	response.ErrorResponse	"Bad request (e.g., missing signature, failed reading body, invalid payload)"
+//	@Success		202					{object}	map[string]bool			`{"success": true}`	"Webhook verified and stored for processing"
+//	@Failure		400					{object}	response.ErrorResponse	"Bad request (e.g., missing signature, body over 1MB, invalid payload)"
 //	@Failure		401					{object}	response.ErrorResponse	"Webhook signature verification failed"
-//	@Failure		500					{object}	response.ErrorResponse	"Internal server error during webhook processing"
+//	@Failure		500					{object}	response.ErrorResponse	"Failed to store the event, Stripe retries the delivery later"
 //	@Router			/payments/webhook [post]
 func (h *PaymentHandler) HandleStripeWebhook() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := middleware.LoggerFromContext(r.Context())
 
-		// read the payload/body
-
-		payload, err := io.ReadAll(r.Body)
+		// the body is read before its signature can be checked, the route being public it is bounded
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxStripeWebhookSize))
 		if err != nil {
 			logger.Error("Error reading webhook body", slog.Any("error", err))
 			response.Error(w, errors.BadRequestError("Failed to read request body"))
@@ -236,17 +417,167 @@ func (h *PaymentHandler) HandleStripeWebhook() http.HandlerFunc {
 			return
 		}
 
-		// Call the service
-		event, err := h.paymentService.ProcessWebhook(r.Context(), payload, signature)
+		event, err := h.paymentService.VerifyWebhook(payload, signature)
 		if err != nil {
-			logger.Error("Failed to process payment webhook", slog.Any("error", err))
+			logger.Error("Failed to verify payment webhook", slog.Any("error", err))
 			response.Error(w, err)
 
 			return
 		}
 
 		logger = logger.With(slog.String("stripeEventId", event.ID), slog.Any("stripeEventType", event.Type))
-		logger.Info("Payment webhook processed successfully")
+
+		// Processing happens on the webhook workers, so that Stripe deliveries don't compete with user
+		// traffic. The event is acknowledged once it is stored, Stripe doesn't deliver it again after that.
+		if err := h.webhookQueue.Enqueue(r.Context(), event, payload); err != nil {
+			logger.Error("Failed to queue payment webhook", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Payment webhook queued")
+		response.Success(w, http.StatusAccepted, map[string]bool{"success": true})
+	}
+}
+
+// ListWebhookDeadLetters godoc
+//
+//	@Summary		List quarantined webhook events (Admin)
+//	@Description	Retrieves a paginated list of Stripe events that failed payload validation or kept failing to be processed, newest first. Only the unresolved ones are returned unless status is "all".
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status		query		string														false	"Filter by review status (default: unresolved)"		Enums(unresolved, all)
+//	@Param			page		query		int															false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int															false	"Number of items per page (default: 10, max: 100)"	minimum(1)	maximum(100)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.WebhookDeadLetter}	"Successfully retrieved list of quarantined events"
+//	@Failure		400			{object}	response.ErrorResponse										"Invalid status filter"
+//	@Failure		401			{object}	response.ErrorResponse										"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse										"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse										"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/webhooks/dead-letters [get]
+func (h *PaymentHandler) ListWebhookDeadLetters() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		unresolvedOnly := true
+
+		switch status := r.URL.Query().Get("status"); status {
+		case "", "unresolved":
+		case "all":
+			unresolvedOnly = false
+		default:
+			logger.Warn("Invalid dead letter status filter", slog.String("status", status))
+			response.Error(w, errors.BadRequestError("Invalid 'status', expected 'unresolved' or 'all'"))
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		logger = logger.With(slog.Bool("unresolvedOnly", unresolvedOnly), slog.Int("page", page), slog.Int("pageSize", pageSize))
+
+		deadLetters, total, err := h.paymentService.ListWebhookDeadLetters(r.Context(), unresolvedOnly, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list webhook dead letters", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Webhook dead letters listed successfully", slog.Int("count", len(deadLetters)), slog.Int("total", total))
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     deadLetters,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// ResolveWebhookDeadLetter godoc
+//
+//	@Summary		Resolve a quarantined webhook event (Admin)
+//	@Description	Marks a quarantined Stripe event as reviewed, so it no longer shows up in the unresolved list.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true				"Dead letter ID"	Format(uuid)
+//	@Success		200	{object}	map[string]bool			`{"success": true}`	"Event marked as resolved"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Dead letter not found or already resolved"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/webhooks/dead-letters/{id}/resolve [post]
+func (h *PaymentHandler) ResolveWebhookDeadLetter() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid dead letter ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("deadLetterId", id.String()))
+
+		if err := h.paymentService.ResolveWebhookDeadLetter(r.Context(), id); err != nil {
+			logger.Error("Failed to resolve webhook dead letter", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Webhook dead letter resolved")
 		response.Success(w, http.StatusOK, map[string]bool{"success": true})
 	}
 }
+
+// parseReportRange reads the from/to query dates of the admin reports, by default the last 30 days.
+// Days start at midnight in the timezone of the request.
+func parseReportRange(r *http.Request) (time.Time, time.Time, error) {
+	loc := locale.FromContext(r.Context())
+	to := loc.StartOfDay(time.Now()).AddDate(0, 0, 1)
+
+	return parseDateRange(r, to.AddDate(0, 0, -30), to, loc.Location)
+}
+
+// parseDateRange reads the optional from (inclusive) and to (exclusive) query dates, as YYYY-MM-DD
+// starting at midnight in location.
+func parseDateRange(r *http.Request, from, to time.Time, location *time.Location) (time.Time, time.Time, error) {
+	if v := r.URL.Query().Get("from"); v != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, v, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.BadRequestError("Invalid 'from' date, expected YYYY-MM-DD")
+		}
+
+		from = parsed
+	}
+
+	if v := r.URL.Query().Get("to"); v != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, v, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.BadRequestError("Invalid 'to' date, expected YYYY-MM-DD")
+		}
+
+		to = parsed
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errors.BadRequestError("'from' must be before 'to'")
+	}
+
+	return from, to, nil
+}
@@ -2,15 +2,19 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
@@ -18,12 +22,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v81"
 )
 
 func TestCreatePayment(t *testing.T) {
 	mockPaymentService := mocks.NewMockPaymentService(t)
-	paymentHandler := handlers.NewPaymentHandler(mockPaymentService)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
 	testUserID := uuid.New()
 
 	t.Run("Success", func(t *testing.T) {
@@ -224,9 +229,79 @@ func TestCreatePayment(t *testing.T) {
 	})
 }
 
+func TestCreateCheckoutSession(t *testing.T) {
+	mockPaymentService := mocks.NewMockPaymentService(t)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CheckoutSessionRequest{
+			CustomerID:  testUserID.String(),
+			Amount:      2500,
+			Currency:    "usd",
+			Description: "Order #42",
+		}
+		expectedResp := &models.CheckoutSessionResponse{
+			Payment:   &models.Payment{ID: "cs_123", CustomerID: testUserID.String(), Amount: 2500, Status: models.PaymentStatusPending},
+			SessionID: "cs_123",
+			URL:       "https://checkout.stripe.com/c/pay/cs_123",
+		}
+		mockPaymentService.On("CreateCheckoutSession", mock.Anything, &reqBody).Return(expectedResp, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/payments/checkout-session", bytes.NewReader(reqBodyBytes), testUserID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.CreateCheckoutSession().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp *response.APIResponse
+		err = json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+
+		dataBytes, err := json.Marshal(resp.Data)
+		assert.NoError(t, err)
+
+		var respCheckout models.CheckoutSessionResponse
+		err = json.Unmarshal(dataBytes, &respCheckout)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResp.URL, respCheckout.URL)
+		assert.Equal(t, expectedResp.SessionID, respCheckout.SessionID)
+
+		mockPaymentService.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Forbidden (Different Customer)", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CheckoutSessionRequest{
+			CustomerID:  uuid.New().String(),
+			Amount:      2500,
+			Currency:    "usd",
+			Description: "Order #42",
+		}
+		reqBodyBytes, err := json.Marshal(reqBody)
+		assert.NoError(t, err)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/payments/checkout-session", bytes.NewReader(reqBodyBytes), testUserID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.CreateCheckoutSession().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		mockPaymentService.AssertNotCalled(t, "CreateCheckoutSession")
+	})
+}
+
 func TestGetPayment(t *testing.T) {
 	mockPaymentService := mocks.NewMockPaymentService(t)
-	paymentHandler := handlers.NewPaymentHandler(mockPaymentService)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
 	testUserID := uuid.New()
 	paymentID := uuid.New().String()
 
@@ -277,6 +352,31 @@ func TestGetPayment(t *testing.T) {
 		mockPaymentService.AssertExpectations(t)
 	})
 
+	t.Run("Success - Stripe ID redacted for customers only", func(t *testing.T) {
+		clientID := uuid.New()
+
+		for name, claims := range map[string]*models.Claims{
+			"customer":       {UserID: testUserID, Role: models.RoleCustomer},
+			"impersonation":  {UserID: testUserID, Role: models.RoleAdmin, Impersonation: &models.Impersonation{ActorID: uuid.New()}},
+			"machine client": {UserID: testUserID, ClientID: &clientID},
+		} {
+			// Arrange
+			payment := &models.Payment{ID: paymentID, CustomerID: testUserID.String(), Status: "succeeded", StripeID: "pi_123"}
+			mockPaymentService.On("GetPaymentByID", mock.Anything, paymentID).Return(payment, nil).Once()
+
+			req := testutils.CreateTestRequestWithContext(http.MethodGet, "/payments/"+paymentID, nil, testUserID, map[string]string{"id": paymentID})
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, claims))
+			rr := httptest.NewRecorder()
+
+			// Act
+			paymentHandler.GetPayment().ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, claims.ClientID != nil, strings.Contains(rr.Body.String(), `"stripe_id":"pi_123"`), name)
+		}
+	})
+
 	t.Run("Failure - Unauthorized", func(t *testing.T) {
 		// Arrange
 		req := testutils.CreateTestRequestWithoutContext(http.MethodGet, "/payments/"+paymentID, nil, nil)
@@ -349,7 +449,7 @@ func TestGetPayment(t *testing.T) {
 
 func TestListPayments(t *testing.T) {
 	mockPaymentService := mocks.NewMockPaymentService(t)
-	paymentHandler := handlers.NewPaymentHandler(mockPaymentService)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
 	testUserID := uuid.New()
 
 	t.Run("Success - Default Pagination", func(t *testing.T) {
@@ -542,7 +642,8 @@ func TestListPayments(t *testing.T) {
 
 func TestHandleStripeWebhook(t *testing.T) {
 	mockPaymentService := mocks.NewMockPaymentService(t)
-	paymentHandler := handlers.NewPaymentHandler(mockPaymentService)
+	mockWebhookQueue := mocks.NewMockWebhookQueue(t)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mockWebhookQueue)
 
 	t.Run("Success", func(t *testing.T) {
 		// Arrange
@@ -553,7 +654,8 @@ func TestHandleStripeWebhook(t *testing.T) {
 			Type: "payment_intent.succeeded",
 		}
 
-		mockPaymentService.On("ProcessWebhook", mock.Anything, payload, signature).Return(expectedEvent, nil).Once()
+		mockPaymentService.On("VerifyWebhook", payload, signature).Return(expectedEvent, nil).Once()
+		mockWebhookQueue.On("Enqueue", mock.Anything, expectedEvent, payload).Return(nil).Once()
 
 		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/payments/webhook", bytes.NewReader(payload), nil)
 		req.Header.Set("Stripe-Signature", signature)
@@ -566,7 +668,7 @@ func TestHandleStripeWebhook(t *testing.T) {
 		handler.ServeHTTP(rr, req)
 
 		// Assert
-		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
 
 		var resp *response.APIResponse
 		err := json.Unmarshal(rr.Body.Bytes(), &resp)
@@ -575,6 +677,7 @@ func TestHandleStripeWebhook(t *testing.T) {
 		assert.NotEmpty(t, resp.Data)
 
 		mockPaymentService.AssertExpectations(t)
+		mockWebhookQueue.AssertExpectations(t)
 	})
 
 	t.Run("Failure - Missing Signature", func(t *testing.T) {
@@ -593,7 +696,22 @@ func TestHandleStripeWebhook(t *testing.T) {
 		// Assert
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 		assert.Contains(t, rr.Body.String(), appErrors.ErrCodeBadRequest)
-		mockPaymentService.AssertNotCalled(t, "ProcessWebhook")
+		mockPaymentService.AssertNotCalled(t, "VerifyWebhook")
+	})
+
+	t.Run("Failure - Body too large", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/payments/webhook", bytes.NewReader(bytes.Repeat([]byte(" "), 1<<20+1)), nil)
+		req.Header.Set("Stripe-Signature", "t=123,v1=abc,v0=def")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.HandleStripeWebhook().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockPaymentService.AssertNotCalled(t, "VerifyWebhook")
 	})
 
 	t.Run("Failure - Service Error (Signature Verification)", func(t *testing.T) {
@@ -601,7 +719,7 @@ func TestHandleStripeWebhook(t *testing.T) {
 		payload := []byte(`{"id": "evt_123", "type": "payment_intent.succeeded"}`)
 		signature := "t=123,v1=invalid,v0=def"
 
-		mockPaymentService.On("ProcessWebhook", mock.Anything, payload, signature).Return(stripe.Event{}, appErrors.UnauthorizedError("invalid webhook signature")).Once()
+		mockPaymentService.On("VerifyWebhook", payload, signature).Return(stripe.Event{}, appErrors.UnauthorizedError("invalid webhook signature")).Once()
 
 		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/payments/webhook", bytes.NewReader(payload), nil)
 		req.Header.Set("Stripe-Signature", signature)
@@ -619,7 +737,7 @@ func TestHandleStripeWebhook(t *testing.T) {
 		mockPaymentService.AssertExpectations(t)
 	})
 
-	t.Run("Failure - Service Error (Processing)", func(t *testing.T) {
+	t.Run("Failure - Event Not Stored", func(t *testing.T) {
 		// Arrange
 		payload := []byte(`{"id": "evt_123", "type": "payment_intent.failed"}`)
 		signature := "t=123,v1=abc,v0=def"
@@ -628,7 +746,8 @@ func TestHandleStripeWebhook(t *testing.T) {
 			Type: "payment_intent.failed",
 		}
 
-		mockPaymentService.On("ProcessWebhook", mock.Anything, payload, signature).Return(expectedEvent, appErrors.InternalError("failed to update order status")).Once()
+		mockPaymentService.On("VerifyWebhook", payload, signature).Return(expectedEvent, nil).Once()
+		mockWebhookQueue.On("Enqueue", mock.Anything, expectedEvent, payload).Return(appErrors.DatabaseError("Failed to store webhook event")).Once()
 
 		req := testutils.CreateTestRequestWithoutContext(http.MethodPost, "/payments/webhook", bytes.NewReader(payload), nil)
 		req.Header.Set("Stripe-Signature", signature)
@@ -642,7 +761,293 @@ func TestHandleStripeWebhook(t *testing.T) {
 
 		// Assert
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		assert.Contains(t, rr.Body.String(), appErrors.ErrCodeInternal)
+		assert.Contains(t, rr.Body.String(), appErrors.ErrCodeDatabaseError)
 		mockPaymentService.AssertExpectations(t)
+		mockWebhookQueue.AssertExpectations(t)
+	})
+}
+
+func TestGetSalesReport(t *testing.T) {
+	mockPaymentService := mocks.NewMockPaymentService(t)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
+
+	t.Run("Success - Explicit range", func(t *testing.T) {
+		// Arrange
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		report := &models.SalesReport{
+			From:      from,
+			To:        to,
+			Summaries: []models.SalesSummary{{Currency: "usd", PaymentCount: 2, GrossAmount: 5000, FeeAmount: 175, NetAmount: 4825}},
+		}
+		mockPaymentService.On("GetSalesReport", mock.Anything, from, to).Return(report, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/sales?from=2025-01-01&to=2025-02-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.GetSalesReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp response.APIResponse
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+	})
+
+	t.Run("Success - Days of the request timezone", func(t *testing.T) {
+		// Arrange
+		newYork, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		from := time.Date(2025, 1, 1, 5, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 2, 1, 5, 0, 0, 0, time.UTC)
+		mockPaymentService.On("GetSalesReport", mock.Anything,
+			mock.MatchedBy(func(t time.Time) bool { return t.Equal(from) }),
+			mock.MatchedBy(func(t time.Time) bool { return t.Equal(to) })).
+			Return(&models.SalesReport{Summaries: []models.SalesSummary{}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/sales?from=2025-01-01&to=2025-02-01", nil, uuid.New(), nil)
+		req = req.WithContext(locale.WithRequested(req.Context(), locale.Locale{Location: newYork}, func() locale.Locale { return locale.Default }))
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.GetSalesReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Success - Default range", func(t *testing.T) {
+		// Arrange
+		mockPaymentService.On("GetSalesReport", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(&models.SalesReport{Summaries: []models.SalesSummary{}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/sales", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.GetSalesReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid date", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/sales?from=yesterday", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.GetSalesReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Empty range", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/sales?from=2025-02-01&to=2025-01-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.GetSalesReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Service Error", func(t *testing.T) {
+		// Arrange
+		mockPaymentService.On("GetSalesReport", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, appErrors.DatabaseError("Failed to fetch sales summary")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/sales", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.GetSalesReport().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestListWebhookDeadLetters(t *testing.T) {
+	mockPaymentService := mocks.NewMockPaymentService(t)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
+
+	t.Run("Success - Unresolved by default", func(t *testing.T) {
+		// Arrange
+		deadLetters := []*models.WebhookDeadLetter{{ID: uuid.New(), EventID: "evt_123", EventType: "charge.refunded", Reason: "invalid payload"}}
+		mockPaymentService.On("ListWebhookDeadLetters", mock.Anything, true, 1, 10).Return(deadLetters, 1, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/webhooks/dead-letters", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ListWebhookDeadLetters().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp response.APIResponse
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+	})
+
+	t.Run("Success - All", func(t *testing.T) {
+		// Arrange
+		mockPaymentService.On("ListWebhookDeadLetters", mock.Anything, false, 2, 5).Return([]*models.WebhookDeadLetter{}, 0, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/webhooks/dead-letters?status=all&page=2&pageSize=5", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ListWebhookDeadLetters().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid status", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/webhooks/dead-letters?status=pending", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ListWebhookDeadLetters().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestResolveWebhookDeadLetter(t *testing.T) {
+	mockPaymentService := mocks.NewMockPaymentService(t)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mockPaymentService.On("ResolveWebhookDeadLetter", mock.Anything, id).Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/webhooks/dead-letters/"+id.String()+"/resolve", nil, uuid.New(), nil)
+		req.SetPathValue("id", id.String())
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ResolveWebhookDeadLetter().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mockPaymentService.On("ResolveWebhookDeadLetter", mock.Anything, id).Return(appErrors.NotFoundError("Dead letter not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/webhooks/dead-letters/"+id.String()+"/resolve", nil, uuid.New(), nil)
+		req.SetPathValue("id", id.String())
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ResolveWebhookDeadLetter().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Failure - Invalid ID", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/webhooks/dead-letters/abc/resolve", nil, uuid.New(), nil)
+		req.SetPathValue("id", "abc")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ResolveWebhookDeadLetter().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestExportPayments(t *testing.T) {
+	mockPaymentService := mocks.NewMockPaymentService(t)
+	paymentHandler := handlers.NewPaymentHandler(mockPaymentService, mocks.NewMockWebhookQueue(t))
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		fee := int64(59)
+		mockPaymentService.On("ExportPayments", mock.Anything, from, to, mock.Anything).
+			Return(func(_ context.Context, _, _ time.Time, fn func(models.Payment) error) error {
+				if err := fn(models.Payment{ID: "pi_1", CustomerID: "cus_1", Amount: 2000, FeeAmount: &fee, Currency: "usd", Status: models.PaymentStatusSucceeded, PaymentMethod: "card", CreatedAt: from}); err != nil {
+					return err
+				}
+
+				return fn(models.Payment{ID: "pi_2", CustomerID: "cus_2", Amount: 500, Currency: "usd", Status: models.PaymentStatusPending, PaymentMethod: "card", CreatedAt: from})
+			}).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/payments/export?from=2025-01-01&to=2025-02-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ExportPayments().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "id,customer_id,amount,fee_amount,net_amount,currency,status,payment_method,created_at\n"+
+			"pi_1,cus_1,2000,59,,usd,succeeded,card,2025-01-01T00:00:00Z\n"+
+			"pi_2,cus_2,500,,,usd,pending,card,2025-01-01T00:00:00Z\n", rr.Body.String())
+	})
+
+	t.Run("Success - No payments", func(t *testing.T) {
+		// Arrange
+		mockPaymentService.On("ExportPayments", mock.Anything, from, to, mock.Anything).Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/payments/export?from=2025-01-01&to=2025-02-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ExportPayments().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "id,customer_id,amount,fee_amount,net_amount,currency,status,payment_method,created_at\n", rr.Body.String())
+	})
+
+	t.Run("Failure - Query error before the first row", func(t *testing.T) {
+		// Arrange
+		mockPaymentService.On("ExportPayments", mock.Anything, from, to, mock.Anything).Return(appErrors.DatabaseError("Failed to export payments")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/payments/export?from=2025-01-01&to=2025-02-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ExportPayments().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"success":false`)
+	})
+
+	t.Run("Failure - Invalid range", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/reports/payments/export?from=2025-02-01&to=2025-01-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		paymentHandler.ExportPayments().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
 	})
 }
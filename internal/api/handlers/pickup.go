@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type PickupHandler struct {
+	pickupService service.PickupService
+	validator     *validator.Validate
+}
+
+func NewPickupHandler(pickupService service.PickupService) *PickupHandler {
+	return &PickupHandler{pickupService: pickupService, validator: validator.New()}
+}
+
+// CreateLocation godoc
+//
+//	@Summary		Create a pickup location (Admin)
+//	@Description	Adds a store where customers can collect their orders.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			location	body		models.CreatePickupLocationRequest	true	"Pickup Location Details"
+//	@Success		201			{object}	models.PickupLocation				"Successfully created pickup location"
+//	@Failure		400			{object}	response.ErrorResponse				"Validation error or invalid input"
+//	@Failure		401			{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/pickup-locations [post]
+func (h *PickupHandler) CreateLocation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.CreatePickupLocationRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to create pickup location", slog.String("name", req.Name))
+
+		location, err := h.pickupService.CreateLocation(r.Context(), &req)
+		if err != nil {
+			logger.Error("Failed to create pickup location", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Pickup location created successfully", slog.String("pickupLocationId", location.ID.String()))
+		response.Success(w, http.StatusCreated, location)
+	}
+}
+
+// UpdateLocation godoc
+//
+//	@Summary		Update a pickup location (Admin)
+//	@Description	Updates the name or address of a pickup location, or (de)activates it. Inactive locations cannot be chosen at checkout.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string								true	"Pickup Location ID (UUID)"	Format(uuid)
+//	@Param			location	body		models.UpdatePickupLocationRequest	true	"Fields to update"
+//	@Success		200			{object}	models.PickupLocation				"Successfully updated pickup location"
+//	@Failure		400			{object}	response.ErrorResponse				"Validation error or invalid input"
+//	@Failure		401			{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse				"Pickup location not found"
+//	@Failure		500			{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/pickup-locations/{id} [patch]
+func (h *PickupHandler) UpdateLocation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid pickup location ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("pickupLocationId", id.String()))
+
+		var req models.UpdatePickupLocationRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		location, err := h.pickupService.UpdateLocation(r.Context(), id, &req)
+		if err != nil {
+			logger.Error("Failed to update pickup location", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Pickup location updated successfully")
+		response.Success(w, http.StatusOK, location)
+	}
+}
+
+// ListAllLocations godoc
+//
+//	@Summary		List all pickup locations (Admin)
+//	@Description	Retrieves every pickup location, including the inactive ones.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{array}		models.PickupLocation	"Successfully retrieved pickup locations"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/pickup-locations [get]
+func (h *PickupHandler) ListAllLocations() http.HandlerFunc {
+	return h.listLocations(false)
+}
+
+// ListLocations godoc
+//
+//	@Summary		List pickup locations
+//	@Description	Retrieves the stores that can be chosen for collecting an order.
+//	@Tags			Orders
+//	@Produce		json
+//	@Success		200	{array}		models.PickupLocation	"Successfully retrieved pickup locations"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/pickup-locations [get]
+func (h *PickupHandler) ListLocations() http.HandlerFunc {
+	return h.listLocations(true)
+}
+
+func (h *PickupHandler) listLocations(activeOnly bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		locations, err := h.pickupService.ListLocations(r.Context(), activeOnly)
+		if err != nil {
+			logger.Error("Failed to list pickup locations", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Pickup locations listed successfully", slog.Int("count", len(locations)))
+		response.Success(w, http.StatusOK, locations)
+	}
+}
+
+// MarkReady godoc
+//
+//	@Summary		Mark a pickup order as ready (Admin)
+//	@Description	Issues a pickup code for the order and emails it to the customer. Marking an order ready again replaces its code.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.OrderPickup		"Order marked as ready for pickup"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid order ID or not a pickup order"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Order not found"
+//	@Failure		409	{object}	response.ErrorResponse	"FRAUD_REVIEW_PENDING, the order is held for a fraud review"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/{id}/pickup-ready [post]
+func (h *PickupHandler) MarkReady() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()))
+
+		pickup, err := h.pickupService.MarkReady(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to mark order as ready for pickup", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order marked as ready for pickup")
+		response.Success(w, http.StatusOK, pickup)
+	}
+}
+
+// VerifyPickup godoc
+//
+//	@Summary		Verify a pickup code (Staff)
+//	@Description	Checks the code shown by the customer at the store and marks the order as delivered.
+//	@Tags			Orders
+//	@Accept			json
+//	@Produce		json
+//	@Param			pickup	body		models.VerifyPickupRequest	true	"Order and pickup code"
+//	@Success		200		{object}	models.Order				"Order handed over"
+//	@Failure		400		{object}	response.ErrorResponse		"Invalid code or order not ready"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse		"Pickup not found"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/pickups/verify [post]
+func (h *PickupHandler) VerifyPickup() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.VerifyPickupRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", req.OrderID.String()))
+
+		order, err := h.pickupService.VerifyPickup(r.Context(), &req)
+		if err != nil {
+			logger.Warn("Pickup verification failed", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order picked up")
+		response.Success(w, http.StatusOK, order)
+	}
+}
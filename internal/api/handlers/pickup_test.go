@@ -0,0 +1,131 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPickupLocations(t *testing.T) {
+	// Arrange
+	mockPickupService := mocks.NewMockPickupService(t)
+	pickupHandler := handlers.NewPickupHandler(mockPickupService)
+
+	t.Run("Customers only see active locations", func(t *testing.T) {
+		// Arrange
+		mockPickupService.On("ListLocations", mock.Anything, true).Return([]*models.PickupLocation{{ID: uuid.New(), Active: true}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/pickup-locations", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		pickupHandler.ListLocations().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Admins see every location", func(t *testing.T) {
+		// Arrange
+		mockPickupService.On("ListLocations", mock.Anything, false).Return([]*models.PickupLocation{}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/pickup-locations", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		pickupHandler.ListAllLocations().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestMarkPickupReady(t *testing.T) {
+	// Arrange
+	mockPickupService := mocks.NewMockPickupService(t)
+	pickupHandler := handlers.NewPickupHandler(mockPickupService)
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockPickupService.On("MarkReady", mock.Anything, orderID).Return(&models.OrderPickup{OrderID: orderID, Code: "042137"}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/orders/"+orderID.String()+"/pickup-ready", nil, uuid.New(), map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		pickupHandler.MarkReady().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "042137")
+	})
+
+	t.Run("Failure - Not a pickup order", func(t *testing.T) {
+		// Arrange
+		mockPickupService.On("MarkReady", mock.Anything, orderID).Return(nil, appErrors.BadRequestError("Order is not a pickup order")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/orders/"+orderID.String()+"/pickup-ready", nil, uuid.New(), map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		pickupHandler.MarkReady().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestVerifyPickup(t *testing.T) {
+	// Arrange
+	mockPickupService := mocks.NewMockPickupService(t)
+	pickupHandler := handlers.NewPickupHandler(mockPickupService)
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.VerifyPickupRequest{OrderID: orderID, Code: "042137"}
+		mockPickupService.On("VerifyPickup", mock.Anything, &reqBody).Return(&models.Order{ID: orderID, Status: models.OrderStatusDelivered}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/pickups/verify", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		pickupHandler.VerifyPickup().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Malformed code", func(t *testing.T) {
+		// Arrange
+		reqBody := models.VerifyPickupRequest{OrderID: orderID, Code: "12ab"}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/pickups/verify", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		pickupHandler.VerifyPickup().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
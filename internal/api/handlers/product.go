@@ -1,16 +1,19 @@
 package handlers
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"strconv"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	models "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 )
 
 type ProductHandler struct {
@@ -25,7 +28,7 @@ func NewProductHandler(productService service.ProductService) *ProductHandler {
 // CreateProduct godoc
 //
 //	@Summary		Create a new product
-//	@Description	Adds a new product to the catalog. Requires authentication.
+//	@Description	Adds a new product to the catalog, active unless created as a draft or scheduled to go live at publish_at. Requires authentication.
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
@@ -60,7 +63,7 @@ func (h *ProductHandler) CreateProduct() http.HandlerFunc {
 		}
 
 		logger.Info("Product created successfully", slog.String("productId", product.ID.String()))
-		response.Success(w, http.StatusCreated, product)
+		response.Success(w, http.StatusCreated, productView(r.Context(), product))
 	}
 }
 
@@ -70,12 +73,14 @@ func (h *ProductHandler) CreateProduct() http.HandlerFunc {
 //	@Description	Retrieves details for a specific product using its ID. Requires authentication.
 //	@Tags			Products
 //	@Produce		json
-//	@Param			id	path		string					true	"Product ID (UUID)"	Format(uuid)
-//	@Success		200	{object}	models.Product			"Successfully retrieved product"
-//	@Failure		400	{object}	response.ErrorResponse	"Invalid product ID format"
-//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
-//	@Failure		404	{object}	response.ErrorResponse	"Product not found"
-//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Param			id			path		string					true	"Product ID (UUID)"										Format(uuid)
+//	@Param			Api-Version	header		string					false	"Response variant, 2 returns prices as money objects"	Enums(1, 2)
+//	@Success		200			{object}	models.Product			"Successfully retrieved product"
+//	@Failure		400			{object}	response.ErrorResponse	"Invalid product ID format"
+//	@Failure		401			{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		404			{object}	response.ErrorResponse	"Product not found"
+//	@Failure		429			{object}	response.ErrorResponse	"IN_WAITING_ROOM during a high-demand drop, the meta field holds the place in line and the ticket to send back in the X-Waiting-Room-Token header"
+//	@Failure		500			{object}	response.ErrorResponse	"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/products/{id} [get]
 func (h *ProductHandler) GetProduct() http.HandlerFunc {
@@ -102,14 +107,52 @@ func (h *ProductHandler) GetProduct() http.HandlerFunc {
 		}
 
 		logger.Info("Product retrieved successfully")
-		response.Success(w, http.StatusOK, product)
+		response.Success(w, http.StatusOK, productView(r.Context(), product))
+	}
+}
+
+// GetProductAvailability godoc
+//
+//	@Summary		Get product availability
+//	@Description	Returns only the stock status, estimated dispatch date and price of a product. Served from the cache so product pages can poll it, stock may lag behind by a few seconds.
+//	@Tags			Products
+//	@Produce		json
+//	@Param			id	path		string						true	"Product ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.ProductAvailability	"Successfully retrieved availability"
+//	@Failure		400	{object}	response.ErrorResponse		"Invalid product ID format"
+//	@Failure		401	{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		404	{object}	response.ErrorResponse		"Product not found"
+//	@Failure		500	{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/products/{id}/availability [get]
+func (h *ProductHandler) GetProductAvailability() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		availability, err := h.productService.GetProductAvailability(r.Context(), id)
+		if err != nil {
+			logger.Warn("Failed to get product availability", slog.String("productId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, availability)
 	}
 }
 
 // UpdateProduct godoc
 //
 //	@Summary		Update a product by ID
-//	@Description	Updates details for an existing product using its ID. Requires authentication.
+//	@Description	Updates details for an existing product using its ID. Status changes follow the product lifecycle: drafts and scheduled products can be published, active and inactive products can be toggled, and any of them discontinued for good. Requires authentication.
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
@@ -119,6 +162,7 @@ func (h *ProductHandler) GetProduct() http.HandlerFunc {
 //	@Failure		400		{object}	response.ErrorResponse		"Invalid product ID format or validation error"
 //	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
 //	@Failure		404		{object}	response.ErrorResponse		"Product not found"
+//	@Failure		409		{object}	response.ErrorResponse		"The product cannot move to this status from its current one"
 //	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
 //	@Security		BearerAuth
 //	@Router			/products/{id} [put]
@@ -157,18 +201,263 @@ func (h *ProductHandler) UpdateProduct() http.HandlerFunc {
 		}
 
 		logger.Info("Product updated successfully")
+		response.Success(w, http.StatusOK, productView(r.Context(), product))
+	}
+}
+
+// SetRelatedProducts godoc
+//
+//	@Summary		Set the related products of a product
+//	@Description	Replaces the curated related, upsell and cross-sell products of a product. Requires admin role.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string								true	"Product ID (UUID)"	Format(uuid)
+//	@Param			relations	body		models.SetRelatedProductsRequest	true	"Related products, in display order per type"
+//	@Success		200			{array}		models.RelatedProduct				"Successfully updated related products"
+//	@Failure		400			{object}	response.ErrorResponse				"Invalid product ID, validation error or unknown related product"
+//	@Failure		401			{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse				"Forbidden"
+//	@Failure		404			{object}	response.ErrorResponse				"Product not found"
+//	@Failure		500			{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/{id}/related [put]
+func (h *ProductHandler) SetRelatedProducts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", id.String()))
+
+		var req models.SetRelatedProductsRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			logger.Warn("Invalid related products input")
+
+			return
+		}
+
+		related, err := h.productService.SetRelatedProducts(r.Context(), id, &req)
+		if err != nil {
+			logger.Warn("Failed to set related products", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Related products updated successfully", slog.Int("count", len(related)))
+		response.Success(w, http.StatusOK, relatedProductsView(r.Context(), related))
+	}
+}
+
+// SetPurchaseLimit godoc
+//
+//	@Summary		Set the purchase limits of a product
+//	@Description	Replaces the limits on how many units of a product a customer can buy, per order and per customer over a number of days, like for limited-edition drops. A limit left out is lifted, without a period the per customer limit counts every order. Carts and checkouts over a limit are refused with PURCHASE_LIMIT_EXCEEDED. Requires admin role.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Product ID (UUID)"	Format(uuid)
+//	@Param			limit	body		models.SetPurchaseLimitRequest	true	"Purchase limits"
+//	@Success		200		{object}	models.Product					"Purchase limits saved successfully"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid product ID or validation error"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden"
+//	@Failure		404		{object}	response.ErrorResponse			"Product not found"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/{id}/purchase-limit [put]
+func (h *ProductHandler) SetPurchaseLimit() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", id.String()))
+
+		var req models.SetPurchaseLimitRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			logger.Warn("Invalid purchase limit input")
+
+			return
+		}
+
+		product, err := h.productService.SetPurchaseLimit(r.Context(), id, &req)
+		if err != nil {
+			logger.Error("Failed to set purchase limit", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Purchase limit saved successfully")
 		response.Success(w, http.StatusOK, product)
 	}
 }
 
+// UpdatePrices godoc
+//
+//	@Summary		Bulk update product prices
+//	@Description	Reprices platform products in a single transaction, either by SKU or by a percentage adjustment of the products matching a filter. Each change is recorded in the audit log. Vendor products are left out. Requires admin role.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			prices	body		models.BulkPriceUpdateRequest	true	"Prices by SKU, or an adjustment"
+//	@Success		200		{object}	models.BulkPriceUpdateResult	"Successfully updated prices"
+//	@Failure		400		{object}	response.ErrorResponse			"Validation error, duplicate or unknown SKU"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden"
+//	@Failure		409		{object}	response.ErrorResponse			"A product was repriced concurrently, nothing was updated"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/prices [post]
+func (h *ProductHandler) UpdatePrices() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized price update attempt: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.BulkPriceUpdateRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			logger.Warn("Invalid price update input")
+
+			return
+		}
+
+		result, err := h.productService.UpdatePrices(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Warn("Failed to update prices", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Prices updated successfully", slog.String("batchId", result.BatchID.String()), slog.Int("updated", result.Updated))
+		response.Success(w, http.StatusOK, result)
+	}
+}
+
+// QuickEditProducts godoc
+//
+//	@Summary		Quick edit product prices and stock
+//	@Description	Sets the price, the stock on hand or both of platform products by SKU, in a single transaction. The result reports each row, if one fails nothing is applied. Price changes are recorded in the audit log, stock changes in the stock ledger. Requires admin role.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			edits	body		[]models.ProductQuickEdit		true	"Edits by SKU"
+//	@Success		200		{object}	models.ProductQuickEditResult	"Edits checked, applied unless a row failed"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid body or too many edits"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden"
+//	@Failure		409		{object}	response.ErrorResponse			"A product changed concurrently, nothing was updated"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/quick-edit [patch]
+func (h *ProductHandler) QuickEditProducts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized quick edit attempt: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var edits []models.ProductQuickEdit
+
+		if err := utils.DecodeJSONBody(r, &edits); err != nil {
+			logger.Warn("Invalid quick edit input")
+			response.Error(w, err)
+
+			return
+		}
+
+		result, err := h.productService.QuickEditProducts(r.Context(), claims.UserID, edits)
+		if err != nil {
+			logger.Warn("Failed to quick edit products", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Quick edits processed", slog.String("batchId", result.BatchID.String()), slog.Bool("applied", result.Applied), slog.Int("updated", result.Updated), slog.Int("failed", result.Failed))
+		response.Success(w, http.StatusOK, result)
+	}
+}
+
+// LookupProduct godoc
+//
+//	@Summary		Look up a product by SKU or barcode
+//	@Description	Resolves a scanned SKU or barcode to its product, for POS and warehouse scanners. Exactly one of the two must be given.
+//	@Tags			Products
+//	@Produce		json
+//	@Param			sku		query		string					false	"Product SKU"
+//	@Param			barcode	query		string					false	"Product barcode (GTIN)"
+//	@Success		200		{object}	models.Product			"Successfully found product"
+//	@Failure		400		{object}	response.ErrorResponse	"Neither or both of SKU and barcode given"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		404		{object}	response.ErrorResponse	"Product not found"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/products/lookup [get]
+func (h *ProductHandler) LookupProduct() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		sku := r.URL.Query().Get("sku")
+		barcode := r.URL.Query().Get("barcode")
+
+		logger = logger.With(slog.String("sku", sku), slog.String("barcode", barcode))
+
+		product, err := h.productService.LookupProduct(r.Context(), sku, barcode)
+		if err != nil {
+			logger.Warn("Product lookup failed", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Product looked up successfully", slog.String("productId", product.ID.String()))
+		response.Success(w, http.StatusOK, productView(r.Context(), product))
+	}
+}
+
 // ListProducts godoc
 //
 //	@Summary		List products with pagination
-//	@Description	Retrieves a paginated list of available products. Requires authentication.
+//	@Description	Retrieves a paginated list of the active products, drafts and products that are scheduled, inactive or discontinued are left out. Requires authentication.
 //	@Tags			Products
 //	@Produce		json
-//	@Param			page		query		int												false	"Page number for pagination (default: 1)"			minimum(1)
-//	@Param			pageSize	query		int												false	"Number of items per page (default: 10, max: 100)"	minimum(1)	maximum(100)
+//	@Param			page		query		int												false	"Page number for pagination (default: 1)"				minimum(1)
+//	@Param			pageSize	query		int												false	"Number of items per page (default: 10, max: 100)"		minimum(1)	maximum(100)
+//	@Param			Api-Version	header		string											false	"Response variant, 2 returns prices as money objects"	Enums(1, 2)
 //	@Success		200			{object}	models.PaginatedResponse{Data=[]models.Product}	"Successfully retrieved list of products"
 //	@Failure		401			{object}	response.ErrorResponse							"Authentication required"
 //	@Failure		500			{object}	response.ErrorResponse							"Internal server error"
@@ -200,10 +489,172 @@ func (h *ProductHandler) ListProducts() http.HandlerFunc {
 
 		logger.Info("Products listed successfully", slog.Int("count", len(products)), slog.Int("total", total))
 		response.Success(w, http.StatusOK, models.PaginatedResponse{
-			Data:     products,
+			Data:     productsView(r.Context(), products),
 			Total:    total,
 			Page:     page,
 			PageSize: pageSize,
 		})
 	}
 }
+
+// ListProductChanges godoc
+//
+//	@Summary		Read the product change feed
+//	@Description	Returns the product creates, updates and deletes after the since cursor, in the order they were made, so search indexers and caches can sync incrementally. Pass next_cursor as since to read on. Changes show up a few seconds after they are made. Requires admin role.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			since	query		string						false	"Cursor of the last change read, omitted to start from the first change"
+//	@Param			limit	query		int							false	"Maximum number of changes (default: 100, max: 1000)"	minimum(1)	maximum(1000)
+//	@Success		200		{object}	models.ProductChangeFeed	"Successfully retrieved product changes"
+//	@Failure		400		{object}	response.ErrorResponse		"Invalid cursor"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Forbidden"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/changes [get]
+func (h *ProductHandler) ListProductChanges() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		since := r.URL.Query().Get("since")
+
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil || limit < 1 || limit > 1000 {
+			limit = 100
+		}
+
+		logger = logger.With(slog.String("since", since), slog.Int("limit", limit))
+
+		feed, err := h.productService.ListProductChanges(r.Context(), since, limit)
+		if err != nil {
+			logger.Error("Failed to fetch product changes", slog.Any("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Product changes listed successfully", slog.Int("count", len(feed.Changes)), slog.String("nextCursor", feed.NextCursor))
+		response.Success(w, http.StatusOK, feed)
+	}
+}
+
+// GetProductFacets godoc
+//
+//	@Summary		Get catalog facet counts
+//	@Description	Counts the products per category, price bucket and status for the given filters, to power filter sidebars. Each facet ignores its own filter.
+//	@Tags			Products
+//	@Produce		json
+//	@Param			category_id	query		string					false	"Category ID (UUID)"	Format(uuid)
+//	@Param			min_price	query		number					false	"Minimum price"
+//	@Param			max_price	query		number					false	"Maximum price"
+//	@Param			status		query		string					false	"Product status"	Enums(active, inactive, discontinued)
+//	@Success		200			{object}	models.ProductFacets	"Successfully retrieved facet counts"
+//	@Failure		400			{object}	response.ErrorResponse	"Invalid filter"
+//	@Failure		401			{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		500			{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/products/facets [get]
+func (h *ProductHandler) GetProductFacets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		filter, err := parseProductFilter(r)
+		if err != nil {
+			logger.Warn("Invalid product filter", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		facets, err := h.productService.GetProductFacets(r.Context(), filter)
+		if err != nil {
+			logger.Error("Failed to get product facets", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Product facets retrieved successfully")
+		response.Success(w, http.StatusOK, facets)
+	}
+}
+
+func parseProductFilter(r *http.Request) (*models.ProductFilter, error) {
+	query := r.URL.Query()
+	filter := &models.ProductFilter{}
+
+	if value := query.Get("category_id"); value != "" {
+		categoryID, err := uuid.Parse(value)
+		if err != nil {
+			return nil, errors.BadRequestError("Invalid 'category_id', expected a UUID")
+		}
+
+		filter.CategoryID = &categoryID
+	}
+
+	if value := query.Get("min_price"); value != "" {
+		minPrice, err := strconv.ParseFloat(value, 64)
+		if err != nil || minPrice < 0 {
+			return nil, errors.BadRequestError("Invalid 'min_price'")
+		}
+
+		filter.MinPrice = &minPrice
+	}
+
+	if value := query.Get("max_price"); value != "" {
+		maxPrice, err := strconv.ParseFloat(value, 64)
+		if err != nil || maxPrice < 0 {
+			return nil, errors.BadRequestError("Invalid 'max_price'")
+		}
+
+		filter.MaxPrice = &maxPrice
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, errors.BadRequestError("'min_price' must not be greater than 'max_price'")
+	}
+
+	switch status := query.Get("status"); status {
+	case "", "active", "inactive", "discontinued":
+		filter.Status = status
+	default:
+		return nil, errors.BadRequestError("Invalid 'status'")
+	}
+
+	return filter, nil
+}
+
+// productView picks the serializer of the product for the API version negotiated by the client.
+func productView(ctx context.Context, product *models.Product) any {
+	if middleware.APIVersionFromContext(ctx) == middleware.APIVersion1 {
+		return product
+	}
+
+	return models.NewProductV2(product)
+}
+
+func productsView(ctx context.Context, products []*models.Product) any {
+	if middleware.APIVersionFromContext(ctx) == middleware.APIVersion1 {
+		return products
+	}
+
+	views := make([]*models.ProductV2, len(products))
+	for i, product := range products {
+		views[i] = models.NewProductV2(product)
+	}
+
+	return views
+}
+
+func relatedProductsView(ctx context.Context, related []models.RelatedProduct) any {
+	if middleware.APIVersionFromContext(ctx) == middleware.APIVersion1 {
+		return related
+	}
+
+	views := make([]models.RelatedProductV2, len(related))
+	for i, product := range related {
+		views[i] = models.NewRelatedProductV2(product)
+	}
+
+	return views
+}
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type ProductQuestionHandler struct {
+	questionService service.ProductQuestionService
+	validator       *validator.Validate
+}
+
+func NewProductQuestionHandler(questionService service.ProductQuestionService) *ProductQuestionHandler {
+	return &ProductQuestionHandler{questionService: questionService, validator: validator.New()}
+}
+
+// ListProductQuestions godoc
+//
+//	@Summary		List the questions of a product
+//	@Description	Retrieves a paginated list of the published questions about a product with their answers, the newest first.
+//	@Tags			Products
+//	@Produce		json
+//	@Param			id			path		string													true	"Product ID (UUID)"									Format(uuid)
+//	@Param			page		query		int														false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int														false	"Number of items per page (default: 10, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.ProductQuestion}	"Successfully retrieved product questions"
+//	@Failure		400			{object}	response.ErrorResponse									"Invalid product ID format"
+//	@Failure		401			{object}	response.ErrorResponse									"Authentication required"
+//	@Failure		500			{object}	response.ErrorResponse									"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/products/{id}/questions [get]
+func (h *ProductQuestionHandler) ListProductQuestions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		productID, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		page, pageSize := parseQuestionPage(r, 10)
+
+		questions, total, err := h.questionService.ListProductQuestions(r.Context(), productID, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list product questions", slog.String("productId", productID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     questions,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// AskQuestion godoc
+//
+//	@Summary		Ask a question about a product
+//	@Description	Asks a question about a product for sale. The question is shown on the product page once a moderator published it, the asker is emailed the first answer. Customers and products get a limited number of questions per window, and a customer cannot ask the same question twice.
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"Product ID (UUID)"	Format(uuid)
+//	@Param			question	body		models.AskQuestionRequest	true	"Question"
+//	@Success		201			{object}	models.ProductQuestion		"Question received, pending moderation"
+//	@Failure		400			{object}	response.ErrorResponse		"Invalid product ID format or validation error"
+//	@Failure		401			{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		404			{object}	response.ErrorResponse		"Product not found"
+//	@Failure		409			{object}	response.ErrorResponse		"Product not for sale or question asked already"
+//	@Failure		429			{object}	response.ErrorResponse		"Too many questions"
+//	@Failure		500			{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/products/{id}/questions [post]
+func (h *ProductQuestionHandler) AskQuestion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized product question attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		productID, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", productID.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.AskQuestionRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		question, err := h.questionService.AskQuestion(r.Context(), productID, claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to ask product question", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Product question asked", slog.String("questionId", question.ID.String()))
+		response.Success(w, http.StatusCreated, question)
+	}
+}
+
+// ListQuestions godoc
+//
+//	@Summary		List product questions (Admin)
+//	@Description	Retrieves a paginated list of the questions about all products, optionally only those in a given status. Pending questions wait for moderation. Questions flagged as one of a burst on the product, or as asked by several customers, come first, then the oldest.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status		query		string													false	"Question status"									Enums(pending, published, rejected)
+//	@Param			page		query		int														false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int														false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.ProductQuestion}	"Successfully retrieved product questions"
+//	@Failure		400			{object}	response.ErrorResponse									"Invalid status"
+//	@Failure		401			{object}	response.ErrorResponse									"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse									"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse									"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/questions [get]
+func (h *ProductQuestionHandler) ListQuestions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		status := models.QuestionStatus(r.URL.Query().Get("status"))
+
+		switch status {
+		case "", models.QuestionStatusPending, models.QuestionStatusPublished, models.QuestionStatusRejected:
+		default:
+			response.Error(w, errors.BadRequestError("Invalid question status"))
+
+			return
+		}
+
+		page, pageSize := parseQuestionPage(r, 20)
+
+		questions, total, err := h.questionService.ListQuestions(r.Context(), status, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list product questions", slog.String("status", string(status)), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     questions,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// ModerateQuestion godoc
+//
+//	@Summary		Moderate a product question (Admin)
+//	@Description	Publishes a question on the product page or rejects it. Rejecting a published question takes it and its answer off the page.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"Question ID (UUID)"	Format(uuid)
+//	@Param			decision	body		models.ModerateQuestionRequest	true	"Moderation decision"
+//	@Success		200			{object}	models.ProductQuestion			"Successfully moderated product question"
+//	@Failure		400			{object}	response.ErrorResponse			"Invalid question ID format or validation error"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse			"Question not found"
+//	@Failure		409			{object}	response.ErrorResponse			"Question already in that status"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/questions/{id}/moderate [post]
+func (h *ProductQuestionHandler) ModerateQuestion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized question moderation attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid question ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("questionId", id.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.ModerateQuestionRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		question, err := h.questionService.ModerateQuestion(r.Context(), id, claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to moderate product question", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Product question moderated", slog.String("status", string(question.Status)))
+		response.Success(w, http.StatusOK, question)
+	}
+}
+
+// AnswerQuestion godoc
+//
+//	@Summary		Answer a product question (Admin)
+//	@Description	Answers a published question in the name of the store, replacing an earlier answer. The asker is emailed the first answer.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Question ID (UUID)"	Format(uuid)
+//	@Param			answer	body		models.AnswerQuestionRequest	true	"Answer"
+//	@Success		200		{object}	models.ProductQuestion			"Successfully answered product question"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid question ID format or validation error"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse			"Question not found"
+//	@Failure		409		{object}	response.ErrorResponse			"Question not published"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/questions/{id}/answer [post]
+func (h *ProductQuestionHandler) AnswerQuestion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized question answer attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		h.answer(w, r, claims.UserID, nil)
+	}
+}
+
+// ListVendorQuestions godoc
+//
+//	@Summary		List the questions about the products of the vendor
+//	@Description	Retrieves a paginated list of the published questions about the products of the vendor the token is scoped to, the unanswered first and the oldest first among them.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Param			page		query		int														false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int														false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.ProductQuestion}	"Successfully retrieved vendor questions"
+//	@Failure		401			{object}	response.ErrorResponse									"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse									"Vendor account required"
+//	@Failure		500			{object}	response.ErrorResponse									"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/questions [get]
+func (h *ProductQuestionHandler) ListVendorQuestions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		page, pageSize := parseQuestionPage(r, 20)
+
+		questions, total, err := h.questionService.ListVendorQuestions(r.Context(), vendorID, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list vendor questions", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     questions,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// AnswerVendorQuestion godoc
+//
+//	@Summary		Answer a question about a product of the vendor
+//	@Description	Answers a published question about a product of the vendor the token is scoped to, replacing an earlier answer. The asker is emailed the first answer.
+//	@Tags			Vendors
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Question ID (UUID)"	Format(uuid)
+//	@Param			answer	body		models.AnswerQuestionRequest	true	"Answer"
+//	@Success		200		{object}	models.ProductQuestion			"Successfully answered product question"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid question ID format or validation error"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Vendor account required or product of another seller"
+//	@Failure		404		{object}	response.ErrorResponse			"Question not found"
+//	@Failure		409		{object}	response.ErrorResponse			"Question not published"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/questions/{id}/answer [post]
+func (h *ProductQuestionHandler) AnswerVendorQuestion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		claims, _ := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+
+		h.answer(w, r, claims.UserID, &vendorID)
+	}
+}
+
+// answer answers the question of the path in the name of the store, or of the vendor when vendorID is set.
+func (h *ProductQuestionHandler) answer(w http.ResponseWriter, r *http.Request, userID uuid.UUID, vendorID *uuid.UUID) {
+	logger := middleware.LoggerFromContext(r.Context())
+
+	id, err := utils.ParseID(r, "id")
+	if err != nil {
+		logger.Warn("Invalid question ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+		response.Error(w, err)
+
+		return
+	}
+
+	logger = logger.With(slog.String("questionId", id.String()), slog.String("userID", userID.String()))
+
+	var req models.AnswerQuestionRequest
+
+	if !utils.ParseAndValidate(r, w, &req, h.validator) {
+		return
+	}
+
+	question, err := h.questionService.AnswerQuestion(r.Context(), id, userID, vendorID, &req)
+	if err != nil {
+		logger.Error("Failed to answer product question", slog.String("error", err.Error()))
+		response.Error(w, err)
+
+		return
+	}
+
+	logger.Info("Product question answered", slog.String("answererRole", string(question.AnswererRole)))
+	response.Success(w, http.StatusOK, question)
+}
+
+// parseQuestionPage reads the page and pageSize query parameters, pageSize up to 50.
+func parseQuestionPage(r *http.Request, defaultSize int) (int, int) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if err != nil || pageSize < 1 || pageSize > 50 {
+		pageSize = defaultSize
+	}
+
+	return page, pageSize
+}
@@ -0,0 +1,141 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAskProductQuestion(t *testing.T) {
+	// Arrange
+	mockQuestionService := mocks.NewMockProductQuestionService(t)
+	questionHandler := handlers.NewProductQuestionHandler(mockQuestionService)
+	productID := uuid.New()
+	customerID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockQuestionService.On("AskQuestion", mock.Anything, productID, customerID, &models.AskQuestionRequest{Question: "Is the strap adjustable?"}).
+			Return(&models.ProductQuestion{ID: uuid.New(), ProductID: productID, Status: models.QuestionStatusPending}, nil).Once()
+
+		body := bytes.NewBufferString(`{"question":"Is the strap adjustable?"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/products/"+productID.String()+"/questions", body, customerID, map[string]string{"id": productID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.AskQuestion().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Question too short", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"question":"Size?"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/products/"+productID.String()+"/questions", body, customerID, map[string]string{"id": productID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.AskQuestion().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestListProductQuestionsAdmin(t *testing.T) {
+	// Arrange
+	mockQuestionService := mocks.NewMockProductQuestionService(t)
+	questionHandler := handlers.NewProductQuestionHandler(mockQuestionService)
+
+	t.Run("Success - Pending queue", func(t *testing.T) {
+		// Arrange
+		mockQuestionService.On("ListQuestions", mock.Anything, models.QuestionStatusPending, 1, 20).Return([]*models.ProductQuestion{}, 0, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/questions?status=pending", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.ListQuestions().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Unknown status", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/questions?status=answered", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.ListQuestions().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestAnswerVendorQuestion(t *testing.T) {
+	// Arrange
+	mockQuestionService := mocks.NewMockProductQuestionService(t)
+	questionHandler := handlers.NewProductQuestionHandler(mockQuestionService)
+	questionID := uuid.New()
+	userID := uuid.New()
+	vendorID := uuid.New()
+	answer := &models.AnswerQuestionRequest{Answer: "Yes, from 80 to 140 cm."}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockQuestionService.On("AnswerQuestion", mock.Anything, questionID, userID, &vendorID, answer).
+			Return(&models.ProductQuestion{ID: questionID, Answer: answer.Answer, AnswererRole: models.RoleVendor}, nil).Once()
+
+		body := bytes.NewBufferString(`{"answer":"Yes, from 80 to 140 cm."}`)
+		req := testutils.CreateVendorTestRequest(http.MethodPost, "/vendors/me/questions/"+questionID.String()+"/answer", body, userID, vendorID, map[string]string{"id": questionID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.AnswerVendorQuestion().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Product of another seller", func(t *testing.T) {
+		// Arrange
+		mockQuestionService.On("AnswerQuestion", mock.Anything, questionID, userID, &vendorID, answer).
+			Return(nil, appErrors.ForbiddenError("Question is about a product of another seller")).Once()
+
+		body := bytes.NewBufferString(`{"answer":"Yes, from 80 to 140 cm."}`)
+		req := testutils.CreateVendorTestRequest(http.MethodPost, "/vendors/me/questions/"+questionID.String()+"/answer", body, userID, vendorID, map[string]string{"id": questionID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.AnswerVendorQuestion().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Failure - Not a vendor token", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"answer":"Yes, from 80 to 140 cm."}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/vendors/me/questions/"+questionID.String()+"/answer", body, userID, map[string]string{"id": questionID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		questionHandler.AnswerVendorQuestion().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -16,10 +16,12 @@ import (
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // newTestRequest -> creates a request with context containing a logger.
@@ -210,6 +212,29 @@ func TestGetProduct(t *testing.T) {
 		mockProductService.AssertExpectations(t)
 	})
 
+	t.Run("Success - API version 2 serializes money as an object", func(t *testing.T) {
+		// Arrange
+		productID := uuid.New()
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/"+productID.String(), nil)
+		req.SetPathValue("id", productID.String())
+		req.Header.Set(middleware.APIVersionHeader, middleware.APIVersion2)
+
+		expectedProduct := &models.Product{ID: productID, Name: "Fetched Product", Price: 149.50}
+
+		mockProductService.On("GetProductByID", mock.Anything, productID).Return(expectedProduct, nil).Once()
+
+		// Act
+		handler := middleware.APIVersion(middleware.APIVersion1)(productHandler.GetProduct())
+		handler.ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, middleware.APIVersion2, rr.Header().Get(middleware.APIVersionHeader))
+		assert.Contains(t, rr.Body.String(), `"price":{"amount":14950,"currency":"usd"}`)
+		assert.Contains(t, rr.Body.String(), `"name":"Fetched Product"`)
+	})
+
 	t.Run("Invalid ID Format", func(t *testing.T) {
 		// Arrange
 		invalidID := "not-a-uuid"
@@ -615,3 +640,404 @@ func float64Ptr(f float64) *float64 {
 func intPtr(i int) *int {
 	return &i
 }
+
+func TestListProductChanges(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+
+	t.Run("Success - Cursor and limit are passed on", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/admin/products/changes?since=42&limit=500", nil)
+		feed := &models.ProductChangeFeed{
+			Changes:    []models.ProductChange{{Cursor: "43", ProductID: uuid.New(), Operation: models.ProductChangeUpdate, Version: 4}},
+			NextCursor: "43",
+		}
+
+		mockProductService.On("ListProductChanges", mock.Anything, "42", 500).Return(feed, nil).Once()
+
+		// Act
+		productHandler.ListProductChanges().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"next_cursor":"43"`)
+	})
+
+	t.Run("Success - Out of range limit falls back to the default", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/admin/products/changes?limit=5000", nil)
+
+		mockProductService.On("ListProductChanges", mock.Anything, "", 100).Return(&models.ProductChangeFeed{Changes: []models.ProductChange{}, NextCursor: "0"}, nil).Once()
+
+		// Act
+		productHandler.ListProductChanges().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid cursor", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/admin/products/changes?since=abc", nil)
+
+		mockProductService.On("ListProductChanges", mock.Anything, "abc", 100).Return(nil, appErrors.BadRequestError("Invalid change feed cursor")).Once()
+
+		// Act
+		productHandler.ListProductChanges().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetProductFacets(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+
+	t.Run("Success - Filters are passed on", func(t *testing.T) {
+		// Arrange
+		categoryID := uuid.New()
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/facets?category_id="+categoryID.String()+"&min_price=10&max_price=50&status=active", nil)
+
+		mockProductService.On("GetProductFacets", mock.Anything, mock.MatchedBy(func(f *models.ProductFilter) bool {
+			return *f.CategoryID == categoryID && *f.MinPrice == 10 && *f.MaxPrice == 50 && f.Status == "active"
+		})).Return(&models.ProductFacets{}, nil).Once()
+
+		// Act
+		productHandler.GetProductFacets().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid filters", func(t *testing.T) {
+		for _, query := range []string{"category_id=abc", "min_price=-1", "min_price=60&max_price=50", "status=unknown"} {
+			// Arrange
+			rr := httptest.NewRecorder()
+			req := newTestRequest(http.MethodGet, "/products/facets?"+query, nil)
+
+			// Act
+			productHandler.GetProductFacets().ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("Failure - Service error", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/facets", nil)
+
+		mockProductService.On("GetProductFacets", mock.Anything, &models.ProductFilter{}).Return(nil, appErrors.DatabaseError("Failed to fetch product facets")).Once()
+
+		// Act
+		productHandler.GetProductFacets().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestLookupProduct(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+
+	t.Run("Success - By barcode", func(t *testing.T) {
+		// Arrange
+		product := &models.Product{ID: uuid.New(), SKU: "SCAN-001", Barcode: "4006381333931"}
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/lookup?barcode=4006381333931", nil)
+
+		mockProductService.On("LookupProduct", mock.Anything, "", "4006381333931").Return(product, nil).Once()
+
+		// Act
+		productHandler.LookupProduct().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "4006381333931")
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/lookup?sku=UNKNOWN", nil)
+
+		mockProductService.On("LookupProduct", mock.Anything, "UNKNOWN", "").Return(nil, appErrors.NotFoundError("Product not found")).Once()
+
+		// Act
+		productHandler.LookupProduct().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestSetRelatedProducts(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+	productID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		relatedID := uuid.New()
+		body := models.SetRelatedProductsRequest{Relations: []models.ProductRelationInput{
+			{ProductID: relatedID, Type: models.ProductRelationCrossSell},
+		}}
+		bodyBytes, err := json.Marshal(body)
+		assert.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodPut, "/admin/products/"+productID.String()+"/related", bodyBytes)
+		req.Header.Set("Content-Type", "application/json")
+		req.SetPathValue("id", productID.String())
+
+		related := []models.RelatedProduct{{ProductID: relatedID, Name: "Case", Type: models.ProductRelationCrossSell}}
+		mockProductService.On("SetRelatedProducts", mock.Anything, productID, &body).Return(related, nil).Once()
+
+		// Act
+		productHandler.SetRelatedProducts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"type":"cross_sell"`)
+	})
+
+	t.Run("Invalid Input - Unknown relation type", func(t *testing.T) {
+		// Arrange
+		bodyBytes := []byte(`{"relations":[{"product_id":"` + uuid.NewString() + `","type":"bundle"}]}`)
+
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodPut, "/admin/products/"+productID.String()+"/related", bodyBytes)
+		req.Header.Set("Content-Type", "application/json")
+		req.SetPathValue("id", productID.String())
+
+		// Act
+		productHandler.SetRelatedProducts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockProductService.AssertNotCalled(t, "SetRelatedProducts")
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodPut, "/admin/products/"+productID.String()+"/related", []byte(`{"relations":[]}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.SetPathValue("id", productID.String())
+
+		mockProductService.On("SetRelatedProducts", mock.Anything, productID, mock.Anything).Return(nil, appErrors.NotFoundError("Product not found")).Once()
+
+		// Act
+		productHandler.SetRelatedProducts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetProductAvailability(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+	productID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		availability := &models.ProductAvailability{ProductID: productID, StockStatus: models.StockStatusInStock, EstimatedDispatchDate: "2025-03-14", Price: 19.99}
+		mockProductService.On("GetProductAvailability", mock.Anything, productID).Return(availability, nil).Once()
+
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/"+productID.String()+"/availability", nil)
+		req.SetPathValue("id", productID.String())
+
+		// Act
+		productHandler.GetProductAvailability().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"stock_status":"in_stock"`)
+		assert.Contains(t, rr.Body.String(), `"estimated_dispatch_date":"2025-03-14"`)
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		mockProductService.On("GetProductAvailability", mock.Anything, productID).Return(nil, appErrors.NotFoundError("Product not found")).Once()
+
+		rr := httptest.NewRecorder()
+		req := newTestRequest(http.MethodGet, "/products/"+productID.String()+"/availability", nil)
+		req.SetPathValue("id", productID.String())
+
+		// Act
+		productHandler.GetProductAvailability().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestUpdatePrices(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		body := models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 18.5}}}
+		bodyBytes, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		result := &models.BulkPriceUpdateResult{BatchID: uuid.New(), Updated: 1, Changes: []models.PriceChange{{ProductID: uuid.New(), SKU: "SKU-1", OldPrice: 20, NewPrice: 18.5}}}
+		mockProductService.On("UpdatePrices", mock.Anything, adminID, &body).Return(result, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/products/prices", bytes.NewBuffer(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.UpdatePrices().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"new_price":18.5`)
+	})
+
+	t.Run("Invalid Input - Adjustment wipes prices out", func(t *testing.T) {
+		// Arrange
+		bodyBytes := []byte(`{"adjustment":{"percent":-100}}`)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/products/prices", bytes.NewBuffer(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.UpdatePrices().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Repriced concurrently", func(t *testing.T) {
+		// Arrange
+		body := models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 18.5}}}
+		bodyBytes, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		mockProductService.On("UpdatePrices", mock.Anything, adminID, &body).
+			Return(nil, appErrors.InvalidTransitionError("Prices changed while the batch was applied, nothing was updated")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/products/prices", bytes.NewBuffer(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.UpdatePrices().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestQuickEditProducts(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		bodyBytes := []byte(`[{"sku":"SKU-1","price":18.5},{"sku":"SKU-2","stock":12}]`)
+		newPrice, newStock := 18.5, 12
+		edits := []models.ProductQuickEdit{{SKU: "SKU-1", Price: &newPrice}, {SKU: "SKU-2", Stock: &newStock}}
+
+		result := &models.ProductQuickEditResult{BatchID: uuid.New(), Applied: true, Updated: 2, Rows: []models.ProductQuickEditRow{
+			{Index: 0, SKU: "SKU-1", Status: models.QuickEditUpdated, NewPrice: &newPrice},
+			{Index: 1, SKU: "SKU-2", Status: models.QuickEditUpdated, NewStock: &newStock},
+		}}
+		mockProductService.On("QuickEditProducts", mock.Anything, adminID, edits).Return(result, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/products/quick-edit", bytes.NewBuffer(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.QuickEditProducts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"applied":true`)
+		assert.Contains(t, rr.Body.String(), `"new_stock":12`)
+	})
+
+	t.Run("Invalid Input - Not an array", func(t *testing.T) {
+		// Arrange
+		bodyBytes := []byte(`{"sku":"SKU-1","price":18.5}`)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/products/quick-edit", bytes.NewBuffer(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.QuickEditProducts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Changed concurrently", func(t *testing.T) {
+		// Arrange
+		bodyBytes := []byte(`[{"sku":"SKU-1","stock":2}]`)
+
+		mockProductService.On("QuickEditProducts", mock.Anything, adminID, mock.Anything).
+			Return(nil, appErrors.InvalidTransitionError("Products changed while the edits were applied, nothing was updated")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPatch, "/admin/products/quick-edit", bytes.NewBuffer(bodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.QuickEditProducts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestSetPurchaseLimit(t *testing.T) {
+	mockProductService := mocks.NewMockProductService(t)
+	productHandler := handlers.NewProductHandler(mockProductService)
+	productID := uuid.New()
+	pathParams := map[string]string{"id": productID.String()}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		maxPerOrder := 2
+		body := models.SetPurchaseLimitRequest{MaxPerOrder: &maxPerOrder}
+		bodyBytes, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		mockProductService.On("SetPurchaseLimit", mock.Anything, productID, &body).Return(&models.Product{ID: productID, MaxPerOrder: &maxPerOrder}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/products/"+productID.String()+"/purchase-limit", bytes.NewBuffer(bodyBytes), uuid.New(), pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.SetPurchaseLimit().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"max_per_order":2`)
+	})
+
+	t.Run("Invalid Input - Period without a per customer limit", func(t *testing.T) {
+		// Arrange
+		bodyBytes := []byte(`{"max_per_order":2,"limit_period_days":30}`)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/products/"+productID.String()+"/purchase-limit", bytes.NewBuffer(bodyBytes), uuid.New(), pathParams)
+		rr := httptest.NewRecorder()
+
+		// Act
+		productHandler.SetPurchaseLimit().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+)
+
+// internalRoles see the identifiers of the payment provider, to look payments up on its dashboard.
+var internalRoles = []models.UserRole{models.RoleAdmin, models.RoleStaff}
+
+// seesInternalIDs reports whether the caller is on the operations side: an admin, a staff member or a
+// machine client, whose scopes were granted by an admin. A support agent impersonating a customer
+// sees what the customer sees.
+func seesInternalIDs(ctx context.Context) bool {
+	claims, ok := ctx.Value(middleware.UserContextKey).(*models.Claims)
+	if !ok {
+		return false
+	}
+
+	return claims.ClientID != nil || (claims.Impersonation == nil && slices.Contains(internalRoles, claims.Role))
+}
+
+func orderView(ctx context.Context, order *models.Order) *models.Order {
+	if seesInternalIDs(ctx) {
+		return order
+	}
+
+	return order.Redacted()
+}
+
+func ordersView(ctx context.Context, orders []models.Order) []models.Order {
+	if seesInternalIDs(ctx) {
+		return orders
+	}
+
+	views := make([]models.Order, len(orders))
+	for i := range orders {
+		views[i] = *orders[i].Redacted()
+	}
+
+	return views
+}
+
+func paymentView(ctx context.Context, payment *models.Payment) *models.Payment {
+	if payment == nil || seesInternalIDs(ctx) {
+		return payment
+	}
+
+	return payment.Redacted()
+}
+
+func paymentsView(ctx context.Context, payments []*models.Payment) []*models.Payment {
+	if seesInternalIDs(ctx) {
+		return payments
+	}
+
+	views := make([]*models.Payment, len(payments))
+	for i, payment := range payments {
+		views[i] = payment.Redacted()
+	}
+
+	return views
+}
+
+func orderTimelineView(ctx context.Context, timeline *models.OrderTimeline) *models.OrderTimeline {
+	if seesInternalIDs(ctx) {
+		return timeline
+	}
+
+	return timeline.Redacted()
+}
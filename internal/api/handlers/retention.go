@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type RetentionHandler struct {
+	retentionService service.RetentionService
+	validator        *validator.Validate
+}
+
+func NewRetentionHandler(retentionService service.RetentionService) *RetentionHandler {
+	return &RetentionHandler{retentionService: retentionService, validator: validator.New()}
+}
+
+// RunRetention godoc
+//
+//	@Summary		Apply the data retention policies (Admin)
+//	@Description	Deletes the carts left untouched past their max age and strips the personal data of the delivered or cancelled orders past the legal retention period, right away instead of waiting for the scheduled run. A dry run only counts the records each policy would purge. Every purged record is recorded in the audit log of its owner. Policies without a period are disabled and left out of the report.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			run	body		models.RunRetentionRequest	true	"Run Options"
+//	@Success		200	{object}	models.RetentionReport		"Retention policies applied"
+//	@Failure		400	{object}	response.ErrorResponse		"Invalid request body"
+//	@Failure		401	{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/retention/run [post]
+func (h *RetentionHandler) RunRetention() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized retention run attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		var req models.RunRetentionRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()), slog.Bool("dryRun", req.DryRun))
+		logger.Info("Attempting to apply retention policies")
+
+		report, err := h.retentionService.ApplyRetention(r.Context(), req.DryRun, &claims.UserID)
+		if err != nil {
+			logger.Error("Failed to apply retention policies", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		for _, result := range report.Results {
+			logger.Info("Retention policy applied", slog.String("policy", string(result.Policy)), slog.Int("count", result.Count))
+		}
+
+		response.Success(w, http.StatusOK, report)
+	}
+}
@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunRetention(t *testing.T) {
+	// Arrange
+	mockRetentionService := mocks.NewMockRetentionService(t)
+	retentionHandler := handlers.NewRetentionHandler(mockRetentionService)
+	adminID := uuid.New()
+
+	t.Run("Success - Dry run report returned", func(t *testing.T) {
+		// Arrange
+		report := &models.RetentionReport{DryRun: true, Results: []models.RetentionResult{
+			{Policy: models.RetentionStaleCarts, Cutoff: time.Now().Add(-90 * 24 * time.Hour).UTC().Truncate(time.Second), Count: 12},
+		}}
+		mockRetentionService.On("ApplyRetention", mock.Anything, true, &adminID).Return(report, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/retention/run", strings.NewReader(`{"dry_run": true}`), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		retentionHandler.RunRetention().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp *response.APIResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+
+		dataBytes, err := json.Marshal(resp.Data)
+		require.NoError(t, err)
+
+		var respReport models.RetentionReport
+		require.NoError(t, json.Unmarshal(dataBytes, &respReport))
+		assert.Equal(t, *report, respReport)
+	})
+
+	t.Run("Failure - Invalid body", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/retention/run", strings.NewReader(`{"dry_run": "yes"}`), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		retentionHandler.RunRetention().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Service Error", func(t *testing.T) {
+		// Arrange
+		mockRetentionService.On("ApplyRetention", mock.Anything, false, &adminID).
+			Return(&models.RetentionReport{}, appErrors.DatabaseError("Failed to purge records past retention")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/retention/run", strings.NewReader(`{}`), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		retentionHandler.RunRetention().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type ShippingHandler struct {
+	shippingService service.ShippingService
+	validator       *validator.Validate
+}
+
+func NewShippingHandler(shippingService service.ShippingService) *ShippingHandler {
+	return &ShippingHandler{shippingService: shippingService, validator: validator.New()}
+}
+
+// Quote godoc
+//
+//	@Summary		Check that items can be shipped to an address
+//	@Description	Checks the address against the countries, states, PO boxes and postal codes the store does not ship to, and the items against the shipping restrictions of their products, like hazardous goods. The same checks are made at checkout, a broken restriction is reported with its own error code.
+//	@Tags			Shipping
+//	@Accept			json
+//	@Produce		json
+//	@Param			quote	body		models.ShippingQuoteRequest	true	"Address and Items"
+//	@Success		200		{object}	models.ShippingQuote		"Items can be shipped to the address"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		422		{object}	response.ErrorResponse		"Shipping restricted: SHIPPING_COUNTRY_NOT_SUPPORTED, SHIPPING_REGION_NOT_SUPPORTED, SHIPPING_PO_BOX_NOT_SUPPORTED, SHIPPING_POSTAL_CODE_EMBARGOED or SHIPPING_PRODUCT_RESTRICTED"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/shipping/quote [post]
+func (h *ShippingHandler) Quote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.ShippingQuoteRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger = logger.With(slog.String("country", req.ShippingAddress.Country), slog.Int("items", len(req.Items)))
+
+		quote, err := h.shippingService.Quote(r.Context(), &req)
+		if err != nil {
+			logger.Warn("Shipping quote refused", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Shipping quote created successfully")
+		response.Success(w, http.StatusOK, quote)
+	}
+}
+
+// SetProductRestriction godoc
+//
+//	@Summary		Restrict where a product can be shipped (Admin)
+//	@Description	Creates or replaces the shipping restriction of a product, like hazardous goods that only ship to some countries and never to a PO box.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string									true	"Product ID (UUID)"	Format(uuid)
+//	@Param			restriction	body		models.SetShippingRestrictionRequest	true	"Restriction Details"
+//	@Success		200			{object}	models.ProductShippingRestriction		"Restriction saved successfully"
+//	@Failure		400			{object}	response.ErrorResponse					"Validation error"
+//	@Failure		401			{object}	response.ErrorResponse					"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse					"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse					"Product not found"
+//	@Failure		500			{object}	response.ErrorResponse					"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/{id}/shipping-restriction [put]
+func (h *ShippingHandler) SetProductRestriction() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", id.String()))
+
+		var req models.SetShippingRestrictionRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		restriction, err := h.shippingService.SetProductRestriction(r.Context(), id, &req)
+		if err != nil {
+			logger.Error("Failed to set shipping restriction", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Shipping restriction saved successfully", slog.String("reason", restriction.Reason))
+		response.Success(w, http.StatusOK, restriction)
+	}
+}
+
+// GetProductRestriction godoc
+//
+//	@Summary		Get the shipping restriction of a product (Admin)
+//	@Description	Retrieves the shipping restriction of a product.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string								true	"Product ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.ProductShippingRestriction	"Successfully retrieved restriction"
+//	@Failure		400	{object}	response.ErrorResponse				"Invalid product ID"
+//	@Failure		401	{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse				"Product has no shipping restriction"
+//	@Failure		500	{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/{id}/shipping-restriction [get]
+func (h *ShippingHandler) GetProductRestriction() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", id.String()))
+
+		restriction, err := h.shippingService.GetProductRestriction(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to fetch shipping restriction", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Shipping restriction retrieved successfully")
+		response.Success(w, http.StatusOK, restriction)
+	}
+}
+
+// DeleteProductRestriction godoc
+//
+//	@Summary		Lift the shipping restriction of a product (Admin)
+//	@Description	Deletes the shipping restriction of a product, it then ships wherever the store ships.
+//	@Tags			Admin
+//	@Param			id	path	string	true	"Product ID (UUID)"	Format(uuid)
+//	@Success		204	"Restriction deleted successfully"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid product ID"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Product has no shipping restriction"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/{id}/shipping-restriction [delete]
+func (h *ShippingHandler) DeleteProductRestriction() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", id.String()))
+
+		if err := h.shippingService.DeleteProductRestriction(r.Context(), id); err != nil {
+			logger.Error("Failed to delete shipping restriction", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Shipping restriction deleted successfully")
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
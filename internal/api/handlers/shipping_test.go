@@ -0,0 +1,113 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShippingQuote(t *testing.T) {
+	// Arrange
+	mockShippingService := mocks.NewMockShippingService(t)
+	shippingHandler := handlers.NewShippingHandler(mockShippingService)
+	reqBody := models.ShippingQuoteRequest{
+		ShippingAddress: models.Address{Street: "PO Box 7", City: "Anytown", State: "NY", PostalCode: "10001", Country: "US"},
+		Items:           []models.ShippingQuoteItem{{ProductID: uuid.New(), Quantity: 1}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockShippingService.On("Quote", mock.Anything, &reqBody).
+			Return(&models.ShippingQuote{ShippingAddress: reqBody.ShippingAddress, Items: reqBody.Items}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/shipping/quote", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		shippingHandler.Quote().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Restricted", func(t *testing.T) {
+		// Arrange
+		mockShippingService.On("Quote", mock.Anything, &reqBody).
+			Return(nil, appErrors.ShippingRestrictedError(appErrors.ErrCodeShippingPOBox, "We do not ship to PO boxes")).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/shipping/quote", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		shippingHandler.Quote().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+
+		var body response.APIResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, appErrors.ErrCodeShippingPOBox, body.Error.Code)
+	})
+}
+
+func TestSetProductRestriction(t *testing.T) {
+	// Arrange
+	mockShippingService := mocks.NewMockShippingService(t)
+	shippingHandler := handlers.NewShippingHandler(mockShippingService)
+	productID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.SetShippingRestrictionRequest{Reason: "hazardous", AllowedCountries: []string{"US"}, NoPOBox: true}
+		mockShippingService.On("SetProductRestriction", mock.Anything, productID, &reqBody).
+			Return(&models.ProductShippingRestriction{ProductID: productID, Reason: "hazardous"}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/products/"+productID.String()+"/shipping-restriction", bytes.NewBuffer(reqBodyBytes), uuid.New(), map[string]string{"id": productID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		shippingHandler.SetProductRestriction().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid country", func(t *testing.T) {
+		// Arrange
+		reqBody := models.SetShippingRestrictionRequest{Reason: "hazardous", AllowedCountries: []string{"USA"}}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/products/"+productID.String()+"/shipping-restriction", bytes.NewBuffer(reqBodyBytes), uuid.New(), map[string]string{"id": productID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		shippingHandler.SetProductRestriction().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
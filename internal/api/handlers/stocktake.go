@@ -0,0 +1,318 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type StocktakeHandler struct {
+	stocktakeService service.StocktakeService
+	validator        *validator.Validate
+}
+
+func NewStocktakeHandler(stocktakeService service.StocktakeService) *StocktakeHandler {
+	return &StocktakeHandler{stocktakeService: stocktakeService, validator: validator.New()}
+}
+
+// CreateStocktake godoc
+//
+//	@Summary		Create a stocktake (Admin)
+//	@Description	Opens a cycle count of the products a warehouse stocks, only those of a category when one is given. The stocktake lists the products to count.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			stocktake	body		models.CreateStocktakeRequest	true	"Stocktake scope"
+//	@Success		201			{object}	models.Stocktake				"Stocktake created"
+//	@Failure		400			{object}	response.ErrorResponse			"Validation error or no product to count"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse			"Warehouse not found"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/stocktakes [post]
+func (h *StocktakeHandler) CreateStocktake() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized stocktake attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		logger = logger.With(slog.String("userID", claims.UserID.String()))
+
+		var req models.CreateStocktakeRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		stocktake, err := h.stocktakeService.CreateStocktake(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to create stocktake", slog.String("warehouseId", req.WarehouseID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Stocktake created", slog.String("stocktakeId", stocktake.ID.String()), slog.Int("lines", len(stocktake.Lines)))
+		response.Success(w, http.StatusCreated, stocktake)
+	}
+}
+
+// ListStocktakes godoc
+//
+//	@Summary		List stocktakes (Admin)
+//	@Description	Retrieves a paginated list of stocktakes, the most recent first, optionally only those in a given status.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status		query		string												false	"Stocktake status"									Enums(open, applied)
+//	@Param			page		query		int													false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int													false	"Number of items per page (default: 20, max: 50)"	minimum(1)	maximum(50)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.Stocktake}	"Successfully retrieved stocktakes"
+//	@Failure		400			{object}	response.ErrorResponse								"Invalid status"
+//	@Failure		401			{object}	response.ErrorResponse								"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse								"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse								"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/stocktakes [get]
+func (h *StocktakeHandler) ListStocktakes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		status := models.StocktakeStatus(r.URL.Query().Get("status"))
+
+		switch status {
+		case "", models.StocktakeOpen, models.StocktakeApplied:
+		default:
+			response.Error(w, errors.BadRequestError("Invalid stocktake status"))
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 50 {
+			pageSize = 20
+		}
+
+		stocktakes, total, err := h.stocktakeService.ListStocktakes(r.Context(), status, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list stocktakes", slog.String("status", string(status)), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     stocktakes,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// GetStocktake godoc
+//
+//	@Summary		Get a stocktake (Admin)
+//	@Description	Retrieves a stocktake with the products to count and the counts submitted so far, by SKU.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Stocktake ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.Stocktake		"Successfully retrieved stocktake"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid stocktake ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Stocktake not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/stocktakes/{id} [get]
+func (h *StocktakeHandler) GetStocktake() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid stocktake ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		stocktake, err := h.stocktakeService.GetStocktake(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get stocktake", slog.String("stocktakeId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, stocktake)
+	}
+}
+
+// SubmitCounts godoc
+//
+//	@Summary		Submit stocktake counts (Admin)
+//	@Description	Records the counted quantities of products of an open stocktake. A product counted again keeps the last count.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string								true	"Stocktake ID (UUID)"	Format(uuid)
+//	@Param			counts	body		models.SubmitStocktakeCountsRequest	true	"Counted quantities"
+//	@Success		200		{object}	models.Stocktake					"Counts recorded"
+//	@Failure		400		{object}	response.ErrorResponse				"Invalid stocktake ID format, validation error or product not counted by the stocktake"
+//	@Failure		401		{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse				"Stocktake not found"
+//	@Failure		409		{object}	response.ErrorResponse				"Stocktake already applied"
+//	@Failure		500		{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/stocktakes/{id}/counts [put]
+func (h *StocktakeHandler) SubmitCounts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid stocktake ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("stocktakeId", id.String()))
+
+		var req models.SubmitStocktakeCountsRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		stocktake, err := h.stocktakeService.SubmitCounts(r.Context(), id, &req)
+		if err != nil {
+			logger.Error("Failed to record stocktake counts", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Stocktake counts recorded", slog.Int("counts", len(req.Counts)))
+		response.Success(w, http.StatusOK, stocktake)
+	}
+}
+
+// GetVariances godoc
+//
+//	@Summary		Get the variances of a stocktake (Admin)
+//	@Description	Compares the counts with the stock the warehouse holds on the books right now, so that stock taken by orders during the count is not counted as missing. A negative variance is units missing.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string							true	"Stocktake ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.StocktakeVarianceReport	"Successfully computed variances"
+//	@Failure		400	{object}	response.ErrorResponse			"Invalid stocktake ID format"
+//	@Failure		401	{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse			"Stocktake not found"
+//	@Failure		500	{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/stocktakes/{id}/variances [get]
+func (h *StocktakeHandler) GetVariances() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid stocktake ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		report, err := h.stocktakeService.GetVariances(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to compute stocktake variances", slog.String("stocktakeId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, report)
+	}
+}
+
+// ApplyStocktake godoc
+//
+//	@Summary		Apply a stocktake (Admin)
+//	@Description	Sets the stock of the warehouse to the counts and closes the stocktake. Every variance needs a reason, recorded in the audit log with the adjustment, and the stock moved is recorded in the stock ledger. Uncounted products are left as they are.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Stocktake ID (UUID)"	Format(uuid)
+//	@Param			reasons	body		models.ApplyStocktakeRequest	true	"Reasons of the variances"
+//	@Success		200		{object}	models.ApplyStocktakeResponse	"Stocktake applied"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid stocktake ID format, validation error, nothing counted or missing reason"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse			"Stocktake not found"
+//	@Failure		409		{object}	response.ErrorResponse			"Stocktake already applied or stock changed while applying it"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/stocktakes/{id}/apply [post]
+func (h *StocktakeHandler) ApplyStocktake() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized stocktake apply attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid stocktake ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("stocktakeId", id.String()), slog.String("userID", claims.UserID.String()))
+
+		var req models.ApplyStocktakeRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		result, err := h.stocktakeService.ApplyStocktake(r.Context(), claims.UserID, id, &req)
+		if err != nil {
+			logger.Error("Failed to apply stocktake", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Stocktake applied", slog.Int("adjustments", len(result.Adjustments)))
+		response.Success(w, http.StatusOK, result)
+	}
+}
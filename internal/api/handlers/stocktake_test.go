@@ -0,0 +1,185 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateStocktake(t *testing.T) {
+	// Arrange
+	mockStocktakeService := mocks.NewMockStocktakeService(t)
+	stocktakeHandler := handlers.NewStocktakeHandler(mockStocktakeService)
+	warehouseID := uuid.New()
+	categoryID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockStocktakeService.On("CreateStocktake", mock.Anything, adminID, &models.CreateStocktakeRequest{WarehouseID: warehouseID, CategoryID: &categoryID}).
+			Return(&models.Stocktake{ID: uuid.New(), WarehouseID: warehouseID, Status: models.StocktakeOpen}, nil).Once()
+
+		body := bytes.NewBufferString(`{"warehouse_id":"` + warehouseID.String() + `","category_id":"` + categoryID.String() + `"}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/stocktakes", body, adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.CreateStocktake().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Warehouse missing", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/stocktakes", bytes.NewBufferString(`{}`), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.CreateStocktake().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestSubmitStocktakeCounts(t *testing.T) {
+	// Arrange
+	mockStocktakeService := mocks.NewMockStocktakeService(t)
+	stocktakeHandler := handlers.NewStocktakeHandler(mockStocktakeService)
+	stocktakeID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockStocktakeService.On("SubmitCounts", mock.Anything, stocktakeID, &models.SubmitStocktakeCountsRequest{Counts: []models.StocktakeCount{{ProductID: productID, Quantity: 0}}}).
+			Return(&models.Stocktake{ID: stocktakeID}, nil).Once()
+
+		body := bytes.NewBufferString(`{"counts":[{"product_id":"` + productID.String() + `","quantity":0}]}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/stocktakes/"+stocktakeID.String()+"/counts", body, uuid.New(), map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.SubmitCounts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Negative count", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"counts":[{"product_id":"` + productID.String() + `","quantity":-1}]}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/stocktakes/"+stocktakeID.String()+"/counts", body, uuid.New(), map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.SubmitCounts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Already applied", func(t *testing.T) {
+		// Arrange
+		mockStocktakeService.On("SubmitCounts", mock.Anything, stocktakeID, mock.Anything).Return(nil, appErrors.InvalidTransitionError("Stocktake is already applied")).Once()
+
+		body := bytes.NewBufferString(`{"counts":[{"product_id":"` + productID.String() + `","quantity":3}]}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/stocktakes/"+stocktakeID.String()+"/counts", body, uuid.New(), map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.SubmitCounts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestGetStocktakeVariances(t *testing.T) {
+	// Arrange
+	mockStocktakeService := mocks.NewMockStocktakeService(t)
+	stocktakeHandler := handlers.NewStocktakeHandler(mockStocktakeService)
+	stocktakeID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockStocktakeService.On("GetVariances", mock.Anything, stocktakeID).Return(&models.StocktakeVarianceReport{
+			StocktakeID: stocktakeID,
+			Counted:     1,
+			Variances:   []models.StocktakeVariance{{SKU: "SKU-1", BookQuantity: 5, CountedQuantity: 3, Variance: -2}},
+		}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/stocktakes/"+stocktakeID.String()+"/variances", nil, uuid.New(), map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.GetVariances().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"variance":-2`)
+	})
+}
+
+func TestApplyStocktake(t *testing.T) {
+	// Arrange
+	mockStocktakeService := mocks.NewMockStocktakeService(t)
+	stocktakeHandler := handlers.NewStocktakeHandler(mockStocktakeService)
+	stocktakeID := uuid.New()
+	productID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockStocktakeService.On("ApplyStocktake", mock.Anything, adminID, stocktakeID, &models.ApplyStocktakeRequest{Reasons: []models.StocktakeAdjustmentReason{{ProductID: productID, Reason: "Damaged"}}}).
+			Return(&models.ApplyStocktakeResponse{Stocktake: &models.Stocktake{ID: stocktakeID, Status: models.StocktakeApplied}}, nil).Once()
+
+		body := bytes.NewBufferString(`{"reasons":[{"product_id":"` + productID.String() + `","reason":"Damaged"}]}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/stocktakes/"+stocktakeID.String()+"/apply", body, adminID, map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.ApplyStocktake().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Empty reason", func(t *testing.T) {
+		// Arrange
+		body := bytes.NewBufferString(`{"reasons":[{"product_id":"` + productID.String() + `","reason":""}]}`)
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/stocktakes/"+stocktakeID.String()+"/apply", body, adminID, map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.ApplyStocktake().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Stock changed", func(t *testing.T) {
+		// Arrange
+		mockStocktakeService.On("ApplyStocktake", mock.Anything, adminID, stocktakeID, mock.Anything).
+			Return(nil, appErrors.InvalidTransitionError("Stock changed while the stocktake was applied, nothing was adjusted; review the variances again")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/stocktakes/"+stocktakeID.String()+"/apply", bytes.NewBufferString(`{}`), adminID, map[string]string{"id": stocktakeID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		stocktakeHandler.ApplyStocktake().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
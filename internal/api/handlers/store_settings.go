@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type StoreSettingsHandler struct {
+	storeSettingsService service.StoreSettingsService
+	validator            *validator.Validate
+}
+
+func NewStoreSettingsHandler(storeSettingsService service.StoreSettingsService) *StoreSettingsHandler {
+	return &StoreSettingsHandler{storeSettingsService: storeSettingsService, validator: validator.New()}
+}
+
+// GetStoreSettings godoc
+//
+//	@Summary		Get store settings (Admin)
+//	@Description	Returns the settings of the store: its name, the legal entity selling in it with its address and tax IDs, the credit note numbering series and the default currency and locale. Until they are saved the settings come from the configuration and have no update time.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{object}	models.StoreSettings	"Store settings"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/store-settings [get]
+func (h *StoreSettingsHandler) GetStoreSettings() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		settings, err := h.storeSettingsService.GetSettings(r.Context())
+		if err != nil {
+			logger.Error("Failed to get store settings", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, settings)
+	}
+}
+
+// UpdateStoreSettings godoc
+//
+//	@Summary		Update store settings (Admin)
+//	@Description	Replaces the settings of the store. Credit notes are issued by the legal entity with its address and tax IDs and numbered in the credit note series of the settings, documents issued before keep the seller they were issued with. A new series or legal entity starts numbering again at 1. Emails are sent in the name of the store. Other instances see the change within a minute.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			settings	body		models.UpdateStoreSettingsRequest	true	"Store settings"
+//	@Success		200			{object}	models.StoreSettings				"Store settings saved"
+//	@Failure		400			{object}	response.ErrorResponse				"Validation error"
+//	@Failure		401			{object}	response.ErrorResponse				"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse				"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse				"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/store-settings [put]
+func (h *StoreSettingsHandler) UpdateStoreSettings() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized store settings update attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		var req models.UpdateStoreSettingsRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		settings, err := h.storeSettingsService.UpdateSettings(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to update store settings", slog.String("userID", claims.UserID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Store settings updated", slog.String("userID", claims.UserID.String()), slog.String("legalEntity", settings.LegalEntity))
+		response.Success(w, http.StatusOK, settings)
+	}
+}
@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStoreSettings(t *testing.T) {
+	// Arrange
+	mockStoreSettingsService := mocks.NewMockStoreSettingsService(t)
+	storeSettingsHandler := handlers.NewStoreSettingsHandler(mockStoreSettingsService)
+	mockStoreSettingsService.On("GetSettings", mock.Anything).Return(&models.StoreSettings{StoreName: "Acme", LegalEntity: "Acme GmbH"}, nil).Once()
+
+	req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/store-settings", nil, uuid.New(), nil)
+	rr := httptest.NewRecorder()
+
+	// Act
+	storeSettingsHandler.GetStoreSettings().ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Acme GmbH")
+}
+
+func TestUpdateStoreSettings(t *testing.T) {
+	// Arrange
+	mockStoreSettingsService := mocks.NewMockStoreSettingsService(t)
+	storeSettingsHandler := handlers.NewStoreSettingsHandler(mockStoreSettingsService)
+	adminID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.UpdateStoreSettingsRequest{
+			StoreName:        "Acme",
+			LegalEntity:      "Acme GmbH",
+			TaxIDs:           []models.TaxID{{Type: "vat", Value: "DE123456789", Country: "DE"}},
+			CreditNoteSeries: "CN",
+			DefaultCurrency:  "eur",
+			DefaultLocale:    "de-DE",
+		}
+		mockStoreSettingsService.On("UpdateSettings", mock.Anything, adminID, &reqBody).
+			Return(&models.StoreSettings{StoreName: "Acme", LegalEntity: "Acme GmbH"}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/store-settings", bytes.NewBuffer(reqBodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		storeSettingsHandler.UpdateStoreSettings().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid series and locale", func(t *testing.T) {
+		// Arrange
+		reqBody := models.UpdateStoreSettingsRequest{
+			StoreName:        "Acme",
+			LegalEntity:      "Acme GmbH",
+			CreditNoteSeries: "CN/2026",
+			DefaultCurrency:  "eur",
+			DefaultLocale:    "not a locale",
+		}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/store-settings", bytes.NewBuffer(reqBodyBytes), adminID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		storeSettingsHandler.UpdateStoreSettings().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type SupportSearchHandler struct {
+	searchService service.SupportSearchService
+	validator     *validator.Validate
+}
+
+func NewSupportSearchHandler(searchService service.SupportSearchService) *SupportSearchHandler {
+	return &SupportSearchHandler{searchService: searchService, validator: validator.New()}
+}
+
+// Search godoc
+//
+//	@Summary		Search customers, orders and payments (Admin/Staff)
+//	@Description	Reads the query as an order or customer ID, an email address, a phone number or a payment intent ID and lists the latest 50 customers, orders, payments and notifications it matches, with links to the admin endpoints to open next. Addresses match whatever their case, a phone number matches the SMS notifications sent to it.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			q	query		string							true	"Order or customer ID, email address, phone number or payment intent ID"
+//	@Success		200	{object}	models.SupportSearchResponse	"Matches, latest first"
+//	@Failure		400	{object}	response.ErrorResponse			"Missing or unrecognised query"
+//	@Failure		401	{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/search [get]
+func (h *SupportSearchHandler) Search() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		query := r.URL.Query().Get("q")
+		if err := h.validator.Var(query, "required,max=320"); err != nil {
+			logger.Warn("Invalid support search query")
+			response.Error(w, errors.BadRequestError("Query parameter 'q' is required and at most 320 characters"))
+
+			return
+		}
+
+		result, err := h.searchService.Search(r.Context(), query)
+		if err != nil {
+			logger.Error("Failed to search", slog.Any("error", err))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Support search completed", slog.Int("matches", len(result.Matches)))
+		response.Success(w, http.StatusOK, result)
+	}
+}
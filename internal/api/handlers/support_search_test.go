@@ -0,0 +1,82 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSupportSearch(t *testing.T) {
+	// Arrange
+	mockSearchService := mocks.NewMockSupportSearchService(t)
+	searchHandler := handlers.NewSupportSearchHandler(mockSearchService)
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockSearchService.On("Search", mock.Anything, "jane@example.com").Return(&models.SupportSearchResponse{
+			Query: "jane@example.com",
+			Matches: []models.SupportSearchMatch{
+				{Type: models.SupportSearchOrder, ID: orderID.String(), MatchedOn: "order_id", Links: map[string]string{"refunds": "/api/v1/admin/orders/" + orderID.String() + "/refunds"}},
+			},
+		}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/search?q=jane%40example.com", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		searchHandler.Search().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"type":"order"`)
+	})
+
+	t.Run("Failure - Missing query", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/search", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		searchHandler.Search().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Query too long", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/search?q="+strings.Repeat("a", 321), nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		searchHandler.Search().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Unrecognised query", func(t *testing.T) {
+		// Arrange
+		mockSearchService.On("Search", mock.Anything, "order 42").Return(nil, appErrors.BadRequestError("Search for an order or customer ID, an email address, a phone number or a payment intent ID")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/search?q=order+42", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		searchHandler.Search().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+type TrackingHandler struct {
+	trackingService service.OrderTrackingService
+}
+
+func NewTrackingHandler(trackingService service.OrderTrackingService) *TrackingHandler {
+	return &TrackingHandler{trackingService: trackingService}
+}
+
+// TrackOrder godoc
+//
+//	@Summary		Track an order from its tracking link
+//	@Description	Shows the status of an order to whoever holds its signed tracking token, sent in the order emails, without logging in. The customer, prices, payment and street address are left out. Lookups are rate limited per client.
+//	@Tags			Orders
+//	@Produce		json
+//	@Param			token	path		string					true	"Tracking Token"
+//	@Success		200		{object}	models.OrderTracking	"Order status"
+//	@Failure		404		{object}	response.ErrorResponse	"Invalid token or order not found"
+//	@Failure		429		{object}	response.ErrorResponse	"Too many tracking requests"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Header			all		{integer}	X-RateLimit-Limit		"Requests allowed per window"
+//	@Header			all		{integer}	X-RateLimit-Remaining	"Requests left in the window after this one"
+//	@Header			all		{integer}	X-RateLimit-Reset		"Seconds until the window frees a request again"
+//	@Router			/track/{token} [get]
+func (h *TrackingHandler) TrackOrder() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		client, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			client = r.RemoteAddr
+		}
+
+		tracking, err := h.trackingService.TrackOrder(r.Context(), client, r.PathValue("token"))
+		if err != nil {
+			logger.Warn("Order tracking refused", slog.String("client", client), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order tracked successfully", slog.String("orderId", tracking.OrderID.String()), slog.String("status", string(tracking.Status)))
+		response.Success(w, http.StatusOK, tracking)
+	}
+}
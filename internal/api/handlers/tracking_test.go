@@ -0,0 +1,55 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTrackOrder(t *testing.T) {
+	// Arrange
+	mockTrackingService := mocks.NewMockOrderTrackingService(t)
+	trackingHandler := handlers.NewTrackingHandler(mockTrackingService)
+	orderID := uuid.New()
+
+	t.Run("Success - Without logging in", func(t *testing.T) {
+		// Arrange
+		mockTrackingService.On("TrackOrder", mock.Anything, "192.0.2.1", "tok_valid").
+			Return(&models.OrderTracking{OrderID: orderID, Status: models.OrderStatusShipping, ItemCount: 2}, nil).Once()
+
+		req := testutils.CreateTestRequestWithoutContext(http.MethodGet, "/track/tok_valid", nil, map[string]string{"token": "tok_valid"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		trackingHandler.TrackOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status":"shipping"`)
+		assert.NotContains(t, rr.Body.String(), "customer_id")
+	})
+
+	t.Run("Failure - Rate limited", func(t *testing.T) {
+		// Arrange
+		mockTrackingService.On("TrackOrder", mock.Anything, "192.0.2.1", "tok_valid").
+			Return(nil, appErrors.TooManyRequestsError("Too many tracking requests, try again in 5 seconds")).Once()
+
+		req := testutils.CreateTestRequestWithoutContext(http.MethodGet, "/track/tok_valid", nil, map[string]string{"token": "tok_valid"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		trackingHandler.TrackOrder().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	})
+}
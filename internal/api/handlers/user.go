@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"log/slog"
+	"net"
 	"net/http"
+	"strconv"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
@@ -14,18 +16,20 @@ import (
 )
 
 type UserHandler struct {
-	userService service.UserService
-	validator   *validator.Validate
+	userService   service.UserService
+	validator     *validator.Validate
+	countryHeader string
 }
 
-func NewUserHandler(userService service.UserService) *UserHandler {
-	return &UserHandler{userService: userService, validator: validator.New()}
+// NewUserHandler reads the country of a login from the countryHeader set by the CDN, when there is one.
+func NewUserHandler(userService service.UserService, countryHeader string) *UserHandler {
+	return &UserHandler{userService: userService, validator: validator.New(), countryHeader: countryHeader}
 }
 
 // Register godoc
 //
 //	@Summary		Register a new user
-//	@Description	Creates a new user account with the provided details.
+//	@Description	Creates a new user account with the provided details. The password has to follow the password policy, a WEAK_PASSWORD error lists the rules it breaks in meta.violations.
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
@@ -63,7 +67,7 @@ func (h *UserHandler) Register() http.HandlerFunc {
 // Login godoc
 //
 //	@Summary		Log in a user
-//	@Description	Authenticates a user and returns a JWT token upon successful login.
+//	@Description	Authenticates a user and returns a JWT token upon successful login. Every login opens a session, listed under the user's sessions, and a login from a device never seen on the account is reported by email.
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
@@ -73,6 +77,9 @@ func (h *UserHandler) Register() http.HandlerFunc {
 //	@Failure		401			{object}	response.ErrorResponse	"Invalid email or password"
 //	@Failure		429			{object}	response.ErrorResponse	"Too many login attempts"
 //	@Failure		500			{object}	response.ErrorResponse	"Internal server error"
+//	@Header			all			{integer}	X-RateLimit-Limit		"Requests allowed per window"
+//	@Header			all			{integer}	X-RateLimit-Remaining	"Requests left in the window after this one"
+//	@Header			all			{integer}	X-RateLimit-Reset		"Seconds until the window frees a request again"
 //	@Router			/users/login [post]
 func (h *UserHandler) Login() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -87,7 +94,7 @@ func (h *UserHandler) Login() http.HandlerFunc {
 		}
 
 		// Call the register service
-		resp, err := h.userService.Login(r.Context(), &req)
+		resp, err := h.userService.Login(r.Context(), &req, h.loginDevice(r))
 		if err != nil {
 			logger.Warn("Login attempt failed", slog.String("email", req.Email), slog.Any("error", err))
 			response.Error(w, err)
@@ -154,3 +161,266 @@ func (h *UserHandler) Profile() http.HandlerFunc {
 		response.Success(w, http.StatusOK, user)
 	}
 }
+
+// Timeline godoc
+//
+//	@Summary		Get a user's activity timeline (Admin)
+//	@Description	Retrieves the registration, logins, orders, payments, refunds and notifications of a user as one feed, most recent first. Meant for customer support.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id			path		string													true	"User ID (UUID)"									Format(uuid)
+//	@Param			page		query		int														false	"Page number for pagination (default: 1)"			minimum(1)
+//	@Param			pageSize	query		int														false	"Number of items per page (default: 10, max: 100)"	minimum(1)	maximum(100)
+//	@Success		200			{object}	models.PaginatedResponse{Data=[]models.TimelineEvent}	"Successfully retrieved user timeline"
+//	@Failure		400			{object}	response.ErrorResponse									"Invalid user ID"
+//	@Failure		401			{object}	response.ErrorResponse									"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse									"Forbidden - Insufficient permissions"
+//	@Failure		404			{object}	response.ErrorResponse									"User not found"
+//	@Failure		500			{object}	response.ErrorResponse									"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/users/{id}/timeline [get]
+func (h *UserHandler) Timeline() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid user ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		logger = logger.With(slog.String("userID", id.String()), slog.Int("page", page), slog.Int("pageSize", pageSize))
+
+		events, total, err := h.userService.GetUserTimeline(r.Context(), id, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to fetch user timeline", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("User timeline retrieved successfully", slog.Int("count", len(events)), slog.Int("total", total))
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     events,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// Impersonate godoc
+//
+//	@Summary		Impersonate a customer (Support)
+//	@Description	Issues a short-lived token acting as the customer, for support agents reproducing an issue. Tokens are read-only unless an admin asks for write access, payments are refused either way. The impersonation is recorded in the customer's audit log and carried in the token's impersonation claim.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"User ID (UUID)"	Format(uuid)
+//	@Param			request	body		models.ImpersonationRequest		true	"Impersonation Details"
+//	@Success		200		{object}	models.ImpersonationResponse	"Impersonation token issued"
+//	@Failure		400		{object}	response.ErrorResponse			"Validation error or invalid user ID"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions or user is not a customer"
+//	@Failure		404		{object}	response.ErrorResponse			"User not found"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/support/users/{id}/impersonate [post]
+func (h *UserHandler) Impersonate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized impersonation attempt")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid user ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		var req models.ImpersonationRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger = logger.With(slog.String("actorId", claims.UserID.String()), slog.String("userID", id.String()))
+
+		result, err := h.userService.Impersonate(r.Context(), claims, id, &req)
+		if err != nil {
+			logger.Warn("Impersonation refused", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Impersonation token issued", slog.Bool("readOnly", result.Impersonation.ReadOnly), slog.Int("expiresIn", result.ExpiresIn))
+		response.Success(w, http.StatusOK, result)
+	}
+}
+
+// ListSessions godoc
+//
+//	@Summary		List the user's sessions
+//	@Description	Retrieves the devices signed in to the account of the authenticated user, most recent first, with the IP address, user agent and country of each login. The session of the token in use is flagged as current.
+//	@Tags			Users
+//	@Produce		json
+//	@Success		200	{array}		models.Session			"Active sessions"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/users/me/sessions [get]
+func (h *UserHandler) ListSessions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized access attempt: missing user claims in context")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		sessions, err := h.userService.ListSessions(r.Context(), claims)
+		if err != nil {
+			logger.Error("Failed to list sessions", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Sessions retrieved successfully", slog.Int("count", len(sessions)))
+		response.Success(w, http.StatusOK, sessions)
+	}
+}
+
+// RevokeSession godoc
+//
+//	@Summary		Revoke a session
+//	@Description	Signs a device out of the account of the authenticated user, the token of the session is refused from then on. Revoking the current session logs out. Not allowed while impersonating.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			id	path	string	true	"Session ID (UUID)"	Format(uuid)
+//	@Success		204	"Session revoked"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid session ID"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Not allowed while impersonating"
+//	@Failure		404	{object}	response.ErrorResponse	"Session not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/users/me/sessions/{id} [delete]
+func (h *UserHandler) RevokeSession() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized access attempt: missing user claims in context")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid session ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("sessionId", id.String()))
+
+		if err := h.userService.RevokeSession(r.Context(), claims, id); err != nil {
+			logger.Warn("Failed to revoke session", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Session revoked successfully")
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// ChangePassword godoc
+//
+//	@Summary		Change password
+//	@Description	Replaces the password of the authenticated user once the current one is confirmed. The new password has to follow the password policy, a WEAK_PASSWORD error lists the rules it breaks in meta.violations. Not allowed while impersonating.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			passwords	body	models.ChangePasswordRequest	true	"Current and new password"
+//	@Success		204			"Password changed"
+//	@Failure		400			{object}	response.ErrorResponse	"Validation error or weak password"
+//	@Failure		401			{object}	response.ErrorResponse	"Authentication required or current password incorrect"
+//	@Failure		403			{object}	response.ErrorResponse	"Not allowed while impersonating"
+//	@Failure		500			{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/users/me/password [put]
+func (h *UserHandler) ChangePassword() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized access attempt: missing user claims in context")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		var req models.ChangePasswordRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		if err := h.userService.ChangePassword(r.Context(), claims, &req); err != nil {
+			logger.Warn("Failed to change password", slog.String("userId", claims.UserID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Password changed successfully", slog.String("userId", claims.UserID.String()))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// loginDevice describes the client of the request, the country is only known behind a CDN setting it.
+func (h *UserHandler) loginDevice(r *http.Request) models.LoginDevice {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	device := models.LoginDevice{IPAddress: ip, UserAgent: r.UserAgent()}
+
+	if h.countryHeader != "" {
+		device.Country = r.Header.Get(h.countryHeader)
+	}
+
+	return device
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
@@ -13,6 +14,7 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -21,7 +23,7 @@ import (
 
 func TestUserHandler_Register(t *testing.T) {
 	mockUserService := mocks.NewMockUserService(t)
-	userHandler := handlers.NewUserHandler(mockUserService)
+	userHandler := handlers.NewUserHandler(mockUserService, "")
 
 	t.Run("Success - User Registration", func(t *testing.T) {
 		// Arrange
@@ -159,7 +161,7 @@ func TestUserHandler_Register(t *testing.T) {
 
 func TestUserHandler_Login(t *testing.T) {
 	mockUserService := mocks.NewMockUserService(t)
-	userHandler := handlers.NewUserHandler(mockUserService)
+	userHandler := handlers.NewUserHandler(mockUserService, "CF-IPCountry")
 
 	t.Run("Success - Valid Login", func(t *testing.T) {
 		// Arrange
@@ -172,6 +174,8 @@ func TestUserHandler_Login(t *testing.T) {
 		assert.NoError(t, err)
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/login", bytes.NewBuffer(reqBody))
 		req.Header.Set("Content/Type", "application/json")
+		req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh)")
+		req.Header.Set("CF-IPCountry", "DE")
 
 		w := httptest.NewRecorder()
 
@@ -184,7 +188,7 @@ func TestUserHandler_Login(t *testing.T) {
 		// did the handler pass the right data to the service?
 		mockUserService.On("Login", mock.Anything, mock.MatchedBy(func(r *models.LoginRequest) bool {
 			return r.Email == loginReq.Email && r.Password == loginReq.Password
-		})).Return(loginResp, nil).Once()
+		}), models.LoginDevice{IPAddress: "192.0.2.1", UserAgent: "Mozilla/5.0 (Macintosh)", Country: "DE"}).Return(loginResp, nil).Once()
 
 		// Act
 		handler := userHandler.Login()
@@ -235,7 +239,7 @@ func TestUserHandler_Login(t *testing.T) {
 		// did the handler pass the right data to the service?
 		mockUserService.On("Login", mock.Anything, mock.MatchedBy(func(r *models.LoginRequest) bool {
 			return r.Email == loginReq.Email && r.Password == loginReq.Password
-		})).Return(loginResp, nil).Once()
+		}), mock.Anything).Return(loginResp, nil).Once()
 
 		// Act
 		handler := userHandler.Login()
@@ -276,7 +280,7 @@ func TestUserHandler_Login(t *testing.T) {
 		// did the handler pass the right data to the service?
 		mockUserService.On("Login", mock.Anything, mock.MatchedBy(func(r *models.LoginRequest) bool {
 			return r.Email == loginReq.Email && r.Password == loginReq.Password
-		})).Return(loginResp, nil).Once()
+		}), mock.Anything).Return(loginResp, nil).Once()
 
 		// Act
 		handler := userHandler.Login()
@@ -298,7 +302,7 @@ func TestUserHandler_Login(t *testing.T) {
 
 func TestUserHandler_Profile(t *testing.T) {
 	mockUserService := mocks.NewMockUserService(t)
-	userHandler := handlers.NewUserHandler(mockUserService)
+	userHandler := handlers.NewUserHandler(mockUserService, "")
 
 	t.Run("Success - Get Profile", func(t *testing.T) {
 		// Arrange
@@ -393,3 +397,211 @@ func TestUserHandler_Profile(t *testing.T) {
 		mockUserService.AssertExpectations(t)
 	})
 }
+
+func TestUserHandler_Timeline(t *testing.T) {
+	mockUserService := mocks.NewMockUserService(t)
+	userHandler := handlers.NewUserHandler(mockUserService, "")
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		events := []*models.TimelineEvent{{Type: models.TimelineOrder, ReferenceID: uuid.NewString(), Status: "pending"}}
+		mockUserService.On("GetUserTimeline", mock.Anything, userID, 2, 20).Return(events, 21, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/users/"+userID.String()+"/timeline?page=2&pageSize=20", nil, uuid.New(), map[string]string{"id": userID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.Timeline().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"type":"order"`)
+		assert.Contains(t, w.Body.String(), `"total":21`)
+	})
+
+	t.Run("Failure - User not found", func(t *testing.T) {
+		// Arrange
+		mockUserService.On("GetUserTimeline", mock.Anything, userID, 1, 10).Return(nil, 0, errors.NotFoundError("User not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/users/"+userID.String()+"/timeline", nil, uuid.New(), map[string]string{"id": userID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.Timeline().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUserHandler_Impersonate(t *testing.T) {
+	mockUserService := mocks.NewMockUserService(t)
+	userHandler := handlers.NewUserHandler(mockUserService, "")
+	actorID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		result := &models.ImpersonationResponse{
+			Token:         "token",
+			ExpiresIn:     900,
+			Impersonation: models.Impersonation{ActorID: actorID, ReadOnly: true, Reason: "ticket 42"},
+		}
+		mockUserService.On("Impersonate", mock.Anything, mock.MatchedBy(func(c *models.Claims) bool { return c.UserID == actorID }), userID, &models.ImpersonationRequest{Reason: "ticket 42"}).Return(result, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/support/users/"+userID.String()+"/impersonate", strings.NewReader(`{"reason":"ticket 42"}`), actorID, map[string]string{"id": userID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.Impersonate().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"token":"token"`)
+		assert.Contains(t, w.Body.String(), `"read_only":true`)
+	})
+
+	t.Run("Failure - Missing reason", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/support/users/"+userID.String()+"/impersonate", strings.NewReader(`{}`), actorID, map[string]string{"id": userID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.Impersonate().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Failure - Forbidden", func(t *testing.T) {
+		// Arrange
+		req := &models.ImpersonationRequest{Reason: "ticket 42", WriteAccess: true}
+		mockUserService.On("Impersonate", mock.Anything, mock.Anything, userID, req).Return(nil, errors.ForbiddenError("Write access requires an admin")).Once()
+
+		httpReq := testutils.CreateTestRequestWithContext(http.MethodPost, "/support/users/"+userID.String()+"/impersonate", strings.NewReader(`{"reason":"ticket 42","write_access":true}`), actorID, map[string]string{"id": userID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.Impersonate().ServeHTTP(w, httpReq)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestUserHandler_Sessions(t *testing.T) {
+	mockUserService := mocks.NewMockUserService(t)
+	userHandler := handlers.NewUserHandler(mockUserService, "")
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	t.Run("Success - List", func(t *testing.T) {
+		// Arrange
+		sessions := []*models.Session{{ID: sessionID, LoginDevice: models.LoginDevice{IPAddress: "203.0.113.7", UserAgent: "curl/8.0"}, Current: true}}
+		mockUserService.On("ListSessions", mock.Anything, mock.MatchedBy(func(c *models.Claims) bool { return c.UserID == userID })).Return(sessions, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/users/me/sessions", nil, userID, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.ListSessions().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"ip_address":"203.0.113.7"`)
+		assert.Contains(t, w.Body.String(), `"current":true`)
+	})
+
+	t.Run("Success - Revoke", func(t *testing.T) {
+		// Arrange
+		mockUserService.On("RevokeSession", mock.Anything, mock.MatchedBy(func(c *models.Claims) bool { return c.UserID == userID }), sessionID).Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/users/me/sessions/"+sessionID.String(), nil, userID, map[string]string{"id": sessionID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.RevokeSession().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Failure - Unknown session", func(t *testing.T) {
+		// Arrange
+		mockUserService.On("RevokeSession", mock.Anything, mock.Anything, sessionID).Return(errors.NotFoundError("Session not found")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/users/me/sessions/"+sessionID.String(), nil, userID, map[string]string{"id": sessionID.String()})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.RevokeSession().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Failure - Invalid session ID", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodDelete, "/users/me/sessions/abc", nil, userID, map[string]string{"id": "abc"})
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.RevokeSession().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUserHandler_ChangePassword(t *testing.T) {
+	mockUserService := mocks.NewMockUserService(t)
+	userHandler := handlers.NewUserHandler(mockUserService, "")
+	userID := uuid.New()
+	body := `{"current_password":"old-password1","new_password":"new-password2"}`
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockUserService.On("ChangePassword", mock.Anything, mock.MatchedBy(func(c *models.Claims) bool { return c.UserID == userID }), &models.ChangePasswordRequest{
+			CurrentPassword: "old-password1",
+			NewPassword:     "new-password2",
+		}).Return(nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/users/me/password", strings.NewReader(body), userID, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.ChangePassword().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Failure - Weak password", func(t *testing.T) {
+		// Arrange
+		mockUserService.On("ChangePassword", mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.WeakPasswordError([]string{"missing_symbol"}, "Password must contain a symbol")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/users/me/password", strings.NewReader(body), userID, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.ChangePassword().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"violations":["missing_symbol"]`)
+	})
+
+	t.Run("Failure - Missing current password", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/users/me/password", strings.NewReader(`{"new_password":"new-password2"}`), userID, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		userHandler.ChangePassword().ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
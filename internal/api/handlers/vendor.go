@@ -0,0 +1,759 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type VendorHandler struct {
+	vendorService  service.VendorService
+	productService service.ProductService
+	validator      *validator.Validate
+}
+
+func NewVendorHandler(vendorService service.VendorService, productService service.ProductService) *VendorHandler {
+	return &VendorHandler{vendorService: vendorService, productService: productService, validator: validator.New()}
+}
+
+// RegisterVendor godoc
+//
+//	@Summary		Apply as a marketplace vendor
+//	@Description	Onboards the authenticated user as a vendor. The vendor can list products once an admin approved it.
+//	@Tags			Vendors
+//	@Accept			json
+//	@Produce		json
+//	@Param			vendor	body		models.CreateVendorRequest	true	"Vendor details"
+//	@Success		201		{object}	models.Vendor				"Vendor registered, pending approval"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or invalid input"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		409		{object}	response.ErrorResponse		"User is already a vendor"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors [post]
+func (h *VendorHandler) RegisterVendor() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized vendor registration attempt: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		var req models.CreateVendorRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		vendor, err := h.vendorService.RegisterVendor(r.Context(), claims.UserID, &req)
+		if err != nil {
+			logger.Error("Failed to register vendor", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Vendor registered", slog.String("vendorId", vendor.ID.String()))
+		response.Success(w, http.StatusCreated, vendor)
+	}
+}
+
+// GetMyVendor godoc
+//
+//	@Summary		Get the vendor of the current user
+//	@Description	Retrieves the vendor account of the authenticated user, including its approval status.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Success		200	{object}	models.Vendor			"Successfully retrieved vendor"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		404	{object}	response.ErrorResponse	"User is not a vendor"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me [get]
+func (h *VendorHandler) GetMyVendor() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Warn("Unauthorized vendor lookup: missing user claims")
+			response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		vendor, err := h.vendorService.GetVendorByUser(r.Context(), claims.UserID)
+		if err != nil {
+			logger.Warn("Failed to get vendor", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, vendor)
+	}
+}
+
+// CreateVendorProduct godoc
+//
+//	@Summary		List a product as a vendor
+//	@Description	Creates a product owned by the vendor the token is scoped to, which must be approved.
+//	@Tags			Vendors
+//	@Accept			json
+//	@Produce		json
+//	@Param			product	body		models.CreateProductRequest	true	"Product details"
+//	@Success		201		{object}	models.Product				"Successfully created product"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or invalid input"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Not a vendor account or vendor not approved"
+//	@Failure		404		{object}	response.ErrorResponse		"Vendor not found"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/products [post]
+func (h *VendorHandler) CreateVendorProduct() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendor, ok := h.approvedVendor(w, r)
+		if !ok {
+			return
+		}
+
+		logger = logger.With(slog.String("vendorId", vendor.ID.String()))
+
+		var req models.CreateProductRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		product, err := h.productService.CreateVendorProduct(r.Context(), vendor.ID, &req)
+		if err != nil {
+			logger.Error("Failed to create vendor product", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Vendor product created", slog.String("productId", product.ID.String()))
+		response.Success(w, http.StatusCreated, product)
+	}
+}
+
+// UpdateVendorProduct godoc
+//
+//	@Summary		Update a product as a vendor
+//	@Description	Updates a product owned by the vendor the token is scoped to. Products of other vendors or of the platform are reported as not found.
+//	@Tags			Vendors
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Product ID (UUID)"	Format(uuid)
+//	@Param			product	body		models.UpdateProductRequest	true	"Fields to update"
+//	@Success		200		{object}	models.Product				"Successfully updated product"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or invalid input"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Not a vendor account or vendor not approved"
+//	@Failure		404		{object}	response.ErrorResponse		"Product not found among the products of the vendor"
+//	@Failure		409		{object}	response.ErrorResponse		"The product cannot move to this status from its current one"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/products/{id} [put]
+func (h *VendorHandler) UpdateVendorProduct() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		vendor, ok := h.approvedVendor(w, r)
+		if !ok {
+			return
+		}
+
+		logger = logger.With(slog.String("vendorId", vendor.ID.String()), slog.String("productId", id.String()))
+
+		var req models.UpdateProductRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		product, err := h.productService.UpdateVendorProduct(r.Context(), vendor.ID, id, &req)
+		if err != nil {
+			logger.Warn("Failed to update vendor product", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Vendor product updated")
+		response.Success(w, http.StatusOK, product)
+	}
+}
+
+// ListVendorProducts godoc
+//
+//	@Summary		List the products of the vendor
+//	@Description	Retrieves the products of the vendor the token is scoped to, inactive ones included, newest first.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Param			page		query		int												false	"Page number"		default(1)
+//	@Param			pageSize	query		int												false	"Items per page"	default(10)
+//	@Success		200			{object}	models.PaginatedResponse{data=[]models.Product}	"Successfully retrieved vendor products"
+//	@Failure		401			{object}	response.ErrorResponse							"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse							"Vendor account required"
+//	@Failure		500			{object}	response.ErrorResponse							"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/products [get]
+func (h *VendorHandler) ListVendorProducts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		products, total, err := h.productService.ListVendorProducts(r.Context(), vendorID, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list vendor products", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     products,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// GetDashboard godoc
+//
+//	@Summary		Get the dashboard of the vendor
+//	@Description	Summarizes the catalog, the pending and recent sub-orders and the balance of the vendor the token is scoped to. Recent figures cover the last 30 days.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Success		200	{object}	models.VendorDashboard	"Vendor dashboard"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Vendor account required"
+//	@Failure		404	{object}	response.ErrorResponse	"Vendor not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/dashboard [get]
+func (h *VendorHandler) GetDashboard() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		dashboard, err := h.vendorService.GetDashboard(r.Context(), vendorID)
+		if err != nil {
+			logger.Error("Failed to get vendor dashboard", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, dashboard)
+	}
+}
+
+// ListVendorOrders godoc
+//
+//	@Summary		List the sub-orders of the vendor
+//	@Description	Retrieves the parts of customer orders to be fulfilled by the vendor the token is scoped to, newest first.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Param			page		query		int													false	"Page number"		default(1)
+//	@Param			pageSize	query		int													false	"Items per page"	default(10)
+//	@Success		200			{object}	models.PaginatedResponse{data=[]models.VendorOrder}	"Successfully retrieved vendor orders"
+//	@Failure		401			{object}	response.ErrorResponse								"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse								"Not a vendor account or vendor not approved"
+//	@Failure		404			{object}	response.ErrorResponse								"Vendor not found"
+//	@Failure		500			{object}	response.ErrorResponse								"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/orders [get]
+func (h *VendorHandler) ListVendorOrders() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendor, ok := h.approvedVendor(w, r)
+		if !ok {
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		vendorOrders, total, err := h.vendorService.ListVendorOrders(r.Context(), vendor.ID, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list vendor orders", slog.String("vendorId", vendor.ID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     vendorOrders,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// ListVendorPayouts godoc
+//
+//	@Summary		List the payouts of the vendor
+//	@Description	Retrieves the payouts calculated for the vendor the token is scoped to, latest period first.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Success		200	{array}		models.VendorPayout		"Successfully retrieved payouts"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Vendor account required"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/payouts [get]
+func (h *VendorHandler) ListVendorPayouts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		payouts, err := h.vendorService.ListVendorPayouts(r.Context(), vendorID)
+		if err != nil {
+			logger.Error("Failed to list vendor payouts", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, payouts)
+	}
+}
+
+// ListVendors godoc
+//
+//	@Summary		List vendors (Admin)
+//	@Description	Retrieves the marketplace vendors, optionally only those in a given status (e.g. pending approval).
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			status	query		string					false	"Vendor status"	Enums(pending, approved, suspended)
+//	@Success		200		{array}		models.Vendor			"Successfully retrieved vendors"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid status"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/vendors [get]
+func (h *VendorHandler) ListVendors() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		status := models.VendorStatus(r.URL.Query().Get("status"))
+
+		switch status {
+		case "", models.VendorStatusPending, models.VendorStatusApproved, models.VendorStatusSuspended:
+		default:
+			response.Error(w, errors.BadRequestError("Invalid vendor status"))
+
+			return
+		}
+
+		vendors, err := h.vendorService.ListVendors(r.Context(), status)
+		if err != nil {
+			logger.Error("Failed to list vendors", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, vendors)
+	}
+}
+
+// UpdateVendor godoc
+//
+//	@Summary		Approve, suspend or set the terms of a vendor (Admin)
+//	@Description	Updates the status, the commission rate or the Stripe Connect account of a vendor. The rate applies to orders placed afterwards.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Vendor ID (UUID)"	Format(uuid)
+//	@Param			vendor	body		models.UpdateVendorRequest	true	"Fields to update"
+//	@Success		200		{object}	models.Vendor				"Successfully updated vendor"
+//	@Failure		400		{object}	response.ErrorResponse		"Validation error or invalid input"
+//	@Failure		401		{object}	response.ErrorResponse		"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse		"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse		"Vendor not found"
+//	@Failure		500		{object}	response.ErrorResponse		"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/vendors/{id} [patch]
+func (h *VendorHandler) UpdateVendor() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid vendor ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("vendorId", id.String()))
+
+		var req models.UpdateVendorRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		vendor, err := h.vendorService.UpdateVendor(r.Context(), id, &req)
+		if err != nil {
+			logger.Error("Failed to update vendor", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Vendor updated", slog.String("status", string(vendor.Status)), slog.Float64("commissionRate", vendor.CommissionRate))
+		response.Success(w, http.StatusOK, vendor)
+	}
+}
+
+// CalculatePayouts godoc
+//
+//	@Summary		Calculate vendor payouts (Admin)
+//	@Description	Calculates the payouts of every vendor over the period, by default the previous calendar month. Only paid orders count, recalculating a period replaces its payouts.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			from	query		string					false	"Start of the period, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the period, exclusive (YYYY-MM-DD)"
+//	@Success		200		{array}		models.VendorPayout		"Calculated payouts"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/vendors/payouts [post]
+func (h *VendorHandler) CalculatePayouts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parsePayoutPeriod(r)
+		if err != nil {
+			response.Error(w, err)
+
+			return
+		}
+
+		payouts, err := h.vendorService.CalculatePayouts(r.Context(), from, to)
+		if err != nil {
+			logger.Error("Failed to calculate vendor payouts", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Vendor payouts calculated", slog.Time("from", from), slog.Time("to", to), slog.Int("vendors", len(payouts)))
+		response.Success(w, http.StatusOK, payouts)
+	}
+}
+
+// ListPayouts godoc
+//
+//	@Summary		List vendor payouts (Admin)
+//	@Description	Retrieves the payouts of the periods starting in the range, by default the previous calendar month.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			from	query		string					false	"Start of the range, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the range, exclusive (YYYY-MM-DD)"
+//	@Success		200		{array}		models.VendorPayout		"Successfully retrieved payouts"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/vendors/payouts [get]
+func (h *VendorHandler) ListPayouts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		from, to, err := parsePayoutPeriod(r)
+		if err != nil {
+			response.Error(w, err)
+
+			return
+		}
+
+		payouts, err := h.vendorService.ListPayouts(r.Context(), from, to)
+		if err != nil {
+			logger.Error("Failed to list vendor payouts", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, payouts)
+	}
+}
+
+// GetEarnings godoc
+//
+//	@Summary		Get the earnings of the vendor
+//	@Description	Reports the sales, commissions and payouts booked on the ledger of the vendor the token is scoped to over the period, by default the last 30 days, with the balance owed now.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Param			from	query		string					false	"Start of the period, inclusive (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"End of the period, exclusive (YYYY-MM-DD)"
+//	@Success		200		{object}	models.VendorEarnings	"Earnings report"
+//	@Failure		400		{object}	response.ErrorResponse	"Invalid date range"
+//	@Failure		401		{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse	"Vendor account required"
+//	@Failure		500		{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/earnings [get]
+func (h *VendorHandler) GetEarnings() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		from, to, err := parseReportRange(r)
+		if err != nil {
+			response.Error(w, err)
+
+			return
+		}
+
+		earnings, err := h.vendorService.GetEarnings(r.Context(), vendorID, from, to)
+		if err != nil {
+			logger.Error("Failed to get vendor earnings", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, earnings)
+	}
+}
+
+// ListLedgerEntries godoc
+//
+//	@Summary		List the ledger of the vendor
+//	@Description	Retrieves the balance movements of the vendor the token is scoped to, newest first. Sales are credited, commissions and payouts debited.
+//	@Tags			Vendors
+//	@Produce		json
+//	@Param			page		query		int															false	"Page number"		default(1)
+//	@Param			pageSize	query		int															false	"Items per page"	default(10)
+//	@Success		200			{object}	models.PaginatedResponse{data=[]models.VendorLedgerEntry}	"Successfully retrieved ledger entries"
+//	@Failure		401			{object}	response.ErrorResponse										"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse										"Vendor account required"
+//	@Failure		500			{object}	response.ErrorResponse										"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/vendors/me/ledger [get]
+func (h *VendorHandler) ListLedgerEntries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		vendorID, ok := vendorScope(w, r)
+		if !ok {
+			return
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 10
+		}
+
+		entries, total, err := h.vendorService.ListLedgerEntries(r.Context(), vendorID, page, pageSize)
+		if err != nil {
+			logger.Error("Failed to list vendor ledger", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, models.PaginatedResponse{
+			Data:     entries,
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		})
+	}
+}
+
+// CreatePayoutBatch godoc
+//
+//	@Summary		Pay out vendor balances (Admin)
+//	@Description	Accrues the earnings of paid orders, then transfers the balance of every vendor owed at least the minimum payout to its Stripe Connect account. Failed transfers are credited back to the balance.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		201	{object}	models.PayoutBatch		"Payout batch, with one item per vendor"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		409	{object}	response.ErrorResponse	"Another payout batch is processing"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/vendors/payout-batches [post]
+func (h *VendorHandler) CreatePayoutBatch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		batch, err := h.vendorService.CreatePayoutBatch(r.Context())
+		if err != nil {
+			logger.Error("Failed to create payout batch", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Payout batch processed", slog.String("batchId", batch.ID.String()), slog.String("status", string(batch.Status)),
+			slog.Int("vendors", len(batch.Items)), slog.Float64("totalAmount", batch.TotalAmount))
+		response.Success(w, http.StatusCreated, batch)
+	}
+}
+
+// GetPayoutBatch godoc
+//
+//	@Summary		Get a payout batch (Admin)
+//	@Description	Retrieves a payout batch with the transfer of each vendor.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Payout batch ID (UUID)"	Format(uuid)
+//	@Success		200	{object}	models.PayoutBatch		"Successfully retrieved payout batch"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Payout batch not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/vendors/payout-batches/{id} [get]
+func (h *VendorHandler) GetPayoutBatch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid payout batch ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		batch, err := h.vendorService.GetPayoutBatch(r.Context(), id)
+		if err != nil {
+			logger.Warn("Failed to get payout batch", slog.String("batchId", id.String()), slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		response.Success(w, http.StatusOK, batch)
+	}
+}
+
+// vendorScope returns the vendor the token is scoped to, whatever its status: suspended vendors keep
+// access to what they are owed. It writes the error response when the token is not a vendor token.
+func vendorScope(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	logger := middleware.LoggerFromContext(r.Context())
+
+	claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+	if !ok {
+		logger.Warn("Unauthorized vendor request: missing user claims")
+		response.Error(w, errors.UnauthorizedError("Authentication required"))
+
+		return uuid.Nil, false
+	}
+
+	if claims.VendorID == nil {
+		logger.Warn("Vendor request without vendor scope", slog.String("userId", claims.UserID.String()))
+		response.Error(w, errors.ForbiddenError("Vendor account required"))
+
+		return uuid.Nil, false
+	}
+
+	return *claims.VendorID, true
+}
+
+// approvedVendor resolves the vendor the token is scoped to, writing the error response when it can't sell.
+func (h *VendorHandler) approvedVendor(w http.ResponseWriter, r *http.Request) (*models.Vendor, bool) {
+	vendorID, ok := vendorScope(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	vendor, err := h.vendorService.GetApprovedVendor(r.Context(), vendorID)
+	if err != nil {
+		middleware.LoggerFromContext(r.Context()).Warn("Vendor request refused", slog.String("vendorId", vendorID.String()), slog.String("error", err.Error()))
+		response.Error(w, err)
+
+		return nil, false
+	}
+
+	return vendor, true
+}
+
+// parsePayoutPeriod reads the from/to query dates, by default the previous calendar month. Payout periods
+// are UTC whatever the timezone of the request.
+func parsePayoutPeriod(r *http.Request) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return parseDateRange(r, to.AddDate(0, -1, 0), to, time.UTC)
+}
@@ -0,0 +1,296 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterVendor(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mocks.NewMockProductService(t))
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateVendorRequest{Name: "Acme", ContactEmail: "shop@acme.test"}
+		mockVendorService.On("RegisterVendor", mock.Anything, userID, &reqBody).Return(&models.Vendor{ID: uuid.New(), UserID: userID, Status: models.VendorStatusPending}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/vendors", bytes.NewBuffer(reqBodyBytes), userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.RegisterVendor().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Invalid email", func(t *testing.T) {
+		// Arrange
+		reqBodyBytes, err := json.Marshal(models.CreateVendorRequest{Name: "Acme", ContactEmail: "not-an-email"})
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/vendors", bytes.NewBuffer(reqBodyBytes), userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.RegisterVendor().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCreateVendorProduct(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	mockProductService := mocks.NewMockProductService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mockProductService)
+	userID := uuid.New()
+	vendorID := uuid.New()
+	reqBody := models.CreateProductRequest{CategoryID: uuid.New(), Name: "Mug", Description: "Ceramic mug", Price: 12.5, StockQuantity: 10, SKU: "MUG-1"}
+
+	reqBodyBytes, err := json.Marshal(reqBody)
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("GetApprovedVendor", mock.Anything, vendorID).Return(&models.Vendor{ID: vendorID, Status: models.VendorStatusApproved}, nil).Once()
+		mockProductService.On("CreateVendorProduct", mock.Anything, vendorID, &reqBody).Return(&models.Product{ID: uuid.New(), VendorID: &vendorID}, nil).Once()
+
+		req := testutils.CreateVendorTestRequest(http.MethodPost, "/vendors/me/products", bytes.NewBuffer(reqBodyBytes), userID, vendorID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.CreateVendorProduct().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Vendor not approved", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("GetApprovedVendor", mock.Anything, vendorID).Return(nil, appErrors.ForbiddenError("Vendor is not approved")).Once()
+
+		req := testutils.CreateVendorTestRequest(http.MethodPost, "/vendors/me/products", bytes.NewBuffer(reqBodyBytes), userID, vendorID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.CreateVendorProduct().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		mockProductService.AssertNotCalled(t, "CreateVendorProduct")
+	})
+}
+
+func TestListVendorProducts(t *testing.T) {
+	// Arrange
+	mockProductService := mocks.NewMockProductService(t)
+	vendorHandler := handlers.NewVendorHandler(mocks.NewMockVendorService(t), mockProductService)
+	userID := uuid.New()
+	vendorID := uuid.New()
+
+	mockProductService.On("ListVendorProducts", mock.Anything, vendorID, 2, 20).Return([]*models.Product{{ID: uuid.New(), VendorID: &vendorID}}, 21, nil).Once()
+
+	req := testutils.CreateVendorTestRequest(http.MethodGet, "/vendors/me/products?page=2&pageSize=20", nil, userID, vendorID, nil)
+	rr := httptest.NewRecorder()
+
+	// Act
+	vendorHandler.ListVendorProducts().ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestGetVendorDashboard(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mocks.NewMockProductService(t))
+	userID := uuid.New()
+	vendorID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("GetDashboard", mock.Anything, vendorID).Return(&models.VendorDashboard{VendorID: vendorID, Products: 3}, nil).Once()
+
+		req := testutils.CreateVendorTestRequest(http.MethodGet, "/vendors/me/dashboard", nil, userID, vendorID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.GetDashboard().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Vendor not found", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("GetDashboard", mock.Anything, vendorID).Return(nil, appErrors.NotFoundError("Vendor not found")).Once()
+
+		req := testutils.CreateVendorTestRequest(http.MethodGet, "/vendors/me/dashboard", nil, userID, vendorID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.GetDashboard().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestListVendors(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mocks.NewMockProductService(t))
+
+	t.Run("Success - Pending only", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("ListVendors", mock.Anything, models.VendorStatusPending).Return([]*models.Vendor{}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/vendors?status=pending", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.ListVendors().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Unknown status", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/vendors?status=banned", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.ListVendors().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCalculateVendorPayouts(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mocks.NewMockProductService(t))
+
+	t.Run("Success - Explicit period", func(t *testing.T) {
+		// Arrange
+		from := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		mockVendorService.On("CalculatePayouts", mock.Anything, from, to).
+			Return([]*models.VendorPayout{{VendorID: uuid.New(), NetAmount: 90.0}}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/vendors/payouts?from=2025-02-01&to=2025-03-01", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.CalculatePayouts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid date", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/vendors/payouts?from=february", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.CalculatePayouts().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetVendorEarnings(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mocks.NewMockProductService(t))
+	userID := uuid.New()
+	vendorID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		from := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		mockVendorService.On("GetEarnings", mock.Anything, vendorID, from, to).Return(&models.VendorEarnings{VendorID: vendorID, Balance: 42.0}, nil).Once()
+
+		req := testutils.CreateVendorTestRequest(http.MethodGet, "/vendors/me/earnings?from=2025-02-01&to=2025-03-01", nil, userID, vendorID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.GetEarnings().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Not a vendor token", func(t *testing.T) {
+		// Arrange
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/vendors/me/earnings", nil, userID, nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.GetEarnings().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		mockVendorService.AssertNotCalled(t, "GetEarnings")
+	})
+}
+
+func TestCreatePayoutBatch(t *testing.T) {
+	// Arrange
+	mockVendorService := mocks.NewMockVendorService(t)
+	vendorHandler := handlers.NewVendorHandler(mockVendorService, mocks.NewMockProductService(t))
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("CreatePayoutBatch", mock.Anything).Return(&models.PayoutBatch{ID: uuid.New(), Status: models.PayoutBatchStatusCompleted}, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/vendors/payout-batches", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.CreatePayoutBatch().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Already processing", func(t *testing.T) {
+		// Arrange
+		mockVendorService.On("CreatePayoutBatch", mock.Anything).Return(nil, appErrors.DuplicateEntryError("A payout batch is already processing")).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/vendors/payout-batches", nil, uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		vendorHandler.CreatePayoutBatch().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
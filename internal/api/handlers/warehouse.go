@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/go-playground/validator/v10"
+)
+
+type WarehouseHandler struct {
+	warehouseService service.WarehouseService
+	validator        *validator.Validate
+}
+
+func NewWarehouseHandler(warehouseService service.WarehouseService) *WarehouseHandler {
+	return &WarehouseHandler{warehouseService: warehouseService, validator: validator.New()}
+}
+
+// CreateWarehouse godoc
+//
+//	@Summary		Create a warehouse (Admin)
+//	@Description	Adds a warehouse orders can be shipped from. Its location is used to allocate order items to the nearest warehouse.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			warehouse	body		models.CreateWarehouseRequest	true	"Warehouse Details"
+//	@Success		201			{object}	models.Warehouse				"Successfully created warehouse"
+//	@Failure		400			{object}	response.ErrorResponse			"Validation error or invalid input"
+//	@Failure		401			{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403			{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		500			{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/warehouses [post]
+func (h *WarehouseHandler) CreateWarehouse() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		var req models.CreateWarehouseRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger.Info("Attempting to create warehouse", slog.String("name", req.Name))
+
+		warehouse, err := h.warehouseService.CreateWarehouse(r.Context(), &req)
+		if err != nil {
+			logger.Error("Failed to create warehouse", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Warehouse created successfully", slog.String("warehouseId", warehouse.ID.String()))
+		response.Success(w, http.StatusCreated, warehouse)
+	}
+}
+
+// ListWarehouses godoc
+//
+//	@Summary		List warehouses (Admin)
+//	@Description	Retrieves all the warehouses.
+//	@Tags			Admin
+//	@Produce		json
+//	@Success		200	{array}		models.Warehouse		"Successfully retrieved warehouses"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/warehouses [get]
+func (h *WarehouseHandler) ListWarehouses() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		warehouses, err := h.warehouseService.ListWarehouses(r.Context())
+		if err != nil {
+			logger.Error("Failed to list warehouses", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Warehouses listed successfully", slog.Int("count", len(warehouses)))
+		response.Success(w, http.StatusOK, warehouses)
+	}
+}
+
+// GetWarehouseStock godoc
+//
+//	@Summary		Get the stock of a warehouse (Admin)
+//	@Description	Retrieves the quantity held by the warehouse for every product it stocks.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Warehouse ID (UUID)"	Format(uuid)
+//	@Success		200	{array}		models.WarehouseStock	"Successfully retrieved warehouse stock"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid warehouse ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		404	{object}	response.ErrorResponse	"Warehouse not found"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/warehouses/{id}/stock [get]
+func (h *WarehouseHandler) GetWarehouseStock() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid warehouse ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("warehouseId", id.String()))
+
+		stock, err := h.warehouseService.GetWarehouseStock(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get warehouse stock", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Warehouse stock retrieved successfully", slog.Int("count", len(stock)))
+		response.Success(w, http.StatusOK, stock)
+	}
+}
+
+// SetWarehouseStock godoc
+//
+//	@Summary		Set the stock of a product in a warehouse (Admin)
+//	@Description	Sets the quantity of a product held by the warehouse. The product's total stock is adjusted by the difference.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Warehouse ID (UUID)"	Format(uuid)
+//	@Param			stock	body		models.SetWarehouseStockRequest	true	"Stock Details"
+//	@Success		200		{object}	models.WarehouseStock			"Successfully set warehouse stock"
+//	@Failure		400		{object}	response.ErrorResponse			"Invalid warehouse ID format or validation error"
+//	@Failure		401		{object}	response.ErrorResponse			"Authentication required"
+//	@Failure		403		{object}	response.ErrorResponse			"Forbidden - Insufficient permissions"
+//	@Failure		404		{object}	response.ErrorResponse			"Warehouse or product not found"
+//	@Failure		500		{object}	response.ErrorResponse			"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/warehouses/{id}/stock [put]
+func (h *WarehouseHandler) SetWarehouseStock() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid warehouse ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		var req models.SetWarehouseStockRequest
+
+		if !utils.ParseAndValidate(r, w, &req, h.validator) {
+			return
+		}
+
+		logger = logger.With(slog.String("warehouseId", id.String()), slog.String("productId", req.ProductID.String()))
+		logger.Info("Attempting to set warehouse stock", slog.Int("quantity", req.Quantity))
+
+		stock, err := h.warehouseService.SetStock(r.Context(), id, &req)
+		if err != nil {
+			logger.Error("Failed to set warehouse stock", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Warehouse stock set successfully")
+		response.Success(w, http.StatusOK, stock)
+	}
+}
+
+// GetProductStock godoc
+//
+//	@Summary		Get the stock of a product per warehouse (Admin)
+//	@Description	Retrieves the quantity of the product held by each warehouse.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Product ID (UUID)"	Format(uuid)
+//	@Success		200	{array}		models.WarehouseStock	"Successfully retrieved product stock"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid product ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/products/{id}/stock [get]
+func (h *WarehouseHandler) GetProductStock() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid product ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("productId", id.String()))
+
+		stock, err := h.warehouseService.GetProductStock(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get product stock", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Product stock retrieved successfully", slog.Int("warehouses", len(stock)))
+		response.Success(w, http.StatusOK, stock)
+	}
+}
+
+// GetOrderAllocations godoc
+//
+//	@Summary		Get the warehouse allocations of an order (Admin)
+//	@Description	Retrieves which warehouse ships each order item, for fulfillment integrations.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path		string					true	"Order ID (UUID)"	Format(uuid)
+//	@Success		200	{array}		models.StockAllocation	"Successfully retrieved order allocations"
+//	@Failure		400	{object}	response.ErrorResponse	"Invalid order ID format"
+//	@Failure		401	{object}	response.ErrorResponse	"Authentication required"
+//	@Failure		403	{object}	response.ErrorResponse	"Forbidden - Insufficient permissions"
+//	@Failure		500	{object}	response.ErrorResponse	"Internal server error"
+//	@Security		BearerAuth
+//	@Router			/admin/orders/{id}/allocations [get]
+func (h *WarehouseHandler) GetOrderAllocations() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.LoggerFromContext(r.Context())
+
+		id, err := utils.ParseID(r, "id")
+		if err != nil {
+			logger.Warn("Invalid order ID in path", slog.Any("error", err), slog.String("pathValue", r.PathValue("id")))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger = logger.With(slog.String("orderId", id.String()))
+
+		allocations, err := h.warehouseService.GetOrderAllocations(r.Context(), id)
+		if err != nil {
+			logger.Error("Failed to get order allocations", slog.String("error", err.Error()))
+			response.Error(w, err)
+
+			return
+		}
+
+		logger.Info("Order allocations retrieved successfully", slog.Int("count", len(allocations)))
+		response.Success(w, http.StatusOK, allocations)
+	}
+}
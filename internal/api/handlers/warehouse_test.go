@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/handlers"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWarehouse(t *testing.T) {
+	// Arrange
+	mockWarehouseService := mocks.NewMockWarehouseService(t)
+	warehouseHandler := handlers.NewWarehouseHandler(mockWarehouseService)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateWarehouseRequest{Name: "Berlin", Country: "DE", State: "BE", PostalCode: "10115"}
+		mockWarehouseService.On("CreateWarehouse", mock.Anything, &reqBody).Return(&models.Warehouse{ID: uuid.New(), Name: "Berlin"}, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/warehouses", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		warehouseHandler.CreateWarehouse().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Failure - Validation Error", func(t *testing.T) {
+		// Arrange
+		reqBody := models.CreateWarehouseRequest{Name: "Berlin", Country: "Germany"}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPost, "/admin/warehouses", bytes.NewBuffer(reqBodyBytes), uuid.New(), nil)
+		rr := httptest.NewRecorder()
+
+		// Act
+		warehouseHandler.CreateWarehouse().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestSetWarehouseStock(t *testing.T) {
+	// Arrange
+	mockWarehouseService := mocks.NewMockWarehouseService(t)
+	warehouseHandler := handlers.NewWarehouseHandler(mockWarehouseService)
+	warehouseID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		reqBody := models.SetWarehouseStockRequest{ProductID: uuid.New(), Quantity: 7}
+		stock := &models.WarehouseStock{WarehouseID: warehouseID, ProductID: reqBody.ProductID, Quantity: 7}
+		mockWarehouseService.On("SetStock", mock.Anything, warehouseID, &reqBody).Return(stock, nil).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/warehouses/"+warehouseID.String()+"/stock", bytes.NewBuffer(reqBodyBytes), uuid.New(), map[string]string{"id": warehouseID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		warehouseHandler.SetWarehouseStock().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Failure - Invalid warehouse ID", func(t *testing.T) {
+		// Arrange
+		reqBody := models.SetWarehouseStockRequest{ProductID: uuid.New(), Quantity: 7}
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/warehouses/bad/stock", bytes.NewBuffer(reqBodyBytes), uuid.New(), map[string]string{"id": "bad"})
+		rr := httptest.NewRecorder()
+
+		// Act
+		warehouseHandler.SetWarehouseStock().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Failure - Warehouse not found", func(t *testing.T) {
+		// Arrange
+		reqBody := models.SetWarehouseStockRequest{ProductID: uuid.New(), Quantity: 7}
+		mockWarehouseService.On("SetStock", mock.Anything, warehouseID, &reqBody).Return(nil, appErrors.NotFoundError("Warehouse not found")).Once()
+
+		reqBodyBytes, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := testutils.CreateTestRequestWithContext(http.MethodPut, "/admin/warehouses/"+warehouseID.String()+"/stock", bytes.NewBuffer(reqBodyBytes), uuid.New(), map[string]string{"id": warehouseID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		warehouseHandler.SetWarehouseStock().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetOrderAllocations(t *testing.T) {
+	// Arrange
+	mockWarehouseService := mocks.NewMockWarehouseService(t)
+	warehouseHandler := handlers.NewWarehouseHandler(mockWarehouseService)
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		allocations := []*models.StockAllocation{{ID: uuid.New(), OrderID: orderID, WarehouseID: uuid.New(), Quantity: 2}}
+		mockWarehouseService.On("GetOrderAllocations", mock.Anything, orderID).Return(allocations, nil).Once()
+
+		req := testutils.CreateTestRequestWithContext(http.MethodGet, "/admin/orders/"+orderID.String()+"/allocations", nil, uuid.New(), map[string]string{"id": orderID.String()})
+		rr := httptest.NewRecorder()
+
+		// Act
+		warehouseHandler.GetOrderAllocations().ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
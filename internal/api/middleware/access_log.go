@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	AccessLogFormatJSON     = "json"
+	AccessLogFormatCombined = "combined"
+)
+
+const accessLogFieldsKey = logContextKey("access_log_fields")
+
+// accessLogFields is shared through the request context so that inner handlers
+// (e.g. the auth middleware) can enrich the access log entry written on the way out.
+type accessLogFields struct {
+	userID  string
+	traceID string
+}
+
+type AccessLogger struct {
+	cfg    *config.AccessLogConfig
+	out    io.Writer
+	logger *slog.Logger
+	sample func() float64
+}
+
+func NewAccessLogger(cfg *config.AccessLogConfig, out io.Writer) *AccessLogger {
+	return &AccessLogger{
+		cfg:    cfg,
+		out:    out,
+		logger: slog.New(slog.NewJSONHandler(out, nil)),
+		sample: rand.Float64,
+	}
+}
+
+// OpenAccessLogSink resolves the configured output to a writer: "stdout", "stderr" or a file path.
+func OpenAccessLogSink(output string) (io.WriteCloser, error) {
+	switch output {
+	case "", "stdout":
+		return nopWriteCloser{os.Stdout}, nil
+	case "stderr":
+		return nopWriteCloser{os.Stderr}, nil
+	}
+
+	f, err := os.OpenFile(output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open access log file %s: %w", output, err)
+	}
+
+	return f, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+func (a *AccessLogger) Handler(next http.Handler) http.Handler {
+	if !a.cfg.Enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		fields := &accessLogFields{}
+		ctx := context.WithValue(r.Context(), accessLogFieldsKey, fields)
+
+		rw := newResponseWriter(w)
+
+		next.ServeHTTP(rw, r.WithContext(ctx))
+
+		// Server errors are always logged, everything else is subject to sampling
+		if rw.statusCode < http.StatusInternalServerError && !a.shouldSample(r.URL.Path) {
+			return
+		}
+
+		duration := time.Since(start)
+
+		if fields.traceID == "" {
+			if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+				fields.traceID = sc.TraceID().String()
+			}
+		}
+
+		if a.cfg.Format == AccessLogFormatCombined {
+			a.writeCombined(r, rw, fields, start, duration)
+
+			return
+		}
+
+		a.logger.LogAttrs(r.Context(), slog.LevelInfo, "access",
+			slog.String("correlation_id", rw.Header().Get("X-Request-ID")),
+			slog.String("http_method", r.Method),
+			slog.String("http_path", r.URL.Path),
+			slog.String("http_proto", r.Proto),
+			slog.Int("http_status", rw.statusCode),
+			slog.Int64("response_bytes", rw.bytesWritten),
+			slog.Float64("latency_ms", float64(duration.Microseconds())/1000),
+			slog.String("remote_addr", r.RemoteAddr),
+			slog.String("user_agent", r.UserAgent()),
+			slog.String("referer", r.Referer()),
+			slog.String("user_id", fields.userID),
+			slog.String("trace_id", fields.traceID),
+		)
+	})
+}
+
+// shouldSample applies the longest matching per-route rate, falling back to the global rate.
+func (a *AccessLogger) shouldSample(path string) bool {
+	rate := a.cfg.SampleRate
+	matched := -1
+
+	for prefix, routeRate := range a.cfg.RouteSampling {
+		if strings.HasPrefix(path, prefix) && len(prefix) > matched {
+			rate = routeRate
+			matched = len(prefix)
+		}
+	}
+
+	if rate >= 1 {
+		return true
+	}
+
+	if rate <= 0 {
+		return false
+	}
+
+	return a.sample() < rate
+}
+
+// writeCombined writes an Apache combined log line, with the request latency in microseconds appended.
+func (a *AccessLogger) writeCombined(r *http.Request, rw *responseWriter, fields *accessLogFields, start time.Time, duration time.Duration) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	user := "-"
+	if fields.userID != "" {
+		user = fields.userID
+	}
+
+	size := "-"
+	if rw.bytesWritten > 0 {
+		size = strconv.FormatInt(rw.bytesWritten, 10)
+	}
+
+	line := fmt.Sprintf("%s - %s [%s] \"%s %s %s\" %d %s %q %q %d\n",
+		host,
+		user,
+		start.Format("02/Jan/2006:15:04:05 -0700"),
+		r.Method,
+		r.URL.RequestURI(),
+		r.Proto,
+		rw.statusCode,
+		size,
+		r.Referer(),
+		r.UserAgent(),
+		duration.Microseconds(),
+	)
+
+	if _, err := io.WriteString(a.out, line); err != nil {
+		slog.Error("failed to write access log", slog.String("error", err.Error()))
+	}
+}
+
+// setAccessLogUserID records the authenticated user on the access log entry of the current request.
+func setAccessLogUserID(ctx context.Context, userID string) {
+	if fields, ok := ctx.Value(accessLogFieldsKey).(*accessLogFields); ok {
+		fields.userID = userID
+	}
+}
+
+// setAccessLogTraceID records the trace of the current request, the span is only started further down the chain.
+func setAccessLogTraceID(ctx context.Context) {
+	fields, ok := ctx.Value(accessLogFieldsKey).(*accessLogFields)
+	if !ok {
+		return
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		fields.traceID = sc.TraceID().String()
+	}
+}
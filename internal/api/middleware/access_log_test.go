@@ -0,0 +1,119 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessLogger(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte("hello"))
+		require.NoError(t, err)
+	})
+
+	failingHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	t.Run("JSON format", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		cfg := &config.AccessLogConfig{Enabled: true, Format: middleware.AccessLogFormatJSON, SampleRate: 1}
+		handler := middleware.NewAccessLogger(cfg, &buf).Handler(okHandler)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/products", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, req)
+
+		// Assert
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, "access", entry["msg"])
+		assert.Equal(t, "POST", entry["http_method"])
+		assert.Equal(t, "/api/v1/products", entry["http_path"])
+		assert.InDelta(t, http.StatusCreated, entry["http_status"], 0)
+		assert.InDelta(t, 5, entry["response_bytes"], 0)
+		assert.Contains(t, entry, "latency_ms")
+	})
+
+	t.Run("Combined format", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		cfg := &config.AccessLogConfig{Enabled: true, Format: middleware.AccessLogFormatCombined, SampleRate: 1}
+		handler := middleware.NewAccessLogger(cfg, &buf).Handler(okHandler)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/products?page=2", http.NoBody)
+		req.Header.Set("User-Agent", "test-agent")
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, req)
+
+		// Assert
+		line := buf.String()
+		assert.True(t, strings.HasPrefix(line, "192.0.2.1 - - ["))
+		assert.Contains(t, line, `"GET /api/v1/products?page=2 HTTP/1.1" 201 5 "" "test-agent"`)
+	})
+
+	t.Run("Route sampling drops health checks", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		cfg := &config.AccessLogConfig{
+			Enabled:       true,
+			Format:        middleware.AccessLogFormatJSON,
+			SampleRate:    1,
+			RouteSampling: map[string]float64{"/livez": 0},
+		}
+		handler := middleware.NewAccessLogger(cfg, &buf).Handler(okHandler)
+
+		// Act
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/livez", http.NoBody))
+
+		// Assert
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("Server errors bypass sampling", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		cfg := &config.AccessLogConfig{Enabled: true, Format: middleware.AccessLogFormatJSON, SampleRate: 0}
+		handler := middleware.NewAccessLogger(cfg, &buf).Handler(failingHandler)
+
+		// Act
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/orders", http.NoBody))
+
+		// Assert
+		assert.Contains(t, buf.String(), `"http_status":500`)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		cfg := &config.AccessLogConfig{Enabled: false, SampleRate: 1}
+		handler := middleware.NewAccessLogger(cfg, &buf).Handler(okHandler)
+
+		// Act
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+		// Assert
+		assert.Empty(t, buf.String())
+	})
+}
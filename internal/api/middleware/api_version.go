@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+const APIVersionHeader = "Api-Version"
+
+// Response variants selectable by clients. A new version is only added for breaking payload
+// changes, handlers fall back to the oldest serializer for anything they do not know about.
+const (
+	APIVersion1 = "1" // money as a decimal number
+	APIVersion2 = "2" // money as an object in the smallest currency unit
+)
+
+var supportedAPIVersions = []string{APIVersion1, APIVersion2}
+
+const apiVersionKey = logContextKey("api_version")
+
+func IsSupportedAPIVersion(version string) bool {
+	return slices.Contains(supportedAPIVersions, version)
+}
+
+// APIVersion resolves the Api-Version request header, falling back to defaultVersion so that
+// existing clients keep their payloads until they opt in. The resolved version is echoed back.
+func APIVersion(defaultVersion string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			version := strings.TrimSpace(r.Header.Get(APIVersionHeader))
+			if version == "" {
+				version = defaultVersion
+			}
+
+			w.Header().Add("Vary", APIVersionHeader)
+
+			if !IsSupportedAPIVersion(version) {
+				LoggerFromContext(r.Context()).Warn("Unsupported API version requested", slog.String("apiVersion", version))
+				response.Error(w, appErrors.BadRequestError("Unsupported Api-Version, expected one of "+strings.Join(supportedAPIVersions, ", ")))
+
+				return
+			}
+
+			w.Header().Set(APIVersionHeader, version)
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiVersionKey, version)))
+		})
+	}
+}
+
+// APIVersionFromContext returns the version negotiated for the request, APIVersion1 outside of the middleware.
+func APIVersionFromContext(ctx context.Context) string {
+	if version, ok := ctx.Value(apiVersionKey).(string); ok {
+		return version
+	}
+
+	return APIVersion1
+}
@@ -0,0 +1,54 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIVersion(t *testing.T) {
+	var negotiated string
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		negotiated = middleware.APIVersionFromContext(r.Context())
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name            string
+		header          string
+		expectedStatus  int
+		expectedVersion string
+	}{
+		{name: "Default version", header: "", expectedStatus: http.StatusOK, expectedVersion: middleware.APIVersion1},
+		{name: "Requested version", header: "2", expectedStatus: http.StatusOK, expectedVersion: middleware.APIVersion2},
+		{name: "Unsupported version", header: "99", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			negotiated = ""
+
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			if tc.header != "" {
+				req.Header.Set(middleware.APIVersionHeader, tc.header)
+			}
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			middleware.APIVersion(middleware.APIVersion1)(nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			assert.Equal(t, tc.expectedVersion, negotiated)
+			assert.Equal(t, tc.expectedVersion, rr.Header().Get(middleware.APIVersionHeader))
+			assert.Equal(t, middleware.APIVersionHeader, rr.Header().Get("Vary"))
+		})
+	}
+}
@@ -5,11 +5,15 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/logging"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
 	models "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -19,15 +23,70 @@ type contextKey uuid.UUID
 
 var UserContextKey = contextKey(uuid.New())
 
+// SessionChecker tells whether the session a token was issued for has been revoked.
+type SessionChecker interface {
+	IsSessionRevoked(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// TokenValidation is what a token has to claim besides its signature and lifetime. An empty Issuer or
+// Audience is not checked, Leeway tolerates clock skew between hosts on the exp, nbf and iat claims.
+type TokenValidation struct {
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+}
+
+// Reasons a token is refused for, reported in the rejection metrics.
+const (
+	rejectMissingHeader    = "missing_header"
+	rejectMalformedHeader  = "malformed_header"
+	rejectSigningMethod    = "signing_method"
+	rejectMalformed        = "malformed"
+	rejectInvalidSignature = "invalid_signature"
+	rejectExpired          = "expired"
+	rejectNotYetValid      = "not_yet_valid"
+	rejectInvalidIssuer    = "invalid_issuer"
+	rejectInvalidAudience  = "invalid_audience"
+	rejectInvalid          = "invalid"
+	rejectInvalidSession   = "invalid_session"
+	rejectRevokedSession   = "revoked_session"
+	rejectMissingScope     = "missing_scope"
+)
+
 type AuthMiddleware struct {
-	jwtKey []byte
+	jwtKey   []byte
+	parser   *jwt.Parser
+	sessions SessionChecker
 }
 
-func NewAuthMiddleware(jwtKey []byte) *AuthMiddleware {
-	return &AuthMiddleware{jwtKey: jwtKey}
+// NewAuthMiddleware refuses the tokens of revoked sessions, no session is checked while sessions is nil.
+// Tokens carrying no session, like the impersonation ones, are only checked for their claims.
+func NewAuthMiddleware(jwtKey []byte, validation TokenValidation, sessions SessionChecker) *AuthMiddleware {
+	options := []jwt.ParserOption{jwt.WithLeeway(validation.Leeway), jwt.WithIssuedAt()}
+
+	if validation.Issuer != "" {
+		options = append(options, jwt.WithIssuer(validation.Issuer))
+	}
+
+	if validation.Audience != "" {
+		options = append(options, jwt.WithAudience(validation.Audience))
+	}
+
+	return &AuthMiddleware{jwtKey: jwtKey, parser: jwt.NewParser(options...), sessions: sessions}
 }
 
+// Authenticate lets in user tokens only, machine client tokens are refused on routes without a scope.
 func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
+	return m.authenticate("", next)
+}
+
+// AuthenticateScope is Authenticate for the routes open to machine clients, whose tokens have to carry
+// the scope. Client tokens pass the role checks behind it, the scope is what grants them access.
+func (m *AuthMiddleware) AuthenticateScope(scope string, next http.Handler) http.HandlerFunc {
+	return m.authenticate(scope, next)
+}
+
+func (m *AuthMiddleware) authenticate(scope string, next http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := LoggerFromContext(r.Context())
 
@@ -36,6 +95,7 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
 
 		if authHeader == "" {
 			logger.Warn("Missing authorization header")
+			metrics.RecordTokenRejected(rejectMissingHeader)
 			response.Error(w, appErrors.UnauthorizedError("Authorization header is required"))
 
 			return
@@ -46,6 +106,7 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
 
 		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
 			logger.Warn("Invalid authorization header format", slog.String("header", authHeader))
+			metrics.RecordTokenRejected(rejectMalformedHeader)
 			response.Error(w, appErrors.UnauthorizedError("Invalid authorization format"))
 
 			return
@@ -56,7 +117,7 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
 		// Stores the decoded information
 		claims := &models.Claims{}
 
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		token, err := m.parser.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
 			// check the signing method
 			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok || t.Header["alg"] != jwt.SigningMethodHS256.Alg() {
 				logger.Error("Unexpected signing method used in JWT", slog.Any("alg", t.Header["alg"]))
@@ -68,6 +129,7 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
 		})
 		if err != nil {
 			logger.Warn("JWT parsing failed", slog.String("error", err.Error()))
+			metrics.RecordTokenRejected(rejectionReason(err))
 
 			var appErr *appErrors.AppError
 			if errors.As(err, &appErr) && appErr.Code == appErrors.ErrCodeBadRequest {
@@ -82,22 +144,69 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
 
 		if !token.Valid {
 			logger.Warn("Invalid token")
+			metrics.RecordTokenRejected(rejectInvalid)
 			response.Error(w, appErrors.UnauthorizedError("Invalid token"))
 
 			return
 		}
 
-		if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(time.Now()) {
-			logger.Warn("Expired token", slog.String("userId", claims.UserID.String()))
-			response.Error(w, appErrors.UnauthorizedError("Token expired"))
+		if claims.ClientID != nil {
+			m.serveClient(w, r, claims, scope, next)
 
 			return
 		}
 
+		if m.sessions != nil && claims.ID != "" {
+			sessionID, err := uuid.Parse(claims.ID)
+			if err != nil {
+				logger.Warn("Invalid session in token", slog.String("userId", claims.UserID.String()))
+				metrics.RecordTokenRejected(rejectInvalidSession)
+				response.Error(w, appErrors.UnauthorizedError("Invalid token"))
+
+				return
+			}
+
+			revoked, err := m.sessions.IsSessionRevoked(r.Context(), sessionID)
+			if err != nil {
+				logger.Error("Failed to check session", slog.String("sessionId", claims.ID), slog.String("error", err.Error()))
+				response.Error(w, appErrors.DatabaseError("Failed to check session"))
+
+				return
+			}
+
+			if revoked {
+				logger.Warn("Token of a revoked session", slog.String("userId", claims.UserID.String()), slog.String("sessionId", claims.ID))
+				metrics.RecordTokenRejected(rejectRevokedSession)
+				response.Error(w, appErrors.UnauthorizedError("Session revoked"))
+
+				return
+			}
+		}
+
+		if claims.Impersonation != nil {
+			logger = logger.With(slog.String("impersonatorId", claims.Impersonation.ActorID.String()))
+
+			if claims.Impersonation.ReadOnly && !isReadOnlyMethod(r.Method) {
+				logger.Warn("Write attempt with a read-only impersonation token", slog.String("userId", claims.UserID.String()))
+				response.Error(w, appErrors.ForbiddenError("Impersonation token is read-only"))
+
+				return
+			}
+
+			// Every request made as the customer is traced back to the agent
+			logger.Info("Impersonated request", slog.String("userId", claims.UserID.String()), slog.String("method", r.Method), slog.String("path", r.URL.Path))
+		}
+
 		// Add userId to the context
 		// It attaches a new key-value pair ("user": claims) to the context.
 		ctx := context.WithValue(r.Context(), UserContextKey, claims)
 
+		setAccessLogUserID(ctx, claims.UserID.String())
+		setPayloadLogUserID(ctx, claims.UserID)
+
+		ctx = tracing.WithIdentifier(ctx, tracing.UserIDKey, claims.UserID.String())
+		ctx = logging.WithUserID(ctx, claims.UserID.String())
+
 		requestScopedLogger := logger.With(slog.String("userId", claims.UserID.String()))
 		ctx = context.WithValue(ctx, LoggerKey, requestScopedLogger)
 
@@ -106,3 +215,127 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.HandlerFunc {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 }
+
+// serveClient lets a machine client token carrying the scope of the route through. The token names no
+// user, the request is logged and traced as the client.
+func (m *AuthMiddleware) serveClient(w http.ResponseWriter, r *http.Request, claims *models.Claims, scope string, next http.Handler) {
+	logger := LoggerFromContext(r.Context()).With(slog.String("clientId", claims.ClientID.String()))
+
+	if scope == "" || !slices.Contains(strings.Fields(claims.Scope), scope) {
+		logger.Warn("Client token without the scope of the route", slog.String("required", scope), slog.String("scope", claims.Scope))
+		metrics.RecordTokenRejected(rejectMissingScope)
+		response.Error(w, appErrors.ForbiddenError("Insufficient scope"))
+
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), UserContextKey, claims)
+
+	setAccessLogUserID(ctx, "client:"+claims.ClientID.String())
+
+	ctx = context.WithValue(ctx, LoggerKey, logger)
+
+	logger.Info("Client authenticated", slog.String("scope", scope))
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RequireRole only lets through users whose token carries the given role. It expects to run after Authenticate.
+func (m *AuthMiddleware) RequireRole(role models.UserRole, next http.Handler) http.HandlerFunc {
+	return m.RequireAnyRole([]models.UserRole{role}, next)
+}
+
+// RequireAnyRole is RequireRole for endpoints shared by several roles. Machine client tokens only get
+// this far through AuthenticateScope, which already checked their scope.
+func (m *AuthMiddleware) RequireAnyRole(roles []models.UserRole, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Error("Failed to get user claims from context")
+			response.Error(w, appErrors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		if claims.ClientID == nil && !slices.Contains(roles, claims.Role) {
+			logger.Warn("Insufficient role", slog.Any("required", roles), slog.String("role", string(claims.Role)))
+			response.Error(w, appErrors.ForbiddenError("Insufficient permissions"))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// RequireVendor only lets through vendor tokens scoped to a vendor, handlers behind it read the vendor
+// from the claims. It expects to run after Authenticate.
+func (m *AuthMiddleware) RequireVendor(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := LoggerFromContext(r.Context())
+
+		claims, ok := r.Context().Value(UserContextKey).(*models.Claims)
+		if !ok {
+			logger.Error("Failed to get user claims from context")
+			response.Error(w, appErrors.UnauthorizedError("Authentication required"))
+
+			return
+		}
+
+		if claims.Role != models.RoleVendor || claims.VendorID == nil {
+			logger.Warn("Vendor token required", slog.String("role", string(claims.Role)))
+			response.Error(w, appErrors.ForbiddenError("Vendor account required"))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// DenyImpersonation guards sensitive operations (e.g. payments) that a support agent must not perform
+// on behalf of a customer, even with write access. It expects to run after Authenticate.
+func (m *AuthMiddleware) DenyImpersonation(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value(UserContextKey).(*models.Claims)
+		if ok && claims.Impersonation != nil {
+			LoggerFromContext(r.Context()).Warn("Sensitive operation refused under impersonation")
+			response.Error(w, appErrors.ForbiddenError("Not allowed while impersonating a customer"))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// rejectionReason tells why the parser refused a token, the signing method check fails with a bad
+// request before the jwt errors are even looked at.
+func rejectionReason(err error) string {
+	var appErr *appErrors.AppError
+
+	switch {
+	case errors.As(err, &appErr):
+		return rejectSigningMethod
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return rejectMalformed
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		return rejectInvalidSignature
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return rejectExpired
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return rejectNotYetValid
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return rejectInvalidIssuer
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return rejectInvalidAudience
+	default:
+		return rejectInvalid
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
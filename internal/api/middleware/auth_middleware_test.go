@@ -2,6 +2,7 @@ package middleware_test
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -36,7 +37,7 @@ func createTestToken(userID uuid.UUID, email string, duration time.Duration, key
 
 func TestAuthMiddleware(t *testing.T) {
 	// Arrange
-	authMiddleware := middleware.NewAuthMiddleware(testJwtKey)
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
 	userID := uuid.New()
 	userEmail := "test@example.com"
 
@@ -187,6 +188,457 @@ func TestAuthMiddleware(t *testing.T) {
 
 func TestNewAuthMiddleware(t *testing.T) {
 	key := []byte("some-key")
-	mw := middleware.NewAuthMiddleware(key)
+	mw := middleware.NewAuthMiddleware(key, middleware.TokenValidation{}, nil)
 	assert.NotNil(t, mw, "Middleware should not be nil")
 }
+
+func TestRequireRole(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		claims         *models.Claims
+		expectedStatus int
+	}{
+		{
+			name:           "Matching role",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleAdmin},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Different role",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleCustomer},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Missing claims",
+			claims:         nil,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			if tc.claims != nil {
+				req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, tc.claims))
+			}
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.RequireRole(models.RoleAdmin, nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestRequireAnyRole(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+	roles := []models.UserRole{models.RoleStaff, models.RoleAdmin}
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		role           models.UserRole
+		expectedStatus int
+	}{
+		{name: "Staff", role: models.RoleStaff, expectedStatus: http.StatusOK},
+		{name: "Admin", role: models.RoleAdmin, expectedStatus: http.StatusOK},
+		{name: "Customer", role: models.RoleCustomer, expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, &models.Claims{UserID: uuid.New(), Role: tc.role}))
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.RequireAnyRole(roles, nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestRequireVendor(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+	vendorID := uuid.New()
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		claims         *models.Claims
+		expectedStatus int
+	}{
+		{
+			name:           "Vendor",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleVendor, VendorID: &vendorID},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Vendor without scope",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleVendor},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Admin",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleAdmin},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, tc.claims))
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.RequireVendor(nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func createImpersonationToken(t *testing.T, readOnly bool) string {
+	t.Helper()
+
+	claims := &models.Claims{
+		UserID:        uuid.New(),
+		Role:          models.RoleCustomer,
+		Impersonation: &models.Impersonation{ActorID: uuid.New(), ReadOnly: readOnly, Reason: "ticket 42"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testJwtKey)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestAuthenticateImpersonation(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		require.True(t, ok)
+		require.NotNil(t, claims.Impersonation)
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		method         string
+		readOnly       bool
+		expectedStatus int
+	}{
+		{name: "Read-only token reads", method: http.MethodGet, readOnly: true, expectedStatus: http.StatusOK},
+		{name: "Read-only token writes", method: http.MethodPost, readOnly: true, expectedStatus: http.StatusForbidden},
+		{name: "Write token writes", method: http.MethodPost, readOnly: false, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(tc.method, "/", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+createImpersonationToken(t, tc.readOnly))
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.Authenticate(nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+// fakeSessions knows the revoked sessions, or fails every check when err is set.
+type fakeSessions struct {
+	revoked map[uuid.UUID]bool
+	err     error
+}
+
+func (f *fakeSessions) IsSessionRevoked(_ context.Context, id uuid.UUID) (bool, error) {
+	return f.revoked[id], f.err
+}
+
+func createSessionToken(t *testing.T, sessionID string) string {
+	t.Helper()
+
+	claims := &models.Claims{
+		UserID: uuid.New(),
+		Role:   models.RoleCustomer,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testJwtKey)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestAuthenticateSession(t *testing.T) {
+	active, revoked := uuid.New(), uuid.New()
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		sessions       *fakeSessions
+		sessionID      string
+		expectedStatus int
+	}{
+		{name: "Active session", sessions: &fakeSessions{revoked: map[uuid.UUID]bool{revoked: true}}, sessionID: active.String(), expectedStatus: http.StatusOK},
+		{name: "Revoked session", sessions: &fakeSessions{revoked: map[uuid.UUID]bool{revoked: true}}, sessionID: revoked.String(), expectedStatus: http.StatusUnauthorized},
+		{name: "Token without session", sessions: &fakeSessions{err: errors.New("db down")}, sessionID: "", expectedStatus: http.StatusOK},
+		{name: "Malformed session", sessions: &fakeSessions{}, sessionID: "not-a-uuid", expectedStatus: http.StatusUnauthorized},
+		{name: "Check fails", sessions: &fakeSessions{err: errors.New("db down")}, sessionID: active.String(), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, tc.sessions)
+
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+createSessionToken(t, tc.sessionID))
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.Authenticate(nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestDenyImpersonation(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		claims         *models.Claims
+		expectedStatus int
+	}{
+		{
+			name:           "Customer",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleCustomer},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Impersonating agent",
+			claims:         &models.Claims{UserID: uuid.New(), Role: models.RoleCustomer, Impersonation: &models.Impersonation{ActorID: uuid.New()}},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, tc.claims))
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.DenyImpersonation(nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestAuthenticateTokenValidation(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{
+		Issuer:   "shop",
+		Audience: "shop-api",
+		Leeway:   30 * time.Second,
+	}, nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	validClaims := func() jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Issuer:    "shop",
+			Audience:  jwt.ClaimStrings{"shop-api"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		claims         func(c *jwt.RegisteredClaims)
+		expectedStatus int
+	}{
+		{"Success - Expected issuer and audience", func(_ *jwt.RegisteredClaims) {}, http.StatusOK},
+		{"Success - Audience among several", func(c *jwt.RegisteredClaims) { c.Audience = jwt.ClaimStrings{"admin", "shop-api"} }, http.StatusOK},
+		{"Success - Expiry within the leeway", func(c *jwt.RegisteredClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-10 * time.Second)) }, http.StatusOK},
+		{"Success - Not before within the leeway", func(c *jwt.RegisteredClaims) { c.NotBefore = jwt.NewNumericDate(time.Now().Add(10 * time.Second)) }, http.StatusOK},
+		{"Fail - Other issuer", func(c *jwt.RegisteredClaims) { c.Issuer = "elsewhere" }, http.StatusUnauthorized},
+		{"Fail - Missing issuer", func(c *jwt.RegisteredClaims) { c.Issuer = "" }, http.StatusUnauthorized},
+		{"Fail - Other audience", func(c *jwt.RegisteredClaims) { c.Audience = jwt.ClaimStrings{"admin"} }, http.StatusUnauthorized},
+		{"Fail - Missing audience", func(c *jwt.RegisteredClaims) { c.Audience = nil }, http.StatusUnauthorized},
+		{"Fail - Expired beyond the leeway", func(c *jwt.RegisteredClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) }, http.StatusUnauthorized},
+		{"Fail - Not valid yet", func(c *jwt.RegisteredClaims) { c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Minute)) }, http.StatusUnauthorized},
+		{"Fail - Issued in the future", func(c *jwt.RegisteredClaims) { c.IssuedAt = jwt.NewNumericDate(time.Now().Add(time.Minute)) }, http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			registered := validClaims()
+			tc.claims(&registered)
+
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &models.Claims{UserID: uuid.New(), RegisteredClaims: registered}).SignedString(testJwtKey)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			authMiddleware.Authenticate(next).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestAuthenticateScope(t *testing.T) {
+	authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+	clientID := uuid.New()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value(middleware.UserContextKey).(*models.Claims)
+		require.True(t, ok)
+		assert.Equal(t, uuid.Nil, claims.UserID)
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	clientToken := func(t *testing.T, scope string) string {
+		t.Helper()
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &models.Claims{
+			ClientID: &clientID,
+			Scope:    scope,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   clientID.String(),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+			},
+		}).SignedString(testJwtKey)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	tests := []struct {
+		name           string
+		token          func(t *testing.T) string
+		handler        http.Handler
+		expectedStatus int
+	}{
+		{
+			name:           "Success - Client token with the scope",
+			token:          func(t *testing.T) string { return clientToken(t, "catalog:read orders:write") },
+			handler:        authMiddleware.AuthenticateScope(models.ScopeOrdersWrite, next),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Success - Client token passes the role check behind the scope",
+			token:          func(t *testing.T) string { return clientToken(t, models.ScopeOrdersWrite) },
+			handler:        authMiddleware.AuthenticateScope(models.ScopeOrdersWrite, authMiddleware.RequireRole(models.RoleAdmin, next)),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Success - User token on a scoped route",
+			token: func(t *testing.T) string {
+				token, err := createTestToken(uuid.Nil, "test@example.com", time.Hour, testJwtKey, jwt.SigningMethodHS256)
+				require.NoError(t, err)
+
+				return token
+			},
+			handler:        authMiddleware.AuthenticateScope(models.ScopeCatalogRead, next),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Fail - Client token without the scope",
+			token:          func(t *testing.T) string { return clientToken(t, models.ScopeCatalogRead) },
+			handler:        authMiddleware.AuthenticateScope(models.ScopeCatalogWrite, next),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Fail - Scope is matched whole, not as a prefix",
+			token:          func(t *testing.T) string { return clientToken(t, "catalog:read") },
+			handler:        authMiddleware.AuthenticateScope("catalog", next),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Fail - Client token on a route without a scope",
+			token: func(t *testing.T) string {
+				return clientToken(t, "catalog:read catalog:write orders:read orders:write")
+			},
+			handler:        authMiddleware.Authenticate(next),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+tc.token(t))
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			tc.handler.ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBasicAuth(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name         string
+		username     string
+		password     string
+		credentials  []string // nil sends no Authorization header
+		expectedCode int
+	}{
+		{name: "Valid credentials", username: "prometheus", password: "s3cret", credentials: []string{"prometheus", "s3cret"}, expectedCode: http.StatusOK},
+		{name: "Wrong password", username: "prometheus", password: "s3cret", credentials: []string{"prometheus", "guess"}, expectedCode: http.StatusUnauthorized},
+		{name: "Wrong username", username: "prometheus", password: "s3cret", credentials: []string{"admin", "s3cret"}, expectedCode: http.StatusUnauthorized},
+		{name: "No credentials", username: "prometheus", password: "s3cret", expectedCode: http.StatusUnauthorized},
+		{name: "Disabled without a username", expectedCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody)
+			if tt.credentials != nil {
+				req.SetBasicAuth(tt.credentials[0], tt.credentials[1])
+			}
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			middleware.BasicAuth(tt.username, tt.password)(okHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, tt.expectedCode, rr.Code)
+
+			if tt.expectedCode == http.StatusUnauthorized {
+				assert.Contains(t, rr.Header().Get("WWW-Authenticate"), "Basic")
+			}
+		})
+	}
+}
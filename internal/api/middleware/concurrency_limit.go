@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	models "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+// ConcurrencyLimiter bounds how many requests a single principal can have in flight at once.
+// Unlike the rate limiter it does not care about request frequency, it keeps one user from
+// tying up the workers with slow endpoints such as checkout or report generation.
+type ConcurrencyLimiter struct {
+	limit int
+
+	mu       sync.Mutex
+	inFlight map[string]int
+}
+
+func NewConcurrencyLimiter(limit int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		limit:    limit,
+		inFlight: make(map[string]int),
+	}
+}
+
+// Limit rejects the request with 429 when its principal already has the maximum number of requests
+// in flight. Authenticated requests are keyed by user, so it has to run after Authenticate.
+func (l *ConcurrencyLimiter) Limit(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if l.limit <= 0 {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		principal := requestPrincipal(r)
+
+		if !l.acquire(principal) {
+			LoggerFromContext(r.Context()).Warn("Concurrent request limit reached",
+				slog.String("principal", principal),
+				slog.Int("limit", l.limit),
+			)
+			w.Header().Set("Retry-After", "1")
+			response.Error(w, appErrors.TooManyRequestsError("Too many concurrent requests, please retry once the previous ones complete"))
+
+			return
+		}
+		defer l.release(principal)
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+func (l *ConcurrencyLimiter) acquire(principal string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inFlight[principal] >= l.limit {
+		return false
+	}
+
+	l.inFlight[principal]++
+
+	return true
+}
+
+func (l *ConcurrencyLimiter) release(principal string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Idle principals are dropped so the map only holds the ones with requests in flight
+	if l.inFlight[principal] <= 1 {
+		delete(l.inFlight, principal)
+
+		return
+	}
+
+	l.inFlight[principal]--
+}
+
+func requestPrincipal(r *http.Request) string {
+	if claims, ok := r.Context().Value(UserContextKey).(*models.Claims); ok {
+		return "user:" + claims.UserID.String()
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return "ip:" + host
+}
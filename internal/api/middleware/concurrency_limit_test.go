@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	newUserRequest := func(userID uuid.UUID) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody)
+
+		return req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, &models.Claims{UserID: userID}))
+	}
+
+	// blockingHandler holds every request until release is closed, so the test controls what is in flight
+	blockingHandler := func(started chan<- struct{}, release <-chan struct{}) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			started <- struct{}{}
+			<-release
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+
+	t.Run("Rejects requests over the limit of the same user", func(t *testing.T) {
+		// Arrange
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan int)
+
+		handler := middleware.NewConcurrencyLimiter(1).Limit(blockingHandler(started, release))
+		userID := uuid.New()
+
+		go func() {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newUserRequest(userID))
+			done <- rr.Code
+		}()
+
+		<-started
+
+		// Act
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, newUserRequest(userID))
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+
+		close(release)
+		assert.Equal(t, http.StatusOK, <-done)
+	})
+
+	t.Run("Users and IPs are limited independently", func(t *testing.T) {
+		// Arrange
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan int, 2)
+
+		handler := middleware.NewConcurrencyLimiter(1).Limit(blockingHandler(started, release))
+
+		go func() {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newUserRequest(uuid.New()))
+			done <- rr.Code
+		}()
+
+		<-started
+
+		// Act
+		go func() {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody))
+			done <- rr.Code
+		}()
+
+		<-started
+		close(release)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, <-done)
+		assert.Equal(t, http.StatusOK, <-done)
+	})
+
+	t.Run("Slots are released once the request completes", func(t *testing.T) {
+		// Arrange
+		handler := middleware.NewConcurrencyLimiter(1).Limit(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		userID := uuid.New()
+
+		for range 3 {
+			// Act
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newUserRequest(userID))
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rr.Code)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		// Arrange
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		done := make(chan int, 2)
+
+		handler := middleware.NewConcurrencyLimiter(0).Limit(blockingHandler(started, release))
+		userID := uuid.New()
+
+		// Act
+		for range 2 {
+			go func() {
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, newUserRequest(userID))
+				done <- rr.Code
+			}()
+		}
+
+		<-started
+		<-started
+		close(release)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, <-done)
+		assert.Equal(t, http.StatusOK, <-done)
+	})
+}
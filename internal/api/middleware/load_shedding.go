@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+)
+
+const (
+	latencySamples        = 1024
+	latencyWindow         = 10 * time.Second // older samples no longer count towards the p99
+	minLatencySamples     = 50               // below this the p99 is just noise
+	p99RefreshInterval    = time.Second
+	p99RefreshObservation = 32
+)
+
+type latencySample struct {
+	at       time.Time
+	duration time.Duration
+}
+
+// LoadShedder turns away low-priority traffic with a 503 while the service is overloaded, so that
+// checkout, payments and webhooks keep being served instead of the whole service degrading.
+// Overload is measured on the requests in flight and on the p99 latency of the last few seconds.
+type LoadShedder struct {
+	cfg      *config.LoadSheddingConfig
+	inFlight atomic.Int64
+
+	mu         sync.Mutex
+	samples    [latencySamples]latencySample
+	next       int
+	p99        time.Duration
+	p99At      time.Time
+	sinceCheck int
+}
+
+func NewLoadShedder(cfg *config.LoadSheddingConfig) *LoadShedder {
+	return &LoadShedder{cfg: cfg}
+}
+
+func (s *LoadShedder) Handler(next http.Handler) http.Handler {
+	if !s.cfg.Enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight := s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+
+		if s.isLowPriority(r) {
+			if reason := s.overloaded(inFlight); reason != "" {
+				LoggerFromContext(r.Context()).Debug("Request shed", slog.String("reason", reason), slog.Int64("inFlight", inFlight))
+				w.Header().Set("Retry-After", "1")
+				response.Error(w, appErrors.ServiceUnavailableError("Service is under heavy load, please retry shortly"))
+
+				return
+			}
+		}
+
+		start := time.Now()
+
+		next.ServeHTTP(w, r)
+
+		s.observe(start, time.Since(start))
+	})
+}
+
+// isLowPriority reports whether the request may be shed. Only reads are, a write is never dropped halfway through a flow.
+func (s *LoadShedder) isLowPriority(r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	return slices.ContainsFunc(s.cfg.LowPriorityRoutes, func(prefix string) bool {
+		return strings.HasPrefix(r.URL.Path, prefix)
+	})
+}
+
+// overloaded returns why the service is considered overloaded, or "" when it is not.
+func (s *LoadShedder) overloaded(inFlight int64) string {
+	if s.cfg.MaxInFlight > 0 && inFlight > int64(s.cfg.MaxInFlight) {
+		return "in_flight"
+	}
+
+	if s.cfg.P99Target > 0 && s.currentP99(time.Now()) > s.cfg.P99Target {
+		return "p99_latency"
+	}
+
+	return ""
+}
+
+func (s *LoadShedder) observe(at time.Time, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.samples[s.next] = latencySample{at: at, duration: duration}
+	s.next = (s.next + 1) % latencySamples
+	s.sinceCheck++
+}
+
+// currentP99 is recomputed at most every p99RefreshInterval, or sooner once enough new samples came in.
+func (s *LoadShedder) currentP99(now time.Time) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.p99At) < p99RefreshInterval && s.sinceCheck < p99RefreshObservation {
+		return s.p99
+	}
+
+	oldest := now.Add(-latencyWindow)
+	durations := make([]time.Duration, 0, latencySamples)
+
+	for _, sample := range s.samples {
+		if sample.at.After(oldest) {
+			durations = append(durations, sample.duration)
+		}
+	}
+
+	s.p99 = 0
+	s.p99At = now
+	s.sinceCheck = 0
+
+	if len(durations) < minLatencySamples {
+		return 0
+	}
+
+	slices.Sort(durations)
+	s.p99 = durations[(len(durations)*99+99)/100-1]
+
+	return s.p99
+}
@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadShedder(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve := func(handler http.Handler, method, target string) int {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(method, target, http.NoBody))
+
+		return rr.Code
+	}
+
+	t.Run("Sheds catalog reads when too many requests are in flight", func(t *testing.T) {
+		// Arrange
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan int)
+
+		cfg := &config.LoadSheddingConfig{Enabled: true, MaxInFlight: 1, LowPriorityRoutes: []string{"/api/v1/products"}}
+		handler := middleware.NewLoadShedder(cfg).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v1/orders" {
+				started <- struct{}{}
+				<-release
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		go func() {
+			done <- serve(handler, http.MethodPost, "/api/v1/orders")
+		}()
+
+		<-started
+
+		// Act
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/products?page=2", http.NoBody))
+
+		// Assert
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+		assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/payments/webhook"))
+		assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/products"))
+
+		close(release)
+		assert.Equal(t, http.StatusOK, <-done)
+		assert.Equal(t, http.StatusOK, serve(handler, http.MethodGet, "/api/v1/products"))
+	})
+
+	t.Run("Sheds catalog reads when the p99 latency exceeds the target", func(t *testing.T) {
+		// Arrange
+		cfg := &config.LoadSheddingConfig{Enabled: true, P99Target: time.Millisecond, LowPriorityRoutes: []string{"/api/v1/products"}}
+		handler := middleware.NewLoadShedder(cfg).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v1/orders" {
+				time.Sleep(2 * time.Millisecond)
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		assert.Equal(t, http.StatusOK, serve(handler, http.MethodGet, "/api/v1/products"))
+
+		for range 60 {
+			serve(handler, http.MethodPost, "/api/v1/orders")
+		}
+
+		// Act
+		code := serve(handler, http.MethodGet, "/api/v1/products")
+
+		// Assert
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		// Arrange
+		cfg := &config.LoadSheddingConfig{Enabled: false, MaxInFlight: 1, LowPriorityRoutes: []string{"/api/v1/products"}}
+		handler := middleware.NewLoadShedder(cfg).Handler(okHandler)
+
+		// Act
+		code := serve(handler, http.MethodGet, "/api/v1/products")
+
+		// Assert
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+)
+
+const TimezoneHeader = "X-Timezone"
+
+// Locale reads the language of the Accept-Language request header and the IANA timezone of the
+// X-Timezone one into the request context, see locale.FromContext. What a request leaves out, or sends
+// unreadable, comes from defaults, only asked for when needed.
+func Locale(defaults func(ctx context.Context) locale.Locale) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			requested := locale.Locale{Tag: locale.ParseAcceptLanguage(r.Header.Get("Accept-Language"))}
+
+			if timezone := strings.TrimSpace(r.Header.Get(TimezoneHeader)); timezone != "" {
+				location, err := time.LoadLocation(timezone)
+				if err != nil {
+					LoggerFromContext(ctx).Warn("Ignoring unknown timezone", slog.String("timezone", timezone))
+				} else {
+					requested.Location = location
+				}
+			}
+
+			ctx = locale.WithRequested(ctx, requested, func() locale.Locale { return defaults(ctx) })
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
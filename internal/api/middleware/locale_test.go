@@ -0,0 +1,63 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale(t *testing.T) {
+	var resolved locale.Locale
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resolved = locale.FromContext(r.Context())
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name             string
+		acceptLanguage   string
+		timezone         string
+		expectedTag      string
+		expectedTimezone string
+		expectedDefaults int
+	}{
+		{name: "Store defaults", expectedTag: "de-DE", expectedTimezone: "Europe/Berlin", expectedDefaults: 1},
+		{name: "Requested locale", acceptLanguage: "fr-CH, fr;q=0.9, en;q=0.8", timezone: "America/New_York", expectedTag: "fr-CH", expectedTimezone: "America/New_York"},
+		{name: "Language only", acceptLanguage: "ja", expectedTag: "ja", expectedTimezone: "Europe/Berlin", expectedDefaults: 1},
+		{name: "Unknown timezone ignored", timezone: "Mars/Olympus_Mons", expectedTag: "de-DE", expectedTimezone: "Europe/Berlin", expectedDefaults: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			calls := 0
+			defaults := func(context.Context) locale.Locale {
+				calls++
+
+				return locale.New("de-DE", "Europe/Berlin")
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			req.Header.Set(middleware.TimezoneHeader, tc.timezone)
+
+			rr := httptest.NewRecorder()
+
+			// Act
+			middleware.Locale(defaults)(nextHandler).ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tc.expectedTag, resolved.Tag)
+			assert.Equal(t, tc.expectedTimezone, resolved.Location.String())
+			assert.Equal(t, tc.expectedDefaults, calls)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/logging"
 	"github.com/google/uuid"
 )
 
@@ -13,14 +14,15 @@ type logContextKey string
 
 const LoggerKey = logContextKey("logger")
 
-// wrapper around http.ResponseWriter to capture the status code.
+// wrapper around http.ResponseWriter to capture the status code and response size.
 type responseWriter struct {
 	http.ResponseWriter
-	statusCode int
+	statusCode   int
+	bytesWritten int64
 }
 
 func newResponseWriter(w http.ResponseWriter) *responseWriter {
-	return &responseWriter{w, http.StatusOK}
+	return &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 }
 
 func (rw *responseWriter) WriteHeader(code int) {
@@ -28,6 +30,18 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytesWritten += int64(n)
+
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the writer below, to flush or move the write deadline.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // main middleware.
 func Logging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -41,9 +55,12 @@ func Logging(next http.Handler) http.Handler {
 
 		w.Header().Set("X-Request-ID", correlationID)
 
+		setAccessLogTraceID(r.Context())
+
+		ctx := logging.WithRequestID(r.Context(), correlationID)
+
 		// Request-scoper logger, every log line would contain these fields
-		requestLogger := slog.Default().With(
-			slog.String("correlation_id", correlationID),
+		requestLogger := logging.With(ctx).With(
 			slog.String("http_method", r.Method),
 			slog.String("http_path", r.URL.Path),
 			slog.String("remote_addr", r.RemoteAddr),
@@ -53,7 +70,7 @@ func Logging(next http.Handler) http.Handler {
 		// Incoming request log
 		requestLogger.Info("Incoming request")
 
-		ctx := context.WithValue(r.Context(), LoggerKey, requestLogger)
+		ctx = context.WithValue(ctx, LoggerKey, requestLogger)
 
 		rw := newResponseWriter(w)
 
@@ -64,10 +81,12 @@ func Logging(next http.Handler) http.Handler {
 	})
 }
 
+// LoggerFromContext returns the request logger of ctx, or the default logger with the IDs ctx knows
+// about outside a request.
 func LoggerFromContext(ctx context.Context) *slog.Logger {
 	if logger, ok := ctx.Value(LoggerKey).(*slog.Logger); ok {
 		return logger
 	}
 
-	return slog.Default()
+	return logging.With(ctx)
 }
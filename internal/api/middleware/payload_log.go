@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/google/uuid"
+)
+
+const payloadLogUserKey = logContextKey("payload_log_user")
+
+// payloadLogUser is shared through the request context so that the auth middleware can tell the
+// payload logger who made the request, it only decides whether to log once the request is served.
+type payloadLogUser struct {
+	id uuid.UUID
+}
+
+// PayloadLogger logs the request and response bodies of the requests its rules pick, redacted, to
+// reproduce the API issues customers report. Passwords, tokens, secrets and card data are replaced,
+// and bodies larger than the cap are logged by their size only since a cut JSON document cannot be
+// redacted reliably. Requests go through untouched while there are no rules. The rules only hold on
+// the instance they are set on.
+type PayloadLogger struct {
+	rules    atomic.Pointer[models.PayloadLogRules]
+	maxBytes int
+	now      func() time.Time
+}
+
+func NewPayloadLogger(cfg *config.PayloadLogConfig) (*PayloadLogger, error) {
+	p := &PayloadLogger{maxBytes: max(cfg.MaxBytes, 1), now: time.Now}
+
+	rules := &models.PayloadLogRules{}
+
+	for _, route := range cfg.Routes {
+		if route = strings.TrimSpace(route); route != "" {
+			rules.Routes = append(rules.Routes, route)
+		}
+	}
+
+	for _, userID := range cfg.UserIDs {
+		if userID = strings.TrimSpace(userID); userID == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload log user ID %q: %w", userID, err)
+		}
+
+		rules.UserIDs = append(rules.UserIDs, id)
+	}
+
+	if len(rules.Routes) > 0 || len(rules.UserIDs) > 0 {
+		p.rules.Store(rules)
+	}
+
+	return p, nil
+}
+
+// Rules returns the rules in force, nil when there are none or they expired.
+func (p *PayloadLogger) Rules() *models.PayloadLogRules {
+	rules := p.rules.Load()
+	if rules == nil || (rules.ExpiresAt != nil && !p.now().Before(*rules.ExpiresAt)) {
+		return nil
+	}
+
+	return rules
+}
+
+// SetRules replaces the rules, nil stops the logging.
+func (p *PayloadLogger) SetRules(rules *models.PayloadLogRules) {
+	p.rules.Store(rules)
+}
+
+func (p *PayloadLogger) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rules := p.Rules()
+		if rules == nil {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		routeMatched := slices.ContainsFunc(rules.Routes, func(prefix string) bool { return strings.HasPrefix(r.URL.Path, prefix) })
+		if !routeMatched && len(rules.UserIDs) == 0 {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		requestBody, err := io.ReadAll(io.LimitReader(r.Body, int64(p.maxBytes)+1))
+		if err != nil {
+			LoggerFromContext(r.Context()).Warn("Failed to read the request body for the payload log", slog.String("error", err.Error()))
+		}
+
+		r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(requestBody), r.Body), Closer: r.Body}
+
+		user := &payloadLogUser{}
+		rw := &payloadResponseWriter{ResponseWriter: w, statusCode: http.StatusOK, maxBytes: p.maxBytes}
+
+		next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), payloadLogUserKey, user)))
+
+		if !routeMatched && !slices.Contains(rules.UserIDs, user.id) {
+			return
+		}
+
+		attrs := []any{
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("query", redactValues(r.URL.Query()).Encode()),
+			slog.Any("request_headers", redactHeaders(r.Header)),
+			slog.String("request_body", p.redactBody(r.Header.Get("Content-Type"), requestBody)),
+			slog.Int("status", rw.statusCode),
+			slog.String("response_body", p.redactBody(rw.Header().Get("Content-Type"), rw.body.Bytes())),
+		}
+
+		if user.id != uuid.Nil {
+			attrs = append(attrs, slog.String("userId", user.id.String()))
+		}
+
+		LoggerFromContext(r.Context()).Info("Request payload", attrs...)
+	})
+}
+
+// setPayloadLogUserID records the authenticated user of the current request for the payload logger.
+func setPayloadLogUserID(ctx context.Context, userID uuid.UUID) {
+	if user, ok := ctx.Value(payloadLogUserKey).(*payloadLogUser); ok {
+		user.id = userID
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// payloadResponseWriter keeps the first bytes of the response, one past the cap to tell a body over it.
+type payloadResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+	maxBytes   int
+}
+
+func (rw *payloadResponseWriter) WriteHeader(code int) {
+	rw.statusCode = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *payloadResponseWriter) Write(b []byte) (int, error) {
+	if room := rw.maxBytes + 1 - rw.body.Len(); room > 0 {
+		rw.body.Write(b[:min(room, len(b))])
+	}
+
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the writer below, streamed exports keep flushing.
+func (rw *payloadResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,174 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// servePayloadLogged serves a request through the payload logger and returns the payload log records.
+func servePayloadLogged(t *testing.T, payloadLogger *middleware.PayloadLogger, next http.Handler, req *http.Request) []map[string]any {
+	t.Helper()
+
+	var out bytes.Buffer
+
+	req = req.WithContext(context.WithValue(req.Context(), middleware.LoggerKey, slog.New(slog.NewJSONHandler(&out, nil))))
+	payloadLogger.Handler(next).ServeHTTP(httptest.NewRecorder(), req)
+
+	var records []map[string]any
+
+	for line := range strings.SplitSeq(strings.TrimSpace(out.String()), "\n") {
+		var record map[string]any
+		if line == "" || json.Unmarshal([]byte(line), &record) != nil || record["msg"] != "Request payload" {
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestPayloadLogger(t *testing.T) {
+	echoHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write(body)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Route logged redacted", func(t *testing.T) {
+		// Arrange
+		payloadLogger, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{Routes: []string{"/api/v1/payments"}, MaxBytes: 1024})
+		require.NoError(t, err)
+
+		body := `{"amount":10,"card":{"number":"4242424242424242"},"note":"card 4000 0566 5566 5556 please","order_id":"12345","password":"hunter2"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/payments?token=abc&page=2", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer abc.def.ghi")
+
+		// Act
+		records := servePayloadLogged(t, payloadLogger, echoHandler, req)
+
+		// Assert
+		require.Len(t, records, 1)
+		record := records[0]
+		assert.Equal(t, `{"amount":10,"card":"[REDACTED]","note":"card [REDACTED] please","order_id":"12345","password":"[REDACTED]"}`, record["request_body"])
+		assert.Equal(t, record["request_body"], record["response_body"])
+		assert.Equal(t, "page=2&token=%5BREDACTED%5D", record["query"])
+		assert.Equal(t, "[REDACTED]", record["request_headers"].(map[string]any)["Authorization"])
+		assert.InDelta(t, http.StatusCreated, record["status"], 0)
+	})
+
+	t.Run("Handler reads the whole body", func(t *testing.T) {
+		// Arrange
+		payloadLogger, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{Routes: []string{"/api/v1/"}, MaxBytes: 8})
+		require.NoError(t, err)
+
+		body := `{"description":"longer than the cap"}`
+		var served string
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			read, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			served = string(read)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/products", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		// Act
+		records := servePayloadLogged(t, payloadLogger, next, req)
+
+		// Assert
+		assert.Equal(t, body, served)
+		require.Len(t, records, 1)
+		assert.Equal(t, "[body over the 8 bytes cap]", records[0]["request_body"])
+	})
+
+	t.Run("Other routes not logged", func(t *testing.T) {
+		// Arrange
+		payloadLogger, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{Routes: []string{"/api/v1/payments"}, MaxBytes: 1024})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/products", nil)
+
+		// Act
+		records := servePayloadLogged(t, payloadLogger, echoHandler, req)
+
+		// Assert
+		assert.Empty(t, records)
+	})
+
+	t.Run("User logged once authenticated", func(t *testing.T) {
+		// Arrange
+		userID := uuid.New()
+		payloadLogger, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{UserIDs: []string{userID.String()}, MaxBytes: 1024})
+		require.NoError(t, err)
+
+		authMiddleware := middleware.NewAuthMiddleware(testJwtKey, middleware.TokenValidation{}, nil)
+		next := authMiddleware.Authenticate(echoHandler)
+
+		ask := func(id uuid.UUID) []map[string]any {
+			token, err := createTestToken(id, "test@example.com", time.Hour, testJwtKey, jwt.SigningMethodHS256)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/carts/items", strings.NewReader(`{"quantity":1}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			return servePayloadLogged(t, payloadLogger, next, req)
+		}
+
+		// Act
+		picked := ask(userID)
+		other := ask(uuid.New())
+
+		// Assert
+		require.Len(t, picked, 1)
+		assert.Equal(t, userID.String(), picked[0]["userId"])
+		assert.Equal(t, `{"quantity":1}`, picked[0]["request_body"])
+		assert.Empty(t, other)
+	})
+
+	t.Run("Expired rules log nothing", func(t *testing.T) {
+		// Arrange
+		payloadLogger, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{MaxBytes: 1024})
+		require.NoError(t, err)
+
+		expiredAt := time.Now().Add(-time.Minute)
+		payloadLogger.SetRules(&models.PayloadLogRules{Routes: []string{"/api/v1/"}, ExpiresAt: &expiredAt})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/products", nil)
+
+		// Act
+		records := servePayloadLogged(t, payloadLogger, echoHandler, req)
+
+		// Assert
+		assert.Empty(t, records)
+		assert.Nil(t, payloadLogger.Rules())
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		_, err := middleware.NewPayloadLogger(&config.PayloadLogConfig{UserIDs: []string{"not-a-uuid"}})
+		assert.Error(t, err)
+	})
+}
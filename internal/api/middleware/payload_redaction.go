@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are the parts of the names of the fields, query parameters and headers whose values
+// are never logged.
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "cookie", "api_key", "api-key", "apikey", "signature", "card", "cvc", "cvv", "iban", "otp"}
+
+// cardNumberPattern finds the digit runs written like a card number, Luhn tells them apart from the
+// other long numbers.
+var cardNumberPattern = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+
+func sensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, part := range sensitiveKeys {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactBody returns a body as it can be logged, JSON and form bodies redacted and the others by
+// their type and size.
+func (p *PayloadLogger) redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if len(body) > p.maxBytes {
+		return fmt.Sprintf("[body over the %d bytes cap]", p.maxBytes)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+
+		var document any
+		if err := decoder.Decode(&document); err != nil {
+			return fmt.Sprintf("[invalid JSON body, %d bytes]", len(body))
+		}
+
+		redactedBody, err := json.Marshal(redactJSON(document))
+		if err != nil {
+			return fmt.Sprintf("[JSON body, %d bytes]", len(body))
+		}
+
+		return string(redactedBody)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return fmt.Sprintf("[invalid form body, %d bytes]", len(body))
+		}
+
+		return redactValues(values).Encode()
+	case mediaType == "":
+		return fmt.Sprintf("[untyped body, %d bytes]", len(body))
+	default:
+		return fmt.Sprintf("[%s body, %d bytes]", mediaType, len(body))
+	}
+}
+
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if sensitiveKey(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(field)
+			}
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+
+		return v
+	case string:
+		return redactCardNumbers(v)
+	case json.Number:
+		if redactCardNumbers(v.String()) != v.String() {
+			return redacted
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func redactValues(values url.Values) url.Values {
+	for key, list := range values {
+		for i, value := range list {
+			if sensitiveKey(key) {
+				list[i] = redacted
+			} else {
+				list[i] = redactCardNumbers(value)
+			}
+		}
+	}
+
+	return values
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+
+	for key := range header {
+		if sensitiveKey(key) {
+			headers[key] = redacted
+		} else {
+			headers[key] = header.Get(key)
+		}
+	}
+
+	return headers
+}
+
+func redactCardNumbers(s string) string {
+	return cardNumberPattern.ReplaceAllStringFunc(s, func(match string) string {
+		if luhnValid(match) {
+			return redacted
+		}
+
+		return match
+	})
+}
+
+// luhnValid runs the Luhn checksum of card numbers over the digits of s.
+func luhnValid(s string) bool {
+	sum := 0
+	double := false
+
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			continue
+		}
+
+		digit := int(s[i] - '0')
+		if double {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
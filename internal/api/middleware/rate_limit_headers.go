@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+type rateLimitHeadersKey struct{}
+
+// RateLimitHeaders lets the rate limits checked while serving a request show up on its response, so
+// clients can slow down before they are throttled. Routes that check no rate limit get no headers.
+func RateLimitHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), rateLimitHeadersKey{}, w.Header())
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// SetRateLimit reports a rate limit checked for the request: the requests allowed per window, those
+// left once this one is counted and the time until the window frees a request again, sent in whole
+// seconds. When a request checks several limits the one with the fewest requests left is reported.
+// It does nothing outside RateLimitHeaders.
+func SetRateLimit(ctx context.Context, limit, remaining int64, reset time.Duration) {
+	header, ok := ctx.Value(rateLimitHeadersKey{}).(http.Header)
+	if !ok {
+		return
+	}
+
+	remaining = max(remaining, 0)
+
+	if current, err := strconv.ParseInt(header.Get(RateLimitRemainingHeader), 10, 64); err == nil && current < remaining {
+		return
+	}
+
+	header.Set(RateLimitLimitHeader, strconv.FormatInt(limit, 10))
+	header.Set(RateLimitRemainingHeader, strconv.FormatInt(remaining, 10))
+	header.Set(RateLimitResetHeader, strconv.Itoa(int(math.Ceil(max(reset, 0).Seconds()))))
+}
@@ -0,0 +1,76 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitHeaders(t *testing.T) {
+	t.Run("Checked limit is reported", func(t *testing.T) {
+		// Arrange
+		handler := middleware.RateLimitHeaders(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			middleware.SetRateLimit(r.Context(), 30, 12, 1500*time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/track/token", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, "30", rr.Header().Get(middleware.RateLimitLimitHeader))
+		assert.Equal(t, "12", rr.Header().Get(middleware.RateLimitRemainingHeader))
+		assert.Equal(t, "2", rr.Header().Get(middleware.RateLimitResetHeader))
+	})
+
+	t.Run("Most restrictive limit wins", func(t *testing.T) {
+		// Arrange
+		handler := middleware.RateLimitHeaders(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			middleware.SetRateLimit(r.Context(), 5, 1, 10*time.Second)
+			middleware.SetRateLimit(r.Context(), 100, 80, time.Minute)
+			middleware.SetRateLimit(r.Context(), 30, -3, 4*time.Second)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/login", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, "30", rr.Header().Get(middleware.RateLimitLimitHeader))
+		assert.Equal(t, "0", rr.Header().Get(middleware.RateLimitRemainingHeader))
+		assert.Equal(t, "4", rr.Header().Get(middleware.RateLimitResetHeader))
+	})
+
+	t.Run("No limit checked", func(t *testing.T) {
+		// Arrange
+		handler := middleware.RateLimitHeaders(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/products", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rr, req)
+
+		// Assert
+		assert.Empty(t, rr.Header().Get(middleware.RateLimitLimitHeader))
+	})
+
+	t.Run("Outside the middleware nothing happens", func(t *testing.T) {
+		// Act & Assert
+		assert.NotPanics(t, func() {
+			middleware.SetRateLimit(t.Context(), 30, 12, time.Second)
+		})
+	})
+}
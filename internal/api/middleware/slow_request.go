@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SlowRequest flags requests exceeding the latency budget: it logs a warning with the
+// trace ID and forces the trace to be exported regardless of the sampling ratio.
+func SlowRequest(threshold time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if threshold <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			next.ServeHTTP(w, r)
+
+			duration := time.Since(start)
+			if duration < threshold {
+				return
+			}
+
+			tracing.ForceSample(r.Context())
+
+			LoggerFromContext(r.Context()).Warn("Slow request detected",
+				slog.Duration("duration", duration),
+				slog.Duration("threshold", threshold),
+				slog.String("trace_id", trace.SpanContextFromContext(r.Context()).TraceID().String()),
+			)
+		})
+	}
+}
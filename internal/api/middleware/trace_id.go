@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceID exposes the trace of the request in the X-Trace-Id header, which response.Error also copies into
+// the error body, so a user reporting an issue hands over an ID that can be searched for directly.
+// It has to run inside the otelhttp handler, where the request span exists.
+func TraceID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+			w.Header().Set(response.TraceIDHeader, sc.TraceID().String())
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
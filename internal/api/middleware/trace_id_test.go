@@ -0,0 +1,58 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTraceID(t *testing.T) {
+	failingHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		response.Error(w, appErrors.NotFoundError("Order not found"))
+	})
+
+	t.Run("Trace ID in the header and the error body", func(t *testing.T) {
+		// Arrange
+		tp := sdktrace.NewTracerProvider()
+		t.Cleanup(func() { _ = tp.Shutdown(t.Context()) })
+
+		ctx, span := tp.Tracer("test").Start(t.Context(), "request")
+		defer span.End()
+
+		req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/orders/123", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		middleware.TraceID(failingHandler).ServeHTTP(rr, req)
+
+		// Assert
+		traceID := span.SpanContext().TraceID().String()
+		assert.Equal(t, traceID, rr.Header().Get(response.TraceIDHeader))
+
+		var body response.APIResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		require.NotNil(t, body.Error)
+		assert.Equal(t, traceID, body.Error.TraceID)
+	})
+
+	t.Run("No trace", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/orders/123", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		// Act
+		middleware.TraceID(failingHandler).ServeHTTP(rr, req)
+
+		// Assert
+		assert.Empty(t, rr.Header().Get(response.TraceIDHeader))
+		assert.NotContains(t, rr.Body.String(), "trace_id")
+	})
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/waitingroom"
+)
+
+// WaitingRoom guards the routes of high-demand drops, their visitors are let in at the rate of the room
+// and the others are told their place in line.
+type WaitingRoom struct {
+	cfg  *config.WaitingRoomConfig
+	room *waitingroom.Room
+}
+
+func NewWaitingRoom(cfg *config.WaitingRoomConfig, room *waitingroom.Room) *WaitingRoom {
+	return &WaitingRoom{cfg: cfg, room: room}
+}
+
+// Hold answers a request on a configured route or product with 429 and its ticket until the ticket is
+// let in, the ticket travels in the X-Waiting-Room-Token header both ways. Admins are never held. It
+// expects to run after Authenticate.
+func (wr *WaitingRoom) Hold(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !wr.cfg.Enabled || !wr.guards(r) || isAdmin(r) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		logger := LoggerFromContext(r.Context())
+
+		ticket, err := wr.room.Enter(r.Context(), r.Header.Get(waitingroom.TokenHeader))
+		if err != nil {
+			// The room protects checkout, it does not stand in its way while Redis is down
+			logger.Error("Waiting room unavailable, request let through", slog.Any("error", err))
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		w.Header().Set(waitingroom.TokenHeader, ticket.Token)
+
+		if !ticket.Admitted {
+			retryAfter := max(int(wr.room.Wait(ticket).Seconds()), 1)
+
+			logger.Debug("Request held in waiting room", slog.Int64("position", ticket.Position))
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			response.Error(w, appErrors.WaitingRoomError(ticket.Position, ticket.Token, retryAfter))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// guards reports whether the request is on one of the routes of the room or names one of its products.
+func (wr *WaitingRoom) guards(r *http.Request) bool {
+	if slices.Contains(wr.cfg.Routes, r.Pattern) {
+		return true
+	}
+
+	if len(wr.cfg.Products) == 0 {
+		return false
+	}
+
+	productID := r.PathValue("id")
+	if productID == "" {
+		productID = bodyProductID(r)
+	}
+
+	return productID != "" && slices.Contains(wr.cfg.Products, productID)
+}
+
+// bodyProductID reads the product_id of a JSON body, like the one of a cart item, leaving the body to
+// read again.
+func bodyProductID(r *http.Request) string {
+	if r.Body == nil || r.Body == http.NoBody {
+		return ""
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(payload))
+
+	if err != nil {
+		return ""
+	}
+
+	var body struct {
+		ProductID string `json:"product_id"`
+	}
+
+	if json.Unmarshal(payload, &body) != nil {
+		return ""
+	}
+
+	return body.ProductID
+}
+
+func isAdmin(r *http.Request) bool {
+	claims, ok := r.Context().Value(UserContextKey).(*models.Claims)
+
+	return ok && claims.Role == models.RoleAdmin
+}
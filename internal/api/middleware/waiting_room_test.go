@@ -0,0 +1,180 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/waitingroom"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/waitingroom/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitingRoom(t *testing.T) {
+	productID := uuid.New()
+	cfg := &config.WaitingRoomConfig{
+		Enabled:  true,
+		Routes:   []string{"POST /api/v1/orders"},
+		Products: []string{productID.String()},
+	}
+
+	// serve routes the request through a mux, which sets the pattern the room matches on
+	serve := func(hold func(http.Handler) http.HandlerFunc, req *http.Request, role models.UserRole) *httptest.ResponseRecorder {
+		mux := http.NewServeMux()
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		mux.Handle("POST /api/v1/orders", hold(next))
+		mux.Handle("POST /api/v1/carts/items", hold(next))
+		mux.Handle("GET /api/v1/products/{id}", hold(next))
+
+		req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, &models.Claims{UserID: uuid.New(), Role: role}))
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	newRoom := func(t *testing.T) (*middleware.WaitingRoom, *mocks.MockQueue) {
+		queue := mocks.NewMockQueue(t)
+		room := waitingroom.NewRoom([]byte("key"), 10, time.Hour, 15*time.Minute, queue, clock.NewFake(time.Now()))
+
+		return middleware.NewWaitingRoom(cfg, room), queue
+	}
+
+	t.Run("Holds a checkout behind the line", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+		queue.On("Join", mock.Anything, 10, mock.Anything).Return(int64(150), int64(100), nil).Once()
+
+		// Act
+		rr := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody), models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		assert.Equal(t, "5", rr.Header().Get("Retry-After"))
+		assert.NotEmpty(t, rr.Header().Get(waitingroom.TokenHeader))
+
+		var body struct {
+			Error struct {
+				Code string         `json:"code"`
+				Meta map[string]any `json:"meta"`
+			} `json:"error"`
+		}
+
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, "IN_WAITING_ROOM", body.Error.Code)
+		assert.EqualValues(t, 50, body.Error.Meta["position"])
+	})
+
+	t.Run("Lets a visitor in once the line reached the ticket", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+		queue.On("Join", mock.Anything, 10, mock.Anything).Return(int64(150), int64(100), nil).Once()
+		held := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody), models.RoleCustomer)
+
+		queue.On("Admitted", mock.Anything, 10, mock.Anything).Return(int64(160), nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody)
+		req.Header.Set(waitingroom.TokenHeader, held.Header().Get(waitingroom.TokenHeader))
+
+		// Act
+		rr := serve(waitingRoom.Hold, req, models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotEqual(t, held.Header().Get(waitingroom.TokenHeader), rr.Header().Get(waitingroom.TokenHeader))
+	})
+
+	t.Run("Holds the cart items of a configured product", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+		queue.On("Join", mock.Anything, 10, mock.Anything).Return(int64(150), int64(100), nil).Once()
+
+		body := `{"product_id":"` + productID.String() + `","quantity":1}`
+
+		// Act
+		rr := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/carts/items", strings.NewReader(body)), models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	})
+
+	t.Run("Holds the page of a configured product", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+		queue.On("Join", mock.Anything, 10, mock.Anything).Return(int64(150), int64(100), nil).Once()
+
+		// Act
+		rr := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodGet, "/api/v1/products/"+productID.String(), http.NoBody), models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	})
+
+	t.Run("Other products are not held", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+
+		body := `{"product_id":"` + uuid.NewString() + `","quantity":1}`
+
+		// Act
+		rr := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/carts/items", strings.NewReader(body)), models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		queue.AssertNotCalled(t, "Join", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Admins go straight through", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+
+		// Act
+		rr := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody), models.RoleAdmin)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+		queue.AssertNotCalled(t, "Join", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Lets requests through while the queue is unavailable", func(t *testing.T) {
+		// Arrange
+		waitingRoom, queue := newRoom(t)
+		queue.On("Join", mock.Anything, 10, mock.Anything).Return(int64(0), int64(0), errors.New("connection refused")).Once()
+
+		// Act
+		rr := serve(waitingRoom.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody), models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Disabled room holds nothing", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		room := waitingroom.NewRoom([]byte("key"), 10, time.Hour, 15*time.Minute, queue, clock.NewFake(time.Now()))
+		disabled := middleware.NewWaitingRoom(&config.WaitingRoomConfig{Routes: cfg.Routes}, room)
+
+		// Act
+		rr := serve(disabled.Hold, httptest.NewRequest(http.MethodPost, "/api/v1/orders", http.NoBody), models.RoleCustomer)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
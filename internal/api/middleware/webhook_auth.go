@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils/response"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
+)
+
+// maxWebhookSize bounds the body of a partner delivery, read before its signature can be checked.
+const maxWebhookSize = 1 << 20
+
+// VerifyWebhook guards the callback route of a partner, only deliveries signed by it reach next, with the
+// body left to read again. A delivery next fails with a server error is forgotten, so the partner can
+// redeliver it as is.
+func VerifyWebhook(verifier *webhookauth.Verifier, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := LoggerFromContext(r.Context()).With(slog.String("partner", verifier.Name()))
+
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+		if err != nil {
+			logger.Error("Error reading webhook body", slog.Any("error", err))
+			response.Error(w, appErrors.BadRequestError("Failed to read request body"))
+
+			return
+		}
+
+		header := r.Header.Get(webhookauth.SignatureHeader)
+
+		if err := verifier.Verify(r.Context(), payload, header); err != nil {
+			reason := webhookRejectionReason(err)
+			if reason == "" {
+				logger.Error("Failed to verify webhook", slog.Any("error", err))
+				response.Error(w, appErrors.ServiceUnavailableError("Webhook verification is unavailable").WithError(err))
+
+				return
+			}
+
+			logger.Warn("Webhook refused", slog.String("reason", reason), slog.Any("error", err))
+			metrics.RecordWebhookRejected(verifier.Name(), reason)
+			response.Error(w, appErrors.UnauthorizedError("Webhook signature verification failed").WithError(err))
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(payload))
+
+		rw := newResponseWriter(w)
+		next.ServeHTTP(rw, r)
+
+		if rw.statusCode >= http.StatusInternalServerError {
+			if err := verifier.Forget(r.Context(), header); err != nil {
+				logger.Error("Failed to forget webhook delivery, its redelivery will be refused", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// webhookRejectionReason is empty for errors that are not the fault of the delivery.
+func webhookRejectionReason(err error) string {
+	switch {
+	case errors.Is(err, webhookauth.ErrMissingSignature):
+		return "missing_signature"
+	case errors.Is(err, webhookauth.ErrInvalidSignature):
+		return "invalid_signature"
+	case errors.Is(err, webhookauth.ErrStaleTimestamp):
+		return "stale"
+	case errors.Is(err, webhookauth.ErrReplayed):
+		return "replayed"
+	default:
+		return ""
+	}
+}
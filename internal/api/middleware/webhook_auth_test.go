@@ -0,0 +1,124 @@
+package middleware_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/api/middleware"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	now := time.Date(2025, time.March, 14, 9, 30, 0, 0, time.UTC)
+	payload := []byte(`{"tracking_number":"1Z999"}`)
+
+	newRequest := func(header string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+		if header != "" {
+			req.Header.Set(webhookauth.SignatureHeader, header)
+		}
+
+		return req
+	}
+
+	t.Run("Success - Signed delivery reaches the handler with its body", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"secret"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(now))
+		nonces.On("Claim", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, payload, body)
+
+			w.WriteHeader(http.StatusOK)
+		})
+
+		rr := httptest.NewRecorder()
+
+		// Act
+		middleware.VerifyWebhook(verifier, next).ServeHTTP(rr, newRequest(webhookauth.Sign("secret", payload, now)))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Success - Failed delivery is forgotten for its redelivery", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"secret"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(now))
+		nonces.On("Claim", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+		nonces.On("Release", mock.Anything, mock.Anything).Return(nil).Once()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusInternalServerError) })
+		rr := httptest.NewRecorder()
+
+		// Act
+		middleware.VerifyWebhook(verifier, next).ServeHTTP(rr, newRequest(webhookauth.Sign("secret", payload, now)))
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Fail - Body too large", func(t *testing.T) {
+		// Arrange
+		verifier := webhookauth.NewVerifier("carrier", []string{"secret"}, webhookauth.DefaultTolerance, mocks.NewMockNonceStore(t), clock.NewFake(now))
+		large := bytes.Repeat([]byte("a"), 2<<20)
+
+		next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) { t.Fatal("next handler should not be called") })
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(large))
+		req.Header.Set(webhookauth.SignatureHeader, webhookauth.Sign("secret", large, now))
+		rr := httptest.NewRecorder()
+
+		// Act
+		middleware.VerifyWebhook(verifier, next).ServeHTTP(rr, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	tests := []struct {
+		name           string
+		header         string
+		claimed        bool
+		expectedStatus int
+	}{
+		{"Fail - Missing signature", "", false, http.StatusUnauthorized},
+		{"Fail - Wrong secret", webhookauth.Sign("guess", payload, now), false, http.StatusUnauthorized},
+		{"Fail - Tampered body", webhookauth.Sign("secret", []byte(`{"tracking_number":"1Z000"}`), now), false, http.StatusUnauthorized},
+		{"Fail - Stale delivery", webhookauth.Sign("secret", payload, now.Add(-time.Hour)), false, http.StatusUnauthorized},
+		{"Fail - Timestamp ahead of the clock", webhookauth.Sign("secret", payload, now.Add(time.Hour)), false, http.StatusUnauthorized},
+		{"Fail - Replayed delivery", webhookauth.Sign("secret", payload, now), true, http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			nonces := mocks.NewMockNonceStore(t)
+			verifier := webhookauth.NewVerifier("carrier", []string{"secret"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(now))
+
+			if tc.claimed {
+				nonces.On("Claim", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+			}
+
+			next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) { t.Fatal("next handler should not be called") })
+			rr := httptest.NewRecorder()
+
+			// Act
+			middleware.VerifyWebhook(verifier, next).ServeHTTP(rr, newRequest(tc.header))
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -184,6 +184,61 @@ func (_c *MockCache_Get_Call) RunAndReturn(run func(ctx context.Context, key str
 	return _c
 }
 
+// InvalidateNamespace provides a mock function for the type MockCache
+func (_mock *MockCache) InvalidateNamespace(ctx context.Context, namespace string) (int64, error) {
+	ret := _mock.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateNamespace")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, namespace)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, namespace)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCache_InvalidateNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateNamespace'
+type MockCache_InvalidateNamespace_Call struct {
+	*mock.Call
+}
+
+// InvalidateNamespace is a helper method to define mock.On call
+//   - ctx
+//   - namespace
+func (_e *MockCache_Expecter) InvalidateNamespace(ctx interface{}, namespace interface{}) *MockCache_InvalidateNamespace_Call {
+	return &MockCache_InvalidateNamespace_Call{Call: _e.mock.On("InvalidateNamespace", ctx, namespace)}
+}
+
+func (_c *MockCache_InvalidateNamespace_Call) Run(run func(ctx context.Context, namespace string)) *MockCache_InvalidateNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_InvalidateNamespace_Call) Return(n int64, err error) *MockCache_InvalidateNamespace_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCache_InvalidateNamespace_Call) RunAndReturn(run func(ctx context.Context, namespace string) (int64, error)) *MockCache_InvalidateNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function for the type MockCache
 func (_mock *MockCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
 	ret := _mock.Called(ctx, key, value, ttl)
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
 	"github.com/redis/go-redis/v9"
 )
@@ -15,23 +19,48 @@ type Cache interface {
 	Get(ctx context.Context, key string, value interface{}) (bool, error)
 	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
+	// InvalidateNamespace drops every key of the namespace at once and returns its new version.
+	InvalidateNamespace(ctx context.Context, namespace string) (int64, error)
 	Close() error
 }
 
+// versionKeyPrefix holds the version counter of each namespace.
+const versionKeyPrefix = "cache_version:"
+
+type namespaceVersion struct {
+	version   int64
+	fetchedAt time.Time
+}
+
+// redisCache stores a key under the current version of its namespace, the part of the key before the
+// first colon, so product_facets:all lives at product_facets:v7:all. Bumping the version orphans the
+// whole namespace at once, the orphaned keys expire with their TTL. The versions are kept in process
+// for VersionRefresh, so the other instances pick up a bump within that long.
 type redisCache struct {
 	client *redis.Client
 	cfg    *config.CacheConfig
+	clock  clock.Clock
+
+	mu       sync.Mutex
+	versions map[string]namespaceVersion
 }
 
-func NewRedisCache(client *redis.Client, cfg *config.CacheConfig) Cache {
+func NewRedisCache(client *redis.Client, cfg *config.CacheConfig, clk clock.Clock) Cache {
 	return &redisCache{
-		client: client,
-		cfg:    cfg,
+		client:   client,
+		cfg:      cfg,
+		clock:    clk,
+		versions: make(map[string]namespaceVersion),
 	}
 }
 
 func (r *redisCache) Get(ctx context.Context, key string, value any) (bool, error) {
-	data, err := r.client.Get(ctx, key).Bytes()
+	versioned, err := r.versionedKey(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	data, err := r.client.Get(ctx, versioned).Bytes()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
 			return false, nil
@@ -57,7 +86,12 @@ func (r *redisCache) Set(ctx context.Context, key string, value interface{}, ttl
 		ttl = r.cfg.DefaultTTL
 	}
 
-	err = r.client.Set(ctx, key, data, ttl).Err()
+	versioned, err := r.versionedKey(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(ctx, versioned, data, ttl).Err()
 	if err != nil {
 		return fmt.Errorf("failed to set key %s in redis: %w", key, err)
 	}
@@ -66,7 +100,12 @@ func (r *redisCache) Set(ctx context.Context, key string, value interface{}, ttl
 }
 
 func (r *redisCache) Delete(ctx context.Context, key string) error {
-	err := r.client.Del(ctx, key).Err()
+	versioned, err := r.versionedKey(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Del(ctx, versioned).Err()
 	if err != nil {
 		return fmt.Errorf("failed to delete key %s from redis: %w", key, err)
 	}
@@ -74,6 +113,60 @@ func (r *redisCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+func (r *redisCache) InvalidateNamespace(ctx context.Context, namespace string) (int64, error) {
+	version, err := r.client.Incr(ctx, versionKeyPrefix+namespace).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to bump version of namespace %s in redis: %w", namespace, err)
+	}
+
+	r.mu.Lock()
+	r.versions[namespace] = namespaceVersion{version: version, fetchedAt: r.clock.Now()}
+	r.mu.Unlock()
+
+	return version, nil
+}
+
+// versionedKey places the key under the current version of its namespace.
+func (r *redisCache) versionedKey(ctx context.Context, key string) (string, error) {
+	namespace, rest, found := strings.Cut(key, ":")
+
+	version, err := r.namespaceVersion(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	versioned := namespace + ":v" + strconv.FormatInt(version, 10)
+	if found {
+		versioned += ":" + rest
+	}
+
+	return versioned, nil
+}
+
+// namespaceVersion returns the version of the namespace, a namespace never invalidated is at version 0.
+func (r *redisCache) namespaceVersion(ctx context.Context, namespace string) (int64, error) {
+	now := r.clock.Now()
+
+	r.mu.Lock()
+	cached, ok := r.versions[namespace]
+	r.mu.Unlock()
+
+	if ok && now.Before(cached.fetchedAt.Add(r.cfg.VersionRefresh)) {
+		return cached.version, nil
+	}
+
+	version, err := r.client.Get(ctx, versionKeyPrefix+namespace).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("failed to get version of namespace %s from redis: %w", namespace, err)
+	}
+
+	r.mu.Lock()
+	r.versions[namespace] = namespaceVersion{version: version, fetchedAt: now}
+	r.mu.Unlock()
+
+	return version, nil
+}
+
 func (r *redisCache) Close() error {
 	return nil
 }
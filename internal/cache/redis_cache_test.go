@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
 	"github.com/go-redis/redismock/v9"
 	"github.com/redis/go-redis/v9"
@@ -25,9 +26,10 @@ func setup(t *testing.T) (cache.Cache, redismock.ClientMock, *config.CacheConfig
 
 	client, mock := redismock.NewClientMock()
 	cfg := &config.CacheConfig{
-		DefaultTTL: 10 * time.Minute,
+		DefaultTTL:     10 * time.Minute,
+		VersionRefresh: 5 * time.Second,
 	}
-	redisCache := cache.NewRedisCache(client, cfg)
+	redisCache := cache.NewRedisCache(client, cfg, clock.NewFake(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)))
 
 	return redisCache, mock, cfg
 }
@@ -50,7 +52,8 @@ func TestGet(t *testing.T) {
 
 		var result TestData
 
-		mock.ExpectGet(testKey).SetVal(string(jsonData))
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectGet("test:v0:get").SetVal(string(jsonData))
 
 		// Act
 		found, err := redisCache.Get(ctx, testKey, &result)
@@ -68,7 +71,8 @@ func TestGet(t *testing.T) {
 
 		var result TestData
 
-		mock.ExpectGet(testKey).SetErr(redis.Nil)
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectGet("test:v0:get").SetErr(redis.Nil)
 
 		// Act
 		found, err := redisCache.Get(ctx, testKey, &result)
@@ -88,7 +92,8 @@ func TestGet(t *testing.T) {
 
 		expectedErr := errors.New("redis connection error")
 
-		mock.ExpectGet(testKey).SetErr(expectedErr)
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectGet("test:v0:get").SetErr(expectedErr)
 
 		// Act
 		found, err := redisCache.Get(ctx, testKey, &result)
@@ -109,7 +114,8 @@ func TestGet(t *testing.T) {
 
 		invalidJSON := `{"field1": "value1", "field2": "not_an_int"}`
 
-		mock.ExpectGet(testKey).SetVal(invalidJSON)
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectGet("test:v0:get").SetVal(invalidJSON)
 
 		// Act
 		found, err := redisCache.Get(ctx, testKey, &result)
@@ -140,7 +146,8 @@ func TestSet(t *testing.T) {
 		redisCache, mock, _ := setup(t)
 		specificTTL := 5 * time.Minute
 
-		mock.ExpectSet(testKey, jsonData, specificTTL).SetVal("OK")
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectSet("test:v0:set", jsonData, specificTTL).SetVal("OK")
 
 		// Act
 		err := redisCache.Set(ctx, testKey, testValue, specificTTL)
@@ -154,7 +161,8 @@ func TestSet(t *testing.T) {
 		// Arrange
 		redisCache, mock, cfg := setup(t)
 
-		mock.ExpectSet(testKey, jsonData, cfg.DefaultTTL).SetVal("OK")
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectSet("test:v0:set", jsonData, cfg.DefaultTTL).SetVal("OK")
 
 		// Act
 		err := redisCache.Set(ctx, testKey, testValue, 0) // TTL <= 0 triggers default
@@ -168,7 +176,8 @@ func TestSet(t *testing.T) {
 		// Arrange
 		redisCache, mock, cfg := setup(t)
 
-		mock.ExpectSet(testKey, jsonData, cfg.DefaultTTL).SetVal("OK")
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectSet("test:v0:set", jsonData, cfg.DefaultTTL).SetVal("OK")
 
 		// Act
 		err := redisCache.Set(ctx, testKey, testValue, -1*time.Second) // TTL <= 0 triggers default
@@ -202,7 +211,8 @@ func TestSet(t *testing.T) {
 		specificTTL := 5 * time.Minute
 		expectedErr := errors.New("redis SET failed")
 
-		mock.ExpectSet(testKey, jsonData, specificTTL).SetErr(expectedErr)
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectSet("test:v0:set", jsonData, specificTTL).SetErr(expectedErr)
 
 		// Act
 		err := redisCache.Set(ctx, testKey, testValue, specificTTL)
@@ -223,7 +233,8 @@ func TestDelete(t *testing.T) {
 		// Arrange
 		redisCache, mock, _ := setup(t)
 
-		mock.ExpectDel(testKey).SetVal(1)
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectDel("test:v0:delete").SetVal(1)
 
 		// Act
 		err := redisCache.Delete(ctx, testKey)
@@ -238,7 +249,8 @@ func TestDelete(t *testing.T) {
 		redisCache, mock, _ := setup(t)
 		expectedErr := errors.New("redis DEL failed")
 
-		mock.ExpectDel(testKey).SetErr(expectedErr)
+		mock.ExpectGet("cache_version:test").RedisNil()
+		mock.ExpectDel("test:v0:delete").SetErr(expectedErr)
 
 		// Act
 		err := redisCache.Delete(ctx, testKey)
@@ -278,3 +290,97 @@ func TestConstants(t *testing.T) {
 	assert.Equal(t, "order", cache.OrderKeyPrefix)
 	assert.Equal(t, "cart", cache.CartKeyPrefix)
 }
+
+func TestInvalidateNamespace(t *testing.T) {
+	ctx := t.Context()
+	jsonData, err := json.Marshal(TestData{Field1: "facets", Field2: 3})
+	require.NoError(t, err)
+
+	setupVersioned := func(t *testing.T) (cache.Cache, redismock.ClientMock, *clock.Fake) {
+		t.Helper()
+
+		client, mock := redismock.NewClientMock()
+		clk := clock.NewFake(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC))
+
+		return cache.NewRedisCache(client, &config.CacheConfig{DefaultTTL: time.Minute, VersionRefresh: 5 * time.Second}, clk), mock, clk
+	}
+
+	t.Run("Success - Keys move to the new version", func(t *testing.T) {
+		// Arrange
+		redisCache, mock, _ := setupVersioned(t)
+
+		var result TestData
+
+		mock.ExpectIncr("cache_version:product_facets").SetVal(8)
+		mock.ExpectGet("product_facets:v8:all").SetVal(string(jsonData))
+
+		// Act
+		version, err := redisCache.InvalidateNamespace(ctx, "product_facets")
+		found, getErr := redisCache.Get(ctx, "product_facets:all", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(err, getErr))
+		assert.Equal(t, int64(8), version)
+		assert.True(t, found)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Version is refetched once stale", func(t *testing.T) {
+		// Arrange
+		redisCache, mock, clk := setupVersioned(t)
+
+		var result TestData
+
+		mock.ExpectGet("cache_version:product_facets").SetVal("3")
+		mock.ExpectGet("product_facets:v3:all").SetVal(string(jsonData))
+		mock.ExpectGet("product_facets:v3:all").SetVal(string(jsonData))
+		mock.ExpectGet("cache_version:product_facets").SetVal("4")
+		mock.ExpectGet("product_facets:v4:all").RedisNil()
+
+		// Act
+		_, err1 := redisCache.Get(ctx, "product_facets:all", &result)
+		_, err2 := redisCache.Get(ctx, "product_facets:all", &result)
+
+		clk.Advance(5 * time.Second)
+
+		found, err3 := redisCache.Get(ctx, "product_facets:all", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(err1, err2, err3))
+		assert.False(t, found)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Redis Error", func(t *testing.T) {
+		// Arrange
+		redisCache, mock, _ := setupVersioned(t)
+		expectedErr := errors.New("redis INCR failed")
+
+		mock.ExpectIncr("cache_version:product_facets").SetErr(expectedErr)
+
+		// Act
+		_, err := redisCache.InvalidateNamespace(ctx, "product_facets")
+
+		// Assert
+		require.ErrorIs(t, err, expectedErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Version lookup error fails the read", func(t *testing.T) {
+		// Arrange
+		redisCache, mock, _ := setupVersioned(t)
+		expectedErr := errors.New("redis GET failed")
+
+		var result TestData
+
+		mock.ExpectGet("cache_version:product_facets").SetErr(expectedErr)
+
+		// Act
+		found, err := redisCache.Get(ctx, "product_facets:all", &result)
+
+		// Assert
+		require.ErrorIs(t, err, expectedErr)
+		assert.False(t, found)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
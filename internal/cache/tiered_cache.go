@@ -0,0 +1,252 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// InvalidationChannel carries the keys written through a TieredCache, so the other instances drop
+// their in-process copies. An invalidated namespace is sent as namespace:*.
+const InvalidationChannel = "cache:invalidate"
+
+const wholeNamespace = ":*"
+
+// TieredCache keeps the hot namespaces in process in front of the shared cache, so the hottest keys
+// skip the round trip to Redis. Run keeps the in-process copies in line with the writes of the other
+// instances, a missed invalidation leaves a copy stale for at most the TTL of its namespace.
+type TieredCache interface {
+	Cache
+	Run(ctx context.Context)
+}
+
+type localEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+type tieredCache struct {
+	shared     Cache
+	client     *redis.Client
+	ttls       map[string]time.Duration
+	maxEntries int
+	instanceID string // tells the own invalidations apart from those of the other instances
+	clock      clock.Clock
+
+	mu      sync.Mutex
+	entries map[string]localEntry
+}
+
+func NewTieredCache(shared Cache, client *redis.Client, cfg *config.CacheConfig, clk clock.Clock) TieredCache {
+	return &tieredCache{
+		shared:     shared,
+		client:     client,
+		ttls:       cfg.LocalTTLs,
+		maxEntries: max(cfg.LocalMaxEntries, 1),
+		instanceID: uuid.NewString(),
+		clock:      clk,
+		entries:    make(map[string]localEntry),
+	}
+}
+
+func (c *tieredCache) Get(ctx context.Context, key string, value any) (bool, error) {
+	ttl, ok := c.localTTL(key)
+	if !ok {
+		return c.shared.Get(ctx, key, value)
+	}
+
+	if data, ok := c.load(key); ok {
+		if err := json.Unmarshal(data, value); err != nil {
+			return false, fmt.Errorf("failed to unmarshal local cache data for key %s: %w", key, err)
+		}
+
+		return true, nil
+	}
+
+	found, err := c.shared.Get(ctx, key, value)
+	if err != nil || !found {
+		return found, err
+	}
+
+	c.store(key, value, ttl)
+
+	return true, nil
+}
+
+func (c *tieredCache) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	if err := c.shared.Set(ctx, key, value, ttl); err != nil {
+		return err
+	}
+
+	localTTL, ok := c.localTTL(key)
+	if !ok {
+		return nil
+	}
+
+	if ttl > 0 {
+		localTTL = min(localTTL, ttl)
+	}
+
+	c.store(key, value, localTTL)
+	c.publish(ctx, key)
+
+	return nil
+}
+
+func (c *tieredCache) Delete(ctx context.Context, key string) error {
+	if err := c.shared.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	if _, ok := c.localTTL(key); ok {
+		c.evict(key)
+		c.publish(ctx, key)
+	}
+
+	return nil
+}
+
+func (c *tieredCache) InvalidateNamespace(ctx context.Context, namespace string) (int64, error) {
+	version, err := c.shared.InvalidateNamespace(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := c.ttls[namespace]; ok {
+		c.evictNamespace(namespace)
+		c.publish(ctx, namespace+wholeNamespace)
+	}
+
+	return version, nil
+}
+
+func (c *tieredCache) Close() error {
+	return c.shared.Close()
+}
+
+// Run drops the in-process copies of the keys the other instances write until the context is done.
+// It returns right away when no namespace is kept in process.
+func (c *tieredCache) Run(ctx context.Context) {
+	if len(c.ttls) == 0 {
+		return
+	}
+
+	pubsub := c.client.Subscribe(ctx, InvalidationChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			instanceID, key, ok := strings.Cut(msg.Payload, " ")
+			if !ok || instanceID == c.instanceID {
+				continue
+			}
+
+			if namespace, found := strings.CutSuffix(key, wholeNamespace); found {
+				c.evictNamespace(namespace)
+			} else {
+				c.evict(key)
+			}
+		}
+	}
+}
+
+// localTTL returns how long the namespace of the key is kept in process, if at all.
+func (c *tieredCache) localTTL(key string) (time.Duration, bool) {
+	namespace, _, _ := strings.Cut(key, ":")
+	ttl, ok := c.ttls[namespace]
+
+	return ttl, ok && ttl > 0
+}
+
+func (c *tieredCache) load(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !c.clock.Now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	return entry.data, true
+}
+
+// store keeps a copy of the value, the value is serialised so later changes to it by the caller do
+// not leak into the cache. When full, the expired copies are dropped first, then an arbitrary one.
+func (c *tieredCache) store(key string, value any, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+
+		for k := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = localEntry{data: data, expiresAt: now.Add(ttl)}
+}
+
+func (c *tieredCache) evict(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+func (c *tieredCache) evictNamespace(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if strings.HasPrefix(key, namespace+":") || key == namespace {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// publish is best effort, the other instances keep their copy until it expires when it fails.
+func (c *tieredCache) publish(ctx context.Context, key string) {
+	if err := c.client.Publish(ctx, InvalidationChannel, c.instanceID+" "+key).Err(); err != nil {
+		slog.Warn("Failed to publish cache invalidation", slog.String("key", key), slog.String("error", err.Error()))
+	}
+}
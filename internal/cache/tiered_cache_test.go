@@ -0,0 +1,244 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const invalidationPattern = `^[0-9a-f-]{36} product_availability:1$`
+
+func setupTiered(t *testing.T) (cache.TieredCache, *mocks.MockCache, redismock.ClientMock, *clock.Fake) {
+	t.Helper()
+
+	client, redisMock := redismock.NewClientMock()
+	shared := mocks.NewMockCache(t)
+	clk := clock.NewFake(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC))
+	cfg := &config.CacheConfig{
+		LocalTTLs:       map[string]time.Duration{"product_availability": 5 * time.Second},
+		LocalMaxEntries: 2,
+	}
+
+	return cache.NewTieredCache(shared, client, cfg, clk), shared, redisMock, clk
+}
+
+// expectSharedHit makes the shared cache find the value once.
+func expectSharedHit(shared *mocks.MockCache, key string, value TestData) {
+	shared.On("Get", mock.Anything, key, mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(2).(*TestData) = value
+	}).Return(true, nil).Once()
+}
+
+func TestTieredCacheGet(t *testing.T) {
+	ctx := t.Context()
+	value := TestData{Field1: "in_stock", Field2: 12}
+
+	t.Run("Success - Hot key is served in process until it expires", func(t *testing.T) {
+		// Arrange
+		tiered, shared, _, clk := setupTiered(t)
+		expectSharedHit(shared, "product_availability:1", value)
+
+		var first, second, third TestData
+
+		// Act
+		found1, err1 := tiered.Get(ctx, "product_availability:1", &first)
+		found2, err2 := tiered.Get(ctx, "product_availability:1", &second)
+
+		clk.Advance(5 * time.Second)
+		expectSharedHit(shared, "product_availability:1", value)
+
+		found3, err3 := tiered.Get(ctx, "product_availability:1", &third)
+
+		// Assert
+		require.NoError(t, errors.Join(err1, err2, err3))
+		assert.True(t, found1 && found2 && found3)
+		assert.Equal(t, value, second)
+		assert.Equal(t, value, third)
+		shared.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("Success - Other namespaces always go to the shared cache", func(t *testing.T) {
+		// Arrange
+		tiered, shared, _, _ := setupTiered(t)
+		expectSharedHit(shared, "product_facets:all", value)
+		expectSharedHit(shared, "product_facets:all", value)
+
+		var result TestData
+
+		// Act
+		_, err1 := tiered.Get(ctx, "product_facets:all", &result)
+		_, err2 := tiered.Get(ctx, "product_facets:all", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(err1, err2))
+		assert.Equal(t, value, result)
+	})
+
+	t.Run("Success - Miss is not kept", func(t *testing.T) {
+		// Arrange
+		tiered, shared, _, _ := setupTiered(t)
+		shared.On("Get", mock.Anything, "product_availability:1", mock.Anything).Return(false, nil).Twice()
+
+		var result TestData
+
+		// Act
+		found1, err1 := tiered.Get(ctx, "product_availability:1", &result)
+		found2, err2 := tiered.Get(ctx, "product_availability:1", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(err1, err2))
+		assert.False(t, found1 || found2)
+	})
+
+	t.Run("Success - Full tier drops a copy for the new one", func(t *testing.T) {
+		// Arrange
+		tiered, shared, _, _ := setupTiered(t)
+
+		for _, key := range []string{"product_availability:1", "product_availability:2", "product_availability:3"} {
+			expectSharedHit(shared, key, value)
+		}
+
+		var result TestData
+
+		// Act
+		for _, key := range []string{"product_availability:1", "product_availability:2", "product_availability:3"} {
+			_, err := tiered.Get(ctx, key, &result)
+			require.NoError(t, err)
+		}
+
+		found, err := tiered.Get(ctx, "product_availability:3", &result)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, found)
+		shared.AssertNumberOfCalls(t, "Get", 3)
+	})
+}
+
+func TestTieredCacheWrites(t *testing.T) {
+	ctx := t.Context()
+	value := TestData{Field1: "low_stock", Field2: 3}
+
+	t.Run("Success - Set keeps a copy and invalidates the other instances", func(t *testing.T) {
+		// Arrange
+		tiered, shared, redisMock, _ := setupTiered(t)
+		shared.On("Set", mock.Anything, "product_availability:1", value, time.Minute).Return(nil).Once()
+		redisMock.Regexp().ExpectPublish(cache.InvalidationChannel, invalidationPattern).SetVal(1)
+
+		var result TestData
+
+		// Act
+		err := tiered.Set(ctx, "product_availability:1", value, time.Minute)
+		found, getErr := tiered.Get(ctx, "product_availability:1", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(err, getErr))
+		assert.True(t, found)
+		assert.Equal(t, value, result)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Failed invalidation does not fail the write", func(t *testing.T) {
+		// Arrange
+		tiered, shared, redisMock, _ := setupTiered(t)
+		shared.On("Set", mock.Anything, "product_availability:1", value, time.Duration(0)).Return(nil).Once()
+		redisMock.Regexp().ExpectPublish(cache.InvalidationChannel, invalidationPattern).SetErr(errors.New("redis down"))
+
+		// Act
+		err := tiered.Set(ctx, "product_availability:1", value, 0)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Delete drops the copy", func(t *testing.T) {
+		// Arrange
+		tiered, shared, redisMock, _ := setupTiered(t)
+		shared.On("Set", mock.Anything, "product_availability:1", value, time.Minute).Return(nil).Once()
+		shared.On("Delete", mock.Anything, "product_availability:1").Return(nil).Once()
+		shared.On("Get", mock.Anything, "product_availability:1", mock.Anything).Return(false, nil).Once()
+		redisMock.Regexp().ExpectPublish(cache.InvalidationChannel, invalidationPattern).SetVal(1)
+		redisMock.Regexp().ExpectPublish(cache.InvalidationChannel, invalidationPattern).SetVal(1)
+
+		var result TestData
+
+		// Act
+		setErr := tiered.Set(ctx, "product_availability:1", value, time.Minute)
+		deleteErr := tiered.Delete(ctx, "product_availability:1")
+		found, getErr := tiered.Get(ctx, "product_availability:1", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(setErr, deleteErr, getErr))
+		assert.False(t, found)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Shared write error keeps no copy", func(t *testing.T) {
+		// Arrange
+		tiered, shared, redisMock, _ := setupTiered(t)
+		shared.On("Set", mock.Anything, "product_availability:1", value, time.Minute).Return(errors.New("redis down")).Once()
+		shared.On("Get", mock.Anything, "product_availability:1", mock.Anything).Return(false, nil).Once()
+
+		var result TestData
+
+		// Act
+		err := tiered.Set(ctx, "product_availability:1", value, time.Minute)
+		found, getErr := tiered.Get(ctx, "product_availability:1", &result)
+
+		// Assert
+		require.Error(t, err)
+		require.NoError(t, getErr)
+		assert.False(t, found)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+}
+
+func TestTieredCacheInvalidateNamespace(t *testing.T) {
+	ctx := t.Context()
+	value := TestData{Field1: "in_stock", Field2: 12}
+
+	t.Run("Success - Copies of the namespace are dropped", func(t *testing.T) {
+		// Arrange
+		tiered, shared, redisMock, _ := setupTiered(t)
+		expectSharedHit(shared, "product_availability:1", value)
+		shared.On("InvalidateNamespace", mock.Anything, "product_availability").Return(int64(4), nil).Once()
+		shared.On("Get", mock.Anything, "product_availability:1", mock.Anything).Return(false, nil).Once()
+		redisMock.Regexp().ExpectPublish(cache.InvalidationChannel, `^[0-9a-f-]{36} product_availability:\*$`).SetVal(1)
+
+		var result TestData
+
+		// Act
+		_, err1 := tiered.Get(ctx, "product_availability:1", &result)
+		version, err2 := tiered.InvalidateNamespace(ctx, "product_availability")
+		found, err3 := tiered.Get(ctx, "product_availability:1", &result)
+
+		// Assert
+		require.NoError(t, errors.Join(err1, err2, err3))
+		assert.Equal(t, int64(4), version)
+		assert.False(t, found)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Shared error is returned", func(t *testing.T) {
+		// Arrange
+		tiered, shared, redisMock, _ := setupTiered(t)
+		shared.On("InvalidateNamespace", mock.Anything, "product_facets").Return(int64(0), errors.New("redis down")).Once()
+
+		// Act
+		_, err := tiered.InvalidateNamespace(ctx, "product_facets")
+
+		// Assert
+		require.Error(t, err)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+}
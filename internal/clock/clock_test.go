@@ -0,0 +1,24 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	// Arrange
+	start := time.Date(2025, time.March, 14, 9, 30, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+
+	// Act
+	fake.Advance(90 * time.Second)
+
+	// Assert
+	assert.Equal(t, start.Add(90*time.Second), fake.Now())
+
+	fake.Set(start)
+	assert.Equal(t, start, fake.Now())
+}
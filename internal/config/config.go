@@ -6,9 +6,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
-
-	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type HTTPServer struct {
@@ -18,6 +17,8 @@ type HTTPServer struct {
 	IdleTimeout             time.Duration `yaml:"IDLE_TIMEOUT"`
 	ShutdownTimeout         time.Duration `yaml:"SHUTDOWN_TIMEOUT"`
 	GracefulShutdownTimeout time.Duration `yaml:"GRACEFUL_SHUTDOWN_TIMEOUT"`
+	SlowRequestThreshold    time.Duration `env:"SLOW_REQUEST_THRESHOLD"    env-default:"2s" yaml:"SLOW_REQUEST_THRESHOLD"`
+	DrainTimeout            time.Duration `env:"DRAIN_TIMEOUT"             env-default:"30s" yaml:"DRAIN_TIMEOUT"` // how long a drain request waits for the connections to close by default
 }
 
 type Database struct {
@@ -51,86 +52,450 @@ type Stripe struct {
 	WebhookSecret       string   `env:"STRIPE_WEBHOOK_SECRET"       env-default:""                   yaml:"STRIPE_WEBHOOK_SECRET"`
 	PaymentMethods      []string `env:"STRIPE_PAYMENT_METHODS"      env-default:"card,bank_transfer" yaml:"STRIPE_PAYMENT_METHODS"`
 	SupportedCurrencies []string `env:"STRIPE_SUPPORTED_CURRENCIES" env-default:"inr, usd, eur"      yaml:"STRIPE_SUPPORTED_CURRENCIES"`
+	CheckoutSuccessURL  string   `env:"STRIPE_CHECKOUT_SUCCESS_URL" env-default:""                   yaml:"STRIPE_CHECKOUT_SUCCESS_URL"`
+	CheckoutCancelURL   string   `env:"STRIPE_CHECKOUT_CANCEL_URL"  env-default:""                   yaml:"STRIPE_CHECKOUT_CANCEL_URL"`
 }
 
 type SendGrid struct {
-	APIKey     string `env:"API_KEY"    env-default:""                     yaml:"API_KEY"`
-	FromEmail  string `env:"FROM_EMAIL" env-default:"noreply@example.com"  yaml:"FROM_EMAIL"`
-	FromName   string `env:"FROM_NAME"  env-default:"Notification Service" yaml:"FROM_NAME"`
-	SMSEnabled bool   `env:"SMSENABLED" env-default:"false"                yaml:"SMSENABLED"`
+	APIKey                 string   `env:"API_KEY"                   env-default:""                                                         yaml:"API_KEY"`
+	FromEmail              string   `env:"FROM_EMAIL"                env-default:"noreply@example.com"                                      yaml:"FROM_EMAIL"`
+	FromName               string   `env:"FROM_NAME"                 env-default:"Notification Service"                                     yaml:"FROM_NAME"`
+	SMSEnabled             bool     `env:"SMSENABLED"                env-default:"false"                                                    yaml:"SMSENABLED"`
+	WebhookPublicKey       string   `env:"WEBHOOK_PUBLIC_KEY"        env-default:""                                                         yaml:"WEBHOOK_PUBLIC_KEY"` // verification key of the event webhook, events are rejected while unset
+	AttachmentMaxSize      int64    `env:"ATTACHMENT_MAX_SIZE"       env-default:"10485760"                                                 yaml:"ATTACHMENT_MAX_SIZE"`
+	AttachmentMaxTotalSize int64    `env:"ATTACHMENT_MAX_TOTAL_SIZE" env-default:"20971520"                                                 yaml:"ATTACHMENT_MAX_TOTAL_SIZE"` // base64 adds a third, SendGrid caps the encoded message at 30MB
+	AttachmentTypes        []string `env:"ATTACHMENT_TYPES"          env-default:"application/pdf,image/png,image/jpeg,text/csv,text/plain" yaml:"ATTACHMENT_TYPES"`
+	Plan                   string   `env:"PLAN"                      env-default:""                                                         yaml:"PLAN"`           // sending quota to stay within, one of sendgrid.Plans; empty sends without limit
+	ThrottleBurst          int      `env:"THROTTLE_BURST"            env-default:"10"                                                       yaml:"THROTTLE_BURST"` // sends allowed back to back before the plan rate applies
+}
+
+// ObjectStorage holds generated documents (e.g. invoices), keys are paths below the directory.
+type ObjectStorage struct {
+	Path string `env:"OBJECT_STORAGE_PATH" env-default:"./storage" yaml:"path"`
 }
 
+// Security signs the tokens of the API. Tokens name JWTIssuer and JWTAudience and are refused unless
+// they do, an empty one is neither set nor checked; JWTClockSkew tolerates clocks drifting apart
+// between hosts.
 type Security struct {
-	JWTKey         string `env:"JWT_KEY"          env-required:"true" yaml:"JWT_KEY"`
-	JWTExpiryHours int    `env:"JWT_EXPIRY_HOURS" env-default:"24"    yaml:"JWT_EXPIRY_HOURS"`
+	JWTKey         string        `env:"JWT_KEY"          env-required:"true"                           yaml:"JWT_KEY"`
+	JWTExpiryHours int           `env:"JWT_EXPIRY_HOURS" env-default:"24"                              yaml:"JWT_EXPIRY_HOURS"`
+	JWTIssuer      string        `env:"JWT_ISSUER"       env-default:"scalable-ecommerce-platform"     yaml:"JWT_ISSUER"`
+	JWTAudience    string        `env:"JWT_AUDIENCE"     env-default:"scalable-ecommerce-platform-api" yaml:"JWT_AUDIENCE"`
+	JWTClockSkew   time.Duration `env:"JWT_CLOCK_SKEW"   env-default:"0s"                              yaml:"JWT_CLOCK_SKEW"`
 }
 
 type OTelConfig struct {
-	ServiceName      string  `env:"OTEL_SERVICE_NAME"       env-default:"scalable-ecommerce-platform"     yaml:"SERVICE_NAME"`
-	ExporterEndpoint string  `env:"OTEL_EXPORTER_ENDPOINT"  env-default:"http://localhost:4318/v1/traces" yaml:"EXPORTER_ENDPOINT"`
-	SamplerRatio     float64 `env:"OTEL_TRACES_SAMPLER_ARG" env-default:"1.0"                             yaml:"SAMPLER_RATIO"`
+	ServiceName       string             `env:"OTEL_SERVICE_NAME"          env-default:"scalable-ecommerce-platform"                            yaml:"SERVICE_NAME"`
+	ExporterEndpoint  string             `env:"OTEL_EXPORTER_ENDPOINT"     env-default:"http://localhost:4318/v1/traces"                        yaml:"EXPORTER_ENDPOINT"`
+	SamplerRatio      float64            `env:"OTEL_TRACES_SAMPLER_ARG"    env-default:"1.0"                                                    yaml:"SAMPLER_RATIO"`
+	RouteSampling     map[string]float64 `env:"OTEL_TRACES_ROUTE_SAMPLING" env-default:"/api/v1/orders:1,/api/v1/payments:1,/livez:0,/readyz:0" yaml:"ROUTE_SAMPLING"`      // per route prefix, overrides SamplerRatio
+	IdentifierHashKey string             `env:"OTEL_IDENTIFIER_HASH_KEY"   env-default:""                                                       yaml:"IDENTIFIER_HASH_KEY"` // non-empty hashes the user, tenant and order IDs recorded on spans
 }
 
+// CacheConfig sets up the Redis cache and the optional in-process tier in front of it. LocalTTLs maps
+// the namespaces kept in process, the part of a key before the first colon, to how long a copy is kept,
+// e.g. product_availability:5s,product_facets:30s.
 type CacheConfig struct {
-	DefaultTTL time.Duration `env:"CACHE_DEFAULT_TTL" env-default:"5m" yaml:"default_ttl"`
+	DefaultTTL      time.Duration            `env:"CACHE_DEFAULT_TTL"       env-default:"5m"    yaml:"default_ttl"`
+	LocalTTLs       map[string]time.Duration `env:"CACHE_LOCAL_TTLS"                            yaml:"local_ttls"`
+	LocalMaxEntries int                      `env:"CACHE_LOCAL_MAX_ENTRIES" env-default:"10000" yaml:"local_max_entries"` // per instance, across namespaces
+	VersionRefresh  time.Duration            `env:"CACHE_VERSION_REFRESH"   env-default:"5s"    yaml:"version_refresh"`   // how soon the other instances see a namespace invalidation
+}
+
+// LogConfig sets the level and format of the server logs, the level can be changed while running
+// through the admin API. An empty level follows the environment, see Config.LogLevel.
+type LogConfig struct {
+	Level  string `env:"LOG_LEVEL"  env-default:""     yaml:"level"`  // debug, info, warn or error
+	Format string `env:"LOG_FORMAT" env-default:"json" yaml:"format"` // json or text
+}
+
+// PayloadLogConfig logs the redacted request and response bodies of the routes under the prefixes and
+// of the users, for debugging. The admin API replaces them for a while.
+type PayloadLogConfig struct {
+	Routes   []string `env:"PAYLOAD_LOG_ROUTES"                        yaml:"routes"` // path prefixes, e.g. /api/v1/orders
+	UserIDs  []string `env:"PAYLOAD_LOG_USER_IDS"                      yaml:"user_ids"`
+	MaxBytes int      `env:"PAYLOAD_LOG_MAX_BYTES" env-default:"8192" yaml:"max_bytes"` // larger bodies are logged by size only
+}
+
+type AccessLogConfig struct {
+	Enabled       bool               `env:"ACCESS_LOG_ENABLED"        env-default:"true"   yaml:"enabled"`
+	Format        string             `env:"ACCESS_LOG_FORMAT"         env-default:"json"   yaml:"format"`
+	Output        string             `env:"ACCESS_LOG_OUTPUT"         env-default:"stdout" yaml:"output"`
+	SampleRate    float64            `env:"ACCESS_LOG_SAMPLE_RATE"    env-default:"1.0"    yaml:"sample_rate"`
+	RouteSampling map[string]float64 `env:"ACCESS_LOG_ROUTE_SAMPLING"                      yaml:"route_sampling"`
+}
+
+// ConcurrencyLimitConfig caps the in-flight requests of a single user (or IP when anonymous) on expensive endpoints.
+type ConcurrencyLimitConfig struct {
+	MaxPerPrincipal int `env:"CONCURRENCY_LIMIT_PER_PRINCIPAL" env-default:"2" yaml:"max_per_principal"` // 0 disables the limit
+}
+
+// LoadSheddingConfig rejects low-priority traffic (GET requests under LowPriorityRoutes) once the service
+// is overloaded, i.e. too many requests are in flight or the recent p99 latency exceeds the target.
+type LoadSheddingConfig struct {
+	Enabled           bool          `env:"LOAD_SHEDDING_ENABLED"             env-default:"false"            yaml:"enabled"`
+	MaxInFlight       int           `env:"LOAD_SHEDDING_MAX_IN_FLIGHT"       env-default:"500"              yaml:"max_in_flight"` // 0 disables the in-flight signal
+	P99Target         time.Duration `env:"LOAD_SHEDDING_P99_TARGET"          env-default:"1s"               yaml:"p99_target"`    // 0 disables the latency signal
+	LowPriorityRoutes []string      `env:"LOAD_SHEDDING_LOW_PRIORITY_ROUTES" env-default:"/api/v1/products" yaml:"low_priority_routes"`
+}
+
+// WaitingRoomConfig holds the visitors of high-demand drops in line during traffic spikes and lets Rate of
+// them in per second, admins go straight through. A request is held on one of the Routes, mux patterns
+// out of the product page, cart item and checkout routes, or when it names one of the Products there.
+type WaitingRoomConfig struct {
+	Enabled   bool          `env:"WAITING_ROOM_ENABLED"    env-default:"false"               yaml:"enabled"`
+	Routes    []string      `env:"WAITING_ROOM_ROUTES"     env-default:"POST /api/v1/orders" yaml:"routes"`
+	Products  []string      `env:"WAITING_ROOM_PRODUCTS"                                     yaml:"products"`
+	Rate      int           `env:"WAITING_ROOM_RATE"       env-default:"50"                  yaml:"rate"`       // across the instances
+	TicketTTL time.Duration `env:"WAITING_ROOM_TICKET_TTL" env-default:"1h"                  yaml:"ticket_ttl"` // a visitor still in line after it joins again at the back
+	PassTTL   time.Duration `env:"WAITING_ROOM_PASS_TTL"   env-default:"15m"                 yaml:"pass_ttl"`   // how long a visitor let in goes through
+	Key       string        `env:"WAITING_ROOM_KEY"        env-default:""                    yaml:"key"`        // the JWT key signs the tickets when empty
+}
+
+// OrderVelocityConfig caps how many orders a customer, an IP or a card places in a window, to slow
+// down bots buying out drops. A rule either blocks the order or lets it through held for a fraud
+// review. In Shadow mode the rules only log what they would have done, to tune them on live traffic.
+type OrderVelocityConfig struct {
+	Enabled bool           `env:"ORDER_VELOCITY_ENABLED" env-default:"false" yaml:"enabled"`
+	Shadow  bool           `env:"ORDER_VELOCITY_SHADOW"  env-default:"true"  yaml:"shadow"`
+	Rules   []VelocityRule `yaml:"rules"`
+}
+
+type VelocityRule struct {
+	Name      string        `yaml:"name"`
+	Key       string        `yaml:"key"`        // customer, ip, payment_fingerprint or device_fingerprint
+	MaxOrders int           `yaml:"max_orders"` // orders allowed in the window, the next one trips the rule
+	Window    time.Duration `yaml:"window"`
+	Action    string        `yaml:"action"` // block or review
+}
+
+type SLOTarget struct {
+	Name             string        `yaml:"name"`
+	Route            string        `yaml:"route"`
+	Objective        float64       `yaml:"objective"`
+	LatencyThreshold time.Duration `yaml:"latency_threshold"`
+}
+
+// OpsServer serves the metrics, probes and API docs on a listener of their own, so the public port only
+// serves the API. Metrics and docs ask for basic auth once a username is set. The probes stay open
+// for the kubelet, so the port is expected to be reachable from inside the cluster only.
+type OpsServer struct {
+	Addr              string `env:"OPS_ADDRESS"             env-default:":9090" yaml:"address"`
+	BasicAuthUsername string `env:"OPS_BASIC_AUTH_USERNAME" env-default:""      yaml:"basic_auth_username"`
+	BasicAuthPassword string `env:"OPS_BASIC_AUTH_PASSWORD" env-default:""      yaml:"basic_auth_password"`
+	SwaggerUI         string `env:"OPS_SWAGGER_UI"          env-default:"auto"  yaml:"swagger_ui"` // auto, enabled or disabled
+}
+
+type MetricsConfig struct {
+	SLOs []SLOTarget `yaml:"slos"`
+}
+
+type AdminConfig struct {
+	NotificationEmails []string `env:"ADMIN_NOTIFICATION_EMAILS" env-default:"" yaml:"notification_emails"`
+}
+
+// ShippingConfig restricts where the store ships, checked at checkout. States and postal codes are
+// given with their country, like US-HI or US-967, postal codes match on their prefix.
+type ShippingConfig struct {
+	AllowedCountries     []string `env:"SHIPPING_ALLOWED_COUNTRIES"      env-default:""      yaml:"allowed_countries"`      // ISO 3166-1 alpha-2, empty ships to every country
+	BlockedStates        []string `env:"SHIPPING_BLOCKED_STATES"         env-default:""      yaml:"blocked_states"`         // COUNTRY-STATE
+	BlockPOBoxes         bool     `env:"SHIPPING_BLOCK_PO_BOXES"         env-default:"false" yaml:"block_po_boxes"`         // refuse post office boxes
+	EmbargoedPostalCodes []string `env:"SHIPPING_EMBARGOED_POSTAL_CODES" env-default:""      yaml:"embargoed_postal_codes"` // COUNTRY-PREFIX
+}
+
+type OrderConfig struct {
+	GiftWrapFee         float64       `env:"ORDER_GIFT_WRAP_FEE"          env-default:"0"   yaml:"gift_wrap_fee"`          // added once per gift-wrapped order
+	BulkStatusMaxOrders int           `env:"ORDER_BULK_STATUS_MAX_ORDERS" env-default:"500" yaml:"bulk_status_max_orders"` // orders accepted by one bulk status update
+	BulkStatusBatchSize int           `env:"ORDER_BULK_STATUS_BATCH_SIZE" env-default:"100" yaml:"bulk_status_batch_size"` // orders updated per statement
+	PickWaveMaxOrders   int           `env:"ORDER_PICK_WAVE_MAX_ORDERS"   env-default:"200" yaml:"pick_wave_max_orders"`   // orders of a fulfillment wave, and packing slips printed at once
+	PaymentWindow       time.Duration `env:"ORDER_PAYMENT_WINDOW"         env-default:"24h" yaml:"payment_window"`         // unpaid orders older than this are cancelled
+	LapseInterval       time.Duration `env:"ORDER_LAPSE_INTERVAL"         env-default:"15m" yaml:"lapse_interval"`         // 0 disables the sweep of unpaid orders
+	LapseBatchSize      int           `env:"ORDER_LAPSE_BATCH_SIZE"       env-default:"100" yaml:"lapse_batch_size"`
+	PaymentMaxRetries   int           `env:"ORDER_PAYMENT_MAX_RETRIES"    env-default:"3"   yaml:"payment_max_retries"`   // payment retries before the lockout
+	PaymentRetryLockout time.Duration `env:"ORDER_PAYMENT_RETRY_LOCKOUT"  env-default:"1h"  yaml:"payment_retry_lockout"` // how long retries are refused once the limit is reached
+	DuplicateWindow     time.Duration `env:"ORDER_DUPLICATE_WINDOW"       env-default:"10m" yaml:"duplicate_window"`      // an order like one placed this recently is refused as a duplicate, 0 disables the check
+}
+
+// StoreConfig holds the store settings served until an admin saves them through the admin API.
+type StoreConfig struct {
+	Name             string `env:"STORE_NAME"               env-default:""        yaml:"name"` // empty keeps the SendGrid sender name on emails
+	LegalEntity      string `env:"STORE_LEGAL_ENTITY"       env-default:"default" yaml:"legal_entity"`
+	CreditNoteSeries string `env:"STORE_CREDIT_NOTE_SERIES" env-default:"CN"      yaml:"credit_note_series"`
+	DefaultCurrency  string `env:"STORE_DEFAULT_CURRENCY"   env-default:"usd"     yaml:"default_currency"`
+	DefaultLocale    string `env:"STORE_DEFAULT_LOCALE"     env-default:"en-US"   yaml:"default_locale"`
+	DefaultTimezone  string `env:"STORE_DEFAULT_TIMEZONE"   env-default:"UTC"     yaml:"default_timezone"` // IANA name
+}
+
+// PaymentCleanupConfig schedules the cleanup of pending payments no order points to, their intents are
+// cancelled in Stripe once they are older than MinAge.
+type PaymentCleanupConfig struct {
+	MinAge    time.Duration `env:"PAYMENT_CLEANUP_MIN_AGE"    env-default:"48h" yaml:"min_age"`  // keep above the order payment window
+	Interval  time.Duration `env:"PAYMENT_CLEANUP_INTERVAL"   env-default:"1h"  yaml:"interval"` // 0 disables the cleanup
+	BatchSize int           `env:"PAYMENT_CLEANUP_BATCH_SIZE" env-default:"100" yaml:"batch_size"`
+}
+
+// RetentionConfig sets how long data is kept before the retention job purges it, a zero period
+// disables its policy. The legal retention period of orders differs between countries, their personal
+// data is kept until it is set.
+type RetentionConfig struct {
+	CartMaxAge  time.Duration `env:"RETENTION_CART_MAX_AGE"  env-default:"2160h" yaml:"cart_max_age"`  // carts untouched this long are deleted, 90 days
+	OrderPIIAge time.Duration `env:"RETENTION_ORDER_PII_AGE" env-default:"0s"    yaml:"order_pii_age"` // finished orders placed this long ago lose their personal data
+	Interval    time.Duration `env:"RETENTION_INTERVAL"      env-default:"24h"   yaml:"interval"`      // 0 disables the scheduled runs, admins run them on demand then
+	BatchSize   int           `env:"RETENTION_BATCH_SIZE"    env-default:"100"   yaml:"batch_size"`    // records purged per transaction
+	DryRun      bool          `env:"RETENTION_DRY_RUN"       env-default:"false" yaml:"dry_run"`       // scheduled runs only log what they would purge
+}
+
+// AnalyticsConfig schedules the refresh of the views the customer analytics read.
+type AnalyticsConfig struct {
+	RefreshInterval time.Duration `env:"ANALYTICS_REFRESH_INTERVAL" env-default:"1h" yaml:"refresh_interval"` // 0 disables the scheduled refresh, admins refresh on demand then
+}
+
+// PasswordPolicyConfig holds the rules a new password has to follow. The breach check sends the first
+// five characters of the SHA-1 of the password to the Pwned Passwords API, and lets the password through
+// when the API cannot be reached.
+type PasswordPolicyConfig struct {
+	MinLength          int           `env:"PASSWORD_MIN_LENGTH"           env-default:"8"                              yaml:"min_length"`
+	RequireUppercase   bool          `env:"PASSWORD_REQUIRE_UPPERCASE"    env-default:"true"                           yaml:"require_uppercase"`
+	RequireLowercase   bool          `env:"PASSWORD_REQUIRE_LOWERCASE"    env-default:"true"                           yaml:"require_lowercase"`
+	RequireDigit       bool          `env:"PASSWORD_REQUIRE_DIGIT"        env-default:"true"                           yaml:"require_digit"`
+	RequireSymbol      bool          `env:"PASSWORD_REQUIRE_SYMBOL"       env-default:"false"                          yaml:"require_symbol"`
+	BreachCheck        bool          `env:"PASSWORD_BREACH_CHECK"         env-default:"false"                          yaml:"breach_check"`
+	BreachCheckURL     string        `env:"PASSWORD_BREACH_CHECK_URL"     env-default:"https://api.pwnedpasswords.com" yaml:"breach_check_url"`
+	BreachCheckTimeout time.Duration `env:"PASSWORD_BREACH_CHECK_TIMEOUT" env-default:"3s"                             yaml:"breach_check_timeout"`
+}
+
+// PasswordHashingConfig tunes Argon2id, run the passhash benchmarks on the production hardware to fit
+// them to the login latency budget. Passwords hashed with other parameters, or with bcrypt, are hashed
+// again on their next login.
+type PasswordHashingConfig struct {
+	Memory      uint32 `env:"PASSWORD_HASH_MEMORY"      env-default:"65536" yaml:"memory"` // KiB
+	Iterations  uint32 `env:"PASSWORD_HASH_ITERATIONS"  env-default:"3"     yaml:"iterations"`
+	Parallelism uint8  `env:"PASSWORD_HASH_PARALLELISM" env-default:"4"     yaml:"parallelism"`
+}
+
+// OAuthConfig sets the lifetime of machine client tokens, revoking a client does not end the tokens it
+// holds, and how long the previous secret keeps working after a rotation.
+type OAuthConfig struct {
+	TokenTTL            time.Duration `env:"OAUTH_TOKEN_TTL"             env-default:"15m" yaml:"token_ttl"`
+	SecretRotationGrace time.Duration `env:"OAUTH_SECRET_ROTATION_GRACE" env-default:"24h" yaml:"secret_rotation_grace"`
+}
+
+// SessionConfig describes the logins recorded in the sessions of a user.
+type SessionConfig struct {
+	CountryHeader string `env:"SESSION_COUNTRY_HEADER" env-default:"" yaml:"country_header"` // set by the CDN, e.g. CF-IPCountry, no country is recorded when empty
+}
+
+// TrackingConfig signs the links customers follow to track an order without logging in. Emails
+// leave the link out while URL is empty.
+type TrackingConfig struct {
+	Key        string        `env:"TRACKING_KEY"         env-default:""   yaml:"key"`        // the JWT key signs the links when empty
+	URL        string        `env:"TRACKING_URL"         env-default:""   yaml:"url"`        // tracking page, the token is appended to it
+	RateLimit  int64         `env:"TRACKING_RATE_LIMIT"  env-default:"30" yaml:"rate_limit"` // lookups per client and window
+	RateWindow time.Duration `env:"TRACKING_RATE_WINDOW" env-default:"1m" yaml:"rate_window"`
+}
+
+// EmailChangeConfig signs the links confirming an email change, sent to both the old and the new
+// address. Emails carry the bare token while URL is empty.
+type EmailChangeConfig struct {
+	Key string        `env:"EMAIL_CHANGE_KEY" env-default:""    yaml:"key"` // the JWT key signs the links when empty
+	URL string        `env:"EMAIL_CHANGE_URL" env-default:""    yaml:"url"` // confirmation page, the token is appended to it
+	TTL time.Duration `env:"EMAIL_CHANGE_TTL" env-default:"24h" yaml:"ttl"` // how long both addresses have to confirm
+}
+
+// WebhookConfig sizes the worker pool processing Stripe webhooks, events of the same payment always go to the same worker.
+type WebhookConfig struct {
+	Workers           int           `env:"WEBHOOK_WORKERS"            env-default:"4"    yaml:"workers"`
+	QueueSize         int           `env:"WEBHOOK_QUEUE_SIZE"         env-default:"1000" yaml:"queue_size"`         // split evenly between the workers
+	RedeliverInterval time.Duration `env:"WEBHOOK_REDELIVER_INTERVAL" env-default:"1m"   yaml:"redeliver_interval"` // how often the failed and abandoned events are delivered again
+}
+
+// CarrierWebhookConfig checks the signatures of the carrier callbacks, several secrets are accepted
+// while one is being rotated out.
+type CarrierWebhookConfig struct {
+	Secrets   []string      `env:"CARRIER_WEBHOOK_SECRETS"   env-default:""   yaml:"secrets"` // callbacks are refused while empty
+	Tolerance time.Duration `env:"CARRIER_WEBHOOK_TOLERANCE" env-default:"5m" yaml:"tolerance"`
+}
+
+// NotificationRetryConfig sets the backoff of failed notifications, the n-th retry waits BaseDelay * 2^(n-1) capped at MaxDelay.
+type NotificationRetryConfig struct {
+	MaxAttempts int           `env:"NOTIFICATION_RETRY_MAX_ATTEMPTS" env-default:"5"   yaml:"max_attempts"` // including the first send
+	BaseDelay   time.Duration `env:"NOTIFICATION_RETRY_BASE_DELAY"   env-default:"1m"  yaml:"base_delay"`
+	MaxDelay    time.Duration `env:"NOTIFICATION_RETRY_MAX_DELAY"    env-default:"1h"  yaml:"max_delay"`
+	Interval    time.Duration `env:"NOTIFICATION_RETRY_INTERVAL"     env-default:"30s" yaml:"interval"` // 0 disables the retry worker
+	BatchSize   int           `env:"NOTIFICATION_RETRY_BATCH_SIZE"   env-default:"50"  yaml:"batch_size"`
+}
+
+// BroadcastConfig throttles admin broadcasts, recipients are emailed at no more than RatePerSecond.
+type BroadcastConfig struct {
+	RatePerSecond  int           `env:"BROADCAST_RATE_PER_SECOND" env-default:"10"  yaml:"rate_per_second"`
+	BatchSize      int           `env:"BROADCAST_BATCH_SIZE"      env-default:"100" yaml:"batch_size"` // recipients between two progress saves
+	QueueSize      int           `env:"BROADCAST_QUEUE_SIZE"      env-default:"100" yaml:"queue_size"`
+	ResumeInterval time.Duration `env:"BROADCAST_RESUME_INTERVAL" env-default:"1m"  yaml:"resume_interval"` // how often abandoned broadcasts are picked up
+}
+
+type ProductConfig struct {
+	PublishInterval time.Duration `env:"PRODUCT_PUBLISH_INTERVAL" env-default:"1m" yaml:"publish_interval"` // 0 disables the publishing of scheduled products
+}
+
+// ProductQuestionConfig limits the product questions a customer can ask, and the questions a product
+// can get, per rate window. A burst of questions on a product within the burst window, or the same
+// question from several customers, puts it first in the moderation queue.
+type ProductQuestionConfig struct {
+	UserLimit       int64         `env:"QUESTION_USER_LIMIT"       env-default:"5"    yaml:"user_limit"`    // questions per customer and window
+	ProductLimit    int64         `env:"QUESTION_PRODUCT_LIMIT"    env-default:"50"   yaml:"product_limit"` // questions per product and window
+	RateWindow      time.Duration `env:"QUESTION_RATE_WINDOW"      env-default:"1h"   yaml:"rate_window"`
+	BurstLimit      int           `env:"QUESTION_BURST_LIMIT"      env-default:"10"   yaml:"burst_limit"`      // questions on a product within the burst window before they are flagged
+	BurstWindow     time.Duration `env:"QUESTION_BURST_WINDOW"     env-default:"10m"  yaml:"burst_window"`     // 0 disables the burst flag
+	DuplicateWindow time.Duration `env:"QUESTION_DUPLICATE_WINDOW" env-default:"720h" yaml:"duplicate_window"` // 0 disables the duplicate checks
+}
+
+type CampaignConfig struct {
+	Interval time.Duration `env:"CAMPAIGN_INTERVAL" env-default:"15s" yaml:"interval"` // how late a campaign may start or end, 0 stops starting and ending campaigns
+}
+
+// CatalogImportConfig holds the credentials of the stores the catalog can be imported from, a store
+// without credentials is not offered as a source.
+type CatalogImportConfig struct {
+	ShopifyStoreURL           string        `env:"CATALOG_IMPORT_SHOPIFY_STORE_URL"           env-default:""    yaml:"shopify_store_url"` // e.g. https://acme.myshopify.com
+	ShopifyAccessToken        string        `env:"CATALOG_IMPORT_SHOPIFY_ACCESS_TOKEN"        env-default:""    yaml:"shopify_access_token"`
+	WooCommerceStoreURL       string        `env:"CATALOG_IMPORT_WOOCOMMERCE_STORE_URL"       env-default:""    yaml:"woocommerce_store_url"`
+	WooCommerceConsumerKey    string        `env:"CATALOG_IMPORT_WOOCOMMERCE_CONSUMER_KEY"    env-default:""    yaml:"woocommerce_consumer_key"`
+	WooCommerceConsumerSecret string        `env:"CATALOG_IMPORT_WOOCOMMERCE_CONSUMER_SECRET" env-default:""    yaml:"woocommerce_consumer_secret"`
+	RequestTimeout            time.Duration `env:"CATALOG_IMPORT_REQUEST_TIMEOUT"             env-default:"30s" yaml:"request_timeout"` // per API call to the store
+	BatchSize                 int           `env:"CATALOG_IMPORT_BATCH_SIZE"                  env-default:"100" yaml:"batch_size"`      // variants between two report saves
+	QueueSize                 int           `env:"CATALOG_IMPORT_QUEUE_SIZE"                  env-default:"10"  yaml:"queue_size"`
+	ResumeInterval            time.Duration `env:"CATALOG_IMPORT_RESUME_INTERVAL"             env-default:"1m"  yaml:"resume_interval"` // how often abandoned imports are picked up
+}
+
+// CatalogSnapshotConfig tunes the catalog snapshot served to the edge caches.
+type CatalogSnapshotConfig struct {
+	MaxDelta int           `env:"CATALOG_SNAPSHOT_MAX_DELTA" env-default:"1000" yaml:"max_delta"` // products changed past which a full snapshot is served instead of a delta
+	MaxAge   time.Duration `env:"CATALOG_SNAPSHOT_MAX_AGE"   env-default:"60s"  yaml:"max_age"`   // how long shared caches keep a snapshot before revalidating it
+}
+
+// APIConfig pins the response variant served to clients that do not send an Api-Version header.
+type APIConfig struct {
+	DefaultVersion string `env:"API_DEFAULT_VERSION" env-default:"1" yaml:"default_version"`
+}
+
+// IDConfig selects the UUID version of new orders and notifications, 4 restores random identifiers.
+type IDConfig struct {
+	Version int `env:"ID_VERSION" env-default:"7" yaml:"version"`
+}
+
+type InvariantsConfig struct {
+	Interval time.Duration `env:"INVARIANTS_INTERVAL" env-default:"0s" yaml:"interval"` // 0 disables the scheduled check
+}
+
+// ChaosConfig enables fault injection for load tests, it must never be turned on in production.
+type ChaosConfig struct {
+	Enabled        bool          `env:"CHAOS_ENABLED"         env-default:"false" yaml:"enabled"`
+	InventoryDelay time.Duration `env:"CHAOS_INVENTORY_DELAY" env-default:"200ms" yaml:"inventory_delay"`
+}
+
+// VendorConfig sets the commission of new marketplace vendors, how often their earnings are accrued and
+// payouts recalculated, and the terms of the Stripe transfers paying them.
+type VendorConfig struct {
+	DefaultCommissionRate float64       `env:"VENDOR_DEFAULT_COMMISSION_RATE" env-default:"0.1" yaml:"default_commission_rate"`
+	PayoutInterval        time.Duration `env:"VENDOR_PAYOUT_INTERVAL"         env-default:"24h" yaml:"payout_interval"` // 0 disables the scheduled payouts
+	PayoutCurrency        string        `env:"VENDOR_PAYOUT_CURRENCY"         env-default:"usd" yaml:"payout_currency"`
+	MinPayout             float64       `env:"VENDOR_MIN_PAYOUT"              env-default:"10"  yaml:"min_payout"` // smaller balances wait for the next batch
 }
 
 type Config struct {
-	Env          string       `env:"ENV"          env-required:"true" yaml:"env"`
-	HTTPServer   HTTPServer   `yaml:"http_server"`
-	Database     Database     `yaml:"database"`
-	RedisConnect RedisConnect `yaml:"redis"`
-	RateConfig   RateConfig   `yaml:"rateConfig"`
-	Stripe       Stripe       `yaml:"stripe"`
-	SendGrid     SendGrid     `yaml:"sendgrid"`
-	Security     Security     `yaml:"security"`
-	OTel         OTelConfig   `yaml:"otel"`
-	Cache        CacheConfig  `yaml:"cache"`
+	Env          string                  `env:"ENV"          env-required:"true" yaml:"env"`
+	HTTPServer   HTTPServer              `yaml:"http_server"`
+	OpsServer    OpsServer               `yaml:"ops_server"`
+	Database     Database                `yaml:"database"`
+	RedisConnect RedisConnect            `yaml:"redis"`
+	RateConfig   RateConfig              `yaml:"rateConfig"`
+	Stripe       Stripe                  `yaml:"stripe"`
+	SendGrid     SendGrid                `yaml:"sendgrid"`
+	Security     Security                `yaml:"security"`
+	OTel         OTelConfig              `yaml:"otel"`
+	Cache        CacheConfig             `yaml:"cache"`
+	Log          LogConfig               `yaml:"log"`
+	PayloadLog   PayloadLogConfig        `yaml:"payload_log"`
+	AccessLog    AccessLogConfig         `yaml:"access_log"`
+	Metrics      MetricsConfig           `yaml:"metrics"`
+	Invariants   InvariantsConfig        `yaml:"invariants"`
+	Chaos        ChaosConfig             `yaml:"chaos"`
+	Admin        AdminConfig             `yaml:"admin"`
+	Orders       OrderConfig             `yaml:"orders"`
+	Store        StoreConfig             `yaml:"store"`
+	Cleanup      PaymentCleanupConfig    `yaml:"payment_cleanup"`
+	Retention    RetentionConfig         `yaml:"retention"`
+	Analytics    AnalyticsConfig         `yaml:"analytics"`
+	Velocity     OrderVelocityConfig     `yaml:"order_velocity"`
+	Tracking     TrackingConfig          `yaml:"tracking"`
+	Sessions     SessionConfig           `yaml:"sessions"`
+	EmailChange  EmailChangeConfig       `yaml:"email_change"`
+	Passwords    PasswordPolicyConfig    `yaml:"password_policy"`
+	Hashing      PasswordHashingConfig   `yaml:"password_hashing"`
+	OAuth        OAuthConfig             `yaml:"oauth"`
+	Shipping     ShippingConfig          `yaml:"shipping"`
+	Concurrency  ConcurrencyLimitConfig  `yaml:"concurrency_limit"`
+	LoadShedding LoadSheddingConfig      `yaml:"load_shedding"`
+	WaitingRoom  WaitingRoomConfig       `yaml:"waiting_room"`
+	Webhooks     WebhookConfig           `yaml:"webhooks"`
+	Carriers     CarrierWebhookConfig    `yaml:"carrier_webhooks"`
+	Notification NotificationRetryConfig `yaml:"notification_retry"`
+	Storage      ObjectStorage           `yaml:"object_storage"`
+	Broadcast    BroadcastConfig         `yaml:"broadcast"`
+	Vendors      VendorConfig            `yaml:"vendors"`
+	Products     ProductConfig           `yaml:"products"`
+	Questions    ProductQuestionConfig   `yaml:"product_questions"`
+	Campaigns    CampaignConfig          `yaml:"campaigns"`
+	Catalog      CatalogImportConfig     `yaml:"catalog_import"`
+	Snapshot     CatalogSnapshotConfig   `yaml:"catalog_snapshot"`
+	API          APIConfig               `yaml:"api"`
+	IDs          IDConfig                `yaml:"ids"`
+
+	layers []string
 }
 
+// MustLoad loads and validates the config, see ResolveLayers for the files read and LoadLayers for
+// the precedence: each file over the previous one, environment variables last.
 func MustLoad() *Config {
-	var configPath string
-
-	configPath = os.Getenv("CONFIG_PATH")
+	var configFlag string
 
-	if configPath == "" {
-		flags := flag.String("config", "", "gets the config flag value")
+	if os.Getenv("CONFIG_PATH") == "" {
+		flags := flag.String("config", "", "config files to read, comma separated, each over the previous one")
 
 		flag.Parse()
 
-		configPath = *flags
-
-		if configPath == "" {
-			defaultPath := "./config/local.yaml"
-			if _, err := os.Stat(defaultPath); err == nil {
-				configPath = defaultPath
-				log.Printf("Config path not specified, using default: %s", configPath)
-			} else {
-				log.Fatal("Config path is not set and default ./config/local.yaml not found")
-			}
-		}
+		configFlag = *flags
 	}
 
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		log.Fatalf("config file does not exist: %s", configPath)
-	} else if err != nil {
-		log.Fatalf("error accessing config file at %s: %v", configPath, err)
+	paths, err := ResolveLayers(configFlag)
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
-	var cfg Config
+	log.Printf("Reading config files: %s", strings.Join(paths, ", "))
 
-	err := cleanenv.ReadConfig(configPath, &cfg)
+	cfg, err := Load(paths...)
 	if err != nil {
-		log.Fatalf("cannot read config file: %s", err.Error())
+		log.Fatal(err.Error())
 	}
 
-	// Environment variables can override the defaults
-	err = cleanenv.ReadEnv(&cfg)
+	return cfg
+}
+
+// Load reads the config files, lets the environment variables override them and validates the
+// result, see LoadLayers and Config.Validate.
+func Load(paths ...string) (*Config, error) {
+	cfg, err := LoadLayers(paths...)
 	if err != nil {
-		log.Fatalf("cannot read environment variables: %s", err.Error())
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
 	}
 
-	return &cfg
+	return cfg, nil
 }
 
 func LoadConfigFromPath(configPath string) (*Config, error) {
@@ -138,23 +503,33 @@ func LoadConfigFromPath(configPath string) (*Config, error) {
 		return nil, errors.New("config path is empty")
 	}
 
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("config file does not exist: %s", configPath)
-	}
-
-	var cfg Config
+	return LoadLayers(configPath)
+}
 
-	err := cleanenv.ReadConfig(configPath, &cfg)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read config file: %s", err.Error())
+// LogLevel returns the configured log level, debug everywhere but in production when none is set.
+func (c *Config) LogLevel() string {
+	if c.Log.Level != "" {
+		return c.Log.Level
 	}
 
-	err = cleanenv.ReadEnv(&cfg)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read environment variables: %s", err.Error())
+	if c.Env == "production" {
+		return "info"
 	}
 
-	return &cfg, nil
+	return "debug"
+}
+
+// SwaggerUIEnabled reports whether the ops listener serves the Swagger UI, auto serves it everywhere
+// but in production. The spec itself is always served.
+func (c *Config) SwaggerUIEnabled() bool {
+	switch c.OpsServer.SwaggerUI {
+	case "enabled":
+		return true
+	case "disabled":
+		return false
+	default:
+		return c.Env != "production"
+	}
 }
 
 func (d *Database) GetDSN() string {
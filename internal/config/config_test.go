@@ -275,6 +275,42 @@ security: {JWT_KEY: "filekey"} # Required field
 	})
 }
 
+func TestSwaggerUIEnabled(t *testing.T) {
+	tests := []struct {
+		env       string
+		swaggerUI string
+		expected  bool
+	}{
+		{env: "local", swaggerUI: "auto", expected: true},
+		{env: "staging", swaggerUI: "", expected: true},
+		{env: "production", swaggerUI: "auto", expected: false},
+		{env: "production", swaggerUI: "enabled", expected: true},
+		{env: "staging", swaggerUI: "disabled", expected: false},
+	}
+
+	for _, tt := range tests {
+		cfg := &Config{Env: tt.env, OpsServer: OpsServer{SwaggerUI: tt.swaggerUI}}
+		assert.Equal(t, tt.expected, cfg.SwaggerUIEnabled(), "env %s, swagger_ui %q", tt.env, tt.swaggerUI)
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	tests := []struct {
+		env      string
+		level    string
+		expected string
+	}{
+		{env: "local", level: "", expected: "debug"},
+		{env: "production", level: "", expected: "info"},
+		{env: "production", level: "warn", expected: "warn"},
+	}
+
+	for _, tt := range tests {
+		cfg := &Config{Env: tt.env, Log: LogConfig{Level: tt.level}}
+		assert.Equal(t, tt.expected, cfg.LogLevel(), "env %s, level %q", tt.env, tt.level)
+	}
+}
+
 func TestRedisConnectGetDSN(t *testing.T) {
 	redisConfig := RedisConnect{
 		Host:     "localhost",
@@ -0,0 +1,206 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"gopkg.in/yaml.v3"
+)
+
+// The layers looked for in the config directory when no file is named, each read over the previous one:
+// the base shared by every environment, the overlay of the environment, e.g. production.yaml, and the
+// local overrides of a developer, kept out of git.
+const (
+	DefaultDir = "./config"
+	BaseLayer  = "base.yaml"
+	LocalLayer = "local.yaml"
+)
+
+const redacted = "[redacted]"
+
+// ResolveLayers returns the config files to read, in order. CONFIG_PATH, or else configFlag, names them
+// as a comma separated list, e.g. config/base.yaml,config/staging.yaml. Otherwise they are the layers of
+// DefaultDir that exist, the overlay being the one of the ENV environment variable, or of the env the
+// base sets.
+func ResolveLayers(configFlag string) ([]string, error) {
+	list := os.Getenv("CONFIG_PATH")
+	if list == "" {
+		list = configFlag
+	}
+
+	if list != "" {
+		var paths []string
+
+		for path := range strings.SplitSeq(list, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+
+		return paths, nil
+	}
+
+	var paths []string
+
+	base := filepath.Join(DefaultDir, BaseLayer)
+	if fileExists(base) {
+		paths = append(paths, base)
+	}
+
+	env := os.Getenv("ENV")
+	if env == "" && len(paths) > 0 {
+		var doc struct {
+			Env string `yaml:"env"`
+		}
+
+		data, err := os.ReadFile(base)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read config file: %w", err)
+		}
+
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("cannot parse config file %s: %w", base, err)
+		}
+
+		env = doc.Env
+	}
+
+	if env != "" && filepath.Base(env) == env && env+".yaml" != LocalLayer && env+".yaml" != BaseLayer {
+		if overlay := filepath.Join(DefaultDir, env+".yaml"); fileExists(overlay) {
+			paths = append(paths, overlay)
+		}
+	}
+
+	if local := filepath.Join(DefaultDir, LocalLayer); fileExists(local) {
+		paths = append(paths, local)
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("config path is not set and no %s, environment overlay or %s found in %s", BaseLayer, LocalLayer, DefaultDir)
+	}
+
+	return paths, nil
+}
+
+// LoadLayers reads the config files in order, then lets the environment variables override them and
+// fills in the defaults of the options still unset. A layer overrides the options it sets and keeps
+// the others: mappings are merged key by key, every other value, lists included, is replaced whole.
+func LoadLayers(paths ...string) (*Config, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("config path is empty")
+	}
+
+	merged := map[string]any{}
+
+	for _, path := range paths {
+		if ext := strings.ToLower(filepath.Ext(path)); ext != ".yaml" && ext != ".yml" {
+			return nil, fmt.Errorf("config file %s is not a YAML file", path)
+		}
+
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("config file does not exist: %s", path)
+		} else if err != nil {
+			return nil, fmt.Errorf("cannot read config file: %w", err)
+		}
+
+		var layer map[string]any
+		if err := yaml.Unmarshal(data, &layer); err != nil {
+			return nil, fmt.Errorf("cannot read config file: config file parsing error in %s: %s", path, err.Error())
+		}
+
+		mergeLayer(merged, layer)
+	}
+
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("cannot merge config files: %w", err)
+	}
+
+	var cfg Config
+
+	if err := cleanenv.ParseYAML(bytes.NewReader(data), &cfg); err != nil {
+		return nil, fmt.Errorf("cannot read config file: config file parsing error: %s", err.Error())
+	}
+
+	// Environment variables override the files and fill in the defaults
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		return nil, fmt.Errorf("cannot read environment variables: %s", err.Error())
+	}
+
+	cfg.layers = paths
+
+	return &cfg, nil
+}
+
+func mergeLayer(dst, src map[string]any) {
+	for key, value := range src {
+		if srcMap, ok := value.(map[string]any); ok {
+			if dstMap, ok := dst[key].(map[string]any); ok {
+				mergeLayer(dstMap, srcMap)
+
+				continue
+			}
+		}
+
+		dst[key] = value
+	}
+}
+
+// Layers returns the files the config was read from, in order.
+func (c *Config) Layers() []string {
+	return c.layers
+}
+
+// Effective renders the config as the service loaded it, every layer and environment variable
+// applied, as YAML with the secrets redacted.
+func (c *Config) Effective() ([]byte, error) {
+	copied := *c
+	redactSecrets(reflect.ValueOf(&copied).Elem())
+
+	data, err := yaml.Marshal(&copied)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return data, nil
+}
+
+// redactSecrets blanks the passwords, keys, secrets and tokens set in value. Only struct fields are
+// walked, the copy shares its slices and maps with the original.
+func redactSecrets(value reflect.Value) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+
+		switch fieldValue.Kind() {
+		case reflect.Struct:
+			redactSecrets(fieldValue)
+		case reflect.String:
+			if fieldValue.String() != "" && isSecret(field.Name) {
+				fieldValue.SetString(redacted)
+			}
+		}
+	}
+}
+
+func isSecret(name string) bool {
+	return strings.HasSuffix(name, "Key") || strings.Contains(name, "Password") ||
+		strings.Contains(name, "Secret") || strings.Contains(name, "Token")
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// Writes the layers into ./config of a temporary working directory.
+func createConfigDir(t *testing.T, layers map[string]string) {
+	t.Helper()
+
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.Mkdir(DefaultDir, 0o755))
+
+	for name, content := range layers {
+		require.NoError(t, os.WriteFile(filepath.Join(DefaultDir, name), []byte(content), 0o600))
+	}
+}
+
+func TestResolveLayers(t *testing.T) {
+	tests := []struct {
+		name       string
+		layers     map[string]string
+		env        string
+		configPath string
+		configFlag string
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:   "Overlay of the env the base sets",
+			layers: map[string]string{"base.yaml": "env: staging", "staging.yaml": "", "production.yaml": "", "local.yaml": ""},
+			want:   []string{"config/base.yaml", "config/staging.yaml", "config/local.yaml"},
+		},
+		{
+			name:   "ENV picks the overlay",
+			layers: map[string]string{"base.yaml": "env: staging", "staging.yaml": "", "production.yaml": ""},
+			env:    "production",
+			want:   []string{"config/base.yaml", "config/production.yaml"},
+		},
+		{
+			name:   "Local overrides alone",
+			layers: map[string]string{"local.yaml": ""},
+			want:   []string{"config/local.yaml"},
+		},
+		{
+			name:       "CONFIG_PATH over the flag",
+			layers:     map[string]string{"base.yaml": ""},
+			configPath: "a.yaml, b.yaml",
+			configFlag: "c.yaml",
+			want:       []string{"a.yaml", "b.yaml"},
+		},
+		{
+			name:       "Flag over the directory",
+			layers:     map[string]string{"base.yaml": ""},
+			configFlag: "c.yaml",
+			want:       []string{"c.yaml"},
+		},
+		{
+			name:    "No layer found",
+			layers:  map[string]string{"production.yaml": ""},
+			env:     "staging",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			createConfigDir(t, tt.layers)
+			t.Setenv("ENV", tt.env)
+			t.Setenv("CONFIG_PATH", tt.configPath)
+
+			// Act
+			paths, err := ResolveLayers(tt.configFlag)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, paths)
+		})
+	}
+}
+
+func TestLoadLayers_Precedence(t *testing.T) {
+	// Arrange
+	createConfigDir(t, map[string]string{
+		"base.yaml": validConfigYAML + `
+cache: {default_ttl: 10m, local_ttls: {products: 5s, facets: 30s}}
+shipping: {allowed_countries: [US, CA, MX]}
+orders: {payment_window: 12h}
+`,
+		"production.yaml": `
+env: production
+database: {PG_HOST: prod-db}
+cache: {local_ttls: {facets: 1m}}
+shipping: {allowed_countries: [US]}
+`,
+		"local.yaml": `
+orders: {payment_window: 6h}
+`,
+	})
+	t.Setenv("ORDER_PAYMENT_WINDOW", "2h")
+
+	// Act
+	cfg, err := LoadLayers("config/base.yaml", "config/production.yaml", "config/local.yaml")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"config/base.yaml", "config/production.yaml", "config/local.yaml"}, cfg.Layers())
+	assert.Equal(t, "production", cfg.Env)
+	assert.Equal(t, "prod-db", cfg.Database.Host)
+	assert.Equal(t, "u", cfg.Database.User)
+	assert.Equal(t, 10*time.Minute, cfg.Cache.DefaultTTL)
+	assert.Equal(t, map[string]time.Duration{"products": 5 * time.Second, "facets": time.Minute}, cfg.Cache.LocalTTLs)
+	assert.Equal(t, []string{"US"}, cfg.Shipping.AllowedCountries)
+	assert.Equal(t, 2*time.Hour, cfg.Orders.PaymentWindow)
+	assert.Equal(t, 24, cfg.Security.JWTExpiryHours)
+}
+
+func TestLoadLayers_MissingLayer(t *testing.T) {
+	// Arrange
+	createConfigDir(t, map[string]string{"base.yaml": validConfigYAML})
+
+	// Act
+	cfg, err := LoadLayers("config/base.yaml", "config/staging.yaml")
+
+	// Assert
+	assert.Nil(t, cfg)
+	assert.ErrorContains(t, err, "config file does not exist: config/staging.yaml")
+}
+
+func TestEffective(t *testing.T) {
+	// Arrange
+	cfg := loadValidConfig(t)
+	cfg.Stripe.APIKey = "sk_live_123"
+	cfg.Catalog.ShopifyAccessToken = "shpat_123"
+
+	// Act
+	data, err := cfg.Effective()
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "sk_live_123")
+	assert.NotContains(t, string(data), "shpat_123")
+
+	var printed Config
+	require.NoError(t, yaml.Unmarshal(data, &printed))
+	assert.Equal(t, redacted, printed.Stripe.APIKey)
+	assert.Equal(t, redacted, printed.Database.Password)
+	assert.Equal(t, redacted, printed.Security.JWTKey)
+	assert.Empty(t, printed.Stripe.WebhookSecret)
+	assert.Equal(t, "db", printed.Database.Host)
+	assert.Equal(t, cfg.Orders.PaymentWindow, printed.Orders.PaymentWindow)
+	assert.Equal(t, "sk_live_123", cfg.Stripe.APIKey)
+}
@@ -0,0 +1,509 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
+)
+
+// The kinds of problem a FieldError reports, match them with errors.Is.
+var (
+	ErrRequired      = errors.New("required option missing")
+	ErrInvalidFormat = errors.New("invalid option format")
+	ErrOutOfRange    = errors.New("option out of range")
+	ErrConflict      = errors.New("conflicting options")
+)
+
+// FieldError is a problem with one option, named by its path in the config file and its environment
+// variable, like orders.payment_window (ORDER_PAYMENT_WINDOW).
+type FieldError struct {
+	Field  string
+	Kind   error
+	Detail string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Detail
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Kind
+}
+
+// ValidationError gathers every problem of a config, so that one start reports them all.
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "invalid config, %d problem(s):", len(e.Errors))
+
+	for _, err := range e.Errors {
+		b.WriteString("\n  - ")
+		b.WriteString(err.Error())
+	}
+
+	return b.String()
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// Validate checks the options the server would otherwise trip on later, at runtime: missing required
+// ones, malformed URLs, addresses and durations, values out of range and options that cannot go
+// together. It returns a *ValidationError listing every problem, or nil.
+func (c *Config) Validate() error {
+	v := newValidator(c)
+
+	c.validateServers(v)
+	c.validateConnections(v)
+	c.validateIntegrations(v)
+	c.validateSecurity(v)
+	c.validateObservability(v)
+	c.validateTraffic(v)
+	c.validateCommerce(v)
+	c.validateJobs(v)
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Errors: v.errs}
+}
+
+func (c *Config) validateServers(v *validator) {
+	v.required(&c.Env)
+	v.required(&c.HTTPServer.Addr)
+	v.positive(&c.HTTPServer.DrainTimeout)
+	v.required(&c.OpsServer.Addr)
+	v.oneOf(&c.OpsServer.SwaggerUI, "auto", "enabled", "disabled")
+
+	if c.HTTPServer.Addr != "" && c.HTTPServer.Addr == c.OpsServer.Addr {
+		v.fail(&c.OpsServer.Addr, ErrConflict, "must differ from the API address %s", c.HTTPServer.Addr)
+	}
+
+	if (c.OpsServer.BasicAuthUsername == "") != (c.OpsServer.BasicAuthPassword == "") {
+		v.fail(&c.OpsServer.BasicAuthPassword, ErrConflict, "must be set together with the basic auth username")
+	}
+
+	if c.Chaos.Enabled && c.Env == "production" {
+		v.fail(&c.Chaos.Enabled, ErrConflict, "must never be turned on in production")
+	}
+}
+
+func (c *Config) validateConnections(v *validator) {
+	v.required(&c.Database.Host)
+	v.port(&c.Database.Port)
+	v.required(&c.Database.User)
+	v.required(&c.Database.Name)
+	v.oneOf(&c.Database.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+
+	if c.Database.MaxOpenConns < 1 {
+		v.fail(&c.Database.MaxOpenConns, ErrOutOfRange, "must be at least 1, got %d", c.Database.MaxOpenConns)
+	} else if c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		v.fail(&c.Database.MaxIdleConns, ErrConflict, "must not exceed the %d open connections", c.Database.MaxOpenConns)
+	}
+
+	v.required(&c.RedisConnect.Host)
+	v.port(&c.RedisConnect.Port)
+
+	if c.RedisConnect.DB < 0 {
+		v.fail(&c.RedisConnect.DB, ErrOutOfRange, "must not be negative, got %d", c.RedisConnect.DB)
+	}
+
+	v.required(&c.Storage.Path)
+}
+
+func (c *Config) validateIntegrations(v *validator) {
+	if c.Env == "production" {
+		v.required(&c.Stripe.APIKey)
+		v.required(&c.Stripe.WebhookSecret)
+		v.required(&c.SendGrid.APIKey)
+	}
+
+	v.url(&c.Stripe.CheckoutSuccessURL)
+	v.url(&c.Stripe.CheckoutCancelURL)
+
+	if len(c.Stripe.SupportedCurrencies) == 0 {
+		v.fail(&c.Stripe.SupportedCurrencies, ErrRequired, "must list at least one currency")
+	}
+
+	for _, currency := range c.Stripe.SupportedCurrencies {
+		if !isCurrency(strings.TrimSpace(currency)) {
+			v.fail(&c.Stripe.SupportedCurrencies, ErrInvalidFormat, "must hold ISO 4217 codes, got %q", currency)
+		}
+	}
+
+	v.email(&c.SendGrid.FromEmail)
+
+	if _, ok := sendgrid.Plans[c.SendGrid.Plan]; c.SendGrid.Plan != "" && !ok {
+		v.fail(&c.SendGrid.Plan, ErrInvalidFormat, "is not a known SendGrid plan, got %q", c.SendGrid.Plan)
+	}
+
+	if c.SendGrid.AttachmentMaxSize > c.SendGrid.AttachmentMaxTotalSize {
+		v.fail(&c.SendGrid.AttachmentMaxSize, ErrConflict, "must not exceed the attachment max total size %d", c.SendGrid.AttachmentMaxTotalSize)
+	}
+
+	v.url(&c.Catalog.ShopifyStoreURL)
+	v.together(&c.Catalog.ShopifyStoreURL, &c.Catalog.ShopifyAccessToken)
+	v.url(&c.Catalog.WooCommerceStoreURL)
+	v.together(&c.Catalog.WooCommerceStoreURL, &c.Catalog.WooCommerceConsumerKey, &c.Catalog.WooCommerceConsumerSecret)
+}
+
+func (c *Config) validateSecurity(v *validator) {
+	v.required(&c.Security.JWTKey)
+
+	if c.Security.JWTExpiryHours < 1 {
+		v.fail(&c.Security.JWTExpiryHours, ErrOutOfRange, "must be at least 1, got %d", c.Security.JWTExpiryHours)
+	}
+
+	if c.Passwords.MinLength < 1 {
+		v.fail(&c.Passwords.MinLength, ErrOutOfRange, "must be at least 1, got %d", c.Passwords.MinLength)
+	}
+
+	if c.Passwords.BreachCheck {
+		v.required(&c.Passwords.BreachCheckURL)
+		v.positive(&c.Passwords.BreachCheckTimeout)
+	}
+
+	v.url(&c.Passwords.BreachCheckURL)
+
+	if c.Hashing.Memory == 0 || c.Hashing.Iterations == 0 || c.Hashing.Parallelism == 0 {
+		v.fail(&c.Hashing, ErrOutOfRange, "memory, iterations and parallelism must all be positive")
+	}
+
+	v.positive(&c.OAuth.TokenTTL)
+	v.url(&c.Tracking.URL)
+	v.url(&c.EmailChange.URL)
+	v.positive(&c.EmailChange.TTL)
+}
+
+func (c *Config) validateObservability(v *validator) {
+	v.oneOf(&c.Log.Level, "", "debug", "info", "warn", "error")
+	v.oneOf(&c.Log.Format, "json", "text")
+	v.oneOf(&c.AccessLog.Format, "json", "combined")
+	v.ratio(&c.AccessLog.SampleRate)
+
+	for route, rate := range c.AccessLog.RouteSampling {
+		if rate < 0 || rate > 1 {
+			v.fail(&c.AccessLog.RouteSampling, ErrOutOfRange, "must be between 0 and 1, got %g for %s", rate, route)
+		}
+	}
+
+	if c.PayloadLog.MaxBytes < 1 {
+		v.fail(&c.PayloadLog.MaxBytes, ErrOutOfRange, "must be at least 1, got %d", c.PayloadLog.MaxBytes)
+	}
+
+	v.required(&c.OTel.ServiceName)
+	v.required(&c.OTel.ExporterEndpoint)
+	v.ratio(&c.OTel.SamplerRatio)
+
+	for route, rate := range c.OTel.RouteSampling {
+		if rate < 0 || rate > 1 {
+			v.fail(&c.OTel.RouteSampling, ErrOutOfRange, "must be between 0 and 1, got %g for %s", rate, route)
+		}
+	}
+
+	for i := range c.Metrics.SLOs {
+		slo := &c.Metrics.SLOs[i]
+
+		v.required(&slo.Name)
+		v.required(&slo.Route)
+
+		if slo.Objective <= 0 || slo.Objective >= 1 {
+			v.fail(&slo.Objective, ErrOutOfRange, "must be between 0 and 1 exclusive, got %g", slo.Objective)
+		}
+	}
+}
+
+func (c *Config) validateTraffic(v *validator) {
+	if c.RateConfig.MaxAttempts < 1 {
+		v.fail(&c.RateConfig.MaxAttempts, ErrOutOfRange, "must be at least 1, got %d", c.RateConfig.MaxAttempts)
+	}
+
+	v.positive(&c.RateConfig.WindowSize)
+	v.positive(&c.Cache.DefaultTTL)
+
+	if c.Concurrency.MaxPerPrincipal < 0 {
+		v.fail(&c.Concurrency.MaxPerPrincipal, ErrOutOfRange, "must not be negative, got %d", c.Concurrency.MaxPerPrincipal)
+	}
+
+	if c.LoadShedding.Enabled && c.LoadShedding.MaxInFlight <= 0 && c.LoadShedding.P99Target == 0 {
+		v.fail(&c.LoadShedding.Enabled, ErrConflict, "needs the max in flight or the p99 target, both are disabled")
+	}
+
+	if c.WaitingRoom.Enabled {
+		if c.WaitingRoom.Rate < 1 {
+			v.fail(&c.WaitingRoom.Rate, ErrOutOfRange, "must be at least 1, got %d", c.WaitingRoom.Rate)
+		}
+
+		v.positive(&c.WaitingRoom.TicketTTL)
+		v.positive(&c.WaitingRoom.PassTTL)
+	}
+
+	if c.Tracking.RateLimit < 1 {
+		v.fail(&c.Tracking.RateLimit, ErrOutOfRange, "must be at least 1, got %d", c.Tracking.RateLimit)
+	}
+
+	v.positive(&c.Tracking.RateWindow)
+	v.positive(&c.Questions.RateWindow)
+}
+
+func (c *Config) validateCommerce(v *validator) {
+	if !isCurrency(c.Store.DefaultCurrency) {
+		v.fail(&c.Store.DefaultCurrency, ErrInvalidFormat, "must be an ISO 4217 code, got %q", c.Store.DefaultCurrency)
+	}
+
+	if _, err := time.LoadLocation(c.Store.DefaultTimezone); err != nil {
+		v.fail(&c.Store.DefaultTimezone, ErrInvalidFormat, "must be an IANA timezone, got %q", c.Store.DefaultTimezone)
+	}
+
+	if !isCurrency(c.Vendors.PayoutCurrency) {
+		v.fail(&c.Vendors.PayoutCurrency, ErrInvalidFormat, "must be an ISO 4217 code, got %q", c.Vendors.PayoutCurrency)
+	}
+
+	v.ratio(&c.Vendors.DefaultCommissionRate)
+
+	for _, email := range c.Admin.NotificationEmails {
+		if _, err := mail.ParseAddress(email); email != "" && err != nil {
+			v.fail(&c.Admin.NotificationEmails, ErrInvalidFormat, "must hold email addresses, got %q", email)
+		}
+	}
+
+	v.positive(&c.Orders.PaymentWindow)
+
+	if c.Orders.BulkStatusMaxOrders < 1 || c.Orders.BulkStatusBatchSize < 1 {
+		v.fail(&c.Orders.BulkStatusBatchSize, ErrOutOfRange, "must be at least 1, as the bulk status max orders")
+	}
+
+	if c.Orders.PickWaveMaxOrders < 1 {
+		v.fail(&c.Orders.PickWaveMaxOrders, ErrOutOfRange, "must be at least 1, got %d", c.Orders.PickWaveMaxOrders)
+	} else if c.Orders.PickWaveMaxOrders > c.Orders.BulkStatusMaxOrders {
+		v.fail(&c.Orders.PickWaveMaxOrders, ErrConflict, "must not exceed the bulk status max orders %d, a wave ships in one bulk update", c.Orders.BulkStatusMaxOrders)
+	}
+
+	if c.Cleanup.Interval > 0 && c.Cleanup.MinAge <= c.Orders.PaymentWindow {
+		v.fail(&c.Cleanup.MinAge, ErrConflict, "must exceed the order payment window %s", c.Orders.PaymentWindow)
+	}
+
+	if c.IDs.Version != 4 && c.IDs.Version != 7 {
+		v.fail(&c.IDs.Version, ErrOutOfRange, "must be 4 or 7, got %d", c.IDs.Version)
+	}
+
+	for i := range c.Velocity.Rules {
+		rule := &c.Velocity.Rules[i]
+
+		v.required(&rule.Name)
+		v.oneOf(&rule.Key, string(models.VelocityKeyCustomer), string(models.VelocityKeyIP),
+			string(models.VelocityKeyPaymentFingerprint), string(models.VelocityKeyDeviceFingerprint))
+		v.oneOf(&rule.Action, string(models.VelocityActionBlock), string(models.VelocityActionReview))
+		v.positive(&rule.Window)
+
+		if rule.MaxOrders < 1 {
+			v.fail(&rule.MaxOrders, ErrOutOfRange, "must be at least 1, got %d", rule.MaxOrders)
+		}
+	}
+}
+
+func (c *Config) validateJobs(v *validator) {
+	if c.Webhooks.Workers < 1 {
+		v.fail(&c.Webhooks.Workers, ErrOutOfRange, "must be at least 1, got %d", c.Webhooks.Workers)
+	} else if c.Webhooks.QueueSize < c.Webhooks.Workers {
+		v.fail(&c.Webhooks.QueueSize, ErrConflict, "must be at least the %d workers", c.Webhooks.Workers)
+	}
+
+	if c.Notification.MaxAttempts < 1 {
+		v.fail(&c.Notification.MaxAttempts, ErrOutOfRange, "must be at least 1, got %d", c.Notification.MaxAttempts)
+	}
+
+	if c.Notification.BaseDelay > c.Notification.MaxDelay {
+		v.fail(&c.Notification.BaseDelay, ErrConflict, "must not exceed the max delay %s", c.Notification.MaxDelay)
+	}
+
+	if c.Broadcast.RatePerSecond < 1 {
+		v.fail(&c.Broadcast.RatePerSecond, ErrOutOfRange, "must be at least 1, got %d", c.Broadcast.RatePerSecond)
+	}
+
+	// batch and queue sizes of the background jobs
+	for _, size := range []*int{
+		&c.Orders.LapseBatchSize, &c.Cleanup.BatchSize, &c.Retention.BatchSize, &c.Notification.BatchSize, &c.Broadcast.BatchSize,
+		&c.Broadcast.QueueSize, &c.Catalog.BatchSize, &c.Catalog.QueueSize, &c.Snapshot.MaxDelta,
+	} {
+		if *size < 1 {
+			v.fail(size, ErrOutOfRange, "must be at least 1, got %d", *size)
+		}
+	}
+}
+
+func isCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+
+	for _, r := range code {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validator collects the problems of a config. Checks take a pointer to the option, so that the
+// problem is reported under the name the option has in the file and in the environment.
+type validator struct {
+	names map[any]string
+	errs  []*FieldError
+}
+
+func newValidator(c *Config) *validator {
+	v := &validator{names: make(map[any]string)}
+	v.walk(reflect.ValueOf(c).Elem(), "")
+
+	return v
+}
+
+// walk names every option below value and reports the negative durations, none of which makes sense.
+func (v *validator) walk(value reflect.Value, path string) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "" {
+			name = field.Name
+		}
+
+		if path != "" {
+			name = path + "." + name
+		}
+
+		display := name
+		if env := field.Tag.Get("env"); env != "" {
+			display += " (" + env + ")"
+		}
+
+		v.names[fieldValue.Addr().Interface()] = display
+
+		switch {
+		case fieldValue.Kind() == reflect.Struct:
+			v.walk(fieldValue, name)
+		case fieldValue.Kind() == reflect.Slice && fieldValue.Type().Elem().Kind() == reflect.Struct:
+			for j := range fieldValue.Len() {
+				v.walk(fieldValue.Index(j), fmt.Sprintf("%s[%d]", name, j))
+			}
+		case fieldValue.Type() == reflect.TypeFor[time.Duration]():
+			if d := time.Duration(fieldValue.Int()); d < 0 {
+				v.errs = append(v.errs, &FieldError{Field: display, Kind: ErrOutOfRange, Detail: "must not be negative, got " + d.String()})
+			}
+		case fieldValue.Type() == reflect.TypeFor[map[string]time.Duration]():
+			for _, key := range fieldValue.MapKeys() {
+				if d := time.Duration(fieldValue.MapIndex(key).Int()); d < 0 {
+					v.errs = append(v.errs, &FieldError{Field: display, Kind: ErrOutOfRange, Detail: fmt.Sprintf("must not be negative, got %s for %s", d, key)})
+				}
+			}
+		}
+	}
+}
+
+func (v *validator) fail(option any, kind error, format string, args ...any) {
+	v.errs = append(v.errs, &FieldError{Field: v.names[option], Kind: kind, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(option *string) {
+	if strings.TrimSpace(*option) == "" {
+		v.fail(option, ErrRequired, "is required")
+	}
+}
+
+func (v *validator) oneOf(option *string, allowed ...string) {
+	for _, value := range allowed {
+		if *option == value {
+			return
+		}
+	}
+
+	quoted := make([]string, len(allowed))
+	for i, value := range allowed {
+		quoted[i] = strconv.Quote(value)
+	}
+
+	v.fail(option, ErrInvalidFormat, "must be one of %s, got %q", strings.Join(quoted, ", "), *option)
+}
+
+// url checks an optional URL, one that is set has to be an absolute http or https one.
+func (v *validator) url(option *string) {
+	if *option == "" {
+		return
+	}
+
+	parsed, err := url.Parse(*option)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		v.fail(option, ErrInvalidFormat, "must be an absolute http or https URL, got %q", *option)
+	}
+}
+
+func (v *validator) email(option *string) {
+	if _, err := mail.ParseAddress(*option); err != nil {
+		v.fail(option, ErrInvalidFormat, "must be an email address, got %q", *option)
+	}
+}
+
+func (v *validator) port(option *string) {
+	if port, err := strconv.Atoi(*option); err != nil || port < 1 || port > 65535 {
+		v.fail(option, ErrInvalidFormat, "must be a port between 1 and 65535, got %q", *option)
+	}
+}
+
+func (v *validator) ratio(option *float64) {
+	if *option < 0 || *option > 1 {
+		v.fail(option, ErrOutOfRange, "must be between 0 and 1, got %g", *option)
+	}
+}
+
+func (v *validator) positive(option *time.Duration) {
+	if *option == 0 {
+		v.fail(option, ErrOutOfRange, "must be positive")
+	}
+}
+
+// together checks options that only work as a set, like a store URL and its credentials.
+func (v *validator) together(options ...*string) {
+	set := 0
+
+	for _, option := range options {
+		if *option != "" {
+			set++
+		}
+	}
+
+	if set == 0 || set == len(options) {
+		return
+	}
+
+	for _, option := range options {
+		if *option == "" {
+			v.fail(option, ErrConflict, "is required with the other options of the same source")
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validConfigYAML = `
+env: "test"
+http_server: {ADDRESS: ":8080"}
+database: {PG_HOST: db, PG_USER: u, PG_PASSWORD: p, PG_DBNAME: d}
+redis: {REDIS_HOST: redis, REDIS_USER: u, REDIS_PASSWORD: p}
+security: {JWT_KEY: k}
+`
+
+func loadValidConfig(t *testing.T) *Config {
+	t.Helper()
+
+	configPath, _ := createTempConfigFile(t, validConfigYAML)
+
+	cfg, err := LoadConfigFromPath(configPath)
+	require.NoError(t, err)
+
+	return cfg
+}
+
+func fieldErrors(t *testing.T, err error) map[string]*FieldError {
+	t.Helper()
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	byField := make(map[string]*FieldError)
+	for _, fieldErr := range validationErr.Errors {
+		byField[fieldErr.Field] = fieldErr
+	}
+
+	return byField
+}
+
+func TestValidate_Defaults(t *testing.T) {
+	// Arrange
+	cfg := loadValidConfig(t)
+
+	// Act
+	err := cfg.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidate_AggregatesProblems(t *testing.T) {
+	// Arrange
+	cfg := loadValidConfig(t)
+	cfg.RedisConnect.Host = ""
+	cfg.Tracking.URL = "tracking.example.com/orders"
+	cfg.Orders.PaymentWindow = -time.Hour
+	cfg.OpsServer.BasicAuthUsername = "ops"
+	cfg.Velocity.Rules = []VelocityRule{{Name: "bots", Key: "cookie", MaxOrders: 3, Window: time.Minute, Action: "block"}}
+
+	// Act
+	err := cfg.Validate()
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRequired)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	byField := fieldErrors(t, err)
+	require.Len(t, byField, 5)
+	assert.True(t, errors.Is(byField["redis.REDIS_HOST (REDIS_HOST)"], ErrRequired))
+	assert.True(t, errors.Is(byField["tracking.url (TRACKING_URL)"], ErrInvalidFormat))
+	assert.Equal(t, "orders.payment_window (ORDER_PAYMENT_WINDOW) must not be negative, got -1h0m0s", byField["orders.payment_window (ORDER_PAYMENT_WINDOW)"].Error())
+	assert.True(t, errors.Is(byField["ops_server.basic_auth_password (OPS_BASIC_AUTH_PASSWORD)"], ErrConflict))
+	assert.Contains(t, byField["order_velocity.rules[0].key"].Detail, `got "cookie"`)
+	assert.Contains(t, err.Error(), "invalid config, 5 problem(s):")
+}
+
+func TestValidate_MutuallyDependentOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(cfg *Config)
+		wantField string
+	}{
+		{
+			name: "Chaos in production",
+			configure: func(cfg *Config) {
+				cfg.Env = "production"
+				cfg.Stripe.APIKey = "sk"
+				cfg.Stripe.WebhookSecret = "wh"
+				cfg.SendGrid.APIKey = "sg"
+				cfg.Chaos.Enabled = true
+			},
+			wantField: "chaos.enabled (CHAOS_ENABLED)",
+		},
+		{
+			name:      "Shopify URL without a token",
+			configure: func(cfg *Config) { cfg.Catalog.ShopifyStoreURL = "https://acme.myshopify.com" },
+			wantField: "catalog_import.shopify_access_token (CATALOG_IMPORT_SHOPIFY_ACCESS_TOKEN)",
+		},
+		{
+			name:      "Payment cleanup within the payment window",
+			configure: func(cfg *Config) { cfg.Cleanup.MinAge = 12 * time.Hour },
+			wantField: "payment_cleanup.min_age (PAYMENT_CLEANUP_MIN_AGE)",
+		},
+		{
+			name:      "Same address for the API and ops servers",
+			configure: func(cfg *Config) { cfg.OpsServer.Addr = ":8080" },
+			wantField: "ops_server.address (OPS_ADDRESS)",
+		},
+		{
+			name:      "Pick waves larger than a bulk status update",
+			configure: func(cfg *Config) { cfg.Orders.PickWaveMaxOrders = cfg.Orders.BulkStatusMaxOrders + 1 },
+			wantField: "orders.pick_wave_max_orders (ORDER_PICK_WAVE_MAX_ORDERS)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			cfg := loadValidConfig(t)
+			tt.configure(cfg)
+
+			// Act
+			err := cfg.Validate()
+
+			// Assert
+			byField := fieldErrors(t, err)
+			require.Len(t, byField, 1)
+			assert.ErrorIs(t, byField[tt.wantField], ErrConflict)
+		})
+	}
+}
+
+func TestLoad_Validates(t *testing.T) {
+	// Arrange
+	configPath, _ := createTempConfigFile(t, validConfigYAML+"ids: {version: 5}\n")
+
+	// Act
+	cfg, err := Load(configPath)
+
+	// Assert
+	assert.Nil(t, cfg)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.Contains(t, err.Error(), "ids.version (ID_VERSION) must be 4 or 7, got 5")
+}
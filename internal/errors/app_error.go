@@ -10,6 +10,7 @@ type AppError struct {
 	Code       string
 	Message    string
 	Detail     string
+	Meta       any // structured details of the failure, sent to the client as is
 	StatusCode int
 	Err        error
 }
@@ -36,6 +37,12 @@ func (e *AppError) WithDetail(detail string) *AppError {
 	return e
 }
 
+func (e *AppError) WithMeta(meta any) *AppError {
+	e.Meta = meta
+
+	return e
+}
+
 func (e *AppError) WithError(err error) *AppError {
 	e.Err = err
 
@@ -43,17 +50,29 @@ func (e *AppError) WithError(err error) *AppError {
 }
 
 const (
-	ErrCodeValidation        = "VALIDATION_ERROR"
-	ErrCodeBadRequest        = "BAD_REQUEST"
-	ErrCodeNotFound          = "NOT_FOUND"
-	ErrCodeUnauthorized      = "UNAUTHORIZED"
-	ErrCodeForbidden         = "FORBIDDEN"
-	ErrCodeInternal          = "INTERNAL_ERROR"
-	ErrCodeDatabaseError     = "DATABASE_ERROR"
-	ErrCodeDuplicateEntry    = "DUPLICATE_ENTRY"
-	ErrCodeThirdPartyError   = "THIRD_PARTY_ERROR"
-	ErrCodeTooManyRequests   = "TOO_MANY_REQUESTS"
-	ErrCodeResourceExhausted = "RESOURCE_EXHAUSTED"
+	ErrCodeValidation         = "VALIDATION_ERROR"
+	ErrCodeBadRequest         = "BAD_REQUEST"
+	ErrCodeNotFound           = "NOT_FOUND"
+	ErrCodeUnauthorized       = "UNAUTHORIZED"
+	ErrCodeForbidden          = "FORBIDDEN"
+	ErrCodeInternal           = "INTERNAL_ERROR"
+	ErrCodeDatabaseError      = "DATABASE_ERROR"
+	ErrCodeDuplicateEntry     = "DUPLICATE_ENTRY"
+	ErrCodeThirdPartyError    = "THIRD_PARTY_ERROR"
+	ErrCodeTooManyRequests    = "TOO_MANY_REQUESTS"
+	ErrCodeResourceExhausted  = "RESOURCE_EXHAUSTED"
+	ErrCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	ErrCodeInvalidTransition  = "INVALID_STATUS_TRANSITION"
+)
+
+// Codes of the shipping restrictions, a checkout failing on one of them can only succeed with
+// another address or without the restricted product.
+const (
+	ErrCodeShippingCountry       = "SHIPPING_COUNTRY_NOT_SUPPORTED"
+	ErrCodeShippingRegion        = "SHIPPING_REGION_NOT_SUPPORTED"
+	ErrCodeShippingPOBox         = "SHIPPING_PO_BOX_NOT_SUPPORTED"
+	ErrCodeShippingPostalCode    = "SHIPPING_POSTAL_CODE_EMBARGOED"
+	ErrCodeShippingProductLimits = "SHIPPING_PRODUCT_RESTRICTED"
 )
 
 func ValidationError(message string) *AppError {
@@ -100,6 +119,18 @@ func ResourceExhaustedError(message string) *AppError {
 	return NewAppError(ErrCodeResourceExhausted, message, http.StatusTooManyRequests)
 }
 
+func ServiceUnavailableError(message string) *AppError {
+	return NewAppError(ErrCodeServiceUnavailable, message, http.StatusServiceUnavailable)
+}
+
+func InvalidTransitionError(message string) *AppError {
+	return NewAppError(ErrCodeInvalidTransition, message, http.StatusConflict)
+}
+
+func ShippingRestrictedError(code, message string) *AppError {
+	return NewAppError(code, message, http.StatusUnprocessableEntity)
+}
+
 func IsAppError(err error) (*AppError, bool) {
 	var appError *AppError
 
@@ -0,0 +1,205 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Codes of the failures a client can act on without parsing the message, the details of the
+// failure are sent in the meta field of the error response.
+const (
+	ErrCodeInsufficientStock = "INSUFFICIENT_STOCK"
+	ErrCodePriceChanged      = "PRICE_CHANGED"
+	ErrCodePaymentDeclined   = "PAYMENT_DECLINED"
+	ErrCodeWeakPassword      = "WEAK_PASSWORD"
+	ErrCodeInvalidClient     = "INVALID_CLIENT"
+	ErrCodeInvalidScope      = "INVALID_SCOPE"
+	ErrCodeProductNotForSale = "PRODUCT_NOT_FOR_SALE"
+	ErrCodePurchaseLimit     = "PURCHASE_LIMIT_EXCEEDED"
+	ErrCodeWaitingRoom       = "IN_WAITING_ROOM"
+	ErrCodeOrderVelocity     = "ORDER_VELOCITY_EXCEEDED"
+	ErrCodeFraudReview       = "FRAUD_REVIEW_PENDING"
+	ErrCodeDuplicateOrder    = "DUPLICATE_ORDER"
+)
+
+// ErrInsufficientStock is the cause of a request asking for more units of a product than are left.
+type ErrInsufficientStock struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Available int       `json:"available"`
+}
+
+func (e *ErrInsufficientStock) Error() string {
+	return fmt.Sprintf("insufficient stock for product %s: %d available", e.ProductID, e.Available)
+}
+
+// ErrPriceChanged is the cause of a checkout made at a price the product is no longer sold at.
+type ErrPriceChanged struct {
+	ProductID    uuid.UUID `json:"product_id"`
+	QuotedPrice  float64   `json:"quoted_price"`
+	CurrentPrice float64   `json:"current_price"`
+}
+
+func (e *ErrPriceChanged) Error() string {
+	return fmt.Sprintf("price of product %s changed from %.2f to %.2f", e.ProductID, e.QuotedPrice, e.CurrentPrice)
+}
+
+// ErrProductNotForSale is the cause of a cart or checkout holding a product that is not active, Status
+// is the current status of the product.
+type ErrProductNotForSale struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Status    string    `json:"status"`
+}
+
+func (e *ErrProductNotForSale) Error() string {
+	return fmt.Sprintf("product %s is not for sale: %s", e.ProductID, e.Status)
+}
+
+// ErrPurchaseLimit is the cause of a cart or checkout asking for more units of a product than one
+// customer may buy. Purchased is what the customer already bought within the period of a per customer
+// limit, and 0 for a per order limit.
+type ErrPurchaseLimit struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Limit     int       `json:"limit"`
+	Purchased int       `json:"purchased"`
+}
+
+func (e *ErrPurchaseLimit) Error() string {
+	return fmt.Sprintf("purchase limit of product %s exceeded: %d allowed, %d already purchased", e.ProductID, e.Limit, e.Purchased)
+}
+
+// ErrWaitingRoom is the cause of a request held in the waiting room of a high-demand drop. The client
+// sends Token back in the X-Waiting-Room-Token header once RetryAfter seconds have passed.
+type ErrWaitingRoom struct {
+	Position   int64  `json:"position"`
+	Token      string `json:"token"`
+	RetryAfter int    `json:"retry_after"`
+}
+
+func (e *ErrWaitingRoom) Error() string {
+	return fmt.Sprintf("in waiting room at position %d", e.Position)
+}
+
+// ErrPaymentDeclined is the cause of a payment refused by the card issuer. Code is the decline code
+// of the processor, like insufficient_funds or expired_card.
+type ErrPaymentDeclined struct {
+	Code string `json:"code"`
+	Err  error  `json:"-"`
+}
+
+func (e *ErrPaymentDeclined) Error() string {
+	return "payment declined: " + e.Code
+}
+
+func (e *ErrPaymentDeclined) Unwrap() error {
+	return e.Err
+}
+
+// ErrDuplicateOrder is the cause of an order looking like one the customer placed moments ago, OrderID
+// is the order already placed.
+type ErrDuplicateOrder struct {
+	OrderID uuid.UUID `json:"order_id"`
+}
+
+func (e *ErrDuplicateOrder) Error() string {
+	return fmt.Sprintf("order looks like a duplicate of order %s", e.OrderID)
+}
+
+func InsufficientStockError(productID uuid.UUID, available int) *AppError {
+	cause := &ErrInsufficientStock{ProductID: productID, Available: max(available, 0)}
+
+	return NewAppError(ErrCodeInsufficientStock, "Insufficient stock for product: "+productID.String(), http.StatusConflict).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+func PriceChangedError(productID uuid.UUID, quotedPrice, currentPrice float64) *AppError {
+	cause := &ErrPriceChanged{ProductID: productID, QuotedPrice: quotedPrice, CurrentPrice: currentPrice}
+
+	return NewAppError(ErrCodePriceChanged, "Price changed for product: "+productID.String(), http.StatusConflict).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+func ProductNotForSaleError(productID uuid.UUID, status string) *AppError {
+	cause := &ErrProductNotForSale{ProductID: productID, Status: status}
+
+	return NewAppError(ErrCodeProductNotForSale, "Product is not for sale: "+productID.String(), http.StatusConflict).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+func PurchaseLimitError(productID uuid.UUID, limit, purchased int) *AppError {
+	cause := &ErrPurchaseLimit{ProductID: productID, Limit: limit, Purchased: purchased}
+
+	return NewAppError(ErrCodePurchaseLimit, "Purchase limit exceeded for product: "+productID.String(), http.StatusConflict).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+func DuplicateOrderError(orderID uuid.UUID) *AppError {
+	cause := &ErrDuplicateOrder{OrderID: orderID}
+
+	return NewAppError(ErrCodeDuplicateOrder, "Order looks like a duplicate of order: "+orderID.String(), http.StatusConflict).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+func WaitingRoomError(position int64, token string, retryAfter int) *AppError {
+	cause := &ErrWaitingRoom{Position: position, Token: token, RetryAfter: retryAfter}
+
+	return NewAppError(ErrCodeWaitingRoom, "High demand, you are in the waiting room", http.StatusTooManyRequests).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+func PaymentDeclinedError(code string, err error) *AppError {
+	cause := &ErrPaymentDeclined{Code: code, Err: err}
+
+	return NewAppError(ErrCodePaymentDeclined, "Payment was declined", http.StatusPaymentRequired).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+// ErrWeakPassword is the cause of a password refused by the password policy, Violations lists the
+// rules it breaks, like too_short, missing_digit or breached.
+type ErrWeakPassword struct {
+	Violations []string `json:"violations"`
+}
+
+func (e *ErrWeakPassword) Error() string {
+	return "weak password: " + strings.Join(e.Violations, ", ")
+}
+
+func WeakPasswordError(violations []string, detail string) *AppError {
+	cause := &ErrWeakPassword{Violations: violations}
+
+	return NewAppError(ErrCodeWeakPassword, "Password does not meet the password policy", http.StatusBadRequest).
+		WithDetail(detail).
+		WithError(cause).
+		WithMeta(cause)
+}
+
+// InvalidClientError refuses a machine client that is unknown, revoked or sent a wrong secret, without
+// telling which.
+func InvalidClientError() *AppError {
+	return NewAppError(ErrCodeInvalidClient, "Client authentication failed", http.StatusUnauthorized)
+}
+
+// InvalidScopeError refuses a token request for scopes the client was not granted.
+func InvalidScopeError(detail string) *AppError {
+	return NewAppError(ErrCodeInvalidScope, "Requested scope is not granted to the client", http.StatusBadRequest).WithDetail(detail)
+}
+
+// OrderVelocityError refuses an order tripping a blocking velocity rule, without telling which, so a bot
+// can't learn the limits.
+func OrderVelocityError() *AppError {
+	return NewAppError(ErrCodeOrderVelocity, "Too many orders placed recently, try again later", http.StatusTooManyRequests)
+}
+
+// FraudReviewError refuses to fulfill an order held by a pending fraud review.
+func FraudReviewError() *AppError {
+	return NewAppError(ErrCodeFraudReview, "Order is held for a fraud review", http.StatusConflict)
+}
@@ -3,90 +3,244 @@ package health
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
 	stripeClient "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
 	"github.com/hellofresh/health-go/v5"
 	"github.com/hellofresh/health-go/v5/checks/postgres"
 	healthRedis "github.com/hellofresh/health-go/v5/checks/redis"
 	"github.com/redis/go-redis/v9"
+	"github.com/sendgrid/sendgrid-go"
 	"github.com/stripe/stripe-go/v81"
 	"github.com/stripe/stripe-go/v81/balance"
 )
 
+// Criticality tells what a dependency being down means for the service. Readiness fails while a
+// critical dependency is down; a degraded one leaves the service ready, running without the features
+// that need it.
+type Criticality string
+
+const (
+	Critical Criticality = "critical"
+	Degraded Criticality = "degraded"
+)
+
 type HealthEndpoint struct {
 	DB           *sql.DB
 	RedisClient  *redis.Client
 	StripeClient *stripeClient.Client
 }
 
-func NewReadinessHandler(cfg *config.Config, healthEndpoint *HealthEndpoint) (http.Handler, error) {
-	h, err := health.New(
+// Check is a dependency probed by the health checks.
+type Check struct {
+	Name        string
+	Criticality Criticality
+	Timeout     time.Duration
+	Check       health.CheckFunc
+}
 
-		health.WithComponent(health.Component{
-			Name:    cfg.OTel.ServiceName,
-			Version: "1.0.0",
-		}),
-		health.WithSystemInfo(),
-		health.WithChecks(
-			health.Config{
-				Name:      "database",
-				Timeout:   3 * time.Second,
-				SkipOnErr: false,
-				Check: postgres.New(postgres.Config{
-					DSN: cfg.Database.GetDSN(),
-				}),
-			},
-			health.Config{
-				Name:      "redis",
-				Timeout:   2 * time.Second,
-				SkipOnErr: false,
-				Check: healthRedis.New(
-					healthRedis.Config{
-						DSN: cfg.RedisConnect.GetDSN(),
-					},
-				),
-			},
-			health.Config{
-				Name:      "stripe",
-				Timeout:   5 * time.Second,
-				SkipOnErr: false,
-				Check: func(ctx context.Context) error {
-					if healthEndpoint.StripeClient == nil {
-						return errors.New("stripe client is not initialized")
-					}
+// Checker probes the dependencies of the service for the readiness and verbose health endpoints, and
+// exposes the state of each in the metrics.
+type Checker struct {
+	health *health.Health
+	checks []Check
+}
 
-					reqCtx, cancel := context.WithTimeout(ctx, 4*time.Second)
-					defer cancel()
+// CheckResult is the state of a dependency in the verbose health report.
+type CheckResult struct {
+	Name        string      `json:"name"`
+	Criticality Criticality `json:"criticality"`
+	Up          bool        `json:"up"`
+	Error       string      `json:"error,omitempty"`
+}
 
-					params := &stripe.BalanceParams{
-						Params: stripe.Params{
-							Context: reqCtx,
-						},
-					}
-					_, err := balance.Get(params)
-					if err != nil {
-						if ctxErr := reqCtx.Err(); errors.Is(ctxErr, context.DeadlineExceeded) {
-							return fmt.Errorf("stripe API call timed out: %w", ctxErr)
-						}
+// VerboseReport lists every dependency with its criticality. Its status is Partially Available while
+// only degraded dependencies are down.
+type VerboseReport struct {
+	Status    health.Status    `json:"status"`
+	Timestamp time.Time        `json:"timestamp"`
+	Component health.Component `json:"component"`
+	Checks    []CheckResult    `json:"checks"`
+}
 
-						return fmt.Errorf("failed to connect to stripe: %w", err)
+// NewChecker probes the database and Redis as critical dependencies, Stripe and SendGrid as degraded
+// ones: without them browsing and orders already placed keep working. SendGrid is only probed once an
+// API key is configured.
+func NewChecker(cfg *config.Config, healthEndpoint *HealthEndpoint) (*Checker, error) {
+	checks := []Check{
+		{
+			Name:        "database",
+			Criticality: Critical,
+			Timeout:     3 * time.Second,
+			Check: postgres.New(postgres.Config{
+				DSN: cfg.Database.GetDSN(),
+			}),
+		},
+		{
+			Name:        "redis",
+			Criticality: Critical,
+			Timeout:     2 * time.Second,
+			Check: healthRedis.New(
+				healthRedis.Config{
+					DSN: cfg.RedisConnect.GetDSN(),
+				},
+			),
+		},
+		{
+			Name:        "stripe",
+			Criticality: Degraded,
+			Timeout:     5 * time.Second,
+			Check: func(ctx context.Context) error {
+				if healthEndpoint.StripeClient == nil {
+					return errors.New("stripe client is not initialized")
+				}
+
+				reqCtx, cancel := context.WithTimeout(ctx, 4*time.Second)
+				defer cancel()
+
+				params := &stripe.BalanceParams{
+					Params: stripe.Params{
+						Context: reqCtx,
+					},
+				}
+				_, err := balance.Get(params)
+				if err != nil {
+					if ctxErr := reqCtx.Err(); errors.Is(ctxErr, context.DeadlineExceeded) {
+						return fmt.Errorf("stripe API call timed out: %w", ctxErr)
 					}
 
-					return nil
-				},
+					return fmt.Errorf("failed to connect to stripe: %w", err)
+				}
+
+				return nil
 			},
-		),
+		},
+	}
+
+	if cfg.SendGrid.APIKey != "" {
+		checks = append(checks, Check{
+			Name:        "sendgrid",
+			Criticality: Degraded,
+			Timeout:     5 * time.Second,
+			Check:       sendGridCheck(cfg.SendGrid.APIKey),
+		})
+	}
+
+	return newChecker(health.Component{Name: cfg.OTel.ServiceName, Version: "1.0.0"}, checks)
+}
+
+func newChecker(component health.Component, checks []Check) (*Checker, error) {
+	configs := make([]health.Config, 0, len(checks))
+	for _, check := range checks {
+		configs = append(configs, health.Config{
+			Name:      check.Name,
+			Timeout:   check.Timeout,
+			SkipOnErr: check.Criticality == Degraded,
+			Check:     check.Check,
+		})
+	}
+
+	h, err := health.New(
+		health.WithComponent(component),
+		health.WithSystemInfo(),
+		health.WithChecks(configs...),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create readiness health instance: %w", err)
 	}
 
-	return h.Handler(), nil
+	return &Checker{health: h, checks: checks}, nil
+}
+
+// sendGridCheck reads the scopes of the API key, which fails when SendGrid is down or refuses the key.
+func sendGridCheck(apiKey string) health.CheckFunc {
+	return func(ctx context.Context) error {
+		resp, err := sendgrid.MakeRequestWithContext(ctx, sendgrid.GetRequest(apiKey, "/v3/scopes", ""))
+		if err != nil {
+			return fmt.Errorf("failed to connect to sendgrid: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("sendgrid responded with status %d", resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+// measure runs the checks and records the state of every dependency in the metrics.
+func (c *Checker) measure(ctx context.Context) health.Check {
+	result := c.health.Measure(ctx)
+
+	for _, check := range c.checks {
+		_, failed := result.Failures[check.Name]
+		metrics.SetDependencyUp(check.Name, string(check.Criticality), !failed)
+	}
+
+	metrics.SetHealthDegraded(result.Status == health.StatusPartiallyAvailable)
+
+	return result
+}
+
+// ReadinessHandler reports the service ready unless a critical dependency is down.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := c.measure(r.Context())
+		writeJSON(w, statusCode(result.Status), result)
+	})
+}
+
+// VerboseHandler reports every dependency with its criticality, to tell a degraded service from a
+// healthy one.
+func (c *Checker) VerboseHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := c.measure(r.Context())
+
+		report := VerboseReport{
+			Status:    result.Status,
+			Timestamp: result.Timestamp,
+			Component: result.Component,
+			Checks:    make([]CheckResult, 0, len(c.checks)),
+		}
+		for _, check := range c.checks {
+			failure, failed := result.Failures[check.Name]
+			report.Checks = append(report.Checks, CheckResult{
+				Name:        check.Name,
+				Criticality: check.Criticality,
+				Up:          !failed,
+				Error:       failure,
+			})
+		}
+
+		writeJSON(w, statusCode(result.Status), report)
+	})
+}
+
+func statusCode(status health.Status) int {
+	if status == health.StatusUnavailable {
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusOK
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
 }
 
 func NewLivenessHandler() http.HandlerFunc {
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hellofresh/health-go/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChecker(t *testing.T, dbErr, emailErr error) *Checker {
+	t.Helper()
+
+	checker, err := newChecker(health.Component{Name: "test", Version: "1.0.0"}, []Check{
+		{Name: "database", Criticality: Critical, Timeout: time.Second, Check: func(context.Context) error { return dbErr }},
+		{Name: "sendgrid", Criticality: Degraded, Timeout: time.Second, Check: func(context.Context) error { return emailErr }},
+	})
+	require.NoError(t, err)
+
+	return checker
+}
+
+func TestChecker_ReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		dbErr      error
+		emailErr   error
+		wantCode   int
+		wantStatus health.Status
+	}{
+		{name: "All dependencies up", wantCode: http.StatusOK, wantStatus: health.StatusOK},
+		{name: "Degraded dependency down", emailErr: errors.New("sendgrid down"), wantCode: http.StatusOK, wantStatus: health.StatusPartiallyAvailable},
+		{name: "Critical dependency down", dbErr: errors.New("db down"), wantCode: http.StatusServiceUnavailable, wantStatus: health.StatusUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			checker := newTestChecker(t, tt.dbErr, tt.emailErr)
+			rr := httptest.NewRecorder()
+
+			// Act
+			checker.ReadinessHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			// Assert
+			assert.Equal(t, tt.wantCode, rr.Code)
+
+			var result health.Check
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+			assert.Equal(t, tt.wantStatus, result.Status)
+		})
+	}
+}
+
+func TestChecker_VerboseHandler(t *testing.T) {
+	// Arrange
+	checker := newTestChecker(t, nil, errors.New("sendgrid down"))
+	rr := httptest.NewRecorder()
+
+	// Act
+	checker.VerboseHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/healthz/verbose", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var report VerboseReport
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+	assert.Equal(t, health.StatusPartiallyAvailable, report.Status)
+	assert.Equal(t, []CheckResult{
+		{Name: "database", Criticality: Critical, Up: true},
+		{Name: "sendgrid", Criticality: Degraded, Up: false, Error: "sendgrid down"},
+	}, report.Checks)
+}
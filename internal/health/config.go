@@ -0,0 +1,51 @@
+package health
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+)
+
+// ConfigProblem is an option the config validation refused, Field is empty when the config could
+// not be read at all.
+type ConfigProblem struct {
+	Field   string `json:"field,omitempty"`
+	Problem string `json:"problem"`
+}
+
+// ConfigValidation reports whether the config, as the next start would load it, is valid.
+type ConfigValidation struct {
+	Layers   []string        `json:"layers"`
+	Valid    bool            `json:"valid"`
+	Problems []ConfigProblem `json:"problems"`
+}
+
+// ConfigValidateHandler reads the config files again, with the environment of the process, and
+// validates them, so that a change to a file is checked before a restart trips on it. It answers 422
+// when the config is invalid.
+func ConfigValidateHandler(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		result := ConfigValidation{Layers: cfg.Layers(), Valid: true, Problems: []ConfigProblem{}}
+
+		if _, err := config.Load(cfg.Layers()...); err != nil {
+			result.Valid = false
+
+			var validationErr *config.ValidationError
+			if errors.As(err, &validationErr) {
+				for _, fieldErr := range validationErr.Errors {
+					result.Problems = append(result.Problems, ConfigProblem{Field: fieldErr.Field, Problem: fieldErr.Detail})
+				}
+			} else {
+				result.Problems = append(result.Problems, ConfigProblem{Problem: err.Error()})
+			}
+		}
+
+		code := http.StatusOK
+		if !result.Valid {
+			code = http.StatusUnprocessableEntity
+		}
+
+		writeJSON(w, code, result)
+	})
+}
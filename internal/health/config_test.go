@@ -0,0 +1,67 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigYAML = `
+env: "test"
+http_server: {ADDRESS: ":8080"}
+database: {PG_HOST: db, PG_USER: u, PG_PASSWORD: p, PG_DBNAME: d}
+redis: {REDIS_HOST: redis, REDIS_USER: u, REDIS_PASSWORD: p}
+security: {JWT_KEY: k}
+`
+
+func TestConfigValidateHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		edit         string
+		wantCode     int
+		wantValid    bool
+		wantProblems []ConfigProblem
+	}{
+		{name: "Unchanged config", wantCode: http.StatusOK, wantValid: true, wantProblems: []ConfigProblem{}},
+		{
+			name:         "Invalid option added",
+			edit:         "log: {format: xml}\n",
+			wantCode:     http.StatusUnprocessableEntity,
+			wantProblems: []ConfigProblem{{Field: "log.format (LOG_FORMAT)", Problem: `must be one of "json", "text", got "xml"`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(configPath, []byte(testConfigYAML), 0o600))
+
+			cfg, err := config.Load(configPath)
+			require.NoError(t, err)
+
+			require.NoError(t, os.WriteFile(configPath, []byte(testConfigYAML+tt.edit), 0o600))
+
+			rec := httptest.NewRecorder()
+
+			// Act
+			ConfigValidateHandler(cfg).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/config-validate", nil))
+
+			// Assert
+			require.Equal(t, tt.wantCode, rec.Code)
+
+			var result ConfigValidation
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&result))
+			assert.Equal(t, []string{configPath}, result.Layers)
+			assert.Equal(t, tt.wantValid, result.Valid)
+			assert.Equal(t, tt.wantProblems, result.Problems)
+		})
+	}
+}
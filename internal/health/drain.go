@@ -0,0 +1,169 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// maxDrainTimeout bounds the wait a drain request asks for.
+const maxDrainTimeout = 5 * time.Minute
+
+// Drainer takes the instance out of the load balancer ahead of a rollout orchestrated from outside:
+// while draining, readiness fails and keep-alives are off, yet the requests still coming in, before
+// the load balancer notices, are served as usual. It counts the in-flight requests and the open
+// connections of the server it is attached to.
+type Drainer struct {
+	server      *http.Server
+	draining    atomic.Bool
+	inFlight    atomic.Int64
+	connections atomic.Int64
+	poll        time.Duration
+}
+
+// DrainStatus reports how far the instance drained, it is drained once no request is in flight and
+// every connection closed.
+type DrainStatus struct {
+	Draining         bool  `json:"draining"`
+	Drained          bool  `json:"drained"`
+	InFlightRequests int64 `json:"in_flight_requests"`
+	OpenConnections  int64 `json:"open_connections"`
+}
+
+func NewDrainer() *Drainer {
+	return &Drainer{poll: 100 * time.Millisecond}
+}
+
+// Attach counts the requests and connections of server, it has to be called before server starts.
+func (d *Drainer) Attach(server *http.Server) {
+	d.server = server
+
+	next := server.Handler
+	server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.inFlight.Add(1)
+		defer d.inFlight.Add(-1)
+
+		next.ServeHTTP(w, r)
+	})
+
+	server.ConnState = func(_ net.Conn, state http.ConnState) {
+		switch state {
+		case http.StateNew:
+			d.connections.Add(1)
+		case http.StateHijacked, http.StateClosed:
+			d.connections.Add(-1)
+		}
+	}
+}
+
+func (d *Drainer) Status() DrainStatus {
+	status := DrainStatus{
+		Draining:         d.draining.Load(),
+		InFlightRequests: d.inFlight.Load(),
+		OpenConnections:  d.connections.Load(),
+	}
+	status.Drained = status.Draining && status.InFlightRequests == 0 && status.OpenConnections == 0
+
+	return status
+}
+
+// Drain starts draining and waits until the instance drained or ctx is done. Turning keep-alives off
+// closes the idle connections and the others once their request is served.
+func (d *Drainer) Drain(ctx context.Context) DrainStatus {
+	d.draining.Store(true)
+
+	if d.server != nil {
+		d.server.SetKeepAlivesEnabled(false)
+	}
+
+	ticker := time.NewTicker(d.poll)
+	defer ticker.Stop()
+
+	for {
+		if status := d.Status(); status.Drained {
+			return status
+		}
+
+		select {
+		case <-ctx.Done():
+			return d.Status()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Resume puts the instance back in the load balancer, e.g. when a rollout is called off.
+func (d *Drainer) Resume() {
+	d.draining.Store(false)
+
+	if d.server != nil {
+		d.server.SetKeepAlivesEnabled(true)
+	}
+}
+
+// Readiness fails the readiness probe while draining, and runs next otherwise.
+func (d *Drainer) Readiness(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if d.draining.Load() {
+			writeJSON(w, http.StatusServiceUnavailable, d.Status())
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// DrainHandler starts draining and answers once the instance drained, or after the timeout query
+// parameter, a duration like 45s, or defaultTimeout. The status tells whether it drained in time, the
+// request can be repeated to wait longer.
+func (d *Drainer) DrainHandler(defaultTimeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timeout := defaultTimeout
+
+		if value := r.URL.Query().Get("timeout"); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil || parsed < 0 {
+				http.Error(w, "invalid timeout", http.StatusBadRequest)
+
+				return
+			}
+
+			timeout = parsed
+		}
+
+		timeout = min(timeout, maxDrainTimeout)
+
+		// the wait may outlast the write timeout of the server
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout + 5*time.Second))
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		slog.Warn("Draining the instance", slog.Duration("timeout", timeout))
+
+		status := d.Drain(ctx)
+
+		slog.Info("Drain request done", slog.Bool("drained", status.Drained),
+			slog.Int64("inFlightRequests", status.InFlightRequests), slog.Int64("openConnections", status.OpenConnections))
+		writeJSON(w, http.StatusOK, status)
+	})
+}
+
+func (d *Drainer) StatusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, d.Status())
+	})
+}
+
+func (d *Drainer) ResumeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		d.Resume()
+
+		slog.Warn("Instance back in the load balancer")
+		writeJSON(w, http.StatusOK, d.Status())
+	})
+}
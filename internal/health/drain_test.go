@@ -0,0 +1,142 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDrainer(handler http.Handler) (*Drainer, *httptest.Server) {
+	drainer := NewDrainer()
+	drainer.poll = 5 * time.Millisecond
+
+	server := httptest.NewUnstartedServer(handler)
+	drainer.Attach(server.Config)
+	server.Start()
+
+	return drainer, server
+}
+
+func decodeDrainStatus(t *testing.T, rec *httptest.ResponseRecorder) DrainStatus {
+	t.Helper()
+
+	var status DrainStatus
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+
+	return status
+}
+
+func TestDrainer_Readiness(t *testing.T) {
+	// Arrange
+	drainer := NewDrainer()
+	handler := drainer.Readiness(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// Act
+	ready := httptest.NewRecorder()
+	handler.ServeHTTP(ready, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	drainer.draining.Store(true)
+
+	draining := httptest.NewRecorder()
+	handler.ServeHTTP(draining, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	drainer.Resume()
+
+	resumed := httptest.NewRecorder()
+	handler.ServeHTTP(resumed, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, ready.Code)
+	assert.Equal(t, http.StatusServiceUnavailable, draining.Code)
+	assert.True(t, decodeDrainStatus(t, draining).Draining)
+	assert.Equal(t, http.StatusOK, resumed.Code)
+}
+
+func TestDrainer_DrainHandler_Drained(t *testing.T) {
+	// Arrange
+	drainer, server := newTestDrainer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	rec := httptest.NewRecorder()
+
+	// Act
+	drainer.DrainHandler(time.Second).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/drain", nil))
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	status := decodeDrainStatus(t, rec)
+	assert.True(t, status.Draining)
+	assert.True(t, status.Drained)
+	assert.Zero(t, status.InFlightRequests)
+	assert.Zero(t, status.OpenConnections)
+}
+
+func TestDrainer_DrainHandler_Timeout(t *testing.T) {
+	// Arrange
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	drainer, server := newTestDrainer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		resp, err := server.Client().Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+
+	rec := httptest.NewRecorder()
+
+	// Act
+	drainer.DrainHandler(time.Minute).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/drain?timeout=20ms", nil))
+
+	close(release)
+	<-done
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	status := decodeDrainStatus(t, rec)
+	assert.True(t, status.Draining)
+	assert.False(t, status.Drained)
+	assert.Equal(t, int64(1), status.InFlightRequests)
+	assert.Equal(t, int64(1), status.OpenConnections)
+}
+
+func TestDrainer_DrainHandler_InvalidTimeout(t *testing.T) {
+	// Arrange
+	drainer := NewDrainer()
+	rec := httptest.NewRecorder()
+
+	// Act
+	drainer.DrainHandler(time.Second).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/drain?timeout=soon", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.False(t, drainer.Status().Draining)
+}
@@ -0,0 +1,156 @@
+// Package locale carries the language and the timezone a request is served in, for the dates written
+// to people: in emails, on documents and as the days of reports.
+package locale
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+const DefaultTag = "en-US"
+
+// Locale is a language, as a BCP 47 tag, and a timezone.
+type Locale struct {
+	Tag      string
+	Location *time.Location
+}
+
+// Default is served when neither the request nor the store settings have a locale.
+var Default = Locale{Tag: DefaultTag, Location: time.UTC}
+
+// New falls back to the defaults for an empty tag or an unknown timezone.
+func New(tag, timezone string) Locale {
+	l := Default
+
+	if tag != "" {
+		l.Tag = tag
+	}
+
+	if timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			l.Location = location
+		}
+	}
+
+	return l
+}
+
+// anyLanguage is what the * wildcard parses to.
+var anyLanguage = language.Make("mul")
+
+// ParseAcceptLanguage returns the preferred language of an Accept-Language header, empty when it names none.
+func ParseAcceptLanguage(header string) string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return ""
+	}
+
+	for _, tag := range tags {
+		if tag != language.Und && tag != anyLanguage {
+			return tag.String()
+		}
+	}
+
+	return ""
+}
+
+type contextKey struct{}
+
+type requestLocale struct {
+	requested Locale
+	fallback  func() Locale
+}
+
+// WithRequested stores the locale a request asked for, either part may be missing. The fallback fills
+// in the missing parts, it is only called when one is missing and at most once per request.
+func WithRequested(ctx context.Context, requested Locale, fallback func() Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, &requestLocale{requested: requested, fallback: sync.OnceValue(fallback)})
+}
+
+// FromContext returns the locale of the request, Default outside of one.
+func FromContext(ctx context.Context) Locale {
+	req, ok := ctx.Value(contextKey{}).(*requestLocale)
+	if !ok {
+		return Default
+	}
+
+	l := req.requested
+	if l.Tag != "" && l.Location != nil {
+		return l
+	}
+
+	fallback := req.fallback()
+
+	if l.Tag == "" {
+		l.Tag = fallback.Tag
+	}
+
+	if l.Location == nil {
+		l.Location = fallback.Location
+	}
+
+	return l
+}
+
+// StartOfDay is the midnight starting the day of t in the timezone of the locale, reports count days by it.
+func (l Locale) StartOfDay(t time.Time) time.Time {
+	t = t.In(l.Location)
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, l.Location)
+}
+
+// FormatDate writes the date of t in the timezone of the locale, the way its language writes dates.
+func (l Locale) FormatDate(t time.Time) string {
+	return t.In(l.Location).Format(l.layouts().date)
+}
+
+// FormatDateTime writes the date and time of t like FormatDate, with the timezone.
+func (l Locale) FormatDateTime(t time.Time) string {
+	return t.In(l.Location).Format(l.layouts().dateTime)
+}
+
+type layouts struct {
+	date     string
+	dateTime string
+}
+
+// Layouts spelling out months are kept to English, the languages without one write the date in numbers.
+var (
+	usLayouts      = layouts{date: "January 2, 2006", dateTime: "January 2, 2006 3:04 PM MST"}
+	englishLayouts = layouts{date: "2 January 2006", dateTime: "2 January 2006 15:04 MST"}
+	dottedLayouts  = layouts{date: "02.01.2006", dateTime: "02.01.2006 15:04 MST"}
+	slashedLayouts = layouts{date: "02/01/2006", dateTime: "02/01/2006 15:04 MST"}
+	eastAsiaLayout = layouts{date: "2006/01/02", dateTime: "2006/01/02 15:04 MST"}
+	isoLayouts     = layouts{date: "2006-01-02", dateTime: "2006-01-02 15:04 MST"}
+)
+
+var languageLayouts = map[string]layouts{
+	"en": englishLayouts,
+	"de": dottedLayouts, "ru": dottedLayouts, "pl": dottedLayouts, "cs": dottedLayouts, "tr": dottedLayouts, "fi": dottedLayouts, "nb": dottedLayouts, "da": dottedLayouts,
+	"fr": slashedLayouts, "es": slashedLayouts, "it": slashedLayouts, "pt": slashedLayouts, "nl": slashedLayouts, "el": slashedLayouts,
+	"ja": eastAsiaLayout, "zh": eastAsiaLayout,
+}
+
+func (l Locale) layouts() layouts {
+	tag, err := language.Parse(l.Tag)
+	if err != nil {
+		return isoLayouts
+	}
+
+	base, _ := tag.Base()
+	region, _ := tag.Region()
+
+	// a bare "en" is taken as American English
+	if base.String() == "en" && region.String() == "US" {
+		return usLayouts
+	}
+
+	if found, ok := languageLayouts[base.String()]; ok {
+		return found
+	}
+
+	return isoLayouts
+}
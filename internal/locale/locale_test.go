@@ -0,0 +1,55 @@
+package locale_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	instant := time.Date(2025, time.March, 4, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		locale           locale.Locale
+		expectedDate     string
+		expectedDateTime string
+	}{
+		{name: "American English", locale: locale.New("en-US", ""), expectedDate: "March 4, 2025", expectedDateTime: "March 4, 2025 11:30 PM UTC"},
+		{name: "British English", locale: locale.New("en-GB", "Europe/London"), expectedDate: "4 March 2025", expectedDateTime: "4 March 2025 23:30 GMT"},
+		{name: "German in Berlin crosses midnight", locale: locale.New("de-DE", "Europe/Berlin"), expectedDate: "05.03.2025", expectedDateTime: "05.03.2025 00:30 CET"},
+		{name: "Unknown language", locale: locale.New("sw", "Asia/Tokyo"), expectedDate: "2025-03-05", expectedDateTime: "2025-03-05 08:30 JST"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act & Assert
+			assert.Equal(t, tc.expectedDate, tc.locale.FormatDate(instant))
+			assert.Equal(t, tc.expectedDateTime, tc.locale.FormatDateTime(instant))
+		})
+	}
+}
+
+func TestStartOfDay(t *testing.T) {
+	// Arrange
+	loc := locale.New("en-US", "America/Los_Angeles")
+
+	// Act
+	start := loc.StartOfDay(time.Date(2025, time.March, 5, 3, 0, 0, 0, time.UTC))
+
+	// Assert
+	assert.Equal(t, time.Date(2025, time.March, 4, 8, 0, 0, 0, time.UTC), start.UTC())
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, "pt-BR", locale.ParseAcceptLanguage("pt-BR,pt;q=0.8"))
+	assert.Equal(t, "de", locale.ParseAcceptLanguage("fr;q=0.5, de"))
+	assert.Empty(t, locale.ParseAcceptLanguage("*"))
+	assert.Empty(t, locale.ParseAcceptLanguage(""))
+}
+
+func TestFromContext_OutsideRequest(t *testing.T) {
+	assert.Equal(t, locale.Default, locale.FromContext(t.Context()))
+}
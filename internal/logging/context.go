@@ -0,0 +1,45 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	userIDKey
+)
+
+// WithRequestID records the correlation ID of the request in ctx.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// WithUserID records the authenticated user of the request in ctx.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// With returns the default logger with the correlation ID, the trace and the user of the request of
+// ctx attached, those of them ctx knows about.
+func With(ctx context.Context) *slog.Logger {
+	var attrs []any
+
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok {
+		attrs = append(attrs, slog.String("correlation_id", requestID))
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		attrs = append(attrs, slog.String("trace_id", sc.TraceID().String()))
+	}
+
+	if userID, ok := ctx.Value(userIDKey).(string); ok {
+		attrs = append(attrs, slog.String("userId", userID))
+	}
+
+	return slog.Default().With(attrs...)
+}
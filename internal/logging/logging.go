@@ -0,0 +1,74 @@
+// Package logging sets up the slog loggers of the server: their level and format come from the
+// configuration, the level can be changed while the server runs, and a logger built from a request
+// context carries the IDs of the request.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// ParseLevel reads a level name, debug, info, warn or error.
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+		return 0, fmt.Errorf("unknown log level %q", name)
+	}
+
+	return level, nil
+}
+
+// New returns a logger writing to w in the given format, dropping the records below level.
+func New(w io.Writer, format string, level slog.Leveler) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: level}
+
+	switch format {
+	case FormatJSON:
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case FormatText:
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+}
+
+// Level is the level of the server loggers, set from the configuration and changed while running
+// through the admin API for live debugging. A change only holds on the instance that made it, until
+// it is reset or the instance restarts. It is safe for concurrent use.
+type Level struct {
+	current    slog.LevelVar
+	configured slog.Level
+}
+
+func NewLevel(configured slog.Level) *Level {
+	l := &Level{configured: configured}
+	l.current.Set(configured)
+
+	return l
+}
+
+// Level implements slog.Leveler.
+func (l *Level) Level() slog.Level {
+	return l.current.Level()
+}
+
+func (l *Level) Set(level slog.Level) {
+	l.current.Set(level)
+}
+
+// Reset goes back to the configured level.
+func (l *Level) Reset() {
+	l.current.Set(l.configured)
+}
+
+func (l *Level) Configured() slog.Level {
+	return l.configured
+}
@@ -0,0 +1,125 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestParseLevel(t *testing.T) {
+	level, err := logging.ParseLevel("warn")
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelWarn, level)
+
+	level, err = logging.ParseLevel(" DEBUG ")
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelDebug, level)
+
+	_, err = logging.ParseLevel("verbose")
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		// Arrange
+		var out bytes.Buffer
+
+		logger, err := logging.New(&out, logging.FormatJSON, slog.LevelInfo)
+		require.NoError(t, err)
+
+		// Act
+		logger.Debug("dropped")
+		logger.Info("kept", slog.String("key", "value"))
+
+		// Assert
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+		assert.Equal(t, "kept", record["msg"])
+		assert.Equal(t, "value", record["key"])
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		// Arrange
+		var out bytes.Buffer
+
+		logger, err := logging.New(&out, logging.FormatText, slog.LevelInfo)
+		require.NoError(t, err)
+
+		// Act
+		logger.Info("kept")
+
+		// Assert
+		assert.Contains(t, out.String(), "msg=kept")
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		_, err := logging.New(&bytes.Buffer{}, "xml", slog.LevelInfo)
+		assert.Error(t, err)
+	})
+}
+
+func TestLevel(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+
+	level := logging.NewLevel(slog.LevelInfo)
+	logger, err := logging.New(&out, logging.FormatText, level)
+	require.NoError(t, err)
+
+	// Act
+	level.Set(slog.LevelDebug)
+	logger.Debug("while debugging")
+	level.Reset()
+	logger.Debug("after the reset")
+
+	// Assert
+	assert.Contains(t, out.String(), "while debugging")
+	assert.NotContains(t, out.String(), "after the reset")
+	assert.Equal(t, slog.LevelInfo, level.Level())
+	assert.Equal(t, slog.LevelInfo, level.Configured())
+}
+
+func TestWith(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+
+	logger, err := logging.New(&out, logging.FormatJSON, slog.LevelInfo)
+	require.NoError(t, err)
+
+	previous := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	traceID := trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}})
+
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	ctx = logging.WithRequestID(ctx, "req-1")
+	ctx = logging.WithUserID(ctx, "user-1")
+
+	// Act
+	logging.With(ctx).Info("handled")
+	logging.With(context.Background()).Info("outside a request")
+
+	// Assert
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &record))
+	assert.Equal(t, "req-1", record["correlation_id"])
+	assert.Equal(t, traceID.String(), record["trace_id"])
+	assert.Equal(t, "user-1", record["userId"])
+
+	record = nil
+	require.NoError(t, json.Unmarshal(lines[1], &record))
+	assert.NotContains(t, record, "correlation_id")
+	assert.NotContains(t, record, "trace_id")
+}
@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var authTokenRejectionsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "auth_token_rejections_total",
+		Help: "Requests refused by the authentication middleware, by reason, e.g. expired, invalid_issuer or invalid_signature.",
+	},
+	[]string{"reason"},
+)
+
+// RecordTokenRejected accounts a refused request, a burst of one reason, like invalid_signature, is
+// worth an alert.
+func RecordTokenRejected(reason string) {
+	authTokenRejectionsTotal.WithLabelValues(reason).Inc()
+}
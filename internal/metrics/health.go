@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	healthDependencyUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "health_dependency_up",
+			Help: "Whether a dependency passed its last health check, by dependency and criticality.",
+		},
+		[]string{"dependency", "criticality"},
+	)
+
+	healthDegraded = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "health_degraded",
+			Help: "Whether the service is ready without some of its degraded dependencies.",
+		},
+	)
+)
+
+func SetDependencyUp(dependency, criticality string, up bool) {
+	healthDependencyUp.WithLabelValues(dependency, criticality).Set(boolValue(up))
+}
+
+func SetHealthDegraded(degraded bool) {
+	healthDegraded.Set(boolValue(degraded))
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	invariantViolations = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "invariant_violations",
+			Help: "Number of violations found by the last invariants check.",
+		},
+		[]string{"check"},
+	)
+
+	invariantLastRun = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "invariant_check_last_run_timestamp_seconds",
+			Help: "Unix time at which the last invariants check finished.",
+		},
+	)
+)
+
+// RecordInvariantReport exposes the outcome of an invariants check.
+func RecordInvariantReport(report *models.InvariantReport) {
+	for check, count := range report.Counts {
+		invariantViolations.WithLabelValues(string(check)).Set(float64(count))
+	}
+
+	invariantLastRun.Set(float64(report.FinishedAt.Unix()))
+}
@@ -4,12 +4,15 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -26,7 +29,7 @@ var (
 			Help:    "Duration of HTTP requests in seconds.",
 			Buckets: prometheus.DefBuckets,
 		},
-		[]string{"method", "path"},
+		[]string{"method", "route", "status_class"},
 	)
 
 	httpRequestsInFlight = promauto.NewGauge(
@@ -64,35 +67,70 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
-func Middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
+// Middleware records request metrics labelled by the templated route of mux (e.g. /api/v1/orders/{id}),
+// which keeps the label cardinality bounded regardless of the IDs in the path.
+func Middleware(mux *http.ServeMux) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
 
-		httpRequestsInFlight.Inc()
+			httpRequestsInFlight.Inc()
 
-		rw := newResponseWriter(w)
+			rw := newResponseWriter(w)
 
-		pathPattern := r.URL.Path
-		if p := r.PathValue("..."); p != "" {
-			pathPattern = r.URL.Path[:len(r.URL.Path)-len(p)] + "{...}"
-		} else if id := r.PathValue("id"); id != "" {
-			pathPattern = r.URL.Path[:len(r.URL.Path)-len(id)] + "{id}"
-		}
+			route := routePattern(mux, r)
 
-		defer func() {
-			duration := time.Since(start)
-			statusCodeStr := strconv.Itoa(rw.statusCode)
+			defer func() {
+				duration := time.Since(start)
+				statusCodeStr := strconv.Itoa(rw.statusCode)
 
-			httpRequestsTotal.WithLabelValues(statusCodeStr, r.Method, pathPattern).Inc()
-			httpRequestsDuration.WithLabelValues(r.Method, pathPattern).Observe(duration.Seconds())
-			httpRequestsInFlight.Dec()
-		}()
+				httpRequestsTotal.WithLabelValues(statusCodeStr, r.Method, route).Inc()
+				observeDuration(r, httpRequestsDuration.WithLabelValues(r.Method, route, statusClass(rw.statusCode)), duration)
+				httpRequestsInFlight.Dec()
 
-		next.ServeHTTP(rw, r)
-	})
+				observeSLOs(route, rw.statusCode, duration, start)
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// routePattern resolves the registered pattern without the method, e.g. "GET /api/v1/orders/{id}" -> "/api/v1/orders/{id}".
+func routePattern(mux *http.ServeMux, r *http.Request) string {
+	_, pattern := mux.Handler(r)
+	if pattern == "" {
+		return "unmatched"
+	}
+
+	if _, path, found := strings.Cut(pattern, " "); found {
+		return path
+	}
+
+	return pattern
+}
+
+func statusClass(code int) string {
+	return strconv.Itoa(code/100) + "xx"
+}
+
+// observeDuration links the observation to the trace as an exemplar, when that trace is exported.
+func observeDuration(r *http.Request, observer prometheus.Observer, duration time.Duration) {
+	spanContext := trace.SpanContextFromContext(r.Context())
+
+	if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok && spanContext.HasTraceID() && tracing.IsExported(r.Context()) {
+		exemplarObserver.ObserveWithExemplar(duration.Seconds(), prometheus.Labels{"trace_id": spanContext.TraceID().String()})
+
+		return
+	}
+
+	observer.Observe(duration.Seconds())
 }
 
 // http.Handler for the Prometheus /metrics endpoint.
+// OpenMetrics is enabled so that exemplars are exposed to scrapers that negotiate it.
 func Handler() http.Handler {
-	return promhttp.Handler()
+	return promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+		promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}),
+	)
 }
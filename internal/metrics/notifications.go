@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Outcomes of an email send passing through the throttle.
+const (
+	EmailSendSent     = "sent"     // a token was available, the email went out
+	EmailSendQueued   = "queued"   // a new email was stored for the retry worker
+	EmailSendDeferred = "deferred" // a queued or retried email was pushed back again
+)
+
+var emailSendsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "notification_email_sends_total",
+		Help: "Email sends passing through the send throttle, by outcome.",
+	},
+	[]string{"outcome"},
+)
+
+func RecordEmailSend(outcome string) {
+	emailSendsTotal.WithLabelValues(outcome).Inc()
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	passwordRejectionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "password_rejections_total",
+			Help: "Passwords refused by the password policy at registration or password change, by broken rule.",
+		},
+		[]string{"reason"},
+	)
+
+	passwordBreachCheckErrors = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "password_breach_check_errors_total",
+			Help: "Breach checks that could not be made, the password was accepted without it.",
+		},
+	)
+
+	passwordRehashesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "password_rehashes_total",
+			Help: "Outdated password hashes, bcrypt or older Argon2id parameters, replaced at login.",
+		},
+	)
+)
+
+// RecordPasswordRejected accounts a refused password once per rule it breaks.
+func RecordPasswordRejected(reasons []string) {
+	for _, reason := range reasons {
+		passwordRejectionsTotal.WithLabelValues(reason).Inc()
+	}
+}
+
+func RecordPasswordBreachCheckError() {
+	passwordBreachCheckErrors.Inc()
+}
+
+// RecordPasswordRehashed tracks the migration of the stored hashes, it stops growing once every active
+// user logged in since the parameters last changed.
+func RecordPasswordRehashed() {
+	passwordRehashesTotal.Inc()
+}
@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Burn-rate windows, paired as in the multiwindow alerting rules (5m/1h and 30m/6h).
+var sloWindows = []struct {
+	label    string
+	duration time.Duration
+}{
+	{"5m", 5 * time.Minute},
+	{"30m", 30 * time.Minute},
+	{"1h", time.Hour},
+	{"6h", 6 * time.Hour},
+}
+
+const sloBuckets = 6 * 60 // one bucket per minute, covers the longest window
+
+var (
+	sloObjectiveDesc = prometheus.NewDesc(
+		"slo_objective_ratio",
+		"Target ratio of good requests for the SLO.",
+		[]string{"slo", "route"}, nil,
+	)
+	sloBurnRateDesc = prometheus.NewDesc(
+		"slo_error_budget_burn_rate",
+		"Rate at which the error budget is consumed over the window, 1 means exactly on budget.",
+		[]string{"slo", "route", "window"}, nil,
+	)
+	sloRequestsDesc = prometheus.NewDesc(
+		"slo_requests_window_total",
+		"Requests accounted for by the SLO over the window.",
+		[]string{"slo", "route", "window"}, nil,
+	)
+)
+
+var sloTracker atomic.Pointer[SLOTracker]
+
+type sloBucket struct {
+	minute int64
+	total  uint64
+	bad    uint64
+}
+
+type sloState struct {
+	target  config.SLOTarget
+	buckets [sloBuckets]sloBucket
+}
+
+// SLOTracker keeps per-minute good/bad counts for each configured SLO and exposes
+// the error-budget burn rate of every window as gauges on scrape.
+type SLOTracker struct {
+	mu    sync.Mutex
+	slos  []*sloState
+	clock func() time.Time
+}
+
+func NewSLOTracker(targets []config.SLOTarget) (*SLOTracker, error) {
+	tracker := &SLOTracker{clock: time.Now}
+
+	for _, target := range targets {
+		if target.Name == "" {
+			return nil, errors.New("slo name is required")
+		}
+
+		if target.Objective <= 0 || target.Objective >= 1 {
+			return nil, fmt.Errorf("slo %s: objective must be between 0 and 1, got %v", target.Name, target.Objective)
+		}
+
+		tracker.slos = append(tracker.slos, &sloState{target: target})
+	}
+
+	return tracker, nil
+}
+
+// RegisterSLOs creates the tracker for the configured targets and exposes it on the default registry.
+func RegisterSLOs(targets []config.SLOTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	tracker, err := NewSLOTracker(targets)
+	if err != nil {
+		return err
+	}
+
+	if err := prometheus.Register(tracker); err != nil {
+		return fmt.Errorf("failed to register slo collector: %w", err)
+	}
+
+	sloTracker.Store(tracker)
+
+	return nil
+}
+
+func observeSLOs(route string, statusCode int, duration time.Duration, at time.Time) {
+	if tracker := sloTracker.Load(); tracker != nil {
+		tracker.Observe(route, statusCode, duration, at)
+	}
+}
+
+// Observe accounts a request against every SLO covering the route. An empty SLO route covers all routes.
+func (t *SLOTracker) Observe(route string, statusCode int, duration time.Duration, at time.Time) {
+	minute := at.Unix() / 60
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, slo := range t.slos {
+		if slo.target.Route != "" && slo.target.Route != route {
+			continue
+		}
+
+		bucket := &slo.buckets[minute%sloBuckets]
+		if bucket.minute != minute {
+			*bucket = sloBucket{minute: minute}
+		}
+
+		bucket.total++
+
+		if statusCode >= http.StatusInternalServerError || (slo.target.LatencyThreshold > 0 && duration > slo.target.LatencyThreshold) {
+			bucket.bad++
+		}
+	}
+}
+
+func (s *sloState) burnRate(now time.Time, window time.Duration) (float64, uint64) {
+	current := now.Unix() / 60
+	oldest := current - int64(window/time.Minute) + 1
+
+	var total, bad uint64
+
+	for _, bucket := range s.buckets {
+		if bucket.minute >= oldest && bucket.minute <= current {
+			total += bucket.total
+			bad += bucket.bad
+		}
+	}
+
+	if total == 0 {
+		return 0, 0
+	}
+
+	errorRatio := float64(bad) / float64(total)
+
+	return errorRatio / (1 - s.target.Objective), total
+}
+
+func (t *SLOTracker) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sloObjectiveDesc
+	ch <- sloBurnRateDesc
+	ch <- sloRequestsDesc
+}
+
+func (t *SLOTracker) Collect(ch chan<- prometheus.Metric) {
+	now := t.clock()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, slo := range t.slos {
+		route := slo.target.Route
+		if route == "" {
+			route = "all"
+		}
+
+		ch <- prometheus.MustNewConstMetric(sloObjectiveDesc, prometheus.GaugeValue, slo.target.Objective, slo.target.Name, route)
+
+		for _, window := range sloWindows {
+			burnRate, total := slo.burnRate(now, window.duration)
+
+			ch <- prometheus.MustNewConstMetric(sloBurnRateDesc, prometheus.GaugeValue, burnRate, slo.target.Name, route, window.label)
+			ch <- prometheus.MustNewConstMetric(sloRequestsDesc, prometheus.GaugeValue, float64(total), slo.target.Name, route, window.label)
+		}
+	}
+}
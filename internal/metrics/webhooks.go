@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	webhookQueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "webhook_queue_depth",
+			Help: "Number of webhook events accepted but not processed yet.",
+		},
+	)
+
+	webhookProcessingLag = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "webhook_processing_lag_seconds",
+			Help:    "Time from accepting a webhook event to the end of its processing.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 14), // 10ms up to ~80s
+		},
+		[]string{"type"},
+	)
+
+	webhookEventsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_events_processed_total",
+			Help: "Webhook events processed, by outcome once all attempts are done.",
+		},
+		[]string{"type", "outcome"},
+	)
+
+	webhookEventsQuarantined = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_events_quarantined_total",
+			Help: "Webhook events moved to the dead-letter table because their payload is invalid or they kept failing.",
+		},
+		[]string{"type"},
+	)
+
+	webhookRejectionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_rejections_total",
+			Help: "Partner webhook deliveries refused, by partner and reason, e.g. invalid_signature, stale or replayed.",
+		},
+		[]string{"partner", "reason"},
+	)
+)
+
+func SetWebhookQueueDepth(depth int64) {
+	webhookQueueDepth.Set(float64(depth))
+}
+
+// RecordWebhookProcessed accounts an event that left the queue, err being the outcome of its last attempt.
+func RecordWebhookProcessed(eventType string, lag time.Duration, err error) {
+	outcome := "processed"
+	if err != nil {
+		outcome = "failed"
+	}
+
+	webhookProcessingLag.WithLabelValues(eventType).Observe(lag.Seconds())
+	webhookEventsTotal.WithLabelValues(eventType, outcome).Inc()
+}
+
+func RecordWebhookQuarantined(eventType string) {
+	webhookEventsQuarantined.WithLabelValues(eventType).Inc()
+}
+
+// RecordWebhookRejected accounts a refused delivery, replayed ones hint at a captured request.
+func RecordWebhookRejected(partner, reason string) {
+	webhookRejectionsTotal.WithLabelValues(partner, reason).Inc()
+}
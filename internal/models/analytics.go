@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// CustomerAnalytics sums up the paid orders of every customer, the lifetime value of a customer being
+// the revenue of their paid orders to date. A repeat customer paid for more than one order.
+type CustomerAnalytics struct {
+	Customers            int     `json:"customers"`
+	RepeatCustomers      int     `json:"repeat_customers"`
+	RepeatPurchaseRate   float64 `json:"repeat_purchase_rate"`
+	Orders               int     `json:"orders"`
+	Revenue              float64 `json:"revenue"`
+	AverageOrderValue    float64 `json:"average_order_value"`
+	AverageLifetimeValue float64 `json:"average_lifetime_value"`
+}
+
+// CohortActivity is what the customers of a cohort did in one of the months since their first paid
+// order: how many paid for an order that month and what they spent.
+type CohortActivity struct {
+	CohortMonth     time.Time `json:"-"`
+	OrderMonth      time.Time `json:"order_month"`
+	MonthsSince     int       `json:"months_since"`
+	ActiveCustomers int       `json:"active_customers"`
+	RetentionRate   float64   `json:"retention_rate"`
+	Revenue         float64   `json:"revenue"`
+}
+
+// Cohort groups the customers by the month of their first paid order. Size is the number of customers
+// of the cohort, LifetimeValue what they spent on average so far.
+type Cohort struct {
+	Month         time.Time        `json:"month"`
+	Size          int              `json:"size"`
+	LifetimeValue float64          `json:"lifetime_value"`
+	Activity      []CohortActivity `json:"activity"`
+}
+
+// CohortReport lists the cohorts of the customers whose first paid order falls within the range.
+type CohortReport struct {
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Cohorts []Cohort  `json:"cohorts"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scopes a machine client can be granted, each opens a set of routes to its tokens.
+const (
+	ScopeCatalogRead  = "catalog:read"
+	ScopeCatalogWrite = "catalog:write"
+	ScopeOrdersRead   = "orders:read"
+	ScopeOrdersWrite  = "orders:write"
+)
+
+// APIClient is an integration authenticating with the OAuth2 client credentials grant. Its tokens act on
+// no user's behalf, they only open the routes of the granted scopes. Only the SHA-256 of a secret is
+// stored; after a rotation the previous secret keeps working until PreviousSecretExpiresAt.
+type APIClient struct {
+	ID                      uuid.UUID  `json:"id"`
+	Name                    string     `json:"name"`
+	Scopes                  []string   `json:"scopes"`
+	SecretHash              string     `json:"-"`
+	PreviousSecretHash      string     `json:"-"`
+	PreviousSecretExpiresAt *time.Time `json:"previous_secret_expires_at,omitempty"`
+	CreatedBy               uuid.UUID  `json:"created_by"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+	RevokedAt               *time.Time `json:"revoked_at,omitempty"`
+}
+
+type CreateAPIClientRequest struct {
+	Name   string   `json:"name"   validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=catalog:read catalog:write orders:read orders:write"`
+}
+
+// APIClientCredentials is the only time a client secret is shown, it cannot be recovered afterwards.
+type APIClientCredentials struct {
+	Client       *APIClient `json:"client"`
+	ClientSecret string     `json:"client_secret"`
+}
+
+// ClientTokenResponse follows RFC 6749, section 5.1.
+type ClientTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// OAuthError follows RFC 6749, section 5.2, standard OAuth2 clients read it instead of the usual error
+// envelope.
+type OAuthError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
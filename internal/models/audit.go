@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+const (
+	// AuditActionLogin points to the session with ReferenceID and describes the device in Details.
+	AuditActionLogin       AuditAction = "login"
+	AuditActionLoginFailed AuditAction = "login_failed"
+	// AuditActionSessionRevoked points to the revoked session with ReferenceID.
+	AuditActionSessionRevoked  AuditAction = "session_revoked"
+	AuditActionPasswordChanged AuditAction = "password_changed"
+	// AuditActionEmailChangeRequested and AuditActionEmailChanged point to the change with ReferenceID.
+	AuditActionEmailChangeRequested AuditAction = "email_change_requested"
+	AuditActionEmailChanged         AuditAction = "email_changed"
+	// AuditActionImpersonated is recorded on the customer, ActorID is the support agent.
+	AuditActionImpersonated AuditAction = "impersonated"
+	// AuditActionOrderEdited is recorded on the customer, ActorID is the admin and ReferenceID the order.
+	AuditActionOrderEdited AuditAction = "order_edited"
+	// AuditActionOrderRefunded is recorded on the customer, ActorID is the admin and ReferenceID the order.
+	AuditActionOrderRefunded AuditAction = "order_refunded"
+	// AuditActionPriceChanged is recorded on the admin, ReferenceID is the product.
+	AuditActionPriceChanged AuditAction = "price_changed"
+	// AuditActionCartPurged and AuditActionOrderAnonymized are recorded on the owner by the retention
+	// policies, ReferenceID is the cart or order and ActorID the admin who ran them by hand.
+	AuditActionCartPurged      AuditAction = "cart_purged"
+	AuditActionOrderAnonymized AuditAction = "order_anonymized"
+	// AuditActionStockAdjusted is recorded on the admin who applied a stocktake, ReferenceID is the product
+	// and Details hold the warehouse, the book and counted quantities and the reason.
+	AuditActionStockAdjusted AuditAction = "stock_adjusted"
+)
+
+// AuditEntry records an action of a user, or taken on their behalf. Actions that change a record,
+// like an order edit, point to it with ReferenceID and describe the change in Details.
+type AuditEntry struct {
+	ID          uuid.UUID       `json:"id"`
+	UserID      uuid.UUID       `json:"user_id"`
+	ActorID     *uuid.UUID      `json:"actor_id,omitempty"` // set when someone else acted on behalf of the user
+	Action      AuditAction     `json:"action"`
+	ReferenceID *uuid.UUID      `json:"reference_id,omitempty"`
+	Details     json.RawMessage `json:"details,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+type TimelineEventType string
+
+const (
+	TimelineRegistered           TimelineEventType = "registered"
+	TimelineLogin                TimelineEventType = "login"
+	TimelineLoginFailed          TimelineEventType = "login_failed"
+	TimelineSessionRevoked       TimelineEventType = "session_revoked"
+	TimelinePasswordChanged      TimelineEventType = "password_changed"
+	TimelineEmailChangeRequested TimelineEventType = "email_change_requested"
+	TimelineEmailChanged         TimelineEventType = "email_changed"
+	TimelineImpersonated         TimelineEventType = "impersonated"
+	TimelineOrderEdited          TimelineEventType = "order_edited"
+	TimelineOrderRefunded        TimelineEventType = "order_refunded"
+	TimelinePriceChanged         TimelineEventType = "price_changed"
+	TimelineCartPurged           TimelineEventType = "cart_purged"
+	TimelineOrderAnonymized      TimelineEventType = "order_anonymized"
+	TimelineStockAdjusted        TimelineEventType = "stock_adjusted"
+	TimelineOrder                TimelineEventType = "order"
+	TimelinePayment              TimelineEventType = "payment"
+	TimelineRefund               TimelineEventType = "refund"
+	TimelineNotification         TimelineEventType = "notification"
+)
+
+// TimelineEvent is an entry of the activity feed of a user, ReferenceID points to the underlying
+// order, payment or notification.
+type TimelineEvent struct {
+	Type        TimelineEventType `json:"type"`
+	OccurredAt  time.Time         `json:"occurred_at"`
+	ReferenceID string            `json:"reference_id"`
+	Status      string            `json:"status,omitempty"`
+	Summary     string            `json:"summary,omitempty"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BroadcastAudienceType string
+
+const (
+	BroadcastAudienceAll               BroadcastAudienceType = "all"
+	BroadcastAudienceTag               BroadcastAudienceType = "tag"
+	BroadcastAudienceProductPurchasers BroadcastAudienceType = "product_purchasers"
+)
+
+type BroadcastStatus string
+
+const (
+	BroadcastStatusPending   BroadcastStatus = "pending"
+	BroadcastStatusRunning   BroadcastStatus = "running"
+	BroadcastStatusCompleted BroadcastStatus = "completed"
+	BroadcastStatusCancelled BroadcastStatus = "cancelled"
+)
+
+type BroadcastAudience struct {
+	Type      BroadcastAudienceType `json:"type"                 validate:"required,oneof=all tag product_purchasers"`
+	Tag       string                `json:"tag,omitempty"        validate:"required_if=Type tag,max=100"`
+	ProductID *uuid.UUID            `json:"product_id,omitempty" validate:"required_if=Type product_purchasers"`
+}
+
+// Broadcast is an email sent to every user of an audience. Subject and content are Go text
+// templates rendered per recipient with {{.Name}} and {{.Email}}.
+type Broadcast struct {
+	ID              uuid.UUID         `json:"id"`
+	Subject         string            `json:"subject"`
+	Content         string            `json:"content"`
+	Audience        BroadcastAudience `json:"audience"`
+	Status          BroadcastStatus   `json:"status"`
+	TotalRecipients int               `json:"total_recipients"`
+	SentCount       int               `json:"sent_count"`
+	FailedCount     int               `json:"failed_count"`
+	CreatedBy       uuid.UUID         `json:"created_by"`
+	CreatedAt       time.Time         `json:"created_at"`
+	StartedAt       *time.Time        `json:"started_at,omitempty"`
+	FinishedAt      *time.Time        `json:"finished_at,omitempty"`
+	Cursor          uuid.UUID         `json:"-"` // last recipient handled, a resumed broadcast continues after it
+}
+
+type CreateBroadcastRequest struct {
+	Subject  string            `json:"subject"  validate:"required,max=200"`
+	Content  string            `json:"content"  validate:"required"`
+	Audience BroadcastAudience `json:"audience" validate:"required"`
+}
+
+// BroadcastRecipient is the data available to the broadcast templates.
+type BroadcastRecipient struct {
+	ID    uuid.UUID `json:"-"`
+	Name  string    `json:"-"`
+	Email string    `json:"-"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// CacheInvalidation reports the version a cache namespace was bumped to, its keys under the previous
+// versions are no longer read.
+type CacheInvalidation struct {
+	Namespace string `json:"namespace"`
+	Version   int64  `json:"version"`
+}
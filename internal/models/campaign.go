@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CampaignStatus string
+
+const (
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+	CampaignStatusActive    CampaignStatus = "active"
+	CampaignStatusEnded     CampaignStatus = "ended"
+	CampaignStatusCancelled CampaignStatus = "cancelled" // ended by an admin, before or while it ran
+)
+
+// Campaign is a limited-time sale: from StartsAt to EndsAt its products are sold at their campaign
+// price, up to the units allocated to it. The prices of the products are set when the campaign starts
+// and restored when it ends.
+type Campaign struct {
+	ID        uuid.UUID      `json:"id"`
+	Name      string         `json:"name"`
+	StartsAt  time.Time      `json:"starts_at"`
+	EndsAt    time.Time      `json:"ends_at"`
+	Status    CampaignStatus `json:"status"`
+	Items     []CampaignItem `json:"items"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type CampaignItem struct {
+	ProductID     uuid.UUID `json:"product_id"`
+	CampaignPrice float64   `json:"campaign_price"`
+	OriginalPrice *float64  `json:"original_price,omitempty"` // price of the product when the campaign started
+	Allocation    int       `json:"allocation"`
+	Sold          int       `json:"sold"`                // counted when the campaign ends
+	Remaining     *int      `json:"remaining,omitempty"` // only while the campaign is active
+}
+
+type CreateCampaignRequest struct {
+	Name     string                      `json:"name"      validate:"required,min=3,max=200"`
+	StartsAt time.Time                   `json:"starts_at" validate:"required"`
+	EndsAt   time.Time                   `json:"ends_at"   validate:"required,gtfield=StartsAt"`
+	Items    []CreateCampaignItemRequest `json:"items"     validate:"required,min=1,max=100,dive"`
+}
+
+type CreateCampaignItemRequest struct {
+	ProductID     uuid.UUID `json:"product_id"     validate:"required"`
+	CampaignPrice float64   `json:"campaign_price" validate:"required,gt=0"`
+	Allocation    int       `json:"allocation"     validate:"required,min=1"`
+}
+
+// CampaignClaim is the units of a product a checkout takes from the allocation of its active campaign.
+type CampaignClaim struct {
+	ProductID  uuid.UUID
+	Quantity   int
+	CampaignID uuid.UUID // set once claimed, uuid.Nil for a product in no running campaign
+}
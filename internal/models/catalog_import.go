@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CatalogImportSource string
+
+const (
+	CatalogImportSourceShopify     CatalogImportSource = "shopify"
+	CatalogImportSourceWooCommerce CatalogImportSource = "woocommerce"
+)
+
+type CatalogImportStatus string
+
+const (
+	CatalogImportStatusPending   CatalogImportStatus = "pending"
+	CatalogImportStatusRunning   CatalogImportStatus = "running"
+	CatalogImportStatusCompleted CatalogImportStatus = "completed"
+	CatalogImportStatusFailed    CatalogImportStatus = "failed"
+)
+
+// CatalogImportAction is what the import did, or would do in a dry run, with a variant of the source.
+type CatalogImportAction string
+
+const (
+	CatalogImportActionCreate    CatalogImportAction = "create"
+	CatalogImportActionUpdate    CatalogImportAction = "update"
+	CatalogImportActionUnchanged CatalogImportAction = "unchanged"
+	CatalogImportActionSkip      CatalogImportAction = "skip" // the SKU exists and updates were not requested
+	CatalogImportActionFail      CatalogImportAction = "fail"
+)
+
+// CatalogImportMapping turns the products of the source into products of the catalog. Every variant
+// becomes a product named "<title> - <variant title>", found again on later imports by its SKU.
+type CatalogImportMapping struct {
+	DefaultCategoryID uuid.UUID            `json:"default_category_id"        validate:"required"`
+	Categories        map[string]uuid.UUID `json:"categories,omitempty"`                                                  // by Shopify product type or first WooCommerce category
+	SKUPrefix         string               `json:"sku_prefix,omitempty"       validate:"max=20"`                          // keeps imported SKUs apart from the existing ones
+	PriceMultiplier   float64              `json:"price_multiplier,omitempty" validate:"omitempty,gt=0"`                  // e.g. a currency conversion, 1 when unset
+	Status            string               `json:"status,omitempty"           validate:"omitempty,oneof=active inactive"` // of created products, inactive when unset
+	SkipDescription   bool                 `json:"skip_description,omitempty"`
+	SkipImages        bool                 `json:"skip_images,omitempty"`
+}
+
+// CatalogImport is a run of the importer. Products are only written when it is not a dry run, the
+// items of the report list the changes either way.
+type CatalogImport struct {
+	ID             uuid.UUID            `json:"id"`
+	Source         CatalogImportSource  `json:"source"`
+	DryRun         bool                 `json:"dry_run"`
+	UpdateExisting bool                 `json:"update_existing"`
+	Mapping        CatalogImportMapping `json:"mapping"`
+	Status         CatalogImportStatus  `json:"status"`
+	Error          string               `json:"error,omitempty"`
+	Created        int                  `json:"created"`
+	Updated        int                  `json:"updated"`
+	Unchanged      int                  `json:"unchanged"`
+	Skipped        int                  `json:"skipped"`
+	Failed         int                  `json:"failed"`
+	CreatedBy      uuid.UUID            `json:"created_by"`
+	CreatedAt      time.Time            `json:"created_at"`
+	StartedAt      *time.Time           `json:"started_at,omitempty"`
+	FinishedAt     *time.Time           `json:"finished_at,omitempty"`
+}
+
+type CreateCatalogImportRequest struct {
+	Source         CatalogImportSource  `json:"source"          validate:"required,oneof=shopify woocommerce"`
+	DryRun         bool                 `json:"dry_run"`
+	UpdateExisting bool                 `json:"update_existing"`
+	Mapping        CatalogImportMapping `json:"mapping"         validate:"required"`
+}
+
+// CatalogImportChange is a field of an existing product the import changes.
+type CatalogImportChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// CatalogImportItem is the line of the diff report for a variant of the source.
+type CatalogImportItem struct {
+	ID         uuid.UUID             `json:"id"`
+	ImportID   uuid.UUID             `json:"import_id"`
+	ExternalID string                `json:"external_id"`
+	SKU        string                `json:"sku"`
+	Name       string                `json:"name"`
+	Action     CatalogImportAction   `json:"action"`
+	ProductID  *uuid.UUID            `json:"product_id,omitempty"` // nil for products a dry run would create
+	Changes    []CatalogImportChange `json:"changes,omitempty"`
+	Error      string                `json:"error,omitempty"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
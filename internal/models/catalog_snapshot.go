@@ -0,0 +1,54 @@
+package models
+
+import (
+	"encoding/hex"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// SnapshotCategory is a category as listed in a catalog snapshot.
+type SnapshotCategory struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// SnapshotProduct is the compact form of a product for sale, enough for an edge worker to render
+// listings. A version older than the one held can be ignored.
+type SnapshotProduct struct {
+	ID         uuid.UUID  `json:"id"`
+	CategoryID uuid.UUID  `json:"category_id"`
+	Name       string     `json:"name"`
+	SKU        string     `json:"sku"`
+	Price      float64    `json:"price"`
+	VendorID   *uuid.UUID `json:"vendor_id,omitempty"`
+	Version    int        `json:"version"`
+	Active     bool       `json:"-"` // a delta lists the products taken off sale as deleted
+}
+
+// CatalogSnapshot is the catalog at Version, a cursor of the product change feed. A full snapshot
+// replaces whatever the edge holds, a delta only has the products changed since the Since version and
+// the ones taken off sale since. Categories are always listed in full, they are few.
+type CatalogSnapshot struct {
+	Version    string             `json:"version"`
+	Since      string             `json:"since,omitempty"`
+	Full       bool               `json:"full"`
+	Categories []SnapshotCategory `json:"categories"`
+	Products   []SnapshotProduct  `json:"products"`
+	Deleted    []uuid.UUID        `json:"deleted"`
+}
+
+// ETag identifies the snapshot for conditional requests. Categories are not in the change feed, so
+// they are hashed in for a renamed category to change the tag too.
+func (s *CatalogSnapshot) ETag() string {
+	hash := fnv.New64a()
+	hash.Write([]byte(s.Version + "|" + s.Since + "|" + strconv.FormatBool(s.Full)))
+
+	for _, category := range s.Categories {
+		hash.Write(category.ID[:])
+		hash.Write([]byte(category.Name))
+	}
+
+	return `"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Dispute statuses as reported by Stripe.
+const (
+	DisputeStatusWon  = "won"
+	DisputeStatusLost = "lost"
+)
+
+type Dispute struct {
+	ID            string     `json:"id"`
+	PaymentID     string     `json:"payment_id"`
+	OrderID       *uuid.UUID `json:"order_id,omitempty"`
+	ChargeID      string     `json:"charge_id"`
+	Amount        int64      `json:"amount"`
+	Currency      string     `json:"currency"`
+	Reason        string     `json:"reason"`
+	Status        string     `json:"status"`
+	EvidenceDueBy *time.Time `json:"evidence_due_by,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
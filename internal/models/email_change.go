@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailChangeTarget is the address a confirmation link was sent to.
+type EmailChangeTarget string
+
+const (
+	EmailChangeTargetOld EmailChangeTarget = "old"
+	EmailChangeTargetNew EmailChangeTarget = "new"
+)
+
+// EmailChange is a pending change of the email of a user. It goes through once both the old and the
+// new address confirmed it before ExpiresAt, every session of the user is revoked then.
+type EmailChange struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	OldEmail       string     `json:"old_email"`
+	NewEmail       string     `json:"new_email"`
+	OldConfirmedAt *time.Time `json:"old_confirmed_at,omitempty"`
+	NewConfirmedAt *time.Time `json:"new_confirmed_at,omitempty"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Confirmed reports whether both addresses confirmed the change.
+func (c *EmailChange) Confirmed() bool {
+	return c.OldConfirmedAt != nil && c.NewConfirmedAt != nil
+}
+
+// EmailChangeRequest asks for the current password like a password change.
+type EmailChangeRequest struct {
+	NewEmail        string `json:"new_email"        validate:"required,email"`
+	CurrentPassword string `json:"current_password" validate:"required"`
+}
+
+// ConfirmEmailChangeRequest carries the token of a confirmation link, either address can confirm first.
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" validate:"required"`
+}
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VelocityKey is what a velocity rule counts the orders of.
+type VelocityKey string
+
+const (
+	VelocityKeyCustomer           VelocityKey = "customer"
+	VelocityKeyIP                 VelocityKey = "ip"
+	VelocityKeyPaymentFingerprint VelocityKey = "payment_fingerprint" // the card fingerprint of Stripe
+	VelocityKeyDeviceFingerprint  VelocityKey = "device_fingerprint"  // the device the order was placed from
+)
+
+// VelocityAction is what happens to an order tripping a velocity rule.
+type VelocityAction string
+
+const (
+	VelocityActionBlock  VelocityAction = "block"
+	VelocityActionReview VelocityAction = "review" // the order is placed, its fulfillment waits for a fraud review
+)
+
+// VelocitySubjects are the values of an order the velocity rules count, the ones unknown are left empty.
+type VelocitySubjects struct {
+	CustomerID         uuid.UUID
+	IP                 string
+	PaymentFingerprint string
+	DeviceFingerprint  string
+}
+
+// DeviceFingerprint is sent at checkout by the fingerprinting script of the storefront. ID tells the
+// device apart across accounts, Components are the signals it was derived from, kept for the reviewers.
+type DeviceFingerprint struct {
+	ID         string         `json:"id"         validate:"required,max=128"`
+	Components map[string]any `json:"components" validate:"omitempty,max=64"`
+}
+
+// OrderDevice is the device an order was placed from.
+type OrderDevice struct {
+	OrderID     uuid.UUID      `json:"order_id"`
+	CustomerID  uuid.UUID      `json:"customer_id"`
+	Fingerprint string         `json:"fingerprint"`
+	Components  map[string]any `json:"components,omitempty"`
+	IPAddress   string         `json:"ip_address,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// LinkedAccount is an account that placed orders from a device, several accounts on one device are
+// often a single buyer getting around the per-customer limits.
+type LinkedAccount struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Email      string    `json:"email"`
+	Orders     int       `json:"orders"`
+	FirstSeen  time.Time `json:"first_seen"`
+	LastSeen   time.Time `json:"last_seen"`
+}
+
+type FraudReviewStatus string
+
+const (
+	FraudReviewStatusPending  FraudReviewStatus = "pending"
+	FraudReviewStatusApproved FraudReviewStatus = "approved"
+	FraudReviewStatusRejected FraudReviewStatus = "rejected" // the order is cancelled
+)
+
+// FraudReview holds an order flagged at checkout, it can't be shipped or picked up until an admin
+// approves it. An order has one review, flagged again its reasons are added to it.
+type FraudReview struct {
+	OrderID    uuid.UUID         `json:"order_id"`
+	CustomerID uuid.UUID         `json:"customer_id"`
+	Status     FraudReviewStatus `json:"status"`
+	Reasons    []string          `json:"reasons"` // the rules the order tripped
+	Device     *OrderDevice      `json:"device,omitempty"`
+	Note       string            `json:"note,omitempty"`
+	ReviewedBy *uuid.UUID        `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time        `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+type ResolveFraudReviewRequest struct {
+	Status FraudReviewStatus `json:"status" validate:"required,oneof=approved rejected"`
+	Note   string            `json:"note"   validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FulfillmentWaveStatus string
+
+const (
+	FulfillmentWaveOpen      FulfillmentWaveStatus = "open"
+	FulfillmentWaveCompleted FulfillmentWaveStatus = "completed"
+)
+
+// FulfillmentWave is a batch of orders picked together in one warehouse, all of the same fulfillment
+// type so that they leave by the same way. An order is in one open wave at most, completing the wave
+// moves its orders to shipping and releases those that could not move.
+type FulfillmentWave struct {
+	ID              uuid.UUID             `json:"id"`
+	WarehouseID     uuid.UUID             `json:"warehouse_id"`
+	FulfillmentType FulfillmentType       `json:"fulfillment_type"`
+	Status          FulfillmentWaveStatus `json:"status"`
+	OrderIDs        []uuid.UUID           `json:"order_ids"` // oldest order first
+	CreatedBy       uuid.UUID             `json:"created_by"`
+	CreatedAt       time.Time             `json:"created_at"`
+	CompletedAt     *time.Time            `json:"completed_at,omitempty"`
+}
+
+// CreateFulfillmentWaveRequest groups the oldest paid orders not shipped yet whose stock is allocated
+// in the warehouse alone. MaxOrders defaults to, and cannot exceed, the configured wave size.
+type CreateFulfillmentWaveRequest struct {
+	WarehouseID     uuid.UUID       `json:"warehouse_id"     validate:"required"`
+	FulfillmentType FulfillmentType `json:"fulfillment_type" validate:"omitempty,oneof=shipping pickup"`
+	MaxOrders       int             `json:"max_orders"       validate:"omitempty,min=1"`
+}
+
+// PickList is what a picker collects in the warehouse for a wave, the allocated units of every
+// product summed over the orders of the wave.
+type PickList struct {
+	WaveID        uuid.UUID      `json:"wave_id"`
+	WarehouseID   uuid.UUID      `json:"warehouse_id"`
+	WarehouseName string         `json:"warehouse_name"`
+	OrderCount    int            `json:"order_count"`
+	Lines         []PickListLine `json:"lines"` // by SKU
+}
+
+type PickListLine struct {
+	ProductID  uuid.UUID `json:"product_id"`
+	SKU        string    `json:"sku"`
+	Name       string    `json:"name"`
+	Quantity   int       `json:"quantity"`
+	OrderCount int       `json:"order_count"` // orders of the wave the units are split into
+}
+
+// CompleteFulfillmentWaveResponse is the completed wave with the outcome of moving each of its
+// orders to shipping.
+type CompleteFulfillmentWaveResponse struct {
+	Wave    *FulfillmentWave               `json:"wave"`
+	Results *BulkUpdateOrderStatusResponse `json:"results"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+type InvariantCheck string
+
+const (
+	InvariantStockLedger   InvariantCheck = "stock_ledger"
+	InvariantOrderTotal    InvariantCheck = "order_total"
+	InvariantOrderPayment  InvariantCheck = "order_payment"
+	InvariantLedgerBalance InvariantCheck = "ledger_balance"
+)
+
+type InvariantViolation struct {
+	Check    InvariantCheck `json:"check"`
+	EntityID string         `json:"entity_id"`
+	Expected string         `json:"expected"`
+	Actual   string         `json:"actual"`
+	Message  string         `json:"message"`
+}
+
+type InvariantReport struct {
+	StartedAt  time.Time              `json:"started_at"`
+	FinishedAt time.Time              `json:"finished_at"`
+	Counts     map[InvariantCheck]int `json:"counts"`
+	Violations []InvariantViolation   `json:"violations"`
+}
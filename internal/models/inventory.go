@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StockMovementReason string
+
+const (
+	StockMovementOrder      StockMovementReason = "order"
+	StockMovementAdjustment StockMovementReason = "adjustment"
+)
+
+// StockMovement is an entry of the stock ledger. Quantity is the number of units taken out of
+// stock (negative when stock is added back), so stock_quantity == initial - sum(quantity).
+type StockMovement struct {
+	ID          uuid.UUID           `json:"id"`
+	ProductID   uuid.UUID           `json:"product_id"`
+	Quantity    int                 `json:"quantity"`
+	Reason      StockMovementReason `json:"reason"`
+	ReferenceID *uuid.UUID          `json:"reference_id,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LedgerAccount is an account of the double-entry ledger, amounts are in the smallest currency unit.
+type LedgerAccount string
+
+const (
+	LedgerAccountStripeBalance  LedgerAccount = "stripe_balance"  // asset, the money Stripe holds for the platform
+	LedgerAccountSales          LedgerAccount = "sales"           // revenue, the payments captured from customers
+	LedgerAccountRefunds        LedgerAccount = "refunds"         // contra revenue, the money given back to customers
+	LedgerAccountProcessingFees LedgerAccount = "processing_fees" // expense, the fees Stripe keeps
+	LedgerAccountVendorPayouts  LedgerAccount = "vendor_payouts"  // expense, the earnings transferred to vendors
+)
+
+type LedgerTransactionType string
+
+const (
+	LedgerTransactionCapture      LedgerTransactionType = "capture"
+	LedgerTransactionRefund       LedgerTransactionType = "refund"
+	LedgerTransactionFee          LedgerTransactionType = "fee"
+	LedgerTransactionVendorPayout LedgerTransactionType = "vendor_payout"
+	// LedgerTransactionRefundReversal takes back a refund recorded ahead of the payment provider that then refused it.
+	LedgerTransactionRefundReversal LedgerTransactionType = "refund_reversal"
+)
+
+// LedgerEntry debits or credits an account, never both. Reference is what the entry is allocated
+// to, like the order item of a refund line.
+type LedgerEntry struct {
+	Account   LedgerAccount `json:"account"`
+	Debit     int64         `json:"debit"`
+	Credit    int64         `json:"credit"`
+	Reference string        `json:"reference,omitempty"`
+}
+
+// LedgerTransaction is a financial movement, its entries always balance. SourceID is what moved the
+// money: the payment intent or checkout session of a capture, refund or fee, or the payout item of a
+// vendor payout. IdempotencyKey makes recording the same movement twice, like on a webhook retry, a no-op.
+type LedgerTransaction struct {
+	ID             uuid.UUID             `json:"id"`
+	Type           LedgerTransactionType `json:"type"`
+	SourceID       string                `json:"source_id"`
+	IdempotencyKey string                `json:"-"`
+	Currency       string                `json:"currency"`
+	Entries        []LedgerEntry         `json:"entries"`
+	CreatedAt      time.Time             `json:"created_at"`
+}
+
+// NewLedgerTransfer moves amount from the credited account to the debited one.
+func NewLedgerTransfer(txType LedgerTransactionType, sourceID, idempotencyKey, currency string, amount int64, debit, credit LedgerAccount) *LedgerTransaction {
+	return &LedgerTransaction{
+		ID:             uuid.New(),
+		Type:           txType,
+		SourceID:       sourceID,
+		IdempotencyKey: idempotencyKey,
+		Currency:       currency,
+		Entries: []LedgerEntry{
+			{Account: debit, Debit: amount},
+			{Account: credit, Credit: amount},
+		},
+	}
+}
+
+// Balanced reports whether the debits of the transaction equal its credits, with no entry negative,
+// both-sided or empty.
+func (t *LedgerTransaction) Balanced() bool {
+	var debits, credits int64
+
+	for _, entry := range t.Entries {
+		if entry.Debit < 0 || entry.Credit < 0 || (entry.Debit == 0) == (entry.Credit == 0) {
+			return false
+		}
+
+		debits += entry.Debit
+		credits += entry.Credit
+	}
+
+	return len(t.Entries) > 0 && debits == credits
+}
+
+// LedgerBalance totals an account in one currency, Balance is the debits minus the credits.
+type LedgerBalance struct {
+	Account  LedgerAccount `json:"account"`
+	Currency string        `json:"currency"`
+	Debit    int64         `json:"debit"`
+	Credit   int64         `json:"credit"`
+	Balance  int64         `json:"balance"`
+}
+
+// LedgerReport is the activity of every account over [From, To), the figures finance reports are
+// built from.
+type LedgerReport struct {
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Balances []LedgerBalance `json:"balances"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// LogLevel reports the level the server logs at and the one it was configured with, they differ while
+// an admin raised or lowered it for live debugging.
+type LogLevel struct {
+	Level      string `json:"level"`
+	Configured string `json:"configured"`
+}
+
+type UpdateLogLevelRequest struct {
+	Level string `json:"level" validate:"required,oneof=debug info warn error"`
+}
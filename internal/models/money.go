@@ -0,0 +1,18 @@
+package models
+
+import (
+	"math"
+)
+
+// CatalogCurrency is the currency of every catalog price, products do not store their own.
+const CatalogCurrency = "usd"
+
+// Money is an amount in the smallest currency unit, as served from API version 2 onwards.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func NewMoney(value float64, currency string) Money {
+	return Money{Amount: int64(math.Round(value * 100)), Currency: currency}
+}
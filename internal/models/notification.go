@@ -21,30 +21,52 @@ const (
 	StatusPending NotificationStatus = "pending"
 	StatusSent    NotificationStatus = "sent"
 	StatusFailed  NotificationStatus = "failed"
+	// StatusQueued is set when the send quota is used up, the retry worker sends it at NextAttemptAt.
+	StatusQueued NotificationStatus = "queued"
+	// StatusPermanentlyFailed is set once the retry policy gave up, only an admin retry sends it again.
+	StatusPermanentlyFailed NotificationStatus = "permanently_failed"
+	// Reported by the SendGrid event webhook once the email left the platform.
+	StatusDelivered    NotificationStatus = "delivered"
+	StatusBounced      NotificationStatus = "bounced"
+	StatusSpamReported NotificationStatus = "spam_reported"
+	// StatusSuppressed is set instead of sending to an address that hard bounced before.
+	StatusSuppressed NotificationStatus = "suppressed"
 )
 
 type Notification struct {
-	ID           uuid.UUID          `json:"id"`
-	Type         NotificationType   `json:"type"`
-	Recipient    string             `json:"recipient"`
-	Subject      string             `json:"subject,omitempty"`
-	Content      string             `json:"content"`
-	Status       NotificationStatus `json:"status"`
-	ErrorMessage string             `json:"error_message,omitempty"`
-	Metadata     json.RawMessage    `json:"metadata,omitempty"      swaggertype:"object"` // highly dynamic
-	CreatedAt    time.Time          `json:"created_at"`
-	UpdatedAt    time.Time          `json:"updated_at"`
-	SentAt       *time.Time         `json:"sent_at,omitempty"`
+	ID            uuid.UUID          `json:"id"`
+	Type          NotificationType   `json:"type"`
+	Recipient     string             `json:"recipient"`
+	Subject       string             `json:"subject,omitempty"`
+	Content       string             `json:"content"`
+	Status        NotificationStatus `json:"status"`
+	ErrorMessage  string             `json:"error_message,omitempty"`
+	RetryCount    int                `json:"retry_count"`
+	NextAttemptAt *time.Time         `json:"next_attempt_at,omitempty"`
+	Metadata      json.RawMessage    `json:"metadata,omitempty"        swaggertype:"object"` // highly dynamic
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	SentAt        *time.Time         `json:"sent_at,omitempty"`
 }
 
 type EmailNotificationRequest struct {
-	To          string            `json:"to"                     validate:"required,email"`
-	Subject     string            `json:"subject"                validate:"required"`
-	Content     string            `json:"content"                validate:"required"`
-	HTMLContent string            `json:"html_content,omitempty"`
-	CC          []string          `json:"cc,omitempty"           validate:"omitempty,dive,email"`
-	BCC         []string          `json:"bcc,omitempty"          validate:"omitempty,dive,email"`
-	Metadata    map[string]string `json:"metadata,omitempty"`
+	To             string            `json:"to"                     validate:"required,email"`
+	Subject        string            `json:"subject"                validate:"required"`
+	Content        string            `json:"content"                validate:"required"`
+	HTMLContent    string            `json:"html_content,omitempty"`
+	CC             []string          `json:"cc,omitempty"           validate:"omitempty,dive,email"`
+	BCC            []string          `json:"bcc,omitempty"          validate:"omitempty,dive,email"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	NotificationID uuid.UUID         `json:"-"` // set by the service, delivery events are matched back to the notification with it
+	FromName       string            `json:"-"` // set by the service from the store name, empty keeps the configured sender name
+	Attachments    []EmailAttachment `json:"-"` // internal callers only, the keys are not checked against the requester
+}
+
+// EmailAttachment references a file in object storage, it is streamed to SendGrid when the email is sent.
+type EmailAttachment struct {
+	Key         string `json:"key"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type,omitempty"` // defaults to the type of the stored object
 }
 
 type NotificationResponse struct {
@@ -55,9 +77,71 @@ type NotificationResponse struct {
 	CreatedAt time.Time          `json:"created_at"`
 }
 
+// NotificationFilter narrows the notifications ops look through, nil or empty fields are not applied.
+// Recipient matches the whole address, whatever its case.
+type NotificationFilter struct {
+	Status      NotificationStatus
+	Type        NotificationType
+	Recipient   string
+	BroadcastID *uuid.UUID
+	Since       *time.Time // created at or after
+	Until       *time.Time // created before
+}
+
+// NotificationSearchResponse holds a page of the filtered notifications. StatusCounts counts every
+// notification matching the filter but its status, so that a delivery breakdown is one request.
+type NotificationSearchResponse struct {
+	Notifications []*Notification            `json:"notifications"`
+	StatusCounts  map[NotificationStatus]int `json:"status_counts"`
+	Total         int                        `json:"total"`
+	Page          int                        `json:"page"`
+	PageSize      int                        `json:"page_size"`
+}
+
+// RecipientNotifications is what support looks at when a customer asks about an email: the latest
+// notifications sent to the address, with their status and error, and its suppression if any.
+type RecipientNotifications struct {
+	Recipient     string            `json:"recipient"`
+	Suppression   *EmailSuppression `json:"suppression,omitempty"`
+	Notifications []*Notification   `json:"notifications"`
+	Total         int               `json:"total"` // every notification sent to the address, only the latest are listed
+}
+
 type NotificationListResponse struct {
 	Notifications []*Notification `json:"notifications"`
 	Total         int             `json:"total"`
 	Page          int             `json:"page"`
 	PageSize      int             `json:"page_size"`
 }
+
+// SuppressionSource tells why an address is on the suppression list, no email is sent to it either way.
+type SuppressionSource string
+
+const (
+	SuppressionBounce    SuppressionSource = "bounce"
+	SuppressionComplaint SuppressionSource = "complaint"
+	SuppressionManual    SuppressionSource = "manual"
+)
+
+type EmailSuppression struct {
+	Email     string            `json:"email"`
+	Source    SuppressionSource `json:"source"`
+	Reason    string            `json:"reason,omitempty"`
+	CreatedBy *uuid.UUID        `json:"created_by,omitempty"` // the admin who added or imported it, nil for the event webhook
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type AddSuppressionRequest struct {
+	Email  string `json:"email"            validate:"required,email"`
+	Reason string `json:"reason,omitempty" validate:"max=500"`
+}
+
+// SuppressionImportResult counts the rows of an imported SendGrid export. Invalid lists the rows that
+// are not an email address, the addresses suppressed before are skipped.
+type SuppressionImportResult struct {
+	Source   SuppressionSource `json:"source"`
+	Read     int               `json:"read"`
+	Imported int               `json:"imported"`
+	Skipped  int               `json:"skipped"`
+	Invalid  []string          `json:"invalid"`
+}
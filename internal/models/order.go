@@ -1,6 +1,7 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,13 +10,43 @@ import (
 type OrderStatus string
 
 const (
-	OrderStatusPending   OrderStatus = "pending"
-	OrderStatusConfirmed OrderStatus = "confirmed"
-	OrderStatusShipping  OrderStatus = "shipping"
-	OrderStatusDelivered OrderStatus = "delivered"
-	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusPending        OrderStatus = "pending"
+	OrderStatusConfirmed      OrderStatus = "confirmed"
+	OrderStatusShipping       OrderStatus = "shipping"
+	OrderStatusDelivered      OrderStatus = "delivered"
+	OrderStatusCancelled      OrderStatus = "cancelled"
+	OrderStatusReadyForPickup OrderStatus = "ready_for_pickup" // pickup orders wait in store instead of shipping
 )
 
+// orderTransitions is the order state machine, the statuses an order can move to from each status.
+// Delivered and cancelled orders are final.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:        {OrderStatusConfirmed, OrderStatusShipping, OrderStatusReadyForPickup, OrderStatusCancelled},
+	OrderStatusConfirmed:      {OrderStatusShipping, OrderStatusReadyForPickup, OrderStatusCancelled},
+	OrderStatusShipping:       {OrderStatusDelivered},
+	OrderStatusReadyForPickup: {OrderStatusDelivered, OrderStatusCancelled},
+}
+
+// CanTransitionTo reports whether an order in status s can be moved to next.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	return slices.Contains(orderTransitions[s], next)
+}
+
+// OrderStatusesLeadingTo lists the statuses an order can be moved to next from.
+func OrderStatusesLeadingTo(next OrderStatus) []OrderStatus {
+	var statuses []OrderStatus
+
+	for status, targets := range orderTransitions {
+		if slices.Contains(targets, next) {
+			statuses = append(statuses, status)
+		}
+	}
+
+	slices.Sort(statuses)
+
+	return statuses
+}
+
 type Address struct {
 	Street     string `json:"street"      validate:"required"`
 	City       string `json:"city"        validate:"required"`
@@ -25,41 +56,216 @@ type Address struct {
 }
 
 type OrderItem struct {
-	ID        uuid.UUID `json:"id"`
-	OrderID   uuid.UUID `json:"order_id"`
-	ProductID uuid.UUID `json:"product_id" validate:"required"`
-	Quantity  int       `json:"quantity"   validate:"required,min=1"`
-	UnitPrice float64   `json:"unit_price" validate:"required,gte=0"`
-	CreatedAt time.Time `json:"created_at"`
+	ID               uuid.UUID  `json:"id"`
+	OrderID          uuid.UUID  `json:"order_id"`
+	ProductID        uuid.UUID  `json:"product_id" validate:"required"`
+	Quantity         int        `json:"quantity"   validate:"required,min=1"`
+	UnitPrice        float64    `json:"unit_price" validate:"required,gte=0"`
+	IsGift           bool       `json:"is_gift"`
+	RefundedQuantity int        `json:"refunded_quantity"`     // units given back by partial refunds, see OrderRefund
+	CampaignID       *uuid.UUID `json:"campaign_id,omitempty"` // campaign whose allocation the units were taken from
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
 type Order struct {
-	ID              uuid.UUID     `json:"id"`
-	CustomerID      uuid.UUID     `json:"customer_id"                 validate:"required"`
-	Status          OrderStatus   `json:"status"`
-	TotalAmount     float64       `json:"total_amount"`
-	PaymentStatus   PaymentStatus `json:"payment_status"`
-	PaymentIntentID string        `json:"payment_intent_id,omitempty"`
-	ShippingAddress *Address      `json:"shipping_address"            validate:"required"`
-	Items           []OrderItem   `json:"items"                       validate:"required,min=1,dive"`
-	CreatedAt       time.Time     `json:"created_at"`
-	UpdatedAt       time.Time     `json:"updated_at"`
+	ID               uuid.UUID       `json:"id"`
+	CustomerID       uuid.UUID       `json:"customer_id"                 validate:"required"`
+	Status           OrderStatus     `json:"status"`
+	TotalAmount      float64         `json:"total_amount"`
+	PaymentStatus    PaymentStatus   `json:"payment_status"`
+	PaymentIntentID  string          `json:"payment_intent_id,omitempty"` // internal, left out for customers
+	FulfillmentType  FulfillmentType `json:"fulfillment_type"`
+	PickupLocationID *uuid.UUID      `json:"pickup_location_id,omitempty"` // its address is used as the shipping address
+	ShippingAddress  *Address        `json:"shipping_address"            validate:"required"`
+	GiftWrap         bool            `json:"gift_wrap"`
+	GiftWrapFee      float64         `json:"gift_wrap_fee"`
+	GiftMessage      string          `json:"gift_message,omitempty"`
+	Items            []OrderItem     `json:"items"                       validate:"required,min=1,dive"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
 }
 
 type CreateOrderRequest struct {
-	CustomerID      uuid.UUID   `json:"customer_id"      validate:"required"`
-	Items           []OrderItem `json:"items"            validate:"required,min=1,dive"`
-	ShippingAddress Address     `json:"shipping_address" validate:"required"`
+	CustomerID       uuid.UUID          `json:"customer_id"        validate:"required"`
+	Items            []OrderItem        `json:"items"              validate:"required,min=1,dive"`
+	FulfillmentType  FulfillmentType    `json:"fulfillment_type"   validate:"omitempty,oneof=shipping pickup"`
+	PickupLocationID *uuid.UUID         `json:"pickup_location_id" validate:"required_if=FulfillmentType pickup"`
+	ShippingAddress  *Address           `json:"shipping_address"   validate:"required_unless=FulfillmentType pickup,omitempty"`
+	GiftWrap         bool               `json:"gift_wrap"`
+	GiftMessage      string             `json:"gift_message"       validate:"omitempty,max=500"`
+	Device           *DeviceFingerprint `json:"device_fingerprint" validate:"omitempty"`
+	AllowDuplicate   bool               `json:"allow_duplicate"` // places the order even though it looks like one placed moments ago
+	ClientIP         string             `json:"-"`               // set by the handler, counted by the velocity rules
+}
+
+// Redacted returns a copy of the order without the identifiers of the payment provider, the items are
+// shared with the order.
+func (o *Order) Redacted() *Order {
+	redacted := *o
+	redacted.PaymentIntentID = ""
+
+	return &redacted
+}
+
+// IsGift reports whether the order, or any of its items, is sent as a gift.
+func (o *Order) IsGift() bool {
+	if o.GiftWrap || o.GiftMessage != "" {
+		return true
+	}
+
+	for _, item := range o.Items {
+		if item.IsGift {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EditOrderRequest changes an order before it leaves the warehouse. Added items are sold at the
+// current price of the product, the items kept keep the price they were bought at.
+type EditOrderRequest struct {
+	AddItems        []EditOrderItem `json:"add_items"        validate:"omitempty,dive"`
+	RemoveItemIDs   []uuid.UUID     `json:"remove_item_ids"`
+	ShippingAddress *Address        `json:"shipping_address" validate:"omitempty"`
+}
+
+type EditOrderItem struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity"   validate:"required,min=1"`
+	IsGift    bool      `json:"is_gift"`
+}
+
+type OrderSettlement string
+
+const (
+	OrderSettlementNone   OrderSettlement = "none"   // the order was not paid yet, or its total did not change
+	OrderSettlementCharge OrderSettlement = "charge" // an additional payment for the difference
+	OrderSettlementRefund OrderSettlement = "refund" // a partial refund of the difference
+)
+
+// OrderEditResult is an edited order with the settlement of the difference of its total. PaymentID is
+// the additional payment intent, or the refund, the ClientSecret confirms the additional payment.
+type OrderEditResult struct {
+	Order         *Order          `json:"order"`
+	PreviousTotal float64         `json:"previous_total"`
+	Delta         Money           `json:"delta"`
+	Settlement    OrderSettlement `json:"settlement"`
+	PaymentID     string          `json:"payment_id,omitempty"`
+	ClientSecret  string          `json:"client_secret,omitempty"`
 }
 
 type UpdateOrderStatusRequest struct {
 	Status OrderStatus `json:"status" validate:"required,oneof=pending confirmed shipping delivered cancelled"`
 }
 
+// CarrierEvent is a carrier callback reporting the progress of the shipment of an order.
+type CarrierEvent struct {
+	OrderID uuid.UUID   `json:"order_id" validate:"required"`
+	Status  OrderStatus `json:"status"   validate:"required,oneof=shipping delivered"`
+}
+
+// BulkUpdateOrderStatusRequest moves many orders to the same status, like a fulfillment system
+// reporting a day of shipments.
+type BulkUpdateOrderStatusRequest struct {
+	OrderIDs []uuid.UUID `json:"order_ids" validate:"required,min=1,unique"`
+	Status   OrderStatus `json:"status"    validate:"required,oneof=pending confirmed shipping delivered cancelled"`
+}
+
+// BulkOrderStatusResult is the outcome for one order of a bulk status update, Code and Message say
+// why the order was left as it was.
+type BulkOrderStatusResult struct {
+	OrderID uuid.UUID `json:"order_id"`
+	Success bool      `json:"success"`
+	Code    string    `json:"code,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+type BulkUpdateOrderStatusResponse struct {
+	Status    OrderStatus             `json:"status"`
+	Succeeded int                     `json:"succeeded"`
+	Failed    int                     `json:"failed"`
+	Results   []BulkOrderStatusResult `json:"results"` // in the order of the request
+}
+
+// RetryPaymentRequest pays a pending order again after its payment failed, like PaymentRequest
+// without the amount, which is the total of the order.
+type RetryPaymentRequest struct {
+	PaymentMethod string `json:"payment_method" validate:"required"`
+	Token         string `json:"token"          validate:"required"`
+}
+
+// PaymentRetryState counts the payment retries of an order. Once the limit is reached, retries are
+// locked out until LockedUntil and the count starts over.
+type PaymentRetryState struct {
+	Retries     int        `json:"retries"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+}
+
+type RetryPaymentResponse struct {
+	OrderID      uuid.UUID  `json:"order_id"`
+	Payment      *Payment   `json:"payment"`
+	ClientSecret string     `json:"client_secret,omitempty"`
+	RetriesLeft  int        `json:"retries_left"`
+	LockedUntil  *time.Time `json:"locked_until,omitempty"` // set when this was the last retry allowed
+}
+
+// OrderTracking is what a tracking link shows of an order to whoever holds the link, so it leaves
+// out the customer, the prices, the payment and the street address.
+type OrderTracking struct {
+	OrderID         uuid.UUID       `json:"order_id"`
+	Status          OrderStatus     `json:"status"`
+	FulfillmentType FulfillmentType `json:"fulfillment_type"`
+	ItemCount       int             `json:"item_count"`
+	City            string          `json:"city,omitempty"`
+	Country         string          `json:"country,omitempty"`
+	PlacedAt        time.Time       `json:"placed_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
 type OrderResponse struct {
 	Order *Order `json:"order"`
 }
 
+// PackingSlip is the document shipped in the parcel. Prices are left out of gift orders.
+type PackingSlip struct {
+	OrderID         uuid.UUID         `json:"order_id"`
+	ShippingAddress *Address          `json:"shipping_address"`
+	GiftWrap        bool              `json:"gift_wrap"`
+	GiftMessage     string            `json:"gift_message,omitempty"`
+	Items           []PackingSlipItem `json:"items"`
+	TotalAmount     *float64          `json:"total_amount,omitempty"`
+}
+
+// PackingSlipItem is a line of a packing slip. Picks say which warehouses the units are taken from,
+// items of products not stocked per warehouse have none.
+type PackingSlipItem struct {
+	OrderItemID uuid.UUID         `json:"order_item_id"`
+	ProductID   uuid.UUID         `json:"product_id"`
+	SKU         string            `json:"sku"`
+	Name        string            `json:"name"`
+	Quantity    int               `json:"quantity"`
+	IsGift      bool              `json:"is_gift"`
+	UnitPrice   *float64          `json:"unit_price,omitempty"`
+	Picks       []PackingSlipPick `json:"picks,omitempty"`
+}
+
+// PackingSlipPick is the part of an item the stock allocation of the order takes from one warehouse.
+type PackingSlipPick struct {
+	WarehouseID   uuid.UUID `json:"warehouse_id"`
+	WarehouseName string    `json:"warehouse_name"`
+	Quantity      int       `json:"quantity"`
+}
+
+// PackingSlipsRequest asks for the packing slips of the orders of a pick wave, printed in the given order.
+type PackingSlipsRequest struct {
+	OrderIDs []uuid.UUID `json:"order_ids" validate:"required,min=1,unique"`
+}
+
+type PackingSlipsResponse struct {
+	Slips []*PackingSlip `json:"slips"`
+}
+
 type OrderHistoryResponse struct {
 	Orders []Order `json:"orders"`
 	Total  int     `json:"total"`
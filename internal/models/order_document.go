@@ -0,0 +1,55 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderDocumentType string
+
+const (
+	OrderDocumentCreditNote OrderDocumentType = "credit_note"
+)
+
+// OrderDocumentNumber formats the sequence number of a document in its numbering series, like CN-000042.
+func OrderDocumentNumber(series string, sequence int64) string {
+	return fmt.Sprintf("%s-%06d", series, sequence)
+}
+
+// OrderDocument is an accounting document issued for an order. Documents are numbered without gaps
+// per legal entity and numbering series, a credit note corrects what the customer was charged by the
+// amount refunded to them. SourceID is the refund the document was issued for, Seller is the store as
+// it was when the document was issued.
+type OrderDocument struct {
+	ID          uuid.UUID           `json:"id"`
+	OrderID     uuid.UUID           `json:"order_id"`
+	Type        OrderDocumentType   `json:"type"`
+	LegalEntity string              `json:"legal_entity"`
+	Series      string              `json:"-"`
+	Number      string              `json:"number"`
+	Seller      DocumentSeller      `json:"seller"`
+	SourceID    string              `json:"source_id"`
+	Amount      int64               `json:"amount"`
+	Currency    string              `json:"currency"`
+	Lines       []OrderDocumentLine `json:"lines"`
+	IssuedOn    string              `json:"issued_on,omitempty"` // date of CreatedAt in the language and timezone of the reader
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+// OrderDocumentLine is a line of an order document, the lines of a document add up to its amount.
+// Lines not about a single item, like the difference of an order edit, have no item.
+type OrderDocumentLine struct {
+	OrderItemID *uuid.UUID `json:"order_item_id,omitempty"`
+	Description string     `json:"description"`
+	Quantity    int        `json:"quantity,omitempty"`
+	Amount      int64      `json:"amount"`
+}
+
+// DocumentSeller is the seller printed on a document.
+type DocumentSeller struct {
+	Name    string   `json:"name"`
+	Address *Address `json:"address,omitempty"`
+	TaxIDs  []TaxID  `json:"tax_ids,omitempty"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefundOrderRequest refunds units of the items of a paid order, each line names an item and how
+// many of its units are refunded.
+type RefundOrderRequest struct {
+	Lines  []RefundOrderLine `json:"lines"  validate:"required,min=1,dive"`
+	Reason string            `json:"reason" validate:"omitempty,max=500"`
+}
+
+type RefundOrderLine struct {
+	OrderItemID uuid.UUID `json:"order_item_id" validate:"required"`
+	Quantity    int       `json:"quantity"      validate:"required,min=1"`
+}
+
+type OrderRefundStatus string
+
+const (
+	OrderRefundPending   OrderRefundStatus = "pending"   // the units are reserved, the payment provider was not asked yet
+	OrderRefundSucceeded OrderRefundStatus = "succeeded" // the money was sent back
+	OrderRefundFailed    OrderRefundStatus = "failed"    // the provider refused, the units can be refunded again
+)
+
+// OrderRefund is a partial refund of an order allocated to its lines, it is the credit note of the
+// refund. Amounts are in the smallest unit of Currency.
+type OrderRefund struct {
+	ID        uuid.UUID         `json:"id"`
+	OrderID   uuid.UUID         `json:"order_id"`
+	Status    OrderRefundStatus `json:"status"`
+	RefundID  string            `json:"refund_id,omitempty"` // internal, the refund of the payment provider
+	Amount    int64             `json:"amount"`
+	Currency  string            `json:"currency"`
+	Reason    string            `json:"reason,omitempty"`
+	Lines     []OrderRefundLine `json:"lines"`
+	CreatedBy uuid.UUID         `json:"created_by"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// OrderRefundLine is what a refund gives back for an item. AdjustmentAmount is the share of the
+// item in the charges of the order beyond its items, like the gift wrap, tax or shipping, in
+// proportion to the value of the units refunded.
+type OrderRefundLine struct {
+	OrderItemID      uuid.UUID `json:"order_item_id"`
+	Quantity         int       `json:"quantity"`
+	ItemAmount       int64     `json:"item_amount"`
+	AdjustmentAmount int64     `json:"adjustment_amount"`
+	Amount           int64     `json:"amount"`
+}
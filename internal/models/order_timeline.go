@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderTimelineEventType string
+
+const (
+	OrderTimelinePlaced        OrderTimelineEventType = "placed"
+	OrderTimelineStatusChanged OrderTimelineEventType = "status_changed"
+	OrderTimelinePayment       OrderTimelineEventType = "payment"
+	OrderTimelineRefund        OrderTimelineEventType = "refund"
+	OrderTimelineNotification  OrderTimelineEventType = "notification"
+	OrderTimelinePicked        OrderTimelineEventType = "picked" // its fulfillment wave was completed
+	OrderTimelineEdited        OrderTimelineEventType = "order_edited"
+	OrderTimelineRefunded      OrderTimelineEventType = "order_refunded"
+	OrderTimelineAnonymized    OrderTimelineEventType = "order_anonymized"
+	OrderTimelineFraudFlagged  OrderTimelineEventType = "fraud_flagged"
+	OrderTimelineFraudReviewed OrderTimelineEventType = "fraud_reviewed" // Summary is the note of the reviewer
+)
+
+// OrderTimelineEvent is an entry of the history of an order. Internal events, the warehouse work, the
+// audit log and the fraud review, are only shown to the operations side.
+type OrderTimelineEvent struct {
+	Type        OrderTimelineEventType `json:"type"`
+	OccurredAt  time.Time              `json:"occurred_at"`
+	ReferenceID string                 `json:"reference_id,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Summary     string                 `json:"summary,omitempty"`
+	Internal    bool                   `json:"internal,omitempty"`
+}
+
+type OrderTimeline struct {
+	OrderID    uuid.UUID            `json:"order_id"`
+	CustomerID uuid.UUID            `json:"customer_id"`
+	Status     OrderStatus          `json:"status"`
+	Events     []OrderTimelineEvent `json:"events"` // oldest first
+}
+
+// Redacted returns a copy of the timeline without the internal events and the identifiers of the
+// payment provider.
+func (t *OrderTimeline) Redacted() *OrderTimeline {
+	redacted := *t
+	redacted.Events = []OrderTimelineEvent{}
+
+	for _, event := range t.Events {
+		if event.Internal {
+			continue
+		}
+
+		if event.Type == OrderTimelinePayment {
+			event.ReferenceID = ""
+		}
+
+		redacted.Events = append(redacted.Events, event)
+	}
+
+	return &redacted
+}
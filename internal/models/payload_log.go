@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayloadLogRules picks the requests whose bodies are logged, redacted, to reproduce the API issues
+// customers report: those under one of the route prefixes and those made by one of the users.
+type PayloadLogRules struct {
+	Routes    []string    `json:"routes"`
+	UserIDs   []uuid.UUID `json:"user_ids"`
+	ExpiresAt *time.Time  `json:"expires_at,omitempty"` // the rules of the configuration do not expire
+}
+
+type UpdatePayloadLogRulesRequest struct {
+	Routes  []string    `json:"routes"   validate:"dive,startswith=/api/v1/"`
+	UserIDs []uuid.UUID `json:"user_ids"`
+	Minutes int         `json:"minutes"  validate:"required,min=1,max=1440"` // how long the rules hold
+}
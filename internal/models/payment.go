@@ -8,15 +8,25 @@ type Payment struct {
 	ID            string        `json:"id"`
 	CustomerID    string        `json:"customer_id"`
 	Amount        int64         `json:"amount"`
+	FeeAmount     *int64        `json:"fee_amount,omitempty"` // Stripe processing fee, known once the charge settles
+	NetAmount     *int64        `json:"net_amount,omitempty"` // Amount paid out after fees
 	Currency      string        `json:"currency"`
 	Description   string        `json:"description"`
 	Status        PaymentStatus `json:"payment_status"`
 	PaymentMethod string        `json:"payment_method"`
-	StripeID      string        `json:"stripe_id"`
+	StripeID      string        `json:"stripe_id,omitempty"` // internal, left out for customers
 	CreatedAt     time.Time     `json:"created_at"`
 	UpdatedAt     time.Time     `json:"updated_at"`
 }
 
+// Redacted returns a copy of the payment without the identifiers of the payment provider.
+func (p *Payment) Redacted() *Payment {
+	redacted := *p
+	redacted.StripeID = ""
+
+	return &redacted
+}
+
 type PaymentIntent struct {
 	ID     string  `json:"id"`
 	Amount float64 `json:"amount"`
@@ -36,6 +46,19 @@ type PaymentRequest struct {
 	Token string `json:"token" validate:"required"`
 }
 
+type CheckoutSessionRequest struct {
+	CustomerID  string `json:"customer_id" validate:"required"`
+	Amount      int64  `json:"amount"      validate:"required,gt=0"`
+	Currency    string `json:"currency"    validate:"required,lte=3"`
+	Description string `json:"description" validate:"required"`
+}
+
+type CheckoutSessionResponse struct {
+	Payment   *Payment `json:"payment"`
+	SessionID string   `json:"session_id"`
+	URL       string   `json:"url"`
+}
+
 type PaymentResponse struct {
 	Payment       *Payment `json:"payment"`
 	ClientSecret  string   `json:"client_secret,omitempty"`
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FulfillmentType string
+
+const (
+	FulfillmentShipping FulfillmentType = "shipping"
+	FulfillmentPickup   FulfillmentType = "pickup"
+)
+
+// PickupLocation is a store where customers collect their orders.
+type PickupLocation struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Address   Address   `json:"address"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// OrderPickup tracks the collection of a pickup order. The code is handed to the
+// customer once the order is ready and checked by store staff on collection.
+type OrderPickup struct {
+	OrderID          uuid.UUID  `json:"order_id"`
+	PickupLocationID uuid.UUID  `json:"pickup_location_id"`
+	Code             string     `json:"-"`
+	ReadyAt          time.Time  `json:"ready_at"`
+	PickedUpAt       *time.Time `json:"picked_up_at,omitempty"`
+}
+
+type CreatePickupLocationRequest struct {
+	Name    string  `json:"name"    validate:"required"`
+	Address Address `json:"address" validate:"required"`
+}
+
+type UpdatePickupLocationRequest struct {
+	Name    *string  `json:"name"    validate:"omitempty,min=1"`
+	Address *Address `json:"address" validate:"omitempty"`
+	Active  *bool    `json:"active"`
+}
+
+type VerifyPickupRequest struct {
+	OrderID uuid.UUID `json:"order_id" validate:"required"`
+	Code    string    `json:"code"     validate:"required,len=6,numeric"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,27 +15,62 @@ type Category struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// Product statuses. Only active products are for sale and listed, a scheduled product goes live on its
+// own at PublishAt.
+const (
+	ProductStatusDraft        = "draft"
+	ProductStatusScheduled    = "scheduled"
+	ProductStatusActive       = "active"
+	ProductStatusInactive     = "inactive"
+	ProductStatusDiscontinued = "discontinued"
+)
+
+// productTransitions is the product lifecycle, the statuses a product can move to from each status.
+// Discontinued products are final.
+var productTransitions = map[string][]string{
+	ProductStatusDraft:     {ProductStatusScheduled, ProductStatusActive, ProductStatusDiscontinued},
+	ProductStatusScheduled: {ProductStatusDraft, ProductStatusActive, ProductStatusDiscontinued},
+	ProductStatusActive:    {ProductStatusInactive, ProductStatusDiscontinued},
+	ProductStatusInactive:  {ProductStatusActive, ProductStatusDiscontinued},
+}
+
+// CanTransitionProductStatus reports whether a product in status from can be moved to next.
+func CanTransitionProductStatus(from, next string) bool {
+	return slices.Contains(productTransitions[from], next)
+}
+
 type Product struct {
-	ID            uuid.UUID `json:"id"`
-	CategoryID    uuid.UUID `json:"category_id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	Price         float64   `json:"price"`
-	StockQuantity int       `json:"stock_quantity"`
-	SKU           string    `json:"sku"`
-	Status        string    `json:"status"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	Category      *Category `json:"category,omitempty"`
+	ID              uuid.UUID        `json:"id"`
+	CategoryID      uuid.UUID        `json:"category_id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Price           float64          `json:"price"`
+	StockQuantity   int              `json:"stock_quantity"`
+	SKU             string           `json:"sku"`
+	Barcode         string           `json:"barcode,omitempty"` // GTIN (EAN-8/13, UPC-A or GTIN-14) printed on the packaging
+	Status          string           `json:"status"`
+	VendorID        *uuid.UUID       `json:"vendor_id,omitempty"`         // nil for products sold by the platform itself
+	PublishAt       *time.Time       `json:"publish_at,omitempty"`        // only set while the product is scheduled
+	MaxPerOrder     *int             `json:"max_per_order,omitempty"`     // nil sells any quantity in one order
+	MaxPerCustomer  *int             `json:"max_per_customer,omitempty"`  // nil sells any quantity to a customer
+	LimitPeriodDays *int             `json:"limit_period_days,omitempty"` // window of MaxPerCustomer, nil counts every order
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+	Category        *Category        `json:"category,omitempty"`
+	RelatedProducts []RelatedProduct `json:"related_products,omitempty"` // only populated when a single product is fetched
+	Images          []string         `json:"images,omitempty"`           // image URLs, main image first; only populated when a single product is fetched
 }
 
 type CreateProductRequest struct {
-	CategoryID    uuid.UUID `json:"category_id"           validate:"required"`
-	Name          string    `json:"name"                  validate:"required,min=3,max=200"`
-	Description   string    `json:"description,omitempty"`
-	Price         float64   `json:"price"                 validate:"required,gt=0"`
-	StockQuantity int       `json:"stock_quantity"        validate:"required,gte=0"`
-	SKU           string    `json:"sku"                   validate:"required,min=3,max=50"`
+	CategoryID    uuid.UUID  `json:"category_id"           validate:"required"`
+	Name          string     `json:"name"                  validate:"required,min=3,max=200"`
+	Description   string     `json:"description,omitempty"`
+	Price         float64    `json:"price"                 validate:"required,gt=0"`
+	StockQuantity int        `json:"stock_quantity"        validate:"required,gte=0"`
+	SKU           string     `json:"sku"                   validate:"required,min=3,max=50"`
+	Barcode       string     `json:"barcode,omitempty"     validate:"omitempty,numeric,min=8,max=14"`
+	Status        string     `json:"status,omitempty"      validate:"omitempty,oneof=draft scheduled active"` // active when empty
+	PublishAt     *time.Time `json:"publish_at,omitempty"`                                                    // required for a scheduled product
 }
 
 type UpdateProductRequest struct {
@@ -43,5 +79,244 @@ type UpdateProductRequest struct {
 	Description   *string    `json:"description,omitempty"`
 	Price         *float64   `json:"price,omitempty"          validate:"omitempty,gt=0"`
 	StockQuantity *int       `json:"stock_quantity,omitempty" validate:"omitempty,gte=0"`
-	Status        *string    `json:"status,omitempty"         validate:"omitempty,oneof=active inactive discontinued"`
+	Barcode       *string    `json:"barcode,omitempty"        validate:"omitempty,numeric,min=8,max=14"`
+	Status        *string    `json:"status,omitempty"         validate:"omitempty,oneof=draft scheduled active inactive discontinued"`
+	PublishAt     *time.Time `json:"publish_at,omitempty"` // reschedules a scheduled product
+}
+
+// SetPurchaseLimitRequest replaces the purchase limits of a product, a limit left out is lifted.
+type SetPurchaseLimitRequest struct {
+	MaxPerOrder     *int `json:"max_per_order,omitempty"     validate:"omitempty,min=1"`
+	MaxPerCustomer  *int `json:"max_per_customer,omitempty"  validate:"omitempty,min=1"`
+	LimitPeriodDays *int `json:"limit_period_days,omitempty" validate:"excluded_without=MaxPerCustomer,omitempty,min=1,max=3650"`
+}
+
+// ProductFilter narrows the catalog, nil or empty fields are not applied.
+type ProductFilter struct {
+	CategoryID *uuid.UUID
+	MinPrice   *float64
+	MaxPrice   *float64
+	Status     string
+}
+
+type CategoryFacet struct {
+	CategoryID uuid.UUID `json:"category_id"`
+	Name       string    `json:"name"`
+	Count      int       `json:"count"`
+}
+
+// PriceBucketFacet counts the products priced in [Min, Max), the last bucket has no upper bound.
+type PriceBucketFacet struct {
+	Min   float64  `json:"min"`
+	Max   *float64 `json:"max,omitempty"`
+	Count int      `json:"count"`
+}
+
+type StatusFacet struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+// ProductFacets holds the counts for the filter sidebar. Each facet ignores its own filter,
+// so that the other values of the facet keep showing how many products they would match.
+type ProductFacets struct {
+	Categories   []CategoryFacet    `json:"categories"`
+	PriceBuckets []PriceBucketFacet `json:"price_buckets"`
+	Statuses     []StatusFacet      `json:"statuses"`
+}
+
+type ProductRelationType string
+
+const (
+	ProductRelationRelated   ProductRelationType = "related"
+	ProductRelationUpsell    ProductRelationType = "upsell"
+	ProductRelationCrossSell ProductRelationType = "cross_sell"
+)
+
+// ProductRelation is a manually curated link from a product to another one, ordered by Position within its type.
+type ProductRelation struct {
+	RelatedProductID uuid.UUID           `json:"related_product_id"`
+	Type             ProductRelationType `json:"type"`
+	Position         int                 `json:"position"`
+}
+
+// RelatedProduct is a curated product as shown on the product page.
+type RelatedProduct struct {
+	ProductID uuid.UUID           `json:"product_id"`
+	Name      string              `json:"name"`
+	Price     float64             `json:"price"`
+	SKU       string              `json:"sku"`
+	Type      ProductRelationType `json:"type"`
+	Position  int                 `json:"position"`
+}
+
+type ProductRelationInput struct {
+	ProductID uuid.UUID           `json:"product_id" validate:"required"`
+	Type      ProductRelationType `json:"type"       validate:"required,oneof=related upsell cross_sell"`
+}
+
+// SetRelatedProductsRequest replaces every relation of the product, the list order is kept per type.
+type SetRelatedProductsRequest struct {
+	Relations []ProductRelationInput `json:"relations" validate:"max=50,dive"`
+}
+
+// ProductV2 serializes the product with its prices as Money, the embedded float fields are shadowed.
+type ProductV2 struct {
+	*Product
+	Price           Money              `json:"price"`
+	RelatedProducts []RelatedProductV2 `json:"related_products,omitempty"`
+}
+
+type RelatedProductV2 struct {
+	RelatedProduct
+	Price Money `json:"price"`
+}
+
+func NewProductV2(p *Product) *ProductV2 {
+	v2 := &ProductV2{Product: p, Price: NewMoney(p.Price, CatalogCurrency)}
+
+	for _, related := range p.RelatedProducts {
+		v2.RelatedProducts = append(v2.RelatedProducts, NewRelatedProductV2(related))
+	}
+
+	return v2
+}
+
+func NewRelatedProductV2(r RelatedProduct) RelatedProductV2 {
+	return RelatedProductV2{RelatedProduct: r, Price: NewMoney(r.Price, CatalogCurrency)}
+}
+
+type StockStatus string
+
+const (
+	StockStatusInStock     StockStatus = "in_stock"
+	StockStatusLowStock    StockStatus = "low_stock"
+	StockStatusOutOfStock  StockStatus = "out_of_stock"
+	StockStatusUnavailable StockStatus = "unavailable" // the product is no longer sold
+)
+
+// ProductAvailability is the lean view product pages poll, it is served from the cache.
+type ProductAvailability struct {
+	ProductID             uuid.UUID   `json:"product_id"`
+	StockStatus           StockStatus `json:"stock_status"`
+	EstimatedDispatchDate string      `json:"estimated_dispatch_date,omitempty"` // YYYY-MM-DD, only when the product can be ordered
+	Price                 float64     `json:"price"`
+}
+
+type ProductChangeOperation string
+
+const (
+	ProductChangeCreate ProductChangeOperation = "create"
+	ProductChangeUpdate ProductChangeOperation = "update"
+	ProductChangeDelete ProductChangeOperation = "delete" // the product is not for sale, or no longer
+)
+
+// ProductChange is an entry of the product change feed. Consumers refetch the product at the given
+// version, an older version than the one they hold can be ignored.
+type ProductChange struct {
+	Cursor    string                 `json:"cursor"`
+	ProductID uuid.UUID              `json:"product_id"`
+	Operation ProductChangeOperation `json:"operation"`
+	Version   int                    `json:"version"`
+	ChangedAt time.Time              `json:"changed_at"`
+}
+
+// ProductChangeFeed is a page of the change feed, NextCursor is passed as since to read on. It stays
+// the same cursor while no new changes are there.
+type ProductChangeFeed struct {
+	Changes    []ProductChange `json:"changes"`
+	NextCursor string          `json:"next_cursor"`
+	HasMore    bool            `json:"has_more"`
+}
+
+// BulkPriceUpdateRequest reprices the catalog in one go, either product by product with Prices or by a
+// percentage with Adjustment, exactly one of the two is given. Products sold by vendors are priced by
+// their vendor and are left out.
+type BulkPriceUpdateRequest struct {
+	Prices     []SKUPrice       `json:"prices,omitempty"     validate:"omitempty,max=1000,dive"`
+	Adjustment *PriceAdjustment `json:"adjustment,omitempty"`
+}
+
+type SKUPrice struct {
+	SKU   string  `json:"sku"   validate:"required"`
+	Price float64 `json:"price" validate:"required,gt=0"`
+}
+
+// PriceAdjustment changes the price of the products matching its filter by Percent, -10 takes 10% off.
+// The new prices are rounded to the cent.
+type PriceAdjustment struct {
+	Percent    float64    `json:"percent"               validate:"required,gt=-100,lte=1000"`
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	MinPrice   *float64   `json:"min_price,omitempty"   validate:"omitempty,gte=0"`
+	MaxPrice   *float64   `json:"max_price,omitempty"   validate:"omitempty,gte=0"`
+	Status     string     `json:"status,omitempty"      validate:"omitempty,oneof=draft scheduled active inactive discontinued"`
+}
+
+// Filter selects the products the adjustment applies to.
+func (a *PriceAdjustment) Filter() *ProductFilter {
+	return &ProductFilter{CategoryID: a.CategoryID, MinPrice: a.MinPrice, MaxPrice: a.MaxPrice, Status: a.Status}
+}
+
+type PriceChange struct {
+	ProductID uuid.UUID `json:"product_id"`
+	SKU       string    `json:"sku"`
+	OldPrice  float64   `json:"old_price"`
+	NewPrice  float64   `json:"new_price"`
+}
+
+// ProductQuickEdit sets the price, the stock on hand or both of the platform product with SKU.
+type ProductQuickEdit struct {
+	SKU   string   `json:"sku"`
+	Price *float64 `json:"price,omitempty"`
+	Stock *int     `json:"stock,omitempty"`
+}
+
+// ProductQuickEditChange is a quick edit resolved against its product, the Old values are the ones the
+// product had when it was read. A field the edit leaves alone keeps its old value as the new one.
+type ProductQuickEditChange struct {
+	ProductID uuid.UUID
+	SKU       string
+	OldPrice  float64
+	NewPrice  float64
+	OldStock  int
+	NewStock  int
+}
+
+type QuickEditStatus string
+
+const (
+	QuickEditUpdated   QuickEditStatus = "updated"
+	QuickEditUnchanged QuickEditStatus = "unchanged"
+	QuickEditFailed    QuickEditStatus = "failed"
+)
+
+// ProductQuickEditRow reports an edit, Index is its position in the request.
+type ProductQuickEditRow struct {
+	Index     int             `json:"index"`
+	SKU       string          `json:"sku"`
+	Status    QuickEditStatus `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	ProductID *uuid.UUID      `json:"product_id,omitempty"`
+	OldPrice  *float64        `json:"old_price,omitempty"`
+	NewPrice  *float64        `json:"new_price,omitempty"`
+	OldStock  *int            `json:"old_stock,omitempty"`
+	NewStock  *int            `json:"new_stock,omitempty"`
+}
+
+// ProductQuickEditResult reports a quick edit row by row. The edits apply all together or not at all,
+// Applied is unset when a row failed and the failed rows tell why.
+type ProductQuickEditResult struct {
+	BatchID uuid.UUID             `json:"batch_id"`
+	Applied bool                  `json:"applied"`
+	Updated int                   `json:"updated"`
+	Failed  int                   `json:"failed"`
+	Rows    []ProductQuickEditRow `json:"rows"`
+}
+
+// BulkPriceUpdateResult lists the prices that changed, products already at their new price are left out.
+// BatchID is recorded with every audit entry of the update.
+type BulkPriceUpdateResult struct {
+	BatchID uuid.UUID     `json:"batch_id"`
+	Updated int           `json:"updated"`
+	Changes []PriceChange `json:"changes"`
 }
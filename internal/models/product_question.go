@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type QuestionStatus string
+
+const (
+	QuestionStatusPending   QuestionStatus = "pending" // waits for a moderator, only shown to admins
+	QuestionStatusPublished QuestionStatus = "published"
+	QuestionStatusRejected  QuestionStatus = "rejected"
+)
+
+// Flags of a question looking like abuse, it is moderated before the others.
+const (
+	QuestionFlagBurst     = "burst"     // one of a burst of questions on the product
+	QuestionFlagDuplicate = "duplicate" // other customers asked the same about the product
+)
+
+// ProductQuestion is a question a customer asked about a product, shown on the product page once a
+// moderator published it. The store or the vendor of the product answers it. Who asked and who
+// answered is kept to the store, the page only says whether the vendor or the store answered.
+type ProductQuestion struct {
+	ID           uuid.UUID      `json:"id"`
+	ProductID    uuid.UUID      `json:"product_id"`
+	CustomerID   uuid.UUID      `json:"-"`
+	Question     string         `json:"question"`
+	Status       QuestionStatus `json:"status"`
+	Flags        []string       `json:"flags,omitempty"` // shown to admins only
+	ContentHash  string         `json:"-"`               // of the normalized question, finds duplicates
+	Answer       string         `json:"answer,omitempty"`
+	AnsweredBy   *uuid.UUID     `json:"-"`
+	AnswererRole UserRole       `json:"answerer_role,omitempty"` // vendor or admin
+	AnsweredAt   *time.Time     `json:"answered_at,omitempty"`
+	ModeratedBy  *uuid.UUID     `json:"-"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type AskQuestionRequest struct {
+	Question string `json:"question" validate:"required,min=10,max=1000"`
+}
+
+type ModerateQuestionRequest struct {
+	Status QuestionStatus `json:"status" validate:"required,oneof=published rejected"`
+}
+
+type AnswerQuestionRequest struct {
+	Answer string `json:"answer" validate:"required,min=2,max=2000"`
+}
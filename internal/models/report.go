@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// SalesSummary aggregates the succeeded payments of one currency, amounts are in the smallest currency unit.
+// Payments whose fee is not known yet are counted in PendingFeeCount and contribute their gross amount to the net.
+type SalesSummary struct {
+	Currency        string `json:"currency"`
+	PaymentCount    int    `json:"payment_count"`
+	GrossAmount     int64  `json:"gross_amount"`
+	FeeAmount       int64  `json:"fee_amount"`
+	NetAmount       int64  `json:"net_amount"`
+	PendingFeeCount int    `json:"pending_fee_count"`
+}
+
+type SalesReport struct {
+	From      time.Time      `json:"from"`
+	To        time.Time      `json:"to"`
+	Summaries []SalesSummary `json:"summaries"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RetentionPolicy string
+
+const (
+	// RetentionStaleCarts deletes the carts left untouched past their max age.
+	RetentionStaleCarts RetentionPolicy = "stale_carts"
+	// RetentionOrderPII strips the personal data of the orders past the legal retention period, the
+	// order itself and its amounts stay for the books.
+	RetentionOrderPII RetentionPolicy = "order_pii"
+)
+
+// RetentionResult is what a policy purged, or would purge in a dry run: the records older than Cutoff.
+type RetentionResult struct {
+	Policy RetentionPolicy `json:"policy"`
+	Cutoff time.Time       `json:"cutoff"`
+	Count  int             `json:"count"`
+}
+
+// RetentionReport lists the results of the enabled policies.
+type RetentionReport struct {
+	DryRun  bool              `json:"dry_run"`
+	Results []RetentionResult `json:"results"`
+}
+
+type RunRetentionRequest struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// RetentionRecord is a purged record and the user it belonged to.
+type RetentionRecord struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
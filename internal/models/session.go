@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginDevice describes where a login comes from. Country is the ISO code set by the CDN in front of
+// the service, it is empty when none is configured.
+type LoginDevice struct {
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+	Country   string `json:"country,omitempty"`
+}
+
+// Session is a login of a user, the token handed out at login carries its ID. A revoked session
+// refuses its token even before the token expires.
+type Session struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"-"`
+	LoginDevice
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Current   bool       `json:"current"` // the session of the token making the request
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProductShippingRestriction limits where a product can be shipped on top of the restrictions of the
+// store, like hazardous goods that carriers only take to some countries and never to a PO box.
+type ProductShippingRestriction struct {
+	ProductID        uuid.UUID `json:"product_id"`
+	Reason           string    `json:"reason"`                      // shown to the customer, e.g. "hazardous"
+	AllowedCountries []string  `json:"allowed_countries,omitempty"` // empty ships wherever the store ships
+	NoPOBox          bool      `json:"no_po_box"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type SetShippingRestrictionRequest struct {
+	Reason           string   `json:"reason"            validate:"required,max=100"`
+	AllowedCountries []string `json:"allowed_countries" validate:"omitempty,dive,iso3166_1_alpha2"`
+	NoPOBox          bool     `json:"no_po_box"`
+}
+
+type ShippingQuoteRequest struct {
+	ShippingAddress Address             `json:"shipping_address" validate:"required"`
+	Items           []ShippingQuoteItem `json:"items"            validate:"required,min=1,dive"`
+}
+
+type ShippingQuoteItem struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity"   validate:"required,min=1"`
+}
+
+// ShippingQuote confirms the items can be shipped to the address, with the product restrictions
+// they were checked against.
+type ShippingQuote struct {
+	ShippingAddress Address                       `json:"shipping_address"`
+	Items           []ShippingQuoteItem           `json:"items"`
+	Restrictions    []*ProductShippingRestriction `json:"restrictions,omitempty"`
+}
@@ -7,4 +7,5 @@ const (
 	PaymentStatusSucceeded PaymentStatus = "succeeded"
 	PaymentStatusFailed    PaymentStatus = "failed"
 	PaymentStatusRefunded  PaymentStatus = "refunded"
+	PaymentStatusDisputed  PaymentStatus = "disputed"
 )
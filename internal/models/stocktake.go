@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StocktakeStatus string
+
+const (
+	StocktakeOpen    StocktakeStatus = "open"
+	StocktakeApplied StocktakeStatus = "applied"
+)
+
+// Stocktake is a cycle count of the products a warehouse stocks, all of them or those of one category.
+// Counts are submitted while it is open, applying it sets the stock of the warehouse to the counts.
+type Stocktake struct {
+	ID          uuid.UUID       `json:"id"`
+	WarehouseID uuid.UUID       `json:"warehouse_id"`
+	CategoryID  *uuid.UUID      `json:"category_id,omitempty"` // nil counts every product of the warehouse
+	Status      StocktakeStatus `json:"status"`
+	CreatedBy   uuid.UUID       `json:"created_by"`
+	CreatedAt   time.Time       `json:"created_at"`
+	AppliedBy   *uuid.UUID      `json:"applied_by,omitempty"`
+	AppliedAt   *time.Time      `json:"applied_at,omitempty"`
+	Lines       []StocktakeLine `json:"lines,omitempty"` // only populated when a single stocktake is fetched
+}
+
+// StocktakeLine is a product to count, CountedQuantity is nil until it is counted. A product counted
+// again keeps the last count.
+type StocktakeLine struct {
+	ProductID       uuid.UUID  `json:"product_id"`
+	SKU             string     `json:"sku"`
+	Name            string     `json:"name"`
+	CountedQuantity *int       `json:"counted_quantity,omitempty"`
+	CountedAt       *time.Time `json:"counted_at,omitempty"`
+}
+
+type CreateStocktakeRequest struct {
+	WarehouseID uuid.UUID  `json:"warehouse_id"          validate:"required"`
+	CategoryID  *uuid.UUID `json:"category_id,omitempty"`
+}
+
+type StocktakeCount struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity"   validate:"gte=0"`
+}
+
+type SubmitStocktakeCountsRequest struct {
+	Counts []StocktakeCount `json:"counts" validate:"required,min=1,max=500,dive"`
+}
+
+// StocktakeVariance compares a count with the stock the warehouse holds on the books right now, so
+// that stock taken by orders during the count is not counted as missing. Variance is counted minus
+// book quantity, negative when units are missing.
+type StocktakeVariance struct {
+	ProductID       uuid.UUID `json:"product_id"`
+	SKU             string    `json:"sku"`
+	Name            string    `json:"name"`
+	BookQuantity    int       `json:"book_quantity"`
+	CountedQuantity int       `json:"counted_quantity"`
+	Variance        int       `json:"variance"`
+}
+
+// StocktakeVarianceReport lists the variances of the counted products, the ones that match the books
+// included. Uncounted products are left as they are when the stocktake is applied.
+type StocktakeVarianceReport struct {
+	StocktakeID uuid.UUID           `json:"stocktake_id"`
+	Counted     int                 `json:"counted"`
+	Uncounted   int                 `json:"uncounted"`
+	Variances   []StocktakeVariance `json:"variances"` // by SKU
+}
+
+// StocktakeAdjustmentReason explains the variance of a product, e.g. "damaged" or "found on the wrong shelf".
+type StocktakeAdjustmentReason struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Reason    string    `json:"reason"     validate:"required,max=500"`
+}
+
+// ApplyStocktakeRequest gives a reason for every product whose count differs from the books.
+type ApplyStocktakeRequest struct {
+	Reasons []StocktakeAdjustmentReason `json:"reasons" validate:"omitempty,max=500,dive"`
+}
+
+// StocktakeAdjustment sets the stock of a product in the warehouse from the book quantity to the count.
+type StocktakeAdjustment struct {
+	ProductID       uuid.UUID `json:"product_id"`
+	SKU             string    `json:"sku"`
+	BookQuantity    int       `json:"book_quantity"`
+	CountedQuantity int       `json:"counted_quantity"`
+	Reason          string    `json:"reason"`
+}
+
+// ApplyStocktakeResponse is the applied stocktake with the adjustments made, products matching the books
+// are left out.
+type ApplyStocktakeResponse struct {
+	Stocktake   *Stocktake            `json:"stocktake"`
+	Adjustments []StocktakeAdjustment `json:"adjustments"`
+}
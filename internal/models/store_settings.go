@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StoreSettings describe the store and the legal entity selling in it. They are printed on the
+// documents of orders and name the sender of emails. Until an admin saves them they come from the
+// configuration, UpdatedAt is then zero.
+type StoreSettings struct {
+	StoreName        string     `json:"store_name"`
+	LegalEntity      string     `json:"legal_entity"` // legal name of the seller, documents are numbered per legal entity
+	Address          *Address   `json:"address,omitempty"`
+	TaxIDs           []TaxID    `json:"tax_ids"`
+	CreditNoteSeries string     `json:"credit_note_series"` // starts the credit note numbers, like CN in CN-000042
+	DefaultCurrency  string     `json:"default_currency"`
+	DefaultLocale    string     `json:"default_locale"`
+	DefaultTimezone  string     `json:"default_timezone"` // IANA name, dates are written in it to whoever did not ask for another
+	UpdatedBy        *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// TaxID is a tax registration of the seller, like a VAT ID.
+type TaxID struct {
+	Type    string `json:"type"              validate:"required,max=20"` // e.g. vat, gst, ein
+	Value   string `json:"value"             validate:"required,max=50"`
+	Country string `json:"country,omitempty" validate:"omitempty,iso3166_1_alpha2"`
+}
+
+type UpdateStoreSettingsRequest struct {
+	StoreName        string   `json:"store_name"         validate:"required,max=100"`
+	LegalEntity      string   `json:"legal_entity"       validate:"required,max=200"`
+	Address          *Address `json:"address,omitempty"  validate:"omitempty"`
+	TaxIDs           []TaxID  `json:"tax_ids"            validate:"omitempty,max=10,dive"`
+	CreditNoteSeries string   `json:"credit_note_series" validate:"required,alphanum,max=10"`
+	DefaultCurrency  string   `json:"default_currency"   validate:"required,len=3,lowercase"`
+	DefaultLocale    string   `json:"default_locale"     validate:"required,bcp47_language_tag"`
+	DefaultTimezone  string   `json:"default_timezone"   validate:"omitempty,timezone"` // empty is UTC
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SupportSearchMatchType string
+
+const (
+	SupportSearchCustomer      SupportSearchMatchType = "customer"
+	SupportSearchOrder         SupportSearchMatchType = "order"
+	SupportSearchPayment       SupportSearchMatchType = "payment"
+	SupportSearchNotifications SupportSearchMatchType = "notifications" // every notification sent to an address
+)
+
+// SupportSearchQuery is what the search text is read as, nil or empty fields are not searched. Email and
+// Phone match the whole address, whatever its case.
+type SupportSearchQuery struct {
+	ID              *uuid.UUID // an order or a customer
+	Email           string
+	Phone           string // the recipient of SMS notifications, users have no phone number
+	PaymentIntentID string
+}
+
+// SupportSearchMatch is a record the search text points to. ID is the address of a notifications match.
+type SupportSearchMatch struct {
+	Type       SupportSearchMatchType `json:"type"`
+	ID         string                 `json:"id"`
+	MatchedOn  string                 `json:"matched_on"` // order_id, user_id, email, phone or payment_intent_id
+	CustomerID *uuid.UUID             `json:"customer_id,omitempty"`
+	Label      string                 `json:"label"`
+	CreatedAt  time.Time              `json:"created_at"` // of the latest notification for a notifications match
+	Links      map[string]string      `json:"links"`      // the admin endpoints to open next, by name
+}
+
+type SupportSearchResponse struct {
+	Query   string               `json:"query"`
+	Matches []SupportSearchMatch `json:"matches"` // latest first
+}
@@ -7,12 +7,22 @@ import (
 	"github.com/google/uuid"
 )
 
+type UserRole string
+
+const (
+	RoleCustomer UserRole = "customer"
+	RoleAdmin    UserRole = "admin"
+	RoleStaff    UserRole = "staff"
+	RoleVendor   UserRole = "vendor" // granted once the vendor of the user is approved
+)
+
 type User struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"       validate:"required"`
 	Username  string    `json:"username"   validate:"required"`
 	Email     string    `json:"email"      validate:"required"`
 	Password  string    `json:"-"`
+	Role      UserRole  `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -20,10 +30,16 @@ type User struct {
 // for registration.
 type RegisterRequest struct {
 	Email    string `json:"email"    validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
+	Password string `json:"password" validate:"required"`
 	Name     string `json:"name"     validate:"required"`
 }
 
+// ChangePasswordRequest is checked against the password policy like a registration.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password"     validate:"required"`
+}
+
 // for login.
 type LoginRequest struct {
 	Email    string `json:"email"    validate:"required,email"`
@@ -43,11 +59,35 @@ type LoginResponse struct {
 // JWT claims structure
 
 type Claims struct {
-	UserID uuid.UUID `json:"user_id"`
-	Email  string    `json:"email"`
+	UserID        uuid.UUID      `json:"user_id"`
+	Email         string         `json:"email"`
+	Role          UserRole       `json:"role,omitempty"`
+	Impersonation *Impersonation `json:"impersonation,omitempty"` // set on support tokens, clients show it as a banner
+	VendorID      *uuid.UUID     `json:"vendor_id,omitempty"`     // set on vendor tokens, scopes every vendor endpoint
+	ClientID      *uuid.UUID     `json:"client_id,omitempty"`     // set on machine client tokens, which carry no user
+	Scope         string         `json:"scope,omitempty"`         // space separated scopes of a machine client token
 	jwt.RegisteredClaims
 }
 
+// Impersonation marks a token issued to a support agent acting as the customer.
+type Impersonation struct {
+	ActorID  uuid.UUID `json:"actor_id"`
+	ReadOnly bool      `json:"read_only"`
+	Reason   string    `json:"reason"`
+}
+
+type ImpersonationRequest struct {
+	Reason          string `json:"reason"           validate:"required,max=500"`
+	WriteAccess     bool   `json:"write_access"`                                       // admins only, tokens are read-only otherwise
+	DurationMinutes int    `json:"duration_minutes" validate:"omitempty,min=1,max=60"` // defaults to 15
+}
+
+type ImpersonationResponse struct {
+	Token         string        `json:"token"`
+	ExpiresIn     int           `json:"expires_in"`
+	Impersonation Impersonation `json:"impersonation"`
+}
+
 /*
 
 Registered claims
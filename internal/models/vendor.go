@@ -0,0 +1,177 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VendorStatus string
+
+const (
+	VendorStatusPending   VendorStatus = "pending"
+	VendorStatusApproved  VendorStatus = "approved"
+	VendorStatusSuspended VendorStatus = "suspended"
+)
+
+type VendorLedgerEntryType string
+
+const (
+	VendorLedgerSale           VendorLedgerEntryType = "sale"
+	VendorLedgerCommission     VendorLedgerEntryType = "commission"
+	VendorLedgerPayout         VendorLedgerEntryType = "payout"
+	VendorLedgerPayoutReversal VendorLedgerEntryType = "payout_reversal"
+)
+
+type PayoutBatchStatus string
+
+const (
+	PayoutBatchStatusProcessing PayoutBatchStatus = "processing"
+	PayoutBatchStatusCompleted  PayoutBatchStatus = "completed"
+	PayoutBatchStatusPartial    PayoutBatchStatus = "partial" // some transfers failed, their amount is back in the balance
+	PayoutBatchStatusFailed     PayoutBatchStatus = "failed"
+)
+
+type PayoutBatchItemStatus string
+
+const (
+	PayoutBatchItemPaid   PayoutBatchItemStatus = "paid"
+	PayoutBatchItemFailed PayoutBatchItemStatus = "failed"
+)
+
+// Vendor is a third-party seller of the marketplace, owned by the user who onboarded it.
+// Only approved vendors can list products, the platform keeps CommissionRate of their sales.
+// StripeAccountID is the Stripe Connect account payouts are transferred to.
+type Vendor struct {
+	ID              uuid.UUID    `json:"id"`
+	UserID          uuid.UUID    `json:"user_id"`
+	Name            string       `json:"name"`
+	ContactEmail    string       `json:"contact_email"`
+	CommissionRate  float64      `json:"commission_rate"`
+	Status          VendorStatus `json:"status"`
+	StripeAccountID string       `json:"stripe_account_id,omitempty"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
+
+// VendorOrder is the part of an order fulfilled by one vendor, Items only holds the vendor's products.
+// The commission is fixed when the order is placed, later rate changes don't affect it.
+type VendorOrder struct {
+	ID             uuid.UUID   `json:"id"`
+	OrderID        uuid.UUID   `json:"order_id"`
+	VendorID       uuid.UUID   `json:"vendor_id"`
+	Subtotal       float64     `json:"subtotal"`
+	CommissionRate float64     `json:"commission_rate"`
+	Commission     float64     `json:"commission"`
+	Status         OrderStatus `json:"status"`
+	Items          []OrderItem `json:"items"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// VendorSales aggregates the paid sub-orders of a vendor over a period, input of the payout calculation.
+type VendorSales struct {
+	VendorID   uuid.UUID
+	GrossSales float64
+	Commission float64
+	OrderCount int
+}
+
+// VendorLedgerEntry is a movement of the balance the platform owes a vendor. Sales are credited, commissions
+// and payouts debited, so the balance is the sum of the amounts.
+type VendorLedgerEntry struct {
+	ID            uuid.UUID             `json:"id"`
+	VendorID      uuid.UUID             `json:"vendor_id"`
+	Type          VendorLedgerEntryType `json:"type"`
+	Amount        float64               `json:"amount"`
+	VendorOrderID *uuid.UUID            `json:"vendor_order_id,omitempty"`
+	PayoutBatchID *uuid.UUID            `json:"payout_batch_id,omitempty"`
+	CreatedAt     time.Time             `json:"created_at"`
+}
+
+// VendorLedgerTotal sums the ledger entries of one type.
+type VendorLedgerTotal struct {
+	Type   VendorLedgerEntryType
+	Amount float64
+	Count  int
+}
+
+// VendorBalance is what the platform currently owes a vendor.
+type VendorBalance struct {
+	VendorID        uuid.UUID
+	StripeAccountID string
+	Balance         float64
+}
+
+// VendorEarnings reports the ledger of a vendor over [From, To), Balance is the amount owed now.
+type VendorEarnings struct {
+	VendorID    uuid.UUID `json:"vendor_id"`
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	OrderCount  int       `json:"order_count"`
+	GrossSales  float64   `json:"gross_sales"`
+	Commission  float64   `json:"commission"`
+	NetEarnings float64   `json:"net_earnings"`
+	PaidOut     float64   `json:"paid_out"`
+	Balance     float64   `json:"balance"`
+}
+
+// VendorDashboard summarizes the catalog, the orders and the balance of a vendor, recent figures cover
+// the sub-orders placed since Since.
+type VendorDashboard struct {
+	VendorID         uuid.UUID    `json:"vendor_id"`
+	Status           VendorStatus `json:"status"`
+	Products         int          `json:"products"`
+	ActiveProducts   int          `json:"active_products"`
+	LowStockProducts int          `json:"low_stock_products"`
+	PendingOrders    int          `json:"pending_orders"`
+	RecentOrders     int          `json:"recent_orders"`
+	RecentSales      float64      `json:"recent_sales"`
+	Balance          float64      `json:"balance"`
+	Since            time.Time    `json:"since"`
+}
+
+// PayoutBatch is one run of vendor payouts, each item is a Stripe transfer of a vendor balance.
+type PayoutBatch struct {
+	ID          uuid.UUID         `json:"id"`
+	Status      PayoutBatchStatus `json:"status"`
+	TotalAmount float64           `json:"total_amount"` // paid out, failed transfers excluded
+	Items       []PayoutBatchItem `json:"items"`
+	CreatedAt   time.Time         `json:"created_at"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+}
+
+type PayoutBatchItem struct {
+	ID         uuid.UUID             `json:"id"`
+	BatchID    uuid.UUID             `json:"batch_id"`
+	VendorID   uuid.UUID             `json:"vendor_id"`
+	Amount     float64               `json:"amount"`
+	Status     PayoutBatchItemStatus `json:"status"`
+	TransferID string                `json:"transfer_id,omitempty"`
+	Error      string                `json:"error,omitempty"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
+
+// VendorPayout is what the platform owes a vendor for the period [PeriodStart, PeriodEnd).
+// Only sub-orders of paid orders are included, the commission is taken off the gross sales.
+type VendorPayout struct {
+	ID          uuid.UUID `json:"id"`
+	VendorID    uuid.UUID `json:"vendor_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	OrderCount  int       `json:"order_count"`
+	GrossSales  float64   `json:"gross_sales"`
+	Commission  float64   `json:"commission"`
+	NetAmount   float64   `json:"net_amount"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreateVendorRequest struct {
+	Name         string `json:"name"          validate:"required,min=2,max=200"`
+	ContactEmail string `json:"contact_email" validate:"required,email"`
+}
+
+type UpdateVendorRequest struct {
+	Status          *VendorStatus `json:"status,omitempty"            validate:"omitempty,oneof=pending approved suspended"`
+	CommissionRate  *float64      `json:"commission_rate,omitempty"   validate:"omitempty,gte=0,lt=1"`
+	StripeAccountID *string       `json:"stripe_account_id,omitempty" validate:"omitempty,startswith=acct_"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Warehouse struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Country    string    `json:"country"`
+	State      string    `json:"state"`
+	PostalCode string    `json:"postal_code"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WarehouseStock is the stock of a product held by one warehouse. The product's
+// stock_quantity is the sum over its warehouses once stock is kept per warehouse.
+type WarehouseStock struct {
+	WarehouseID uuid.UUID `json:"warehouse_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	Quantity    int       `json:"quantity"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// StockAllocation records which warehouse ships (part of) an order item, for fulfillment.
+type StockAllocation struct {
+	ID          uuid.UUID `json:"id"`
+	OrderID     uuid.UUID `json:"order_id"`
+	OrderItemID uuid.UUID `json:"order_item_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	WarehouseID uuid.UUID `json:"warehouse_id"`
+	Quantity    int       `json:"quantity"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreateWarehouseRequest struct {
+	Name       string `json:"name"        validate:"required"`
+	Country    string `json:"country"     validate:"required,iso3166_1_alpha2"`
+	State      string `json:"state"       validate:"required"`
+	PostalCode string `json:"postal_code" validate:"required"`
+}
+
+type SetWarehouseStockRequest struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity"   validate:"gte=0"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetter is a Stripe event that could not be parsed or validated, or that kept failing to
+// be processed. It is kept aside for an admin to review instead of being retried.
+type WebhookDeadLetter struct {
+	ID         uuid.UUID       `json:"id"`
+	EventID    string          `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	Payload    json.RawMessage `json:"payload"               swaggertype:"object"`
+	Reason     string          `json:"reason"`
+	CreatedAt  time.Time       `json:"created_at"`
+	ResolvedAt *time.Time      `json:"resolved_at,omitempty"`
+}
+
+// WebhookInboxEvent is a verified Stripe event, stored before it is acknowledged so that it is not lost
+// if it fails to be processed or the instance stops first. Failures counts the deliveries to the
+// workers that failed.
+type WebhookInboxEvent struct {
+	EventID   string
+	EventType string
+	Payload   json.RawMessage
+	Failures  int
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/lib/pq"
+)
+
+// paidOrders keeps the paid orders that were not cancelled since. A view takes no bind parameters, so the
+// statuses are quoted into its definition.
+var paidOrders = fmt.Sprintf("payment_status = %s AND status <> %s",
+	pq.QuoteLiteral(string(models.PaymentStatusSucceeded)), pq.QuoteLiteral(string(models.OrderStatusCancelled)))
+
+// The analytics read materialized views of the paid orders, too costly to aggregate on every request.
+// They are created by the first refresh, with their data, and refreshed concurrently afterwards so the
+// reports stay readable meanwhile; the unique indexes are what a concurrent refresh requires. Revenue is
+// summed as NUMERIC, like the other revenue reports.
+var analyticsViews = []string{
+	`CREATE MATERIALIZED VIEW IF NOT EXISTS customer_order_stats AS
+		SELECT customer_id, COUNT(*) AS orders, SUM(total_amount::numeric) AS revenue, MIN(created_at) AS first_order_at, MAX(created_at) AS last_order_at
+		FROM orders
+		WHERE ` + paidOrders + `
+		GROUP BY customer_id`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS customer_order_stats_customer_id_idx ON customer_order_stats (customer_id)`,
+	`CREATE MATERIALIZED VIEW IF NOT EXISTS monthly_cohorts AS
+		WITH paid AS (
+			SELECT customer_id, date_trunc('month', created_at) AS order_month, total_amount
+			FROM orders
+			WHERE ` + paidOrders + `
+		), cohorts AS (
+			SELECT customer_id, MIN(order_month) AS cohort_month FROM paid GROUP BY customer_id
+		)
+		SELECT c.cohort_month, p.order_month, COUNT(DISTINCT p.customer_id) AS customers, SUM(p.total_amount::numeric) AS revenue
+		FROM paid p
+		JOIN cohorts c ON c.customer_id = p.customer_id
+		GROUP BY c.cohort_month, p.order_month`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS monthly_cohorts_months_idx ON monthly_cohorts (cohort_month, order_month)`,
+	`REFRESH MATERIALIZED VIEW CONCURRENTLY customer_order_stats`,
+	`REFRESH MATERIALIZED VIEW CONCURRENTLY monthly_cohorts`,
+}
+
+type AnalyticsRepository interface {
+	RefreshViews(ctx context.Context) error
+	GetCustomerAnalytics(ctx context.Context) (*models.CustomerAnalytics, error)
+	ListCohortActivity(ctx context.Context, from, to time.Time) ([]models.CohortActivity, error)
+}
+
+type analyticsRepository struct {
+	DB *sql.DB
+}
+
+func NewAnalyticsRepo(db *sql.DB) AnalyticsRepository {
+	return &analyticsRepository{DB: db}
+}
+
+// RefreshViews creates the views missing and refreshes them. It is not bound by the database timeout,
+// the refresh takes as long as aggregating all the paid orders does.
+func (r *analyticsRepository) RefreshViews(ctx context.Context) error {
+	for _, statement := range analyticsViews {
+		if _, err := r.DB.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to refresh analytics views: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetCustomerAnalytics totals the customers, repeat customers, orders and revenue of the customer stats.
+func (r *analyticsRepository) GetCustomerAnalytics(ctx context.Context) (*models.CustomerAnalytics, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE orders > 1), COALESCE(SUM(orders), 0), COALESCE(SUM(revenue), 0)
+		FROM customer_order_stats
+	`
+
+	var analytics models.CustomerAnalytics
+
+	err := r.DB.QueryRowContext(dbCtx, query).Scan(&analytics.Customers, &analytics.RepeatCustomers, &analytics.Orders, utils.NumericAmount(&analytics.Revenue))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer analytics: %w", err)
+	}
+
+	return &analytics, nil
+}
+
+// ListCohortActivity lists the monthly activity of the cohorts of the months from (inclusive) to (exclusive),
+// ordered by cohort then month.
+func (r *analyticsRepository) ListCohortActivity(ctx context.Context, from, to time.Time) ([]models.CohortActivity, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT cohort_month, order_month, customers, revenue
+		FROM monthly_cohorts
+		WHERE cohort_month >= $1 AND cohort_month < $2
+		ORDER BY cohort_month, order_month
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cohort activity: %w", err)
+	}
+	defer rows.Close()
+
+	activity := []models.CohortActivity{}
+
+	for rows.Next() {
+		var month models.CohortActivity
+
+		if err := rows.Scan(&month.CohortMonth, &month.OrderMonth, &month.ActiveCustomers, utils.NumericAmount(&month.Revenue)); err != nil {
+			return nil, fmt.Errorf("failed to scan cohort activity: %w", err)
+		}
+
+		activity = append(activity, month)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cohort activity: %w", err)
+	}
+
+	return activity, nil
+}
@@ -0,0 +1,90 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewAnalyticsRepo(db)
+	ctx := t.Context()
+
+	t.Run("RefreshViews_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("CREATE MATERIALIZED VIEW IF NOT EXISTS customer_order_stats .*WHERE payment_status = 'succeeded' AND status <> 'cancelled'").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("CREATE UNIQUE INDEX IF NOT EXISTS customer_order_stats_customer_id_idx").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("CREATE MATERIALIZED VIEW IF NOT EXISTS monthly_cohorts .*WHERE payment_status = 'succeeded' AND status <> 'cancelled'").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("CREATE UNIQUE INDEX IF NOT EXISTS monthly_cohorts_months_idx").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("REFRESH MATERIALIZED VIEW CONCURRENTLY customer_order_stats").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("REFRESH MATERIALIZED VIEW CONCURRENTLY monthly_cohorts").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.RefreshViews(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RefreshViews_Failure_StopsAtFirstError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectExec("CREATE MATERIALIZED VIEW IF NOT EXISTS customer_order_stats").WillReturnError(dbErr)
+
+		// Act
+		err := repo.RefreshViews(ctx)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetCustomerAnalytics_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM customer_order_stats").
+			WillReturnRows(sqlmock.NewRows([]string{"customers", "repeat_customers", "orders", "revenue"}).AddRow(4, 1, 6, 300.0))
+
+		// Act
+		analytics, err := repo.GetCustomerAnalytics(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.CustomerAnalytics{Customers: 4, RepeatCustomers: 1, Orders: 6, Revenue: 300}, analytics)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListCohortActivity_Success", func(t *testing.T) {
+		// Arrange
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(1, 0, 0)
+		feb := from.AddDate(0, 1, 0)
+
+		mock.ExpectQuery("FROM monthly_cohorts").
+			WithArgs(from, to).
+			WillReturnRows(sqlmock.NewRows([]string{"cohort_month", "order_month", "customers", "revenue"}).
+				AddRow(from, from, 10, 500.0).
+				AddRow(from, feb, 4, 180.0))
+
+		// Act
+		activity, err := repo.ListCohortActivity(ctx, from, to)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.CohortActivity{
+			{CohortMonth: from, OrderMonth: from, ActiveCustomers: 10, Revenue: 500},
+			{CohortMonth: from, OrderMonth: feb, ActiveCustomers: 4, Revenue: 180},
+		}, activity)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
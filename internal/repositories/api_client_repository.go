@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type APIClientRepository interface {
+	CreateClient(ctx context.Context, client *models.APIClient) error
+	GetClientByID(ctx context.Context, id uuid.UUID) (*models.APIClient, error)
+	ListClients(ctx context.Context) ([]*models.APIClient, error)
+	RotateSecret(ctx context.Context, client *models.APIClient) error
+	RevokeClient(ctx context.Context, id uuid.UUID) error
+}
+
+type apiClientRepository struct {
+	DB *sql.DB
+}
+
+func NewAPIClientRepo(db *sql.DB) APIClientRepository {
+	return &apiClientRepository{DB: db}
+}
+
+const apiClientColumns = `id, name, scopes, secret_hash, previous_secret_hash, previous_secret_expires_at, created_by, created_at, updated_at, revoked_at`
+
+func (r *apiClientRepository) CreateClient(ctx context.Context, client *models.APIClient) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO api_clients (id, name, scopes, secret_hash, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, client.ID, client.Name, pq.Array(client.Scopes), client.SecretHash, client.CreatedBy).
+		Scan(&client.CreatedAt, &client.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert API client: %w", err)
+	}
+
+	return nil
+}
+
+// GetClientByID returns revoked clients too, it returns sql.ErrNoRows when there is no such client.
+func (r *apiClientRepository) GetClientByID(ctx context.Context, id uuid.UUID) (*models.APIClient, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	client, err := scanAPIClient(r.DB.QueryRowContext(dbCtx, `SELECT `+apiClientColumns+` FROM api_clients WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to query API client: %w", err)
+	}
+
+	return client, nil
+}
+
+func (r *apiClientRepository) ListClients(ctx context.Context) ([]*models.APIClient, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.DB.QueryContext(dbCtx, `SELECT `+apiClientColumns+` FROM api_clients ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query API clients: %w", err)
+	}
+	defer rows.Close()
+
+	clients := []*models.APIClient{}
+
+	for rows.Next() {
+		client, err := scanAPIClient(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API client: %w", err)
+		}
+
+		clients = append(clients, client)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return clients, nil
+}
+
+// RotateSecret stores the new secret and the previous one of the client, it returns sql.ErrNoRows when
+// the client does not exist or is revoked.
+func (r *apiClientRepository) RotateSecret(ctx context.Context, client *models.APIClient) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE api_clients
+		SET secret_hash = $1, previous_secret_hash = $2, previous_secret_expires_at = $3, updated_at = NOW()
+		WHERE id = $4 AND revoked_at IS NULL
+		RETURNING updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, client.SecretHash, client.PreviousSecretHash, client.PreviousSecretExpiresAt, client.ID).Scan(&client.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		return fmt.Errorf("failed to rotate API client secret: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeClient returns sql.ErrNoRows when the client does not exist or is already revoked.
+func (r *apiClientRepository) RevokeClient(ctx context.Context, id uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.DB.ExecContext(dbCtx, `UPDATE api_clients SET revoked_at = NOW(), updated_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API client: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanAPIClient(row interface{ Scan(dest ...any) error }) (*models.APIClient, error) {
+	var client models.APIClient
+
+	err := row.Scan(&client.ID, &client.Name, pq.Array(&client.Scopes), &client.SecretHash, &client.PreviousSecretHash, &client.PreviousSecretExpiresAt,
+		&client.CreatedBy, &client.CreatedAt, &client.UpdatedAt, &client.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
@@ -0,0 +1,127 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIClientRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewAPIClientRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	clientID := uuid.New()
+	adminID := uuid.New()
+	scopes := []string{models.ScopeCatalogRead, models.ScopeOrdersWrite}
+	columns := []string{"id", "name", "scopes", "secret_hash", "previous_secret_hash", "previous_secret_expires_at", "created_by", "created_at", "updated_at", "revoked_at"}
+
+	t.Run("CreateClient_Success", func(t *testing.T) {
+		// Arrange
+		client := &models.APIClient{ID: clientID, Name: "ERP", Scopes: scopes, SecretHash: "hash", CreatedBy: adminID}
+
+		mock.ExpectQuery("INSERT INTO api_clients").
+			WithArgs(clientID, "ERP", pq.Array(scopes), "hash", adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+
+		// Act
+		err := repo.CreateClient(ctx, client)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, client.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetClientByID_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM api_clients WHERE id").
+			WithArgs(clientID).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(clientID, "ERP", "{catalog:read,orders:write}", "hash", "old-hash", now, adminID, now, now, nil))
+
+		// Act
+		client, err := repo.GetClientByID(ctx, clientID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, scopes, client.Scopes)
+		assert.Equal(t, "old-hash", client.PreviousSecretHash)
+		require.NotNil(t, client.PreviousSecretExpiresAt)
+		assert.Nil(t, client.RevokedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetClientByID_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM api_clients WHERE id").
+			WithArgs(clientID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		client, err := repo.GetClientByID(ctx, clientID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, client)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListClients_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM api_clients ORDER BY created_at DESC").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(clientID, "ERP", "{catalog:read}", "hash", "", nil, adminID, now, now, now))
+
+		// Act
+		clients, err := repo.ListClients(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, clients, 1)
+		assert.NotNil(t, clients[0].RevokedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RotateSecret_Revoked", func(t *testing.T) {
+		// Arrange
+		expiresAt := now.Add(24 * time.Hour)
+		client := &models.APIClient{ID: clientID, SecretHash: "new-hash", PreviousSecretHash: "hash", PreviousSecretExpiresAt: &expiresAt}
+
+		mock.ExpectQuery("UPDATE api_clients").
+			WithArgs("new-hash", "hash", &expiresAt, clientID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		err := repo.RotateSecret(ctx, client)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RevokeClient_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("UPDATE api_clients SET revoked_at").
+			WithArgs(clientID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.RevokeClient(ctx, clientID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
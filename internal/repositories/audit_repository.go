@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+type AuditRepository interface {
+	RecordEntry(ctx context.Context, entry *models.AuditEntry) error
+	ListUserTimeline(ctx context.Context, user *models.User, page int, size int) ([]*models.TimelineEvent, int, error)
+}
+
+type auditRepository struct {
+	DB *sql.DB
+}
+
+func NewAuditRepo(db *sql.DB) AuditRepository {
+	return &auditRepository{DB: db}
+}
+
+func (r *auditRepository) RecordEntry(ctx context.Context, entry *models.AuditEntry) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	// an empty details column is NULL, not an invalid JSON document
+	var details any
+	if len(entry.Details) > 0 {
+		details = []byte(entry.Details)
+	}
+
+	query := `
+		INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING created_at
+	`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, entry.ID, entry.UserID, entry.ActorID, entry.Action, entry.ReferenceID, details).Scan(&entry.CreatedAt); err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// userTimelineQuery merges the audit log with the domain tables. $1 is the user ID and $2 the email,
+// notifications only know their recipient address. Audit entries of a change point to the changed record.
+// Refunds are dated by the last update of the payment.
+const userTimelineQuery = `
+	SELECT 'registered' AS type, u.created_at AS occurred_at, u.id::text AS reference_id, '' AS status, '' AS summary
+	FROM users u WHERE u.id = $1
+	UNION ALL
+	SELECT a.action, a.created_at, COALESCE(a.reference_id::text, a.id::text), '', COALESCE('By ' || a.actor_id::text, '')
+	FROM audit_log a WHERE a.user_id = $1
+	UNION ALL
+	SELECT 'order', o.created_at, o.id::text, o.status, 'Total ' || o.total_amount::text
+	FROM orders o WHERE o.customer_id = $1
+	UNION ALL
+	SELECT 'payment', p.created_at, p.id, p.status, COALESCE(p.description, '')
+	FROM payments p WHERE p.customer_id = $1::text
+	UNION ALL
+	SELECT 'refund', p.updated_at, p.id, p.status, COALESCE(p.description, '')
+	FROM payments p WHERE p.customer_id = $1::text AND p.status = 'refunded'
+	UNION ALL
+	SELECT 'notification', n.created_at, n.id::text, n.status, COALESCE(n.subject, '')
+	FROM notifications n WHERE n.recipient = $2
+`
+
+// ListUserTimeline returns the activity of the user, most recent first.
+func (r *auditRepository) ListUserTimeline(ctx context.Context, user *models.User, page int, size int) ([]*models.TimelineEvent, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	countQuery := `SELECT COUNT(*) FROM (` + userTimelineQuery + `) timeline`
+
+	if err := r.DB.QueryRowContext(dbCtx, countQuery, user.ID, user.Email).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count timeline events: %w", err)
+	}
+
+	offset := (page - 1) * size
+
+	query := `
+		SELECT type, occurred_at, reference_id, status, summary
+		FROM (` + userTimelineQuery + `) timeline
+		ORDER BY occurred_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, user.ID, user.Email, size, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query timeline events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.TimelineEvent{}
+
+	for rows.Next() {
+		var event models.TimelineEvent
+
+		if err := rows.Scan(&event.Type, &event.OccurredAt, &event.ReferenceID, &event.Status, &event.Summary); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan timeline event: %w", err)
+		}
+
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return events, total, nil
+}
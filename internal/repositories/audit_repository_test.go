@@ -0,0 +1,92 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewAuditRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	user := &models.User{ID: uuid.New(), Email: "user@example.com"}
+
+	t.Run("RecordEntry_Success", func(t *testing.T) {
+		// Arrange
+		entry := &models.AuditEntry{ID: uuid.New(), UserID: user.ID, Action: models.AuditActionLogin}
+
+		mock.ExpectQuery("INSERT INTO audit_log").
+			WithArgs(entry.ID, user.ID, nil, models.AuditActionLogin, nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.RecordEntry(ctx, entry)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, entry.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordEntry_WithReferenceAndDetails", func(t *testing.T) {
+		// Arrange
+		adminID, orderID := uuid.New(), uuid.New()
+		entry := &models.AuditEntry{
+			ID:          uuid.New(),
+			UserID:      user.ID,
+			ActorID:     &adminID,
+			Action:      models.AuditActionOrderEdited,
+			ReferenceID: &orderID,
+			Details:     []byte(`{"previous_total":40,"total":55}`),
+		}
+
+		mock.ExpectQuery("INSERT INTO audit_log").
+			WithArgs(entry.ID, user.ID, &adminID, models.AuditActionOrderEdited, &orderID, []byte(`{"previous_total":40,"total":55}`)).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.RecordEntry(ctx, entry)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListUserTimeline_Success", func(t *testing.T) {
+		// Arrange
+		orderID := uuid.New()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM \(`).
+			WithArgs(user.ID, user.Email).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+		mock.ExpectQuery(`ORDER BY occurred_at DESC\s+LIMIT \$3 OFFSET \$4`).
+			WithArgs(user.ID, user.Email, 2, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"type", "occurred_at", "reference_id", "status", "summary"}).
+				AddRow("order", now, orderID.String(), "pending", "Total 59.90").
+				AddRow("login", now.Add(-time.Hour), uuid.NewString(), "", ""))
+
+		// Act
+		events, total, err := repo.ListUserTimeline(ctx, user, 6, 2)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 12, total)
+		require.Len(t, events, 2)
+		assert.Equal(t, models.TimelineOrder, events[0].Type)
+		assert.Equal(t, orderID.String(), events[0].ReferenceID)
+		assert.Equal(t, models.TimelineLogin, events[1].Type)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,298 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type BroadcastRepository interface {
+	CreateBroadcast(ctx context.Context, broadcast *models.Broadcast) error
+	GetBroadcastByID(ctx context.Context, id uuid.UUID) (*models.Broadcast, error)
+	CountBroadcastRecipients(ctx context.Context, audience models.BroadcastAudience) (int, error)
+	ListBroadcastRecipients(ctx context.Context, audience models.BroadcastAudience, afterID uuid.UUID, limit int) ([]*models.BroadcastRecipient, error)
+	ClaimBroadcast(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (*models.Broadcast, error)
+	ListResumableBroadcasts(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	SaveBroadcastProgress(ctx context.Context, broadcast *models.Broadcast, leaseUntil time.Time) (models.BroadcastStatus, error)
+	FinishBroadcast(ctx context.Context, id uuid.UUID, status models.BroadcastStatus) error
+	CancelBroadcast(ctx context.Context, id uuid.UUID) error
+}
+
+type broadcastRepository struct {
+	DB *sql.DB
+}
+
+func NewBroadcastRepo(db *sql.DB) BroadcastRepository {
+	return &broadcastRepository{DB: db}
+}
+
+const broadcastColumns = `id, subject, content, audience, status, total_recipients, sent_count, failed_count, cursor, created_by, created_at, started_at, finished_at`
+
+func scanBroadcast(row interface{ Scan(dest ...any) error }) (*models.Broadcast, error) {
+	var (
+		broadcast models.Broadcast
+		audience  []byte
+	)
+
+	err := row.Scan(&broadcast.ID, &broadcast.Subject, &broadcast.Content, &audience, &broadcast.Status, &broadcast.TotalRecipients,
+		&broadcast.SentCount, &broadcast.FailedCount, &broadcast.Cursor, &broadcast.CreatedBy, &broadcast.CreatedAt, &broadcast.StartedAt, &broadcast.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(audience, &broadcast.Audience); err != nil {
+		return nil, fmt.Errorf("failed to decode broadcast audience: %w", err)
+	}
+
+	return &broadcast, nil
+}
+
+// broadcastAudienceFilter returns the condition on users u selecting the audience, its argument is bound to $argPos.
+func broadcastAudienceFilter(audience models.BroadcastAudience, argPos int) (string, []any, error) {
+	switch audience.Type {
+	case models.BroadcastAudienceAll:
+		return "TRUE", nil, nil
+	case models.BroadcastAudienceTag:
+		return fmt.Sprintf(`EXISTS (SELECT 1 FROM user_tags ut WHERE ut.user_id = u.id AND ut.tag = $%d)`, argPos), []any{audience.Tag}, nil
+	case models.BroadcastAudienceProductPurchasers:
+		if audience.ProductID == nil {
+			return "", nil, fmt.Errorf("product purchasers audience without product")
+		}
+
+		return fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM orders o JOIN order_items oi ON oi.order_id = o.id
+			WHERE o.customer_id = u.id AND oi.product_id = $%d AND o.payment_status = 'succeeded'
+		)`, argPos), []any{*audience.ProductID}, nil
+	default:
+		return "", nil, fmt.Errorf("unknown broadcast audience: %s", audience.Type)
+	}
+}
+
+func (r *broadcastRepository) CreateBroadcast(ctx context.Context, broadcast *models.Broadcast) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	audience, err := json.Marshal(broadcast.Audience)
+	if err != nil {
+		return fmt.Errorf("failed to encode broadcast audience: %w", err)
+	}
+
+	query := `
+		INSERT INTO broadcasts (id, subject, content, audience, status, total_recipients, sent_count, failed_count, cursor, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, 0, 0, $7, $8, NOW())
+		RETURNING created_at
+	`
+
+	err = r.DB.QueryRowContext(dbCtx, query, broadcast.ID, broadcast.Subject, broadcast.Content, audience, broadcast.Status,
+		broadcast.TotalRecipients, broadcast.Cursor, broadcast.CreatedBy).Scan(&broadcast.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create broadcast: %w", err)
+	}
+
+	return nil
+}
+
+func (r *broadcastRepository) GetBroadcastByID(ctx context.Context, id uuid.UUID) (*models.Broadcast, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + broadcastColumns + ` FROM broadcasts WHERE id = $1`
+
+	broadcast, err := scanBroadcast(r.DB.QueryRowContext(dbCtx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broadcast: %w", err)
+	}
+
+	return broadcast, nil
+}
+
+func (r *broadcastRepository) CountBroadcastRecipients(ctx context.Context, audience models.BroadcastAudience) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	filter, args, err := broadcastAudienceFilter(audience, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+
+	query := `SELECT COUNT(*) FROM users u WHERE ` + filter
+
+	if err := r.DB.QueryRowContext(dbCtx, query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count broadcast recipients: %w", err)
+	}
+
+	return total, nil
+}
+
+// ListBroadcastRecipients pages through the audience by user ID, so that users signing up while the
+// broadcast is running don't shift the pages.
+func (r *broadcastRepository) ListBroadcastRecipients(ctx context.Context, audience models.BroadcastAudience, afterID uuid.UUID, limit int) ([]*models.BroadcastRecipient, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	filter, args, err := broadcastAudienceFilter(audience, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT u.id, u.name, u.email
+		FROM users u
+		WHERE u.id > $1 AND ` + filter + `
+		ORDER BY u.id
+		LIMIT $2
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, append([]any{afterID, limit}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query broadcast recipients: %w", err)
+	}
+	defer rows.Close()
+
+	var recipients []*models.BroadcastRecipient
+
+	for rows.Next() {
+		var recipient models.BroadcastRecipient
+
+		if err := rows.Scan(&recipient.ID, &recipient.Name, &recipient.Email); err != nil {
+			return nil, fmt.Errorf("failed to scan broadcast recipient: %w", err)
+		}
+
+		recipients = append(recipients, &recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// ClaimBroadcast marks a pending broadcast as running, or takes over a running one whose lease expired
+// because its instance went away. sql.ErrNoRows is returned when the broadcast can't be claimed.
+func (r *broadcastRepository) ClaimBroadcast(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (*models.Broadcast, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE broadcasts SET status = 'running', started_at = COALESCE(started_at, $2), lease_until = $3
+		WHERE id = $1 AND (status = 'pending' OR (status = 'running' AND lease_until <= $2))
+		RETURNING ` + broadcastColumns
+
+	broadcast, err := scanBroadcast(r.DB.QueryRowContext(dbCtx, query, id, now, leaseUntil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim broadcast: %w", err)
+	}
+
+	return broadcast, nil
+}
+
+// ListResumableBroadcasts returns the broadcasts nobody is working on: pending ones that didn't make it
+// into the queue and running ones whose lease expired.
+func (r *broadcastRepository) ListResumableBroadcasts(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id FROM broadcasts
+		WHERE status = 'pending' OR (status = 'running' AND lease_until <= $1)
+		ORDER BY created_at
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query resumable broadcasts: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan broadcast id: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// SaveBroadcastProgress stores the cursor and counters after a batch and extends the lease. The current
+// status is returned, so that the worker notices a cancellation.
+func (r *broadcastRepository) SaveBroadcastProgress(ctx context.Context, broadcast *models.Broadcast, leaseUntil time.Time) (models.BroadcastStatus, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE broadcasts SET cursor = $2, sent_count = $3, failed_count = $4, lease_until = $5
+		WHERE id = $1
+		RETURNING status
+	`
+
+	var status models.BroadcastStatus
+
+	err := r.DB.QueryRowContext(dbCtx, query, broadcast.ID, broadcast.Cursor, broadcast.SentCount, broadcast.FailedCount, leaseUntil).Scan(&status)
+	if err != nil {
+		return "", fmt.Errorf("failed to save broadcast progress: %w", err)
+	}
+
+	return status, nil
+}
+
+func (r *broadcastRepository) FinishBroadcast(ctx context.Context, id uuid.UUID, status models.BroadcastStatus) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE broadcasts SET status = $2, finished_at = NOW(), lease_until = NULL
+		WHERE id = $1 AND status = 'running'
+	`
+
+	if _, err := r.DB.ExecContext(dbCtx, query, id, status); err != nil {
+		return fmt.Errorf("failed to finish broadcast: %w", err)
+	}
+
+	return nil
+}
+
+// CancelBroadcast stops a pending or running broadcast, sql.ErrNoRows is returned when it already finished.
+func (r *broadcastRepository) CancelBroadcast(ctx context.Context, id uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE broadcasts SET status = 'cancelled', finished_at = NOW(), lease_until = NULL
+		WHERE id = $1 AND status IN ('pending', 'running')
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel broadcast: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return fmt.Errorf("broadcast not cancellable: %s: %w", id, sql.ErrNoRows)
+	}
+
+	return nil
+}
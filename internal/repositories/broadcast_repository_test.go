@@ -0,0 +1,153 @@
+package repository_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewBroadcastRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	broadcastID := uuid.New()
+	adminID := uuid.New()
+	productID := uuid.New()
+	audience := models.BroadcastAudience{Type: models.BroadcastAudienceProductPurchasers, ProductID: &productID}
+
+	audienceJSON, err := json.Marshal(audience)
+	require.NoError(t, err)
+
+	broadcastColumns := []string{"id", "subject", "content", "audience", "status", "total_recipients", "sent_count", "failed_count", "cursor", "created_by", "created_at", "started_at", "finished_at"}
+
+	t.Run("CreateBroadcast_Success", func(t *testing.T) {
+		// Arrange
+		broadcast := &models.Broadcast{ID: broadcastID, Subject: "Hi", Content: "Sale", Audience: audience, Status: models.BroadcastStatusPending, TotalRecipients: 3, CreatedBy: adminID}
+
+		mock.ExpectQuery("INSERT INTO broadcasts").
+			WithArgs(broadcastID, "Hi", "Sale", audienceJSON, models.BroadcastStatusPending, 3, uuid.Nil, adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.CreateBroadcast(ctx, broadcast)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, broadcast.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CountBroadcastRecipients_ProductPurchasers", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM users u WHERE EXISTS \(\s*SELECT 1 FROM orders o JOIN order_items oi`).
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		// Act
+		total, err := repo.CountBroadcastRecipients(ctx, audience)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListBroadcastRecipients_Tag", func(t *testing.T) {
+		// Arrange
+		afterID := uuid.New()
+		userID := uuid.New()
+
+		mock.ExpectQuery(`FROM users u\s+WHERE u.id > \$1 AND EXISTS \(SELECT 1 FROM user_tags ut WHERE ut.user_id = u.id AND ut.tag = \$3\)`).
+			WithArgs(afterID, 50, "vip").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).AddRow(userID, "Alice", "alice@example.com"))
+
+		// Act
+		recipients, err := repo.ListBroadcastRecipients(ctx, models.BroadcastAudience{Type: models.BroadcastAudienceTag, Tag: "vip"}, afterID, 50)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, recipients, 1)
+		assert.Equal(t, userID, recipients[0].ID)
+		assert.Equal(t, "alice@example.com", recipients[0].Email)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimBroadcast_Success", func(t *testing.T) {
+		// Arrange
+		leaseUntil := now.Add(5 * time.Minute)
+
+		mock.ExpectQuery("UPDATE broadcasts SET status = 'running'").
+			WithArgs(broadcastID, now, leaseUntil).
+			WillReturnRows(sqlmock.NewRows(broadcastColumns).
+				AddRow(broadcastID, "Hi", "Sale", audienceJSON, models.BroadcastStatusRunning, 3, 1, 0, uuid.Nil, adminID, now, now, nil))
+
+		// Act
+		broadcast, err := repo.ClaimBroadcast(ctx, broadcastID, now, leaseUntil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.BroadcastStatusRunning, broadcast.Status)
+		assert.Equal(t, audience, broadcast.Audience)
+		assert.Equal(t, 1, broadcast.SentCount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimBroadcast_NotClaimable", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("UPDATE broadcasts SET status = 'running'").
+			WithArgs(broadcastID, now, now).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		broadcast, err := repo.ClaimBroadcast(ctx, broadcastID, now, now)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, broadcast)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveBroadcastProgress_ReturnsStatus", func(t *testing.T) {
+		// Arrange
+		cursor := uuid.New()
+		broadcast := &models.Broadcast{ID: broadcastID, Cursor: cursor, SentCount: 2, FailedCount: 1}
+
+		mock.ExpectQuery("UPDATE broadcasts SET cursor").
+			WithArgs(broadcastID, cursor, 2, 1, now).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(models.BroadcastStatusCancelled))
+
+		// Act
+		status, err := repo.SaveBroadcastProgress(ctx, broadcast, now)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.BroadcastStatusCancelled, status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CancelBroadcast_AlreadyFinished", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("UPDATE broadcasts SET status = 'cancelled'").
+			WithArgs(broadcastID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.CancelBroadcast(ctx, broadcastID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,377 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type CampaignRepository interface {
+	CreateCampaign(ctx context.Context, campaign *models.Campaign) error
+	GetCampaignByID(ctx context.Context, id uuid.UUID) (*models.Campaign, error)
+	ListCampaigns(ctx context.Context, status models.CampaignStatus, page, size int) ([]*models.Campaign, int, error)
+	ListConflictingProducts(ctx context.Context, productIDs []uuid.UUID, startsAt, endsAt time.Time) ([]uuid.UUID, error)
+	ListCampaignsToStart(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+	ListCampaignsToEnd(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+	StartCampaign(ctx context.Context, id uuid.UUID) error
+	EndCampaign(ctx context.Context, id uuid.UUID, status models.CampaignStatus, sold map[uuid.UUID]int) error
+}
+
+type campaignRepository struct {
+	DB *sql.DB
+}
+
+func NewCampaignRepo(db *sql.DB) CampaignRepository {
+	return &campaignRepository{DB: db}
+}
+
+func (r *campaignRepository) CreateCampaign(ctx context.Context, campaign *models.Campaign) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		INSERT INTO campaigns (id, name, starts_at, ends_at, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, query, campaign.ID, campaign.Name, campaign.StartsAt, campaign.EndsAt, campaign.Status).
+		Scan(&campaign.CreatedAt, &campaign.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert campaign: %w", err)
+	}
+
+	itemQuery := `
+		INSERT INTO campaign_items (campaign_id, product_id, campaign_price, allocation, sold)
+		VALUES ($1, $2, $3, $4, 0)
+	`
+
+	for _, item := range campaign.Items {
+		if _, err := tx.ExecContext(dbCtx, itemQuery, campaign.ID, item.ProductID, item.CampaignPrice, item.Allocation); err != nil {
+			return fmt.Errorf("failed to insert campaign item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit campaign: %w", err)
+	}
+
+	return nil
+}
+
+func (r *campaignRepository) GetCampaignByID(ctx context.Context, id uuid.UUID) (*models.Campaign, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, name, starts_at, ends_at, status, created_at, updated_at
+		FROM campaigns
+		WHERE id = $1
+	`
+
+	campaign := &models.Campaign{}
+
+	err := r.DB.QueryRowContext(dbCtx, query, id).
+		Scan(&campaign.ID, &campaign.Name, &campaign.StartsAt, &campaign.EndsAt, &campaign.Status, &campaign.CreatedAt, &campaign.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign: %w", err)
+	}
+
+	if err := r.attachItems(dbCtx, []*models.Campaign{campaign}); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// ListCampaigns returns a page of the campaigns, the latest starting first. An empty status lists them all.
+func (r *campaignRepository) ListCampaigns(ctx context.Context, status models.CampaignStatus, page, size int) ([]*models.Campaign, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM campaigns WHERE $1 = '' OR status = $1`, status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count campaigns: %w", err)
+	}
+
+	query := `
+		SELECT id, name, starts_at, ends_at, status, created_at, updated_at
+		FROM campaigns
+		WHERE $1 = '' OR status = $1
+		ORDER BY starts_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, status, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list campaigns: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns := []*models.Campaign{}
+
+	for rows.Next() {
+		campaign := &models.Campaign{}
+
+		if err := rows.Scan(&campaign.ID, &campaign.Name, &campaign.StartsAt, &campaign.EndsAt, &campaign.Status, &campaign.CreatedAt, &campaign.UpdatedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan campaign: %w", err)
+		}
+
+		campaigns = append(campaigns, campaign)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating campaigns: %w", err)
+	}
+
+	if err := r.attachItems(dbCtx, campaigns); err != nil {
+		return nil, 0, err
+	}
+
+	return campaigns, total, nil
+}
+
+// attachItems loads the items of the campaigns in a single query.
+func (r *campaignRepository) attachItems(ctx context.Context, campaigns []*models.Campaign) error {
+	if len(campaigns) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*models.Campaign, len(campaigns))
+	ids := make([]uuid.UUID, 0, len(campaigns))
+
+	for _, campaign := range campaigns {
+		campaign.Items = []models.CampaignItem{}
+		byID[campaign.ID] = campaign
+		ids = append(ids, campaign.ID)
+	}
+
+	query := `
+		SELECT campaign_id, product_id, campaign_price, original_price, allocation, sold
+		FROM campaign_items
+		WHERE campaign_id = ANY($1)
+		ORDER BY campaign_id, product_id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to list campaign items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			campaignID uuid.UUID
+			item       models.CampaignItem
+		)
+
+		if err := rows.Scan(&campaignID, &item.ProductID, &item.CampaignPrice, &item.OriginalPrice, &item.Allocation, &item.Sold); err != nil {
+			return fmt.Errorf("failed to scan campaign item: %w", err)
+		}
+
+		byID[campaignID].Items = append(byID[campaignID].Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating campaign items: %w", err)
+	}
+
+	return nil
+}
+
+// ListConflictingProducts returns the products already in a scheduled or active campaign overlapping
+// the period, a product is in one campaign at a time.
+func (r *campaignRepository) ListConflictingProducts(ctx context.Context, productIDs []uuid.UUID, startsAt, endsAt time.Time) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT ci.product_id
+		FROM campaign_items ci
+		JOIN campaigns c ON c.id = ci.campaign_id
+		WHERE ci.product_id = ANY($1) AND c.status IN ('scheduled', 'active')
+		AND c.starts_at < $3 AND c.ends_at > $2
+		ORDER BY ci.product_id
+	`
+
+	return r.queryIDs(dbCtx, "conflicting campaign products", query, pq.Array(productIDs), startsAt, endsAt)
+}
+
+// ListCampaignsToStart returns up to limit of the scheduled campaigns whose start time has come.
+func (r *campaignRepository) ListCampaignsToStart(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id FROM campaigns
+		WHERE status = 'scheduled' AND starts_at <= $1
+		ORDER BY starts_at
+		LIMIT $2
+	`
+
+	return r.queryIDs(dbCtx, "campaigns to start", query, now, limit)
+}
+
+// ListCampaignsToEnd returns up to limit of the active campaigns whose end time has come.
+func (r *campaignRepository) ListCampaignsToEnd(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id FROM campaigns
+		WHERE status = 'active' AND ends_at <= $1
+		ORDER BY ends_at
+		LIMIT $2
+	`
+
+	return r.queryIDs(dbCtx, "campaigns to end", query, now, limit)
+}
+
+func (r *campaignRepository) queryIDs(ctx context.Context, what, query string, args ...any) ([]uuid.UUID, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", what, err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", what, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating %s: %w", what, err)
+	}
+
+	return ids, nil
+}
+
+// StartCampaign makes the scheduled campaign active and sells its products at their campaign price,
+// keeping the price each had to restore it later. It returns sql.ErrNoRows when the campaign is no
+// longer scheduled, like when another instance started it first.
+func (r *campaignRepository) StartCampaign(ctx context.Context, id uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	result, err := tx.ExecContext(dbCtx, `UPDATE campaigns SET status = 'active', updated_at = NOW() WHERE id = $1 AND status = 'scheduled'`, id)
+	if err != nil {
+		return fmt.Errorf("failed to start campaign: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	query := `
+		UPDATE campaign_items ci SET original_price = products.price
+		FROM products
+		WHERE ci.campaign_id = $1 AND products.id = ci.product_id
+	`
+
+	if _, err := tx.ExecContext(dbCtx, query, id); err != nil {
+		return fmt.Errorf("failed to keep original prices: %w", err)
+	}
+
+	query = withProductChange(`
+		UPDATE products SET price = ci.campaign_price, version = products.version + 1, updated_at = NOW()
+		FROM campaign_items ci
+		WHERE ci.campaign_id = $1 AND products.id = ci.product_id
+		RETURNING products.id, products.status, products.version, products.updated_at`,
+		"id")
+
+	if _, err := tx.ExecContext(dbCtx, query, id); err != nil {
+		return fmt.Errorf("failed to set campaign prices: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit campaign start: %w", err)
+	}
+
+	return nil
+}
+
+// EndCampaign moves a scheduled or active campaign to status and records the units sold per product.
+// The prices of its products are restored, except those repriced while the campaign ran. It returns
+// sql.ErrNoRows when the campaign already ended.
+func (r *campaignRepository) EndCampaign(ctx context.Context, id uuid.UUID, status models.CampaignStatus, sold map[uuid.UUID]int) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	result, err := tx.ExecContext(dbCtx, `UPDATE campaigns SET status = $2, updated_at = NOW() WHERE id = $1 AND status IN ('scheduled', 'active')`, id, status)
+	if err != nil {
+		return fmt.Errorf("failed to end campaign: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	query := withProductChange(`
+		UPDATE products SET price = ci.original_price, version = products.version + 1, updated_at = NOW()
+		FROM campaign_items ci
+		WHERE ci.campaign_id = $1 AND products.id = ci.product_id
+		AND ci.original_price IS NOT NULL AND products.price = ci.campaign_price
+		RETURNING products.id, products.status, products.version, products.updated_at`,
+		"id")
+
+	if _, err := tx.ExecContext(dbCtx, query, id); err != nil {
+		return fmt.Errorf("failed to restore prices: %w", err)
+	}
+
+	for productID, units := range sold {
+		query := `UPDATE campaign_items SET sold = $3 WHERE campaign_id = $1 AND product_id = $2`
+
+		if _, err := tx.ExecContext(dbCtx, query, id, productID, units); err != nil {
+			return fmt.Errorf("failed to record units sold: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit campaign end: %w", err)
+	}
+
+	return nil
+}
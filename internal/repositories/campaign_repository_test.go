@@ -0,0 +1,188 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewCampaignRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	campaignID := uuid.New()
+	productID := uuid.New()
+	startsAt := now.Add(time.Hour)
+	endsAt := now.Add(3 * time.Hour)
+
+	t.Run("CreateCampaign_Success", func(t *testing.T) {
+		// Arrange
+		campaign := &models.Campaign{
+			ID:       campaignID,
+			Name:     "Spring flash sale",
+			StartsAt: startsAt,
+			EndsAt:   endsAt,
+			Status:   models.CampaignStatusScheduled,
+			Items:    []models.CampaignItem{{ProductID: productID, CampaignPrice: 7.5, Allocation: 20}},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO campaigns").
+			WithArgs(campaignID, "Spring flash sale", startsAt, endsAt, models.CampaignStatusScheduled).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+		mock.ExpectExec("INSERT INTO campaign_items").
+			WithArgs(campaignID, productID, 7.5, 20).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.CreateCampaign(ctx, campaign)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, campaign.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetCampaignByID_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM campaigns").
+			WithArgs(campaignID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "starts_at", "ends_at", "status", "created_at", "updated_at"}).
+				AddRow(campaignID, "Spring flash sale", startsAt, endsAt, models.CampaignStatusActive, now, now))
+		mock.ExpectQuery("FROM campaign_items").
+			WithArgs(pq.Array([]uuid.UUID{campaignID})).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "product_id", "campaign_price", "original_price", "allocation", "sold"}).
+				AddRow(campaignID, productID, 7.5, 10.0, 20, 0))
+
+		// Act
+		campaign, err := repo.GetCampaignByID(ctx, campaignID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.CampaignStatusActive, campaign.Status)
+		require.Len(t, campaign.Items, 1)
+		assert.Equal(t, productID, campaign.Items[0].ProductID)
+		require.NotNil(t, campaign.Items[0].OriginalPrice)
+		assert.Equal(t, 10.0, *campaign.Items[0].OriginalPrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetCampaignByID_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM campaigns").
+			WithArgs(campaignID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		campaign, err := repo.GetCampaignByID(ctx, campaignID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, campaign)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StartCampaign_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE campaigns SET status = 'active'").
+			WithArgs(campaignID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE campaign_items ci SET original_price = products.price").
+			WithArgs(campaignID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE products SET price = ci.campaign_price").
+			WithArgs(campaignID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.StartCampaign(ctx, campaignID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StartCampaign_AlreadyStarted", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE campaigns SET status = 'active'").
+			WithArgs(campaignID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.StartCampaign(ctx, campaignID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("EndCampaign_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE campaigns SET status = \\$2").
+			WithArgs(campaignID, models.CampaignStatusEnded).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE products SET price = ci.original_price").
+			WithArgs(campaignID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE campaign_items SET sold").
+			WithArgs(campaignID, productID, 18).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.EndCampaign(ctx, campaignID, models.CampaignStatusEnded, map[uuid.UUID]int{productID: 18})
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("EndCampaign_AlreadyEnded", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE campaigns SET status = \\$2").
+			WithArgs(campaignID, models.CampaignStatusCancelled).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.EndCampaign(ctx, campaignID, models.CampaignStatusCancelled, nil)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListCampaignsToStart_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("WHERE status = 'scheduled' AND starts_at <= \\$1").
+			WithArgs(now, 50).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(campaignID))
+
+		// Act
+		ids, err := repo.ListCampaignsToStart(ctx, now, 50)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{campaignID}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
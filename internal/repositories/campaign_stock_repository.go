@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// campaignStockKeyPrefix keeps the campaign counters apart from the other keys of the Redis instance.
+// A product is in one campaign at a time, so its counter is keyed by the product only; the counter is a
+// hash of the campaign it belongs to and the units left.
+const campaignStockKeyPrefix = "campaign_stock:"
+
+// seedCampaignStock creates the counters missing, ARGV being the campaign, the time to live in
+// milliseconds and the allocation of every key.
+var seedCampaignStock = redis.NewScript(`
+	for i, key in ipairs(KEYS) do
+		if redis.call('EXISTS', key) == 0 then
+			redis.call('HSET', key, 'campaign', ARGV[1], 'left', ARGV[i + 2])
+			redis.call('PEXPIRE', key, ARGV[2])
+		end
+	end
+	return 0
+`)
+
+// claimCampaignStock takes the claimed units from the counters all at once or not at all. Products
+// without a counter are not in a running campaign and are left alone. It returns {0, 0} followed by the
+// campaign every claim was taken from, empty for the products left alone, or the position of the first
+// claim the counter can't meet with the units it has left.
+var claimCampaignStock = redis.NewScript(`
+	for i, key in ipairs(KEYS) do
+		local left = redis.call('HGET', key, 'left')
+		if left and tonumber(left) < tonumber(ARGV[i]) then
+			return {i, tonumber(left)}
+		end
+	end
+	local result = {0, 0}
+	for i, key in ipairs(KEYS) do
+		local campaign = redis.call('HGET', key, 'campaign')
+		if campaign then
+			redis.call('HINCRBY', key, 'left', -tonumber(ARGV[i]))
+		else
+			campaign = ''
+		end
+		result[i + 2] = campaign
+	end
+	return result
+`)
+
+// releaseCampaignStock gives claimed units back to the counters still there and still of the campaign
+// they were taken from, ARGV holding the campaign and the units of every key.
+var releaseCampaignStock = redis.NewScript(`
+	for i, key in ipairs(KEYS) do
+		if redis.call('HGET', key, 'campaign') == ARGV[2 * i - 1] then
+			redis.call('HINCRBY', key, 'left', ARGV[2 * i])
+		end
+	end
+	return 0
+`)
+
+// CampaignStockRepository counts the units left of the allocations of the running campaigns. Checkouts
+// of every instance take from the same counters, atomically, so an allocation is never oversold.
+type CampaignStockRepository interface {
+	Seed(ctx context.Context, campaignID uuid.UUID, items []models.CampaignItem, ttl time.Duration) error
+	// Claim returns the claim that could not be met and the units left for it, nil when all were taken.
+	// The claims taken from a campaign get its ID.
+	Claim(ctx context.Context, claims []models.CampaignClaim) (*models.CampaignClaim, int, error)
+	Release(ctx context.Context, claims []models.CampaignClaim) error
+	Remaining(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	Clear(ctx context.Context, productIDs []uuid.UUID) error
+}
+
+type campaignStockRepository struct {
+	client *redis.Client
+}
+
+func NewCampaignStockRepo(client *redis.Client) CampaignStockRepository {
+	return &campaignStockRepository{client: client}
+}
+
+func campaignStockKey(productID uuid.UUID) string {
+	return campaignStockKeyPrefix + productID.String()
+}
+
+// Seed sets the counter of every item to its allocation, they expire after ttl in case the campaign
+// is never ended. A counter already there is kept, so seeding again never gives sold units back.
+func (r *campaignStockRepository) Seed(ctx context.Context, campaignID uuid.UUID, items []models.CampaignItem, ttl time.Duration) error {
+	keys := make([]string, 0, len(items))
+	args := make([]any, 0, len(items)+2)
+	args = append(args, campaignID.String(), ttl.Milliseconds())
+
+	for _, item := range items {
+		keys = append(keys, campaignStockKey(item.ProductID))
+		args = append(args, item.Allocation)
+	}
+
+	if err := seedCampaignStock.Run(ctx, r.client, keys, args...).Err(); err != nil {
+		return fmt.Errorf("failed to seed campaign stock: %w", err)
+	}
+
+	return nil
+}
+
+func (r *campaignStockRepository) Claim(ctx context.Context, claims []models.CampaignClaim) (*models.CampaignClaim, int, error) {
+	keys := make([]string, 0, len(claims))
+	quantities := make([]any, 0, len(claims))
+
+	for _, claim := range claims {
+		keys = append(keys, campaignStockKey(claim.ProductID))
+		quantities = append(quantities, claim.Quantity)
+	}
+
+	result, err := claimCampaignStock.Run(ctx, r.client, keys, quantities...).Slice()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to claim campaign stock: %w", err)
+	}
+
+	if position, _ := result[0].(int64); position != 0 {
+		left, _ := result[1].(int64)
+
+		// Lua lists start at 1
+		return &claims[position-1], int(left), nil
+	}
+
+	for i, value := range result[2:] {
+		campaign, _ := value.(string)
+		if campaign == "" {
+			continue
+		}
+
+		campaignID, err := uuid.Parse(campaign)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid campaign of the stock of product %s: %w", claims[i].ProductID, err)
+		}
+
+		claims[i].CampaignID = campaignID
+	}
+
+	return nil, 0, nil
+}
+
+// Release gives back the units of the claims taken from a campaign, the others are skipped.
+func (r *campaignStockRepository) Release(ctx context.Context, claims []models.CampaignClaim) error {
+	keys := make([]string, 0, len(claims))
+	args := make([]any, 0, 2*len(claims))
+
+	for _, claim := range claims {
+		if claim.CampaignID == uuid.Nil {
+			continue
+		}
+
+		keys = append(keys, campaignStockKey(claim.ProductID))
+		args = append(args, claim.CampaignID.String(), claim.Quantity)
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := releaseCampaignStock.Run(ctx, r.client, keys, args...).Err(); err != nil {
+		return fmt.Errorf("failed to release campaign stock: %w", err)
+	}
+
+	return nil
+}
+
+// Remaining returns the units left of the products in a running campaign, the others are left out.
+func (r *campaignStockRepository) Remaining(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	remaining := make(map[uuid.UUID]int, len(productIDs))
+
+	if len(productIDs) == 0 {
+		return remaining, nil
+	}
+
+	pipe := r.client.Pipeline()
+
+	lefts := make([]*redis.StringCmd, 0, len(productIDs))
+	for _, id := range productIDs {
+		lefts = append(lefts, pipe.HGet(ctx, campaignStockKey(id), "left"))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to get campaign stock: %w", err)
+	}
+
+	for i, left := range lefts {
+		units, err := left.Int()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid campaign stock of product %s: %w", productIDs[i], err)
+		}
+
+		remaining[productIDs[i]] = units
+	}
+
+	return remaining, nil
+}
+
+func (r *campaignStockRepository) Clear(ctx context.Context, productIDs []uuid.UUID) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(productIDs))
+	for _, id := range productIDs {
+		keys = append(keys, campaignStockKey(id))
+	}
+
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to clear campaign stock: %w", err)
+	}
+
+	return nil
+}
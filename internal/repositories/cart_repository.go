@@ -12,6 +12,8 @@ import (
 	"github.com/google/uuid"
 )
 
+// CartRepository keeps carts in Postgres with their items in a JSON column, they are not cached in Redis
+// and outlive a flush or loss of it.
 type CartRepository interface {
 	CreateCart(ctx context.Context, cart *models.Cart) error
 	GetCartByCustomerID(ctx context.Context, customerID uuid.UUID) (*models.Cart, error)
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type CatalogImportRepository interface {
+	CreateCatalogImport(ctx context.Context, catalogImport *models.CatalogImport) error
+	GetCatalogImport(ctx context.Context, id uuid.UUID) (*models.CatalogImport, error)
+	ClaimCatalogImport(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (*models.CatalogImport, error)
+	ListResumableCatalogImports(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	SaveCatalogImportBatch(ctx context.Context, catalogImport *models.CatalogImport, items []*models.CatalogImportItem, leaseUntil time.Time) error
+	FinishCatalogImport(ctx context.Context, catalogImport *models.CatalogImport) error
+	ListCatalogImportItems(ctx context.Context, importID uuid.UUID, action models.CatalogImportAction, page, size int) ([]*models.CatalogImportItem, int, error)
+}
+
+type catalogImportRepository struct {
+	DB *sql.DB
+}
+
+func NewCatalogImportRepo(db *sql.DB) CatalogImportRepository {
+	return &catalogImportRepository{DB: db}
+}
+
+const catalogImportColumns = `id, source, dry_run, update_existing, mapping, status, COALESCE(error, ''), created_count, updated_count,
+	unchanged_count, skipped_count, failed_count, created_by, created_at, started_at, finished_at`
+
+func scanCatalogImport(row interface{ Scan(dest ...any) error }) (*models.CatalogImport, error) {
+	var (
+		catalogImport models.CatalogImport
+		mapping       []byte
+	)
+
+	err := row.Scan(&catalogImport.ID, &catalogImport.Source, &catalogImport.DryRun, &catalogImport.UpdateExisting, &mapping,
+		&catalogImport.Status, &catalogImport.Error, &catalogImport.Created, &catalogImport.Updated, &catalogImport.Unchanged,
+		&catalogImport.Skipped, &catalogImport.Failed, &catalogImport.CreatedBy, &catalogImport.CreatedAt, &catalogImport.StartedAt, &catalogImport.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(mapping, &catalogImport.Mapping); err != nil {
+		return nil, fmt.Errorf("failed to decode catalog import mapping: %w", err)
+	}
+
+	return &catalogImport, nil
+}
+
+func (r *catalogImportRepository) CreateCatalogImport(ctx context.Context, catalogImport *models.CatalogImport) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	mapping, err := json.Marshal(catalogImport.Mapping)
+	if err != nil {
+		return fmt.Errorf("failed to encode catalog import mapping: %w", err)
+	}
+
+	query := `
+		INSERT INTO catalog_imports (id, source, dry_run, update_existing, mapping, status, created_count, updated_count,
+			unchanged_count, skipped_count, failed_count, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, 0, 0, 0, 0, 0, $7, NOW())
+		RETURNING created_at
+	`
+
+	err = r.DB.QueryRowContext(dbCtx, query, catalogImport.ID, catalogImport.Source, catalogImport.DryRun, catalogImport.UpdateExisting,
+		mapping, catalogImport.Status, catalogImport.CreatedBy).Scan(&catalogImport.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create catalog import: %w", err)
+	}
+
+	return nil
+}
+
+func (r *catalogImportRepository) GetCatalogImport(ctx context.Context, id uuid.UUID) (*models.CatalogImport, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + catalogImportColumns + ` FROM catalog_imports WHERE id = $1`
+
+	catalogImport, err := scanCatalogImport(r.DB.QueryRowContext(dbCtx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog import: %w", err)
+	}
+
+	return catalogImport, nil
+}
+
+// ClaimCatalogImport marks a pending import as running, or takes over a running one whose lease expired
+// because its instance went away. The report of an interrupted run is cleared, the import starts over:
+// products it already wrote are found again by SKU. sql.ErrNoRows is returned when the import can't be claimed.
+func (r *catalogImportRepository) ClaimCatalogImport(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (*models.CatalogImport, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		UPDATE catalog_imports SET status = 'running', started_at = COALESCE(started_at, $2), lease_until = $3,
+			created_count = 0, updated_count = 0, unchanged_count = 0, skipped_count = 0, failed_count = 0
+		WHERE id = $1 AND (status = 'pending' OR (status = 'running' AND lease_until <= $2))
+		RETURNING ` + catalogImportColumns
+
+	catalogImport, err := scanCatalogImport(tx.QueryRowContext(dbCtx, query, id, now, leaseUntil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim catalog import: %w", err)
+	}
+
+	if _, err := tx.ExecContext(dbCtx, `DELETE FROM catalog_import_items WHERE import_id = $1`, id); err != nil {
+		return nil, fmt.Errorf("failed to clear catalog import items: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit catalog import claim: %w", err)
+	}
+
+	return catalogImport, nil
+}
+
+// ListResumableCatalogImports returns the imports nobody is working on: pending ones that didn't make it
+// into the queue and running ones whose lease expired.
+func (r *catalogImportRepository) ListResumableCatalogImports(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id FROM catalog_imports
+		WHERE status = 'pending' OR (status = 'running' AND lease_until <= $1)
+		ORDER BY created_at
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query resumable catalog imports: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan catalog import id: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// SaveCatalogImportBatch stores a batch of the report together with the counters, and extends the lease.
+func (r *catalogImportRepository) SaveCatalogImportBatch(ctx context.Context, catalogImport *models.CatalogImport, items []*models.CatalogImportItem, leaseUntil time.Time) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		INSERT INTO catalog_import_items (id, import_id, external_id, sku, name, action, product_id, changes, error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NOW())
+		RETURNING created_at
+	`
+
+	for _, item := range items {
+		changes, err := json.Marshal(item.Changes)
+		if err != nil {
+			return fmt.Errorf("failed to encode catalog import changes: %w", err)
+		}
+
+		err = tx.QueryRowContext(dbCtx, query, item.ID, item.ImportID, item.ExternalID, item.SKU, item.Name, item.Action,
+			item.ProductID, changes, item.Error).Scan(&item.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert catalog import item: %w", err)
+		}
+	}
+
+	query = `
+		UPDATE catalog_imports SET created_count = $2, updated_count = $3, unchanged_count = $4, skipped_count = $5,
+			failed_count = $6, lease_until = $7
+		WHERE id = $1
+	`
+
+	_, err = tx.ExecContext(dbCtx, query, catalogImport.ID, catalogImport.Created, catalogImport.Updated, catalogImport.Unchanged,
+		catalogImport.Skipped, catalogImport.Failed, leaseUntil)
+	if err != nil {
+		return fmt.Errorf("failed to save catalog import progress: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit catalog import batch: %w", err)
+	}
+
+	return nil
+}
+
+// FinishCatalogImport stores the final status, error and counters of a running import.
+func (r *catalogImportRepository) FinishCatalogImport(ctx context.Context, catalogImport *models.CatalogImport) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE catalog_imports SET status = $2, error = NULLIF($3, ''), created_count = $4, updated_count = $5, unchanged_count = $6,
+			skipped_count = $7, failed_count = $8, finished_at = NOW(), lease_until = NULL
+		WHERE id = $1 AND status = 'running'
+		RETURNING finished_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, catalogImport.ID, catalogImport.Status, catalogImport.Error, catalogImport.Created,
+		catalogImport.Updated, catalogImport.Unchanged, catalogImport.Skipped, catalogImport.Failed).Scan(&catalogImport.FinishedAt)
+	if err != nil {
+		return fmt.Errorf("failed to finish catalog import: %w", err)
+	}
+
+	return nil
+}
+
+// ListCatalogImportItems pages through the report in source order, optionally only the items with the given action.
+func (r *catalogImportRepository) ListCatalogImportItems(ctx context.Context, importID uuid.UUID, action models.CatalogImportAction, page, size int) ([]*models.CatalogImportItem, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	filter := `import_id = $1 AND ($2 = '' OR action = $2)`
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM catalog_import_items WHERE `+filter, importID, action).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count catalog import items: %w", err)
+	}
+
+	query := `
+		SELECT id, import_id, external_id, sku, name, action, product_id, changes, COALESCE(error, ''), created_at
+		FROM catalog_import_items
+		WHERE ` + filter + `
+		ORDER BY created_at, id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, importID, action, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query catalog import items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.CatalogImportItem
+
+	for rows.Next() {
+		var (
+			item    models.CatalogImportItem
+			changes []byte
+		)
+
+		err := rows.Scan(&item.ID, &item.ImportID, &item.ExternalID, &item.SKU, &item.Name, &item.Action, &item.ProductID,
+			&changes, &item.Error, &item.CreatedAt)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan catalog import item: %w", err)
+		}
+
+		if err := json.Unmarshal(changes, &item.Changes); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode catalog import changes: %w", err)
+		}
+
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return items, total, nil
+}
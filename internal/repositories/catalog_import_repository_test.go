@@ -0,0 +1,154 @@
+package repository_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogImportRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewCatalogImportRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	importID := uuid.New()
+	adminID := uuid.New()
+	mapping := models.CatalogImportMapping{DefaultCategoryID: uuid.New(), SKUPrefix: "SH-", PriceMultiplier: 1, Status: "inactive"}
+
+	mappingJSON, err := json.Marshal(mapping)
+	require.NoError(t, err)
+
+	importColumns := []string{"id", "source", "dry_run", "update_existing", "mapping", "status", "error", "created_count", "updated_count",
+		"unchanged_count", "skipped_count", "failed_count", "created_by", "created_at", "started_at", "finished_at"}
+
+	t.Run("CreateCatalogImport_Success", func(t *testing.T) {
+		// Arrange
+		catalogImport := &models.CatalogImport{
+			ID: importID, Source: models.CatalogImportSourceShopify, DryRun: true, Mapping: mapping, Status: models.CatalogImportStatusPending, CreatedBy: adminID,
+		}
+
+		mock.ExpectQuery("INSERT INTO catalog_imports").
+			WithArgs(importID, models.CatalogImportSourceShopify, true, false, mappingJSON, models.CatalogImportStatusPending, adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.CreateCatalogImport(ctx, catalogImport)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, catalogImport.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimCatalogImport_ClearsPreviousReport", func(t *testing.T) {
+		// Arrange
+		leaseUntil := now.Add(10 * time.Minute)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE catalog_imports SET status = 'running'").
+			WithArgs(importID, now, leaseUntil).
+			WillReturnRows(sqlmock.NewRows(importColumns).
+				AddRow(importID, models.CatalogImportSourceShopify, true, false, mappingJSON, models.CatalogImportStatusRunning, "", 0, 0, 0, 0, 0, adminID, now, now, nil))
+		mock.ExpectExec("DELETE FROM catalog_import_items WHERE import_id = \\$1").
+			WithArgs(importID).
+			WillReturnResult(sqlmock.NewResult(0, 120))
+		mock.ExpectCommit()
+
+		// Act
+		catalogImport, err := repo.ClaimCatalogImport(ctx, importID, now, leaseUntil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.CatalogImportStatusRunning, catalogImport.Status)
+		assert.Equal(t, mapping, catalogImport.Mapping)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimCatalogImport_NotClaimable", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE catalog_imports SET status = 'running'").
+			WithArgs(importID, now, now).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		// Act
+		catalogImport, err := repo.ClaimCatalogImport(ctx, importID, now, now)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, catalogImport)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveCatalogImportBatch_Success", func(t *testing.T) {
+		// Arrange
+		productID := uuid.New()
+		catalogImport := &models.CatalogImport{ID: importID, Updated: 1, Failed: 1}
+		items := []*models.CatalogImportItem{
+			{
+				ID: uuid.New(), ImportID: importID, ExternalID: "11", SKU: "SH-MUG-1", Name: "Mug", Action: models.CatalogImportActionUpdate, ProductID: &productID,
+				Changes: []models.CatalogImportChange{{Field: "price", From: 10.0, To: 12.5}},
+			},
+			{ID: uuid.New(), ImportID: importID, ExternalID: "12", Name: "Mug - Large", Action: models.CatalogImportActionFail, Error: "variant has no SKU"},
+		}
+		leaseUntil := now.Add(10 * time.Minute)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO catalog_import_items").
+			WithArgs(items[0].ID, importID, "11", "SH-MUG-1", "Mug", models.CatalogImportActionUpdate, &productID,
+				[]byte(`[{"field":"price","from":10,"to":12.5}]`), "").
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectQuery("INSERT INTO catalog_import_items").
+			WithArgs(items[1].ID, importID, "12", "", "Mug - Large", models.CatalogImportActionFail, nil, []byte("null"), "variant has no SKU").
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectExec("UPDATE catalog_imports SET created_count").
+			WithArgs(importID, 0, 1, 0, 0, 1, leaseUntil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.SaveCatalogImportBatch(ctx, catalogImport, items, leaseUntil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, items[1].CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListCatalogImportItems_ByAction", func(t *testing.T) {
+		// Arrange
+		itemID := uuid.New()
+		productID := uuid.New()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM catalog_import_items`).
+			WithArgs(importID, models.CatalogImportActionUpdate).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+		mock.ExpectQuery("FROM catalog_import_items").
+			WithArgs(importID, models.CatalogImportActionUpdate, 10, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "import_id", "external_id", "sku", "name", "action", "product_id", "changes", "error", "created_at"}).
+				AddRow(itemID, importID, "11", "SH-MUG-1", "Mug", models.CatalogImportActionUpdate, productID, []byte(`[{"field":"stock_quantity","from":3,"to":7}]`), "", now))
+
+		// Act
+		items, total, err := repo.ListCatalogImportItems(ctx, importID, models.CatalogImportActionUpdate, 2, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 11, total)
+		require.Len(t, items, 1)
+		assert.Equal(t, &productID, items[0].ProductID)
+		assert.Equal(t, []models.CatalogImportChange{{Field: "stock_quantity", From: 3.0, To: 7.0}}, items[0].Changes)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/google/uuid"
+)
+
+// chaosProductRepository delays product reads so that concurrent orders interleave between the
+// stock check and the stock update. It is only meant for load tests that try to oversell
+// inventory, the invariants checker then reports the drift.
+type chaosProductRepository struct {
+	ProductRepository
+	delay time.Duration
+}
+
+func NewChaosProductRepo(repo ProductRepository, delay time.Duration) ProductRepository {
+	return &chaosProductRepository{ProductRepository: repo, delay: delay}
+}
+
+func (r *chaosProductRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error) {
+	product, err := r.ProductRepository.GetProductByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(r.delay):
+	}
+
+	return product, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 
 	"github.com/XSAM/otelsql"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
@@ -14,16 +15,46 @@ import (
 )
 
 type Repositories struct {
-	DB           *sql.DB
-	RedisClient  *redis.Client
-	User         UserRepository
-	Product      ProductRepository
-	Cart         CartRepository
-	Order        OrderRepository
-	Payment      PaymentRepository
-	Notification NotificationRepository
-	RateLimiter  RateLimitRepository
-	Cache        cache.Cache
+	DB              *sql.DB
+	RedisClient     *redis.Client
+	User            UserRepository
+	Product         ProductRepository
+	Cart            CartRepository
+	Order           OrderRepository
+	Payment         PaymentRepository
+	Notification    NotificationRepository
+	Inventory       InventoryRepository
+	Invariant       InvariantRepository
+	Dispute         DisputeRepository
+	Warehouse       WarehouseRepository
+	FulfillmentWave FulfillmentWaveRepository
+	Stocktake       StocktakeRepository
+	Pickup          PickupRepository
+	Webhook         WebhookDeadLetterRepository
+	WebhookInbox    WebhookInboxRepository
+	Broadcast       BroadcastRepository
+	Vendor          VendorRepository
+	CatalogImport   CatalogImportRepository
+	Shipping        ShippingRepository
+	Audit           AuditRepository
+	Session         SessionRepository
+	EmailChange     EmailChangeRepository
+	Ledger          LedgerRepository
+	OrderRefund     OrderRefundRepository
+	OrderDocument   OrderDocumentRepository
+	StoreSettings   StoreSettingsRepository
+	Analytics       AnalyticsRepository
+	APIClient       APIClientRepository
+	Campaign        CampaignRepository
+	CampaignStock   CampaignStockRepository
+	FraudReview     FraudReviewRepository
+	Velocity        VelocityRepository
+	Device          DeviceRepository
+	ProductQuestion ProductQuestionRepository
+	Retention       RetentionRepository
+	SupportSearch   SupportSearchRepository
+	RateLimiter     RateLimitRepository
+	Cache           cache.Cache
 }
 
 func New(cfg *config.Config, redisClient *redis.Client, cacheImpl cache.Cache, rateLimiter RateLimitRepository) (*Repositories, error) {
@@ -56,18 +87,55 @@ func New(cfg *config.Config, redisClient *redis.Client, cacheImpl cache.Cache, r
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	product := NewProductRepo(db)
+	if cfg.Chaos.Enabled {
+		slog.Warn("⚠️ Chaos mode enabled, product reads are delayed", slog.Duration("delay", cfg.Chaos.InventoryDelay))
+
+		product = NewChaosProductRepo(product, cfg.Chaos.InventoryDelay)
+	}
+
 	// Initialize repositories
 	return &Repositories{
-		DB:           db,
-		RedisClient:  redisClient,
-		User:         NewUserRepo(db),
-		Product:      NewProductRepo(db),
-		Cart:         NewCartRepo(db),
-		Order:        NewOrderRepository(db),
-		Payment:      NewPaymentRepository(db),
-		Notification: NewNotificationRepo(db),
-		RateLimiter:  rateLimiter,
-		Cache:        cacheImpl,
+		DB:              db,
+		RedisClient:     redisClient,
+		User:            NewUserRepo(db),
+		Product:         product,
+		Cart:            NewCartRepo(db),
+		Order:           NewOrderRepository(db),
+		Payment:         NewPaymentRepository(db),
+		Notification:    NewNotificationRepo(db),
+		Inventory:       NewInventoryRepo(db),
+		Invariant:       NewInvariantRepo(db),
+		Dispute:         NewDisputeRepo(db),
+		Warehouse:       NewWarehouseRepo(db),
+		FulfillmentWave: NewFulfillmentWaveRepo(db),
+		Stocktake:       NewStocktakeRepo(db),
+		Pickup:          NewPickupRepo(db),
+		Webhook:         NewWebhookDeadLetterRepo(db),
+		WebhookInbox:    NewWebhookInboxRepo(db),
+		Broadcast:       NewBroadcastRepo(db),
+		Vendor:          NewVendorRepo(db),
+		CatalogImport:   NewCatalogImportRepo(db),
+		Shipping:        NewShippingRepo(db),
+		Audit:           NewAuditRepo(db),
+		Session:         NewSessionRepo(db),
+		EmailChange:     NewEmailChangeRepo(db),
+		Ledger:          NewLedgerRepo(db),
+		OrderRefund:     NewOrderRefundRepo(db),
+		OrderDocument:   NewOrderDocumentRepo(db),
+		StoreSettings:   NewStoreSettingsRepo(db),
+		Analytics:       NewAnalyticsRepo(db),
+		APIClient:       NewAPIClientRepo(db),
+		Campaign:        NewCampaignRepo(db),
+		CampaignStock:   NewCampaignStockRepo(redisClient),
+		FraudReview:     NewFraudReviewRepo(db),
+		Velocity:        NewVelocityRepo(redisClient),
+		Device:          NewDeviceRepo(db),
+		ProductQuestion: NewProductQuestionRepo(db),
+		Retention:       NewRetentionRepo(db),
+		SupportSearch:   NewSupportSearchRepo(db),
+		RateLimiter:     rateLimiter,
+		Cache:           cacheImpl,
 	}, nil
 }
 
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type DeviceRepository interface {
+	SaveOrderDevice(ctx context.Context, device *models.OrderDevice) error
+	GetOrderDevice(ctx context.Context, orderID uuid.UUID) (*models.OrderDevice, error)
+	ListLinkedAccounts(ctx context.Context, fingerprint string, page, size int) ([]*models.LinkedAccount, int, error)
+}
+
+type deviceRepository struct {
+	DB *sql.DB
+}
+
+func NewDeviceRepo(db *sql.DB) DeviceRepository {
+	return &deviceRepository{DB: db}
+}
+
+func (r *deviceRepository) SaveOrderDevice(ctx context.Context, device *models.OrderDevice) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	components, err := json.Marshal(device.Components)
+	if err != nil {
+		return fmt.Errorf("failed to encode device components: %w", err)
+	}
+
+	query := `
+		INSERT INTO order_devices (order_id, customer_id, fingerprint, components, ip_address, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	err = r.DB.QueryRowContext(dbCtx, query, device.OrderID, device.CustomerID, device.Fingerprint, components, device.IPAddress).Scan(&device.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save order device: %w", err)
+	}
+
+	return nil
+}
+
+func (r *deviceRepository) GetOrderDevice(ctx context.Context, orderID uuid.UUID) (*models.OrderDevice, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT order_id, customer_id, fingerprint, components, ip_address, created_at
+		FROM order_devices
+		WHERE order_id = $1
+	`
+
+	var (
+		device     models.OrderDevice
+		components []byte
+	)
+
+	err := r.DB.QueryRowContext(dbCtx, query, orderID).Scan(&device.OrderID, &device.CustomerID, &device.Fingerprint, &components, &device.IPAddress, &device.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order device: %w", err)
+	}
+
+	if err := json.Unmarshal(components, &device.Components); err != nil {
+		return nil, fmt.Errorf("failed to decode device components: %w", err)
+	}
+
+	return &device, nil
+}
+
+// ListLinkedAccounts returns the accounts that placed orders from the device, the most recently seen first.
+func (r *deviceRepository) ListLinkedAccounts(ctx context.Context, fingerprint string, page, size int) ([]*models.LinkedAccount, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(DISTINCT customer_id) FROM order_devices WHERE fingerprint = $1`, fingerprint).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count linked accounts: %w", err)
+	}
+
+	query := `
+		SELECT d.customer_id, u.email, COUNT(*), MIN(d.created_at), MAX(d.created_at)
+		FROM order_devices d
+		JOIN users u ON u.id = d.customer_id
+		WHERE d.fingerprint = $1
+		GROUP BY d.customer_id, u.email
+		ORDER BY MAX(d.created_at) DESC, d.customer_id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, fingerprint, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list linked accounts: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := []*models.LinkedAccount{}
+
+	for rows.Next() {
+		account := &models.LinkedAccount{}
+		if err := rows.Scan(&account.CustomerID, &account.Email, &account.Orders, &account.FirstSeen, &account.LastSeen); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan linked account: %w", err)
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating linked accounts: %w", err)
+	}
+
+	return accounts, total, nil
+}
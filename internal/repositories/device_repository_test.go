@@ -0,0 +1,106 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewDeviceRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	orderID := uuid.New()
+	customerID := uuid.New()
+
+	t.Run("SaveOrderDevice_Success", func(t *testing.T) {
+		// Arrange
+		device := &models.OrderDevice{
+			OrderID:     orderID,
+			CustomerID:  customerID,
+			Fingerprint: "fp_123",
+			Components:  map[string]any{"timezone": "Europe/Berlin"},
+			IPAddress:   "203.0.113.7",
+		}
+
+		mock.ExpectQuery("INSERT INTO order_devices").
+			WithArgs(orderID, customerID, "fp_123", []byte(`{"timezone":"Europe/Berlin"}`), "203.0.113.7").
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.SaveOrderDevice(ctx, device)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, device.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetOrderDevice_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT (.+) FROM order_devices").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"order_id", "customer_id", "fingerprint", "components", "ip_address", "created_at"}).
+				AddRow(orderID, customerID, "fp_123", []byte(`{"timezone":"Europe/Berlin"}`), "203.0.113.7", now))
+
+		// Act
+		device, err := repo.GetOrderDevice(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "fp_123", device.Fingerprint)
+		assert.Equal(t, "Europe/Berlin", device.Components["timezone"])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetOrderDevice_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT (.+) FROM order_devices").
+			WithArgs(orderID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		device, err := repo.GetOrderDevice(ctx, orderID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, device)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListLinkedAccounts_Success", func(t *testing.T) {
+		// Arrange
+		otherID := uuid.New()
+
+		mock.ExpectQuery("SELECT COUNT\\(DISTINCT customer_id\\) FROM order_devices").
+			WithArgs("fp_123").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery("SELECT (.+) FROM order_devices d JOIN users u").
+			WithArgs("fp_123", 20, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"customer_id", "email", "count", "min", "max"}).
+				AddRow(customerID, "first@example.com", 3, now.Add(-time.Hour), now).
+				AddRow(otherID, "second@example.com", 1, now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+
+		// Act
+		accounts, total, err := repo.ListLinkedAccounts(ctx, "fp_123", 1, 20)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, accounts, 2)
+		assert.Equal(t, customerID, accounts[0].CustomerID)
+		assert.Equal(t, 3, accounts[0].Orders)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
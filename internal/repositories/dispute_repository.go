@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type DisputeRepository interface {
+	UpsertDispute(ctx context.Context, dispute *models.Dispute) error
+	ListDisputes(ctx context.Context, page, size int) ([]*models.Dispute, int, error)
+}
+
+type disputeRepository struct {
+	DB *sql.DB
+}
+
+func NewDisputeRepo(db *sql.DB) DisputeRepository {
+	return &disputeRepository{DB: db}
+}
+
+// UpsertDispute stores the latest state of a dispute, linking it to the order paid by the disputed payment intent.
+func (r *disputeRepository) UpsertDispute(ctx context.Context, dispute *models.Dispute) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO disputes (id, payment_id, order_id, charge_id, amount, currency, reason, status, evidence_due_by, created_at, updated_at)
+		VALUES ($1, $2, (SELECT id FROM orders WHERE payment_intent_id = $2 LIMIT 1), $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		ON CONFLICT (id) DO UPDATE
+		SET status = EXCLUDED.status, reason = EXCLUDED.reason, evidence_due_by = EXCLUDED.evidence_due_by, updated_at = NOW()
+		RETURNING order_id, created_at, updated_at
+	`
+
+	var orderID uuid.NullUUID
+
+	err := r.DB.QueryRowContext(dbCtx, query, dispute.ID, dispute.PaymentID, dispute.ChargeID, dispute.Amount, dispute.Currency, dispute.Reason, dispute.Status, dispute.EvidenceDueBy).Scan(&orderID, &dispute.CreatedAt, &dispute.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert dispute: %w", err)
+	}
+
+	if orderID.Valid {
+		dispute.OrderID = &orderID.UUID
+	}
+
+	return nil
+}
+
+// ListDisputes returns the disputes with the closest evidence deadline first.
+func (r *disputeRepository) ListDisputes(ctx context.Context, page, size int) ([]*models.Dispute, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	countQuery := `SELECT COUNT(*) FROM disputes`
+
+	if err := r.DB.QueryRowContext(dbCtx, countQuery).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count disputes: %w", err)
+	}
+
+	offset := (page - 1) * size
+
+	query := `
+		SELECT id, payment_id, order_id, charge_id, amount, currency, reason, status, evidence_due_by, created_at, updated_at
+		FROM disputes
+		ORDER BY evidence_due_by ASC NULLS LAST, created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, size, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list disputes: %w", err)
+	}
+	defer rows.Close()
+
+	var disputes []*models.Dispute
+
+	for rows.Next() {
+		dispute := &models.Dispute{}
+
+		var (
+			orderID       uuid.NullUUID
+			evidenceDueBy sql.NullTime
+		)
+
+		err := rows.Scan(&dispute.ID, &dispute.PaymentID, &orderID, &dispute.ChargeID, &dispute.Amount, &dispute.Currency, &dispute.Reason, &dispute.Status, &evidenceDueBy, &dispute.CreatedAt, &dispute.UpdatedAt)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan dispute: %w", err)
+		}
+
+		if orderID.Valid {
+			dispute.OrderID = &orderID.UUID
+		}
+
+		if evidenceDueBy.Valid {
+			dispute.EvidenceDueBy = &evidenceDueBy.Time
+		}
+
+		disputes = append(disputes, dispute)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating disputes: %w", err)
+	}
+
+	return disputes, total, nil
+}
@@ -0,0 +1,109 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisputeRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewDisputeRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+
+	t.Run("UpsertDispute_Success", func(t *testing.T) {
+		// Arrange
+		orderID := uuid.New()
+		dueBy := now.Add(7 * 24 * time.Hour)
+		dispute := &models.Dispute{
+			ID:            "dp_123",
+			PaymentID:     "pi_123",
+			ChargeID:      "ch_123",
+			Amount:        5000,
+			Currency:      "usd",
+			Reason:        "fraudulent",
+			Status:        "needs_response",
+			EvidenceDueBy: &dueBy,
+		}
+
+		mock.ExpectQuery("INSERT INTO disputes").
+			WithArgs(dispute.ID, dispute.PaymentID, dispute.ChargeID, dispute.Amount, dispute.Currency, dispute.Reason, dispute.Status, dispute.EvidenceDueBy).
+			WillReturnRows(sqlmock.NewRows([]string{"order_id", "created_at", "updated_at"}).AddRow(orderID, now, now))
+
+		// Act
+		err := repo.UpsertDispute(ctx, dispute)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, dispute.OrderID)
+		assert.Equal(t, orderID, *dispute.OrderID)
+		assert.Equal(t, now, dispute.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpsertDispute_QueryError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectQuery("INSERT INTO disputes").WillReturnError(dbErr)
+
+		// Act
+		err := repo.UpsertDispute(ctx, &models.Dispute{ID: "dp_123"})
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDisputes_Success", func(t *testing.T) {
+		// Arrange
+		orderID := uuid.New()
+
+		mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery("FROM disputes").
+			WithArgs(10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "payment_id", "order_id", "charge_id", "amount", "currency", "reason", "status", "evidence_due_by", "created_at", "updated_at",
+			}).
+				AddRow("dp_1", "pi_1", orderID, "ch_1", 5000, "usd", "fraudulent", "needs_response", now, now, now).
+				AddRow("dp_2", "pi_2", nil, "ch_2", 1000, "usd", "general", "lost", nil, now, now))
+
+		// Act
+		disputes, total, err := repo.ListDisputes(ctx, 1, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, disputes, 2)
+		assert.Equal(t, orderID, *disputes[0].OrderID)
+		assert.NotNil(t, disputes[0].EvidenceDueBy)
+		assert.Nil(t, disputes[1].OrderID)
+		assert.Nil(t, disputes[1].EvidenceDueBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDisputes_CountError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectQuery("SELECT COUNT").WillReturnError(dbErr)
+
+		// Act
+		disputes, total, err := repo.ListDisputes(ctx, 1, 10)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.Nil(t, disputes)
+		assert.Zero(t, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
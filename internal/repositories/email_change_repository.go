@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type EmailChangeRepository interface {
+	CreateEmailChange(ctx context.Context, change *models.EmailChange) error
+	GetEmailChange(ctx context.Context, id uuid.UUID) (*models.EmailChange, error)
+	ConfirmEmailChange(ctx context.Context, id uuid.UUID, target models.EmailChangeTarget) (*models.EmailChange, error)
+	CompleteEmailChange(ctx context.Context, change *models.EmailChange) (int, error)
+}
+
+type emailChangeRepository struct {
+	DB *sql.DB
+}
+
+func NewEmailChangeRepo(db *sql.DB) EmailChangeRepository {
+	return &emailChangeRepository{DB: db}
+}
+
+const emailChangeColumns = `id, user_id, old_email, new_email, old_confirmed_at, new_confirmed_at, expires_at, completed_at, created_at`
+
+func scanEmailChange(row interface{ Scan(dest ...any) error }) (*models.EmailChange, error) {
+	var change models.EmailChange
+
+	err := row.Scan(&change.ID, &change.UserID, &change.OldEmail, &change.NewEmail, &change.OldConfirmedAt, &change.NewConfirmedAt,
+		&change.ExpiresAt, &change.CompletedAt, &change.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// CreateEmailChange records a pending change, the pending changes the user requested before are
+// dropped so that only the links of the latest one work.
+func (r *emailChangeRepository) CreateEmailChange(ctx context.Context, change *models.EmailChange) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	if _, err := tx.ExecContext(dbCtx, `DELETE FROM email_changes WHERE user_id = $1 AND completed_at IS NULL`, change.UserID); err != nil {
+		return fmt.Errorf("failed to drop pending email changes: %w", err)
+	}
+
+	query := `
+		INSERT INTO email_changes (id, user_id, old_email, new_email, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, query, change.ID, change.UserID, change.OldEmail, change.NewEmail, change.ExpiresAt).Scan(&change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert email change: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit email change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *emailChangeRepository) GetEmailChange(ctx context.Context, id uuid.UUID) (*models.EmailChange, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	change, err := scanEmailChange(r.DB.QueryRowContext(dbCtx, `SELECT `+emailChangeColumns+` FROM email_changes WHERE id = $1`, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email change: %w", err)
+	}
+
+	return change, nil
+}
+
+// ConfirmEmailChange records the confirmation of the target address, confirming twice keeps the first
+// time. The change is returned as updated, so of two confirmations racing the latter sees both. It
+// returns sql.ErrNoRows when the change is no longer pending.
+func (r *emailChangeRepository) ConfirmEmailChange(ctx context.Context, id uuid.UUID, target models.EmailChangeTarget) (*models.EmailChange, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	column := "old_confirmed_at"
+	if target == models.EmailChangeTargetNew {
+		column = "new_confirmed_at"
+	}
+
+	query := `
+		UPDATE email_changes SET ` + column + ` = COALESCE(` + column + `, NOW())
+		WHERE id = $1 AND completed_at IS NULL
+		RETURNING ` + emailChangeColumns
+
+	change, err := scanEmailChange(r.DB.QueryRowContext(dbCtx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm email change: %w", err)
+	}
+
+	return change, nil
+}
+
+// CompleteEmailChange moves the user to the new email and revokes all of their sessions in one
+// transaction, returning how many were revoked. It returns sql.ErrNoRows when the change was already
+// completed, when the user no longer has the old email or when the new one was taken meanwhile.
+func (r *emailChangeRepository) CompleteEmailChange(ctx context.Context, change *models.EmailChange) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	err = tx.QueryRowContext(dbCtx, `UPDATE email_changes SET completed_at = NOW() WHERE id = $1 AND completed_at IS NULL RETURNING completed_at`, change.ID).
+		Scan(&change.CompletedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to complete email change: %w", err)
+	}
+
+	query := `
+		UPDATE users SET email = $2, updated_at = NOW()
+		WHERE id = $1 AND email = $3 AND NOT EXISTS (SELECT 1 FROM users WHERE email = $2)
+	`
+
+	result, err := tx.ExecContext(dbCtx, query, change.UserID, change.NewEmail, change.OldEmail)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update email: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return 0, sql.ErrNoRows
+	}
+
+	result, err = tx.ExecContext(dbCtx, `UPDATE user_sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()`, change.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get revoked sessions: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit email change: %w", err)
+	}
+
+	return int(revoked), nil
+}
@@ -0,0 +1,126 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailChangeRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewEmailChangeRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	columns := []string{"id", "user_id", "old_email", "new_email", "old_confirmed_at", "new_confirmed_at", "expires_at", "completed_at", "created_at"}
+	change := &models.EmailChange{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		OldEmail:  "old@example.com",
+		NewEmail:  "new@example.com",
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	t.Run("CreateEmailChange_DropsPendingChanges", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM email_changes WHERE user_id = \\$1 AND completed_at IS NULL").
+			WithArgs(change.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("INSERT INTO email_changes").
+			WithArgs(change.ID, change.UserID, change.OldEmail, change.NewEmail, change.ExpiresAt).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.CreateEmailChange(ctx, change)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, change.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ConfirmEmailChange_New", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("UPDATE email_changes SET new_confirmed_at = COALESCE\\(new_confirmed_at, NOW\\(\\)\\)").
+			WithArgs(change.ID).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(change.ID, change.UserID, change.OldEmail, change.NewEmail, now, now, change.ExpiresAt, nil, now))
+
+		// Act
+		confirmed, err := repo.ConfirmEmailChange(ctx, change.ID, models.EmailChangeTargetNew)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, confirmed.Confirmed())
+		assert.Nil(t, confirmed.CompletedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ConfirmEmailChange_NotPending", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("UPDATE email_changes SET old_confirmed_at").
+			WithArgs(change.ID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		_, err := repo.ConfirmEmailChange(ctx, change.ID, models.EmailChangeTargetOld)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CompleteEmailChange_RevokesSessions", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE email_changes SET completed_at = NOW\\(\\)").
+			WithArgs(change.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"completed_at"}).AddRow(now))
+		mock.ExpectExec("UPDATE users SET email = \\$2").
+			WithArgs(change.UserID, change.NewEmail, change.OldEmail).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE user_sessions SET revoked_at = NOW\\(\\) WHERE user_id = \\$1").
+			WithArgs(change.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		// Act
+		revoked, err := repo.CompleteEmailChange(ctx, change)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, revoked)
+		assert.Equal(t, now, *change.CompletedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CompleteEmailChange_EmailTaken", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE email_changes SET completed_at = NOW\\(\\)").
+			WithArgs(change.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"completed_at"}).AddRow(now))
+		mock.ExpectExec("UPDATE users SET email = \\$2").
+			WithArgs(change.UserID, change.NewEmail, change.OldEmail).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		_, err := repo.CompleteEmailChange(ctx, change)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
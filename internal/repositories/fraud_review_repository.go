@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type FraudReviewRepository interface {
+	FlagOrder(ctx context.Context, review *models.FraudReview) error
+	GetReview(ctx context.Context, orderID uuid.UUID) (*models.FraudReview, error)
+	ListReviews(ctx context.Context, status models.FraudReviewStatus, page, size int) ([]*models.FraudReview, int, error)
+	ListPendingOrderIDs(ctx context.Context, orderIDs []uuid.UUID) ([]uuid.UUID, error)
+	ResolveReview(ctx context.Context, review *models.FraudReview) error
+}
+
+type fraudReviewRepository struct {
+	DB *sql.DB
+}
+
+func NewFraudReviewRepo(db *sql.DB) FraudReviewRepository {
+	return &fraudReviewRepository{DB: db}
+}
+
+const fraudReviewColumns = `order_id, customer_id, status, reasons, note, reviewed_by, reviewed_at, created_at, updated_at`
+
+// FlagOrder opens the review of an order, or reopens it with the new reasons added when the order was
+// flagged before, like by the card of a payment retry.
+func (r *fraudReviewRepository) FlagOrder(ctx context.Context, review *models.FraudReview) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO fraud_reviews (order_id, customer_id, status, reasons, note, created_at, updated_at)
+		VALUES ($1, $2, 'pending', $3, '', NOW(), NOW())
+		ON CONFLICT (order_id) DO UPDATE
+		SET status = 'pending',
+			reasons = ARRAY(SELECT DISTINCT unnest(fraud_reviews.reasons || EXCLUDED.reasons)),
+			reviewed_by = NULL, reviewed_at = NULL, updated_at = NOW()
+		RETURNING ` + fraudReviewColumns
+
+	return scanFraudReview(r.DB.QueryRowContext(dbCtx, query, review.OrderID, review.CustomerID, pq.Array(review.Reasons)), review)
+}
+
+func (r *fraudReviewRepository) GetReview(ctx context.Context, orderID uuid.UUID) (*models.FraudReview, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + fraudReviewColumns + ` FROM fraud_reviews WHERE order_id = $1`
+
+	review := &models.FraudReview{}
+	if err := scanFraudReview(r.DB.QueryRowContext(dbCtx, query, orderID), review); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// ListReviews returns the reviews with the oldest first, so the orders waiting longest are looked at first.
+func (r *fraudReviewRepository) ListReviews(ctx context.Context, status models.FraudReviewStatus, page, size int) ([]*models.FraudReview, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM fraud_reviews WHERE $1 = '' OR status = $1`, status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count fraud reviews: %w", err)
+	}
+
+	query := `
+		SELECT ` + fraudReviewColumns + `
+		FROM fraud_reviews
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at, order_id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, status, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list fraud reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := []*models.FraudReview{}
+
+	for rows.Next() {
+		review := &models.FraudReview{}
+		if err := scanFraudReview(rows, review); err != nil {
+			return nil, 0, err
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating fraud reviews: %w", err)
+	}
+
+	return reviews, total, nil
+}
+
+// ListPendingOrderIDs returns the orders out of orderIDs held by a pending review.
+func (r *fraudReviewRepository) ListPendingOrderIDs(ctx context.Context, orderIDs []uuid.UUID) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT order_id FROM fraud_reviews WHERE order_id = ANY($1) AND status = 'pending'
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, pq.Array(orderIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending fraud reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan pending fraud review: %w", err)
+		}
+
+		pending = append(pending, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pending fraud reviews: %w", err)
+	}
+
+	return pending, nil
+}
+
+// ResolveReview records the decision on a pending review, it returns sql.ErrNoRows when the review is
+// not pending anymore.
+func (r *fraudReviewRepository) ResolveReview(ctx context.Context, review *models.FraudReview) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE fraud_reviews
+		SET status = $2, note = $3, reviewed_by = $4, reviewed_at = $5, updated_at = NOW()
+		WHERE order_id = $1 AND status = 'pending'
+		RETURNING ` + fraudReviewColumns
+
+	return scanFraudReview(r.DB.QueryRowContext(dbCtx, query, review.OrderID, review.Status, review.Note, review.ReviewedBy, review.ReviewedAt), review)
+}
+
+func scanFraudReview(row interface{ Scan(dest ...any) error }, review *models.FraudReview) error {
+	var (
+		reviewedBy uuid.NullUUID
+		reviewedAt sql.NullTime
+	)
+
+	err := row.Scan(&review.OrderID, &review.CustomerID, &review.Status, pq.Array(&review.Reasons), &review.Note, &reviewedBy, &reviewedAt, &review.CreatedAt, &review.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to scan fraud review: %w", err)
+	}
+
+	review.ReviewedBy = nil
+	if reviewedBy.Valid {
+		review.ReviewedBy = &reviewedBy.UUID
+	}
+
+	review.ReviewedAt = nil
+	if reviewedAt.Valid {
+		review.ReviewedAt = &reviewedAt.Time
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFraudReviewRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewFraudReviewRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	orderID := uuid.New()
+	customerID := uuid.New()
+	reviewerID := uuid.New()
+	columns := []string{"order_id", "customer_id", "status", "reasons", "note", "reviewed_by", "reviewed_at", "created_at", "updated_at"}
+
+	t.Run("FlagOrder_Success", func(t *testing.T) {
+		// Arrange
+		review := &models.FraudReview{OrderID: orderID, CustomerID: customerID, Reasons: []string{"ip-hourly"}}
+
+		mock.ExpectQuery("INSERT INTO fraud_reviews").
+			WithArgs(orderID, customerID, pq.Array([]string{"ip-hourly"})).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(orderID, customerID, models.FraudReviewStatusPending, "{ip-daily,ip-hourly}", "", nil, nil, now, now))
+
+		// Act
+		err := repo.FlagOrder(ctx, review)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.FraudReviewStatusPending, review.Status)
+		assert.Equal(t, []string{"ip-daily", "ip-hourly"}, review.Reasons)
+		assert.Nil(t, review.ReviewedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPendingOrderIDs_Success", func(t *testing.T) {
+		// Arrange
+		otherID := uuid.New()
+
+		mock.ExpectQuery("SELECT order_id FROM fraud_reviews").
+			WithArgs(pq.Array([]uuid.UUID{orderID, otherID})).
+			WillReturnRows(sqlmock.NewRows([]string{"order_id"}).AddRow(orderID))
+
+		// Act
+		pending, err := repo.ListPendingOrderIDs(ctx, []uuid.UUID{orderID, otherID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{orderID}, pending)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ResolveReview_Success", func(t *testing.T) {
+		// Arrange
+		review := &models.FraudReview{OrderID: orderID, Status: models.FraudReviewStatusApproved, ReviewedBy: &reviewerID, ReviewedAt: &now}
+
+		mock.ExpectQuery("UPDATE fraud_reviews").
+			WithArgs(orderID, models.FraudReviewStatusApproved, "", &reviewerID, &now).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(orderID, customerID, models.FraudReviewStatusApproved, "{ip-hourly}", "", reviewerID, now, now, now))
+
+		// Act
+		err := repo.ResolveReview(ctx, review)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, customerID, review.CustomerID)
+		assert.Equal(t, &reviewerID, review.ReviewedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ResolveReview_NotPending", func(t *testing.T) {
+		// Arrange
+		review := &models.FraudReview{OrderID: orderID, Status: models.FraudReviewStatusRejected, ReviewedBy: &reviewerID, ReviewedAt: &now}
+
+		mock.ExpectQuery("UPDATE fraud_reviews").
+			WithArgs(orderID, models.FraudReviewStatusRejected, "", &reviewerID, &now).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		// Act
+		err := repo.ResolveReview(ctx, review)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListReviews_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM fraud_reviews").
+			WithArgs(models.FraudReviewStatusPending).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("FROM fraud_reviews").
+			WithArgs(models.FraudReviewStatusPending, 20, 0).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(orderID, customerID, models.FraudReviewStatusPending, "{customer-hourly}", "", nil, nil, now, now))
+
+		// Act
+		reviews, total, err := repo.ListReviews(ctx, models.FraudReviewStatusPending, 1, 20)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, reviews, 1)
+		assert.Equal(t, []string{"customer-hourly"}, reviews[0].Reasons)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type FulfillmentWaveRepository interface {
+	CreateWave(ctx context.Context, wave *models.FulfillmentWave, from []models.OrderStatus, maxOrders int) error
+	GetWave(ctx context.Context, id uuid.UUID) (*models.FulfillmentWave, error)
+	ListWaves(ctx context.Context, status models.FulfillmentWaveStatus, page, size int) ([]*models.FulfillmentWave, int, error)
+	ListPickListLines(ctx context.Context, waveID uuid.UUID) ([]models.PickListLine, error)
+	CompleteWave(ctx context.Context, wave *models.FulfillmentWave) error
+}
+
+type fulfillmentWaveRepository struct {
+	DB *sql.DB
+}
+
+func NewFulfillmentWaveRepo(db *sql.DB) FulfillmentWaveRepository {
+	return &fulfillmentWaveRepository{DB: db}
+}
+
+// fulfillmentWaveColumns are scanned by scanFulfillmentWave, the orders of the wave in their position.
+const fulfillmentWaveColumns = `
+	w.id, w.warehouse_id, w.fulfillment_type, w.status, w.created_by, w.created_at, w.completed_at,
+	ARRAY(SELECT wo.order_id FROM fulfillment_wave_orders wo WHERE wo.wave_id = w.id ORDER BY wo.position)
+`
+
+func scanFulfillmentWave(row interface{ Scan(dest ...any) error }, wave *models.FulfillmentWave) error {
+	err := row.Scan(&wave.ID, &wave.WarehouseID, &wave.FulfillmentType, &wave.Status, &wave.CreatedBy, &wave.CreatedAt, &wave.CompletedAt, pq.Array(&wave.OrderIDs))
+	if err != nil {
+		return fmt.Errorf("failed to scan fulfillment wave: %w", err)
+	}
+
+	return nil
+}
+
+// CreateWave takes up to maxOrders of the oldest paid orders in one of the from statuses whose stock is
+// allocated in the warehouse of the wave alone, leaving out the orders held by a fraud review or already
+// in an open wave. Orders locked by a wave being created at the same time are skipped rather than waited
+// for. It returns sql.ErrNoRows, and creates no wave, when no order is ready.
+func (r *fulfillmentWaveRepository) CreateWave(ctx context.Context, wave *models.FulfillmentWave, from []models.OrderStatus, maxOrders int) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		SELECT o.id
+		FROM orders o
+		WHERE o.status = ANY($1) AND o.payment_status = $2 AND o.fulfillment_type = $3
+			AND EXISTS (SELECT 1 FROM stock_allocations a WHERE a.order_id = o.id)
+			AND NOT EXISTS (SELECT 1 FROM stock_allocations a WHERE a.order_id = o.id AND a.warehouse_id <> $4)
+			AND NOT EXISTS (SELECT 1 FROM fraud_reviews f WHERE f.order_id = o.id AND f.status = 'pending')
+			AND NOT EXISTS (
+				SELECT 1 FROM fulfillment_wave_orders wo
+				JOIN fulfillment_waves w ON w.id = wo.wave_id
+				WHERE wo.order_id = o.id AND w.status = $5
+			)
+		ORDER BY o.created_at, o.id
+		LIMIT $6
+		FOR UPDATE OF o SKIP LOCKED
+	`
+
+	rows, err := tx.QueryContext(dbCtx, query, pq.Array(from), models.PaymentStatusSucceeded, wave.FulfillmentType, wave.WarehouseID, models.FulfillmentWaveOpen, maxOrders)
+	if err != nil {
+		return fmt.Errorf("failed to select wave orders: %w", err)
+	}
+
+	var orderIDs []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+
+			return fmt.Errorf("failed to scan wave order: %w", err)
+		}
+
+		orderIDs = append(orderIDs, id)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating wave orders: %w", err)
+	}
+
+	if len(orderIDs) == 0 {
+		return sql.ErrNoRows
+	}
+
+	waveQuery := `
+		INSERT INTO fulfillment_waves (id, warehouse_id, fulfillment_type, status, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, waveQuery, wave.ID, wave.WarehouseID, wave.FulfillmentType, models.FulfillmentWaveOpen, wave.CreatedBy).Scan(&wave.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert fulfillment wave: %w", err)
+	}
+
+	ordersQuery := `
+		INSERT INTO fulfillment_wave_orders (wave_id, order_id, position)
+		SELECT $1, t.order_id, t.position FROM unnest($2::uuid[]) WITH ORDINALITY AS t(order_id, position)
+	`
+
+	if _, err := tx.ExecContext(dbCtx, ordersQuery, wave.ID, pq.Array(orderIDs)); err != nil {
+		return fmt.Errorf("failed to insert fulfillment wave orders: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit fulfillment wave: %w", err)
+	}
+
+	wave.Status = models.FulfillmentWaveOpen
+	wave.OrderIDs = orderIDs
+
+	return nil
+}
+
+func (r *fulfillmentWaveRepository) GetWave(ctx context.Context, id uuid.UUID) (*models.FulfillmentWave, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + fulfillmentWaveColumns + ` FROM fulfillment_waves w WHERE w.id = $1`
+
+	wave := &models.FulfillmentWave{}
+	if err := scanFulfillmentWave(r.DB.QueryRowContext(dbCtx, query, id), wave); err != nil {
+		return nil, err
+	}
+
+	return wave, nil
+}
+
+func (r *fulfillmentWaveRepository) ListWaves(ctx context.Context, status models.FulfillmentWaveStatus, page, size int) ([]*models.FulfillmentWave, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM fulfillment_waves WHERE $1 = '' OR status = $1`, status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count fulfillment waves: %w", err)
+	}
+
+	query := `
+		SELECT ` + fulfillmentWaveColumns + `
+		FROM fulfillment_waves w
+		WHERE $1 = '' OR w.status = $1
+		ORDER BY w.created_at DESC, w.id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, status, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list fulfillment waves: %w", err)
+	}
+	defer rows.Close()
+
+	waves := []*models.FulfillmentWave{}
+
+	for rows.Next() {
+		wave := &models.FulfillmentWave{}
+		if err := scanFulfillmentWave(rows, wave); err != nil {
+			return nil, 0, err
+		}
+
+		waves = append(waves, wave)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating fulfillment waves: %w", err)
+	}
+
+	return waves, total, nil
+}
+
+// ListPickListLines sums the allocated units of the orders of the wave by product, in the order of their SKU.
+func (r *fulfillmentWaveRepository) ListPickListLines(ctx context.Context, waveID uuid.UUID) ([]models.PickListLine, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT a.product_id, p.sku, p.name, SUM(a.quantity), COUNT(DISTINCT a.order_id)
+		FROM fulfillment_wave_orders wo
+		JOIN stock_allocations a ON a.order_id = wo.order_id
+		JOIN products p ON p.id = a.product_id
+		WHERE wo.wave_id = $1
+		GROUP BY a.product_id, p.sku, p.name
+		ORDER BY p.sku, a.product_id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, waveID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pick list lines: %w", err)
+	}
+	defer rows.Close()
+
+	lines := []models.PickListLine{}
+
+	for rows.Next() {
+		var line models.PickListLine
+		if err := rows.Scan(&line.ProductID, &line.SKU, &line.Name, &line.Quantity, &line.OrderCount); err != nil {
+			return nil, fmt.Errorf("failed to scan pick list line: %w", err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pick list lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// CompleteWave closes an open wave, it returns sql.ErrNoRows when the wave was completed already.
+func (r *fulfillmentWaveRepository) CompleteWave(ctx context.Context, wave *models.FulfillmentWave) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE fulfillment_waves SET status = $1, completed_at = NOW()
+		WHERE id = $2 AND status = $3
+		RETURNING completed_at
+	`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, models.FulfillmentWaveCompleted, wave.ID, models.FulfillmentWaveOpen).Scan(&wave.CompletedAt); err != nil {
+		return fmt.Errorf("failed to complete fulfillment wave: %w", err)
+	}
+
+	wave.Status = models.FulfillmentWaveCompleted
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFulfillmentWaveRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewFulfillmentWaveRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	warehouseID := uuid.New()
+	adminID := uuid.New()
+	firstID := uuid.New()
+	secondID := uuid.New()
+	from := []models.OrderStatus{models.OrderStatusPending, models.OrderStatusConfirmed}
+	columns := []string{"id", "warehouse_id", "fulfillment_type", "status", "created_by", "created_at", "completed_at", "order_ids"}
+
+	t.Run("CreateWave_Success", func(t *testing.T) {
+		// Arrange
+		wave := &models.FulfillmentWave{ID: uuid.New(), WarehouseID: warehouseID, FulfillmentType: models.FulfillmentShipping, CreatedBy: adminID}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT o.id FROM orders o").
+			WithArgs(pq.Array(from), models.PaymentStatusSucceeded, models.FulfillmentShipping, warehouseID, models.FulfillmentWaveOpen, 50).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(firstID).AddRow(secondID))
+		mock.ExpectQuery("INSERT INTO fulfillment_waves").
+			WithArgs(wave.ID, warehouseID, models.FulfillmentShipping, models.FulfillmentWaveOpen, adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectExec("INSERT INTO fulfillment_wave_orders").
+			WithArgs(wave.ID, pq.Array([]uuid.UUID{firstID, secondID})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.CreateWave(ctx, wave, from, 50)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.FulfillmentWaveOpen, wave.Status)
+		assert.Equal(t, []uuid.UUID{firstID, secondID}, wave.OrderIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateWave_NoOrdersReady", func(t *testing.T) {
+		// Arrange
+		wave := &models.FulfillmentWave{ID: uuid.New(), WarehouseID: warehouseID, FulfillmentType: models.FulfillmentPickup, CreatedBy: adminID}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT o.id FROM orders o").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.CreateWave(ctx, wave, from, 50)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetWave_Success", func(t *testing.T) {
+		// Arrange
+		waveID := uuid.New()
+
+		mock.ExpectQuery("SELECT (.+) FROM fulfillment_waves w WHERE w.id = \\$1").
+			WithArgs(waveID).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(waveID, warehouseID, models.FulfillmentShipping, models.FulfillmentWaveOpen, adminID, now, nil, "{"+firstID.String()+","+secondID.String()+"}"))
+
+		// Act
+		wave, err := repo.GetWave(ctx, waveID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{firstID, secondID}, wave.OrderIDs)
+		assert.Nil(t, wave.CompletedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPickListLines_Success", func(t *testing.T) {
+		// Arrange
+		waveID := uuid.New()
+		productID := uuid.New()
+
+		mock.ExpectQuery("SELECT a.product_id, p.sku, p.name, SUM\\(a.quantity\\)").
+			WithArgs(waveID).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id", "sku", "name", "quantity", "orders"}).
+				AddRow(productID, "SKU-1", "Mug", 5, 2))
+
+		// Act
+		lines, err := repo.ListPickListLines(ctx, waveID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.PickListLine{{ProductID: productID, SKU: "SKU-1", Name: "Mug", Quantity: 5, OrderCount: 2}}, lines)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CompleteWave_AlreadyCompleted", func(t *testing.T) {
+		// Arrange
+		wave := &models.FulfillmentWave{ID: uuid.New(), Status: models.FulfillmentWaveOpen}
+
+		mock.ExpectQuery("UPDATE fulfillment_waves SET status").
+			WithArgs(models.FulfillmentWaveCompleted, wave.ID, models.FulfillmentWaveOpen).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		err := repo.CompleteWave(ctx, wave)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, models.FulfillmentWaveOpen, wave.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
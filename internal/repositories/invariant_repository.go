@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+// InvariantRepository runs the consistency queries behind the invariants checker,
+// each method returns at most limit violations.
+type InvariantRepository interface {
+	FindStockLedgerViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error)
+	FindOrderTotalViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error)
+	FindOrderPaymentViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error)
+	FindLedgerBalanceViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error)
+}
+
+type invariantRepository struct {
+	DB *sql.DB
+}
+
+func NewInvariantRepo(db *sql.DB) InvariantRepository {
+	return &invariantRepository{DB: db}
+}
+
+// FindStockLedgerViolations reports products whose stock drifted from initial - sum(movements), or went negative.
+func (r *invariantRepository) FindStockLedgerViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT p.id, p.initial_stock_quantity - COALESCE(SUM(m.quantity), 0) AS expected, p.stock_quantity
+		FROM products p
+		LEFT JOIN stock_movements m ON m.product_id = p.id
+		GROUP BY p.id, p.initial_stock_quantity, p.stock_quantity
+		HAVING p.stock_quantity <> p.initial_stock_quantity - COALESCE(SUM(m.quantity), 0) OR p.stock_quantity < 0
+		LIMIT $1
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock ledger: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []models.InvariantViolation
+
+	for rows.Next() {
+		var (
+			productID       string
+			expected, stock int
+		)
+
+		if err := rows.Scan(&productID, &expected, &stock); err != nil {
+			return nil, fmt.Errorf("failed to scan stock ledger row: %w", err)
+		}
+
+		message := "stock does not match the movements ledger"
+		if stock < 0 {
+			message = "stock is negative, product was oversold"
+		}
+
+		violations = append(violations, models.InvariantViolation{
+			Check:    models.InvariantStockLedger,
+			EntityID: productID,
+			Expected: strconv.Itoa(expected),
+			Actual:   strconv.Itoa(stock),
+			Message:  message,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock ledger rows: %w", err)
+	}
+
+	return violations, nil
+}
+
+// FindOrderTotalViolations reports orders whose total differs from the sum of their items plus the gift
+// wrap fee. Orders carry no tax, shipping or discount amounts yet, so those components count as zero.
+func (r *invariantRepository) FindOrderTotalViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT o.id, COALESCE(SUM(oi.quantity * oi.unit_price), 0) + o.gift_wrap_fee AS expected, o.total_amount
+		FROM orders o
+		LEFT JOIN order_items oi ON oi.order_id = o.id
+		GROUP BY o.id, o.total_amount, o.gift_wrap_fee
+		HAVING ABS(o.total_amount - COALESCE(SUM(oi.quantity * oi.unit_price), 0) - o.gift_wrap_fee) >= 0.01
+		LIMIT $1
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order totals: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []models.InvariantViolation
+
+	for rows.Next() {
+		var (
+			orderID         string
+			expected, total float64
+		)
+
+		if err := rows.Scan(&orderID, &expected, &total); err != nil {
+			return nil, fmt.Errorf("failed to scan order total row: %w", err)
+		}
+
+		violations = append(violations, models.InvariantViolation{
+			Check:    models.InvariantOrderTotal,
+			EntityID: orderID,
+			Expected: strconv.FormatFloat(expected, 'f', 2, 64),
+			Actual:   strconv.FormatFloat(total, 'f', 2, 64),
+			Message:  "order total does not match the sum of its items and gift wrap fee",
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order total rows: %w", err)
+	}
+
+	return violations, nil
+}
+
+// FindOrderPaymentViolations reports orders linked to a payment intent whose payment is missing,
+// has a different amount (payments are stored in minor units) or a different status.
+func (r *invariantRepository) FindOrderPaymentViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT o.id, ROUND(o.total_amount * 100)::BIGINT, o.payment_status, p.amount, p.status
+		FROM orders o
+		LEFT JOIN payments p ON p.id = o.payment_intent_id
+		WHERE o.payment_intent_id <> ''
+		  AND (p.id IS NULL OR p.amount <> ROUND(o.total_amount * 100)::BIGINT OR p.status <> o.payment_status)
+		LIMIT $1
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order payments: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []models.InvariantViolation
+
+	for rows.Next() {
+		var (
+			orderID        string
+			expectedAmount int64
+			orderStatus    string
+			paymentAmount  sql.NullInt64
+			paymentStatus  sql.NullString
+		)
+
+		if err := rows.Scan(&orderID, &expectedAmount, &orderStatus, &paymentAmount, &paymentStatus); err != nil {
+			return nil, fmt.Errorf("failed to scan order payment row: %w", err)
+		}
+
+		violation := models.InvariantViolation{
+			Check:    models.InvariantOrderPayment,
+			EntityID: orderID,
+		}
+
+		switch {
+		case !paymentAmount.Valid:
+			violation.Expected = "payment"
+			violation.Actual = "none"
+			violation.Message = "order references a payment that does not exist"
+		case paymentAmount.Int64 != expectedAmount:
+			violation.Expected = strconv.FormatInt(expectedAmount, 10)
+			violation.Actual = strconv.FormatInt(paymentAmount.Int64, 10)
+			violation.Message = "payment amount does not match the order total"
+		default:
+			violation.Expected = orderStatus
+			violation.Actual = paymentStatus.String
+			violation.Message = "payment status does not match the order payment status"
+		}
+
+		violations = append(violations, violation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order payment rows: %w", err)
+	}
+
+	return violations, nil
+}
+
+// FindLedgerBalanceViolations reports ledger transactions whose debits do not equal their credits.
+func (r *invariantRepository) FindLedgerBalanceViolations(ctx context.Context, limit int) ([]models.InvariantViolation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT t.id, COALESCE(SUM(e.debit), 0), COALESCE(SUM(e.credit), 0)
+		FROM ledger_transactions t
+		LEFT JOIN ledger_entries e ON e.transaction_id = t.id
+		GROUP BY t.id
+		HAVING COALESCE(SUM(e.debit), 0) <> COALESCE(SUM(e.credit), 0) OR COUNT(e.transaction_id) = 0
+		LIMIT $1
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ledger balance: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []models.InvariantViolation
+
+	for rows.Next() {
+		var (
+			transactionID string
+			debit, credit int64
+		)
+
+		if err := rows.Scan(&transactionID, &debit, &credit); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger balance row: %w", err)
+		}
+
+		violations = append(violations, models.InvariantViolation{
+			Check:    models.InvariantLedgerBalance,
+			EntityID: transactionID,
+			Expected: strconv.FormatInt(debit, 10),
+			Actual:   strconv.FormatInt(credit, 10),
+			Message:  "ledger transaction credits do not equal its debits",
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ledger balance rows: %w", err)
+	}
+
+	return violations, nil
+}
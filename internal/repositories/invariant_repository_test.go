@@ -0,0 +1,136 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariantRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewInvariantRepo(db)
+	ctx := t.Context()
+
+	t.Run("FindStockLedgerViolations_Success", func(t *testing.T) {
+		// Arrange
+		driftedID := uuid.NewString()
+		oversoldID := uuid.NewString()
+
+		mock.ExpectQuery("FROM products p").
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "expected", "stock_quantity"}).
+				AddRow(driftedID, 5, 7).
+				AddRow(oversoldID, 0, -2))
+
+		// Act
+		violations, err := repo.FindStockLedgerViolations(ctx, 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, violations, 2)
+		assert.Equal(t, models.InvariantViolation{
+			Check:    models.InvariantStockLedger,
+			EntityID: driftedID,
+			Expected: "5",
+			Actual:   "7",
+			Message:  "stock does not match the movements ledger",
+		}, violations[0])
+		assert.Equal(t, "stock is negative, product was oversold", violations[1].Message)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindOrderTotalViolations_Success", func(t *testing.T) {
+		// Arrange
+		orderID := uuid.NewString()
+
+		// the gift wrap fee is part of the expected total
+		mock.ExpectQuery(`SUM\(oi.quantity \* oi.unit_price\), 0\) \+ o.gift_wrap_fee AS expected`).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "expected", "total_amount"}).
+				AddRow(orderID, 34.5, 30.0))
+
+		// Act
+		violations, err := repo.FindOrderTotalViolations(ctx, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.InvariantViolation{{
+			Check:    models.InvariantOrderTotal,
+			EntityID: orderID,
+			Expected: "34.50",
+			Actual:   "30.00",
+			Message:  "order total does not match the sum of its items and gift wrap fee",
+		}}, violations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindOrderTotalViolations_QueryError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectQuery("FROM orders o").WithArgs(10).WillReturnError(dbErr)
+
+		// Act
+		violations, err := repo.FindOrderTotalViolations(ctx, 10)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.Nil(t, violations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindOrderPaymentViolations_Success", func(t *testing.T) {
+		// Arrange
+		missingID := uuid.NewString()
+		amountID := uuid.NewString()
+		statusID := uuid.NewString()
+
+		mock.ExpectQuery("LEFT JOIN payments p").
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "expected_amount", "payment_status", "amount", "status"}).
+				AddRow(missingID, 1000, "pending", nil, nil).
+				AddRow(amountID, 1000, "succeeded", 900, "succeeded").
+				AddRow(statusID, 1000, "pending", 1000, "succeeded"))
+
+		// Act
+		violations, err := repo.FindOrderPaymentViolations(ctx, 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, violations, 3)
+		assert.Equal(t, "order references a payment that does not exist", violations[0].Message)
+		assert.Equal(t, "900", violations[1].Actual)
+		assert.Equal(t, "pending", violations[2].Expected)
+		assert.Equal(t, "succeeded", violations[2].Actual)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindLedgerBalanceViolations_Success", func(t *testing.T) {
+		// Arrange
+		transactionID := uuid.NewString()
+
+		mock.ExpectQuery("FROM ledger_transactions t").
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "debit", "credit"}).AddRow(transactionID, 1000, 900))
+
+		// Act
+		violations, err := repo.FindLedgerBalanceViolations(ctx, 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, models.InvariantLedgerBalance, violations[0].Check)
+		assert.Equal(t, transactionID, violations[0].EntityID)
+		assert.Equal(t, "1000", violations[0].Expected)
+		assert.Equal(t, "900", violations[0].Actual)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
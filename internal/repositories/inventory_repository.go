@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+type InventoryRepository interface {
+	RecordMovement(ctx context.Context, movement *models.StockMovement) error
+}
+
+type inventoryRepository struct {
+	DB *sql.DB
+}
+
+func NewInventoryRepo(db *sql.DB) InventoryRepository {
+	return &inventoryRepository{DB: db}
+}
+
+func (r *inventoryRepository) RecordMovement(ctx context.Context, movement *models.StockMovement) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO stock_movements (id, product_id, quantity, reason, reference_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, movement.ID, movement.ProductID, movement.Quantity, movement.Reason, movement.ReferenceID).Scan(&movement.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert stock movement: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+type LedgerRepository interface {
+	RecordTransaction(ctx context.Context, txn *models.LedgerTransaction) (bool, error)
+	GetRefundedAmount(ctx context.Context, sourceID string) (int64, error)
+	GetBalances(ctx context.Context, from, to time.Time) ([]models.LedgerBalance, error)
+}
+
+type ledgerRepository struct {
+	DB *sql.DB
+}
+
+func NewLedgerRepo(db *sql.DB) LedgerRepository {
+	return &ledgerRepository{DB: db}
+}
+
+// RecordTransaction stores the transaction with its entries, it reports false when a transaction with
+// the same idempotency key was already recorded.
+func (r *ledgerRepository) RecordTransaction(ctx context.Context, txn *models.LedgerTransaction) (bool, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		INSERT INTO ledger_transactions (id, type, source_id, idempotency_key, currency, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (idempotency_key) DO NOTHING
+		RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, query, txn.ID, txn.Type, txn.SourceID, txn.IdempotencyKey, txn.Currency).Scan(&txn.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to insert ledger transaction: %w", err)
+	}
+
+	for _, entry := range txn.Entries {
+		_, err := tx.ExecContext(dbCtx, `INSERT INTO ledger_entries (transaction_id, account, debit, credit, reference) VALUES ($1, $2, $3, $4, $5)`,
+			txn.ID, entry.Account, entry.Debit, entry.Credit, entry.Reference)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert ledger entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit ledger transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetRefundedAmount totals the refunds recorded for the payment, net of their reversals.
+func (r *ledgerRepository) GetRefundedAmount(ctx context.Context, sourceID string) (int64, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COALESCE(SUM(e.debit - e.credit), 0)
+		FROM ledger_transactions t
+		JOIN ledger_entries e ON e.transaction_id = t.id
+		WHERE t.source_id = $1 AND e.account = $2
+	`
+
+	var refunded int64
+
+	err := r.DB.QueryRowContext(dbCtx, query, sourceID, models.LedgerAccountRefunds).Scan(&refunded)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get refunded amount: %w", err)
+	}
+
+	return refunded, nil
+}
+
+// GetBalances totals the entries of the transactions recorded in [from, to) per account and currency.
+func (r *ledgerRepository) GetBalances(ctx context.Context, from, to time.Time) ([]models.LedgerBalance, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT e.account, t.currency, COALESCE(SUM(e.debit), 0), COALESCE(SUM(e.credit), 0)
+		FROM ledger_transactions t
+		JOIN ledger_entries e ON e.transaction_id = t.id
+		WHERE t.created_at >= $1 AND t.created_at < $2
+		GROUP BY e.account, t.currency
+		ORDER BY e.account, t.currency
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger balances: %w", err)
+	}
+	defer rows.Close()
+
+	balances := []models.LedgerBalance{}
+
+	for rows.Next() {
+		var balance models.LedgerBalance
+
+		if err := rows.Scan(&balance.Account, &balance.Currency, &balance.Debit, &balance.Credit); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger balance: %w", err)
+		}
+
+		balance.Balance = balance.Debit - balance.Credit
+		balances = append(balances, balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ledger balances: %w", err)
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,105 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewLedgerRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+
+	t.Run("RecordTransaction_Success", func(t *testing.T) {
+		// Arrange
+		txn := models.NewLedgerTransfer(models.LedgerTransactionCapture, "pi_1", "capture:pi_1", "usd", 1000,
+			models.LedgerAccountStripeBalance, models.LedgerAccountSales)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO ledger_transactions").
+			WithArgs(txn.ID, txn.Type, txn.SourceID, txn.IdempotencyKey, txn.Currency).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(txn.ID, models.LedgerAccountStripeBalance, int64(1000), int64(0), "").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(txn.ID, models.LedgerAccountSales, int64(0), int64(1000), "").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		recorded, err := repo.RecordTransaction(ctx, txn)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, recorded)
+		assert.Equal(t, now, txn.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordTransaction_AlreadyRecorded", func(t *testing.T) {
+		// Arrange
+		txn := models.NewLedgerTransfer(models.LedgerTransactionCapture, "pi_1", "capture:pi_1", "usd", 1000,
+			models.LedgerAccountStripeBalance, models.LedgerAccountSales)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO ledger_transactions").
+			WithArgs(txn.ID, txn.Type, txn.SourceID, txn.IdempotencyKey, txn.Currency).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+		mock.ExpectRollback()
+
+		// Act
+		recorded, err := repo.RecordTransaction(ctx, txn)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, recorded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetRefundedAmount_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COALESCE\\(SUM\\(e.debit - e.credit\\), 0\\)").
+			WithArgs("pi_1", models.LedgerAccountRefunds).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(400))
+
+		// Act
+		refunded, err := repo.GetRefundedAmount(ctx, "pi_1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(400), refunded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetBalances_Success", func(t *testing.T) {
+		// Arrange
+		from := now.Add(-24 * time.Hour)
+
+		mock.ExpectQuery("GROUP BY e.account, t.currency").
+			WithArgs(from, now).
+			WillReturnRows(sqlmock.NewRows([]string{"account", "currency", "debit", "credit"}).
+				AddRow("sales", "usd", 0, 1000).
+				AddRow("stripe_balance", "usd", 1000, 0))
+
+		// Act
+		balances, err := repo.GetBalances(ctx, from, now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, balances, 2)
+		assert.Equal(t, models.LedgerBalance{Account: models.LedgerAccountSales, Currency: "usd", Credit: 1000, Balance: -1000}, balances[0])
+		assert.Equal(t, int64(1000), balances[1].Balance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/google/uuid"
@@ -39,6 +40,122 @@ func (_m *MockNotificationRepository) EXPECT() *MockNotificationRepository_Expec
 	return &MockNotificationRepository_Expecter{mock: &_m.Mock}
 }
 
+// ClaimDueNotifications provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) ClaimDueNotifications(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*models.Notification, error) {
+	ret := _mock.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueNotifications")
+	}
+
+	var r0 []*models.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*models.Notification, error)); ok {
+		return returnFunc(ctx, now, leaseUntil, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*models.Notification); ok {
+		r0 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_ClaimDueNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueNotifications'
+type MockNotificationRepository_ClaimDueNotifications_Call struct {
+	*mock.Call
+}
+
+// ClaimDueNotifications is a helper method to define mock.On call
+//   - ctx
+//   - now
+//   - leaseUntil
+//   - limit
+func (_e *MockNotificationRepository_Expecter) ClaimDueNotifications(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MockNotificationRepository_ClaimDueNotifications_Call {
+	return &MockNotificationRepository_ClaimDueNotifications_Call{Call: _e.mock.On("ClaimDueNotifications", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *MockNotificationRepository_ClaimDueNotifications_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *MockNotificationRepository_ClaimDueNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_ClaimDueNotifications_Call) Return(notifications []*models.Notification, err error) *MockNotificationRepository_ClaimDueNotifications_Call {
+	_c.Call.Return(notifications, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_ClaimDueNotifications_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*models.Notification, error)) *MockNotificationRepository_ClaimDueNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountNotificationsByStatus provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) CountNotificationsByStatus(ctx context.Context, filter *models.NotificationFilter) (map[models.NotificationStatus]int, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountNotificationsByStatus")
+	}
+
+	var r0 map[models.NotificationStatus]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter) (map[models.NotificationStatus]int, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter) map[models.NotificationStatus]int); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[models.NotificationStatus]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.NotificationFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_CountNotificationsByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountNotificationsByStatus'
+type MockNotificationRepository_CountNotificationsByStatus_Call struct {
+	*mock.Call
+}
+
+// CountNotificationsByStatus is a helper method to define mock.On call
+//   - ctx
+//   - filter
+func (_e *MockNotificationRepository_Expecter) CountNotificationsByStatus(ctx interface{}, filter interface{}) *MockNotificationRepository_CountNotificationsByStatus_Call {
+	return &MockNotificationRepository_CountNotificationsByStatus_Call{Call: _e.mock.On("CountNotificationsByStatus", ctx, filter)}
+}
+
+func (_c *MockNotificationRepository_CountNotificationsByStatus_Call) Run(run func(ctx context.Context, filter *models.NotificationFilter)) *MockNotificationRepository_CountNotificationsByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationFilter))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_CountNotificationsByStatus_Call) Return(m map[models.NotificationStatus]int, err error) *MockNotificationRepository_CountNotificationsByStatus_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_CountNotificationsByStatus_Call) RunAndReturn(run func(ctx context.Context, filter *models.NotificationFilter) (map[models.NotificationStatus]int, error)) *MockNotificationRepository_CountNotificationsByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateNotification provides a mock function for the type MockNotificationRepository
 func (_mock *MockNotificationRepository) CreateNotification(ctx context.Context, notification *models.Notification) error {
 	ret := _mock.Called(ctx, notification)
@@ -85,6 +202,99 @@ func (_c *MockNotificationRepository_CreateNotification_Call) RunAndReturn(run f
 	return _c
 }
 
+// DeferNotification provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) DeferNotification(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time) error {
+	ret := _mock.Called(ctx, id, nextAttemptAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepository_DeferNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeferNotification'
+type MockNotificationRepository_DeferNotification_Call struct {
+	*mock.Call
+}
+
+// DeferNotification is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - nextAttemptAt
+func (_e *MockNotificationRepository_Expecter) DeferNotification(ctx interface{}, id interface{}, nextAttemptAt interface{}) *MockNotificationRepository_DeferNotification_Call {
+	return &MockNotificationRepository_DeferNotification_Call{Call: _e.mock.On("DeferNotification", ctx, id, nextAttemptAt)}
+}
+
+func (_c *MockNotificationRepository_DeferNotification_Call) Run(run func(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time)) *MockNotificationRepository_DeferNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_DeferNotification_Call) Return(err error) *MockNotificationRepository_DeferNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_DeferNotification_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time) error) *MockNotificationRepository_DeferNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSuppression provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) DeleteSuppression(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSuppression")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepository_DeleteSuppression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSuppression'
+type MockNotificationRepository_DeleteSuppression_Call struct {
+	*mock.Call
+}
+
+// DeleteSuppression is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockNotificationRepository_Expecter) DeleteSuppression(ctx interface{}, email interface{}) *MockNotificationRepository_DeleteSuppression_Call {
+	return &MockNotificationRepository_DeleteSuppression_Call{Call: _e.mock.On("DeleteSuppression", ctx, email)}
+}
+
+func (_c *MockNotificationRepository_DeleteSuppression_Call) Run(run func(ctx context.Context, email string)) *MockNotificationRepository_DeleteSuppression_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_DeleteSuppression_Call) Return(err error) *MockNotificationRepository_DeleteSuppression_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_DeleteSuppression_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockNotificationRepository_DeleteSuppression_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNotificationByID provides a mock function for the type MockNotificationRepository
 func (_mock *MockNotificationRepository) GetNotificationByID(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
 	ret := _mock.Called(ctx, id)
@@ -142,6 +352,174 @@ func (_c *MockNotificationRepository_GetNotificationByID_Call) RunAndReturn(run
 	return _c
 }
 
+// GetSuppression provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) GetSuppression(ctx context.Context, email string) (*models.EmailSuppression, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuppression")
+	}
+
+	var r0 *models.EmailSuppression
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.EmailSuppression, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.EmailSuppression); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.EmailSuppression)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_GetSuppression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuppression'
+type MockNotificationRepository_GetSuppression_Call struct {
+	*mock.Call
+}
+
+// GetSuppression is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockNotificationRepository_Expecter) GetSuppression(ctx interface{}, email interface{}) *MockNotificationRepository_GetSuppression_Call {
+	return &MockNotificationRepository_GetSuppression_Call{Call: _e.mock.On("GetSuppression", ctx, email)}
+}
+
+func (_c *MockNotificationRepository_GetSuppression_Call) Run(run func(ctx context.Context, email string)) *MockNotificationRepository_GetSuppression_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_GetSuppression_Call) Return(emailSuppression *models.EmailSuppression, err error) *MockNotificationRepository_GetSuppression_Call {
+	_c.Call.Return(emailSuppression, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_GetSuppression_Call) RunAndReturn(run func(ctx context.Context, email string) (*models.EmailSuppression, error)) *MockNotificationRepository_GetSuppression_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSuppressions provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) ImportSuppressions(ctx context.Context, suppressions []*models.EmailSuppression, createdBy *uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, suppressions, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSuppressions")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*models.EmailSuppression, *uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, suppressions, createdBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*models.EmailSuppression, *uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, suppressions, createdBy)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*models.EmailSuppression, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, suppressions, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_ImportSuppressions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSuppressions'
+type MockNotificationRepository_ImportSuppressions_Call struct {
+	*mock.Call
+}
+
+// ImportSuppressions is a helper method to define mock.On call
+//   - ctx
+//   - suppressions
+//   - createdBy
+func (_e *MockNotificationRepository_Expecter) ImportSuppressions(ctx interface{}, suppressions interface{}, createdBy interface{}) *MockNotificationRepository_ImportSuppressions_Call {
+	return &MockNotificationRepository_ImportSuppressions_Call{Call: _e.mock.On("ImportSuppressions", ctx, suppressions, createdBy)}
+}
+
+func (_c *MockNotificationRepository_ImportSuppressions_Call) Run(run func(ctx context.Context, suppressions []*models.EmailSuppression, createdBy *uuid.UUID)) *MockNotificationRepository_ImportSuppressions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.EmailSuppression), args[2].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_ImportSuppressions_Call) Return(n int, err error) *MockNotificationRepository_ImportSuppressions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_ImportSuppressions_Call) RunAndReturn(run func(ctx context.Context, suppressions []*models.EmailSuppression, createdBy *uuid.UUID) (int, error)) *MockNotificationRepository_ImportSuppressions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEmailSuppressed provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) IsEmailSuppressed(ctx context.Context, email string) (bool, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEmailSuppressed")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_IsEmailSuppressed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEmailSuppressed'
+type MockNotificationRepository_IsEmailSuppressed_Call struct {
+	*mock.Call
+}
+
+// IsEmailSuppressed is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockNotificationRepository_Expecter) IsEmailSuppressed(ctx interface{}, email interface{}) *MockNotificationRepository_IsEmailSuppressed_Call {
+	return &MockNotificationRepository_IsEmailSuppressed_Call{Call: _e.mock.On("IsEmailSuppressed", ctx, email)}
+}
+
+func (_c *MockNotificationRepository_IsEmailSuppressed_Call) Run(run func(ctx context.Context, email string)) *MockNotificationRepository_IsEmailSuppressed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_IsEmailSuppressed_Call) Return(b bool, err error) *MockNotificationRepository_IsEmailSuppressed_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_IsEmailSuppressed_Call) RunAndReturn(run func(ctx context.Context, email string) (bool, error)) *MockNotificationRepository_IsEmailSuppressed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNotifications provides a mock function for the type MockNotificationRepository
 func (_mock *MockNotificationRepository) ListNotifications(ctx context.Context, page int, size int) ([]*models.Notification, int, error) {
 	ret := _mock.Called(ctx, page, size)
@@ -206,6 +584,284 @@ func (_c *MockNotificationRepository_ListNotifications_Call) RunAndReturn(run fu
 	return _c
 }
 
+// ListSuppressions provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) ListSuppressions(ctx context.Context, source models.SuppressionSource, page int, size int) ([]*models.EmailSuppression, int, error) {
+	ret := _mock.Called(ctx, source, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSuppressions")
+	}
+
+	var r0 []*models.EmailSuppression
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.SuppressionSource, int, int) ([]*models.EmailSuppression, int, error)); ok {
+		return returnFunc(ctx, source, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.SuppressionSource, int, int) []*models.EmailSuppression); ok {
+		r0 = returnFunc(ctx, source, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.EmailSuppression)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.SuppressionSource, int, int) int); ok {
+		r1 = returnFunc(ctx, source, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, models.SuppressionSource, int, int) error); ok {
+		r2 = returnFunc(ctx, source, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationRepository_ListSuppressions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSuppressions'
+type MockNotificationRepository_ListSuppressions_Call struct {
+	*mock.Call
+}
+
+// ListSuppressions is a helper method to define mock.On call
+//   - ctx
+//   - source
+//   - page
+//   - size
+func (_e *MockNotificationRepository_Expecter) ListSuppressions(ctx interface{}, source interface{}, page interface{}, size interface{}) *MockNotificationRepository_ListSuppressions_Call {
+	return &MockNotificationRepository_ListSuppressions_Call{Call: _e.mock.On("ListSuppressions", ctx, source, page, size)}
+}
+
+func (_c *MockNotificationRepository_ListSuppressions_Call) Run(run func(ctx context.Context, source models.SuppressionSource, page int, size int)) *MockNotificationRepository_ListSuppressions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SuppressionSource), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_ListSuppressions_Call) Return(emailSuppressions []*models.EmailSuppression, n int, err error) *MockNotificationRepository_ListSuppressions_Call {
+	_c.Call.Return(emailSuppressions, n, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_ListSuppressions_Call) RunAndReturn(run func(ctx context.Context, source models.SuppressionSource, page int, size int) ([]*models.EmailSuppression, int, error)) *MockNotificationRepository_ListSuppressions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNotificationFailure provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) RecordNotificationFailure(ctx context.Context, notification *models.Notification) error {
+	ret := _mock.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNotificationFailure")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Notification) error); ok {
+		r0 = returnFunc(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepository_RecordNotificationFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNotificationFailure'
+type MockNotificationRepository_RecordNotificationFailure_Call struct {
+	*mock.Call
+}
+
+// RecordNotificationFailure is a helper method to define mock.On call
+//   - ctx
+//   - notification
+func (_e *MockNotificationRepository_Expecter) RecordNotificationFailure(ctx interface{}, notification interface{}) *MockNotificationRepository_RecordNotificationFailure_Call {
+	return &MockNotificationRepository_RecordNotificationFailure_Call{Call: _e.mock.On("RecordNotificationFailure", ctx, notification)}
+}
+
+func (_c *MockNotificationRepository_RecordNotificationFailure_Call) Run(run func(ctx context.Context, notification *models.Notification)) *MockNotificationRepository_RecordNotificationFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Notification))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_RecordNotificationFailure_Call) Return(err error) *MockNotificationRepository_RecordNotificationFailure_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_RecordNotificationFailure_Call) RunAndReturn(run func(ctx context.Context, notification *models.Notification) error) *MockNotificationRepository_RecordNotificationFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchNotifications provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) SearchNotifications(ctx context.Context, filter *models.NotificationFilter, page int, size int) ([]*models.Notification, int, error) {
+	ret := _mock.Called(ctx, filter, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchNotifications")
+	}
+
+	var r0 []*models.Notification
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter, int, int) ([]*models.Notification, int, error)); ok {
+		return returnFunc(ctx, filter, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter, int, int) []*models.Notification); ok {
+		r0 = returnFunc(ctx, filter, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.NotificationFilter, int, int) int); ok {
+		r1 = returnFunc(ctx, filter, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *models.NotificationFilter, int, int) error); ok {
+		r2 = returnFunc(ctx, filter, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationRepository_SearchNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNotifications'
+type MockNotificationRepository_SearchNotifications_Call struct {
+	*mock.Call
+}
+
+// SearchNotifications is a helper method to define mock.On call
+//   - ctx
+//   - filter
+//   - page
+//   - size
+func (_e *MockNotificationRepository_Expecter) SearchNotifications(ctx interface{}, filter interface{}, page interface{}, size interface{}) *MockNotificationRepository_SearchNotifications_Call {
+	return &MockNotificationRepository_SearchNotifications_Call{Call: _e.mock.On("SearchNotifications", ctx, filter, page, size)}
+}
+
+func (_c *MockNotificationRepository_SearchNotifications_Call) Run(run func(ctx context.Context, filter *models.NotificationFilter, page int, size int)) *MockNotificationRepository_SearchNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationFilter), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_SearchNotifications_Call) Return(notifications []*models.Notification, n int, err error) *MockNotificationRepository_SearchNotifications_Call {
+	_c.Call.Return(notifications, n, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_SearchNotifications_Call) RunAndReturn(run func(ctx context.Context, filter *models.NotificationFilter, page int, size int) ([]*models.Notification, int, error)) *MockNotificationRepository_SearchNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamNotifications provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) StreamNotifications(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamNotifications")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter, func(*models.Notification) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepository_StreamNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamNotifications'
+type MockNotificationRepository_StreamNotifications_Call struct {
+	*mock.Call
+}
+
+// StreamNotifications is a helper method to define mock.On call
+//   - ctx
+//   - filter
+//   - fn
+func (_e *MockNotificationRepository_Expecter) StreamNotifications(ctx interface{}, filter interface{}, fn interface{}) *MockNotificationRepository_StreamNotifications_Call {
+	return &MockNotificationRepository_StreamNotifications_Call{Call: _e.mock.On("StreamNotifications", ctx, filter, fn)}
+}
+
+func (_c *MockNotificationRepository_StreamNotifications_Call) Run(run func(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error)) *MockNotificationRepository_StreamNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationFilter), args[2].(func(*models.Notification) error))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_StreamNotifications_Call) Return(err error) *MockNotificationRepository_StreamNotifications_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_StreamNotifications_Call) RunAndReturn(run func(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error) *MockNotificationRepository_StreamNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuppressEmail provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) SuppressEmail(ctx context.Context, suppression *models.EmailSuppression) (bool, error) {
+	ret := _mock.Called(ctx, suppression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuppressEmail")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.EmailSuppression) (bool, error)); ok {
+		return returnFunc(ctx, suppression)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.EmailSuppression) bool); ok {
+		r0 = returnFunc(ctx, suppression)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.EmailSuppression) error); ok {
+		r1 = returnFunc(ctx, suppression)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_SuppressEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuppressEmail'
+type MockNotificationRepository_SuppressEmail_Call struct {
+	*mock.Call
+}
+
+// SuppressEmail is a helper method to define mock.On call
+//   - ctx
+//   - suppression
+func (_e *MockNotificationRepository_Expecter) SuppressEmail(ctx interface{}, suppression interface{}) *MockNotificationRepository_SuppressEmail_Call {
+	return &MockNotificationRepository_SuppressEmail_Call{Call: _e.mock.On("SuppressEmail", ctx, suppression)}
+}
+
+func (_c *MockNotificationRepository_SuppressEmail_Call) Run(run func(ctx context.Context, suppression *models.EmailSuppression)) *MockNotificationRepository_SuppressEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.EmailSuppression))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_SuppressEmail_Call) Return(b bool, err error) *MockNotificationRepository_SuppressEmail_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_SuppressEmail_Call) RunAndReturn(run func(ctx context.Context, suppression *models.EmailSuppression) (bool, error)) *MockNotificationRepository_SuppressEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateNotificationStatus provides a mock function for the type MockNotificationRepository
 func (_mock *MockNotificationRepository) UpdateNotificationStatus(ctx context.Context, id uuid.UUID, status models.NotificationStatus, errorMsg string) error {
 	ret := _mock.Called(ctx, id, status, errorMsg)
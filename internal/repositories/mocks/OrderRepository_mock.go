@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/google/uuid"
@@ -39,6 +40,63 @@ func (_m *MockOrderRepository) EXPECT() *MockOrderRepository_Expecter {
 	return &MockOrderRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountPurchasedUnits provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) CountPurchasedUnits(ctx context.Context, customerID uuid.UUID, productID uuid.UUID, since time.Time) (int, error) {
+	ret := _mock.Called(ctx, customerID, productID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPurchasedUnits")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (int, error)); ok {
+		return returnFunc(ctx, customerID, productID, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) int); ok {
+		r0 = returnFunc(ctx, customerID, productID, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, customerID, productID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_CountPurchasedUnits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPurchasedUnits'
+type MockOrderRepository_CountPurchasedUnits_Call struct {
+	*mock.Call
+}
+
+// CountPurchasedUnits is a helper method to define mock.On call
+//   - ctx
+//   - customerID
+//   - productID
+//   - since
+func (_e *MockOrderRepository_Expecter) CountPurchasedUnits(ctx interface{}, customerID interface{}, productID interface{}, since interface{}) *MockOrderRepository_CountPurchasedUnits_Call {
+	return &MockOrderRepository_CountPurchasedUnits_Call{Call: _e.mock.On("CountPurchasedUnits", ctx, customerID, productID, since)}
+}
+
+func (_c *MockOrderRepository_CountPurchasedUnits_Call) Run(run func(ctx context.Context, customerID uuid.UUID, productID uuid.UUID, since time.Time)) *MockOrderRepository_CountPurchasedUnits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_CountPurchasedUnits_Call) Return(n int, err error) *MockOrderRepository_CountPurchasedUnits_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_CountPurchasedUnits_Call) RunAndReturn(run func(ctx context.Context, customerID uuid.UUID, productID uuid.UUID, since time.Time) (int, error)) *MockOrderRepository_CountPurchasedUnits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateOrder provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) CreateOrder(ctx context.Context, order *models.Order) error {
 	ret := _mock.Called(ctx, order)
@@ -85,6 +143,54 @@ func (_c *MockOrderRepository_CreateOrder_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// EditOrder provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) EditOrder(ctx context.Context, order *models.Order, removedItemIDs []uuid.UUID, addedItems []models.OrderItem) error {
+	ret := _mock.Called(ctx, order, removedItemIDs, addedItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditOrder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Order, []uuid.UUID, []models.OrderItem) error); ok {
+		r0 = returnFunc(ctx, order, removedItemIDs, addedItems)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderRepository_EditOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditOrder'
+type MockOrderRepository_EditOrder_Call struct {
+	*mock.Call
+}
+
+// EditOrder is a helper method to define mock.On call
+//   - ctx
+//   - order
+//   - removedItemIDs
+//   - addedItems
+func (_e *MockOrderRepository_Expecter) EditOrder(ctx interface{}, order interface{}, removedItemIDs interface{}, addedItems interface{}) *MockOrderRepository_EditOrder_Call {
+	return &MockOrderRepository_EditOrder_Call{Call: _e.mock.On("EditOrder", ctx, order, removedItemIDs, addedItems)}
+}
+
+func (_c *MockOrderRepository_EditOrder_Call) Run(run func(ctx context.Context, order *models.Order, removedItemIDs []uuid.UUID, addedItems []models.OrderItem)) *MockOrderRepository_EditOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Order), args[2].([]uuid.UUID), args[3].([]models.OrderItem))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_EditOrder_Call) Return(err error) *MockOrderRepository_EditOrder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderRepository_EditOrder_Call) RunAndReturn(run func(ctx context.Context, order *models.Order, removedItemIDs []uuid.UUID, addedItems []models.OrderItem) error) *MockOrderRepository_EditOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrderByID provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) GetOrderByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
 	ret := _mock.Called(ctx, id)
@@ -142,6 +248,278 @@ func (_c *MockOrderRepository_GetOrderByID_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetOrderIDByPaymentIntentID provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) GetOrderIDByPaymentIntentID(ctx context.Context, paymentIntentID string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, paymentIntentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderIDByPaymentIntentID")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, paymentIntentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, paymentIntentID)
+	} else {
+		r0 = ret.Get(0).(uuid.UUID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, paymentIntentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_GetOrderIDByPaymentIntentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderIDByPaymentIntentID'
+type MockOrderRepository_GetOrderIDByPaymentIntentID_Call struct {
+	*mock.Call
+}
+
+// GetOrderIDByPaymentIntentID is a helper method to define mock.On call
+//   - ctx
+//   - paymentIntentID
+func (_e *MockOrderRepository_Expecter) GetOrderIDByPaymentIntentID(ctx interface{}, paymentIntentID interface{}) *MockOrderRepository_GetOrderIDByPaymentIntentID_Call {
+	return &MockOrderRepository_GetOrderIDByPaymentIntentID_Call{Call: _e.mock.On("GetOrderIDByPaymentIntentID", ctx, paymentIntentID)}
+}
+
+func (_c *MockOrderRepository_GetOrderIDByPaymentIntentID_Call) Run(run func(ctx context.Context, paymentIntentID string)) *MockOrderRepository_GetOrderIDByPaymentIntentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_GetOrderIDByPaymentIntentID_Call) Return(uUID uuid.UUID, err error) *MockOrderRepository_GetOrderIDByPaymentIntentID_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_GetOrderIDByPaymentIntentID_Call) RunAndReturn(run func(ctx context.Context, paymentIntentID string) (uuid.UUID, error)) *MockOrderRepository_GetOrderIDByPaymentIntentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderStatuses provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) GetOrderStatuses(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.OrderStatus, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatuses")
+	}
+
+	var r0 map[uuid.UUID]models.OrderStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]models.OrderStatus, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]models.OrderStatus); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]models.OrderStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_GetOrderStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatuses'
+type MockOrderRepository_GetOrderStatuses_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatuses is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockOrderRepository_Expecter) GetOrderStatuses(ctx interface{}, ids interface{}) *MockOrderRepository_GetOrderStatuses_Call {
+	return &MockOrderRepository_GetOrderStatuses_Call{Call: _e.mock.On("GetOrderStatuses", ctx, ids)}
+}
+
+func (_c *MockOrderRepository_GetOrderStatuses_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockOrderRepository_GetOrderStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_GetOrderStatuses_Call) Return(m map[uuid.UUID]models.OrderStatus, err error) *MockOrderRepository_GetOrderStatuses_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_GetOrderStatuses_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.OrderStatus, error)) *MockOrderRepository_GetOrderStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentRetryState provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) GetPaymentRetryState(ctx context.Context, id uuid.UUID) (*models.PaymentRetryState, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentRetryState")
+	}
+
+	var r0 *models.PaymentRetryState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.PaymentRetryState, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.PaymentRetryState); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PaymentRetryState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_GetPaymentRetryState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentRetryState'
+type MockOrderRepository_GetPaymentRetryState_Call struct {
+	*mock.Call
+}
+
+// GetPaymentRetryState is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockOrderRepository_Expecter) GetPaymentRetryState(ctx interface{}, id interface{}) *MockOrderRepository_GetPaymentRetryState_Call {
+	return &MockOrderRepository_GetPaymentRetryState_Call{Call: _e.mock.On("GetPaymentRetryState", ctx, id)}
+}
+
+func (_c *MockOrderRepository_GetPaymentRetryState_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOrderRepository_GetPaymentRetryState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_GetPaymentRetryState_Call) Return(paymentRetryState *models.PaymentRetryState, err error) *MockOrderRepository_GetPaymentRetryState_Call {
+	_c.Call.Return(paymentRetryState, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_GetPaymentRetryState_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.PaymentRetryState, error)) *MockOrderRepository_GetPaymentRetryState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LapseOrder provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) LapseOrder(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LapseOrder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderRepository_LapseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LapseOrder'
+type MockOrderRepository_LapseOrder_Call struct {
+	*mock.Call
+}
+
+// LapseOrder is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockOrderRepository_Expecter) LapseOrder(ctx interface{}, id interface{}) *MockOrderRepository_LapseOrder_Call {
+	return &MockOrderRepository_LapseOrder_Call{Call: _e.mock.On("LapseOrder", ctx, id)}
+}
+
+func (_c *MockOrderRepository_LapseOrder_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOrderRepository_LapseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_LapseOrder_Call) Return(err error) *MockOrderRepository_LapseOrder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderRepository_LapseOrder_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockOrderRepository_LapseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrderTimeline provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) ListOrderTimeline(ctx context.Context, id uuid.UUID) ([]models.OrderTimelineEvent, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrderTimeline")
+	}
+
+	var r0 []models.OrderTimelineEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrderTimelineEvent, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrderTimelineEvent); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderTimelineEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_ListOrderTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrderTimeline'
+type MockOrderRepository_ListOrderTimeline_Call struct {
+	*mock.Call
+}
+
+// ListOrderTimeline is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockOrderRepository_Expecter) ListOrderTimeline(ctx interface{}, id interface{}) *MockOrderRepository_ListOrderTimeline_Call {
+	return &MockOrderRepository_ListOrderTimeline_Call{Call: _e.mock.On("ListOrderTimeline", ctx, id)}
+}
+
+func (_c *MockOrderRepository_ListOrderTimeline_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOrderRepository_ListOrderTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_ListOrderTimeline_Call) Return(orderTimelineEvents []models.OrderTimelineEvent, err error) *MockOrderRepository_ListOrderTimeline_Call {
+	_c.Call.Return(orderTimelineEvents, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_ListOrderTimeline_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) ([]models.OrderTimelineEvent, error)) *MockOrderRepository_ListOrderTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListOrdersByCustomer provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) ListOrdersByCustomer(ctx context.Context, customerID uuid.UUID, page int, size int) ([]models.Order, int, error) {
 	ret := _mock.Called(ctx, customerID, page, size)
@@ -207,6 +585,162 @@ func (_c *MockOrderRepository_ListOrdersByCustomer_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ListUnpaidOrderIDs provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) ListUnpaidOrderIDs(ctx context.Context, placedBefore time.Time, limit int) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, placedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnpaidOrderIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, placedBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, placedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, placedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_ListUnpaidOrderIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnpaidOrderIDs'
+type MockOrderRepository_ListUnpaidOrderIDs_Call struct {
+	*mock.Call
+}
+
+// ListUnpaidOrderIDs is a helper method to define mock.On call
+//   - ctx
+//   - placedBefore
+//   - limit
+func (_e *MockOrderRepository_Expecter) ListUnpaidOrderIDs(ctx interface{}, placedBefore interface{}, limit interface{}) *MockOrderRepository_ListUnpaidOrderIDs_Call {
+	return &MockOrderRepository_ListUnpaidOrderIDs_Call{Call: _e.mock.On("ListUnpaidOrderIDs", ctx, placedBefore, limit)}
+}
+
+func (_c *MockOrderRepository_ListUnpaidOrderIDs_Call) Run(run func(ctx context.Context, placedBefore time.Time, limit int)) *MockOrderRepository_ListUnpaidOrderIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_ListUnpaidOrderIDs_Call) Return(uUIDs []uuid.UUID, err error) *MockOrderRepository_ListUnpaidOrderIDs_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_ListUnpaidOrderIDs_Call) RunAndReturn(run func(ctx context.Context, placedBefore time.Time, limit int) ([]uuid.UUID, error)) *MockOrderRepository_ListUnpaidOrderIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamOrdersOfCustomer provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) StreamOrdersOfCustomer(ctx context.Context, customerID uuid.UUID, from time.Time, to time.Time, fn func(*models.Order) error) error {
+	ret := _mock.Called(ctx, customerID, from, to, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamOrdersOfCustomer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time, func(*models.Order) error) error); ok {
+		r0 = returnFunc(ctx, customerID, from, to, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderRepository_StreamOrdersOfCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamOrdersOfCustomer'
+type MockOrderRepository_StreamOrdersOfCustomer_Call struct {
+	*mock.Call
+}
+
+// StreamOrdersOfCustomer is a helper method to define mock.On call
+//   - ctx
+//   - customerID
+//   - from
+//   - to
+//   - fn
+func (_e *MockOrderRepository_Expecter) StreamOrdersOfCustomer(ctx interface{}, customerID interface{}, from interface{}, to interface{}, fn interface{}) *MockOrderRepository_StreamOrdersOfCustomer_Call {
+	return &MockOrderRepository_StreamOrdersOfCustomer_Call{Call: _e.mock.On("StreamOrdersOfCustomer", ctx, customerID, from, to, fn)}
+}
+
+func (_c *MockOrderRepository_StreamOrdersOfCustomer_Call) Run(run func(ctx context.Context, customerID uuid.UUID, from time.Time, to time.Time, fn func(*models.Order) error)) *MockOrderRepository_StreamOrdersOfCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time), args[4].(func(*models.Order) error))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_StreamOrdersOfCustomer_Call) Return(err error) *MockOrderRepository_StreamOrdersOfCustomer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderRepository_StreamOrdersOfCustomer_Call) RunAndReturn(run func(ctx context.Context, customerID uuid.UUID, from time.Time, to time.Time, fn func(*models.Order) error) error) *MockOrderRepository_StreamOrdersOfCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwapPaymentIntent provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) SwapPaymentIntent(ctx context.Context, id uuid.UUID, previousIntentID string, paymentIntentID string, state *models.PaymentRetryState) error {
+	ret := _mock.Called(ctx, id, previousIntentID, paymentIntentID, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwapPaymentIntent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, *models.PaymentRetryState) error); ok {
+		r0 = returnFunc(ctx, id, previousIntentID, paymentIntentID, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderRepository_SwapPaymentIntent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwapPaymentIntent'
+type MockOrderRepository_SwapPaymentIntent_Call struct {
+	*mock.Call
+}
+
+// SwapPaymentIntent is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - previousIntentID
+//   - paymentIntentID
+//   - state
+func (_e *MockOrderRepository_Expecter) SwapPaymentIntent(ctx interface{}, id interface{}, previousIntentID interface{}, paymentIntentID interface{}, state interface{}) *MockOrderRepository_SwapPaymentIntent_Call {
+	return &MockOrderRepository_SwapPaymentIntent_Call{Call: _e.mock.On("SwapPaymentIntent", ctx, id, previousIntentID, paymentIntentID, state)}
+}
+
+func (_c *MockOrderRepository_SwapPaymentIntent_Call) Run(run func(ctx context.Context, id uuid.UUID, previousIntentID string, paymentIntentID string, state *models.PaymentRetryState)) *MockOrderRepository_SwapPaymentIntent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(*models.PaymentRetryState))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_SwapPaymentIntent_Call) Return(err error) *MockOrderRepository_SwapPaymentIntent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderRepository_SwapPaymentIntent_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, previousIntentID string, paymentIntentID string, state *models.PaymentRetryState) error) *MockOrderRepository_SwapPaymentIntent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateOrderStatus provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status models.OrderStatus) (*models.Order, error) {
 	ret := _mock.Called(ctx, id, status)
@@ -265,6 +799,65 @@ func (_c *MockOrderRepository_UpdateOrderStatus_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// UpdateOrderStatuses provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) UpdateOrderStatuses(ctx context.Context, ids []uuid.UUID, status models.OrderStatus, from []models.OrderStatus) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, ids, status, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatuses")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, models.OrderStatus, []models.OrderStatus) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, ids, status, from)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, models.OrderStatus, []models.OrderStatus) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, ids, status, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, models.OrderStatus, []models.OrderStatus) error); ok {
+		r1 = returnFunc(ctx, ids, status, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_UpdateOrderStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderStatuses'
+type MockOrderRepository_UpdateOrderStatuses_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderStatuses is a helper method to define mock.On call
+//   - ctx
+//   - ids
+//   - status
+//   - from
+func (_e *MockOrderRepository_Expecter) UpdateOrderStatuses(ctx interface{}, ids interface{}, status interface{}, from interface{}) *MockOrderRepository_UpdateOrderStatuses_Call {
+	return &MockOrderRepository_UpdateOrderStatuses_Call{Call: _e.mock.On("UpdateOrderStatuses", ctx, ids, status, from)}
+}
+
+func (_c *MockOrderRepository_UpdateOrderStatuses_Call) Run(run func(ctx context.Context, ids []uuid.UUID, status models.OrderStatus, from []models.OrderStatus)) *MockOrderRepository_UpdateOrderStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(models.OrderStatus), args[3].([]models.OrderStatus))
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_UpdateOrderStatuses_Call) Return(uUIDs []uuid.UUID, err error) *MockOrderRepository_UpdateOrderStatuses_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_UpdateOrderStatuses_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID, status models.OrderStatus, from []models.OrderStatus) ([]uuid.UUID, error)) *MockOrderRepository_UpdateOrderStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePaymentStatus provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) UpdatePaymentStatus(ctx context.Context, id uuid.UUID, status models.PaymentStatus, paymentIntentID string) error {
 	ret := _mock.Called(ctx, id, status, paymentIntentID)
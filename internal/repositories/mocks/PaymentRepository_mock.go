@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	mock "github.com/stretchr/testify/mock"
@@ -141,6 +142,122 @@ func (_c *MockPaymentRepository_GetPaymentByID_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetSalesSummary provides a mock function for the type MockPaymentRepository
+func (_mock *MockPaymentRepository) GetSalesSummary(ctx context.Context, from time.Time, to time.Time) ([]models.SalesSummary, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSalesSummary")
+	}
+
+	var r0 []models.SalesSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]models.SalesSummary, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []models.SalesSummary); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SalesSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentRepository_GetSalesSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSalesSummary'
+type MockPaymentRepository_GetSalesSummary_Call struct {
+	*mock.Call
+}
+
+// GetSalesSummary is a helper method to define mock.On call
+//   - ctx
+//   - from
+//   - to
+func (_e *MockPaymentRepository_Expecter) GetSalesSummary(ctx interface{}, from interface{}, to interface{}) *MockPaymentRepository_GetSalesSummary_Call {
+	return &MockPaymentRepository_GetSalesSummary_Call{Call: _e.mock.On("GetSalesSummary", ctx, from, to)}
+}
+
+func (_c *MockPaymentRepository_GetSalesSummary_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockPaymentRepository_GetSalesSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPaymentRepository_GetSalesSummary_Call) Return(salesSummarys []models.SalesSummary, err error) *MockPaymentRepository_GetSalesSummary_Call {
+	_c.Call.Return(salesSummarys, err)
+	return _c
+}
+
+func (_c *MockPaymentRepository_GetSalesSummary_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) ([]models.SalesSummary, error)) *MockPaymentRepository_GetSalesSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrphanedPaymentIDs provides a mock function for the type MockPaymentRepository
+func (_mock *MockPaymentRepository) ListOrphanedPaymentIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	ret := _mock.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrphanedPaymentIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]string, error)); ok {
+		return returnFunc(ctx, createdBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []string); ok {
+		r0 = returnFunc(ctx, createdBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentRepository_ListOrphanedPaymentIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrphanedPaymentIDs'
+type MockPaymentRepository_ListOrphanedPaymentIDs_Call struct {
+	*mock.Call
+}
+
+// ListOrphanedPaymentIDs is a helper method to define mock.On call
+//   - ctx
+//   - createdBefore
+//   - limit
+func (_e *MockPaymentRepository_Expecter) ListOrphanedPaymentIDs(ctx interface{}, createdBefore interface{}, limit interface{}) *MockPaymentRepository_ListOrphanedPaymentIDs_Call {
+	return &MockPaymentRepository_ListOrphanedPaymentIDs_Call{Call: _e.mock.On("ListOrphanedPaymentIDs", ctx, createdBefore, limit)}
+}
+
+func (_c *MockPaymentRepository_ListOrphanedPaymentIDs_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *MockPaymentRepository_ListOrphanedPaymentIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockPaymentRepository_ListOrphanedPaymentIDs_Call) Return(ss []string, err error) *MockPaymentRepository_ListOrphanedPaymentIDs_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockPaymentRepository_ListOrphanedPaymentIDs_Call) RunAndReturn(run func(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)) *MockPaymentRepository_ListOrphanedPaymentIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPaymentsOfCustomer provides a mock function for the type MockPaymentRepository
 func (_mock *MockPaymentRepository) ListPaymentsOfCustomer(ctx context.Context, customerID string, page int, size int) ([]*models.Payment, int, error) {
 	ret := _mock.Called(ctx, customerID, page, size)
@@ -206,6 +323,102 @@ func (_c *MockPaymentRepository_ListPaymentsOfCustomer_Call) RunAndReturn(run fu
 	return _c
 }
 
+// StreamPayments provides a mock function for the type MockPaymentRepository
+func (_mock *MockPaymentRepository) StreamPayments(ctx context.Context, from time.Time, to time.Time, fn func(models.Payment) error) error {
+	ret := _mock.Called(ctx, from, to, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPayments")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, func(models.Payment) error) error); ok {
+		r0 = returnFunc(ctx, from, to, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentRepository_StreamPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPayments'
+type MockPaymentRepository_StreamPayments_Call struct {
+	*mock.Call
+}
+
+// StreamPayments is a helper method to define mock.On call
+//   - ctx
+//   - from
+//   - to
+//   - fn
+func (_e *MockPaymentRepository_Expecter) StreamPayments(ctx interface{}, from interface{}, to interface{}, fn interface{}) *MockPaymentRepository_StreamPayments_Call {
+	return &MockPaymentRepository_StreamPayments_Call{Call: _e.mock.On("StreamPayments", ctx, from, to, fn)}
+}
+
+func (_c *MockPaymentRepository_StreamPayments_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, fn func(models.Payment) error)) *MockPaymentRepository_StreamPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(func(models.Payment) error))
+	})
+	return _c
+}
+
+func (_c *MockPaymentRepository_StreamPayments_Call) Return(err error) *MockPaymentRepository_StreamPayments_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentRepository_StreamPayments_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time, fn func(models.Payment) error) error) *MockPaymentRepository_StreamPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePaymentFees provides a mock function for the type MockPaymentRepository
+func (_mock *MockPaymentRepository) UpdatePaymentFees(ctx context.Context, id string, feeAmount int64, netAmount int64) error {
+	ret := _mock.Called(ctx, id, feeAmount, netAmount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePaymentFees")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, int64) error); ok {
+		r0 = returnFunc(ctx, id, feeAmount, netAmount)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentRepository_UpdatePaymentFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentFees'
+type MockPaymentRepository_UpdatePaymentFees_Call struct {
+	*mock.Call
+}
+
+// UpdatePaymentFees is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - feeAmount
+//   - netAmount
+func (_e *MockPaymentRepository_Expecter) UpdatePaymentFees(ctx interface{}, id interface{}, feeAmount interface{}, netAmount interface{}) *MockPaymentRepository_UpdatePaymentFees_Call {
+	return &MockPaymentRepository_UpdatePaymentFees_Call{Call: _e.mock.On("UpdatePaymentFees", ctx, id, feeAmount, netAmount)}
+}
+
+func (_c *MockPaymentRepository_UpdatePaymentFees_Call) Run(run func(ctx context.Context, id string, feeAmount int64, netAmount int64)) *MockPaymentRepository_UpdatePaymentFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockPaymentRepository_UpdatePaymentFees_Call) Return(err error) *MockPaymentRepository_UpdatePaymentFees_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentRepository_UpdatePaymentFees_Call) RunAndReturn(run func(ctx context.Context, id string, feeAmount int64, netAmount int64) error) *MockPaymentRepository_UpdatePaymentFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePaymentStatus provides a mock function for the type MockPaymentRepository
 func (_mock *MockPaymentRepository) UpdatePaymentStatus(ctx context.Context, id string, status models.PaymentStatus) error {
 	ret := _mock.Called(ctx, id, status)
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/google/uuid"
@@ -39,6 +40,54 @@ func (_m *MockProductRepository) EXPECT() *MockProductRepository_Expecter {
 	return &MockProductRepository_Expecter{mock: &_m.Mock}
 }
 
+// ApplyQuickEdits provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ApplyQuickEdits(ctx context.Context, changes []models.ProductQuickEditChange, movements []*models.StockMovement, entries []*models.AuditEntry) error {
+	ret := _mock.Called(ctx, changes, movements, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyQuickEdits")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.ProductQuickEditChange, []*models.StockMovement, []*models.AuditEntry) error); ok {
+		r0 = returnFunc(ctx, changes, movements, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_ApplyQuickEdits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyQuickEdits'
+type MockProductRepository_ApplyQuickEdits_Call struct {
+	*mock.Call
+}
+
+// ApplyQuickEdits is a helper method to define mock.On call
+//   - ctx
+//   - changes
+//   - movements
+//   - entries
+func (_e *MockProductRepository_Expecter) ApplyQuickEdits(ctx interface{}, changes interface{}, movements interface{}, entries interface{}) *MockProductRepository_ApplyQuickEdits_Call {
+	return &MockProductRepository_ApplyQuickEdits_Call{Call: _e.mock.On("ApplyQuickEdits", ctx, changes, movements, entries)}
+}
+
+func (_c *MockProductRepository_ApplyQuickEdits_Call) Run(run func(ctx context.Context, changes []models.ProductQuickEditChange, movements []*models.StockMovement, entries []*models.AuditEntry)) *MockProductRepository_ApplyQuickEdits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.ProductQuickEditChange), args[2].([]*models.StockMovement), args[3].([]*models.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ApplyQuickEdits_Call) Return(err error) *MockProductRepository_ApplyQuickEdits_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_ApplyQuickEdits_Call) RunAndReturn(run func(ctx context.Context, changes []models.ProductQuickEditChange, movements []*models.StockMovement, entries []*models.AuditEntry) error) *MockProductRepository_ApplyQuickEdits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProduct provides a mock function for the type MockProductRepository
 func (_mock *MockProductRepository) CreateProduct(ctx context.Context, product *models.Product) error {
 	ret := _mock.Called(ctx, product)
@@ -85,6 +134,63 @@ func (_c *MockProductRepository_CreateProduct_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetProductByBarcode provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetProductByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	ret := _mock.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByBarcode")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.Product, error)); ok {
+		return returnFunc(ctx, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.Product); ok {
+		r0 = returnFunc(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_GetProductByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByBarcode'
+type MockProductRepository_GetProductByBarcode_Call struct {
+	*mock.Call
+}
+
+// GetProductByBarcode is a helper method to define mock.On call
+//   - ctx
+//   - barcode
+func (_e *MockProductRepository_Expecter) GetProductByBarcode(ctx interface{}, barcode interface{}) *MockProductRepository_GetProductByBarcode_Call {
+	return &MockProductRepository_GetProductByBarcode_Call{Call: _e.mock.On("GetProductByBarcode", ctx, barcode)}
+}
+
+func (_c *MockProductRepository_GetProductByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockProductRepository_GetProductByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetProductByBarcode_Call) Return(product *models.Product, err error) *MockProductRepository_GetProductByBarcode_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_GetProductByBarcode_Call) RunAndReturn(run func(ctx context.Context, barcode string) (*models.Product, error)) *MockProductRepository_GetProductByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProductByID provides a mock function for the type MockProductRepository
 func (_mock *MockProductRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error) {
 	ret := _mock.Called(ctx, id)
@@ -142,112 +248,1200 @@ func (_c *MockProductRepository_GetProductByID_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
-// ListProducts provides a mock function for the type MockProductRepository
-func (_mock *MockProductRepository) ListProducts(ctx context.Context, page int, size int) ([]*models.Product, int, error) {
-	ret := _mock.Called(ctx, page, size)
+// GetProductBySKU provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetProductBySKU(ctx context.Context, sku string) (*models.Product, error) {
+	ret := _mock.Called(ctx, sku)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListProducts")
+		panic("no return value specified for GetProductBySKU")
 	}
 
-	var r0 []*models.Product
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*models.Product, int, error)); ok {
-		return returnFunc(ctx, page, size)
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.Product, error)); ok {
+		return returnFunc(ctx, sku)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*models.Product); ok {
-		r0 = returnFunc(ctx, page, size)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.Product); ok {
+		r0 = returnFunc(ctx, sku)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Product)
+			r0 = ret.Get(0).(*models.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
-		r1 = returnFunc(ctx, page, size)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sku)
 	} else {
-		r1 = ret.Get(1).(int)
+		r1 = ret.Error(1)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
-		r2 = returnFunc(ctx, page, size)
+	return r0, r1
+}
+
+// MockProductRepository_GetProductBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBySKU'
+type MockProductRepository_GetProductBySKU_Call struct {
+	*mock.Call
+}
+
+// GetProductBySKU is a helper method to define mock.On call
+//   - ctx
+//   - sku
+func (_e *MockProductRepository_Expecter) GetProductBySKU(ctx interface{}, sku interface{}) *MockProductRepository_GetProductBySKU_Call {
+	return &MockProductRepository_GetProductBySKU_Call{Call: _e.mock.On("GetProductBySKU", ctx, sku)}
+}
+
+func (_c *MockProductRepository_GetProductBySKU_Call) Run(run func(ctx context.Context, sku string)) *MockProductRepository_GetProductBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetProductBySKU_Call) Return(product *models.Product, err error) *MockProductRepository_GetProductBySKU_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_GetProductBySKU_Call) RunAndReturn(run func(ctx context.Context, sku string) (*models.Product, error)) *MockProductRepository_GetProductBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductChangeCursor provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetProductChangeCursor(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductChangeCursor")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0, r1, r2
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductRepository_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
-type MockProductRepository_ListProducts_Call struct {
+// MockProductRepository_GetProductChangeCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductChangeCursor'
+type MockProductRepository_GetProductChangeCursor_Call struct {
 	*mock.Call
 }
 
-// ListProducts is a helper method to define mock.On call
+// GetProductChangeCursor is a helper method to define mock.On call
 //   - ctx
-//   - page
-//   - size
-func (_e *MockProductRepository_Expecter) ListProducts(ctx interface{}, page interface{}, size interface{}) *MockProductRepository_ListProducts_Call {
-	return &MockProductRepository_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, page, size)}
+func (_e *MockProductRepository_Expecter) GetProductChangeCursor(ctx interface{}) *MockProductRepository_GetProductChangeCursor_Call {
+	return &MockProductRepository_GetProductChangeCursor_Call{Call: _e.mock.On("GetProductChangeCursor", ctx)}
 }
 
-func (_c *MockProductRepository_ListProducts_Call) Run(run func(ctx context.Context, page int, size int)) *MockProductRepository_ListProducts_Call {
+func (_c *MockProductRepository_GetProductChangeCursor_Call) Run(run func(ctx context.Context)) *MockProductRepository_GetProductChangeCursor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(int))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockProductRepository_ListProducts_Call) Return(products []*models.Product, n int, err error) *MockProductRepository_ListProducts_Call {
-	_c.Call.Return(products, n, err)
+func (_c *MockProductRepository_GetProductChangeCursor_Call) Return(n int64, err error) *MockProductRepository_GetProductChangeCursor_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockProductRepository_ListProducts_Call) RunAndReturn(run func(ctx context.Context, page int, size int) ([]*models.Product, int, error)) *MockProductRepository_ListProducts_Call {
+func (_c *MockProductRepository_GetProductChangeCursor_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockProductRepository_GetProductChangeCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateProduct provides a mock function for the type MockProductRepository
-func (_mock *MockProductRepository) UpdateProduct(ctx context.Context, product *models.Product) error {
-	ret := _mock.Called(ctx, product)
+// GetProductFacets provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetProductFacets(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProduct")
+		panic("no return value specified for GetProductFacets")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Product) error); ok {
-		r0 = returnFunc(ctx, product)
+	var r0 *models.ProductFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ProductFilter) (*models.ProductFacets, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ProductFilter) *models.ProductFacets); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductFacets)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.ProductFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductRepository_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
-type MockProductRepository_UpdateProduct_Call struct {
+// MockProductRepository_GetProductFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFacets'
+type MockProductRepository_GetProductFacets_Call struct {
 	*mock.Call
 }
 
-// UpdateProduct is a helper method to define mock.On call
+// GetProductFacets is a helper method to define mock.On call
 //   - ctx
-//   - product
-func (_e *MockProductRepository_Expecter) UpdateProduct(ctx interface{}, product interface{}) *MockProductRepository_UpdateProduct_Call {
-	return &MockProductRepository_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, product)}
+//   - filter
+func (_e *MockProductRepository_Expecter) GetProductFacets(ctx interface{}, filter interface{}) *MockProductRepository_GetProductFacets_Call {
+	return &MockProductRepository_GetProductFacets_Call{Call: _e.mock.On("GetProductFacets", ctx, filter)}
 }
 
-func (_c *MockProductRepository_UpdateProduct_Call) Run(run func(ctx context.Context, product *models.Product)) *MockProductRepository_UpdateProduct_Call {
+func (_c *MockProductRepository_GetProductFacets_Call) Run(run func(ctx context.Context, filter *models.ProductFilter)) *MockProductRepository_GetProductFacets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.Product))
+		run(args[0].(context.Context), args[1].(*models.ProductFilter))
 	})
 	return _c
 }
 
-func (_c *MockProductRepository_UpdateProduct_Call) Return(err error) *MockProductRepository_UpdateProduct_Call {
-	_c.Call.Return(err)
+func (_c *MockProductRepository_GetProductFacets_Call) Return(productFacets *models.ProductFacets, err error) *MockProductRepository_GetProductFacets_Call {
+	_c.Call.Return(productFacets, err)
 	return _c
 }
 
-func (_c *MockProductRepository_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, product *models.Product) error) *MockProductRepository_UpdateProduct_Call {
+func (_c *MockProductRepository_GetProductFacets_Call) RunAndReturn(run func(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error)) *MockProductRepository_GetProductFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVendorProduct provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetVendorProduct(ctx context.Context, vendorID uuid.UUID, id uuid.UUID) (*models.Product, error) {
+	ret := _mock.Called(ctx, vendorID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVendorProduct")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Product, error)); ok {
+		return returnFunc(ctx, vendorID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Product); ok {
+		r0 = returnFunc(ctx, vendorID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, vendorID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_GetVendorProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVendorProduct'
+type MockProductRepository_GetVendorProduct_Call struct {
+	*mock.Call
+}
+
+// GetVendorProduct is a helper method to define mock.On call
+//   - ctx
+//   - vendorID
+//   - id
+func (_e *MockProductRepository_Expecter) GetVendorProduct(ctx interface{}, vendorID interface{}, id interface{}) *MockProductRepository_GetVendorProduct_Call {
+	return &MockProductRepository_GetVendorProduct_Call{Call: _e.mock.On("GetVendorProduct", ctx, vendorID, id)}
+}
+
+func (_c *MockProductRepository_GetVendorProduct_Call) Run(run func(ctx context.Context, vendorID uuid.UUID, id uuid.UUID)) *MockProductRepository_GetVendorProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetVendorProduct_Call) Return(product *models.Product, err error) *MockProductRepository_GetVendorProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_GetVendorProduct_Call) RunAndReturn(run func(ctx context.Context, vendorID uuid.UUID, id uuid.UUID) (*models.Product, error)) *MockProductRepository_GetVendorProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChangedSnapshotProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListChangedSnapshotProducts(ctx context.Context, after int64, upTo int64, limit int) ([]models.SnapshotProduct, error) {
+	ret := _mock.Called(ctx, after, upTo, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChangedSnapshotProducts")
+	}
+
+	var r0 []models.SnapshotProduct
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int) ([]models.SnapshotProduct, error)); ok {
+		return returnFunc(ctx, after, upTo, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int) []models.SnapshotProduct); ok {
+		r0 = returnFunc(ctx, after, upTo, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SnapshotProduct)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, int) error); ok {
+		r1 = returnFunc(ctx, after, upTo, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListChangedSnapshotProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChangedSnapshotProducts'
+type MockProductRepository_ListChangedSnapshotProducts_Call struct {
+	*mock.Call
+}
+
+// ListChangedSnapshotProducts is a helper method to define mock.On call
+//   - ctx
+//   - after
+//   - upTo
+//   - limit
+func (_e *MockProductRepository_Expecter) ListChangedSnapshotProducts(ctx interface{}, after interface{}, upTo interface{}, limit interface{}) *MockProductRepository_ListChangedSnapshotProducts_Call {
+	return &MockProductRepository_ListChangedSnapshotProducts_Call{Call: _e.mock.On("ListChangedSnapshotProducts", ctx, after, upTo, limit)}
+}
+
+func (_c *MockProductRepository_ListChangedSnapshotProducts_Call) Run(run func(ctx context.Context, after int64, upTo int64, limit int)) *MockProductRepository_ListChangedSnapshotProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListChangedSnapshotProducts_Call) Return(snapshotProducts []models.SnapshotProduct, err error) *MockProductRepository_ListChangedSnapshotProducts_Call {
+	_c.Call.Return(snapshotProducts, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListChangedSnapshotProducts_Call) RunAndReturn(run func(ctx context.Context, after int64, upTo int64, limit int) ([]models.SnapshotProduct, error)) *MockProductRepository_ListChangedSnapshotProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPricesByFilter provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListPricesByFilter(ctx context.Context, filter *models.ProductFilter, limit int) ([]models.PriceChange, error) {
+	ret := _mock.Called(ctx, filter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPricesByFilter")
+	}
+
+	var r0 []models.PriceChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ProductFilter, int) ([]models.PriceChange, error)); ok {
+		return returnFunc(ctx, filter, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ProductFilter, int) []models.PriceChange); ok {
+		r0 = returnFunc(ctx, filter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PriceChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.ProductFilter, int) error); ok {
+		r1 = returnFunc(ctx, filter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListPricesByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPricesByFilter'
+type MockProductRepository_ListPricesByFilter_Call struct {
+	*mock.Call
+}
+
+// ListPricesByFilter is a helper method to define mock.On call
+//   - ctx
+//   - filter
+//   - limit
+func (_e *MockProductRepository_Expecter) ListPricesByFilter(ctx interface{}, filter interface{}, limit interface{}) *MockProductRepository_ListPricesByFilter_Call {
+	return &MockProductRepository_ListPricesByFilter_Call{Call: _e.mock.On("ListPricesByFilter", ctx, filter, limit)}
+}
+
+func (_c *MockProductRepository_ListPricesByFilter_Call) Run(run func(ctx context.Context, filter *models.ProductFilter, limit int)) *MockProductRepository_ListPricesByFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ProductFilter), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListPricesByFilter_Call) Return(priceChanges []models.PriceChange, err error) *MockProductRepository_ListPricesByFilter_Call {
+	_c.Call.Return(priceChanges, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListPricesByFilter_Call) RunAndReturn(run func(ctx context.Context, filter *models.ProductFilter, limit int) ([]models.PriceChange, error)) *MockProductRepository_ListPricesByFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPricesBySKU provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListPricesBySKU(ctx context.Context, skus []string) ([]models.PriceChange, error) {
+	ret := _mock.Called(ctx, skus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPricesBySKU")
+	}
+
+	var r0 []models.PriceChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]models.PriceChange, error)); ok {
+		return returnFunc(ctx, skus)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []models.PriceChange); ok {
+		r0 = returnFunc(ctx, skus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PriceChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, skus)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListPricesBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPricesBySKU'
+type MockProductRepository_ListPricesBySKU_Call struct {
+	*mock.Call
+}
+
+// ListPricesBySKU is a helper method to define mock.On call
+//   - ctx
+//   - skus
+func (_e *MockProductRepository_Expecter) ListPricesBySKU(ctx interface{}, skus interface{}) *MockProductRepository_ListPricesBySKU_Call {
+	return &MockProductRepository_ListPricesBySKU_Call{Call: _e.mock.On("ListPricesBySKU", ctx, skus)}
+}
+
+func (_c *MockProductRepository_ListPricesBySKU_Call) Run(run func(ctx context.Context, skus []string)) *MockProductRepository_ListPricesBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListPricesBySKU_Call) Return(priceChanges []models.PriceChange, err error) *MockProductRepository_ListPricesBySKU_Call {
+	_c.Call.Return(priceChanges, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListPricesBySKU_Call) RunAndReturn(run func(ctx context.Context, skus []string) ([]models.PriceChange, error)) *MockProductRepository_ListPricesBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductChanges provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListProductChanges(ctx context.Context, after int64, limit int) ([]models.ProductChange, error) {
+	ret := _mock.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProductChanges")
+	}
+
+	var r0 []models.ProductChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) ([]models.ProductChange, error)); ok {
+		return returnFunc(ctx, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) []models.ProductChange); ok {
+		r0 = returnFunc(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListProductChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductChanges'
+type MockProductRepository_ListProductChanges_Call struct {
+	*mock.Call
+}
+
+// ListProductChanges is a helper method to define mock.On call
+//   - ctx
+//   - after
+//   - limit
+func (_e *MockProductRepository_Expecter) ListProductChanges(ctx interface{}, after interface{}, limit interface{}) *MockProductRepository_ListProductChanges_Call {
+	return &MockProductRepository_ListProductChanges_Call{Call: _e.mock.On("ListProductChanges", ctx, after, limit)}
+}
+
+func (_c *MockProductRepository_ListProductChanges_Call) Run(run func(ctx context.Context, after int64, limit int)) *MockProductRepository_ListProductChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListProductChanges_Call) Return(productChanges []models.ProductChange, err error) *MockProductRepository_ListProductChanges_Call {
+	_c.Call.Return(productChanges, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListProductChanges_Call) RunAndReturn(run func(ctx context.Context, after int64, limit int) ([]models.ProductChange, error)) *MockProductRepository_ListProductChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductImages provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListProductImages(ctx context.Context, productID uuid.UUID) ([]string, error) {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProductImages")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return returnFunc(ctx, productID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListProductImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductImages'
+type MockProductRepository_ListProductImages_Call struct {
+	*mock.Call
+}
+
+// ListProductImages is a helper method to define mock.On call
+//   - ctx
+//   - productID
+func (_e *MockProductRepository_Expecter) ListProductImages(ctx interface{}, productID interface{}) *MockProductRepository_ListProductImages_Call {
+	return &MockProductRepository_ListProductImages_Call{Call: _e.mock.On("ListProductImages", ctx, productID)}
+}
+
+func (_c *MockProductRepository_ListProductImages_Call) Run(run func(ctx context.Context, productID uuid.UUID)) *MockProductRepository_ListProductImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListProductImages_Call) Return(ss []string, err error) *MockProductRepository_ListProductImages_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListProductImages_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID) ([]string, error)) *MockProductRepository_ListProductImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListProducts(ctx context.Context, page int, size int) ([]*models.Product, int, error) {
+	ret := _mock.Called(ctx, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProducts")
+	}
+
+	var r0 []*models.Product
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*models.Product, int, error)); ok {
+		return returnFunc(ctx, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*models.Product); ok {
+		r0 = returnFunc(ctx, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = returnFunc(ctx, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockProductRepository_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
+type MockProductRepository_ListProducts_Call struct {
+	*mock.Call
+}
+
+// ListProducts is a helper method to define mock.On call
+//   - ctx
+//   - page
+//   - size
+func (_e *MockProductRepository_Expecter) ListProducts(ctx interface{}, page interface{}, size interface{}) *MockProductRepository_ListProducts_Call {
+	return &MockProductRepository_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, page, size)}
+}
+
+func (_c *MockProductRepository_ListProducts_Call) Run(run func(ctx context.Context, page int, size int)) *MockProductRepository_ListProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListProducts_Call) Return(products []*models.Product, n int, err error) *MockProductRepository_ListProducts_Call {
+	_c.Call.Return(products, n, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListProducts_Call) RunAndReturn(run func(ctx context.Context, page int, size int) ([]*models.Product, int, error)) *MockProductRepository_ListProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQuickEditsBySKU provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListQuickEditsBySKU(ctx context.Context, skus []string) ([]models.ProductQuickEditChange, error) {
+	ret := _mock.Called(ctx, skus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuickEditsBySKU")
+	}
+
+	var r0 []models.ProductQuickEditChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]models.ProductQuickEditChange, error)); ok {
+		return returnFunc(ctx, skus)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []models.ProductQuickEditChange); ok {
+		r0 = returnFunc(ctx, skus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductQuickEditChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, skus)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListQuickEditsBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQuickEditsBySKU'
+type MockProductRepository_ListQuickEditsBySKU_Call struct {
+	*mock.Call
+}
+
+// ListQuickEditsBySKU is a helper method to define mock.On call
+//   - ctx
+//   - skus
+func (_e *MockProductRepository_Expecter) ListQuickEditsBySKU(ctx interface{}, skus interface{}) *MockProductRepository_ListQuickEditsBySKU_Call {
+	return &MockProductRepository_ListQuickEditsBySKU_Call{Call: _e.mock.On("ListQuickEditsBySKU", ctx, skus)}
+}
+
+func (_c *MockProductRepository_ListQuickEditsBySKU_Call) Run(run func(ctx context.Context, skus []string)) *MockProductRepository_ListQuickEditsBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListQuickEditsBySKU_Call) Return(productQuickEditChanges []models.ProductQuickEditChange, err error) *MockProductRepository_ListQuickEditsBySKU_Call {
+	_c.Call.Return(productQuickEditChanges, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListQuickEditsBySKU_Call) RunAndReturn(run func(ctx context.Context, skus []string) ([]models.ProductQuickEditChange, error)) *MockProductRepository_ListQuickEditsBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRelatedProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListRelatedProducts(ctx context.Context, productID uuid.UUID) ([]models.RelatedProduct, error) {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelatedProducts")
+	}
+
+	var r0 []models.RelatedProduct
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RelatedProduct, error)); ok {
+		return returnFunc(ctx, productID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RelatedProduct); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RelatedProduct)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListRelatedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRelatedProducts'
+type MockProductRepository_ListRelatedProducts_Call struct {
+	*mock.Call
+}
+
+// ListRelatedProducts is a helper method to define mock.On call
+//   - ctx
+//   - productID
+func (_e *MockProductRepository_Expecter) ListRelatedProducts(ctx interface{}, productID interface{}) *MockProductRepository_ListRelatedProducts_Call {
+	return &MockProductRepository_ListRelatedProducts_Call{Call: _e.mock.On("ListRelatedProducts", ctx, productID)}
+}
+
+func (_c *MockProductRepository_ListRelatedProducts_Call) Run(run func(ctx context.Context, productID uuid.UUID)) *MockProductRepository_ListRelatedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListRelatedProducts_Call) Return(relatedProducts []models.RelatedProduct, err error) *MockProductRepository_ListRelatedProducts_Call {
+	_c.Call.Return(relatedProducts, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListRelatedProducts_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID) ([]models.RelatedProduct, error)) *MockProductRepository_ListRelatedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSnapshotCategories provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListSnapshotCategories(ctx context.Context) ([]models.SnapshotCategory, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshotCategories")
+	}
+
+	var r0 []models.SnapshotCategory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]models.SnapshotCategory, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []models.SnapshotCategory); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SnapshotCategory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_ListSnapshotCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshotCategories'
+type MockProductRepository_ListSnapshotCategories_Call struct {
+	*mock.Call
+}
+
+// ListSnapshotCategories is a helper method to define mock.On call
+//   - ctx
+func (_e *MockProductRepository_Expecter) ListSnapshotCategories(ctx interface{}) *MockProductRepository_ListSnapshotCategories_Call {
+	return &MockProductRepository_ListSnapshotCategories_Call{Call: _e.mock.On("ListSnapshotCategories", ctx)}
+}
+
+func (_c *MockProductRepository_ListSnapshotCategories_Call) Run(run func(ctx context.Context)) *MockProductRepository_ListSnapshotCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListSnapshotCategories_Call) Return(snapshotCategorys []models.SnapshotCategory, err error) *MockProductRepository_ListSnapshotCategories_Call {
+	_c.Call.Return(snapshotCategorys, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListSnapshotCategories_Call) RunAndReturn(run func(ctx context.Context) ([]models.SnapshotCategory, error)) *MockProductRepository_ListSnapshotCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListVendorProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ListVendorProducts(ctx context.Context, vendorID uuid.UUID, page int, size int) ([]*models.Product, int, error) {
+	ret := _mock.Called(ctx, vendorID, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVendorProducts")
+	}
+
+	var r0 []*models.Product
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.Product, int, error)); ok {
+		return returnFunc(ctx, vendorID, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.Product); ok {
+		r0 = returnFunc(ctx, vendorID, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = returnFunc(ctx, vendorID, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, vendorID, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockProductRepository_ListVendorProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVendorProducts'
+type MockProductRepository_ListVendorProducts_Call struct {
+	*mock.Call
+}
+
+// ListVendorProducts is a helper method to define mock.On call
+//   - ctx
+//   - vendorID
+//   - page
+//   - size
+func (_e *MockProductRepository_Expecter) ListVendorProducts(ctx interface{}, vendorID interface{}, page interface{}, size interface{}) *MockProductRepository_ListVendorProducts_Call {
+	return &MockProductRepository_ListVendorProducts_Call{Call: _e.mock.On("ListVendorProducts", ctx, vendorID, page, size)}
+}
+
+func (_c *MockProductRepository_ListVendorProducts_Call) Run(run func(ctx context.Context, vendorID uuid.UUID, page int, size int)) *MockProductRepository_ListVendorProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ListVendorProducts_Call) Return(products []*models.Product, n int, err error) *MockProductRepository_ListVendorProducts_Call {
+	_c.Call.Return(products, n, err)
+	return _c
+}
+
+func (_c *MockProductRepository_ListVendorProducts_Call) RunAndReturn(run func(ctx context.Context, vendorID uuid.UUID, page int, size int) ([]*models.Product, int, error)) *MockProductRepository_ListVendorProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishScheduledProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) PublishScheduledProducts(ctx context.Context, due time.Time, limit int) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, due, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishScheduledProducts")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, due, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, due, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, due, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_PublishScheduledProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishScheduledProducts'
+type MockProductRepository_PublishScheduledProducts_Call struct {
+	*mock.Call
+}
+
+// PublishScheduledProducts is a helper method to define mock.On call
+//   - ctx
+//   - due
+//   - limit
+func (_e *MockProductRepository_Expecter) PublishScheduledProducts(ctx interface{}, due interface{}, limit interface{}) *MockProductRepository_PublishScheduledProducts_Call {
+	return &MockProductRepository_PublishScheduledProducts_Call{Call: _e.mock.On("PublishScheduledProducts", ctx, due, limit)}
+}
+
+func (_c *MockProductRepository_PublishScheduledProducts_Call) Run(run func(ctx context.Context, due time.Time, limit int)) *MockProductRepository_PublishScheduledProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_PublishScheduledProducts_Call) Return(uUIDs []uuid.UUID, err error) *MockProductRepository_PublishScheduledProducts_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockProductRepository_PublishScheduledProducts_Call) RunAndReturn(run func(ctx context.Context, due time.Time, limit int) ([]uuid.UUID, error)) *MockProductRepository_PublishScheduledProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProductImages provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) SetProductImages(ctx context.Context, productID uuid.UUID, urls []string) error {
+	ret := _mock.Called(ctx, productID, urls)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProductImages")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) error); ok {
+		r0 = returnFunc(ctx, productID, urls)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_SetProductImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProductImages'
+type MockProductRepository_SetProductImages_Call struct {
+	*mock.Call
+}
+
+// SetProductImages is a helper method to define mock.On call
+//   - ctx
+//   - productID
+//   - urls
+func (_e *MockProductRepository_Expecter) SetProductImages(ctx interface{}, productID interface{}, urls interface{}) *MockProductRepository_SetProductImages_Call {
+	return &MockProductRepository_SetProductImages_Call{Call: _e.mock.On("SetProductImages", ctx, productID, urls)}
+}
+
+func (_c *MockProductRepository_SetProductImages_Call) Run(run func(ctx context.Context, productID uuid.UUID, urls []string)) *MockProductRepository_SetProductImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_SetProductImages_Call) Return(err error) *MockProductRepository_SetProductImages_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_SetProductImages_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID, urls []string) error) *MockProductRepository_SetProductImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPurchaseLimit provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) SetPurchaseLimit(ctx context.Context, product *models.Product) error {
+	ret := _mock.Called(ctx, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPurchaseLimit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Product) error); ok {
+		r0 = returnFunc(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_SetPurchaseLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPurchaseLimit'
+type MockProductRepository_SetPurchaseLimit_Call struct {
+	*mock.Call
+}
+
+// SetPurchaseLimit is a helper method to define mock.On call
+//   - ctx
+//   - product
+func (_e *MockProductRepository_Expecter) SetPurchaseLimit(ctx interface{}, product interface{}) *MockProductRepository_SetPurchaseLimit_Call {
+	return &MockProductRepository_SetPurchaseLimit_Call{Call: _e.mock.On("SetPurchaseLimit", ctx, product)}
+}
+
+func (_c *MockProductRepository_SetPurchaseLimit_Call) Run(run func(ctx context.Context, product *models.Product)) *MockProductRepository_SetPurchaseLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_SetPurchaseLimit_Call) Return(err error) *MockProductRepository_SetPurchaseLimit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_SetPurchaseLimit_Call) RunAndReturn(run func(ctx context.Context, product *models.Product) error) *MockProductRepository_SetPurchaseLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRelatedProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) SetRelatedProducts(ctx context.Context, productID uuid.UUID, relations []models.ProductRelation) error {
+	ret := _mock.Called(ctx, productID, relations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRelatedProducts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.ProductRelation) error); ok {
+		r0 = returnFunc(ctx, productID, relations)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_SetRelatedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRelatedProducts'
+type MockProductRepository_SetRelatedProducts_Call struct {
+	*mock.Call
+}
+
+// SetRelatedProducts is a helper method to define mock.On call
+//   - ctx
+//   - productID
+//   - relations
+func (_e *MockProductRepository_Expecter) SetRelatedProducts(ctx interface{}, productID interface{}, relations interface{}) *MockProductRepository_SetRelatedProducts_Call {
+	return &MockProductRepository_SetRelatedProducts_Call{Call: _e.mock.On("SetRelatedProducts", ctx, productID, relations)}
+}
+
+func (_c *MockProductRepository_SetRelatedProducts_Call) Run(run func(ctx context.Context, productID uuid.UUID, relations []models.ProductRelation)) *MockProductRepository_SetRelatedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.ProductRelation))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_SetRelatedProducts_Call) Return(err error) *MockProductRepository_SetRelatedProducts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_SetRelatedProducts_Call) RunAndReturn(run func(ctx context.Context, productID uuid.UUID, relations []models.ProductRelation) error) *MockProductRepository_SetRelatedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamSnapshotProducts provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) StreamSnapshotProducts(ctx context.Context, fn func(*models.SnapshotProduct) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamSnapshotProducts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(*models.SnapshotProduct) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_StreamSnapshotProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSnapshotProducts'
+type MockProductRepository_StreamSnapshotProducts_Call struct {
+	*mock.Call
+}
+
+// StreamSnapshotProducts is a helper method to define mock.On call
+//   - ctx
+//   - fn
+func (_e *MockProductRepository_Expecter) StreamSnapshotProducts(ctx interface{}, fn interface{}) *MockProductRepository_StreamSnapshotProducts_Call {
+	return &MockProductRepository_StreamSnapshotProducts_Call{Call: _e.mock.On("StreamSnapshotProducts", ctx, fn)}
+}
+
+func (_c *MockProductRepository_StreamSnapshotProducts_Call) Run(run func(ctx context.Context, fn func(*models.SnapshotProduct) error)) *MockProductRepository_StreamSnapshotProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(*models.SnapshotProduct) error))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_StreamSnapshotProducts_Call) Return(err error) *MockProductRepository_StreamSnapshotProducts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_StreamSnapshotProducts_Call) RunAndReturn(run func(ctx context.Context, fn func(*models.SnapshotProduct) error) error) *MockProductRepository_StreamSnapshotProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePrices provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) UpdatePrices(ctx context.Context, changes []models.PriceChange, entries []*models.AuditEntry) error {
+	ret := _mock.Called(ctx, changes, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrices")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.PriceChange, []*models.AuditEntry) error); ok {
+		r0 = returnFunc(ctx, changes, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_UpdatePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrices'
+type MockProductRepository_UpdatePrices_Call struct {
+	*mock.Call
+}
+
+// UpdatePrices is a helper method to define mock.On call
+//   - ctx
+//   - changes
+//   - entries
+func (_e *MockProductRepository_Expecter) UpdatePrices(ctx interface{}, changes interface{}, entries interface{}) *MockProductRepository_UpdatePrices_Call {
+	return &MockProductRepository_UpdatePrices_Call{Call: _e.mock.On("UpdatePrices", ctx, changes, entries)}
+}
+
+func (_c *MockProductRepository_UpdatePrices_Call) Run(run func(ctx context.Context, changes []models.PriceChange, entries []*models.AuditEntry)) *MockProductRepository_UpdatePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.PriceChange), args[2].([]*models.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_UpdatePrices_Call) Return(err error) *MockProductRepository_UpdatePrices_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_UpdatePrices_Call) RunAndReturn(run func(ctx context.Context, changes []models.PriceChange, entries []*models.AuditEntry) error) *MockProductRepository_UpdatePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProduct provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) UpdateProduct(ctx context.Context, product *models.Product) error {
+	ret := _mock.Called(ctx, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Product) error); ok {
+		r0 = returnFunc(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
+type MockProductRepository_UpdateProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateProduct is a helper method to define mock.On call
+//   - ctx
+//   - product
+func (_e *MockProductRepository_Expecter) UpdateProduct(ctx interface{}, product interface{}) *MockProductRepository_UpdateProduct_Call {
+	return &MockProductRepository_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, product)}
+}
+
+func (_c *MockProductRepository_UpdateProduct_Call) Run(run func(ctx context.Context, product *models.Product)) *MockProductRepository_UpdateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateProduct_Call) Return(err error) *MockProductRepository_UpdateProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, product *models.Product) error) *MockProductRepository_UpdateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateVendorProduct provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) UpdateVendorProduct(ctx context.Context, vendorID uuid.UUID, product *models.Product) error {
+	ret := _mock.Called(ctx, vendorID, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVendorProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.Product) error); ok {
+		r0 = returnFunc(ctx, vendorID, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_UpdateVendorProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVendorProduct'
+type MockProductRepository_UpdateVendorProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateVendorProduct is a helper method to define mock.On call
+//   - ctx
+//   - vendorID
+//   - product
+func (_e *MockProductRepository_Expecter) UpdateVendorProduct(ctx interface{}, vendorID interface{}, product interface{}) *MockProductRepository_UpdateVendorProduct_Call {
+	return &MockProductRepository_UpdateVendorProduct_Call{Call: _e.mock.On("UpdateVendorProduct", ctx, vendorID, product)}
+}
+
+func (_c *MockProductRepository_UpdateVendorProduct_Call) Run(run func(ctx context.Context, vendorID uuid.UUID, product *models.Product)) *MockProductRepository_UpdateVendorProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*models.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateVendorProduct_Call) Return(err error) *MockProductRepository_UpdateVendorProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateVendorProduct_Call) RunAndReturn(run func(ctx context.Context, vendorID uuid.UUID, product *models.Product) error) *MockProductRepository_UpdateVendorProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -103,3 +104,66 @@ func (_c *MockRateLimitRepository_CheckLoginRateLimit_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// CheckRateLimit provides a mock function for the type MockRateLimitRepository
+func (_mock *MockRateLimitRepository) CheckRateLimit(ctx context.Context, key string, limit int64, window time.Duration) (bool, int, error) {
+	ret := _mock.Called(ctx, key, limit, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckRateLimit")
+	}
+
+	var r0 bool
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, time.Duration) (bool, int, error)); ok {
+		return returnFunc(ctx, key, limit, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, time.Duration) bool); ok {
+		r0 = returnFunc(ctx, key, limit, window)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, time.Duration) int); ok {
+		r1 = returnFunc(ctx, key, limit, window)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int64, time.Duration) error); ok {
+		r2 = returnFunc(ctx, key, limit, window)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRateLimitRepository_CheckRateLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckRateLimit'
+type MockRateLimitRepository_CheckRateLimit_Call struct {
+	*mock.Call
+}
+
+// CheckRateLimit is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - limit
+//   - window
+func (_e *MockRateLimitRepository_Expecter) CheckRateLimit(ctx interface{}, key interface{}, limit interface{}, window interface{}) *MockRateLimitRepository_CheckRateLimit_Call {
+	return &MockRateLimitRepository_CheckRateLimit_Call{Call: _e.mock.On("CheckRateLimit", ctx, key, limit, window)}
+}
+
+func (_c *MockRateLimitRepository_CheckRateLimit_Call) Run(run func(ctx context.Context, key string, limit int64, window time.Duration)) *MockRateLimitRepository_CheckRateLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockRateLimitRepository_CheckRateLimit_Call) Return(b bool, n int, err error) *MockRateLimitRepository_CheckRateLimit_Call {
+	_c.Call.Return(b, n, err)
+	return _c
+}
+
+func (_c *MockRateLimitRepository_CheckRateLimit_Call) RunAndReturn(run func(ctx context.Context, key string, limit int64, window time.Duration) (bool, int, error)) *MockRateLimitRepository_CheckRateLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
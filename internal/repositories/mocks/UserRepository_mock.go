@@ -198,3 +198,97 @@ func (_c *MockUserRepository_GetUserByID_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdatePassword provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, password string) error {
+	ret := _mock.Called(ctx, id, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - password
+func (_e *MockUserRepository_Expecter) UpdatePassword(ctx interface{}, id interface{}, password interface{}) *MockUserRepository_UpdatePassword_Call {
+	return &MockUserRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, password)}
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Run(run func(ctx context.Context, id uuid.UUID, password string)) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Return(err error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, password string) error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRole provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdateUserRole(ctx context.Context, id uuid.UUID, role models.UserRole) error {
+	ret := _mock.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UserRole) error); ok {
+		r0 = returnFunc(ctx, id, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRole'
+type MockUserRepository_UpdateUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRole is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - role
+func (_e *MockUserRepository_Expecter) UpdateUserRole(ctx interface{}, id interface{}, role interface{}) *MockUserRepository_UpdateUserRole_Call {
+	return &MockUserRepository_UpdateUserRole_Call{Call: _e.mock.On("UpdateUserRole", ctx, id, role)}
+}
+
+func (_c *MockUserRepository_UpdateUserRole_Call) Run(run func(ctx context.Context, id uuid.UUID, role models.UserRole)) *MockUserRepository_UpdateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UserRole))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateUserRole_Call) Return(err error) *MockUserRepository_UpdateUserRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateUserRole_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, role models.UserRole) error) *MockUserRepository_UpdateUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
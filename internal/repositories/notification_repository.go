@@ -4,12 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type NotificationRepository interface {
@@ -17,6 +20,18 @@ type NotificationRepository interface {
 	GetNotificationByID(ctx context.Context, id uuid.UUID) (*models.Notification, error)
 	UpdateNotificationStatus(ctx context.Context, id uuid.UUID, status models.NotificationStatus, errorMsg string) error
 	ListNotifications(ctx context.Context, page int, size int) ([]*models.Notification, int, error)
+	SearchNotifications(ctx context.Context, filter *models.NotificationFilter, page, size int) ([]*models.Notification, int, error)
+	CountNotificationsByStatus(ctx context.Context, filter *models.NotificationFilter) (map[models.NotificationStatus]int, error)
+	StreamNotifications(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error
+	RecordNotificationFailure(ctx context.Context, notification *models.Notification) error
+	DeferNotification(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time) error
+	ClaimDueNotifications(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.Notification, error)
+	SuppressEmail(ctx context.Context, suppression *models.EmailSuppression) (bool, error)
+	ImportSuppressions(ctx context.Context, suppressions []*models.EmailSuppression, createdBy *uuid.UUID) (int, error)
+	IsEmailSuppressed(ctx context.Context, email string) (bool, error)
+	GetSuppression(ctx context.Context, email string) (*models.EmailSuppression, error)
+	ListSuppressions(ctx context.Context, source models.SuppressionSource, page, size int) ([]*models.EmailSuppression, int, error)
+	DeleteSuppression(ctx context.Context, email string) error
 }
 
 type notificationRepository struct {
@@ -32,11 +47,11 @@ func (r *notificationRepository) CreateNotification(ctx context.Context, notific
 	defer cancel()
 
 	query := `
-		INSERT INTO notifications (id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		INSERT INTO notifications (id, type, recipient, subject, content, status, error_message, metadata, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
 	`
 
-	_, err := r.DB.ExecContext(dbCtx, query, notification.ID, notification.Type, notification.Recipient, notification.Subject, notification.Content, notification.Status, notification.ErrorMessage, notification.Metadata)
+	_, err := r.DB.ExecContext(dbCtx, query, notification.ID, notification.Type, notification.Recipient, notification.Subject, notification.Content, notification.Status, notification.ErrorMessage, notification.Metadata, notification.NextAttemptAt)
 	if err != nil {
 		return fmt.Errorf("failed to create notification: %w", err)
 	}
@@ -49,22 +64,16 @@ func (r *notificationRepository) GetNotificationByID(ctx context.Context, id uui
 	defer cancel()
 
 	query := `
-		SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+		SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
 		FROM notifications
 		WHERE id = $1
 	`
 
-	result := &models.Notification{}
-
-	var metadata []byte
-
-	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&result.ID, &result.Type, &result.Recipient, &result.Subject, &result.Content, &result.Status, &result.ErrorMessage, &metadata, &result.CreatedAt, &result.UpdatedAt)
+	result, err := scanNotification(r.DB.QueryRowContext(dbCtx, query, id))
 	if err != nil {
 		return &models.Notification{}, fmt.Errorf("failed to create notification: %w", err)
 	}
 
-	result.Metadata = json.RawMessage(metadata)
-
 	return result, nil
 }
 
@@ -73,7 +82,7 @@ func (r *notificationRepository) UpdateNotificationStatus(ctx context.Context, i
 	defer cancel()
 
 	query := `
-		UPDATE notifications SET status = $1, error_message = $2, updated_at = $3
+		UPDATE notifications SET status = $1, error_message = $2, next_attempt_at = NULL, updated_at = $3
 		WHERE id = $4
 	`
 
@@ -88,7 +97,7 @@ func (r *notificationRepository) UpdateNotificationStatus(ctx context.Context, i
 	}
 
 	if updatedRows == 0 {
-		return fmt.Errorf("notification not found: %s", id)
+		return fmt.Errorf("notification not found: %s: %w", id, sql.ErrNoRows)
 	}
 
 	return nil
@@ -110,7 +119,7 @@ func (r *notificationRepository) ListNotifications(ctx context.Context, page int
 	offSet := (page - 1) * size
 
 	query := `
-		SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+		SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
 		FROM notifications
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -126,18 +135,102 @@ func (r *notificationRepository) ListNotifications(ctx context.Context, page int
 	notifications := []*models.Notification{}
 
 	for rows.Next() {
-		var notification models.Notification
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan notifications: %w", err)
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+// RecordNotificationFailure stores the outcome of a failed attempt, next_attempt_at is cleared once the notification gave up.
+// notificationFilterClause builds the WHERE clause of the filter, leaving out its status when countByStatus
+// is set.
+func notificationFilterClause(filter *models.NotificationFilter, countByStatus bool) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Status != "" && !countByStatus {
+		add("status = $%d", filter.Status)
+	}
+
+	if filter.Type != "" {
+		add("type = $%d", filter.Type)
+	}
+
+	if filter.Recipient != "" {
+		add("LOWER(recipient) = LOWER($%d)", filter.Recipient)
+	}
+
+	if filter.BroadcastID != nil {
+		add("metadata->>'broadcast_id' = $%d", filter.BroadcastID.String())
+	}
+
+	if filter.Since != nil {
+		add("created_at >= $%d", *filter.Since)
+	}
+
+	if filter.Until != nil {
+		add("created_at < $%d", *filter.Until)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
 
-		var metadata []byte
+// SearchNotifications lists the notifications matching the filter, the latest first.
+func (r *notificationRepository) SearchNotifications(ctx context.Context, filter *models.NotificationFilter, page, size int) ([]*models.Notification, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	where, args := notificationFilterClause(filter, false)
+
+	var total int
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM notifications `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
+		FROM notifications
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
 
-		err := rows.Scan(&notification.ID, &notification.Type, &notification.Recipient, &notification.Subject, &notification.Content, &notification.Status, &metadata, &notification.ErrorMessage, &notification.CreatedAt, &notification.UpdatedAt)
+	rows, err := r.DB.QueryContext(dbCtx, query, append(args, size, (page-1)*size)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []*models.Notification{}
+
+	for rows.Next() {
+		notification, err := scanNotification(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan notifications: %w", err)
 		}
 
-		notification.Metadata = json.RawMessage(metadata)
-
-		notifications = append(notifications, &notification)
+		notifications = append(notifications, notification)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -146,3 +239,364 @@ func (r *notificationRepository) ListNotifications(ctx context.Context, page int
 
 	return notifications, total, nil
 }
+
+// CountNotificationsByStatus counts the notifications matching the filter but its status, per status.
+func (r *notificationRepository) CountNotificationsByStatus(ctx context.Context, filter *models.NotificationFilter) (map[models.NotificationStatus]int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	where, args := notificationFilterClause(filter, true)
+
+	rows, err := r.DB.QueryContext(dbCtx, `SELECT status, COUNT(*) FROM notifications `+where+` GROUP BY status`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications by status: %w", err)
+	}
+	defer rows.Close()
+
+	counts := map[models.NotificationStatus]int{}
+
+	for rows.Next() {
+		var (
+			status models.NotificationStatus
+			count  int
+		)
+
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan notification count: %w", err)
+		}
+
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// StreamNotifications hands the notifications matching the filter to fn one at a time, oldest first,
+// without the query timeout so that large exports can finish. fn's errors are returned as is.
+func (r *notificationRepository) StreamNotifications(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error {
+	where, args := notificationFilterClause(filter, false)
+
+	query := `
+		SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
+		FROM notifications
+		` + where + `
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to stream the notifications: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan the notifications: %w", err)
+		}
+
+		if err := fn(notification); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating notifications: %w", err)
+	}
+
+	return ctx.Err()
+}
+
+func (r *notificationRepository) RecordNotificationFailure(ctx context.Context, notification *models.Notification) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notifications SET status = $1, error_message = $2, retry_count = $3, next_attempt_at = $4, updated_at = NOW()
+		WHERE id = $5
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, notification.Status, notification.ErrorMessage, notification.RetryCount, notification.NextAttemptAt, notification.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record notification failure: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return fmt.Errorf("notification not found: %s", notification.ID)
+	}
+
+	return nil
+}
+
+// DeferNotification queues a notification held back by the send throttle, its retry count is left as is.
+func (r *notificationRepository) DeferNotification(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notifications SET status = 'queued', next_attempt_at = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, nextAttemptAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to defer notification: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return fmt.Errorf("notification not found: %s: %w", id, sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// ClaimDueNotifications picks the failed and queued notifications whose attempt is due and pushes their next attempt
+// to leaseUntil, so that another instance doesn't pick them up while they are being sent.
+func (r *notificationRepository) ClaimDueNotifications(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.Notification, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notifications SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status IN ('failed', 'queued') AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []*models.Notification
+
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notifications: %w", err)
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// SuppressEmail stops any further send to the address and reports whether it was added, the first
+// recorded source and reason are kept.
+func (r *notificationRepository) SuppressEmail(ctx context.Context, suppression *models.EmailSuppression) (bool, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO email_suppressions (email, source, reason, created_by, created_at)
+		VALUES (LOWER($1), $2, $3, $4, NOW())
+		ON CONFLICT (email) DO NOTHING
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, suppression.Email, suppression.Source, suppression.Reason, suppression.CreatedBy).Scan(&suppression.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to suppress email: %w", err)
+	}
+
+	return true, nil
+}
+
+// ImportSuppressions suppresses the addresses in a single statement and returns how many were added, the
+// ones suppressed before keep their entry.
+func (r *notificationRepository) ImportSuppressions(ctx context.Context, suppressions []*models.EmailSuppression, createdBy *uuid.UUID) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	emails := make([]string, len(suppressions))
+	sources := make([]string, len(suppressions))
+	reasons := make([]string, len(suppressions))
+
+	for i, suppression := range suppressions {
+		emails[i], sources[i], reasons[i] = suppression.Email, string(suppression.Source), suppression.Reason
+	}
+
+	query := `
+		INSERT INTO email_suppressions (email, source, reason, created_by, created_at)
+		SELECT LOWER(e.email), e.source, e.reason, $4, NOW()
+		FROM unnest($1::text[], $2::text[], $3::text[]) AS e(email, source, reason)
+		ON CONFLICT (email) DO NOTHING
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, pq.Array(emails), pq.Array(sources), pq.Array(reasons), createdBy)
+	if err != nil {
+		return 0, fmt.Errorf("failed to import suppressions: %w", err)
+	}
+
+	imported, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count imported suppressions: %w", err)
+	}
+
+	return int(imported), nil
+}
+
+func (r *notificationRepository) IsEmailSuppressed(ctx context.Context, email string) (bool, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT EXISTS (SELECT 1 FROM email_suppressions WHERE email = LOWER($1))`
+
+	var suppressed bool
+	if err := r.DB.QueryRowContext(dbCtx, query, email).Scan(&suppressed); err != nil {
+		return false, fmt.Errorf("failed to check email suppression: %w", err)
+	}
+
+	return suppressed, nil
+}
+
+func (r *notificationRepository) GetSuppression(ctx context.Context, email string) (*models.EmailSuppression, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT email, source, reason, created_by, created_at FROM email_suppressions WHERE email = LOWER($1)`
+
+	suppression, err := scanSuppression(r.DB.QueryRowContext(dbCtx, query, email))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suppression: %w", err)
+	}
+
+	return suppression, nil
+}
+
+// ListSuppressions lists the suppressed addresses, the latest first, an empty source lists them all.
+func (r *notificationRepository) ListSuppressions(ctx context.Context, source models.SuppressionSource, page, size int) ([]*models.EmailSuppression, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	countQuery := `SELECT COUNT(*) FROM email_suppressions WHERE $1 = '' OR source = $1`
+
+	if err := r.DB.QueryRowContext(dbCtx, countQuery, source).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count suppressions: %w", err)
+	}
+
+	query := `
+		SELECT email, source, reason, created_by, created_at
+		FROM email_suppressions
+		WHERE $1 = '' OR source = $1
+		ORDER BY created_at DESC, email
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, source, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query suppressions: %w", err)
+	}
+	defer rows.Close()
+
+	suppressions := []*models.EmailSuppression{}
+
+	for rows.Next() {
+		suppression, err := scanSuppression(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan suppression: %w", err)
+		}
+
+		suppressions = append(suppressions, suppression)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return suppressions, total, nil
+}
+
+// DeleteSuppression lets the address receive emails again, sql.ErrNoRows when it was not suppressed.
+func (r *notificationRepository) DeleteSuppression(ctx context.Context, email string) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.DB.ExecContext(dbCtx, `DELETE FROM email_suppressions WHERE email = LOWER($1)`, email)
+	if err != nil {
+		return fmt.Errorf("failed to delete suppression: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check deleted suppression: %w", err)
+	}
+
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanSuppression(row interface{ Scan(dest ...any) error }) (*models.EmailSuppression, error) {
+	var (
+		suppression models.EmailSuppression
+		reason      sql.NullString
+	)
+
+	if err := row.Scan(&suppression.Email, &suppression.Source, &reason, &suppression.CreatedBy, &suppression.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	suppression.Reason = reason.String
+
+	return &suppression, nil
+}
+
+func scanNotification(row interface{ Scan(dest ...any) error }) (*models.Notification, error) {
+	notification := &models.Notification{}
+
+	var (
+		metadata      []byte
+		nextAttemptAt sql.NullTime
+	)
+
+	err := row.Scan(&notification.ID, &notification.Type, &notification.Recipient, &notification.Subject, &notification.Content, &notification.Status, &notification.ErrorMessage, &metadata, &notification.RetryCount, &nextAttemptAt, &notification.CreatedAt, &notification.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	notification.Metadata = json.RawMessage(metadata)
+
+	if nextAttemptAt.Valid {
+		notification.NextAttemptAt = &nextAttemptAt.Time
+	}
+
+	return notification, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -60,13 +61,13 @@ func TestNotificationRepository(t *testing.T) {
 			}
 
 			expectedSQL := regexp.QuoteMeta(`
-                INSERT INTO notifications (id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at)
-                VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+                INSERT INTO notifications (id, type, recipient, subject, content, status, error_message, metadata, next_attempt_at, created_at, updated_at)
+                VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
             `)
 
 			// Expect the ExecContext call
 			mock.ExpectExec(expectedSQL).
-				WithArgs(notification.ID, notification.Type, notification.Recipient, notification.Subject, notification.Content, notification.Status, notification.ErrorMessage, notification.Metadata).
+				WithArgs(notification.ID, notification.Type, notification.Recipient, notification.Subject, notification.Content, notification.Status, notification.ErrorMessage, notification.Metadata, notification.NextAttemptAt).
 				WillReturnResult(sqlmock.NewResult(1, 1)) // Simulate 1 row inserted
 
 			// Act
@@ -91,13 +92,13 @@ func TestNotificationRepository(t *testing.T) {
 			dbError := errors.New("database insertion error")
 
 			expectedSQL := regexp.QuoteMeta(`
-                INSERT INTO notifications (id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at)
-                VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+                INSERT INTO notifications (id, type, recipient, subject, content, status, error_message, metadata, next_attempt_at, created_at, updated_at)
+                VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
             `)
 
 			// Expect the ExecContext call to fail
 			mock.ExpectExec(expectedSQL).
-				WithArgs(notification.ID, notification.Type, notification.Recipient, notification.Subject, notification.Content, notification.Status, notification.ErrorMessage, notification.Metadata).
+				WithArgs(notification.ID, notification.Type, notification.Recipient, notification.Subject, notification.Content, notification.Status, notification.ErrorMessage, notification.Metadata, notification.NextAttemptAt).
 				WillReturnError(dbError)
 
 			// Act
@@ -131,14 +132,14 @@ func TestNotificationRepository(t *testing.T) {
 			}
 
 			expectedSQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 WHERE id = $1
             `)
 
 			// Mock the database call for successful retrieval
-			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "created_at", "updated_at"}).
-				AddRow(expectedNotification.ID, expectedNotification.Type, expectedNotification.Recipient, expectedNotification.Subject, expectedNotification.Content, expectedNotification.Status, expectedNotification.ErrorMessage, []byte(expectedNotification.Metadata), expectedNotification.CreatedAt, expectedNotification.UpdatedAt)
+			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "retry_count", "next_attempt_at", "created_at", "updated_at"}).
+				AddRow(expectedNotification.ID, expectedNotification.Type, expectedNotification.Recipient, expectedNotification.Subject, expectedNotification.Content, expectedNotification.Status, expectedNotification.ErrorMessage, []byte(expectedNotification.Metadata), expectedNotification.RetryCount, nil, expectedNotification.CreatedAt, expectedNotification.UpdatedAt)
 			mock.ExpectQuery(expectedSQL).
 				WithArgs(notificationID).
 				WillReturnRows(rows)
@@ -158,7 +159,7 @@ func TestNotificationRepository(t *testing.T) {
 			notificationID := uuid.New()
 
 			expectedSQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 WHERE id = $1
             `)
@@ -186,7 +187,7 @@ func TestNotificationRepository(t *testing.T) {
 			notificationID := uuid.New()
 
 			expectedSQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 WHERE id = $1
             `)
@@ -221,7 +222,7 @@ func TestNotificationRepository(t *testing.T) {
 			errorMsg := ""
 
 			expectedSQL := regexp.QuoteMeta(`
-                UPDATE notifications SET status = $1, error_message = $2, updated_at = $3
+                UPDATE notifications SET status = $1, error_message = $2, next_attempt_at = NULL, updated_at = $3
                 WHERE id = $4
             `)
 
@@ -246,7 +247,7 @@ func TestNotificationRepository(t *testing.T) {
 			errorMsg := "Service unavailable"
 
 			expectedSQL := regexp.QuoteMeta(`
-                UPDATE notifications SET status = $1, error_message = $2, updated_at = $3
+                UPDATE notifications SET status = $1, error_message = $2, next_attempt_at = NULL, updated_at = $3
                 WHERE id = $4
             `)
 
@@ -261,6 +262,7 @@ func TestNotificationRepository(t *testing.T) {
 			// Assert
 			require.Error(t, err, "UpdateNotificationStatus should return an error when not found")
 			assert.Contains(t, err.Error(), fmt.Sprintf("notification not found: %s", notificationID), "Error message should indicate not found")
+			assert.ErrorIs(t, err, sql.ErrNoRows)
 			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
 		})
 
@@ -273,7 +275,7 @@ func TestNotificationRepository(t *testing.T) {
 			dbError := errors.New("exec error")
 
 			expectedSQL := regexp.QuoteMeta(`
-                UPDATE notifications SET status = $1, error_message = $2, updated_at = $3
+                UPDATE notifications SET status = $1, error_message = $2, next_attempt_at = NULL, updated_at = $3
                 WHERE id = $4
             `)
 
@@ -301,7 +303,7 @@ func TestNotificationRepository(t *testing.T) {
 			rowsAffectedError := errors.New("rows affected error")
 
 			expectedSQL := regexp.QuoteMeta(`
-                UPDATE notifications SET status = $1, error_message = $2, updated_at = $3
+                UPDATE notifications SET status = $1, error_message = $2, next_attempt_at = NULL, updated_at = $3
                 WHERE id = $4
             `)
 
@@ -343,15 +345,15 @@ func TestNotificationRepository(t *testing.T) {
 
 			// Expect list query
 			listQuerySQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 ORDER BY created_at DESC
                 LIMIT $1 OFFSET $2
             `)
 
-			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "metadata", "error_message", "created_at", "updated_at"})
+			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "retry_count", "next_attempt_at", "created_at", "updated_at"})
 			for _, n := range expectedNotifications {
-				rows.AddRow(n.ID, n.Type, n.Recipient, n.Subject, n.Content, n.Status, []byte(n.Metadata), n.ErrorMessage, n.CreatedAt, n.UpdatedAt)
+				rows.AddRow(n.ID, n.Type, n.Recipient, n.Subject, n.Content, n.Status, n.ErrorMessage, []byte(n.Metadata), n.RetryCount, nil, n.CreatedAt, n.UpdatedAt)
 			}
 
 			mock.ExpectQuery(listQuerySQL).
@@ -380,12 +382,12 @@ func TestNotificationRepository(t *testing.T) {
 
 			// Expect list query (will return no rows)
 			listQuerySQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 ORDER BY created_at DESC
                 LIMIT $1 OFFSET $2
             `)
-			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "metadata", "error_message", "created_at", "updated_at"}) // Empty rows
+			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "retry_count", "next_attempt_at", "created_at", "updated_at"}) // Empty rows
 			mock.ExpectQuery(listQuerySQL).
 				WithArgs(size, offset).
 				WillReturnRows(rows)
@@ -434,7 +436,7 @@ func TestNotificationRepository(t *testing.T) {
 
 			// Expect list query to fail
 			listQuerySQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 ORDER BY created_at DESC
                 LIMIT $1 OFFSET $2
@@ -466,14 +468,14 @@ func TestNotificationRepository(t *testing.T) {
 
 			// Expect list query with bad data
 			listQuerySQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 ORDER BY created_at DESC
                 LIMIT $1 OFFSET $2
             `)
 			// Return a row that will cause a scan error (e.g., wrong type for ID)
-			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "metadata", "error_message", "created_at", "updated_at"}).
-				AddRow("not-a-uuid", "email", "r", "s", "c", "p", []byte("{}"), "", time.Now(), time.Now())
+			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "retry_count", "next_attempt_at", "created_at", "updated_at"}).
+				AddRow("not-a-uuid", "email", "r", "s", "c", "p", "", []byte("{}"), 0, nil, time.Now(), time.Now())
 			mock.ExpectQuery(listQuerySQL).
 				WithArgs(size, offset).
 				WillReturnRows(rows)
@@ -502,7 +504,7 @@ func TestNotificationRepository(t *testing.T) {
 
 			// Expect list query
 			listQuerySQL := regexp.QuoteMeta(`
-                SELECT id, type, recipient, subject, content, status, error_message, metadata, created_at, updated_at
+                SELECT id, type, recipient, subject, content, status, error_message, metadata, retry_count, next_attempt_at, created_at, updated_at
                 FROM notifications
                 ORDER BY created_at DESC
                 LIMIT $1 OFFSET $2
@@ -526,3 +528,381 @@ func TestNotificationRepository(t *testing.T) {
 		})
 	})
 }
+
+func TestNotificationRepository_Retries(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("RecordNotificationFailure", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			repo, mock := setupNotificationRepoTest(t)
+			nextAttemptAt := time.Now().Add(time.Minute)
+			notification := &models.Notification{ID: uuid.New(), Status: models.StatusFailed, ErrorMessage: "sendgrid error", RetryCount: 1, NextAttemptAt: &nextAttemptAt}
+
+			expectedSQL := regexp.QuoteMeta(`
+                UPDATE notifications SET status = $1, error_message = $2, retry_count = $3, next_attempt_at = $4, updated_at = NOW()
+                WHERE id = $5
+            `)
+
+			mock.ExpectExec(expectedSQL).
+				WithArgs(notification.Status, notification.ErrorMessage, notification.RetryCount, notification.NextAttemptAt, notification.ID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			// Act
+			err := repo.RecordNotificationFailure(ctx, notification)
+
+			// Assert
+			require.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+		})
+
+		t.Run("Failure - Not Found", func(t *testing.T) {
+			// Arrange
+			repo, mock := setupNotificationRepoTest(t)
+			notification := &models.Notification{ID: uuid.New(), Status: models.StatusPermanentlyFailed}
+
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE notifications SET status = $1`)).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			// Act
+			err := repo.RecordNotificationFailure(ctx, notification)
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "notification not found")
+			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+		})
+	})
+
+	t.Run("DeferNotification", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			repo, mock := setupNotificationRepoTest(t)
+			notificationID := uuid.New()
+			nextAttemptAt := time.Now().Add(time.Minute)
+
+			expectedSQL := regexp.QuoteMeta(`
+                UPDATE notifications SET status = 'queued', next_attempt_at = $1, updated_at = NOW()
+                WHERE id = $2
+            `)
+
+			mock.ExpectExec(expectedSQL).
+				WithArgs(nextAttemptAt, notificationID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			// Act
+			err := repo.DeferNotification(ctx, notificationID, nextAttemptAt)
+
+			// Assert
+			require.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+		})
+
+		t.Run("Failure - Not Found", func(t *testing.T) {
+			// Arrange
+			repo, mock := setupNotificationRepoTest(t)
+
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE notifications SET status = 'queued'`)).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			// Act
+			err := repo.DeferNotification(ctx, uuid.New(), time.Now())
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+		})
+	})
+
+	t.Run("ClaimDueNotifications", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			repo, mock := setupNotificationRepoTest(t)
+			now := time.Now()
+			leaseUntil := now.Add(5 * time.Minute)
+			notificationID := uuid.New()
+
+			rows := sqlmock.NewRows([]string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "retry_count", "next_attempt_at", "created_at", "updated_at"}).
+				AddRow(notificationID, "email", "r@example.com", "s", "c", models.StatusFailed, "sendgrid error", []byte("{}"), 2, leaseUntil, now, now)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE notifications SET next_attempt_at = $2`)+`.*FOR UPDATE SKIP LOCKED`).
+				WithArgs(now, leaseUntil, 10).
+				WillReturnRows(rows)
+
+			// Act
+			results, err := repo.ClaimDueNotifications(ctx, now, leaseUntil, 10)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, notificationID, results[0].ID)
+			assert.Equal(t, 2, results[0].RetryCount)
+			require.NotNil(t, results[0].NextAttemptAt)
+			assert.Equal(t, leaseUntil, *results[0].NextAttemptAt)
+			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+		})
+
+		t.Run("Failure - Query Error", func(t *testing.T) {
+			// Arrange
+			repo, mock := setupNotificationRepoTest(t)
+			dbError := errors.New("claim failed")
+
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE notifications SET next_attempt_at = $2`)).WillReturnError(dbError)
+
+			// Act
+			results, err := repo.ClaimDueNotifications(ctx, time.Now(), time.Now(), 10)
+
+			// Assert
+			require.ErrorIs(t, err, dbError)
+			assert.Nil(t, results)
+			assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+		})
+	})
+}
+
+func TestNotificationRepository_Search(t *testing.T) {
+	ctx := t.Context()
+	columns := []string{"id", "type", "recipient", "subject", "content", "status", "error_message", "metadata", "retry_count", "next_attempt_at", "created_at", "updated_at"}
+	broadcastID := uuid.New()
+	since := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter := &models.NotificationFilter{Status: models.StatusFailed, Recipient: "User@Example.com", BroadcastID: &broadcastID, Since: &since}
+
+	t.Run("SearchNotifications", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		id := uuid.New()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM notifications WHERE status = $1 AND LOWER(recipient) = LOWER($2) AND metadata->>'broadcast_id' = $3 AND created_at >= $4`)).
+			WithArgs(models.StatusFailed, "User@Example.com", broadcastID.String(), since).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+		mock.ExpectQuery(`FROM notifications\s+WHERE status = \$1 .* ORDER BY created_at DESC, id\s+LIMIT \$5 OFFSET \$6`).
+			WithArgs(models.StatusFailed, "User@Example.com", broadcastID.String(), since, 10, 10).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(id, "email", "user@example.com", "Sale", "", "failed", "timeout", nil, 1, nil, since, since))
+
+		// Act
+		notifications, total, err := repo.SearchNotifications(ctx, filter, 2, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 11, total)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, id, notifications[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("SearchNotifications - No filter", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM notifications`) + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(`LIMIT \$1 OFFSET \$2`).
+			WithArgs(20, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		// Act
+		notifications, total, err := repo.SearchNotifications(ctx, &models.NotificationFilter{}, 1, 20)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, notifications)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("CountNotificationsByStatus - Ignores the status filter", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT status, COUNT(*) FROM notifications WHERE LOWER(recipient) = LOWER($1) AND metadata->>'broadcast_id' = $2 AND created_at >= $3 GROUP BY status`)).
+			WithArgs("User@Example.com", broadcastID.String(), since).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("failed", 2).AddRow("delivered", 40))
+
+		// Act
+		counts, err := repo.CountNotificationsByStatus(ctx, filter)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[models.NotificationStatus]int{models.StatusFailed: 2, models.StatusDelivered: 40}, counts)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("StreamNotifications - Stops at the first error of fn", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		fnErr := errors.New("client gone")
+
+		mock.ExpectQuery(`ORDER BY created_at, id`).
+			WithArgs(models.StatusFailed, "User@Example.com", broadcastID.String(), since).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(uuid.New(), "email", "user@example.com", "Sale", "", "failed", "", nil, 0, nil, since, since).
+				AddRow(uuid.New(), "email", "user@example.com", "Sale", "", "failed", "", nil, 0, nil, since, since))
+
+		calls := 0
+
+		// Act
+		err := repo.StreamNotifications(ctx, filter, func(*models.Notification) error {
+			calls++
+
+			return fnErr
+		})
+
+		// Assert
+		require.ErrorIs(t, err, fnErr)
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+}
+
+func TestNotificationRepository_Suppressions(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("SuppressEmail", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		suppression := &models.EmailSuppression{Email: "User@Example.com", Source: models.SuppressionBounce, Reason: "550 unknown user"}
+		createdAt := time.Now()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO email_suppressions (email, source, reason, created_by, created_at)`)+`.*ON CONFLICT \(email\) DO NOTHING`).
+			WithArgs("User@Example.com", models.SuppressionBounce, "550 unknown user", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(createdAt))
+
+		// Act
+		added, err := repo.SuppressEmail(ctx, suppression)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, createdAt, suppression.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("SuppressEmail - Already Suppressed", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		adminID := uuid.New()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO email_suppressions`)).
+			WithArgs("user@example.com", models.SuppressionManual, "", &adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+		// Act
+		added, err := repo.SuppressEmail(ctx, &models.EmailSuppression{Email: "user@example.com", Source: models.SuppressionManual, CreatedBy: &adminID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, added)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("ImportSuppressions", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		adminID := uuid.New()
+		suppressions := []*models.EmailSuppression{
+			{Email: "a@example.com", Source: models.SuppressionComplaint},
+			{Email: "b@example.com", Source: models.SuppressionComplaint, Reason: "spam"},
+		}
+
+		mock.ExpectExec(regexp.QuoteMeta(`FROM unnest($1::text[], $2::text[], $3::text[])`)+`.*ON CONFLICT \(email\) DO NOTHING`).
+			WithArgs(pq.Array([]string{"a@example.com", "b@example.com"}), pq.Array([]string{"complaint", "complaint"}), pq.Array([]string{"", "spam"}), &adminID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		imported, err := repo.ImportSuppressions(ctx, suppressions, &adminID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, imported)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("ListSuppressions", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		adminID := uuid.New()
+		createdAt := time.Now()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM email_suppressions WHERE $1 = '' OR source = $1`)).
+			WithArgs(models.SuppressionManual).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(21))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, source, reason, created_by, created_at`)).
+			WithArgs(models.SuppressionManual, 20, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"email", "source", "reason", "created_by", "created_at"}).
+				AddRow("user@example.com", "manual", nil, adminID, createdAt))
+
+		// Act
+		suppressions, total, err := repo.ListSuppressions(ctx, models.SuppressionManual, 2, 20)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 21, total)
+		assert.Equal(t, []*models.EmailSuppression{{Email: "user@example.com", Source: models.SuppressionManual, CreatedBy: &adminID, CreatedAt: createdAt}}, suppressions)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("DeleteSuppression - Not Suppressed", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM email_suppressions WHERE email = LOWER($1)`)).
+			WithArgs("User@Example.com").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.DeleteSuppression(ctx, "User@Example.com")
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("IsEmailSuppressed", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM email_suppressions WHERE email = LOWER($1))`)).
+			WithArgs("user@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		// Act
+		suppressed, err := repo.IsEmailSuppressed(ctx, "user@example.com")
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, suppressed)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("GetSuppression - Not Suppressed", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, source, reason, created_by, created_at FROM email_suppressions WHERE email = LOWER($1)`)).
+			WithArgs("User@Example.com").
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		suppression, err := repo.GetSuppression(ctx, "User@Example.com")
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, suppression)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("IsEmailSuppressed - Query Error", func(t *testing.T) {
+		// Arrange
+		repo, mock := setupNotificationRepoTest(t)
+		dbError := errors.New("query failed")
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS`)).WillReturnError(dbError)
+
+		// Act
+		suppressed, err := repo.IsEmailSuppressed(ctx, "user@example.com")
+
+		// Assert
+		require.ErrorIs(t, err, dbError)
+		assert.False(t, suppressed)
+	})
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type OrderDocumentRepository interface {
+	CreateOrderDocument(ctx context.Context, doc *models.OrderDocument) (bool, error)
+	ListOrderDocuments(ctx context.Context, orderID uuid.UUID) ([]models.OrderDocument, error)
+}
+
+type orderDocumentRepository struct {
+	DB *sql.DB
+}
+
+func NewOrderDocumentRepo(db *sql.DB) OrderDocumentRepository {
+	return &orderDocumentRepository{DB: db}
+}
+
+// CreateOrderDocument numbers the document with the next number of its legal entity and series and
+// stores it. It reports false when a document of the type was already issued for the source, the
+// number is then not used up.
+func (r *orderDocumentRepository) CreateOrderDocument(ctx context.Context, doc *models.OrderDocument) (bool, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	lines, err := json.Marshal(doc.Lines)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal order document lines: %w", err)
+	}
+
+	seller, err := json.Marshal(doc.Seller)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal order document seller: %w", err)
+	}
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	// the row lock of the counter holds back the other documents of the entity until commit,
+	// so the numbers are issued without gaps
+	var sequence int64
+
+	err = tx.QueryRowContext(dbCtx, `
+		INSERT INTO order_document_sequences (legal_entity, series, last_number)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (legal_entity, series) DO UPDATE SET last_number = order_document_sequences.last_number + 1
+		RETURNING last_number
+	`, doc.LegalEntity, doc.Series).Scan(&sequence)
+	if err != nil {
+		return false, fmt.Errorf("failed to get next order document number: %w", err)
+	}
+
+	number := models.OrderDocumentNumber(doc.Series, sequence)
+
+	query := `
+		INSERT INTO order_documents (id, order_id, type, legal_entity, number, seller, source_id, amount, currency, lines, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+		ON CONFLICT (type, source_id) DO NOTHING
+		RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, query, doc.ID, doc.OrderID, doc.Type, doc.LegalEntity, number, seller, doc.SourceID, doc.Amount, doc.Currency, lines).Scan(&doc.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to insert order document: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit order document: %w", err)
+	}
+
+	doc.Number = number
+
+	return true, nil
+}
+
+// ListOrderDocuments returns the documents issued for the order, oldest first.
+func (r *orderDocumentRepository) ListOrderDocuments(ctx context.Context, orderID uuid.UUID) ([]models.OrderDocument, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, type, legal_entity, number, seller, source_id, amount, currency, lines, created_at
+		FROM order_documents
+		WHERE order_id = $1
+		ORDER BY created_at, number
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order documents: %w", err)
+	}
+	defer rows.Close()
+
+	docs := []models.OrderDocument{}
+
+	for rows.Next() {
+		var (
+			doc    models.OrderDocument
+			seller []byte
+			lines  []byte
+		)
+
+		if err := rows.Scan(&doc.ID, &doc.Type, &doc.LegalEntity, &doc.Number, &seller, &doc.SourceID, &doc.Amount, &doc.Currency, &lines, &doc.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan order document: %w", err)
+		}
+
+		if err := json.Unmarshal(seller, &doc.Seller); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal seller of order document %s: %w", doc.ID, err)
+		}
+
+		if err := json.Unmarshal(lines, &doc.Lines); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal lines of order document %s: %w", doc.ID, err)
+		}
+
+		doc.OrderID = orderID
+		docs = append(docs, doc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order documents: %w", err)
+	}
+
+	return docs, nil
+}
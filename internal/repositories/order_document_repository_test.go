@@ -0,0 +1,110 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderDocumentRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewOrderDocumentRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	orderID := uuid.New()
+
+	newCreditNote := func() *models.OrderDocument {
+		return &models.OrderDocument{
+			ID:          uuid.New(),
+			OrderID:     orderID,
+			Type:        models.OrderDocumentCreditNote,
+			LegalEntity: "acme-gmbh",
+			Series:      "CN",
+			Seller:      models.DocumentSeller{Name: "acme-gmbh"},
+			SourceID:    "re_1",
+			Amount:      2000,
+			Currency:    "usd",
+			Lines:       []models.OrderDocumentLine{{Description: "Difference of the order edit", Amount: 2000}},
+		}
+	}
+
+	t.Run("CreateOrderDocument_Success", func(t *testing.T) {
+		// Arrange
+		doc := newCreditNote()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO order_document_sequences").
+			WithArgs("acme-gmbh", "CN").
+			WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(42))
+		mock.ExpectQuery("INSERT INTO order_documents .* ON CONFLICT \\(type, source_id\\) DO NOTHING").
+			WithArgs(doc.ID, orderID, models.OrderDocumentCreditNote, "acme-gmbh", "CN-000042", sqlmock.AnyArg(), "re_1", int64(2000), "usd", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectCommit()
+
+		// Act
+		created, err := repo.CreateOrderDocument(ctx, doc)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "CN-000042", doc.Number)
+		assert.Equal(t, now, doc.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateOrderDocument_AlreadyIssued", func(t *testing.T) {
+		// Arrange
+		doc := newCreditNote()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO order_document_sequences").
+			WithArgs("acme-gmbh", "CN").
+			WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(43))
+		mock.ExpectQuery("INSERT INTO order_documents").
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+		mock.ExpectRollback()
+
+		// Act
+		created, err := repo.CreateOrderDocument(ctx, doc)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Empty(t, doc.Number)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListOrderDocuments_Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		itemID := uuid.New()
+		lines := `[{"order_item_id":"` + itemID.String() + `","description":"Refunded units","quantity":1,"amount":1112}]`
+
+		mock.ExpectQuery("FROM order_documents").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "type", "legal_entity", "number", "seller", "source_id", "amount", "currency", "lines", "created_at"}).
+				AddRow(id, models.OrderDocumentCreditNote, "acme-gmbh", "CN-000001", []byte(`{"name":"acme-gmbh"}`), "re_1", 1112, "usd", []byte(lines), now))
+
+		// Act
+		docs, err := repo.ListOrderDocuments(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, docs, 1)
+		assert.Equal(t, orderID, docs[0].OrderID)
+		assert.Equal(t, "CN-000001", docs[0].Number)
+		assert.Equal(t, "acme-gmbh", docs[0].Seller.Name)
+		require.Len(t, docs[0].Lines, 1)
+		assert.Equal(t, itemID, *docs[0].Lines[0].OrderItemID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type OrderRefundRepository interface {
+	CreateOrderRefund(ctx context.Context, refund *models.OrderRefund) error
+	CompleteOrderRefund(ctx context.Context, id uuid.UUID, refundID string) error
+	FailOrderRefund(ctx context.Context, refund *models.OrderRefund) error
+	ListOrderRefunds(ctx context.Context, orderID uuid.UUID) ([]models.OrderRefund, error)
+}
+
+type orderRefundRepository struct {
+	DB *sql.DB
+}
+
+func NewOrderRefundRepo(db *sql.DB) OrderRefundRepository {
+	return &orderRefundRepository{DB: db}
+}
+
+// CreateOrderRefund stores the pending refund with its lines and reserves the refunded units of the
+// items. It returns sql.ErrNoRows when a line refunds more units of an item than are left to refund.
+func (r *orderRefundRepository) CreateOrderRefund(ctx context.Context, refund *models.OrderRefund) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	for _, line := range refund.Lines {
+		result, err := tx.ExecContext(dbCtx, `
+			UPDATE order_items SET refunded_quantity = refunded_quantity + $1
+			WHERE id = $2 AND order_id = $3 AND refunded_quantity + $1 <= quantity
+		`, line.Quantity, line.OrderItemID, refund.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to reserve refunded units: %w", err)
+		}
+
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get updated rows: %w", err)
+		}
+
+		if updated == 0 {
+			return sql.ErrNoRows
+		}
+	}
+
+	query := `
+		INSERT INTO order_refunds (id, order_id, status, amount, currency, reason, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, query, refund.ID, refund.OrderID, refund.Status, refund.Amount, refund.Currency, refund.Reason, refund.CreatedBy).Scan(&refund.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert order refund: %w", err)
+	}
+
+	for _, line := range refund.Lines {
+		_, err := tx.ExecContext(dbCtx, `
+			INSERT INTO order_refund_lines (refund_id, order_item_id, quantity, item_amount, adjustment_amount, amount)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, refund.ID, line.OrderItemID, line.Quantity, line.ItemAmount, line.AdjustmentAmount, line.Amount)
+		if err != nil {
+			return fmt.Errorf("failed to insert order refund line: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order refund: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteOrderRefund marks the pending refund succeeded with the refund of the payment provider.
+// It returns sql.ErrNoRows when the refund is no longer pending.
+func (r *orderRefundRepository) CompleteOrderRefund(ctx context.Context, id uuid.UUID, refundID string) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE order_refunds SET status = $1, refund_id = $2 WHERE id = $3 AND status = $4`
+
+	result, err := r.DB.ExecContext(dbCtx, query, models.OrderRefundSucceeded, refundID, id, models.OrderRefundPending)
+	if err != nil {
+		return fmt.Errorf("failed to complete order refund: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// FailOrderRefund marks the pending refund failed and gives its units back to the items, so they can
+// be refunded again. It returns sql.ErrNoRows when the refund is no longer pending.
+func (r *orderRefundRepository) FailOrderRefund(ctx context.Context, refund *models.OrderRefund) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	result, err := tx.ExecContext(dbCtx, `UPDATE order_refunds SET status = $1 WHERE id = $2 AND status = $3`,
+		models.OrderRefundFailed, refund.ID, models.OrderRefundPending)
+	if err != nil {
+		return fmt.Errorf("failed to fail order refund: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+
+	for _, line := range refund.Lines {
+		_, err := tx.ExecContext(dbCtx, `UPDATE order_items SET refunded_quantity = refunded_quantity - $1 WHERE id = $2 AND order_id = $3`,
+			line.Quantity, line.OrderItemID, refund.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to release refunded units: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order refund: %w", err)
+	}
+
+	refund.Status = models.OrderRefundFailed
+
+	return nil
+}
+
+// ListOrderRefunds returns the refunds of the order with their lines, oldest first.
+func (r *orderRefundRepository) ListOrderRefunds(ctx context.Context, orderID uuid.UUID) ([]models.OrderRefund, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT r.id, r.status, COALESCE(r.refund_id, ''), r.amount, r.currency, r.reason, r.created_by, r.created_at,
+			l.order_item_id, l.quantity, l.item_amount, l.adjustment_amount, l.amount
+		FROM order_refunds r
+		JOIN order_refund_lines l ON l.refund_id = r.id
+		WHERE r.order_id = $1
+		ORDER BY r.created_at, r.id, l.order_item_id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order refunds: %w", err)
+	}
+	defer rows.Close()
+
+	refunds := []models.OrderRefund{}
+
+	for rows.Next() {
+		var (
+			refund models.OrderRefund
+			line   models.OrderRefundLine
+		)
+
+		err := rows.Scan(&refund.ID, &refund.Status, &refund.RefundID, &refund.Amount, &refund.Currency, &refund.Reason, &refund.CreatedBy, &refund.CreatedAt,
+			&line.OrderItemID, &line.Quantity, &line.ItemAmount, &line.AdjustmentAmount, &line.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order refund: %w", err)
+		}
+
+		// the lines of a refund come one after the other
+		if n := len(refunds); n > 0 && refunds[n-1].ID == refund.ID {
+			refunds[n-1].Lines = append(refunds[n-1].Lines, line)
+
+			continue
+		}
+
+		refund.OrderID = orderID
+		refund.Lines = []models.OrderRefundLine{line}
+		refunds = append(refunds, refund)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order refunds: %w", err)
+	}
+
+	return refunds, nil
+}
@@ -0,0 +1,182 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRefundRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewOrderRefundRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	orderID := uuid.New()
+	adminID := uuid.New()
+	mugID, posterID := uuid.New(), uuid.New()
+
+	newRefund := func() *models.OrderRefund {
+		return &models.OrderRefund{
+			ID:        uuid.New(),
+			OrderID:   orderID,
+			Status:    models.OrderRefundPending,
+			Amount:    3337,
+			Currency:  models.CatalogCurrency,
+			Reason:    "Chipped",
+			CreatedBy: adminID,
+			Lines: []models.OrderRefundLine{
+				{OrderItemID: mugID, Quantity: 1, ItemAmount: 1000, AdjustmentAmount: 112, Amount: 1112},
+				{OrderItemID: posterID, Quantity: 1, ItemAmount: 2000, AdjustmentAmount: 225, Amount: 2225},
+			},
+		}
+	}
+
+	t.Run("CreateOrderRefund_Success", func(t *testing.T) {
+		// Arrange
+		refund := newRefund()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE order_items SET refunded_quantity = refunded_quantity \\+ \\$1").
+			WithArgs(1, mugID, orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE order_items SET refunded_quantity = refunded_quantity \\+ \\$1").
+			WithArgs(1, posterID, orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("INSERT INTO order_refunds").
+			WithArgs(refund.ID, orderID, models.OrderRefundPending, int64(3337), models.CatalogCurrency, "Chipped", adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectExec("INSERT INTO order_refund_lines").
+			WithArgs(refund.ID, mugID, 1, int64(1000), int64(112), int64(1112)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO order_refund_lines").
+			WithArgs(refund.ID, posterID, 1, int64(2000), int64(225), int64(2225)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.CreateOrderRefund(ctx, refund)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, refund.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateOrderRefund_AlreadyRefunded", func(t *testing.T) {
+		// Arrange
+		refund := newRefund()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE order_items SET refunded_quantity = refunded_quantity \\+ \\$1").
+			WithArgs(1, mugID, orderID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.CreateOrderRefund(ctx, refund)
+
+		// Assert
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CompleteOrderRefund_Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mock.ExpectExec("UPDATE order_refunds SET status = \\$1, refund_id = \\$2").
+			WithArgs(models.OrderRefundSucceeded, "re_1", id, models.OrderRefundPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.CompleteOrderRefund(ctx, id, "re_1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FailOrderRefund_ReleasesUnits", func(t *testing.T) {
+		// Arrange
+		refund := newRefund()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE order_refunds SET status = \\$1").
+			WithArgs(models.OrderRefundFailed, refund.ID, models.OrderRefundPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE order_items SET refunded_quantity = refunded_quantity - \\$1").
+			WithArgs(1, mugID, orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE order_items SET refunded_quantity = refunded_quantity - \\$1").
+			WithArgs(1, posterID, orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.FailOrderRefund(ctx, refund)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderRefundFailed, refund.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FailOrderRefund_NotPending", func(t *testing.T) {
+		// Arrange
+		refund := newRefund()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE order_refunds SET status = \\$1").
+			WithArgs(models.OrderRefundFailed, refund.ID, models.OrderRefundPending).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.FailOrderRefund(ctx, refund)
+
+		// Assert
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, models.OrderRefundPending, refund.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListOrderRefunds_GroupsLines", func(t *testing.T) {
+		// Arrange
+		firstID, secondID := uuid.New(), uuid.New()
+		columns := []string{
+			"id", "status", "refund_id", "amount", "currency", "reason", "created_by", "created_at",
+			"order_item_id", "quantity", "item_amount", "adjustment_amount", "amount",
+		}
+
+		mock.ExpectQuery("FROM order_refunds r").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(firstID, models.OrderRefundSucceeded, "re_1", 3337, "usd", "", adminID, now, mugID, 1, 1000, 112, 1112).
+				AddRow(firstID, models.OrderRefundSucceeded, "re_1", 3337, "usd", "", adminID, now, posterID, 1, 2000, 225, 2225).
+				AddRow(secondID, models.OrderRefundFailed, "", 1112, "usd", "", adminID, now, mugID, 1, 1000, 112, 1112))
+
+		// Act
+		refunds, err := repo.ListOrderRefunds(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, refunds, 2)
+		assert.Equal(t, firstID, refunds[0].ID)
+		assert.Equal(t, orderID, refunds[0].OrderID)
+		assert.Len(t, refunds[0].Lines, 2)
+		assert.Equal(t, int64(2225), refunds[0].Lines[1].Amount)
+		assert.Equal(t, models.OrderRefundFailed, refunds[1].Status)
+		assert.Len(t, refunds[1].Lines, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
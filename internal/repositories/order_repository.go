@@ -11,14 +11,26 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type OrderRepository interface {
 	CreateOrder(ctx context.Context, order *models.Order) error
 	GetOrderByID(ctx context.Context, id uuid.UUID) (*models.Order, error)
 	ListOrdersByCustomer(ctx context.Context, customerID uuid.UUID, page int, size int) ([]models.Order, int, error)
+	StreamOrdersOfCustomer(ctx context.Context, customerID uuid.UUID, from, to time.Time, fn func(*models.Order) error) error
 	UpdateOrderStatus(ctx context.Context, id uuid.UUID, status models.OrderStatus) (*models.Order, error)
+	GetOrderStatuses(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.OrderStatus, error)
+	UpdateOrderStatuses(ctx context.Context, ids []uuid.UUID, status models.OrderStatus, from []models.OrderStatus) ([]uuid.UUID, error)
 	UpdatePaymentStatus(ctx context.Context, id uuid.UUID, status models.PaymentStatus, paymentIntentID string) error
+	EditOrder(ctx context.Context, order *models.Order, removedItemIDs []uuid.UUID, addedItems []models.OrderItem) error
+	GetOrderIDByPaymentIntentID(ctx context.Context, paymentIntentID string) (uuid.UUID, error)
+	ListUnpaidOrderIDs(ctx context.Context, placedBefore time.Time, limit int) ([]uuid.UUID, error)
+	LapseOrder(ctx context.Context, id uuid.UUID) error
+	GetPaymentRetryState(ctx context.Context, id uuid.UUID) (*models.PaymentRetryState, error)
+	SwapPaymentIntent(ctx context.Context, id uuid.UUID, previousIntentID, paymentIntentID string, state *models.PaymentRetryState) error
+	CountPurchasedUnits(ctx context.Context, customerID, productID uuid.UUID, since time.Time) (int, error)
+	ListOrderTimeline(ctx context.Context, id uuid.UUID) ([]models.OrderTimelineEvent, error)
 }
 
 type orderRepository struct {
@@ -40,11 +52,11 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *models.Order)
 
 	// Insert an order
 	query := `
-		INSERT INTO orders (id, customer_id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		INSERT INTO orders (id, customer_id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
 	`
 
-	_, err = r.DB.ExecContext(dbCtx, query, order.ID, order.CustomerID, order.Status, order.TotalAmount, order.PaymentStatus, order.PaymentIntentID, shippingAddress)
+	_, err = r.DB.ExecContext(dbCtx, query, order.ID, order.CustomerID, order.Status, order.TotalAmount, order.PaymentStatus, order.PaymentIntentID, order.FulfillmentType, order.PickupLocationID, shippingAddress, order.GiftWrap, order.GiftWrapFee, order.GiftMessage)
 	if err != nil {
 		return fmt.Errorf("failed to insert order: %w", err)
 	}
@@ -52,11 +64,11 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *models.Order)
 	// Insert order items
 	for _, item := range order.Items {
 		query := `
-			INSERT INTO order_items (id, order_id, product_id, quantity, unit_price, created_at)
-			VALUES ($1, $2, $3, $4, $5, NOW())
+			INSERT INTO order_items (id, order_id, product_id, quantity, unit_price, is_gift, campaign_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
 		`
 
-		_, err := r.DB.ExecContext(dbCtx, query, item.ID, order.ID, item.ProductID, item.Quantity, item.UnitPrice)
+		_, err := r.DB.ExecContext(dbCtx, query, item.ID, order.ID, item.ProductID, item.Quantity, item.UnitPrice, item.IsGift, item.CampaignID)
 		if err != nil {
 			return fmt.Errorf("failed to insert an order item: %w", err)
 		}
@@ -75,14 +87,14 @@ func (r *orderRepository) GetOrderByID(ctx context.Context, id uuid.UUID) (*mode
 	}
 
 	query := `
-		SELECT customer_id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at
+		SELECT customer_id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at
 		FROM orders
 		WHERE id = $1
 	`
 
 	var jsonData []byte
 
-	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&order.CustomerID, &order.Status, &order.TotalAmount, &order.PaymentStatus, &order.PaymentIntentID, &jsonData, &order.CreatedAt, &order.UpdatedAt)
+	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&order.CustomerID, &order.Status, &order.TotalAmount, &order.PaymentStatus, &order.PaymentIntentID, &order.FulfillmentType, &order.PickupLocationID, &jsonData, &order.GiftWrap, &order.GiftWrapFee, &order.GiftMessage, &order.CreatedAt, &order.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("querying database: %w", err)
@@ -97,7 +109,7 @@ func (r *orderRepository) GetOrderByID(ctx context.Context, id uuid.UUID) (*mode
 
 	// Get the order items
 	query = `
-		SELECT id, product_id, quantity, unit_price, created_at
+		SELECT id, product_id, quantity, unit_price, is_gift, refunded_quantity, campaign_id, created_at
 		FROM order_items
 		WHERE order_id = $1
 	`
@@ -118,7 +130,7 @@ func (r *orderRepository) GetOrderByID(ctx context.Context, id uuid.UUID) (*mode
 	for rows.Next() {
 		var item models.OrderItem
 
-		err := rows.Scan(&item.ID, &item.ProductID, &item.Quantity, &item.UnitPrice, &item.CreatedAt)
+		err := rows.Scan(&item.ID, &item.ProductID, &item.Quantity, &item.UnitPrice, &item.IsGift, &item.RefundedQuantity, &item.CampaignID, &item.CreatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan order item: %w", err)
 		}
@@ -157,7 +169,7 @@ func (r *orderRepository) ListOrdersByCustomer(ctx context.Context, customerID u
 
 	// Get orders with pagination
 	query := `
-		SELECT id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at
+		SELECT id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at
 		FROM orders
 		WHERE customer_id = $1
 		ORDER BY created_at DESC
@@ -180,7 +192,7 @@ func (r *orderRepository) ListOrdersByCustomer(ctx context.Context, customerID u
 
 		var jsonData []byte
 
-		err := rows.Scan(&order.ID, &order.Status, &order.TotalAmount, &order.PaymentStatus, &order.PaymentIntentID, &jsonData, &order.CreatedAt, &order.UpdatedAt)
+		err := rows.Scan(&order.ID, &order.Status, &order.TotalAmount, &order.PaymentStatus, &order.PaymentIntentID, &order.FulfillmentType, &order.PickupLocationID, &jsonData, &order.GiftWrap, &order.GiftWrapFee, &order.GiftMessage, &order.CreatedAt, &order.UpdatedAt)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan order row: %w", err)
 		}
@@ -194,7 +206,7 @@ func (r *orderRepository) ListOrdersByCustomer(ctx context.Context, customerID u
 
 	// now for each order we have to fetch the respective order items
 	query = `
-		SELECT id, product_id, quantity, unit_price, created_at
+		SELECT id, product_id, quantity, unit_price, is_gift, refunded_quantity, campaign_id, created_at
 		FROM order_items
 		WHERE order_id = $1
 	`
@@ -211,7 +223,7 @@ func (r *orderRepository) ListOrdersByCustomer(ctx context.Context, customerID u
 		for itemsRows.Next() {
 			var item models.OrderItem
 
-			scanErr := itemsRows.Scan(&item.ID, &item.ProductID, &item.Quantity, &item.UnitPrice, &item.CreatedAt)
+			scanErr := itemsRows.Scan(&item.ID, &item.ProductID, &item.Quantity, &item.UnitPrice, &item.IsGift, &item.RefundedQuantity, &item.CampaignID, &item.CreatedAt)
 			if scanErr != nil {
 				closeErr := itemsRows.Close()
 				if closeErr != nil {
@@ -238,13 +250,18 @@ func (r *orderRepository) ListOrdersByCustomer(ctx context.Context, customerID u
 	return orders, total, nil
 }
 
-// Update Order status.
+// Update Order status, the change is recorded in the status history of the order.
 func (r *orderRepository) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status models.OrderStatus) (*models.Order, error) {
 	dbCtx, cancel := utils.WithDBTimeout(ctx)
 	defer cancel()
 
 	query := `
-		UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3
+		WITH updated AS (
+			UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3
+			RETURNING id, status, updated_at
+		)
+		INSERT INTO order_status_history (order_id, status, changed_at)
+		SELECT id, status, updated_at FROM updated
 	`
 
 	result, err := r.DB.ExecContext(dbCtx, query, status, time.Now(), id)
@@ -269,6 +286,86 @@ func (r *orderRepository) UpdateOrderStatus(ctx context.Context, id uuid.UUID, s
 	return updatedOrder, nil
 }
 
+// GetOrderStatuses returns the status of each order found, unknown IDs are left out.
+func (r *orderRepository) GetOrderStatuses(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.OrderStatus, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, status FROM orders WHERE id = ANY($1)
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order statuses: %w", err)
+	}
+	defer rows.Close()
+
+	statuses := make(map[uuid.UUID]models.OrderStatus, len(ids))
+
+	for rows.Next() {
+		var (
+			id     uuid.UUID
+			status models.OrderStatus
+		)
+
+		if err := rows.Scan(&id, &status); err != nil {
+			return nil, fmt.Errorf("failed to scan order status: %w", err)
+		}
+
+		statuses[id] = status
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order statuses: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// UpdateOrderStatuses moves the orders still in one of the from statuses to status, in a single
+// statement so the batch is applied at once. It returns the IDs of the orders updated, an order whose
+// status changed since it was read is skipped.
+func (r *orderRepository) UpdateOrderStatuses(ctx context.Context, ids []uuid.UUID, status models.OrderStatus, from []models.OrderStatus) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		WITH updated AS (
+			UPDATE orders SET status = $1, updated_at = $2
+			WHERE id = ANY($3) AND status = ANY($4)
+			RETURNING id, status, updated_at
+		), history AS (
+			INSERT INTO order_status_history (order_id, status, changed_at)
+			SELECT id, status, updated_at FROM updated
+		)
+		SELECT id FROM updated
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, status, time.Now(), pq.Array(ids), pq.Array(from))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute bulk order status update: %w", err)
+	}
+	defer rows.Close()
+
+	var updated []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan updated order id: %w", err)
+		}
+
+		updated = append(updated, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating updated orders: %w", err)
+	}
+
+	return updated, nil
+}
+
 // Update the Payment Status and Payment Intent ID of an order.
 func (r *orderRepository) UpdatePaymentStatus(ctx context.Context, id uuid.UUID, status models.PaymentStatus, paymentIntentID string) error {
 	dbCtx, cancel := utils.WithDBTimeout(ctx)
@@ -294,3 +391,429 @@ func (r *orderRepository) UpdatePaymentStatus(ctx context.Context, id uuid.UUID,
 
 	return nil
 }
+
+// EditOrder saves the total and shipping address of the order, deletes the removed items and inserts
+// the added ones in one transaction. The order must still be pending or confirmed when the edit is
+// committed, otherwise sql.ErrNoRows is returned and nothing changes.
+func (r *orderRepository) EditOrder(ctx context.Context, order *models.Order, removedItemIDs []uuid.UUID, addedItems []models.OrderItem) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	shippingAddress, err := json.Marshal(order.ShippingAddress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shipping address: %w", err)
+	}
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	// the update locks the order, so it cannot start shipping while it is edited
+	query := `
+		UPDATE orders SET total_amount = $1, shipping_address = $2, updated_at = $3
+		WHERE id = $4 AND status IN ('pending', 'confirmed')
+	`
+
+	result, err := tx.ExecContext(dbCtx, query, order.TotalAmount, shippingAddress, order.UpdatedAt, order.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed checking rows affected for order edit: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	if len(removedItemIDs) > 0 {
+		query := `DELETE FROM order_items WHERE order_id = $1 AND id = ANY($2)`
+
+		if _, err := tx.ExecContext(dbCtx, query, order.ID, pq.Array(removedItemIDs)); err != nil {
+			return fmt.Errorf("failed to delete order items: %w", err)
+		}
+	}
+
+	for _, item := range addedItems {
+		query := `
+			INSERT INTO order_items (id, order_id, product_id, quantity, unit_price, is_gift, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`
+
+		_, err := tx.ExecContext(dbCtx, query, item.ID, order.ID, item.ProductID, item.Quantity, item.UnitPrice, item.IsGift, item.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert an order item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order edit: %w", err)
+	}
+
+	return nil
+}
+
+// StreamOrdersOfCustomer calls fn for every order of the customer created in [from, to), oldest first,
+// with its items but without the shipping address. Orders and items come from a single query, only
+// the order being assembled is held in memory and it is reused between calls, fn must copy what it
+// keeps. Like the other streams it is bounded by ctx only, not by the default DB timeout.
+func (r *orderRepository) StreamOrdersOfCustomer(ctx context.Context, customerID uuid.UUID, from, to time.Time, fn func(*models.Order) error) error {
+	query := `
+		SELECT o.id, o.status, o.total_amount, o.payment_status, o.fulfillment_type, o.gift_wrap, o.gift_wrap_fee, o.created_at, o.updated_at,
+			i.id, i.product_id, i.quantity, i.unit_price, i.is_gift, i.created_at
+		FROM orders o
+		JOIN order_items i ON i.order_id = o.id
+		WHERE o.customer_id = $1 AND o.created_at >= $2 AND o.created_at < $3
+		ORDER BY o.created_at, o.id, i.created_at, i.id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, customerID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to stream the orders: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		order   models.Order
+		pending bool
+	)
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var (
+			row  models.Order
+			item models.OrderItem
+		)
+
+		err := rows.Scan(&row.ID, &row.Status, &row.TotalAmount, &row.PaymentStatus, &row.FulfillmentType, &row.GiftWrap, &row.GiftWrapFee, &row.CreatedAt, &row.UpdatedAt,
+			&item.ID, &item.ProductID, &item.Quantity, &item.UnitPrice, &item.IsGift, &item.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to scan order row: %w", err)
+		}
+
+		// Rows are sorted by order, a new ID means the previous order is complete
+		if pending && row.ID != order.ID {
+			if err := fn(&order); err != nil {
+				return err
+			}
+
+			pending = false
+		}
+
+		if !pending {
+			items := order.Items[:0]
+			order = row
+			order.CustomerID = customerID
+			order.Items = items
+			pending = true
+		}
+
+		item.OrderID = order.ID
+		order.Items = append(order.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error during order rows iteration: %w", err)
+	}
+
+	if pending {
+		if err := fn(&order); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// GetOrderIDByPaymentIntentID finds the order paid by a payment intent, or a checkout session.
+func (r *orderRepository) GetOrderIDByPaymentIntentID(ctx context.Context, paymentIntentID string) (uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id FROM orders WHERE payment_intent_id = $1 LIMIT 1
+	`
+
+	var id uuid.UUID
+	if err := r.DB.QueryRowContext(dbCtx, query, paymentIntentID).Scan(&id); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to find order by payment intent: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListUnpaidOrderIDs returns the pending orders placed before placedBefore that were never paid,
+// oldest first.
+func (r *orderRepository) ListUnpaidOrderIDs(ctx context.Context, placedBefore time.Time, limit int) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id FROM orders
+		WHERE status = $1 AND payment_status IN ($2, $3) AND created_at < $4
+		ORDER BY created_at
+		LIMIT $5
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, models.OrderStatusPending, models.PaymentStatusPending, models.PaymentStatusFailed, placedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unpaid orders: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan unpaid order id: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating unpaid orders: %w", err)
+	}
+
+	return ids, nil
+}
+
+// LapseOrder cancels a pending order that was never paid, puts the stock allocated to its items back
+// into their warehouses and the stock the order took back on sale, and records the stock movements, in
+// a single transaction. The stock taken is what the stock movements of the order add up to, so an order
+// that failed before all its stock was taken gives back only what it took. It returns sql.ErrNoRows when
+// the order was paid or moved on in the meantime, so a payment arriving late always wins, and a lapse
+// failing halfway leaves the order pending for the next sweep.
+func (r *orderRepository) LapseOrder(ctx context.Context, id uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	// the update locks the order, so its items cannot be edited while the stock is given back
+	query := `
+		WITH updated AS (
+			UPDATE orders SET status = $1, payment_status = $2, updated_at = $3
+			WHERE id = $4 AND status = $5 AND payment_status IN ($6, $2)
+			RETURNING id, status, updated_at
+		)
+		INSERT INTO order_status_history (order_id, status, changed_at)
+		SELECT id, status, updated_at FROM updated
+	`
+
+	result, err := tx.ExecContext(dbCtx, query, models.OrderStatusCancelled, models.PaymentStatusFailed, time.Now(), id, models.OrderStatusPending, models.PaymentStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to lapse order: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed checking rows affected for lapsed order: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	releaseQuery := `
+		WITH released AS (
+			DELETE FROM stock_allocations
+			WHERE order_item_id IN (SELECT id FROM order_items WHERE order_id = $1)
+			RETURNING warehouse_id, product_id, quantity
+		)
+		UPDATE warehouse_stock s SET quantity = s.quantity + r.quantity, updated_at = NOW()
+		FROM (
+			SELECT warehouse_id, product_id, SUM(quantity) AS quantity
+			FROM released
+			GROUP BY warehouse_id, product_id
+		) r
+		WHERE s.warehouse_id = r.warehouse_id AND s.product_id = r.product_id
+	`
+
+	if _, err := tx.ExecContext(dbCtx, releaseQuery, id); err != nil {
+		return fmt.Errorf("failed to release stock allocations: %w", err)
+	}
+
+	taken := `SELECT product_id, SUM(quantity) AS quantity FROM stock_movements WHERE reference_id = $1 AND reason = $2 GROUP BY product_id HAVING SUM(quantity) <> 0`
+
+	restockQuery := withProductChange(`
+		UPDATE products p SET stock_quantity = p.stock_quantity + t.quantity, version = p.version + 1, updated_at = NOW()
+		FROM (`+taken+`) t
+		WHERE p.id = t.product_id
+		RETURNING p.id, p.status, p.version, p.updated_at`,
+		"id")
+
+	if _, err := tx.ExecContext(dbCtx, restockQuery, id, models.StockMovementOrder); err != nil {
+		return fmt.Errorf("failed to restock order items: %w", err)
+	}
+
+	movementQuery := `
+		INSERT INTO stock_movements (id, product_id, quantity, reason, reference_id, created_at)
+		SELECT gen_random_uuid(), t.product_id, -t.quantity, $2, $1, NOW()
+		FROM (` + taken + `) t
+	`
+
+	if _, err := tx.ExecContext(dbCtx, movementQuery, id, models.StockMovementOrder); err != nil {
+		return fmt.Errorf("failed to insert stock movements: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order lapse: %w", err)
+	}
+
+	return nil
+}
+
+// GetPaymentRetryState returns how many times the payment of an order was retried, and until when
+// further retries are locked out.
+func (r *orderRepository) GetPaymentRetryState(ctx context.Context, id uuid.UUID) (*models.PaymentRetryState, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT payment_retries, payment_retry_locked_until FROM orders WHERE id = $1
+	`
+
+	var state models.PaymentRetryState
+	if err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&state.Retries, &state.LockedUntil); err != nil {
+		return nil, fmt.Errorf("failed to get payment retry state: %w", err)
+	}
+
+	return &state, nil
+}
+
+// SwapPaymentIntent binds a pending order to a new payment intent, pending again, and saves its retry
+// state. It returns sql.ErrNoRows when the order left pending or its payment intent changed in the
+// meantime, so two retries racing never both win.
+func (r *orderRepository) SwapPaymentIntent(ctx context.Context, id uuid.UUID, previousIntentID, paymentIntentID string, state *models.PaymentRetryState) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE orders SET payment_intent_id = $1, payment_status = $2, payment_retries = $3, payment_retry_locked_until = $4, updated_at = $5
+		WHERE id = $6 AND payment_intent_id = $7 AND status = $8
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, paymentIntentID, models.PaymentStatusPending, state.Retries, state.LockedUntil, time.Now(), id, previousIntentID, models.OrderStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to swap payment intent: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed checking rows affected for payment intent swap: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountPurchasedUnits returns how many units of the product the customer ordered since the given time,
+// cancelled orders aside.
+func (r *orderRepository) CountPurchasedUnits(ctx context.Context, customerID, productID uuid.UUID, since time.Time) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COALESCE(SUM(oi.quantity), 0)
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.customer_id = $1 AND oi.product_id = $2 AND o.status <> $3 AND o.created_at >= $4
+	`
+
+	var purchased int
+
+	err := r.DB.QueryRowContext(dbCtx, query, customerID, productID, models.OrderStatusCancelled, since).Scan(&purchased)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purchased units: %w", err)
+	}
+
+	return purchased, nil
+}
+
+// orderTimelineQuery merges the history of an order from the domain tables, $1 is the order ID. The
+// status changes come from the status updates of the repository, orders placed before the history was
+// kept only have their current status. Payments are the current payment intent of the order and the
+// retries, whose description names the order, dated by the last change of their status. Notifications
+// name the order in their metadata.
+const orderTimelineQuery = `
+	SELECT 'placed' AS type, o.created_at AS occurred_at, o.id::text AS reference_id, '' AS status, 'Total ' || o.total_amount::text AS summary, FALSE AS internal
+	FROM orders o WHERE o.id = $1
+	UNION ALL
+	SELECT 'status_changed', h.changed_at, '', h.status, '', FALSE
+	FROM order_status_history h WHERE h.order_id = $1
+	UNION ALL
+	SELECT 'payment', p.updated_at, p.id, p.status, p.amount::text || ' ' || p.currency, FALSE
+	FROM payments p JOIN orders o ON o.id = $1
+	WHERE p.id = o.payment_intent_id OR p.description = 'Payment retry for order ' || o.id::text
+	UNION ALL
+	SELECT 'refund', r.created_at, r.id::text, r.status, 'Refund of ' || r.amount::text || ' ' || r.currency, FALSE
+	FROM order_refunds r WHERE r.order_id = $1
+	UNION ALL
+	SELECT 'notification', n.created_at, n.id::text, n.status, COALESCE(n.subject, ''), FALSE
+	FROM notifications n WHERE n.metadata->>'order_id' = $1::text
+	UNION ALL
+	SELECT 'picked', w.completed_at, w.id::text, '', '', TRUE
+	FROM fulfillment_wave_orders wo JOIN fulfillment_waves w ON w.id = wo.wave_id
+	WHERE wo.order_id = $1 AND w.completed_at IS NOT NULL
+	UNION ALL
+	SELECT a.action, a.created_at, a.id::text, '', COALESCE('By ' || a.actor_id::text, ''), TRUE
+	FROM audit_log a WHERE a.reference_id = $1
+	UNION ALL
+	SELECT 'fraud_flagged', f.created_at, '', '', array_to_string(f.reasons, ', '), TRUE
+	FROM fraud_reviews f WHERE f.order_id = $1
+	UNION ALL
+	SELECT 'fraud_reviewed', f.reviewed_at, COALESCE(f.reviewed_by::text, ''), f.status, f.note, TRUE
+	FROM fraud_reviews f WHERE f.order_id = $1 AND f.reviewed_at IS NOT NULL
+`
+
+// ListOrderTimeline returns the history of the order, oldest first.
+func (r *orderRepository) ListOrderTimeline(ctx context.Context, id uuid.UUID) ([]models.OrderTimelineEvent, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT type, occurred_at, reference_id, status, summary, internal
+		FROM (` + orderTimelineQuery + `) timeline
+		ORDER BY occurred_at, type
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order timeline: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.OrderTimelineEvent{}
+
+	for rows.Next() {
+		var event models.OrderTimelineEvent
+
+		if err := rows.Scan(&event.Type, &event.OccurredAt, &event.ReferenceID, &event.Status, &event.Summary, &event.Internal); err != nil {
+			return nil, fmt.Errorf("failed to scan order timeline event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return events, nil
+}
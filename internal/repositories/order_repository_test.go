@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -80,28 +82,28 @@ func TestCreateOrder(t *testing.T) {
 	require.NoError(t, err, "Failed to marshal shipping address for test setup")
 
 	expectedOrderInsertSQL := regexp.QuoteMeta(`
-        INSERT INTO orders (id, customer_id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+        INSERT INTO orders (id, customer_id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
     `)
 	expectedItemInsertSQL := regexp.QuoteMeta(`
-            INSERT INTO order_items (id, order_id, product_id, quantity, unit_price, created_at)
-            VALUES ($1, $2, $3, $4, $5, NOW())
+            INSERT INTO order_items (id, order_id, product_id, quantity, unit_price, is_gift, campaign_id, created_at)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
         `)
 
 	t.Run("Success - Create Order", func(t *testing.T) {
 		// Expect the order insertion
 		mock.ExpectExec(expectedOrderInsertSQL).
-			WithArgs(testOrder.ID, testOrder.CustomerID, testOrder.Status, testOrder.TotalAmount, testOrder.PaymentStatus, testOrder.PaymentIntentID, shippingAddrJSON).
+			WithArgs(testOrder.ID, testOrder.CustomerID, testOrder.Status, testOrder.TotalAmount, testOrder.PaymentStatus, testOrder.PaymentIntentID, testOrder.FulfillmentType, testOrder.PickupLocationID, shippingAddrJSON, testOrder.GiftWrap, testOrder.GiftWrapFee, testOrder.GiftMessage).
 			WillReturnResult(sqlmock.NewResult(1, 1)) // Simulate 1 row inserted
 
 		// Expect the first item insertion
 		mock.ExpectExec(expectedItemInsertSQL).
-			WithArgs(testOrder.Items[0].ID, testOrder.ID, testOrder.Items[0].ProductID, testOrder.Items[0].Quantity, testOrder.Items[0].UnitPrice).
+			WithArgs(testOrder.Items[0].ID, testOrder.ID, testOrder.Items[0].ProductID, testOrder.Items[0].Quantity, testOrder.Items[0].UnitPrice, testOrder.Items[0].IsGift, testOrder.Items[0].CampaignID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Expect the second item insertion
 		mock.ExpectExec(expectedItemInsertSQL).
-			WithArgs(testOrder.Items[1].ID, testOrder.ID, testOrder.Items[1].ProductID, testOrder.Items[1].Quantity, testOrder.Items[1].UnitPrice).
+			WithArgs(testOrder.Items[1].ID, testOrder.ID, testOrder.Items[1].ProductID, testOrder.Items[1].Quantity, testOrder.Items[1].UnitPrice, testOrder.Items[1].IsGift, testOrder.Items[1].CampaignID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Act
@@ -115,7 +117,7 @@ func TestCreateOrder(t *testing.T) {
 		dbErr := errors.New("DB error on order insert")
 		// Expect the order insertion to fail
 		mock.ExpectExec(expectedOrderInsertSQL).
-			WithArgs(testOrder.ID, testOrder.CustomerID, testOrder.Status, testOrder.TotalAmount, testOrder.PaymentStatus, testOrder.PaymentIntentID, shippingAddrJSON).
+			WithArgs(testOrder.ID, testOrder.CustomerID, testOrder.Status, testOrder.TotalAmount, testOrder.PaymentStatus, testOrder.PaymentIntentID, testOrder.FulfillmentType, testOrder.PickupLocationID, shippingAddrJSON, testOrder.GiftWrap, testOrder.GiftWrapFee, testOrder.GiftMessage).
 			WillReturnError(dbErr)
 
 		// Act
@@ -131,12 +133,12 @@ func TestCreateOrder(t *testing.T) {
 		dbErr := errors.New("DB error on item insert")
 		// Expect the order insertion to succeed
 		mock.ExpectExec(expectedOrderInsertSQL).
-			WithArgs(testOrder.ID, testOrder.CustomerID, testOrder.Status, testOrder.TotalAmount, testOrder.PaymentStatus, testOrder.PaymentIntentID, shippingAddrJSON).
+			WithArgs(testOrder.ID, testOrder.CustomerID, testOrder.Status, testOrder.TotalAmount, testOrder.PaymentStatus, testOrder.PaymentIntentID, testOrder.FulfillmentType, testOrder.PickupLocationID, shippingAddrJSON, testOrder.GiftWrap, testOrder.GiftWrapFee, testOrder.GiftMessage).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Expect the first item insertion to fail
 		mock.ExpectExec(expectedItemInsertSQL).
-			WithArgs(testOrder.Items[0].ID, testOrder.ID, testOrder.Items[0].ProductID, testOrder.Items[0].Quantity, testOrder.Items[0].UnitPrice).
+			WithArgs(testOrder.Items[0].ID, testOrder.ID, testOrder.Items[0].ProductID, testOrder.Items[0].Quantity, testOrder.Items[0].UnitPrice, testOrder.Items[0].IsGift, testOrder.Items[0].CampaignID).
 			WillReturnError(dbErr)
 
 		// Act
@@ -157,6 +159,7 @@ func TestGetOrderByID(t *testing.T) {
 	customerID := uuid.New()
 	productID1 := uuid.New()
 	itemID1 := uuid.New()
+	campaignID := uuid.New()
 	now := time.Now()
 
 	expectedAddress := &models.Address{
@@ -176,30 +179,30 @@ func TestGetOrderByID(t *testing.T) {
 		CreatedAt:       now.Add(-time.Hour),
 		UpdatedAt:       now,
 		Items: []models.OrderItem{
-			{ID: itemID1, OrderID: orderID, ProductID: productID1, Quantity: 1, UnitPrice: 100.00, CreatedAt: now.Add(-time.Hour)},
+			{ID: itemID1, OrderID: orderID, ProductID: productID1, Quantity: 1, UnitPrice: 100.00, CampaignID: &campaignID, CreatedAt: now.Add(-time.Hour)},
 		},
 	}
 
 	expectedOrderQuerySQL := regexp.QuoteMeta(`
-        SELECT customer_id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at
+        SELECT customer_id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at
         FROM orders
         WHERE id = $1
     `)
 	expectedItemsQuerySQL := regexp.QuoteMeta(`
-        SELECT id, product_id, quantity, unit_price, created_at
+        SELECT id, product_id, quantity, unit_price, is_gift, refunded_quantity, campaign_id, created_at
         FROM order_items
         WHERE order_id = $1
     `)
 
 	t.Run("Success - Get Order By ID", func(t *testing.T) {
 		// Mock order query
-		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedAddrJSON, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedOrder.FulfillmentType, expectedOrder.PickupLocationID, expectedAddrJSON, expectedOrder.GiftWrap, expectedOrder.GiftWrapFee, expectedOrder.GiftMessage, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
 		mock.ExpectQuery(expectedOrderQuerySQL).WithArgs(orderID).WillReturnRows(orderRows)
 
 		// Mock items query
-		itemRows := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "created_at"}).
-			AddRow(expectedOrder.Items[0].ID, expectedOrder.Items[0].ProductID, expectedOrder.Items[0].Quantity, expectedOrder.Items[0].UnitPrice, expectedOrder.Items[0].CreatedAt)
+		itemRows := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "is_gift", "refunded_quantity", "campaign_id", "created_at"}).
+			AddRow(expectedOrder.Items[0].ID, expectedOrder.Items[0].ProductID, expectedOrder.Items[0].Quantity, expectedOrder.Items[0].UnitPrice, expectedOrder.Items[0].IsGift, expectedOrder.Items[0].RefundedQuantity, campaignID, expectedOrder.Items[0].CreatedAt)
 		mock.ExpectQuery(expectedItemsQuerySQL).WithArgs(orderID).WillReturnRows(itemRows)
 
 		// Act
@@ -251,8 +254,8 @@ func TestGetOrderByID(t *testing.T) {
 	t.Run("Failure - Address Unmarshal Error", func(t *testing.T) {
 		// Mock order query with invalid JSON for address
 		invalidJSON := []byte(`{"street": "Invalid`)
-		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, invalidJSON, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedOrder.FulfillmentType, expectedOrder.PickupLocationID, invalidJSON, expectedOrder.GiftWrap, expectedOrder.GiftWrapFee, expectedOrder.GiftMessage, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
 		mock.ExpectQuery(expectedOrderQuerySQL).WithArgs(orderID).WillReturnRows(orderRows)
 
 		// Act
@@ -267,8 +270,8 @@ func TestGetOrderByID(t *testing.T) {
 	t.Run("Failure - Items Query Error", func(t *testing.T) {
 		dbErr := errors.New("DB error querying items")
 		// Mock order query (success)
-		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedAddrJSON, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedOrder.FulfillmentType, expectedOrder.PickupLocationID, expectedAddrJSON, expectedOrder.GiftWrap, expectedOrder.GiftWrapFee, expectedOrder.GiftMessage, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
 		mock.ExpectQuery(expectedOrderQuerySQL).WithArgs(orderID).WillReturnRows(orderRows)
 
 		// Mock items query (failure)
@@ -286,8 +289,8 @@ func TestGetOrderByID(t *testing.T) {
 
 	t.Run("Failure - Item Scan Error", func(t *testing.T) {
 		// Mock order query (success)
-		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedAddrJSON, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrder.CustomerID, expectedOrder.Status, expectedOrder.TotalAmount, expectedOrder.PaymentStatus, expectedOrder.PaymentIntentID, expectedOrder.FulfillmentType, expectedOrder.PickupLocationID, expectedAddrJSON, expectedOrder.GiftWrap, expectedOrder.GiftWrapFee, expectedOrder.GiftMessage, expectedOrder.CreatedAt, expectedOrder.UpdatedAt)
 		mock.ExpectQuery(expectedOrderQuerySQL).WithArgs(orderID).WillReturnRows(orderRows)
 
 		// Mock items query with incorrect columns
@@ -338,14 +341,14 @@ func TestListOrdersByCustomer(t *testing.T) {
 
 	expectedCountSQL := regexp.QuoteMeta(`SELECT COUNT(*) FROM orders WHERE customer_id = $1`)
 	expectedListOrdersSQL := regexp.QuoteMeta(`
-        SELECT id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at
+        SELECT id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at
         FROM orders
         WHERE customer_id = $1
         ORDER BY created_at DESC
         LIMIT $2 OFFSET $3
     `)
 	expectedListItemsSQL := regexp.QuoteMeta(`
-        SELECT id, product_id, quantity, unit_price, created_at
+        SELECT id, product_id, quantity, unit_price, is_gift, refunded_quantity, campaign_id, created_at
         FROM order_items
         WHERE order_id = $1
     `)
@@ -355,19 +358,19 @@ func TestListOrdersByCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).WithArgs(customerID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(totalOrders))
 
 		// Mock list orders query
-		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, addr1JSON, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt).
-			AddRow(expectedOrders[1].ID, expectedOrders[1].Status, expectedOrders[1].TotalAmount, expectedOrders[1].PaymentStatus, expectedOrders[1].PaymentIntentID, addr2JSON, expectedOrders[1].CreatedAt, expectedOrders[1].UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, expectedOrders[0].FulfillmentType, expectedOrders[0].PickupLocationID, addr1JSON, expectedOrders[0].GiftWrap, expectedOrders[0].GiftWrapFee, expectedOrders[0].GiftMessage, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt).
+			AddRow(expectedOrders[1].ID, expectedOrders[1].Status, expectedOrders[1].TotalAmount, expectedOrders[1].PaymentStatus, expectedOrders[1].PaymentIntentID, expectedOrders[1].FulfillmentType, expectedOrders[1].PickupLocationID, addr2JSON, expectedOrders[1].GiftWrap, expectedOrders[1].GiftWrapFee, expectedOrders[1].GiftMessage, expectedOrders[1].CreatedAt, expectedOrders[1].UpdatedAt)
 		mock.ExpectQuery(expectedListOrdersSQL).WithArgs(customerID, size, offset).WillReturnRows(orderRows)
 
 		// Mock items query for order 1
-		itemRows1 := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "created_at"}).
-			AddRow(expectedOrders[0].Items[0].ID, expectedOrders[0].Items[0].ProductID, expectedOrders[0].Items[0].Quantity, expectedOrders[0].Items[0].UnitPrice, expectedOrders[0].Items[0].CreatedAt)
+		itemRows1 := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "is_gift", "refunded_quantity", "campaign_id", "created_at"}).
+			AddRow(expectedOrders[0].Items[0].ID, expectedOrders[0].Items[0].ProductID, expectedOrders[0].Items[0].Quantity, expectedOrders[0].Items[0].UnitPrice, expectedOrders[0].Items[0].IsGift, expectedOrders[0].Items[0].RefundedQuantity, expectedOrders[0].Items[0].CampaignID, expectedOrders[0].Items[0].CreatedAt)
 		mock.ExpectQuery(expectedListItemsSQL).WithArgs(expectedOrders[0].ID).WillReturnRows(itemRows1)
 
 		// Mock items query for order 2
-		itemRows2 := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "created_at"}).
-			AddRow(expectedOrders[1].Items[0].ID, expectedOrders[1].Items[0].ProductID, expectedOrders[1].Items[0].Quantity, expectedOrders[1].Items[0].UnitPrice, expectedOrders[1].Items[0].CreatedAt)
+		itemRows2 := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "is_gift", "refunded_quantity", "campaign_id", "created_at"}).
+			AddRow(expectedOrders[1].Items[0].ID, expectedOrders[1].Items[0].ProductID, expectedOrders[1].Items[0].Quantity, expectedOrders[1].Items[0].UnitPrice, expectedOrders[1].Items[0].IsGift, expectedOrders[1].Items[0].RefundedQuantity, expectedOrders[1].Items[0].CampaignID, expectedOrders[1].Items[0].CreatedAt)
 		mock.ExpectQuery(expectedListItemsSQL).WithArgs(expectedOrders[1].ID).WillReturnRows(itemRows2)
 
 		// Act
@@ -384,7 +387,7 @@ func TestListOrdersByCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).WithArgs(customerID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 		// Mock list orders query (returns no rows)
-		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"})
+		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"})
 		mock.ExpectQuery(expectedListOrdersSQL).WithArgs(customerID, size, offset).WillReturnRows(orderRows)
 
 		// No item queries expected
@@ -456,8 +459,8 @@ func TestListOrdersByCustomer(t *testing.T) {
 
 		// Mock list orders query with invalid JSON address
 		invalidJSON := []byte(`{"invalid`)
-		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, invalidJSON, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, expectedOrders[0].FulfillmentType, expectedOrders[0].PickupLocationID, invalidJSON, expectedOrders[0].GiftWrap, expectedOrders[0].GiftWrapFee, expectedOrders[0].GiftMessage, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt)
 		mock.ExpectQuery(expectedListOrdersSQL).WithArgs(customerID, size, offset).WillReturnRows(orderRows)
 
 		// Act
@@ -476,8 +479,8 @@ func TestListOrdersByCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).WithArgs(customerID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
 		// Mock list orders query (success)
-		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, addr1JSON, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, expectedOrders[0].FulfillmentType, expectedOrders[0].PickupLocationID, addr1JSON, expectedOrders[0].GiftWrap, expectedOrders[0].GiftWrapFee, expectedOrders[0].GiftMessage, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt)
 		mock.ExpectQuery(expectedListOrdersSQL).WithArgs(customerID, size, offset).WillReturnRows(orderRows)
 
 		// Mock items query for order 1 (failure)
@@ -499,8 +502,8 @@ func TestListOrdersByCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).WithArgs(customerID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
 		// Mock list orders query (success)
-		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, addr1JSON, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt)
+		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, expectedOrders[0].FulfillmentType, expectedOrders[0].PickupLocationID, addr1JSON, expectedOrders[0].GiftWrap, expectedOrders[0].GiftWrapFee, expectedOrders[0].GiftMessage, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt)
 		mock.ExpectQuery(expectedListOrdersSQL).WithArgs(customerID, size, offset).WillReturnRows(orderRows)
 
 		// Mock items query for order 1 (scan error)
@@ -523,14 +526,14 @@ func TestListOrdersByCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).WithArgs(customerID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
 		// Mock list orders query, simulate error after reading rows
-		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, addr1JSON, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt).
+		orderRows := sqlmock.NewRows([]string{"id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(expectedOrders[0].ID, expectedOrders[0].Status, expectedOrders[0].TotalAmount, expectedOrders[0].PaymentStatus, expectedOrders[0].PaymentIntentID, expectedOrders[0].FulfillmentType, expectedOrders[0].PickupLocationID, addr1JSON, expectedOrders[0].GiftWrap, expectedOrders[0].GiftWrapFee, expectedOrders[0].GiftMessage, expectedOrders[0].CreatedAt, expectedOrders[0].UpdatedAt).
 			CloseError(rowsErr) // Simulate error on rows.Err() or rows.Close()
 		mock.ExpectQuery(expectedListOrdersSQL).WithArgs(customerID, size, offset).WillReturnRows(orderRows)
 
 		// Mock items query for order 1 (will likely run before CloseError is checked)
-		itemRows1 := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "created_at"}).
-			AddRow(expectedOrders[0].Items[0].ID, expectedOrders[0].Items[0].ProductID, expectedOrders[0].Items[0].Quantity, expectedOrders[0].Items[0].UnitPrice, expectedOrders[0].Items[0].CreatedAt)
+		itemRows1 := sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "is_gift", "refunded_quantity", "campaign_id", "created_at"}).
+			AddRow(expectedOrders[0].Items[0].ID, expectedOrders[0].Items[0].ProductID, expectedOrders[0].Items[0].Quantity, expectedOrders[0].Items[0].UnitPrice, expectedOrders[0].Items[0].IsGift, expectedOrders[0].Items[0].RefundedQuantity, expectedOrders[0].Items[0].CampaignID, expectedOrders[0].Items[0].CreatedAt)
 		mock.ExpectQuery(expectedListItemsSQL).WithArgs(expectedOrders[0].ID).WillReturnRows(itemRows1)
 
 		// Act
@@ -552,10 +555,11 @@ func TestUpdateOrderStatus(t *testing.T) {
 	newStatus := models.OrderStatusShipping
 	now := time.Now() // For mocking fetched order timestamps
 
-	expectedSQL := regexp.QuoteMeta(`UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3`)
+	// The change is recorded in the status history in the same statement
+	expectedSQL := regexp.QuoteMeta(`UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3`) + `[\s\S]+INSERT INTO order_status_history`
 	// Assume the implementation fetches the order after update
 	expectedFetchSQL := regexp.QuoteMeta(`
-        SELECT customer_id, status, total_amount, payment_status, payment_intent_id, shipping_address, created_at, updated_at
+        SELECT customer_id, status, total_amount, payment_status, payment_intent_id, fulfillment_type, pickup_location_id, shipping_address, gift_wrap, gift_wrap_fee, gift_message, created_at, updated_at
         FROM orders
         WHERE id = $1
     `)
@@ -570,12 +574,12 @@ func TestUpdateOrderStatus(t *testing.T) {
 		if err != nil {
 			t.Fatalf("failed to marshal expectedAddress: %v", err)
 		}
-		fetchedRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "shipping_address", "created_at", "updated_at"}).
-			AddRow(uuid.New(), newStatus, 100.0, models.PaymentStatusPending, "pi_fetch", expectedAddrJSON, now.Add(-time.Hour), now)
+		fetchedRows := sqlmock.NewRows([]string{"customer_id", "status", "total_amount", "payment_status", "payment_intent_id", "fulfillment_type", "pickup_location_id", "shipping_address", "gift_wrap", "gift_wrap_fee", "gift_message", "created_at", "updated_at"}).
+			AddRow(uuid.New(), newStatus, 100.0, models.PaymentStatusPending, "pi_fetch", models.FulfillmentShipping, nil, expectedAddrJSON, false, 0.0, "", now.Add(-time.Hour), now)
 		mock.ExpectQuery(expectedFetchSQL).WithArgs(orderID).WillReturnRows(fetchedRows)
 
-		expectedItemsQuerySQL := regexp.QuoteMeta(`SELECT id, product_id, quantity, unit_price, created_at FROM order_items WHERE order_id = $1`)
-		mock.ExpectQuery(expectedItemsQuerySQL).WithArgs(orderID).WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "created_at"})) // Assuming no items for simplicity or mock them
+		expectedItemsQuerySQL := regexp.QuoteMeta(`SELECT id, product_id, quantity, unit_price, is_gift, refunded_quantity, campaign_id, created_at FROM order_items WHERE order_id = $1`)
+		mock.ExpectQuery(expectedItemsQuerySQL).WithArgs(orderID).WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "quantity", "unit_price", "is_gift", "refunded_quantity", "campaign_id", "created_at"})) // Assuming no items for simplicity or mock them
 
 		// Act
 		order, err := repo.UpdateOrderStatus(ctx, orderID, newStatus)
@@ -634,6 +638,57 @@ func TestUpdateOrderStatus(t *testing.T) {
 	})
 }
 
+func TestBulkOrderStatus(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	ctx := t.Context()
+
+	confirmedID, deliveredID := uuid.New(), uuid.New()
+	ids := []uuid.UUID{confirmedID, deliveredID}
+	from := []models.OrderStatus{models.OrderStatusConfirmed, models.OrderStatusPending}
+
+	t.Run("Success - Get Order Statuses", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, status FROM orders WHERE id = ANY($1)`)).
+			WithArgs(pq.Array(ids)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).
+				AddRow(confirmedID, models.OrderStatusConfirmed).
+				AddRow(deliveredID, models.OrderStatusDelivered))
+
+		// Act
+		statuses, err := repo.GetOrderStatuses(ctx, ids)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]models.OrderStatus{confirmedID: models.OrderStatusConfirmed, deliveredID: models.OrderStatusDelivered}, statuses)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Update Order Statuses", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE orders SET status = \$1, updated_at = \$2\s+WHERE id = ANY\(\$3\) AND status = ANY\(\$4\)\s+RETURNING id`).
+			WithArgs(models.OrderStatusShipping, sqlmock.AnyArg(), pq.Array(ids), pq.Array(from)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(confirmedID))
+
+		// Act
+		updated, err := repo.UpdateOrderStatuses(ctx, ids, models.OrderStatusShipping, from)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{confirmedID}, updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Update Error", func(t *testing.T) {
+		dbErr := errors.New("update failed")
+		mock.ExpectQuery(`UPDATE orders SET status`).WillReturnError(dbErr)
+
+		// Act
+		_, err := repo.UpdateOrderStatuses(ctx, ids, models.OrderStatusShipping, from)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestUpdatePaymentStatus(t *testing.T) {
 	repo, mock := setupOrderRepoTest(t)
 	ctx := t.Context()
@@ -705,3 +760,378 @@ func TestUpdatePaymentStatus(t *testing.T) {
 		assert.ErrorIs(t, err, rowsAffectedErr, "Error should wrap the RowsAffected error")
 	})
 }
+
+func TestEditOrder(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	ctx := t.Context()
+	now := time.Now()
+
+	order := &models.Order{
+		ID:              uuid.New(),
+		TotalAmount:     69.0,
+		ShippingAddress: &models.Address{Street: "2 New St", City: "Hamburg", State: "HH", PostalCode: "20095", Country: "DE"},
+		UpdatedAt:       now,
+	}
+	addressJSON, err := json.Marshal(order.ShippingAddress)
+	require.NoError(t, err)
+
+	removedIDs := []uuid.UUID{uuid.New()}
+	added := []models.OrderItem{{ID: uuid.New(), ProductID: uuid.New(), Quantity: 2, UnitPrice: 12.25, CreatedAt: now}}
+
+	updateSQL := regexp.QuoteMeta(`UPDATE orders SET total_amount = $1, shipping_address = $2, updated_at = $3`)
+	deleteSQL := regexp.QuoteMeta(`DELETE FROM order_items WHERE order_id = $1 AND id = ANY($2)`)
+	insertSQL := regexp.QuoteMeta(`INSERT INTO order_items`)
+
+	t.Run("Success - Items and total are saved together", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec(updateSQL).
+			WithArgs(69.0, addressJSON, now, order.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(deleteSQL).
+			WithArgs(order.ID, pq.Array(removedIDs)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(insertSQL).
+			WithArgs(added[0].ID, order.ID, added[0].ProductID, 2, 12.25, false, now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.EditOrder(ctx, order, removedIDs, added)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Order no longer editable", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectExec(updateSQL).
+			WithArgs(69.0, addressJSON, now, order.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.EditOrder(ctx, order, removedIDs, added)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Item insert error rolls back", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("insert failed")
+		mock.ExpectBegin()
+		mock.ExpectExec(updateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(insertSQL).WillReturnError(dbErr)
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.EditOrder(ctx, order, nil, added)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.ErrorContains(t, err, "failed to insert an order item")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestStreamOrdersOfCustomer(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	customerID := uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+	columns := []string{"o.id", "o.status", "o.total_amount", "o.payment_status", "o.fulfillment_type", "o.gift_wrap", "o.gift_wrap_fee", "o.created_at", "o.updated_at",
+		"i.id", "i.product_id", "i.quantity", "i.unit_price", "i.is_gift", "i.created_at"}
+
+	addRow := func(rows *sqlmock.Rows, orderID uuid.UUID, quantity int) {
+		rows.AddRow(orderID, models.OrderStatusDelivered, 30.0, models.PaymentStatusSucceeded, models.FulfillmentShipping, false, 0.0, from, from,
+			uuid.New(), uuid.New(), quantity, 10.0, false, from)
+	}
+
+	t.Run("Success - Items are grouped per order", func(t *testing.T) {
+		// Arrange
+		first, second := uuid.New(), uuid.New()
+		rows := sqlmock.NewRows(columns)
+		addRow(rows, first, 1)
+		addRow(rows, first, 2)
+		addRow(rows, second, 3)
+
+		mock.ExpectQuery("FROM orders o").WithArgs(customerID, from, to).WillReturnRows(rows)
+
+		var (
+			ids   []uuid.UUID
+			items []int
+		)
+
+		// Act
+		err := repo.StreamOrdersOfCustomer(t.Context(), customerID, from, to, func(o *models.Order) error {
+			ids = append(ids, o.ID)
+			items = append(items, len(o.Items))
+
+			assert.Equal(t, customerID, o.CustomerID)
+
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first, second}, ids)
+		assert.Equal(t, []int{2, 1}, items)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Context cancelled mid-stream", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		rows := sqlmock.NewRows(columns)
+		for range 50 {
+			addRow(rows, uuid.New(), 1)
+		}
+
+		mock.ExpectQuery("FROM orders o").WithArgs(customerID, from, to).WillReturnRows(rows)
+
+		count := 0
+
+		// Act
+		err := repo.StreamOrdersOfCustomer(ctx, customerID, from, to, func(_ *models.Order) error {
+			count++
+			if count == 5 {
+				cancel()
+			}
+
+			return nil
+		})
+
+		// Assert
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 5, count, "no order should be processed after the cancellation")
+	})
+}
+
+func TestLapseUnpaidOrders(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	ctx := t.Context()
+
+	orderID := uuid.New()
+	placedBefore := time.Now().Add(-24 * time.Hour)
+
+	t.Run("Success - Get Order By Payment Intent", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM orders WHERE payment_intent_id = $1 LIMIT 1`)).
+			WithArgs("pi_abc").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(orderID))
+
+		// Act
+		id, err := repo.GetOrderIDByPaymentIntentID(ctx, "pi_abc")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, orderID, id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Unknown Payment Intent", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id FROM orders WHERE payment_intent_id`).
+			WithArgs("pi_unknown").
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		_, err := repo.GetOrderIDByPaymentIntentID(ctx, "pi_unknown")
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - List Unpaid Orders", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id FROM orders\s+WHERE status = \$1 AND payment_status IN \(\$2, \$3\) AND created_at < \$4\s+ORDER BY created_at\s+LIMIT \$5`).
+			WithArgs(models.OrderStatusPending, models.PaymentStatusPending, models.PaymentStatusFailed, placedBefore, 100).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(orderID))
+
+		// Act
+		ids, err := repo.ListUnpaidOrderIDs(ctx, placedBefore, 100)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{orderID}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Lapse Order", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE orders SET status = \$1, payment_status = \$2, updated_at = \$3\s+WHERE id = \$4 AND status = \$5 AND payment_status IN \(\$6, \$2\)`).
+			WithArgs(models.OrderStatusCancelled, models.PaymentStatusFailed, sqlmock.AnyArg(), orderID, models.OrderStatusPending, models.PaymentStatusPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM stock_allocations\s+WHERE order_item_id IN \(SELECT id FROM order_items WHERE order_id = \$1\)`).
+			WithArgs(orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE products p SET stock_quantity = p.stock_quantity \+ t.quantity.*FROM \(SELECT product_id, SUM\(quantity\) AS quantity FROM stock_movements WHERE reference_id = \$1 AND reason = \$2`).
+			WithArgs(orderID, models.StockMovementOrder).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO stock_movements`).
+			WithArgs(orderID, models.StockMovementOrder).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.LapseOrder(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Order Paid In The Meantime", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE orders SET status = \$1, payment_status = \$2`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.LapseOrder(ctx, orderID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Restock Error Rolls The Lapse Back", func(t *testing.T) {
+		dbErr := errors.New("db error")
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE orders SET status = \$1, payment_status = \$2`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM stock_allocations`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE products p SET stock_quantity`).
+			WillReturnError(dbErr)
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.LapseOrder(ctx, orderID)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPaymentRetry(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	ctx := t.Context()
+
+	orderID := uuid.New()
+	lockedUntil := time.Now().Add(time.Hour)
+
+	t.Run("Success - Get Payment Retry State", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT payment_retries, payment_retry_locked_until FROM orders WHERE id = $1`)).
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"payment_retries", "payment_retry_locked_until"}).AddRow(3, lockedUntil))
+
+		// Act
+		state, err := repo.GetPaymentRetryState(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, state.Retries)
+		require.NotNil(t, state.LockedUntil)
+		assert.True(t, lockedUntil.Equal(*state.LockedUntil))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Swap Payment Intent", func(t *testing.T) {
+		state := &models.PaymentRetryState{Retries: 3, LockedUntil: &lockedUntil}
+		mock.ExpectExec(`UPDATE orders SET payment_intent_id = \$1, payment_status = \$2, payment_retries = \$3, payment_retry_locked_until = \$4, updated_at = \$5\s+WHERE id = \$6 AND payment_intent_id = \$7 AND status = \$8`).
+			WithArgs("pi_retry", models.PaymentStatusPending, 3, &lockedUntil, sqlmock.AnyArg(), orderID, "pi_failed", models.OrderStatusPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.SwapPaymentIntent(ctx, orderID, "pi_failed", "pi_retry", state)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Payment Intent Changed In The Meantime", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE orders SET payment_intent_id`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.SwapPaymentIntent(ctx, orderID, "pi_failed", "pi_retry", &models.PaymentRetryState{Retries: 1})
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCountPurchasedUnits(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	ctx := t.Context()
+
+	customerID := uuid.New()
+	productID := uuid.New()
+	since := time.Date(2025, time.March, 7, 9, 30, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE o.customer_id = $1 AND oi.product_id = $2 AND o.status <> $3 AND o.created_at >= $4`)).
+			WithArgs(customerID, productID, models.OrderStatusCancelled, since).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(3))
+
+		// Act
+		purchased, err := repo.CountPurchasedUnits(ctx, customerID, productID, since)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, purchased)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestListOrderTimeline(t *testing.T) {
+	repo, mock := setupOrderRepoTest(t)
+	ctx := t.Context()
+
+	orderID := uuid.New()
+	placedAt := time.Date(2025, time.March, 7, 9, 30, 0, 0, time.UTC)
+	columns := []string{"type", "occurred_at", "reference_id", "status", "summary", "internal"}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT type, occurred_at, reference_id, status, summary, internal`)).
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("placed", placedAt, orderID.String(), "", "Total 42.50", false).
+				AddRow("payment", placedAt.Add(time.Minute), "pi_123", "succeeded", "42.50 usd", false).
+				AddRow("fraud_reviewed", placedAt.Add(time.Hour), "", "approved", "Called the customer", true))
+
+		// Act
+		events, err := repo.ListOrderTimeline(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, models.OrderTimelinePlaced, events[0].Type)
+		assert.Equal(t, "pi_123", events[1].ReferenceID)
+		assert.True(t, events[2].Internal)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Database Error", func(t *testing.T) {
+		dbErr := errors.New("connection refused")
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT type, occurred_at, reference_id, status, summary, internal`)).
+			WithArgs(orderID).
+			WillReturnError(dbErr)
+
+		// Act
+		events, err := repo.ListOrderTimeline(ctx, orderID)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.Nil(t, events)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -15,6 +15,10 @@ type PaymentRepository interface {
 	GetPaymentByID(ctx context.Context, id string) (*models.Payment, error)
 	UpdatePaymentStatus(ctx context.Context, id string, status models.PaymentStatus) error
 	ListPaymentsOfCustomer(ctx context.Context, customerID string, page, size int) ([]*models.Payment, int, error)
+	UpdatePaymentFees(ctx context.Context, id string, feeAmount, netAmount int64) error
+	GetSalesSummary(ctx context.Context, from, to time.Time) ([]models.SalesSummary, error)
+	StreamPayments(ctx context.Context, from, to time.Time, fn func(models.Payment) error) error
+	ListOrphanedPaymentIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
 }
 
 type paymentRepository struct {
@@ -49,12 +53,12 @@ func (r *paymentRepository) GetPaymentByID(ctx context.Context, id string) (*mod
 	payment := &models.Payment{}
 
 	query := `
-		SELECT id, amount, currency, customer_id, description, status, payment_method, stripe_id, created_at, updated_at
+		SELECT id, amount, fee_amount, net_amount, currency, customer_id, description, status, payment_method, stripe_id, created_at, updated_at
 		FROM payments
 		WHERE id = $1
 	`
 
-	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&payment.ID, &payment.Amount, &payment.Currency, &payment.CustomerID, &payment.Description, &payment.Status, &payment.PaymentMethod, &payment.StripeID, &payment.CreatedAt, &payment.UpdatedAt)
+	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&payment.ID, &payment.Amount, &payment.FeeAmount, &payment.NetAmount, &payment.Currency, &payment.CustomerID, &payment.Description, &payment.Status, &payment.PaymentMethod, &payment.StripeID, &payment.CreatedAt, &payment.UpdatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the payment: %w", err)
 	}
@@ -105,7 +109,7 @@ func (r *paymentRepository) ListPaymentsOfCustomer(ctx context.Context, customer
 	offset := (page - 1) * size
 
 	query := `
-		SELECT id, customer_id, amount, currency, description, status, payment_method, stripe_id, created_at, updated_at
+		SELECT id, customer_id, amount, fee_amount, net_amount, currency, description, status, payment_method, stripe_id, created_at, updated_at
 		FROM payments
 		WHERE customer_id = $1
 		ORDER BY created_at DESC
@@ -124,7 +128,7 @@ func (r *paymentRepository) ListPaymentsOfCustomer(ctx context.Context, customer
 	for rows.Next() {
 		payment := &models.Payment{}
 
-		err := rows.Scan(&payment.ID, &payment.CustomerID, &payment.Amount, &payment.Currency, &payment.Description, &payment.Status, &payment.PaymentMethod, &payment.StripeID, &payment.CreatedAt, &payment.UpdatedAt)
+		err := rows.Scan(&payment.ID, &payment.CustomerID, &payment.Amount, &payment.FeeAmount, &payment.NetAmount, &payment.Currency, &payment.Description, &payment.Status, &payment.PaymentMethod, &payment.StripeID, &payment.CreatedAt, &payment.UpdatedAt)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan the payments: %w", err)
 		}
@@ -138,3 +142,152 @@ func (r *paymentRepository) ListPaymentsOfCustomer(ctx context.Context, customer
 
 	return payments, total, nil
 }
+
+func (r *paymentRepository) UpdatePaymentFees(ctx context.Context, id string, feeAmount, netAmount int64) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payments SET fee_amount = $1, net_amount = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, feeAmount, netAmount, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update the payment fees: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetSalesSummary totals the succeeded payments created in [from, to) per currency.
+func (r *paymentRepository) GetSalesSummary(ctx context.Context, from, to time.Time) ([]models.SalesSummary, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT currency,
+			COUNT(*),
+			COALESCE(SUM(amount), 0),
+			COALESCE(SUM(fee_amount), 0),
+			COALESCE(SUM(COALESCE(net_amount, amount)), 0),
+			COUNT(*) FILTER (WHERE fee_amount IS NULL)
+		FROM payments
+		WHERE status = $1 AND created_at >= $2 AND created_at < $3
+		GROUP BY currency
+		ORDER BY currency
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, models.PaymentStatusSucceeded, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sales summary: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []models.SalesSummary
+
+	for rows.Next() {
+		var summary models.SalesSummary
+
+		if err := rows.Scan(&summary.Currency, &summary.PaymentCount, &summary.GrossAmount, &summary.FeeAmount, &summary.NetAmount, &summary.PendingFeeCount); err != nil {
+			return nil, fmt.Errorf("failed to scan sales summary: %w", err)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sales summary: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// StreamPayments calls fn for every payment created in [from, to), oldest first, without loading them
+// all in memory. Each payment is passed by value, fn may keep it without it being overwritten by the
+// next row. Iteration stops at the first error of fn or once ctx is done; the query is bounded by ctx only, not by the
+// default DB timeout, since exports can legitimately run for a while.
+func (r *paymentRepository) StreamPayments(ctx context.Context, from, to time.Time, fn func(models.Payment) error) error {
+	query := `
+		SELECT id, customer_id, amount, fee_amount, net_amount, currency, description, status, payment_method, stripe_id, created_at, updated_at
+		FROM payments
+		WHERE created_at >= $1 AND created_at < $2
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to stream the payments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var payment models.Payment
+
+		err := rows.Scan(&payment.ID, &payment.CustomerID, &payment.Amount, &payment.FeeAmount, &payment.NetAmount, &payment.Currency, &payment.Description, &payment.Status, &payment.PaymentMethod, &payment.StripeID, &payment.CreatedAt, &payment.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to scan the payments: %w", err)
+		}
+
+		if err := fn(payment); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating payments: %w", err)
+	}
+
+	return ctx.Err()
+}
+
+// ListOrphanedPaymentIDs returns the pending payment intents created before createdBefore that no
+// order points to, oldest first. Checkout sessions are left out, Stripe expires them on its own.
+func (r *paymentRepository) ListOrphanedPaymentIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT p.id FROM payments p
+		WHERE p.status = $1 AND p.payment_method <> 'checkout' AND p.created_at < $2
+			AND NOT EXISTS (SELECT 1 FROM orders o WHERE o.payment_intent_id = p.id)
+		ORDER BY p.created_at
+		LIMIT $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, models.PaymentStatusPending, createdBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orphaned payments: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan orphaned payment id: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating orphaned payments: %w", err)
+	}
+
+	return ids, nil
+}
@@ -1,8 +1,10 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 	"time"
@@ -98,7 +100,7 @@ func TestGetPaymentByID(t *testing.T) {
 
 	// Define the expected SQL query
 	expectedSQL := regexp.QuoteMeta(`
-        SELECT id, amount, currency, customer_id, description, status, payment_method, stripe_id, created_at, updated_at
+        SELECT id, amount, fee_amount, net_amount, currency, customer_id, description, status, payment_method, stripe_id, created_at, updated_at
         FROM payments
         WHERE id = $1
     `)
@@ -118,8 +120,8 @@ func TestGetPaymentByID(t *testing.T) {
 	}
 
 	t.Run("Success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "amount", "currency", "customer_id", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
-			AddRow(expectedPayment.ID, expectedPayment.Amount, expectedPayment.Currency, expectedPayment.CustomerID, expectedPayment.Description, expectedPayment.Status, expectedPayment.PaymentMethod, expectedPayment.StripeID, expectedPayment.CreatedAt, expectedPayment.UpdatedAt)
+		rows := sqlmock.NewRows([]string{"id", "amount", "fee_amount", "net_amount", "currency", "customer_id", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
+			AddRow(expectedPayment.ID, expectedPayment.Amount, nil, nil, expectedPayment.Currency, expectedPayment.CustomerID, expectedPayment.Description, expectedPayment.Status, expectedPayment.PaymentMethod, expectedPayment.StripeID, expectedPayment.CreatedAt, expectedPayment.UpdatedAt)
 
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(testID).
@@ -169,8 +171,8 @@ func TestGetPaymentByID(t *testing.T) {
 	})
 
 	t.Run("Failure - Scan Error", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "amount", "currency", "customer_id", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
-			AddRow(expectedPayment.ID, "not-an-int", expectedPayment.Currency, expectedPayment.CustomerID, expectedPayment.Description, expectedPayment.Status, expectedPayment.PaymentMethod, expectedPayment.StripeID, expectedPayment.CreatedAt, expectedPayment.UpdatedAt)
+		rows := sqlmock.NewRows([]string{"id", "amount", "fee_amount", "net_amount", "currency", "customer_id", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
+			AddRow(expectedPayment.ID, "not-an-int", nil, nil, expectedPayment.Currency, expectedPayment.CustomerID, expectedPayment.Description, expectedPayment.Status, expectedPayment.PaymentMethod, expectedPayment.StripeID, expectedPayment.CreatedAt, expectedPayment.UpdatedAt)
 
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(testID).
@@ -269,7 +271,7 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 	// Define expected SQL queries
 	expectedCountSQL := regexp.QuoteMeta(`SELECT COUNT(*) FROM payments`)
 	expectedListSQL := regexp.QuoteMeta(`
-        SELECT id, customer_id, amount, currency, description, status, payment_method, stripe_id, created_at, updated_at
+        SELECT id, customer_id, amount, fee_amount, net_amount, currency, description, status, payment_method, stripe_id, created_at, updated_at
         FROM payments
         WHERE customer_id = $1
         ORDER BY created_at DESC
@@ -286,9 +288,9 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(expectedTotal))
 
-		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
-			AddRow(payment2.ID, payment2.CustomerID, payment2.Amount, payment2.Currency, payment2.Description, payment2.Status, payment2.PaymentMethod, payment2.StripeID, payment2.CreatedAt, payment2.UpdatedAt). // Order is DESC
-			AddRow(payment1.ID, payment1.CustomerID, payment1.Amount, payment1.Currency, payment1.Description, payment1.Status, payment1.PaymentMethod, payment1.StripeID, payment1.CreatedAt, payment1.UpdatedAt)
+		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
+			AddRow(payment2.ID, payment2.CustomerID, payment2.Amount, nil, nil, payment2.Currency, payment2.Description, payment2.Status, payment2.PaymentMethod, payment2.StripeID, payment2.CreatedAt, payment2.UpdatedAt). // Order is DESC
+			AddRow(payment1.ID, payment1.CustomerID, payment1.Amount, nil, nil, payment1.Currency, payment1.Description, payment1.Status, payment1.PaymentMethod, payment1.StripeID, payment1.CreatedAt, payment1.UpdatedAt)
 
 		mock.ExpectQuery(expectedListSQL).
 			WithArgs(customerID, size, (page-1)*size).
@@ -312,7 +314,7 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(expectedTotal))
 
-		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"})
+		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"})
 
 		mock.ExpectQuery(expectedListSQL).
 			WithArgs(customerID, size, (page-1)*size).
@@ -373,8 +375,8 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(expectedTotal))
 
-		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
-			AddRow(payment1.ID, payment1.CustomerID, "not-an-int", payment1.Currency, payment1.Description, payment1.Status, payment1.PaymentMethod, payment1.StripeID, payment1.CreatedAt, payment1.UpdatedAt) // Bad amount
+		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
+			AddRow(payment1.ID, payment1.CustomerID, "not-an-int", nil, nil, payment1.Currency, payment1.Description, payment1.Status, payment1.PaymentMethod, payment1.StripeID, payment1.CreatedAt, payment1.UpdatedAt) // Bad amount
 
 		mock.ExpectQuery(expectedListSQL).
 			WithArgs(customerID, size, (page-1)*size).
@@ -399,8 +401,8 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 		mock.ExpectQuery(expectedCountSQL).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(expectedTotal))
 
-		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
-			AddRow(payment1.ID, payment1.CustomerID, payment1.Amount, payment1.Currency, payment1.Description, payment1.Status, payment1.PaymentMethod, payment1.StripeID, payment1.CreatedAt, payment1.UpdatedAt).
+		listRows := sqlmock.NewRows([]string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}).
+			AddRow(payment1.ID, payment1.CustomerID, payment1.Amount, nil, nil, payment1.Currency, payment1.Description, payment1.Status, payment1.PaymentMethod, payment1.StripeID, payment1.CreatedAt, payment1.UpdatedAt).
 			RowError(0, rowsErr)
 
 		mock.ExpectQuery(expectedListSQL).
@@ -429,7 +431,7 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 
 		mock.ExpectQuery(expectedListSQL).
 			WithArgs(customerID, size, (page-1)*size).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id", "amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"})) // Empty result
+			WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"})) // Empty result
 
 		// Act
 		_, _, err := repo.ListPaymentsOfCustomer(ctx, customerID, page, size)
@@ -439,3 +441,225 @@ func TestListPaymentsOfCustomer(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met, check count query")
 	})
 }
+
+func TestPaymentRepository_UpdatePaymentFees(t *testing.T) {
+	repo, mock := setupPaymentRepoTest(t)
+	ctx := t.Context()
+	testID := "pi_fees123"
+
+	expectedSQL := regexp.QuoteMeta(`
+        UPDATE payments SET fee_amount = $1, net_amount = $2, updated_at = $3
+        WHERE id = $4
+    `)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(expectedSQL).
+			WithArgs(int64(175), int64(4825), sqlmock.AnyArg(), testID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.UpdatePaymentFees(ctx, testID, 175, 4825)
+
+		// Assert
+		assert.NoError(t, err, "UpdatePaymentFees should succeed")
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("Failure - Not Found (0 Rows Affected)", func(t *testing.T) {
+		mock.ExpectExec(expectedSQL).
+			WithArgs(int64(175), int64(4825), sqlmock.AnyArg(), testID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.UpdatePaymentFees(ctx, testID, 175, 4825)
+
+		// Assert
+		assert.ErrorIs(t, err, sql.ErrNoRows, "Error should be sql.ErrNoRows for 0 affected rows")
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+}
+
+func TestGetSalesSummary(t *testing.T) {
+	repo, mock := setupPaymentRepoTest(t)
+	ctx := t.Context()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"currency", "count", "gross", "fee", "net", "pending"}).
+			AddRow("eur", 2, 3000, 120, 2880, 0).
+			AddRow("usd", 3, 10000, 300, 9700, 1)
+
+		mock.ExpectQuery("FROM payments").
+			WithArgs(models.PaymentStatusSucceeded, from, to).
+			WillReturnRows(rows)
+
+		// Act
+		summaries, err := repo.GetSalesSummary(ctx, from, to)
+
+		// Assert
+		require.NoError(t, err, "GetSalesSummary should succeed")
+		require.Len(t, summaries, 2)
+		assert.Equal(t, models.SalesSummary{
+			Currency:        "usd",
+			PaymentCount:    3,
+			GrossAmount:     10000,
+			FeeAmount:       300,
+			NetAmount:       9700,
+			PendingFeeCount: 1,
+		}, summaries[1])
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("Failure - DB Error on Query", func(t *testing.T) {
+		dbErr := errors.New("query execution failed")
+		mock.ExpectQuery("FROM payments").WillReturnError(dbErr)
+
+		// Act
+		summaries, err := repo.GetSalesSummary(ctx, from, to)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr, "Error should wrap the original DB error")
+		assert.Nil(t, summaries)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+}
+
+func TestStreamPayments(t *testing.T) {
+	repo, mock := setupPaymentRepoTest(t)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	columns := []string{"id", "customer_id", "amount", "fee_amount", "net_amount", "currency", "description", "status", "payment_method", "stripe_id", "created_at", "updated_at"}
+
+	newRows := func(n int) *sqlmock.Rows {
+		rows := sqlmock.NewRows(columns)
+		for i := range n {
+			rows.AddRow(fmt.Sprintf("pi_%d", i), "cus_123", 1000+i, nil, nil, "usd", "", models.PaymentStatusSucceeded, "card", fmt.Sprintf("pi_%d", i), from, from)
+		}
+
+		return rows
+	}
+
+	t.Run("Success - Rows are streamed one at a time", func(t *testing.T) {
+		// Arrange
+		const total = 10000
+
+		mock.ExpectQuery("FROM payments").WithArgs(from, to).WillReturnRows(newRows(total))
+
+		var (
+			count       int
+			first, last models.Payment
+		)
+
+		// Act
+		err := repo.StreamPayments(t.Context(), from, to, func(p models.Payment) error {
+			if count == 0 {
+				first = p
+			}
+
+			count++
+			last = p
+
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, total, count)
+		assert.Equal(t, "pi_0", first.ID, "a kept payment should not be overwritten by the next rows")
+		assert.Equal(t, fmt.Sprintf("pi_%d", total-1), last.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Context cancelled mid-stream", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		mock.ExpectQuery("FROM payments").WithArgs(from, to).WillReturnRows(newRows(100))
+
+		count := 0
+
+		// Act
+		err := repo.StreamPayments(ctx, from, to, func(_ models.Payment) error {
+			count++
+			if count == 10 {
+				cancel()
+			}
+
+			return nil
+		})
+
+		// Assert
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 10, count, "no row should be processed after the cancellation")
+	})
+
+	t.Run("Failure - Callback error stops the stream", func(t *testing.T) {
+		// Arrange
+		writeErr := errors.New("client went away")
+		mock.ExpectQuery("FROM payments").WithArgs(from, to).WillReturnRows(newRows(5))
+
+		count := 0
+
+		// Act
+		err := repo.StreamPayments(t.Context(), from, to, func(_ models.Payment) error {
+			count++
+
+			return writeErr
+		})
+
+		// Assert
+		require.ErrorIs(t, err, writeErr)
+		assert.Equal(t, 1, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - DB Error on Query", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("query execution failed")
+		mock.ExpectQuery("FROM payments").WillReturnError(dbErr)
+
+		// Act
+		err := repo.StreamPayments(t.Context(), from, to, func(_ models.Payment) error { return nil })
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestListOrphanedPaymentIDs(t *testing.T) {
+	repo, mock := setupPaymentRepoTest(t)
+	createdBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success - Pending intents without an order", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT p.id FROM payments p (.+) NOT EXISTS \\(SELECT 1 FROM orders o WHERE o.payment_intent_id = p.id\\)").
+			WithArgs(models.PaymentStatusPending, createdBefore, 50).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("pi_1").AddRow("pi_2"))
+
+		// Act
+		ids, err := repo.ListOrphanedPaymentIDs(t.Context(), createdBefore, 50)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pi_1", "pi_2"}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Query error", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT p.id FROM payments p").
+			WithArgs(models.PaymentStatusPending, createdBefore, 50).
+			WillReturnError(errors.New("db down"))
+
+		// Act
+		ids, err := repo.ListOrphanedPaymentIDs(t.Context(), createdBefore, 50)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
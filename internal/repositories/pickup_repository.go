@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type PickupRepository interface {
+	CreateLocation(ctx context.Context, location *models.PickupLocation) error
+	GetLocationByID(ctx context.Context, id uuid.UUID) (*models.PickupLocation, error)
+	ListLocations(ctx context.Context, activeOnly bool) ([]*models.PickupLocation, error)
+	UpdateLocation(ctx context.Context, location *models.PickupLocation) error
+	SavePickup(ctx context.Context, pickup *models.OrderPickup) error
+	GetPickupByOrderID(ctx context.Context, orderID uuid.UUID) (*models.OrderPickup, error)
+	MarkPickedUp(ctx context.Context, orderID uuid.UUID) error
+}
+
+type pickupRepository struct {
+	DB *sql.DB
+}
+
+func NewPickupRepo(db *sql.DB) PickupRepository {
+	return &pickupRepository{DB: db}
+}
+
+func (r *pickupRepository) CreateLocation(ctx context.Context, location *models.PickupLocation) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	address, err := json.Marshal(location.Address)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pickup location address: %w", err)
+	}
+
+	query := `
+		INSERT INTO pickup_locations (id, name, address, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err = r.DB.QueryRowContext(dbCtx, query, location.ID, location.Name, address, location.Active).Scan(&location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert pickup location: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pickupRepository) GetLocationByID(ctx context.Context, id uuid.UUID) (*models.PickupLocation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, name, address, active, created_at, updated_at
+		FROM pickup_locations
+		WHERE id = $1
+	`
+
+	location := &models.PickupLocation{}
+
+	var address []byte
+
+	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&location.ID, &location.Name, &address, &location.Active, &location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pickup location: %w", err)
+	}
+
+	if err := json.Unmarshal(address, &location.Address); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pickup location address: %w", err)
+	}
+
+	return location, nil
+}
+
+func (r *pickupRepository) ListLocations(ctx context.Context, activeOnly bool) ([]*models.PickupLocation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, name, address, active, created_at, updated_at
+		FROM pickup_locations
+		WHERE active OR NOT $1
+		ORDER BY name
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pickup locations: %w", err)
+	}
+	defer rows.Close()
+
+	var locations []*models.PickupLocation
+
+	for rows.Next() {
+		location := &models.PickupLocation{}
+
+		var address []byte
+
+		if err := rows.Scan(&location.ID, &location.Name, &address, &location.Active, &location.CreatedAt, &location.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan pickup location: %w", err)
+		}
+
+		if err := json.Unmarshal(address, &location.Address); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal address of pickup location %s: %w", location.ID, err)
+		}
+
+		locations = append(locations, location)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pickup locations: %w", err)
+	}
+
+	return locations, nil
+}
+
+func (r *pickupRepository) UpdateLocation(ctx context.Context, location *models.PickupLocation) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	address, err := json.Marshal(location.Address)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pickup location address: %w", err)
+	}
+
+	query := `
+		UPDATE pickup_locations SET name = $1, address = $2, active = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	err = r.DB.QueryRowContext(dbCtx, query, location.Name, address, location.Active, location.ID).Scan(&location.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update pickup location: %w", err)
+	}
+
+	return nil
+}
+
+// SavePickup records that the order is ready for pickup. Marking it ready again issues a new code.
+func (r *pickupRepository) SavePickup(ctx context.Context, pickup *models.OrderPickup) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_pickups (order_id, pickup_location_id, code, ready_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (order_id) DO UPDATE
+		SET code = EXCLUDED.code, ready_at = EXCLUDED.ready_at, picked_up_at = NULL
+		RETURNING ready_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, pickup.OrderID, pickup.PickupLocationID, pickup.Code).Scan(&pickup.ReadyAt)
+	if err != nil {
+		return fmt.Errorf("failed to save order pickup: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pickupRepository) GetPickupByOrderID(ctx context.Context, orderID uuid.UUID) (*models.OrderPickup, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT order_id, pickup_location_id, code, ready_at, picked_up_at
+		FROM order_pickups
+		WHERE order_id = $1
+	`
+
+	pickup := &models.OrderPickup{}
+
+	var pickedUpAt sql.NullTime
+
+	err := r.DB.QueryRowContext(dbCtx, query, orderID).Scan(&pickup.OrderID, &pickup.PickupLocationID, &pickup.Code, &pickup.ReadyAt, &pickedUpAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order pickup: %w", err)
+	}
+
+	if pickedUpAt.Valid {
+		pickup.PickedUpAt = &pickedUpAt.Time
+	}
+
+	return pickup, nil
+}
+
+// MarkPickedUp returns sql.ErrNoRows when the order was already collected.
+func (r *pickupRepository) MarkPickedUp(ctx context.Context, orderID uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE order_pickups SET picked_up_at = $1
+		WHERE order_id = $2 AND picked_up_at IS NULL
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, time.Now(), orderID)
+	if err != nil {
+		return fmt.Errorf("failed to mark order as picked up: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickupRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewPickupRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	locationID := uuid.New()
+	orderID := uuid.New()
+	address := models.Address{Street: "1 Store Rd", City: "Anytown", PostalCode: "12345", Country: "USA"}
+
+	addressJSON, err := json.Marshal(address)
+	require.NoError(t, err)
+
+	t.Run("CreateLocation_Success", func(t *testing.T) {
+		// Arrange
+		location := &models.PickupLocation{ID: locationID, Name: "Downtown", Address: address, Active: true}
+
+		mock.ExpectQuery("INSERT INTO pickup_locations").
+			WithArgs(locationID, "Downtown", addressJSON, true).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+
+		// Act
+		err := repo.CreateLocation(ctx, location)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, location.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListLocations_ActiveOnly", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM pickup_locations").
+			WithArgs(true).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "address", "active", "created_at", "updated_at"}).
+				AddRow(locationID, "Downtown", addressJSON, true, now, now))
+
+		// Act
+		locations, err := repo.ListLocations(ctx, true)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, locations, 1)
+		assert.Equal(t, address, locations[0].Address)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SavePickup_Success", func(t *testing.T) {
+		// Arrange
+		pickup := &models.OrderPickup{OrderID: orderID, PickupLocationID: locationID, Code: "042137"}
+
+		mock.ExpectQuery("INSERT INTO order_pickups").
+			WithArgs(orderID, locationID, "042137").
+			WillReturnRows(sqlmock.NewRows([]string{"ready_at"}).AddRow(now))
+
+		// Act
+		err := repo.SavePickup(ctx, pickup)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, pickup.ReadyAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetPickupByOrderID_NotPickedUp", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM order_pickups").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"order_id", "pickup_location_id", "code", "ready_at", "picked_up_at"}).
+				AddRow(orderID, locationID, "042137", now, nil))
+
+		// Act
+		pickup, err := repo.GetPickupByOrderID(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "042137", pickup.Code)
+		assert.Nil(t, pickup.PickedUpAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MarkPickedUp_AlreadyPickedUp", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("UPDATE order_pickups").
+			WithArgs(sqlmock.AnyArg(), orderID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.MarkPickedUp(ctx, orderID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
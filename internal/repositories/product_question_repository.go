@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type ProductQuestionRepository interface {
+	CreateQuestion(ctx context.Context, question *models.ProductQuestion) error
+	GetQuestion(ctx context.Context, id uuid.UUID) (*models.ProductQuestion, error)
+	ListProductQuestions(ctx context.Context, productID uuid.UUID, status models.QuestionStatus, page, size int) ([]*models.ProductQuestion, int, error)
+	ListQuestions(ctx context.Context, status models.QuestionStatus, page, size int) ([]*models.ProductQuestion, int, error)
+	ListVendorQuestions(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.ProductQuestion, int, error)
+	ModerateQuestion(ctx context.Context, question *models.ProductQuestion) error
+	AnswerQuestion(ctx context.Context, question *models.ProductQuestion) error
+	CountDuplicateQuestions(ctx context.Context, question *models.ProductQuestion, since time.Time) (int, int, error)
+	CountRecentProductQuestions(ctx context.Context, productID uuid.UUID, since time.Time) (int, error)
+}
+
+type productQuestionRepository struct {
+	DB *sql.DB
+}
+
+func NewProductQuestionRepo(db *sql.DB) ProductQuestionRepository {
+	return &productQuestionRepository{DB: db}
+}
+
+const productQuestionColumns = `q.id, q.product_id, q.customer_id, q.question, q.status, q.flags, q.answer, q.answered_by, q.answerer_role, q.answered_at, q.moderated_by, q.created_at, q.updated_at`
+
+func (r *productQuestionRepository) CreateQuestion(ctx context.Context, question *models.ProductQuestion) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO product_questions (id, product_id, customer_id, question, content_hash, status, flags, answer, answerer_role, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, '', '', NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, question.ID, question.ProductID, question.CustomerID, question.Question, question.ContentHash, question.Status, pq.Array(question.Flags)).
+		Scan(&question.CreatedAt, &question.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create product question: %w", err)
+	}
+
+	return nil
+}
+
+func (r *productQuestionRepository) GetQuestion(ctx context.Context, id uuid.UUID) (*models.ProductQuestion, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + productQuestionColumns + ` FROM product_questions q WHERE q.id = $1`
+
+	question := &models.ProductQuestion{}
+	if err := scanProductQuestion(r.DB.QueryRowContext(dbCtx, query, id), question); err != nil {
+		return nil, err
+	}
+
+	return question, nil
+}
+
+// ListProductQuestions returns the questions of a product in a status, the newest first.
+func (r *productQuestionRepository) ListProductQuestions(ctx context.Context, productID uuid.UUID, status models.QuestionStatus, page, size int) ([]*models.ProductQuestion, int, error) {
+	return r.listQuestions(ctx, `q.product_id = $1 AND q.status = $2`, `q.created_at DESC, q.id`, page, size, productID, status)
+}
+
+// ListQuestions returns the questions in a status, all of them for an empty one. The flagged come
+// first, then the oldest so the questions waiting longest are moderated first.
+func (r *productQuestionRepository) ListQuestions(ctx context.Context, status models.QuestionStatus, page, size int) ([]*models.ProductQuestion, int, error) {
+	return r.listQuestions(ctx, `$1 = '' OR q.status = $1`, `cardinality(q.flags) = 0, q.created_at, q.id`, page, size, status)
+}
+
+// ListVendorQuestions returns the published questions about the products of a vendor, the unanswered
+// first and the oldest first among them.
+func (r *productQuestionRepository) ListVendorQuestions(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.ProductQuestion, int, error) {
+	return r.listQuestions(ctx, `q.status = 'published' AND q.product_id IN (SELECT id FROM products WHERE vendor_id = $1)`,
+		`q.answered_at IS NOT NULL, q.created_at, q.id`, page, size, vendorID)
+}
+
+func (r *productQuestionRepository) listQuestions(ctx context.Context, filter, order string, page, size int, args ...any) ([]*models.ProductQuestion, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM product_questions q WHERE `+filter, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count product questions: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM product_questions q
+		WHERE %s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, productQuestionColumns, filter, order, len(args)+1, len(args)+2)
+
+	rows, err := r.DB.QueryContext(dbCtx, query, append(args, size, (page-1)*size)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list product questions: %w", err)
+	}
+	defer rows.Close()
+
+	questions := []*models.ProductQuestion{}
+
+	for rows.Next() {
+		question := &models.ProductQuestion{}
+		if err := scanProductQuestion(rows, question); err != nil {
+			return nil, 0, err
+		}
+
+		questions = append(questions, question)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating product questions: %w", err)
+	}
+
+	return questions, total, nil
+}
+
+// ModerateQuestion moves a question to the status of the moderator, it returns sql.ErrNoRows when the
+// question is in that status already.
+func (r *productQuestionRepository) ModerateQuestion(ctx context.Context, question *models.ProductQuestion) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE product_questions q
+		SET status = $2, moderated_by = $3, updated_at = NOW()
+		WHERE q.id = $1 AND q.status <> $2
+		RETURNING ` + productQuestionColumns
+
+	return scanProductQuestion(r.DB.QueryRowContext(dbCtx, query, question.ID, question.Status, question.ModeratedBy), question)
+}
+
+// AnswerQuestion records the answer of a published question, replacing an earlier one. It returns
+// sql.ErrNoRows when the question is not published.
+func (r *productQuestionRepository) AnswerQuestion(ctx context.Context, question *models.ProductQuestion) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE product_questions q
+		SET answer = $2, answered_by = $3, answerer_role = $4, answered_at = $5, updated_at = NOW()
+		WHERE q.id = $1 AND q.status = 'published'
+		RETURNING ` + productQuestionColumns
+
+	return scanProductQuestion(r.DB.QueryRowContext(dbCtx, query, question.ID, question.Answer, question.AnsweredBy, question.AnswererRole, question.AnsweredAt), question)
+}
+
+// CountDuplicateQuestions counts the questions with the content hash of question asked since a time,
+// by its customer about any product and by the other customers about its product.
+func (r *productQuestionRepository) CountDuplicateQuestions(ctx context.Context, question *models.ProductQuestion, since time.Time) (int, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE customer_id = $2),
+			COUNT(*) FILTER (WHERE customer_id <> $2 AND product_id = $3)
+		FROM product_questions
+		WHERE content_hash = $1 AND created_at >= $4
+	`
+
+	var own, others int
+
+	if err := r.DB.QueryRowContext(dbCtx, query, question.ContentHash, question.CustomerID, question.ProductID, since).Scan(&own, &others); err != nil {
+		return 0, 0, fmt.Errorf("failed to count duplicate product questions: %w", err)
+	}
+
+	return own, others, nil
+}
+
+// CountRecentProductQuestions counts the questions about a product asked since a time, in any status.
+func (r *productQuestionRepository) CountRecentProductQuestions(ctx context.Context, productID uuid.UUID, since time.Time) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var count int
+
+	query := `SELECT COUNT(*) FROM product_questions WHERE product_id = $1 AND created_at >= $2`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, productID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count recent product questions: %w", err)
+	}
+
+	return count, nil
+}
+
+func scanProductQuestion(row interface{ Scan(dest ...any) error }, question *models.ProductQuestion) error {
+	var (
+		answeredBy  uuid.NullUUID
+		answeredAt  sql.NullTime
+		moderatedBy uuid.NullUUID
+	)
+
+	err := row.Scan(&question.ID, &question.ProductID, &question.CustomerID, &question.Question, &question.Status, pq.Array(&question.Flags), &question.Answer,
+		&answeredBy, &question.AnswererRole, &answeredAt, &moderatedBy, &question.CreatedAt, &question.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to scan product question: %w", err)
+	}
+
+	question.AnsweredBy = nil
+	if answeredBy.Valid {
+		question.AnsweredBy = &answeredBy.UUID
+	}
+
+	question.AnsweredAt = nil
+	if answeredAt.Valid {
+		question.AnsweredAt = &answeredAt.Time
+	}
+
+	question.ModeratedBy = nil
+	if moderatedBy.Valid {
+		question.ModeratedBy = &moderatedBy.UUID
+	}
+
+	return nil
+}
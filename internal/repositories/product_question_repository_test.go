@@ -0,0 +1,161 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQuestionRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewProductQuestionRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	questionID := uuid.New()
+	productID := uuid.New()
+	customerID := uuid.New()
+	userID := uuid.New()
+	columns := []string{"id", "product_id", "customer_id", "question", "status", "flags", "answer", "answered_by", "answerer_role", "answered_at", "moderated_by", "created_at", "updated_at"}
+
+	t.Run("CreateQuestion_Success", func(t *testing.T) {
+		// Arrange
+		question := &models.ProductQuestion{ID: questionID, ProductID: productID, CustomerID: customerID, Question: "Is it waterproof?", ContentHash: "abc123",
+			Status: models.QuestionStatusPending, Flags: []string{models.QuestionFlagBurst}}
+
+		mock.ExpectQuery("INSERT INTO product_questions").
+			WithArgs(questionID, productID, customerID, "Is it waterproof?", "abc123", models.QuestionStatusPending, pq.Array([]string{models.QuestionFlagBurst})).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+
+		// Act
+		err := repo.CreateQuestion(ctx, question)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, question.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListProductQuestions_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COUNT").
+			WithArgs(productID, models.QuestionStatusPublished).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+		mock.ExpectQuery("SELECT (.+) FROM product_questions q WHERE q.product_id = \\$1 AND q.status = \\$2 ORDER BY (.+) LIMIT \\$3 OFFSET \\$4").
+			WithArgs(productID, models.QuestionStatusPublished, 10, 10).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(questionID, productID, customerID, "Is it waterproof?", models.QuestionStatusPublished, "{burst}", "Yes.", userID, models.RoleAdmin, now, userID, now, now))
+
+		// Act
+		questions, total, err := repo.ListProductQuestions(ctx, productID, models.QuestionStatusPublished, 2, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 11, total)
+		require.Len(t, questions, 1)
+		assert.Equal(t, &userID, questions[0].AnsweredBy)
+		assert.Equal(t, models.RoleAdmin, questions[0].AnswererRole)
+		assert.Equal(t, []string{models.QuestionFlagBurst}, questions[0].Flags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ModerateQuestion_AlreadyInStatus", func(t *testing.T) {
+		// Arrange
+		question := &models.ProductQuestion{ID: questionID, Status: models.QuestionStatusPublished, ModeratedBy: &userID}
+
+		mock.ExpectQuery("UPDATE product_questions").
+			WithArgs(questionID, models.QuestionStatusPublished, &userID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		err := repo.ModerateQuestion(ctx, question)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AnswerQuestion_Success", func(t *testing.T) {
+		// Arrange
+		question := &models.ProductQuestion{ID: questionID, Answer: "Yes.", AnsweredBy: &userID, AnswererRole: models.RoleVendor, AnsweredAt: &now}
+
+		mock.ExpectQuery("UPDATE product_questions").
+			WithArgs(questionID, "Yes.", &userID, models.RoleVendor, &now).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(questionID, productID, customerID, "Is it waterproof?", models.QuestionStatusPublished, "{}", "Yes.", userID, models.RoleVendor, now, nil, now, now))
+
+		// Act
+		err := repo.AnswerQuestion(ctx, question)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, customerID, question.CustomerID)
+		assert.Equal(t, "Is it waterproof?", question.Question)
+		assert.Nil(t, question.ModeratedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListQuestions_FlaggedFirst", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COUNT").
+			WithArgs(models.QuestionStatusPending).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("ORDER BY cardinality\\(q.flags\\) = 0, q.created_at, q.id").
+			WithArgs(models.QuestionStatusPending, 20, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		// Act
+		questions, _, err := repo.ListQuestions(ctx, models.QuestionStatusPending, 1, 20)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, questions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CountDuplicateQuestions_Success", func(t *testing.T) {
+		// Arrange
+		question := &models.ProductQuestion{ProductID: productID, CustomerID: customerID, ContentHash: "abc123"}
+		since := now.Add(-time.Hour)
+
+		mock.ExpectQuery("SELECT (.+) FROM product_questions WHERE content_hash = \\$1").
+			WithArgs("abc123", customerID, productID, since).
+			WillReturnRows(sqlmock.NewRows([]string{"own", "others"}).AddRow(0, 2))
+
+		// Act
+		own, others, err := repo.CountDuplicateQuestions(ctx, question, since)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, own)
+		assert.Equal(t, 2, others)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CountRecentProductQuestions_Success", func(t *testing.T) {
+		// Arrange
+		since := now.Add(-10 * time.Minute)
+
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM product_questions WHERE product_id = \\$1").
+			WithArgs(productID, since).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+		// Act
+		count, err := repo.CountRecentProductQuestions(ctx, productID, since)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 7, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,19 +4,50 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type ProductRepository interface {
 	CreateProduct(ctx context.Context, product *models.Product) error
 	GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error)
+	GetProductBySKU(ctx context.Context, sku string) (*models.Product, error)
+	GetProductByBarcode(ctx context.Context, barcode string) (*models.Product, error)
 	UpdateProduct(ctx context.Context, product *models.Product) error
 	ListProducts(ctx context.Context, page, size int) ([]*models.Product, int, error)
+	GetVendorProduct(ctx context.Context, vendorID, id uuid.UUID) (*models.Product, error)
+	UpdateVendorProduct(ctx context.Context, vendorID uuid.UUID, product *models.Product) error
+	ListVendorProducts(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.Product, int, error)
+	GetProductFacets(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error)
+	SetRelatedProducts(ctx context.Context, productID uuid.UUID, relations []models.ProductRelation) error
+	ListRelatedProducts(ctx context.Context, productID uuid.UUID) ([]models.RelatedProduct, error)
+	SetProductImages(ctx context.Context, productID uuid.UUID, urls []string) error
+	ListProductImages(ctx context.Context, productID uuid.UUID) ([]string, error)
+	ListProductChanges(ctx context.Context, after int64, limit int) ([]models.ProductChange, error)
+	GetProductChangeCursor(ctx context.Context) (int64, error)
+	ListSnapshotCategories(ctx context.Context) ([]models.SnapshotCategory, error)
+	StreamSnapshotProducts(ctx context.Context, fn func(*models.SnapshotProduct) error) error
+	ListChangedSnapshotProducts(ctx context.Context, after, upTo int64, limit int) ([]models.SnapshotProduct, error)
+	PublishScheduledProducts(ctx context.Context, due time.Time, limit int) ([]uuid.UUID, error)
+	ListPricesBySKU(ctx context.Context, skus []string) ([]models.PriceChange, error)
+	ListPricesByFilter(ctx context.Context, filter *models.ProductFilter, limit int) ([]models.PriceChange, error)
+	UpdatePrices(ctx context.Context, changes []models.PriceChange, entries []*models.AuditEntry) error
+	ListQuickEditsBySKU(ctx context.Context, skus []string) ([]models.ProductQuickEditChange, error)
+	ApplyQuickEdits(ctx context.Context, changes []models.ProductQuickEditChange, movements []*models.StockMovement, entries []*models.AuditEntry) error
+	SetPurchaseLimit(ctx context.Context, product *models.Product) error
 }
 
+// productChangeSettleLag is how old a change must be before the feed hands it out. A write gets its
+// sequence number mid statement and commits at most a DB timeout after it started, so a change older
+// than two timeouts can no longer be overtaken by an uncommitted change with a lower sequence number.
+const productChangeSettleLag = 2 * utils.DefaultDBTimeout
+
 type productRepository struct {
 	DB *sql.DB
 }
@@ -29,15 +60,36 @@ func (r *productRepository) CreateProduct(ctx context.Context, product *models.P
 	dbCtx, cancel := utils.WithDBTimeout(ctx)
 	defer cancel()
 
-	query := `INSERT INTO products (category_id, name, description, price, stock_quantity, sku, status)
-			  VALUES ($1, $2, $3, $4, $5, $6, $7)
-			  RETURNING id, created_at, updated_at
-	`
+	// The initial stock is kept as the baseline of the stock movements ledger.
+	// Products without a barcode store NULL, so they don't collide on the unique barcode index.
+	query := withProductChange(`
+		INSERT INTO products (category_id, name, description, price, stock_quantity, initial_stock_quantity, sku, barcode, status, vendor_id, publish_at, version)
+		VALUES ($1, $2, $3, $4, $5, $5, $6, NULLIF($7, ''), $8, $9, $10, 1)
+		RETURNING id, status, version, created_at, updated_at`,
+		"id, created_at, updated_at")
 
-	return r.DB.QueryRowContext(dbCtx, query, product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.SKU, product.Status).Scan(&product.ID, &product.CreatedAt, &product.UpdatedAt)
+	return r.DB.QueryRowContext(dbCtx, query, product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.SKU, product.Barcode, product.Status, product.VendorID, product.PublishAt).Scan(&product.ID, &product.CreatedAt, &product.UpdatedAt)
 }
 
 func (r *productRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error) {
+	return r.getProduct(ctx, "p.id = $1", id)
+}
+
+func (r *productRepository) GetProductBySKU(ctx context.Context, sku string) (*models.Product, error) {
+	return r.getProduct(ctx, "p.sku = $1", sku)
+}
+
+func (r *productRepository) GetProductByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	return r.getProduct(ctx, "p.barcode = $1", barcode)
+}
+
+// GetVendorProduct only finds the product if the vendor owns it.
+func (r *productRepository) GetVendorProduct(ctx context.Context, vendorID, id uuid.UUID) (*models.Product, error) {
+	return r.getProduct(ctx, "p.id = $1 AND p.vendor_id = $2", id, vendorID)
+}
+
+// getProduct fetches the product matching the condition, which must hit an indexed column.
+func (r *productRepository) getProduct(ctx context.Context, condition string, args ...any) (*models.Product, error) {
 	dbCtx, cancel := utils.WithDBTimeout(ctx)
 	defer cancel()
 
@@ -45,15 +97,15 @@ func (r *productRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*
 
 	query := `
         SELECT p.id, p.category_id, p.name, p.description, p.price, 
-               p.stock_quantity, p.sku, p.status, p.created_at, p.updated_at,
+               p.stock_quantity, p.sku, COALESCE(p.barcode, ''), p.status, p.vendor_id, p.publish_at, p.max_per_order, p.max_per_customer, p.limit_period_days, p.created_at, p.updated_at,
                c.id, c.name, c.description
         FROM products p
         LEFT JOIN categories c ON p.category_id = c.id
-        WHERE p.id = $1`
+        WHERE ` + condition
 
 	var category models.Category
 
-	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&product.ID, &product.CategoryID, &product.Name, &product.Description, &product.Price, &product.StockQuantity, &product.SKU, &product.Status, &product.CreatedAt, &product.UpdatedAt, &category.ID, &category.Name, &category.Description)
+	err := r.DB.QueryRowContext(dbCtx, query, args...).Scan(&product.ID, &product.CategoryID, &product.Name, &product.Description, &product.Price, &product.StockQuantity, &product.SKU, &product.Barcode, &product.Status, &product.VendorID, &product.PublishAt, &product.MaxPerOrder, &product.MaxPerCustomer, &product.LimitPeriodDays, &product.CreatedAt, &product.UpdatedAt, &category.ID, &category.Name, &category.Description)
 	if err != nil {
 		return nil, fmt.Errorf("querying database: %w", err)
 	}
@@ -67,22 +119,89 @@ func (r *productRepository) UpdateProduct(ctx context.Context, product *models.P
 	dbCtx, cancel := utils.WithDBTimeout(ctx)
 	defer cancel()
 
+	query := withProductChange(`
+		UPDATE products SET category_id = $1, name = $2, description = $3, price = $4, stock_quantity = $5, barcode = NULLIF($6, ''), status = $7,
+		publish_at = $8, version = version + 1, updated_at = NOW()
+		WHERE id = $9
+		RETURNING id, status, version, updated_at`,
+		"updated_at")
+
+	return r.DB.QueryRowContext(dbCtx, query, product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.Barcode, product.Status, product.PublishAt, product.ID).Scan(&product.UpdatedAt)
+}
+
+// UpdateVendorProduct is UpdateProduct restricted to the products of the vendor, it returns sql.ErrNoRows otherwise.
+func (r *productRepository) UpdateVendorProduct(ctx context.Context, vendorID uuid.UUID, product *models.Product) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := withProductChange(`
+		UPDATE products SET category_id = $1, name = $2, description = $3, price = $4, stock_quantity = $5, barcode = NULLIF($6, ''), status = $7,
+		publish_at = $8, version = version + 1, updated_at = NOW()
+		WHERE id = $9 AND vendor_id = $10
+		RETURNING id, status, version, updated_at`,
+		"updated_at")
+
+	return r.DB.QueryRowContext(dbCtx, query, product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.Barcode, product.Status, product.PublishAt, product.ID, vendorID).Scan(&product.UpdatedAt)
+}
+
+// ListVendorProducts returns a page of the vendor's own products.
+func (r *productRepository) ListVendorProducts(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.Product, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM products WHERE vendor_id = $1`, vendorID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count vendor products: %w", err)
+	}
+
 	query := `
-		UPDATE products SET category_id = $1, name = $2, description = $3, price = $4, stock_quantity = $5, status = $6, updated_at = NOW()
-		WHERE id = $7
-		RETURNING updated_at
+		SELECT p.id, p.category_id, p.name, p.description, p.price,
+		p.stock_quantity, p.sku, COALESCE(p.barcode, ''), p.status, p.vendor_id, p.publish_at, p.max_per_order, p.max_per_customer, p.limit_period_days, p.created_at, p.updated_at,
+		c.id, c.name, c.description
+		FROM products p
+		LEFT JOIN categories c on p.category_id = c.id
+		WHERE p.vendor_id = $1
+		ORDER BY p.created_at DESC, p.id
+		LIMIT $2 OFFSET $3
 	`
 
-	return r.DB.QueryRowContext(dbCtx, query, product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.Status, product.ID).Scan(&product.UpdatedAt)
+	rows, err := r.DB.QueryContext(dbCtx, query, vendorID, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vendor products: %w", err)
+	}
+	defer rows.Close()
+
+	products := []*models.Product{}
+
+	for rows.Next() {
+		product := &models.Product{}
+		category := &models.Category{}
+
+		err := rows.Scan(&product.ID, &product.CategoryID, &product.Name, &product.Description, &product.Price, &product.StockQuantity, &product.SKU, &product.Barcode, &product.Status, &product.VendorID, &product.PublishAt, &product.MaxPerOrder, &product.MaxPerCustomer, &product.LimitPeriodDays, &product.CreatedAt, &product.UpdatedAt, &category.ID, &category.Name, &category.Description)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan vendor product: %w", err)
+		}
+
+		product.Category = category
+		products = append(products, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating vendor products: %w", err)
+	}
+
+	return products, total, nil
 }
 
+// ListProducts returns a page of the catalog, products that are not for sale are left out.
 func (r *productRepository) ListProducts(ctx context.Context, page, size int) ([]*models.Product, int, error) {
 	dbCtx, cancel := utils.WithDBTimeout(ctx)
 	defer cancel()
 
 	var total int
 
-	countQuery := `SELECT COUNT(*) FROM products`
+	countQuery := `SELECT COUNT(*) FROM products WHERE status = 'active'`
 
 	err := r.DB.QueryRowContext(dbCtx, countQuery).Scan(&total)
 	if err != nil {
@@ -94,10 +213,11 @@ func (r *productRepository) ListProducts(ctx context.Context, page, size int) ([
 
 	query := `
 		SELECT p.id, p.category_id, p.name, p.description, p.price, 
-		p.stock_quantity, p.sku, p.status, p.created_at, p.updated_at,
+		p.stock_quantity, p.sku, COALESCE(p.barcode, ''), p.status, p.vendor_id, p.publish_at, p.max_per_order, p.max_per_customer, p.limit_period_days, p.created_at, p.updated_at,
 		c.id, c.name, c.description
 		FROM products p
 		LEFT JOIN categories c on p.category_id = c.id
+		WHERE p.status = 'active'
 		ORDER BY p.id
 		LIMIT $1 OFFSET $2
 	`
@@ -115,7 +235,7 @@ func (r *productRepository) ListProducts(ctx context.Context, page, size int) ([
 		product := &models.Product{}
 		category := &models.Category{}
 
-		err := rows.Scan(&product.ID, &product.CategoryID, &product.Name, &product.Description, &product.Price, &product.StockQuantity, &product.SKU, &product.Status, &product.CreatedAt, &product.UpdatedAt, &category.ID, &category.Name, &category.Description)
+		err := rows.Scan(&product.ID, &product.CategoryID, &product.Name, &product.Description, &product.Price, &product.StockQuantity, &product.SKU, &product.Barcode, &product.Status, &product.VendorID, &product.PublishAt, &product.MaxPerOrder, &product.MaxPerCustomer, &product.LimitPeriodDays, &product.CreatedAt, &product.UpdatedAt, &category.ID, &category.Name, &category.Description)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -130,3 +250,726 @@ func (r *productRepository) ListProducts(ctx context.Context, page, size int) ([
 
 	return products, total, nil
 }
+
+// Upper bounds of the price buckets, products above the last bound share an open-ended bucket.
+var priceBucketBounds = []float64{25, 50, 100, 250, 500}
+
+const (
+	facetCategory = "category"
+	facetPrice    = "price"
+	facetStatus   = "status"
+)
+
+// productFilterClause builds the WHERE clause of the filter, leaving out the facet being counted.
+func productFilterClause(filter *models.ProductFilter, skip string) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.CategoryID != nil && skip != facetCategory {
+		add("p.category_id = $%d", *filter.CategoryID)
+	}
+
+	if filter.MinPrice != nil && skip != facetPrice {
+		add("p.price >= $%d", *filter.MinPrice)
+	}
+
+	if filter.MaxPrice != nil && skip != facetPrice {
+		add("p.price <= $%d", *filter.MaxPrice)
+	}
+
+	if filter.Status != "" && skip != facetStatus {
+		add("p.status = $%d", filter.Status)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *productRepository) GetProductFacets(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	facets := &models.ProductFacets{
+		Categories:   []models.CategoryFacet{},
+		PriceBuckets: []models.PriceBucketFacet{},
+		Statuses:     []models.StatusFacet{},
+	}
+
+	where, args := productFilterClause(filter, facetCategory)
+	query := `
+		SELECT p.category_id, COALESCE(c.name, ''), COUNT(*)
+		FROM products p
+		LEFT JOIN categories c ON p.category_id = c.id
+		` + where + `
+		GROUP BY p.category_id, c.name
+		ORDER BY COUNT(*) DESC, c.name
+	`
+
+	err := r.queryFacet(dbCtx, query, args, func(rows *sql.Rows) error {
+		var facet models.CategoryFacet
+		if err := rows.Scan(&facet.CategoryID, &facet.Name, &facet.Count); err != nil {
+			return err
+		}
+
+		facets.Categories = append(facets.Categories, facet)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products per category: %w", err)
+	}
+
+	bounds := make([]string, len(priceBucketBounds))
+	for i, bound := range priceBucketBounds {
+		bounds[i] = strconv.FormatFloat(bound, 'f', -1, 64)
+	}
+
+	// width_bucket returns 0 below the first bound and len(bounds) above the last one
+	where, args = productFilterClause(filter, facetPrice)
+	query = `
+		SELECT width_bucket(p.price::float8, ARRAY[` + strings.Join(bounds, ", ") + `]::float8[]) AS bucket, COUNT(*)
+		FROM products p
+		` + where + `
+		GROUP BY bucket
+		ORDER BY bucket
+	`
+
+	err = r.queryFacet(dbCtx, query, args, func(rows *sql.Rows) error {
+		var bucket, count int
+		if err := rows.Scan(&bucket, &count); err != nil {
+			return err
+		}
+
+		facet := models.PriceBucketFacet{Count: count}
+
+		if bucket > 0 {
+			facet.Min = priceBucketBounds[bucket-1]
+		}
+
+		if bucket < len(priceBucketBounds) {
+			facet.Max = &priceBucketBounds[bucket]
+		}
+
+		facets.PriceBuckets = append(facets.PriceBuckets, facet)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products per price bucket: %w", err)
+	}
+
+	where, args = productFilterClause(filter, facetStatus)
+	query = `
+		SELECT p.status, COUNT(*)
+		FROM products p
+		` + where + `
+		GROUP BY p.status
+		ORDER BY p.status
+	`
+
+	err = r.queryFacet(dbCtx, query, args, func(rows *sql.Rows) error {
+		var facet models.StatusFacet
+		if err := rows.Scan(&facet.Status, &facet.Count); err != nil {
+			return err
+		}
+
+		facets.Statuses = append(facets.Statuses, facet)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products per status: %w", err)
+	}
+
+	return facets, nil
+}
+
+// SetRelatedProducts replaces all the curated relations of the product in a single transaction.
+func (r *productRepository) SetRelatedProducts(ctx context.Context, productID uuid.UUID, relations []models.ProductRelation) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	if _, err := tx.ExecContext(dbCtx, `DELETE FROM product_relations WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to delete product relations: %w", err)
+	}
+
+	query := `INSERT INTO product_relations (product_id, related_product_id, relation_type, position)
+			  VALUES ($1, $2, $3, $4)
+	`
+
+	for _, relation := range relations {
+		if _, err := tx.ExecContext(dbCtx, query, productID, relation.RelatedProductID, relation.Type, relation.Position); err != nil {
+			return fmt.Errorf("failed to insert product relation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product relations: %w", err)
+	}
+
+	return nil
+}
+
+func (r *productRepository) ListRelatedProducts(ctx context.Context, productID uuid.UUID) ([]models.RelatedProduct, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT p.id, p.name, p.price, p.sku, pr.relation_type, pr.position
+		FROM product_relations pr
+		JOIN products p ON p.id = pr.related_product_id
+		WHERE pr.product_id = $1
+		ORDER BY pr.relation_type, pr.position
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query related products: %w", err)
+	}
+	defer rows.Close()
+
+	var related []models.RelatedProduct
+
+	for rows.Next() {
+		var product models.RelatedProduct
+		if err := rows.Scan(&product.ProductID, &product.Name, &product.Price, &product.SKU, &product.Type, &product.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan related product: %w", err)
+		}
+
+		related = append(related, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate related products: %w", err)
+	}
+
+	return related, nil
+}
+
+// SetProductImages replaces the images of the product in a single transaction, the first one is the main image.
+func (r *productRepository) SetProductImages(ctx context.Context, productID uuid.UUID, urls []string) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	if _, err := tx.ExecContext(dbCtx, `DELETE FROM product_images WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to delete product images: %w", err)
+	}
+
+	query := `INSERT INTO product_images (product_id, url, position)
+			  VALUES ($1, $2, $3)
+	`
+
+	for position, url := range urls {
+		if _, err := tx.ExecContext(dbCtx, query, productID, url, position); err != nil {
+			return fmt.Errorf("failed to insert product image: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product images: %w", err)
+	}
+
+	return nil
+}
+
+func (r *productRepository) ListProductImages(ctx context.Context, productID uuid.UUID) ([]string, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.DB.QueryContext(dbCtx, `SELECT url FROM product_images WHERE product_id = $1 ORDER BY position`, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query product images: %w", err)
+	}
+	defer rows.Close()
+
+	var urls []string
+
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("failed to scan product image: %w", err)
+		}
+
+		urls = append(urls, url)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate product images: %w", err)
+	}
+
+	return urls, nil
+}
+
+// ListProductChanges returns the settled changes following the after sequence number, in the order they
+// were made. It stops at the first change that is not settled yet, so no change is ever skipped.
+func (r *productRepository) ListProductChanges(ctx context.Context, after int64, limit int) ([]models.ProductChange, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT seq, product_id, operation, version, changed_at
+		FROM product_changes
+		WHERE seq > $1 AND seq < ALL (
+			SELECT seq FROM product_changes WHERE seq > $1 AND changed_at >= NOW() - make_interval(secs => $2)
+		)
+		ORDER BY seq
+		LIMIT $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, after, productChangeSettleLag.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query product changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []models.ProductChange{}
+
+	for rows.Next() {
+		var (
+			change models.ProductChange
+			seq    int64
+		)
+
+		if err := rows.Scan(&seq, &change.ProductID, &change.Operation, &change.Version, &change.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan product change: %w", err)
+		}
+
+		change.Cursor = strconv.FormatInt(seq, 10)
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate product changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// GetProductChangeCursor returns the sequence number of the last settled change, the one the change
+// feed hands out last. It is 0 while the feed is empty.
+func (r *productRepository) GetProductChangeCursor(ctx context.Context) (int64, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COALESCE(MAX(seq), 0)
+		FROM product_changes
+		WHERE seq < ALL (
+			SELECT seq FROM product_changes WHERE changed_at >= NOW() - make_interval(secs => $1)
+		)
+	`
+
+	var cursor int64
+
+	if err := r.DB.QueryRowContext(dbCtx, query, productChangeSettleLag.Seconds()).Scan(&cursor); err != nil {
+		return 0, fmt.Errorf("failed to get product change cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+func (r *productRepository) ListSnapshotCategories(ctx context.Context) ([]models.SnapshotCategory, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.DB.QueryContext(dbCtx, `SELECT id, name FROM categories ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := []models.SnapshotCategory{}
+
+	for rows.Next() {
+		var category models.SnapshotCategory
+		if err := rows.Scan(&category.ID, &category.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// StreamSnapshotProducts calls fn for every product for sale, by ID, without loading them all in memory.
+// The same SnapshotProduct is reused between calls, fn must copy whatever it keeps. Like the exports the
+// query is bounded by ctx only, not by the default DB timeout.
+func (r *productRepository) StreamSnapshotProducts(ctx context.Context, fn func(*models.SnapshotProduct) error) error {
+	query := `
+		SELECT id, category_id, name, sku, price, vendor_id, version
+		FROM products
+		WHERE status = 'active'
+		ORDER BY id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to stream the snapshot products: %w", err)
+	}
+	defer rows.Close()
+
+	product := &models.SnapshotProduct{}
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		*product = models.SnapshotProduct{Active: true}
+
+		if err := rows.Scan(&product.ID, &product.CategoryID, &product.Name, &product.SKU, &product.Price, &product.VendorID, &product.Version); err != nil {
+			return fmt.Errorf("failed to scan the snapshot products: %w", err)
+		}
+
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating snapshot products: %w", err)
+	}
+
+	return ctx.Err()
+}
+
+// ListChangedSnapshotProducts returns the products changed after the after sequence number up to upTo,
+// at most limit, as they are now. The ones no longer for sale are returned with Active unset.
+func (r *productRepository) ListChangedSnapshotProducts(ctx context.Context, after, upTo int64, limit int) ([]models.SnapshotProduct, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, category_id, name, sku, price, vendor_id, version, status = 'active'
+		FROM products
+		WHERE id IN (SELECT product_id FROM product_changes WHERE seq > $1 AND seq <= $2)
+		ORDER BY id
+		LIMIT $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, after, upTo, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changed products: %w", err)
+	}
+	defer rows.Close()
+
+	products := []models.SnapshotProduct{}
+
+	for rows.Next() {
+		var product models.SnapshotProduct
+		if err := rows.Scan(&product.ID, &product.CategoryID, &product.Name, &product.SKU, &product.Price, &product.VendorID, &product.Version, &product.Active); err != nil {
+			return nil, fmt.Errorf("failed to scan changed product: %w", err)
+		}
+
+		products = append(products, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating changed products: %w", err)
+	}
+
+	return products, nil
+}
+
+// ListPricesBySKU returns the current price of the platform products among the SKUs, as the OldPrice of
+// a change. SKUs of vendor products or of no product are left out.
+func (r *productRepository) ListPricesBySKU(ctx context.Context, skus []string) ([]models.PriceChange, error) {
+	return r.listPrices(ctx, `WHERE p.sku = ANY($1) AND p.vendor_id IS NULL`, []any{pq.Array(skus)}, len(skus))
+}
+
+// ListPricesByFilter is ListPricesBySKU for the platform products matching the filter, up to limit of them.
+func (r *productRepository) ListPricesByFilter(ctx context.Context, filter *models.ProductFilter, limit int) ([]models.PriceChange, error) {
+	where, args := productFilterClause(filter, "")
+	if where == "" {
+		where = "WHERE p.vendor_id IS NULL"
+	} else {
+		where += " AND p.vendor_id IS NULL"
+	}
+
+	return r.listPrices(ctx, where, args, limit)
+}
+
+func (r *productRepository) listPrices(ctx context.Context, where string, args []any, limit int) ([]models.PriceChange, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := fmt.Sprintf(`SELECT p.id, p.sku, p.price FROM products p %s ORDER BY p.sku LIMIT $%d`, where, len(args)+1)
+
+	rows, err := r.DB.QueryContext(dbCtx, query, append(args, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query product prices: %w", err)
+	}
+	defer rows.Close()
+
+	prices := []models.PriceChange{}
+
+	for rows.Next() {
+		var price models.PriceChange
+
+		if err := rows.Scan(&price.ProductID, &price.SKU, &price.OldPrice); err != nil {
+			return nil, fmt.Errorf("failed to scan product price: %w", err)
+		}
+
+		prices = append(prices, price)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate product prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+// UpdatePrices applies the changes and records the audit entries in a single transaction. A product whose
+// price is no longer the OldPrice of its change was repriced concurrently, the whole batch is then rolled
+// back with sql.ErrNoRows.
+func (r *productRepository) UpdatePrices(ctx context.Context, changes []models.PriceChange, entries []*models.AuditEntry) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := withProductChange(`
+		UPDATE products SET price = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND price = $3
+		RETURNING id, status, version, updated_at`,
+		"id")
+
+	for _, change := range changes {
+		var id uuid.UUID
+
+		if err := tx.QueryRowContext(dbCtx, query, change.NewPrice, change.ProductID, change.OldPrice).Scan(&id); err != nil {
+			return fmt.Errorf("failed to update price of product %s: %w", change.ProductID, err)
+		}
+	}
+
+	auditQuery := `
+		INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	`
+
+	for _, entry := range entries {
+		if _, err := tx.ExecContext(dbCtx, auditQuery, entry.ID, entry.UserID, entry.ActorID, entry.Action, entry.ReferenceID, []byte(entry.Details)); err != nil {
+			return fmt.Errorf("failed to record audit entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit price changes: %w", err)
+	}
+
+	return nil
+}
+
+// ListQuickEditsBySKU returns the current price and stock of the platform products among the SKUs, as the
+// old and new values of a change. SKUs of vendor products or of no product are left out.
+func (r *productRepository) ListQuickEditsBySKU(ctx context.Context, skus []string) ([]models.ProductQuickEditChange, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT id, sku, price, stock_quantity FROM products WHERE sku = ANY($1) AND vendor_id IS NULL ORDER BY sku`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, pq.Array(skus))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query products to edit: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []models.ProductQuickEditChange{}
+
+	for rows.Next() {
+		var change models.ProductQuickEditChange
+
+		if err := rows.Scan(&change.ProductID, &change.SKU, &change.OldPrice, &change.OldStock); err != nil {
+			return nil, fmt.Errorf("failed to scan product to edit: %w", err)
+		}
+
+		change.NewPrice, change.NewStock = change.OldPrice, change.OldStock
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate products to edit: %w", err)
+	}
+
+	return changes, nil
+}
+
+// ApplyQuickEdits applies the changes, records the stock movements and the audit entries in a single
+// transaction. A product whose price or stock is no longer the old one of its change was changed
+// concurrently, e.g. by an order, the whole batch is then rolled back with sql.ErrNoRows.
+func (r *productRepository) ApplyQuickEdits(ctx context.Context, changes []models.ProductQuickEditChange, movements []*models.StockMovement, entries []*models.AuditEntry) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := withProductChange(`
+		UPDATE products SET price = $1, stock_quantity = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND price = $4 AND stock_quantity = $5
+		RETURNING id, status, version, updated_at`,
+		"id")
+
+	for _, change := range changes {
+		var id uuid.UUID
+
+		if err := tx.QueryRowContext(dbCtx, query, change.NewPrice, change.NewStock, change.ProductID, change.OldPrice, change.OldStock).Scan(&id); err != nil {
+			return fmt.Errorf("failed to edit product %s: %w", change.ProductID, err)
+		}
+	}
+
+	movementQuery := `
+		INSERT INTO stock_movements (id, product_id, quantity, reason, reference_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`
+
+	for _, movement := range movements {
+		if _, err := tx.ExecContext(dbCtx, movementQuery, movement.ID, movement.ProductID, movement.Quantity, movement.Reason, movement.ReferenceID); err != nil {
+			return fmt.Errorf("failed to insert stock movement: %w", err)
+		}
+	}
+
+	auditQuery := `
+		INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	`
+
+	for _, entry := range entries {
+		if _, err := tx.ExecContext(dbCtx, auditQuery, entry.ID, entry.UserID, entry.ActorID, entry.Action, entry.ReferenceID, []byte(entry.Details)); err != nil {
+			return fmt.Errorf("failed to record audit entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit quick edits: %w", err)
+	}
+
+	return nil
+}
+
+// PublishScheduledProducts makes up to limit of the scheduled products due by then active, and returns them.
+func (r *productRepository) PublishScheduledProducts(ctx context.Context, due time.Time, limit int) ([]uuid.UUID, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := withProductChange(`
+		UPDATE products SET status = 'active', publish_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM products WHERE status = 'scheduled' AND publish_at <= $1
+			ORDER BY publish_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, status, version, updated_at`,
+		"id")
+
+	rows, err := r.DB.QueryContext(dbCtx, query, due, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish scheduled products: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []uuid.UUID{}
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan published product: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate published products: %w", err)
+	}
+
+	return ids, nil
+}
+
+// withProductChange records a product write in the change feed within the same statement. The write
+// returns the id, status, version and updated_at of the product, the statement returns the columns
+// asked for. Writes taking a product off sale, or keeping it off sale, are recorded as deletes so that
+// search drops it.
+func withProductChange(write, columns string) string {
+	return `
+		WITH p AS (` + write + `
+		), change AS (
+			INSERT INTO product_changes (product_id, operation, version, changed_at)
+			SELECT id, CASE WHEN status <> 'active' THEN 'delete' WHEN version = 1 THEN 'create' ELSE 'update' END, version, updated_at
+			FROM p
+		)
+		SELECT ` + columns + ` FROM p
+	`
+}
+
+func (r *productRepository) queryFacet(ctx context.Context, query string, args []any, scan func(rows *sql.Rows) error) error {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// SetPurchaseLimit replaces the purchase limits of the product, it returns sql.ErrNoRows for an unknown product.
+func (r *productRepository) SetPurchaseLimit(ctx context.Context, product *models.Product) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE products SET max_per_order = $1, max_per_customer = $2, limit_period_days = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, product.MaxPerOrder, product.MaxPerCustomer, product.LimitPeriodDays, product.ID).Scan(&product.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set purchase limit: %w", err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,10 +48,10 @@ func TestProductRepository(t *testing.T) {
 			now := time.Now()
 			newID := uuid.New()
 
-			expectedSQL := regexp.QuoteMeta(`INSERT INTO products (category_id, name, description, price, stock_quantity, sku, status) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`)
+			expectedSQL := regexp.QuoteMeta(`INSERT INTO products (category_id, name, description, price, stock_quantity, initial_stock_quantity, sku, barcode, status, vendor_id, publish_at, version) VALUES ($1, $2, $3, $4, $5, $5, $6, NULLIF($7, ''), $8, $9, $10, 1) RETURNING id, status, version, created_at, updated_at`) + `.*` + regexp.QuoteMeta(`INSERT INTO product_changes`)
 
 			mock.ExpectQuery(expectedSQL).
-				WithArgs(product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.SKU, product.Status).
+				WithArgs(product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.SKU, product.Barcode, product.Status, product.VendorID, product.PublishAt).
 				WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
 					AddRow(newID, now, now))
 
@@ -78,10 +79,10 @@ func TestProductRepository(t *testing.T) {
 			}
 			dbError := errors.New("database insertion error")
 
-			expectedSQL := regexp.QuoteMeta(`INSERT INTO products (category_id, name, description, price, stock_quantity, sku, status) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`)
+			expectedSQL := regexp.QuoteMeta(`INSERT INTO products (category_id, name, description, price, stock_quantity, initial_stock_quantity, sku, barcode, status, vendor_id, publish_at, version) VALUES ($1, $2, $3, $4, $5, $5, $6, NULLIF($7, ''), $8, $9, $10, 1) RETURNING id, status, version, created_at, updated_at`) + `.*` + regexp.QuoteMeta(`INSERT INTO product_changes`)
 
 			mock.ExpectQuery(expectedSQL).
-				WithArgs(product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.SKU, product.Status).
+				WithArgs(product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.SKU, product.Barcode, product.Status, product.VendorID, product.PublishAt).
 				WillReturnError(dbError)
 
 			// Act
@@ -97,11 +98,12 @@ func TestProductRepository(t *testing.T) {
 	t.Run("GetProductByID", func(t *testing.T) {
 		productID := uuid.New()
 		categoryID := uuid.New()
+		vendorID := uuid.New()
 		now := time.Now()
 
 		expectedSQL := regexp.QuoteMeta(`
         SELECT p.id, p.category_id, p.name, p.description, p.price,
-               p.stock_quantity, p.sku, p.status, p.created_at, p.updated_at,
+               p.stock_quantity, p.sku, COALESCE(p.barcode, ''), p.status, p.vendor_id, p.publish_at, p.max_per_order, p.max_per_customer, p.limit_period_days, p.created_at, p.updated_at,
                c.id, c.name, c.description
         FROM products p
         LEFT JOIN categories c ON p.category_id = c.id
@@ -109,6 +111,7 @@ func TestProductRepository(t *testing.T) {
 
 		t.Run("Success", func(t *testing.T) {
 			// Arrange
+			maxPerOrder := 2
 			expectedProduct := &models.Product{
 				ID:            productID,
 				CategoryID:    categoryID,
@@ -117,7 +120,10 @@ func TestProductRepository(t *testing.T) {
 				Price:         50.00,
 				StockQuantity: 20,
 				SKU:           "FOUNDSKU",
+				Barcode:       "4006381333931",
 				Status:        "active",
+				VendorID:      &vendorID,
+				MaxPerOrder:   &maxPerOrder,
 				CreatedAt:     now.Add(-time.Hour),
 				UpdatedAt:     now,
 				Category: &models.Category{
@@ -129,11 +135,11 @@ func TestProductRepository(t *testing.T) {
 
 			rows := sqlmock.NewRows([]string{
 				"p.id", "p.category_id", "p.name", "p.description", "p.price",
-				"p.stock_quantity", "p.sku", "p.status", "p.created_at", "p.updated_at",
+				"p.stock_quantity", "p.sku", "p.barcode", "p.status", "p.vendor_id", "p.publish_at", "p.max_per_order", "p.max_per_customer", "p.limit_period_days", "p.created_at", "p.updated_at",
 				"c.id", "c.name", "c.description",
 			}).AddRow(
 				expectedProduct.ID, expectedProduct.CategoryID, expectedProduct.Name, expectedProduct.Description, expectedProduct.Price,
-				expectedProduct.StockQuantity, expectedProduct.SKU, expectedProduct.Status, expectedProduct.CreatedAt, expectedProduct.UpdatedAt,
+				expectedProduct.StockQuantity, expectedProduct.SKU, expectedProduct.Barcode, expectedProduct.Status, *expectedProduct.VendorID, nil, *expectedProduct.MaxPerOrder, nil, nil, expectedProduct.CreatedAt, expectedProduct.UpdatedAt,
 				expectedProduct.Category.ID, expectedProduct.Category.Name, expectedProduct.Category.Description,
 			)
 
@@ -186,15 +192,122 @@ func TestProductRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("GetProductByBarcode", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+			categoryID := uuid.New()
+			now := time.Now()
+
+			rows := sqlmock.NewRows([]string{
+				"p.id", "p.category_id", "p.name", "p.description", "p.price",
+				"p.stock_quantity", "p.sku", "p.barcode", "p.status", "p.vendor_id", "p.publish_at", "p.max_per_order", "p.max_per_customer", "p.limit_period_days", "p.created_at", "p.updated_at",
+				"c.id", "c.name", "c.description",
+			}).AddRow(productID, categoryID, "Scanned Product", "", 5.0, 3, "SCANSKU", "4006381333931", "active", nil, nil, nil, nil, nil, now, now, categoryID, "Category", "")
+
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE p.barcode = $1`)).
+				WithArgs("4006381333931").
+				WillReturnRows(rows)
+
+			// Act
+			product, err := repo.GetProductByBarcode(ctx, "4006381333931")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, productID, product.ID)
+			assert.Equal(t, "4006381333931", product.Barcode)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("NotFound", func(t *testing.T) {
+			// Arrange
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE p.barcode = $1`)).
+				WithArgs("0000000000000").
+				WillReturnError(sql.ErrNoRows)
+
+			// Act
+			product, err := repo.GetProductByBarcode(ctx, "0000000000000")
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			assert.Nil(t, product)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("VendorProducts", func(t *testing.T) {
+		vendorID := uuid.New()
+		productID := uuid.New()
+
+		t.Run("GetVendorProduct_NotOwned", func(t *testing.T) {
+			// Arrange
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE p.id = $1 AND p.vendor_id = $2`)).
+				WithArgs(productID, vendorID).
+				WillReturnError(sql.ErrNoRows)
+
+			// Act
+			product, err := repo.GetVendorProduct(ctx, vendorID, productID)
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			assert.Nil(t, product)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("UpdateVendorProduct_NotOwned", func(t *testing.T) {
+			// Arrange
+			product := &models.Product{ID: productID, CategoryID: uuid.New(), Name: "Mug", Price: 12.5, StockQuantity: 4, Status: "active"}
+
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE id = $9 AND vendor_id = $10`)).
+				WithArgs(product.CategoryID, product.Name, product.Description, product.Price, product.StockQuantity, product.Barcode, product.Status, product.PublishAt, productID, vendorID).
+				WillReturnError(sql.ErrNoRows)
+
+			// Act
+			err := repo.UpdateVendorProduct(ctx, vendorID, product)
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("ListVendorProducts_Success", func(t *testing.T) {
+			// Arrange
+			categoryID := uuid.New()
+			now := time.Now()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM products WHERE vendor_id = $1`)).
+				WithArgs(vendorID).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE p.vendor_id = $1`)).
+				WithArgs(vendorID, 10, 0).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"p.id", "p.category_id", "p.name", "p.description", "p.price",
+					"p.stock_quantity", "p.sku", "p.barcode", "p.status", "p.vendor_id", "p.publish_at", "p.max_per_order", "p.max_per_customer", "p.limit_period_days", "p.created_at", "p.updated_at",
+					"c.id", "c.name", "c.description",
+				}).AddRow(productID, categoryID, "Mug", "", 12.5, 4, "MUG-1", "", "inactive", vendorID, nil, nil, nil, nil, now, now, categoryID, "Kitchen", ""))
+
+			// Act
+			products, total, err := repo.ListVendorProducts(ctx, vendorID, 1, 10)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, 1, total)
+			require.Len(t, products, 1)
+			assert.Equal(t, &vendorID, products[0].VendorID)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
 	t.Run("UpdateProduct", func(t *testing.T) {
 		productID := uuid.New()
 		categoryID := uuid.New()
 		now := time.Now()
 
 		expectedSQL := regexp.QuoteMeta(`
-        UPDATE products SET category_id = $1, name = $2, description = $3, price = $4, stock_quantity = $5, status = $6, updated_at = NOW()
-        WHERE id = $7
-        RETURNING updated_at`)
+        UPDATE products SET category_id = $1, name = $2, description = $3, price = $4, stock_quantity = $5, barcode = NULLIF($6, ''), status = $7,
+        publish_at = $8, version = version + 1, updated_at = NOW()
+        WHERE id = $9
+        RETURNING id, status, version, updated_at`) + `.*` + regexp.QuoteMeta(`INSERT INTO product_changes`)
 
 		t.Run("Success", func(t *testing.T) {
 			// Arrange
@@ -210,7 +323,7 @@ func TestProductRepository(t *testing.T) {
 			updatedAt := now
 
 			mock.ExpectQuery(expectedSQL).
-				WithArgs(productToUpdate.CategoryID, productToUpdate.Name, productToUpdate.Description, productToUpdate.Price, productToUpdate.StockQuantity, productToUpdate.Status, productToUpdate.ID).
+				WithArgs(productToUpdate.CategoryID, productToUpdate.Name, productToUpdate.Description, productToUpdate.Price, productToUpdate.StockQuantity, productToUpdate.Barcode, productToUpdate.Status, productToUpdate.PublishAt, productToUpdate.ID).
 				WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
 
 			// Act
@@ -236,7 +349,7 @@ func TestProductRepository(t *testing.T) {
 			dbError := errors.New("database update error")
 
 			mock.ExpectQuery(expectedSQL).
-				WithArgs(productToUpdate.CategoryID, productToUpdate.Name, productToUpdate.Description, productToUpdate.Price, productToUpdate.StockQuantity, productToUpdate.Status, productToUpdate.ID).
+				WithArgs(productToUpdate.CategoryID, productToUpdate.Name, productToUpdate.Description, productToUpdate.Price, productToUpdate.StockQuantity, productToUpdate.Barcode, productToUpdate.Status, productToUpdate.PublishAt, productToUpdate.ID).
 				WillReturnError(dbError)
 
 			// Act
@@ -261,7 +374,7 @@ func TestProductRepository(t *testing.T) {
 			}
 
 			mock.ExpectQuery(expectedSQL).
-				WithArgs(productToUpdate.CategoryID, productToUpdate.Name, productToUpdate.Description, productToUpdate.Price, productToUpdate.StockQuantity, productToUpdate.Status, productToUpdate.ID).
+				WithArgs(productToUpdate.CategoryID, productToUpdate.Name, productToUpdate.Description, productToUpdate.Price, productToUpdate.StockQuantity, productToUpdate.Barcode, productToUpdate.Status, productToUpdate.PublishAt, productToUpdate.ID).
 				WillReturnError(sql.ErrNoRows) // Simulate row not found during update
 
 			// Act
@@ -279,19 +392,20 @@ func TestProductRepository(t *testing.T) {
 		offset := (page - 1) * size
 		now := time.Now()
 
-		expectedCountSQL := regexp.QuoteMeta(`SELECT COUNT(*) FROM products`)
+		expectedCountSQL := regexp.QuoteMeta(`SELECT COUNT(*) FROM products WHERE status = 'active'`)
 		expectedListSQL := regexp.QuoteMeta(`
         SELECT p.id, p.category_id, p.name, p.description, p.price,
-        p.stock_quantity, p.sku, p.status, p.created_at, p.updated_at,
+        p.stock_quantity, p.sku, COALESCE(p.barcode, ''), p.status, p.vendor_id, p.publish_at, p.max_per_order, p.max_per_customer, p.limit_period_days, p.created_at, p.updated_at,
         c.id, c.name, c.description
         FROM products p
         LEFT JOIN categories c on p.category_id = c.id
+        WHERE p.status = 'active'
         ORDER BY p.id
         LIMIT $1 OFFSET $2`)
 
 		productCols := []string{
 			"p.id", "p.category_id", "p.name", "p.description", "p.price",
-			"p.stock_quantity", "p.sku", "p.status", "p.created_at", "p.updated_at",
+			"p.stock_quantity", "p.sku", "p.barcode", "p.status", "p.vendor_id", "p.publish_at", "p.max_per_order", "p.max_per_customer", "p.limit_period_days", "p.created_at", "p.updated_at",
 			"c.id", "c.name", "c.description",
 		}
 
@@ -314,8 +428,8 @@ func TestProductRepository(t *testing.T) {
 
 			mock.ExpectQuery(expectedCountSQL).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(total))
 			rows := sqlmock.NewRows(productCols).
-				AddRow(expectedProducts[0].ID, expectedProducts[0].CategoryID, expectedProducts[0].Name, expectedProducts[0].Description, expectedProducts[0].Price, expectedProducts[0].StockQuantity, expectedProducts[0].SKU, expectedProducts[0].Status, expectedProducts[0].CreatedAt, expectedProducts[0].UpdatedAt, expectedProducts[0].Category.ID, expectedProducts[0].Category.Name, expectedProducts[0].Category.Description).
-				AddRow(expectedProducts[1].ID, expectedProducts[1].CategoryID, expectedProducts[1].Name, expectedProducts[1].Description, expectedProducts[1].Price, expectedProducts[1].StockQuantity, expectedProducts[1].SKU, expectedProducts[1].Status, expectedProducts[1].CreatedAt, expectedProducts[1].UpdatedAt, expectedProducts[1].Category.ID, expectedProducts[1].Category.Name, expectedProducts[1].Category.Description)
+				AddRow(expectedProducts[0].ID, expectedProducts[0].CategoryID, expectedProducts[0].Name, expectedProducts[0].Description, expectedProducts[0].Price, expectedProducts[0].StockQuantity, expectedProducts[0].SKU, expectedProducts[0].Barcode, expectedProducts[0].Status, nil, nil, nil, nil, nil, expectedProducts[0].CreatedAt, expectedProducts[0].UpdatedAt, expectedProducts[0].Category.ID, expectedProducts[0].Category.Name, expectedProducts[0].Category.Description).
+				AddRow(expectedProducts[1].ID, expectedProducts[1].CategoryID, expectedProducts[1].Name, expectedProducts[1].Description, expectedProducts[1].Price, expectedProducts[1].StockQuantity, expectedProducts[1].SKU, expectedProducts[1].Barcode, expectedProducts[1].Status, nil, nil, nil, nil, nil, expectedProducts[1].CreatedAt, expectedProducts[1].UpdatedAt, expectedProducts[1].Category.ID, expectedProducts[1].Category.Name, expectedProducts[1].Category.Description)
 			mock.ExpectQuery(expectedListSQL).WithArgs(size, offset).WillReturnRows(rows)
 
 			// Act
@@ -387,7 +501,7 @@ func TestProductRepository(t *testing.T) {
 
 			mock.ExpectQuery(expectedCountSQL).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(total))
 			// Return rows with incorrect column types to trigger a scan error
-			rows := sqlmock.NewRows(productCols).AddRow("invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid").RowError(0, scanError)
+			rows := sqlmock.NewRows(productCols).AddRow("invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid", "invalid").RowError(0, scanError)
 			mock.ExpectQuery(expectedListSQL).WithArgs(size, offset).WillReturnRows(rows)
 
 			// Act
@@ -408,7 +522,7 @@ func TestProductRepository(t *testing.T) {
 
 			mock.ExpectQuery(expectedCountSQL).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(total))
 			rows := sqlmock.NewRows(productCols).
-				AddRow(uuid.New(), uuid.New(), "Prod 1", "", 10.0, 1, "SKU1", "active", time.Now(), time.Now(), uuid.New(), "Cat 1", "").
+				AddRow(uuid.New(), uuid.New(), "Prod 1", "", 10.0, 1, "SKU1", "", "active", nil, nil, nil, nil, nil, time.Now(), time.Now(), uuid.New(), "Cat 1", "").
 				CloseError(rowsError) // Simulate error during rows.Err() check after loop
 			mock.ExpectQuery(expectedListSQL).WithArgs(size, offset).WillReturnRows(rows)
 
@@ -423,4 +537,511 @@ func TestProductRepository(t *testing.T) {
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	})
+	t.Run("GetProductFacets", func(t *testing.T) {
+		t.Run("Success - Each facet ignores its own filter", func(t *testing.T) {
+			// Arrange
+			categoryID := uuid.New()
+			minPrice := 20.0
+			filter := &models.ProductFilter{CategoryID: &categoryID, MinPrice: &minPrice, Status: "active"}
+
+			mock.ExpectQuery(`FROM products p\s+LEFT JOIN categories c ON p.category_id = c.id\s+WHERE p.price >= \$1 AND p.status = \$2\s+GROUP BY p.category_id`).
+				WithArgs(minPrice, "active").
+				WillReturnRows(sqlmock.NewRows([]string{"category_id", "name", "count"}).AddRow(categoryID, "Books", 4))
+			mock.ExpectQuery(regexp.QuoteMeta(`width_bucket(p.price::float8, ARRAY[25, 50, 100, 250, 500]::float8[])`)+`.*WHERE p.category_id = \$1 AND p.status = \$2`).
+				WithArgs(categoryID, "active").
+				WillReturnRows(sqlmock.NewRows([]string{"bucket", "count"}).AddRow(0, 1).AddRow(2, 2).AddRow(5, 1))
+			mock.ExpectQuery(`GROUP BY p.status`).
+				WithArgs(categoryID, minPrice).
+				WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("active", 3).AddRow("inactive", 1))
+
+			// Act
+			facets, err := repo.GetProductFacets(ctx, filter)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []models.CategoryFacet{{CategoryID: categoryID, Name: "Books", Count: 4}}, facets.Categories)
+			require.Len(t, facets.PriceBuckets, 3)
+			assert.Zero(t, facets.PriceBuckets[0].Min)
+			assert.Equal(t, 25.0, *facets.PriceBuckets[0].Max)
+			assert.Equal(t, 50.0, facets.PriceBuckets[1].Min)
+			assert.Equal(t, 100.0, *facets.PriceBuckets[1].Max)
+			assert.Equal(t, 500.0, facets.PriceBuckets[2].Min)
+			assert.Nil(t, facets.PriceBuckets[2].Max)
+			assert.Len(t, facets.Statuses, 2)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("QueryError", func(t *testing.T) {
+			// Arrange
+			dbError := errors.New("db error")
+			mock.ExpectQuery(`GROUP BY p.category_id`).WillReturnError(dbError)
+
+			// Act
+			facets, err := repo.GetProductFacets(ctx, &models.ProductFilter{})
+
+			// Assert
+			require.ErrorIs(t, err, dbError)
+			assert.Nil(t, facets)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("SetRelatedProducts", func(t *testing.T) {
+		productID := uuid.New()
+		relations := []models.ProductRelation{
+			{RelatedProductID: uuid.New(), Type: models.ProductRelationUpsell, Position: 0},
+			{RelatedProductID: uuid.New(), Type: models.ProductRelationUpsell, Position: 1},
+		}
+		insertSQL := regexp.QuoteMeta(`INSERT INTO product_relations (product_id, related_product_id, relation_type, position) VALUES ($1, $2, $3, $4)`)
+
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM product_relations WHERE product_id = $1`)).
+				WithArgs(productID).
+				WillReturnResult(sqlmock.NewResult(0, 3))
+
+			for _, relation := range relations {
+				mock.ExpectExec(insertSQL).
+					WithArgs(productID, relation.RelatedProductID, relation.Type, relation.Position).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			mock.ExpectCommit()
+
+			// Act
+			err := repo.SetRelatedProducts(ctx, productID, relations)
+
+			// Assert
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("InsertError", func(t *testing.T) {
+			// Arrange
+			dbError := errors.New("foreign key violation")
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`DELETE FROM product_relations`).
+				WithArgs(productID).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(insertSQL).WillReturnError(dbError)
+			mock.ExpectRollback()
+
+			// Act
+			err := repo.SetRelatedProducts(ctx, productID, relations)
+
+			// Assert
+			require.ErrorIs(t, err, dbError)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("ListRelatedProducts", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+			relatedID := uuid.New()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM product_relations pr JOIN products p ON p.id = pr.related_product_id WHERE pr.product_id = $1`)).
+				WithArgs(productID).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "sku", "relation_type", "position"}).
+					AddRow(relatedID, "Case", 9.99, "CASE-1", "cross_sell", 0))
+
+			// Act
+			related, err := repo.ListRelatedProducts(ctx, productID)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []models.RelatedProduct{
+				{ProductID: relatedID, Name: "Case", Price: 9.99, SKU: "CASE-1", Type: models.ProductRelationCrossSell, Position: 0},
+			}, related)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("QueryError", func(t *testing.T) {
+			// Arrange
+			dbError := errors.New("db error")
+			mock.ExpectQuery(`FROM product_relations`).WillReturnError(dbError)
+
+			// Act
+			related, err := repo.ListRelatedProducts(ctx, uuid.New())
+
+			// Assert
+			require.ErrorIs(t, err, dbError)
+			assert.Nil(t, related)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("SetProductImages", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+			urls := []string{"https://cdn.test/front.jpg", "https://cdn.test/back.jpg"}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM product_images WHERE product_id = $1`)).
+				WithArgs(productID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			for position, url := range urls {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO product_images (product_id, url, position) VALUES ($1, $2, $3)`)).
+					WithArgs(productID, url, position).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			mock.ExpectCommit()
+
+			// Act
+			err := repo.SetProductImages(ctx, productID, urls)
+
+			// Assert
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("ListProductImages", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT url FROM product_images WHERE product_id = $1 ORDER BY position`)).
+				WithArgs(productID).
+				WillReturnRows(sqlmock.NewRows([]string{"url"}).AddRow("https://cdn.test/front.jpg").AddRow("https://cdn.test/back.jpg"))
+
+			// Act
+			urls, err := repo.ListProductImages(ctx, productID)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []string{"https://cdn.test/front.jpg", "https://cdn.test/back.jpg"}, urls)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("ListProductChanges", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+			changedAt := time.Now().Add(-time.Minute)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM product_changes WHERE seq > $1 AND seq < ALL (`)).
+				WithArgs(int64(41), float64(10), 3).
+				WillReturnRows(sqlmock.NewRows([]string{"seq", "product_id", "operation", "version", "changed_at"}).
+					AddRow(int64(42), productID, "create", 1, changedAt).
+					AddRow(int64(45), productID, "update", 2, changedAt))
+
+			// Act
+			changes, err := repo.ListProductChanges(ctx, 41, 3)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, changes, 2)
+			assert.Equal(t, models.ProductChange{Cursor: "42", ProductID: productID, Operation: models.ProductChangeCreate, Version: 1, ChangedAt: changedAt}, changes[0])
+			assert.Equal(t, "45", changes[1].Cursor)
+			assert.Equal(t, models.ProductChangeUpdate, changes[1].Operation)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("Empty", func(t *testing.T) {
+			// Arrange
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM product_changes`)).
+				WithArgs(int64(0), float64(10), 100).
+				WillReturnRows(sqlmock.NewRows([]string{"seq", "product_id", "operation", "version", "changed_at"}))
+
+			// Act
+			changes, err := repo.ListProductChanges(ctx, 0, 100)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Empty(t, changes)
+			assert.NotNil(t, changes)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("GetProductChangeCursor", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(seq), 0) FROM product_changes WHERE seq < ALL (`)).
+			WithArgs(float64(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(int64(57)))
+
+		// Act
+		cursor, err := repo.GetProductChangeCursor(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(57), cursor)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StreamSnapshotProducts", func(t *testing.T) {
+		// Arrange
+		first, second := uuid.New(), uuid.New()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM products WHERE status = 'active' ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "category_id", "name", "sku", "price", "vendor_id", "version"}).
+				AddRow(first, uuid.New(), "Sneaker", "SKU-1", 89.0, nil, 3).
+				AddRow(second, uuid.New(), "Hoodie", "SKU-2", 49.0, nil, 1))
+
+		var streamed []models.SnapshotProduct
+
+		// Act
+		err := repo.StreamSnapshotProducts(ctx, func(product *models.SnapshotProduct) error {
+			streamed = append(streamed, *product)
+
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, streamed, 2)
+		assert.Equal(t, first, streamed[0].ID)
+		assert.Equal(t, 3, streamed[0].Version)
+		assert.True(t, streamed[1].Active)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListChangedSnapshotProducts", func(t *testing.T) {
+		// Arrange
+		onSale, offSale := uuid.New(), uuid.New()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE id IN (SELECT product_id FROM product_changes WHERE seq > $1 AND seq <= $2)`)).
+			WithArgs(int64(41), int64(57), 101).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "category_id", "name", "sku", "price", "vendor_id", "version", "active"}).
+				AddRow(onSale, uuid.New(), "Sneaker", "SKU-1", 89.0, nil, 4, true).
+				AddRow(offSale, uuid.New(), "Hoodie", "SKU-2", 49.0, nil, 2, false))
+
+		// Act
+		products, err := repo.ListChangedSnapshotProducts(ctx, 41, 57, 101)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+		assert.True(t, products[0].Active)
+		assert.False(t, products[1].Active)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPricesBySKU", func(t *testing.T) {
+		t.Run("Success - Platform products only", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+			skus := []string{"SKU-1", "SKU-2"}
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT p.id, p.sku, p.price FROM products p WHERE p.sku = ANY($1) AND p.vendor_id IS NULL ORDER BY p.sku LIMIT $2`)).
+				WithArgs(pq.Array(skus), 2).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "price"}).AddRow(productID, "SKU-1", 19.99))
+
+			// Act
+			prices, err := repo.ListPricesBySKU(ctx, skus)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []models.PriceChange{{ProductID: productID, SKU: "SKU-1", OldPrice: 19.99}}, prices)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("ListPricesByFilter", func(t *testing.T) {
+		t.Run("Success - Filter and limit", func(t *testing.T) {
+			// Arrange
+			categoryID := uuid.New()
+			filter := &models.ProductFilter{CategoryID: &categoryID, Status: "active"}
+
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM products p WHERE p.category_id = $1 AND p.status = $2 AND p.vendor_id IS NULL ORDER BY p.sku LIMIT $3`)).
+				WithArgs(categoryID, "active", 1001).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "price"}))
+
+			// Act
+			prices, err := repo.ListPricesByFilter(ctx, filter, 1001)
+
+			// Assert
+			require.NoError(t, err)
+			assert.NotNil(t, prices)
+			assert.Empty(t, prices)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("Success - Whole catalog", func(t *testing.T) {
+			// Arrange
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM products p WHERE p.vendor_id IS NULL ORDER BY p.sku LIMIT $1`)).
+				WithArgs(10).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "price"}))
+
+			// Act
+			_, err := repo.ListPricesByFilter(ctx, &models.ProductFilter{}, 10)
+
+			// Assert
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("UpdatePrices", func(t *testing.T) {
+		change := models.PriceChange{ProductID: uuid.New(), SKU: "SKU-1", OldPrice: 20, NewPrice: 18}
+		entry := &models.AuditEntry{ID: uuid.New(), UserID: uuid.New(), Action: models.AuditActionPriceChanged, ReferenceID: &change.ProductID, Details: []byte(`{"sku":"SKU-1"}`)}
+		updateSQL := regexp.QuoteMeta(`UPDATE products SET price = $1, version = version + 1, updated_at = NOW() WHERE id = $2 AND price = $3`) + `.*` + regexp.QuoteMeta(`INSERT INTO product_changes`)
+
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			mock.ExpectBegin()
+			mock.ExpectQuery(updateSQL).
+				WithArgs(18.0, change.ProductID, 20.0).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(change.ProductID))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)`)).
+				WithArgs(entry.ID, entry.UserID, entry.ActorID, entry.Action, entry.ReferenceID, []byte(entry.Details)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			// Act
+			err := repo.UpdatePrices(ctx, []models.PriceChange{change}, []*models.AuditEntry{entry})
+
+			// Assert
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("Failure - Repriced concurrently", func(t *testing.T) {
+			// Arrange
+			mock.ExpectBegin()
+			mock.ExpectQuery(updateSQL).
+				WithArgs(18.0, change.ProductID, 20.0).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			// Act
+			err := repo.UpdatePrices(ctx, []models.PriceChange{change}, []*models.AuditEntry{entry})
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("ListQuickEditsBySKU", func(t *testing.T) {
+		t.Run("Success - Current values as old and new", func(t *testing.T) {
+			// Arrange
+			productID := uuid.New()
+			skus := []string{"SKU-1", "VENDOR-1"}
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, sku, price, stock_quantity FROM products WHERE sku = ANY($1) AND vendor_id IS NULL ORDER BY sku`)).
+				WithArgs(pq.Array(skus)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "price", "stock_quantity"}).AddRow(productID, "SKU-1", 19.99, 7))
+
+			// Act
+			changes, err := repo.ListQuickEditsBySKU(ctx, skus)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []models.ProductQuickEditChange{{ProductID: productID, SKU: "SKU-1", OldPrice: 19.99, NewPrice: 19.99, OldStock: 7, NewStock: 7}}, changes)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("ApplyQuickEdits", func(t *testing.T) {
+		change := models.ProductQuickEditChange{ProductID: uuid.New(), SKU: "SKU-1", OldPrice: 20, NewPrice: 18, OldStock: 5, NewStock: 12}
+		batchID := uuid.New()
+		movement := &models.StockMovement{ID: uuid.New(), ProductID: change.ProductID, Quantity: -7, Reason: models.StockMovementAdjustment, ReferenceID: &batchID}
+		entry := &models.AuditEntry{ID: uuid.New(), UserID: uuid.New(), Action: models.AuditActionPriceChanged, ReferenceID: &change.ProductID, Details: []byte(`{"sku":"SKU-1"}`)}
+		updateSQL := regexp.QuoteMeta(`UPDATE products SET price = $1, stock_quantity = $2, version = version + 1, updated_at = NOW() WHERE id = $3 AND price = $4 AND stock_quantity = $5`) +
+			`.*` + regexp.QuoteMeta(`INSERT INTO product_changes`)
+
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			mock.ExpectBegin()
+			mock.ExpectQuery(updateSQL).
+				WithArgs(18.0, 12, change.ProductID, 20.0, 5).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(change.ProductID))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO stock_movements (id, product_id, quantity, reason, reference_id, created_at)`)).
+				WithArgs(movement.ID, movement.ProductID, -7, models.StockMovementAdjustment, movement.ReferenceID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)`)).
+				WithArgs(entry.ID, entry.UserID, entry.ActorID, entry.Action, entry.ReferenceID, []byte(entry.Details)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			// Act
+			err := repo.ApplyQuickEdits(ctx, []models.ProductQuickEditChange{change}, []*models.StockMovement{movement}, []*models.AuditEntry{entry})
+
+			// Assert
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("Failure - Changed concurrently", func(t *testing.T) {
+			// Arrange
+			mock.ExpectBegin()
+			mock.ExpectQuery(updateSQL).
+				WithArgs(18.0, 12, change.ProductID, 20.0, 5).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			// Act
+			err := repo.ApplyQuickEdits(ctx, []models.ProductQuickEditChange{change}, []*models.StockMovement{movement}, []*models.AuditEntry{entry})
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("PublishScheduledProducts", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			due := time.Date(2025, time.March, 14, 9, 30, 0, 0, time.UTC)
+			productID := uuid.New()
+
+			expectedSQL := regexp.QuoteMeta(`UPDATE products SET status = 'active', publish_at = NULL`) + `.*` +
+				regexp.QuoteMeta(`WHERE status = 'scheduled' AND publish_at <= $1`) + `.*` + regexp.QuoteMeta(`INSERT INTO product_changes`)
+
+			mock.ExpectQuery(expectedSQL).
+				WithArgs(due, 100).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(productID))
+
+			// Act
+			ids, err := repo.PublishScheduledProducts(ctx, due, 100)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []uuid.UUID{productID}, ids)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("SetPurchaseLimit", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			// Arrange
+			maxPerCustomer, periodDays := 2, 30
+			product := &models.Product{ID: uuid.New(), MaxPerCustomer: &maxPerCustomer, LimitPeriodDays: &periodDays}
+			updatedAt := time.Now()
+
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE products SET max_per_order = $1, max_per_customer = $2, limit_period_days = $3, updated_at = NOW() WHERE id = $4`)).
+				WithArgs(nil, &maxPerCustomer, &periodDays, product.ID).
+				WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+
+			// Act
+			err := repo.SetPurchaseLimit(ctx, product)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, updatedAt, product.UpdatedAt)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("Failure - Unknown product", func(t *testing.T) {
+			// Arrange
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE products SET max_per_order`)).
+				WillReturnError(sql.ErrNoRows)
+
+			// Act
+			err := repo.SetPurchaseLimit(ctx, &models.Product{ID: uuid.New()})
+
+			// Assert
+			require.ErrorIs(t, err, sql.ErrNoRows)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"strconv"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 type RateLimitRepository interface {
 	CheckLoginRateLimit(ctx context.Context, username string) (bool, int, int, error)
+	CheckRateLimit(ctx context.Context, key string, limit int64, window time.Duration) (bool, int, error)
 }
 
 type redisRepository struct {
@@ -81,6 +83,9 @@ func (r *redisRepository) CheckLoginRateLimit(ctx context.Context, username stri
 	// count the number of login attempts, currently in the window
 	count := pipe.ZCard(ctx, key)
 
+	// the oldest attempt in the window, the window frees an attempt when it expires
+	oldest := pipe.ZRangeWithScores(ctx, key, 0, 0)
+
 	// delete the redis key after expiry
 	pipe.Expire(ctx, key, r.cfg.RateConfig.WindowSize)
 
@@ -94,32 +99,59 @@ func (r *redisRepository) CheckLoginRateLimit(ctx context.Context, username stri
 
 	// remaining attempts
 	attempts := count.Val()
-	remaining := r.cfg.RateConfig.MaxAttempts - attempts
+	remaining := max(r.cfg.RateConfig.MaxAttempts-attempts, 0)
+
+	reset := r.cfg.RateConfig.WindowSize
+	if scores := oldest.Val(); len(scores) > 0 {
+		reset = time.Duration(int64(scores[0].Score)-now)*time.Second + r.cfg.RateConfig.WindowSize
+	}
+
+	middleware.SetRateLimit(ctx, r.cfg.RateConfig.MaxAttempts, remaining, reset)
 
 	if attempts >= r.cfg.RateConfig.MaxAttempts {
-		oldestScoreCmd := r.client.ZRangeArgsWithScores(ctx, redis.ZRangeArgs{
-			Key: key, Start: 0, Stop: 0,
-		})
+		logger.Warn("Rate limit exceeded for user", slog.String("username", username), slog.Int64("attempts", attempts))
 
-		scores, err := oldestScoreCmd.Result()
-		if err != nil || len(scores) == 0 {
-			logger.Error("Failed to get oldest attempt time for rate limit", slog.String("key", key), slog.Any("error", err))
+		return false, 0, int(max(reset.Seconds(), 0)), nil
+	}
 
-			return false, 0, int(r.cfg.RateConfig.WindowSize.Seconds()), fmt.Errorf("failed to get oldest attempt time: %w", err)
-		}
+	logger.Debug("Rate limit check passed", slog.String("username", username), slog.Int64("attempts", attempts), slog.Int64("remaining", remaining))
 
-		oldestTimestamp := int64(scores[0].Score)
+	return true, int(remaining), 0, nil
+}
 
-		retryAfter := max((oldestTimestamp+int64(r.cfg.RateConfig.WindowSize.Seconds()))-now, 0)
+// CheckRateLimit counts a request under key in a sliding window, like the login attempts. It returns
+// whether the request is allowed and, when it is not, the seconds to wait. Both checks report the
+// limit on the response through middleware.SetRateLimit.
+func (r *redisRepository) CheckRateLimit(ctx context.Context, key string, limit int64, window time.Duration) (bool, int, error) {
+	key = "rate_limit:" + key
 
-		logger.Warn("Rate limit exceeded for user", slog.String("username", username), slog.Int64("attempts", attempts))
+	now := time.Now()
+	windowStart := now.Add(-window).UnixNano()
 
-		return false, 0, int(retryAfter), nil
+	pipe := r.client.Pipeline()
+	pipe.ZRemRangeByScore(ctx, key, "0", strconv.FormatInt(windowStart, 10))
+	// scored in nanoseconds, requests of the same second are all counted
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixNano()), Member: now.UnixNano()})
+	count := pipe.ZCard(ctx, key)
+	oldest := pipe.ZRangeWithScores(ctx, key, 0, 0)
+	pipe.Expire(ctx, key, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, 0, fmt.Errorf("redis pipeline error for rate limit check: %w", err)
 	}
 
-	logger.Debug("Rate limit check passed", slog.String("username", username), slog.Int64("attempts", attempts), slog.Int64("remaining", remaining))
+	reset := window
+	if scores := oldest.Val(); len(scores) > 0 {
+		reset = time.Duration(int64(scores[0].Score) + window.Nanoseconds() - now.UnixNano())
+	}
 
-	return true, int(remaining), 0, nil
+	middleware.SetRateLimit(ctx, limit, limit-count.Val(), reset)
+
+	if count.Val() <= limit {
+		return true, 0, nil
+	}
+
+	return false, max(int(math.Ceil(reset.Seconds())), 1), nil
 }
 
 // login attempts stored in redis
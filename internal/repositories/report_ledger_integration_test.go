@@ -0,0 +1,176 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"database/sql"
+	"math/big"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestSchema connects to the database of TEST_DATABASE_DSN inside a schema of its own, dropped once the
+// test is done, and creates the tables given in it.
+func openTestSchema(t *testing.T, tables ...string) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	admin, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = admin.Close() })
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	_, err = admin.ExecContext(t.Context(), "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = admin.Exec("DROP SCHEMA " + schema + " CASCADE") })
+
+	// lib/pq passes the parameters it doesn't know to the server as run-time parameters
+	separator := " "
+	if strings.Contains(dsn, "://") {
+		separator = "&"
+		if !strings.Contains(dsn, "?") {
+			separator = "?"
+		}
+	}
+
+	db, err := sql.Open("postgres", dsn+separator+"search_path="+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	for _, table := range tables {
+		_, err := db.ExecContext(t.Context(), table)
+		require.NoError(t, err)
+	}
+
+	return db
+}
+
+// exactCents sums the amounts as decimals, without any float arithmetic, and rounds the sum to the cent.
+func exactCents(t *testing.T, amounts []float64) float64 {
+	t.Helper()
+
+	sum := new(big.Rat)
+
+	for _, amount := range amounts {
+		value, ok := new(big.Rat).SetString(strconv.FormatFloat(amount, 'f', -1, 64))
+		require.True(t, ok)
+		sum.Add(sum, value)
+	}
+
+	cents, err := utils.ParseCents(sum.FloatString(10))
+	require.NoError(t, err)
+
+	return float64(cents) / 100
+}
+
+func TestVendorReportsMatchLedger(t *testing.T) {
+	db := openTestSchema(t,
+		`CREATE TABLE orders (id UUID PRIMARY KEY, payment_status TEXT NOT NULL)`,
+		`CREATE TABLE vendor_orders (
+			id UUID PRIMARY KEY, order_id UUID NOT NULL, vendor_id UUID NOT NULL, subtotal DOUBLE PRECISION NOT NULL,
+			commission_rate DOUBLE PRECISION NOT NULL, commission DOUBLE PRECISION NOT NULL, status TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL)`,
+		`CREATE TABLE vendor_ledger_entries (
+			id UUID PRIMARY KEY, vendor_id UUID NOT NULL, type TEXT NOT NULL, amount DOUBLE PRECISION NOT NULL,
+			vendor_order_id UUID, payout_batch_id UUID, created_at TIMESTAMPTZ NOT NULL)`,
+		`CREATE UNIQUE INDEX vendor_ledger_entries_vendor_order_type_idx ON vendor_ledger_entries (vendor_order_id, type)`,
+	)
+
+	repo := repository.NewVendorRepo(db)
+	ctx := t.Context()
+
+	// Arrange: amounts that float sums drift on, and commissions in fractions of a cent with ties
+	vendorID := uuid.New()
+	subtotals := []float64{0.1, 19.99, 0.25, 7.35, 1234.565}
+	commissions := []float64{0.01, 1.999, 0.025, 0.735, 123.4565}
+
+	var sales, charged []float64
+
+	for i := range 2000 {
+		orderID := uuid.New()
+		status := models.PaymentStatusSucceeded
+
+		if i%10 == 0 {
+			status = models.PaymentStatusFailed
+		}
+
+		_, err := db.ExecContext(ctx, `INSERT INTO orders (id, payment_status) VALUES ($1, $2)`, orderID, status)
+		require.NoError(t, err)
+
+		vendorOrder := &models.VendorOrder{
+			ID:             uuid.New(),
+			OrderID:        orderID,
+			VendorID:       vendorID,
+			Subtotal:       subtotals[i%len(subtotals)],
+			CommissionRate: 0.1,
+			Commission:     commissions[i%len(commissions)],
+			Status:         models.OrderStatusPending,
+			CreatedAt:      time.Now(),
+		}
+		require.NoError(t, repo.CreateVendorOrder(ctx, vendorOrder))
+
+		if status == models.PaymentStatusSucceeded {
+			sales = append(sales, vendorOrder.Subtotal)
+			charged = append(charged, vendorOrder.Commission)
+		}
+	}
+
+	from := time.Now().Add(-time.Hour)
+	to := time.Now().Add(time.Hour)
+
+	// Act
+	accrued, err := repo.AccrueEarnings(ctx)
+	require.NoError(t, err)
+
+	report, err := repo.GetVendorSales(ctx, from, to)
+	require.NoError(t, err)
+
+	totals, err := repo.GetLedgerTotals(ctx, vendorID, from, to)
+	require.NoError(t, err)
+
+	balance, err := repo.GetVendorBalance(ctx, vendorID)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(2*len(sales)), accrued)
+	require.Len(t, report, 1)
+	assert.Equal(t, len(sales), report[0].OrderCount)
+
+	ledger := map[models.VendorLedgerEntryType]float64{}
+	for _, total := range totals {
+		ledger[total.Type] = total.Amount
+	}
+
+	assert.Equal(t, exactCents(t, sales), report[0].GrossSales, "report gross sales")
+	assert.Equal(t, exactCents(t, charged), report[0].Commission, "report commission")
+	assert.Equal(t, report[0].GrossSales, ledger[models.VendorLedgerSale], "ledger sales")
+	assert.Equal(t, -report[0].Commission, ledger[models.VendorLedgerCommission], "ledger commission")
+
+	expectedBalance := exactCents(t, slices.Concat(sales, negate(charged)))
+	assert.Equal(t, expectedBalance, balance, "vendor balance")
+}
+
+func negate(amounts []float64) []float64 {
+	negated := make([]float64, len(amounts))
+	for i, amount := range amounts {
+		negated[i] = -amount
+	}
+
+	return negated
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+// RetentionRepository purges the data kept past its retention period. Every purge records an audit
+// entry on the owner of the record in the same transaction, actorID is the admin who asked for it, or
+// nil for the scheduled runs.
+type RetentionRepository interface {
+	CountStaleCarts(ctx context.Context, updatedBefore time.Time) (int, error)
+	PurgeStaleCarts(ctx context.Context, updatedBefore time.Time, limit int, actorID *uuid.UUID) ([]models.RetentionRecord, error)
+	CountOrdersToAnonymize(ctx context.Context, placedBefore time.Time) (int, error)
+	AnonymizeOrders(ctx context.Context, placedBefore time.Time, limit int, actorID *uuid.UUID) ([]models.RetentionRecord, error)
+}
+
+type retentionRepository struct {
+	DB *sql.DB
+}
+
+func NewRetentionRepo(db *sql.DB) RetentionRepository {
+	return &retentionRepository{DB: db}
+}
+
+// finishedOrders are the statuses an order no longer leaves, only these are anonymized.
+const finishedOrders = `status IN ('delivered', 'cancelled')`
+
+func (r *retentionRepository) CountStaleCarts(ctx context.Context, updatedBefore time.Time) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var count int
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM carts WHERE updated_at < $1`, updatedBefore).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count stale carts: %w", err)
+	}
+
+	return count, nil
+}
+
+// PurgeStaleCarts deletes up to limit of the carts last updated before updatedBefore, oldest first.
+func (r *retentionRepository) PurgeStaleCarts(ctx context.Context, updatedBefore time.Time, limit int, actorID *uuid.UUID) ([]models.RetentionRecord, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		DELETE FROM carts
+		WHERE id IN (
+			SELECT id FROM carts WHERE updated_at < $1
+			ORDER BY updated_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id
+	`
+
+	records, err := scanRetentionRecords(tx.QueryContext(dbCtx, query, updatedBefore, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge stale carts: %w", err)
+	}
+
+	if err := recordRetentionEntries(dbCtx, tx, records, models.AuditActionCartPurged, models.RetentionStaleCarts, updatedBefore, actorID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit cart purge: %w", err)
+	}
+
+	return records, nil
+}
+
+func (r *retentionRepository) CountOrdersToAnonymize(ctx context.Context, placedBefore time.Time) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM orders WHERE created_at < $1 AND anonymized_at IS NULL AND ` + finishedOrders
+
+	var count int
+
+	if err := r.DB.QueryRowContext(dbCtx, query, placedBefore).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count orders to anonymize: %w", err)
+	}
+
+	return count, nil
+}
+
+// AnonymizeOrders strips the personal data of up to limit of the delivered or cancelled orders placed
+// before placedBefore, oldest first: the shipping address keeps only its state and country, which the
+// tax reports need, the gift message goes and so do the devices the orders were placed from.
+func (r *retentionRepository) AnonymizeOrders(ctx context.Context, placedBefore time.Time, limit int, actorID *uuid.UUID) ([]models.RetentionRecord, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		SELECT id, customer_id, shipping_address FROM orders
+		WHERE created_at < $1 AND anonymized_at IS NULL AND ` + finishedOrders + `
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.QueryContext(dbCtx, query, placedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders to anonymize: %w", err)
+	}
+
+	var (
+		records   []models.RetentionRecord
+		addresses [][]byte
+	)
+
+	for rows.Next() {
+		var (
+			record  models.RetentionRecord
+			address []byte
+		)
+
+		if err := rows.Scan(&record.ID, &record.UserID, &address); err != nil {
+			rows.Close()
+
+			return nil, fmt.Errorf("failed to scan order to anonymize: %w", err)
+		}
+
+		records = append(records, record)
+		addresses = append(addresses, address)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate orders to anonymize: %w", err)
+	}
+
+	for i, record := range records {
+		address, err := anonymizeAddress(addresses[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to anonymize address of order %s: %w", record.ID, err)
+		}
+
+		if _, err := tx.ExecContext(dbCtx, `UPDATE orders SET shipping_address = $1, gift_message = NULL, anonymized_at = NOW() WHERE id = $2`, address, record.ID); err != nil {
+			return nil, fmt.Errorf("failed to anonymize order %s: %w", record.ID, err)
+		}
+
+		if _, err := tx.ExecContext(dbCtx, `DELETE FROM order_devices WHERE order_id = $1`, record.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete devices of order %s: %w", record.ID, err)
+		}
+	}
+
+	if err := recordRetentionEntries(dbCtx, tx, records, models.AuditActionOrderAnonymized, models.RetentionOrderPII, placedBefore, actorID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit order anonymization: %w", err)
+	}
+
+	return records, nil
+}
+
+// anonymizeAddress keeps the state and country of a stored address, an order without one keeps none.
+func anonymizeAddress(stored []byte) (any, error) {
+	if stored == nil {
+		return nil, nil
+	}
+
+	var address *models.Address
+	if err := json.Unmarshal(stored, &address); err != nil || address == nil {
+		return stored, err
+	}
+
+	return json.Marshal(models.Address{State: address.State, Country: address.Country})
+}
+
+func scanRetentionRecords(rows *sql.Rows, err error) ([]models.RetentionRecord, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []models.RetentionRecord
+
+	for rows.Next() {
+		var record models.RetentionRecord
+		if err := rows.Scan(&record.ID, &record.UserID); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+func recordRetentionEntries(ctx context.Context, tx *sql.Tx, records []models.RetentionRecord, action models.AuditAction, policy models.RetentionPolicy, cutoff time.Time, actorID *uuid.UUID) error {
+	details, err := json.Marshal(map[string]any{"policy": policy, "cutoff": cutoff})
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit details: %w", err)
+	}
+
+	query := `
+		INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	`
+
+	for _, record := range records {
+		if _, err := tx.ExecContext(ctx, query, uuid.New(), record.UserID, actorID, action, record.ID, details); err != nil {
+			return fmt.Errorf("failed to record audit entry: %w", err)
+		}
+	}
+
+	return nil
+}
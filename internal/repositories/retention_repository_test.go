@@ -0,0 +1,135 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewRetentionRepo(db)
+	ctx := t.Context()
+	cutoff := time.Now().Add(-90 * 24 * time.Hour)
+	adminID := uuid.New()
+
+	t.Run("CountStaleCarts_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM carts WHERE updated_at < \\$1").
+			WithArgs(cutoff).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+		// Act
+		count, err := repo.CountStaleCarts(ctx, cutoff)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 7, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PurgeStaleCarts_RecordsAuditEntries", func(t *testing.T) {
+		// Arrange
+		cartID, userID := uuid.New(), uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("DELETE FROM carts").
+			WithArgs(cutoff, 50).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(cartID, userID))
+		mock.ExpectExec("INSERT INTO audit_log").
+			WithArgs(sqlmock.AnyArg(), userID, &adminID, models.AuditActionCartPurged, cartID, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		records, err := repo.PurgeStaleCarts(ctx, cutoff, 50, &adminID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.RetentionRecord{{ID: cartID, UserID: userID}}, records)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PurgeStaleCarts_AuditFailureRollsBack", func(t *testing.T) {
+		// Arrange
+		mock.ExpectBegin()
+		mock.ExpectQuery("DELETE FROM carts").
+			WithArgs(cutoff, 50).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(uuid.New(), uuid.New()))
+		mock.ExpectExec("INSERT INTO audit_log").WillReturnError(errors.New("db down"))
+		mock.ExpectRollback()
+
+		// Act
+		records, err := repo.PurgeStaleCarts(ctx, cutoff, 50, nil)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, records)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AnonymizeOrders_KeepsStateAndCountry", func(t *testing.T) {
+		// Arrange
+		orderID, pickupOrderID, customerID := uuid.New(), uuid.New(), uuid.New()
+		address := `{"street":"1 Main St","city":"Springfield","state":"IL","postal_code":"62701","country":"US"}`
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, customer_id, shipping_address FROM orders").
+			WithArgs(cutoff, 50).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id", "shipping_address"}).
+				AddRow(orderID, customerID, []byte(address)).
+				AddRow(pickupOrderID, customerID, nil))
+		mock.ExpectExec("UPDATE orders SET shipping_address = \\$1, gift_message = NULL, anonymized_at = NOW\\(\\) WHERE id = \\$2").
+			WithArgs([]byte(`{"street":"","city":"","state":"IL","postal_code":"","country":"US"}`), orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM order_devices WHERE order_id = \\$1").
+			WithArgs(orderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE orders SET shipping_address").
+			WithArgs(nil, pickupOrderID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM order_devices").
+			WithArgs(pickupOrderID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO audit_log").
+			WithArgs(sqlmock.AnyArg(), customerID, nil, models.AuditActionOrderAnonymized, orderID, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO audit_log").
+			WithArgs(sqlmock.AnyArg(), customerID, nil, models.AuditActionOrderAnonymized, pickupOrderID, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		records, err := repo.AnonymizeOrders(ctx, cutoff, 50, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, records, 2)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CountOrdersToAnonymize_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM orders WHERE created_at < \\$1 AND anonymized_at IS NULL AND status IN \\('delivered', 'cancelled'\\)").
+			WithArgs(cutoff).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		// Act
+		count, err := repo.CountOrdersToAnonymize(ctx, cutoff)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
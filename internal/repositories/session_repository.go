@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *models.Session) error
+	ListActiveSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, userID, id uuid.UUID) error
+	IsSessionRevoked(ctx context.Context, id uuid.UUID) (bool, error)
+	CountDeviceSessions(ctx context.Context, userID uuid.UUID, userAgent string) (int, int, error)
+}
+
+type sessionRepository struct {
+	DB *sql.DB
+}
+
+func NewSessionRepo(db *sql.DB) SessionRepository {
+	return &sessionRepository{DB: db}
+}
+
+func (r *sessionRepository) CreateSession(ctx context.Context, session *models.Session) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO user_sessions (id, user_id, ip_address, user_agent, country, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, session.ID, session.UserID, session.IPAddress, session.UserAgent, session.Country, session.ExpiresAt).Scan(&session.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert session: %w", err)
+	}
+
+	return nil
+}
+
+// ListActiveSessions returns the sessions of the user that are neither revoked nor expired, most
+// recent first.
+func (r *sessionRepository) ListActiveSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, ip_address, user_agent, country, created_at, expires_at
+		FROM user_sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*models.Session{}
+
+	for rows.Next() {
+		var session models.Session
+
+		if err := rows.Scan(&session.ID, &session.UserID, &session.IPAddress, &session.UserAgent, &session.Country, &session.CreatedAt, &session.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+
+		sessions = append(sessions, &session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes an active session of the user, it returns sql.ErrNoRows when the user has no
+// such session.
+func (r *sessionRepository) RevokeSession(ctx context.Context, userID, id uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE user_sessions SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// IsSessionRevoked is checked on every authenticated request, an unknown session is not revoked.
+func (r *sessionRepository) IsSessionRevoked(ctx context.Context, id uuid.UUID) (bool, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT EXISTS (SELECT 1 FROM user_sessions WHERE id = $1 AND revoked_at IS NOT NULL)`
+
+	var revoked bool
+
+	if err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&revoked); err != nil {
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+
+	return revoked, nil
+}
+
+// CountDeviceSessions returns how many sessions the user ever had, and how many of them came from the
+// user agent, revoked and expired ones included.
+func (r *sessionRepository) CountDeviceSessions(ctx context.Context, userID uuid.UUID, userAgent string) (int, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE user_agent = $2)
+		FROM user_sessions
+		WHERE user_id = $1
+	`
+
+	var total, device int
+
+	if err := r.DB.QueryRowContext(dbCtx, query, userID, userAgent).Scan(&total, &device); err != nil {
+		return 0, 0, fmt.Errorf("failed to count sessions: %w", err)
+	}
+
+	return total, device, nil
+}
@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewSessionRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	device := models.LoginDevice{IPAddress: "203.0.113.7", UserAgent: "Mozilla/5.0 (Macintosh)", Country: "DE"}
+
+	t.Run("CreateSession_Success", func(t *testing.T) {
+		// Arrange
+		session := &models.Session{ID: sessionID, UserID: userID, LoginDevice: device, ExpiresAt: now.Add(24 * time.Hour)}
+
+		mock.ExpectQuery("INSERT INTO user_sessions").
+			WithArgs(sessionID, userID, device.IPAddress, device.UserAgent, device.Country, session.ExpiresAt).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.CreateSession(ctx, session)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, session.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListActiveSessions_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM user_sessions").
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "ip_address", "user_agent", "country", "created_at", "expires_at"}).
+				AddRow(sessionID, userID, device.IPAddress, device.UserAgent, device.Country, now, now.Add(24*time.Hour)))
+
+		// Act
+		sessions, err := repo.ListActiveSessions(ctx, userID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, sessionID, sessions[0].ID)
+		assert.Equal(t, device, sessions[0].LoginDevice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RevokeSession_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("UPDATE user_sessions SET revoked_at").
+			WithArgs(sessionID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.RevokeSession(ctx, userID, sessionID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("IsSessionRevoked_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(sessionID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		// Act
+		revoked, err := repo.IsSessionRevoked(ctx, sessionID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CountDeviceSessions_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM user_sessions").
+			WithArgs(userID, device.UserAgent).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(4, 1))
+
+		// Act
+		total, known, err := repo.CountDeviceSessions(ctx, userID, device.UserAgent)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, 1, known)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
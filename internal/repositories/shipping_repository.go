@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type ShippingRepository interface {
+	SetProductRestriction(ctx context.Context, restriction *models.ProductShippingRestriction) error
+	GetProductRestriction(ctx context.Context, productID uuid.UUID) (*models.ProductShippingRestriction, error)
+	DeleteProductRestriction(ctx context.Context, productID uuid.UUID) error
+	ListProductRestrictions(ctx context.Context, productIDs []uuid.UUID) ([]*models.ProductShippingRestriction, error)
+}
+
+type shippingRepository struct {
+	DB *sql.DB
+}
+
+func NewShippingRepo(db *sql.DB) ShippingRepository {
+	return &shippingRepository{DB: db}
+}
+
+// SetProductRestriction creates the restriction of the product, or replaces the one it has.
+func (r *shippingRepository) SetProductRestriction(ctx context.Context, restriction *models.ProductShippingRestriction) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO product_shipping_restrictions (product_id, reason, allowed_countries, no_po_box, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (product_id) DO UPDATE
+		SET reason = EXCLUDED.reason, allowed_countries = EXCLUDED.allowed_countries, no_po_box = EXCLUDED.no_po_box, updated_at = NOW()
+		RETURNING updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, restriction.ProductID, restriction.Reason, pq.Array(restriction.AllowedCountries), restriction.NoPOBox).
+		Scan(&restriction.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set product shipping restriction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *shippingRepository) GetProductRestriction(ctx context.Context, productID uuid.UUID) (*models.ProductShippingRestriction, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT product_id, reason, allowed_countries, no_po_box, updated_at
+		FROM product_shipping_restrictions
+		WHERE product_id = $1
+	`
+
+	restriction := &models.ProductShippingRestriction{}
+
+	err := r.DB.QueryRowContext(dbCtx, query, productID).
+		Scan(&restriction.ProductID, &restriction.Reason, pq.Array(&restriction.AllowedCountries), &restriction.NoPOBox, &restriction.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product shipping restriction: %w", err)
+	}
+
+	return restriction, nil
+}
+
+func (r *shippingRepository) DeleteProductRestriction(ctx context.Context, productID uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.DB.ExecContext(dbCtx, `DELETE FROM product_shipping_restrictions WHERE product_id = $1`, productID)
+	if err != nil {
+		return fmt.Errorf("failed to delete product shipping restriction: %w", err)
+	}
+
+	deletedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get deleted rows: %w", err)
+	}
+
+	if deletedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListProductRestrictions returns the restrictions of the products that have one.
+func (r *shippingRepository) ListProductRestrictions(ctx context.Context, productIDs []uuid.UUID) ([]*models.ProductShippingRestriction, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT product_id, reason, allowed_countries, no_po_box, updated_at
+		FROM product_shipping_restrictions
+		WHERE product_id = ANY($1)
+		ORDER BY product_id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, pq.Array(productIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product shipping restrictions: %w", err)
+	}
+	defer rows.Close()
+
+	var restrictions []*models.ProductShippingRestriction
+
+	for rows.Next() {
+		restriction := &models.ProductShippingRestriction{}
+
+		err := rows.Scan(&restriction.ProductID, &restriction.Reason, pq.Array(&restriction.AllowedCountries), &restriction.NoPOBox, &restriction.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product shipping restriction: %w", err)
+		}
+
+		restrictions = append(restrictions, restriction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product shipping restrictions: %w", err)
+	}
+
+	return restrictions, nil
+}
@@ -0,0 +1,91 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShippingRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewShippingRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	productID := uuid.New()
+	columns := []string{"product_id", "reason", "allowed_countries", "no_po_box", "updated_at"}
+
+	t.Run("SetProductRestriction_Success", func(t *testing.T) {
+		// Arrange
+		restriction := &models.ProductShippingRestriction{ProductID: productID, Reason: "hazardous", AllowedCountries: []string{"US", "CA"}, NoPOBox: true}
+
+		mock.ExpectQuery("INSERT INTO product_shipping_restrictions").
+			WithArgs(productID, "hazardous", pq.Array([]string{"US", "CA"}), true).
+			WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(now))
+
+		// Act
+		err := repo.SetProductRestriction(ctx, restriction)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, restriction.UpdatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListProductRestrictions_Success", func(t *testing.T) {
+		// Arrange
+		otherID := uuid.New()
+
+		mock.ExpectQuery("FROM product_shipping_restrictions").
+			WithArgs(pq.Array([]uuid.UUID{productID, otherID})).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(productID, "hazardous", []byte("{US,CA}"), true, now))
+
+		// Act
+		restrictions, err := repo.ListProductRestrictions(ctx, []uuid.UUID{productID, otherID})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, restrictions, 1)
+		assert.Equal(t, []string{"US", "CA"}, restrictions[0].AllowedCountries)
+		assert.True(t, restrictions[0].NoPOBox)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetProductRestriction_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM product_shipping_restrictions").
+			WithArgs(productID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		_, err := repo.GetProductRestriction(ctx, productID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteProductRestriction_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("DELETE FROM product_shipping_restrictions").
+			WithArgs(productID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.DeleteProductRestriction(ctx, productID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,348 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type StocktakeRepository interface {
+	CreateStocktake(ctx context.Context, stocktake *models.Stocktake) error
+	GetStocktake(ctx context.Context, id uuid.UUID) (*models.Stocktake, error)
+	ListStocktakes(ctx context.Context, status models.StocktakeStatus, page, size int) ([]*models.Stocktake, int, error)
+	SubmitCounts(ctx context.Context, id uuid.UUID, counts []models.StocktakeCount) error
+	ListVariances(ctx context.Context, id uuid.UUID) ([]models.StocktakeVariance, error)
+	ApplyStocktake(ctx context.Context, stocktake *models.Stocktake, adjustments []models.StocktakeAdjustment, movements []*models.StockMovement, entries []*models.AuditEntry) error
+}
+
+type stocktakeRepository struct {
+	DB *sql.DB
+}
+
+func NewStocktakeRepo(db *sql.DB) StocktakeRepository {
+	return &stocktakeRepository{DB: db}
+}
+
+const stocktakeColumns = `id, warehouse_id, category_id, status, created_by, created_at, applied_by, applied_at`
+
+func scanStocktake(row interface{ Scan(dest ...any) error }, stocktake *models.Stocktake) error {
+	err := row.Scan(&stocktake.ID, &stocktake.WarehouseID, &stocktake.CategoryID, &stocktake.Status, &stocktake.CreatedBy, &stocktake.CreatedAt, &stocktake.AppliedBy, &stocktake.AppliedAt)
+	if err != nil {
+		return fmt.Errorf("failed to scan stocktake: %w", err)
+	}
+
+	return nil
+}
+
+// CreateStocktake opens the stocktake with a line for every product the warehouse stocks, only those of
+// the category when one is set. It returns sql.ErrNoRows, and creates nothing, when there is no product
+// to count.
+func (r *stocktakeRepository) CreateStocktake(ctx context.Context, stocktake *models.Stocktake) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	query := `
+		INSERT INTO stocktakes (id, warehouse_id, category_id, status, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(dbCtx, query, stocktake.ID, stocktake.WarehouseID, stocktake.CategoryID, models.StocktakeOpen, stocktake.CreatedBy).Scan(&stocktake.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert stocktake: %w", err)
+	}
+
+	linesQuery := `
+		INSERT INTO stocktake_lines (stocktake_id, product_id)
+		SELECT $1, s.product_id
+		FROM warehouse_stock s
+		JOIN products p ON p.id = s.product_id
+		WHERE s.warehouse_id = $2 AND ($3::uuid IS NULL OR p.category_id = $3)
+	`
+
+	result, err := tx.ExecContext(dbCtx, linesQuery, stocktake.ID, stocktake.WarehouseID, stocktake.CategoryID)
+	if err != nil {
+		return fmt.Errorf("failed to insert stocktake lines: %w", err)
+	}
+
+	lines, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get inserted rows: %w", err)
+	}
+
+	if lines == 0 {
+		return sql.ErrNoRows
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit stocktake: %w", err)
+	}
+
+	stocktake.Status = models.StocktakeOpen
+
+	return nil
+}
+
+// GetStocktake returns the stocktake with its lines, in the order of their SKU.
+func (r *stocktakeRepository) GetStocktake(ctx context.Context, id uuid.UUID) (*models.Stocktake, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	stocktake := &models.Stocktake{}
+
+	query := `SELECT ` + stocktakeColumns + ` FROM stocktakes WHERE id = $1`
+	if err := scanStocktake(r.DB.QueryRowContext(dbCtx, query, id), stocktake); err != nil {
+		return nil, err
+	}
+
+	linesQuery := `
+		SELECT l.product_id, p.sku, p.name, l.counted_quantity, l.counted_at
+		FROM stocktake_lines l
+		JOIN products p ON p.id = l.product_id
+		WHERE l.stocktake_id = $1
+		ORDER BY p.sku, l.product_id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, linesQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stocktake lines: %w", err)
+	}
+	defer rows.Close()
+
+	stocktake.Lines = []models.StocktakeLine{}
+
+	for rows.Next() {
+		var line models.StocktakeLine
+
+		if err := rows.Scan(&line.ProductID, &line.SKU, &line.Name, &line.CountedQuantity, &line.CountedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan stocktake line: %w", err)
+		}
+
+		stocktake.Lines = append(stocktake.Lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stocktake lines: %w", err)
+	}
+
+	return stocktake, nil
+}
+
+func (r *stocktakeRepository) ListStocktakes(ctx context.Context, status models.StocktakeStatus, page, size int) ([]*models.Stocktake, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM stocktakes WHERE $1 = '' OR status = $1`, status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count stocktakes: %w", err)
+	}
+
+	query := `
+		SELECT ` + stocktakeColumns + `
+		FROM stocktakes
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, status, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list stocktakes: %w", err)
+	}
+	defer rows.Close()
+
+	stocktakes := []*models.Stocktake{}
+
+	for rows.Next() {
+		stocktake := &models.Stocktake{}
+		if err := scanStocktake(rows, stocktake); err != nil {
+			return nil, 0, err
+		}
+
+		stocktakes = append(stocktakes, stocktake)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating stocktakes: %w", err)
+	}
+
+	return stocktakes, total, nil
+}
+
+// SubmitCounts records the counts on the lines of an open stocktake in one statement. It returns
+// sql.ErrNoRows, and records nothing, when the stocktake was applied in the meantime.
+func (r *stocktakeRepository) SubmitCounts(ctx context.Context, id uuid.UUID, counts []models.StocktakeCount) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	productIDs := make([]uuid.UUID, len(counts))
+	quantities := make([]int64, len(counts))
+
+	for i, count := range counts {
+		productIDs[i] = count.ProductID
+		quantities[i] = int64(count.Quantity)
+	}
+
+	query := `
+		UPDATE stocktake_lines l SET counted_quantity = c.quantity, counted_at = NOW()
+		FROM unnest($2::uuid[], $3::int[]) AS c(product_id, quantity), stocktakes s
+		WHERE l.stocktake_id = $1 AND l.product_id = c.product_id
+			AND s.id = l.stocktake_id AND s.status = $4
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, id, pq.Array(productIDs), pq.Array(quantities), models.StocktakeOpen)
+	if err != nil {
+		return fmt.Errorf("failed to record stocktake counts: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListVariances compares the counted lines with the stock the warehouse holds now, in the order of
+// their SKU. A product the warehouse no longer holds has a book quantity of 0.
+func (r *stocktakeRepository) ListVariances(ctx context.Context, id uuid.UUID) ([]models.StocktakeVariance, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT l.product_id, p.sku, p.name, COALESCE(s.quantity, 0), l.counted_quantity
+		FROM stocktake_lines l
+		JOIN stocktakes t ON t.id = l.stocktake_id
+		JOIN products p ON p.id = l.product_id
+		LEFT JOIN warehouse_stock s ON s.warehouse_id = t.warehouse_id AND s.product_id = l.product_id
+		WHERE l.stocktake_id = $1 AND l.counted_quantity IS NOT NULL
+		ORDER BY p.sku, l.product_id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stocktake variances: %w", err)
+	}
+	defer rows.Close()
+
+	variances := []models.StocktakeVariance{}
+
+	for rows.Next() {
+		var variance models.StocktakeVariance
+
+		if err := rows.Scan(&variance.ProductID, &variance.SKU, &variance.Name, &variance.BookQuantity, &variance.CountedQuantity); err != nil {
+			return nil, fmt.Errorf("failed to scan stocktake variance: %w", err)
+		}
+
+		variance.Variance = variance.CountedQuantity - variance.BookQuantity
+		variances = append(variances, variance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stocktake variances: %w", err)
+	}
+
+	return variances, nil
+}
+
+// ApplyStocktake sets the stock of the warehouse to the counts, moves the total stock of the products by
+// the same amounts, records the stock movements and the audit entries and closes the stocktake, in a
+// single transaction. A product whose warehouse stock is no longer its book quantity was changed
+// concurrently, e.g. by an order, and a stocktake no longer open was applied already; the whole
+// stocktake is then rolled back with sql.ErrNoRows.
+func (r *stocktakeRepository) ApplyStocktake(ctx context.Context, stocktake *models.Stocktake, adjustments []models.StocktakeAdjustment, movements []*models.StockMovement, entries []*models.AuditEntry) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(dbCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // no-op once committed
+
+	// a warehouse that no longer holds the product has no row, its book quantity of 0 inserts one
+	stockQuery := `
+		INSERT INTO warehouse_stock AS s (warehouse_id, product_id, quantity, updated_at)
+		SELECT $1, $2, $3, NOW() WHERE $4 = 0
+		ON CONFLICT (warehouse_id, product_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity, updated_at = NOW()
+		WHERE s.quantity = $4
+		RETURNING s.product_id
+	`
+
+	productQuery := withProductChange(`
+		UPDATE products SET stock_quantity = stock_quantity + $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2
+		RETURNING id, status, version, updated_at`,
+		"id")
+
+	for _, adjustment := range adjustments {
+		var id uuid.UUID
+
+		err := tx.QueryRowContext(dbCtx, stockQuery, stocktake.WarehouseID, adjustment.ProductID, adjustment.CountedQuantity, adjustment.BookQuantity).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("failed to adjust warehouse stock of product %s: %w", adjustment.ProductID, err)
+		}
+
+		if err := tx.QueryRowContext(dbCtx, productQuery, adjustment.CountedQuantity-adjustment.BookQuantity, adjustment.ProductID).Scan(&id); err != nil {
+			return fmt.Errorf("failed to adjust stock of product %s: %w", adjustment.ProductID, err)
+		}
+	}
+
+	movementQuery := `
+		INSERT INTO stock_movements (id, product_id, quantity, reason, reference_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`
+
+	for _, movement := range movements {
+		if _, err := tx.ExecContext(dbCtx, movementQuery, movement.ID, movement.ProductID, movement.Quantity, movement.Reason, movement.ReferenceID); err != nil {
+			return fmt.Errorf("failed to insert stock movement: %w", err)
+		}
+	}
+
+	auditQuery := `
+		INSERT INTO audit_log (id, user_id, actor_id, action, reference_id, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	`
+
+	for _, entry := range entries {
+		if _, err := tx.ExecContext(dbCtx, auditQuery, entry.ID, entry.UserID, entry.ActorID, entry.Action, entry.ReferenceID, []byte(entry.Details)); err != nil {
+			return fmt.Errorf("failed to record audit entry: %w", err)
+		}
+	}
+
+	applyQuery := `
+		UPDATE stocktakes SET status = $1, applied_by = $2, applied_at = NOW()
+		WHERE id = $3 AND status = $4
+		RETURNING applied_at
+	`
+
+	if err := tx.QueryRowContext(dbCtx, applyQuery, models.StocktakeApplied, stocktake.AppliedBy, stocktake.ID, models.StocktakeOpen).Scan(&stocktake.AppliedAt); err != nil {
+		return fmt.Errorf("failed to close stocktake: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit stocktake: %w", err)
+	}
+
+	stocktake.Status = models.StocktakeApplied
+
+	return nil
+}
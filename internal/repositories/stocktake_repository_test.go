@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStocktakeRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewStocktakeRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	warehouseID := uuid.New()
+	adminID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("CreateStocktake_Success", func(t *testing.T) {
+		// Arrange
+		stocktake := &models.Stocktake{ID: uuid.New(), WarehouseID: warehouseID, CreatedBy: adminID}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO stocktakes").
+			WithArgs(stocktake.ID, warehouseID, nil, models.StocktakeOpen, adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectExec("INSERT INTO stocktake_lines").
+			WithArgs(stocktake.ID, warehouseID, nil).
+			WillReturnResult(sqlmock.NewResult(0, 12))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.CreateStocktake(ctx, stocktake)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.StocktakeOpen, stocktake.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateStocktake_NothingToCount", func(t *testing.T) {
+		// Arrange
+		categoryID := uuid.New()
+		stocktake := &models.Stocktake{ID: uuid.New(), WarehouseID: warehouseID, CategoryID: &categoryID, CreatedBy: adminID}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO stocktakes").
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+		mock.ExpectExec("INSERT INTO stocktake_lines").
+			WithArgs(stocktake.ID, warehouseID, &categoryID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.CreateStocktake(ctx, stocktake)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SubmitCounts_AlreadyApplied", func(t *testing.T) {
+		// Arrange
+		stocktakeID := uuid.New()
+
+		mock.ExpectExec("UPDATE stocktake_lines l SET counted_quantity").
+			WithArgs(stocktakeID, pq.Array([]uuid.UUID{productID}), pq.Array([]int64{7}), models.StocktakeOpen).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.SubmitCounts(ctx, stocktakeID, []models.StocktakeCount{{ProductID: productID, Quantity: 7}})
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListVariances_Success", func(t *testing.T) {
+		// Arrange
+		stocktakeID := uuid.New()
+
+		mock.ExpectQuery("SELECT l.product_id, p.sku, p.name, COALESCE\\(s.quantity, 0\\), l.counted_quantity").
+			WithArgs(stocktakeID).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id", "sku", "name", "book", "counted"}).
+				AddRow(productID, "SKU-1", "Mug", 5, 3))
+
+		// Act
+		variances, err := repo.ListVariances(ctx, stocktakeID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.StocktakeVariance{{ProductID: productID, SKU: "SKU-1", Name: "Mug", BookQuantity: 5, CountedQuantity: 3, Variance: -2}}, variances)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ApplyStocktake_Success", func(t *testing.T) {
+		// Arrange
+		stocktake := &models.Stocktake{ID: uuid.New(), WarehouseID: warehouseID, Status: models.StocktakeOpen, AppliedBy: &adminID}
+		adjustments := []models.StocktakeAdjustment{{ProductID: productID, SKU: "SKU-1", BookQuantity: 5, CountedQuantity: 3, Reason: "Damaged"}}
+		movements := []*models.StockMovement{{ID: uuid.New(), ProductID: productID, Quantity: 2, Reason: models.StockMovementAdjustment, ReferenceID: &stocktake.ID}}
+		entries := []*models.AuditEntry{{ID: uuid.New(), UserID: adminID, Action: models.AuditActionStockAdjusted, ReferenceID: &productID, Details: []byte(`{"reason":"Damaged"}`)}}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO warehouse_stock AS s").
+			WithArgs(warehouseID, productID, 3, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id"}).AddRow(productID))
+		mock.ExpectQuery("UPDATE products SET stock_quantity = stock_quantity \\+ \\$1").
+			WithArgs(-2, productID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(productID))
+		mock.ExpectExec("INSERT INTO stock_movements").
+			WithArgs(movements[0].ID, productID, 2, models.StockMovementAdjustment, &stocktake.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO audit_log").
+			WithArgs(entries[0].ID, adminID, nil, models.AuditActionStockAdjusted, &productID, []byte(`{"reason":"Damaged"}`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("UPDATE stocktakes SET status").
+			WithArgs(models.StocktakeApplied, &adminID, stocktake.ID, models.StocktakeOpen).
+			WillReturnRows(sqlmock.NewRows([]string{"applied_at"}).AddRow(now))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.ApplyStocktake(ctx, stocktake, adjustments, movements, entries)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.StocktakeApplied, stocktake.Status)
+		assert.NotNil(t, stocktake.AppliedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ApplyStocktake_StockChanged", func(t *testing.T) {
+		// Arrange
+		stocktake := &models.Stocktake{ID: uuid.New(), WarehouseID: warehouseID, Status: models.StocktakeOpen, AppliedBy: &adminID}
+		adjustments := []models.StocktakeAdjustment{{ProductID: productID, BookQuantity: 5, CountedQuantity: 3, Reason: "Damaged"}}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO warehouse_stock AS s").
+			WithArgs(warehouseID, productID, 3, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id"}))
+		mock.ExpectRollback()
+
+		// Act
+		err := repo.ApplyStocktake(ctx, stocktake, adjustments, nil, nil)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, models.StocktakeOpen, stocktake.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
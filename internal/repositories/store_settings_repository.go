@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+type StoreSettingsRepository interface {
+	GetStoreSettings(ctx context.Context) (*models.StoreSettings, error)
+	SaveStoreSettings(ctx context.Context, settings *models.StoreSettings) error
+}
+
+type storeSettingsRepository struct {
+	DB *sql.DB
+}
+
+func NewStoreSettingsRepo(db *sql.DB) StoreSettingsRepository {
+	return &storeSettingsRepository{DB: db}
+}
+
+// GetStoreSettings returns the saved settings of the store, sql.ErrNoRows when none were saved yet.
+func (r *storeSettingsRepository) GetStoreSettings(ctx context.Context) (*models.StoreSettings, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT store_name, legal_entity, address, tax_ids, credit_note_series, default_currency, default_locale, default_timezone, updated_by, updated_at
+		FROM store_settings
+		WHERE id = 1
+	`
+
+	var (
+		settings models.StoreSettings
+		address  []byte
+		taxIDs   []byte
+	)
+
+	err := r.DB.QueryRowContext(dbCtx, query).Scan(&settings.StoreName, &settings.LegalEntity, &address, &taxIDs, &settings.CreditNoteSeries,
+		&settings.DefaultCurrency, &settings.DefaultLocale, &settings.DefaultTimezone, &settings.UpdatedBy, &settings.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store settings: %w", err)
+	}
+
+	if err := json.Unmarshal(address, &settings.Address); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal store address: %w", err)
+	}
+
+	if err := json.Unmarshal(taxIDs, &settings.TaxIDs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal store tax IDs: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// SaveStoreSettings replaces the settings of the store, the store has a single row of settings.
+func (r *storeSettingsRepository) SaveStoreSettings(ctx context.Context, settings *models.StoreSettings) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	address, err := json.Marshal(settings.Address)
+	if err != nil {
+		return fmt.Errorf("failed to marshal store address: %w", err)
+	}
+
+	taxIDs, err := json.Marshal(settings.TaxIDs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal store tax IDs: %w", err)
+	}
+
+	query := `
+		INSERT INTO store_settings (id, store_name, legal_entity, address, tax_ids, credit_note_series, default_currency, default_locale, default_timezone, updated_by, updated_at)
+		VALUES (1, $1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			store_name = EXCLUDED.store_name,
+			legal_entity = EXCLUDED.legal_entity,
+			address = EXCLUDED.address,
+			tax_ids = EXCLUDED.tax_ids,
+			credit_note_series = EXCLUDED.credit_note_series,
+			default_currency = EXCLUDED.default_currency,
+			default_locale = EXCLUDED.default_locale,
+			default_timezone = EXCLUDED.default_timezone,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+
+	err = r.DB.QueryRowContext(dbCtx, query, settings.StoreName, settings.LegalEntity, address, taxIDs, settings.CreditNoteSeries,
+		settings.DefaultCurrency, settings.DefaultLocale, settings.DefaultTimezone, settings.UpdatedBy).Scan(&settings.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save store settings: %w", err)
+	}
+
+	return nil
+}
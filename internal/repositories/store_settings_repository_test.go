@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreSettingsRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewStoreSettingsRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	adminID := uuid.New()
+	columns := []string{"store_name", "legal_entity", "address", "tax_ids", "credit_note_series", "default_currency", "default_locale", "default_timezone", "updated_by", "updated_at"}
+
+	t.Run("GetStoreSettings_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM store_settings").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("Acme", "Acme GmbH",
+				[]byte(`{"street":"1 Main St","city":"Berlin","state":"BE","postal_code":"10115","country":"DE"}`),
+				[]byte(`[{"type":"vat","value":"DE123456789"}]`), "CN", "eur", "de-DE", "Europe/Berlin", adminID, now))
+
+		// Act
+		settings, err := repo.GetStoreSettings(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Acme GmbH", settings.LegalEntity)
+		assert.Equal(t, "Berlin", settings.Address.City)
+		assert.Equal(t, []models.TaxID{{Type: "vat", Value: "DE123456789"}}, settings.TaxIDs)
+		assert.Equal(t, "Europe/Berlin", settings.DefaultTimezone)
+		assert.Equal(t, adminID, *settings.UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetStoreSettings_NotSaved", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM store_settings").WillReturnRows(sqlmock.NewRows(columns))
+
+		// Act
+		settings, err := repo.GetStoreSettings(ctx)
+
+		// Assert
+		assert.Nil(t, settings)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveStoreSettings_Success", func(t *testing.T) {
+		// Arrange
+		settings := &models.StoreSettings{
+			StoreName:        "Acme",
+			LegalEntity:      "Acme GmbH",
+			TaxIDs:           []models.TaxID{},
+			CreditNoteSeries: "CN",
+			DefaultCurrency:  "eur",
+			DefaultLocale:    "de-DE",
+			DefaultTimezone:  "Europe/Berlin",
+			UpdatedBy:        &adminID,
+		}
+
+		mock.ExpectQuery("INSERT INTO store_settings .* ON CONFLICT \\(id\\) DO UPDATE").
+			WithArgs("Acme", "Acme GmbH", []byte("null"), []byte("[]"), "CN", "eur", "de-DE", "Europe/Berlin", &adminID).
+			WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(now))
+
+		// Act
+		err := repo.SaveStoreSettings(ctx, settings)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, settings.UpdatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+type SupportSearchRepository interface {
+	Search(ctx context.Context, query *models.SupportSearchQuery, limit int) ([]models.SupportSearchMatch, error)
+}
+
+type supportSearchRepository struct {
+	DB *sql.DB
+}
+
+func NewSupportSearchRepo(db *sql.DB) SupportSearchRepository {
+	return &supportSearchRepository{DB: db}
+}
+
+// Search looks the query up in the customers, orders, payments and notifications in a single
+// statement, the latest first. The notifications sent to an address are one match.
+func (r *supportSearchRepository) Search(ctx context.Context, query *models.SupportSearchQuery, limit int) ([]models.SupportSearchMatch, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	statement := `
+		SELECT type, id, matched_on, customer_id, label, created_at
+		FROM (
+			SELECT $1 AS type, u.id::text AS id,
+				CASE WHEN u.id = $5 THEN 'user_id' ELSE 'email' END AS matched_on,
+				u.id AS customer_id, u.name || ' <' || u.email || '>' AS label, u.created_at
+			FROM users u
+			WHERE u.id = $5 OR LOWER(u.email) = LOWER(NULLIF($6, ''))
+			UNION ALL
+			SELECT $2, o.id::text,
+				CASE WHEN o.id = $5 THEN 'order_id' ELSE 'payment_intent_id' END,
+				o.customer_id, o.status || ', ' || o.total_amount, o.created_at
+			FROM orders o
+			WHERE o.id = $5 OR o.payment_intent_id = NULLIF($8, '')
+			UNION ALL
+			SELECT $3, p.id, 'payment_intent_id', NULL, p.status || ', ' || p.amount || ' ' || p.currency, p.created_at
+			FROM payments p
+			WHERE p.id = NULLIF($8, '') OR p.stripe_id = NULLIF($8, '')
+			UNION ALL
+			SELECT $4, MIN(n.recipient),
+				CASE WHEN n.type = $10 THEN 'phone' ELSE 'email' END,
+				NULL, COUNT(*) || ' ' || n.type || ' notifications', MAX(n.created_at)
+			FROM notifications n
+			WHERE (n.type = $9 AND LOWER(n.recipient) = LOWER(NULLIF($6, '')))
+				OR (n.type = $10 AND n.recipient = NULLIF($7, ''))
+			GROUP BY n.type, LOWER(n.recipient)
+		) matches
+		ORDER BY created_at DESC
+		LIMIT $11
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, statement,
+		models.SupportSearchCustomer, models.SupportSearchOrder, models.SupportSearchPayment, models.SupportSearchNotifications,
+		query.ID, query.Email, query.Phone, query.PaymentIntentID,
+		models.NotificationTypeEmail, models.NotificationTypeSMS, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	defer rows.Close()
+
+	matches := []models.SupportSearchMatch{}
+
+	for rows.Next() {
+		var match models.SupportSearchMatch
+		if err := rows.Scan(&match.Type, &match.ID, &match.MatchedOn, &match.CustomerID, &match.Label, &match.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan search match: %w", err)
+		}
+
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return matches, nil
+}
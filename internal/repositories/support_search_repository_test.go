@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportSearchRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewSupportSearchRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	columns := []string{"type", "id", "matched_on", "customer_id", "label", "created_at"}
+
+	t.Run("Search_Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		query := &models.SupportSearchQuery{ID: &id}
+
+		mock.ExpectQuery("SELECT type, id, matched_on, customer_id, label, created_at FROM \\(").
+			WithArgs(models.SupportSearchCustomer, models.SupportSearchOrder, models.SupportSearchPayment, models.SupportSearchNotifications,
+				&id, "", "", "", models.NotificationTypeEmail, models.NotificationTypeSMS, 50).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(models.SupportSearchOrder, id.String(), "order_id", uuid.New(), "pending, 42.5", now))
+
+		// Act
+		matches, err := repo.Search(ctx, query, 50)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		assert.Equal(t, models.SupportSearchOrder, matches[0].Type)
+		assert.Equal(t, "order_id", matches[0].MatchedOn)
+		assert.NotNil(t, matches[0].CustomerID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Search_PhoneNotifications", func(t *testing.T) {
+		// Arrange
+		query := &models.SupportSearchQuery{Phone: "+15550102030"}
+
+		mock.ExpectQuery("SELECT type, id, matched_on, customer_id, label, created_at FROM \\(").
+			WithArgs(models.SupportSearchCustomer, models.SupportSearchOrder, models.SupportSearchPayment, models.SupportSearchNotifications,
+				nil, "", "+15550102030", "", models.NotificationTypeEmail, models.NotificationTypeSMS, 50).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(models.SupportSearchNotifications, "+15550102030", "phone", nil, "3 sms notifications", now))
+
+		// Act
+		matches, err := repo.Search(ctx, query, 50)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		assert.Nil(t, matches[0].CustomerID)
+		assert.Equal(t, "+15550102030", matches[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Search_Error", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT type, id, matched_on").WillReturnError(errors.New("connection refused"))
+
+		// Act
+		matches, err := repo.Search(ctx, &models.SupportSearchQuery{Email: "jane@example.com"}, 50)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, matches)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
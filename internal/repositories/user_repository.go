@@ -15,6 +15,8 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *models.User) error
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	UpdateUserRole(ctx context.Context, id uuid.UUID, role models.UserRole) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, password string) error
 }
 
 type userRepository struct {
@@ -42,11 +44,11 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 	defer cancel()
 
 	user := &models.User{} // user holds the address of the new instance of new User models
-	query := `SELECT id, email, password, name, created_at, updated_at
+	query := `SELECT id, email, password, name, role, created_at, updated_at
 			  FROM users 
 			  WHERE email = $1`
 
-	err := r.DB.QueryRowContext(dbCtx, query, email).Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.CreatedAt, &user.UpdatedAt)
+	err := r.DB.QueryRowContext(dbCtx, query, email).Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.Role, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, err
@@ -64,12 +66,12 @@ func (r *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models
 	user := &models.User{}
 
 	query := `
-	SELECT id, email, name, created_at, updated_at
+	SELECT id, email, name, role, created_at, updated_at
 	FROM users
 	WHERE id = $1
 	`
 
-	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt, &user.UpdatedAt)
+	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&user.ID, &user.Email, &user.Name, &user.Role, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.New("user not found")
@@ -80,3 +82,47 @@ func (r *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models
 
 	return user, nil
 }
+
+func (r *userRepository) UpdateUserRole(ctx context.Context, id uuid.UUID, role models.UserRole) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.DB.ExecContext(dbCtx, `UPDATE users SET role = $1, updated_at = NOW() WHERE id = $2`, role, id)
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// UpdatePassword stores the hash of the new password, it returns sql.ErrNoRows when the user does not
+// exist.
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, password string) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.DB.ExecContext(dbCtx, `UPDATE users SET password = $1, updated_at = NOW() WHERE id = $2`, password, id)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -100,17 +100,18 @@ func TestUserRepository(t *testing.T) {
 			Email:     email,
 			Password:  "hashedpassword",
 			Name:      "Found User",
+			Role:      models.RoleCustomer,
 			CreatedAt: time.Now().Add(-time.Hour),
 			UpdatedAt: time.Now(),
 		}
 
-		expectedSQL := regexp.QuoteMeta(`SELECT id, email, password, name, created_at, updated_at
+		expectedSQL := regexp.QuoteMeta(`SELECT id, email, password, name, role, created_at, updated_at
               FROM users 
               WHERE email = $1`)
 
 		// Mock the database call for successful retrieval
-		rows := sqlmock.NewRows([]string{"id", "email", "password", "name", "created_at", "updated_at"}).
-			AddRow(expectedUser.ID, expectedUser.Email, expectedUser.Password, expectedUser.Name, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+		rows := sqlmock.NewRows([]string{"id", "email", "password", "name", "role", "created_at", "updated_at"}).
+			AddRow(expectedUser.ID, expectedUser.Email, expectedUser.Password, expectedUser.Name, expectedUser.Role, expectedUser.CreatedAt, expectedUser.UpdatedAt)
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(email).
 			WillReturnRows(rows)
@@ -128,7 +129,7 @@ func TestUserRepository(t *testing.T) {
 		// Arrange
 		email := "notfound@example.com"
 
-		expectedSQL := regexp.QuoteMeta(`SELECT id, email, password, name, created_at, updated_at
+		expectedSQL := regexp.QuoteMeta(`SELECT id, email, password, name, role, created_at, updated_at
               FROM users 
               WHERE email = $1`)
 
@@ -150,7 +151,7 @@ func TestUserRepository(t *testing.T) {
 	t.Run("GetUserByEmail_ScanError", func(t *testing.T) {
 		// Arrange
 		email := "scanerror@example.com"
-		expectedSQL := regexp.QuoteMeta(`SELECT id, email, password, name, created_at, updated_at
+		expectedSQL := regexp.QuoteMeta(`SELECT id, email, password, name, role, created_at, updated_at
               FROM users 
               WHERE email = $1`)
 
@@ -178,19 +179,20 @@ func TestUserRepository(t *testing.T) {
 			ID:        userID,
 			Email:     "byid@example.com",
 			Name:      "User By ID",
+			Role:      models.RoleAdmin,
 			CreatedAt: time.Now().Add(-2 * time.Hour),
 			UpdatedAt: time.Now().Add(-time.Minute),
 		}
 
 		expectedSQL := regexp.QuoteMeta(`
-			SELECT id, email, name, created_at, updated_at
+			SELECT id, email, name, role, created_at, updated_at
 			FROM users
 			WHERE id = $1
 		`)
 
 		// Mock the database call for successful retrieval
-		rows := sqlmock.NewRows([]string{"id", "email", "name", "created_at", "updated_at"}).
-			AddRow(expectedUser.ID, expectedUser.Email, expectedUser.Name, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+		rows := sqlmock.NewRows([]string{"id", "email", "name", "role", "created_at", "updated_at"}).
+			AddRow(expectedUser.ID, expectedUser.Email, expectedUser.Name, expectedUser.Role, expectedUser.CreatedAt, expectedUser.UpdatedAt)
 		mock.ExpectQuery(expectedSQL).
 			WithArgs(userID).
 			WillReturnRows(rows)
@@ -214,7 +216,7 @@ func TestUserRepository(t *testing.T) {
 		userID := uuid.New()
 
 		expectedSQL := regexp.QuoteMeta(`
-			SELECT id, email, name, created_at, updated_at
+			SELECT id, email, name, role, created_at, updated_at
 			FROM users
 			WHERE id = $1
 		`)
@@ -240,7 +242,7 @@ func TestUserRepository(t *testing.T) {
 		scanError := errors.New("some other db error")
 
 		expectedSQL := regexp.QuoteMeta(`
-			SELECT id, email, name, created_at, updated_at
+			SELECT id, email, name, role, created_at, updated_at
 			FROM users
 			WHERE id = $1
 		`)
@@ -259,4 +261,52 @@ func TestUserRepository(t *testing.T) {
 		assert.Nil(t, user, "Returned user should be nil on error")
 		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
 	})
+
+	t.Run("UpdateUserRole_NotFound", func(t *testing.T) {
+		// Arrange
+		userID := uuid.New()
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET role = $1`)).
+			WithArgs(models.RoleVendor, userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.UpdateUserRole(ctx, userID, models.RoleVendor)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("UpdatePassword_Success", func(t *testing.T) {
+		// Arrange
+		userID := uuid.New()
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET password = $1, updated_at = NOW() WHERE id = $2`)).
+			WithArgs("new-hash", userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.UpdatePassword(ctx, userID, "new-hash")
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
+
+	t.Run("UpdatePassword_NotFound", func(t *testing.T) {
+		// Arrange
+		userID := uuid.New()
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET password = $1`)).
+			WithArgs("new-hash", userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.UpdatePassword(ctx, userID, "new-hash")
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet(), "SQL mock expectations were not met")
+	})
 }
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// velocityKeyPrefix keeps the order counters apart from the other keys of the Redis instance.
+const velocityKeyPrefix = "order_velocity:"
+
+// VelocityRepository counts the recent orders of a customer, an IP or a card, shared by the instances.
+// Every counter is a sorted set of order IDs scored by when they were placed, so an order counted twice,
+// like a card retried on the same order, is only counted once.
+type VelocityRepository interface {
+	// CountOrders returns the orders placed since, leaving out orderID.
+	CountOrders(ctx context.Context, key models.VelocityKey, value string, since time.Time, orderID uuid.UUID) (int, error)
+	// RecordOrder counts the order, the orders older than window are dropped.
+	RecordOrder(ctx context.Context, key models.VelocityKey, value string, orderID uuid.UUID, at time.Time, window time.Duration) error
+}
+
+type velocityRepository struct {
+	client *redis.Client
+}
+
+func NewVelocityRepo(client *redis.Client) VelocityRepository {
+	return &velocityRepository{client: client}
+}
+
+func velocityKey(key models.VelocityKey, value string) string {
+	return velocityKeyPrefix + string(key) + ":" + value
+}
+
+func (r *velocityRepository) CountOrders(ctx context.Context, key models.VelocityKey, value string, since time.Time, orderID uuid.UUID) (int, error) {
+	redisKey := velocityKey(key, value)
+
+	pipe := r.client.Pipeline()
+	count := pipe.ZCount(ctx, redisKey, strconv.FormatInt(since.UnixMilli(), 10), "+inf")
+	counted := pipe.ZScore(ctx, redisKey, orderID.String())
+
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	orders := int(count.Val())
+
+	if score, err := counted.Result(); err == nil && int64(score) >= since.UnixMilli() {
+		orders--
+	}
+
+	return orders, nil
+}
+
+func (r *velocityRepository) RecordOrder(ctx context.Context, key models.VelocityKey, value string, orderID uuid.UUID, at time.Time, window time.Duration) error {
+	redisKey := velocityKey(key, value)
+
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, redisKey, redis.Z{Score: float64(at.UnixMilli()), Member: orderID.String()})
+	pipe.ZRemRangeByScore(ctx, redisKey, "-inf", "("+strconv.FormatInt(at.Add(-window).UnixMilli(), 10))
+	pipe.Expire(ctx, redisKey, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record order: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,714 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type VendorRepository interface {
+	CreateVendor(ctx context.Context, vendor *models.Vendor) error
+	GetVendorByID(ctx context.Context, id uuid.UUID) (*models.Vendor, error)
+	GetVendorByUserID(ctx context.Context, userID uuid.UUID) (*models.Vendor, error)
+	ListVendors(ctx context.Context, status models.VendorStatus) ([]*models.Vendor, error)
+	UpdateVendor(ctx context.Context, vendor *models.Vendor) error
+	CreateVendorOrder(ctx context.Context, vendorOrder *models.VendorOrder) error
+	ListVendorOrders(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorOrder, int, error)
+	GetVendorSales(ctx context.Context, from, to time.Time) ([]models.VendorSales, error)
+	SavePayout(ctx context.Context, payout *models.VendorPayout) error
+	ListPayoutsByVendor(ctx context.Context, vendorID uuid.UUID) ([]*models.VendorPayout, error)
+	ListPayoutsByPeriod(ctx context.Context, from, to time.Time) ([]*models.VendorPayout, error)
+	AccrueEarnings(ctx context.Context) (int64, error)
+	AddLedgerEntry(ctx context.Context, entry *models.VendorLedgerEntry) error
+	ListLedgerEntries(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorLedgerEntry, int, error)
+	GetLedgerTotals(ctx context.Context, vendorID uuid.UUID, from, to time.Time) ([]models.VendorLedgerTotal, error)
+	GetVendorBalance(ctx context.Context, vendorID uuid.UUID) (float64, error)
+	ListPayableBalances(ctx context.Context, minimum float64) ([]models.VendorBalance, error)
+	CreatePayoutBatch(ctx context.Context, batch *models.PayoutBatch) error
+	AddPayoutBatchItem(ctx context.Context, item *models.PayoutBatchItem) error
+	ListUnrecordedPayouts(ctx context.Context) ([]models.PayoutBatchItem, error)
+	CompletePayoutBatch(ctx context.Context, batch *models.PayoutBatch) error
+	GetPayoutBatch(ctx context.Context, id uuid.UUID) (*models.PayoutBatch, error)
+	GetVendorDashboard(ctx context.Context, vendorID uuid.UUID, since time.Time, lowStock int) (*models.VendorDashboard, error)
+}
+
+type vendorRepository struct {
+	DB *sql.DB
+}
+
+func NewVendorRepo(db *sql.DB) VendorRepository {
+	return &vendorRepository{DB: db}
+}
+
+func (r *vendorRepository) CreateVendor(ctx context.Context, vendor *models.Vendor) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO vendors (id, user_id, name, contact_email, commission_rate, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, vendor.ID, vendor.UserID, vendor.Name, vendor.ContactEmail, vendor.CommissionRate, vendor.Status).Scan(&vendor.CreatedAt, &vendor.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert vendor: %w", err)
+	}
+
+	return nil
+}
+
+func (r *vendorRepository) GetVendorByID(ctx context.Context, id uuid.UUID) (*models.Vendor, error) {
+	return r.getVendor(ctx, "id", id)
+}
+
+func (r *vendorRepository) GetVendorByUserID(ctx context.Context, userID uuid.UUID) (*models.Vendor, error) {
+	return r.getVendor(ctx, "user_id", userID)
+}
+
+func (r *vendorRepository) getVendor(ctx context.Context, column string, value uuid.UUID) (*models.Vendor, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, name, contact_email, commission_rate, status, COALESCE(stripe_account_id, ''), created_at, updated_at
+		FROM vendors
+		WHERE ` + column + ` = $1`
+
+	vendor := &models.Vendor{}
+
+	err := r.DB.QueryRowContext(dbCtx, query, value).Scan(&vendor.ID, &vendor.UserID, &vendor.Name, &vendor.ContactEmail, &vendor.CommissionRate, &vendor.Status, &vendor.StripeAccountID, &vendor.CreatedAt, &vendor.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+
+	return vendor, nil
+}
+
+// ListVendors returns the vendors in the given status, or all of them when status is empty.
+func (r *vendorRepository) ListVendors(ctx context.Context, status models.VendorStatus) ([]*models.Vendor, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, name, contact_email, commission_rate, status, COALESCE(stripe_account_id, ''), created_at, updated_at
+		FROM vendors
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vendors: %w", err)
+	}
+	defer rows.Close()
+
+	var vendors []*models.Vendor
+
+	for rows.Next() {
+		vendor := &models.Vendor{}
+
+		if err := rows.Scan(&vendor.ID, &vendor.UserID, &vendor.Name, &vendor.ContactEmail, &vendor.CommissionRate, &vendor.Status, &vendor.StripeAccountID, &vendor.CreatedAt, &vendor.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan vendor: %w", err)
+		}
+
+		vendors = append(vendors, vendor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vendors: %w", err)
+	}
+
+	return vendors, nil
+}
+
+func (r *vendorRepository) UpdateVendor(ctx context.Context, vendor *models.Vendor) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE vendors SET status = $1, commission_rate = $2, stripe_account_id = NULLIF($3, ''), updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, vendor.Status, vendor.CommissionRate, vendor.StripeAccountID, vendor.ID).Scan(&vendor.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to update vendor: %w", err)
+	}
+
+	return nil
+}
+
+func (r *vendorRepository) CreateVendorOrder(ctx context.Context, vendorOrder *models.VendorOrder) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO vendor_orders (id, order_id, vendor_id, subtotal, commission_rate, commission, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.DB.ExecContext(dbCtx, query, vendorOrder.ID, vendorOrder.OrderID, vendorOrder.VendorID, vendorOrder.Subtotal,
+		vendorOrder.CommissionRate, vendorOrder.Commission, vendorOrder.Status, vendorOrder.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert vendor order: %w", err)
+	}
+
+	return nil
+}
+
+// ListVendorOrders returns a page of the vendor's sub-orders, newest first, with the items of the vendor's products.
+func (r *vendorRepository) ListVendorOrders(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorOrder, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM vendor_orders WHERE vendor_id = $1`, vendorID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count vendor orders: %w", err)
+	}
+
+	query := `
+		SELECT id, order_id, vendor_id, subtotal, commission_rate, commission, status, created_at
+		FROM vendor_orders
+		WHERE vendor_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, vendorID, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vendor orders: %w", err)
+	}
+	defer rows.Close()
+
+	var vendorOrders []*models.VendorOrder
+
+	byOrderID := make(map[uuid.UUID]*models.VendorOrder)
+	orderIDs := make([]string, 0, size)
+
+	for rows.Next() {
+		vendorOrder := &models.VendorOrder{Items: []models.OrderItem{}}
+
+		if err := rows.Scan(&vendorOrder.ID, &vendorOrder.OrderID, &vendorOrder.VendorID, &vendorOrder.Subtotal, &vendorOrder.CommissionRate, &vendorOrder.Commission, &vendorOrder.Status, &vendorOrder.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan vendor order: %w", err)
+		}
+
+		vendorOrders = append(vendorOrders, vendorOrder)
+		byOrderID[vendorOrder.OrderID] = vendorOrder
+		orderIDs = append(orderIDs, vendorOrder.OrderID.String())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating vendor orders: %w", err)
+	}
+
+	if len(vendorOrders) == 0 {
+		return vendorOrders, total, nil
+	}
+
+	// The items of the page are fetched at once, only the vendor's products belong to its sub-orders
+	itemsQuery := `
+		SELECT i.id, i.order_id, i.product_id, i.quantity, i.unit_price, i.is_gift, i.created_at
+		FROM order_items i
+		JOIN products p ON p.id = i.product_id
+		WHERE i.order_id = ANY($1) AND p.vendor_id = $2
+		ORDER BY i.created_at, i.id
+	`
+
+	itemRows, err := r.DB.QueryContext(dbCtx, itemsQuery, pq.Array(orderIDs), vendorID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vendor order items: %w", err)
+	}
+	defer itemRows.Close()
+
+	for itemRows.Next() {
+		var item models.OrderItem
+
+		if err := itemRows.Scan(&item.ID, &item.OrderID, &item.ProductID, &item.Quantity, &item.UnitPrice, &item.IsGift, &item.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan vendor order item: %w", err)
+		}
+
+		if vendorOrder, ok := byOrderID[item.OrderID]; ok {
+			vendorOrder.Items = append(vendorOrder.Items, item)
+		}
+	}
+
+	if err := itemRows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating vendor order items: %w", err)
+	}
+
+	return vendorOrders, total, nil
+}
+
+// GetVendorSales sums the sub-orders created in [from, to) per vendor, only counting orders that were paid.
+func (r *vendorRepository) GetVendorSales(ctx context.Context, from, to time.Time) ([]models.VendorSales, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT vo.vendor_id, COALESCE(SUM(vo.subtotal::numeric), 0), COALESCE(SUM(vo.commission::numeric), 0), COUNT(*)
+		FROM vendor_orders vo
+		JOIN orders o ON o.id = vo.order_id
+		WHERE o.payment_status = $1 AND vo.created_at >= $2 AND vo.created_at < $3
+		GROUP BY vo.vendor_id
+		ORDER BY vo.vendor_id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, models.PaymentStatusSucceeded, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor sales: %w", err)
+	}
+	defer rows.Close()
+
+	var sales []models.VendorSales
+
+	for rows.Next() {
+		var entry models.VendorSales
+
+		if err := rows.Scan(&entry.VendorID, utils.NumericAmount(&entry.GrossSales), utils.NumericAmount(&entry.Commission), &entry.OrderCount); err != nil {
+			return nil, fmt.Errorf("failed to scan vendor sales: %w", err)
+		}
+
+		sales = append(sales, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vendor sales: %w", err)
+	}
+
+	return sales, nil
+}
+
+// SavePayout stores the payout of a vendor for a period, recalculating a period overwrites its payout.
+func (r *vendorRepository) SavePayout(ctx context.Context, payout *models.VendorPayout) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO vendor_payouts (id, vendor_id, period_start, period_end, order_count, gross_sales, commission, net_amount, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT (vendor_id, period_start, period_end) DO UPDATE
+		SET order_count = EXCLUDED.order_count, gross_sales = EXCLUDED.gross_sales,
+		    commission = EXCLUDED.commission, net_amount = EXCLUDED.net_amount
+		RETURNING id, created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, payout.ID, payout.VendorID, payout.PeriodStart, payout.PeriodEnd, payout.OrderCount, payout.GrossSales, payout.Commission, payout.NetAmount).Scan(&payout.ID, &payout.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save vendor payout: %w", err)
+	}
+
+	return nil
+}
+
+func (r *vendorRepository) ListPayoutsByVendor(ctx context.Context, vendorID uuid.UUID) ([]*models.VendorPayout, error) {
+	query := `
+		SELECT id, vendor_id, period_start, period_end, order_count, gross_sales, commission, net_amount, created_at
+		FROM vendor_payouts
+		WHERE vendor_id = $1
+		ORDER BY period_start DESC
+	`
+
+	return r.listPayouts(ctx, query, vendorID)
+}
+
+// ListPayoutsByPeriod returns the payouts of the periods starting in [from, to).
+func (r *vendorRepository) ListPayoutsByPeriod(ctx context.Context, from, to time.Time) ([]*models.VendorPayout, error) {
+	query := `
+		SELECT id, vendor_id, period_start, period_end, order_count, gross_sales, commission, net_amount, created_at
+		FROM vendor_payouts
+		WHERE period_start >= $1 AND period_start < $2
+		ORDER BY period_start, vendor_id
+	`
+
+	return r.listPayouts(ctx, query, from, to)
+}
+
+func (r *vendorRepository) listPayouts(ctx context.Context, query string, args ...any) ([]*models.VendorPayout, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.DB.QueryContext(dbCtx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vendor payouts: %w", err)
+	}
+	defer rows.Close()
+
+	payouts := []*models.VendorPayout{}
+
+	for rows.Next() {
+		payout := &models.VendorPayout{}
+
+		if err := rows.Scan(&payout.ID, &payout.VendorID, &payout.PeriodStart, &payout.PeriodEnd, &payout.OrderCount, &payout.GrossSales, &payout.Commission, &payout.NetAmount, &payout.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan vendor payout: %w", err)
+		}
+
+		payouts = append(payouts, payout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vendor payouts: %w", err)
+	}
+
+	return payouts, nil
+}
+
+// AccrueEarnings posts the sale and the commission of every sub-order whose order got paid and that is not in the
+// ledger yet, returning the number of entries created. The unique (vendor_order_id, type) index makes it idempotent.
+func (r *vendorRepository) AccrueEarnings(ctx context.Context) (int64, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO vendor_ledger_entries (id, vendor_id, type, amount, vendor_order_id, created_at)
+		SELECT gen_random_uuid(), vo.vendor_id, t.type,
+		       CASE WHEN t.type = $1 THEN vo.subtotal ELSE -vo.commission END, vo.id, NOW()
+		FROM vendor_orders vo
+		JOIN orders o ON o.id = vo.order_id
+		CROSS JOIN (VALUES ($1), ($2)) AS t(type)
+		WHERE o.payment_status = $3
+		ON CONFLICT (vendor_order_id, type) DO NOTHING
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, models.VendorLedgerSale, models.VendorLedgerCommission, models.PaymentStatusSucceeded)
+	if err != nil {
+		return 0, fmt.Errorf("failed to accrue vendor earnings: %w", err)
+	}
+
+	created, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get accrued entries: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *vendorRepository) AddLedgerEntry(ctx context.Context, entry *models.VendorLedgerEntry) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO vendor_ledger_entries (id, vendor_id, type, amount, vendor_order_id, payout_batch_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, entry.ID, entry.VendorID, entry.Type, entry.Amount, entry.VendorOrderID, entry.PayoutBatchID).Scan(&entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert vendor ledger entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListLedgerEntries returns a page of the vendor's ledger, newest first.
+func (r *vendorRepository) ListLedgerEntries(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorLedgerEntry, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int
+
+	if err := r.DB.QueryRowContext(dbCtx, `SELECT COUNT(*) FROM vendor_ledger_entries WHERE vendor_id = $1`, vendorID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count vendor ledger entries: %w", err)
+	}
+
+	query := `
+		SELECT id, vendor_id, type, amount, vendor_order_id, payout_batch_id, created_at
+		FROM vendor_ledger_entries
+		WHERE vendor_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, vendorID, size, (page-1)*size)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vendor ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.VendorLedgerEntry{}
+
+	for rows.Next() {
+		entry := &models.VendorLedgerEntry{}
+
+		if err := rows.Scan(&entry.ID, &entry.VendorID, &entry.Type, &entry.Amount, &entry.VendorOrderID, &entry.PayoutBatchID, &entry.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan vendor ledger entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating vendor ledger entries: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+// GetLedgerTotals sums the vendor's ledger entries posted in [from, to) per type.
+func (r *vendorRepository) GetLedgerTotals(ctx context.Context, vendorID uuid.UUID, from, to time.Time) ([]models.VendorLedgerTotal, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT type, COALESCE(SUM(amount::numeric), 0), COUNT(*)
+		FROM vendor_ledger_entries
+		WHERE vendor_id = $1 AND created_at >= $2 AND created_at < $3
+		GROUP BY type
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, vendorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor ledger totals: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []models.VendorLedgerTotal
+
+	for rows.Next() {
+		var total models.VendorLedgerTotal
+
+		if err := rows.Scan(&total.Type, utils.NumericAmount(&total.Amount), &total.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan vendor ledger total: %w", err)
+		}
+
+		totals = append(totals, total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vendor ledger totals: %w", err)
+	}
+
+	return totals, nil
+}
+
+func (r *vendorRepository) GetVendorBalance(ctx context.Context, vendorID uuid.UUID) (float64, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	var balance float64
+
+	query := `SELECT COALESCE(SUM(amount::numeric), 0) FROM vendor_ledger_entries WHERE vendor_id = $1`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, vendorID).Scan(utils.NumericAmount(&balance)); err != nil {
+		return 0, fmt.Errorf("failed to get vendor balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// ListPayableBalances returns the vendors owed at least minimum that have a Stripe account to transfer to.
+func (r *vendorRepository) ListPayableBalances(ctx context.Context, minimum float64) ([]models.VendorBalance, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT v.id, v.stripe_account_id, SUM(l.amount::numeric)
+		FROM vendors v
+		JOIN vendor_ledger_entries l ON l.vendor_id = v.id
+		WHERE v.stripe_account_id IS NOT NULL
+		GROUP BY v.id, v.stripe_account_id
+		HAVING SUM(l.amount::numeric) >= $1
+		ORDER BY v.id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, minimum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vendor balances: %w", err)
+	}
+	defer rows.Close()
+
+	var balances []models.VendorBalance
+
+	for rows.Next() {
+		var balance models.VendorBalance
+
+		if err := rows.Scan(&balance.VendorID, &balance.StripeAccountID, utils.NumericAmount(&balance.Balance)); err != nil {
+			return nil, fmt.Errorf("failed to scan vendor balance: %w", err)
+		}
+
+		balances = append(balances, balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vendor balances: %w", err)
+	}
+
+	return balances, nil
+}
+
+// CreatePayoutBatch starts a processing batch. It returns sql.ErrNoRows when another batch is still
+// processing, so two runs never pay the same balance.
+func (r *vendorRepository) CreatePayoutBatch(ctx context.Context, batch *models.PayoutBatch) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO payout_batches (id, status, total_amount, created_at)
+		SELECT $1, $2, 0, NOW()
+		WHERE NOT EXISTS (SELECT 1 FROM payout_batches WHERE status = $2)
+		RETURNING created_at
+	`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, batch.ID, models.PayoutBatchStatusProcessing).Scan(&batch.CreatedAt); err != nil {
+		return fmt.Errorf("failed to create payout batch: %w", err)
+	}
+
+	batch.Status = models.PayoutBatchStatusProcessing
+
+	return nil
+}
+
+func (r *vendorRepository) AddPayoutBatchItem(ctx context.Context, item *models.PayoutBatchItem) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO payout_batch_items (id, batch_id, vendor_id, amount, status, transfer_id, error, created_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), NOW())
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, item.ID, item.BatchID, item.VendorID, item.Amount, item.Status, item.TransferID, item.Error).Scan(&item.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert payout batch item: %w", err)
+	}
+
+	return nil
+}
+
+// ListUnrecordedPayouts returns the paid payout batch items the ledger has no transaction for, the ones
+// whose ledger write failed after their transfer went out.
+func (r *vendorRepository) ListUnrecordedPayouts(ctx context.Context) ([]models.PayoutBatchItem, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT i.id, i.batch_id, i.vendor_id, i.amount, i.status, COALESCE(i.transfer_id, ''), i.created_at
+		FROM payout_batch_items i
+		WHERE i.status = $1
+		AND NOT EXISTS (
+			SELECT 1 FROM ledger_transactions t WHERE t.type = $2 AND t.source_id = i.id::text
+		)
+		ORDER BY i.created_at, i.id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, models.PayoutBatchItemPaid, models.LedgerTransactionVendorPayout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unrecorded payouts: %w", err)
+	}
+	defer rows.Close()
+
+	var items []models.PayoutBatchItem
+
+	for rows.Next() {
+		var item models.PayoutBatchItem
+
+		if err := rows.Scan(&item.ID, &item.BatchID, &item.VendorID, &item.Amount, &item.Status, &item.TransferID, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan payout batch item: %w", err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating payout batch items: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *vendorRepository) CompletePayoutBatch(ctx context.Context, batch *models.PayoutBatch) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payout_batches SET status = $1, total_amount = $2, completed_at = NOW()
+		WHERE id = $3
+		RETURNING completed_at
+	`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, batch.Status, batch.TotalAmount, batch.ID).Scan(&batch.CompletedAt); err != nil {
+		return fmt.Errorf("failed to complete payout batch: %w", err)
+	}
+
+	return nil
+}
+
+func (r *vendorRepository) GetPayoutBatch(ctx context.Context, id uuid.UUID) (*models.PayoutBatch, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	batch := &models.PayoutBatch{Items: []models.PayoutBatchItem{}}
+
+	query := `SELECT id, status, total_amount, created_at, completed_at FROM payout_batches WHERE id = $1`
+
+	if err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&batch.ID, &batch.Status, &batch.TotalAmount, &batch.CreatedAt, &batch.CompletedAt); err != nil {
+		return nil, fmt.Errorf("failed to get payout batch: %w", err)
+	}
+
+	itemsQuery := `
+		SELECT id, batch_id, vendor_id, amount, status, COALESCE(transfer_id, ''), COALESCE(error, ''), created_at
+		FROM payout_batch_items
+		WHERE batch_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, itemsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payout batch items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item models.PayoutBatchItem
+
+		if err := rows.Scan(&item.ID, &item.BatchID, &item.VendorID, &item.Amount, &item.Status, &item.TransferID, &item.Error, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan payout batch item: %w", err)
+		}
+
+		batch.Items = append(batch.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating payout batch items: %w", err)
+	}
+
+	return batch, nil
+}
+
+// GetVendorDashboard counts the vendor's products and sub-orders, every figure is filtered on the vendor.
+// Products with at most lowStock units left are reported as low on stock.
+func (r *vendorRepository) GetVendorDashboard(ctx context.Context, vendorID uuid.UUID, since time.Time, lowStock int) (*models.VendorDashboard, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM products WHERE vendor_id = $1),
+			(SELECT COUNT(*) FROM products WHERE vendor_id = $1 AND status = 'active'),
+			(SELECT COUNT(*) FROM products WHERE vendor_id = $1 AND status = 'active' AND stock_quantity <= $3),
+			(SELECT COUNT(*) FROM vendor_orders WHERE vendor_id = $1 AND status = $4),
+			(SELECT COUNT(*) FROM vendor_orders WHERE vendor_id = $1 AND created_at >= $2),
+			(SELECT COALESCE(SUM(subtotal::numeric), 0) FROM vendor_orders WHERE vendor_id = $1 AND created_at >= $2),
+			(SELECT COALESCE(SUM(amount::numeric), 0) FROM vendor_ledger_entries WHERE vendor_id = $1)
+	`
+
+	dashboard := &models.VendorDashboard{VendorID: vendorID, Since: since}
+
+	err := r.DB.QueryRowContext(dbCtx, query, vendorID, since, lowStock, models.OrderStatusPending).Scan(
+		&dashboard.Products, &dashboard.ActiveProducts, &dashboard.LowStockProducts, &dashboard.PendingOrders,
+		&dashboard.RecentOrders, utils.NumericAmount(&dashboard.RecentSales), utils.NumericAmount(&dashboard.Balance))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor dashboard: %w", err)
+	}
+
+	return dashboard, nil
+}
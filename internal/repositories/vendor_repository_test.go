@@ -0,0 +1,332 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVendorRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewVendorRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	vendorID := uuid.New()
+	userID := uuid.New()
+	vendorColumns := []string{"id", "user_id", "name", "contact_email", "commission_rate", "status", "stripe_account_id", "created_at", "updated_at"}
+
+	t.Run("CreateVendor_Success", func(t *testing.T) {
+		// Arrange
+		vendor := &models.Vendor{ID: vendorID, UserID: userID, Name: "Acme", ContactEmail: "shop@acme.test", CommissionRate: 0.1, Status: models.VendorStatusPending}
+
+		mock.ExpectQuery("INSERT INTO vendors").
+			WithArgs(vendorID, userID, "Acme", "shop@acme.test", 0.1, models.VendorStatusPending).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+
+		// Act
+		err := repo.CreateVendor(ctx, vendor)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, vendor.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetVendorByUserID_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM vendors\\s+WHERE user_id = \\$1").
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(vendorColumns).
+				AddRow(vendorID, userID, "Acme", "shop@acme.test", 0.1, models.VendorStatusApproved, "acct_123", now, now))
+
+		// Act
+		vendor, err := repo.GetVendorByUserID(ctx, userID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, vendorID, vendor.ID)
+		assert.Equal(t, models.VendorStatusApproved, vendor.Status)
+		assert.Equal(t, "acct_123", vendor.StripeAccountID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetVendorByID_NotFound", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM vendors\\s+WHERE id = \\$1").
+			WithArgs(vendorID).
+			WillReturnError(sql.ErrNoRows)
+
+		// Act
+		vendor, err := repo.GetVendorByID(ctx, vendorID)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, vendor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListVendors_ByStatus", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM vendors").
+			WithArgs(models.VendorStatusPending).
+			WillReturnRows(sqlmock.NewRows(vendorColumns).
+				AddRow(vendorID, userID, "Acme", "shop@acme.test", 0.1, models.VendorStatusPending, "", now, now))
+
+		// Act
+		vendors, err := repo.ListVendors(ctx, models.VendorStatusPending)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, vendors, 1)
+		assert.Equal(t, "Acme", vendors[0].Name)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListVendorOrders_WithItems", func(t *testing.T) {
+		// Arrange
+		vendorOrderID := uuid.New()
+		orderID := uuid.New()
+		itemID := uuid.New()
+		productID := uuid.New()
+
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM vendor_orders").
+			WithArgs(vendorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("FROM vendor_orders\\s+WHERE vendor_id = \\$1").
+			WithArgs(vendorID, 10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "vendor_id", "subtotal", "commission_rate", "commission", "status", "created_at"}).
+				AddRow(vendorOrderID, orderID, vendorID, 40.0, 0.1, 4.0, models.OrderStatusPending, now))
+		mock.ExpectQuery("FROM order_items i\\s+JOIN products p").
+			WithArgs(pq.Array([]string{orderID.String()}), vendorID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "product_id", "quantity", "unit_price", "is_gift", "created_at"}).
+				AddRow(itemID, orderID, productID, 2, 20.0, false, now))
+
+		// Act
+		vendorOrders, total, err := repo.ListVendorOrders(ctx, vendorID, 1, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, vendorOrders, 1)
+		require.Len(t, vendorOrders[0].Items, 1)
+		assert.Equal(t, productID, vendorOrders[0].Items[0].ProductID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetVendorSales_PaidOrdersOnly", func(t *testing.T) {
+		// Arrange
+		from := now.AddDate(0, -1, 0)
+
+		mock.ExpectQuery("FROM vendor_orders vo\\s+JOIN orders o").
+			WithArgs(models.PaymentStatusSucceeded, from, now).
+			WillReturnRows(sqlmock.NewRows([]string{"vendor_id", "sum", "commission", "count"}).AddRow(vendorID, []byte("250.50"), []byte("25.05"), 3))
+
+		// Act
+		sales, err := repo.GetVendorSales(ctx, from, now)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.VendorSales{{VendorID: vendorID, GrossSales: 250.5, Commission: 25.05, OrderCount: 3}}, sales)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SavePayout_Upsert", func(t *testing.T) {
+		// Arrange
+		existingID := uuid.New()
+		from := now.AddDate(0, -1, 0)
+		payout := &models.VendorPayout{ID: uuid.New(), VendorID: vendorID, PeriodStart: from, PeriodEnd: now, OrderCount: 3, GrossSales: 250.5, Commission: 25.05, NetAmount: 225.45}
+
+		mock.ExpectQuery("INSERT INTO vendor_payouts .* ON CONFLICT \\(vendor_id, period_start, period_end\\) DO UPDATE").
+			WithArgs(payout.ID, vendorID, from, now, 3, 250.5, 25.05, 225.45).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(existingID, now))
+
+		// Act
+		err := repo.SavePayout(ctx, payout)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, existingID, payout.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPayoutsByVendor_Empty", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM vendor_payouts\\s+WHERE vendor_id = \\$1").
+			WithArgs(vendorID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "vendor_id", "period_start", "period_end", "order_count", "gross_sales", "commission", "net_amount", "created_at"}))
+
+		// Act
+		payouts, err := repo.ListPayoutsByVendor(ctx, vendorID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, payouts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AccrueEarnings_Idempotent", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("INSERT INTO vendor_ledger_entries .* ON CONFLICT \\(vendor_order_id, type\\) DO NOTHING").
+			WithArgs(models.VendorLedgerSale, models.VendorLedgerCommission, models.PaymentStatusSucceeded).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+
+		// Act
+		created, err := repo.AccrueEarnings(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPayableBalances_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM vendors v\\s+JOIN vendor_ledger_entries l").
+			WithArgs(10.0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "stripe_account_id", "sum"}).AddRow(vendorID, "acct_123", []byte("120.50")))
+
+		// Act
+		balances, err := repo.ListPayableBalances(ctx, 10.0)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.VendorBalance{{VendorID: vendorID, StripeAccountID: "acct_123", Balance: 120.5}}, balances)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListUnrecordedPayouts_Success", func(t *testing.T) {
+		// Arrange
+		itemID := uuid.New()
+		batchID := uuid.New()
+		createdAt := time.Now()
+
+		mock.ExpectQuery("FROM payout_batch_items i\\s+WHERE i.status = \\$1\\s+AND NOT EXISTS .*FROM ledger_transactions t").
+			WithArgs(models.PayoutBatchItemPaid, models.LedgerTransactionVendorPayout).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "batch_id", "vendor_id", "amount", "status", "transfer_id", "created_at"}).
+				AddRow(itemID, batchID, vendorID, 120.46, models.PayoutBatchItemPaid, "tr_1", createdAt))
+
+		// Act
+		items, err := repo.ListUnrecordedPayouts(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.PayoutBatchItem{{ID: itemID, BatchID: batchID, VendorID: vendorID, Amount: 120.46, Status: models.PayoutBatchItemPaid, TransferID: "tr_1", CreatedAt: createdAt}}, items)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreatePayoutBatch_AlreadyProcessing", func(t *testing.T) {
+		// Arrange
+		batch := &models.PayoutBatch{ID: uuid.New()}
+
+		mock.ExpectQuery("INSERT INTO payout_batches").
+			WithArgs(batch.ID, models.PayoutBatchStatusProcessing).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+		// Act
+		err := repo.CreatePayoutBatch(ctx, batch)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetPayoutBatch_WithItems", func(t *testing.T) {
+		// Arrange
+		batchID := uuid.New()
+
+		mock.ExpectQuery("FROM payout_batches WHERE id = \\$1").
+			WithArgs(batchID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "total_amount", "created_at", "completed_at"}).
+				AddRow(batchID, models.PayoutBatchStatusCompleted, 120.5, now, now))
+		mock.ExpectQuery("FROM payout_batch_items").
+			WithArgs(batchID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "batch_id", "vendor_id", "amount", "status", "transfer_id", "error", "created_at"}).
+				AddRow(uuid.New(), batchID, vendorID, 120.5, models.PayoutBatchItemPaid, "tr_1", "", now))
+
+		// Act
+		batch, err := repo.GetPayoutBatch(ctx, batchID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, batch.Items, 1)
+		assert.Equal(t, "tr_1", batch.Items[0].TransferID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetVendorDashboard_ScopedToVendor", func(t *testing.T) {
+		// Arrange
+		since := now.AddDate(0, 0, -30)
+
+		mock.ExpectQuery("FROM products WHERE vendor_id = \\$1").
+			WithArgs(vendorID, since, 5, models.OrderStatusPending).
+			WillReturnRows(sqlmock.NewRows([]string{"products", "active", "low_stock", "pending", "recent", "sales", "balance"}).
+				AddRow(12, 10, 2, 3, 7, []byte("420.50"), []byte("120.25")))
+
+		// Act
+		dashboard, err := repo.GetVendorDashboard(ctx, vendorID, since, 5)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.VendorDashboard{
+			VendorID: vendorID, Products: 12, ActiveProducts: 10, LowStockProducts: 2, PendingOrders: 3,
+			RecentOrders: 7, RecentSales: 420.5, Balance: 120.25, Since: since,
+		}, dashboard)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetLedgerTotals_MatchesLedgerToTheCent", func(t *testing.T) {
+		// Arrange: a month of small sales, whose float sum drifts away from the ledger
+		from := now.AddDate(0, -1, 0)
+		ledgerCents := int64(0)
+		floatSum := 0.0
+
+		for range 1000 {
+			ledgerCents += 10
+			floatSum += 0.1
+		}
+
+		require.NotEqual(t, 100.0, floatSum)
+
+		mock.ExpectQuery("SELECT type, COALESCE\\(SUM\\(amount::numeric\\), 0\\), COUNT\\(\\*\\)").
+			WithArgs(vendorID, from, now).
+			WillReturnRows(sqlmock.NewRows([]string{"type", "sum", "count"}).
+				AddRow(models.VendorLedgerSale, []byte("100.00"), 1000).
+				AddRow(models.VendorLedgerCommission, []byte("-10.00"), 1000))
+
+		// Act
+		totals, err := repo.GetLedgerTotals(ctx, vendorID, from, now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, totals, 2)
+		assert.Equal(t, float64(ledgerCents)/100, totals[0].Amount)
+		assert.Equal(t, -10.0, totals[1].Amount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetVendorBalance_BankersRounding", func(t *testing.T) {
+		// Arrange: commissions of fractional cents add up to a tie, which goes to the even cent
+		mock.ExpectQuery("SELECT COALESCE\\(SUM\\(amount::numeric\\), 0\\) FROM vendor_ledger_entries").
+			WithArgs(vendorID).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow([]byte("1234.565")))
+
+		// Act
+		balance, err := repo.GetVendorBalance(ctx, vendorID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1234.56, balance)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type WarehouseRepository interface {
+	CreateWarehouse(ctx context.Context, warehouse *models.Warehouse) error
+	GetWarehouseByID(ctx context.Context, id uuid.UUID) (*models.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]*models.Warehouse, error)
+	GetStock(ctx context.Context, warehouseID, productID uuid.UUID) (int, error)
+	SetStock(ctx context.Context, stock *models.WarehouseStock) error
+	ListStockByWarehouse(ctx context.Context, warehouseID uuid.UUID) ([]*models.WarehouseStock, error)
+	ListStockByProduct(ctx context.Context, productID uuid.UUID) ([]*models.WarehouseStock, error)
+	ReserveStock(ctx context.Context, warehouseID, productID uuid.UUID, quantity int) error
+	CreateAllocation(ctx context.Context, allocation *models.StockAllocation) error
+	ListAllocationsByOrder(ctx context.Context, orderID uuid.UUID) ([]*models.StockAllocation, error)
+	ReleaseAllocations(ctx context.Context, orderItemIDs []uuid.UUID) error
+}
+
+type warehouseRepository struct {
+	DB *sql.DB
+}
+
+func NewWarehouseRepo(db *sql.DB) WarehouseRepository {
+	return &warehouseRepository{DB: db}
+}
+
+func (r *warehouseRepository) CreateWarehouse(ctx context.Context, warehouse *models.Warehouse) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO warehouses (id, name, country, state, postal_code, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, warehouse.ID, warehouse.Name, warehouse.Country, warehouse.State, warehouse.PostalCode).Scan(&warehouse.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert warehouse: %w", err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) GetWarehouseByID(ctx context.Context, id uuid.UUID) (*models.Warehouse, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, name, country, state, postal_code, created_at
+		FROM warehouses
+		WHERE id = $1
+	`
+
+	warehouse := &models.Warehouse{}
+
+	err := r.DB.QueryRowContext(dbCtx, query, id).Scan(&warehouse.ID, &warehouse.Name, &warehouse.Country, &warehouse.State, &warehouse.PostalCode, &warehouse.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouse: %w", err)
+	}
+
+	return warehouse, nil
+}
+
+func (r *warehouseRepository) ListWarehouses(ctx context.Context) ([]*models.Warehouse, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, name, country, state, postal_code, created_at
+		FROM warehouses
+		ORDER BY name
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list warehouses: %w", err)
+	}
+	defer rows.Close()
+
+	var warehouses []*models.Warehouse
+
+	for rows.Next() {
+		warehouse := &models.Warehouse{}
+
+		if err := rows.Scan(&warehouse.ID, &warehouse.Name, &warehouse.Country, &warehouse.State, &warehouse.PostalCode, &warehouse.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan warehouse: %w", err)
+		}
+
+		warehouses = append(warehouses, warehouse)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating warehouses: %w", err)
+	}
+
+	return warehouses, nil
+}
+
+// GetStock returns 0 for a product the warehouse never held.
+func (r *warehouseRepository) GetStock(ctx context.Context, warehouseID, productID uuid.UUID) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COALESCE(SUM(quantity), 0) FROM warehouse_stock WHERE warehouse_id = $1 AND product_id = $2`
+
+	var quantity int
+
+	if err := r.DB.QueryRowContext(dbCtx, query, warehouseID, productID).Scan(&quantity); err != nil {
+		return 0, fmt.Errorf("failed to get warehouse stock: %w", err)
+	}
+
+	return quantity, nil
+}
+
+func (r *warehouseRepository) SetStock(ctx context.Context, stock *models.WarehouseStock) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO warehouse_stock (warehouse_id, product_id, quantity, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (warehouse_id, product_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity, updated_at = NOW()
+		RETURNING updated_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, stock.WarehouseID, stock.ProductID, stock.Quantity).Scan(&stock.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set warehouse stock: %w", err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) ListStockByWarehouse(ctx context.Context, warehouseID uuid.UUID) ([]*models.WarehouseStock, error) {
+	query := `
+		SELECT warehouse_id, product_id, quantity, updated_at
+		FROM warehouse_stock
+		WHERE warehouse_id = $1
+		ORDER BY product_id
+	`
+
+	return r.listStock(ctx, query, warehouseID)
+}
+
+func (r *warehouseRepository) ListStockByProduct(ctx context.Context, productID uuid.UUID) ([]*models.WarehouseStock, error) {
+	query := `
+		SELECT warehouse_id, product_id, quantity, updated_at
+		FROM warehouse_stock
+		WHERE product_id = $1
+		ORDER BY warehouse_id
+	`
+
+	return r.listStock(ctx, query, productID)
+}
+
+func (r *warehouseRepository) listStock(ctx context.Context, query string, id uuid.UUID) ([]*models.WarehouseStock, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.DB.QueryContext(dbCtx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list warehouse stock: %w", err)
+	}
+	defer rows.Close()
+
+	var stock []*models.WarehouseStock
+
+	for rows.Next() {
+		entry := &models.WarehouseStock{}
+
+		if err := rows.Scan(&entry.WarehouseID, &entry.ProductID, &entry.Quantity, &entry.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan warehouse stock: %w", err)
+		}
+
+		stock = append(stock, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating warehouse stock: %w", err)
+	}
+
+	return stock, nil
+}
+
+// ReserveStock takes quantity out of the warehouse, returning sql.ErrNoRows when it doesn't hold enough.
+func (r *warehouseRepository) ReserveStock(ctx context.Context, warehouseID, productID uuid.UUID, quantity int) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE warehouse_stock SET quantity = quantity - $1, updated_at = NOW()
+		WHERE warehouse_id = $2 AND product_id = $3 AND quantity >= $1
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, quantity, warehouseID, productID)
+	if err != nil {
+		return fmt.Errorf("failed to reserve warehouse stock: %w", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+
+	if updatedRows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) CreateAllocation(ctx context.Context, allocation *models.StockAllocation) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO stock_allocations (id, order_id, order_item_id, product_id, warehouse_id, quantity, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING created_at
+	`
+
+	err := r.DB.QueryRowContext(dbCtx, query, allocation.ID, allocation.OrderID, allocation.OrderItemID, allocation.ProductID, allocation.WarehouseID, allocation.Quantity).Scan(&allocation.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert stock allocation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) ListAllocationsByOrder(ctx context.Context, orderID uuid.UUID) ([]*models.StockAllocation, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, order_id, order_item_id, product_id, warehouse_id, quantity, created_at
+		FROM stock_allocations
+		WHERE order_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stock allocations: %w", err)
+	}
+	defer rows.Close()
+
+	var allocations []*models.StockAllocation
+
+	for rows.Next() {
+		allocation := &models.StockAllocation{}
+
+		err := rows.Scan(&allocation.ID, &allocation.OrderID, &allocation.OrderItemID, &allocation.ProductID, &allocation.WarehouseID, &allocation.Quantity, &allocation.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stock allocation: %w", err)
+		}
+
+		allocations = append(allocations, allocation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock allocations: %w", err)
+	}
+
+	return allocations, nil
+}
+
+// ReleaseAllocations deletes the allocations of the order items and puts their stock back into the
+// warehouses it was reserved in, in one statement.
+func (r *warehouseRepository) ReleaseAllocations(ctx context.Context, orderItemIDs []uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		WITH released AS (
+			DELETE FROM stock_allocations
+			WHERE order_item_id = ANY($1)
+			RETURNING warehouse_id, product_id, quantity
+		)
+		UPDATE warehouse_stock s SET quantity = s.quantity + r.quantity, updated_at = NOW()
+		FROM (
+			SELECT warehouse_id, product_id, SUM(quantity) AS quantity
+			FROM released
+			GROUP BY warehouse_id, product_id
+		) r
+		WHERE s.warehouse_id = r.warehouse_id AND s.product_id = r.product_id
+	`
+
+	if _, err := r.DB.ExecContext(dbCtx, query, pq.Array(orderItemIDs)); err != nil {
+		return fmt.Errorf("failed to release stock allocations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package repository_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouseRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewWarehouseRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	warehouseID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("CreateWarehouse_Success", func(t *testing.T) {
+		// Arrange
+		warehouse := &models.Warehouse{ID: warehouseID, Name: "Berlin", Country: "DE", State: "BE", PostalCode: "10115"}
+
+		mock.ExpectQuery("INSERT INTO warehouses").
+			WithArgs(warehouse.ID, warehouse.Name, warehouse.Country, warehouse.State, warehouse.PostalCode).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+		// Act
+		err := repo.CreateWarehouse(ctx, warehouse)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, now, warehouse.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetStock_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("FROM warehouse_stock").
+			WithArgs(warehouseID, productID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity"}).AddRow(4))
+
+		// Act
+		quantity, err := repo.GetStock(ctx, warehouseID, productID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 4, quantity)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListStockByProduct_Success", func(t *testing.T) {
+		// Arrange
+		otherWarehouseID := uuid.New()
+
+		mock.ExpectQuery("FROM warehouse_stock").
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "product_id", "quantity", "updated_at"}).
+				AddRow(warehouseID, productID, 4, now).
+				AddRow(otherWarehouseID, productID, 0, now))
+
+		// Act
+		stock, err := repo.ListStockByProduct(ctx, productID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, stock, 2)
+		assert.Equal(t, otherWarehouseID, stock[1].WarehouseID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReserveStock_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("UPDATE warehouse_stock").
+			WithArgs(2, warehouseID, productID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.ReserveStock(ctx, warehouseID, productID, 2)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReserveStock_NotEnoughStock", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec("UPDATE warehouse_stock").
+			WithArgs(20, warehouseID, productID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.ReserveStock(ctx, warehouseID, productID, 20)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateAllocation_QueryError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectQuery("INSERT INTO stock_allocations").WillReturnError(dbErr)
+
+		// Act
+		err := repo.CreateAllocation(ctx, &models.StockAllocation{ID: uuid.New()})
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("ReleaseAllocations_Success", func(t *testing.T) {
+		// Arrange
+		itemIDs := []uuid.UUID{uuid.New(), uuid.New()}
+		mock.ExpectExec("DELETE FROM stock_allocations").
+			WithArgs(pq.Array(itemIDs)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		// Act
+		err := repo.ReleaseAllocations(ctx, itemIDs)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+type WebhookDeadLetterRepository interface {
+	CreateDeadLetter(ctx context.Context, deadLetter *models.WebhookDeadLetter) error
+	ListDeadLetters(ctx context.Context, unresolvedOnly bool, page, size int) ([]*models.WebhookDeadLetter, int, error)
+	ResolveDeadLetter(ctx context.Context, id uuid.UUID) error
+}
+
+type webhookDeadLetterRepository struct {
+	DB *sql.DB
+}
+
+func NewWebhookDeadLetterRepo(db *sql.DB) WebhookDeadLetterRepository {
+	return &webhookDeadLetterRepository{DB: db}
+}
+
+// CreateDeadLetter quarantines an event. A redelivered event keeps its first entry.
+func (r *webhookDeadLetterRepository) CreateDeadLetter(ctx context.Context, deadLetter *models.WebhookDeadLetter) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhook_dead_letters (event_id, event_type, payload, reason)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (event_id) DO NOTHING
+	`
+
+	if _, err := r.DB.ExecContext(dbCtx, query, deadLetter.EventID, deadLetter.EventType, []byte(deadLetter.Payload), deadLetter.Reason); err != nil {
+		return fmt.Errorf("failed to insert webhook dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeadLetters returns the most recently quarantined events first.
+func (r *webhookDeadLetterRepository) ListDeadLetters(ctx context.Context, unresolvedOnly bool, page, size int) ([]*models.WebhookDeadLetter, int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	where := ""
+	if unresolvedOnly {
+		where = "WHERE resolved_at IS NULL"
+	}
+
+	var total int
+
+	countQuery := `SELECT COUNT(*) FROM webhook_dead_letters ` + where
+
+	if err := r.DB.QueryRowContext(dbCtx, countQuery).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook dead letters: %w", err)
+	}
+
+	offset := (page - 1) * size
+
+	query := `
+		SELECT id, event_id, event_type, payload, reason, created_at, resolved_at
+		FROM webhook_dead_letters
+		` + where + `
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, size, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	var deadLetters []*models.WebhookDeadLetter
+
+	for rows.Next() {
+		deadLetter := &models.WebhookDeadLetter{}
+
+		var resolvedAt sql.NullTime
+
+		err := rows.Scan(&deadLetter.ID, &deadLetter.EventID, &deadLetter.EventType, &deadLetter.Payload, &deadLetter.Reason, &deadLetter.CreatedAt, &resolvedAt)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan webhook dead letter: %w", err)
+		}
+
+		if resolvedAt.Valid {
+			deadLetter.ResolvedAt = &resolvedAt.Time
+		}
+
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating webhook dead letters: %w", err)
+	}
+
+	return deadLetters, total, nil
+}
+
+// ResolveDeadLetter marks an entry as reviewed, it returns sql.ErrNoRows if it is unknown or resolved already.
+func (r *webhookDeadLetterRepository) ResolveDeadLetter(ctx context.Context, id uuid.UUID) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE webhook_dead_letters SET resolved_at = NOW() WHERE id = $1 AND resolved_at IS NULL`
+
+	result, err := r.DB.ExecContext(dbCtx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook dead letter: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
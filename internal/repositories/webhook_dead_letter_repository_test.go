@@ -0,0 +1,128 @@
+package repository_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDeadLetterRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewWebhookDeadLetterRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+
+	t.Run("CreateDeadLetter_Success", func(t *testing.T) {
+		// Arrange
+		deadLetter := &models.WebhookDeadLetter{
+			EventID:   "evt_123",
+			EventType: "charge.refunded",
+			Payload:   json.RawMessage(`{"id":"ch_123"}`),
+			Reason:    "invalid charge payload",
+		}
+
+		mock.ExpectExec("INSERT INTO webhook_dead_letters").
+			WithArgs(deadLetter.EventID, deadLetter.EventType, []byte(deadLetter.Payload), deadLetter.Reason).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.CreateDeadLetter(ctx, deadLetter)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDeadLetter_ExecError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectExec("INSERT INTO webhook_dead_letters").WillReturnError(dbErr)
+
+		// Act
+		err := repo.CreateDeadLetter(ctx, &models.WebhookDeadLetter{EventID: "evt_123"})
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDeadLetters_Unresolved", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM webhook_dead_letters WHERE resolved_at IS NULL").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("SELECT id, event_id, event_type, payload, reason, created_at, resolved_at FROM webhook_dead_letters WHERE resolved_at IS NULL").
+			WithArgs(10, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "event_type", "payload", "reason", "created_at", "resolved_at"}).
+				AddRow(id, "evt_123", "charge.refunded", []byte(`{}`), "invalid charge payload", now, nil))
+
+		// Act
+		deadLetters, total, err := repo.ListDeadLetters(ctx, true, 2, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, deadLetters, 1)
+		assert.Equal(t, id, deadLetters[0].ID)
+		assert.Nil(t, deadLetters[0].ResolvedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDeadLetters_All", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM webhook_dead_letters").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("SELECT id, event_id, event_type, payload, reason, created_at, resolved_at FROM webhook_dead_letters ORDER BY").
+			WithArgs(10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "event_type", "payload", "reason", "created_at", "resolved_at"}).
+				AddRow(uuid.New(), "evt_123", "charge.refunded", []byte(`{}`), "invalid charge payload", now, now))
+
+		// Act
+		deadLetters, _, err := repo.ListDeadLetters(ctx, false, 1, 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		assert.NotNil(t, deadLetters[0].ResolvedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ResolveDeadLetter_Success", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mock.ExpectExec("UPDATE webhook_dead_letters SET resolved_at").WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.ResolveDeadLetter(ctx, id)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ResolveDeadLetter_NotFound", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mock.ExpectExec("UPDATE webhook_dead_letters SET resolved_at").WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		err := repo.ResolveDeadLetter(ctx, id)
+
+		// Assert
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
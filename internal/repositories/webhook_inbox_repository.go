@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+)
+
+type WebhookInboxRepository interface {
+	SaveEvent(ctx context.Context, event *models.WebhookInboxEvent, leaseUntil time.Time) (bool, error)
+	ClaimDueEvents(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.WebhookInboxEvent, error)
+	MarkProcessed(ctx context.Context, eventID string) error
+	RecordFailure(ctx context.Context, eventID, reason string) (int, error)
+	DeleteProcessedEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+type webhookInboxRepository struct {
+	DB *sql.DB
+}
+
+func NewWebhookInboxRepo(db *sql.DB) WebhookInboxRepository {
+	return &webhookInboxRepository{DB: db}
+}
+
+// SaveEvent stores a received event, leased to the worker it is queued on until leaseUntil, and reports
+// whether it was added. An event redelivered by Stripe keeps its first entry.
+func (r *webhookInboxRepository) SaveEvent(ctx context.Context, event *models.WebhookInboxEvent, leaseUntil time.Time) (bool, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhook_inbox (event_id, event_type, payload, next_attempt_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (event_id) DO NOTHING
+	`
+
+	result, err := r.DB.ExecContext(dbCtx, query, event.EventID, event.EventType, []byte(event.Payload), leaseUntil)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert webhook inbox event: %w", err)
+	}
+
+	insertedRows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get inserted rows: %w", err)
+	}
+
+	return insertedRows > 0, nil
+}
+
+// ClaimDueEvents picks the unprocessed events whose lease expired, because their worker gave up on them
+// or their instance stopped, and pushes their lease to leaseUntil so that another instance doesn't pick
+// them up as well.
+func (r *webhookInboxRepository) ClaimDueEvents(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.WebhookInboxEvent, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_inbox SET next_attempt_at = $2
+		WHERE event_id IN (
+			SELECT event_id FROM webhook_inbox
+			WHERE processed_at IS NULL AND next_attempt_at <= $1
+			ORDER BY received_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING event_id, event_type, payload, failures
+	`
+
+	rows, err := r.DB.QueryContext(dbCtx, query, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due webhook events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*models.WebhookInboxEvent
+
+	for rows.Next() {
+		event := &models.WebhookInboxEvent{}
+
+		if err := rows.Scan(&event.EventID, &event.EventType, &event.Payload, &event.Failures); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook inbox event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over the rows: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkProcessed takes the event out of the redeliveries.
+func (r *webhookInboxRepository) MarkProcessed(ctx context.Context, eventID string) error {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE webhook_inbox SET processed_at = NOW() WHERE event_id = $1 AND processed_at IS NULL`
+
+	if _, err := r.DB.ExecContext(dbCtx, query, eventID); err != nil {
+		return fmt.Errorf("failed to mark webhook event processed: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed delivery of the event and returns the failures so far.
+func (r *webhookInboxRepository) RecordFailure(ctx context.Context, eventID, reason string) (int, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_inbox SET failures = failures + 1, last_error = $2
+		WHERE event_id = $1
+		RETURNING failures
+	`
+
+	var failures int
+
+	if err := r.DB.QueryRowContext(dbCtx, query, eventID, reason).Scan(&failures); err != nil {
+		return 0, fmt.Errorf("failed to record webhook event failure: %w", err)
+	}
+
+	return failures, nil
+}
+
+// DeleteProcessedEvents drops the events processed before the cutoff.
+func (r *webhookInboxRepository) DeleteProcessedEvents(ctx context.Context, before time.Time) (int64, error) {
+	dbCtx, cancel := utils.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.DB.ExecContext(dbCtx, `DELETE FROM webhook_inbox WHERE processed_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete processed webhook events: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return deleted, nil
+}
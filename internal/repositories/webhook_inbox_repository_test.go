@@ -0,0 +1,137 @@
+package repository_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookInboxRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewWebhookInboxRepo(db)
+	ctx := t.Context()
+	now := time.Now()
+	payload := json.RawMessage(`{"id":"evt_123","type":"charge.refunded"}`)
+
+	t.Run("SaveEvent_Success", func(t *testing.T) {
+		// Arrange
+		event := &models.WebhookInboxEvent{EventID: "evt_123", EventType: "charge.refunded", Payload: payload}
+
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO webhook_inbox`)+`.*ON CONFLICT \(event_id\) DO NOTHING`).
+			WithArgs("evt_123", "charge.refunded", []byte(payload), now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		saved, err := repo.SaveEvent(ctx, event, now)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, saved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveEvent_AlreadyReceived", func(t *testing.T) {
+		// Arrange
+		event := &models.WebhookInboxEvent{EventID: "evt_123", EventType: "charge.refunded", Payload: payload}
+
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO webhook_inbox`)+`.*ON CONFLICT \(event_id\) DO NOTHING`).
+			WithArgs("evt_123", "charge.refunded", []byte(payload), now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		// Act
+		saved, err := repo.SaveEvent(ctx, event, now)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, saved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveEvent_ExecError", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		mock.ExpectExec("INSERT INTO webhook_inbox").WillReturnError(dbErr)
+
+		// Act
+		_, err := repo.SaveEvent(ctx, &models.WebhookInboxEvent{EventID: "evt_123"}, now)
+
+		// Assert
+		require.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimDueEvents_Success", func(t *testing.T) {
+		// Arrange
+		leaseUntil := now.Add(5 * time.Minute)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE webhook_inbox SET next_attempt_at = $2`)+`.*FOR UPDATE SKIP LOCKED`).
+			WithArgs(now, leaseUntil, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "event_type", "payload", "failures"}).
+				AddRow("evt_123", "charge.refunded", []byte(payload), 2))
+
+		// Act
+		events, err := repo.ClaimDueEvents(ctx, now, leaseUntil, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []*models.WebhookInboxEvent{{EventID: "evt_123", EventType: "charge.refunded", Payload: payload, Failures: 2}}, events)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MarkProcessed_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE webhook_inbox SET processed_at = NOW() WHERE event_id = $1`)).
+			WithArgs("evt_123").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Act
+		err := repo.MarkProcessed(ctx, "evt_123")
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordFailure_Success", func(t *testing.T) {
+		// Arrange
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE webhook_inbox SET failures = failures + 1, last_error = $2`)).
+			WithArgs("evt_123", "db down").
+			WillReturnRows(sqlmock.NewRows([]string{"failures"}).AddRow(3))
+
+		// Act
+		failures, err := repo.RecordFailure(ctx, "evt_123", "db down")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, failures)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteProcessedEvents_Success", func(t *testing.T) {
+		// Arrange
+		cutoff := now.Add(-7 * 24 * time.Hour)
+
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM webhook_inbox WHERE processed_at < $1`)).
+			WithArgs(cutoff).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+
+		// Act
+		deleted, err := repo.DeleteProcessedEvents(ctx, cutoff)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+)
+
+// AnalyticsService reports the lifetime value of the customers, how many come back, and how the monthly
+// cohorts of new customers keep buying. The reports read views of the paid orders refreshed on a
+// schedule, they lag behind the orders by up to the refresh interval.
+type AnalyticsService interface {
+	GetCustomerAnalytics(ctx context.Context) (*models.CustomerAnalytics, error)
+	GetCohortReport(ctx context.Context, from, to time.Time) (*models.CohortReport, error)
+	RefreshAnalytics(ctx context.Context) error
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type analyticsService struct {
+	repo repository.AnalyticsRepository
+}
+
+func NewAnalyticsService(repo repository.AnalyticsRepository) AnalyticsService {
+	return &analyticsService{repo: repo}
+}
+
+func (s *analyticsService) GetCustomerAnalytics(ctx context.Context) (*models.CustomerAnalytics, error) {
+	analytics, err := s.repo.GetCustomerAnalytics(ctx)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to get customer analytics").WithError(err)
+	}
+
+	analytics.Revenue = roundCents(analytics.Revenue)
+
+	if analytics.Customers > 0 {
+		analytics.RepeatPurchaseRate = float64(analytics.RepeatCustomers) / float64(analytics.Customers)
+		analytics.AverageLifetimeValue = roundCents(analytics.Revenue / float64(analytics.Customers))
+	}
+
+	if analytics.Orders > 0 {
+		analytics.AverageOrderValue = roundCents(analytics.Revenue / float64(analytics.Orders))
+	}
+
+	return analytics, nil
+}
+
+// GetCohortReport groups the monthly activity by cohort. The first month of a cohort is the month of its
+// first orders, every customer of the cohort is active in it, so it gives the size of the cohort.
+func (s *analyticsService) GetCohortReport(ctx context.Context, from, to time.Time) (*models.CohortReport, error) {
+	activity, err := s.repo.ListCohortActivity(ctx, from, to)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to get cohort activity").WithError(err)
+	}
+
+	report := &models.CohortReport{From: from, To: to, Cohorts: []models.Cohort{}}
+
+	for _, month := range activity {
+		if n := len(report.Cohorts); n == 0 || !report.Cohorts[n-1].Month.Equal(month.CohortMonth) {
+			report.Cohorts = append(report.Cohorts, models.Cohort{Month: month.CohortMonth, Size: month.ActiveCustomers})
+		}
+
+		cohort := &report.Cohorts[len(report.Cohorts)-1]
+
+		month.MonthsSince = monthsBetween(cohort.Month, month.OrderMonth)
+		month.Revenue = roundCents(month.Revenue)
+
+		if cohort.Size > 0 {
+			month.RetentionRate = float64(month.ActiveCustomers) / float64(cohort.Size)
+		}
+
+		cohort.LifetimeValue += month.Revenue // the revenue of the cohort, per customer once all months are in
+		cohort.Activity = append(cohort.Activity, month)
+	}
+
+	for i := range report.Cohorts {
+		if cohort := &report.Cohorts[i]; cohort.Size > 0 {
+			cohort.LifetimeValue = roundCents(cohort.LifetimeValue / float64(cohort.Size))
+		}
+	}
+
+	return report, nil
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
+
+func (s *analyticsService) RefreshAnalytics(ctx context.Context) error {
+	if err := s.repo.RefreshViews(ctx); err != nil {
+		return errors.DatabaseError("Failed to refresh analytics").WithError(err)
+	}
+
+	return nil
+}
+
+// Run refreshes the analytics right away, which creates the views on a new database, then every
+// interval until ctx is cancelled.
+func (s *analyticsService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		if err := s.RefreshAnalytics(ctx); err != nil {
+			slog.Error("Scheduled refresh of the analytics failed", slog.String("error", err.Error()))
+		} else {
+			slog.Info("Analytics refreshed", slog.Duration("duration", time.Since(start)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsService_GetCustomerAnalytics(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success - Rates and averages", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockAnalyticsRepository(t)
+		analyticsService := service.NewAnalyticsService(mockRepo)
+		mockRepo.On("GetCustomerAnalytics", ctx).Return(&models.CustomerAnalytics{Customers: 4, RepeatCustomers: 1, Orders: 6, Revenue: 300.5}, nil).Once()
+
+		// Act
+		analytics, err := analyticsService.GetCustomerAnalytics(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.CustomerAnalytics{
+			Customers:            4,
+			RepeatCustomers:      1,
+			RepeatPurchaseRate:   0.25,
+			Orders:               6,
+			Revenue:              300.5,
+			AverageOrderValue:    50.08,
+			AverageLifetimeValue: 75.13,
+		}, analytics)
+	})
+
+	t.Run("Success - No customers yet", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockAnalyticsRepository(t)
+		analyticsService := service.NewAnalyticsService(mockRepo)
+		mockRepo.On("GetCustomerAnalytics", ctx).Return(&models.CustomerAnalytics{}, nil).Once()
+
+		// Act
+		analytics, err := analyticsService.GetCustomerAnalytics(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.CustomerAnalytics{}, analytics)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockAnalyticsRepository(t)
+		analyticsService := service.NewAnalyticsService(mockRepo)
+		mockRepo.On("GetCustomerAnalytics", ctx).Return(nil, errors.New("db error")).Once()
+
+		// Act
+		analytics, err := analyticsService.GetCustomerAnalytics(ctx)
+
+		// Assert
+		assert.Nil(t, analytics)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestAnalyticsService_GetCohortReport(t *testing.T) {
+	ctx := t.Context()
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	mar := jan.AddDate(0, 2, 0)
+	to := jan.AddDate(1, 0, 0)
+
+	t.Run("Success - Activity grouped by cohort", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockAnalyticsRepository(t)
+		analyticsService := service.NewAnalyticsService(mockRepo)
+		mockRepo.On("ListCohortActivity", ctx, jan, to).Return([]models.CohortActivity{
+			{CohortMonth: jan, OrderMonth: jan, ActiveCustomers: 10, Revenue: 500},
+			{CohortMonth: jan, OrderMonth: mar, ActiveCustomers: 4, Revenue: 180},
+			{CohortMonth: feb, OrderMonth: feb, ActiveCustomers: 3, Revenue: 90},
+		}, nil).Once()
+
+		// Act
+		report, err := analyticsService.GetCohortReport(ctx, jan, to)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.CohortReport{From: jan, To: to, Cohorts: []models.Cohort{
+			{Month: jan, Size: 10, LifetimeValue: 68, Activity: []models.CohortActivity{
+				{CohortMonth: jan, OrderMonth: jan, MonthsSince: 0, ActiveCustomers: 10, RetentionRate: 1, Revenue: 500},
+				{CohortMonth: jan, OrderMonth: mar, MonthsSince: 2, ActiveCustomers: 4, RetentionRate: 0.4, Revenue: 180},
+			}},
+			{Month: feb, Size: 3, LifetimeValue: 30, Activity: []models.CohortActivity{
+				{CohortMonth: feb, OrderMonth: feb, MonthsSince: 0, ActiveCustomers: 3, RetentionRate: 1, Revenue: 90},
+			}},
+		}}, report)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockAnalyticsRepository(t)
+		analyticsService := service.NewAnalyticsService(mockRepo)
+		mockRepo.On("ListCohortActivity", ctx, jan, to).Return(nil, errors.New("db error")).Once()
+
+		// Act
+		report, err := analyticsService.GetCohortReport(ctx, jan, to)
+
+		// Assert
+		assert.Nil(t, report)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestAnalyticsService_RefreshAnalytics(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	mockRepo := mocks.NewMockAnalyticsRepository(t)
+	analyticsService := service.NewAnalyticsService(mockRepo)
+	dbErr := errors.New("db error")
+	mockRepo.On("RefreshViews", ctx).Return(dbErr).Once()
+
+	// Act
+	err := analyticsService.RefreshAnalytics(ctx)
+
+	// Assert
+	assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	assert.ErrorIs(t, err, dbErr)
+}
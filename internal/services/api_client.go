@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// clientSecretBytes of randomness make a secret nobody guesses, which is why a fast hash stores it.
+const clientSecretBytes = 32
+
+type APIClientService interface {
+	CreateClient(ctx context.Context, actor *models.Claims, req *models.CreateAPIClientRequest) (*models.APIClientCredentials, error)
+	ListClients(ctx context.Context) ([]*models.APIClient, error)
+	RotateSecret(ctx context.Context, id uuid.UUID) (*models.APIClientCredentials, error)
+	RevokeClient(ctx context.Context, id uuid.UUID) error
+	IssueToken(ctx context.Context, clientID, secret, scope string) (*models.ClientTokenResponse, error)
+}
+
+// APIClientPolicy sets how long client tokens live, a revoked client keeps its tokens until they
+// expire, and how long the previous secret keeps working after a rotation.
+type APIClientPolicy struct {
+	TokenTTL      time.Duration
+	RotationGrace time.Duration
+}
+
+type apiClientService struct {
+	repo   repository.APIClientRepository
+	tokens TokenPolicy
+	policy APIClientPolicy
+	clock  clock.Clock
+}
+
+func NewAPIClientService(repo repository.APIClientRepository, tokens TokenPolicy, policy APIClientPolicy, clk clock.Clock) APIClientService {
+	return &apiClientService{repo: repo, tokens: tokens, policy: policy, clock: clk}
+}
+
+func (s *apiClientService) CreateClient(ctx context.Context, actor *models.Claims, req *models.CreateAPIClientRequest) (*models.APIClientCredentials, error) {
+	secret, secretHash, err := newClientSecret()
+	if err != nil {
+		return nil, appErrors.InternalError("Failed to generate client secret").WithError(err)
+	}
+
+	scopes := slices.Clone(req.Scopes)
+	slices.Sort(scopes)
+
+	client := &models.APIClient{
+		ID:         uuid.New(),
+		Name:       req.Name,
+		Scopes:     slices.Compact(scopes),
+		SecretHash: secretHash,
+		CreatedBy:  actor.UserID,
+	}
+
+	if err := s.repo.CreateClient(ctx, client); err != nil {
+		return nil, appErrors.DatabaseError("Failed to create API client").WithError(err)
+	}
+
+	return &models.APIClientCredentials{Client: client, ClientSecret: secret}, nil
+}
+
+func (s *apiClientService) ListClients(ctx context.Context) ([]*models.APIClient, error) {
+	clients, err := s.repo.ListClients(ctx)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch API clients").WithError(err)
+	}
+
+	return clients, nil
+}
+
+// RotateSecret hands out a new secret, the current one keeps working for the rotation grace period so
+// the integration can switch over without downtime.
+func (s *apiClientService) RotateSecret(ctx context.Context, id uuid.UUID) (*models.APIClientCredentials, error) {
+	client, err := s.repo.GetClientByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("API client not found")
+		}
+
+		return nil, appErrors.DatabaseError("Failed to fetch API client").WithError(err)
+	}
+
+	if client.RevokedAt != nil {
+		return nil, appErrors.InvalidTransitionError("API client is revoked")
+	}
+
+	secret, secretHash, err := newClientSecret()
+	if err != nil {
+		return nil, appErrors.InternalError("Failed to generate client secret").WithError(err)
+	}
+
+	previousExpiresAt := s.clock.Now().Add(s.policy.RotationGrace)
+
+	client.PreviousSecretHash = client.SecretHash
+	client.PreviousSecretExpiresAt = &previousExpiresAt
+	client.SecretHash = secretHash
+
+	if err := s.repo.RotateSecret(ctx, client); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.InvalidTransitionError("API client is revoked")
+		}
+
+		return nil, appErrors.DatabaseError("Failed to rotate client secret").WithError(err)
+	}
+
+	return &models.APIClientCredentials{Client: client, ClientSecret: secret}, nil
+}
+
+// RevokeClient stops the client from getting new tokens, the ones it holds expire within the token TTL.
+func (s *apiClientService) RevokeClient(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.RevokeClient(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return appErrors.NotFoundError("API client not found")
+		}
+
+		return appErrors.DatabaseError("Failed to revoke API client").WithError(err)
+	}
+
+	return nil
+}
+
+// IssueToken is the client credentials grant. The token carries no user, only the client and the scopes
+// asked for, all the granted ones when scope is empty.
+func (s *apiClientService) IssueToken(ctx context.Context, clientID, secret, scope string) (*models.ClientTokenResponse, error) {
+	id, err := uuid.Parse(clientID)
+	if err != nil {
+		return nil, appErrors.InvalidClientError()
+	}
+
+	client, err := s.repo.GetClientByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.InvalidClientError()
+		}
+
+		return nil, appErrors.DatabaseError("Failed to fetch API client").WithError(err)
+	}
+
+	now := s.clock.Now()
+
+	if client.RevokedAt != nil || !s.secretMatches(client, secret, now) {
+		return nil, appErrors.InvalidClientError()
+	}
+
+	scopes := client.Scopes
+	if requested := strings.Fields(scope); len(requested) > 0 {
+		for _, requestedScope := range requested {
+			if !slices.Contains(client.Scopes, requestedScope) {
+				return nil, appErrors.InvalidScopeError(fmt.Sprintf("Scope %q is not granted", requestedScope))
+			}
+		}
+
+		scopes = requested
+	}
+
+	claims := &models.Claims{
+		ClientID:         &client.ID,
+		Scope:            strings.Join(scopes, " "),
+		RegisteredClaims: s.tokens.registeredClaims("", now, now.Add(s.policy.TokenTTL)),
+	}
+	claims.Subject = client.ID.String()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.tokens.Key)
+	if err != nil {
+		return nil, appErrors.InternalError("Failed to generate access token").WithError(err)
+	}
+
+	return &models.ClientTokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(s.policy.TokenTTL.Seconds()),
+		Scope:       claims.Scope,
+	}, nil
+}
+
+// secretMatches accepts the current secret, and the previous one during the rotation grace period.
+func (s *apiClientService) secretMatches(client *models.APIClient, secret string, now time.Time) bool {
+	hash := hashClientSecret(secret)
+
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(client.SecretHash)) == 1 {
+		return true
+	}
+
+	return client.PreviousSecretHash != "" && client.PreviousSecretExpiresAt != nil && now.Before(*client.PreviousSecretExpiresAt) &&
+		subtle.ConstantTimeCompare([]byte(hash), []byte(client.PreviousSecretHash)) == 1
+}
+
+func newClientSecret() (string, string, error) {
+	raw := make([]byte, clientSecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(raw)
+
+	return secret, hashClientSecret(secret), nil
+}
+
+func hashClientSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,274 @@
+package service_test
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testAPIClientPolicy = service.APIClientPolicy{TokenTTL: 15 * time.Minute, RotationGrace: 24 * time.Hour}
+
+func newAPIClientService(t *testing.T) (service.APIClientService, *mocks.MockAPIClientRepository) {
+	t.Helper()
+
+	repo := mocks.NewMockAPIClientRepository(t)
+	apiClientService := service.NewAPIClientService(repo, service.TokenPolicy{Key: []byte("test-key"), Issuer: "shop", Audience: "shop-api"}, testAPIClientPolicy, clock.NewFake(testNow))
+
+	return apiClientService, repo
+}
+
+func secretHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func assertAppErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var appErr *appErrors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestAPIClientService_CreateClient(t *testing.T) {
+	t.Run("Success - Only the hash of the secret is stored", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		actor := &models.Claims{UserID: uuid.New(), Role: models.RoleAdmin}
+		req := &models.CreateAPIClientRequest{Name: "ERP", Scopes: []string{models.ScopeOrdersWrite, models.ScopeCatalogRead, models.ScopeOrdersWrite}}
+
+		repo.On("CreateClient", mock.Anything, mock.AnythingOfType("*models.APIClient")).Return(nil).Once()
+
+		// Act
+		credentials, err := apiClientService.CreateClient(t.Context(), actor, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, credentials.ClientSecret)
+		assert.Equal(t, secretHash(credentials.ClientSecret), credentials.Client.SecretHash)
+		assert.Equal(t, []string{models.ScopeCatalogRead, models.ScopeOrdersWrite}, credentials.Client.Scopes)
+		assert.Equal(t, actor.UserID, credentials.Client.CreatedBy)
+	})
+
+	t.Run("Failure - Database error", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("CreateClient", mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		credentials, err := apiClientService.CreateClient(t.Context(), &models.Claims{}, &models.CreateAPIClientRequest{Name: "ERP", Scopes: []string{models.ScopeCatalogRead}})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+		assert.Nil(t, credentials)
+	})
+}
+
+func TestAPIClientService_RotateSecret(t *testing.T) {
+	clientID := uuid.New()
+
+	t.Run("Success - Previous secret is kept for the grace period", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(&models.APIClient{ID: clientID, SecretHash: "old-hash"}, nil).Once()
+		repo.On("RotateSecret", mock.Anything, mock.AnythingOfType("*models.APIClient")).Return(nil).Once()
+
+		// Act
+		credentials, err := apiClientService.RotateSecret(t.Context(), clientID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, secretHash(credentials.ClientSecret), credentials.Client.SecretHash)
+		assert.Equal(t, "old-hash", credentials.Client.PreviousSecretHash)
+		require.NotNil(t, credentials.Client.PreviousSecretExpiresAt)
+		assert.Equal(t, testNow.Add(24*time.Hour), *credentials.Client.PreviousSecretExpiresAt)
+	})
+
+	t.Run("Failure - Revoked client", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		revokedAt := testNow.Add(-time.Hour)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(&models.APIClient{ID: clientID, RevokedAt: &revokedAt}, nil).Once()
+
+		// Act
+		credentials, err := apiClientService.RotateSecret(t.Context(), clientID)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+		assert.Nil(t, credentials)
+		repo.AssertNotCalled(t, "RotateSecret", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Client not found", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := apiClientService.RotateSecret(t.Context(), clientID)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+}
+
+func TestAPIClientService_IssueToken(t *testing.T) {
+	clientID := uuid.New()
+	graceEnd := testNow.Add(time.Hour)
+	graceOver := testNow.Add(-time.Minute)
+
+	newClient := func() *models.APIClient {
+		return &models.APIClient{
+			ID:                      clientID,
+			Scopes:                  []string{models.ScopeCatalogRead, models.ScopeOrdersWrite},
+			SecretHash:              secretHash("current"),
+			PreviousSecretHash:      secretHash("previous"),
+			PreviousSecretExpiresAt: &graceEnd,
+		}
+	}
+
+	t.Run("Success - Token carries the client and all granted scopes", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(newClient(), nil).Once()
+
+		// Act
+		resp, err := apiClientService.IssueToken(t.Context(), clientID.String(), "current", "")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer", resp.TokenType)
+		assert.Equal(t, 900, resp.ExpiresIn)
+		assert.Equal(t, "catalog:read orders:write", resp.Scope)
+
+		token, err := jwt.ParseWithClaims(resp.AccessToken, &models.Claims{}, func(_ *jwt.Token) (interface{}, error) {
+			return []byte("test-key"), nil
+		}, jwt.WithTimeFunc(func() time.Time { return testNow }))
+		require.NoError(t, err)
+
+		claims, ok := token.Claims.(*models.Claims)
+		require.True(t, ok)
+		require.NotNil(t, claims.ClientID)
+		assert.Equal(t, clientID, *claims.ClientID)
+		assert.Equal(t, clientID.String(), claims.Subject)
+		assert.Equal(t, uuid.Nil, claims.UserID)
+		assert.Equal(t, "shop", claims.Issuer)
+		assert.Equal(t, jwt.ClaimStrings{"shop-api"}, claims.Audience)
+		assert.True(t, testNow.Add(15*time.Minute).Equal(claims.ExpiresAt.Time))
+	})
+
+	t.Run("Success - Subset of the granted scopes", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(newClient(), nil).Once()
+
+		// Act
+		resp, err := apiClientService.IssueToken(t.Context(), clientID.String(), "current", "orders:write")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "orders:write", resp.Scope)
+	})
+
+	t.Run("Success - Previous secret during the grace period", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(newClient(), nil).Once()
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), clientID.String(), "previous", "")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Previous secret after the grace period", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		client := newClient()
+		client.PreviousSecretExpiresAt = &graceOver
+		repo.On("GetClientByID", mock.Anything, clientID).Return(client, nil).Once()
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), clientID.String(), "previous", "")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidClient)
+	})
+
+	t.Run("Failure - Wrong secret", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(newClient(), nil).Once()
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), clientID.String(), "guess", "")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidClient)
+	})
+
+	t.Run("Failure - Revoked client", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		client := newClient()
+		client.RevokedAt = &graceOver
+		repo.On("GetClientByID", mock.Anything, clientID).Return(client, nil).Once()
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), clientID.String(), "current", "")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidClient)
+	})
+
+	t.Run("Failure - Unknown client", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), clientID.String(), "current", "")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidClient)
+	})
+
+	t.Run("Failure - Malformed client ID", func(t *testing.T) {
+		// Arrange
+		apiClientService, _ := newAPIClientService(t)
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), "not-a-uuid", "current", "")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidClient)
+	})
+
+	t.Run("Failure - Scope not granted", func(t *testing.T) {
+		// Arrange
+		apiClientService, repo := newAPIClientService(t)
+		repo.On("GetClientByID", mock.Anything, clientID).Return(newClient(), nil).Once()
+
+		// Act
+		_, err := apiClientService.IssueToken(t.Context(), clientID.String(), "current", "catalog:read catalog:write")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidScope)
+	})
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"log/slog"
+	"text/template"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+)
+
+// broadcastLease is how long a running broadcast stays with its instance without saving progress,
+// after that it is taken over by the resume scan.
+const broadcastLease = 5 * time.Minute
+
+type BroadcastService interface {
+	CreateBroadcast(ctx context.Context, adminID uuid.UUID, req *models.CreateBroadcastRequest) (*models.Broadcast, error)
+	GetBroadcast(ctx context.Context, id uuid.UUID) (*models.Broadcast, error)
+	CancelBroadcast(ctx context.Context, id uuid.UUID) (*models.Broadcast, error)
+	Run(ctx context.Context, resumeInterval time.Duration)
+}
+
+// BroadcastPolicy throttles the fan-out: recipients are emailed at no more than RatePerSecond and
+// the progress is saved every BatchSize recipients.
+type BroadcastPolicy struct {
+	RatePerSecond int
+	BatchSize     int
+	QueueSize     int
+}
+
+type broadcastService struct {
+	repo                repository.BroadcastRepository
+	notificationService NotificationService
+	policy              BroadcastPolicy
+	queue               chan uuid.UUID
+	clock               clock.Clock
+}
+
+func NewBroadcastService(repo repository.BroadcastRepository, notificationService NotificationService, policy BroadcastPolicy, clk clock.Clock) BroadcastService {
+	policy.RatePerSecond = max(policy.RatePerSecond, 1)
+	policy.BatchSize = max(policy.BatchSize, 1)
+
+	return &broadcastService{
+		repo:                repo,
+		notificationService: notificationService,
+		policy:              policy,
+		queue:               make(chan uuid.UUID, max(policy.QueueSize, 1)),
+		clock:               clk,
+	}
+}
+
+type broadcastTemplates struct {
+	subject *template.Template
+	content *template.Template
+}
+
+// parseBroadcastTemplates also renders the templates once, so that references to unknown fields are
+// rejected when the broadcast is created rather than for every recipient.
+func parseBroadcastTemplates(subject, content string) (*broadcastTemplates, error) {
+	subjectTmpl, err := template.New("subject").Option("missingkey=error").Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	contentTmpl, err := template.New("content").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := &broadcastTemplates{subject: subjectTmpl, content: contentTmpl}
+
+	if _, _, err := templates.render(&models.BroadcastRecipient{}); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (t *broadcastTemplates) render(recipient *models.BroadcastRecipient) (string, string, error) {
+	var subject, content bytes.Buffer
+
+	if err := t.subject.Execute(&subject, recipient); err != nil {
+		return "", "", err
+	}
+
+	if err := t.content.Execute(&content, recipient); err != nil {
+		return "", "", err
+	}
+
+	return subject.String(), content.String(), nil
+}
+
+func (s *broadcastService) CreateBroadcast(ctx context.Context, adminID uuid.UUID, req *models.CreateBroadcastRequest) (*models.Broadcast, error) {
+	if _, err := parseBroadcastTemplates(req.Subject, req.Content); err != nil {
+		return nil, errors.BadRequestError("Invalid broadcast template").WithError(err)
+	}
+
+	total, err := s.repo.CountBroadcastRecipients(ctx, req.Audience)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to count broadcast recipients").WithError(err)
+	}
+
+	broadcast := &models.Broadcast{
+		ID:              utils.NewID(),
+		Subject:         req.Subject,
+		Content:         req.Content,
+		Audience:        req.Audience,
+		Status:          models.BroadcastStatusPending,
+		TotalRecipients: total,
+		CreatedBy:       adminID,
+	}
+
+	if err := s.repo.CreateBroadcast(ctx, broadcast); err != nil {
+		return nil, errors.DatabaseError("Failed to create broadcast").WithError(err)
+	}
+
+	// A broadcast that doesn't fit in the queue stays pending until the next resume scan
+	select {
+	case s.queue <- broadcast.ID:
+	default:
+		slog.Warn("Broadcast queue is full, deferring broadcast", slog.String("broadcastId", broadcast.ID.String()))
+	}
+
+	return broadcast, nil
+}
+
+func (s *broadcastService) GetBroadcast(ctx context.Context, id uuid.UUID) (*models.Broadcast, error) {
+	broadcast, err := s.repo.GetBroadcastByID(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Broadcast not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch broadcast").WithError(err)
+	}
+
+	return broadcast, nil
+}
+
+// CancelBroadcast stops a broadcast, a running one stops after its current batch.
+func (s *broadcastService) CancelBroadcast(ctx context.Context, id uuid.UUID) (*models.Broadcast, error) {
+	broadcast, err := s.GetBroadcast(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CancelBroadcast(ctx, id); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.BadRequestError("Broadcast has already finished").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to cancel broadcast").WithError(err)
+	}
+
+	broadcast.Status = models.BroadcastStatusCancelled
+
+	return broadcast, nil
+}
+
+// Run works through the queued broadcasts one at a time until ctx is cancelled. Every resumeInterval
+// the broadcasts left pending or abandoned by another instance are queued again.
+func (s *broadcastService) Run(ctx context.Context, resumeInterval time.Duration) {
+	if resumeInterval <= 0 {
+		resumeInterval = time.Minute
+	}
+
+	ticker := time.NewTicker(resumeInterval)
+	defer ticker.Stop()
+
+	s.resume(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-s.queue:
+			s.process(ctx, id)
+		case <-ticker.C:
+			s.resume(ctx)
+		}
+	}
+}
+
+func (s *broadcastService) resume(ctx context.Context) {
+	ids, err := s.repo.ListResumableBroadcasts(ctx, s.clock.Now())
+	if err != nil {
+		slog.Error("Failed to list resumable broadcasts", slog.String("error", err.Error()))
+
+		return
+	}
+
+	for _, id := range ids {
+		select {
+		case s.queue <- id:
+		default:
+			return
+		}
+	}
+}
+
+// process fans the broadcast out from its saved cursor. If ctx is cancelled midway the progress is
+// saved with an expired lease, so that the broadcast is resumed by the next resume scan.
+func (s *broadcastService) process(ctx context.Context, id uuid.UUID) {
+	logger := slog.With(slog.String("broadcastId", id.String()))
+
+	broadcast, err := s.repo.ClaimBroadcast(ctx, id, s.clock.Now(), s.clock.Now().Add(broadcastLease))
+	if err != nil {
+		// Claimed by another instance, cancelled or already finished
+		if !stdErrors.Is(err, sql.ErrNoRows) {
+			logger.Error("Failed to claim broadcast", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	templates, err := parseBroadcastTemplates(broadcast.Subject, broadcast.Content)
+	if err != nil {
+		logger.Error("Invalid broadcast template", slog.String("error", err.Error()))
+		s.finish(ctx, logger, broadcast, models.BroadcastStatusCancelled)
+
+		return
+	}
+
+	throttle := time.NewTicker(time.Second / time.Duration(s.policy.RatePerSecond))
+	defer throttle.Stop()
+
+	for {
+		recipients, err := s.repo.ListBroadcastRecipients(ctx, broadcast.Audience, broadcast.Cursor, s.policy.BatchSize)
+		if err != nil {
+			logger.Error("Failed to list broadcast recipients", slog.String("error", err.Error()))
+
+			return
+		}
+
+		if len(recipients) == 0 {
+			s.finish(ctx, logger, broadcast, models.BroadcastStatusCompleted)
+
+			return
+		}
+
+		for _, recipient := range recipients {
+			select {
+			case <-ctx.Done():
+				// Hand the broadcast over right away, without resending the part of the batch already sent
+				if _, err := s.repo.SaveBroadcastProgress(context.WithoutCancel(ctx), broadcast, s.clock.Now()); err != nil {
+					logger.Error("Failed to save broadcast progress", slog.String("error", err.Error()))
+				}
+
+				return
+			case <-throttle.C:
+			}
+
+			s.send(ctx, logger, broadcast, templates, recipient)
+			broadcast.Cursor = recipient.ID
+		}
+
+		status, err := s.repo.SaveBroadcastProgress(ctx, broadcast, s.clock.Now().Add(broadcastLease))
+		if err != nil {
+			logger.Error("Failed to save broadcast progress", slog.String("error", err.Error()))
+
+			return
+		}
+
+		if status == models.BroadcastStatusCancelled {
+			logger.Info("Broadcast cancelled", slog.Int("sent", broadcast.SentCount), slog.Int("failed", broadcast.FailedCount))
+
+			return
+		}
+	}
+}
+
+// send goes through the notification service, so a failed email is retried like any other notification.
+func (s *broadcastService) send(ctx context.Context, logger *slog.Logger, broadcast *models.Broadcast, templates *broadcastTemplates, recipient *models.BroadcastRecipient) {
+	subject, content, err := templates.render(recipient)
+	if err == nil {
+		_, err = s.notificationService.SendEmail(ctx, &models.EmailNotificationRequest{
+			To:       recipient.Email,
+			Subject:  subject,
+			Content:  content,
+			Metadata: map[string]string{"broadcast_id": broadcast.ID.String()},
+		})
+	}
+
+	if err != nil {
+		broadcast.FailedCount++
+
+		logger.Warn("Failed to send broadcast email", slog.String("userId", recipient.ID.String()), slog.String("error", err.Error()))
+
+		return
+	}
+
+	broadcast.SentCount++
+}
+
+func (s *broadcastService) finish(ctx context.Context, logger *slog.Logger, broadcast *models.Broadcast, status models.BroadcastStatus) {
+	if err := s.repo.FinishBroadcast(ctx, broadcast.ID, status); err != nil {
+		logger.Error("Failed to finish broadcast", slog.String("error", err.Error()))
+
+		return
+	}
+
+	logger.Info("Broadcast finished", slog.String("status", string(status)), slog.Int("sent", broadcast.SentCount), slog.Int("failed", broadcast.FailedCount))
+}
@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testBroadcastPolicy = service.BroadcastPolicy{RatePerSecond: 1000, BatchSize: 2, QueueSize: 10}
+
+func setupBroadcastServiceTest(t *testing.T) (service.BroadcastService, *mocks.MockBroadcastRepository, *svcMocks.MockNotificationService) {
+	repo := mocks.NewMockBroadcastRepository(t)
+	notification := svcMocks.NewMockNotificationService(t)
+
+	return service.NewBroadcastService(repo, notification, testBroadcastPolicy, clock.NewFake(testNow)), repo, notification
+}
+
+// runBroadcastWorker runs the worker until done is closed.
+func runBroadcastWorker(t *testing.T, broadcastService service.BroadcastService, done <-chan struct{}) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stopped := make(chan struct{})
+
+	go func() {
+		broadcastService.Run(ctx, time.Hour)
+		close(stopped)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("broadcast was not processed")
+	}
+
+	cancel()
+	<-stopped
+}
+
+func TestCreateBroadcast(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		broadcastService, repo, _ := setupBroadcastServiceTest(t)
+		req := &models.CreateBroadcastRequest{
+			Subject:  "Hi {{.Name}}",
+			Content:  "Your product is back in stock, {{.Email}}",
+			Audience: models.BroadcastAudience{Type: models.BroadcastAudienceProductPurchasers, ProductID: &productID},
+		}
+
+		repo.On("CountBroadcastRecipients", ctx, req.Audience).Return(42, nil).Once()
+		repo.On("CreateBroadcast", ctx, mock.MatchedBy(func(b *models.Broadcast) bool {
+			return b.Status == models.BroadcastStatusPending && b.TotalRecipients == 42 && b.CreatedBy == adminID
+		})).Return(nil).Once()
+
+		// Act
+		broadcast, err := broadcastService.CreateBroadcast(ctx, adminID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.BroadcastStatusPending, broadcast.Status)
+		assert.Equal(t, 42, broadcast.TotalRecipients)
+	})
+
+	t.Run("Failure - Unknown template field", func(t *testing.T) {
+		// Arrange
+		broadcastService, _, _ := setupBroadcastServiceTest(t)
+		req := &models.CreateBroadcastRequest{
+			Subject:  "Hi {{.Password}}",
+			Content:  "Hello",
+			Audience: models.BroadcastAudience{Type: models.BroadcastAudienceAll},
+		}
+
+		// Act
+		broadcast, err := broadcastService.CreateBroadcast(ctx, adminID, req)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, broadcast)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
+func TestCancelBroadcast(t *testing.T) {
+	ctx := t.Context()
+	broadcastID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		broadcastService, repo, _ := setupBroadcastServiceTest(t)
+		repo.On("GetBroadcastByID", ctx, broadcastID).Return(&models.Broadcast{ID: broadcastID, Status: models.BroadcastStatusRunning}, nil).Once()
+		repo.On("CancelBroadcast", ctx, broadcastID).Return(nil).Once()
+
+		// Act
+		broadcast, err := broadcastService.CancelBroadcast(ctx, broadcastID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.BroadcastStatusCancelled, broadcast.Status)
+	})
+
+	t.Run("Failure - Already finished", func(t *testing.T) {
+		// Arrange
+		broadcastService, repo, _ := setupBroadcastServiceTest(t)
+		repo.On("GetBroadcastByID", ctx, broadcastID).Return(&models.Broadcast{ID: broadcastID, Status: models.BroadcastStatusCompleted}, nil).Once()
+		repo.On("CancelBroadcast", ctx, broadcastID).Return(sql.ErrNoRows).Once()
+
+		// Act
+		broadcast, err := broadcastService.CancelBroadcast(ctx, broadcastID)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, broadcast)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		broadcastService, repo, _ := setupBroadcastServiceTest(t)
+		repo.On("GetBroadcastByID", ctx, broadcastID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		broadcast, err := broadcastService.CancelBroadcast(ctx, broadcastID)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, broadcast)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestRunBroadcast(t *testing.T) {
+	audience := models.BroadcastAudience{Type: models.BroadcastAudienceAll}
+	alice := &models.BroadcastRecipient{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Name: "Alice", Email: "alice@example.com"}
+	bob := &models.BroadcastRecipient{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Name: "Bob", Email: "bob@example.com"}
+
+	t.Run("Sends to every recipient and completes", func(t *testing.T) {
+		// Arrange
+		broadcastService, repo, notification := setupBroadcastServiceTest(t)
+		done := make(chan struct{})
+
+		repo.On("CountBroadcastRecipients", mock.Anything, audience).Return(2, nil).Once()
+		repo.On("CreateBroadcast", mock.Anything, mock.Anything).Return(nil).Once()
+
+		broadcast, err := broadcastService.CreateBroadcast(t.Context(), uuid.New(), &models.CreateBroadcastRequest{Subject: "Hi {{.Name}}", Content: "Sale", Audience: audience})
+		require.NoError(t, err)
+
+		repo.On("ListResumableBroadcasts", mock.Anything, mock.Anything).Return(nil, nil).Once()
+		repo.On("ClaimBroadcast", mock.Anything, broadcast.ID, mock.Anything, mock.Anything).Return(&models.Broadcast{
+			ID: broadcast.ID, Subject: broadcast.Subject, Content: broadcast.Content, Audience: audience, Status: models.BroadcastStatusRunning,
+		}, nil).Once()
+		repo.On("ListBroadcastRecipients", mock.Anything, audience, uuid.Nil, testBroadcastPolicy.BatchSize).Return([]*models.BroadcastRecipient{alice, bob}, nil).Once()
+		notification.On("SendEmail", mock.Anything, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool {
+			return req.To == alice.Email && req.Subject == "Hi Alice" && req.Metadata["broadcast_id"] == broadcast.ID.String()
+		})).Return(&models.NotificationResponse{}, nil).Once()
+		notification.On("SendEmail", mock.Anything, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool {
+			return req.To == bob.Email && req.Subject == "Hi Bob"
+		})).Return(nil, errors.New("sendgrid down")).Once()
+		repo.On("SaveBroadcastProgress", mock.Anything, mock.MatchedBy(func(b *models.Broadcast) bool {
+			return b.Cursor == bob.ID && b.SentCount == 1 && b.FailedCount == 1
+		}), mock.Anything).Return(models.BroadcastStatusRunning, nil).Once()
+		repo.On("ListBroadcastRecipients", mock.Anything, audience, bob.ID, testBroadcastPolicy.BatchSize).Return(nil, nil).Once()
+		repo.On("FinishBroadcast", mock.Anything, broadcast.ID, models.BroadcastStatusCompleted).Return(nil).Once().
+			Run(func(mock.Arguments) { close(done) })
+
+		// Act
+		runBroadcastWorker(t, broadcastService, done)
+
+		// Assert
+		repo.AssertExpectations(t)
+		notification.AssertExpectations(t)
+	})
+
+	t.Run("Stops after the batch once cancelled", func(t *testing.T) {
+		// Arrange
+		broadcastService, repo, notification := setupBroadcastServiceTest(t)
+		broadcastID := uuid.New()
+		done := make(chan struct{})
+
+		repo.On("ListResumableBroadcasts", mock.Anything, mock.Anything).Return([]uuid.UUID{broadcastID}, nil).Once()
+		repo.On("ClaimBroadcast", mock.Anything, broadcastID, mock.Anything, mock.Anything).Return(&models.Broadcast{
+			ID: broadcastID, Subject: "Hi", Content: "Sale", Audience: audience, Status: models.BroadcastStatusRunning,
+		}, nil).Once()
+		repo.On("ListBroadcastRecipients", mock.Anything, audience, uuid.Nil, testBroadcastPolicy.BatchSize).Return([]*models.BroadcastRecipient{alice, bob}, nil).Once()
+		notification.On("SendEmail", mock.Anything, mock.Anything).Return(&models.NotificationResponse{}, nil).Twice()
+		repo.On("SaveBroadcastProgress", mock.Anything, mock.Anything, mock.Anything).Return(models.BroadcastStatusCancelled, nil).Once().
+			Run(func(mock.Arguments) { close(done) })
+
+		// Act
+		runBroadcastWorker(t, broadcastService, done)
+
+		// Assert: the strict mocks fail on any further listing or on finishing the broadcast
+		repo.AssertExpectations(t)
+	})
+}
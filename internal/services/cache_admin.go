@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+)
+
+// cacheNamespacePattern matches the namespaces of the cache keys, like product_availability.
+var cacheNamespacePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// CacheAdminService lets deploys and operators drop a whole cache namespace at once.
+type CacheAdminService interface {
+	InvalidateNamespace(ctx context.Context, namespace string) (*models.CacheInvalidation, error)
+}
+
+type cacheAdminService struct {
+	cache cache.Cache
+}
+
+func NewCacheAdminService(cache cache.Cache) CacheAdminService {
+	return &cacheAdminService{cache: cache}
+}
+
+// InvalidateNamespace implements CacheAdminService.
+func (s *cacheAdminService) InvalidateNamespace(ctx context.Context, namespace string) (*models.CacheInvalidation, error) {
+	if !cacheNamespacePattern.MatchString(namespace) {
+		return nil, errors.BadRequestError("Invalid cache namespace")
+	}
+
+	version, err := s.cache.InvalidateNamespace(ctx, namespace)
+	if err != nil {
+		return nil, errors.ThirdPartyError("Failed to invalidate cache namespace").WithError(err)
+	}
+
+	return &models.CacheInvalidation{Namespace: namespace, Version: version}, nil
+}
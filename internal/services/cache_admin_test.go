@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	cacheMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache/mocks"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidateCacheNamespace(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success - Namespace version is bumped", func(t *testing.T) {
+		// Arrange
+		mockCache := cacheMocks.NewMockCache(t)
+		cacheAdminService := service.NewCacheAdminService(mockCache)
+		mockCache.On("InvalidateNamespace", ctx, "product_availability").Return(int64(7), nil).Once()
+
+		// Act
+		result, err := cacheAdminService.InvalidateNamespace(ctx, "product_availability")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.CacheInvalidation{Namespace: "product_availability", Version: 7}, result)
+	})
+
+	t.Run("Failure - Invalid namespace", func(t *testing.T) {
+		for _, namespace := range []string{"", "Product", "product:1", "cache version"} {
+			// Arrange
+			mockCache := cacheMocks.NewMockCache(t)
+			cacheAdminService := service.NewCacheAdminService(mockCache)
+
+			// Act
+			_, err := cacheAdminService.InvalidateNamespace(ctx, namespace)
+
+			// Assert
+			var appErr *appErrors.AppError
+
+			require.ErrorAs(t, err, &appErr, namespace)
+			assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		}
+	})
+
+	t.Run("Failure - Cache error", func(t *testing.T) {
+		// Arrange
+		mockCache := cacheMocks.NewMockCache(t)
+		cacheAdminService := service.NewCacheAdminService(mockCache)
+		mockCache.On("InvalidateNamespace", ctx, "product_facets").Return(int64(0), errors.New("redis down")).Once()
+
+		// Act
+		_, err := cacheAdminService.InvalidateNamespace(ctx, "product_facets")
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
+	})
+}
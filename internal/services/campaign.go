@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+const (
+	campaignBatchSize = 50
+	// campaignStockGrace keeps the counters of a campaign past its end, until the next run ends it
+	campaignStockGrace = time.Hour
+)
+
+// CampaignService runs the flash sales: the products of a campaign are sold at its prices while it
+// runs, up to the units allocated to it, and at their own prices again once it ends.
+//
+// Campaigns are the only discounts of the store. They replace the price of a product instead of taking
+// an amount off it, and a product is in one campaign at a time, so no two discounts ever meet on an
+// order and there is no order to apply them in. Rules for stacking belong with the next kind of discount.
+type CampaignService interface {
+	CreateCampaign(ctx context.Context, req *models.CreateCampaignRequest) (*models.Campaign, error)
+	GetCampaign(ctx context.Context, id uuid.UUID) (*models.Campaign, error)
+	ListCampaigns(ctx context.Context, status models.CampaignStatus, page, size int) ([]*models.Campaign, int, error)
+	CancelCampaign(ctx context.Context, id uuid.UUID) (*models.Campaign, error)
+	ClaimStock(ctx context.Context, claims []models.CampaignClaim) error
+	ReleaseStock(ctx context.Context, claims []models.CampaignClaim)
+	ProcessCampaigns(ctx context.Context) (int, int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type campaignService struct {
+	repo        repository.CampaignRepository
+	stock       repository.CampaignStockRepository
+	productRepo repository.ProductRepository
+	cache       cache.Cache
+	clock       clock.Clock
+}
+
+func NewCampaignService(repo repository.CampaignRepository, stock repository.CampaignStockRepository, productRepo repository.ProductRepository, cache cache.Cache, clk clock.Clock) CampaignService {
+	return &campaignService{repo: repo, stock: stock, productRepo: productRepo, cache: cache, clock: clk}
+}
+
+// CreateCampaign schedules a campaign of platform products, each discounted and allocated no more units
+// than it has in stock. A product is in one campaign at a time.
+func (s *campaignService) CreateCampaign(ctx context.Context, req *models.CreateCampaignRequest) (*models.Campaign, error) {
+	if !req.EndsAt.After(s.clock.Now()) {
+		return nil, errors.ValidationError("A campaign must end in the future")
+	}
+
+	campaign := &models.Campaign{
+		ID:       uuid.New(),
+		Name:     req.Name,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Status:   models.CampaignStatusScheduled,
+		Items:    make([]models.CampaignItem, 0, len(req.Items)),
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.Items))
+	productIDs := make([]uuid.UUID, 0, len(req.Items))
+
+	for _, input := range req.Items {
+		if seen[input.ProductID] {
+			return nil, errors.ValidationError("Product given more than once").WithDetail(input.ProductID.String())
+		}
+
+		seen[input.ProductID] = true
+
+		product, err := s.productRepo.GetProductByID(ctx, input.ProductID)
+		if err != nil {
+			if stdErrors.Is(err, sql.ErrNoRows) {
+				return nil, errors.ValidationError("Product not found").WithDetail(input.ProductID.String())
+			}
+
+			return nil, errors.DatabaseError("Failed to get product").WithError(err)
+		}
+
+		switch {
+		case product.VendorID != nil:
+			return nil, errors.ValidationError("Vendor products cannot be in a campaign").WithDetail(product.SKU)
+		case input.CampaignPrice >= product.Price:
+			return nil, errors.ValidationError("Campaign price must be below the price of the product").WithDetail(product.SKU)
+		case input.Allocation > product.StockQuantity:
+			return nil, errors.ValidationError("Allocation exceeds the stock of the product").WithDetail(product.SKU)
+		}
+
+		campaign.Items = append(campaign.Items, models.CampaignItem{
+			ProductID:     product.ID,
+			CampaignPrice: roundCents(input.CampaignPrice),
+			Allocation:    input.Allocation,
+		})
+		productIDs = append(productIDs, product.ID)
+	}
+
+	conflicting, err := s.repo.ListConflictingProducts(ctx, productIDs, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to check campaign products").WithError(err)
+	}
+
+	if len(conflicting) > 0 {
+		ids := make([]string, 0, len(conflicting))
+		for _, id := range conflicting {
+			ids = append(ids, id.String())
+		}
+
+		return nil, errors.DuplicateEntryError("Products are already in a campaign over this period").WithDetail(strings.Join(ids, ", "))
+	}
+
+	if err := s.repo.CreateCampaign(ctx, campaign); err != nil {
+		return nil, errors.DatabaseError("Failed to create campaign").WithError(err)
+	}
+
+	return campaign, nil
+}
+
+// GetCampaign returns the campaign, with the units left of each product while it runs.
+func (s *campaignService) GetCampaign(ctx context.Context, id uuid.UUID) (*models.Campaign, error) {
+	campaign, err := s.repo.GetCampaignByID(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Campaign not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to get campaign").WithError(err)
+	}
+
+	if err := s.fillRemaining(ctx, []*models.Campaign{campaign}); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// ListCampaigns returns a page of the campaigns in the status, or of all of them when it is empty.
+func (s *campaignService) ListCampaigns(ctx context.Context, status models.CampaignStatus, page, size int) ([]*models.Campaign, int, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if size < 1 || size > 50 {
+		size = 20
+	}
+
+	campaigns, total, err := s.repo.ListCampaigns(ctx, status, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list campaigns").WithError(err)
+	}
+
+	if err := s.fillRemaining(ctx, campaigns); err != nil {
+		return nil, 0, err
+	}
+
+	return campaigns, total, nil
+}
+
+// fillRemaining reads the units left of the products of the active campaigns from their counters.
+func (s *campaignService) fillRemaining(ctx context.Context, campaigns []*models.Campaign) error {
+	var productIDs []uuid.UUID
+
+	for _, campaign := range campaigns {
+		if campaign.Status != models.CampaignStatusActive {
+			continue
+		}
+
+		for _, item := range campaign.Items {
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	remaining, err := s.stock.Remaining(ctx, productIDs)
+	if err != nil {
+		return errors.ServiceUnavailableError("Campaign stock is unavailable").WithError(err)
+	}
+
+	for _, campaign := range campaigns {
+		if campaign.Status != models.CampaignStatusActive {
+			continue
+		}
+
+		for i, item := range campaign.Items {
+			if left, ok := remaining[item.ProductID]; ok {
+				campaign.Items[i].Remaining = &left
+			}
+		}
+	}
+
+	return nil
+}
+
+// CancelCampaign calls a scheduled campaign off, or ends a running one early and restores its prices.
+func (s *campaignService) CancelCampaign(ctx context.Context, id uuid.UUID) (*models.Campaign, error) {
+	campaign, err := s.repo.GetCampaignByID(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Campaign not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to get campaign").WithError(err)
+	}
+
+	if campaign.Status != models.CampaignStatusScheduled && campaign.Status != models.CampaignStatusActive {
+		return nil, errors.InvalidTransitionError("Campaign already " + string(campaign.Status))
+	}
+
+	if err := s.endCampaign(ctx, campaign, models.CampaignStatusCancelled); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.InvalidTransitionError("Campaign ended while it was cancelled").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to cancel campaign").WithError(err)
+	}
+
+	return s.GetCampaign(ctx, id)
+}
+
+// ClaimStock takes the units of the checkout from the allocations of the running campaigns, all of them
+// or none. Products not in a running campaign are not limited. The claims taken from a campaign get its
+// ID, the one they are given back to.
+func (s *campaignService) ClaimStock(ctx context.Context, claims []models.CampaignClaim) error {
+	if len(claims) == 0 {
+		return nil
+	}
+
+	short, left, err := s.stock.Claim(ctx, claims)
+	if err != nil {
+		return errors.ServiceUnavailableError("Campaign stock is unavailable").WithError(err)
+	}
+
+	if short != nil {
+		return errors.InsufficientStockError(short.ProductID, left)
+	}
+
+	return nil
+}
+
+// ReleaseStock gives the units of a checkout that failed, or of an order cancelled since, back to the
+// allocations of the campaigns they were claimed from.
+func (s *campaignService) ReleaseStock(ctx context.Context, claims []models.CampaignClaim) {
+	if len(claims) == 0 {
+		return
+	}
+
+	if err := s.stock.Release(ctx, claims); err != nil {
+		slog.Error("Failed to release campaign stock, the units are lost to the campaign", slog.Int("claims", len(claims)), slog.String("error", err.Error()))
+	}
+}
+
+// ProcessCampaigns starts the campaigns whose start time has come and ends those whose end time has,
+// it returns how many were started and ended.
+func (s *campaignService) ProcessCampaigns(ctx context.Context) (int, int, error) {
+	now := s.clock.Now()
+	started, ended := 0, 0
+
+	toEnd, err := s.repo.ListCampaignsToEnd(ctx, now, campaignBatchSize)
+	if err != nil {
+		return 0, 0, errors.DatabaseError("Failed to list campaigns to end").WithError(err)
+	}
+
+	for _, id := range toEnd {
+		campaign, err := s.repo.GetCampaignByID(ctx, id)
+		if err != nil {
+			return started, ended, errors.DatabaseError("Failed to get campaign").WithError(err)
+		}
+
+		if err := s.endCampaign(ctx, campaign, models.CampaignStatusEnded); err != nil {
+			if stdErrors.Is(err, sql.ErrNoRows) {
+				continue // ended by another instance
+			}
+
+			return started, ended, errors.DatabaseError("Failed to end campaign").WithError(err)
+		}
+
+		ended++
+	}
+
+	toStart, err := s.repo.ListCampaignsToStart(ctx, now, campaignBatchSize)
+	if err != nil {
+		return started, ended, errors.DatabaseError("Failed to list campaigns to start").WithError(err)
+	}
+
+	for _, id := range toStart {
+		campaign, err := s.repo.GetCampaignByID(ctx, id)
+		if err != nil {
+			return started, ended, errors.DatabaseError("Failed to get campaign").WithError(err)
+		}
+
+		// a campaign missed entirely, like while the job was down, ends without ever starting
+		if !campaign.EndsAt.After(now) {
+			if err := s.endCampaign(ctx, campaign, models.CampaignStatusEnded); err != nil && !stdErrors.Is(err, sql.ErrNoRows) {
+				return started, ended, errors.DatabaseError("Failed to end campaign").WithError(err)
+			}
+
+			continue
+		}
+
+		if err := s.startCampaign(ctx, campaign, now); err != nil {
+			if stdErrors.Is(err, sql.ErrNoRows) {
+				continue // started by another instance
+			}
+
+			return started, ended, errors.DatabaseError("Failed to start campaign").WithError(err)
+		}
+
+		started++
+	}
+
+	return started, ended, nil
+}
+
+// startCampaign seeds the counters before the campaign prices apply, so no unit is sold at a campaign
+// price outside of its allocation.
+func (s *campaignService) startCampaign(ctx context.Context, campaign *models.Campaign, now time.Time) error {
+	if err := s.stock.Seed(ctx, campaign.ID, campaign.Items, campaign.EndsAt.Sub(now)+campaignStockGrace); err != nil {
+		return err
+	}
+
+	if err := s.repo.StartCampaign(ctx, campaign.ID); err != nil {
+		if !stdErrors.Is(err, sql.ErrNoRows) {
+			s.clearStock(ctx, campaign)
+		}
+
+		return err
+	}
+
+	s.invalidateProducts(ctx, campaign)
+
+	return nil
+}
+
+// endCampaign records the units sold from the counters of a running campaign, restores the prices of
+// its products and drops the counters.
+func (s *campaignService) endCampaign(ctx context.Context, campaign *models.Campaign, status models.CampaignStatus) error {
+	running := campaign.Status == models.CampaignStatusActive
+
+	var sold map[uuid.UUID]int
+
+	if running {
+		productIDs := campaignProductIDs(campaign)
+
+		remaining, err := s.stock.Remaining(ctx, productIDs)
+		if err != nil {
+			return err
+		}
+
+		sold = make(map[uuid.UUID]int, len(remaining))
+
+		for _, item := range campaign.Items {
+			if left, ok := remaining[item.ProductID]; ok {
+				sold[item.ProductID] = item.Allocation - max(left, 0)
+			}
+		}
+	}
+
+	if err := s.repo.EndCampaign(ctx, campaign.ID, status, sold); err != nil {
+		return err
+	}
+
+	if running {
+		s.clearStock(ctx, campaign)
+		s.invalidateProducts(ctx, campaign)
+	}
+
+	return nil
+}
+
+func (s *campaignService) clearStock(ctx context.Context, campaign *models.Campaign) {
+	if err := s.stock.Clear(ctx, campaignProductIDs(campaign)); err != nil {
+		slog.Warn("Failed to clear campaign stock", slog.String("campaignId", campaign.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+// invalidateProducts drops the cached views of the products repriced by the campaign, the change feed
+// picks the new prices up for search.
+func (s *campaignService) invalidateProducts(ctx context.Context, campaign *models.Campaign) {
+	for _, item := range campaign.Items {
+		if err := s.cache.Delete(ctx, productAvailabilityCacheKey(item.ProductID)); err != nil {
+			slog.Warn("Failed to invalidate product availability", slog.String("productId", item.ProductID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	if _, err := s.cache.InvalidateNamespace(ctx, productFacetsNamespace); err != nil {
+		slog.Warn("Failed to invalidate product facets", slog.String("error", err.Error()))
+	}
+}
+
+func campaignProductIDs(campaign *models.Campaign) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(campaign.Items))
+	for _, item := range campaign.Items {
+		ids = append(ids, item.ProductID)
+	}
+
+	return ids
+}
+
+// Run starts and ends the campaigns every interval until ctx is cancelled.
+func (s *campaignService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			started, ended, err := s.ProcessCampaigns(ctx)
+			if err != nil {
+				slog.Error("Processing of campaigns failed", slog.String("error", err.Error()))
+			}
+
+			if started > 0 || ended > 0 {
+				slog.Info("Campaigns processed", slog.Int("started", started), slog.Int("ended", ended))
+			}
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	cacheMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type campaignServiceMocks struct {
+	repo        *mocks.MockCampaignRepository
+	stock       *mocks.MockCampaignStockRepository
+	productRepo *mocks.MockProductRepository
+	cache       *cacheMocks.MockCache
+}
+
+func setupCampaignServiceTest(t *testing.T) (service.CampaignService, *campaignServiceMocks) {
+	m := &campaignServiceMocks{
+		repo:        mocks.NewMockCampaignRepository(t),
+		stock:       mocks.NewMockCampaignStockRepository(t),
+		productRepo: mocks.NewMockProductRepository(t),
+		cache:       cacheMocks.NewMockCache(t),
+	}
+
+	return service.NewCampaignService(m.repo, m.stock, m.productRepo, m.cache, clock.NewFake(testNow)), m
+}
+
+// expectProductInvalidation accepts the cache invalidations of a campaign starting or ending.
+func (m *campaignServiceMocks) expectProductInvalidation() {
+	m.cache.On("Delete", mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.cache.On("InvalidateNamespace", mock.Anything, mock.Anything).Return(int64(1), nil).Maybe()
+}
+
+func TestCampaignService_CreateCampaign(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+	product := &models.Product{ID: productID, SKU: "TSHIRT-M", Price: 20.0, StockQuantity: 50, Status: models.ProductStatusActive}
+
+	newRequest := func(price float64, allocation int) *models.CreateCampaignRequest {
+		return &models.CreateCampaignRequest{
+			Name:     "Weekend flash sale",
+			StartsAt: testNow.Add(time.Hour),
+			EndsAt:   testNow.Add(25 * time.Hour),
+			Items:    []models.CreateCampaignItemRequest{{ProductID: productID, CampaignPrice: price, Allocation: allocation}},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(product, nil).Once()
+		m.repo.On("ListConflictingProducts", ctx, []uuid.UUID{productID}, testNow.Add(time.Hour), testNow.Add(25*time.Hour)).Return(nil, nil).Once()
+		m.repo.On("CreateCampaign", ctx, mock.MatchedBy(func(c *models.Campaign) bool {
+			return c.Status == models.CampaignStatusScheduled && len(c.Items) == 1 && c.Items[0].CampaignPrice == 14.99
+		})).Return(nil).Once()
+
+		// Act
+		campaign, err := campaignService.CreateCampaign(ctx, newRequest(14.994, 30))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 30, campaign.Items[0].Allocation)
+	})
+
+	t.Run("Failure - Price not discounted", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(product, nil).Once()
+
+		// Act
+		campaign, err := campaignService.CreateCampaign(ctx, newRequest(20.0, 30))
+
+		// Assert
+		assert.Nil(t, campaign)
+		assertAppErrorCode(t, err, appErrors.ErrCodeValidation)
+		m.repo.AssertNotCalled(t, "CreateCampaign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Allocation above stock", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(product, nil).Once()
+
+		// Act
+		campaign, err := campaignService.CreateCampaign(ctx, newRequest(15.0, 51))
+
+		// Assert
+		assert.Nil(t, campaign)
+		assertAppErrorCode(t, err, appErrors.ErrCodeValidation)
+	})
+
+	t.Run("Failure - Product already in a campaign", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(product, nil).Once()
+		m.repo.On("ListConflictingProducts", ctx, []uuid.UUID{productID}, mock.Anything, mock.Anything).Return([]uuid.UUID{productID}, nil).Once()
+
+		// Act
+		campaign, err := campaignService.CreateCampaign(ctx, newRequest(15.0, 30))
+
+		// Assert
+		assert.Nil(t, campaign)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDuplicateEntry)
+		m.repo.AssertNotCalled(t, "CreateCampaign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Ends in the past", func(t *testing.T) {
+		// Arrange
+		campaignService, _ := setupCampaignServiceTest(t)
+		req := newRequest(15.0, 30)
+		req.StartsAt = testNow.Add(-2 * time.Hour)
+		req.EndsAt = testNow.Add(-time.Hour)
+
+		// Act
+		campaign, err := campaignService.CreateCampaign(ctx, req)
+
+		// Assert
+		assert.Nil(t, campaign)
+		assertAppErrorCode(t, err, appErrors.ErrCodeValidation)
+	})
+}
+
+func TestCampaignService_ClaimStock(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+	claims := []models.CampaignClaim{{ProductID: productID, Quantity: 3}}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.stock.On("Claim", ctx, claims).Return(nil, 0, nil).Once()
+
+		// Act
+		err := campaignService.ClaimStock(ctx, claims)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Allocation sold out", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.stock.On("Claim", ctx, claims).Return(&claims[0], 2, nil).Once()
+
+		// Act
+		err := campaignService.ClaimStock(ctx, claims)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeInsufficientStock, appErr.Code)
+		assert.Contains(t, appErr.Message, productID.String())
+	})
+
+	t.Run("Failure - Redis unavailable", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.stock.On("Claim", ctx, claims).Return(nil, 0, errors.New("connection refused")).Once()
+
+		// Act
+		err := campaignService.ClaimStock(ctx, claims)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeServiceUnavailable)
+	})
+}
+
+func TestCampaignService_ReleaseStock(t *testing.T) {
+	ctx := t.Context()
+	claims := []models.CampaignClaim{{ProductID: uuid.New(), Quantity: 3, CampaignID: uuid.New()}}
+
+	t.Run("Success - Claimed units are given back", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.stock.On("Release", ctx, claims).Return(nil).Once()
+
+		// Act
+		campaignService.ReleaseStock(ctx, claims)
+	})
+
+	t.Run("Success - Nothing claimed leaves the counters alone", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+
+		// Act
+		campaignService.ReleaseStock(ctx, nil)
+
+		// Assert
+		m.stock.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCampaignService_ProcessCampaigns(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+
+	t.Run("Success - Starts a due campaign", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.expectProductInvalidation()
+		campaign := &models.Campaign{
+			ID:       uuid.New(),
+			StartsAt: testNow.Add(-time.Minute),
+			EndsAt:   testNow.Add(2 * time.Hour),
+			Status:   models.CampaignStatusScheduled,
+			Items:    []models.CampaignItem{{ProductID: productID, CampaignPrice: 5.0, Allocation: 10}},
+		}
+
+		m.repo.On("ListCampaignsToEnd", ctx, testNow, mock.Anything).Return(nil, nil).Once()
+		m.repo.On("ListCampaignsToStart", ctx, testNow, mock.Anything).Return([]uuid.UUID{campaign.ID}, nil).Once()
+		m.repo.On("GetCampaignByID", ctx, campaign.ID).Return(campaign, nil).Once()
+		m.stock.On("Seed", ctx, campaign.ID, campaign.Items, 3*time.Hour).Return(nil).Once()
+		m.repo.On("StartCampaign", ctx, campaign.ID).Return(nil).Once()
+
+		// Act
+		started, ended, err := campaignService.ProcessCampaigns(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, started)
+		assert.Equal(t, 0, ended)
+	})
+
+	t.Run("Success - Ends a campaign and counts the units sold", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.expectProductInvalidation()
+		campaign := &models.Campaign{
+			ID:       uuid.New(),
+			StartsAt: testNow.Add(-2 * time.Hour),
+			EndsAt:   testNow.Add(-time.Minute),
+			Status:   models.CampaignStatusActive,
+			Items:    []models.CampaignItem{{ProductID: productID, CampaignPrice: 5.0, Allocation: 10}},
+		}
+
+		m.repo.On("ListCampaignsToEnd", ctx, testNow, mock.Anything).Return([]uuid.UUID{campaign.ID}, nil).Once()
+		m.repo.On("GetCampaignByID", ctx, campaign.ID).Return(campaign, nil).Once()
+		m.stock.On("Remaining", ctx, []uuid.UUID{productID}).Return(map[uuid.UUID]int{productID: 4}, nil).Once()
+		m.repo.On("EndCampaign", ctx, campaign.ID, models.CampaignStatusEnded, map[uuid.UUID]int{productID: 6}).Return(nil).Once()
+		m.stock.On("Clear", ctx, []uuid.UUID{productID}).Return(nil).Once()
+		m.repo.On("ListCampaignsToStart", ctx, testNow, mock.Anything).Return(nil, nil).Once()
+
+		// Act
+		started, ended, err := campaignService.ProcessCampaigns(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, started)
+		assert.Equal(t, 1, ended)
+	})
+
+	t.Run("Success - Campaign started by another instance", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		campaign := &models.Campaign{
+			ID:       uuid.New(),
+			StartsAt: testNow.Add(-time.Minute),
+			EndsAt:   testNow.Add(time.Hour),
+			Status:   models.CampaignStatusScheduled,
+			Items:    []models.CampaignItem{{ProductID: productID, CampaignPrice: 5.0, Allocation: 10}},
+		}
+
+		m.repo.On("ListCampaignsToEnd", ctx, testNow, mock.Anything).Return(nil, nil).Once()
+		m.repo.On("ListCampaignsToStart", ctx, testNow, mock.Anything).Return([]uuid.UUID{campaign.ID}, nil).Once()
+		m.repo.On("GetCampaignByID", ctx, campaign.ID).Return(campaign, nil).Once()
+		m.stock.On("Seed", ctx, campaign.ID, campaign.Items, mock.Anything).Return(nil).Once()
+		m.repo.On("StartCampaign", ctx, campaign.ID).Return(sql.ErrNoRows).Once()
+
+		// Act
+		started, _, err := campaignService.ProcessCampaigns(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, started)
+		m.stock.AssertNotCalled(t, "Clear", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Missed campaign ends without starting", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		campaign := &models.Campaign{
+			ID:       uuid.New(),
+			StartsAt: testNow.Add(-3 * time.Hour),
+			EndsAt:   testNow.Add(-time.Hour),
+			Status:   models.CampaignStatusScheduled,
+			Items:    []models.CampaignItem{{ProductID: productID, CampaignPrice: 5.0, Allocation: 10}},
+		}
+
+		m.repo.On("ListCampaignsToEnd", ctx, testNow, mock.Anything).Return(nil, nil).Once()
+		m.repo.On("ListCampaignsToStart", ctx, testNow, mock.Anything).Return([]uuid.UUID{campaign.ID}, nil).Once()
+		m.repo.On("GetCampaignByID", ctx, campaign.ID).Return(campaign, nil).Once()
+		m.repo.On("EndCampaign", ctx, campaign.ID, models.CampaignStatusEnded, map[uuid.UUID]int(nil)).Return(nil).Once()
+
+		// Act
+		started, _, err := campaignService.ProcessCampaigns(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, started)
+		m.stock.AssertNotCalled(t, "Seed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCampaignService_CancelCampaign(t *testing.T) {
+	ctx := t.Context()
+	campaignID := uuid.New()
+
+	t.Run("Success - Scheduled campaign", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		scheduled := &models.Campaign{ID: campaignID, Status: models.CampaignStatusScheduled}
+		m.repo.On("GetCampaignByID", ctx, campaignID).Return(scheduled, nil).Once()
+		m.repo.On("EndCampaign", ctx, campaignID, models.CampaignStatusCancelled, map[uuid.UUID]int(nil)).Return(nil).Once()
+		m.repo.On("GetCampaignByID", ctx, campaignID).Return(&models.Campaign{ID: campaignID, Status: models.CampaignStatusCancelled}, nil).Once()
+
+		// Act
+		campaign, err := campaignService.CancelCampaign(ctx, campaignID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.CampaignStatusCancelled, campaign.Status)
+	})
+
+	t.Run("Failure - Already ended", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.repo.On("GetCampaignByID", ctx, campaignID).Return(&models.Campaign{ID: campaignID, Status: models.CampaignStatusEnded}, nil).Once()
+
+		// Act
+		campaign, err := campaignService.CancelCampaign(ctx, campaignID)
+
+		// Assert
+		assert.Nil(t, campaign)
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		campaignService, m := setupCampaignServiceTest(t)
+		m.repo.On("GetCampaignByID", ctx, campaignID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		campaign, err := campaignService.CancelCampaign(ctx, campaignID)
+
+		// Assert
+		assert.Nil(t, campaign)
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appError "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
@@ -20,21 +21,26 @@ type CartService interface {
 }
 
 type cartService struct {
-	repo repository.CartRepository
+	repo        repository.CartRepository
+	productRepo repository.ProductRepository
+	orderRepo   repository.OrderRepository
+	clock       clock.Clock
 }
 
-func NewCartService(repo repository.CartRepository) CartService {
-	return &cartService{repo: repo}
+func NewCartService(repo repository.CartRepository, productRepo repository.ProductRepository, orderRepo repository.OrderRepository, clk clock.Clock) CartService {
+	return &cartService{repo: repo, productRepo: productRepo, orderRepo: orderRepo, clock: clk}
 }
 
 func (s *cartService) CreateCart(ctx context.Context, userID uuid.UUID) (*models.Cart, error) {
+	now := s.clock.Now()
+
 	cart := &models.Cart{
 		ID:        uuid.New(),
 		UserID:    userID,
 		Items:     make(map[string]models.CartItem),
 		Total:     0,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
 	err := s.repo.CreateCart(ctx, cart)
@@ -64,6 +70,24 @@ func (s *cartService) AddItem(ctx context.Context, customerID uuid.UUID, req *mo
 		return nil, appError.NotFoundError("Cart not found").WithError(err)
 	}
 
+	// Only products for sale go in a cart, the checkout checks them again in case they were taken off sale since
+	product, err := s.productRepo.GetProductByID(ctx, req.ProductID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appError.NotFoundError("Product not found").WithError(err)
+		}
+
+		return nil, appError.DatabaseError("Failed to get product").WithError(err)
+	}
+
+	if product.Status != models.ProductStatusActive {
+		return nil, appError.ProductNotForSaleError(product.ID, product.Status)
+	}
+
+	if err := checkPurchaseLimit(ctx, s.orderRepo, product, customerID, req.Quantity, s.clock.Now()); err != nil {
+		return nil, err
+	}
+
 	item := models.CartItem{
 		ProductID:  req.ProductID,
 		Quantity:   req.Quantity,
@@ -72,7 +96,7 @@ func (s *cartService) AddItem(ctx context.Context, customerID uuid.UUID, req *mo
 	}
 
 	cart.Items[req.ProductID.String()] = item
-	cart.UpdatedAt = time.Now()
+	cart.UpdatedAt = s.clock.Now()
 	cart.Total = s.calculateTotal(cart.Items)
 
 	if err := s.repo.UpdateCart(ctx, cart); err != nil {
@@ -93,6 +117,21 @@ func (s *cartService) UpdateQuantity(ctx context.Context, customerID uuid.UUID,
 		return nil, appError.BadRequestError("Item not found in the cart")
 	}
 
+	if req.Quantity > item.Quantity {
+		product, err := s.productRepo.GetProductByID(ctx, req.ProductID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, appError.NotFoundError("Product not found").WithError(err)
+			}
+
+			return nil, appError.DatabaseError("Failed to get product").WithError(err)
+		}
+
+		if err := checkPurchaseLimit(ctx, s.orderRepo, product, customerID, req.Quantity, s.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.Quantity == 0 {
 		delete(cart.Items, req.ProductID.String())
 	} else {
@@ -102,7 +141,7 @@ func (s *cartService) UpdateQuantity(ctx context.Context, customerID uuid.UUID,
 	}
 
 	// update the cart
-	cart.UpdatedAt = time.Now()
+	cart.UpdatedAt = s.clock.Now()
 	cart.Total = s.calculateTotal(cart.Items)
 
 	err = s.repo.UpdateCart(ctx, cart)
@@ -122,3 +161,31 @@ func (s *cartService) calculateTotal(items map[string]models.CartItem) float64 {
 
 	return totalPrice
 }
+
+// checkPurchaseLimit refuses quantity units of the product when they break its limit per order, or
+// its limit per customer counting what the customer ordered within the limit period.
+func checkPurchaseLimit(ctx context.Context, orderRepo repository.OrderRepository, product *models.Product, customerID uuid.UUID, quantity int, now time.Time) error {
+	if product.MaxPerOrder != nil && quantity > *product.MaxPerOrder {
+		return appError.PurchaseLimitError(product.ID, *product.MaxPerOrder, 0)
+	}
+
+	if product.MaxPerCustomer == nil {
+		return nil
+	}
+
+	var since time.Time
+	if product.LimitPeriodDays != nil {
+		since = now.AddDate(0, 0, -*product.LimitPeriodDays)
+	}
+
+	purchased, err := orderRepo.CountPurchasedUnits(ctx, customerID, product.ID, since)
+	if err != nil {
+		return appError.DatabaseError("Failed to check purchase limit").WithError(err)
+	}
+
+	if purchased+quantity > *product.MaxPerCustomer {
+		return appError.PurchaseLimitError(product.ID, *product.MaxPerCustomer, purchased)
+	}
+
+	return nil
+}
@@ -3,9 +3,11 @@ package service_test
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
@@ -13,11 +15,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateCart(t *testing.T) {
 	mockRepo := mocks.NewMockCartRepository(t)
-	cartService := service.NewCartService(mockRepo)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	cartService := service.NewCartService(mockRepo, mockProductRepo, mockOrderRepo, clock.NewFake(testNow))
 	ctx := t.Context()
 	userID := uuid.New()
 
@@ -35,8 +40,8 @@ func TestCreateCart(t *testing.T) {
 		assert.NotEqual(t, uuid.Nil, cart.ID)
 		assert.Empty(t, cart.Items)
 		assert.Equal(t, float64(0), cart.Total)
-		assert.WithinDuration(t, time.Now(), cart.CreatedAt, time.Second) // Check if created recently
-		assert.WithinDuration(t, time.Now(), cart.UpdatedAt, time.Second) // Check if updated recently
+		assert.Equal(t, testNow, cart.CreatedAt)
+		assert.Equal(t, testNow, cart.UpdatedAt)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -63,7 +68,9 @@ func TestCreateCart(t *testing.T) {
 
 func TestGetCart(t *testing.T) {
 	mockRepo := mocks.NewMockCartRepository(t)
-	cartService := service.NewCartService(mockRepo)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	cartService := service.NewCartService(mockRepo, mockProductRepo, mockOrderRepo, clock.NewFake(testNow))
 	ctx := t.Context()
 	customerID := uuid.New()
 	existingCart := &models.Cart{
@@ -131,7 +138,9 @@ func TestGetCart(t *testing.T) {
 
 func TestAddItem(t *testing.T) {
 	mockRepo := mocks.NewMockCartRepository(t)
-	cartService := service.NewCartService(mockRepo)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	cartService := service.NewCartService(mockRepo, mockProductRepo, mockOrderRepo, clock.NewFake(testNow))
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New()
@@ -149,12 +158,14 @@ func TestAddItem(t *testing.T) {
 		Quantity:  2,
 		UnitPrice: 10.50,
 	}
+	activeProduct := &models.Product{ID: productID1, Status: models.ProductStatusActive}
 
 	t.Run("Success - Add New Item", func(t *testing.T) {
 		// Arrange:
 		// 1. Expect GetCartByCustomerID to return the existing empty cart
 		// 2. Expect UpdateCart to be called with the updated cart and return nil error
 		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(activeProduct, nil).Once()
 		mockRepo.On("UpdateCart", ctx, mock.MatchedBy(func(cart *models.Cart) bool {
 			item, exists := cart.Items[productID1.String()]
 
@@ -181,7 +192,7 @@ func TestAddItem(t *testing.T) {
 		assert.Equal(t, 10.50, item.UnitPrice)
 		assert.Equal(t, 21.00, item.TotalPrice)
 		assert.Equal(t, 21.00, updatedCart.Total)
-		assert.WithinDuration(t, time.Now(), updatedCart.UpdatedAt, time.Second)
+		assert.Equal(t, testNow, updatedCart.UpdatedAt)
 		mockRepo.AssertExpectations(t)
 
 		existingCart.Items = make(map[string]models.CartItem)
@@ -195,6 +206,7 @@ func TestAddItem(t *testing.T) {
 		addItemReq2 := &models.AddItemRequest{ProductID: productID2, Quantity: 3, UnitPrice: 2.0}
 
 		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID2).Return(&models.Product{ID: productID2, Status: models.ProductStatusActive}, nil).Once()
 		mockRepo.On("UpdateCart", ctx, mock.MatchedBy(func(cart *models.Cart) bool {
 			item1, exists1 := cart.Items[productID1.String()]
 			item2, exists2 := cart.Items[productID2.String()]
@@ -247,6 +259,7 @@ func TestAddItem(t *testing.T) {
 		dbError := errors.New("failed to write to db")
 
 		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(activeProduct, nil).Once()
 		mockRepo.On("UpdateCart", ctx, mock.AnythingOfType("*models.Cart")).Return(dbError).Once()
 
 		// Act
@@ -266,11 +279,107 @@ func TestAddItem(t *testing.T) {
 		existingCart.Items = make(map[string]models.CartItem)
 		existingCart.Total = 0
 	})
+
+	t.Run("Failure - Product not for sale", func(t *testing.T) {
+		for _, status := range []string{models.ProductStatusDraft, models.ProductStatusScheduled, models.ProductStatusInactive, models.ProductStatusDiscontinued} {
+			// Arrange
+			mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+			mockProductRepo.On("GetProductByID", ctx, productID1).Return(&models.Product{ID: productID1, Status: status}, nil).Once()
+
+			// Act
+			cart, err := cartService.AddItem(ctx, customerID, addItemReq)
+
+			// Assert
+			assert.Nil(t, cart)
+
+			var appErr *appErrors.AppError
+
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, appErrors.ErrCodeProductNotForSale, appErr.Code, status)
+		}
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(nil, fmt.Errorf("querying database: %w", sql.ErrNoRows)).Once()
+
+		// Act
+		_, err := cartService.AddItem(ctx, customerID, addItemReq)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Over the limit per order", func(t *testing.T) {
+		// Arrange
+		maxPerOrder := 1
+		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(&models.Product{ID: productID1, Status: models.ProductStatusActive, MaxPerOrder: &maxPerOrder}, nil).Once()
+
+		// Act
+		cart, err := cartService.AddItem(ctx, customerID, addItemReq)
+
+		// Assert
+		assert.Nil(t, cart)
+
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodePurchaseLimit, appErr.Code)
+		assert.Equal(t, &appErrors.ErrPurchaseLimit{ProductID: productID1, Limit: 1, Purchased: 0}, appErr.Meta)
+	})
+
+	t.Run("Failure - Over the limit per customer within the period", func(t *testing.T) {
+		// Arrange
+		maxPerCustomer, periodDays := 3, 30
+		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).
+			Return(&models.Product{ID: productID1, Status: models.ProductStatusActive, MaxPerCustomer: &maxPerCustomer, LimitPeriodDays: &periodDays}, nil).Once()
+		mockOrderRepo.On("CountPurchasedUnits", ctx, customerID, productID1, testNow.AddDate(0, 0, -30)).Return(2, nil).Once()
+
+		// Act
+		cart, err := cartService.AddItem(ctx, customerID, addItemReq)
+
+		// Assert
+		assert.Nil(t, cart)
+
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodePurchaseLimit, appErr.Code)
+		assert.Equal(t, &appErrors.ErrPurchaseLimit{ProductID: productID1, Limit: 3, Purchased: 2}, appErr.Meta)
+	})
+
+	t.Run("Success - Within the limit per customer of every order", func(t *testing.T) {
+		// Arrange
+		maxPerCustomer := 3
+		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(existingCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).
+			Return(&models.Product{ID: productID1, Status: models.ProductStatusActive, MaxPerCustomer: &maxPerCustomer}, nil).Once()
+		mockOrderRepo.On("CountPurchasedUnits", ctx, customerID, productID1, time.Time{}).Return(1, nil).Once()
+		mockRepo.On("UpdateCart", ctx, mock.AnythingOfType("*models.Cart")).Return(nil).Once()
+
+		// Act
+		cart, err := cartService.AddItem(ctx, customerID, addItemReq)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, cart.Items[productID1.String()].Quantity)
+
+		existingCart.Items = make(map[string]models.CartItem)
+		existingCart.Total = 0
+	})
 }
 
 func TestCartService_UpdateQuantity(t *testing.T) {
 	mockRepo := mocks.NewMockCartRepository(t)
-	cartService := service.NewCartService(mockRepo)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	cartService := service.NewCartService(mockRepo, mockProductRepo, mockOrderRepo, clock.NewFake(testNow))
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New()
@@ -309,6 +418,7 @@ func TestCartService_UpdateQuantity(t *testing.T) {
 		updateReq := &models.UpdateQuantityRequest{ProductID: productID1, Quantity: 5}
 
 		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(initialCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(&models.Product{ID: productID1, Status: models.ProductStatusActive}, nil).Once()
 		mockRepo.On("UpdateCart", ctx, mock.MatchedBy(func(cart *models.Cart) bool {
 			item, exists := cart.Items[productID1.String()]
 
@@ -329,7 +439,7 @@ func TestCartService_UpdateQuantity(t *testing.T) {
 		assert.Equal(t, 5, item.Quantity)
 		assert.Equal(t, 50.0, item.TotalPrice)
 		assert.Equal(t, 50.0, updatedCart.Total)
-		assert.WithinDuration(t, time.Now(), updatedCart.UpdatedAt, time.Second)
+		assert.Equal(t, testNow, updatedCart.UpdatedAt)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -355,7 +465,7 @@ func TestCartService_UpdateQuantity(t *testing.T) {
 		assert.NotNil(t, updatedCart)
 		assert.Empty(t, updatedCart.Items) // Cart should be empty
 		assert.Equal(t, 0.0, updatedCart.Total)
-		assert.WithinDuration(t, time.Now(), updatedCart.UpdatedAt, time.Second)
+		assert.Equal(t, testNow, updatedCart.UpdatedAt)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -410,6 +520,7 @@ func TestCartService_UpdateQuantity(t *testing.T) {
 		dbError := errors.New("db write constraint failed")
 
 		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(initialCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(&models.Product{ID: productID1, Status: models.ProductStatusActive}, nil).Once()
 		mockRepo.On("UpdateCart", ctx, mock.AnythingOfType("*models.Cart")).Return(dbError).Once()
 
 		// Act
@@ -426,4 +537,26 @@ func TestCartService_UpdateQuantity(t *testing.T) {
 		assert.ErrorIs(t, err, dbError)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("Failure - Raised over the limit per order", func(t *testing.T) {
+		resetState()
+		// Arrange
+		maxPerOrder := 3
+		updateReq := &models.UpdateQuantityRequest{ProductID: productID1, Quantity: 4}
+
+		mockRepo.On("GetCartByCustomerID", ctx, customerID).Return(initialCart, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID1).Return(&models.Product{ID: productID1, Status: models.ProductStatusActive, MaxPerOrder: &maxPerOrder}, nil).Once()
+
+		// Act
+		cart, err := cartService.UpdateQuantity(ctx, customerID, updateReq)
+
+		// Assert
+		assert.Nil(t, cart)
+
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodePurchaseLimit, appErr.Code)
+		mockRepo.AssertNotCalled(t, "UpdateCart", mock.Anything, mock.Anything)
+	})
 }
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"log/slog"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storefront"
+	"github.com/google/uuid"
+)
+
+// catalogImportLease is how long a running import stays with its instance without saving a batch,
+// after that it is taken over by the resume scan.
+const catalogImportLease = 10 * time.Minute
+
+// Limits of CreateProductRequest, imported products must be valid products too
+const (
+	importMinNameLength = 3
+	importMaxNameLength = 200
+	importMinSKULength  = 3
+	importMaxSKULength  = 50
+)
+
+type CatalogImportService interface {
+	CreateImport(ctx context.Context, adminID uuid.UUID, req *models.CreateCatalogImportRequest) (*models.CatalogImport, error)
+	GetImport(ctx context.Context, id uuid.UUID) (*models.CatalogImport, error)
+	ListImportItems(ctx context.Context, id uuid.UUID, action models.CatalogImportAction, page, pageSize int) ([]*models.CatalogImportItem, int, error)
+	Run(ctx context.Context, resumeInterval time.Duration)
+}
+
+// CatalogImportPolicy sizes the job queue, the report is saved every BatchSize variants.
+type CatalogImportPolicy struct {
+	BatchSize int
+	QueueSize int
+}
+
+type catalogImportService struct {
+	repo          repository.CatalogImportRepository
+	productRepo   repository.ProductRepository
+	inventoryRepo repository.InventoryRepository
+	cache         cache.Cache
+	sources       map[models.CatalogImportSource]storefront.Client
+	policy        CatalogImportPolicy
+	queue         chan uuid.UUID
+	clock         clock.Clock
+}
+
+// NewCatalogImportService imports from the configured sources only, imports of other sources are rejected.
+func NewCatalogImportService(repo repository.CatalogImportRepository, productRepo repository.ProductRepository, inventoryRepo repository.InventoryRepository, cache cache.Cache, sources map[models.CatalogImportSource]storefront.Client, policy CatalogImportPolicy, clk clock.Clock) CatalogImportService {
+	policy.BatchSize = max(policy.BatchSize, 1)
+
+	return &catalogImportService{
+		repo:          repo,
+		productRepo:   productRepo,
+		inventoryRepo: inventoryRepo,
+		cache:         cache,
+		sources:       sources,
+		policy:        policy,
+		queue:         make(chan uuid.UUID, max(policy.QueueSize, 1)),
+		clock:         clk,
+	}
+}
+
+func (s *catalogImportService) CreateImport(ctx context.Context, adminID uuid.UUID, req *models.CreateCatalogImportRequest) (*models.CatalogImport, error) {
+	if _, ok := s.sources[req.Source]; !ok {
+		return nil, errors.BadRequestError("Catalog import source is not configured")
+	}
+
+	catalogImport := &models.CatalogImport{
+		ID:             utils.NewID(),
+		Source:         req.Source,
+		DryRun:         req.DryRun,
+		UpdateExisting: req.UpdateExisting,
+		Mapping:        req.Mapping,
+		Status:         models.CatalogImportStatusPending,
+		CreatedBy:      adminID,
+	}
+
+	if catalogImport.Mapping.PriceMultiplier == 0 {
+		catalogImport.Mapping.PriceMultiplier = 1
+	}
+
+	if catalogImport.Mapping.Status == "" {
+		catalogImport.Mapping.Status = "inactive"
+	}
+
+	if err := s.repo.CreateCatalogImport(ctx, catalogImport); err != nil {
+		return nil, errors.DatabaseError("Failed to create catalog import").WithError(err)
+	}
+
+	// An import that doesn't fit in the queue stays pending until the next resume scan
+	select {
+	case s.queue <- catalogImport.ID:
+	default:
+		slog.Warn("Catalog import queue is full, deferring import", slog.String("importId", catalogImport.ID.String()))
+	}
+
+	return catalogImport, nil
+}
+
+func (s *catalogImportService) GetImport(ctx context.Context, id uuid.UUID) (*models.CatalogImport, error) {
+	catalogImport, err := s.repo.GetCatalogImport(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Catalog import not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch catalog import").WithError(err)
+	}
+
+	return catalogImport, nil
+}
+
+// ListImportItems pages through the diff report of the import, an empty action lists every item.
+func (s *catalogImportService) ListImportItems(ctx context.Context, id uuid.UUID, action models.CatalogImportAction, page, pageSize int) ([]*models.CatalogImportItem, int, error) {
+	if _, err := s.GetImport(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	items, total, err := s.repo.ListCatalogImportItems(ctx, id, action, page, pageSize)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list catalog import items").WithError(err)
+	}
+
+	return items, total, nil
+}
+
+// Run works through the queued imports one at a time until ctx is cancelled. Every resumeInterval
+// the imports left pending or abandoned by another instance are queued again.
+func (s *catalogImportService) Run(ctx context.Context, resumeInterval time.Duration) {
+	if resumeInterval <= 0 {
+		resumeInterval = time.Minute
+	}
+
+	ticker := time.NewTicker(resumeInterval)
+	defer ticker.Stop()
+
+	s.resume(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-s.queue:
+			s.process(ctx, id)
+		case <-ticker.C:
+			s.resume(ctx)
+		}
+	}
+}
+
+func (s *catalogImportService) resume(ctx context.Context) {
+	ids, err := s.repo.ListResumableCatalogImports(ctx, s.clock.Now())
+	if err != nil {
+		slog.Error("Failed to list resumable catalog imports", slog.String("error", err.Error()))
+
+		return
+	}
+
+	for _, id := range ids {
+		select {
+		case s.queue <- id:
+		default:
+			return
+		}
+	}
+}
+
+// process fetches the whole catalog of the source and imports it variant by variant. An interrupted
+// import is started over by whichever instance claims it next.
+func (s *catalogImportService) process(ctx context.Context, id uuid.UUID) {
+	logger := slog.With(slog.String("importId", id.String()))
+
+	catalogImport, err := s.repo.ClaimCatalogImport(ctx, id, s.clock.Now(), s.clock.Now().Add(catalogImportLease))
+	if err != nil {
+		// Claimed by another instance or already finished
+		if !stdErrors.Is(err, sql.ErrNoRows) {
+			logger.Error("Failed to claim catalog import", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	client, ok := s.sources[catalogImport.Source]
+	if !ok {
+		s.finish(ctx, logger, catalogImport, "catalog import source is not configured")
+
+		return
+	}
+
+	products, err := client.ListProducts(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.finish(ctx, logger, catalogImport, err.Error())
+		}
+
+		return
+	}
+
+	seen := make(map[string]bool)
+	batch := make([]*models.CatalogImportItem, 0, s.policy.BatchSize)
+
+	for _, product := range products {
+		for _, variant := range product.Variants {
+			if ctx.Err() != nil {
+				// Hand the import over right away, the next claim starts it over
+				if err := s.repo.SaveCatalogImportBatch(context.WithoutCancel(ctx), catalogImport, batch, s.clock.Now()); err != nil {
+					logger.Error("Failed to save catalog import batch", slog.String("error", err.Error()))
+				}
+
+				return
+			}
+
+			item := s.importVariant(ctx, catalogImport, &product, &variant, seen)
+			countCatalogImportItem(catalogImport, item.Action)
+
+			batch = append(batch, item)
+			if len(batch) < s.policy.BatchSize {
+				continue
+			}
+
+			if err := s.repo.SaveCatalogImportBatch(ctx, catalogImport, batch, s.clock.Now().Add(catalogImportLease)); err != nil {
+				logger.Error("Failed to save catalog import batch", slog.String("error", err.Error()))
+
+				return
+			}
+
+			batch = make([]*models.CatalogImportItem, 0, s.policy.BatchSize)
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := s.repo.SaveCatalogImportBatch(ctx, catalogImport, batch, s.clock.Now().Add(catalogImportLease)); err != nil {
+			logger.Error("Failed to save catalog import batch", slog.String("error", err.Error()))
+
+			return
+		}
+	}
+
+	s.finish(ctx, logger, catalogImport, "")
+}
+
+func countCatalogImportItem(catalogImport *models.CatalogImport, action models.CatalogImportAction) {
+	switch action {
+	case models.CatalogImportActionCreate:
+		catalogImport.Created++
+	case models.CatalogImportActionUpdate:
+		catalogImport.Updated++
+	case models.CatalogImportActionUnchanged:
+		catalogImport.Unchanged++
+	case models.CatalogImportActionSkip:
+		catalogImport.Skipped++
+	case models.CatalogImportActionFail:
+		catalogImport.Failed++
+	}
+}
+
+// finish completes the import, or fails it with failure.
+func (s *catalogImportService) finish(ctx context.Context, logger *slog.Logger, catalogImport *models.CatalogImport, failure string) {
+	catalogImport.Status = models.CatalogImportStatusCompleted
+	catalogImport.Error = failure
+
+	if failure != "" {
+		catalogImport.Status = models.CatalogImportStatusFailed
+	}
+
+	if err := s.repo.FinishCatalogImport(ctx, catalogImport); err != nil {
+		logger.Error("Failed to finish catalog import", slog.String("error", err.Error()))
+
+		return
+	}
+
+	// The facet counts of the whole catalog moved, drop them at once rather than let them lag
+	if !catalogImport.DryRun && catalogImport.Created+catalogImport.Updated > 0 {
+		if _, err := s.cache.InvalidateNamespace(ctx, productFacetsNamespace); err != nil {
+			logger.Warn("Failed to invalidate product facets", slog.String("error", err.Error()))
+		}
+	}
+
+	logger.Info("Catalog import finished", slog.String("status", string(catalogImport.Status)), slog.Bool("dryRun", catalogImport.DryRun),
+		slog.Int("created", catalogImport.Created), slog.Int("updated", catalogImport.Updated), slog.Int("failed", catalogImport.Failed))
+}
+
+// importVariant maps the variant to a product and creates or updates it, a dry run only reports what it would do.
+func (s *catalogImportService) importVariant(ctx context.Context, catalogImport *models.CatalogImport, product *storefront.Product, variant *storefront.Variant, seen map[string]bool) *models.CatalogImportItem {
+	mapping := catalogImport.Mapping
+
+	item := &models.CatalogImportItem{
+		ID:         utils.NewID(),
+		ImportID:   catalogImport.ID,
+		ExternalID: variant.ExternalID,
+		SKU:        mapping.SKUPrefix + variant.SKU,
+		Name:       importedProductName(product.Title, variant.Title),
+	}
+
+	fail := func(reason string) *models.CatalogImportItem {
+		item.Action = models.CatalogImportActionFail
+		item.Error = reason
+
+		return item
+	}
+
+	switch {
+	case variant.SKU == "":
+		return fail("variant has no SKU")
+	case seen[item.SKU]:
+		return fail("SKU appears more than once in the source")
+	case len(item.SKU) < importMinSKULength || len(item.SKU) > importMaxSKULength:
+		return fail("SKU must be between 3 and 50 characters")
+	case utf8.RuneCountInString(item.Name) < importMinNameLength:
+		return fail("name must be at least 3 characters")
+	case variant.Price <= 0:
+		return fail("price must be greater than zero")
+	case variant.Barcode != "" && !validImportedBarcode(variant.Barcode):
+		return fail("invalid barcode")
+	}
+
+	seen[item.SKU] = true
+
+	desired := &models.Product{
+		CategoryID:    mapping.DefaultCategoryID,
+		Name:          item.Name,
+		Price:         roundCents(variant.Price * mapping.PriceMultiplier),
+		StockQuantity: max(variant.Stock, 0),
+		SKU:           item.SKU,
+		Barcode:       variant.Barcode,
+		Status:        mapping.Status,
+	}
+
+	if categoryID, ok := mapping.Categories[product.ProductType]; ok {
+		desired.CategoryID = categoryID
+	}
+
+	if !mapping.SkipDescription {
+		desired.Description = product.Description
+	}
+
+	if !mapping.SkipImages {
+		desired.Images = product.Images
+	}
+
+	existing, err := s.productRepo.GetProductBySKU(ctx, item.SKU)
+	if err != nil && !stdErrors.Is(err, sql.ErrNoRows) {
+		return fail("failed to look up SKU")
+	}
+
+	if existing == nil {
+		return s.createImportedProduct(ctx, catalogImport, item, desired, fail)
+	}
+
+	item.ProductID = &existing.ID
+
+	if existing.VendorID != nil {
+		return fail("SKU belongs to a vendor product")
+	}
+
+	if !catalogImport.UpdateExisting {
+		item.Action = models.CatalogImportActionSkip
+
+		return item
+	}
+
+	return s.updateImportedProduct(ctx, catalogImport, item, existing, desired, fail)
+}
+
+func (s *catalogImportService) createImportedProduct(ctx context.Context, catalogImport *models.CatalogImport, item *models.CatalogImportItem, desired *models.Product, fail func(string) *models.CatalogImportItem) *models.CatalogImportItem {
+	if reason := s.checkImportedBarcode(ctx, desired.Barcode, uuid.Nil); reason != "" {
+		return fail(reason)
+	}
+
+	item.Action = models.CatalogImportActionCreate
+
+	if catalogImport.DryRun {
+		return item
+	}
+
+	if err := s.productRepo.CreateProduct(ctx, desired); err != nil {
+		return fail("failed to create product")
+	}
+
+	item.ProductID = &desired.ID
+
+	if len(desired.Images) > 0 {
+		if err := s.productRepo.SetProductImages(ctx, desired.ID, desired.Images); err != nil {
+			return fail("product created, failed to save its images")
+		}
+	}
+
+	return item
+}
+
+// updateImportedProduct brings the existing product in line with the source. The status is left
+// alone, a product taken off sale in the catalog stays off sale.
+func (s *catalogImportService) updateImportedProduct(ctx context.Context, catalogImport *models.CatalogImport, item *models.CatalogImportItem, existing, desired *models.Product, fail func(string) *models.CatalogImportItem) *models.CatalogImportItem {
+	updated := *existing
+
+	change := func(field string, from, to any) {
+		item.Changes = append(item.Changes, models.CatalogImportChange{Field: field, From: from, To: to})
+	}
+
+	if existing.Name != desired.Name {
+		change("name", existing.Name, desired.Name)
+		updated.Name = desired.Name
+	}
+
+	if !catalogImport.Mapping.SkipDescription && existing.Description != desired.Description {
+		change("description", existing.Description, desired.Description)
+		updated.Description = desired.Description
+	}
+
+	if existing.Price != desired.Price {
+		change("price", existing.Price, desired.Price)
+		updated.Price = desired.Price
+	}
+
+	if existing.StockQuantity != desired.StockQuantity {
+		change("stock_quantity", existing.StockQuantity, desired.StockQuantity)
+		updated.StockQuantity = desired.StockQuantity
+	}
+
+	// The source doesn't clear a barcode set in the catalog
+	if desired.Barcode != "" && existing.Barcode != desired.Barcode {
+		if reason := s.checkImportedBarcode(ctx, desired.Barcode, existing.ID); reason != "" {
+			return fail(reason)
+		}
+
+		change("barcode", existing.Barcode, desired.Barcode)
+		updated.Barcode = desired.Barcode
+	}
+
+	if existing.CategoryID != desired.CategoryID {
+		change("category_id", existing.CategoryID, desired.CategoryID)
+		updated.CategoryID = desired.CategoryID
+	}
+
+	imagesChanged := false
+
+	if !catalogImport.Mapping.SkipImages {
+		images, err := s.productRepo.ListProductImages(ctx, existing.ID)
+		if err != nil {
+			return fail("failed to get product images")
+		}
+
+		if !slices.Equal(images, desired.Images) {
+			change("images", images, desired.Images)
+
+			imagesChanged = true
+		}
+	}
+
+	if len(item.Changes) == 0 {
+		item.Action = models.CatalogImportActionUnchanged
+
+		return item
+	}
+
+	item.Action = models.CatalogImportActionUpdate
+
+	if catalogImport.DryRun {
+		return item
+	}
+
+	if len(item.Changes) > 1 || !imagesChanged {
+		if err := s.productRepo.UpdateProduct(ctx, &updated); err != nil {
+			return fail("failed to update product")
+		}
+	}
+
+	if imagesChanged {
+		if err := s.productRepo.SetProductImages(ctx, existing.ID, desired.Images); err != nil {
+			return fail("failed to save product images")
+		}
+	}
+
+	if updated.StockQuantity != existing.StockQuantity {
+		err := s.inventoryRepo.RecordMovement(ctx, &models.StockMovement{
+			ID:        uuid.New(),
+			ProductID: existing.ID,
+			Quantity:  existing.StockQuantity - updated.StockQuantity,
+			Reason:    models.StockMovementAdjustment,
+		})
+		if err != nil {
+			return fail("product updated, failed to record the stock adjustment")
+		}
+
+		if err := s.cache.Delete(ctx, productAvailabilityCacheKey(existing.ID)); err != nil {
+			slog.Warn("Failed to invalidate product availability", slog.String("productId", existing.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	return item
+}
+
+// checkImportedBarcode is checkBarcodeAvailable for the report, it returns why the barcode can't be used.
+func (s *catalogImportService) checkImportedBarcode(ctx context.Context, barcode string, owner uuid.UUID) string {
+	if barcode == "" {
+		return ""
+	}
+
+	existing, err := s.productRepo.GetProductByBarcode(ctx, barcode)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return ""
+		}
+
+		return "failed to check barcode"
+	}
+
+	if existing.ID != owner {
+		return "barcode is already assigned to another product"
+	}
+
+	return ""
+}
+
+// validImportedBarcode accepts the GTINs CreateProductRequest accepts: 8 to 14 digits.
+func validImportedBarcode(barcode string) bool {
+	if len(barcode) < 8 || len(barcode) > 14 {
+		return false
+	}
+
+	for _, c := range barcode {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// importedProductName is "<title> - <variant title>", cut to the maximum length of a product name.
+func importedProductName(title, variantTitle string) string {
+	name := title
+	if variantTitle != "" {
+		name += " - " + variantTitle
+	}
+
+	if runes := []rune(name); len(runes) > importMaxNameLength {
+		name = string(runes[:importMaxNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,287 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	cacheMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storefront"
+	storefrontMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storefront/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type catalogImportTestDeps struct {
+	repo      *mocks.MockCatalogImportRepository
+	products  *mocks.MockProductRepository
+	inventory *mocks.MockInventoryRepository
+	cache     *cacheMocks.MockCache
+	shopify   *storefrontMocks.MockClient
+}
+
+func setupCatalogImportServiceTest(t *testing.T) (service.CatalogImportService, *catalogImportTestDeps) {
+	deps := &catalogImportTestDeps{
+		repo:      mocks.NewMockCatalogImportRepository(t),
+		products:  mocks.NewMockProductRepository(t),
+		inventory: mocks.NewMockInventoryRepository(t),
+		cache:     cacheMocks.NewMockCache(t),
+		shopify:   storefrontMocks.NewMockClient(t),
+	}
+
+	sources := map[models.CatalogImportSource]storefront.Client{models.CatalogImportSourceShopify: deps.shopify}
+
+	return service.NewCatalogImportService(deps.repo, deps.products, deps.inventory, deps.cache, sources,
+		service.CatalogImportPolicy{BatchSize: 10, QueueSize: 10}, clock.NewFake(testNow)), deps
+}
+
+// runCatalogImportWorker runs the worker on the import until done is closed.
+func runCatalogImportWorker(t *testing.T, catalogImportService service.CatalogImportService, deps *catalogImportTestDeps, catalogImport *models.CatalogImport, done <-chan struct{}) {
+	t.Helper()
+
+	deps.repo.On("ListResumableCatalogImports", mock.Anything, mock.Anything).Return([]uuid.UUID{catalogImport.ID}, nil).Once()
+	deps.repo.On("ClaimCatalogImport", mock.Anything, catalogImport.ID, mock.Anything, mock.Anything).Return(catalogImport, nil).Once()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stopped := make(chan struct{})
+
+	go func() {
+		catalogImportService.Run(ctx, time.Hour)
+		close(stopped)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("catalog import was not processed")
+	}
+
+	cancel()
+	<-stopped
+}
+
+func TestCreateImport(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+
+	t.Run("Success - Applies the mapping defaults", func(t *testing.T) {
+		// Arrange
+		catalogImportService, deps := setupCatalogImportServiceTest(t)
+		req := &models.CreateCatalogImportRequest{
+			Source:  models.CatalogImportSourceShopify,
+			DryRun:  true,
+			Mapping: models.CatalogImportMapping{DefaultCategoryID: uuid.New()},
+		}
+
+		deps.repo.On("CreateCatalogImport", ctx, mock.MatchedBy(func(i *models.CatalogImport) bool {
+			return i.Status == models.CatalogImportStatusPending && i.CreatedBy == adminID && i.DryRun
+		})).Return(nil).Once()
+
+		// Act
+		catalogImport, err := catalogImportService.CreateImport(ctx, adminID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, catalogImport.Mapping.PriceMultiplier, 0)
+		assert.Equal(t, "inactive", catalogImport.Mapping.Status)
+	})
+
+	t.Run("Failure - Source not configured", func(t *testing.T) {
+		// Arrange
+		catalogImportService, _ := setupCatalogImportServiceTest(t)
+		req := &models.CreateCatalogImportRequest{
+			Source:  models.CatalogImportSourceWooCommerce,
+			Mapping: models.CatalogImportMapping{DefaultCategoryID: uuid.New()},
+		}
+
+		// Act
+		catalogImport, err := catalogImportService.CreateImport(ctx, adminID, req)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, catalogImport)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
+func TestListImportItems(t *testing.T) {
+	ctx := t.Context()
+	importID := uuid.New()
+
+	t.Run("Failure - Import not found", func(t *testing.T) {
+		// Arrange
+		catalogImportService, deps := setupCatalogImportServiceTest(t)
+		deps.repo.On("GetCatalogImport", ctx, importID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		items, total, err := catalogImportService.ListImportItems(ctx, importID, "", 1, 10)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, items)
+		assert.Zero(t, total)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestRunCatalogImport(t *testing.T) {
+	categoryID := uuid.New()
+	kitchenID := uuid.New()
+	mapping := models.CatalogImportMapping{
+		DefaultCategoryID: categoryID,
+		Categories:        map[string]uuid.UUID{"Kitchen": kitchenID},
+		SKUPrefix:         "SH-",
+		PriceMultiplier:   2,
+		Status:            "inactive",
+	}
+	products := []storefront.Product{
+		{
+			ExternalID: "1", Title: "Mug", Description: "Ceramic", ProductType: "Kitchen", Images: []string{"https://cdn.test/mug.jpg"},
+			Variants: []storefront.Variant{{ExternalID: "11", SKU: "MUG", Price: 6.25, Stock: 7}},
+		},
+		{
+			ExternalID: "2", Title: "Shirt", Description: "Cotton",
+			Variants: []storefront.Variant{
+				{ExternalID: "21", Title: "S", SKU: "SHIRT-S", Price: 10, Stock: 4},
+				{ExternalID: "22", Title: "M", Price: 10},
+				{ExternalID: "23", Title: "L", SKU: "SHIRT-S", Price: 10},
+			},
+		},
+	}
+	notFound := fmt.Errorf("querying database: %w", sql.ErrNoRows)
+
+	// existingShirt differs from the source in price and stock
+	existingShirt := func() *models.Product {
+		return &models.Product{ID: uuid.New(), CategoryID: categoryID, Name: "Shirt - S", Description: "Cotton", Price: 15, StockQuantity: 1, SKU: "SH-SHIRT-S", Status: "active"}
+	}
+
+	t.Run("Creates, updates and reports the invalid variants", func(t *testing.T) {
+		// Arrange
+		catalogImportService, deps := setupCatalogImportServiceTest(t)
+		catalogImport := &models.CatalogImport{ID: uuid.New(), Source: models.CatalogImportSourceShopify, UpdateExisting: true, Mapping: mapping, Status: models.CatalogImportStatusRunning}
+		shirt := existingShirt()
+		done := make(chan struct{})
+
+		deps.shopify.On("ListProducts", mock.Anything).Return(products, nil).Once()
+		deps.products.On("GetProductBySKU", mock.Anything, "SH-MUG").Return(nil, notFound).Once()
+		deps.products.On("CreateProduct", mock.Anything, mock.MatchedBy(func(p *models.Product) bool {
+			return p.Name == "Mug" && p.Price == 12.5 && p.CategoryID == kitchenID && p.StockQuantity == 7 && p.Status == "inactive"
+		})).Return(nil).Once()
+		deps.products.On("SetProductImages", mock.Anything, mock.Anything, []string{"https://cdn.test/mug.jpg"}).Return(nil).Once()
+		deps.products.On("GetProductBySKU", mock.Anything, "SH-SHIRT-S").Return(shirt, nil).Once()
+		deps.products.On("ListProductImages", mock.Anything, shirt.ID).Return(nil, nil).Once()
+		deps.products.On("UpdateProduct", mock.Anything, mock.MatchedBy(func(p *models.Product) bool {
+			return p.ID == shirt.ID && p.Price == 20 && p.StockQuantity == 4 && p.Status == "active"
+		})).Return(nil).Once()
+		deps.inventory.On("RecordMovement", mock.Anything, mock.MatchedBy(func(m *models.StockMovement) bool {
+			return m.ProductID == shirt.ID && m.Quantity == -3 && m.Reason == models.StockMovementAdjustment
+		})).Return(nil).Once()
+		deps.cache.On("Delete", mock.Anything, "product_availability:"+shirt.ID.String()).Return(nil).Once()
+		deps.repo.On("SaveCatalogImportBatch", mock.Anything, catalogImport, mock.MatchedBy(func(items []*models.CatalogImportItem) bool {
+			return len(items) == 4 &&
+				items[0].Action == models.CatalogImportActionCreate && items[0].ProductID != nil &&
+				items[1].Action == models.CatalogImportActionUpdate && len(items[1].Changes) == 2 &&
+				items[2].Action == models.CatalogImportActionFail && items[2].Error == "variant has no SKU" &&
+				items[3].Action == models.CatalogImportActionFail && items[3].Error == "SKU appears more than once in the source"
+		}), mock.Anything).Return(nil).Once()
+		deps.repo.On("FinishCatalogImport", mock.Anything, mock.MatchedBy(func(i *models.CatalogImport) bool {
+			return i.Status == models.CatalogImportStatusCompleted && i.Created == 1 && i.Updated == 1 && i.Failed == 2
+		})).Return(nil).Once()
+		deps.cache.On("InvalidateNamespace", mock.Anything, "product_facets").Return(int64(2), nil).Once().
+			Run(func(mock.Arguments) { close(done) })
+
+		// Act
+		runCatalogImportWorker(t, catalogImportService, deps, catalogImport, done)
+
+		// Assert
+		deps.repo.AssertExpectations(t)
+		deps.products.AssertExpectations(t)
+	})
+
+	t.Run("Dry run only reports the changes", func(t *testing.T) {
+		// Arrange
+		catalogImportService, deps := setupCatalogImportServiceTest(t)
+		catalogImport := &models.CatalogImport{ID: uuid.New(), Source: models.CatalogImportSourceShopify, DryRun: true, UpdateExisting: true, Mapping: mapping, Status: models.CatalogImportStatusRunning}
+		shirt := existingShirt()
+		done := make(chan struct{})
+
+		deps.shopify.On("ListProducts", mock.Anything).Return(products[1:], nil).Once()
+		deps.products.On("GetProductBySKU", mock.Anything, "SH-SHIRT-S").Return(shirt, nil).Once()
+		deps.products.On("ListProductImages", mock.Anything, shirt.ID).Return(nil, nil).Once()
+		deps.repo.On("SaveCatalogImportBatch", mock.Anything, catalogImport, mock.MatchedBy(func(items []*models.CatalogImportItem) bool {
+			return len(items) == 3 && items[0].Action == models.CatalogImportActionUpdate && assert.ObjectsAreEqual([]models.CatalogImportChange{
+				{Field: "price", From: 15.0, To: 20.0},
+				{Field: "stock_quantity", From: 1, To: 4},
+			}, items[0].Changes)
+		}), mock.Anything).Return(nil).Once()
+		deps.repo.On("FinishCatalogImport", mock.Anything, mock.MatchedBy(func(i *models.CatalogImport) bool {
+			return i.Status == models.CatalogImportStatusCompleted && i.Updated == 1
+		})).Return(nil).Once().
+			Run(func(mock.Arguments) { close(done) })
+
+		// Act
+		runCatalogImportWorker(t, catalogImportService, deps, catalogImport, done)
+
+		// Assert: the strict mocks fail on any write
+		deps.repo.AssertExpectations(t)
+	})
+
+	t.Run("Skips existing products unless updates are requested", func(t *testing.T) {
+		// Arrange
+		catalogImportService, deps := setupCatalogImportServiceTest(t)
+		catalogImport := &models.CatalogImport{ID: uuid.New(), Source: models.CatalogImportSourceShopify, Mapping: mapping, Status: models.CatalogImportStatusRunning}
+		shirt := existingShirt()
+		done := make(chan struct{})
+
+		deps.shopify.On("ListProducts", mock.Anything).Return(products[1:], nil).Once()
+		deps.products.On("GetProductBySKU", mock.Anything, "SH-SHIRT-S").Return(shirt, nil).Once()
+		deps.repo.On("SaveCatalogImportBatch", mock.Anything, catalogImport, mock.MatchedBy(func(items []*models.CatalogImportItem) bool {
+			return items[0].Action == models.CatalogImportActionSkip && *items[0].ProductID == shirt.ID
+		}), mock.Anything).Return(nil).Once()
+		deps.repo.On("FinishCatalogImport", mock.Anything, mock.MatchedBy(func(i *models.CatalogImport) bool {
+			return i.Skipped == 1 && i.Failed == 2
+		})).Return(nil).Once().
+			Run(func(mock.Arguments) { close(done) })
+
+		// Act
+		runCatalogImportWorker(t, catalogImportService, deps, catalogImport, done)
+
+		// Assert
+		deps.repo.AssertExpectations(t)
+	})
+
+	t.Run("Fails the import when the store can't be read", func(t *testing.T) {
+		// Arrange
+		catalogImportService, deps := setupCatalogImportServiceTest(t)
+		catalogImport := &models.CatalogImport{ID: uuid.New(), Source: models.CatalogImportSourceShopify, Mapping: mapping, Status: models.CatalogImportStatusRunning}
+		done := make(chan struct{})
+
+		deps.shopify.On("ListProducts", mock.Anything).Return(nil, errors.New("shopify: /admin/api/2024-10/products.json returned 401")).Once()
+		deps.repo.On("FinishCatalogImport", mock.Anything, mock.MatchedBy(func(i *models.CatalogImport) bool {
+			return i.Status == models.CatalogImportStatusFailed && i.Error == "shopify: /admin/api/2024-10/products.json returned 401"
+		})).Return(nil).Once().
+			Run(func(mock.Arguments) { close(done) })
+
+		// Act
+		runCatalogImportWorker(t, catalogImportService, deps, catalogImport, done)
+
+		// Assert
+		deps.repo.AssertExpectations(t)
+	})
+}
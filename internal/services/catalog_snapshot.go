@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// CatalogSnapshotService dumps the catalog for the edge caches, versioned by the product change feed
+// so that an edge can catch up with a delta instead of fetching it all again.
+type CatalogSnapshotService interface {
+	// GetSnapshot returns the snapshot served for since, a full one when since is empty or too far
+	// behind. The products of a full snapshot are left out, they are streamed with StreamProducts.
+	GetSnapshot(ctx context.Context, since string) (*models.CatalogSnapshot, error)
+	// StreamProducts calls fn for every product for sale, errors of fn are returned as is.
+	StreamProducts(ctx context.Context, fn func(*models.SnapshotProduct) error) error
+}
+
+// CatalogSnapshotPolicy caps the products of a delta, past MaxDelta a full snapshot is served instead.
+type CatalogSnapshotPolicy struct {
+	MaxDelta int
+}
+
+type catalogSnapshotService struct {
+	productRepo repository.ProductRepository
+	policy      CatalogSnapshotPolicy
+}
+
+func NewCatalogSnapshotService(productRepo repository.ProductRepository, policy CatalogSnapshotPolicy) CatalogSnapshotService {
+	policy.MaxDelta = max(policy.MaxDelta, 1)
+
+	return &catalogSnapshotService{productRepo: productRepo, policy: policy}
+}
+
+func (s *catalogSnapshotService) GetSnapshot(ctx context.Context, since string) (*models.CatalogSnapshot, error) {
+	version, err := s.productRepo.GetProductChangeCursor(ctx)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to get catalog version").WithError(err)
+	}
+
+	var after int64
+
+	if since != "" {
+		after, err = strconv.ParseInt(since, 10, 64)
+		if err != nil || after < 0 || after > version {
+			return nil, errors.BadRequestError("Invalid catalog snapshot version")
+		}
+	}
+
+	categories, err := s.productRepo.ListSnapshotCategories(ctx)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to list categories").WithError(err)
+	}
+
+	snapshot := &models.CatalogSnapshot{
+		Version:    strconv.FormatInt(version, 10),
+		Full:       true,
+		Categories: categories,
+		Products:   []models.SnapshotProduct{},
+		Deleted:    []uuid.UUID{},
+	}
+
+	if since == "" {
+		return snapshot, nil
+	}
+
+	changed, err := s.productRepo.ListChangedSnapshotProducts(ctx, after, version, s.policy.MaxDelta+1)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to list changed products").WithError(err)
+	}
+
+	if len(changed) > s.policy.MaxDelta {
+		slog.Info("Catalog snapshot delta too large, serving a full snapshot",
+			slog.String("since", since),
+			slog.String("version", snapshot.Version))
+
+		return snapshot, nil
+	}
+
+	snapshot.Since, snapshot.Full = since, false
+
+	for _, product := range changed {
+		if product.Active {
+			snapshot.Products = append(snapshot.Products, product)
+		} else {
+			snapshot.Deleted = append(snapshot.Deleted, product.ID)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// StreamProducts wraps the failures of the query only, like the exports.
+func (s *catalogSnapshotService) StreamProducts(ctx context.Context, fn func(*models.SnapshotProduct) error) error {
+	var fnErr error
+
+	err := s.productRepo.StreamSnapshotProducts(ctx, func(product *models.SnapshotProduct) error {
+		fnErr = fn(product)
+
+		return fnErr
+	})
+	if err == nil || fnErr != nil || ctx.Err() != nil {
+		return err
+	}
+
+	return errors.DatabaseError("Failed to stream catalog snapshot").WithError(err)
+}
@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogSnapshotService_GetSnapshot(t *testing.T) {
+	ctx := t.Context()
+	categories := []models.SnapshotCategory{{ID: uuid.New(), Name: "Shoes"}}
+
+	t.Run("Success - Full snapshot without a version", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{MaxDelta: 2})
+		mockRepo.On("GetProductChangeCursor", ctx).Return(int64(57), nil).Once()
+		mockRepo.On("ListSnapshotCategories", ctx).Return(categories, nil).Once()
+
+		// Act
+		snapshot, err := snapshotService.GetSnapshot(ctx, "")
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, snapshot.Full)
+		assert.Equal(t, "57", snapshot.Version)
+		assert.Equal(t, categories, snapshot.Categories)
+		mockRepo.AssertNotCalled(t, "ListChangedSnapshotProducts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Delta since a version", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{MaxDelta: 2})
+		onSale := models.SnapshotProduct{ID: uuid.New(), Name: "Sneaker", Version: 4, Active: true}
+		offSale := models.SnapshotProduct{ID: uuid.New(), Name: "Hoodie", Version: 2}
+		mockRepo.On("GetProductChangeCursor", ctx).Return(int64(57), nil).Once()
+		mockRepo.On("ListSnapshotCategories", ctx).Return(categories, nil).Once()
+		mockRepo.On("ListChangedSnapshotProducts", ctx, int64(41), int64(57), 3).Return([]models.SnapshotProduct{onSale, offSale}, nil).Once()
+
+		// Act
+		snapshot, err := snapshotService.GetSnapshot(ctx, "41")
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, snapshot.Full)
+		assert.Equal(t, "41", snapshot.Since)
+		assert.Equal(t, []models.SnapshotProduct{onSale}, snapshot.Products)
+		assert.Equal(t, []uuid.UUID{offSale.ID}, snapshot.Deleted)
+	})
+
+	t.Run("Success - Delta too large served in full", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{MaxDelta: 2})
+		changed := []models.SnapshotProduct{{ID: uuid.New(), Active: true}, {ID: uuid.New(), Active: true}, {ID: uuid.New()}}
+		mockRepo.On("GetProductChangeCursor", ctx).Return(int64(57), nil).Once()
+		mockRepo.On("ListSnapshotCategories", ctx).Return(categories, nil).Once()
+		mockRepo.On("ListChangedSnapshotProducts", ctx, int64(3), int64(57), 3).Return(changed, nil).Once()
+
+		// Act
+		snapshot, err := snapshotService.GetSnapshot(ctx, "3")
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, snapshot.Full)
+		assert.Empty(t, snapshot.Since)
+		assert.Empty(t, snapshot.Products)
+	})
+
+	t.Run("Failure - Version ahead of the catalog", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{MaxDelta: 2})
+		mockRepo.On("GetProductChangeCursor", ctx).Return(int64(57), nil).Once()
+
+		// Act
+		snapshot, err := snapshotService.GetSnapshot(ctx, "58")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+		assert.Nil(t, snapshot)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{MaxDelta: 2})
+		mockRepo.On("GetProductChangeCursor", ctx).Return(int64(0), errors.New("db down")).Once()
+
+		// Act
+		_, err := snapshotService.GetSnapshot(ctx, "")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestCatalogSnapshotService_StreamProducts(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Failure - Writer error is returned as is", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{})
+
+		writeErr := errors.New("broken pipe")
+		mockRepo.On("StreamSnapshotProducts", ctx, mock.Anything).
+			Return(func(_ context.Context, fn func(*models.SnapshotProduct) error) error {
+				return fn(&models.SnapshotProduct{ID: uuid.New()})
+			}).Once()
+
+		// Act
+		err := snapshotService.StreamProducts(ctx, func(_ *models.SnapshotProduct) error { return writeErr })
+
+		// Assert
+		assert.Equal(t, writeErr, err)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		snapshotService := service.NewCatalogSnapshotService(mockRepo, service.CatalogSnapshotPolicy{})
+		mockRepo.On("StreamSnapshotProducts", ctx, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		err := snapshotService.StreamProducts(ctx, func(_ *models.SnapshotProduct) error { return nil })
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
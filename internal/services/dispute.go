@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+)
+
+type DisputeService interface {
+	HandleDisputeEvent(ctx context.Context, event stripe.Event) error
+	ListDisputes(ctx context.Context, page, size int) ([]*models.Dispute, int, error)
+}
+
+type disputeService struct {
+	repo                repository.DisputeRepository
+	paymentRepo         repository.PaymentRepository
+	orderRepo           repository.OrderRepository
+	notificationService NotificationService
+	adminEmails         []string
+}
+
+func NewDisputeService(repo repository.DisputeRepository, paymentRepo repository.PaymentRepository, orderRepo repository.OrderRepository, notificationService NotificationService, adminEmails []string) DisputeService {
+	return &disputeService{
+		repo:                repo,
+		paymentRepo:         paymentRepo,
+		orderRepo:           orderRepo,
+		notificationService: notificationService,
+		adminEmails:         adminEmails,
+	}
+}
+
+// HandleDisputeEvent persists a charge.dispute.* event. An open dispute flags the payment and its order
+// as disputed, a closed one settles them as succeeded (won) or refunded (lost).
+func (s *disputeService) HandleDisputeEvent(ctx context.Context, event stripe.Event) error {
+	var stripeDispute stripe.Dispute
+	if err := json.Unmarshal(event.Data.Raw, &stripeDispute); err != nil {
+		return errors.ValidationError("Invalid dispute payload in webhook").WithError(err)
+	}
+
+	if stripeDispute.ID == "" || stripeDispute.PaymentIntent == nil || stripeDispute.PaymentIntent.ID == "" {
+		return errors.ValidationError("Missing dispute or payment intent ID in webhook")
+	}
+
+	dispute := &models.Dispute{
+		ID:        stripeDispute.ID,
+		PaymentID: stripeDispute.PaymentIntent.ID,
+		Amount:    stripeDispute.Amount,
+		Currency:  string(stripeDispute.Currency),
+		Reason:    string(stripeDispute.Reason),
+		Status:    string(stripeDispute.Status),
+	}
+
+	if stripeDispute.Charge != nil {
+		dispute.ChargeID = stripeDispute.Charge.ID
+	}
+
+	if stripeDispute.EvidenceDetails != nil && stripeDispute.EvidenceDetails.DueBy > 0 {
+		dueBy := time.Unix(stripeDispute.EvidenceDetails.DueBy, 0).UTC()
+		dispute.EvidenceDueBy = &dueBy
+	}
+
+	if err := s.repo.UpsertDispute(ctx, dispute); err != nil {
+		return errors.DatabaseError("Failed to save dispute").WithError(err)
+	}
+
+	status := models.PaymentStatusDisputed
+
+	switch dispute.Status {
+	case models.DisputeStatusWon:
+		status = models.PaymentStatusSucceeded
+	case models.DisputeStatusLost:
+		status = models.PaymentStatusRefunded
+	}
+
+	if err := s.paymentRepo.UpdatePaymentStatus(ctx, dispute.PaymentID, status); err != nil {
+		return errors.DatabaseError("Failed to update payment status").WithError(err)
+	}
+
+	if dispute.OrderID != nil {
+		if err := s.orderRepo.UpdatePaymentStatus(ctx, *dispute.OrderID, status, dispute.PaymentID); err != nil {
+			return errors.DatabaseError("Failed to flag disputed order").WithError(err)
+		}
+	}
+
+	if event.Type == "charge.dispute.created" || event.Type == "charge.dispute.closed" {
+		s.notifyAdmins(ctx, dispute)
+	}
+
+	return nil
+}
+
+// notifyAdmins is best effort, a failed email must not make Stripe redeliver the event.
+func (s *disputeService) notifyAdmins(ctx context.Context, dispute *models.Dispute) {
+	subject := fmt.Sprintf("Dispute %s is %s", dispute.ID, dispute.Status)
+	content := fmt.Sprintf("Payment %s is disputed for %d %s (reason: %s).", dispute.PaymentID, dispute.Amount, dispute.Currency, dispute.Reason)
+
+	if dispute.EvidenceDueBy != nil {
+		content += " Evidence is due by " + locale.FromContext(ctx).FormatDateTime(*dispute.EvidenceDueBy) + "."
+	}
+
+	for _, email := range s.adminEmails {
+		req := &models.EmailNotificationRequest{
+			To:       email,
+			Subject:  subject,
+			Content:  content,
+			Metadata: map[string]string{"dispute_id": dispute.ID},
+		}
+
+		if _, err := s.notificationService.SendEmail(ctx, req); err != nil {
+			slog.Error("Failed to notify admin about dispute",
+				slog.String("disputeId", dispute.ID),
+				slog.String("recipient", email),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (s *disputeService) ListDisputes(ctx context.Context, page, size int) ([]*models.Dispute, int, error) {
+	disputes, total, err := s.repo.ListDisputes(ctx, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to fetch disputes").WithError(err)
+	}
+
+	return disputes, total, nil
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repoMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+type disputeServiceMocks struct {
+	repo                *repoMocks.MockDisputeRepository
+	paymentRepo         *repoMocks.MockPaymentRepository
+	orderRepo           *repoMocks.MockOrderRepository
+	notificationService *svcMocks.MockNotificationService
+}
+
+func setupDisputeServiceTest(t *testing.T) (service.DisputeService, disputeServiceMocks) {
+	t.Helper()
+
+	m := disputeServiceMocks{
+		repo:                repoMocks.NewMockDisputeRepository(t),
+		paymentRepo:         repoMocks.NewMockPaymentRepository(t),
+		orderRepo:           repoMocks.NewMockOrderRepository(t),
+		notificationService: svcMocks.NewMockNotificationService(t),
+	}
+
+	return service.NewDisputeService(m.repo, m.paymentRepo, m.orderRepo, m.notificationService, []string{"admin@example.com"}), m
+}
+
+func disputeEvent(eventType stripe.EventType, status string) stripe.Event {
+	raw := `{"id": "dp_123", "amount": 5000, "currency": "usd", "reason": "fraudulent", "status": "` + status + `",
+		"charge": "ch_123", "payment_intent": "pi_123", "evidence_details": {"due_by": 1767225600}}`
+
+	return stripe.Event{ID: "evt_dp", Type: eventType, Data: &stripe.EventData{Raw: []byte(raw)}}
+}
+
+func TestHandleDisputeEvent(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	t.Run("Success - Dispute created flags payment and order", func(t *testing.T) {
+		// Arrange
+		disputeService, m := setupDisputeServiceTest(t)
+
+		m.repo.On("UpsertDispute", ctx, mock.MatchedBy(func(d *models.Dispute) bool {
+			return d.ID == "dp_123" && d.PaymentID == "pi_123" && d.ChargeID == "ch_123" &&
+				d.Amount == 5000 && d.EvidenceDueBy != nil && d.EvidenceDueBy.Unix() == 1767225600
+		})).Run(func(args mock.Arguments) {
+			d, ok := args.Get(1).(*models.Dispute)
+			require.True(t, ok)
+			d.OrderID = &orderID
+		}).Return(nil).Once()
+		m.paymentRepo.On("UpdatePaymentStatus", ctx, "pi_123", models.PaymentStatusDisputed).Return(nil).Once()
+		m.orderRepo.On("UpdatePaymentStatus", ctx, orderID, models.PaymentStatusDisputed, "pi_123").Return(nil).Once()
+		m.notificationService.On("SendEmail", ctx, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool {
+			return req.To == "admin@example.com" && req.Metadata["dispute_id"] == "dp_123"
+		})).Return(&models.NotificationResponse{}, nil).Once()
+
+		// Act
+		err := disputeService.HandleDisputeEvent(ctx, disputeEvent("charge.dispute.created", "needs_response"))
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Lost dispute refunds payment without an order", func(t *testing.T) {
+		// Arrange
+		disputeService, m := setupDisputeServiceTest(t)
+
+		m.repo.On("UpsertDispute", ctx, mock.Anything).Return(nil).Once()
+		m.paymentRepo.On("UpdatePaymentStatus", ctx, "pi_123", models.PaymentStatusRefunded).Return(nil).Once()
+		m.notificationService.On("SendEmail", ctx, mock.Anything).Return(nil, appErrors.ThirdPartyError("sendgrid down")).Once()
+
+		// Act
+		err := disputeService.HandleDisputeEvent(ctx, disputeEvent("charge.dispute.closed", models.DisputeStatusLost))
+
+		// Assert
+		assert.NoError(t, err)
+		m.orderRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+	})
+
+	t.Run("Success - Updated dispute does not notify admins", func(t *testing.T) {
+		// Arrange
+		disputeService, m := setupDisputeServiceTest(t)
+
+		m.repo.On("UpsertDispute", ctx, mock.Anything).Return(nil).Once()
+		m.paymentRepo.On("UpdatePaymentStatus", ctx, "pi_123", models.PaymentStatusDisputed).Return(nil).Once()
+
+		// Act
+		err := disputeService.HandleDisputeEvent(ctx, disputeEvent("charge.dispute.updated", "under_review"))
+
+		// Assert
+		assert.NoError(t, err)
+		m.notificationService.AssertNotCalled(t, "SendEmail")
+	})
+
+	t.Run("Failure - Missing payment intent", func(t *testing.T) {
+		// Arrange
+		disputeService, _ := setupDisputeServiceTest(t)
+		event := stripe.Event{Type: "charge.dispute.created", Data: &stripe.EventData{Raw: []byte(`{"id": "dp_123"}`)}}
+
+		// Act
+		err := disputeService.HandleDisputeEvent(ctx, event)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeValidation, appErr.Code)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		disputeService, m := setupDisputeServiceTest(t)
+		dbErr := errors.New("db error")
+
+		m.repo.On("UpsertDispute", ctx, mock.Anything).Return(dbErr).Once()
+
+		// Act
+		err := disputeService.HandleDisputeEvent(ctx, disputeEvent("charge.dispute.created", "needs_response"))
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		require.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestListDisputes(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		disputeService, m := setupDisputeServiceTest(t)
+		disputes := []*models.Dispute{{ID: "dp_1"}, {ID: "dp_2"}}
+
+		m.repo.On("ListDisputes", ctx, 1, 10).Return(disputes, 2, nil).Once()
+
+		// Act
+		result, total, err := disputeService.ListDisputes(ctx, 1, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, disputes, result)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		disputeService, m := setupDisputeServiceTest(t)
+
+		m.repo.On("ListDisputes", ctx, 1, 10).Return(nil, 0, errors.New("db error")).Once()
+
+		// Act
+		result, total, err := disputeService.ListDisputes(ctx, 1, 10)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		assert.Nil(t, result)
+		assert.Zero(t, total)
+	})
+}
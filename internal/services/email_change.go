@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appError "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/passhash"
+	"github.com/google/uuid"
+)
+
+// emailChangeTargets encodes the target of a confirmation token in its byte following the change ID.
+var emailChangeTargets = map[models.EmailChangeTarget]byte{
+	models.EmailChangeTargetOld: 'o',
+	models.EmailChangeTargetNew: 'n',
+}
+
+const defaultEmailChangeTTL = 24 * time.Hour
+
+// EmailChangeService changes the email of a user once both the old and the new address confirmed it,
+// so that neither a stolen session nor a typo can move the account to an address the owner does not
+// hold. Each address is sent its own confirmation token, the change ID and target signed with the
+// email change key, so tokens need no storage and one address cannot confirm for the other.
+type EmailChangeService interface {
+	RequestEmailChange(ctx context.Context, claims *models.Claims, req *models.EmailChangeRequest) (*models.EmailChange, error)
+	ConfirmEmailChange(ctx context.Context, token string) (*models.EmailChange, error)
+	ConfirmationToken(id uuid.UUID, target models.EmailChangeTarget) string
+}
+
+// EmailChangePolicy holds the key signing the confirmation tokens, the page the links lead to and how
+// long a change can wait for its confirmations.
+type EmailChangePolicy struct {
+	Key []byte
+	URL string
+	TTL time.Duration
+}
+
+type emailChangeService struct {
+	userRepo            repository.UserRepository
+	emailChangeRepo     repository.EmailChangeRepository
+	auditRepo           repository.AuditRepository
+	notificationService NotificationService
+	hasher              *passhash.Hasher
+	policy              EmailChangePolicy
+	clock               clock.Clock
+}
+
+func NewEmailChangeService(userRepo repository.UserRepository, emailChangeRepo repository.EmailChangeRepository, auditRepo repository.AuditRepository, notificationService NotificationService, hasher *passhash.Hasher, policy EmailChangePolicy, clk clock.Clock) EmailChangeService {
+	if policy.TTL <= 0 {
+		policy.TTL = defaultEmailChangeTTL
+	}
+
+	return &emailChangeService{
+		userRepo:            userRepo,
+		emailChangeRepo:     emailChangeRepo,
+		auditRepo:           auditRepo,
+		notificationService: notificationService,
+		hasher:              hasher,
+		policy:              policy,
+		clock:               clk,
+	}
+}
+
+// RequestEmailChange records the change once the current password is confirmed and sends a
+// confirmation link to both addresses. A new request replaces the pending one.
+func (s *emailChangeService) RequestEmailChange(ctx context.Context, claims *models.Claims, req *models.EmailChangeRequest) (*models.EmailChange, error) {
+	user, err := s.userRepo.GetUserByEmail(ctx, claims.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appError.NotFoundError("User not found")
+		}
+
+		return nil, appError.DatabaseError("Failed to fetch user").WithError(err)
+	}
+
+	if match, _, err := s.hasher.Verify(req.CurrentPassword, user.Password); err != nil || !match {
+		return nil, appError.UnauthorizedError("Current password is incorrect")
+	}
+
+	if strings.EqualFold(req.NewEmail, user.Email) {
+		return nil, appError.BadRequestError("New email is the same as the current one")
+	}
+
+	if _, err := s.userRepo.GetUserByEmail(ctx, req.NewEmail); err == nil {
+		return nil, appError.DuplicateEntryError("Email already registered")
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, appError.DatabaseError("Failed to check email").WithError(err)
+	}
+
+	change := &models.EmailChange{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		OldEmail:  user.Email,
+		NewEmail:  req.NewEmail,
+		ExpiresAt: s.clock.Now().Add(s.policy.TTL),
+	}
+
+	if err := s.emailChangeRepo.CreateEmailChange(ctx, change); err != nil {
+		return nil, appError.DatabaseError("Failed to record email change").WithError(err)
+	}
+
+	deadline := locale.FromContext(ctx).FormatDateTime(change.ExpiresAt)
+
+	_, err = s.notificationService.SendEmail(ctx, &models.EmailNotificationRequest{
+		To:      change.OldEmail,
+		Subject: "Confirm the change of your email",
+		Content: fmt.Sprintf("A change of the email of your account to %s was requested. Confirm it before %s with %s. If this was not you, ignore this email and change your password, the email will not change without your confirmation.",
+			change.NewEmail, deadline, s.confirmationLink(change.ID, models.EmailChangeTargetOld)),
+		Metadata: map[string]string{"email_change_id": change.ID.String()},
+	})
+	if err != nil {
+		return nil, appError.ThirdPartyError("Failed to send confirmation email").WithError(err)
+	}
+
+	_, err = s.notificationService.SendEmailToAddress(ctx, &models.EmailNotificationRequest{
+		To:      change.NewEmail,
+		Subject: "Confirm your new email",
+		Content: fmt.Sprintf("Confirm this address as the new email of your account before %s with %s. If you did not ask for it, ignore this email.",
+			deadline, s.confirmationLink(change.ID, models.EmailChangeTargetNew)),
+		Metadata: map[string]string{"email_change_id": change.ID.String()},
+	})
+	if err != nil {
+		return nil, appError.ThirdPartyError("Failed to send confirmation email").WithError(err)
+	}
+
+	s.audit(ctx, &models.AuditEntry{UserID: user.ID, Action: models.AuditActionEmailChangeRequested, ReferenceID: &change.ID})
+
+	return change, nil
+}
+
+// ConfirmEmailChange records the confirmation of the address the token was sent to, the confirmation
+// completing the change moves the user to the new email and signs them out everywhere. A forged token
+// is reported like an unknown change, a completed change is returned as is.
+func (s *emailChangeService) ConfirmEmailChange(ctx context.Context, token string) (*models.EmailChange, error) {
+	id, target, ok := s.verify(token)
+	if !ok {
+		return nil, appError.NotFoundError("Email change not found")
+	}
+
+	change, err := s.emailChangeRepo.GetEmailChange(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appError.NotFoundError("Email change not found")
+		}
+
+		return nil, appError.DatabaseError("Failed to fetch email change").WithError(err)
+	}
+
+	if change.CompletedAt != nil {
+		return change, nil
+	}
+
+	if !s.clock.Now().Before(change.ExpiresAt) {
+		return nil, appError.BadRequestError("Email change has expired, request it again")
+	}
+
+	change, err = s.emailChangeRepo.ConfirmEmailChange(ctx, id, target)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appError.NotFoundError("Email change not found")
+		}
+
+		return nil, appError.DatabaseError("Failed to confirm email change").WithError(err)
+	}
+
+	if !change.Confirmed() {
+		return change, nil
+	}
+
+	revoked, err := s.emailChangeRepo.CompleteEmailChange(ctx, change)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appError.DuplicateEntryError("Email change can no longer be completed, the new email was registered meanwhile")
+		}
+
+		return nil, appError.DatabaseError("Failed to complete email change").WithError(err)
+	}
+
+	slog.Info("Email changed",
+		slog.String("userId", change.UserID.String()),
+		slog.String("emailChangeId", change.ID.String()),
+		slog.Int("sessionsRevoked", revoked))
+
+	s.audit(ctx, &models.AuditEntry{UserID: change.UserID, Action: models.AuditActionEmailChanged, ReferenceID: &change.ID})
+	s.notifyChanged(ctx, change)
+
+	return change, nil
+}
+
+// ConfirmationToken implements EmailChangeService.
+func (s *emailChangeService) ConfirmationToken(id uuid.UUID, target models.EmailChangeTarget) string {
+	raw := append(id[:], emailChangeTargets[target])
+
+	return base64.RawURLEncoding.EncodeToString(append(raw, s.sign(raw)...))
+}
+
+// confirmationLink points to the confirmation page, the bare token is sent while no page is configured.
+func (s *emailChangeService) confirmationLink(id uuid.UUID, target models.EmailChangeTarget) string {
+	token := s.ConfirmationToken(id, target)
+	if s.policy.URL == "" {
+		return "the code " + token
+	}
+
+	return strings.TrimRight(s.policy.URL, "/") + "/" + token
+}
+
+func (s *emailChangeService) sign(raw []byte) []byte {
+	mac := hmac.New(sha256.New, s.policy.Key)
+	mac.Write([]byte("email-change:"))
+	mac.Write(raw)
+
+	return mac.Sum(nil)[:trackingSignatureSize]
+}
+
+func (s *emailChangeService) verify(token string) (uuid.UUID, models.EmailChangeTarget, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != len(uuid.UUID{})+1+trackingSignatureSize {
+		return uuid.Nil, "", false
+	}
+
+	payload := raw[:len(uuid.UUID{})+1]
+	if !hmac.Equal(raw[len(payload):], s.sign(payload)) {
+		return uuid.Nil, "", false
+	}
+
+	for target, code := range emailChangeTargets {
+		if payload[len(uuid.UUID{})] == code {
+			return uuid.UUID(payload[:len(uuid.UUID{})]), target, true
+		}
+	}
+
+	return uuid.Nil, "", false
+}
+
+// notifyChanged is best effort, the change went through when the emails cannot be sent. The old
+// address is told too, in case the change was not wanted.
+func (s *emailChangeService) notifyChanged(ctx context.Context, change *models.EmailChange) {
+	content := fmt.Sprintf("The email of your account was changed from %s to %s and every device was signed out. Sign in again with the new email.",
+		change.OldEmail, change.NewEmail)
+
+	for _, to := range []string{change.NewEmail, change.OldEmail} {
+		req := &models.EmailNotificationRequest{
+			To:       to,
+			Subject:  "Your email was changed",
+			Content:  content,
+			Metadata: map[string]string{"email_change_id": change.ID.String()},
+		}
+
+		var err error
+		if to == change.NewEmail {
+			_, err = s.notificationService.SendEmail(ctx, req)
+		} else {
+			_, err = s.notificationService.SendEmailToAddress(ctx, req) // no longer on the account
+		}
+
+		if err != nil {
+			slog.Error("Failed to send email change notification",
+				slog.String("userId", change.UserID.String()),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+// audit is best effort like the one of the user service.
+func (s *emailChangeService) audit(ctx context.Context, entry *models.AuditEntry) {
+	entry.ID = uuid.New()
+
+	if err := s.auditRepo.RecordEntry(ctx, entry); err != nil {
+		slog.Error("Failed to record audit entry",
+			slog.String("userId", entry.UserID.String()),
+			slog.String("action", string(entry.Action)),
+			slog.String("error", err.Error()))
+	}
+}
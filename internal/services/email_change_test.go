@@ -0,0 +1,249 @@
+package service_test
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testEmailChangePolicy = service.EmailChangePolicy{
+	Key: []byte("email-change-secret"),
+	URL: "https://shop.example.com/email-change/",
+	TTL: time.Hour,
+}
+
+type emailChangeMocks struct {
+	userRepo        *mocks.MockUserRepository
+	emailChangeRepo *mocks.MockEmailChangeRepository
+	auditRepo       *mocks.MockAuditRepository
+	notification    *svcMocks.MockNotificationService
+}
+
+func setupEmailChangeServiceTest(t *testing.T) (service.EmailChangeService, emailChangeMocks) {
+	m := emailChangeMocks{
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailChangeRepo: mocks.NewMockEmailChangeRepository(t),
+		auditRepo:       mocks.NewMockAuditRepository(t),
+		notification:    svcMocks.NewMockNotificationService(t),
+	}
+
+	return service.NewEmailChangeService(m.userRepo, m.emailChangeRepo, m.auditRepo, m.notification, testHasher, testEmailChangePolicy, clock.NewFake(testNow)), m
+}
+
+func TestEmailChangeService_RequestEmailChange(t *testing.T) {
+	hashedPassword, err := testHasher.Hash("password1")
+	require.NoError(t, err)
+
+	user := &models.User{ID: uuid.New(), Email: "old@example.com", Password: hashedPassword}
+	claims := &models.Claims{UserID: user.ID, Email: user.Email}
+
+	t.Run("Success - Both addresses are sent a link", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+		req := &models.EmailChangeRequest{NewEmail: "new@example.com", CurrentPassword: "password1"}
+
+		var change *models.EmailChange
+
+		m.userRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+		m.userRepo.On("GetUserByEmail", mock.Anything, req.NewEmail).Return(nil, sql.ErrNoRows).Once()
+		m.emailChangeRepo.On("CreateEmailChange", mock.Anything, mock.MatchedBy(func(c *models.EmailChange) bool {
+			return c.UserID == user.ID && c.OldEmail == user.Email && c.NewEmail == req.NewEmail && c.ExpiresAt.Equal(testNow.Add(time.Hour))
+		})).Run(func(args mock.Arguments) {
+			change = args.Get(1).(*models.EmailChange)
+		}).Return(nil).Once()
+		m.notification.On("SendEmail", mock.Anything, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.To == user.Email && strings.Contains(r.Content, "https://shop.example.com/email-change/"+emailChangeService.ConfirmationToken(change.ID, models.EmailChangeTargetOld))
+		})).Return(&models.NotificationResponse{}, nil).Once()
+		m.notification.On("SendEmailToAddress", mock.Anything, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.To == req.NewEmail && strings.Contains(r.Content, "https://shop.example.com/email-change/"+emailChangeService.ConfirmationToken(change.ID, models.EmailChangeTargetNew))
+		})).Return(&models.NotificationResponse{}, nil).Once()
+		m.auditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == user.ID && e.Action == models.AuditActionEmailChangeRequested
+		})).Return(nil).Once()
+
+		// Act
+		result, err := emailChangeService.RequestEmailChange(t.Context(), claims, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Same(t, change, result)
+	})
+
+	t.Run("Failure - Wrong current password", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+
+		m.userRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+
+		// Act
+		_, err := emailChangeService.RequestEmailChange(t.Context(), claims, &models.EmailChangeRequest{NewEmail: "new@example.com", CurrentPassword: "guess"})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeUnauthorized)
+	})
+
+	t.Run("Failure - Same email", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+
+		m.userRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+
+		// Act
+		_, err := emailChangeService.RequestEmailChange(t.Context(), claims, &models.EmailChangeRequest{NewEmail: "OLD@example.com", CurrentPassword: "password1"})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+
+	t.Run("Failure - New email already registered", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+
+		m.userRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+		m.userRepo.On("GetUserByEmail", mock.Anything, "taken@example.com").Return(&models.User{ID: uuid.New()}, nil).Once()
+
+		// Act
+		_, err := emailChangeService.RequestEmailChange(t.Context(), claims, &models.EmailChangeRequest{NewEmail: "taken@example.com", CurrentPassword: "password1"})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDuplicateEntry)
+	})
+}
+
+func TestEmailChangeService_ConfirmEmailChange(t *testing.T) {
+	confirmedAt := testNow.Add(-time.Minute)
+
+	pending := func() *models.EmailChange {
+		return &models.EmailChange{
+			ID:        uuid.New(),
+			UserID:    uuid.New(),
+			OldEmail:  "old@example.com",
+			NewEmail:  "new@example.com",
+			ExpiresAt: testNow.Add(time.Hour),
+		}
+	}
+
+	t.Run("Success - First confirmation leaves the change pending", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+		change := pending()
+		confirmed := *change
+		confirmed.OldConfirmedAt = &confirmedAt
+
+		m.emailChangeRepo.On("GetEmailChange", mock.Anything, change.ID).Return(change, nil).Once()
+		m.emailChangeRepo.On("ConfirmEmailChange", mock.Anything, change.ID, models.EmailChangeTargetOld).Return(&confirmed, nil).Once()
+
+		// Act
+		result, err := emailChangeService.ConfirmEmailChange(t.Context(), emailChangeService.ConfirmationToken(change.ID, models.EmailChangeTargetOld))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, result.CompletedAt)
+		m.emailChangeRepo.AssertNotCalled(t, "CompleteEmailChange", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Second confirmation completes the change", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+		change := pending()
+		change.OldConfirmedAt = &confirmedAt
+		confirmed := *change
+		confirmed.NewConfirmedAt = &testNow
+
+		m.emailChangeRepo.On("GetEmailChange", mock.Anything, change.ID).Return(change, nil).Once()
+		m.emailChangeRepo.On("ConfirmEmailChange", mock.Anything, change.ID, models.EmailChangeTargetNew).Return(&confirmed, nil).Once()
+		m.emailChangeRepo.On("CompleteEmailChange", mock.Anything, &confirmed).Run(func(args mock.Arguments) {
+			args.Get(1).(*models.EmailChange).CompletedAt = &testNow
+		}).Return(3, nil).Once()
+		m.auditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == change.UserID && e.Action == models.AuditActionEmailChanged && *e.ReferenceID == change.ID
+		})).Return(nil).Once()
+		m.notification.On("SendEmail", mock.Anything, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.To == "new@example.com"
+		})).Return(&models.NotificationResponse{}, nil).Once()
+		m.notification.On("SendEmailToAddress", mock.Anything, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.To == "old@example.com"
+		})).Return(&models.NotificationResponse{}, nil).Once()
+
+		// Act
+		result, err := emailChangeService.ConfirmEmailChange(t.Context(), emailChangeService.ConfirmationToken(change.ID, models.EmailChangeTargetNew))
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotNil(t, result.CompletedAt)
+	})
+
+	t.Run("Failure - Token of one address cannot be turned into the other", func(t *testing.T) {
+		// Arrange
+		emailChangeService, _ := setupEmailChangeServiceTest(t)
+		raw, err := base64.RawURLEncoding.DecodeString(emailChangeService.ConfirmationToken(uuid.New(), models.EmailChangeTargetOld))
+		require.NoError(t, err)
+
+		raw[len(uuid.UUID{})] = 'n' // the target follows the change ID
+
+		// Act
+		_, err = emailChangeService.ConfirmEmailChange(t.Context(), base64.RawURLEncoding.EncodeToString(raw))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+
+	t.Run("Failure - Token signed with another key", func(t *testing.T) {
+		// Arrange
+		emailChangeService, _ := setupEmailChangeServiceTest(t)
+		other := service.NewEmailChangeService(nil, nil, nil, nil, testHasher, service.EmailChangePolicy{Key: []byte("other")}, clock.NewFake(testNow))
+
+		// Act
+		_, err := emailChangeService.ConfirmEmailChange(t.Context(), other.ConfirmationToken(uuid.New(), models.EmailChangeTargetNew))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+
+	t.Run("Failure - Change expired", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+		change := pending()
+		change.ExpiresAt = testNow
+
+		m.emailChangeRepo.On("GetEmailChange", mock.Anything, change.ID).Return(change, nil).Once()
+
+		// Act
+		_, err := emailChangeService.ConfirmEmailChange(t.Context(), emailChangeService.ConfirmationToken(change.ID, models.EmailChangeTargetOld))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+
+	t.Run("Failure - New email registered meanwhile", func(t *testing.T) {
+		// Arrange
+		emailChangeService, m := setupEmailChangeServiceTest(t)
+		change := pending()
+		change.NewConfirmedAt = &confirmedAt
+		confirmed := *change
+		confirmed.OldConfirmedAt = &testNow
+
+		m.emailChangeRepo.On("GetEmailChange", mock.Anything, change.ID).Return(change, nil).Once()
+		m.emailChangeRepo.On("ConfirmEmailChange", mock.Anything, change.ID, models.EmailChangeTargetOld).Return(&confirmed, nil).Once()
+		m.emailChangeRepo.On("CompleteEmailChange", mock.Anything, &confirmed).Return(0, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := emailChangeService.ConfirmEmailChange(t.Context(), emailChangeService.ConfirmationToken(change.ID, models.EmailChangeTargetOld))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDuplicateEntry)
+	})
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/google/uuid"
+)
+
+// FraudService slows down bots buying out drops: the velocity rules count the recent orders of a
+// customer, an IP, a card and a device, an order over a limit is blocked or held for a fraud review.
+type FraudService interface {
+	// CheckVelocity returns the review rules the order trips, or an error when it trips a blocking one.
+	CheckVelocity(ctx context.Context, orderID uuid.UUID, subjects models.VelocitySubjects) ([]string, error)
+	// RecordOrder counts the order placed, and holds it for a review when flagged by CheckVelocity.
+	RecordOrder(ctx context.Context, orderID, customerID uuid.UUID, subjects models.VelocitySubjects, flagged []string) error
+	// RecordDevice keeps the device the order was placed from, for the reviewers to find linked accounts.
+	RecordDevice(ctx context.Context, orderID, customerID uuid.UUID, ip string, device *models.DeviceFingerprint)
+	HeldOrders(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+	GetReview(ctx context.Context, orderID uuid.UUID) (*models.FraudReview, error)
+	ListReviews(ctx context.Context, status models.FraudReviewStatus, page, size int) ([]*models.FraudReview, int, error)
+	ResolveReview(ctx context.Context, orderID, reviewerID uuid.UUID, req *models.ResolveFraudReviewRequest) (*models.FraudReview, error)
+	ListLinkedAccounts(ctx context.Context, fingerprint string, page, size int) ([]*models.LinkedAccount, int, error)
+}
+
+// VelocityRule trips once MaxOrders orders were placed with the same key in the last Window.
+type VelocityRule struct {
+	Name      string
+	Key       models.VelocityKey
+	MaxOrders int
+	Window    time.Duration
+	Action    models.VelocityAction
+}
+
+// VelocityPolicy holds the velocity rules. In Shadow mode the orders are still counted, but a rule
+// tripped is only logged.
+type VelocityPolicy struct {
+	Enabled bool
+	Shadow  bool
+	Rules   []VelocityRule
+}
+
+// Validate refuses a rule the service would never trip or could not apply.
+func (p VelocityPolicy) Validate() error {
+	for _, rule := range p.Rules {
+		switch {
+		case rule.Name == "":
+			return stdErrors.New("velocity rule without a name")
+		case rule.Key != models.VelocityKeyCustomer && rule.Key != models.VelocityKeyIP &&
+			rule.Key != models.VelocityKeyPaymentFingerprint && rule.Key != models.VelocityKeyDeviceFingerprint:
+			return fmt.Errorf("velocity rule %s: unknown key %q", rule.Name, rule.Key)
+		case rule.Action != models.VelocityActionBlock && rule.Action != models.VelocityActionReview:
+			return fmt.Errorf("velocity rule %s: unknown action %q", rule.Name, rule.Action)
+		case rule.MaxOrders < 1 || rule.Window <= 0:
+			return fmt.Errorf("velocity rule %s: max orders and window must be positive", rule.Name)
+		}
+	}
+
+	return nil
+}
+
+type fraudService struct {
+	reviewRepo      repository.FraudReviewRepository
+	velocityRepo    repository.VelocityRepository
+	deviceRepo      repository.DeviceRepository
+	orderRepo       repository.OrderRepository
+	campaignService CampaignService
+	policy          VelocityPolicy
+	clock           clock.Clock
+}
+
+func NewFraudService(reviewRepo repository.FraudReviewRepository, velocityRepo repository.VelocityRepository, deviceRepo repository.DeviceRepository, orderRepo repository.OrderRepository, campaignService CampaignService, policy VelocityPolicy, clk clock.Clock) FraudService {
+	return &fraudService{reviewRepo: reviewRepo, velocityRepo: velocityRepo, deviceRepo: deviceRepo, orderRepo: orderRepo, campaignService: campaignService, policy: policy, clock: clk}
+}
+
+// velocityValue is the value of the order a rule on key counts, empty when unknown.
+func velocityValue(subjects models.VelocitySubjects, key models.VelocityKey) string {
+	switch key {
+	case models.VelocityKeyCustomer:
+		if subjects.CustomerID != uuid.Nil {
+			return subjects.CustomerID.String()
+		}
+	case models.VelocityKeyIP:
+		return subjects.IP
+	case models.VelocityKeyPaymentFingerprint:
+		return subjects.PaymentFingerprint
+	case models.VelocityKeyDeviceFingerprint:
+		return subjects.DeviceFingerprint
+	}
+
+	return ""
+}
+
+func (s *fraudService) CheckVelocity(ctx context.Context, orderID uuid.UUID, subjects models.VelocitySubjects) ([]string, error) {
+	if !s.policy.Enabled {
+		return nil, nil
+	}
+
+	now := s.clock.Now()
+
+	var flagged []string
+
+	for _, rule := range s.policy.Rules {
+		value := velocityValue(subjects, rule.Key)
+		if value == "" {
+			continue
+		}
+
+		orders, err := s.velocityRepo.CountOrders(ctx, rule.Key, value, now.Add(-rule.Window), orderID)
+		if err != nil {
+			// The rules slow bots down, they do not stand in the way of checkout while Redis is down
+			slog.Error("Failed to check velocity rule, order let through",
+				slog.String("rule", rule.Name),
+				slog.String("error", err.Error()))
+
+			continue
+		}
+
+		if orders < rule.MaxOrders {
+			continue
+		}
+
+		attrs := []any{
+			slog.String("rule", rule.Name),
+			slog.String("key", string(rule.Key)),
+			slog.String("action", string(rule.Action)),
+			slog.String("orderId", orderID.String()),
+			slog.Int("orders", orders),
+		}
+
+		if s.policy.Shadow {
+			slog.Warn("Velocity rule tripped in shadow mode, order let through", attrs...)
+
+			continue
+		}
+
+		slog.Warn("Velocity rule tripped", attrs...)
+
+		if rule.Action == models.VelocityActionBlock {
+			return nil, errors.OrderVelocityError()
+		}
+
+		flagged = append(flagged, rule.Name)
+	}
+
+	return flagged, nil
+}
+
+// RecordOrder counts the order once per key, over the longest window of the rules on it. A counter
+// failing to record is logged, the order was placed already.
+func (s *fraudService) RecordOrder(ctx context.Context, orderID, customerID uuid.UUID, subjects models.VelocitySubjects, flagged []string) error {
+	if !s.policy.Enabled {
+		return nil
+	}
+
+	now := s.clock.Now()
+	windows := make(map[models.VelocityKey]time.Duration)
+
+	for _, rule := range s.policy.Rules {
+		if velocityValue(subjects, rule.Key) != "" {
+			windows[rule.Key] = max(windows[rule.Key], rule.Window)
+		}
+	}
+
+	for key, window := range windows {
+		if err := s.velocityRepo.RecordOrder(ctx, key, velocityValue(subjects, key), orderID, now, window); err != nil {
+			slog.Error("Failed to record order for velocity rules",
+				slog.String("key", string(key)),
+				slog.String("orderId", orderID.String()),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	if len(flagged) == 0 {
+		return nil
+	}
+
+	review := &models.FraudReview{OrderID: orderID, CustomerID: customerID, Reasons: flagged}
+	if err := s.reviewRepo.FlagOrder(ctx, review); err != nil {
+		return errors.DatabaseError("Failed to hold order for fraud review").WithError(err)
+	}
+
+	return nil
+}
+
+// RecordDevice is recorded whether the rules are enabled or not, the device is evidence for a review
+// later on. A device failing to save is logged, the order was placed already.
+func (s *fraudService) RecordDevice(ctx context.Context, orderID, customerID uuid.UUID, ip string, device *models.DeviceFingerprint) {
+	if device == nil {
+		return
+	}
+
+	orderDevice := &models.OrderDevice{
+		OrderID:     orderID,
+		CustomerID:  customerID,
+		Fingerprint: device.ID,
+		Components:  device.Components,
+		IPAddress:   ip,
+	}
+
+	if err := s.deviceRepo.SaveOrderDevice(ctx, orderDevice); err != nil {
+		slog.Error("Failed to save order device",
+			slog.String("orderId", orderID.String()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// HeldOrders returns which of the orders are held by a pending review.
+func (s *fraudService) HeldOrders(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	pending, err := s.reviewRepo.ListPendingOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to check fraud reviews").WithError(err)
+	}
+
+	held := make(map[uuid.UUID]bool, len(pending))
+	for _, id := range pending {
+		held[id] = true
+	}
+
+	return held, nil
+}
+
+func (s *fraudService) GetReview(ctx context.Context, orderID uuid.UUID) (*models.FraudReview, error) {
+	review, err := s.reviewRepo.GetReview(ctx, orderID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Fraud review not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to get fraud review").WithError(err)
+	}
+
+	device, err := s.deviceRepo.GetOrderDevice(ctx, orderID)
+	if err != nil && !stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.DatabaseError("Failed to get order device").WithError(err)
+	}
+
+	review.Device = device
+
+	return review, nil
+}
+
+func (s *fraudService) ListReviews(ctx context.Context, status models.FraudReviewStatus, page, size int) ([]*models.FraudReview, int, error) {
+	reviews, total, err := s.reviewRepo.ListReviews(ctx, status, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list fraud reviews").WithError(err)
+	}
+
+	return reviews, total, nil
+}
+
+// ResolveReview releases the order for fulfillment once approved. A rejected order is cancelled, like
+// an admin cancelling it, when it has not left the warehouse yet.
+func (s *fraudService) ResolveReview(ctx context.Context, orderID, reviewerID uuid.UUID, req *models.ResolveFraudReviewRequest) (*models.FraudReview, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	now := s.clock.Now()
+	review := &models.FraudReview{OrderID: orderID, Status: req.Status, Note: req.Note, ReviewedBy: &reviewerID, ReviewedAt: &now}
+
+	if err := s.reviewRepo.ResolveReview(ctx, review); err != nil {
+		if !stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.DatabaseError("Failed to resolve fraud review").WithError(err)
+		}
+
+		current, err := s.GetReview(ctx, orderID)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, errors.InvalidTransitionError(fmt.Sprintf("Fraud review is already %s", current.Status))
+	}
+
+	if review.Status != models.FraudReviewStatusRejected {
+		return review, nil
+	}
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if !order.Status.CanTransitionTo(models.OrderStatusCancelled) {
+		slog.Warn("Rejected order cannot be cancelled anymore",
+			slog.String("orderId", orderID.String()),
+			slog.String("status", string(order.Status)))
+
+		return review, nil
+	}
+
+	if _, err := s.orderRepo.UpdateOrderStatus(ctx, orderID, models.OrderStatusCancelled); err != nil {
+		return nil, errors.DatabaseError("Failed to cancel rejected order").WithError(err)
+	}
+
+	s.campaignService.ReleaseStock(ctx, campaignClaims(order.Items))
+
+	return review, nil
+}
+
+func (s *fraudService) ListLinkedAccounts(ctx context.Context, fingerprint string, page, size int) ([]*models.LinkedAccount, int, error) {
+	accounts, total, err := s.deviceRepo.ListLinkedAccounts(ctx, fingerprint, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list linked accounts").WithError(err)
+	}
+
+	return accounts, total, nil
+}
@@ -0,0 +1,343 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testVelocityRules = []service.VelocityRule{
+	{Name: "customer-hourly", Key: models.VelocityKeyCustomer, MaxOrders: 3, Window: time.Hour, Action: models.VelocityActionBlock},
+	{Name: "ip-daily", Key: models.VelocityKeyIP, MaxOrders: 10, Window: 24 * time.Hour, Action: models.VelocityActionReview},
+	{Name: "ip-hourly", Key: models.VelocityKeyIP, MaxOrders: 5, Window: time.Hour, Action: models.VelocityActionReview},
+}
+
+type fraudServiceMocks struct {
+	reviewRepo   *mocks.MockFraudReviewRepository
+	velocityRepo *mocks.MockVelocityRepository
+	deviceRepo   *mocks.MockDeviceRepository
+	orderRepo    *mocks.MockOrderRepository
+	campaign     *svcMocks.MockCampaignService
+}
+
+func setupFraudServiceTest(t *testing.T, policy service.VelocityPolicy) (service.FraudService, *fraudServiceMocks) {
+	m := &fraudServiceMocks{
+		reviewRepo:   mocks.NewMockFraudReviewRepository(t),
+		velocityRepo: mocks.NewMockVelocityRepository(t),
+		deviceRepo:   mocks.NewMockDeviceRepository(t),
+		orderRepo:    mocks.NewMockOrderRepository(t),
+		campaign:     svcMocks.NewMockCampaignService(t),
+	}
+
+	return service.NewFraudService(m.reviewRepo, m.velocityRepo, m.deviceRepo, m.orderRepo, m.campaign, policy, clock.NewFake(testNow)), m
+}
+
+func TestFraudService_CheckVelocity(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	subjects := models.VelocitySubjects{CustomerID: uuid.New(), IP: "203.0.113.7"}
+	customer := subjects.CustomerID.String()
+
+	t.Run("Success - Orders under the limits", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyCustomer, customer, testNow.Add(-time.Hour), orderID).Return(2, nil).Once()
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyIP, "203.0.113.7", testNow.Add(-24*time.Hour), orderID).Return(9, nil).Once()
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyIP, "203.0.113.7", testNow.Add(-time.Hour), orderID).Return(4, nil).Once()
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, subjects)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, flagged)
+	})
+
+	t.Run("Success - Review rules flag the order", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyCustomer, customer, mock.Anything, orderID).Return(0, nil).Once()
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyIP, "203.0.113.7", mock.Anything, orderID).Return(12, nil).Twice()
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, subjects)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ip-daily", "ip-hourly"}, flagged)
+	})
+
+	t.Run("Failure - Blocking rule tripped", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyCustomer, customer, mock.Anything, orderID).Return(3, nil).Once()
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, subjects)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeOrderVelocity)
+		assert.Nil(t, flagged)
+	})
+
+	t.Run("Success - Shadow mode only logs", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Shadow: true, Rules: testVelocityRules})
+		m.velocityRepo.On("CountOrders", ctx, mock.Anything, mock.Anything, mock.Anything, orderID).Return(50, nil).Times(3)
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, subjects)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, flagged)
+	})
+
+	t.Run("Success - Unknown values and counters down let the order through", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyCustomer, customer, mock.Anything, orderID).Return(0, errors.New("connection refused")).Once()
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, models.VelocitySubjects{CustomerID: subjects.CustomerID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, flagged)
+	})
+
+	t.Run("Failure - Device used across accounts", func(t *testing.T) {
+		// Arrange
+		rules := []service.VelocityRule{{Name: "device-daily", Key: models.VelocityKeyDeviceFingerprint, MaxOrders: 5, Window: 24 * time.Hour, Action: models.VelocityActionBlock}}
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: rules})
+		m.velocityRepo.On("CountOrders", ctx, models.VelocityKeyDeviceFingerprint, "fp_123", testNow.Add(-24*time.Hour), orderID).Return(5, nil).Once()
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, models.VelocitySubjects{CustomerID: subjects.CustomerID, DeviceFingerprint: "fp_123"})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeOrderVelocity)
+		assert.Nil(t, flagged)
+	})
+
+	t.Run("Success - Disabled rules count nothing", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Rules: testVelocityRules})
+
+		// Act
+		flagged, err := fraudService.CheckVelocity(ctx, orderID, subjects)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, flagged)
+		m.velocityRepo.AssertNotCalled(t, "CountOrders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestFraudService_RecordOrder(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	customerID := uuid.New()
+	subjects := models.VelocitySubjects{CustomerID: customerID, IP: "203.0.113.7"}
+
+	t.Run("Success - Counted once per key over its longest window", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("RecordOrder", ctx, models.VelocityKeyCustomer, customerID.String(), orderID, testNow, time.Hour).Return(nil).Once()
+		m.velocityRepo.On("RecordOrder", ctx, models.VelocityKeyIP, "203.0.113.7", orderID, testNow, 24*time.Hour).Return(nil).Once()
+
+		// Act
+		err := fraudService.RecordOrder(ctx, orderID, customerID, subjects, nil)
+
+		// Assert
+		require.NoError(t, err)
+		m.reviewRepo.AssertNotCalled(t, "FlagOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Flagged order held for review", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("RecordOrder", ctx, mock.Anything, mock.Anything, orderID, testNow, mock.Anything).Return(errors.New("connection refused")).Twice()
+		m.reviewRepo.On("FlagOrder", ctx, mock.MatchedBy(func(r *models.FraudReview) bool {
+			return r.OrderID == orderID && r.CustomerID == customerID && assert.ObjectsAreEqual([]string{"ip-hourly"}, r.Reasons)
+		})).Return(nil).Once()
+
+		// Act
+		err := fraudService.RecordOrder(ctx, orderID, customerID, subjects, []string{"ip-hourly"})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Review not opened", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{Enabled: true, Rules: testVelocityRules})
+		m.velocityRepo.On("RecordOrder", ctx, mock.Anything, mock.Anything, orderID, testNow, mock.Anything).Return(nil).Twice()
+		m.reviewRepo.On("FlagOrder", ctx, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		err := fraudService.RecordOrder(ctx, orderID, customerID, subjects, []string{"ip-hourly"})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestFraudService_RecordDevice(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	customerID := uuid.New()
+	device := &models.DeviceFingerprint{ID: "fp_123", Components: map[string]any{"timezone": "Europe/Berlin"}}
+
+	t.Run("Success - Device saved with the order", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.deviceRepo.On("SaveOrderDevice", ctx, mock.MatchedBy(func(d *models.OrderDevice) bool {
+			return d.OrderID == orderID && d.CustomerID == customerID && d.Fingerprint == "fp_123" && d.IPAddress == "203.0.113.7"
+		})).Return(nil).Once()
+
+		// Act
+		fraudService.RecordDevice(ctx, orderID, customerID, "203.0.113.7", device)
+	})
+
+	t.Run("Success - No fingerprint sent", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+
+		// Act
+		fraudService.RecordDevice(ctx, orderID, customerID, "203.0.113.7", nil)
+
+		// Assert
+		m.deviceRepo.AssertNotCalled(t, "SaveOrderDevice", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFraudService_GetReview(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	t.Run("Success - Review with the device of the order", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.reviewRepo.On("GetReview", ctx, orderID).Return(&models.FraudReview{OrderID: orderID, Status: models.FraudReviewStatusPending}, nil).Once()
+		m.deviceRepo.On("GetOrderDevice", ctx, orderID).Return(&models.OrderDevice{OrderID: orderID, Fingerprint: "fp_123"}, nil).Once()
+
+		// Act
+		review, err := fraudService.GetReview(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, review.Device)
+		assert.Equal(t, "fp_123", review.Device.Fingerprint)
+	})
+
+	t.Run("Success - Order placed without a fingerprint", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.reviewRepo.On("GetReview", ctx, orderID).Return(&models.FraudReview{OrderID: orderID, Status: models.FraudReviewStatusPending}, nil).Once()
+		m.deviceRepo.On("GetOrderDevice", ctx, orderID).Return(nil, fmt.Errorf("failed to get order device: %w", sql.ErrNoRows)).Once()
+
+		// Act
+		review, err := fraudService.GetReview(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, review.Device)
+	})
+}
+
+func TestFraudService_ResolveReview(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	reviewerID := uuid.New()
+
+	t.Run("Success - Approved order released", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.reviewRepo.On("ResolveReview", ctx, mock.MatchedBy(func(r *models.FraudReview) bool {
+			return r.OrderID == orderID && r.Status == models.FraudReviewStatusApproved && *r.ReviewedBy == reviewerID && r.ReviewedAt.Equal(testNow)
+		})).Return(nil).Once()
+
+		// Act
+		review, err := fraudService.ResolveReview(ctx, orderID, reviewerID, &models.ResolveFraudReviewRequest{Status: models.FraudReviewStatusApproved})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.FraudReviewStatusApproved, review.Status)
+		m.orderRepo.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Rejected order cancelled", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.reviewRepo.On("ResolveReview", ctx, mock.Anything).Return(nil).Once()
+		productID, campaignID := uuid.New(), uuid.New()
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{
+			ID: orderID, Status: models.OrderStatusConfirmed, Items: []models.OrderItem{{ProductID: productID, Quantity: 2, CampaignID: &campaignID}},
+		}, nil).Once()
+		m.orderRepo.On("UpdateOrderStatus", ctx, orderID, models.OrderStatusCancelled).Return(&models.Order{ID: orderID, Status: models.OrderStatusCancelled}, nil).Once()
+		m.campaign.On("ReleaseStock", ctx, []models.CampaignClaim{{ProductID: productID, Quantity: 2, CampaignID: campaignID}}).Return().Once()
+
+		// Act
+		review, err := fraudService.ResolveReview(ctx, orderID, reviewerID, &models.ResolveFraudReviewRequest{Status: models.FraudReviewStatusRejected, Note: "Reseller bot"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.FraudReviewStatusRejected, review.Status)
+	})
+
+	t.Run("Failure - Review already resolved", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.reviewRepo.On("ResolveReview", ctx, mock.Anything).Return(sql.ErrNoRows).Once()
+		m.reviewRepo.On("GetReview", ctx, orderID).Return(&models.FraudReview{OrderID: orderID, Status: models.FraudReviewStatusApproved}, nil).Once()
+		m.deviceRepo.On("GetOrderDevice", ctx, orderID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		review, err := fraudService.ResolveReview(ctx, orderID, reviewerID, &models.ResolveFraudReviewRequest{Status: models.FraudReviewStatusRejected})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+		assert.Nil(t, review)
+	})
+
+	t.Run("Failure - Review not found", func(t *testing.T) {
+		// Arrange
+		fraudService, m := setupFraudServiceTest(t, service.VelocityPolicy{})
+		m.reviewRepo.On("ResolveReview", ctx, mock.Anything).Return(sql.ErrNoRows).Once()
+		m.reviewRepo.On("GetReview", ctx, orderID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := fraudService.ResolveReview(ctx, orderID, reviewerID, &models.ResolveFraudReviewRequest{Status: models.FraudReviewStatusApproved})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+}
+
+func TestVelocityPolicy_Validate(t *testing.T) {
+	require.NoError(t, service.VelocityPolicy{Rules: testVelocityRules}.Validate())
+
+	for name, rule := range map[string]service.VelocityRule{
+		"Unknown key":    {Name: "device", Key: "device", MaxOrders: 1, Window: time.Hour, Action: models.VelocityActionBlock},
+		"Unknown action": {Name: "ip", Key: models.VelocityKeyIP, MaxOrders: 1, Window: time.Hour, Action: "alert"},
+		"No window":      {Name: "ip", Key: models.VelocityKeyIP, MaxOrders: 1, Action: models.VelocityActionBlock},
+	} {
+		t.Run("Failure - "+name, func(t *testing.T) {
+			assert.Error(t, service.VelocityPolicy{Rules: []service.VelocityRule{rule}}.Validate())
+		})
+	}
+}
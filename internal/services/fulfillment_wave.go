@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// FulfillmentWaveService batches the orders ready to ship into waves picked together in a warehouse.
+type FulfillmentWaveService interface {
+	CreateWave(ctx context.Context, createdBy uuid.UUID, req *models.CreateFulfillmentWaveRequest) (*models.FulfillmentWave, error)
+	GetWave(ctx context.Context, id uuid.UUID) (*models.FulfillmentWave, error)
+	ListWaves(ctx context.Context, status models.FulfillmentWaveStatus, page, size int) ([]*models.FulfillmentWave, int, error)
+	GetPickList(ctx context.Context, id uuid.UUID) (*models.PickList, error)
+	CompleteWave(ctx context.Context, id uuid.UUID) (*models.CompleteFulfillmentWaveResponse, error)
+}
+
+// FulfillmentWavePolicy holds the largest wave, its packing slips are printed in one go and its orders
+// moved to shipping in one bulk update.
+type FulfillmentWavePolicy struct {
+	MaxOrders int
+}
+
+type fulfillmentWaveService struct {
+	repo          repository.FulfillmentWaveRepository
+	warehouseRepo repository.WarehouseRepository
+	orderService  OrderService
+	policy        FulfillmentWavePolicy
+}
+
+func NewFulfillmentWaveService(repo repository.FulfillmentWaveRepository, warehouseRepo repository.WarehouseRepository, orderService OrderService, policy FulfillmentWavePolicy) FulfillmentWaveService {
+	policy.MaxOrders = max(policy.MaxOrders, 1)
+
+	return &fulfillmentWaveService{
+		repo:          repo,
+		warehouseRepo: warehouseRepo,
+		orderService:  orderService,
+		policy:        policy,
+	}
+}
+
+// CreateWave groups the oldest paid orders of the fulfillment type not shipped yet whose stock is all
+// allocated in the warehouse. Orders split across warehouses are left out, they can't leave as one parcel.
+func (s *fulfillmentWaveService) CreateWave(ctx context.Context, createdBy uuid.UUID, req *models.CreateFulfillmentWaveRequest) (*models.FulfillmentWave, error) {
+	maxOrders := s.policy.MaxOrders
+	if req.MaxOrders > 0 {
+		if req.MaxOrders > maxOrders {
+			return nil, errors.BadRequestError(fmt.Sprintf("A wave holds at most %d orders", maxOrders))
+		}
+
+		maxOrders = req.MaxOrders
+	}
+
+	if _, err := s.warehouseRepo.GetWarehouseByID(ctx, req.WarehouseID); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Warehouse not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch warehouse").WithError(err)
+	}
+
+	fulfillmentType := req.FulfillmentType
+	if fulfillmentType == "" {
+		fulfillmentType = models.FulfillmentShipping
+	}
+
+	wave := &models.FulfillmentWave{
+		ID:              uuid.New(),
+		WarehouseID:     req.WarehouseID,
+		FulfillmentType: fulfillmentType,
+		CreatedBy:       createdBy,
+	}
+
+	if err := s.repo.CreateWave(ctx, wave, models.OrderStatusesLeadingTo(models.OrderStatusShipping), maxOrders); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.BadRequestError("No orders are ready to pick in this warehouse").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to create fulfillment wave").WithError(err)
+	}
+
+	return wave, nil
+}
+
+func (s *fulfillmentWaveService) GetWave(ctx context.Context, id uuid.UUID) (*models.FulfillmentWave, error) {
+	wave, err := s.repo.GetWave(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Fulfillment wave not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch fulfillment wave").WithError(err)
+	}
+
+	return wave, nil
+}
+
+func (s *fulfillmentWaveService) ListWaves(ctx context.Context, status models.FulfillmentWaveStatus, page, size int) ([]*models.FulfillmentWave, int, error) {
+	waves, total, err := s.repo.ListWaves(ctx, status, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list fulfillment waves").WithError(err)
+	}
+
+	return waves, total, nil
+}
+
+// GetPickList consolidates the items of the orders of the wave, a line per product.
+func (s *fulfillmentWaveService) GetPickList(ctx context.Context, id uuid.UUID) (*models.PickList, error) {
+	wave, err := s.GetWave(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, wave.WarehouseID)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch warehouse").WithError(err)
+	}
+
+	lines, err := s.repo.ListPickListLines(ctx, wave.ID)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to build pick list").WithError(err)
+	}
+
+	return &models.PickList{
+		WaveID:        wave.ID,
+		WarehouseID:   warehouse.ID,
+		WarehouseName: warehouse.Name,
+		OrderCount:    len(wave.OrderIDs),
+		Lines:         lines,
+	}, nil
+}
+
+// CompleteWave moves the orders of the wave to shipping, checked one by one like any bulk status
+// update, and closes the wave. Orders that could not move, like one cancelled since it was picked,
+// stay as they were and are free to join another wave.
+func (s *fulfillmentWaveService) CompleteWave(ctx context.Context, id uuid.UUID) (*models.CompleteFulfillmentWaveResponse, error) {
+	wave, err := s.GetWave(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if wave.Status != models.FulfillmentWaveOpen {
+		return nil, errors.InvalidTransitionError("Fulfillment wave is already completed")
+	}
+
+	results, err := s.orderService.BulkUpdateOrderStatus(ctx, &models.BulkUpdateOrderStatusRequest{
+		OrderIDs: wave.OrderIDs,
+		Status:   models.OrderStatusShipping,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CompleteWave(ctx, wave); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.InvalidTransitionError("Fulfillment wave is already completed").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to complete fulfillment wave").WithError(err)
+	}
+
+	return &models.CompleteFulfillmentWaveResponse{Wave: wave, Results: results}, nil
+}
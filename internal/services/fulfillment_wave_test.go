@@ -0,0 +1,200 @@
+package service_test
+
+import (
+	"database/sql"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fulfillmentWaveServiceMocks struct {
+	waveRepo      *mocks.MockFulfillmentWaveRepository
+	warehouseRepo *mocks.MockWarehouseRepository
+	orderService  *svcMocks.MockOrderService
+}
+
+func setupFulfillmentWaveServiceTest(t *testing.T) (service.FulfillmentWaveService, *fulfillmentWaveServiceMocks) {
+	m := &fulfillmentWaveServiceMocks{
+		waveRepo:      mocks.NewMockFulfillmentWaveRepository(t),
+		warehouseRepo: mocks.NewMockWarehouseRepository(t),
+		orderService:  svcMocks.NewMockOrderService(t),
+	}
+
+	return service.NewFulfillmentWaveService(m.waveRepo, m.warehouseRepo, m.orderService, service.FulfillmentWavePolicy{MaxOrders: 100}), m
+}
+
+func TestFulfillmentWaveService_CreateWave(t *testing.T) {
+	ctx := t.Context()
+	warehouseID := uuid.New()
+	adminID := uuid.New()
+	pickable := models.OrderStatusesLeadingTo(models.OrderStatusShipping)
+
+	t.Run("Success - Defaults to shipping and the configured size", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+		m.waveRepo.On("CreateWave", ctx, mock.MatchedBy(func(wave *models.FulfillmentWave) bool {
+			return wave.WarehouseID == warehouseID && wave.FulfillmentType == models.FulfillmentShipping && wave.CreatedBy == adminID
+		}), pickable, 100).Return(nil).Once()
+
+		// Act
+		wave, err := waveService.CreateWave(ctx, adminID, &models.CreateFulfillmentWaveRequest{WarehouseID: warehouseID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.FulfillmentShipping, wave.FulfillmentType)
+	})
+
+	t.Run("Success - Smaller wave asked for", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+		m.waveRepo.On("CreateWave", ctx, mock.Anything, pickable, 25).Return(nil).Once()
+
+		// Act
+		_, err := waveService.CreateWave(ctx, adminID, &models.CreateFulfillmentWaveRequest{WarehouseID: warehouseID, FulfillmentType: models.FulfillmentPickup, MaxOrders: 25})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Wave larger than configured", func(t *testing.T) {
+		// Arrange
+		waveService, _ := setupFulfillmentWaveServiceTest(t)
+
+		// Act
+		_, err := waveService.CreateWave(ctx, adminID, &models.CreateFulfillmentWaveRequest{WarehouseID: warehouseID, MaxOrders: 101})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Warehouse not found", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := waveService.CreateWave(ctx, adminID, &models.CreateFulfillmentWaveRequest{WarehouseID: warehouseID})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - No order ready", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+		m.waveRepo.On("CreateWave", ctx, mock.Anything, pickable, 100).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := waveService.CreateWave(ctx, adminID, &models.CreateFulfillmentWaveRequest{WarehouseID: warehouseID})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
+func TestFulfillmentWaveService_GetPickList(t *testing.T) {
+	ctx := t.Context()
+	waveID := uuid.New()
+	warehouseID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		lines := []models.PickListLine{{ProductID: uuid.New(), SKU: "SKU-1", Quantity: 5, OrderCount: 2}}
+		m.waveRepo.On("GetWave", ctx, waveID).Return(&models.FulfillmentWave{ID: waveID, WarehouseID: warehouseID, OrderIDs: []uuid.UUID{uuid.New(), uuid.New()}}, nil).Once()
+		m.warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID, Name: "North"}, nil).Once()
+		m.waveRepo.On("ListPickListLines", ctx, waveID).Return(lines, nil).Once()
+
+		// Act
+		pickList, err := waveService.GetPickList(ctx, waveID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "North", pickList.WarehouseName)
+		assert.Equal(t, 2, pickList.OrderCount)
+		assert.Equal(t, lines, pickList.Lines)
+	})
+
+	t.Run("Failure - Wave not found", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.waveRepo.On("GetWave", ctx, waveID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := waveService.GetPickList(ctx, waveID)
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestFulfillmentWaveService_CompleteWave(t *testing.T) {
+	ctx := t.Context()
+	waveID := uuid.New()
+	orderIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.Run("Success - Orders moved to shipping", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		results := &models.BulkUpdateOrderStatusResponse{Status: models.OrderStatusShipping, Succeeded: 1, Failed: 1}
+		m.waveRepo.On("GetWave", ctx, waveID).Return(&models.FulfillmentWave{ID: waveID, Status: models.FulfillmentWaveOpen, OrderIDs: orderIDs}, nil).Once()
+		m.orderService.On("BulkUpdateOrderStatus", ctx, &models.BulkUpdateOrderStatusRequest{OrderIDs: orderIDs, Status: models.OrderStatusShipping}).Return(results, nil).Once()
+		m.waveRepo.On("CompleteWave", ctx, mock.AnythingOfType("*models.FulfillmentWave")).Return(nil).Once()
+
+		// Act
+		completed, err := waveService.CompleteWave(ctx, waveID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, results, completed.Results)
+	})
+
+	t.Run("Failure - Already completed", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.waveRepo.On("GetWave", ctx, waveID).Return(&models.FulfillmentWave{ID: waveID, Status: models.FulfillmentWaveCompleted, OrderIDs: orderIDs}, nil).Once()
+
+		// Act
+		_, err := waveService.CompleteWave(ctx, waveID)
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeInvalidTransition, appErr.Code)
+	})
+
+	t.Run("Failure - Completed concurrently", func(t *testing.T) {
+		// Arrange
+		waveService, m := setupFulfillmentWaveServiceTest(t)
+		m.waveRepo.On("GetWave", ctx, waveID).Return(&models.FulfillmentWave{ID: waveID, Status: models.FulfillmentWaveOpen, OrderIDs: orderIDs}, nil).Once()
+		m.orderService.On("BulkUpdateOrderStatus", ctx, mock.Anything).Return(&models.BulkUpdateOrderStatusResponse{}, nil).Once()
+		m.waveRepo.On("CompleteWave", ctx, mock.Anything).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := waveService.CompleteWave(ctx, waveID)
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeInvalidTransition, appErr.Code)
+	})
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+)
+
+// maxViolationsPerCheck bounds the size of a report, the metrics still reflect the capped count.
+const maxViolationsPerCheck = 100
+
+type InvariantService interface {
+	RunChecks(ctx context.Context) (*models.InvariantReport, error)
+	GetLastReport(ctx context.Context) (*models.InvariantReport, error)
+	RunScheduled(ctx context.Context, interval time.Duration)
+}
+
+type invariantService struct {
+	repo repository.InvariantRepository
+
+	mu         sync.RWMutex
+	lastReport *models.InvariantReport
+}
+
+func NewInvariantService(repo repository.InvariantRepository) InvariantService {
+	return &invariantService{repo: repo}
+}
+
+func (s *invariantService) RunChecks(ctx context.Context) (*models.InvariantReport, error) {
+	report := &models.InvariantReport{
+		StartedAt:  time.Now(),
+		Counts:     make(map[models.InvariantCheck]int),
+		Violations: []models.InvariantViolation{},
+	}
+
+	checks := []struct {
+		name models.InvariantCheck
+		run  func(ctx context.Context, limit int) ([]models.InvariantViolation, error)
+	}{
+		{models.InvariantStockLedger, s.repo.FindStockLedgerViolations},
+		{models.InvariantOrderTotal, s.repo.FindOrderTotalViolations},
+		{models.InvariantOrderPayment, s.repo.FindOrderPaymentViolations},
+		{models.InvariantLedgerBalance, s.repo.FindLedgerBalanceViolations},
+	}
+
+	for _, check := range checks {
+		violations, err := check.run(ctx, maxViolationsPerCheck)
+		if err != nil {
+			return nil, errors.DatabaseError("Failed to run invariant check: " + string(check.name)).WithError(err)
+		}
+
+		report.Counts[check.name] = len(violations)
+		report.Violations = append(report.Violations, violations...)
+	}
+
+	report.FinishedAt = time.Now()
+
+	metrics.RecordInvariantReport(report)
+
+	s.mu.Lock()
+	s.lastReport = report
+	s.mu.Unlock()
+
+	return report, nil
+}
+
+func (s *invariantService) GetLastReport(_ context.Context) (*models.InvariantReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.lastReport == nil {
+		return nil, errors.NotFoundError("No invariants check has run yet")
+	}
+
+	return s.lastReport, nil
+}
+
+// RunScheduled runs the checks every interval until ctx is cancelled.
+func (s *invariantService) RunScheduled(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := s.RunChecks(ctx)
+			if err != nil {
+				slog.Error("Scheduled invariants check failed", slog.String("error", err.Error()))
+
+				continue
+			}
+
+			if len(report.Violations) > 0 {
+				slog.Warn("Invariant violations detected", slog.Any("counts", report.Counts))
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariantService_RunChecks(t *testing.T) {
+	t.Run("Success - Violations are collected", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockInvariantRepository(t)
+		invariantService := service.NewInvariantService(mockRepo)
+		ctx := t.Context()
+
+		stockViolation := models.InvariantViolation{Check: models.InvariantStockLedger, EntityID: "product-1", Expected: "5", Actual: "-1"}
+
+		mockRepo.On("FindStockLedgerViolations", ctx, mock.AnythingOfType("int")).Return([]models.InvariantViolation{stockViolation}, nil).Once()
+		mockRepo.On("FindOrderTotalViolations", ctx, mock.AnythingOfType("int")).Return(nil, nil).Once()
+		mockRepo.On("FindOrderPaymentViolations", ctx, mock.AnythingOfType("int")).Return(nil, nil).Once()
+		mockRepo.On("FindLedgerBalanceViolations", ctx, mock.AnythingOfType("int")).Return(nil, nil).Once()
+
+		// Act
+		report, err := invariantService.RunChecks(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.InvariantViolation{stockViolation}, report.Violations)
+		assert.Equal(t, 1, report.Counts[models.InvariantStockLedger])
+		assert.Equal(t, 0, report.Counts[models.InvariantOrderTotal])
+		assert.Equal(t, 0, report.Counts[models.InvariantOrderPayment])
+		assert.Equal(t, 0, report.Counts[models.InvariantLedgerBalance])
+
+		lastReport, err := invariantService.GetLastReport(ctx)
+		require.NoError(t, err)
+		assert.Same(t, report, lastReport)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockInvariantRepository(t)
+		invariantService := service.NewInvariantService(mockRepo)
+		ctx := t.Context()
+		dbErr := errors.New("db error")
+
+		mockRepo.On("FindStockLedgerViolations", ctx, mock.AnythingOfType("int")).Return(nil, dbErr).Once()
+
+		// Act
+		report, err := invariantService.RunChecks(ctx)
+
+		// Assert
+		assert.Nil(t, report)
+
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		assert.ErrorIs(t, err, dbErr)
+
+		mockRepo.AssertNotCalled(t, "FindOrderTotalViolations")
+	})
+}
+
+func TestInvariantService_GetLastReport(t *testing.T) {
+	// Arrange
+	invariantService := service.NewInvariantService(mocks.NewMockInvariantRepository(t))
+
+	// Act
+	report, err := invariantService.GetLastReport(t.Context())
+
+	// Assert
+	assert.Nil(t, report)
+
+	var appErr *appErrors.AppError
+
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+}
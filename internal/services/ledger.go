@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// LedgerService records every movement of money as a balanced double-entry transaction, the source of
+// truth of the finance reports. Recording is idempotent, a movement reported twice is recorded once, so a
+// failed write is retried as is: webhook events that fail stay in the webhook inbox for their redelivery
+// and end up in the dead letters, vendor payouts are recorded again by the payouts job.
+type LedgerService interface {
+	RecordCapture(ctx context.Context, sourceID, currency string, amount int64) error
+	RecordRefund(ctx context.Context, sourceID, currency string, refundedTotal int64) error
+	RecordFee(ctx context.Context, sourceID, balanceTransactionID, currency string, fee int64) error
+	RecordVendorPayout(ctx context.Context, payoutItemID, currency string, amount int64) error
+	RecordOrderRefund(ctx context.Context, paymentIntentID string, refund *models.OrderRefund) error
+	ReverseOrderRefund(ctx context.Context, paymentIntentID string, refund *models.OrderRefund) error
+	GetLedgerReport(ctx context.Context, from, to time.Time) (*models.LedgerReport, error)
+}
+
+type ledgerService struct {
+	repo repository.LedgerRepository
+}
+
+func NewLedgerService(repo repository.LedgerRepository) LedgerService {
+	return &ledgerService{repo: repo}
+}
+
+// RecordCapture moves a captured payment from the customer into the Stripe balance.
+func (s *ledgerService) RecordCapture(ctx context.Context, sourceID, currency string, amount int64) error {
+	return s.record(ctx, models.NewLedgerTransfer(models.LedgerTransactionCapture, sourceID, "capture:"+sourceID, currency, amount,
+		models.LedgerAccountStripeBalance, models.LedgerAccountSales))
+}
+
+// RecordRefund records what was refunded on the payment since the last refund recorded, Stripe reports
+// refundedTotal cumulatively. A total at or below what is already recorded is a late or repeated event.
+func (s *ledgerService) RecordRefund(ctx context.Context, sourceID, currency string, refundedTotal int64) error {
+	recorded, err := s.repo.GetRefundedAmount(ctx, sourceID)
+	if err != nil {
+		return errors.DatabaseError("Failed to get refunded amount").WithError(err)
+	}
+
+	key := "refund:" + sourceID + ":" + strconv.FormatInt(refundedTotal, 10)
+
+	return s.record(ctx, models.NewLedgerTransfer(models.LedgerTransactionRefund, sourceID, key, currency, refundedTotal-recorded,
+		models.LedgerAccountRefunds, models.LedgerAccountStripeBalance))
+}
+
+// RecordFee records the processing fee Stripe kept from a charge, keyed by its balance transaction.
+func (s *ledgerService) RecordFee(ctx context.Context, sourceID, balanceTransactionID, currency string, fee int64) error {
+	return s.record(ctx, models.NewLedgerTransfer(models.LedgerTransactionFee, sourceID, "fee:"+balanceTransactionID, currency, fee,
+		models.LedgerAccountProcessingFees, models.LedgerAccountStripeBalance))
+}
+
+// RecordVendorPayout records the transfer of a payout item to its vendor.
+func (s *ledgerService) RecordVendorPayout(ctx context.Context, payoutItemID, currency string, amount int64) error {
+	return s.record(ctx, models.NewLedgerTransfer(models.LedgerTransactionVendorPayout, payoutItemID, "vendor_payout:"+payoutItemID, currency, amount,
+		models.LedgerAccountVendorPayouts, models.LedgerAccountStripeBalance))
+}
+
+// RecordOrderRefund records a refund of order lines with an entry per line, referencing its order
+// item. It is recorded before the payment provider is asked, so the charge.refunded webhook of the
+// refund finds it already recorded and only records what was refunded otherwise.
+func (s *ledgerService) RecordOrderRefund(ctx context.Context, paymentIntentID string, refund *models.OrderRefund) error {
+	txn := &models.LedgerTransaction{
+		ID:             uuid.New(),
+		Type:           models.LedgerTransactionRefund,
+		SourceID:       paymentIntentID,
+		IdempotencyKey: "refund:" + refund.ID.String(),
+		Currency:       refund.Currency,
+	}
+
+	for _, line := range refund.Lines {
+		if line.Amount > 0 {
+			txn.Entries = append(txn.Entries, models.LedgerEntry{Account: models.LedgerAccountRefunds, Debit: line.Amount, Reference: line.OrderItemID.String()})
+		}
+	}
+
+	if len(txn.Entries) == 0 {
+		return nil
+	}
+
+	txn.Entries = append(txn.Entries, models.LedgerEntry{Account: models.LedgerAccountStripeBalance, Credit: refund.Amount})
+
+	return s.record(ctx, txn)
+}
+
+// ReverseOrderRefund takes back a refund recorded by RecordOrderRefund that the payment provider refused.
+func (s *ledgerService) ReverseOrderRefund(ctx context.Context, paymentIntentID string, refund *models.OrderRefund) error {
+	return s.record(ctx, models.NewLedgerTransfer(models.LedgerTransactionRefundReversal, paymentIntentID, "refund_reversal:"+refund.ID.String(), refund.Currency, refund.Amount,
+		models.LedgerAccountStripeBalance, models.LedgerAccountRefunds))
+}
+
+// record stores the transaction, movements of no amount are skipped.
+func (s *ledgerService) record(ctx context.Context, txn *models.LedgerTransaction) error {
+	if txn.Entries[0].Debit <= 0 {
+		return nil
+	}
+
+	txn.Currency = strings.ToLower(txn.Currency)
+
+	if !txn.Balanced() {
+		return errors.InternalError("Unbalanced ledger transaction")
+	}
+
+	// a transaction recorded before is not recorded again
+	if _, err := s.repo.RecordTransaction(ctx, txn); err != nil {
+		return errors.DatabaseError("Failed to record ledger transaction").WithError(err)
+	}
+
+	return nil
+}
+
+// GetLedgerReport totals every account over [from, to).
+func (s *ledgerService) GetLedgerReport(ctx context.Context, from, to time.Time) (*models.LedgerReport, error) {
+	balances, err := s.repo.GetBalances(ctx, from, to)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to get ledger balances").WithError(err)
+	}
+
+	return &models.LedgerReport{From: from, To: to, Balances: balances}, nil
+}
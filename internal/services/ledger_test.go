@@ -0,0 +1,202 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// permissiveLedgerService records every movement, for the tests not about the ledger.
+func permissiveLedgerService(t *testing.T) *svcMocks.MockLedgerService {
+	mockLedger := svcMocks.NewMockLedgerService(t)
+	mockLedger.On("RecordCapture", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockLedger.On("RecordRefund", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockLedger.On("RecordFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockLedger.On("RecordVendorPayout", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return mockLedger
+}
+
+// ledgerTransfer matches a transaction moving amount between the two accounts.
+func ledgerTransfer(txType models.LedgerTransactionType, key string, amount int64, debit, credit models.LedgerAccount) any {
+	return mock.MatchedBy(func(txn *models.LedgerTransaction) bool {
+		return txn.Type == txType && txn.IdempotencyKey == key && txn.Currency == "usd" && txn.Balanced() &&
+			txn.Entries[0] == models.LedgerEntry{Account: debit, Debit: amount} &&
+			txn.Entries[1] == models.LedgerEntry{Account: credit, Credit: amount}
+	})
+}
+
+func TestLedgerService_Record(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success - Capture credits the sales", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		mockRepo.On("RecordTransaction", ctx, ledgerTransfer(models.LedgerTransactionCapture, "capture:pi_1", 5000,
+			models.LedgerAccountStripeBalance, models.LedgerAccountSales)).Return(true, nil).Once()
+
+		// Act
+		err := ledgerService.RecordCapture(ctx, "pi_1", "USD", 5000)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Refund records what was refunded since the last one", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		mockRepo.On("GetRefundedAmount", ctx, "pi_1").Return(int64(1000), nil).Once()
+		mockRepo.On("RecordTransaction", ctx, ledgerTransfer(models.LedgerTransactionRefund, "refund:pi_1:2500", 1500,
+			models.LedgerAccountRefunds, models.LedgerAccountStripeBalance)).Return(true, nil).Once()
+
+		// Act
+		err := ledgerService.RecordRefund(ctx, "pi_1", "usd", 2500)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Late refund event is skipped", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		mockRepo.On("GetRefundedAmount", ctx, "pi_1").Return(int64(2500), nil).Once()
+
+		// Act
+		err := ledgerService.RecordRefund(ctx, "pi_1", "usd", 1000)
+
+		// Assert
+		require.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "RecordTransaction")
+	})
+
+	t.Run("Success - Fee and vendor payout leave the Stripe balance", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		mockRepo.On("RecordTransaction", ctx, ledgerTransfer(models.LedgerTransactionFee, "fee:txn_1", 175,
+			models.LedgerAccountProcessingFees, models.LedgerAccountStripeBalance)).Return(true, nil).Once()
+		mockRepo.On("RecordTransaction", ctx, ledgerTransfer(models.LedgerTransactionVendorPayout, "vendor_payout:item_1", 12046,
+			models.LedgerAccountVendorPayouts, models.LedgerAccountStripeBalance)).Return(false, nil).Once()
+
+		// Act
+		feeErr := ledgerService.RecordFee(ctx, "pi_1", "txn_1", "usd", 175)
+		payoutErr := ledgerService.RecordVendorPayout(ctx, "item_1", "usd", 12046)
+
+		// Assert
+		require.NoError(t, feeErr)
+		require.NoError(t, payoutErr)
+	})
+
+	t.Run("Success - Order refund debits the refunds per line", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		mugID, posterID := uuid.New(), uuid.New()
+		refund := &models.OrderRefund{ID: uuid.New(), Amount: 3338, Currency: "usd", Lines: []models.OrderRefundLine{
+			{OrderItemID: mugID, Amount: 1112},
+			{OrderItemID: posterID, Amount: 2226},
+		}}
+		mockRepo.On("RecordTransaction", ctx, mock.MatchedBy(func(txn *models.LedgerTransaction) bool {
+			return txn.Type == models.LedgerTransactionRefund && txn.SourceID == "pi_1" && txn.IdempotencyKey == "refund:"+refund.ID.String() &&
+				txn.Balanced() && len(txn.Entries) == 3 &&
+				txn.Entries[0] == models.LedgerEntry{Account: models.LedgerAccountRefunds, Debit: 1112, Reference: mugID.String()} &&
+				txn.Entries[1] == models.LedgerEntry{Account: models.LedgerAccountRefunds, Debit: 2226, Reference: posterID.String()} &&
+				txn.Entries[2] == models.LedgerEntry{Account: models.LedgerAccountStripeBalance, Credit: 3338}
+		})).Return(true, nil).Once()
+
+		// Act
+		err := ledgerService.RecordOrderRefund(ctx, "pi_1", refund)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Refused order refund is reversed", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		refund := &models.OrderRefund{ID: uuid.New(), Amount: 1112, Currency: "usd"}
+		mockRepo.On("RecordTransaction", ctx, ledgerTransfer(models.LedgerTransactionRefundReversal, "refund_reversal:"+refund.ID.String(), 1112,
+			models.LedgerAccountStripeBalance, models.LedgerAccountRefunds)).Return(true, nil).Once()
+
+		// Act
+		err := ledgerService.ReverseOrderRefund(ctx, "pi_1", refund)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Zero amount is skipped", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+
+		// Act
+		err := ledgerService.RecordFee(ctx, "pi_1", "txn_1", "usd", 0)
+
+		// Assert
+		require.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "RecordTransaction")
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		dbErr := errors.New("db error")
+		mockRepo.On("RecordTransaction", ctx, mock.AnythingOfType("*models.LedgerTransaction")).Return(false, dbErr).Once()
+
+		// Act
+		err := ledgerService.RecordCapture(ctx, "pi_1", "usd", 5000)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestLedgerService_GetLedgerReport(t *testing.T) {
+	ctx := t.Context()
+	from := testNow.AddDate(0, -1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		balances := []models.LedgerBalance{{Account: models.LedgerAccountSales, Currency: "usd", Credit: 5000, Balance: -5000}}
+		mockRepo.On("GetBalances", ctx, from, testNow).Return(balances, nil).Once()
+
+		// Act
+		report, err := ledgerService.GetLedgerReport(ctx, from, testNow)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.LedgerReport{From: from, To: testNow, Balances: balances}, report)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockLedgerRepository(t)
+		ledgerService := service.NewLedgerService(mockRepo)
+		mockRepo.On("GetBalances", ctx, from, testNow).Return(nil, errors.New("db error")).Once()
+
+		// Act
+		report, err := ledgerService.GetLedgerReport(ctx, from, testNow)
+
+		// Assert
+		assert.Nil(t, report)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
@@ -6,6 +6,8 @@ package mocks
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/google/uuid"
@@ -39,6 +41,168 @@ func (_m *MockNotificationService) EXPECT() *MockNotificationService_Expecter {
 	return &MockNotificationService_Expecter{mock: &_m.Mock}
 }
 
+// AddSuppression provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) AddSuppression(ctx context.Context, actorID uuid.UUID, req *models.AddSuppressionRequest) (*models.EmailSuppression, error) {
+	ret := _mock.Called(ctx, actorID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSuppression")
+	}
+
+	var r0 *models.EmailSuppression
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.AddSuppressionRequest) (*models.EmailSuppression, error)); ok {
+		return returnFunc(ctx, actorID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.AddSuppressionRequest) *models.EmailSuppression); ok {
+		r0 = returnFunc(ctx, actorID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.EmailSuppression)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *models.AddSuppressionRequest) error); ok {
+		r1 = returnFunc(ctx, actorID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_AddSuppression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSuppression'
+type MockNotificationService_AddSuppression_Call struct {
+	*mock.Call
+}
+
+// AddSuppression is a helper method to define mock.On call
+//   - ctx
+//   - actorID
+//   - req
+func (_e *MockNotificationService_Expecter) AddSuppression(ctx interface{}, actorID interface{}, req interface{}) *MockNotificationService_AddSuppression_Call {
+	return &MockNotificationService_AddSuppression_Call{Call: _e.mock.On("AddSuppression", ctx, actorID, req)}
+}
+
+func (_c *MockNotificationService_AddSuppression_Call) Run(run func(ctx context.Context, actorID uuid.UUID, req *models.AddSuppressionRequest)) *MockNotificationService_AddSuppression_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*models.AddSuppressionRequest))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_AddSuppression_Call) Return(emailSuppression *models.EmailSuppression, err error) *MockNotificationService_AddSuppression_Call {
+	_c.Call.Return(emailSuppression, err)
+	return _c
+}
+
+func (_c *MockNotificationService_AddSuppression_Call) RunAndReturn(run func(ctx context.Context, actorID uuid.UUID, req *models.AddSuppressionRequest) (*models.EmailSuppression, error)) *MockNotificationService_AddSuppression_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportNotifications provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) ExportNotifications(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportNotifications")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter, func(*models.Notification) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_ExportNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportNotifications'
+type MockNotificationService_ExportNotifications_Call struct {
+	*mock.Call
+}
+
+// ExportNotifications is a helper method to define mock.On call
+//   - ctx
+//   - filter
+//   - fn
+func (_e *MockNotificationService_Expecter) ExportNotifications(ctx interface{}, filter interface{}, fn interface{}) *MockNotificationService_ExportNotifications_Call {
+	return &MockNotificationService_ExportNotifications_Call{Call: _e.mock.On("ExportNotifications", ctx, filter, fn)}
+}
+
+func (_c *MockNotificationService_ExportNotifications_Call) Run(run func(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error)) *MockNotificationService_ExportNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationFilter), args[2].(func(*models.Notification) error))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_ExportNotifications_Call) Return(err error) *MockNotificationService_ExportNotifications_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_ExportNotifications_Call) RunAndReturn(run func(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error) *MockNotificationService_ExportNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRecipientNotifications provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) FindRecipientNotifications(ctx context.Context, recipient string) (*models.RecipientNotifications, error) {
+	ret := _mock.Called(ctx, recipient)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecipientNotifications")
+	}
+
+	var r0 *models.RecipientNotifications
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.RecipientNotifications, error)); ok {
+		return returnFunc(ctx, recipient)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.RecipientNotifications); ok {
+		r0 = returnFunc(ctx, recipient)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RecipientNotifications)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, recipient)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_FindRecipientNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecipientNotifications'
+type MockNotificationService_FindRecipientNotifications_Call struct {
+	*mock.Call
+}
+
+// FindRecipientNotifications is a helper method to define mock.On call
+//   - ctx
+//   - recipient
+func (_e *MockNotificationService_Expecter) FindRecipientNotifications(ctx interface{}, recipient interface{}) *MockNotificationService_FindRecipientNotifications_Call {
+	return &MockNotificationService_FindRecipientNotifications_Call{Call: _e.mock.On("FindRecipientNotifications", ctx, recipient)}
+}
+
+func (_c *MockNotificationService_FindRecipientNotifications_Call) Run(run func(ctx context.Context, recipient string)) *MockNotificationService_FindRecipientNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_FindRecipientNotifications_Call) Return(recipientNotifications *models.RecipientNotifications, err error) *MockNotificationService_FindRecipientNotifications_Call {
+	_c.Call.Return(recipientNotifications, err)
+	return _c
+}
+
+func (_c *MockNotificationService_FindRecipientNotifications_Call) RunAndReturn(run func(ctx context.Context, recipient string) (*models.RecipientNotifications, error)) *MockNotificationService_FindRecipientNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNotification provides a mock function for the type MockNotificationService
 func (_mock *MockNotificationService) GetNotification(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
 	ret := _mock.Called(ctx, id)
@@ -96,6 +260,113 @@ func (_c *MockNotificationService_GetNotification_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// HandleDeliveryEvents provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) HandleDeliveryEvents(ctx context.Context, payload []byte, signature string, timestamp string) error {
+	ret := _mock.Called(ctx, payload, signature, timestamp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleDeliveryEvents")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string, string) error); ok {
+		r0 = returnFunc(ctx, payload, signature, timestamp)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_HandleDeliveryEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleDeliveryEvents'
+type MockNotificationService_HandleDeliveryEvents_Call struct {
+	*mock.Call
+}
+
+// HandleDeliveryEvents is a helper method to define mock.On call
+//   - ctx
+//   - payload
+//   - signature
+//   - timestamp
+func (_e *MockNotificationService_Expecter) HandleDeliveryEvents(ctx interface{}, payload interface{}, signature interface{}, timestamp interface{}) *MockNotificationService_HandleDeliveryEvents_Call {
+	return &MockNotificationService_HandleDeliveryEvents_Call{Call: _e.mock.On("HandleDeliveryEvents", ctx, payload, signature, timestamp)}
+}
+
+func (_c *MockNotificationService_HandleDeliveryEvents_Call) Run(run func(ctx context.Context, payload []byte, signature string, timestamp string)) *MockNotificationService_HandleDeliveryEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_HandleDeliveryEvents_Call) Return(err error) *MockNotificationService_HandleDeliveryEvents_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_HandleDeliveryEvents_Call) RunAndReturn(run func(ctx context.Context, payload []byte, signature string, timestamp string) error) *MockNotificationService_HandleDeliveryEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSuppressions provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) ImportSuppressions(ctx context.Context, actorID uuid.UUID, source models.SuppressionSource, export io.Reader) (*models.SuppressionImportResult, error) {
+	ret := _mock.Called(ctx, actorID, source, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSuppressions")
+	}
+
+	var r0 *models.SuppressionImportResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.SuppressionSource, io.Reader) (*models.SuppressionImportResult, error)); ok {
+		return returnFunc(ctx, actorID, source, export)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.SuppressionSource, io.Reader) *models.SuppressionImportResult); ok {
+		r0 = returnFunc(ctx, actorID, source, export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SuppressionImportResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.SuppressionSource, io.Reader) error); ok {
+		r1 = returnFunc(ctx, actorID, source, export)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_ImportSuppressions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSuppressions'
+type MockNotificationService_ImportSuppressions_Call struct {
+	*mock.Call
+}
+
+// ImportSuppressions is a helper method to define mock.On call
+//   - ctx
+//   - actorID
+//   - source
+//   - export
+func (_e *MockNotificationService_Expecter) ImportSuppressions(ctx interface{}, actorID interface{}, source interface{}, export interface{}) *MockNotificationService_ImportSuppressions_Call {
+	return &MockNotificationService_ImportSuppressions_Call{Call: _e.mock.On("ImportSuppressions", ctx, actorID, source, export)}
+}
+
+func (_c *MockNotificationService_ImportSuppressions_Call) Run(run func(ctx context.Context, actorID uuid.UUID, source models.SuppressionSource, export io.Reader)) *MockNotificationService_ImportSuppressions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.SuppressionSource), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_ImportSuppressions_Call) Return(suppressionImportResult *models.SuppressionImportResult, err error) *MockNotificationService_ImportSuppressions_Call {
+	_c.Call.Return(suppressionImportResult, err)
+	return _c
+}
+
+func (_c *MockNotificationService_ImportSuppressions_Call) RunAndReturn(run func(ctx context.Context, actorID uuid.UUID, source models.SuppressionSource, export io.Reader) (*models.SuppressionImportResult, error)) *MockNotificationService_ImportSuppressions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNotifications provides a mock function for the type MockNotificationService
 func (_mock *MockNotificationService) ListNotifications(ctx context.Context, page int, size int) ([]*models.Notification, int, error) {
 	ret := _mock.Called(ctx, page, size)
@@ -160,6 +431,381 @@ func (_c *MockNotificationService_ListNotifications_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ListSuppressions provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) ListSuppressions(ctx context.Context, source models.SuppressionSource, page int, size int) ([]*models.EmailSuppression, int, error) {
+	ret := _mock.Called(ctx, source, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSuppressions")
+	}
+
+	var r0 []*models.EmailSuppression
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.SuppressionSource, int, int) ([]*models.EmailSuppression, int, error)); ok {
+		return returnFunc(ctx, source, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.SuppressionSource, int, int) []*models.EmailSuppression); ok {
+		r0 = returnFunc(ctx, source, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.EmailSuppression)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.SuppressionSource, int, int) int); ok {
+		r1 = returnFunc(ctx, source, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, models.SuppressionSource, int, int) error); ok {
+		r2 = returnFunc(ctx, source, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationService_ListSuppressions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSuppressions'
+type MockNotificationService_ListSuppressions_Call struct {
+	*mock.Call
+}
+
+// ListSuppressions is a helper method to define mock.On call
+//   - ctx
+//   - source
+//   - page
+//   - size
+func (_e *MockNotificationService_Expecter) ListSuppressions(ctx interface{}, source interface{}, page interface{}, size interface{}) *MockNotificationService_ListSuppressions_Call {
+	return &MockNotificationService_ListSuppressions_Call{Call: _e.mock.On("ListSuppressions", ctx, source, page, size)}
+}
+
+func (_c *MockNotificationService_ListSuppressions_Call) Run(run func(ctx context.Context, source models.SuppressionSource, page int, size int)) *MockNotificationService_ListSuppressions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SuppressionSource), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_ListSuppressions_Call) Return(emailSuppressions []*models.EmailSuppression, n int, err error) *MockNotificationService_ListSuppressions_Call {
+	_c.Call.Return(emailSuppressions, n, err)
+	return _c
+}
+
+func (_c *MockNotificationService_ListSuppressions_Call) RunAndReturn(run func(ctx context.Context, source models.SuppressionSource, page int, size int) ([]*models.EmailSuppression, int, error)) *MockNotificationService_ListSuppressions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSuppression provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) RemoveSuppression(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSuppression")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_RemoveSuppression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSuppression'
+type MockNotificationService_RemoveSuppression_Call struct {
+	*mock.Call
+}
+
+// RemoveSuppression is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockNotificationService_Expecter) RemoveSuppression(ctx interface{}, email interface{}) *MockNotificationService_RemoveSuppression_Call {
+	return &MockNotificationService_RemoveSuppression_Call{Call: _e.mock.On("RemoveSuppression", ctx, email)}
+}
+
+func (_c *MockNotificationService_RemoveSuppression_Call) Run(run func(ctx context.Context, email string)) *MockNotificationService_RemoveSuppression_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_RemoveSuppression_Call) Return(err error) *MockNotificationService_RemoveSuppression_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_RemoveSuppression_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockNotificationService_RemoveSuppression_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendNotification provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) ResendNotification(ctx context.Context, id uuid.UUID) (*models.NotificationResponse, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendNotification")
+	}
+
+	var r0 *models.NotificationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.NotificationResponse, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.NotificationResponse); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_ResendNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendNotification'
+type MockNotificationService_ResendNotification_Call struct {
+	*mock.Call
+}
+
+// ResendNotification is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockNotificationService_Expecter) ResendNotification(ctx interface{}, id interface{}) *MockNotificationService_ResendNotification_Call {
+	return &MockNotificationService_ResendNotification_Call{Call: _e.mock.On("ResendNotification", ctx, id)}
+}
+
+func (_c *MockNotificationService_ResendNotification_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockNotificationService_ResendNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_ResendNotification_Call) Return(notificationResponse *models.NotificationResponse, err error) *MockNotificationService_ResendNotification_Call {
+	_c.Call.Return(notificationResponse, err)
+	return _c
+}
+
+func (_c *MockNotificationService_ResendNotification_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.NotificationResponse, error)) *MockNotificationService_ResendNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryDueNotifications provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) RetryDueNotifications(ctx context.Context, batchSize int) (int, error) {
+	ret := _mock.Called(ctx, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryDueNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return returnFunc(ctx, batchSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = returnFunc(ctx, batchSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_RetryDueNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryDueNotifications'
+type MockNotificationService_RetryDueNotifications_Call struct {
+	*mock.Call
+}
+
+// RetryDueNotifications is a helper method to define mock.On call
+//   - ctx
+//   - batchSize
+func (_e *MockNotificationService_Expecter) RetryDueNotifications(ctx interface{}, batchSize interface{}) *MockNotificationService_RetryDueNotifications_Call {
+	return &MockNotificationService_RetryDueNotifications_Call{Call: _e.mock.On("RetryDueNotifications", ctx, batchSize)}
+}
+
+func (_c *MockNotificationService_RetryDueNotifications_Call) Run(run func(ctx context.Context, batchSize int)) *MockNotificationService_RetryDueNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_RetryDueNotifications_Call) Return(n int, err error) *MockNotificationService_RetryDueNotifications_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationService_RetryDueNotifications_Call) RunAndReturn(run func(ctx context.Context, batchSize int) (int, error)) *MockNotificationService_RetryDueNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryNotification provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) RetryNotification(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryNotification")
+	}
+
+	var r0 *models.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Notification, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Notification); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_RetryNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryNotification'
+type MockNotificationService_RetryNotification_Call struct {
+	*mock.Call
+}
+
+// RetryNotification is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockNotificationService_Expecter) RetryNotification(ctx interface{}, id interface{}) *MockNotificationService_RetryNotification_Call {
+	return &MockNotificationService_RetryNotification_Call{Call: _e.mock.On("RetryNotification", ctx, id)}
+}
+
+func (_c *MockNotificationService_RetryNotification_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockNotificationService_RetryNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_RetryNotification_Call) Return(notification *models.Notification, err error) *MockNotificationService_RetryNotification_Call {
+	_c.Call.Return(notification, err)
+	return _c
+}
+
+func (_c *MockNotificationService_RetryNotification_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.Notification, error)) *MockNotificationService_RetryNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunRetryWorker provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) RunRetryWorker(ctx context.Context, interval time.Duration, batchSize int) {
+	_mock.Called(ctx, interval, batchSize)
+	return
+}
+
+// MockNotificationService_RunRetryWorker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunRetryWorker'
+type MockNotificationService_RunRetryWorker_Call struct {
+	*mock.Call
+}
+
+// RunRetryWorker is a helper method to define mock.On call
+//   - ctx
+//   - interval
+//   - batchSize
+func (_e *MockNotificationService_Expecter) RunRetryWorker(ctx interface{}, interval interface{}, batchSize interface{}) *MockNotificationService_RunRetryWorker_Call {
+	return &MockNotificationService_RunRetryWorker_Call{Call: _e.mock.On("RunRetryWorker", ctx, interval, batchSize)}
+}
+
+func (_c *MockNotificationService_RunRetryWorker_Call) Run(run func(ctx context.Context, interval time.Duration, batchSize int)) *MockNotificationService_RunRetryWorker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_RunRetryWorker_Call) Return() *MockNotificationService_RunRetryWorker_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockNotificationService_RunRetryWorker_Call) RunAndReturn(run func(ctx context.Context, interval time.Duration, batchSize int)) *MockNotificationService_RunRetryWorker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchNotifications provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) SearchNotifications(ctx context.Context, filter *models.NotificationFilter, page int, size int) (*models.NotificationSearchResponse, error) {
+	ret := _mock.Called(ctx, filter, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchNotifications")
+	}
+
+	var r0 *models.NotificationSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter, int, int) (*models.NotificationSearchResponse, error)); ok {
+		return returnFunc(ctx, filter, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.NotificationFilter, int, int) *models.NotificationSearchResponse); ok {
+		r0 = returnFunc(ctx, filter, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.NotificationFilter, int, int) error); ok {
+		r1 = returnFunc(ctx, filter, page, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_SearchNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNotifications'
+type MockNotificationService_SearchNotifications_Call struct {
+	*mock.Call
+}
+
+// SearchNotifications is a helper method to define mock.On call
+//   - ctx
+//   - filter
+//   - page
+//   - size
+func (_e *MockNotificationService_Expecter) SearchNotifications(ctx interface{}, filter interface{}, page interface{}, size interface{}) *MockNotificationService_SearchNotifications_Call {
+	return &MockNotificationService_SearchNotifications_Call{Call: _e.mock.On("SearchNotifications", ctx, filter, page, size)}
+}
+
+func (_c *MockNotificationService_SearchNotifications_Call) Run(run func(ctx context.Context, filter *models.NotificationFilter, page int, size int)) *MockNotificationService_SearchNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationFilter), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SearchNotifications_Call) Return(notificationSearchResponse *models.NotificationSearchResponse, err error) *MockNotificationService_SearchNotifications_Call {
+	_c.Call.Return(notificationSearchResponse, err)
+	return _c
+}
+
+func (_c *MockNotificationService_SearchNotifications_Call) RunAndReturn(run func(ctx context.Context, filter *models.NotificationFilter, page int, size int) (*models.NotificationSearchResponse, error)) *MockNotificationService_SearchNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendEmail provides a mock function for the type MockNotificationService
 func (_mock *MockNotificationService) SendEmail(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error) {
 	ret := _mock.Called(ctx, req)
@@ -216,3 +862,60 @@ func (_c *MockNotificationService_SendEmail_Call) RunAndReturn(run func(ctx cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// SendEmailToAddress provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) SendEmailToAddress(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEmailToAddress")
+	}
+
+	var r0 *models.NotificationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.EmailNotificationRequest) (*models.NotificationResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.EmailNotificationRequest) *models.NotificationResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.EmailNotificationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationService_SendEmailToAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendEmailToAddress'
+type MockNotificationService_SendEmailToAddress_Call struct {
+	*mock.Call
+}
+
+// SendEmailToAddress is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockNotificationService_Expecter) SendEmailToAddress(ctx interface{}, req interface{}) *MockNotificationService_SendEmailToAddress_Call {
+	return &MockNotificationService_SendEmailToAddress_Call{Call: _e.mock.On("SendEmailToAddress", ctx, req)}
+}
+
+func (_c *MockNotificationService_SendEmailToAddress_Call) Run(run func(ctx context.Context, req *models.EmailNotificationRequest)) *MockNotificationService_SendEmailToAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.EmailNotificationRequest))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendEmailToAddress_Call) Return(notificationResponse *models.NotificationResponse, err error) *MockNotificationService_SendEmailToAddress_Call {
+	_c.Call.Return(notificationResponse, err)
+	return _c
+}
+
+func (_c *MockNotificationService_SendEmailToAddress_Call) RunAndReturn(run func(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error)) *MockNotificationService_SendEmailToAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
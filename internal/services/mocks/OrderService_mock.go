@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/google/uuid"
@@ -39,6 +40,63 @@ func (_m *MockOrderService) EXPECT() *MockOrderService_Expecter {
 	return &MockOrderService_Expecter{mock: &_m.Mock}
 }
 
+// BulkUpdateOrderStatus provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) BulkUpdateOrderStatus(ctx context.Context, req *models.BulkUpdateOrderStatusRequest) (*models.BulkUpdateOrderStatusResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateOrderStatus")
+	}
+
+	var r0 *models.BulkUpdateOrderStatusResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.BulkUpdateOrderStatusRequest) (*models.BulkUpdateOrderStatusResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.BulkUpdateOrderStatusRequest) *models.BulkUpdateOrderStatusResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkUpdateOrderStatusResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.BulkUpdateOrderStatusRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_BulkUpdateOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateOrderStatus'
+type MockOrderService_BulkUpdateOrderStatus_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateOrderStatus is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockOrderService_Expecter) BulkUpdateOrderStatus(ctx interface{}, req interface{}) *MockOrderService_BulkUpdateOrderStatus_Call {
+	return &MockOrderService_BulkUpdateOrderStatus_Call{Call: _e.mock.On("BulkUpdateOrderStatus", ctx, req)}
+}
+
+func (_c *MockOrderService_BulkUpdateOrderStatus_Call) Run(run func(ctx context.Context, req *models.BulkUpdateOrderStatusRequest)) *MockOrderService_BulkUpdateOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.BulkUpdateOrderStatusRequest))
+	})
+	return _c
+}
+
+func (_c *MockOrderService_BulkUpdateOrderStatus_Call) Return(bulkUpdateOrderStatusResponse *models.BulkUpdateOrderStatusResponse, err error) *MockOrderService_BulkUpdateOrderStatus_Call {
+	_c.Call.Return(bulkUpdateOrderStatusResponse, err)
+	return _c
+}
+
+func (_c *MockOrderService_BulkUpdateOrderStatus_Call) RunAndReturn(run func(ctx context.Context, req *models.BulkUpdateOrderStatusRequest) (*models.BulkUpdateOrderStatusResponse, error)) *MockOrderService_BulkUpdateOrderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateOrder provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) CreateOrder(ctx context.Context, req *models.CreateOrderRequest) (*models.Order, error) {
 	ret := _mock.Called(ctx, req)
@@ -96,6 +154,55 @@ func (_c *MockOrderService_CreateOrder_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// ExportOrders provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) ExportOrders(ctx context.Context, customerID uuid.UUID, from time.Time, to time.Time, fn func(*models.Order) error) error {
+	ret := _mock.Called(ctx, customerID, from, to, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportOrders")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time, func(*models.Order) error) error); ok {
+		r0 = returnFunc(ctx, customerID, from, to, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderService_ExportOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportOrders'
+type MockOrderService_ExportOrders_Call struct {
+	*mock.Call
+}
+
+// ExportOrders is a helper method to define mock.On call
+//   - ctx
+//   - customerID
+//   - from
+//   - to
+//   - fn
+func (_e *MockOrderService_Expecter) ExportOrders(ctx interface{}, customerID interface{}, from interface{}, to interface{}, fn interface{}) *MockOrderService_ExportOrders_Call {
+	return &MockOrderService_ExportOrders_Call{Call: _e.mock.On("ExportOrders", ctx, customerID, from, to, fn)}
+}
+
+func (_c *MockOrderService_ExportOrders_Call) Run(run func(ctx context.Context, customerID uuid.UUID, from time.Time, to time.Time, fn func(*models.Order) error)) *MockOrderService_ExportOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time), args[4].(func(*models.Order) error))
+	})
+	return _c
+}
+
+func (_c *MockOrderService_ExportOrders_Call) Return(err error) *MockOrderService_ExportOrders_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderService_ExportOrders_Call) RunAndReturn(run func(ctx context.Context, customerID uuid.UUID, from time.Time, to time.Time, fn func(*models.Order) error) error) *MockOrderService_ExportOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrderByID provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
 	ret := _mock.Called(ctx, id)
@@ -153,6 +260,177 @@ func (_c *MockOrderService_GetOrderByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetOrderTimeline provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetOrderTimeline(ctx context.Context, id uuid.UUID) (*models.OrderTimeline, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderTimeline")
+	}
+
+	var r0 *models.OrderTimeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrderTimeline, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrderTimeline); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderTimeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetOrderTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderTimeline'
+type MockOrderService_GetOrderTimeline_Call struct {
+	*mock.Call
+}
+
+// GetOrderTimeline is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockOrderService_Expecter) GetOrderTimeline(ctx interface{}, id interface{}) *MockOrderService_GetOrderTimeline_Call {
+	return &MockOrderService_GetOrderTimeline_Call{Call: _e.mock.On("GetOrderTimeline", ctx, id)}
+}
+
+func (_c *MockOrderService_GetOrderTimeline_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOrderService_GetOrderTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderTimeline_Call) Return(orderTimeline *models.OrderTimeline, err error) *MockOrderService_GetOrderTimeline_Call {
+	_c.Call.Return(orderTimeline, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderTimeline_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.OrderTimeline, error)) *MockOrderService_GetOrderTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPackingSlip provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetPackingSlip(ctx context.Context, id uuid.UUID) (*models.PackingSlip, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPackingSlip")
+	}
+
+	var r0 *models.PackingSlip
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.PackingSlip, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.PackingSlip); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PackingSlip)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetPackingSlip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPackingSlip'
+type MockOrderService_GetPackingSlip_Call struct {
+	*mock.Call
+}
+
+// GetPackingSlip is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockOrderService_Expecter) GetPackingSlip(ctx interface{}, id interface{}) *MockOrderService_GetPackingSlip_Call {
+	return &MockOrderService_GetPackingSlip_Call{Call: _e.mock.On("GetPackingSlip", ctx, id)}
+}
+
+func (_c *MockOrderService_GetPackingSlip_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockOrderService_GetPackingSlip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetPackingSlip_Call) Return(packingSlip *models.PackingSlip, err error) *MockOrderService_GetPackingSlip_Call {
+	_c.Call.Return(packingSlip, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetPackingSlip_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.PackingSlip, error)) *MockOrderService_GetPackingSlip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPackingSlips provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetPackingSlips(ctx context.Context, ids []uuid.UUID) ([]*models.PackingSlip, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPackingSlips")
+	}
+
+	var r0 []*models.PackingSlip
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*models.PackingSlip, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*models.PackingSlip); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.PackingSlip)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetPackingSlips_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPackingSlips'
+type MockOrderService_GetPackingSlips_Call struct {
+	*mock.Call
+}
+
+// GetPackingSlips is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockOrderService_Expecter) GetPackingSlips(ctx interface{}, ids interface{}) *MockOrderService_GetPackingSlips_Call {
+	return &MockOrderService_GetPackingSlips_Call{Call: _e.mock.On("GetPackingSlips", ctx, ids)}
+}
+
+func (_c *MockOrderService_GetPackingSlips_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockOrderService_GetPackingSlips_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetPackingSlips_Call) Return(packingSlips []*models.PackingSlip, err error) *MockOrderService_GetPackingSlips_Call {
+	_c.Call.Return(packingSlips, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetPackingSlips_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) ([]*models.PackingSlip, error)) *MockOrderService_GetPackingSlips_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListOrdersByCustomer provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) ListOrdersByCustomer(ctx context.Context, customerID uuid.UUID, page int, size int) ([]models.Order, int, error) {
 	ret := _mock.Called(ctx, customerID, page, size)
@@ -6,9 +6,11 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,6 +41,63 @@ func (_m *MockPaymentService) EXPECT() *MockPaymentService_Expecter {
 	return &MockPaymentService_Expecter{mock: &_m.Mock}
 }
 
+// CreateCheckoutSession provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) CreateCheckoutSession(ctx context.Context, req *models.CheckoutSessionRequest) (*models.CheckoutSessionResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCheckoutSession")
+	}
+
+	var r0 *models.CheckoutSessionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.CheckoutSessionRequest) (*models.CheckoutSessionResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.CheckoutSessionRequest) *models.CheckoutSessionResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CheckoutSessionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.CheckoutSessionRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_CreateCheckoutSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCheckoutSession'
+type MockPaymentService_CreateCheckoutSession_Call struct {
+	*mock.Call
+}
+
+// CreateCheckoutSession is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockPaymentService_Expecter) CreateCheckoutSession(ctx interface{}, req interface{}) *MockPaymentService_CreateCheckoutSession_Call {
+	return &MockPaymentService_CreateCheckoutSession_Call{Call: _e.mock.On("CreateCheckoutSession", ctx, req)}
+}
+
+func (_c *MockPaymentService_CreateCheckoutSession_Call) Run(run func(ctx context.Context, req *models.CheckoutSessionRequest)) *MockPaymentService_CreateCheckoutSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CheckoutSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_CreateCheckoutSession_Call) Return(checkoutSessionResponse *models.CheckoutSessionResponse, err error) *MockPaymentService_CreateCheckoutSession_Call {
+	_c.Call.Return(checkoutSessionResponse, err)
+	return _c
+}
+
+func (_c *MockPaymentService_CreateCheckoutSession_Call) RunAndReturn(run func(ctx context.Context, req *models.CheckoutSessionRequest) (*models.CheckoutSessionResponse, error)) *MockPaymentService_CreateCheckoutSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePayment provides a mock function for the type MockPaymentService
 func (_mock *MockPaymentService) CreatePayment(ctx context.Context, req *models.PaymentRequest) (*models.PaymentResponse, error) {
 	ret := _mock.Called(ctx, req)
@@ -96,6 +155,54 @@ func (_c *MockPaymentService_CreatePayment_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// ExportPayments provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) ExportPayments(ctx context.Context, from time.Time, to time.Time, fn func(models.Payment) error) error {
+	ret := _mock.Called(ctx, from, to, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPayments")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, func(models.Payment) error) error); ok {
+		r0 = returnFunc(ctx, from, to, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentService_ExportPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPayments'
+type MockPaymentService_ExportPayments_Call struct {
+	*mock.Call
+}
+
+// ExportPayments is a helper method to define mock.On call
+//   - ctx
+//   - from
+//   - to
+//   - fn
+func (_e *MockPaymentService_Expecter) ExportPayments(ctx interface{}, from interface{}, to interface{}, fn interface{}) *MockPaymentService_ExportPayments_Call {
+	return &MockPaymentService_ExportPayments_Call{Call: _e.mock.On("ExportPayments", ctx, from, to, fn)}
+}
+
+func (_c *MockPaymentService_ExportPayments_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, fn func(models.Payment) error)) *MockPaymentService_ExportPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(func(models.Payment) error))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_ExportPayments_Call) Return(err error) *MockPaymentService_ExportPayments_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentService_ExportPayments_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time, fn func(models.Payment) error) error) *MockPaymentService_ExportPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPaymentByID provides a mock function for the type MockPaymentService
 func (_mock *MockPaymentService) GetPaymentByID(ctx context.Context, id string) (*models.Payment, error) {
 	ret := _mock.Called(ctx, id)
@@ -153,6 +260,110 @@ func (_c *MockPaymentService_GetPaymentByID_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetSalesReport provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) GetSalesReport(ctx context.Context, from time.Time, to time.Time) (*models.SalesReport, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSalesReport")
+	}
+
+	var r0 *models.SalesReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (*models.SalesReport, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) *models.SalesReport); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SalesReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_GetSalesReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSalesReport'
+type MockPaymentService_GetSalesReport_Call struct {
+	*mock.Call
+}
+
+// GetSalesReport is a helper method to define mock.On call
+//   - ctx
+//   - from
+//   - to
+func (_e *MockPaymentService_Expecter) GetSalesReport(ctx interface{}, from interface{}, to interface{}) *MockPaymentService_GetSalesReport_Call {
+	return &MockPaymentService_GetSalesReport_Call{Call: _e.mock.On("GetSalesReport", ctx, from, to)}
+}
+
+func (_c *MockPaymentService_GetSalesReport_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockPaymentService_GetSalesReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_GetSalesReport_Call) Return(salesReport *models.SalesReport, err error) *MockPaymentService_GetSalesReport_Call {
+	_c.Call.Return(salesReport, err)
+	return _c
+}
+
+func (_c *MockPaymentService_GetSalesReport_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) (*models.SalesReport, error)) *MockPaymentService_GetSalesReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandleWebhookEvent provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) HandleWebhookEvent(ctx context.Context, event stripe.Event) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleWebhookEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, stripe.Event) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentService_HandleWebhookEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleWebhookEvent'
+type MockPaymentService_HandleWebhookEvent_Call struct {
+	*mock.Call
+}
+
+// HandleWebhookEvent is a helper method to define mock.On call
+//   - ctx
+//   - event
+func (_e *MockPaymentService_Expecter) HandleWebhookEvent(ctx interface{}, event interface{}) *MockPaymentService_HandleWebhookEvent_Call {
+	return &MockPaymentService_HandleWebhookEvent_Call{Call: _e.mock.On("HandleWebhookEvent", ctx, event)}
+}
+
+func (_c *MockPaymentService_HandleWebhookEvent_Call) Run(run func(ctx context.Context, event stripe.Event)) *MockPaymentService_HandleWebhookEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(stripe.Event))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_HandleWebhookEvent_Call) Return(err error) *MockPaymentService_HandleWebhookEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentService_HandleWebhookEvent_Call) RunAndReturn(run func(ctx context.Context, event stripe.Event) error) *MockPaymentService_HandleWebhookEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPaymentsByCustomer provides a mock function for the type MockPaymentService
 func (_mock *MockPaymentService) ListPaymentsByCustomer(ctx context.Context, customerID string, page int, size int) ([]*models.Payment, int, error) {
 	ret := _mock.Called(ctx, customerID, page, size)
@@ -218,58 +429,168 @@ func (_c *MockPaymentService_ListPaymentsByCustomer_Call) RunAndReturn(run func(
 	return _c
 }
 
-// ProcessWebhook provides a mock function for the type MockPaymentService
-func (_mock *MockPaymentService) ProcessWebhook(ctx context.Context, payload []byte, signature string) (stripe.Event, error) {
-	ret := _mock.Called(ctx, payload, signature)
+// ListWebhookDeadLetters provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) ListWebhookDeadLetters(ctx context.Context, unresolvedOnly bool, page int, size int) ([]*models.WebhookDeadLetter, int, error) {
+	ret := _mock.Called(ctx, unresolvedOnly, page, size)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ProcessWebhook")
+		panic("no return value specified for ListWebhookDeadLetters")
+	}
+
+	var r0 []*models.WebhookDeadLetter
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool, int, int) ([]*models.WebhookDeadLetter, int, error)); ok {
+		return returnFunc(ctx, unresolvedOnly, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool, int, int) []*models.WebhookDeadLetter); ok {
+		r0 = returnFunc(ctx, unresolvedOnly, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WebhookDeadLetter)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool, int, int) int); ok {
+		r1 = returnFunc(ctx, unresolvedOnly, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, bool, int, int) error); ok {
+		r2 = returnFunc(ctx, unresolvedOnly, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPaymentService_ListWebhookDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeadLetters'
+type MockPaymentService_ListWebhookDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeadLetters is a helper method to define mock.On call
+//   - ctx
+//   - unresolvedOnly
+//   - page
+//   - size
+func (_e *MockPaymentService_Expecter) ListWebhookDeadLetters(ctx interface{}, unresolvedOnly interface{}, page interface{}, size interface{}) *MockPaymentService_ListWebhookDeadLetters_Call {
+	return &MockPaymentService_ListWebhookDeadLetters_Call{Call: _e.mock.On("ListWebhookDeadLetters", ctx, unresolvedOnly, page, size)}
+}
+
+func (_c *MockPaymentService_ListWebhookDeadLetters_Call) Run(run func(ctx context.Context, unresolvedOnly bool, page int, size int)) *MockPaymentService_ListWebhookDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_ListWebhookDeadLetters_Call) Return(webhookDeadLetters []*models.WebhookDeadLetter, n int, err error) *MockPaymentService_ListWebhookDeadLetters_Call {
+	_c.Call.Return(webhookDeadLetters, n, err)
+	return _c
+}
+
+func (_c *MockPaymentService_ListWebhookDeadLetters_Call) RunAndReturn(run func(ctx context.Context, unresolvedOnly bool, page int, size int) ([]*models.WebhookDeadLetter, int, error)) *MockPaymentService_ListWebhookDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveWebhookDeadLetter provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) ResolveWebhookDeadLetter(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveWebhookDeadLetter")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentService_ResolveWebhookDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveWebhookDeadLetter'
+type MockPaymentService_ResolveWebhookDeadLetter_Call struct {
+	*mock.Call
+}
+
+// ResolveWebhookDeadLetter is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPaymentService_Expecter) ResolveWebhookDeadLetter(ctx interface{}, id interface{}) *MockPaymentService_ResolveWebhookDeadLetter_Call {
+	return &MockPaymentService_ResolveWebhookDeadLetter_Call{Call: _e.mock.On("ResolveWebhookDeadLetter", ctx, id)}
+}
+
+func (_c *MockPaymentService_ResolveWebhookDeadLetter_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockPaymentService_ResolveWebhookDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_ResolveWebhookDeadLetter_Call) Return(err error) *MockPaymentService_ResolveWebhookDeadLetter_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentService_ResolveWebhookDeadLetter_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockPaymentService_ResolveWebhookDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyWebhook provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) VerifyWebhook(payload []byte, signature string) (stripe.Event, error) {
+	ret := _mock.Called(payload, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyWebhook")
 	}
 
 	var r0 stripe.Event
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string) (stripe.Event, error)); ok {
-		return returnFunc(ctx, payload, signature)
+	if returnFunc, ok := ret.Get(0).(func([]byte, string) (stripe.Event, error)); ok {
+		return returnFunc(payload, signature)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string) stripe.Event); ok {
-		r0 = returnFunc(ctx, payload, signature)
+	if returnFunc, ok := ret.Get(0).(func([]byte, string) stripe.Event); ok {
+		r0 = returnFunc(payload, signature)
 	} else {
 		r0 = ret.Get(0).(stripe.Event)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte, string) error); ok {
-		r1 = returnFunc(ctx, payload, signature)
+	if returnFunc, ok := ret.Get(1).(func([]byte, string) error); ok {
+		r1 = returnFunc(payload, signature)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPaymentService_ProcessWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessWebhook'
-type MockPaymentService_ProcessWebhook_Call struct {
+// MockPaymentService_VerifyWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyWebhook'
+type MockPaymentService_VerifyWebhook_Call struct {
 	*mock.Call
 }
 
-// ProcessWebhook is a helper method to define mock.On call
-//   - ctx
+// VerifyWebhook is a helper method to define mock.On call
 //   - payload
 //   - signature
-func (_e *MockPaymentService_Expecter) ProcessWebhook(ctx interface{}, payload interface{}, signature interface{}) *MockPaymentService_ProcessWebhook_Call {
-	return &MockPaymentService_ProcessWebhook_Call{Call: _e.mock.On("ProcessWebhook", ctx, payload, signature)}
+func (_e *MockPaymentService_Expecter) VerifyWebhook(payload interface{}, signature interface{}) *MockPaymentService_VerifyWebhook_Call {
+	return &MockPaymentService_VerifyWebhook_Call{Call: _e.mock.On("VerifyWebhook", payload, signature)}
 }
 
-func (_c *MockPaymentService_ProcessWebhook_Call) Run(run func(ctx context.Context, payload []byte, signature string)) *MockPaymentService_ProcessWebhook_Call {
+func (_c *MockPaymentService_VerifyWebhook_Call) Run(run func(payload []byte, signature string)) *MockPaymentService_VerifyWebhook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]byte), args[2].(string))
+		run(args[0].([]byte), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockPaymentService_ProcessWebhook_Call) Return(v stripe.Event, err error) *MockPaymentService_ProcessWebhook_Call {
+func (_c *MockPaymentService_VerifyWebhook_Call) Return(v stripe.Event, err error) *MockPaymentService_VerifyWebhook_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockPaymentService_ProcessWebhook_Call) RunAndReturn(run func(ctx context.Context, payload []byte, signature string) (stripe.Event, error)) *MockPaymentService_ProcessWebhook_Call {
+func (_c *MockPaymentService_VerifyWebhook_Call) RunAndReturn(run func(payload []byte, signature string) (stripe.Event, error)) *MockPaymentService_VerifyWebhook_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/google/uuid"
@@ -96,6 +97,121 @@ func (_c *MockProductService_CreateProduct_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// CreateVendorProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) CreateVendorProduct(ctx context.Context, vendorID uuid.UUID, req *models.CreateProductRequest) (*models.Product, error) {
+	ret := _mock.Called(ctx, vendorID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVendorProduct")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.CreateProductRequest) (*models.Product, error)); ok {
+		return returnFunc(ctx, vendorID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.CreateProductRequest) *models.Product); ok {
+		r0 = returnFunc(ctx, vendorID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *models.CreateProductRequest) error); ok {
+		r1 = returnFunc(ctx, vendorID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_CreateVendorProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVendorProduct'
+type MockProductService_CreateVendorProduct_Call struct {
+	*mock.Call
+}
+
+// CreateVendorProduct is a helper method to define mock.On call
+//   - ctx
+//   - vendorID
+//   - req
+func (_e *MockProductService_Expecter) CreateVendorProduct(ctx interface{}, vendorID interface{}, req interface{}) *MockProductService_CreateVendorProduct_Call {
+	return &MockProductService_CreateVendorProduct_Call{Call: _e.mock.On("CreateVendorProduct", ctx, vendorID, req)}
+}
+
+func (_c *MockProductService_CreateVendorProduct_Call) Run(run func(ctx context.Context, vendorID uuid.UUID, req *models.CreateProductRequest)) *MockProductService_CreateVendorProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*models.CreateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockProductService_CreateVendorProduct_Call) Return(product *models.Product, err error) *MockProductService_CreateVendorProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductService_CreateVendorProduct_Call) RunAndReturn(run func(ctx context.Context, vendorID uuid.UUID, req *models.CreateProductRequest) (*models.Product, error)) *MockProductService_CreateVendorProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductAvailability provides a mock function for the type MockProductService
+func (_mock *MockProductService) GetProductAvailability(ctx context.Context, id uuid.UUID) (*models.ProductAvailability, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductAvailability")
+	}
+
+	var r0 *models.ProductAvailability
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ProductAvailability, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ProductAvailability); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductAvailability)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_GetProductAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductAvailability'
+type MockProductService_GetProductAvailability_Call struct {
+	*mock.Call
+}
+
+// GetProductAvailability is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProductService_Expecter) GetProductAvailability(ctx interface{}, id interface{}) *MockProductService_GetProductAvailability_Call {
+	return &MockProductService_GetProductAvailability_Call{Call: _e.mock.On("GetProductAvailability", ctx, id)}
+}
+
+func (_c *MockProductService_GetProductAvailability_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockProductService_GetProductAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductService_GetProductAvailability_Call) Return(productAvailability *models.ProductAvailability, err error) *MockProductService_GetProductAvailability_Call {
+	_c.Call.Return(productAvailability, err)
+	return _c
+}
+
+func (_c *MockProductService_GetProductAvailability_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.ProductAvailability, error)) *MockProductService_GetProductAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProductByID provides a mock function for the type MockProductService
 func (_mock *MockProductService) GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error) {
 	ret := _mock.Called(ctx, id)
@@ -153,6 +269,121 @@ func (_c *MockProductService_GetProductByID_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetProductFacets provides a mock function for the type MockProductService
+func (_mock *MockProductService) GetProductFacets(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFacets")
+	}
+
+	var r0 *models.ProductFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ProductFilter) (*models.ProductFacets, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ProductFilter) *models.ProductFacets); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductFacets)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.ProductFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_GetProductFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFacets'
+type MockProductService_GetProductFacets_Call struct {
+	*mock.Call
+}
+
+// GetProductFacets is a helper method to define mock.On call
+//   - ctx
+//   - filter
+func (_e *MockProductService_Expecter) GetProductFacets(ctx interface{}, filter interface{}) *MockProductService_GetProductFacets_Call {
+	return &MockProductService_GetProductFacets_Call{Call: _e.mock.On("GetProductFacets", ctx, filter)}
+}
+
+func (_c *MockProductService_GetProductFacets_Call) Run(run func(ctx context.Context, filter *models.ProductFilter)) *MockProductService_GetProductFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ProductFilter))
+	})
+	return _c
+}
+
+func (_c *MockProductService_GetProductFacets_Call) Return(productFacets *models.ProductFacets, err error) *MockProductService_GetProductFacets_Call {
+	_c.Call.Return(productFacets, err)
+	return _c
+}
+
+func (_c *MockProductService_GetProductFacets_Call) RunAndReturn(run func(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error)) *MockProductService_GetProductFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductChanges provides a mock function for the type MockProductService
+func (_mock *MockProductService) ListProductChanges(ctx context.Context, since string, limit int) (*models.ProductChangeFeed, error) {
+	ret := _mock.Called(ctx, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProductChanges")
+	}
+
+	var r0 *models.ProductChangeFeed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*models.ProductChangeFeed, error)); ok {
+		return returnFunc(ctx, since, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *models.ProductChangeFeed); ok {
+		r0 = returnFunc(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductChangeFeed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_ListProductChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductChanges'
+type MockProductService_ListProductChanges_Call struct {
+	*mock.Call
+}
+
+// ListProductChanges is a helper method to define mock.On call
+//   - ctx
+//   - since
+//   - limit
+func (_e *MockProductService_Expecter) ListProductChanges(ctx interface{}, since interface{}, limit interface{}) *MockProductService_ListProductChanges_Call {
+	return &MockProductService_ListProductChanges_Call{Call: _e.mock.On("ListProductChanges", ctx, since, limit)}
+}
+
+func (_c *MockProductService_ListProductChanges_Call) Run(run func(ctx context.Context, since string, limit int)) *MockProductService_ListProductChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductService_ListProductChanges_Call) Return(productChangeFeed *models.ProductChangeFeed, err error) *MockProductService_ListProductChanges_Call {
+	_c.Call.Return(productChangeFeed, err)
+	return _c
+}
+
+func (_c *MockProductService_ListProductChanges_Call) RunAndReturn(run func(ctx context.Context, since string, limit int) (*models.ProductChangeFeed, error)) *MockProductService_ListProductChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListProducts provides a mock function for the type MockProductService
 func (_mock *MockProductService) ListProducts(ctx context.Context, page int, pageSize int) ([]*models.Product, int, error) {
 	ret := _mock.Called(ctx, page, pageSize)
@@ -217,6 +448,450 @@ func (_c *MockProductService_ListProducts_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// ListVendorProducts provides a mock function for the type MockProductService
+func (_mock *MockProductService) ListVendorProducts(ctx context.Context, vendorID uuid.UUID, page int, pageSize int) ([]*models.Product, int, error) {
+	ret := _mock.Called(ctx, vendorID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVendorProducts")
+	}
+
+	var r0 []*models.Product
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.Product, int, error)); ok {
+		return returnFunc(ctx, vendorID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.Product); ok {
+		r0 = returnFunc(ctx, vendorID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = returnFunc(ctx, vendorID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, vendorID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockProductService_ListVendorProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVendorProducts'
+type MockProductService_ListVendorProducts_Call struct {
+	*mock.Call
+}
+
+// ListVendorProducts is a helper method to define mock.On call
+//   - ctx
+//   - vendorID
+//   - page
+//   - pageSize
+func (_e *MockProductService_Expecter) ListVendorProducts(ctx interface{}, vendorID interface{}, page interface{}, pageSize interface{}) *MockProductService_ListVendorProducts_Call {
+	return &MockProductService_ListVendorProducts_Call{Call: _e.mock.On("ListVendorProducts", ctx, vendorID, page, pageSize)}
+}
+
+func (_c *MockProductService_ListVendorProducts_Call) Run(run func(ctx context.Context, vendorID uuid.UUID, page int, pageSize int)) *MockProductService_ListVendorProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockProductService_ListVendorProducts_Call) Return(products []*models.Product, n int, err error) *MockProductService_ListVendorProducts_Call {
+	_c.Call.Return(products, n, err)
+	return _c
+}
+
+func (_c *MockProductService_ListVendorProducts_Call) RunAndReturn(run func(ctx context.Context, vendorID uuid.UUID, page int, pageSize int) ([]*models.Product, int, error)) *MockProductService_ListVendorProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LookupProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) LookupProduct(ctx context.Context, sku string, barcode string) (*models.Product, error) {
+	ret := _mock.Called(ctx, sku, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupProduct")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*models.Product, error)); ok {
+		return returnFunc(ctx, sku, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *models.Product); ok {
+		r0 = returnFunc(ctx, sku, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, sku, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_LookupProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupProduct'
+type MockProductService_LookupProduct_Call struct {
+	*mock.Call
+}
+
+// LookupProduct is a helper method to define mock.On call
+//   - ctx
+//   - sku
+//   - barcode
+func (_e *MockProductService_Expecter) LookupProduct(ctx interface{}, sku interface{}, barcode interface{}) *MockProductService_LookupProduct_Call {
+	return &MockProductService_LookupProduct_Call{Call: _e.mock.On("LookupProduct", ctx, sku, barcode)}
+}
+
+func (_c *MockProductService_LookupProduct_Call) Run(run func(ctx context.Context, sku string, barcode string)) *MockProductService_LookupProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProductService_LookupProduct_Call) Return(product *models.Product, err error) *MockProductService_LookupProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductService_LookupProduct_Call) RunAndReturn(run func(ctx context.Context, sku string, barcode string) (*models.Product, error)) *MockProductService_LookupProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishScheduledProducts provides a mock function for the type MockProductService
+func (_mock *MockProductService) PublishScheduledProducts(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishScheduledProducts")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_PublishScheduledProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishScheduledProducts'
+type MockProductService_PublishScheduledProducts_Call struct {
+	*mock.Call
+}
+
+// PublishScheduledProducts is a helper method to define mock.On call
+//   - ctx
+func (_e *MockProductService_Expecter) PublishScheduledProducts(ctx interface{}) *MockProductService_PublishScheduledProducts_Call {
+	return &MockProductService_PublishScheduledProducts_Call{Call: _e.mock.On("PublishScheduledProducts", ctx)}
+}
+
+func (_c *MockProductService_PublishScheduledProducts_Call) Run(run func(ctx context.Context)) *MockProductService_PublishScheduledProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductService_PublishScheduledProducts_Call) Return(n int, err error) *MockProductService_PublishScheduledProducts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProductService_PublishScheduledProducts_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockProductService_PublishScheduledProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuickEditProducts provides a mock function for the type MockProductService
+func (_mock *MockProductService) QuickEditProducts(ctx context.Context, adminID uuid.UUID, edits []models.ProductQuickEdit) (*models.ProductQuickEditResult, error) {
+	ret := _mock.Called(ctx, adminID, edits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickEditProducts")
+	}
+
+	var r0 *models.ProductQuickEditResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.ProductQuickEdit) (*models.ProductQuickEditResult, error)); ok {
+		return returnFunc(ctx, adminID, edits)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.ProductQuickEdit) *models.ProductQuickEditResult); ok {
+		r0 = returnFunc(ctx, adminID, edits)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProductQuickEditResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []models.ProductQuickEdit) error); ok {
+		r1 = returnFunc(ctx, adminID, edits)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_QuickEditProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuickEditProducts'
+type MockProductService_QuickEditProducts_Call struct {
+	*mock.Call
+}
+
+// QuickEditProducts is a helper method to define mock.On call
+//   - ctx
+//   - adminID
+//   - edits
+func (_e *MockProductService_Expecter) QuickEditProducts(ctx interface{}, adminID interface{}, edits interface{}) *MockProductService_QuickEditProducts_Call {
+	return &MockProductService_QuickEditProducts_Call{Call: _e.mock.On("QuickEditProducts", ctx, adminID, edits)}
+}
+
+func (_c *MockProductService_QuickEditProducts_Call) Run(run func(ctx context.Context, adminID uuid.UUID, edits []models.ProductQuickEdit)) *MockProductService_QuickEditProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.ProductQuickEdit))
+	})
+	return _c
+}
+
+func (_c *MockProductService_QuickEditProducts_Call) Return(productQuickEditResult *models.ProductQuickEditResult, err error) *MockProductService_QuickEditProducts_Call {
+	_c.Call.Return(productQuickEditResult, err)
+	return _c
+}
+
+func (_c *MockProductService_QuickEditProducts_Call) RunAndReturn(run func(ctx context.Context, adminID uuid.UUID, edits []models.ProductQuickEdit) (*models.ProductQuickEditResult, error)) *MockProductService_QuickEditProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunPublisher provides a mock function for the type MockProductService
+func (_mock *MockProductService) RunPublisher(ctx context.Context, interval time.Duration) {
+	_mock.Called(ctx, interval)
+	return
+}
+
+// MockProductService_RunPublisher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunPublisher'
+type MockProductService_RunPublisher_Call struct {
+	*mock.Call
+}
+
+// RunPublisher is a helper method to define mock.On call
+//   - ctx
+//   - interval
+func (_e *MockProductService_Expecter) RunPublisher(ctx interface{}, interval interface{}) *MockProductService_RunPublisher_Call {
+	return &MockProductService_RunPublisher_Call{Call: _e.mock.On("RunPublisher", ctx, interval)}
+}
+
+func (_c *MockProductService_RunPublisher_Call) Run(run func(ctx context.Context, interval time.Duration)) *MockProductService_RunPublisher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockProductService_RunPublisher_Call) Return() *MockProductService_RunPublisher_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProductService_RunPublisher_Call) RunAndReturn(run func(ctx context.Context, interval time.Duration)) *MockProductService_RunPublisher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPurchaseLimit provides a mock function for the type MockProductService
+func (_mock *MockProductService) SetPurchaseLimit(ctx context.Context, id uuid.UUID, req *models.SetPurchaseLimitRequest) (*models.Product, error) {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPurchaseLimit")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.SetPurchaseLimitRequest) (*models.Product, error)); ok {
+		return returnFunc(ctx, id, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.SetPurchaseLimitRequest) *models.Product); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *models.SetPurchaseLimitRequest) error); ok {
+		r1 = returnFunc(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_SetPurchaseLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPurchaseLimit'
+type MockProductService_SetPurchaseLimit_Call struct {
+	*mock.Call
+}
+
+// SetPurchaseLimit is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockProductService_Expecter) SetPurchaseLimit(ctx interface{}, id interface{}, req interface{}) *MockProductService_SetPurchaseLimit_Call {
+	return &MockProductService_SetPurchaseLimit_Call{Call: _e.mock.On("SetPurchaseLimit", ctx, id, req)}
+}
+
+func (_c *MockProductService_SetPurchaseLimit_Call) Run(run func(ctx context.Context, id uuid.UUID, req *models.SetPurchaseLimitRequest)) *MockProductService_SetPurchaseLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*models.SetPurchaseLimitRequest))
+	})
+	return _c
+}
+
+func (_c *MockProductService_SetPurchaseLimit_Call) Return(product *models.Product, err error) *MockProductService_SetPurchaseLimit_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductService_SetPurchaseLimit_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, req *models.SetPurchaseLimitRequest) (*models.Product, error)) *MockProductService_SetPurchaseLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRelatedProducts provides a mock function for the type MockProductService
+func (_mock *MockProductService) SetRelatedProducts(ctx context.Context, id uuid.UUID, req *models.SetRelatedProductsRequest) ([]models.RelatedProduct, error) {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRelatedProducts")
+	}
+
+	var r0 []models.RelatedProduct
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.SetRelatedProductsRequest) ([]models.RelatedProduct, error)); ok {
+		return returnFunc(ctx, id, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.SetRelatedProductsRequest) []models.RelatedProduct); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RelatedProduct)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *models.SetRelatedProductsRequest) error); ok {
+		r1 = returnFunc(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_SetRelatedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRelatedProducts'
+type MockProductService_SetRelatedProducts_Call struct {
+	*mock.Call
+}
+
+// SetRelatedProducts is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockProductService_Expecter) SetRelatedProducts(ctx interface{}, id interface{}, req interface{}) *MockProductService_SetRelatedProducts_Call {
+	return &MockProductService_SetRelatedProducts_Call{Call: _e.mock.On("SetRelatedProducts", ctx, id, req)}
+}
+
+func (_c *MockProductService_SetRelatedProducts_Call) Run(run func(ctx context.Context, id uuid.UUID, req *models.SetRelatedProductsRequest)) *MockProductService_SetRelatedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*models.SetRelatedProductsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProductService_SetRelatedProducts_Call) Return(relatedProducts []models.RelatedProduct, err error) *MockProductService_SetRelatedProducts_Call {
+	_c.Call.Return(relatedProducts, err)
+	return _c
+}
+
+func (_c *MockProductService_SetRelatedProducts_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, req *models.SetRelatedProductsRequest) ([]models.RelatedProduct, error)) *MockProductService_SetRelatedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePrices provides a mock function for the type MockProductService
+func (_mock *MockProductService) UpdatePrices(ctx context.Context, adminID uuid.UUID, req *models.BulkPriceUpdateRequest) (*models.BulkPriceUpdateResult, error) {
+	ret := _mock.Called(ctx, adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrices")
+	}
+
+	var r0 *models.BulkPriceUpdateResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.BulkPriceUpdateRequest) (*models.BulkPriceUpdateResult, error)); ok {
+		return returnFunc(ctx, adminID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.BulkPriceUpdateRequest) *models.BulkPriceUpdateResult); ok {
+		r0 = returnFunc(ctx, adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkPriceUpdateResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *models.BulkPriceUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_UpdatePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrices'
+type MockProductService_UpdatePrices_Call struct {
+	*mock.Call
+}
+
+// UpdatePrices is a helper method to define mock.On call
+//   - ctx
+//   - adminID
+//   - req
+func (_e *MockProductService_Expecter) UpdatePrices(ctx interface{}, adminID interface{}, req interface{}) *MockProductService_UpdatePrices_Call {
+	return &MockProductService_UpdatePrices_Call{Call: _e.mock.On("UpdatePrices", ctx, adminID, req)}
+}
+
+func (_c *MockProductService_UpdatePrices_Call) Run(run func(ctx context.Context, adminID uuid.UUID, req *models.BulkPriceUpdateRequest)) *MockProductService_UpdatePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*models.BulkPriceUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *MockProductService_UpdatePrices_Call) Return(bulkPriceUpdateResult *models.BulkPriceUpdateResult, err error) *MockProductService_UpdatePrices_Call {
+	_c.Call.Return(bulkPriceUpdateResult, err)
+	return _c
+}
+
+func (_c *MockProductService_UpdatePrices_Call) RunAndReturn(run func(ctx context.Context, adminID uuid.UUID, req *models.BulkPriceUpdateRequest) (*models.BulkPriceUpdateResult, error)) *MockProductService_UpdatePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProduct provides a mock function for the type MockProductService
 func (_mock *MockProductService) UpdateProduct(ctx context.Context, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error) {
 	ret := _mock.Called(ctx, id, req)
@@ -274,3 +949,62 @@ func (_c *MockProductService_UpdateProduct_Call) RunAndReturn(run func(ctx conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateVendorProduct provides a mock function for the type MockProductService
+func (_mock *MockProductService) UpdateVendorProduct(ctx context.Context, vendorID uuid.UUID, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error) {
+	ret := _mock.Called(ctx, vendorID, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVendorProduct")
+	}
+
+	var r0 *models.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *models.UpdateProductRequest) (*models.Product, error)); ok {
+		return returnFunc(ctx, vendorID, id, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *models.UpdateProductRequest) *models.Product); ok {
+		r0 = returnFunc(ctx, vendorID, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *models.UpdateProductRequest) error); ok {
+		r1 = returnFunc(ctx, vendorID, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductService_UpdateVendorProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVendorProduct'
+type MockProductService_UpdateVendorProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateVendorProduct is a helper method to define mock.On call
+//   - ctx
+//   - vendorID
+//   - id
+//   - req
+func (_e *MockProductService_Expecter) UpdateVendorProduct(ctx interface{}, vendorID interface{}, id interface{}, req interface{}) *MockProductService_UpdateVendorProduct_Call {
+	return &MockProductService_UpdateVendorProduct_Call{Call: _e.mock.On("UpdateVendorProduct", ctx, vendorID, id, req)}
+}
+
+func (_c *MockProductService_UpdateVendorProduct_Call) Run(run func(ctx context.Context, vendorID uuid.UUID, id uuid.UUID, req *models.UpdateProductRequest)) *MockProductService_UpdateVendorProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*models.UpdateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockProductService_UpdateVendorProduct_Call) Return(product *models.Product, err error) *MockProductService_UpdateVendorProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductService_UpdateVendorProduct_Call) RunAndReturn(run func(ctx context.Context, vendorID uuid.UUID, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error)) *MockProductService_UpdateVendorProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,6 +39,53 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
+// ChangePassword provides a mock function for the type MockUserService
+func (_mock *MockUserService) ChangePassword(ctx context.Context, claims *models.Claims, req *models.ChangePasswordRequest) error {
+	ret := _mock.Called(ctx, claims, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Claims, *models.ChangePasswordRequest) error); ok {
+		r0 = returnFunc(ctx, claims, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockUserService_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx
+//   - claims
+//   - req
+func (_e *MockUserService_Expecter) ChangePassword(ctx interface{}, claims interface{}, req interface{}) *MockUserService_ChangePassword_Call {
+	return &MockUserService_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, claims, req)}
+}
+
+func (_c *MockUserService_ChangePassword_Call) Run(run func(ctx context.Context, claims *models.Claims, req *models.ChangePasswordRequest)) *MockUserService_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Claims), args[2].(*models.ChangePasswordRequest))
+	})
+	return _c
+}
+
+func (_c *MockUserService_ChangePassword_Call) Return(err error) *MockUserService_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, claims *models.Claims, req *models.ChangePasswordRequest) error) *MockUserService_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByID provides a mock function for the type MockUserService
 func (_mock *MockUserService) GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	ret := _mock.Called(ctx, id)
@@ -96,9 +143,190 @@ func (_c *MockUserService_GetUserByID_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// GetUserTimeline provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetUserTimeline(ctx context.Context, id uuid.UUID, page int, size int) ([]*models.TimelineEvent, int, error) {
+	ret := _mock.Called(ctx, id, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTimeline")
+	}
+
+	var r0 []*models.TimelineEvent
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.TimelineEvent, int, error)); ok {
+		return returnFunc(ctx, id, page, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.TimelineEvent); ok {
+		r0 = returnFunc(ctx, id, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TimelineEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = returnFunc(ctx, id, page, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, id, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserService_GetUserTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTimeline'
+type MockUserService_GetUserTimeline_Call struct {
+	*mock.Call
+}
+
+// GetUserTimeline is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - page
+//   - size
+func (_e *MockUserService_Expecter) GetUserTimeline(ctx interface{}, id interface{}, page interface{}, size interface{}) *MockUserService_GetUserTimeline_Call {
+	return &MockUserService_GetUserTimeline_Call{Call: _e.mock.On("GetUserTimeline", ctx, id, page, size)}
+}
+
+func (_c *MockUserService_GetUserTimeline_Call) Run(run func(ctx context.Context, id uuid.UUID, page int, size int)) *MockUserService_GetUserTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetUserTimeline_Call) Return(timelineEvents []*models.TimelineEvent, n int, err error) *MockUserService_GetUserTimeline_Call {
+	_c.Call.Return(timelineEvents, n, err)
+	return _c
+}
+
+func (_c *MockUserService_GetUserTimeline_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, page int, size int) ([]*models.TimelineEvent, int, error)) *MockUserService_GetUserTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Impersonate provides a mock function for the type MockUserService
+func (_mock *MockUserService) Impersonate(ctx context.Context, actor *models.Claims, id uuid.UUID, req *models.ImpersonationRequest) (*models.ImpersonationResponse, error) {
+	ret := _mock.Called(ctx, actor, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Impersonate")
+	}
+
+	var r0 *models.ImpersonationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Claims, uuid.UUID, *models.ImpersonationRequest) (*models.ImpersonationResponse, error)); ok {
+		return returnFunc(ctx, actor, id, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Claims, uuid.UUID, *models.ImpersonationRequest) *models.ImpersonationResponse); ok {
+		r0 = returnFunc(ctx, actor, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImpersonationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.Claims, uuid.UUID, *models.ImpersonationRequest) error); ok {
+		r1 = returnFunc(ctx, actor, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_Impersonate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Impersonate'
+type MockUserService_Impersonate_Call struct {
+	*mock.Call
+}
+
+// Impersonate is a helper method to define mock.On call
+//   - ctx
+//   - actor
+//   - id
+//   - req
+func (_e *MockUserService_Expecter) Impersonate(ctx interface{}, actor interface{}, id interface{}, req interface{}) *MockUserService_Impersonate_Call {
+	return &MockUserService_Impersonate_Call{Call: _e.mock.On("Impersonate", ctx, actor, id, req)}
+}
+
+func (_c *MockUserService_Impersonate_Call) Run(run func(ctx context.Context, actor *models.Claims, id uuid.UUID, req *models.ImpersonationRequest)) *MockUserService_Impersonate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Claims), args[2].(uuid.UUID), args[3].(*models.ImpersonationRequest))
+	})
+	return _c
+}
+
+func (_c *MockUserService_Impersonate_Call) Return(impersonationResponse *models.ImpersonationResponse, err error) *MockUserService_Impersonate_Call {
+	_c.Call.Return(impersonationResponse, err)
+	return _c
+}
+
+func (_c *MockUserService_Impersonate_Call) RunAndReturn(run func(ctx context.Context, actor *models.Claims, id uuid.UUID, req *models.ImpersonationRequest) (*models.ImpersonationResponse, error)) *MockUserService_Impersonate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockUserService
+func (_mock *MockUserService) ListSessions(ctx context.Context, claims *models.Claims) ([]*models.Session, error) {
+	ret := _mock.Called(ctx, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []*models.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Claims) ([]*models.Session, error)); ok {
+		return returnFunc(ctx, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Claims) []*models.Session); ok {
+		r0 = returnFunc(ctx, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.Claims) error); ok {
+		r1 = returnFunc(ctx, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockUserService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx
+//   - claims
+func (_e *MockUserService_Expecter) ListSessions(ctx interface{}, claims interface{}) *MockUserService_ListSessions_Call {
+	return &MockUserService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, claims)}
+}
+
+func (_c *MockUserService_ListSessions_Call) Run(run func(ctx context.Context, claims *models.Claims)) *MockUserService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Claims))
+	})
+	return _c
+}
+
+func (_c *MockUserService_ListSessions_Call) Return(sessions []*models.Session, err error) *MockUserService_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockUserService_ListSessions_Call) RunAndReturn(run func(ctx context.Context, claims *models.Claims) ([]*models.Session, error)) *MockUserService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function for the type MockUserService
-func (_mock *MockUserService) Login(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error) {
-	ret := _mock.Called(ctx, req)
+func (_mock *MockUserService) Login(ctx context.Context, req *models.LoginRequest, device models.LoginDevice) (*models.LoginResponse, error) {
+	ret := _mock.Called(ctx, req, device)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -106,18 +334,18 @@ func (_mock *MockUserService) Login(ctx context.Context, req *models.LoginReques
 
 	var r0 *models.LoginResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.LoginRequest) (*models.LoginResponse, error)); ok {
-		return returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.LoginRequest, models.LoginDevice) (*models.LoginResponse, error)); ok {
+		return returnFunc(ctx, req, device)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.LoginRequest) *models.LoginResponse); ok {
-		r0 = returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.LoginRequest, models.LoginDevice) *models.LoginResponse); ok {
+		r0 = returnFunc(ctx, req, device)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.LoginResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.LoginRequest) error); ok {
-		r1 = returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.LoginRequest, models.LoginDevice) error); ok {
+		r1 = returnFunc(ctx, req, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -132,13 +360,14 @@ type MockUserService_Login_Call struct {
 // Login is a helper method to define mock.On call
 //   - ctx
 //   - req
-func (_e *MockUserService_Expecter) Login(ctx interface{}, req interface{}) *MockUserService_Login_Call {
-	return &MockUserService_Login_Call{Call: _e.mock.On("Login", ctx, req)}
+//   - device
+func (_e *MockUserService_Expecter) Login(ctx interface{}, req interface{}, device interface{}) *MockUserService_Login_Call {
+	return &MockUserService_Login_Call{Call: _e.mock.On("Login", ctx, req, device)}
 }
 
-func (_c *MockUserService_Login_Call) Run(run func(ctx context.Context, req *models.LoginRequest)) *MockUserService_Login_Call {
+func (_c *MockUserService_Login_Call) Run(run func(ctx context.Context, req *models.LoginRequest, device models.LoginDevice)) *MockUserService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.LoginRequest))
+		run(args[0].(context.Context), args[1].(*models.LoginRequest), args[2].(models.LoginDevice))
 	})
 	return _c
 }
@@ -148,7 +377,7 @@ func (_c *MockUserService_Login_Call) Return(loginResponse *models.LoginResponse
 	return _c
 }
 
-func (_c *MockUserService_Login_Call) RunAndReturn(run func(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error)) *MockUserService_Login_Call {
+func (_c *MockUserService_Login_Call) RunAndReturn(run func(ctx context.Context, req *models.LoginRequest, device models.LoginDevice) (*models.LoginResponse, error)) *MockUserService_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -209,3 +438,50 @@ func (_c *MockUserService_Register_Call) RunAndReturn(run func(ctx context.Conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// RevokeSession provides a mock function for the type MockUserService
+func (_mock *MockUserService) RevokeSession(ctx context.Context, claims *models.Claims, id uuid.UUID) error {
+	ret := _mock.Called(ctx, claims, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Claims, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, claims, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockUserService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx
+//   - claims
+//   - id
+func (_e *MockUserService_Expecter) RevokeSession(ctx interface{}, claims interface{}, id interface{}) *MockUserService_RevokeSession_Call {
+	return &MockUserService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, claims, id)}
+}
+
+func (_c *MockUserService_RevokeSession_Call) Run(run func(ctx context.Context, claims *models.Claims, id uuid.UUID)) *MockUserService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Claims), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserService_RevokeSession_Call) Return(err error) *MockUserService_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, claims *models.Claims, id uuid.UUID) error) *MockUserService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,40 +2,132 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"encoding/json"
+	stdErrors "errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
 	"github.com/google/uuid"
 )
 
 type NotificationService interface {
 	SendEmail(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error)
+	SendEmailToAddress(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error)
 	GetNotification(ctx context.Context, id uuid.UUID) (*models.Notification, error)
 	ListNotifications(ctx context.Context, page int, size int) ([]*models.Notification, int, error)
+	SearchNotifications(ctx context.Context, filter *models.NotificationFilter, page, size int) (*models.NotificationSearchResponse, error)
+	ExportNotifications(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error
+	RetryNotification(ctx context.Context, id uuid.UUID) (*models.Notification, error)
+	FindRecipientNotifications(ctx context.Context, recipient string) (*models.RecipientNotifications, error)
+	ResendNotification(ctx context.Context, id uuid.UUID) (*models.NotificationResponse, error)
+	RetryDueNotifications(ctx context.Context, batchSize int) (int, error)
+	RunRetryWorker(ctx context.Context, interval time.Duration, batchSize int)
+	HandleDeliveryEvents(ctx context.Context, payload []byte, signature, timestamp string) error
+	ListSuppressions(ctx context.Context, source models.SuppressionSource, page, size int) ([]*models.EmailSuppression, int, error)
+	AddSuppression(ctx context.Context, actorID uuid.UUID, req *models.AddSuppressionRequest) (*models.EmailSuppression, error)
+	RemoveSuppression(ctx context.Context, email string) error
+	ImportSuppressions(ctx context.Context, actorID uuid.UUID, source models.SuppressionSource, export io.Reader) (*models.SuppressionImportResult, error)
+}
+
+const suppressedReason = "Recipient address is on the suppression list"
+
+// recipientNotificationsLimit bounds the notifications listed for a recipient, the latest are kept.
+const recipientNotificationsLimit = 100
+
+// suppressionImportBatch bounds the addresses inserted by a single statement of an import.
+const suppressionImportBatch = 1000
+
+// notificationRetryLease is how long a claimed retry stays hidden from the other workers.
+const notificationRetryLease = 5 * time.Minute
+
+// NotificationRetryPolicy controls how failed notifications are retried. The n-th retry waits
+// BaseDelay * 2^(n-1), capped at MaxDelay. A notification is given up after MaxAttempts sends.
+type NotificationRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func (p NotificationRetryPolicy) backoff(retryCount int) time.Duration {
+	delay := p.BaseDelay
+	for range retryCount {
+		if delay >= p.MaxDelay {
+			break
+		}
+
+		delay *= 2
+	}
+
+	return min(delay, p.MaxDelay)
 }
 
 type notificationService struct {
 	repo         repository.NotificationRepository
 	userRepo     repository.UserRepository
 	emailService sendgrid.EmailService
+	retryPolicy  NotificationRetryPolicy
+	throttle     *sendgrid.Throttle
+	nonces       webhookauth.NonceStore
+	settings     StoreSettingsService
+	clock        clock.Clock
 }
 
-func NewNotificationService(repo repository.NotificationRepository, userRepo repository.UserRepository, emailService sendgrid.EmailService) NotificationService {
-	return &notificationService{repo: repo, userRepo: userRepo, emailService: emailService}
+// NewNotificationService takes the throttle of the SendGrid plan, nil sends without limit, the nonces
+// refusing replayed event webhook deliveries, nil turns the replay check off, and the store settings
+// naming the sender, nil keeps the sender name of the email service.
+func NewNotificationService(repo repository.NotificationRepository, userRepo repository.UserRepository, emailService sendgrid.EmailService, retryPolicy NotificationRetryPolicy, throttle *sendgrid.Throttle, nonces webhookauth.NonceStore, settings StoreSettingsService, clk clock.Clock) NotificationService {
+	return &notificationService{repo: repo, userRepo: userRepo, emailService: emailService, retryPolicy: retryPolicy, throttle: throttle, nonces: nonces, settings: settings, clock: clk}
 }
 
-// SendEmail implements NotificationService.
+// SendEmail implements NotificationService. Once the send quota is used up the email is queued for the
+// retry worker instead, like a retry it then only keeps the subject and the content.
 func (s *notificationService) SendEmail(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error) {
 	_, err := s.userRepo.GetUserByEmail(ctx, req.To)
 	if err != nil {
 		return nil, errors.NotFoundError("User not found").WithError(err)
 	}
 
+	return s.send(ctx, req)
+}
+
+// SendEmailToAddress sends like SendEmail to an address that is not on any account yet, internal
+// callers only, e.g. to confirm a new email address.
+func (s *notificationService) SendEmailToAddress(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error) {
+	return s.send(ctx, req)
+}
+
+func (s *notificationService) send(ctx context.Context, req *models.EmailNotificationRequest) (*models.NotificationResponse, error) {
+	if len(req.Attachments) > 0 {
+		if err := s.emailService.ValidateAttachments(ctx, req.Attachments); err != nil {
+			if stdErrors.Is(err, sendgrid.ErrAttachmentRejected) {
+				return nil, errors.BadRequestError("Invalid attachment").WithError(err)
+			}
+
+			return nil, errors.InternalError("Failed to check attachments").WithError(err)
+		}
+	}
+
+	suppressed, err := s.repo.IsEmailSuppressed(ctx, req.To)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to check email suppression").WithError(err)
+	}
+
 	var metadataJSON json.RawMessage
 
 	if req.Metadata != nil {
@@ -48,15 +140,26 @@ func (s *notificationService) SendEmail(ctx context.Context, req *models.EmailNo
 	}
 
 	notification := &models.Notification{
-		ID:        uuid.New(),
+		ID:        utils.NewID(),
 		Type:      models.NotificationTypeEmail,
 		Recipient: req.To,
 		Subject:   req.Subject,
 		Content:   req.Content,
 		Status:    models.StatusPending,
 		Metadata:  metadataJSON,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		CreatedAt: s.clock.Now(),
+		UpdatedAt: s.clock.Now(),
+	}
+
+	// The notification is still recorded for a suppressed address, it is just never sent
+	if suppressed {
+		notification.Status = models.StatusSuppressed
+		notification.ErrorMessage = suppressedReason
+	} else if ok, wait := s.throttle.Take(); !ok {
+		nextAttemptAt := s.clock.Now().Add(wait)
+
+		notification.Status = models.StatusQueued
+		notification.NextAttemptAt = &nextAttemptAt
 	}
 
 	// Save to the database
@@ -64,12 +167,28 @@ func (s *notificationService) SendEmail(ctx context.Context, req *models.EmailNo
 		return nil, errors.DatabaseError("Failed to create notification").WithError(err)
 	}
 
+	if notification.Status != models.StatusPending {
+		if notification.Status == models.StatusQueued {
+			metrics.RecordEmailSend(metrics.EmailSendQueued)
+		}
+
+		return &models.NotificationResponse{
+			ID:        notification.ID,
+			Type:      notification.Type,
+			Status:    notification.Status,
+			Recipient: notification.Recipient,
+			CreatedAt: notification.CreatedAt,
+		}, nil
+	}
+
+	req.NotificationID = notification.ID
+	req.FromName = s.senderName(ctx)
+
+	metrics.RecordEmailSend(metrics.EmailSendSent)
+
 	err = s.emailService.Send(ctx, req)
 	if err != nil {
-		notification.Status = models.StatusFailed
-		notification.ErrorMessage = err.Error()
-
-		if updateErr := s.repo.UpdateNotificationStatus(ctx, notification.ID, models.StatusFailed, notification.ErrorMessage); updateErr != nil {
+		if updateErr := s.recordFailure(ctx, notification, err); updateErr != nil {
 			return nil, fmt.Errorf("Failed to update notification status after send failure: %w", updateErr)
 		}
 
@@ -119,3 +238,543 @@ func (s *notificationService) ListNotifications(ctx context.Context, page int, s
 
 	return notifications, total, nil
 }
+
+// SearchNotifications implements NotificationService. It pages through the notifications matching the
+// filter and counts them per status, e.g. to follow the delivery of a broadcast.
+func (s *notificationService) SearchNotifications(ctx context.Context, filter *models.NotificationFilter, page, size int) (*models.NotificationSearchResponse, error) {
+	if err := validateNotificationFilter(filter); err != nil {
+		return nil, err
+	}
+
+	page = max(page, 1)
+
+	if size < 1 || size > 100 {
+		size = 20
+	}
+
+	notifications, total, err := s.repo.SearchNotifications(ctx, filter, page, size)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch notifications").WithError(err)
+	}
+
+	counts, err := s.repo.CountNotificationsByStatus(ctx, filter)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to count notifications").WithError(err)
+	}
+
+	return &models.NotificationSearchResponse{
+		Notifications: notifications,
+		StatusCounts:  counts,
+		Total:         total,
+		Page:          page,
+		PageSize:      size,
+	}, nil
+}
+
+// ExportNotifications implements NotificationService. Notifications are streamed to fn one at a time,
+// its errors and the cancellation of ctx are returned as is, only the failures of the query are wrapped.
+func (s *notificationService) ExportNotifications(ctx context.Context, filter *models.NotificationFilter, fn func(*models.Notification) error) error {
+	if err := validateNotificationFilter(filter); err != nil {
+		return err
+	}
+
+	var fnErr error
+
+	err := s.repo.StreamNotifications(ctx, filter, func(notification *models.Notification) error {
+		fnErr = fn(notification)
+
+		return fnErr
+	})
+	if err == nil || fnErr != nil || ctx.Err() != nil {
+		return err
+	}
+
+	return errors.DatabaseError("Failed to export notifications").WithError(err)
+}
+
+func validateNotificationFilter(filter *models.NotificationFilter) error {
+	switch filter.Status {
+	case "", models.StatusPending, models.StatusSent, models.StatusFailed, models.StatusQueued, models.StatusPermanentlyFailed,
+		models.StatusDelivered, models.StatusBounced, models.StatusSpamReported, models.StatusSuppressed:
+	default:
+		return errors.BadRequestError("Invalid notification status")
+	}
+
+	switch filter.Type {
+	case "", models.NotificationTypeEmail, models.NotificationTypeSMS, models.NotificationTypePush:
+	default:
+		return errors.BadRequestError("Invalid notification type")
+	}
+
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return errors.BadRequestError("'since' must be before 'until'")
+	}
+
+	return nil
+}
+
+// RetryNotification implements NotificationService. It resends a failed notification right away,
+// including one the retry policy already gave up on.
+func (s *notificationService) RetryNotification(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	notification, err := s.repo.GetNotificationByID(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Notification not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch notification").WithError(err)
+	}
+
+	if notification.Status != models.StatusFailed && notification.Status != models.StatusPermanentlyFailed {
+		return nil, errors.BadRequestError("Only failed notifications can be retried")
+	}
+
+	if err := s.retry(ctx, notification); err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+// FindRecipientNotifications implements NotificationService. It lists the latest notifications sent to
+// the address, so that support can tell a customer whether an email went out and why not.
+func (s *notificationService) FindRecipientNotifications(ctx context.Context, recipient string) (*models.RecipientNotifications, error) {
+	filter := &models.NotificationFilter{Recipient: recipient}
+
+	notifications, total, err := s.repo.SearchNotifications(ctx, filter, 1, recipientNotificationsLimit)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch notifications").WithError(err)
+	}
+
+	suppression, err := s.repo.GetSuppression(ctx, recipient)
+	if err != nil && !stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.DatabaseError("Failed to check email suppression").WithError(err)
+	}
+
+	return &models.RecipientNotifications{
+		Recipient:     recipient,
+		Suppression:   suppression,
+		Notifications: notifications,
+		Total:         total,
+	}, nil
+}
+
+// ResendNotification implements NotificationService. It sends an email notification again as a new
+// notification, whatever the status of the original, e.g. for a customer who lost it. Like a retry
+// only the subject, the content and the metadata are sent again, a suppressed address gets none.
+func (s *notificationService) ResendNotification(ctx context.Context, id uuid.UUID) (*models.NotificationResponse, error) {
+	notification, err := s.repo.GetNotificationByID(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Notification not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch notification").WithError(err)
+	}
+
+	if notification.Type != models.NotificationTypeEmail {
+		return nil, errors.BadRequestError("Only email notifications can be resent")
+	}
+
+	var metadata map[string]string
+
+	if len(notification.Metadata) > 0 {
+		// metadata is only informative, a payload that doesn't decode is left out
+		_ = json.Unmarshal(notification.Metadata, &metadata)
+	}
+
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	metadata["resent_from"] = notification.ID.String()
+
+	return s.send(ctx, &models.EmailNotificationRequest{
+		To:       notification.Recipient,
+		Subject:  notification.Subject,
+		Content:  notification.Content,
+		Metadata: metadata,
+	})
+}
+
+// RetryDueNotifications implements NotificationService. It resends the failed notifications whose
+// backoff elapsed and the queued ones, and returns how many were sent successfully.
+func (s *notificationService) RetryDueNotifications(ctx context.Context, batchSize int) (int, error) {
+	now := s.clock.Now()
+
+	notifications, err := s.repo.ClaimDueNotifications(ctx, now, now.Add(notificationRetryLease), batchSize)
+	if err != nil {
+		return 0, errors.DatabaseError("Failed to claim due notifications").WithError(err)
+	}
+
+	sent := 0
+
+	for _, notification := range notifications {
+		if err := s.retry(ctx, notification); err != nil {
+			slog.Warn("Notification retry failed",
+				slog.String("notificationId", notification.ID.String()),
+				slog.Int("retryCount", notification.RetryCount),
+				slog.String("status", string(notification.Status)),
+				slog.String("error", err.Error()))
+
+			continue
+		}
+
+		if notification.Status == models.StatusSent {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// RunRetryWorker retries the due notifications every interval until ctx is cancelled.
+func (s *notificationService) RunRetryWorker(ctx context.Context, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RetryDueNotifications(ctx, batchSize); err != nil {
+				slog.Error("Scheduled notification retry failed", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// retry resends a stored notification. Only the subject and the content are persisted, so the HTML
+// body, the CC/BCC recipients and the attachments of the original request are not part of a retry.
+func (s *notificationService) retry(ctx context.Context, notification *models.Notification) error {
+	suppressed, err := s.repo.IsEmailSuppressed(ctx, notification.Recipient)
+	if err != nil {
+		return errors.DatabaseError("Failed to check email suppression").WithError(err)
+	}
+
+	if suppressed {
+		if err := s.repo.UpdateNotificationStatus(ctx, notification.ID, models.StatusSuppressed, suppressedReason); err != nil {
+			return errors.DatabaseError("Failed to update notification status").WithError(err)
+		}
+
+		notification.Status = models.StatusSuppressed
+		notification.ErrorMessage = suppressedReason
+		notification.NextAttemptAt = nil
+
+		return errors.BadRequestError(suppressedReason)
+	}
+
+	// Still over the quota, the notification goes back to the queue without using up an attempt
+	if ok, wait := s.throttle.Take(); !ok {
+		nextAttemptAt := s.clock.Now().Add(wait)
+
+		if err := s.repo.DeferNotification(ctx, notification.ID, nextAttemptAt); err != nil {
+			return errors.DatabaseError("Failed to defer notification").WithError(err)
+		}
+
+		notification.Status = models.StatusQueued
+		notification.NextAttemptAt = &nextAttemptAt
+
+		metrics.RecordEmailSend(metrics.EmailSendDeferred)
+
+		return nil
+	}
+
+	metrics.RecordEmailSend(metrics.EmailSendSent)
+
+	req := &models.EmailNotificationRequest{
+		To:             notification.Recipient,
+		Subject:        notification.Subject,
+		Content:        notification.Content,
+		NotificationID: notification.ID,
+		FromName:       s.senderName(ctx),
+	}
+
+	if len(notification.Metadata) > 0 {
+		// metadata is only informative, a payload that doesn't decode is left out
+		_ = json.Unmarshal(notification.Metadata, &req.Metadata)
+	}
+
+	notification.RetryCount++
+
+	if err := s.emailService.Send(ctx, req); err != nil {
+		if updateErr := s.recordFailure(ctx, notification, err); updateErr != nil {
+			return errors.DatabaseError("Failed to update notification status").WithError(updateErr)
+		}
+
+		return errors.ThirdPartyError("Failed to send notification").WithError(err)
+	}
+
+	if err := s.repo.UpdateNotificationStatus(ctx, notification.ID, models.StatusSent, ""); err != nil {
+		return errors.DatabaseError("Failed to update notification status").WithError(err)
+	}
+
+	notification.Status = models.StatusSent
+	notification.ErrorMessage = ""
+	notification.NextAttemptAt = nil
+
+	return nil
+}
+
+// senderName names the sender of emails after the store, the settings failing to load only leave the
+// sender name of the email service.
+func (s *notificationService) senderName(ctx context.Context) string {
+	if s.settings == nil {
+		return ""
+	}
+
+	settings, err := s.settings.GetSettings(ctx)
+	if err != nil {
+		slog.Warn("Failed to get store settings for the sender name", slog.String("error", err.Error()))
+
+		return ""
+	}
+
+	return settings.StoreName
+}
+
+// recordFailure schedules the next attempt, or marks the notification as permanently failed once
+// the policy is out of attempts.
+func (s *notificationService) recordFailure(ctx context.Context, notification *models.Notification, sendErr error) error {
+	notification.ErrorMessage = sendErr.Error()
+	notification.Status = models.StatusPermanentlyFailed
+	notification.NextAttemptAt = nil
+
+	if notification.RetryCount+1 < s.retryPolicy.MaxAttempts {
+		nextAttemptAt := s.clock.Now().Add(s.retryPolicy.backoff(notification.RetryCount))
+
+		notification.Status = models.StatusFailed
+		notification.NextAttemptAt = &nextAttemptAt
+	}
+
+	return s.repo.RecordNotificationFailure(ctx, notification)
+}
+
+// HandleDeliveryEvents implements NotificationService. It verifies a SendGrid event webhook delivery and
+// reflects the events on the notifications, hard-bounced and complaining addresses are suppressed from
+// then on.
+func (s *notificationService) HandleDeliveryEvents(ctx context.Context, payload []byte, signature, timestamp string) error {
+	events, err := s.emailService.ParseEvents(payload, signature, timestamp)
+	if err != nil {
+		switch {
+		case stdErrors.Is(err, sendgrid.ErrInvalidEventSignature):
+			return errors.UnauthorizedError("Event webhook signature verification failed").WithError(err)
+		case stdErrors.Is(err, sendgrid.ErrInvalidEventPayload):
+			return errors.BadRequestError("Invalid event webhook payload").WithError(err)
+		default:
+			return errors.InternalError("Failed to verify event webhook").WithError(err)
+		}
+	}
+
+	nonce := deliveryNonce(payload, timestamp)
+
+	if s.nonces != nil {
+		first, err := s.nonces.Claim(ctx, nonce, 2*sendgrid.EventTimestampTolerance)
+		if err != nil {
+			return errors.ServiceUnavailableError("Failed to check event webhook replay").WithError(err)
+		}
+
+		if !first {
+			return errors.UnauthorizedError("Event webhook delivery was already received").WithError(webhookauth.ErrReplayed)
+		}
+	}
+
+	for _, event := range events {
+		if err := s.handleDeliveryEvent(ctx, event); err != nil {
+			s.forgetDelivery(ctx, nonce)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliveryNonce identifies a delivery by what its signature covers rather than by the signature itself:
+// ECDSA signatures are malleable, a captured delivery could be replayed under another valid signature.
+func deliveryNonce(payload []byte, timestamp string) string {
+	hash := sha256.New()
+	hash.Write([]byte(timestamp))
+	hash.Write([]byte("."))
+	hash.Write(payload)
+
+	return "sendgrid:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// forgetDelivery lets SendGrid redeliver a batch that failed, the events are handled idempotently.
+func (s *notificationService) forgetDelivery(ctx context.Context, nonce string) {
+	if s.nonces == nil {
+		return
+	}
+
+	if err := s.nonces.Release(ctx, nonce); err != nil {
+		slog.Error("Failed to forget event webhook delivery, its redelivery will be refused", slog.Any("error", err))
+	}
+}
+
+// handleDeliveryEvent is idempotent, SendGrid redelivers the whole batch when the request fails.
+func (s *notificationService) handleDeliveryEvent(ctx context.Context, event sendgrid.Event) error {
+	var status models.NotificationStatus
+
+	switch event.Event {
+	case sendgrid.EventDelivered:
+		status = models.StatusDelivered
+	case sendgrid.EventBounce:
+		status = models.StatusBounced
+	case sendgrid.EventSpamReport:
+		status = models.StatusSpamReported
+	default:
+		return nil
+	}
+
+	var source models.SuppressionSource
+
+	switch {
+	case event.IsHardBounce():
+		source = models.SuppressionBounce
+	case event.Event == sendgrid.EventSpamReport:
+		source = models.SuppressionComplaint
+	}
+
+	if source != "" {
+		added, err := s.repo.SuppressEmail(ctx, &models.EmailSuppression{Email: event.Email, Source: source, Reason: event.Reason})
+		if err != nil {
+			return errors.DatabaseError("Failed to suppress email").WithError(err)
+		}
+
+		if added {
+			slog.Info("Email address suppressed", slog.String("source", string(source)), slog.String("sgEventId", event.SGEventID))
+		}
+	}
+
+	// Emails sent outside of the notification service carry no notification ID
+	id, err := uuid.Parse(event.NotificationID)
+	if err != nil {
+		return nil
+	}
+
+	if err := s.repo.UpdateNotificationStatus(ctx, id, status, event.Reason); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return errors.DatabaseError("Failed to update notification status").WithError(err)
+	}
+
+	return nil
+}
+
+// ListSuppressions implements NotificationService.
+func (s *notificationService) ListSuppressions(ctx context.Context, source models.SuppressionSource, page, size int) ([]*models.EmailSuppression, int, error) {
+	if source != "" && !validSuppressionSource(source) {
+		return nil, 0, errors.BadRequestError("Invalid suppression source")
+	}
+
+	page = max(page, 1)
+
+	if size < 1 || size > 100 {
+		size = 20
+	}
+
+	suppressions, total, err := s.repo.ListSuppressions(ctx, source, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to fetch suppressions").WithError(err)
+	}
+
+	return suppressions, total, nil
+}
+
+// AddSuppression implements NotificationService. It blocks an address by hand, e.g. on request of its
+// owner, the notifications already queued for it are suppressed when the retry worker picks them up.
+func (s *notificationService) AddSuppression(ctx context.Context, actorID uuid.UUID, req *models.AddSuppressionRequest) (*models.EmailSuppression, error) {
+	suppression := &models.EmailSuppression{
+		Email:     strings.ToLower(req.Email),
+		Source:    models.SuppressionManual,
+		Reason:    req.Reason,
+		CreatedBy: &actorID,
+	}
+
+	added, err := s.repo.SuppressEmail(ctx, suppression)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to suppress email").WithError(err)
+	}
+
+	if !added {
+		return nil, errors.DuplicateEntryError("Email address is already suppressed")
+	}
+
+	return suppression, nil
+}
+
+// RemoveSuppression implements NotificationService. The notifications suppressed so far stay so, only
+// later sends reach the address again.
+func (s *notificationService) RemoveSuppression(ctx context.Context, email string) error {
+	if err := s.repo.DeleteSuppression(ctx, email); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return errors.NotFoundError("Email address is not suppressed").WithError(err)
+		}
+
+		return errors.DatabaseError("Failed to remove suppression").WithError(err)
+	}
+
+	return nil
+}
+
+// ImportSuppressions implements NotificationService. It suppresses the addresses of a suppression list
+// exported from SendGrid, see sendgrid.ParseSuppressionExport, so that moving accounts keeps the
+// addresses SendGrid already blocked. The rows that are not an email address are reported and skipped,
+// an import that failed midway can be run again as the addresses imported before are skipped.
+func (s *notificationService) ImportSuppressions(ctx context.Context, actorID uuid.UUID, source models.SuppressionSource, export io.Reader) (*models.SuppressionImportResult, error) {
+	if !validSuppressionSource(source) {
+		return nil, errors.BadRequestError("Invalid suppression source")
+	}
+
+	entries, err := sendgrid.ParseSuppressionExport(export)
+	if err != nil {
+		return nil, errors.BadRequestError("Invalid suppression export").WithError(err)
+	}
+
+	result := &models.SuppressionImportResult{Source: source, Read: len(entries), Invalid: []string{}}
+	seen := make(map[string]bool, len(entries))
+	suppressions := make([]*models.EmailSuppression, 0, len(entries))
+
+	for _, entry := range entries {
+		address, err := mail.ParseAddress(entry.Email)
+		if err != nil || address.Address != entry.Email {
+			result.Invalid = append(result.Invalid, entry.Email)
+
+			continue
+		}
+
+		email := strings.ToLower(entry.Email)
+		if seen[email] {
+			continue
+		}
+
+		seen[email] = true
+		suppressions = append(suppressions, &models.EmailSuppression{Email: email, Source: source, Reason: entry.Reason})
+	}
+
+	for batch := range slices.Chunk(suppressions, suppressionImportBatch) {
+		imported, err := s.repo.ImportSuppressions(ctx, batch, &actorID)
+		if err != nil {
+			return nil, errors.DatabaseError("Failed to import suppressions").WithError(err)
+		}
+
+		result.Imported += imported
+	}
+
+	result.Skipped = result.Read - result.Imported - len(result.Invalid)
+
+	return result, nil
+}
+
+func validSuppressionSource(source models.SuppressionSource) bool {
+	switch source {
+	case models.SuppressionBounce, models.SuppressionComplaint, models.SuppressionManual:
+		return true
+	default:
+		return false
+	}
+}
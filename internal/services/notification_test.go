@@ -1,27 +1,41 @@
 package service_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repoMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
+	nonceMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
 	emailMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
+var testRetryPolicy = service.NotificationRetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute}
+
 func TestNewNotificationService(t *testing.T) {
 	mockRepo := repoMocks.NewMockNotificationRepository(t)
 	mockUserRepo := repoMocks.NewMockUserRepository(t)
 	mockEmailService := emailMocks.NewMockEmailService(t)
 
-	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
 	assert.NotNil(t, service)
 }
 
@@ -30,7 +44,7 @@ func TestSendEmail(t *testing.T) {
 	mockRepo := repoMocks.NewMockNotificationRepository(t)
 	mockUserRepo := repoMocks.NewMockUserRepository(t)
 	mockEmailService := emailMocks.NewMockEmailService(t)
-	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
 
 	testEmail := "test@example.com"
 	testSubject := "Test Subject"
@@ -56,6 +70,7 @@ func TestSendEmail(t *testing.T) {
 	t.Run("Success - Send Email", func(t *testing.T) {
 		// Arrange
 		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, testEmail).Return(false, nil).Once()
 		mockRepo.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(n *models.Notification) bool {
 			return n.Recipient == testEmail && n.Subject == testSubject && n.Status == models.StatusPending && string(n.Metadata) == string(metadataBytes)
 		})).Return(nil).Once()
@@ -87,6 +102,7 @@ func TestSendEmail(t *testing.T) {
 		}
 
 		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, testEmail).Return(false, nil).Once()
 		mockRepo.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(n *models.Notification) bool {
 			return n.Recipient == testEmail && n.Subject == testSubject && n.Status == models.StatusPending && n.Metadata == nil
 		})).Return(nil).Once()
@@ -105,6 +121,46 @@ func TestSendEmail(t *testing.T) {
 		mockEmailService.AssertExpectations(t)
 	})
 
+	t.Run("Success - Suppressed recipient is recorded but not sent", func(t *testing.T) {
+		// Arrange
+		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, testEmail).Return(true, nil).Once()
+		mockRepo.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(n *models.Notification) bool {
+			return n.Status == models.StatusSuppressed
+		})).Return(nil).Once()
+
+		// Act
+		resp, err := service.SendEmail(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusSuppressed, resp.Status) // Send has no expectation, the mock fails if it is called
+	})
+
+	t.Run("Failure - Attachment rejected", func(t *testing.T) {
+		// Arrange
+		reqWithAttachment := &models.EmailNotificationRequest{
+			To:          testEmail,
+			Subject:     testSubject,
+			Content:     testContent,
+			Attachments: []models.EmailAttachment{{Key: "invoices/inv-1.exe", Filename: "invoice.exe"}},
+		}
+
+		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockEmailService.EXPECT().ValidateAttachments(ctx, reqWithAttachment.Attachments).Return(sendgrid.ErrAttachmentRejected).Once()
+
+		// Act
+		resp, err := service.SendEmail(ctx, reqWithAttachment)
+
+		// Assert
+		assert.Nil(t, resp)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
 	t.Run("Failure - User Not Found", func(t *testing.T) {
 		// Arrange
 		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(nil, notFoundErr).Once()
@@ -128,6 +184,7 @@ func TestSendEmail(t *testing.T) {
 	t.Run("Failure - Create Notification Fails", func(t *testing.T) {
 		// Arrange
 		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, testEmail).Return(false, nil).Once()
 		mockRepo.EXPECT().CreateNotification(ctx, mock.AnythingOfType("*models.Notification")).Return(dbErr).Once()
 
 		// Act
@@ -149,9 +206,13 @@ func TestSendEmail(t *testing.T) {
 	t.Run("Failure - Email Send Fails", func(t *testing.T) {
 		// Arrange
 		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, testEmail).Return(false, nil).Once()
 		mockRepo.EXPECT().CreateNotification(ctx, mock.AnythingOfType("*models.Notification")).Return(nil).Once()
 		mockEmailService.EXPECT().Send(ctx, req).Return(sendErr).Once()
-		mockRepo.EXPECT().UpdateNotificationStatus(ctx, mock.AnythingOfType("uuid.UUID"), models.StatusFailed, sendErr.Error()).Return(nil).Once() // Expect update with error message
+		mockRepo.EXPECT().RecordNotificationFailure(ctx, mock.MatchedBy(func(n *models.Notification) bool {
+			return n.Status == models.StatusFailed && n.ErrorMessage == sendErr.Error() && n.RetryCount == 0 &&
+				n.NextAttemptAt != nil && n.NextAttemptAt.Equal(testNow.Add(time.Minute)) // first retry after the base delay
+		})).Return(nil).Once()
 
 		// Act
 		resp, err := service.SendEmail(ctx, req)
@@ -172,6 +233,7 @@ func TestSendEmail(t *testing.T) {
 	t.Run("Failure - Update Status Fails After Send Success", func(t *testing.T) {
 		// Arrange
 		mockUserRepo.EXPECT().GetUserByEmail(ctx, testEmail).Return(user, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, testEmail).Return(false, nil).Once()
 		mockRepo.EXPECT().CreateNotification(ctx, mock.AnythingOfType("*models.Notification")).Return(nil).Once()
 		mockEmailService.EXPECT().Send(ctx, req).Return(nil).Once()
 		mockRepo.EXPECT().UpdateNotificationStatus(ctx, mock.AnythingOfType("uuid.UUID"), models.StatusSent, "").Return(dbErr).Once() // Update fails
@@ -198,7 +260,7 @@ func TestGetNotification(t *testing.T) {
 	mockRepo := repoMocks.NewMockNotificationRepository(t)
 	mockUserRepo := repoMocks.NewMockUserRepository(t)
 	mockEmailService := emailMocks.NewMockEmailService(t)
-	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
 
 	testID := uuid.New()
 	expectedNotification := &models.Notification{
@@ -265,7 +327,7 @@ func TestListNotifications(t *testing.T) {
 	mockRepo := repoMocks.NewMockNotificationRepository(t)
 	mockUserRepo := repoMocks.NewMockUserRepository(t)
 	mockEmailService := emailMocks.NewMockEmailService(t)
-	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
 
 	expectedNotifications := []*models.Notification{
 		{ID: uuid.New(), Recipient: "user1@example.com"},
@@ -357,3 +419,748 @@ func TestListNotifications(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestRetryNotification(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	mockUserRepo := repoMocks.NewMockUserRepository(t)
+	mockEmailService := emailMocks.NewMockEmailService(t)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
+
+	sendErr := errors.New("sendgrid error")
+
+	newFailedNotification := func(status models.NotificationStatus, retryCount int) *models.Notification {
+		return &models.Notification{
+			ID:         uuid.New(),
+			Type:       models.NotificationTypeEmail,
+			Recipient:  "test@example.com",
+			Subject:    "Test Subject",
+			Content:    "Test Content",
+			Status:     status,
+			RetryCount: retryCount,
+			Metadata:   json.RawMessage(`{"key":"value"}`),
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		notification := newFailedNotification(models.StatusPermanentlyFailed, 2)
+		expectedReq := &models.EmailNotificationRequest{To: notification.Recipient, Subject: notification.Subject, Content: notification.Content, Metadata: map[string]string{"key": "value"}, NotificationID: notification.ID}
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, notification.ID).Return(notification, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, notification.Recipient).Return(false, nil).Once()
+		mockEmailService.EXPECT().Send(ctx, expectedReq).Return(nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, notification.ID, models.StatusSent, "").Return(nil).Once()
+
+		// Act
+		result, err := service.RetryNotification(ctx, notification.ID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusSent, result.Status)
+		assert.Equal(t, 3, result.RetryCount)
+		assert.Nil(t, result.NextAttemptAt)
+	})
+
+	t.Run("Failure - Send fails and schedules the next attempt", func(t *testing.T) {
+		// Arrange
+		notification := newFailedNotification(models.StatusFailed, 0)
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, notification.ID).Return(notification, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, notification.Recipient).Return(false, nil).Once()
+		mockEmailService.EXPECT().Send(ctx, mock.Anything).Return(sendErr).Once()
+		mockRepo.EXPECT().RecordNotificationFailure(ctx, notification).Return(nil).Once()
+
+		// Act
+		_, err := service.RetryNotification(ctx, notification.ID)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
+		assert.Equal(t, models.StatusFailed, notification.Status)
+		assert.Equal(t, 1, notification.RetryCount)
+		assert.NotNil(t, notification.NextAttemptAt)
+		assert.Equal(t, testNow.Add(2*time.Minute), *notification.NextAttemptAt, "second retry waits twice the base delay")
+	})
+
+	t.Run("Failure - Send fails on the last attempt", func(t *testing.T) {
+		// Arrange
+		notification := newFailedNotification(models.StatusFailed, 1)
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, notification.ID).Return(notification, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, notification.Recipient).Return(false, nil).Once()
+		mockEmailService.EXPECT().Send(ctx, mock.Anything).Return(sendErr).Once()
+		mockRepo.EXPECT().RecordNotificationFailure(ctx, notification).Return(nil).Once()
+
+		// Act
+		_, err := service.RetryNotification(ctx, notification.ID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, models.StatusPermanentlyFailed, notification.Status)
+		assert.Equal(t, sendErr.Error(), notification.ErrorMessage)
+		assert.Nil(t, notification.NextAttemptAt)
+	})
+
+	t.Run("Failure - Suppressed recipient", func(t *testing.T) {
+		// Arrange
+		notification := newFailedNotification(models.StatusFailed, 0)
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, notification.ID).Return(notification, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, notification.Recipient).Return(true, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, notification.ID, models.StatusSuppressed, mock.AnythingOfType("string")).Return(nil).Once()
+
+		// Act
+		_, err := service.RetryNotification(ctx, notification.ID)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		assert.Equal(t, models.StatusSuppressed, notification.Status)
+	})
+
+	t.Run("Failure - Not in a failed state", func(t *testing.T) {
+		// Arrange
+		notification := newFailedNotification(models.StatusSent, 0)
+		mockRepo.EXPECT().GetNotificationByID(ctx, notification.ID).Return(notification, nil).Once()
+
+		// Act
+		_, err := service.RetryNotification(ctx, notification.ID)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		mockRepo.EXPECT().GetNotificationByID(ctx, id).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := service.RetryNotification(ctx, id)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestRetryDueNotifications(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	mockUserRepo := repoMocks.NewMockUserRepository(t)
+	mockEmailService := emailMocks.NewMockEmailService(t)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		delivered := &models.Notification{ID: uuid.New(), Recipient: "ok@example.com", Status: models.StatusFailed}
+		failing := &models.Notification{ID: uuid.New(), Recipient: "ko@example.com", Status: models.StatusFailed}
+
+		mockRepo.EXPECT().ClaimDueNotifications(ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 10).
+			Return([]*models.Notification{delivered, failing}, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, mock.Anything).Return(false, nil).Twice()
+		mockEmailService.EXPECT().Send(ctx, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool { return req.To == "ok@example.com" })).Return(nil).Once()
+		mockEmailService.EXPECT().Send(ctx, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool { return req.To == "ko@example.com" })).Return(errors.New("sendgrid error")).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, delivered.ID, models.StatusSent, "").Return(nil).Once()
+		mockRepo.EXPECT().RecordNotificationFailure(ctx, failing).Return(nil).Once()
+
+		// Act
+		sent, err := service.RetryDueNotifications(ctx, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, models.StatusFailed, failing.Status)
+		assert.Equal(t, 1, failing.RetryCount)
+	})
+
+	t.Run("Failure - Claim fails", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().ClaimDueNotifications(ctx, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 10).
+			Return(nil, errors.New("db error")).Once()
+
+		// Act
+		_, err := service.RetryDueNotifications(ctx, 10)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
+
+func TestHandleDeliveryEvents(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	mockUserRepo := repoMocks.NewMockUserRepository(t)
+	mockEmailService := emailMocks.NewMockEmailService(t)
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
+
+	payload := []byte(`[]`)
+	notificationID := uuid.New()
+
+	t.Run("Success - Hard bounce suppresses the address", func(t *testing.T) {
+		// Arrange
+		events := []sendgrid.Event{
+			{Email: "user@example.com", Event: sendgrid.EventBounce, Type: "bounce", Reason: "550 unknown user", NotificationID: notificationID.String()},
+			{Email: "user@example.com", Event: "open", NotificationID: notificationID.String()},
+		}
+
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		mockRepo.EXPECT().SuppressEmail(ctx, &models.EmailSuppression{Email: "user@example.com", Source: models.SuppressionBounce, Reason: "550 unknown user"}).Return(true, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, notificationID, models.StatusBounced, "550 unknown user").Return(nil).Once()
+
+		// Act
+		err := service.HandleDeliveryEvents(ctx, payload, "sig", "123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Spam report suppresses the address", func(t *testing.T) {
+		// Arrange
+		events := []sendgrid.Event{{Email: "user@example.com", Event: sendgrid.EventSpamReport, NotificationID: notificationID.String()}}
+
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		mockRepo.EXPECT().SuppressEmail(ctx, &models.EmailSuppression{Email: "user@example.com", Source: models.SuppressionComplaint}).Return(false, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, notificationID, models.StatusSpamReported, "").Return(nil).Once()
+
+		// Act
+		err := service.HandleDeliveryEvents(ctx, payload, "sig", "123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Soft bounce and unknown notifications", func(t *testing.T) {
+		// Arrange
+		events := []sendgrid.Event{
+			{Email: "user@example.com", Event: sendgrid.EventBounce, Type: sendgrid.BounceTypeBlocked, NotificationID: notificationID.String()},
+			{Email: "user@example.com", Event: sendgrid.EventDelivered},
+		}
+
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, notificationID, models.StatusBounced, "").Return(sql.ErrNoRows).Once()
+
+		// Act
+		err := service.HandleDeliveryEvents(ctx, payload, "sig", "123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure - Invalid signature", func(t *testing.T) {
+		// Arrange
+		mockEmailService.EXPECT().ParseEvents(payload, "bad", "123").Return(nil, sendgrid.ErrInvalidEventSignature).Once()
+
+		// Act
+		err := service.HandleDeliveryEvents(ctx, payload, "bad", "123")
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeUnauthorized, appErr.Code)
+	})
+
+	t.Run("Failure - Status update fails", func(t *testing.T) {
+		// Arrange
+		events := []sendgrid.Event{{Email: "user@example.com", Event: sendgrid.EventDelivered, NotificationID: notificationID.String()}}
+
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, notificationID, models.StatusDelivered, "").Return(errors.New("db error")).Once()
+
+		// Act
+		err := service.HandleDeliveryEvents(ctx, payload, "sig", "123")
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
+
+func TestHandleDeliveryEventsReplay(t *testing.T) {
+	payload := []byte(`[]`)
+	notificationID := uuid.New()
+	events := []sendgrid.Event{{Email: "user@example.com", Event: sendgrid.EventDelivered, NotificationID: notificationID.String()}}
+
+	// The nonce is the hash of what the signature covers, "<timestamp>.<payload>"
+	sum := sha256.Sum256([]byte("123.[]"))
+	nonce := "sendgrid:" + hex.EncodeToString(sum[:])
+
+	newService := func(t *testing.T) (service.NotificationService, *repoMocks.MockNotificationRepository, *emailMocks.MockEmailService, *nonceMocks.MockNonceStore) {
+		t.Helper()
+
+		mockRepo := repoMocks.NewMockNotificationRepository(t)
+		mockEmailService := emailMocks.NewMockEmailService(t)
+		nonces := nonceMocks.NewMockNonceStore(t)
+
+		return service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), mockEmailService, testRetryPolicy, nil, nonces, nil, clock.NewFake(testNow)), mockRepo, mockEmailService, nonces
+	}
+
+	t.Run("Success - First delivery claims its nonce", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo, mockEmailService, nonces := newService(t)
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		nonces.EXPECT().Claim(mock.Anything, nonce, 2*sendgrid.EventTimestampTolerance).Return(true, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(mock.Anything, notificationID, models.StatusDelivered, "").Return(nil).Once()
+
+		// Act
+		err := notificationService.HandleDeliveryEvents(t.Context(), payload, "sig", "123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure - Replayed delivery", func(t *testing.T) {
+		// Arrange
+		notificationService, _, mockEmailService, nonces := newService(t)
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		nonces.EXPECT().Claim(mock.Anything, nonce, mock.Anything).Return(false, nil).Once()
+
+		// Act
+		err := notificationService.HandleDeliveryEvents(t.Context(), payload, "sig", "123")
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeUnauthorized, appErr.Code)
+		assert.ErrorIs(t, err, webhookauth.ErrReplayed)
+	})
+
+	t.Run("Failure - Replay under another signature of the same delivery", func(t *testing.T) {
+		// Arrange
+		notificationService, _, mockEmailService, nonces := newService(t)
+		mockEmailService.EXPECT().ParseEvents(payload, "other-sig", "123").Return(events, nil).Once()
+		nonces.EXPECT().Claim(mock.Anything, nonce, mock.Anything).Return(false, nil).Once()
+
+		// Act
+		err := notificationService.HandleDeliveryEvents(t.Context(), payload, "other-sig", "123")
+
+		// Assert
+		assert.ErrorIs(t, err, webhookauth.ErrReplayed)
+	})
+
+	t.Run("Success - Failed delivery is forgotten for its redelivery", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo, mockEmailService, nonces := newService(t)
+		mockEmailService.EXPECT().ParseEvents(payload, "sig", "123").Return(events, nil).Once()
+		nonces.EXPECT().Claim(mock.Anything, nonce, mock.Anything).Return(true, nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(mock.Anything, notificationID, models.StatusDelivered, "").Return(errors.New("db error")).Once()
+		nonces.EXPECT().Release(mock.Anything, nonce).Return(nil).Once()
+
+		// Act
+		err := notificationService.HandleDeliveryEvents(t.Context(), payload, "sig", "123")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestSendEmailThrottled(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	mockUserRepo := repoMocks.NewMockUserRepository(t)
+	mockEmailService := emailMocks.NewMockEmailService(t)
+
+	// The free plan refills a send every ~15 minutes, the single burst token is used by the first send
+	throttle, err := sendgrid.NewThrottle("free", 1)
+	require.NoError(t, err)
+
+	service := service.NewNotificationService(mockRepo, mockUserRepo, mockEmailService, testRetryPolicy, throttle, nil, nil, clock.NewFake(testNow))
+	req := &models.EmailNotificationRequest{To: "user@example.com", Subject: "Subject", Content: "Content"}
+
+	mockUserRepo.EXPECT().GetUserByEmail(ctx, req.To).Return(&models.User{Email: req.To}, nil)
+	mockRepo.EXPECT().IsEmailSuppressed(ctx, req.To).Return(false, nil)
+
+	t.Run("Sends while the quota allows", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(n *models.Notification) bool {
+			return n.Status == models.StatusPending
+		})).Return(nil).Once()
+		mockEmailService.EXPECT().Send(ctx, req).Return(nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, mock.Anything, models.StatusSent, "").Return(nil).Once()
+
+		// Act
+		resp, err := service.SendEmail(ctx, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusSent, resp.Status)
+	})
+
+	t.Run("Queues once the quota is used up", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(n *models.Notification) bool {
+			return n.Status == models.StatusQueued && n.NextAttemptAt != nil && n.NextAttemptAt.After(testNow)
+		})).Return(nil).Once()
+
+		// Act
+		resp, err := service.SendEmail(ctx, req)
+
+		// Assert: the strict email mock fails on a second Send
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusQueued, resp.Status)
+	})
+
+	t.Run("Retries are deferred while the quota is used up", func(t *testing.T) {
+		// Arrange
+		notification := &models.Notification{ID: uuid.New(), Recipient: req.To, Status: models.StatusQueued}
+
+		mockRepo.EXPECT().ClaimDueNotifications(ctx, mock.Anything, mock.Anything, 10).Return([]*models.Notification{notification}, nil).Once()
+		mockRepo.EXPECT().DeferNotification(ctx, notification.ID, mock.Anything).Return(nil).Once()
+
+		// Act
+		sent, err := service.RetryDueNotifications(ctx, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, models.StatusQueued, notification.Status)
+		assert.Equal(t, 0, notification.RetryCount)
+	})
+}
+
+func TestSendEmail_StoreSender(t *testing.T) {
+	ctx := t.Context()
+	req := func() *models.EmailNotificationRequest {
+		return &models.EmailNotificationRequest{To: "test@example.com", Subject: "Subject", Content: "Content"}
+	}
+
+	setup := func(t *testing.T) (service.NotificationService, *repoMocks.MockNotificationRepository, *emailMocks.MockEmailService, *svcMocks.MockStoreSettingsService) {
+		mockRepo := repoMocks.NewMockNotificationRepository(t)
+		mockEmailService := emailMocks.NewMockEmailService(t)
+		settings := svcMocks.NewMockStoreSettingsService(t)
+
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, "test@example.com").Return(false, nil).Once()
+		mockRepo.EXPECT().CreateNotification(ctx, mock.Anything).Return(nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, mock.AnythingOfType("uuid.UUID"), models.StatusSent, "").Return(nil).Once()
+
+		return service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), mockEmailService, testRetryPolicy, nil, nil, settings, clock.NewFake(testNow)),
+			mockRepo, mockEmailService, settings
+	}
+
+	t.Run("Success - Sender named after the store", func(t *testing.T) {
+		// Arrange
+		notificationService, _, mockEmailService, settings := setup(t)
+		settings.On("GetSettings", ctx).Return(&models.StoreSettings{StoreName: "Acme"}, nil).Once()
+		mockEmailService.EXPECT().Send(ctx, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.FromName == "Acme"
+		})).Return(nil).Once()
+
+		// Act
+		_, err := notificationService.SendEmailToAddress(ctx, req())
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Settings failing keep the configured sender", func(t *testing.T) {
+		// Arrange
+		notificationService, _, mockEmailService, settings := setup(t)
+		settings.On("GetSettings", ctx).Return(nil, errors.New("db error")).Once()
+		mockEmailService.EXPECT().Send(ctx, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.FromName == ""
+		})).Return(nil).Once()
+
+		// Act
+		_, err := notificationService.SendEmailToAddress(ctx, req())
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestEmailSuppressions(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+
+	newService := func(t *testing.T) (service.NotificationService, *repoMocks.MockNotificationRepository) {
+		t.Helper()
+
+		mockRepo := repoMocks.NewMockNotificationRepository(t)
+
+		return service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), emailMocks.NewMockEmailService(t), testRetryPolicy, nil, nil, nil, clock.NewFake(testNow)), mockRepo
+	}
+
+	t.Run("AddSuppression - Success", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo := newService(t)
+		mockRepo.EXPECT().SuppressEmail(ctx, &models.EmailSuppression{Email: "user@example.com", Source: models.SuppressionManual, Reason: "asked to stop", CreatedBy: &adminID}).Return(true, nil).Once()
+
+		// Act
+		suppression, err := notificationService.AddSuppression(ctx, adminID, &models.AddSuppressionRequest{Email: "User@Example.com", Reason: "asked to stop"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "user@example.com", suppression.Email)
+		assert.Equal(t, models.SuppressionManual, suppression.Source)
+	})
+
+	t.Run("AddSuppression - Already suppressed", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo := newService(t)
+		mockRepo.EXPECT().SuppressEmail(ctx, mock.Anything).Return(false, nil).Once()
+
+		// Act
+		suppression, err := notificationService.AddSuppression(ctx, adminID, &models.AddSuppressionRequest{Email: "user@example.com"})
+
+		// Assert
+		assert.Nil(t, suppression)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDuplicateEntry)
+	})
+
+	t.Run("RemoveSuppression - Not suppressed", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo := newService(t)
+		mockRepo.EXPECT().DeleteSuppression(ctx, "user@example.com").Return(sql.ErrNoRows).Once()
+
+		// Act
+		err := notificationService.RemoveSuppression(ctx, "user@example.com")
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+
+	t.Run("ListSuppressions - Invalid source", func(t *testing.T) {
+		// Arrange
+		notificationService, _ := newService(t)
+
+		// Act
+		_, _, err := notificationService.ListSuppressions(ctx, "unsubscribe", 1, 20)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+
+	t.Run("ImportSuppressions - Success", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo := newService(t)
+		export := "email,created,reason\n" +
+			"A@example.com,1700000000,550 unknown user\n" +
+			"not an address,1700000000,\n" +
+			"a@example.com,1700000001,550 unknown user\n" +
+			"b@example.com,1700000002,\n"
+
+		mockRepo.EXPECT().ImportSuppressions(ctx, []*models.EmailSuppression{
+			{Email: "a@example.com", Source: models.SuppressionBounce, Reason: "550 unknown user"},
+			{Email: "b@example.com", Source: models.SuppressionBounce},
+		}, &adminID).Return(1, nil).Once()
+
+		// Act
+		result, err := notificationService.ImportSuppressions(ctx, adminID, models.SuppressionBounce, strings.NewReader(export))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.SuppressionImportResult{Source: models.SuppressionBounce, Read: 4, Imported: 1, Skipped: 2, Invalid: []string{"not an address"}}, result)
+	})
+
+	t.Run("ImportSuppressions - Invalid export", func(t *testing.T) {
+		// Arrange
+		notificationService, _ := newService(t)
+
+		// Act
+		result, err := notificationService.ImportSuppressions(ctx, adminID, models.SuppressionComplaint, strings.NewReader("address\nuser@example.com\n"))
+
+		// Assert
+		assert.Nil(t, result)
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+}
+
+func TestSearchNotifications(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	notificationService := service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), emailMocks.NewMockEmailService(t), testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
+
+	t.Run("Success - Page and status counts", func(t *testing.T) {
+		// Arrange
+		filter := &models.NotificationFilter{Status: models.StatusFailed}
+		notifications := []*models.Notification{{ID: uuid.New(), Status: models.StatusFailed}}
+		counts := map[models.NotificationStatus]int{models.StatusFailed: 1, models.StatusDelivered: 9}
+
+		mockRepo.EXPECT().SearchNotifications(ctx, filter, 1, 20).Return(notifications, 1, nil).Once()
+		mockRepo.EXPECT().CountNotificationsByStatus(ctx, filter).Return(counts, nil).Once()
+
+		// Act
+		result, err := notificationService.SearchNotifications(ctx, filter, 0, 500)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.NotificationSearchResponse{Notifications: notifications, StatusCounts: counts, Total: 1, Page: 1, PageSize: 20}, result)
+	})
+
+	t.Run("Failure - Invalid filters", func(t *testing.T) {
+		since := testNow
+		until := testNow.Add(-time.Hour)
+
+		for name, filter := range map[string]*models.NotificationFilter{
+			"Unknown status": {Status: "lost"},
+			"Unknown type":   {Type: "fax"},
+			"Empty range":    {Since: &since, Until: &until},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// Act
+				result, err := notificationService.SearchNotifications(ctx, filter, 1, 20)
+
+				// Assert
+				assert.Nil(t, result)
+				assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+			})
+		}
+	})
+}
+
+func TestExportNotifications(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	notificationService := service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), emailMocks.NewMockEmailService(t), testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
+	filter := &models.NotificationFilter{Type: models.NotificationTypeEmail}
+
+	t.Run("Failure - Query error is wrapped", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().StreamNotifications(ctx, filter, mock.Anything).Return(errors.New("db error")).Once()
+
+		// Act
+		err := notificationService.ExportNotifications(ctx, filter, func(*models.Notification) error { return nil })
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+
+	t.Run("Failure - Writer error is returned as is", func(t *testing.T) {
+		// Arrange
+		writeErr := errors.New("client gone")
+
+		mockRepo.EXPECT().StreamNotifications(ctx, filter, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ *models.NotificationFilter, fn func(*models.Notification) error) error {
+				return fn(&models.Notification{})
+			}).Once()
+
+		// Act
+		err := notificationService.ExportNotifications(ctx, filter, func(*models.Notification) error { return writeErr })
+
+		// Assert
+		assert.ErrorIs(t, err, writeErr)
+	})
+}
+
+func TestFindRecipientNotifications(t *testing.T) {
+	ctx := t.Context()
+	mockRepo := repoMocks.NewMockNotificationRepository(t)
+	notificationService := service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), emailMocks.NewMockEmailService(t), testRetryPolicy, nil, nil, nil, clock.NewFake(testNow))
+	filter := &models.NotificationFilter{Recipient: "user@example.com"}
+	notifications := []*models.Notification{{ID: uuid.New(), Recipient: "user@example.com", Status: models.StatusBounced, ErrorMessage: "550 unknown user"}}
+
+	t.Run("Success - Suppressed address", func(t *testing.T) {
+		// Arrange
+		suppression := &models.EmailSuppression{Email: "user@example.com", Source: models.SuppressionBounce}
+
+		mockRepo.EXPECT().SearchNotifications(ctx, filter, 1, 100).Return(notifications, 120, nil).Once()
+		mockRepo.EXPECT().GetSuppression(ctx, "user@example.com").Return(suppression, nil).Once()
+
+		// Act
+		result, err := notificationService.FindRecipientNotifications(ctx, "user@example.com")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.RecipientNotifications{Recipient: "user@example.com", Suppression: suppression, Notifications: notifications, Total: 120}, result)
+	})
+
+	t.Run("Success - Address not suppressed", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().SearchNotifications(ctx, filter, 1, 100).Return(notifications, 1, nil).Once()
+		mockRepo.EXPECT().GetSuppression(ctx, "user@example.com").Return(nil, fmt.Errorf("failed to get suppression: %w", sql.ErrNoRows)).Once()
+
+		// Act
+		result, err := notificationService.FindRecipientNotifications(ctx, "user@example.com")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, result.Suppression)
+		assert.Equal(t, 1, result.Total)
+	})
+}
+
+func TestResendNotification(t *testing.T) {
+	ctx := t.Context()
+
+	newService := func(t *testing.T) (service.NotificationService, *repoMocks.MockNotificationRepository, *emailMocks.MockEmailService) {
+		t.Helper()
+
+		mockRepo := repoMocks.NewMockNotificationRepository(t)
+		mockEmailService := emailMocks.NewMockEmailService(t)
+
+		return service.NewNotificationService(mockRepo, repoMocks.NewMockUserRepository(t), mockEmailService, testRetryPolicy, nil, nil, nil, clock.NewFake(testNow)), mockRepo, mockEmailService
+	}
+
+	t.Run("Success - Sent as a new notification", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo, mockEmailService := newService(t)
+		original := &models.Notification{ID: uuid.New(), Type: models.NotificationTypeEmail, Recipient: "user@example.com", Subject: "Your invoice", Content: "Attached",
+			Status: models.StatusDelivered, Metadata: json.RawMessage(`{"order_id":"42"}`)}
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, original.ID).Return(original, nil).Once()
+		mockRepo.EXPECT().IsEmailSuppressed(ctx, "user@example.com").Return(false, nil).Once()
+		mockRepo.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(n *models.Notification) bool {
+			return n.ID != original.ID && n.Status == models.StatusPending && n.Subject == "Your invoice" &&
+				string(n.Metadata) == `{"order_id":"42","resent_from":"`+original.ID.String()+`"}`
+		})).Return(nil).Once()
+		mockEmailService.EXPECT().Send(ctx, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool {
+			return req.To == "user@example.com" && req.Metadata["resent_from"] == original.ID.String()
+		})).Return(nil).Once()
+		mockRepo.EXPECT().UpdateNotificationStatus(ctx, mock.Anything, models.StatusSent, "").Return(nil).Once()
+
+		// Act
+		resent, err := notificationService.ResendNotification(ctx, original.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEqual(t, original.ID, resent.ID)
+		assert.Equal(t, models.StatusSent, resent.Status)
+	})
+
+	t.Run("Failure - Not an email", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo, _ := newService(t)
+		original := &models.Notification{ID: uuid.New(), Type: models.NotificationTypeSMS, Metadata: json.RawMessage(`null`)}
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, original.ID).Return(original, nil).Once()
+
+		// Act
+		resent, err := notificationService.ResendNotification(ctx, original.ID)
+
+		// Assert
+		assert.Nil(t, resent)
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		notificationService, mockRepo, _ := newService(t)
+		id := uuid.New()
+
+		mockRepo.EXPECT().GetNotificationByID(ctx, id).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		resent, err := notificationService.ResendNotification(ctx, id)
+
+		// Assert
+		assert.Nil(t, resent)
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+}
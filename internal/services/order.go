@@ -2,29 +2,76 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
 	"github.com/google/uuid"
 )
 
 type OrderService interface {
 	CreateOrder(ctx context.Context, req *models.CreateOrderRequest) (*models.Order, error)
 	GetOrderByID(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	GetOrderTimeline(ctx context.Context, id uuid.UUID) (*models.OrderTimeline, error)
 	ListOrdersByCustomer(ctx context.Context, customerID uuid.UUID, page int, size int) ([]models.Order, int, error)
 	UpdateOrderStatus(ctx context.Context, id uuid.UUID, status models.OrderStatus) (*models.Order, error)
+	BulkUpdateOrderStatus(ctx context.Context, req *models.BulkUpdateOrderStatusRequest) (*models.BulkUpdateOrderStatusResponse, error)
+	GetPackingSlip(ctx context.Context, id uuid.UUID) (*models.PackingSlip, error)
+	GetPackingSlips(ctx context.Context, ids []uuid.UUID) ([]*models.PackingSlip, error)
+	ExportOrders(ctx context.Context, customerID uuid.UUID, from, to time.Time, fn func(*models.Order) error) error
 }
 
 type orderService struct {
-	orderRepo   repository.OrderRepository
-	cartRepo    repository.CartRepository
-	productRepo repository.ProductRepository
+	orderRepo        repository.OrderRepository
+	cartRepo         repository.CartRepository
+	productRepo      repository.ProductRepository
+	inventoryRepo    repository.InventoryRepository
+	warehouseService WarehouseService
+	shippingService  ShippingService
+	campaignService  CampaignService
+	fraudService     FraudService
+	pickupRepo       repository.PickupRepository
+	vendorRepo       repository.VendorRepository
+	policy           OrderPolicy
+	clock            clock.Clock
 }
 
-func NewOrderService(orderRepo repository.OrderRepository, cartRepo repository.CartRepository, productRepo repository.ProductRepository) OrderService {
-	return &orderService{orderRepo: orderRepo, cartRepo: cartRepo, productRepo: productRepo}
+// OrderPolicy holds the fees of an order and the limits of the bulk operations of fulfillment systems.
+type OrderPolicy struct {
+	GiftWrapFee         float64
+	BulkStatusMaxOrders int
+	BulkStatusBatchSize int
+	PickWaveMaxOrders   int           // packing slips printed at once
+	DuplicateWindow     time.Duration // 0 places orders without looking for duplicates
+}
+
+func NewOrderService(orderRepo repository.OrderRepository, cartRepo repository.CartRepository, productRepo repository.ProductRepository, inventoryRepo repository.InventoryRepository, warehouseService WarehouseService, shippingService ShippingService, campaignService CampaignService, fraudService FraudService, pickupRepo repository.PickupRepository, vendorRepo repository.VendorRepository, policy OrderPolicy, clk clock.Clock) OrderService {
+	policy.BulkStatusMaxOrders = max(policy.BulkStatusMaxOrders, 1)
+	policy.BulkStatusBatchSize = max(policy.BulkStatusBatchSize, 1)
+	policy.PickWaveMaxOrders = max(policy.PickWaveMaxOrders, 1)
+
+	return &orderService{
+		orderRepo:        orderRepo,
+		cartRepo:         cartRepo,
+		productRepo:      productRepo,
+		inventoryRepo:    inventoryRepo,
+		warehouseService: warehouseService,
+		shippingService:  shippingService,
+		campaignService:  campaignService,
+		fraudService:     fraudService,
+		pickupRepo:       pickupRepo,
+		vendorRepo:       vendorRepo,
+		policy:           policy,
+		clock:            clk,
+	}
 }
 
 func (s *orderService) CreateOrder(ctx context.Context, req *models.CreateOrderRequest) (*models.Order, error) {
@@ -39,14 +86,83 @@ func (s *orderService) CreateOrder(ctx context.Context, req *models.CreateOrderR
 	}
 
 	// now check the availability of the product
+	vendors := make(map[uuid.UUID]uuid.UUID)
+	prices := make(map[uuid.UUID]float64)
+
 	for _, item := range cart.Items {
 		product, err := s.productRepo.GetProductByID(ctx, item.ProductID)
 		if err != nil {
 			return nil, errors.NotFoundError("Product not found: " + item.ProductID.String()).WithError(err)
 		}
 
+		if product.Status != models.ProductStatusActive {
+			return nil, errors.ProductNotForSaleError(product.ID, product.Status)
+		}
+
+		// the cart checked the limits already, orders placed since by the customer may count against them
+		if err := checkPurchaseLimit(ctx, s.orderRepo, product, req.CustomerID, item.Quantity, s.clock.Now()); err != nil {
+			return nil, err
+		}
+
 		if product.StockQuantity < item.Quantity {
-			return nil, errors.BadRequestError("Insufficient stock for product: " + item.ProductID.String())
+			return nil, errors.InsufficientStockError(product.ID, product.StockQuantity)
+		}
+
+		if product.VendorID != nil {
+			vendors[product.ID] = *product.VendorID
+		}
+
+		prices[product.ID] = product.Price
+	}
+
+	// the order is made of the cart at the current prices, the items confirm what the customer was shown:
+	// every item of the cart once, with its quantity, and if a price changed since they must confirm the new one
+	confirmed := make(map[uuid.UUID]bool, len(req.Items))
+
+	for _, item := range req.Items {
+		price, ok := prices[item.ProductID]
+		if !ok || confirmed[item.ProductID] || item.Quantity != cart.Items[item.ProductID.String()].Quantity {
+			return nil, errors.BadRequestError("Order items do not match the cart: " + item.ProductID.String())
+		}
+
+		if roundCents(item.UnitPrice) != roundCents(price) {
+			return nil, errors.PriceChangedError(item.ProductID, item.UnitPrice, price)
+		}
+
+		confirmed[item.ProductID] = true
+	}
+
+	if len(confirmed) != len(cart.Items) {
+		return nil, errors.BadRequestError("Order items do not match the cart")
+	}
+
+	fulfillmentType := req.FulfillmentType
+	if fulfillmentType == "" {
+		fulfillmentType = models.FulfillmentShipping
+	}
+
+	shippingAddress := req.ShippingAddress
+
+	// pickup orders are shipped to the store, so the store address takes the place of the customer's
+	if fulfillmentType == models.FulfillmentPickup {
+		location, err := s.pickupRepo.GetLocationByID(ctx, *req.PickupLocationID)
+		if err != nil {
+			return nil, errors.NotFoundError("Pickup location not found").WithError(err)
+		}
+
+		if !location.Active {
+			return nil, errors.BadRequestError("Pickup location is not available")
+		}
+
+		shippingAddress = &location.Address
+	} else {
+		productIDs := make([]uuid.UUID, 0, len(cart.Items))
+		for _, item := range cart.Items {
+			productIDs = append(productIDs, item.ProductID)
+		}
+
+		if err := s.shippingService.CheckOrder(ctx, shippingAddress, productIDs); err != nil {
+			return nil, err
 		}
 	}
 
@@ -54,33 +170,56 @@ func (s *orderService) CreateOrder(ctx context.Context, req *models.CreateOrderR
 	var grossTotal float64
 
 	for _, item := range req.Items {
-		grossTotal += float64(item.Quantity) * item.UnitPrice
+		grossTotal += float64(item.Quantity) * prices[item.ProductID]
+	}
+
+	var giftWrapFee float64
+	if req.GiftWrap {
+		giftWrapFee = s.policy.GiftWrapFee
+		grossTotal += giftWrapFee
+	}
+
+	now := s.clock.Now()
+
+	// a double click or a retried checkout places the same order twice, unless the customer confirms it
+	if !req.AllowDuplicate && s.policy.DuplicateWindow > 0 {
+		if err := s.checkDuplicateOrder(ctx, req, grossTotal, now); err != nil {
+			return nil, err
+		}
 	}
 
 	// assemble the order struct
 	order := &models.Order{
-		ID:              uuid.New(),
-		CustomerID:      req.CustomerID,
-		Status:          models.OrderStatusPending,
-		TotalAmount:     grossTotal,
-		PaymentStatus:   models.PaymentStatusPending,
-		ShippingAddress: &req.ShippingAddress,
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
+		ID:               utils.NewID(),
+		CustomerID:       req.CustomerID,
+		Status:           models.OrderStatusPending,
+		TotalAmount:      grossTotal,
+		PaymentStatus:    models.PaymentStatusPending,
+		ShippingAddress:  shippingAddress,
+		FulfillmentType:  fulfillmentType,
+		PickupLocationID: req.PickupLocationID,
+		GiftWrap:         req.GiftWrap,
+		GiftWrapFee:      giftWrapFee,
+		GiftMessage:      req.GiftMessage,
+		CreatedAt:        now,
+		UpdatedAt:        now,
 	}
 
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, order.ID.String())
+
 	// now add the items
 
 	var items []models.OrderItem
 
 	for _, item := range req.Items {
 		orderItem := models.OrderItem{
-			ID:        uuid.New(),
+			ID:        utils.NewID(),
 			OrderID:   order.ID,
 			ProductID: item.ProductID,
 			Quantity:  item.Quantity,
-			UnitPrice: item.UnitPrice,
-			CreatedAt: time.Now(),
+			UnitPrice: prices[item.ProductID],
+			IsGift:    item.IsGift,
+			CreatedAt: now,
 		}
 
 		items = append(items, orderItem)
@@ -88,28 +227,147 @@ func (s *orderService) CreateOrder(ctx context.Context, req *models.CreateOrderR
 
 	order.Items = items
 
+	// bots buying out a drop place many orders in a row, the velocity rules block them or hold the order
+	subjects := models.VelocitySubjects{CustomerID: req.CustomerID, IP: req.ClientIP}
+	if req.Device != nil {
+		subjects.DeviceFingerprint = req.Device.ID
+	}
+
+	flagged, err := s.fraudService.CheckVelocity(ctx, order.ID, subjects)
+	if err != nil {
+		return nil, err
+	}
+
+	// the units sold at a campaign price come out of its allocation, given back if the order is not placed
+	if err := s.claimCampaignStock(ctx, order.Items); err != nil {
+		return nil, err
+	}
+
+	claims := campaignClaims(order.Items)
+
 	err = s.orderRepo.CreateOrder(ctx, order)
 	if err != nil {
+		s.campaignService.ReleaseStock(ctx, claims)
+
 		return nil, errors.DatabaseError("Failed to create order").WithError(err)
 	}
 
+	// the order is stored, if it can't be placed now it is lapsed and gives back what it took so far
+	abandon := func(err error) (*models.Order, error) {
+		s.abandonOrder(ctx, order.ID, claims)
+
+		return nil, err
+	}
+
+	if err := s.fraudService.RecordOrder(ctx, order.ID, order.CustomerID, subjects, flagged); err != nil {
+		return abandon(err)
+	}
+
+	s.fraudService.RecordDevice(ctx, order.ID, order.CustomerID, req.ClientIP, req.Device)
+
+	// each vendor fulfills its own part of the order, the commission is fixed at the rate of today
+	for _, vendorOrder := range splitByVendor(order, vendors) {
+		vendor, err := s.vendorRepo.GetVendorByID(ctx, vendorOrder.VendorID)
+		if err != nil {
+			return abandon(errors.DatabaseError("Failed to get vendor").WithError(err))
+		}
+
+		vendorOrder.CommissionRate = vendor.CommissionRate
+		vendorOrder.Commission = roundCents(vendorOrder.Subtotal * vendor.CommissionRate)
+
+		if err := s.vendorRepo.CreateVendorOrder(ctx, vendorOrder); err != nil {
+			return abandon(errors.DatabaseError("Failed to create vendor order").WithError(err))
+		}
+	}
+
 	for _, item := range cart.Items {
 		product, err := s.productRepo.GetProductByID(ctx, item.ProductID)
 		if err != nil {
-			return nil, errors.DatabaseError("Failed to get product").WithError(err)
+			return abandon(errors.DatabaseError("Failed to get product").WithError(err))
 		}
 		product.StockQuantity -= item.Quantity
 
 		err = s.productRepo.UpdateProduct(ctx, product)
 		if err != nil {
-			return nil, errors.DatabaseError("Failed to update inventory").WithError(err)
+			return abandon(errors.DatabaseError("Failed to update inventory").WithError(err))
 		}
+
+		err = s.inventoryRepo.RecordMovement(ctx, &models.StockMovement{
+			ID:          uuid.New(),
+			ProductID:   item.ProductID,
+			Quantity:    item.Quantity,
+			Reason:      models.StockMovementOrder,
+			ReferenceID: &order.ID,
+		})
+		if err != nil {
+			return abandon(errors.DatabaseError("Failed to record stock movement").WithError(err))
+		}
+	}
+
+	// ship every item from the warehouses nearest to the customer
+	if _, err := s.warehouseService.AllocateOrder(ctx, order); err != nil {
+		return abandon(err)
 	}
 
 	return order, nil
 }
 
+// abandonOrder lapses an order that was stored but could not be placed, which gives back the stock it
+// took, then gives its units back to the campaign allocations. An order failing to lapse stays pending,
+// the lapse sweep gives both back later.
+func (s *orderService) abandonOrder(ctx context.Context, orderID uuid.UUID, claims []models.CampaignClaim) {
+	if err := s.orderRepo.LapseOrder(ctx, orderID); err != nil {
+		slog.Error("Failed to lapse order that could not be placed, left for the lapse sweep",
+			slog.String("orderId", orderID.String()),
+			slog.String("error", err.Error()))
+
+		return
+	}
+
+	s.campaignService.ReleaseStock(ctx, claims)
+}
+
+// checkDuplicateOrder refuses an order of the same items and total as an order of the customer placed
+// within the duplicate window and not cancelled since.
+func (s *orderService) checkDuplicateOrder(ctx context.Context, req *models.CreateOrderRequest, total float64, now time.Time) error {
+	quantities := make(map[uuid.UUID]int, len(req.Items))
+	for _, item := range req.Items {
+		quantities[item.ProductID] += item.Quantity
+	}
+
+	var duplicateID uuid.UUID
+
+	// orders are stamped by the database clock, the minute past now covers it running ahead of ours
+	err := s.orderRepo.StreamOrdersOfCustomer(ctx, req.CustomerID, now.Add(-s.policy.DuplicateWindow), now.Add(time.Minute), func(order *models.Order) error {
+		if order.Status == models.OrderStatusCancelled || roundCents(order.TotalAmount) != roundCents(total) {
+			return nil
+		}
+
+		placed := make(map[uuid.UUID]int, len(order.Items))
+		for _, item := range order.Items {
+			placed[item.ProductID] += item.Quantity
+		}
+
+		if maps.Equal(placed, quantities) {
+			duplicateID = order.ID // the latest one wins, orders are streamed oldest first
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.DatabaseError("Failed to check for duplicate orders").WithError(err)
+	}
+
+	if duplicateID != uuid.Nil {
+		return errors.DuplicateOrderError(duplicateID)
+	}
+
+	return nil
+}
+
 func (s *orderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, id.String())
+
 	order, err := s.orderRepo.GetOrderByID(ctx, id)
 	if err != nil {
 		return nil, errors.NotFoundError("Order not found").WithError(err)
@@ -118,6 +376,27 @@ func (s *orderService) GetOrderByID(ctx context.Context, id uuid.UUID) (*models.
 	return order, nil
 }
 
+// GetOrderTimeline returns the history of the order across the domain tables, oldest first. The caller
+// decides who sees the internal events.
+func (s *orderService) GetOrderTimeline(ctx context.Context, id uuid.UUID) (*models.OrderTimeline, error) {
+	order, err := s.GetOrderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.orderRepo.ListOrderTimeline(ctx, id)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch order timeline").WithError(err)
+	}
+
+	return &models.OrderTimeline{
+		OrderID:    order.ID,
+		CustomerID: order.CustomerID,
+		Status:     order.Status,
+		Events:     events,
+	}, nil
+}
+
 func (s *orderService) ListOrdersByCustomer(ctx context.Context, customerID uuid.UUID, page int, size int) ([]models.Order, int, error) {
 	if page < 1 {
 		page = 1
@@ -136,16 +415,392 @@ func (s *orderService) ListOrdersByCustomer(ctx context.Context, customerID uuid
 }
 
 func (s *orderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, status models.OrderStatus) (*models.Order, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, id.String())
+
 	// check if order exists or not
-	_, err := s.orderRepo.GetOrderByID(ctx, id)
+	current, err := s.orderRepo.GetOrderByID(ctx, id)
 	if err != nil {
 		return nil, errors.NotFoundError("Order not found").WithError(err)
 	}
 
+	if !current.Status.CanTransitionTo(status) {
+		return nil, errors.InvalidTransitionError(fmt.Sprintf("Order cannot move from %s to %s", current.Status, status))
+	}
+
+	if isFulfillment(status) {
+		held, err := s.fraudService.HeldOrders(ctx, []uuid.UUID{id})
+		if err != nil {
+			return nil, err
+		}
+
+		if held[id] {
+			return nil, errors.FraudReviewError()
+		}
+	}
+
 	order, err := s.orderRepo.UpdateOrderStatus(ctx, id, status)
 	if err != nil {
 		return nil, errors.DatabaseError("Failed to update order status").WithError(err)
 	}
 
+	// the units a cancelled order claimed go back to the campaign allocations
+	if status == models.OrderStatusCancelled {
+		s.campaignService.ReleaseStock(ctx, campaignClaims(current.Items))
+	}
+
 	return order, nil
 }
+
+// BulkUpdateOrderStatus moves the orders to the status, each checked against the state machine on
+// its own. The orders are updated batch by batch, a batch failing leaves its orders as they were but
+// the next batches still run, so the outcome is reported order by order.
+func (s *orderService) BulkUpdateOrderStatus(ctx context.Context, req *models.BulkUpdateOrderStatusRequest) (*models.BulkUpdateOrderStatusResponse, error) {
+	if len(req.OrderIDs) > s.policy.BulkStatusMaxOrders {
+		return nil, errors.BadRequestError(fmt.Sprintf("At most %d orders can be updated at once", s.policy.BulkStatusMaxOrders))
+	}
+
+	results := make([]models.BulkOrderStatusResult, len(req.OrderIDs))
+	index := make(map[uuid.UUID]int, len(req.OrderIDs))
+
+	for i, id := range req.OrderIDs {
+		results[i].OrderID = id
+		index[id] = i
+	}
+
+	fail := func(ids []uuid.UUID, code, message string) {
+		for _, id := range ids {
+			results[index[id]].Code = code
+			results[index[id]].Message = message
+		}
+	}
+
+	from := models.OrderStatusesLeadingTo(req.Status)
+
+	for batch := range slices.Chunk(req.OrderIDs, s.policy.BulkStatusBatchSize) {
+		statuses, err := s.orderRepo.GetOrderStatuses(ctx, batch)
+		if err != nil {
+			fail(batch, errors.ErrCodeDatabaseError, "Failed to fetch order status")
+
+			continue
+		}
+
+		held := map[uuid.UUID]bool{}
+
+		if isFulfillment(req.Status) {
+			held, err = s.fraudService.HeldOrders(ctx, batch)
+			if err != nil {
+				fail(batch, errors.ErrCodeDatabaseError, "Failed to check fraud reviews")
+
+				continue
+			}
+		}
+
+		var movable []uuid.UUID
+
+		for _, id := range batch {
+			current, ok := statuses[id]
+
+			switch {
+			case !ok:
+				fail([]uuid.UUID{id}, errors.ErrCodeNotFound, "Order not found")
+			case !current.CanTransitionTo(req.Status):
+				fail([]uuid.UUID{id}, errors.ErrCodeInvalidTransition, fmt.Sprintf("Order cannot move from %s to %s", current, req.Status))
+			case held[id]:
+				fail([]uuid.UUID{id}, errors.ErrCodeFraudReview, "Order is held for a fraud review")
+			default:
+				movable = append(movable, id)
+			}
+		}
+
+		if len(movable) == 0 {
+			continue
+		}
+
+		updated, err := s.orderRepo.UpdateOrderStatuses(ctx, movable, req.Status, from)
+		if err != nil {
+			fail(movable, errors.ErrCodeDatabaseError, "Failed to update order status")
+
+			continue
+		}
+
+		for _, id := range updated {
+			results[index[id]].Success = true
+		}
+
+		if req.Status == models.OrderStatusCancelled {
+			s.releaseCampaignStock(ctx, updated)
+		}
+
+		for _, id := range movable {
+			if !results[index[id]].Success {
+				fail([]uuid.UUID{id}, errors.ErrCodeInvalidTransition, "Order status changed during the update")
+			}
+		}
+	}
+
+	response := &models.BulkUpdateOrderStatusResponse{Status: req.Status, Results: results}
+
+	for _, result := range results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
+
+// releaseCampaignStock gives the units claimed by the cancelled orders back to the campaign allocations.
+// It is best effort, an order that can't be read keeps its units out of the campaign.
+func (s *orderService) releaseCampaignStock(ctx context.Context, orderIDs []uuid.UUID) {
+	for _, id := range orderIDs {
+		order, err := s.orderRepo.GetOrderByID(ctx, id)
+		if err != nil {
+			slog.Error("Failed to get cancelled order, its campaign stock is not released",
+				slog.String("orderId", id.String()),
+				slog.String("error", err.Error()))
+
+			continue
+		}
+
+		s.campaignService.ReleaseStock(ctx, campaignClaims(order.Items))
+	}
+}
+
+// isFulfillment reports whether an order moving to status leaves the store, which an order held by a
+// fraud review must not.
+func isFulfillment(status models.OrderStatus) bool {
+	return status == models.OrderStatusShipping || status == models.OrderStatusReadyForPickup
+}
+
+// GetPackingSlip builds the slip put in the parcel. Gift orders must not reveal what was paid,
+// so their prices are left out, in a mixed order only the gifted items are hidden. The items show the
+// warehouses the stock allocation of the order picks them from.
+func (s *orderService) GetPackingSlip(ctx context.Context, id uuid.UUID) (*models.PackingSlip, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, id.String())
+
+	order, err := s.orderRepo.GetOrderByID(ctx, id)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	slips := newPackingSlips(s)
+	if err := slips.load(ctx); err != nil {
+		return nil, err
+	}
+
+	return slips.build(ctx, order)
+}
+
+// GetPackingSlips builds the slips of the orders of a pick wave, in the order they were asked for.
+// An order not found fails the whole wave, so that no parcel of it goes out without its slip.
+func (s *orderService) GetPackingSlips(ctx context.Context, ids []uuid.UUID) ([]*models.PackingSlip, error) {
+	if len(ids) > s.policy.PickWaveMaxOrders {
+		return nil, errors.BadRequestError(fmt.Sprintf("At most %d packing slips can be printed at once", s.policy.PickWaveMaxOrders))
+	}
+
+	slips := newPackingSlips(s)
+	if err := slips.load(ctx); err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.PackingSlip, 0, len(ids))
+
+	for _, id := range ids {
+		order, err := s.orderRepo.GetOrderByID(ctx, id)
+		if err != nil {
+			return nil, errors.NotFoundError("Order not found: " + id.String()).WithError(err)
+		}
+
+		slip, err := slips.build(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, slip)
+	}
+
+	return result, nil
+}
+
+// packingSlips builds packing slips, the warehouses and the products looked up are shared by the
+// slips of a wave.
+type packingSlips struct {
+	s          *orderService
+	warehouses map[uuid.UUID]string
+	products   map[uuid.UUID]*models.Product
+}
+
+func newPackingSlips(s *orderService) *packingSlips {
+	return &packingSlips{s: s, products: make(map[uuid.UUID]*models.Product)}
+}
+
+func (p *packingSlips) load(ctx context.Context) error {
+	warehouses, err := p.s.warehouseService.ListWarehouses(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.warehouses = make(map[uuid.UUID]string, len(warehouses))
+	for _, warehouse := range warehouses {
+		p.warehouses[warehouse.ID] = warehouse.Name
+	}
+
+	return nil
+}
+
+func (p *packingSlips) build(ctx context.Context, order *models.Order) (*models.PackingSlip, error) {
+	allocations, err := p.s.warehouseService.GetOrderAllocations(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	picks := make(map[uuid.UUID][]models.PackingSlipPick)
+	for _, allocation := range allocations {
+		picks[allocation.OrderItemID] = append(picks[allocation.OrderItemID], models.PackingSlipPick{
+			WarehouseID:   allocation.WarehouseID,
+			WarehouseName: p.warehouses[allocation.WarehouseID],
+			Quantity:      allocation.Quantity,
+		})
+	}
+
+	wholeOrderIsGift := order.GiftWrap || order.GiftMessage != ""
+
+	slip := &models.PackingSlip{
+		OrderID:         order.ID,
+		ShippingAddress: order.ShippingAddress,
+		GiftWrap:        order.GiftWrap,
+		GiftMessage:     order.GiftMessage,
+		Items:           make([]models.PackingSlipItem, 0, len(order.Items)),
+	}
+
+	for _, item := range order.Items {
+		product, err := p.product(ctx, item.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		slipItem := models.PackingSlipItem{
+			OrderItemID: item.ID,
+			ProductID:   item.ProductID,
+			SKU:         product.SKU,
+			Name:        product.Name,
+			Quantity:    item.Quantity,
+			IsGift:      item.IsGift,
+			Picks:       picks[item.ID],
+		}
+
+		if !wholeOrderIsGift && !item.IsGift {
+			slipItem.UnitPrice = &item.UnitPrice
+		}
+
+		slip.Items = append(slip.Items, slipItem)
+	}
+
+	if !order.IsGift() {
+		slip.TotalAmount = &order.TotalAmount
+	}
+
+	return slip, nil
+}
+
+func (p *packingSlips) product(ctx context.Context, id uuid.UUID) (*models.Product, error) {
+	if product, ok := p.products[id]; ok {
+		return product, nil
+	}
+
+	product, err := p.s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch ordered product").WithError(err)
+	}
+
+	p.products[id] = product
+
+	return product, nil
+}
+
+// claimCampaignStock takes the units of the items from the allocations of the running campaigns and
+// records on the items the campaign they were taken from.
+func (s *orderService) claimCampaignStock(ctx context.Context, items []models.OrderItem) error {
+	claims := make([]models.CampaignClaim, 0, len(items))
+	for _, item := range items {
+		claims = append(claims, models.CampaignClaim{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	if err := s.campaignService.ClaimStock(ctx, claims); err != nil {
+		return err
+	}
+
+	for i, claim := range claims {
+		if claim.CampaignID != uuid.Nil {
+			items[i].CampaignID = &claim.CampaignID
+		}
+	}
+
+	return nil
+}
+
+// campaignClaims lists the units of the items taken from a campaign allocation, the ones to give back
+// to it. Units sold outside of a campaign are left out.
+func campaignClaims(items []models.OrderItem) []models.CampaignClaim {
+	var claims []models.CampaignClaim
+
+	for _, item := range items {
+		if item.CampaignID != nil {
+			claims = append(claims, models.CampaignClaim{ProductID: item.ProductID, Quantity: item.Quantity, CampaignID: *item.CampaignID})
+		}
+	}
+
+	return claims
+}
+
+// splitByVendor groups the items of vendor products into one sub-order per vendor, in the order
+// the vendors first appear. Items of the platform's own products are not part of any sub-order.
+func splitByVendor(order *models.Order, vendors map[uuid.UUID]uuid.UUID) []*models.VendorOrder {
+	var vendorOrders []*models.VendorOrder
+
+	byVendor := make(map[uuid.UUID]*models.VendorOrder)
+
+	for _, item := range order.Items {
+		vendorID, ok := vendors[item.ProductID]
+		if !ok {
+			continue
+		}
+
+		vendorOrder, ok := byVendor[vendorID]
+		if !ok {
+			vendorOrder = &models.VendorOrder{
+				ID:        utils.NewID(),
+				OrderID:   order.ID,
+				VendorID:  vendorID,
+				Status:    models.OrderStatusPending,
+				CreatedAt: order.CreatedAt,
+			}
+			byVendor[vendorID] = vendorOrder
+			vendorOrders = append(vendorOrders, vendorOrder)
+		}
+
+		vendorOrder.Items = append(vendorOrder.Items, item)
+		vendorOrder.Subtotal += float64(item.Quantity) * item.UnitPrice
+	}
+
+	return vendorOrders
+}
+
+// ExportOrders implements OrderService. Orders are streamed to fn one at a time, its errors and the
+// cancellation of ctx are returned as is, only the failures of the query are wrapped.
+func (s *orderService) ExportOrders(ctx context.Context, customerID uuid.UUID, from, to time.Time, fn func(*models.Order) error) error {
+	var fnErr error
+
+	err := s.orderRepo.StreamOrdersOfCustomer(ctx, customerID, from, to, func(order *models.Order) error {
+		fnErr = fn(order)
+
+		return fnErr
+	})
+	if err == nil || fnErr != nil || ctx.Err() != nil {
+		return err
+	}
+
+	return errors.DatabaseError("Failed to export orders").WithError(err)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/google/uuid"
+)
+
+// OrderDocumentService issues the accounting documents of orders, credit notes for the money
+// refunded on them.
+type OrderDocumentService interface {
+	IssueRefundCreditNote(ctx context.Context, refund *models.OrderRefund) (*models.OrderDocument, error)
+	IssueEditCreditNote(ctx context.Context, orderID uuid.UUID, refundID string, amount models.Money) (*models.OrderDocument, error)
+	ListOrderDocuments(ctx context.Context, customerID, orderID uuid.UUID) ([]models.OrderDocument, error)
+}
+
+type orderDocumentService struct {
+	orderRepo       repository.OrderRepository
+	documentRepo    repository.OrderDocumentRepository
+	settingsService StoreSettingsService
+}
+
+// NewOrderDocumentService issues the documents in the name of the legal entity of the store settings.
+func NewOrderDocumentService(orderRepo repository.OrderRepository, documentRepo repository.OrderDocumentRepository, settingsService StoreSettingsService) OrderDocumentService {
+	return &orderDocumentService{
+		orderRepo:       orderRepo,
+		documentRepo:    documentRepo,
+		settingsService: settingsService,
+	}
+}
+
+// IssueRefundCreditNote issues the credit note of a refund of order lines, with a line per refunded item.
+func (s *orderDocumentService) IssueRefundCreditNote(ctx context.Context, refund *models.OrderRefund) (*models.OrderDocument, error) {
+	doc, err := s.creditNote(ctx, refund.OrderID, refund.RefundID, models.Money{Amount: refund.Amount, Currency: refund.Currency})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range refund.Lines {
+		itemID := line.OrderItemID
+		doc.Lines = append(doc.Lines, models.OrderDocumentLine{
+			OrderItemID: &itemID,
+			Description: "Refunded units with their share of the order charges",
+			Quantity:    line.Quantity,
+			Amount:      line.Amount,
+		})
+	}
+
+	return s.issue(ctx, doc)
+}
+
+// IssueEditCreditNote issues the credit note of the difference refunded after an order edit lowered
+// the total of the order.
+func (s *orderDocumentService) IssueEditCreditNote(ctx context.Context, orderID uuid.UUID, refundID string, amount models.Money) (*models.OrderDocument, error) {
+	doc, err := s.creditNote(ctx, orderID, refundID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Lines = []models.OrderDocumentLine{{Description: "Difference of the order edit", Amount: amount.Amount}}
+
+	return s.issue(ctx, doc)
+}
+
+// creditNote starts a credit note of the seller of the store settings.
+func (s *orderDocumentService) creditNote(ctx context.Context, orderID uuid.UUID, refundID string, amount models.Money) (*models.OrderDocument, error) {
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OrderDocument{
+		ID:          uuid.New(),
+		OrderID:     orderID,
+		Type:        models.OrderDocumentCreditNote,
+		LegalEntity: settings.LegalEntity,
+		Series:      settings.CreditNoteSeries,
+		Seller:      models.DocumentSeller{Name: settings.LegalEntity, Address: settings.Address, TaxIDs: settings.TaxIDs},
+		SourceID:    refundID,
+		Amount:      amount.Amount,
+		Currency:    amount.Currency,
+	}, nil
+}
+
+// issue stores the document, a document already issued for the source is not issued twice.
+func (s *orderDocumentService) issue(ctx context.Context, doc *models.OrderDocument) (*models.OrderDocument, error) {
+	if doc.SourceID == "" {
+		return nil, errors.InternalError("Credit note has no refund")
+	}
+
+	created, err := s.documentRepo.CreateOrderDocument(ctx, doc)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to issue order document").WithError(err)
+	}
+
+	if !created {
+		return nil, nil
+	}
+
+	return doc, nil
+}
+
+func (s *orderDocumentService) ListOrderDocuments(ctx context.Context, customerID, orderID uuid.UUID) ([]models.OrderDocument, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if order.CustomerID != customerID {
+		return nil, errors.ForbiddenError("You don't have permission to access this order")
+	}
+
+	docs, err := s.documentRepo.ListOrderDocuments(ctx, orderID)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to list order documents").WithError(err)
+	}
+
+	loc := locale.FromContext(ctx)
+	for i := range docs {
+		docs[i].IssuedOn = loc.FormatDate(docs[i].CreatedAt)
+	}
+
+	return docs, nil
+}
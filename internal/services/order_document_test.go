@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testStoreSettings sell as Acme GmbH, numbering credit notes in the CN series.
+var testStoreSettings = &models.StoreSettings{
+	StoreName:        "Acme",
+	LegalEntity:      "acme-gmbh",
+	Address:          &models.Address{Street: "1 Main St", City: "Berlin", State: "BE", PostalCode: "10115", Country: "DE"},
+	TaxIDs:           []models.TaxID{{Type: "vat", Value: "DE123456789", Country: "DE"}},
+	CreditNoteSeries: "CN",
+	DefaultCurrency:  "usd",
+	DefaultLocale:    "de-DE",
+}
+
+func setupOrderDocumentServiceTest(t *testing.T) (service.OrderDocumentService, *mocks.MockOrderRepository, *mocks.MockOrderDocumentRepository) {
+	orderRepo := mocks.NewMockOrderRepository(t)
+	documentRepo := mocks.NewMockOrderDocumentRepository(t)
+	settingsService := svcMocks.NewMockStoreSettingsService(t)
+	settingsService.On("GetSettings", mock.Anything).Return(testStoreSettings, nil).Maybe()
+
+	return service.NewOrderDocumentService(orderRepo, documentRepo, settingsService), orderRepo, documentRepo
+}
+
+func TestIssueCreditNote(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	t.Run("Success - Refund credit note has a line per item", func(t *testing.T) {
+		// Arrange
+		documentService, _, documentRepo := setupOrderDocumentServiceTest(t)
+		mugID, posterID := uuid.New(), uuid.New()
+		refund := &models.OrderRefund{ID: uuid.New(), OrderID: orderID, RefundID: "re_1", Amount: 3338, Currency: "usd", Lines: []models.OrderRefundLine{
+			{OrderItemID: mugID, Quantity: 1, Amount: 1112},
+			{OrderItemID: posterID, Quantity: 1, Amount: 2226},
+		}}
+
+		documentRepo.On("CreateOrderDocument", ctx, mock.MatchedBy(func(doc *models.OrderDocument) bool {
+			return doc.Type == models.OrderDocumentCreditNote && doc.LegalEntity == "acme-gmbh" && doc.Series == "CN" && doc.OrderID == orderID &&
+				doc.Seller.Name == "acme-gmbh" && doc.Seller.TaxIDs[0].Value == "DE123456789" &&
+				doc.SourceID == "re_1" && doc.Amount == 3338 && len(doc.Lines) == 2 &&
+				*doc.Lines[0].OrderItemID == mugID && doc.Lines[1].Amount == 2226
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*models.OrderDocument).Number = "CN-000007"
+		}).Return(true, nil).Once()
+
+		// Act
+		doc, err := documentService.IssueRefundCreditNote(ctx, refund)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "CN-000007", doc.Number)
+	})
+
+	t.Run("Success - Edit credit note has the difference", func(t *testing.T) {
+		// Arrange
+		documentService, _, documentRepo := setupOrderDocumentServiceTest(t)
+
+		documentRepo.On("CreateOrderDocument", ctx, mock.MatchedBy(func(doc *models.OrderDocument) bool {
+			return doc.SourceID == "re_diff" && doc.Amount == 2000 && doc.Currency == "usd" &&
+				len(doc.Lines) == 1 && doc.Lines[0].OrderItemID == nil && doc.Lines[0].Amount == 2000
+		})).Return(true, nil).Once()
+
+		// Act
+		doc, err := documentService.IssueEditCreditNote(ctx, orderID, "re_diff", models.Money{Amount: 2000, Currency: "usd"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderDocumentCreditNote, doc.Type)
+	})
+
+	t.Run("Success - Already issued", func(t *testing.T) {
+		// Arrange
+		documentService, _, documentRepo := setupOrderDocumentServiceTest(t)
+
+		documentRepo.On("CreateOrderDocument", ctx, mock.AnythingOfType("*models.OrderDocument")).Return(false, nil).Once()
+
+		// Act
+		doc, err := documentService.IssueEditCreditNote(ctx, orderID, "re_diff", models.Money{Amount: 2000, Currency: "usd"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, doc)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		documentService, _, documentRepo := setupOrderDocumentServiceTest(t)
+		dbErr := errors.New("db error")
+
+		documentRepo.On("CreateOrderDocument", ctx, mock.AnythingOfType("*models.OrderDocument")).Return(false, dbErr).Once()
+
+		// Act
+		_, err := documentService.IssueEditCreditNote(ctx, orderID, "re_diff", models.Money{Amount: 2000, Currency: "usd"})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestListOrderDocuments(t *testing.T) {
+	ctx := t.Context()
+	customerID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		documentService, orderRepo, documentRepo := setupOrderDocumentServiceTest(t)
+		docs := []models.OrderDocument{{ID: uuid.New(), OrderID: orderID, Type: models.OrderDocumentCreditNote, Number: "CN-000001"}}
+
+		orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, CustomerID: customerID}, nil).Once()
+		documentRepo.On("ListOrderDocuments", ctx, orderID).Return(docs, nil).Once()
+
+		// Act
+		result, err := documentService.ListOrderDocuments(ctx, customerID, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, docs, result)
+	})
+
+	t.Run("Failure - Order of another customer", func(t *testing.T) {
+		// Arrange
+		documentService, orderRepo, documentRepo := setupOrderDocumentServiceTest(t)
+
+		orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, CustomerID: uuid.New()}, nil).Once()
+
+		// Act
+		_, err := documentService.ListOrderDocuments(ctx, customerID, orderID)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeForbidden)
+		documentRepo.AssertNotCalled(t, "ListOrderDocuments")
+	})
+}
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"log/slog"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+	"github.com/google/uuid"
+)
+
+type OrderEditService interface {
+	EditOrder(ctx context.Context, adminID, orderID uuid.UUID, req *models.EditOrderRequest) (*models.OrderEditResult, error)
+}
+
+type orderEditService struct {
+	orderRepo        repository.OrderRepository
+	productRepo      repository.ProductRepository
+	inventoryRepo    repository.InventoryRepository
+	warehouseService WarehouseService
+	shippingService  ShippingService
+	paymentRepo      repository.PaymentRepository
+	auditRepo        repository.AuditRepository
+	documentService  OrderDocumentService
+	stripeClient     stripe.Client
+	clock            clock.Clock
+}
+
+func NewOrderEditService(orderRepo repository.OrderRepository, productRepo repository.ProductRepository, inventoryRepo repository.InventoryRepository, warehouseService WarehouseService, shippingService ShippingService, paymentRepo repository.PaymentRepository, auditRepo repository.AuditRepository, documentService OrderDocumentService, stripeClient stripe.Client, clk clock.Clock) OrderEditService {
+	return &orderEditService{
+		orderRepo:        orderRepo,
+		productRepo:      productRepo,
+		inventoryRepo:    inventoryRepo,
+		warehouseService: warehouseService,
+		shippingService:  shippingService,
+		paymentRepo:      paymentRepo,
+		auditRepo:        auditRepo,
+		documentService:  documentService,
+		stripeClient:     stripeClient,
+		clock:            clk,
+	}
+}
+
+// orderEditDetails is what the audit log keeps of an edit.
+type orderEditDetails struct {
+	AddedItems      []models.OrderItem     `json:"added_items,omitempty"`
+	RemovedItems    []models.OrderItem     `json:"removed_items,omitempty"`
+	PreviousAddress *models.Address        `json:"previous_address,omitempty"`
+	ShippingAddress *models.Address        `json:"shipping_address,omitempty"`
+	PreviousTotal   float64                `json:"previous_total"`
+	Total           float64                `json:"total"`
+	Settlement      models.OrderSettlement `json:"settlement"`
+	PaymentID       string                 `json:"payment_id,omitempty"`
+	SettlementError string                 `json:"settlement_error,omitempty"`
+}
+
+// EditOrder implements OrderEditService. The edit is saved first, then the stock and the warehouse
+// allocations follow it and the difference of the total is settled when the order was already paid.
+// The audit entry is recorded even when the settlement fails, with the failure, so the payment
+// can be settled by hand.
+func (s *orderEditService) EditOrder(ctx context.Context, adminID, orderID uuid.UUID, req *models.EditOrderRequest) (*models.OrderEditResult, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	if len(req.AddItems) == 0 && len(req.RemoveItemIDs) == 0 && req.ShippingAddress == nil {
+		return nil, errors.BadRequestError("Nothing to edit")
+	}
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if order.Status != models.OrderStatusPending && order.Status != models.OrderStatusConfirmed {
+		return nil, errors.BadRequestError("Only orders that have not shipped can be edited")
+	}
+
+	if req.ShippingAddress != nil && order.FulfillmentType == models.FulfillmentPickup {
+		return nil, errors.BadRequestError("Pickup orders are shipped to their pickup location")
+	}
+
+	removing := make(map[uuid.UUID]bool, len(req.RemoveItemIDs))
+
+	for _, id := range req.RemoveItemIDs {
+		if removing[id] {
+			return nil, errors.BadRequestError("Item removed twice: " + id.String())
+		}
+
+		removing[id] = true
+	}
+
+	var kept, removed []models.OrderItem
+
+	for _, item := range order.Items {
+		if removing[item.ID] {
+			removed = append(removed, item)
+		} else {
+			kept = append(kept, item)
+		}
+	}
+
+	if len(removed) != len(removing) {
+		return nil, errors.BadRequestError("Item not found in order")
+	}
+
+	if len(kept)+len(req.AddItems) == 0 {
+		return nil, errors.BadRequestError("An order must keep at least one item")
+	}
+
+	// the products are shared between the items, so the stock of a product changed twice adds up
+	products := make(map[uuid.UUID]*models.Product)
+
+	getProduct := func(id uuid.UUID) (*models.Product, error) {
+		if product, ok := products[id]; ok {
+			return product, nil
+		}
+
+		product, err := s.productRepo.GetProductByID(ctx, id)
+		if err != nil {
+			return nil, errors.NotFoundError("Product not found: " + id.String()).WithError(err)
+		}
+
+		// vendor sub-orders and their commission are fixed when the order is placed
+		if product.VendorID != nil {
+			return nil, errors.BadRequestError("Items of marketplace vendors cannot be edited: " + id.String())
+		}
+
+		products[id] = product
+
+		return product, nil
+	}
+
+	for _, item := range removed {
+		if _, err := getProduct(item.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.clock.Now()
+	added := make([]models.OrderItem, 0, len(req.AddItems))
+	wanted := make(map[uuid.UUID]int)
+
+	for _, item := range req.AddItems {
+		product, err := getProduct(item.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		wanted[product.ID] += item.Quantity
+		if product.StockQuantity < wanted[product.ID] {
+			return nil, errors.InsufficientStockError(product.ID, product.StockQuantity)
+		}
+
+		added = append(added, models.OrderItem{
+			ID:        utils.NewID(),
+			OrderID:   order.ID,
+			ProductID: product.ID,
+			Quantity:  item.Quantity,
+			UnitPrice: product.Price,
+			IsGift:    item.IsGift,
+			CreatedAt: now,
+		})
+	}
+
+	previousTotal := order.TotalAmount
+	previousAddress := order.ShippingAddress
+
+	// the gift wrap stays charged at the fee the order was placed with
+	total := order.GiftWrapFee
+	order.Items = append(kept, added...)
+
+	for _, item := range order.Items {
+		total += float64(item.Quantity) * item.UnitPrice
+	}
+
+	order.TotalAmount = roundCents(total)
+	order.UpdatedAt = now
+
+	if req.ShippingAddress != nil {
+		order.ShippingAddress = req.ShippingAddress
+	}
+
+	// a new address or new items must still be shippable, like at checkout
+	if order.FulfillmentType != models.FulfillmentPickup && (req.ShippingAddress != nil || len(added) > 0) {
+		productIDs := make([]uuid.UUID, 0, len(order.Items))
+		for _, item := range order.Items {
+			productIDs = append(productIDs, item.ProductID)
+		}
+
+		if err := s.shippingService.CheckOrder(ctx, order.ShippingAddress, productIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	removedIDs := make([]uuid.UUID, 0, len(removed))
+	for _, item := range removed {
+		removedIDs = append(removedIDs, item.ID)
+	}
+
+	if err := s.orderRepo.EditOrder(ctx, order, removedIDs, added); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.BadRequestError("Only orders that have not shipped can be edited")
+		}
+
+		return nil, errors.DatabaseError("Failed to edit order").WithError(err)
+	}
+
+	if err := s.warehouseService.ReleaseOrderItems(ctx, removedIDs); err != nil {
+		return nil, err
+	}
+
+	// removed items go back into stock, the movement is negative like every restock
+	for _, item := range removed {
+		if err := moveStock(ctx, s.productRepo, s.inventoryRepo, order.ID, products[item.ProductID], -item.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range added {
+		if err := moveStock(ctx, s.productRepo, s.inventoryRepo, order.ID, products[item.ProductID], item.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(added) > 0 {
+		if _, err := s.warehouseService.AllocateOrder(ctx, &models.Order{ID: order.ID, ShippingAddress: order.ShippingAddress, Items: added}); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &models.OrderEditResult{
+		Order:         order,
+		PreviousTotal: previousTotal,
+		Delta: models.Money{
+			Amount:   models.NewMoney(order.TotalAmount, models.CatalogCurrency).Amount - models.NewMoney(previousTotal, models.CatalogCurrency).Amount,
+			Currency: models.CatalogCurrency,
+		},
+		Settlement: models.OrderSettlementNone,
+	}
+
+	settleErr := s.settle(ctx, order, result)
+
+	details := orderEditDetails{
+		AddedItems:    added,
+		RemovedItems:  removed,
+		PreviousTotal: previousTotal,
+		Total:         order.TotalAmount,
+		Settlement:    result.Settlement,
+		PaymentID:     result.PaymentID,
+	}
+
+	if req.ShippingAddress != nil {
+		details.PreviousAddress = previousAddress
+		details.ShippingAddress = order.ShippingAddress
+	}
+
+	if settleErr != nil {
+		details.SettlementError = settleErr.Error()
+	}
+
+	if err := s.recordEdit(ctx, adminID, order, &details); err != nil {
+		return nil, err
+	}
+
+	if settleErr != nil {
+		return nil, settleErr
+	}
+
+	return result, nil
+}
+
+// moveStock takes quantity units of the product out of stock for the order, or puts them back
+// when quantity is negative, and records the movement in the stock ledger.
+func moveStock(ctx context.Context, productRepo repository.ProductRepository, inventoryRepo repository.InventoryRepository, orderID uuid.UUID, product *models.Product, quantity int) error {
+	product.StockQuantity -= quantity
+
+	if err := productRepo.UpdateProduct(ctx, product); err != nil {
+		return errors.DatabaseError("Failed to update inventory").WithError(err)
+	}
+
+	err := inventoryRepo.RecordMovement(ctx, &models.StockMovement{
+		ID:          uuid.New(),
+		ProductID:   product.ID,
+		Quantity:    quantity,
+		Reason:      models.StockMovementOrder,
+		ReferenceID: &orderID,
+	})
+	if err != nil {
+		return errors.DatabaseError("Failed to record stock movement").WithError(err)
+	}
+
+	return nil
+}
+
+// settle charges or refunds the difference of the total of a paid order, a refund gets a credit note.
+// Orders not paid yet are simply paid at their new total.
+func (s *orderEditService) settle(ctx context.Context, order *models.Order, result *models.OrderEditResult) error {
+	if order.PaymentStatus != models.PaymentStatusSucceeded || order.PaymentIntentID == "" || result.Delta.Amount == 0 {
+		return nil
+	}
+
+	if result.Delta.Amount < 0 {
+		refund, err := s.stripeClient.RefundPayment(order.PaymentIntentID, -result.Delta.Amount)
+		if err != nil {
+			return errors.ThirdPartyError("Failed to refund the difference").WithError(err)
+		}
+
+		result.Settlement = models.OrderSettlementRefund
+		result.PaymentID = refund.ID
+
+		// the money is back already, failing to issue the credit note is only logged
+		if _, err := s.documentService.IssueEditCreditNote(ctx, order.ID, refund.ID, models.Money{Amount: -result.Delta.Amount, Currency: result.Delta.Currency}); err != nil {
+			slog.Error("Failed to issue credit note of order edit", slog.String("orderId", order.ID.String()), slog.String("refundId", refund.ID), slog.String("error", err.Error()))
+		}
+
+		return nil
+	}
+
+	description := "Additional payment for order " + order.ID.String()
+
+	paymentIntent, err := s.stripeClient.CreatePaymentIntent(result.Delta.Amount, result.Delta.Currency, description, order.CustomerID.String())
+	if err != nil {
+		return errors.ThirdPartyError("Failed to create payment intent").WithError(err)
+	}
+
+	now := s.clock.Now()
+
+	payment := &models.Payment{
+		ID:            paymentIntent.ID,
+		CustomerID:    order.CustomerID.String(),
+		Amount:        result.Delta.Amount,
+		Currency:      result.Delta.Currency,
+		Description:   description,
+		Status:        models.PaymentStatusPending,
+		PaymentMethod: "card",
+		StripeID:      paymentIntent.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	result.Settlement = models.OrderSettlementCharge
+	result.PaymentID = paymentIntent.ID
+	result.ClientSecret = paymentIntent.ClientSecret
+
+	if err := s.paymentRepo.CreatePayment(ctx, payment); err != nil {
+		return errors.DatabaseError("Failed to record payment").WithError(err)
+	}
+
+	return nil
+}
+
+func (s *orderEditService) recordEdit(ctx context.Context, adminID uuid.UUID, order *models.Order, details *orderEditDetails) error {
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return errors.InternalError("Failed to encode order edit").WithError(err)
+	}
+
+	entry := &models.AuditEntry{
+		ID:          uuid.New(),
+		UserID:      order.CustomerID,
+		ActorID:     &adminID,
+		Action:      models.AuditActionOrderEdited,
+		ReferenceID: &order.ID,
+		Details:     raw,
+	}
+
+	if err := s.auditRepo.RecordEntry(ctx, entry); err != nil {
+		return errors.DatabaseError("Failed to record order edit").WithError(err)
+	}
+
+	return nil
+}
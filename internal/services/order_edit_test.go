@@ -0,0 +1,386 @@
+package service_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	stripeMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+type orderEditMocks struct {
+	orderRepo        *mocks.MockOrderRepository
+	productRepo      *mocks.MockProductRepository
+	inventoryRepo    *mocks.MockInventoryRepository
+	warehouseService *svcMocks.MockWarehouseService
+	shippingService  *svcMocks.MockShippingService
+	paymentRepo      *mocks.MockPaymentRepository
+	auditRepo        *mocks.MockAuditRepository
+	documentService  *svcMocks.MockOrderDocumentService
+	stripeClient     *stripeMocks.MockClient
+}
+
+func setupOrderEditServiceTest(t *testing.T) (service.OrderEditService, *orderEditMocks) {
+	m := &orderEditMocks{
+		orderRepo:        mocks.NewMockOrderRepository(t),
+		productRepo:      mocks.NewMockProductRepository(t),
+		inventoryRepo:    mocks.NewMockInventoryRepository(t),
+		warehouseService: svcMocks.NewMockWarehouseService(t),
+		shippingService:  svcMocks.NewMockShippingService(t),
+		paymentRepo:      mocks.NewMockPaymentRepository(t),
+		auditRepo:        mocks.NewMockAuditRepository(t),
+		documentService:  svcMocks.NewMockOrderDocumentService(t),
+		stripeClient:     stripeMocks.NewMockClient(t),
+	}
+
+	orderEditService := service.NewOrderEditService(m.orderRepo, m.productRepo, m.inventoryRepo, m.warehouseService, m.shippingService, m.paymentRepo, m.auditRepo, m.documentService, m.stripeClient, clock.NewFake(testNow))
+
+	return orderEditService, m
+}
+
+// paidOrder has a mug at 10.00 twice and a poster at 20.00, paid with a gift wrap of 4.50.
+func paidOrder(mugID, posterID uuid.UUID) *models.Order {
+	orderID := uuid.New()
+
+	return &models.Order{
+		ID:              orderID,
+		CustomerID:      uuid.New(),
+		Status:          models.OrderStatusConfirmed,
+		TotalAmount:     44.5,
+		PaymentStatus:   models.PaymentStatusSucceeded,
+		PaymentIntentID: "pi_order",
+		FulfillmentType: models.FulfillmentShipping,
+		ShippingAddress: &models.Address{Street: "1 Old St", City: "Berlin", State: "BE", PostalCode: "10115", Country: "DE"},
+		GiftWrap:        true,
+		GiftWrapFee:     testGiftWrapFee,
+		Items: []models.OrderItem{
+			{ID: uuid.New(), OrderID: orderID, ProductID: mugID, Quantity: 2, UnitPrice: 10.0},
+			{ID: uuid.New(), OrderID: orderID, ProductID: posterID, Quantity: 1, UnitPrice: 20.0},
+		},
+	}
+}
+
+func auditDetails(t *testing.T, entry *models.AuditEntry) map[string]any {
+	t.Helper()
+
+	var details map[string]any
+	require.NoError(t, json.Unmarshal(entry.Details, &details))
+
+	return details
+}
+
+func TestEditOrder(t *testing.T) {
+	adminID := uuid.New()
+	mugID, posterID, lampID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("Success - Added item is charged at the current price", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		lamp := &models.Product{ID: lampID, Price: 12.25, StockQuantity: 3}
+
+		var entry *models.AuditEntry
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, lampID).Return(lamp, nil).Once()
+		m.shippingService.On("CheckOrder", ctx, order.ShippingAddress, []uuid.UUID{mugID, posterID, lampID}).Return(nil).Once()
+		m.orderRepo.On("EditOrder", ctx, order, []uuid.UUID{}, mock.MatchedBy(func(items []models.OrderItem) bool {
+			return len(items) == 1 && items[0].ProductID == lampID && items[0].Quantity == 2 && items[0].UnitPrice == 12.25
+		})).Return(nil).Once()
+		m.warehouseService.On("ReleaseOrderItems", ctx, []uuid.UUID{}).Return(nil).Once()
+		m.productRepo.On("UpdateProduct", ctx, mock.MatchedBy(func(p *models.Product) bool { return p.ID == lampID && p.StockQuantity == 1 })).Return(nil).Once()
+		m.inventoryRepo.On("RecordMovement", ctx, mock.MatchedBy(func(mv *models.StockMovement) bool {
+			return mv.ProductID == lampID && mv.Quantity == 2 && mv.Reason == models.StockMovementOrder && *mv.ReferenceID == order.ID
+		})).Return(nil).Once()
+		m.warehouseService.On("AllocateOrder", ctx, mock.MatchedBy(func(o *models.Order) bool { return o.ID == order.ID && len(o.Items) == 1 })).
+			Return([]*models.StockAllocation{}, nil).Once()
+		m.stripeClient.On("CreatePaymentIntent", int64(2450), models.CatalogCurrency, "Additional payment for order "+order.ID.String(), order.CustomerID.String()).
+			Return(&stripe.PaymentIntent{ID: "pi_extra", ClientSecret: "pi_extra_secret"}, nil).Once()
+		m.paymentRepo.On("CreatePayment", ctx, mock.MatchedBy(func(p *models.Payment) bool {
+			return p.ID == "pi_extra" && p.Amount == 2450 && p.Status == models.PaymentStatusPending
+		})).Return(nil).Once()
+		m.auditRepo.On("RecordEntry", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			entry = args.Get(1).(*models.AuditEntry)
+		}).Return(nil).Once()
+
+		// Act
+		result, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{
+			AddItems: []models.EditOrderItem{{ProductID: lampID, Quantity: 2}},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 69.0, result.Order.TotalAmount)
+		assert.Equal(t, 44.5, result.PreviousTotal)
+		assert.Equal(t, models.Money{Amount: 2450, Currency: models.CatalogCurrency}, result.Delta)
+		assert.Equal(t, models.OrderSettlementCharge, result.Settlement)
+		assert.Equal(t, "pi_extra_secret", result.ClientSecret)
+		assert.Len(t, result.Order.Items, 3)
+
+		require.NotNil(t, entry)
+		assert.Equal(t, models.AuditActionOrderEdited, entry.Action)
+		assert.Equal(t, order.CustomerID, entry.UserID)
+		assert.Equal(t, adminID, *entry.ActorID)
+		assert.Equal(t, order.ID, *entry.ReferenceID)
+		assert.Equal(t, "pi_extra", auditDetails(t, entry)["payment_id"])
+	})
+
+	t.Run("Success - Removed item is restocked and refunded", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		posterItemID := order.Items[1].ID
+		poster := &models.Product{ID: posterID, Price: 25.0, StockQuantity: 4}
+		newAddress := &models.Address{Street: "2 New St", City: "Hamburg", State: "HH", PostalCode: "20095", Country: "DE"}
+
+		var entry *models.AuditEntry
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, posterID).Return(poster, nil).Once()
+		m.shippingService.On("CheckOrder", ctx, newAddress, []uuid.UUID{mugID}).Return(nil).Once()
+		m.orderRepo.On("EditOrder", ctx, order, []uuid.UUID{posterItemID}, []models.OrderItem{}).Return(nil).Once()
+		m.warehouseService.On("ReleaseOrderItems", ctx, []uuid.UUID{posterItemID}).Return(nil).Once()
+		m.productRepo.On("UpdateProduct", ctx, mock.MatchedBy(func(p *models.Product) bool { return p.ID == posterID && p.StockQuantity == 5 })).Return(nil).Once()
+		m.inventoryRepo.On("RecordMovement", ctx, mock.MatchedBy(func(mv *models.StockMovement) bool {
+			return mv.ProductID == posterID && mv.Quantity == -1
+		})).Return(nil).Once()
+		m.stripeClient.On("RefundPayment", "pi_order", int64(2000)).Return(&stripe.Refund{ID: "re_diff"}, nil).Once()
+		m.documentService.On("IssueEditCreditNote", ctx, order.ID, "re_diff", models.Money{Amount: 2000, Currency: models.CatalogCurrency}).
+			Return(&models.OrderDocument{Number: "CN-000001"}, nil).Once()
+		m.auditRepo.On("RecordEntry", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			entry = args.Get(1).(*models.AuditEntry)
+		}).Return(nil).Once()
+
+		// Act
+		result, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{
+			RemoveItemIDs:   []uuid.UUID{posterItemID},
+			ShippingAddress: newAddress,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 24.5, result.Order.TotalAmount)
+		assert.Equal(t, int64(-2000), result.Delta.Amount)
+		assert.Equal(t, models.OrderSettlementRefund, result.Settlement)
+		assert.Equal(t, "re_diff", result.PaymentID)
+		assert.Equal(t, newAddress, result.Order.ShippingAddress)
+
+		details := auditDetails(t, entry)
+		assert.Equal(t, "Berlin", details["previous_address"].(map[string]any)["city"])
+		assert.Equal(t, "Hamburg", details["shipping_address"].(map[string]any)["city"])
+	})
+
+	t.Run("Success - Unpaid order is not settled", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		order.PaymentStatus = models.PaymentStatusPending
+		order.PaymentIntentID = ""
+		posterItemID := order.Items[1].ID
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, posterID).Return(&models.Product{ID: posterID, StockQuantity: 4}, nil).Once()
+		m.orderRepo.On("EditOrder", ctx, order, []uuid.UUID{posterItemID}, []models.OrderItem{}).Return(nil).Once()
+		m.warehouseService.On("ReleaseOrderItems", ctx, []uuid.UUID{posterItemID}).Return(nil).Once()
+		m.productRepo.On("UpdateProduct", ctx, mock.Anything).Return(nil).Once()
+		m.inventoryRepo.On("RecordMovement", ctx, mock.Anything).Return(nil).Once()
+		m.auditRepo.On("RecordEntry", ctx, mock.Anything).Return(nil).Once()
+
+		// Act
+		result, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{RemoveItemIDs: []uuid.UUID{posterItemID}})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderSettlementNone, result.Settlement)
+		m.stripeClient.AssertNotCalled(t, "RefundPayment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Refund fails after the edit is saved", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		posterItemID := order.Items[1].ID
+
+		var entry *models.AuditEntry
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, posterID).Return(&models.Product{ID: posterID, StockQuantity: 4}, nil).Once()
+		m.orderRepo.On("EditOrder", ctx, order, []uuid.UUID{posterItemID}, []models.OrderItem{}).Return(nil).Once()
+		m.warehouseService.On("ReleaseOrderItems", ctx, []uuid.UUID{posterItemID}).Return(nil).Once()
+		m.productRepo.On("UpdateProduct", ctx, mock.Anything).Return(nil).Once()
+		m.inventoryRepo.On("RecordMovement", ctx, mock.Anything).Return(nil).Once()
+		m.stripeClient.On("RefundPayment", "pi_order", int64(2000)).Return(nil, errors.New("card declined")).Once()
+		m.auditRepo.On("RecordEntry", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			entry = args.Get(1).(*models.AuditEntry)
+		}).Return(nil).Once()
+
+		// Act
+		result, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{RemoveItemIDs: []uuid.UUID{posterItemID}})
+
+		// Assert
+		assert.Nil(t, result)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
+		assert.Contains(t, auditDetails(t, entry)["settlement_error"], "Failed to refund the difference")
+	})
+
+	t.Run("Failure - Order already shipped", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		order.Status = models.OrderStatusShipping
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{RemoveItemIDs: []uuid.UUID{order.Items[0].ID}})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Order ships while it is edited", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		posterItemID := order.Items[1].ID
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, posterID).Return(&models.Product{ID: posterID, StockQuantity: 4}, nil).Once()
+		m.orderRepo.On("EditOrder", ctx, order, []uuid.UUID{posterItemID}, []models.OrderItem{}).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{RemoveItemIDs: []uuid.UUID{posterItemID}})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - New address is restricted", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		poBox := &models.Address{Street: "PO Box 12", City: "Hamburg", State: "HH", PostalCode: "20095", Country: "DE"}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.shippingService.On("CheckOrder", ctx, poBox, []uuid.UUID{mugID, posterID}).
+			Return(appErrors.ShippingRestrictedError(appErrors.ErrCodeShippingPOBox, "We do not ship to PO boxes")).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{ShippingAddress: poBox})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeShippingPOBox, appErr.Code)
+		m.orderRepo.AssertNotCalled(t, "EditOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Last item removed", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{
+			RemoveItemIDs: []uuid.UUID{order.Items[0].ID, order.Items[1].ID},
+		})
+
+		// Assert
+		require.ErrorContains(t, err, "An order must keep at least one item")
+	})
+
+	t.Run("Failure - Insufficient stock", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, lampID).Return(&models.Product{ID: lampID, Price: 12.25, StockQuantity: 3}, nil).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{
+			AddItems: []models.EditOrderItem{{ProductID: lampID, Quantity: 2}, {ProductID: lampID, Quantity: 2, IsGift: true}},
+		})
+
+		// Assert
+		var stockErr *appErrors.ErrInsufficientStock
+		require.ErrorAs(t, err, &stockErr)
+		assert.Equal(t, 3, stockErr.Available)
+	})
+
+	t.Run("Failure - Vendor product", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		vendorID := uuid.New()
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, lampID).Return(&models.Product{ID: lampID, Price: 12.25, StockQuantity: 3, VendorID: &vendorID}, nil).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{
+			AddItems: []models.EditOrderItem{{ProductID: lampID, Quantity: 1}},
+		})
+
+		// Assert
+		require.ErrorContains(t, err, "Items of marketplace vendors cannot be edited")
+	})
+
+	t.Run("Failure - Address of a pickup order", func(t *testing.T) {
+		// Arrange
+		orderEditService, m := setupOrderEditServiceTest(t)
+		ctx := t.Context()
+		order := paidOrder(mugID, posterID)
+		order.FulfillmentType = models.FulfillmentPickup
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+
+		// Act
+		_, err := orderEditService.EditOrder(ctx, adminID, order.ID, &models.EditOrderRequest{ShippingAddress: &models.Address{City: "Hamburg"}})
+
+		// Assert
+		require.ErrorContains(t, err, "Pickup orders are shipped to their pickup location")
+	})
+
+	t.Run("Failure - Nothing to edit", func(t *testing.T) {
+		// Arrange
+		orderEditService, _ := setupOrderEditServiceTest(t)
+
+		// Act
+		_, err := orderEditService.EditOrder(t.Context(), adminID, uuid.New(), &models.EditOrderRequest{})
+
+		// Assert
+		require.ErrorContains(t, err, "Nothing to edit")
+	})
+}
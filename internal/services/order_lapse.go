@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/google/uuid"
+)
+
+// OrderLapseService gives the stock taken by unpaid orders back: orders whose payment was cancelled
+// or expired, and pending orders left unpaid for longer than the payment window.
+type OrderLapseService interface {
+	LapseOrder(ctx context.Context, orderID uuid.UUID) (bool, error)
+	LapsePayment(ctx context.Context, paymentIntentID string) error
+	LapseUnpaidOrders(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// OrderLapsePolicy sets how long a pending order waits for its payment, and how many stale orders a
+// sweep lapses at a time.
+type OrderLapsePolicy struct {
+	PaymentWindow time.Duration
+	BatchSize     int
+}
+
+type orderLapseService struct {
+	orderRepo           repository.OrderRepository
+	campaignService     CampaignService
+	userRepo            repository.UserRepository
+	notificationService NotificationService
+	trackingService     OrderTrackingService
+	policy              OrderLapsePolicy
+	clock               clock.Clock
+}
+
+func NewOrderLapseService(orderRepo repository.OrderRepository, campaignService CampaignService, userRepo repository.UserRepository, notificationService NotificationService, trackingService OrderTrackingService, policy OrderLapsePolicy, clk clock.Clock) OrderLapseService {
+	policy.BatchSize = max(policy.BatchSize, 1)
+
+	return &orderLapseService{
+		orderRepo:           orderRepo,
+		campaignService:     campaignService,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		trackingService:     trackingService,
+		policy:              policy,
+		clock:               clk,
+	}
+}
+
+// LapseOrder cancels an unpaid order, puts its stock back on sale, gives the units it claimed back to the
+// campaign allocations and tells the customer. It reports false when the order was paid or closed in the
+// meantime. The order is cancelled in the same transaction that gives its stock back, so a payment
+// arriving during the lapse never ships an order without stock, and a lapse that fails leaves the order
+// pending for the next sweep.
+func (s *orderLapseService) LapseOrder(ctx context.Context, orderID uuid.UUID) (bool, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return false, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if err := s.orderRepo.LapseOrder(ctx, orderID); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, errors.DatabaseError("Failed to lapse order").WithError(err)
+	}
+
+	s.campaignService.ReleaseStock(ctx, campaignClaims(order.Items))
+	s.notifyCustomer(ctx, order)
+
+	return true, nil
+}
+
+// LapsePayment lapses the order paid by a cancelled payment intent or an expired checkout session.
+// Payments not made for an order are ignored.
+func (s *orderLapseService) LapsePayment(ctx context.Context, paymentIntentID string) error {
+	orderID, err := s.orderRepo.GetOrderIDByPaymentIntentID(ctx, paymentIntentID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return errors.DatabaseError("Failed to find order of payment").WithError(err)
+	}
+
+	_, err = s.LapseOrder(ctx, orderID)
+
+	return err
+}
+
+// LapseUnpaidOrders lapses the pending orders placed more than the payment window ago, batch by batch.
+// An order failing to lapse is logged and left for the next sweep.
+func (s *orderLapseService) LapseUnpaidOrders(ctx context.Context) (int, error) {
+	placedBefore := s.clock.Now().Add(-s.policy.PaymentWindow)
+	lapsed := 0
+
+	for {
+		ids, err := s.orderRepo.ListUnpaidOrderIDs(ctx, placedBefore, s.policy.BatchSize)
+		if err != nil {
+			return lapsed, errors.DatabaseError("Failed to list unpaid orders").WithError(err)
+		}
+
+		lapsedInBatch := 0
+
+		for _, id := range ids {
+			ok, err := s.LapseOrder(ctx, id)
+			if err != nil {
+				slog.Error("Failed to lapse unpaid order", slog.String("orderId", id.String()), slog.String("error", err.Error()))
+
+				continue
+			}
+
+			if ok {
+				lapsedInBatch++
+			}
+		}
+
+		lapsed += lapsedInBatch
+
+		// a batch where nothing lapsed would be listed again, it waits for the next sweep
+		if len(ids) < s.policy.BatchSize || lapsedInBatch == 0 {
+			return lapsed, nil
+		}
+	}
+}
+
+// Run sweeps the unpaid orders every interval until ctx is cancelled.
+func (s *orderLapseService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lapsed, err := s.LapseUnpaidOrders(ctx)
+			if err != nil {
+				slog.Error("Scheduled lapse of unpaid orders failed", slog.String("error", err.Error()))
+			}
+
+			if lapsed > 0 {
+				slog.Info("Unpaid orders lapsed", slog.Int("count", lapsed))
+			}
+		}
+	}
+}
+
+// notifyCustomer is best effort, the order is lapsed whether the email goes out or not.
+func (s *orderLapseService) notifyCustomer(ctx context.Context, order *models.Order) {
+	user, err := s.userRepo.GetUserByID(ctx, order.CustomerID)
+	if err != nil {
+		slog.Error("Failed to look up customer for lapsed order notification",
+			slog.String("orderId", order.ID.String()),
+			slog.String("error", err.Error()))
+
+		return
+	}
+
+	req := &models.EmailNotificationRequest{
+		To:       user.Email,
+		Subject:  "Your order was cancelled",
+		Content:  fmt.Sprintf("Order %s was cancelled because it was not paid, its items were put back on sale.", order.ID) + trackingLine(s.trackingService, order.ID),
+		Metadata: map[string]string{"order_id": order.ID.String()},
+	}
+
+	if _, err := s.notificationService.SendEmail(ctx, req); err != nil {
+		slog.Error("Failed to send lapsed order notification",
+			slog.String("orderId", order.ID.String()),
+			slog.String("error", err.Error()))
+	}
+}
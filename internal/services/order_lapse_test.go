@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testOrderLapsePolicy = service.OrderLapsePolicy{PaymentWindow: 24 * time.Hour, BatchSize: 2}
+
+type orderLapseServiceMocks struct {
+	orderRepo    *mocks.MockOrderRepository
+	campaign     *svcMocks.MockCampaignService
+	userRepo     *mocks.MockUserRepository
+	notification *svcMocks.MockNotificationService
+}
+
+func setupOrderLapseServiceTest(t *testing.T) (service.OrderLapseService, *orderLapseServiceMocks) {
+	m := &orderLapseServiceMocks{
+		orderRepo:    mocks.NewMockOrderRepository(t),
+		campaign:     svcMocks.NewMockCampaignService(t),
+		userRepo:     mocks.NewMockUserRepository(t),
+		notification: svcMocks.NewMockNotificationService(t),
+	}
+
+	return service.NewOrderLapseService(m.orderRepo, m.campaign, m.userRepo, m.notification, service.NewOrderTrackingService(nil, nil, testOrderTrackingPolicy), testOrderLapsePolicy, clock.NewFake(testNow)), m
+}
+
+// expectLapse sets up a pending order that lapses, its stock is given back by the repository and its
+// units to the campaigns.
+func (m *orderLapseServiceMocks) expectLapse(orderID uuid.UUID) {
+	productID, customerID, campaignID := uuid.New(), uuid.New(), uuid.New()
+	order := &models.Order{
+		ID:         orderID,
+		CustomerID: customerID,
+		Status:     models.OrderStatusPending,
+		Items:      []models.OrderItem{{ID: uuid.New(), ProductID: productID, Quantity: 3, CampaignID: &campaignID}},
+	}
+
+	m.orderRepo.On("GetOrderByID", mock.Anything, orderID).Return(order, nil).Once()
+	m.orderRepo.On("LapseOrder", mock.Anything, orderID).Return(nil).Once()
+	m.campaign.On("ReleaseStock", mock.Anything, []models.CampaignClaim{{ProductID: productID, Quantity: 3, CampaignID: campaignID}}).Return().Once()
+	m.userRepo.On("GetUserByID", mock.Anything, customerID).Return(&models.User{ID: customerID, Email: "buyer@example.com"}, nil).Once()
+	m.notification.On("SendEmail", mock.Anything, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool {
+		return req.To == "buyer@example.com" && req.Metadata["order_id"] == orderID.String()
+	})).Return(&models.NotificationResponse{}, nil).Once()
+}
+
+func TestLapseOrder(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	t.Run("Success - Stock is put back on sale", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		m.expectLapse(orderID)
+
+		// Act
+		lapsed, err := lapseService.LapseOrder(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, lapsed)
+	})
+
+	t.Run("Success - Order paid in the meantime is left alone", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID}, nil).Once()
+		m.orderRepo.On("LapseOrder", ctx, orderID).Return(sql.ErrNoRows).Once()
+
+		// Act
+		lapsed, err := lapseService.LapseOrder(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, lapsed)
+		m.userRepo.AssertNotCalled(t, "GetUserByID")
+	})
+
+	t.Run("Failure - Order left pending when the lapse fails", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID}, nil).Once()
+		m.orderRepo.On("LapseOrder", ctx, orderID).Return(errors.New("db down")).Once()
+
+		// Act
+		lapsed, err := lapseService.LapseOrder(ctx, orderID)
+
+		// Assert
+		require.Error(t, err)
+		assert.False(t, lapsed)
+		m.userRepo.AssertNotCalled(t, "GetUserByID")
+	})
+
+	t.Run("Success - Unknown payment is ignored", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		m.orderRepo.On("GetOrderIDByPaymentIntentID", ctx, "pi_unknown").Return(uuid.Nil, sql.ErrNoRows).Once()
+
+		// Act
+		err := lapseService.LapsePayment(ctx, "pi_unknown")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Cancelled payment lapses its order", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		m.orderRepo.On("GetOrderIDByPaymentIntentID", ctx, "pi_abc").Return(orderID, nil).Once()
+		m.expectLapse(orderID)
+
+		// Act
+		err := lapseService.LapsePayment(ctx, "pi_abc")
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestLapseUnpaidOrders(t *testing.T) {
+	ctx := t.Context()
+	placedBefore := testNow.Add(-testOrderLapsePolicy.PaymentWindow)
+
+	t.Run("Success - Sweeps batch by batch", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		first, second, third := uuid.New(), uuid.New(), uuid.New()
+		m.orderRepo.On("ListUnpaidOrderIDs", ctx, placedBefore, 2).Return([]uuid.UUID{first, second}, nil).Once()
+		m.orderRepo.On("ListUnpaidOrderIDs", ctx, placedBefore, 2).Return([]uuid.UUID{third}, nil).Once()
+		m.expectLapse(first)
+		m.expectLapse(second)
+		m.expectLapse(third)
+
+		// Act
+		lapsed, err := lapseService.LapseUnpaidOrders(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, lapsed)
+	})
+
+	t.Run("Success - Failed orders are left for the next sweep", func(t *testing.T) {
+		// Arrange
+		lapseService, m := setupOrderLapseServiceTest(t)
+		first, second := uuid.New(), uuid.New()
+		m.orderRepo.On("ListUnpaidOrderIDs", ctx, placedBefore, 2).Return([]uuid.UUID{first, second}, nil).Once()
+		m.orderRepo.On("GetOrderByID", ctx, first).Return(nil, errors.New("db down")).Once()
+		m.orderRepo.On("GetOrderByID", ctx, second).Return(nil, errors.New("db down")).Once()
+
+		// Act
+		lapsed, err := lapseService.LapseUnpaidOrders(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, lapsed)
+	})
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+	"github.com/google/uuid"
+)
+
+type OrderPaymentService interface {
+	RetryPayment(ctx context.Context, customerID, orderID uuid.UUID, req *models.RetryPaymentRequest) (*models.RetryPaymentResponse, error)
+}
+
+// OrderPaymentPolicy caps the payment retries of an order. Once MaxRetries is reached, the order
+// cannot be retried for RetryLockout, which slows down card testing with stolen cards.
+type OrderPaymentPolicy struct {
+	MaxRetries   int
+	RetryLockout time.Duration
+}
+
+type orderPaymentService struct {
+	orderRepo    repository.OrderRepository
+	paymentRepo  repository.PaymentRepository
+	stripeClient stripe.Client
+	fraudService FraudService
+	policy       OrderPaymentPolicy
+	clock        clock.Clock
+}
+
+func NewOrderPaymentService(orderRepo repository.OrderRepository, paymentRepo repository.PaymentRepository, stripeClient stripe.Client, fraudService FraudService, policy OrderPaymentPolicy, clk clock.Clock) OrderPaymentService {
+	policy.MaxRetries = max(policy.MaxRetries, 1)
+
+	return &orderPaymentService{
+		orderRepo:    orderRepo,
+		paymentRepo:  paymentRepo,
+		stripeClient: stripeClient,
+		fraudService: fraudService,
+		policy:       policy,
+		clock:        clk,
+	}
+}
+
+// RetryPayment implements OrderPaymentService. The order is bound to a fresh payment intent before
+// the failed one is cancelled, so the cancellation webhook of the old intent no longer finds the
+// order and does not lapse it.
+func (s *orderPaymentService) RetryPayment(ctx context.Context, customerID, orderID uuid.UUID, req *models.RetryPaymentRequest) (*models.RetryPaymentResponse, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if order.CustomerID != customerID {
+		return nil, errors.ForbiddenError("You don't have permission to access this order")
+	}
+
+	if order.Status != models.OrderStatusPending {
+		return nil, errors.BadRequestError("Only the payment of a pending order can be retried")
+	}
+
+	failed, err := s.paymentFailed(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if !failed {
+		return nil, errors.BadRequestError("Only a failed payment can be retried")
+	}
+
+	state, err := s.orderRepo.GetPaymentRetryState(ctx, orderID)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to get payment retries").WithError(err)
+	}
+
+	now := s.clock.Now()
+
+	if state.LockedUntil != nil {
+		if now.Before(*state.LockedUntil) {
+			return nil, errors.TooManyRequestsError("Too many payment retries, try again after " + state.LockedUntil.Format(time.RFC3339))
+		}
+
+		state = &models.PaymentRetryState{}
+	}
+
+	payment, clientSecret, err := s.createPayment(ctx, order, req, now)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Retries++
+	if state.Retries >= s.policy.MaxRetries {
+		lockedUntil := now.Add(s.policy.RetryLockout)
+		state.LockedUntil = &lockedUntil
+	}
+
+	if err := s.orderRepo.SwapPaymentIntent(ctx, orderID, order.PaymentIntentID, payment.ID, state); err != nil {
+		s.cancelPaymentIntent(payment.ID)
+
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.BadRequestError("The order changed while its payment was retried")
+		}
+
+		return nil, errors.DatabaseError("Failed to update order payment").WithError(err)
+	}
+
+	s.cancelPaymentIntent(order.PaymentIntentID)
+
+	return &models.RetryPaymentResponse{
+		OrderID:      orderID,
+		Payment:      payment,
+		ClientSecret: clientSecret,
+		RetriesLeft:  max(s.policy.MaxRetries-state.Retries, 0),
+		LockedUntil:  state.LockedUntil,
+	}, nil
+}
+
+// paymentFailed reports whether the payment of an order failed. The webhooks settle the payment
+// record, the order only learns about it once lapsed.
+func (s *orderPaymentService) paymentFailed(ctx context.Context, order *models.Order) (bool, error) {
+	if order.PaymentStatus == models.PaymentStatusFailed {
+		return true, nil
+	}
+
+	if order.PaymentIntentID == "" {
+		return false, nil
+	}
+
+	payment, err := s.paymentRepo.GetPaymentByID(ctx, order.PaymentIntentID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, errors.DatabaseError("Failed to get order payment").WithError(err)
+	}
+
+	return payment.Status == models.PaymentStatusFailed, nil
+}
+
+// createPayment creates and records the payment intent of the full total of the order. The card is
+// checked against the velocity rules, counted once per order however many times it is retried.
+func (s *orderPaymentService) createPayment(ctx context.Context, order *models.Order, req *models.RetryPaymentRequest, now time.Time) (*models.Payment, string, error) {
+	total := models.NewMoney(order.TotalAmount, models.CatalogCurrency)
+	description := fmt.Sprintf("Payment retry for order %s", order.ID)
+
+	paymentIntent, err := s.stripeClient.CreatePaymentIntent(total.Amount, total.Currency, description, order.CustomerID.String())
+	if err != nil {
+		return nil, "", stripeError("Failed to create payment intent", err)
+	}
+
+	var (
+		subjects models.VelocitySubjects
+		flagged  []string
+	)
+
+	if req.PaymentMethod == "card" {
+		paymentMethod, err := s.stripeClient.CreatePaymentMethodFromToken(req.Token)
+		if err != nil {
+			s.cancelPaymentIntent(paymentIntent.ID)
+
+			return nil, "", stripeError("Failed to create payment method", err)
+		}
+
+		if paymentMethod.Card != nil {
+			subjects.PaymentFingerprint = paymentMethod.Card.Fingerprint
+		}
+
+		flagged, err = s.fraudService.CheckVelocity(ctx, order.ID, subjects)
+		if err != nil {
+			s.cancelPaymentIntent(paymentIntent.ID)
+
+			return nil, "", err
+		}
+
+		if err := s.stripeClient.AttachPaymentMethodToIntent(paymentMethod.ID, paymentIntent.ID); err != nil {
+			s.cancelPaymentIntent(paymentIntent.ID)
+
+			return nil, "", stripeError("Failed to attach payment method", err)
+		}
+	}
+
+	if err := s.fraudService.RecordOrder(ctx, order.ID, order.CustomerID, subjects, flagged); err != nil {
+		s.cancelPaymentIntent(paymentIntent.ID)
+
+		return nil, "", err
+	}
+
+	payment := &models.Payment{
+		ID:            paymentIntent.ID,
+		CustomerID:    order.CustomerID.String(),
+		Amount:        total.Amount,
+		Currency:      total.Currency,
+		Description:   description,
+		Status:        models.PaymentStatusPending,
+		PaymentMethod: req.PaymentMethod,
+		StripeID:      paymentIntent.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := s.paymentRepo.CreatePayment(ctx, payment); err != nil {
+		s.cancelPaymentIntent(paymentIntent.ID)
+
+		return nil, "", errors.DatabaseError("Failed to record payment").WithError(err)
+	}
+
+	return payment, paymentIntent.ClientSecret, nil
+}
+
+// cancelPaymentIntent is best effort, a failure is logged so the intent can be cancelled by hand.
+func (s *orderPaymentService) cancelPaymentIntent(paymentIntentID string) {
+	if paymentIntentID == "" {
+		return
+	}
+
+	if _, err := s.stripeClient.CancelPaymentIntent(paymentIntentID); err != nil {
+		slog.Error("Failed to cancel payment intent",
+			slog.String("paymentIntentId", paymentIntentID),
+			slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,227 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	stripeMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+var testOrderPaymentPolicy = service.OrderPaymentPolicy{MaxRetries: 3, RetryLockout: time.Hour}
+
+type orderPaymentServiceMocks struct {
+	orderRepo    *mocks.MockOrderRepository
+	paymentRepo  *mocks.MockPaymentRepository
+	stripeClient *stripeMocks.MockClient
+	fraud        *svcMocks.MockFraudService
+}
+
+func setupOrderPaymentServiceTest(t *testing.T) (service.OrderPaymentService, *orderPaymentServiceMocks) {
+	m := &orderPaymentServiceMocks{
+		orderRepo:    mocks.NewMockOrderRepository(t),
+		paymentRepo:  mocks.NewMockPaymentRepository(t),
+		stripeClient: stripeMocks.NewMockClient(t),
+		fraud:        permissiveFraudService(t),
+	}
+
+	return service.NewOrderPaymentService(m.orderRepo, m.paymentRepo, m.stripeClient, m.fraud, testOrderPaymentPolicy, clock.NewFake(testNow)), m
+}
+
+func TestRetryPayment(t *testing.T) {
+	ctx := t.Context()
+	customerID := uuid.New()
+	req := &models.RetryPaymentRequest{PaymentMethod: "card", Token: "pm_new"}
+
+	failedOrder := func() *models.Order {
+		return &models.Order{
+			ID:              uuid.New(),
+			CustomerID:      customerID,
+			Status:          models.OrderStatusPending,
+			TotalAmount:     24.5,
+			PaymentStatus:   models.PaymentStatusPending,
+			PaymentIntentID: "pi_failed",
+		}
+	}
+
+	// expectNewIntent sets up the fresh payment intent of the order, paid with a card.
+	expectNewIntent := func(m *orderPaymentServiceMocks, order *models.Order) {
+		m.paymentRepo.On("GetPaymentByID", ctx, "pi_failed").Return(&models.Payment{ID: "pi_failed", Status: models.PaymentStatusFailed}, nil).Once()
+		m.stripeClient.On("CreatePaymentIntent", int64(2450), models.CatalogCurrency, "Payment retry for order "+order.ID.String(), customerID.String()).
+			Return(&stripe.PaymentIntent{ID: "pi_retry", ClientSecret: "pi_retry_secret"}, nil).Once()
+		m.stripeClient.On("CreatePaymentMethodFromToken", "pm_new").Return(&stripe.PaymentMethod{ID: "pm_new"}, nil).Once()
+		m.stripeClient.On("AttachPaymentMethodToIntent", "pm_new", "pi_retry").Return(nil).Once()
+		m.paymentRepo.On("CreatePayment", ctx, mock.MatchedBy(func(p *models.Payment) bool {
+			return p.ID == "pi_retry" && p.Amount == 2450 && p.Status == models.PaymentStatusPending
+		})).Return(nil).Once()
+	}
+
+	t.Run("Success - Fresh intent replaces the failed one", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.orderRepo.On("GetPaymentRetryState", ctx, order.ID).Return(&models.PaymentRetryState{Retries: 1}, nil).Once()
+		expectNewIntent(m, order)
+		m.orderRepo.On("SwapPaymentIntent", ctx, order.ID, "pi_failed", "pi_retry", &models.PaymentRetryState{Retries: 2}).Return(nil).Once()
+		m.stripeClient.On("CancelPaymentIntent", "pi_failed").Return(&stripe.PaymentIntent{ID: "pi_failed"}, nil).Once()
+
+		// Act
+		result, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "pi_retry", result.Payment.ID)
+		assert.Equal(t, "pi_retry_secret", result.ClientSecret)
+		assert.Equal(t, 1, result.RetriesLeft)
+		assert.Nil(t, result.LockedUntil)
+	})
+
+	t.Run("Failure - Card used on too many orders", func(t *testing.T) {
+		// Arrange
+		_, m := setupOrderPaymentServiceTest(t)
+		mockFraudService := svcMocks.NewMockFraudService(t)
+		orderPaymentService := service.NewOrderPaymentService(m.orderRepo, m.paymentRepo, m.stripeClient, mockFraudService, testOrderPaymentPolicy, clock.NewFake(testNow))
+		order := failedOrder()
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.orderRepo.On("GetPaymentRetryState", ctx, order.ID).Return(&models.PaymentRetryState{}, nil).Once()
+		m.paymentRepo.On("GetPaymentByID", ctx, "pi_failed").Return(&models.Payment{ID: "pi_failed", Status: models.PaymentStatusFailed}, nil).Once()
+		m.stripeClient.On("CreatePaymentIntent", int64(2450), models.CatalogCurrency, mock.Anything, customerID.String()).
+			Return(&stripe.PaymentIntent{ID: "pi_retry"}, nil).Once()
+		m.stripeClient.On("CreatePaymentMethodFromToken", "pm_new").
+			Return(&stripe.PaymentMethod{ID: "pm_new", Card: &stripe.PaymentMethodCard{Fingerprint: "fp_shared"}}, nil).Once()
+		mockFraudService.On("CheckVelocity", ctx, order.ID, models.VelocitySubjects{PaymentFingerprint: "fp_shared"}).
+			Return(nil, appErrors.OrderVelocityError()).Once()
+		m.stripeClient.On("CancelPaymentIntent", "pi_retry").Return(&stripe.PaymentIntent{ID: "pi_retry"}, nil).Once()
+
+		// Act
+		result, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		assert.Nil(t, result)
+		assertAppErrorCode(t, err, appErrors.ErrCodeOrderVelocity)
+		m.paymentRepo.AssertNotCalled(t, "CreatePayment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Last retry locks further retries out", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		lockedUntil := testNow.Add(time.Hour)
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.orderRepo.On("GetPaymentRetryState", ctx, order.ID).Return(&models.PaymentRetryState{Retries: 2}, nil).Once()
+		expectNewIntent(m, order)
+		m.orderRepo.On("SwapPaymentIntent", ctx, order.ID, "pi_failed", "pi_retry", &models.PaymentRetryState{Retries: 3, LockedUntil: &lockedUntil}).Return(nil).Once()
+		m.stripeClient.On("CancelPaymentIntent", "pi_failed").Return(&stripe.PaymentIntent{ID: "pi_failed"}, nil).Once()
+
+		// Act
+		result, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, result.RetriesLeft)
+		assert.Equal(t, &lockedUntil, result.LockedUntil)
+	})
+
+	t.Run("Success - Retries start over once the lockout ended", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		lockedUntil := testNow.Add(-time.Minute)
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.orderRepo.On("GetPaymentRetryState", ctx, order.ID).Return(&models.PaymentRetryState{Retries: 3, LockedUntil: &lockedUntil}, nil).Once()
+		expectNewIntent(m, order)
+		m.orderRepo.On("SwapPaymentIntent", ctx, order.ID, "pi_failed", "pi_retry", &models.PaymentRetryState{Retries: 1}).Return(nil).Once()
+		m.stripeClient.On("CancelPaymentIntent", "pi_failed").Return(nil, errors.New("stripe down")).Once()
+
+		// Act
+		result, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.RetriesLeft)
+	})
+
+	t.Run("Failure - Locked out", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		lockedUntil := testNow.Add(30 * time.Minute)
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.paymentRepo.On("GetPaymentByID", ctx, "pi_failed").Return(&models.Payment{ID: "pi_failed", Status: models.PaymentStatusFailed}, nil).Once()
+		m.orderRepo.On("GetPaymentRetryState", ctx, order.ID).Return(&models.PaymentRetryState{Retries: 3, LockedUntil: &lockedUntil}, nil).Once()
+
+		// Act
+		_, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusTooManyRequests, appErr.StatusCode)
+		m.stripeClient.AssertNotCalled(t, "CreatePaymentIntent")
+	})
+
+	t.Run("Failure - Payment did not fail", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.paymentRepo.On("GetPaymentByID", ctx, "pi_failed").Return(&models.Payment{ID: "pi_failed", Status: models.PaymentStatusPending}, nil).Once()
+
+		// Act
+		_, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Order of another customer", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+
+		// Act
+		_, err := orderPaymentService.RetryPayment(ctx, uuid.New(), order.ID, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeForbidden, appErr.Code)
+	})
+
+	t.Run("Failure - Order changed meanwhile cancels the fresh intent", func(t *testing.T) {
+		// Arrange
+		orderPaymentService, m := setupOrderPaymentServiceTest(t)
+		order := failedOrder()
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.orderRepo.On("GetPaymentRetryState", ctx, order.ID).Return(&models.PaymentRetryState{}, nil).Once()
+		expectNewIntent(m, order)
+		m.orderRepo.On("SwapPaymentIntent", ctx, order.ID, "pi_failed", "pi_retry", mock.Anything).Return(sql.ErrNoRows).Once()
+		m.stripeClient.On("CancelPaymentIntent", "pi_retry").Return(&stripe.PaymentIntent{ID: "pi_retry"}, nil).Once()
+
+		// Act
+		_, err := orderPaymentService.RetryPayment(ctx, customerID, order.ID, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		m.stripeClient.AssertNotCalled(t, "CancelPaymentIntent", "pi_failed")
+	})
+}
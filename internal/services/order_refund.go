@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+	"github.com/google/uuid"
+)
+
+// OrderRefundService refunds units of the items of paid orders, allocating the refund to the lines.
+type OrderRefundService interface {
+	RefundOrder(ctx context.Context, adminID, orderID uuid.UUID, req *models.RefundOrderRequest) (*models.OrderRefund, error)
+	ListOrderRefunds(ctx context.Context, orderID uuid.UUID) ([]models.OrderRefund, error)
+}
+
+type orderRefundService struct {
+	orderRepo       repository.OrderRepository
+	refundRepo      repository.OrderRefundRepository
+	auditRepo       repository.AuditRepository
+	ledgerService   LedgerService
+	documentService OrderDocumentService
+	stripeClient    stripe.Client
+}
+
+func NewOrderRefundService(orderRepo repository.OrderRepository, refundRepo repository.OrderRefundRepository, auditRepo repository.AuditRepository, ledgerService LedgerService, documentService OrderDocumentService, stripeClient stripe.Client) OrderRefundService {
+	return &orderRefundService{
+		orderRepo:       orderRepo,
+		refundRepo:      refundRepo,
+		auditRepo:       auditRepo,
+		ledgerService:   ledgerService,
+		documentService: documentService,
+		stripeClient:    stripeClient,
+	}
+}
+
+// RefundOrder implements OrderRefundService. The refunded units are reserved and the refund recorded
+// in the ledger before the payment provider is asked, like a vendor payout, and both are given back
+// when it refuses. Once the money is sent back, failing to save the outcome or to issue its credit
+// note is only logged.
+func (s *orderRefundService) RefundOrder(ctx context.Context, adminID, orderID uuid.UUID, req *models.RefundOrderRequest) (*models.OrderRefund, error) {
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if order.PaymentIntentID == "" || (order.PaymentStatus != models.PaymentStatusSucceeded && order.PaymentStatus != models.PaymentStatusRefunded) {
+		return nil, errors.BadRequestError("Only paid orders can be refunded")
+	}
+
+	previous, err := s.refundRepo.ListOrderRefunds(ctx, orderID)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to list order refunds").WithError(err)
+	}
+
+	var refunded int64
+
+	for _, refund := range previous {
+		if refund.Status != models.OrderRefundFailed {
+			refunded += refund.Amount
+		}
+	}
+
+	lines, err := allocateRefund(order, req.Lines, refunded)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := &models.OrderRefund{
+		ID:        uuid.New(),
+		OrderID:   order.ID,
+		Status:    models.OrderRefundPending,
+		Currency:  models.CatalogCurrency,
+		Reason:    req.Reason,
+		Lines:     lines,
+		CreatedBy: adminID,
+	}
+
+	for _, line := range lines {
+		refund.Amount += line.Amount
+	}
+
+	if err := s.refundRepo.CreateOrderRefund(ctx, refund); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.BadRequestError("Items already refunded")
+		}
+
+		return nil, errors.DatabaseError("Failed to create order refund").WithError(err)
+	}
+
+	if err := s.ledgerService.RecordOrderRefund(ctx, order.PaymentIntentID, refund); err != nil {
+		s.release(ctx, refund)
+
+		return nil, err
+	}
+
+	stripeRefund, err := s.stripeClient.RefundPayment(order.PaymentIntentID, refund.Amount)
+	if err != nil {
+		s.release(ctx, refund)
+
+		if err := s.ledgerService.ReverseOrderRefund(ctx, order.PaymentIntentID, refund); err != nil {
+			slog.Error("Failed to reverse a refused refund in the ledger", slog.String("refundId", refund.ID.String()), slog.String("error", err.Error()))
+		}
+
+		return nil, errors.ThirdPartyError("Failed to refund the order").WithError(err)
+	}
+
+	refund.Status = models.OrderRefundSucceeded
+	refund.RefundID = stripeRefund.ID
+
+	if err := s.refundRepo.CompleteOrderRefund(ctx, refund.ID, stripeRefund.ID); err != nil {
+		slog.Error("Failed to complete order refund", slog.String("refundId", refund.ID.String()),
+			slog.String("stripeRefundId", stripeRefund.ID), slog.String("error", err.Error()))
+	}
+
+	if _, err := s.documentService.IssueRefundCreditNote(ctx, refund); err != nil {
+		slog.Error("Failed to issue credit note of order refund", slog.String("refundId", refund.ID.String()), slog.String("error", err.Error()))
+	}
+
+	s.recordRefund(ctx, adminID, order, refund)
+
+	return refund, nil
+}
+
+// allocateRefund prices the lines of a refund. An item gives back its unit price for every unit, plus
+// its share of the charges beyond the items in proportion to its value. The refund that leaves no unit
+// to refund also gives back what the rounding of the shares kept, so the order is refunded its total.
+func allocateRefund(order *models.Order, requested []models.RefundOrderLine, refunded int64) ([]models.OrderRefundLine, error) {
+	items := make(map[uuid.UUID]models.OrderItem, len(order.Items))
+
+	var subtotal int64
+
+	left := 0
+
+	for _, item := range order.Items {
+		items[item.ID] = item
+		subtotal += models.NewMoney(item.UnitPrice, models.CatalogCurrency).Amount * int64(item.Quantity)
+		left += item.Quantity - item.RefundedQuantity
+	}
+
+	total := models.NewMoney(order.TotalAmount, models.CatalogCurrency).Amount
+	adjustments := max(total-subtotal, 0)
+
+	lines := make([]models.OrderRefundLine, 0, len(requested))
+	seen := make(map[uuid.UUID]bool, len(requested))
+
+	var amount int64
+
+	for _, line := range requested {
+		if seen[line.OrderItemID] {
+			return nil, errors.BadRequestError("Item refunded twice: " + line.OrderItemID.String())
+		}
+
+		seen[line.OrderItemID] = true
+
+		item, ok := items[line.OrderItemID]
+		if !ok {
+			return nil, errors.BadRequestError("Item not found in order")
+		}
+
+		if remaining := item.Quantity - item.RefundedQuantity; line.Quantity > remaining {
+			return nil, errors.BadRequestError(fmt.Sprintf("Only %d units left to refund of item %s", remaining, item.ID))
+		}
+
+		refundLine := models.OrderRefundLine{
+			OrderItemID: item.ID,
+			Quantity:    line.Quantity,
+			ItemAmount:  models.NewMoney(item.UnitPrice, models.CatalogCurrency).Amount * int64(line.Quantity),
+		}
+
+		if subtotal > 0 {
+			refundLine.AdjustmentAmount = adjustments * refundLine.ItemAmount / subtotal
+		}
+
+		refundLine.Amount = refundLine.ItemAmount + refundLine.AdjustmentAmount
+		amount += refundLine.Amount
+		left -= line.Quantity
+		lines = append(lines, refundLine)
+	}
+
+	if refunded+amount > total {
+		return nil, errors.BadRequestError("Refund exceeds what is left of the order total")
+	}
+
+	if rest := total - refunded - amount; left == 0 && rest > 0 {
+		last := &lines[len(lines)-1]
+		last.AdjustmentAmount += rest
+		last.Amount += rest
+	}
+
+	return lines, nil
+}
+
+// release gives the units of a refund that did not go through back to the items.
+func (s *orderRefundService) release(ctx context.Context, refund *models.OrderRefund) {
+	if err := s.refundRepo.FailOrderRefund(ctx, refund); err != nil {
+		slog.Error("Failed to release the units of a failed refund", slog.String("refundId", refund.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+// recordRefund adds the refund to the audit log of the customer, the money is already back so a
+// failure is only logged.
+func (s *orderRefundService) recordRefund(ctx context.Context, adminID uuid.UUID, order *models.Order, refund *models.OrderRefund) {
+	raw, err := json.Marshal(refund)
+	if err != nil {
+		slog.Error("Failed to encode order refund", slog.String("refundId", refund.ID.String()), slog.String("error", err.Error()))
+
+		return
+	}
+
+	entry := &models.AuditEntry{
+		ID:          uuid.New(),
+		UserID:      order.CustomerID,
+		ActorID:     &adminID,
+		Action:      models.AuditActionOrderRefunded,
+		ReferenceID: &order.ID,
+		Details:     raw,
+	}
+
+	if err := s.auditRepo.RecordEntry(ctx, entry); err != nil {
+		slog.Error("Failed to record order refund", slog.String("refundId", refund.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+func (s *orderRefundService) ListOrderRefunds(ctx context.Context, orderID uuid.UUID) ([]models.OrderRefund, error) {
+	if _, err := s.orderRepo.GetOrderByID(ctx, orderID); err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	refunds, err := s.refundRepo.ListOrderRefunds(ctx, orderID)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to list order refunds").WithError(err)
+	}
+
+	return refunds, nil
+}
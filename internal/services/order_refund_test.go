@@ -0,0 +1,244 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	stripeMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+type orderRefundMocks struct {
+	orderRepo       *mocks.MockOrderRepository
+	refundRepo      *mocks.MockOrderRefundRepository
+	auditRepo       *mocks.MockAuditRepository
+	ledgerService   *svcMocks.MockLedgerService
+	documentService *svcMocks.MockOrderDocumentService
+	stripeClient    *stripeMocks.MockClient
+}
+
+func setupOrderRefundServiceTest(t *testing.T) (service.OrderRefundService, *orderRefundMocks) {
+	m := &orderRefundMocks{
+		orderRepo:       mocks.NewMockOrderRepository(t),
+		refundRepo:      mocks.NewMockOrderRefundRepository(t),
+		auditRepo:       mocks.NewMockAuditRepository(t),
+		ledgerService:   svcMocks.NewMockLedgerService(t),
+		documentService: svcMocks.NewMockOrderDocumentService(t),
+		stripeClient:    stripeMocks.NewMockClient(t),
+	}
+
+	return service.NewOrderRefundService(m.orderRepo, m.refundRepo, m.auditRepo, m.ledgerService, m.documentService, m.stripeClient), m
+}
+
+func TestRefundOrder(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	anyRefund := mock.AnythingOfType("*models.OrderRefund")
+
+	t.Run("Success - Gift wrap is refunded in proportion to the items", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		mug := order.Items[0]
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: mug.ID, Quantity: 1}}, Reason: "Chipped"}
+
+		// 1 of the 4 units of value is refunded, so a quarter of the 4.50 gift wrap, rounded down
+		expectedLine := models.OrderRefundLine{OrderItemID: mug.ID, Quantity: 1, ItemAmount: 1000, AdjustmentAmount: 112, Amount: 1112}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, order.ID).Return([]models.OrderRefund{}, nil).Once()
+		m.refundRepo.On("CreateOrderRefund", ctx, mock.MatchedBy(func(r *models.OrderRefund) bool {
+			return r.Status == models.OrderRefundPending && r.Amount == 1112 && r.Currency == models.CatalogCurrency &&
+				len(r.Lines) == 1 && r.Lines[0] == expectedLine && r.CreatedBy == adminID
+		})).Return(nil).Once()
+		m.ledgerService.On("RecordOrderRefund", ctx, "pi_order", anyRefund).Return(nil).Once()
+		m.stripeClient.On("RefundPayment", "pi_order", int64(1112)).Return(&stripe.Refund{ID: "re_1"}, nil).Once()
+		m.refundRepo.On("CompleteOrderRefund", ctx, mock.AnythingOfType("uuid.UUID"), "re_1").Return(nil).Once()
+		m.documentService.On("IssueRefundCreditNote", ctx, mock.MatchedBy(func(r *models.OrderRefund) bool {
+			return r.RefundID == "re_1" && r.Status == models.OrderRefundSucceeded
+		})).Return(&models.OrderDocument{Number: "CN-000001"}, nil).Once()
+		m.auditRepo.On("RecordEntry", ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.Action == models.AuditActionOrderRefunded && e.UserID == order.CustomerID && *e.ActorID == adminID && *e.ReferenceID == order.ID
+		})).Return(nil).Once()
+
+		// Act
+		refund, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderRefundSucceeded, refund.Status)
+		assert.Equal(t, "re_1", refund.RefundID)
+		assert.Equal(t, []models.OrderRefundLine{expectedLine}, refund.Lines)
+	})
+
+	t.Run("Success - Last units give back what the rounding kept", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		order.Items[0].RefundedQuantity = 1
+		mug, poster := order.Items[0], order.Items[1]
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: mug.ID, Quantity: 1}, {OrderItemID: poster.ID, Quantity: 1}}}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, order.ID).Return([]models.OrderRefund{
+			{Status: models.OrderRefundSucceeded, Amount: 1112},
+			{Status: models.OrderRefundFailed, Amount: 3000},
+		}, nil).Once()
+		m.refundRepo.On("CreateOrderRefund", ctx, anyRefund).Return(nil).Once()
+		m.ledgerService.On("RecordOrderRefund", ctx, "pi_order", anyRefund).Return(nil).Once()
+		m.stripeClient.On("RefundPayment", "pi_order", int64(3338)).Return(&stripe.Refund{ID: "re_2"}, nil).Once()
+		m.refundRepo.On("CompleteOrderRefund", ctx, mock.AnythingOfType("uuid.UUID"), "re_2").Return(nil).Once()
+		m.documentService.On("IssueRefundCreditNote", ctx, anyRefund).Return(nil, errors.New("db error")).Once()
+		m.auditRepo.On("RecordEntry", ctx, mock.AnythingOfType("*models.AuditEntry")).Return(nil).Once()
+
+		// Act
+		refund, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(3338), refund.Amount)
+		assert.Equal(t, int64(1112), refund.Lines[0].Amount)
+		assert.Equal(t, int64(226), refund.Lines[1].AdjustmentAmount)
+		assert.Equal(t, int64(2226), refund.Lines[1].Amount)
+	})
+
+	t.Run("Failure - More units than left to refund", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		order.Items[0].RefundedQuantity = 1
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: order.Items[0].ID, Quantity: 2}}}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, order.ID).Return([]models.OrderRefund{}, nil).Once()
+
+		// Act
+		refund, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		assert.Nil(t, refund)
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+		m.refundRepo.AssertNotCalled(t, "CreateOrderRefund")
+	})
+
+	t.Run("Failure - Item of another order", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: uuid.New(), Quantity: 1}}}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, order.ID).Return([]models.OrderRefund{}, nil).Once()
+
+		// Act
+		_, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+
+	t.Run("Failure - Order not paid", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		order.PaymentStatus = models.PaymentStatusPending
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: order.Items[0].ID, Quantity: 1}}}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+
+		// Act
+		_, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+	})
+
+	t.Run("Failure - Units refunded concurrently", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: order.Items[1].ID, Quantity: 1}}}
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, order.ID).Return([]models.OrderRefund{}, nil).Once()
+		m.refundRepo.On("CreateOrderRefund", ctx, anyRefund).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+		m.stripeClient.AssertNotCalled(t, "RefundPayment")
+	})
+
+	t.Run("Failure - Refused refund releases the units and reverses the ledger", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		order := paidOrder(uuid.New(), uuid.New())
+		req := &models.RefundOrderRequest{Lines: []models.RefundOrderLine{{OrderItemID: order.Items[1].ID, Quantity: 1}}}
+		stripeErr := errors.New("charge already refunded")
+
+		m.orderRepo.On("GetOrderByID", ctx, order.ID).Return(order, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, order.ID).Return([]models.OrderRefund{}, nil).Once()
+		m.refundRepo.On("CreateOrderRefund", ctx, anyRefund).Return(nil).Once()
+		m.ledgerService.On("RecordOrderRefund", ctx, "pi_order", anyRefund).Return(nil).Once()
+		m.stripeClient.On("RefundPayment", "pi_order", int64(2225)).Return(nil, stripeErr).Once()
+		m.refundRepo.On("FailOrderRefund", ctx, anyRefund).Return(nil).Once()
+		m.ledgerService.On("ReverseOrderRefund", ctx, "pi_order", anyRefund).Return(nil).Once()
+
+		// Act
+		refund, err := refundService.RefundOrder(ctx, adminID, order.ID, req)
+
+		// Assert
+		assert.Nil(t, refund)
+		assertAppErrorCode(t, err, appErrors.ErrCodeThirdPartyError)
+		assert.ErrorIs(t, err, stripeErr)
+		m.refundRepo.AssertNotCalled(t, "CompleteOrderRefund")
+		m.documentService.AssertNotCalled(t, "IssueRefundCreditNote")
+	})
+}
+
+func TestListOrderRefunds(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		orderID := uuid.New()
+		refunds := []models.OrderRefund{{ID: uuid.New(), OrderID: orderID, Status: models.OrderRefundSucceeded, Amount: 1112}}
+
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID}, nil).Once()
+		m.refundRepo.On("ListOrderRefunds", ctx, orderID).Return(refunds, nil).Once()
+
+		// Act
+		result, err := refundService.ListOrderRefunds(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, refunds, result)
+	})
+
+	t.Run("Failure - Order not found", func(t *testing.T) {
+		// Arrange
+		refundService, m := setupOrderRefundServiceTest(t)
+		orderID := uuid.New()
+
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := refundService.ListOrderRefunds(ctx, orderID)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+}
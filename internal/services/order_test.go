@@ -1,31 +1,73 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-func setupOrderServiceTest(t *testing.T) (service.OrderService, *mocks.MockOrderRepository, *mocks.MockCartRepository, *mocks.MockProductRepository) {
+const testGiftWrapFee = 4.5
+
+var testOrderPolicy = service.OrderPolicy{GiftWrapFee: testGiftWrapFee, BulkStatusMaxOrders: 4, BulkStatusBatchSize: 2, PickWaveMaxOrders: 3}
+
+// testNow is the frozen time of the fake clock handed to the services under test.
+var testNow = time.Date(2025, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+// permissiveShippingService ships every order, the shipping restrictions are covered by their own tests.
+func permissiveShippingService(t *testing.T) *svcMocks.MockShippingService {
+	mockShippingService := svcMocks.NewMockShippingService(t)
+	mockShippingService.On("CheckOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return mockShippingService
+}
+
+// permissiveCampaignService lets every checkout take its units, the allocations are covered by their own tests.
+func permissiveCampaignService(t *testing.T) *svcMocks.MockCampaignService {
+	mockCampaignService := svcMocks.NewMockCampaignService(t)
+	mockCampaignService.On("ClaimStock", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCampaignService.On("ReleaseStock", mock.Anything, mock.Anything).Return().Maybe()
+
+	return mockCampaignService
+}
+
+// permissiveFraudService lets every order through and holds none, the velocity rules are covered by their own tests.
+func permissiveFraudService(t *testing.T) *svcMocks.MockFraudService {
+	mockFraudService := svcMocks.NewMockFraudService(t)
+	mockFraudService.On("CheckVelocity", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	mockFraudService.On("RecordOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockFraudService.On("RecordDevice", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockFraudService.On("HeldOrders", mock.Anything, mock.Anything).Return(map[uuid.UUID]bool{}, nil).Maybe()
+
+	return mockFraudService
+}
+
+func setupOrderServiceTest(t *testing.T) (service.OrderService, *mocks.MockOrderRepository, *mocks.MockCartRepository, *mocks.MockProductRepository, *mocks.MockInventoryRepository, *svcMocks.MockWarehouseService, *mocks.MockPickupRepository) {
 	mockOrderRepo := mocks.NewMockOrderRepository(t)
 	mockCartRepo := mocks.NewMockCartRepository(t)
 	mockProductRepo := mocks.NewMockProductRepository(t)
-	orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockWarehouseService := svcMocks.NewMockWarehouseService(t)
+	mockPickupRepo := mocks.NewMockPickupRepository(t)
+	orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, permissiveShippingService(t), permissiveCampaignService(t), permissiveFraudService(t), mockPickupRepo, mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
 
-	return orderService, mockOrderRepo, mockCartRepo, mockProductRepo
+	return orderService, mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, mockPickupRepo
 }
 
 func TestCreateOrder_Success(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, mockCartRepo, mockProductRepo := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New()
@@ -42,8 +84,8 @@ func TestCreateOrder_Success(t *testing.T) {
 	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil).Once()
 
 	// Mock Call Product Repository
-	mockProduct1 := &models.Product{ID: productID1, StockQuantity: 10, Price: 50.0}
-	mockProduct2 := &models.Product{ID: productID2, StockQuantity: 5, Price: 100.0}
+	mockProduct1 := &models.Product{ID: productID1, Status: models.ProductStatusActive, StockQuantity: 10, Price: 50.0}
+	mockProduct2 := &models.Product{ID: productID2, Status: models.ProductStatusActive, StockQuantity: 5, Price: 100.0}
 
 	mockProductRepo.On("GetProductByID", ctx, productID1).Return(mockProduct1, nil).Once()
 	mockProductRepo.On("GetProductByID", ctx, productID2).Return(mockProduct2, nil).Once()
@@ -68,13 +110,24 @@ func TestCreateOrder_Success(t *testing.T) {
 	mockProductRepo.On("UpdateProduct", ctx, mock.MatchedBy(func(p *models.Product) bool { return p.ID == productID1 && p.StockQuantity == 8 })).Return(nil).Once() // 10 - 2 = 8
 	mockProductRepo.On("UpdateProduct", ctx, mock.MatchedBy(func(p *models.Product) bool { return p.ID == productID2 && p.StockQuantity == 4 })).Return(nil).Once() // 5 - 1 = 4
 
+	// Mock Call Inventory Repository
+	mockInventoryRepo.On("RecordMovement", ctx, mock.MatchedBy(func(m *models.StockMovement) bool {
+		return m.ProductID == productID1 && m.Quantity == 2 && m.Reason == models.StockMovementOrder
+	})).Return(nil).Once()
+	mockInventoryRepo.On("RecordMovement", ctx, mock.MatchedBy(func(m *models.StockMovement) bool {
+		return m.ProductID == productID2 && m.Quantity == 1 && m.Reason == models.StockMovementOrder
+	})).Return(nil).Once()
+
+	// Mock Call Warehouse Service
+	mockWarehouseService.On("AllocateOrder", ctx, mock.AnythingOfType("*models.Order")).Return([]*models.StockAllocation{}, nil).Once()
+
 	req := &models.CreateOrderRequest{
 		CustomerID: customerID,
 		Items: []models.OrderItem{
 			{ProductID: productID1, Quantity: 2, UnitPrice: 50.0},
 			{ProductID: productID2, Quantity: 1, UnitPrice: 100.0},
 		},
-		ShippingAddress: models.Address{
+		ShippingAddress: &models.Address{
 			Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "USA",
 		},
 	}
@@ -93,11 +146,88 @@ func TestCreateOrder_Success(t *testing.T) {
 	mockCartRepo.AssertExpectations(t)
 	mockProductRepo.AssertExpectations(t)
 	mockOrderRepo.AssertExpectations(t)
+	mockInventoryRepo.AssertExpectations(t)
+}
+
+func TestCreateOrder_Pickup(t *testing.T) {
+	// Arrange
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, mockPickupRepo := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+	locationID := uuid.New()
+	storeAddress := models.Address{Street: "1 Store Rd", City: "Anytown", PostalCode: "12345", Country: "USA"}
+
+	mockCart := &models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+	}
+	mockProduct := &models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 3, Price: 20.0}
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(mockProduct, nil).Twice()
+	mockPickupRepo.On("GetLocationByID", ctx, locationID).Return(&models.PickupLocation{ID: locationID, Address: storeAddress, Active: true}, nil).Once()
+	mockOrderRepo.On("CreateOrder", ctx, mock.MatchedBy(func(o *models.Order) bool {
+		return o.FulfillmentType == models.FulfillmentPickup && *o.PickupLocationID == locationID && *o.ShippingAddress == storeAddress
+	})).Return(nil).Once()
+	mockProductRepo.On("UpdateProduct", ctx, mock.Anything).Return(nil).Once()
+	mockInventoryRepo.On("RecordMovement", ctx, mock.Anything).Return(nil).Once()
+	mockWarehouseService.On("AllocateOrder", ctx, mock.AnythingOfType("*models.Order")).Return([]*models.StockAllocation{}, nil).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID:       customerID,
+		Items:            []models.OrderItem{{ProductID: productID, Quantity: 1, UnitPrice: 20.0}},
+		FulfillmentType:  models.FulfillmentPickup,
+		PickupLocationID: &locationID,
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.FulfillmentPickup, order.FulfillmentType)
+	assert.Equal(t, storeAddress, *order.ShippingAddress)
+}
+
+func TestCreateOrder_PickupLocationInactive(t *testing.T) {
+	// Arrange
+	orderService, _, mockCartRepo, mockProductRepo, _, _, mockPickupRepo := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+	locationID := uuid.New()
+
+	mockCart := &models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+	}
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 3}, nil).Once()
+	mockPickupRepo.On("GetLocationByID", ctx, locationID).Return(&models.PickupLocation{ID: locationID, Active: false}, nil).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID:       customerID,
+		Items:            []models.OrderItem{{ProductID: productID, Quantity: 1}},
+		FulfillmentType:  models.FulfillmentPickup,
+		PickupLocationID: &locationID,
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.Nil(t, order)
+
+	appErr, ok := err.(*appErrors.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
 }
 
 func TestCreateOrder_CartNotFound(t *testing.T) {
 	// Arrange
-	orderService, _, mockCartRepo, _ := setupOrderServiceTest(t)
+	orderService, _, mockCartRepo, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 
@@ -125,7 +255,7 @@ func TestCreateOrder_CartNotFound(t *testing.T) {
 
 func TestCreateOrder_EmptyCart(t *testing.T) {
 	// Arrange
-	orderService, _, mockCartRepo, _ := setupOrderServiceTest(t)
+	orderService, _, mockCartRepo, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 
@@ -152,7 +282,7 @@ func TestCreateOrder_EmptyCart(t *testing.T) {
 
 func TestCreateOrder_ProductNotFound(t *testing.T) {
 	// Arrange
-	orderService, _, mockCartRepo, mockProductRepo := setupOrderServiceTest(t)
+	orderService, _, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New() // Product that exists
@@ -169,7 +299,7 @@ func TestCreateOrder_ProductNotFound(t *testing.T) {
 	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil)
 
 	// Mock Call Product Repository
-	mockProduct1 := &models.Product{ID: productID1, StockQuantity: 10}
+	mockProduct1 := &models.Product{ID: productID1, Status: models.ProductStatusActive, StockQuantity: 10}
 	mockProductRepo.On("GetProductByID", ctx, productID1).Return(mockProduct1, nil).Once()
 
 	mockErr := errors.New("mock product repo error")
@@ -196,7 +326,7 @@ func TestCreateOrder_ProductNotFound(t *testing.T) {
 
 func TestCreateOrder_InsufficientStock(t *testing.T) {
 	// Arrange
-	orderService, _, mockCartRepo, mockProductRepo := setupOrderServiceTest(t)
+	orderService, _, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New()
@@ -210,7 +340,7 @@ func TestCreateOrder_InsufficientStock(t *testing.T) {
 	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil)
 
 	// Mock Call Product Repository
-	mockProduct1 := &models.Product{ID: productID1, StockQuantity: 3} // Only 3 in stock
+	mockProduct1 := &models.Product{ID: productID1, Status: models.ProductStatusActive, StockQuantity: 3} // Only 3 in stock
 	mockProductRepo.On("GetProductByID", ctx, productID1).Return(mockProduct1, nil).Once()
 
 	req := &models.CreateOrderRequest{CustomerID: customerID}
@@ -224,16 +354,325 @@ func TestCreateOrder_InsufficientStock(t *testing.T) {
 
 	appErr, ok := err.(*appErrors.AppError)
 	assert.True(t, ok)
-	assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	assert.Equal(t, appErrors.ErrCodeInsufficientStock, appErr.Code)
 	assert.Contains(t, appErr.Error(), "Insufficient stock for product: "+productID1.String())
 
+	var stockErr *appErrors.ErrInsufficientStock
+	assert.ErrorAs(t, err, &stockErr)
+	assert.Equal(t, &appErrors.ErrInsufficientStock{ProductID: productID1, Available: 3}, stockErr)
+
 	mockCartRepo.AssertExpectations(t)
 	mockProductRepo.AssertExpectations(t)
 }
 
+func TestCreateOrder_PriceChanged(t *testing.T) {
+	// Arrange
+	orderService, _, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(&models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+	}, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 12.5}, nil).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      []models.OrderItem{{ProductID: productID, Quantity: 1, UnitPrice: 10.0}},
+		ShippingAddress: &models.Address{
+			Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "USA",
+		},
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.Nil(t, order)
+
+	appErr, ok := appErrors.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, appErrors.ErrCodePriceChanged, appErr.Code)
+	assert.Equal(t, &appErrors.ErrPriceChanged{ProductID: productID, QuotedPrice: 10.0, CurrentPrice: 12.5}, appErr.Meta)
+}
+
+func TestCreateOrder_ItemsMustMatchCart(t *testing.T) {
+	productID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name  string
+		items []models.OrderItem
+	}{
+		{name: "Product not in the cart", items: []models.OrderItem{
+			{ProductID: productID, Quantity: 2, UnitPrice: 12.5},
+			{ProductID: otherID, Quantity: 1, UnitPrice: 0.01},
+		}},
+		{name: "Other quantity than the cart", items: []models.OrderItem{{ProductID: productID, Quantity: 5, UnitPrice: 12.5}}},
+		{name: "Product listed twice", items: []models.OrderItem{
+			{ProductID: productID, Quantity: 2, UnitPrice: 12.5},
+			{ProductID: productID, Quantity: 2, UnitPrice: 12.5},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run("Failure - "+tt.name, func(t *testing.T) {
+			// Arrange
+			orderService, _, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
+			ctx := t.Context()
+			customerID := uuid.New()
+
+			mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(&models.Cart{
+				UserID: customerID,
+				Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 2}},
+			}, nil).Once()
+			mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 12.5}, nil).Once()
+
+			req := &models.CreateOrderRequest{
+				CustomerID:      customerID,
+				Items:           tt.items,
+				ShippingAddress: &models.Address{Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "USA"},
+			}
+
+			// Act
+			order, err := orderService.CreateOrder(ctx, req)
+
+			// Assert
+			assert.Nil(t, order)
+
+			appErr, ok := appErrors.IsAppError(err)
+			require.True(t, ok)
+			assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		})
+	}
+
+	t.Run("Success - Items priced at the current price", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, _ := setupOrderServiceTest(t)
+		ctx := t.Context()
+		customerID := uuid.New()
+		product := &models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 12.5}
+
+		mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(&models.Cart{
+			UserID: customerID,
+			Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 2}},
+		}, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID).Return(product, nil).Twice()
+		mockOrderRepo.On("CreateOrder", ctx, mock.AnythingOfType("*models.Order")).Return(nil).Once()
+		mockProductRepo.On("UpdateProduct", ctx, product).Return(nil).Once()
+		mockInventoryRepo.On("RecordMovement", ctx, mock.AnythingOfType("*models.StockMovement")).Return(nil).Once()
+		mockWarehouseService.On("AllocateOrder", ctx, mock.AnythingOfType("*models.Order")).Return([]*models.StockAllocation{}, nil).Once()
+
+		// the quoted price rounds to the current one, the order is billed at the current one
+		req := &models.CreateOrderRequest{
+			CustomerID:      customerID,
+			Items:           []models.OrderItem{{ProductID: productID, Quantity: 2, UnitPrice: 12.504}},
+			ShippingAddress: &models.Address{Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "USA"},
+		}
+
+		// Act
+		order, err := orderService.CreateOrder(ctx, req)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, order.Items, 1)
+		assert.Equal(t, 12.5, order.Items[0].UnitPrice)
+		assert.Equal(t, 25.0, order.TotalAmount)
+	})
+}
+
+func TestCreateOrder_DuplicateOrder(t *testing.T) {
+	customerID := uuid.New()
+	productID := uuid.New()
+	policy := testOrderPolicy
+	policy.DuplicateWindow = 10 * time.Minute
+
+	setup := func(t *testing.T, recent ...*models.Order) (service.OrderService, *mocks.MockOrderRepository) {
+		t.Helper()
+
+		mockOrderRepo := mocks.NewMockOrderRepository(t)
+		mockCartRepo := mocks.NewMockCartRepository(t)
+		mockProductRepo := mocks.NewMockProductRepository(t)
+		orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo, mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), permissiveShippingService(t), permissiveCampaignService(t), permissiveFraudService(t), mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), policy, clock.NewFake(testNow))
+
+		mockCartRepo.On("GetCartByCustomerID", mock.Anything, customerID).Return(&models.Cart{
+			UserID: customerID,
+			Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 2}},
+		}, nil).Once()
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 12.5}, nil).Once()
+		mockOrderRepo.On("StreamOrdersOfCustomer", mock.Anything, customerID, testNow.Add(-10*time.Minute), testNow.Add(time.Minute), mock.Anything).
+			Return(func(_ context.Context, _ uuid.UUID, _, _ time.Time, fn func(*models.Order) error) error {
+				for _, order := range recent {
+					if err := fn(order); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}).Maybe()
+
+		return orderService, mockOrderRepo
+	}
+
+	newRequest := func(allowDuplicate bool) *models.CreateOrderRequest {
+		return &models.CreateOrderRequest{
+			CustomerID:      customerID,
+			Items:           []models.OrderItem{{ProductID: productID, Quantity: 2, UnitPrice: 12.5}},
+			ShippingAddress: &models.Address{Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "USA"},
+			AllowDuplicate:  allowDuplicate,
+		}
+	}
+
+	recentOrder := func(status models.OrderStatus, quantity int) *models.Order {
+		return &models.Order{ID: uuid.New(), CustomerID: customerID, Status: status, TotalAmount: 12.5 * float64(quantity), Items: []models.OrderItem{
+			{ProductID: productID, Quantity: quantity, UnitPrice: 12.5},
+		}}
+	}
+
+	t.Run("Failure - Same items and total as a recent order", func(t *testing.T) {
+		// Arrange
+		cancelled := recentOrder(models.OrderStatusCancelled, 2)
+		placed := recentOrder(models.OrderStatusPending, 2)
+		orderService, _ := setup(t, placed, cancelled)
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), newRequest(false))
+
+		// Assert
+		assert.Nil(t, order)
+
+		appErr, ok := appErrors.IsAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeDuplicateOrder, appErr.Code)
+		assert.Equal(t, &appErrors.ErrDuplicateOrder{OrderID: placed.ID}, appErr.Meta)
+	})
+
+	t.Run("Success - Other quantities are not a duplicate", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo := setup(t, recentOrder(models.OrderStatusPending, 1))
+		mockOrderRepo.On("CreateOrder", mock.Anything, mock.AnythingOfType("*models.Order")).Return(errors.New("db error")).Once()
+
+		// Act
+		_, err := orderService.CreateOrder(t.Context(), newRequest(false))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+
+	t.Run("Success - Allowed duplicate is placed", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo := setup(t, recentOrder(models.OrderStatusPending, 2))
+		mockOrderRepo.On("CreateOrder", mock.Anything, mock.AnythingOfType("*models.Order")).Return(errors.New("db error")).Once()
+
+		// Act
+		_, err := orderService.CreateOrder(t.Context(), newRequest(true))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+		mockOrderRepo.AssertNotCalled(t, "StreamOrdersOfCustomer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateOrder_ProductNotForSale(t *testing.T) {
+	// Arrange
+	orderService, _, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(&models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+	}, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusDiscontinued, StockQuantity: 5, Price: 10.0}, nil).Once()
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, &models.CreateOrderRequest{CustomerID: customerID})
+
+	// Assert
+	assert.Nil(t, order)
+
+	appErr, ok := appErrors.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, appErrors.ErrCodeProductNotForSale, appErr.Code)
+	assert.Equal(t, &appErrors.ErrProductNotForSale{ProductID: productID, Status: models.ProductStatusDiscontinued}, appErr.Meta)
+}
+
+func TestCreateOrder_PurchaseLimitExceeded(t *testing.T) {
+	// Arrange
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+	maxPerCustomer, periodDays := 2, 7
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(&models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+	}, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{
+		ID: productID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 10.0, MaxPerCustomer: &maxPerCustomer, LimitPeriodDays: &periodDays,
+	}, nil).Once()
+	// the customer bought the limit in another order since the item went in the cart
+	mockOrderRepo.On("CountPurchasedUnits", ctx, customerID, productID, testNow.AddDate(0, 0, -7)).Return(2, nil).Once()
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, &models.CreateOrderRequest{CustomerID: customerID})
+
+	// Assert
+	assert.Nil(t, order)
+
+	appErr, ok := appErrors.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, appErrors.ErrCodePurchaseLimit, appErr.Code)
+	assert.Equal(t, &appErrors.ErrPurchaseLimit{ProductID: productID, Limit: 2, Purchased: 2}, appErr.Meta)
+	mockOrderRepo.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything)
+}
+
+func TestCreateOrder_ShippingRestricted(t *testing.T) {
+	// Arrange
+	mockCartRepo := mocks.NewMockCartRepository(t)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockShippingService := svcMocks.NewMockShippingService(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo, mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), mockShippingService, permissiveCampaignService(t), permissiveFraudService(t), mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
+
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+	address := &models.Address{Street: "PO Box 7", City: "Anytown", State: "NY", PostalCode: "10001", Country: "US"}
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(&models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+	}, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 10.0}, nil).Once()
+	mockShippingService.On("CheckOrder", ctx, address, []uuid.UUID{productID}).
+		Return(appErrors.ShippingRestrictedError(appErrors.ErrCodeShippingPOBox, "We do not ship to PO boxes")).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID:      customerID,
+		Items:           []models.OrderItem{{ProductID: productID, Quantity: 1, UnitPrice: 10.0}},
+		ShippingAddress: address,
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.Nil(t, order)
+
+	appErr, ok := err.(*appErrors.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, appErrors.ErrCodeShippingPOBox, appErr.Code)
+	mockOrderRepo.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything)
+}
+
 func TestCreateOrder_CreateOrderRepoError(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, mockCartRepo, mockProductRepo := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New()
@@ -249,7 +688,7 @@ func TestCreateOrder_CreateOrderRepoError(t *testing.T) {
 	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil)
 
 	// Mock Call Product Repo
-	mockProduct1 := &models.Product{ID: productID1, StockQuantity: 10, Price: 25.0}
+	mockProduct1 := &models.Product{ID: productID1, Status: models.ProductStatusActive, StockQuantity: 10, Price: 25.0}
 	mockProductRepo.On("GetProductByID", ctx, productID1).Return(mockProduct1, nil).Once()
 
 	// Mock Call Order Repo
@@ -259,7 +698,7 @@ func TestCreateOrder_CreateOrderRepoError(t *testing.T) {
 	req := &models.CreateOrderRequest{
 		CustomerID:      customerID,
 		Items:           []models.OrderItem{{ProductID: productID1, Quantity: 1, UnitPrice: 25.0}},
-		ShippingAddress: models.Address{},
+		ShippingAddress: &models.Address{},
 	}
 
 	// Act
@@ -280,9 +719,192 @@ func TestCreateOrder_CreateOrderRepoError(t *testing.T) {
 	mockOrderRepo.AssertExpectations(t)
 }
 
+func TestCreateOrder_CampaignAllocation(t *testing.T) {
+	newService := func(t *testing.T) (service.OrderService, *mocks.MockOrderRepository, *mocks.MockProductRepository, *svcMocks.MockCampaignService, uuid.UUID, *models.CreateOrderRequest) {
+		mockOrderRepo := mocks.NewMockOrderRepository(t)
+		mockCartRepo := mocks.NewMockCartRepository(t)
+		mockProductRepo := mocks.NewMockProductRepository(t)
+		mockCampaignService := svcMocks.NewMockCampaignService(t)
+		orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo, mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), permissiveShippingService(t), mockCampaignService, permissiveFraudService(t), mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
+
+		customerID := uuid.New()
+		productID := uuid.New()
+
+		mockCartRepo.On("GetCartByCustomerID", mock.Anything, customerID).Return(&models.Cart{
+			UserID: customerID,
+			Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 3}},
+		}, nil).Once()
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 10, Price: 8.0}, nil).Once()
+
+		req := &models.CreateOrderRequest{
+			CustomerID:      customerID,
+			Items:           []models.OrderItem{{ProductID: productID, Quantity: 3, UnitPrice: 8.0}},
+			ShippingAddress: &models.Address{},
+		}
+
+		return orderService, mockOrderRepo, mockProductRepo, mockCampaignService, productID, req
+	}
+
+	// claimedFrom has ClaimStock take the units from the campaign, the way the stock repository records it
+	campaignID := uuid.New()
+	claimedFrom := func(args mock.Arguments) {
+		for i := range args.Get(1).([]models.CampaignClaim) {
+			args.Get(1).([]models.CampaignClaim)[i].CampaignID = campaignID
+		}
+	}
+
+	t.Run("Failure - Allocation sold out", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockCampaignService, productID, req := newService(t)
+		claims := []models.CampaignClaim{{ProductID: productID, Quantity: 3}}
+		mockCampaignService.On("ClaimStock", mock.Anything, claims).Return(appErrors.InsufficientStockError(productID, 1)).Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+
+		appErr, ok := appErrors.IsAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeInsufficientStock, appErr.Code)
+		mockOrderRepo.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Claimed units released when the order is not placed", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockCampaignService, productID, req := newService(t)
+		claims := []models.CampaignClaim{{ProductID: productID, Quantity: 3}}
+		mockCampaignService.On("ClaimStock", mock.Anything, claims).Run(claimedFrom).Return(nil).Once()
+		mockOrderRepo.On("CreateOrder", mock.Anything, mock.AnythingOfType("*models.Order")).Return(errors.New("connection reset")).Once()
+		mockCampaignService.On("ReleaseStock", mock.Anything, []models.CampaignClaim{{ProductID: productID, Quantity: 3, CampaignID: campaignID}}).Return().Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+
+	t.Run("Failure - Stored order not placed is lapsed and its units released", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, mockProductRepo, mockCampaignService, productID, req := newService(t)
+		claims := []models.CampaignClaim{{ProductID: productID, Quantity: 3}}
+		mockCampaignService.On("ClaimStock", mock.Anything, claims).Run(claimedFrom).Return(nil).Once()
+		mockOrderRepo.On("CreateOrder", mock.Anything, mock.MatchedBy(func(o *models.Order) bool {
+			return *o.Items[0].CampaignID == campaignID
+		})).Return(nil).Once()
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(nil, errors.New("connection reset")).Once()
+		mockOrderRepo.On("LapseOrder", mock.Anything, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
+		mockCampaignService.On("ReleaseStock", mock.Anything, []models.CampaignClaim{{ProductID: productID, Quantity: 3, CampaignID: campaignID}}).Return().Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+
+	t.Run("Failure - Units of an order failing to lapse are left to the sweep", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, mockProductRepo, mockCampaignService, productID, req := newService(t)
+		claims := []models.CampaignClaim{{ProductID: productID, Quantity: 3}}
+		mockCampaignService.On("ClaimStock", mock.Anything, claims).Run(claimedFrom).Return(nil).Once()
+		mockOrderRepo.On("CreateOrder", mock.Anything, mock.AnythingOfType("*models.Order")).Return(nil).Once()
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(nil, errors.New("connection reset")).Once()
+		mockOrderRepo.On("LapseOrder", mock.Anything, mock.AnythingOfType("uuid.UUID")).Return(errors.New("connection reset")).Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+		mockCampaignService.AssertNotCalled(t, "ReleaseStock", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateOrder_VelocityRules(t *testing.T) {
+	newService := func(t *testing.T) (service.OrderService, *mocks.MockOrderRepository, *svcMocks.MockFraudService, *models.CreateOrderRequest) {
+		mockOrderRepo := mocks.NewMockOrderRepository(t)
+		mockCartRepo := mocks.NewMockCartRepository(t)
+		mockProductRepo := mocks.NewMockProductRepository(t)
+		mockFraudService := svcMocks.NewMockFraudService(t)
+		orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo, mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), permissiveShippingService(t), permissiveCampaignService(t), mockFraudService, mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
+
+		customerID := uuid.New()
+		productID := uuid.New()
+
+		mockCartRepo.On("GetCartByCustomerID", mock.Anything, customerID).Return(&models.Cart{
+			UserID: customerID,
+			Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 1}},
+		}, nil).Once()
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 10, Price: 8.0}, nil).Once()
+
+		req := &models.CreateOrderRequest{
+			CustomerID:      customerID,
+			Items:           []models.OrderItem{{ProductID: productID, Quantity: 1, UnitPrice: 8.0}},
+			ShippingAddress: &models.Address{},
+			ClientIP:        "203.0.113.7",
+		}
+
+		return orderService, mockOrderRepo, mockFraudService, req
+	}
+
+	t.Run("Failure - Blocked order not placed", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, mockFraudService, req := newService(t)
+		subjects := models.VelocitySubjects{CustomerID: req.CustomerID, IP: "203.0.113.7"}
+		mockFraudService.On("CheckVelocity", mock.Anything, mock.Anything, subjects).Return(nil, appErrors.OrderVelocityError()).Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+		assertAppErrorCode(t, err, appErrors.ErrCodeOrderVelocity)
+		mockOrderRepo.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Device fingerprint counted", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, mockFraudService, req := newService(t)
+		req.Device = &models.DeviceFingerprint{ID: "fp_123"}
+		subjects := models.VelocitySubjects{CustomerID: req.CustomerID, IP: "203.0.113.7", DeviceFingerprint: "fp_123"}
+		mockFraudService.On("CheckVelocity", mock.Anything, mock.Anything, subjects).Return(nil, appErrors.OrderVelocityError()).Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+		assertAppErrorCode(t, err, appErrors.ErrCodeOrderVelocity)
+		mockOrderRepo.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Flagged order not held", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, mockFraudService, req := newService(t)
+		mockFraudService.On("CheckVelocity", mock.Anything, mock.Anything, mock.Anything).Return([]string{"ip-hourly"}, nil).Once()
+		mockOrderRepo.On("CreateOrder", mock.Anything, mock.AnythingOfType("*models.Order")).Return(nil).Once()
+		mockFraudService.On("RecordOrder", mock.Anything, mock.Anything, req.CustomerID, mock.Anything, []string{"ip-hourly"}).
+			Return(appErrors.DatabaseError("Failed to hold order for fraud review")).Once()
+		mockOrderRepo.On("LapseOrder", mock.Anything, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
+
+		// Act
+		order, err := orderService.CreateOrder(t.Context(), req)
+
+		// Assert
+		assert.Nil(t, order)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
 func TestCreateOrder_UpdateInventoryRepoError(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, mockCartRepo, mockProductRepo := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	productID1 := uuid.New()
@@ -297,7 +919,7 @@ func TestCreateOrder_UpdateInventoryRepoError(t *testing.T) {
 	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil)
 
 	// Mock Call Product Repo
-	mockProduct1 := &models.Product{ID: productID1, StockQuantity: 10, Price: 25.0}
+	mockProduct1 := &models.Product{ID: productID1, Status: models.ProductStatusActive, StockQuantity: 10, Price: 25.0}
 	mockProductRepo.On("GetProductByID", ctx, productID1).Return(mockProduct1, nil).Twice() // Called once for check, once for update loop
 
 	// Mock Call Order Repo
@@ -307,10 +929,13 @@ func TestCreateOrder_UpdateInventoryRepoError(t *testing.T) {
 	mockErr := errors.New("mock update product error")
 	mockProductRepo.On("UpdateProduct", ctx, mock.AnythingOfType("*models.Product")).Return(mockErr).Once()
 
+	// the stored order is lapsed, which gives back what it took
+	mockOrderRepo.On("LapseOrder", ctx, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
+
 	req := &models.CreateOrderRequest{
 		CustomerID:      customerID,
 		Items:           []models.OrderItem{{ProductID: productID1, Quantity: 1, UnitPrice: 25.0}},
-		ShippingAddress: models.Address{},
+		ShippingAddress: &models.Address{},
 	}
 
 	// Act
@@ -331,9 +956,48 @@ func TestCreateOrder_UpdateInventoryRepoError(t *testing.T) {
 	mockOrderRepo.AssertExpectations(t)
 }
 
+func TestCreateOrder_AllocationError(t *testing.T) {
+	// Arrange
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, _ := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID1 := uuid.New()
+
+	mockCart := &models.Cart{
+		UserID: customerID,
+		Items: map[string]models.CartItem{
+			productID1.String(): {ProductID: productID1, Quantity: 1},
+		},
+	}
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil)
+
+	mockProduct1 := &models.Product{ID: productID1, Status: models.ProductStatusActive, StockQuantity: 10, Price: 25.0}
+	mockProductRepo.On("GetProductByID", ctx, productID1).Return(mockProduct1, nil).Twice()
+	mockProductRepo.On("UpdateProduct", ctx, mock.AnythingOfType("*models.Product")).Return(nil).Once()
+	mockOrderRepo.On("CreateOrder", ctx, mock.AnythingOfType("*models.Order")).Return(nil).Once()
+	mockInventoryRepo.On("RecordMovement", ctx, mock.AnythingOfType("*models.StockMovement")).Return(nil).Once()
+
+	allocationErr := appErrors.BadRequestError("Insufficient warehouse stock for product: " + productID1.String())
+	mockWarehouseService.On("AllocateOrder", ctx, mock.AnythingOfType("*models.Order")).Return(nil, allocationErr).Once()
+	mockOrderRepo.On("LapseOrder", ctx, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID:      customerID,
+		Items:           []models.OrderItem{{ProductID: productID1, Quantity: 1, UnitPrice: 25.0}},
+		ShippingAddress: &models.Address{},
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.Nil(t, order)
+	assert.ErrorIs(t, err, allocationErr)
+}
+
 func TestGetOrderByID_Success(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	orderID := uuid.New()
 	expectedOrder := &models.Order{ID: orderID, CustomerID: uuid.New(), Status: models.OrderStatusDelivered}
@@ -354,7 +1018,7 @@ func TestGetOrderByID_Success(t *testing.T) {
 
 func TestGetOrderByID_NotFound(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	orderID := uuid.New()
 
@@ -380,7 +1044,7 @@ func TestGetOrderByID_NotFound(t *testing.T) {
 
 func TestListOrdersByCustomer_Success(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	page, size := 1, 5
@@ -405,7 +1069,7 @@ func TestListOrdersByCustomer_Success(t *testing.T) {
 }
 
 func TestListOrdersByCustomer_PaginationDefaults(t *testing.T) {
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	defaultPage, defaultSize := 1, 10
@@ -426,7 +1090,7 @@ func TestListOrdersByCustomer_PaginationDefaults(t *testing.T) {
 
 func TestListOrdersByCustomer_RepoError(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	customerID := uuid.New()
 	page, size := 1, 10
@@ -454,7 +1118,7 @@ func TestListOrdersByCustomer_RepoError(t *testing.T) {
 
 func TestUpdateOrderStatus_Success(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	orderID := uuid.New()
 	newStatus := models.OrderStatusShipping
@@ -481,7 +1145,7 @@ func TestUpdateOrderStatus_Success(t *testing.T) {
 
 func TestUpdateOrderStatus_OrderNotFound(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	orderID := uuid.New()
 	newStatus := models.OrderStatusShipping
@@ -508,7 +1172,7 @@ func TestUpdateOrderStatus_OrderNotFound(t *testing.T) {
 
 func TestUpdateOrderStatus_UpdateRepoError(t *testing.T) {
 	// Arrange
-	orderService, mockOrderRepo, _, _ := setupOrderServiceTest(t)
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
 	ctx := t.Context()
 	orderID := uuid.New()
 	newStatus := models.OrderStatusDelivered
@@ -536,3 +1200,571 @@ func TestUpdateOrderStatus_UpdateRepoError(t *testing.T) {
 
 	mockOrderRepo.AssertExpectations(t)
 }
+
+func TestUpdateOrderStatus_InvalidTransition(t *testing.T) {
+	// Arrange
+	orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, Status: models.OrderStatusDelivered}, nil).Once()
+
+	// Act
+	order, err := orderService.UpdateOrderStatus(ctx, orderID, models.OrderStatusPending)
+
+	// Assert
+	assert.Nil(t, order)
+
+	appErr, ok := appErrors.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, appErrors.ErrCodeInvalidTransition, appErr.Code)
+	mockOrderRepo.AssertNotCalled(t, "UpdateOrderStatus")
+}
+
+func TestUpdateOrderStatus_HeldForFraudReview(t *testing.T) {
+	// Arrange
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	mockFraudService := svcMocks.NewMockFraudService(t)
+	orderService := service.NewOrderService(mockOrderRepo, mocks.NewMockCartRepository(t), mocks.NewMockProductRepository(t), mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), permissiveShippingService(t), permissiveCampaignService(t), mockFraudService, mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, Status: models.OrderStatusConfirmed}, nil).Once()
+	mockFraudService.On("HeldOrders", ctx, []uuid.UUID{orderID}).Return(map[uuid.UUID]bool{orderID: true}, nil).Once()
+
+	// Act
+	order, err := orderService.UpdateOrderStatus(ctx, orderID, models.OrderStatusShipping)
+
+	// Assert
+	assert.Nil(t, order)
+	assertAppErrorCode(t, err, appErrors.ErrCodeFraudReview)
+	mockOrderRepo.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateOrderStatus_CancelReleasesCampaignStock(t *testing.T) {
+	ctx := t.Context()
+	orderID, productID, campaignID := uuid.New(), uuid.New(), uuid.New()
+
+	newService := func(t *testing.T, items []models.OrderItem) (service.OrderService, *svcMocks.MockCampaignService) {
+		mockOrderRepo := mocks.NewMockOrderRepository(t)
+		mockCampaignService := svcMocks.NewMockCampaignService(t)
+		orderService := service.NewOrderService(mockOrderRepo, mocks.NewMockCartRepository(t), mocks.NewMockProductRepository(t), mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), permissiveShippingService(t), mockCampaignService, permissiveFraudService(t), mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
+
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, Status: models.OrderStatusConfirmed, Items: items}, nil).Once()
+		mockOrderRepo.On("UpdateOrderStatus", ctx, orderID, models.OrderStatusCancelled).Return(&models.Order{ID: orderID, Status: models.OrderStatusCancelled}, nil).Once()
+
+		return orderService, mockCampaignService
+	}
+
+	t.Run("Success - Claimed units go back to their campaign", func(t *testing.T) {
+		// Arrange
+		orderService, mockCampaignService := newService(t, []models.OrderItem{
+			{ProductID: productID, Quantity: 2, CampaignID: &campaignID},
+			{ProductID: uuid.New(), Quantity: 1},
+		})
+		mockCampaignService.On("ReleaseStock", ctx, []models.CampaignClaim{{ProductID: productID, Quantity: 2, CampaignID: campaignID}}).Return().Once()
+
+		// Act
+		order, err := orderService.UpdateOrderStatus(ctx, orderID, models.OrderStatusCancelled)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderStatusCancelled, order.Status)
+	})
+
+	t.Run("Success - Order that claimed nothing gives nothing back", func(t *testing.T) {
+		// Arrange: placed at full price, before a campaign started on the product
+		orderService, mockCampaignService := newService(t, []models.OrderItem{{ProductID: productID, Quantity: 2}})
+		mockCampaignService.On("ReleaseStock", ctx, []models.CampaignClaim(nil)).Return().Once()
+
+		// Act
+		order, err := orderService.UpdateOrderStatus(ctx, orderID, models.OrderStatusCancelled)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderStatusCancelled, order.Status)
+	})
+}
+
+func TestBulkUpdateOrderStatus(t *testing.T) {
+	ctx := t.Context()
+	shipped, pending, delivered, missing := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	toShipping := []models.OrderStatus{models.OrderStatusConfirmed, models.OrderStatusPending}
+
+	t.Run("Success - Results per order", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+
+		// the batch size is 2, so the orders are updated in two batches
+		mockOrderRepo.On("GetOrderStatuses", ctx, []uuid.UUID{shipped, pending}).Return(map[uuid.UUID]models.OrderStatus{
+			shipped: models.OrderStatusConfirmed,
+			pending: models.OrderStatusPending,
+		}, nil).Once()
+		mockOrderRepo.On("UpdateOrderStatuses", ctx, []uuid.UUID{shipped, pending}, models.OrderStatusShipping, toShipping).
+			Return([]uuid.UUID{shipped}, nil).Once()
+		mockOrderRepo.On("GetOrderStatuses", ctx, []uuid.UUID{delivered, missing}).Return(map[uuid.UUID]models.OrderStatus{
+			delivered: models.OrderStatusDelivered,
+		}, nil).Once()
+
+		// Act
+		resp, err := orderService.BulkUpdateOrderStatus(ctx, &models.BulkUpdateOrderStatusRequest{
+			OrderIDs: []uuid.UUID{shipped, pending, delivered, missing},
+			Status:   models.OrderStatusShipping,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, resp.Succeeded)
+		assert.Equal(t, 3, resp.Failed)
+		assert.Equal(t, []models.BulkOrderStatusResult{
+			{OrderID: shipped, Success: true},
+			{OrderID: pending, Code: appErrors.ErrCodeInvalidTransition, Message: "Order status changed during the update"},
+			{OrderID: delivered, Code: appErrors.ErrCodeInvalidTransition, Message: "Order cannot move from delivered to shipping"},
+			{OrderID: missing, Code: appErrors.ErrCodeNotFound, Message: "Order not found"},
+		}, resp.Results)
+		mockOrderRepo.AssertNumberOfCalls(t, "UpdateOrderStatuses", 1)
+	})
+
+	t.Run("Failure in a batch does not stop the next", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+
+		mockOrderRepo.On("GetOrderStatuses", ctx, []uuid.UUID{shipped, pending}).Return(nil, errors.New("db down")).Once()
+		mockOrderRepo.On("GetOrderStatuses", ctx, []uuid.UUID{delivered}).Return(map[uuid.UUID]models.OrderStatus{
+			delivered: models.OrderStatusConfirmed,
+		}, nil).Once()
+		mockOrderRepo.On("UpdateOrderStatuses", ctx, []uuid.UUID{delivered}, models.OrderStatusShipping, toShipping).
+			Return([]uuid.UUID{delivered}, nil).Once()
+
+		// Act
+		resp, err := orderService.BulkUpdateOrderStatus(ctx, &models.BulkUpdateOrderStatusRequest{
+			OrderIDs: []uuid.UUID{shipped, pending, delivered},
+			Status:   models.OrderStatusShipping,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, resp.Succeeded)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, resp.Results[0].Code)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, resp.Results[1].Code)
+		assert.True(t, resp.Results[2].Success)
+	})
+
+	t.Run("Success - Cancelled orders release their campaign stock", func(t *testing.T) {
+		// Arrange
+		mockOrderRepo := mocks.NewMockOrderRepository(t)
+		mockCampaignService := svcMocks.NewMockCampaignService(t)
+		orderService := service.NewOrderService(mockOrderRepo, mocks.NewMockCartRepository(t), mocks.NewMockProductRepository(t), mocks.NewMockInventoryRepository(t), svcMocks.NewMockWarehouseService(t), permissiveShippingService(t), mockCampaignService, permissiveFraudService(t), mocks.NewMockPickupRepository(t), mocks.NewMockVendorRepository(t), testOrderPolicy, clock.NewFake(testNow))
+		productID, campaignID := uuid.New(), uuid.New()
+
+		mockOrderRepo.On("GetOrderStatuses", ctx, []uuid.UUID{pending, delivered}).Return(map[uuid.UUID]models.OrderStatus{
+			pending:   models.OrderStatusPending,
+			delivered: models.OrderStatusDelivered,
+		}, nil).Once()
+		mockOrderRepo.On("UpdateOrderStatuses", ctx, []uuid.UUID{pending}, models.OrderStatusCancelled, models.OrderStatusesLeadingTo(models.OrderStatusCancelled)).
+			Return([]uuid.UUID{pending}, nil).Once()
+		mockOrderRepo.On("GetOrderByID", ctx, pending).Return(&models.Order{
+			ID: pending, Status: models.OrderStatusCancelled, Items: []models.OrderItem{{ProductID: productID, Quantity: 4, CampaignID: &campaignID}},
+		}, nil).Once()
+		mockCampaignService.On("ReleaseStock", ctx, []models.CampaignClaim{{ProductID: productID, Quantity: 4, CampaignID: campaignID}}).Return().Once()
+
+		// Act
+		resp, err := orderService.BulkUpdateOrderStatus(ctx, &models.BulkUpdateOrderStatusRequest{
+			OrderIDs: []uuid.UUID{pending, delivered},
+			Status:   models.OrderStatusCancelled,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.Succeeded)
+		assert.Equal(t, 1, resp.Failed)
+	})
+
+	t.Run("Failure - Too many orders", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+
+		// Act
+		_, err := orderService.BulkUpdateOrderStatus(ctx, &models.BulkUpdateOrderStatusRequest{
+			OrderIDs: []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()},
+			Status:   models.OrderStatusShipping,
+		})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		mockOrderRepo.AssertNotCalled(t, "GetOrderStatuses")
+	})
+}
+
+func TestCreateOrder_GiftWrap(t *testing.T) {
+	// Arrange
+	orderService, mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, _ := setupOrderServiceTest(t)
+	ctx := t.Context()
+	customerID := uuid.New()
+	productID := uuid.New()
+
+	mockCart := &models.Cart{
+		UserID: customerID,
+		Items:  map[string]models.CartItem{productID.String(): {ProductID: productID, Quantity: 2}},
+	}
+	mockProduct := &models.Product{ID: productID, Status: models.ProductStatusActive, StockQuantity: 3, Price: 10.0}
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, productID).Return(mockProduct, nil).Twice()
+	mockOrderRepo.On("CreateOrder", ctx, mock.MatchedBy(func(o *models.Order) bool {
+		return o.GiftWrap && o.GiftWrapFee == testGiftWrapFee && o.GiftMessage == "Enjoy!" && o.Items[0].IsGift
+	})).Return(nil).Once()
+	mockProductRepo.On("UpdateProduct", ctx, mock.Anything).Return(nil).Once()
+	mockInventoryRepo.On("RecordMovement", ctx, mock.Anything).Return(nil).Once()
+	mockWarehouseService.On("AllocateOrder", ctx, mock.AnythingOfType("*models.Order")).Return([]*models.StockAllocation{}, nil).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID:      customerID,
+		Items:           []models.OrderItem{{ProductID: productID, Quantity: 2, UnitPrice: 10.0, IsGift: true}},
+		ShippingAddress: &models.Address{Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "US"},
+		GiftWrap:        true,
+		GiftMessage:     "Enjoy!",
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0+testGiftWrapFee, order.TotalAmount)
+}
+
+func TestCreateOrder_SplitsByVendor(t *testing.T) {
+	// Arrange
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	mockCartRepo := mocks.NewMockCartRepository(t)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockWarehouseService := svcMocks.NewMockWarehouseService(t)
+	mockVendorRepo := mocks.NewMockVendorRepository(t)
+	orderService := service.NewOrderService(mockOrderRepo, mockCartRepo, mockProductRepo, mockInventoryRepo, mockWarehouseService, permissiveShippingService(t), permissiveCampaignService(t), permissiveFraudService(t), mocks.NewMockPickupRepository(t), mockVendorRepo, testOrderPolicy, clock.NewFake(testNow))
+
+	ctx := t.Context()
+	customerID := uuid.New()
+	vendorID := uuid.New()
+	vendorProductID := uuid.New()
+	platformProductID := uuid.New()
+
+	mockCart := &models.Cart{
+		UserID: customerID,
+		Items: map[string]models.CartItem{
+			vendorProductID.String():   {ProductID: vendorProductID, Quantity: 2},
+			platformProductID.String(): {ProductID: platformProductID, Quantity: 1},
+		},
+	}
+	vendorProduct := &models.Product{ID: vendorProductID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 15.0, VendorID: &vendorID}
+	platformProduct := &models.Product{ID: platformProductID, Status: models.ProductStatusActive, StockQuantity: 5, Price: 30.0}
+
+	mockCartRepo.On("GetCartByCustomerID", ctx, customerID).Return(mockCart, nil).Once()
+	mockProductRepo.On("GetProductByID", ctx, vendorProductID).Return(vendorProduct, nil).Twice()
+	mockProductRepo.On("GetProductByID", ctx, platformProductID).Return(platformProduct, nil).Twice()
+	mockOrderRepo.On("CreateOrder", ctx, mock.AnythingOfType("*models.Order")).Return(nil).Once()
+	mockVendorRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, CommissionRate: 0.15}, nil).Once()
+	mockVendorRepo.On("CreateVendorOrder", ctx, mock.MatchedBy(func(vo *models.VendorOrder) bool {
+		return vo.VendorID == vendorID && vo.Subtotal == 30.0 && vo.CommissionRate == 0.15 && vo.Commission == 4.5 && len(vo.Items) == 1 &&
+			vo.Items[0].ProductID == vendorProductID && vo.Status == models.OrderStatusPending
+	})).Return(nil).Once()
+	mockProductRepo.On("UpdateProduct", ctx, mock.Anything).Return(nil).Twice()
+	mockInventoryRepo.On("RecordMovement", ctx, mock.Anything).Return(nil).Twice()
+	mockWarehouseService.On("AllocateOrder", ctx, mock.AnythingOfType("*models.Order")).Return([]*models.StockAllocation{}, nil).Once()
+
+	req := &models.CreateOrderRequest{
+		CustomerID: customerID,
+		Items: []models.OrderItem{
+			{ProductID: vendorProductID, Quantity: 2, UnitPrice: 15.0},
+			{ProductID: platformProductID, Quantity: 1, UnitPrice: 30.0},
+		},
+		ShippingAddress: &models.Address{Street: "123 Main St", City: "Anytown", PostalCode: "12345", Country: "US"},
+	}
+
+	// Act
+	order, err := orderService.CreateOrder(ctx, req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 60.0, order.TotalAmount)
+	mockVendorRepo.AssertExpectations(t)
+}
+
+// expectPackingSlipLookups stubs the warehouses, the allocations and the products a packing slip is built from.
+func expectPackingSlipLookups(mockWarehouseService *svcMocks.MockWarehouseService, mockProductRepo *mocks.MockProductRepository, products ...uuid.UUID) {
+	mockWarehouseService.On("ListWarehouses", mock.Anything).Return([]*models.Warehouse{}, nil).Once()
+	mockWarehouseService.On("GetOrderAllocations", mock.Anything, mock.Anything).Return([]*models.StockAllocation{}, nil)
+
+	for _, id := range products {
+		mockProductRepo.On("GetProductByID", mock.Anything, id).Return(&models.Product{ID: id, SKU: "SKU-" + id.String()[:8], Name: "Product"}, nil).Once()
+	}
+}
+
+func TestGetPackingSlip(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	giftedID := uuid.New()
+	regularID := uuid.New()
+
+	t.Run("Regular order shows prices", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockProductRepo, _, mockWarehouseService, _ := setupOrderServiceTest(t)
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{
+			ID:          orderID,
+			TotalAmount: 30.0,
+			Items:       []models.OrderItem{{ProductID: regularID, Quantity: 3, UnitPrice: 10.0}},
+		}, nil).Once()
+		expectPackingSlipLookups(mockWarehouseService, mockProductRepo, regularID)
+
+		// Act
+		slip, err := orderService.GetPackingSlip(ctx, orderID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, *slip.Items[0].UnitPrice)
+		assert.Equal(t, 30.0, *slip.TotalAmount)
+	})
+
+	t.Run("Gift wrapped order hides every price", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockProductRepo, _, mockWarehouseService, _ := setupOrderServiceTest(t)
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{
+			ID:          orderID,
+			GiftWrap:    true,
+			GiftMessage: "Enjoy!",
+			TotalAmount: 34.5,
+			Items:       []models.OrderItem{{ProductID: regularID, Quantity: 3, UnitPrice: 10.0}},
+		}, nil).Once()
+		expectPackingSlipLookups(mockWarehouseService, mockProductRepo, regularID)
+
+		// Act
+		slip, err := orderService.GetPackingSlip(ctx, orderID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Enjoy!", slip.GiftMessage)
+		assert.Nil(t, slip.Items[0].UnitPrice)
+		assert.Nil(t, slip.TotalAmount)
+	})
+
+	t.Run("Mixed order hides gifted items and the total", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockProductRepo, _, mockWarehouseService, _ := setupOrderServiceTest(t)
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{
+			ID:          orderID,
+			TotalAmount: 40.0,
+			Items: []models.OrderItem{
+				{ProductID: giftedID, Quantity: 1, UnitPrice: 25.0, IsGift: true},
+				{ProductID: regularID, Quantity: 1, UnitPrice: 15.0},
+			},
+		}, nil).Once()
+		expectPackingSlipLookups(mockWarehouseService, mockProductRepo, giftedID, regularID)
+
+		// Act
+		slip, err := orderService.GetPackingSlip(ctx, orderID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, slip.Items[0].UnitPrice)
+		assert.Equal(t, 15.0, *slip.Items[1].UnitPrice)
+		assert.Nil(t, slip.TotalAmount)
+	})
+
+	t.Run("Items show the SKU and where the allocation picks them", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockProductRepo, _, mockWarehouseService, _ := setupOrderServiceTest(t)
+		itemID := uuid.New()
+		east := &models.Warehouse{ID: uuid.New(), Name: "East"}
+		west := &models.Warehouse{ID: uuid.New(), Name: "West"}
+
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{
+			ID:    orderID,
+			Items: []models.OrderItem{{ID: itemID, ProductID: regularID, Quantity: 5, UnitPrice: 10.0}},
+		}, nil).Once()
+		mockWarehouseService.On("ListWarehouses", ctx).Return([]*models.Warehouse{east, west}, nil).Once()
+		mockWarehouseService.On("GetOrderAllocations", ctx, orderID).Return([]*models.StockAllocation{
+			{OrderID: orderID, OrderItemID: itemID, ProductID: regularID, WarehouseID: east.ID, Quantity: 3},
+			{OrderID: orderID, OrderItemID: itemID, ProductID: regularID, WarehouseID: west.ID, Quantity: 2},
+		}, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, regularID).Return(&models.Product{ID: regularID, SKU: "MUG-001", Name: "Mug"}, nil).Once()
+
+		// Act
+		slip, err := orderService.GetPackingSlip(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "MUG-001", slip.Items[0].SKU)
+		assert.Equal(t, "Mug", slip.Items[0].Name)
+		assert.Equal(t, []models.PackingSlipPick{
+			{WarehouseID: east.ID, WarehouseName: "East", Quantity: 3},
+			{WarehouseID: west.ID, WarehouseName: "West", Quantity: 2},
+		}, slip.Items[0].Picks)
+	})
+}
+
+func TestGetOrderTimeline(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	customerID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+		events := []models.OrderTimelineEvent{
+			{Type: models.OrderTimelinePlaced, OccurredAt: testNow},
+			{Type: models.OrderTimelineStatusChanged, OccurredAt: testNow.Add(time.Hour), Status: string(models.OrderStatusConfirmed)},
+		}
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, CustomerID: customerID, Status: models.OrderStatusConfirmed}, nil).Once()
+		mockOrderRepo.On("ListOrderTimeline", ctx, orderID).Return(events, nil).Once()
+
+		// Act
+		timeline, err := orderService.GetOrderTimeline(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, customerID, timeline.CustomerID)
+		assert.Equal(t, models.OrderStatusConfirmed, timeline.Status)
+		assert.Equal(t, events, timeline.Events)
+	})
+
+	t.Run("Order not found", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(nil, errors.New("mock repo error: not found")).Once()
+
+		// Act
+		timeline, err := orderService.GetOrderTimeline(ctx, orderID)
+
+		// Assert
+		assert.Nil(t, timeline)
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Database error", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+		mockOrderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, CustomerID: customerID}, nil).Once()
+		mockOrderRepo.On("ListOrderTimeline", ctx, orderID).Return(nil, errors.New("connection refused")).Once()
+
+		// Act
+		_, err := orderService.GetOrderTimeline(ctx, orderID)
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
+
+func TestGetPackingSlips(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+
+	t.Run("Success - Slips in the order of the wave, products looked up once", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, mockProductRepo, _, mockWarehouseService, _ := setupOrderServiceTest(t)
+		first, second := uuid.New(), uuid.New()
+
+		for _, id := range []uuid.UUID{first, second} {
+			mockOrderRepo.On("GetOrderByID", ctx, id).Return(&models.Order{
+				ID:    id,
+				Items: []models.OrderItem{{ID: uuid.New(), ProductID: productID, Quantity: 1}},
+			}, nil).Once()
+		}
+
+		expectPackingSlipLookups(mockWarehouseService, mockProductRepo, productID)
+
+		// Act
+		slips, err := orderService.GetPackingSlips(ctx, []uuid.UUID{second, first})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, slips, 2)
+		assert.Equal(t, second, slips[0].OrderID)
+		assert.Equal(t, first, slips[1].OrderID)
+	})
+
+	t.Run("Failure - Order not found fails the wave", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, mockWarehouseService, _ := setupOrderServiceTest(t)
+		missing := uuid.New()
+
+		mockWarehouseService.On("ListWarehouses", ctx).Return([]*models.Warehouse{}, nil).Once()
+		mockOrderRepo.On("GetOrderByID", ctx, missing).Return(nil, errors.New("no rows")).Once()
+
+		// Act
+		slips, err := orderService.GetPackingSlips(ctx, []uuid.UUID{missing})
+
+		// Assert
+		assert.Nil(t, slips)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Too many orders", func(t *testing.T) {
+		// Arrange
+		orderService, _, _, _, _, _, _ := setupOrderServiceTest(t)
+		ids := make([]uuid.UUID, testOrderPolicy.PickWaveMaxOrders+1)
+
+		for i := range ids {
+			ids[i] = uuid.New()
+		}
+
+		// Act
+		slips, err := orderService.GetPackingSlips(ctx, ids)
+
+		// Assert
+		assert.Nil(t, slips)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
+func TestExportOrders(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	customerID := uuid.New()
+
+	t.Run("Failure - Writer error is returned as is", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+		ctx := t.Context()
+
+		writeErr := errors.New("broken pipe")
+		mockOrderRepo.On("StreamOrdersOfCustomer", ctx, customerID, from, to, mock.Anything).
+			Return(func(_ context.Context, _ uuid.UUID, _, _ time.Time, fn func(*models.Order) error) error {
+				return fn(&models.Order{ID: uuid.New(), CustomerID: customerID})
+			}).Once()
+
+		// Act
+		err := orderService.ExportOrders(ctx, customerID, from, to, func(_ *models.Order) error { return writeErr })
+
+		// Assert
+		assert.Equal(t, writeErr, err)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		orderService, mockOrderRepo, _, _, _, _, _ := setupOrderServiceTest(t)
+		ctx := t.Context()
+
+		mockOrderRepo.On("StreamOrdersOfCustomer", ctx, customerID, from, to, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		err := orderService.ExportOrders(ctx, customerID, from, to, func(_ *models.Order) error { return nil })
+
+		// Assert
+		var appErr *appErrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
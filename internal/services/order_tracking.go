@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/google/uuid"
+)
+
+// trackingSignatureSize is the length of the truncated HMAC in a tracking token, 128 bits cannot be
+// guessed within any rate limit.
+const trackingSignatureSize = 16
+
+// OrderTrackingService lets customers follow an order from an email link without logging in. The
+// token of an order is its ID signed with the tracking key, it needs no storage and stays valid for
+// the life of the order, or until the key is rotated.
+type OrderTrackingService interface {
+	TrackingToken(orderID uuid.UUID) string
+	TrackingLink(orderID uuid.UUID) string
+	TrackOrder(ctx context.Context, client, token string) (*models.OrderTracking, error)
+}
+
+// OrderTrackingPolicy holds the key signing the tracking tokens, the page the links lead to and how
+// many lookups a client can make per window.
+type OrderTrackingPolicy struct {
+	Key        []byte
+	URL        string
+	RateLimit  int64
+	RateWindow time.Duration
+}
+
+type orderTrackingService struct {
+	orderRepo   repository.OrderRepository
+	rateLimiter repository.RateLimitRepository
+	policy      OrderTrackingPolicy
+}
+
+func NewOrderTrackingService(orderRepo repository.OrderRepository, rateLimiter repository.RateLimitRepository, policy OrderTrackingPolicy) OrderTrackingService {
+	policy.RateLimit = max(policy.RateLimit, 1)
+	policy.RateWindow = max(policy.RateWindow, time.Second)
+
+	return &orderTrackingService{orderRepo: orderRepo, rateLimiter: rateLimiter, policy: policy}
+}
+
+// TrackingToken implements OrderTrackingService.
+func (s *orderTrackingService) TrackingToken(orderID uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString(append(orderID[:], s.sign(orderID)...))
+}
+
+// TrackingLink returns the tracking page of the order, or nothing while no page is configured.
+func (s *orderTrackingService) TrackingLink(orderID uuid.UUID) string {
+	if s.policy.URL == "" {
+		return ""
+	}
+
+	return strings.TrimRight(s.policy.URL, "/") + "/" + s.TrackingToken(orderID)
+}
+
+// TrackOrder implements OrderTrackingService. The client is rate limited before the token is checked,
+// and a forged token is reported like an unknown order.
+func (s *orderTrackingService) TrackOrder(ctx context.Context, client, token string) (*models.OrderTracking, error) {
+	allowed, retryAfter, err := s.rateLimiter.CheckRateLimit(ctx, "order_tracking:"+client, s.policy.RateLimit, s.policy.RateWindow)
+	if err != nil {
+		return nil, errors.ThirdPartyError("Rate limit check failed").WithError(err)
+	}
+
+	if !allowed {
+		return nil, errors.TooManyRequestsError(fmt.Sprintf("Too many tracking requests, try again in %d seconds", retryAfter))
+	}
+
+	orderID, ok := s.verify(token)
+	if !ok {
+		return nil, errors.NotFoundError("Order not found")
+	}
+
+	tracing.SetIdentifier(ctx, tracing.OrderIDKey, orderID.String())
+
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	tracking := &models.OrderTracking{
+		OrderID:         order.ID,
+		Status:          order.Status,
+		FulfillmentType: order.FulfillmentType,
+		PlacedAt:        order.CreatedAt,
+		UpdatedAt:       order.UpdatedAt,
+	}
+
+	for _, item := range order.Items {
+		tracking.ItemCount += item.Quantity
+	}
+
+	if order.ShippingAddress != nil {
+		tracking.City = order.ShippingAddress.City
+		tracking.Country = order.ShippingAddress.Country
+	}
+
+	return tracking, nil
+}
+
+func (s *orderTrackingService) sign(orderID uuid.UUID) []byte {
+	mac := hmac.New(sha256.New, s.policy.Key)
+	mac.Write([]byte("order-tracking:"))
+	mac.Write(orderID[:])
+
+	return mac.Sum(nil)[:trackingSignatureSize]
+}
+
+func (s *orderTrackingService) verify(token string) (uuid.UUID, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != len(uuid.UUID{})+trackingSignatureSize {
+		return uuid.Nil, false
+	}
+
+	orderID, err := uuid.FromBytes(raw[:len(uuid.UUID{})])
+	if err != nil || !hmac.Equal(raw[len(uuid.UUID{}):], s.sign(orderID)) {
+		return uuid.Nil, false
+	}
+
+	return orderID, true
+}
+
+// trackingLine ends the emails about an order with its tracking link, when there is one.
+func trackingLine(trackingService OrderTrackingService, orderID uuid.UUID) string {
+	link := trackingService.TrackingLink(orderID)
+	if link == "" {
+		return ""
+	}
+
+	return " Track your order at " + link
+}
@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testOrderTrackingPolicy = service.OrderTrackingPolicy{
+	Key:        []byte("tracking-secret"),
+	URL:        "https://shop.example.com/track/",
+	RateLimit:  30,
+	RateWindow: time.Minute,
+}
+
+func setupOrderTrackingServiceTest(t *testing.T) (service.OrderTrackingService, *mocks.MockOrderRepository, *mocks.MockRateLimitRepository) {
+	orderRepo := mocks.NewMockOrderRepository(t)
+	rateLimiter := mocks.NewMockRateLimitRepository(t)
+
+	return service.NewOrderTrackingService(orderRepo, rateLimiter, testOrderTrackingPolicy), orderRepo, rateLimiter
+}
+
+func TestTrackingLink(t *testing.T) {
+	// Arrange
+	trackingService, _, _ := setupOrderTrackingServiceTest(t)
+	orderID := uuid.New()
+
+	// Act
+	link := trackingService.TrackingLink(orderID)
+
+	// Assert
+	assert.Equal(t, "https://shop.example.com/track/"+trackingService.TrackingToken(orderID), link)
+	assert.NotEqual(t, trackingService.TrackingToken(orderID), trackingService.TrackingToken(uuid.New()))
+	assert.Empty(t, service.NewOrderTrackingService(nil, nil, service.OrderTrackingPolicy{Key: []byte("k")}).TrackingLink(orderID))
+}
+
+func TestTrackOrder(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+
+	t.Run("Success - Redacted view of the order", func(t *testing.T) {
+		// Arrange
+		trackingService, orderRepo, rateLimiter := setupOrderTrackingServiceTest(t)
+		rateLimiter.On("CheckRateLimit", ctx, "order_tracking:203.0.113.7", int64(30), time.Minute).Return(true, 0, nil).Once()
+		orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{
+			ID:              orderID,
+			CustomerID:      uuid.New(),
+			Status:          models.OrderStatusShipping,
+			TotalAmount:     99.5,
+			PaymentIntentID: "pi_secret",
+			FulfillmentType: models.FulfillmentShipping,
+			ShippingAddress: &models.Address{Street: "1 Main St", City: "Berlin", PostalCode: "10115", Country: "DE"},
+			Items:           []models.OrderItem{{Quantity: 2}, {Quantity: 1}},
+		}, nil).Once()
+
+		// Act
+		tracking, err := trackingService.TrackOrder(ctx, "203.0.113.7", trackingService.TrackingToken(orderID))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderTracking{
+			OrderID:         orderID,
+			Status:          models.OrderStatusShipping,
+			FulfillmentType: models.FulfillmentShipping,
+			ItemCount:       3,
+			City:            "Berlin",
+			Country:         "DE",
+		}, *tracking)
+	})
+
+	t.Run("Failure - Forged token", func(t *testing.T) {
+		// Arrange
+		trackingService, _, rateLimiter := setupOrderTrackingServiceTest(t)
+		rateLimiter.On("CheckRateLimit", ctx, mock.Anything, int64(30), time.Minute).Return(true, 0, nil).Times(3)
+		other := service.NewOrderTrackingService(nil, nil, service.OrderTrackingPolicy{Key: []byte("another-key")})
+		token := trackingService.TrackingToken(orderID)
+		// another order ID under the signature of this one
+		tampered := "A" + token[1:]
+		if token[0] == 'A' {
+			tampered = "B" + token[1:]
+		}
+
+		for _, forged := range []string{other.TrackingToken(orderID), "not-a-token", tampered} {
+			// Act
+			_, err := trackingService.TrackOrder(ctx, "203.0.113.7", forged)
+
+			// Assert
+			var appErr *appErrors.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code, forged)
+		}
+	})
+
+	t.Run("Failure - Order deleted", func(t *testing.T) {
+		// Arrange
+		trackingService, orderRepo, rateLimiter := setupOrderTrackingServiceTest(t)
+		rateLimiter.On("CheckRateLimit", ctx, mock.Anything, int64(30), time.Minute).Return(true, 0, nil).Once()
+		orderRepo.On("GetOrderByID", ctx, orderID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := trackingService.TrackOrder(ctx, "203.0.113.7", trackingService.TrackingToken(orderID))
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Rate limited", func(t *testing.T) {
+		// Arrange
+		trackingService, orderRepo, rateLimiter := setupOrderTrackingServiceTest(t)
+		rateLimiter.On("CheckRateLimit", ctx, "order_tracking:203.0.113.7", int64(30), time.Minute).Return(false, 12, nil).Once()
+
+		// Act
+		_, err := trackingService.TrackOrder(ctx, "203.0.113.7", trackingService.TrackingToken(orderID))
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusTooManyRequests, appErr.StatusCode)
+		assert.Contains(t, appErr.Message, "12 seconds")
+		orderRepo.AssertNotCalled(t, "GetOrderByID")
+	})
+
+	t.Run("Failure - Rate limiter down", func(t *testing.T) {
+		// Arrange
+		trackingService, _, rateLimiter := setupOrderTrackingServiceTest(t)
+		rateLimiter.On("CheckRateLimit", ctx, mock.Anything, int64(30), time.Minute).Return(false, 0, errors.New("redis down")).Once()
+
+		// Act
+		_, err := trackingService.TrackOrder(ctx, "203.0.113.7", trackingService.TrackingToken(orderID))
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
+	})
+}
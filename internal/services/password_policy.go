@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	appError "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/pwned"
+)
+
+// maxPasswordBytes bounds the work of hashing a password, it is the limit of the legacy bcrypt hashes.
+const maxPasswordBytes = 72
+
+// Rules a password can break, reported to the client and in the rejection metrics.
+const (
+	passwordTooShort    = "too_short"
+	passwordTooLong     = "too_long"
+	passwordNoUppercase = "missing_uppercase"
+	passwordNoLowercase = "missing_lowercase"
+	passwordNoDigit     = "missing_digit"
+	passwordNoSymbol    = "missing_symbol"
+	passwordBreached    = "breached"
+)
+
+// PasswordPolicy holds the rules a new password has to follow. Breaches is nil when passwords are not
+// checked against known breaches.
+type PasswordPolicy struct {
+	MinLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	Breaches         pwned.Client
+}
+
+// check returns a WEAK_PASSWORD error listing every rule the password breaks. The breach check only
+// runs on passwords following the other rules, and lets the password through when it fails.
+func (p PasswordPolicy) check(ctx context.Context, password string) error {
+	var violations, details []string
+
+	broken := func(violation, detail string) {
+		violations = append(violations, violation)
+		details = append(details, detail)
+	}
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		broken(passwordTooShort, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+
+	if len(password) > maxPasswordBytes {
+		broken(passwordTooLong, fmt.Sprintf("must be at most %d bytes long", maxPasswordBytes))
+	}
+
+	if p.RequireUppercase && !strings.ContainsFunc(password, unicode.IsUpper) {
+		broken(passwordNoUppercase, "must contain an uppercase letter")
+	}
+
+	if p.RequireLowercase && !strings.ContainsFunc(password, unicode.IsLower) {
+		broken(passwordNoLowercase, "must contain a lowercase letter")
+	}
+
+	if p.RequireDigit && !strings.ContainsFunc(password, unicode.IsDigit) {
+		broken(passwordNoDigit, "must contain a digit")
+	}
+
+	if p.RequireSymbol && !strings.ContainsFunc(password, isPasswordSymbol) {
+		broken(passwordNoSymbol, "must contain a symbol")
+	}
+
+	if len(violations) == 0 && p.Breaches != nil {
+		count, err := p.Breaches.BreachCount(ctx, password)
+
+		switch {
+		case err != nil:
+			slog.Warn("Password breach check failed, password accepted without it", slog.String("error", err.Error()))
+			metrics.RecordPasswordBreachCheckError()
+		case count > 0:
+			broken(passwordBreached, "has appeared in a known data breach, choose another one")
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	metrics.RecordPasswordRejected(violations)
+
+	return appError.WeakPasswordError(violations, "Password "+strings.Join(details, "; "))
+}
+
+func isPasswordSymbol(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
@@ -0,0 +1,148 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	pwnedMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/pwned/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testPasswordPolicy = service.PasswordPolicy{
+	MinLength:        8,
+	RequireUppercase: true,
+	RequireLowercase: true,
+	RequireDigit:     true,
+	RequireSymbol:    true,
+}
+
+func newPasswordPolicyService(t *testing.T, policy service.PasswordPolicy) (service.UserService, *mocks.MockUserRepository) {
+	t.Helper()
+
+	userRepo := mocks.NewMockUserRepository(t)
+	userService := service.NewUserService(userRepo, mocks.NewMockRateLimitRepository(t), mocks.NewMockAuditRepository(t), mocks.NewMockVendorRepository(t), mocks.NewMockSessionRepository(t), svcMocks.NewMockNotificationService(t), policy, testHasher, service.TokenPolicy{Key: []byte("test-key")}, clock.NewFake(testNow))
+
+	return userService, userRepo
+}
+
+func register(t *testing.T, userService service.UserService, password string) error {
+	t.Helper()
+
+	_, err := userService.Register(t.Context(), &models.RegisterRequest{Name: "Test User", Email: "test@example.com", Password: password})
+
+	return err
+}
+
+func TestPasswordPolicy_Rules(t *testing.T) {
+	tests := []struct {
+		name       string
+		password   string
+		violations []string
+	}{
+		{"Too short", "Ab1!", []string{"too_short"}},
+		{"Too long for bcrypt", "Ab1!" + strings.Repeat("a", 69), []string{"too_long"}},
+		{"Missing uppercase", "abcdef1!", []string{"missing_uppercase"}},
+		{"Missing lowercase", "ABCDEF1!", []string{"missing_lowercase"}},
+		{"Missing digit", "Abcdefg!", []string{"missing_digit"}},
+		{"Missing symbol", "Abcdefg1", []string{"missing_symbol"}},
+		{"Every rule broken at once", "", []string{"too_short", "missing_uppercase", "missing_lowercase", "missing_digit", "missing_symbol"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userService, userRepo := newPasswordPolicyService(t, testPasswordPolicy)
+			userRepo.On("GetUserByEmail", mock.Anything, "test@example.com").Return(nil, nil).Once()
+
+			// Act
+			err := register(t, userService, tt.password)
+
+			// Assert
+			var appErr *appErrors.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, appErrors.ErrCodeWeakPassword, appErr.Code)
+			assert.Equal(t, &appErrors.ErrWeakPassword{Violations: tt.violations}, appErr.Meta)
+			userRepo.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("Length counts characters, not bytes", func(t *testing.T) {
+		// Arrange
+		userService, userRepo := newPasswordPolicyService(t, testPasswordPolicy)
+		userRepo.On("GetUserByEmail", mock.Anything, "test@example.com").Return(nil, nil).Once()
+
+		// Act
+		err := register(t, userService, "Äé1!")
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, &appErrors.ErrWeakPassword{Violations: []string{"too_short"}}, appErr.Meta)
+	})
+}
+
+func TestPasswordPolicy_BreachCheck(t *testing.T) {
+	t.Run("Failure - Breached password", func(t *testing.T) {
+		// Arrange
+		breaches := pwnedMocks.NewMockClient(t)
+		policy := testPasswordPolicy
+		policy.Breaches = breaches
+
+		userService, userRepo := newPasswordPolicyService(t, policy)
+		userRepo.On("GetUserByEmail", mock.Anything, "test@example.com").Return(nil, nil).Once()
+		breaches.On("BreachCount", mock.Anything, "P@ssw0rd").Return(42, nil).Once()
+
+		// Act
+		err := register(t, userService, "P@ssw0rd")
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeWeakPassword, appErr.Code)
+		assert.Equal(t, &appErrors.ErrWeakPassword{Violations: []string{"breached"}}, appErr.Meta)
+	})
+
+	t.Run("Success - Unavailable breach check lets the password through", func(t *testing.T) {
+		// Arrange
+		breaches := pwnedMocks.NewMockClient(t)
+		policy := testPasswordPolicy
+		policy.Breaches = breaches
+
+		userService, userRepo := newPasswordPolicyService(t, policy)
+		userRepo.On("GetUserByEmail", mock.Anything, "test@example.com").Return(nil, nil).Once()
+		userRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*models.User")).Return(nil).Once()
+		breaches.On("BreachCount", mock.Anything, "C0rrect-Horse").Return(0, errors.New("timeout")).Once()
+
+		// Act
+		err := register(t, userService, "C0rrect-Horse")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Weak password is refused without a breach check", func(t *testing.T) {
+		// Arrange
+		breaches := pwnedMocks.NewMockClient(t)
+		policy := testPasswordPolicy
+		policy.Breaches = breaches
+
+		userService, userRepo := newPasswordPolicyService(t, policy)
+		userRepo.On("GetUserByEmail", mock.Anything, "test@example.com").Return(nil, nil).Once()
+
+		// Act
+		err := register(t, userService, "short")
+
+		// Assert
+		require.Error(t, err)
+		breaches.AssertNotCalled(t, "BreachCount", mock.Anything, mock.Anything)
+	})
+}
@@ -2,28 +2,44 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"log/slog"
 	"time"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+	"github.com/google/uuid"
 )
 
 type PaymentService interface {
 	CreatePayment(ctx context.Context, req *models.PaymentRequest) (*models.PaymentResponse, error)
 	GetPaymentByID(ctx context.Context, id string) (*models.Payment, error)
 	ListPaymentsByCustomer(ctx context.Context, customerID string, page, size int) ([]*models.Payment, int, error)
-	ProcessWebhook(ctx context.Context, payload []byte, signature string) (stripe.Event, error)
+	VerifyWebhook(payload []byte, signature string) (stripe.Event, error)
+	HandleWebhookEvent(ctx context.Context, event stripe.Event) error
+	ListWebhookDeadLetters(ctx context.Context, unresolvedOnly bool, page, size int) ([]*models.WebhookDeadLetter, int, error)
+	ResolveWebhookDeadLetter(ctx context.Context, id uuid.UUID) error
+	CreateCheckoutSession(ctx context.Context, req *models.CheckoutSessionRequest) (*models.CheckoutSessionResponse, error)
+	GetSalesReport(ctx context.Context, from, to time.Time) (*models.SalesReport, error)
+	ExportPayments(ctx context.Context, from, to time.Time, fn func(models.Payment) error) error
 }
 
 type paymentService struct {
-	repo         repository.PaymentRepository
-	stripeClient stripe.Client
+	repo           repository.PaymentRepository
+	deadLetterRepo repository.WebhookDeadLetterRepository
+	stripeClient   stripe.Client
+	disputeService DisputeService
+	lapseService   OrderLapseService
+	ledgerService  LedgerService
 }
 
-func NewPaymentService(repo repository.PaymentRepository, stripeClient stripe.Client) PaymentService {
-	return &paymentService{repo: repo, stripeClient: stripeClient}
+func NewPaymentService(repo repository.PaymentRepository, deadLetterRepo repository.WebhookDeadLetterRepository, stripeClient stripe.Client, disputeService DisputeService, lapseService OrderLapseService, ledgerService LedgerService) PaymentService {
+	return &paymentService{repo: repo, deadLetterRepo: deadLetterRepo, stripeClient: stripeClient, disputeService: disputeService, lapseService: lapseService, ledgerService: ledgerService}
 }
 
 // CreatePayment implements PaymentService.
@@ -32,7 +48,7 @@ func (s *paymentService) CreatePayment(ctx context.Context, req *models.PaymentR
 	paymentIntent, err := s.stripeClient.CreatePaymentIntent(
 		req.Amount, req.Currency, req.Description, req.CustomerID)
 	if err != nil {
-		return nil, errors.ThirdPartyError("Failed to create payment intent").WithError(err)
+		return nil, stripeError("Failed to create payment intent", err)
 	}
 
 	// create a payment method & attach it to paymentIntent
@@ -40,12 +56,12 @@ func (s *paymentService) CreatePayment(ctx context.Context, req *models.PaymentR
 		// paymentMethod, err := p.stripeClient.CreatePaymentMethod(req.CardNumber, fmt.Sprintf("%d", req.CardExpMonth), fmt.Sprintf("%d", req.CardExpYear), req.CardCVC)
 		paymentMethod, err := s.stripeClient.CreatePaymentMethodFromToken(req.Token)
 		if err != nil {
-			return nil, errors.ThirdPartyError("Failed to create payment method").WithError(err)
+			return nil, stripeError("Failed to create payment method", err)
 		}
 
 		err = s.stripeClient.AttachPaymentMethodToIntent(paymentMethod.ID, paymentIntent.ID)
 		if err != nil {
-			return nil, errors.ThirdPartyError("Failed to attach payment method").WithError(err)
+			return nil, stripeError("Failed to attach payment method", err)
 		}
 	}
 
@@ -75,6 +91,48 @@ func (s *paymentService) CreatePayment(ctx context.Context, req *models.PaymentR
 	}, nil
 }
 
+// stripeError surfaces a card refused by its issuer with its decline code, so the customer can be asked
+// for another card, any other failure of Stripe is ours.
+func stripeError(message string, err error) *errors.AppError {
+	if code, ok := stripe.DeclineCode(err); ok {
+		return errors.PaymentDeclinedError(code, err)
+	}
+
+	return errors.ThirdPartyError(message).WithError(err)
+}
+
+// CreateCheckoutSession implements PaymentService.
+// The payment is recorded under the session ID, it is settled by the checkout.session.* webhooks.
+func (s *paymentService) CreateCheckoutSession(ctx context.Context, req *models.CheckoutSessionRequest) (*models.CheckoutSessionResponse, error) {
+	checkoutSession, err := s.stripeClient.CreateCheckoutSession(req.Amount, req.Currency, req.Description, req.CustomerID)
+	if err != nil {
+		return nil, errors.ThirdPartyError("Failed to create checkout session").WithError(err)
+	}
+
+	payment := &models.Payment{
+		ID:            checkoutSession.ID,
+		CustomerID:    req.CustomerID,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		Description:   req.Description,
+		Status:        models.PaymentStatusPending,
+		PaymentMethod: "checkout",
+		StripeID:      checkoutSession.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := s.repo.CreatePayment(ctx, payment); err != nil {
+		return nil, errors.DatabaseError("Failed to record payment").WithError(err)
+	}
+
+	return &models.CheckoutSessionResponse{
+		Payment:   payment,
+		SessionID: checkoutSession.ID,
+		URL:       checkoutSession.URL,
+	}, nil
+}
+
 // GetPaymentByID implements PaymentService.
 func (s *paymentService) GetPaymentByID(ctx context.Context, id string) (*models.Payment, error) {
 	payment, err := s.repo.GetPaymentByID(ctx, id)
@@ -95,68 +153,250 @@ func (s *paymentService) ListPaymentsByCustomer(ctx context.Context, customerID
 	return payments, total, nil
 }
 
-// ProcessWebhook implements PaymentService.
-func (s *paymentService) ProcessWebhook(ctx context.Context, payload []byte, signature string) (stripe.Event, error) {
+// VerifyWebhook implements PaymentService.
+func (s *paymentService) VerifyWebhook(payload []byte, signature string) (stripe.Event, error) {
 	event, err := s.stripeClient.VerifyWebhookSignature(payload, signature)
 	if err != nil {
 		return stripe.Event{}, errors.ThirdPartyError("Webhook signature verification failed").WithError(err)
 	}
 
+	return event, nil
+}
+
+// HandleWebhookEvent implements PaymentService. The event must have been verified by VerifyWebhook.
+// Events that fail validation can never succeed, they are quarantined for review instead of retried.
+func (s *paymentService) HandleWebhookEvent(ctx context.Context, event stripe.Event) error {
+	err := s.handleWebhookEvent(ctx, event)
+
+	if appErr, ok := errors.IsAppError(err); ok && appErr.Code == errors.ErrCodeValidation {
+		return s.quarantineWebhook(ctx, event, appErr)
+	}
+
+	return err
+}
+
+func (s *paymentService) handleWebhookEvent(ctx context.Context, event stripe.Event) error {
 	switch event.Type {
-	case "payment_intent.succeeded":
-		paymentIntent := event.Data.Object
+	case "payment_intent.succeeded", "payment_intent.payment_failed", "payment_intent.canceled":
+		var paymentIntent stripe.PaymentIntent
+		if err := decodeWebhookObject(event, &paymentIntent); err != nil {
+			return err
+		}
+
+		if paymentIntent.ID == "" {
+			return errors.ValidationError("Missing payment intent ID in webhook")
+		}
+
+		status := models.PaymentStatusSucceeded
+		if event.Type != "payment_intent.succeeded" {
+			status = models.PaymentStatusFailed
+		}
+
+		if err := s.repo.UpdatePaymentStatus(ctx, paymentIntent.ID, status); err != nil {
+			return errors.DatabaseError("Failed to update payment status").WithError(err)
+		}
 
-		stripeIDInterface, ok := paymentIntent["id"]
-		if !ok {
+		if status == models.PaymentStatusSucceeded {
+			return s.ledgerService.RecordCapture(ctx, paymentIntent.ID, string(paymentIntent.Currency), paymentIntent.Amount)
+		}
 
-			return event, errors.InternalError("Payment intent ID not found in Stripe response")
+		// a failed payment can be retried with another card, a cancelled one is over
+		if event.Type == "payment_intent.canceled" {
+			return s.lapseService.LapsePayment(ctx, paymentIntent.ID)
 		}
-		stripeID, ok := stripeIDInterface.(string)
-		if !ok {
-			return event, errors.InternalError("Payment intent ID is not a string in Stripe response")
+
+	case "charge.refunded", "charge.succeeded", "charge.updated":
+		var charge stripe.Charge
+		if err := decodeWebhookObject(event, &charge); err != nil {
+			return err
 		}
 
-		if stripeID == "" {
-			return event, errors.ThirdPartyError("Missing payment intent ID in webhook")
+		if charge.PaymentIntent == nil || charge.PaymentIntent.ID == "" {
+			return errors.ValidationError("Missing payment intent ID in webhook")
 		}
 
-		if err := s.repo.UpdatePaymentStatus(ctx, stripeID, models.PaymentStatusSucceeded); err != nil {
-			return event, errors.DatabaseError("Failed to update payment status").WithError(err)
+		if event.Type == "charge.refunded" {
+			if err := s.repo.UpdatePaymentStatus(ctx, charge.PaymentIntent.ID, models.PaymentStatusRefunded); err != nil {
+				return errors.DatabaseError("Failed to update payment status").WithError(err)
+			}
+
+			return s.ledgerService.RecordRefund(ctx, charge.PaymentIntent.ID, string(charge.Currency), charge.AmountRefunded)
 		}
 
-	case "payment_intent.payment_failed":
-		paymentIntent := event.Data.Object
+		if err := s.recordFees(ctx, charge.PaymentIntent.ID, charge.BalanceTransaction); err != nil {
+			return err
+		}
 
-		stripeIDInterface, ok := paymentIntent["id"]
-		if !ok {
-			return event, errors.InternalError("Payment intent ID not found in Stripe response")
+	case "charge.dispute.created", "charge.dispute.updated", "charge.dispute.closed":
+		if err := s.disputeService.HandleDisputeEvent(ctx, event); err != nil {
+			return err
 		}
 
-		stripeID, ok := stripeIDInterface.(string)
-		if !ok {
-			return event, errors.InternalError("Payment intent ID is not a string in Stripe response")
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded", "checkout.session.async_payment_failed", "checkout.session.expired":
+		var checkoutSession stripe.CheckoutSession
+		if err := decodeWebhookObject(event, &checkoutSession); err != nil {
+			return err
 		}
 
-		if stripeID == "" {
-			return event, errors.ThirdPartyError("Missing payment intent ID in webhook")
+		if checkoutSession.ID == "" {
+			return errors.ValidationError("Missing checkout session ID in webhook")
 		}
 
-		if err := s.repo.UpdatePaymentStatus(ctx, stripeID, models.PaymentStatusFailed); err != nil {
-			return event, errors.DatabaseError("Failed to update payment status").WithError(err)
+		// Delayed payment methods complete the session unpaid, the outcome comes with the async events
+		status := models.PaymentStatusSucceeded
+
+		switch {
+		case event.Type == "checkout.session.async_payment_failed", event.Type == "checkout.session.expired":
+			status = models.PaymentStatusFailed
+		case checkoutSession.PaymentStatus == stripe.CheckoutSessionPaymentStatusUnpaid:
+			return nil
 		}
 
-	case "charge.refunded":
-		chargeObject := event.Data.Object
-		paymentIntentID, piOK := chargeObject["payment_intent"].(string)
+		if err := s.repo.UpdatePaymentStatus(ctx, checkoutSession.ID, status); err != nil {
+			return errors.DatabaseError("Failed to update payment status").WithError(err)
+		}
 
-		if !piOK || paymentIntentID == "" {
-			return event, errors.ThirdPartyError("Missing payment intent ID in webhook")
+		if event.Type == "checkout.session.expired" {
+			return s.lapseService.LapsePayment(ctx, checkoutSession.ID)
 		}
 
-		if err := s.repo.UpdatePaymentStatus(ctx, paymentIntentID, models.PaymentStatusRefunded); err != nil {
-			return event, errors.DatabaseError("Failed to update payment status").WithError(err)
+		if status == models.PaymentStatusSucceeded {
+			return s.ledgerService.RecordCapture(ctx, checkoutSession.ID, string(checkoutSession.Currency), checkoutSession.AmountTotal)
 		}
 	}
 
-	return event, nil
+	return nil
+}
+
+// decodeWebhookObject unmarshals the object of the event into the stripe-go type matching the event type.
+func decodeWebhookObject(event stripe.Event, object any) error {
+	if event.Data == nil || len(event.Data.Raw) == 0 {
+		return errors.ValidationError("Missing object in webhook")
+	}
+
+	if err := json.Unmarshal(event.Data.Raw, object); err != nil {
+		return errors.ValidationError("Invalid " + string(event.Type) + " payload in webhook").WithError(err)
+	}
+
+	return nil
+}
+
+func (s *paymentService) quarantineWebhook(ctx context.Context, event stripe.Event, reason *errors.AppError) error {
+	deadLetter := &models.WebhookDeadLetter{
+		EventID:   event.ID,
+		EventType: string(event.Type),
+		Reason:    reason.Error(),
+	}
+
+	if event.Data != nil && json.Valid(event.Data.Raw) {
+		deadLetter.Payload = json.RawMessage(event.Data.Raw)
+	}
+
+	if err := s.deadLetterRepo.CreateDeadLetter(ctx, deadLetter); err != nil {
+		return errors.DatabaseError("Failed to quarantine webhook event").WithError(err)
+	}
+
+	slog.Warn("Webhook event quarantined",
+		slog.String("stripeEventId", event.ID),
+		slog.String("stripeEventType", string(event.Type)),
+		slog.String("reason", deadLetter.Reason),
+	)
+	metrics.RecordWebhookQuarantined(string(event.Type))
+
+	return nil
+}
+
+// recordFees stores the fee and net amount of a settled charge and records the fee in the ledger. The
+// balance transaction comes either expanded in the webhook payload or as an ID that is looked up through
+// the API. It is not available until the charge settles, in which case Stripe follows up with a
+// charge.updated event.
+func (s *paymentService) recordFees(ctx context.Context, paymentIntentID string, balanceTransaction *stripe.BalanceTransaction) error {
+	if balanceTransaction == nil || balanceTransaction.ID == "" {
+		return nil
+	}
+
+	fee, net, currency := balanceTransaction.Fee, balanceTransaction.Net, balanceTransaction.Currency
+
+	// An unexpanded balance transaction only carries its ID
+	if balanceTransaction.Object == "" {
+		txn, err := s.stripeClient.GetBalanceTransaction(balanceTransaction.ID)
+		if err != nil {
+			return errors.ThirdPartyError("Failed to fetch balance transaction").WithError(err)
+		}
+
+		fee, net, currency = txn.Fee, txn.Net, txn.Currency
+	}
+
+	// the fee left the Stripe balance whether or not a payment record matches the charge
+	if err := s.ledgerService.RecordFee(ctx, paymentIntentID, balanceTransaction.ID, string(currency), fee); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdatePaymentFees(ctx, paymentIntentID, fee, net); err != nil {
+		// Checkout payments are tracked by session ID, their charges don't map to a payment record
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			slog.Warn("Received fees for an unknown payment", slog.String("paymentIntentId", paymentIntentID))
+
+			return nil
+		}
+
+		return errors.DatabaseError("Failed to update payment fees").WithError(err)
+	}
+
+	return nil
+}
+
+func (s *paymentService) ListWebhookDeadLetters(ctx context.Context, unresolvedOnly bool, page, size int) ([]*models.WebhookDeadLetter, int, error) {
+	deadLetters, total, err := s.deadLetterRepo.ListDeadLetters(ctx, unresolvedOnly, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to fetch webhook dead letters").WithError(err)
+	}
+
+	if deadLetters == nil {
+		deadLetters = []*models.WebhookDeadLetter{}
+	}
+
+	return deadLetters, total, nil
+}
+
+func (s *paymentService) ResolveWebhookDeadLetter(ctx context.Context, id uuid.UUID) error {
+	if err := s.deadLetterRepo.ResolveDeadLetter(ctx, id); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return errors.NotFoundError("Unresolved webhook dead letter not found").WithError(err)
+		}
+
+		return errors.DatabaseError("Failed to resolve webhook dead letter").WithError(err)
+	}
+
+	return nil
+}
+
+func (s *paymentService) GetSalesReport(ctx context.Context, from, to time.Time) (*models.SalesReport, error) {
+	summaries, err := s.repo.GetSalesSummary(ctx, from, to)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch sales summary").WithError(err)
+	}
+
+	if summaries == nil {
+		summaries = []models.SalesSummary{}
+	}
+
+	return &models.SalesReport{From: from, To: to, Summaries: summaries}, nil
+}
+
+// ExportPayments implements PaymentService. Payments are streamed to fn one at a time, its errors
+// and the cancellation of ctx are returned as is, only the failures of the query are wrapped.
+func (s *paymentService) ExportPayments(ctx context.Context, from, to time.Time, fn func(models.Payment) error) error {
+	var fnErr error
+
+	err := s.repo.StreamPayments(ctx, from, to, func(payment models.Payment) error {
+		fnErr = fn(payment)
+
+		return fnErr
+	})
+	if err == nil || fnErr != nil || ctx.Err() != nil {
+		return err
+	}
+
+	return errors.DatabaseError("Failed to export payments").WithError(err)
 }
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+)
+
+// PaymentCleanupService voids the payment intents left pending by payments that never completed, like
+// checkouts abandoned before the order was placed or intents replaced by a payment retry, and brings
+// the payments table in line with what Stripe knows of them.
+type PaymentCleanupService interface {
+	CleanupOrphanedPayments(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// PaymentCleanupPolicy sets how old a pending payment without an order has to be before its intent is
+// cancelled, and how many payments a sweep looks at a time. MinAge should leave the customer time to
+// finish paying, it is best kept above the payment window of the orders.
+type PaymentCleanupPolicy struct {
+	MinAge    time.Duration
+	BatchSize int
+}
+
+type paymentCleanupService struct {
+	paymentRepo  repository.PaymentRepository
+	stripeClient stripe.Client
+	policy       PaymentCleanupPolicy
+	clock        clock.Clock
+}
+
+func NewPaymentCleanupService(paymentRepo repository.PaymentRepository, stripeClient stripe.Client, policy PaymentCleanupPolicy, clk clock.Clock) PaymentCleanupService {
+	policy.BatchSize = max(policy.BatchSize, 1)
+
+	return &paymentCleanupService{paymentRepo: paymentRepo, stripeClient: stripeClient, policy: policy, clock: clk}
+}
+
+// CleanupOrphanedPayments settles the orphaned payments older than the minimum age batch by batch,
+// returning how many were settled. A payment failing to settle is logged and left for the next sweep.
+func (s *paymentCleanupService) CleanupOrphanedPayments(ctx context.Context) (int, error) {
+	createdBefore := s.clock.Now().Add(-s.policy.MinAge)
+	settled := 0
+
+	for {
+		ids, err := s.paymentRepo.ListOrphanedPaymentIDs(ctx, createdBefore, s.policy.BatchSize)
+		if err != nil {
+			return settled, errors.DatabaseError("Failed to list orphaned payments").WithError(err)
+		}
+
+		settledInBatch := 0
+
+		for _, id := range ids {
+			ok, err := s.settle(ctx, id)
+			if err != nil {
+				slog.Error("Failed to clean up orphaned payment", slog.String("paymentId", id), slog.String("error", err.Error()))
+
+				continue
+			}
+
+			if ok {
+				settledInBatch++
+			}
+		}
+
+		settled += settledInBatch
+
+		// a batch where nothing settled would be listed again, it waits for the next sweep
+		if len(ids) < s.policy.BatchSize || settledInBatch == 0 {
+			return settled, nil
+		}
+	}
+}
+
+// settle cancels the intent of the payment when Stripe still waits on it, and records the outcome. A
+// cancelled intent, or one Stripe does not know, fails the payment like the payment_intent.canceled
+// webhook does. An intent still processing is left alone, it reports false.
+func (s *paymentCleanupService) settle(ctx context.Context, id string) (bool, error) {
+	status := models.PaymentStatusFailed
+
+	intent, err := s.stripeClient.GetPaymentIntent(id)
+
+	switch {
+	case err != nil && !stripe.IsNotFound(err):
+		return false, errors.ThirdPartyError("Failed to get payment intent").WithError(err)
+	case err != nil:
+		slog.Warn("Orphaned payment unknown to Stripe", slog.String("paymentId", id))
+	case intent.Status == stripe.PaymentIntentStatusProcessing:
+		return false, nil
+	case intent.Status == stripe.PaymentIntentStatusSucceeded:
+		// the webhook was missed, the money came in for a payment no order points to
+		status = models.PaymentStatusSucceeded
+
+		slog.Warn("Orphaned payment succeeded without an order", slog.String("paymentId", id), slog.Int64("amount", intent.Amount))
+	case intent.Status != stripe.PaymentIntentStatusCanceled:
+		if _, err := s.stripeClient.CancelPaymentIntent(id); err != nil {
+			return false, errors.ThirdPartyError("Failed to cancel payment intent").WithError(err)
+		}
+
+		slog.Info("Orphaned payment intent cancelled", slog.String("paymentId", id), slog.String("intentStatus", string(intent.Status)))
+	}
+
+	if err := s.paymentRepo.UpdatePaymentStatus(ctx, id, status); err != nil {
+		return false, errors.DatabaseError("Failed to update payment status").WithError(err)
+	}
+
+	return true, nil
+}
+
+// Run cleans up the orphaned payments every interval until ctx is cancelled.
+func (s *paymentCleanupService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			settled, err := s.CleanupOrphanedPayments(ctx)
+			if err != nil {
+				slog.Error("Scheduled cleanup of orphaned payments failed", slog.String("error", err.Error()))
+			}
+
+			if settled > 0 {
+				slog.Info("Orphaned payments cleaned up", slog.Int("count", settled))
+			}
+		}
+	}
+}
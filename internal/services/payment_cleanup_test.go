@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	stripeMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+var testPaymentCleanupPolicy = service.PaymentCleanupPolicy{MinAge: 48 * time.Hour, BatchSize: 2}
+
+func setupPaymentCleanupServiceTest(t *testing.T) (service.PaymentCleanupService, *mocks.MockPaymentRepository, *stripeMocks.MockClient) {
+	paymentRepo := mocks.NewMockPaymentRepository(t)
+	stripeClient := stripeMocks.NewMockClient(t)
+
+	return service.NewPaymentCleanupService(paymentRepo, stripeClient, testPaymentCleanupPolicy, clock.NewFake(testNow)), paymentRepo, stripeClient
+}
+
+func TestCleanupOrphanedPayments(t *testing.T) {
+	ctx := t.Context()
+	createdBefore := testNow.Add(-testPaymentCleanupPolicy.MinAge)
+
+	t.Run("Success - Abandoned intents are cancelled batch by batch", func(t *testing.T) {
+		// Arrange
+		cleanupService, paymentRepo, stripeClient := setupPaymentCleanupServiceTest(t)
+		paymentRepo.On("ListOrphanedPaymentIDs", ctx, createdBefore, 2).Return([]string{"pi_1", "pi_2"}, nil).Once()
+		paymentRepo.On("ListOrphanedPaymentIDs", ctx, createdBefore, 2).Return([]string{"pi_3"}, nil).Once()
+
+		for _, id := range []string{"pi_1", "pi_2", "pi_3"} {
+			stripeClient.On("GetPaymentIntent", id).Return(&stripe.PaymentIntent{ID: id, Status: stripe.PaymentIntentStatusRequiresPaymentMethod}, nil).Once()
+			stripeClient.On("CancelPaymentIntent", id).Return(&stripe.PaymentIntent{ID: id, Status: stripe.PaymentIntentStatusCanceled}, nil).Once()
+			paymentRepo.On("UpdatePaymentStatus", ctx, id, models.PaymentStatusFailed).Return(nil).Once()
+		}
+
+		// Act
+		settled, err := cleanupService.CleanupOrphanedPayments(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, settled)
+	})
+
+	t.Run("Success - Local status follows Stripe", func(t *testing.T) {
+		// Arrange
+		cleanupService, paymentRepo, stripeClient := setupPaymentCleanupServiceTest(t)
+		paymentRepo.On("ListOrphanedPaymentIDs", ctx, createdBefore, 2).Return([]string{"pi_paid", "pi_cancelled"}, nil).Once()
+		paymentRepo.On("ListOrphanedPaymentIDs", ctx, createdBefore, 2).Return([]string{"pi_gone"}, nil).Once()
+		stripeClient.On("GetPaymentIntent", "pi_paid").Return(&stripe.PaymentIntent{ID: "pi_paid", Status: stripe.PaymentIntentStatusSucceeded}, nil).Once()
+		stripeClient.On("GetPaymentIntent", "pi_cancelled").Return(&stripe.PaymentIntent{ID: "pi_cancelled", Status: stripe.PaymentIntentStatusCanceled}, nil).Once()
+		stripeClient.On("GetPaymentIntent", "pi_gone").Return(nil, &stripe.Error{Code: stripe.ErrorCodeResourceMissing}).Once()
+		paymentRepo.On("UpdatePaymentStatus", ctx, "pi_paid", models.PaymentStatusSucceeded).Return(nil).Once()
+		paymentRepo.On("UpdatePaymentStatus", ctx, "pi_cancelled", models.PaymentStatusFailed).Return(nil).Once()
+		paymentRepo.On("UpdatePaymentStatus", ctx, "pi_gone", models.PaymentStatusFailed).Return(nil).Once()
+
+		// Act
+		settled, err := cleanupService.CleanupOrphanedPayments(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, settled)
+		stripeClient.AssertNotCalled(t, "CancelPaymentIntent", "pi_paid")
+		stripeClient.AssertNotCalled(t, "CancelPaymentIntent", "pi_cancelled")
+	})
+
+	t.Run("Success - Processing and failing intents wait for the next sweep", func(t *testing.T) {
+		// Arrange
+		cleanupService, paymentRepo, stripeClient := setupPaymentCleanupServiceTest(t)
+		paymentRepo.On("ListOrphanedPaymentIDs", ctx, createdBefore, 2).Return([]string{"pi_processing", "pi_error"}, nil).Once()
+		stripeClient.On("GetPaymentIntent", "pi_processing").Return(&stripe.PaymentIntent{ID: "pi_processing", Status: stripe.PaymentIntentStatusProcessing}, nil).Once()
+		stripeClient.On("GetPaymentIntent", "pi_error").Return(&stripe.PaymentIntent{ID: "pi_error", Status: stripe.PaymentIntentStatusRequiresAction}, nil).Once()
+		stripeClient.On("CancelPaymentIntent", "pi_error").Return(nil, errors.New("stripe down")).Once()
+
+		// Act
+		settled, err := cleanupService.CleanupOrphanedPayments(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, settled)
+		paymentRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+	})
+
+	t.Run("Failure - Listing fails", func(t *testing.T) {
+		// Arrange
+		cleanupService, paymentRepo, _ := setupPaymentCleanupServiceTest(t)
+		paymentRepo.On("ListOrphanedPaymentIDs", ctx, createdBefore, 2).Return(nil, errors.New("db down")).Once()
+
+		// Act
+		settled, err := cleanupService.CleanupOrphanedPayments(ctx)
+
+		// Assert
+		require.Error(t, err)
+		assert.Zero(t, settled)
+	})
+}
@@ -1,7 +1,11 @@
 package service_test
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,17 +13,19 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repoMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
 	stripeMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v81"
 )
 
 func TestNewPaymentService(t *testing.T) {
 	mockRepo := repoMocks.NewMockPaymentRepository(t)
 	mockStripeClient := stripeMocks.NewMockClient(t)
-	service := service.NewPaymentService(mockRepo, mockStripeClient)
+	service := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 	assert.NotNil(t, service)
 }
 
@@ -77,7 +83,7 @@ func TestCreatePayment(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		mockStripeClient.On("CreatePaymentIntent", reqCard.Amount, reqCard.Currency, reqCard.Description, reqCard.CustomerID).Return(mockPaymentIntent, nil).Once()
 		mockStripeClient.On("CreatePaymentMethodFromToken", reqCard.Token).Return(mockPaymentMethod, nil).Once()
@@ -107,7 +113,7 @@ func TestCreatePayment(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		mockPaymentIntentOther := &stripe.PaymentIntent{
 			ID:           "pi_789",
@@ -144,7 +150,7 @@ func TestCreatePayment(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		stripeErr := errors.New("stripe API error")
 		mockStripeClient.On("CreatePaymentIntent", reqCard.Amount, reqCard.Currency, reqCard.Description, reqCard.CustomerID).Return(nil, stripeErr).Once()
@@ -169,7 +175,7 @@ func TestCreatePayment(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		stripeErr := errors.New("stripe token error")
 
@@ -193,11 +199,41 @@ func TestCreatePayment(t *testing.T) {
 		mockStripeClient.AssertNotCalled(t, "AttachPaymentMethodToIntent")
 	})
 
+	t.Run("Failure - Card declined", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		stripeErr := &stripe.Error{Type: stripe.ErrorTypeCard, Code: stripe.ErrorCodeCardDeclined, DeclineCode: stripe.DeclineCodeInsufficientFunds}
+
+		mockStripeClient.On("CreatePaymentIntent", reqCard.Amount, reqCard.Currency, reqCard.Description, reqCard.CustomerID).Return(mockPaymentIntent, nil).Once()
+		mockStripeClient.On("CreatePaymentMethodFromToken", reqCard.Token).Return(mockPaymentMethod, nil).Once()
+		mockStripeClient.On("AttachPaymentMethodToIntent", mockPaymentMethod.ID, mockPaymentIntent.ID).Return(stripeErr).Once()
+
+		// Act
+		resp, err := paymentService.CreatePayment(ctx, reqCard)
+
+		// Assert
+		assert.Nil(t, resp)
+
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodePaymentDeclined, appErr.Code)
+
+		var declined *appErrors.ErrPaymentDeclined
+		require.ErrorAs(t, err, &declined)
+		assert.Equal(t, "insufficient_funds", declined.Code)
+		assert.ErrorIs(t, err, stripeErr)
+
+		mockRepo.AssertNotCalled(t, "CreatePayment")
+	})
+
 	t.Run("Failure - AttachPaymentMethodToIntent Fails", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		stripeErr := errors.New("stripe attach error")
 
@@ -225,7 +261,7 @@ func TestCreatePayment(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		dbErr := errors.New("database insert error")
 
@@ -269,7 +305,7 @@ func TestGetPaymentByID(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		mockRepo.On("GetPaymentByID", ctx, testPaymentID).Return(expectedPayment, nil).Once()
 
@@ -287,7 +323,7 @@ func TestGetPaymentByID(t *testing.T) {
 	t.Run("Failure - Repository Error", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		repoErr := errors.New("payment not found in DB")
 		mockRepo.On("GetPaymentByID", ctx, testPaymentID).Return(nil, repoErr).Once()
@@ -324,7 +360,7 @@ func TestListPaymentsByCustomer(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		mockRepo.On("ListPaymentsOfCustomer", ctx, testCustomerID, page, size).Return(expectedPayments, expectedTotal, nil).Once()
 
@@ -342,7 +378,7 @@ func TestListPaymentsByCustomer(t *testing.T) {
 	t.Run("Failure - Repository Error", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		repoErr := errors.New("failed to query payments")
 		mockRepo.On("ListPaymentsOfCustomer", ctx, testCustomerID, page, size).Return(nil, 0, repoErr).Once()
@@ -364,6 +400,23 @@ func TestListPaymentsByCustomer(t *testing.T) {
 	})
 }
 
+// webhookEventData carries the object both raw and decoded, as stripe-go does for a received event.
+func webhookEventData(object map[string]any) *stripe.EventData {
+	raw, _ := json.Marshal(object)
+
+	return &stripe.EventData{Object: object, Raw: raw}
+}
+
+// processWebhook verifies and handles the payload the way the webhook handler and queue do together.
+func processWebhook(ctx context.Context, paymentService service.PaymentService, payload []byte, signature string) (stripe.Event, error) {
+	event, err := paymentService.VerifyWebhook(payload, signature)
+	if err != nil {
+		return event, err
+	}
+
+	return event, paymentService.HandleWebhookEvent(ctx, event)
+}
+
 func TestProcessWebhook(t *testing.T) {
 	ctx := t.Context()
 
@@ -374,59 +427,49 @@ func TestProcessWebhook(t *testing.T) {
 	eventSucceeded := stripe.Event{
 		ID:   "evt_123",
 		Type: "payment_intent.succeeded",
-		Data: &stripe.EventData{
-			Object: map[string]any{
-				"id": stripePaymentIntentID,
-			},
-		},
+		Data: webhookEventData(map[string]any{
+			"id": stripePaymentIntentID,
+		}),
 	}
 	eventFailed := stripe.Event{
 		ID:   "evt_456",
 		Type: "payment_intent.payment_failed",
-		Data: &stripe.EventData{
-			Object: map[string]interface{}{
-				"id": stripePaymentIntentID,
-			},
-		},
+		Data: webhookEventData(map[string]interface{}{
+			"id": stripePaymentIntentID,
+		}),
 	}
 	eventRefunded := stripe.Event{
 		ID:   "evt_789",
 		Type: "charge.refunded",
-		Data: &stripe.EventData{
-			Object: map[string]any{
-				"id":             "ch_xyz",
-				"payment_intent": stripePaymentIntentID,
-			},
-		},
+		Data: webhookEventData(map[string]any{
+			"id":             "ch_xyz",
+			"payment_intent": stripePaymentIntentID,
+		}),
 	}
 	eventOther := stripe.Event{
 		ID:   "evt_000",
 		Type: "customer.created",
-		Data: &stripe.EventData{
-			Object: map[string]interface{}{"id": "cus_123"},
-		},
+		Data: webhookEventData(map[string]interface{}{"id": "cus_123"}),
 	}
 	eventMissingID := stripe.Event{
 		ID:   "evt_bad",
 		Type: "payment_intent.succeeded",
-		Data: &stripe.EventData{
-			Object: map[string]interface{}{
-				"amount": 1000,
-			},
-		},
+		Data: webhookEventData(map[string]interface{}{
+			"amount": 1000,
+		}),
 	}
 
 	t.Run("Success - payment_intent.succeeded", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(eventSucceeded, nil).Once()
 		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusSucceeded).Return(nil).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payload, signature)
+		event, err := processWebhook(ctx, paymentService, payload, signature)
 
 		// Assert
 		assert.NoError(t, err)
@@ -441,14 +484,14 @@ func TestProcessWebhook(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		payloadFailed := []byte(`{"id": "evt_456", "type": "payment_intent.payment_failed", "data": {"object": {"id": "pi_abc"}}}`)
 		mockStripeClient.On("VerifyWebhookSignature", payloadFailed, signature).Return(eventFailed, nil).Once()
 		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusFailed).Return(nil).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadFailed, signature)
+		event, err := processWebhook(ctx, paymentService, payloadFailed, signature)
 
 		// Assert
 		assert.NoError(t, err)
@@ -458,18 +501,38 @@ func TestProcessWebhook(t *testing.T) {
 		mockStripeClient.AssertExpectations(t)
 	})
 
+	t.Run("Success - payment_intent.canceled lapses the order", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		mockLapse := svcMocks.NewMockOrderLapseService(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), mockLapse, permissiveLedgerService(t))
+
+		payloadCanceled := []byte(`{"id": "evt_457", "type": "payment_intent.canceled", "data": {"object": {"id": "pi_abc"}}}`)
+		eventCanceled := stripe.Event{ID: "evt_457", Type: "payment_intent.canceled", Data: webhookEventData(map[string]any{"id": stripePaymentIntentID})}
+		mockStripeClient.On("VerifyWebhookSignature", payloadCanceled, signature).Return(eventCanceled, nil).Once()
+		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusFailed).Return(nil).Once()
+		mockLapse.On("LapsePayment", ctx, stripePaymentIntentID).Return(nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payloadCanceled, signature)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
 	t.Run("Success - charge.refunded", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		payloadRefunded := []byte(`{"id": "evt_789", "type": "charge.refunded", "data": {"object": {"id": "ch_xyz", "payment_intent": "pi_abc"}}}`)
 		mockStripeClient.On("VerifyWebhookSignature", payloadRefunded, signature).Return(eventRefunded, nil).Once()
 		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusRefunded).Return(nil).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadRefunded, signature)
+		event, err := processWebhook(ctx, paymentService, payloadRefunded, signature)
 
 		// Assert
 		assert.NoError(t, err)
@@ -483,13 +546,13 @@ func TestProcessWebhook(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		payloadOther := []byte(`{"id": "evt_000", "type": "customer.created", "data": {"object": {"id": "cus_123"}}}`)
 		mockStripeClient.On("VerifyWebhookSignature", payloadOther, signature).Return(eventOther, nil).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadOther, signature)
+		event, err := processWebhook(ctx, paymentService, payloadOther, signature)
 
 		// Assert
 		assert.NoError(t, err)
@@ -503,13 +566,13 @@ func TestProcessWebhook(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		verifyErr := errors.New("invalid signature")
 		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(stripe.Event{}, verifyErr).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payload, signature)
+		event, err := processWebhook(ctx, paymentService, payload, signature)
 
 		// Assert
 		assert.Error(t, err)
@@ -524,28 +587,29 @@ func TestProcessWebhook(t *testing.T) {
 		mockStripeClient.AssertExpectations(t)
 	})
 
-	t.Run("Failure - Missing Payment Intent ID (Succeeded)", func(t *testing.T) {
+	t.Run("Quarantine - Missing Payment Intent ID (Succeeded)", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, mockDeadLetterRepo, mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		payloadMissingID := []byte(`{"id": "evt_bad", "type": "payment_intent.succeeded", "data": {"object": {"amount": 1000}}}`)
 		mockStripeClient.On("VerifyWebhookSignature", payloadMissingID, signature).Return(eventMissingID, nil).Once()
 
+		mockDeadLetterRepo.On("CreateDeadLetter", ctx, mock.MatchedBy(func(dl *models.WebhookDeadLetter) bool {
+			return dl.EventID == eventMissingID.ID && strings.Contains(dl.Reason, "Missing payment intent ID")
+		})).Return(nil).Once()
+
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadMissingID, signature)
+		event, err := processWebhook(ctx, paymentService, payloadMissingID, signature)
 
 		// Assert
-		assert.Error(t, err)
+		assert.NoError(t, err, "invalid events are quarantined, not retried")
 		assert.Equal(t, eventMissingID.ID, event.ID)
 
-		appErr, ok := appErrors.IsAppError(err)
-		assert.True(t, ok)
-		assert.Equal(t, appErrors.ErrCodeInternal, appErr.Code)
-		assert.Contains(t, err.Error(), "Payment intent ID not found")
-
 		mockRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+		mockDeadLetterRepo.AssertExpectations(t)
 		mockStripeClient.AssertExpectations(t)
 	})
 
@@ -553,7 +617,7 @@ func TestProcessWebhook(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		dbErr := errors.New("db update failed")
 
@@ -561,7 +625,7 @@ func TestProcessWebhook(t *testing.T) {
 		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusSucceeded).Return(dbErr).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payload, signature)
+		event, err := processWebhook(ctx, paymentService, payload, signature)
 
 		// Assert
 		assert.Error(t, err)
@@ -576,33 +640,34 @@ func TestProcessWebhook(t *testing.T) {
 		mockStripeClient.AssertExpectations(t)
 	})
 
-	t.Run("Failure - Missing Payment Intent ID (Failed)", func(t *testing.T) {
+	t.Run("Quarantine - Missing Payment Intent ID (Failed)", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, mockDeadLetterRepo, mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		eventMissingIDFailed := stripe.Event{
 			ID:   "evt_bad_fail",
 			Type: "payment_intent.payment_failed",
-			Data: &stripe.EventData{Object: map[string]interface{}{"reason": "card_declined"}},
+			Data: webhookEventData(map[string]interface{}{"reason": "card_declined"}),
 		}
 		payloadMissingIDFailed := []byte(`{"id": "evt_bad_fail", "type": "payment_intent.payment_failed", "data": {"object": {"reason": "card_declined"}}}`)
 		mockStripeClient.On("VerifyWebhookSignature", payloadMissingIDFailed, signature).Return(eventMissingIDFailed, nil).Once()
 
+		mockDeadLetterRepo.On("CreateDeadLetter", ctx, mock.MatchedBy(func(dl *models.WebhookDeadLetter) bool {
+			return dl.EventID == eventMissingIDFailed.ID && strings.Contains(dl.Reason, "Missing payment intent ID")
+		})).Return(nil).Once()
+
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadMissingIDFailed, signature)
+		event, err := processWebhook(ctx, paymentService, payloadMissingIDFailed, signature)
 
 		// Assert
-		assert.Error(t, err)
+		assert.NoError(t, err, "invalid events are quarantined, not retried")
 		assert.Equal(t, eventMissingIDFailed.ID, event.ID)
 
-		appErr, ok := appErrors.IsAppError(err)
-		assert.True(t, ok)
-		assert.Equal(t, appErrors.ErrCodeInternal, appErr.Code)
-		assert.Contains(t, err.Error(), "Payment intent ID not found")
-
 		mockRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+		mockDeadLetterRepo.AssertExpectations(t)
 		mockStripeClient.AssertExpectations(t)
 	})
 
@@ -610,7 +675,7 @@ func TestProcessWebhook(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		dbErr := errors.New("db update failed")
 		payloadFailed := []byte(`{"id": "evt_456", "type": "payment_intent.payment_failed", "data": {"object": {"id": "pi_abc"}}}`)
@@ -618,7 +683,7 @@ func TestProcessWebhook(t *testing.T) {
 		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusFailed).Return(dbErr).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadFailed, signature)
+		event, err := processWebhook(ctx, paymentService, payloadFailed, signature)
 
 		// Assert
 		assert.Error(t, err)
@@ -633,33 +698,34 @@ func TestProcessWebhook(t *testing.T) {
 		mockStripeClient.AssertExpectations(t)
 	})
 
-	t.Run("Failure - Missing Payment Intent ID (Refunded)", func(t *testing.T) {
+	t.Run("Quarantine - Missing Payment Intent ID (Refunded)", func(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, mockDeadLetterRepo, mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		eventMissingIDRefunded := stripe.Event{
 			ID:   "evt_bad_refund",
 			Type: "charge.refunded",
-			Data: &stripe.EventData{Object: map[string]any{"id": "ch_xyz"}},
+			Data: webhookEventData(map[string]any{"id": "ch_xyz"}),
 		}
 		payloadMissingIDRefunded := []byte(`{"id": "evt_bad_refund", "type": "charge.refunded", "data": {"object": {"id": "ch_xyz"}}}`)
 		mockStripeClient.On("VerifyWebhookSignature", payloadMissingIDRefunded, signature).Return(eventMissingIDRefunded, nil).Once()
 
+		mockDeadLetterRepo.On("CreateDeadLetter", ctx, mock.MatchedBy(func(dl *models.WebhookDeadLetter) bool {
+			return dl.EventID == eventMissingIDRefunded.ID && strings.Contains(dl.Reason, "Missing payment intent ID")
+		})).Return(nil).Once()
+
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadMissingIDRefunded, signature)
+		event, err := processWebhook(ctx, paymentService, payloadMissingIDRefunded, signature)
 
 		// Assert
-		assert.Error(t, err)
+		assert.NoError(t, err, "invalid events are quarantined, not retried")
 		assert.Equal(t, eventMissingIDRefunded.ID, event.ID)
 
-		appErr, ok := appErrors.IsAppError(err)
-		assert.True(t, ok)
-		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
-		assert.Contains(t, err.Error(), "Missing payment intent ID")
-
 		mockRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+		mockDeadLetterRepo.AssertExpectations(t)
 		mockStripeClient.AssertExpectations(t)
 	})
 
@@ -667,7 +733,7 @@ func TestProcessWebhook(t *testing.T) {
 		// Arrange
 		mockRepo := repoMocks.NewMockPaymentRepository(t)
 		mockStripeClient := stripeMocks.NewMockClient(t)
-		paymentService := service.NewPaymentService(mockRepo, mockStripeClient)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
 
 		dbErr := errors.New("db update failed")
 		payloadRefunded := []byte(`{"id": "evt_789", "type": "charge.refunded", "data": {"object": {"id": "ch_xyz", "payment_intent": "pi_abc"}}}`)
@@ -675,7 +741,7 @@ func TestProcessWebhook(t *testing.T) {
 		mockRepo.On("UpdatePaymentStatus", ctx, stripePaymentIntentID, models.PaymentStatusRefunded).Return(dbErr).Once()
 
 		// Act
-		event, err := paymentService.ProcessWebhook(ctx, payloadRefunded, signature)
+		event, err := processWebhook(ctx, paymentService, payloadRefunded, signature)
 
 		// Assert
 		assert.Error(t, err)
@@ -690,3 +756,543 @@ func TestProcessWebhook(t *testing.T) {
 		mockStripeClient.AssertExpectations(t)
 	})
 }
+
+func TestCreateCheckoutSession(t *testing.T) {
+	ctx := t.Context()
+
+	req := &models.CheckoutSessionRequest{
+		CustomerID:  uuid.New().String(),
+		Amount:      2500,
+		Currency:    "usd",
+		Description: "Order #42",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		checkoutSession := &stripe.CheckoutSession{ID: "cs_123", URL: "https://checkout.stripe.com/c/pay/cs_123"}
+		mockStripeClient.On("CreateCheckoutSession", req.Amount, req.Currency, req.Description, req.CustomerID).Return(checkoutSession, nil).Once()
+		mockRepo.On("CreatePayment", ctx, mock.MatchedBy(func(p *models.Payment) bool {
+			return p.ID == "cs_123" && p.StripeID == "cs_123" && p.Amount == req.Amount && p.Status == models.PaymentStatusPending && p.PaymentMethod == "checkout"
+		})).Return(nil).Once()
+
+		// Act
+		resp, err := paymentService.CreateCheckoutSession(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "cs_123", resp.SessionID)
+		assert.Equal(t, checkoutSession.URL, resp.URL)
+		assert.Equal(t, req.CustomerID, resp.Payment.CustomerID)
+	})
+
+	t.Run("Failure - Stripe Error", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		stripeErr := errors.New("stripe unavailable")
+		mockStripeClient.On("CreateCheckoutSession", req.Amount, req.Currency, req.Description, req.CustomerID).Return(nil, stripeErr).Once()
+
+		// Act
+		resp, err := paymentService.CreateCheckoutSession(ctx, req)
+
+		// Assert
+		assert.Nil(t, resp)
+
+		appErr, ok := appErrors.IsAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
+		assert.ErrorIs(t, err, stripeErr)
+
+		mockRepo.AssertNotCalled(t, "CreatePayment")
+	})
+}
+
+func TestProcessWebhook_CheckoutSession(t *testing.T) {
+	ctx := t.Context()
+	signature := "whsec_sig"
+
+	tests := []struct {
+		name           string
+		eventType      stripe.EventType
+		paymentStatus  string
+		expectedStatus models.PaymentStatus
+		expectUpdate   bool
+	}{
+		{"Completed and paid", "checkout.session.completed", "paid", models.PaymentStatusSucceeded, true},
+		{"Completed but unpaid", "checkout.session.completed", "unpaid", "", false},
+		{"Async payment succeeded", "checkout.session.async_payment_succeeded", "paid", models.PaymentStatusSucceeded, true},
+		{"Async payment failed", "checkout.session.async_payment_failed", "unpaid", models.PaymentStatusFailed, true},
+		{"Expired", "checkout.session.expired", "unpaid", models.PaymentStatusFailed, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := repoMocks.NewMockPaymentRepository(t)
+			mockStripeClient := stripeMocks.NewMockClient(t)
+			mockLapse := svcMocks.NewMockOrderLapseService(t)
+			paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), mockLapse, permissiveLedgerService(t))
+
+			payload := []byte(`{"id": "evt_cs"}`)
+			event := stripe.Event{
+				ID:   "evt_cs",
+				Type: tc.eventType,
+				Data: webhookEventData(map[string]any{"id": "cs_123", "payment_status": tc.paymentStatus}),
+			}
+
+			mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+
+			if tc.expectUpdate {
+				mockRepo.On("UpdatePaymentStatus", ctx, "cs_123", tc.expectedStatus).Return(nil).Once()
+			}
+
+			if tc.eventType == "checkout.session.expired" {
+				mockLapse.On("LapsePayment", ctx, "cs_123").Return(nil).Once()
+			}
+
+			// Act
+			_, err := processWebhook(ctx, paymentService, payload, signature)
+
+			// Assert
+			assert.NoError(t, err)
+
+			if !tc.expectUpdate {
+				mockRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+			}
+		})
+	}
+}
+
+func TestProcessWebhook_Dispute(t *testing.T) {
+	ctx := t.Context()
+	signature := "whsec_sig"
+	payload := []byte(`{"id": "evt_dp"}`)
+
+	t.Run("Dispute events are delegated", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		mockDisputeService := svcMocks.NewMockDisputeService(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, mockDisputeService, svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := stripe.Event{ID: "evt_dp", Type: "charge.dispute.created", Data: webhookEventData(map[string]any{"id": "dp_123"})}
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+		mockDisputeService.On("HandleDisputeEvent", ctx, event).Return(nil).Once()
+
+		// Act
+		result, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "evt_dp", result.ID)
+		mockRepo.AssertNotCalled(t, "UpdatePaymentStatus")
+	})
+
+	t.Run("Dispute handling error is returned", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		mockDisputeService := svcMocks.NewMockDisputeService(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, mockDisputeService, svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := stripe.Event{ID: "evt_dp", Type: "charge.dispute.closed", Data: webhookEventData(map[string]any{"id": "dp_123"})}
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+		mockDisputeService.On("HandleDisputeEvent", ctx, event).Return(appErrors.DatabaseError("Failed to save dispute")).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestProcessWebhook_ChargeFees(t *testing.T) {
+	ctx := t.Context()
+	signature := "whsec_sig"
+	payload := []byte(`{"id": "evt_ch"}`)
+
+	chargeEvent := func(eventType stripe.EventType, balanceTransaction any) stripe.Event {
+		return stripe.Event{
+			ID:   "evt_ch",
+			Type: eventType,
+			Data: webhookEventData(map[string]any{"id": "ch_123", "payment_intent": "pi_123", "balance_transaction": balanceTransaction}),
+		}
+	}
+
+	t.Run("Success - Expanded balance transaction", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := chargeEvent("charge.succeeded", map[string]any{"id": "txn_123", "object": "balance_transaction", "fee": float64(175), "net": float64(4825)})
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+		mockRepo.On("UpdatePaymentFees", ctx, "pi_123", int64(175), int64(4825)).Return(nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Balance transaction looked up", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := chargeEvent("charge.updated", "txn_123")
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+		mockStripeClient.On("GetBalanceTransaction", "txn_123").Return(&stripe.BalanceTransaction{ID: "txn_123", Fee: 175, Net: 4825}, nil).Once()
+		mockRepo.On("UpdatePaymentFees", ctx, "pi_123", int64(175), int64(4825)).Return(nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Charge not settled yet", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := chargeEvent("charge.succeeded", nil)
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "UpdatePaymentFees")
+	})
+
+	t.Run("Success - Unknown payment is ignored", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := chargeEvent("charge.updated", map[string]any{"id": "txn_123", "object": "balance_transaction", "fee": float64(175), "net": float64(4825)})
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+		mockRepo.On("UpdatePaymentFees", ctx, "pi_123", int64(175), int64(4825)).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure - Balance transaction lookup error", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		event := chargeEvent("charge.updated", "txn_123")
+
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+		mockStripeClient.On("GetBalanceTransaction", "txn_123").Return(nil, errors.New("stripe down")).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		var appErr *appErrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeThirdPartyError, appErr.Code)
+	})
+}
+
+func TestProcessWebhook_Ledger(t *testing.T) {
+	ctx := t.Context()
+	signature := "whsec_sig"
+	payload := []byte(`{"id": "evt_ledger"}`)
+
+	setup := func(t *testing.T, event stripe.Event) (service.PaymentService, *repoMocks.MockPaymentRepository, *svcMocks.MockLedgerService) {
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		mockStripeClient := stripeMocks.NewMockClient(t)
+		mockLedger := svcMocks.NewMockLedgerService(t)
+		mockStripeClient.On("VerifyWebhookSignature", payload, signature).Return(event, nil).Once()
+
+		return service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), mockStripeClient, svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), mockLedger), mockRepo, mockLedger
+	}
+
+	t.Run("Success - Captured payment intent", func(t *testing.T) {
+		// Arrange
+		event := stripe.Event{ID: "evt_ledger", Type: "payment_intent.succeeded", Data: webhookEventData(map[string]any{"id": "pi_123", "amount": float64(5000), "currency": "usd"})}
+		paymentService, mockRepo, mockLedger := setup(t, event)
+		mockRepo.On("UpdatePaymentStatus", ctx, "pi_123", models.PaymentStatusSucceeded).Return(nil).Once()
+		mockLedger.On("RecordCapture", ctx, "pi_123", "usd", int64(5000)).Return(nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Paid checkout session", func(t *testing.T) {
+		// Arrange
+		event := stripe.Event{ID: "evt_ledger", Type: "checkout.session.completed", Data: webhookEventData(map[string]any{"id": "cs_123", "payment_status": "paid", "amount_total": float64(5000), "currency": "usd"})}
+		paymentService, mockRepo, mockLedger := setup(t, event)
+		mockRepo.On("UpdatePaymentStatus", ctx, "cs_123", models.PaymentStatusSucceeded).Return(nil).Once()
+		mockLedger.On("RecordCapture", ctx, "cs_123", "usd", int64(5000)).Return(nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Refunded charge", func(t *testing.T) {
+		// Arrange
+		event := stripe.Event{ID: "evt_ledger", Type: "charge.refunded", Data: webhookEventData(map[string]any{"id": "ch_123", "payment_intent": "pi_123", "amount_refunded": float64(2000), "currency": "usd"})}
+		paymentService, mockRepo, mockLedger := setup(t, event)
+		mockRepo.On("UpdatePaymentStatus", ctx, "pi_123", models.PaymentStatusRefunded).Return(nil).Once()
+		mockLedger.On("RecordRefund", ctx, "pi_123", "usd", int64(2000)).Return(nil).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Fee recorded even without a payment record", func(t *testing.T) {
+		// Arrange
+		event := stripe.Event{ID: "evt_ledger", Type: "charge.succeeded", Data: webhookEventData(map[string]any{
+			"id": "ch_123", "payment_intent": "pi_123",
+			"balance_transaction": map[string]any{"id": "txn_123", "object": "balance_transaction", "fee": float64(175), "net": float64(4825), "currency": "usd"},
+		})}
+		paymentService, mockRepo, mockLedger := setup(t, event)
+		mockLedger.On("RecordFee", ctx, "pi_123", "txn_123", "usd", int64(175)).Return(nil).Once()
+		mockRepo.On("UpdatePaymentFees", ctx, "pi_123", int64(175), int64(4825)).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Ledger error fails the event so it is retried", func(t *testing.T) {
+		// Arrange
+		event := stripe.Event{ID: "evt_ledger", Type: "payment_intent.succeeded", Data: webhookEventData(map[string]any{"id": "pi_123", "amount": float64(5000), "currency": "usd"})}
+		paymentService, mockRepo, mockLedger := setup(t, event)
+		mockRepo.On("UpdatePaymentStatus", ctx, "pi_123", models.PaymentStatusSucceeded).Return(nil).Once()
+		mockLedger.On("RecordCapture", ctx, "pi_123", "usd", int64(5000)).Return(appErrors.DatabaseError("Failed to record ledger transaction")).Once()
+
+		// Act
+		_, err := processWebhook(ctx, paymentService, payload, signature)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestGetSalesReport(t *testing.T) {
+	ctx := t.Context()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		summaries := []models.SalesSummary{{Currency: "usd", PaymentCount: 2, GrossAmount: 5000, FeeAmount: 175, NetAmount: 4825}}
+		mockRepo.On("GetSalesSummary", ctx, from, to).Return(summaries, nil).Once()
+
+		// Act
+		report, err := paymentService.GetSalesReport(ctx, from, to)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &models.SalesReport{From: from, To: to, Summaries: summaries}, report)
+	})
+
+	t.Run("Success - No sales", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		mockRepo.On("GetSalesSummary", ctx, from, to).Return(nil, nil).Once()
+
+		// Act
+		report, err := paymentService.GetSalesReport(ctx, from, to)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, report.Summaries)
+		assert.Empty(t, report.Summaries)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		mockRepo.On("GetSalesSummary", ctx, from, to).Return(nil, errors.New("db error")).Once()
+
+		// Act
+		report, err := paymentService.GetSalesReport(ctx, from, to)
+
+		// Assert
+		var appErr *appErrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		assert.Nil(t, report)
+	})
+}
+
+func TestHandleWebhookEvent_Quarantine(t *testing.T) {
+	ctx := t.Context()
+	event := stripe.Event{ID: "evt_bad", Type: "charge.refunded", Data: &stripe.EventData{Raw: []byte(`{"id": "ch_123", "amount": "not-a-number"}`)}}
+
+	t.Run("Unparseable payload is quarantined", func(t *testing.T) {
+		// Arrange
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
+		paymentService := service.NewPaymentService(repoMocks.NewMockPaymentRepository(t), mockDeadLetterRepo, stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		mockDeadLetterRepo.On("CreateDeadLetter", ctx, mock.MatchedBy(func(dl *models.WebhookDeadLetter) bool {
+			return dl.EventID == "evt_bad" && dl.EventType == "charge.refunded" && string(dl.Payload) == string(event.Data.Raw)
+		})).Return(nil).Once()
+
+		// Act
+		err := paymentService.HandleWebhookEvent(ctx, event)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure - Dead letter not saved", func(t *testing.T) {
+		// Arrange
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
+		paymentService := service.NewPaymentService(repoMocks.NewMockPaymentRepository(t), mockDeadLetterRepo, stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		mockDeadLetterRepo.On("CreateDeadLetter", ctx, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		err := paymentService.HandleWebhookEvent(ctx, event)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code, "the event is retried until it is quarantined")
+	})
+}
+
+func TestWebhookDeadLetters(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("List", func(t *testing.T) {
+		// Arrange
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
+		paymentService := service.NewPaymentService(repoMocks.NewMockPaymentRepository(t), mockDeadLetterRepo, stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		mockDeadLetterRepo.On("ListDeadLetters", ctx, true, 1, 10).Return(nil, 0, nil).Once()
+
+		// Act
+		deadLetters, total, err := paymentService.ListWebhookDeadLetters(ctx, true, 1, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, deadLetters)
+		assert.Empty(t, deadLetters)
+		assert.Zero(t, total)
+	})
+
+	t.Run("Resolve", func(t *testing.T) {
+		// Arrange
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
+		paymentService := service.NewPaymentService(repoMocks.NewMockPaymentRepository(t), mockDeadLetterRepo, stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+		id := uuid.New()
+
+		mockDeadLetterRepo.On("ResolveDeadLetter", ctx, id).Return(nil).Once()
+
+		// Act
+		err := paymentService.ResolveWebhookDeadLetter(ctx, id)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Resolve - Not found", func(t *testing.T) {
+		// Arrange
+		mockDeadLetterRepo := repoMocks.NewMockWebhookDeadLetterRepository(t)
+		paymentService := service.NewPaymentService(repoMocks.NewMockPaymentRepository(t), mockDeadLetterRepo, stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+		id := uuid.New()
+
+		mockDeadLetterRepo.On("ResolveDeadLetter", ctx, id).Return(sql.ErrNoRows).Once()
+
+		// Act
+		err := paymentService.ResolveWebhookDeadLetter(ctx, id)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestExportPayments(t *testing.T) {
+	ctx := t.Context()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Failure - Writer error is returned as is", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		writeErr := errors.New("broken pipe")
+		mockRepo.On("StreamPayments", ctx, from, to, mock.Anything).
+			Return(func(_ context.Context, _, _ time.Time, fn func(models.Payment) error) error {
+				return fn(models.Payment{ID: "pi_123"})
+			}).Once()
+
+		// Act
+		err := paymentService.ExportPayments(ctx, from, to, func(_ models.Payment) error { return writeErr })
+
+		// Assert
+		assert.Equal(t, writeErr, err)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := repoMocks.NewMockPaymentRepository(t)
+		paymentService := service.NewPaymentService(mockRepo, repoMocks.NewMockWebhookDeadLetterRepository(t), stripeMocks.NewMockClient(t), svcMocks.NewMockDisputeService(t), svcMocks.NewMockOrderLapseService(t), permissiveLedgerService(t))
+
+		mockRepo.On("StreamPayments", ctx, from, to, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		err := paymentService.ExportPayments(ctx, from, to, func(_ models.Payment) error { return nil })
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
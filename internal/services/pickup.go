@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+const pickupCodeDigits = 6
+
+type PickupService interface {
+	CreateLocation(ctx context.Context, req *models.CreatePickupLocationRequest) (*models.PickupLocation, error)
+	UpdateLocation(ctx context.Context, id uuid.UUID, req *models.UpdatePickupLocationRequest) (*models.PickupLocation, error)
+	ListLocations(ctx context.Context, activeOnly bool) ([]*models.PickupLocation, error)
+	MarkReady(ctx context.Context, orderID uuid.UUID) (*models.OrderPickup, error)
+	VerifyPickup(ctx context.Context, req *models.VerifyPickupRequest) (*models.Order, error)
+}
+
+type pickupService struct {
+	repo                repository.PickupRepository
+	orderRepo           repository.OrderRepository
+	userRepo            repository.UserRepository
+	notificationService NotificationService
+	trackingService     OrderTrackingService
+	fraudService        FraudService
+}
+
+func NewPickupService(repo repository.PickupRepository, orderRepo repository.OrderRepository, userRepo repository.UserRepository, notificationService NotificationService, trackingService OrderTrackingService, fraudService FraudService) PickupService {
+	return &pickupService{
+		repo:                repo,
+		orderRepo:           orderRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		trackingService:     trackingService,
+		fraudService:        fraudService,
+	}
+}
+
+func (s *pickupService) CreateLocation(ctx context.Context, req *models.CreatePickupLocationRequest) (*models.PickupLocation, error) {
+	location := &models.PickupLocation{
+		ID:      uuid.New(),
+		Name:    req.Name,
+		Address: req.Address,
+		Active:  true,
+	}
+
+	if err := s.repo.CreateLocation(ctx, location); err != nil {
+		return nil, errors.DatabaseError("Failed to create pickup location").WithError(err)
+	}
+
+	return location, nil
+}
+
+func (s *pickupService) UpdateLocation(ctx context.Context, id uuid.UUID, req *models.UpdatePickupLocationRequest) (*models.PickupLocation, error) {
+	location, err := s.repo.GetLocationByID(ctx, id)
+	if err != nil {
+		return nil, errors.NotFoundError("Pickup location not found").WithError(err)
+	}
+
+	if req.Name != nil {
+		location.Name = *req.Name
+	}
+
+	if req.Address != nil {
+		location.Address = *req.Address
+	}
+
+	if req.Active != nil {
+		location.Active = *req.Active
+	}
+
+	if err := s.repo.UpdateLocation(ctx, location); err != nil {
+		return nil, errors.DatabaseError("Failed to update pickup location").WithError(err)
+	}
+
+	return location, nil
+}
+
+func (s *pickupService) ListLocations(ctx context.Context, activeOnly bool) ([]*models.PickupLocation, error) {
+	locations, err := s.repo.ListLocations(ctx, activeOnly)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch pickup locations").WithError(err)
+	}
+
+	return locations, nil
+}
+
+// MarkReady issues a fresh pickup code for the order and emails it to the customer.
+func (s *pickupService) MarkReady(ctx context.Context, orderID uuid.UUID) (*models.OrderPickup, error) {
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if order.FulfillmentType != models.FulfillmentPickup || order.PickupLocationID == nil {
+		return nil, errors.BadRequestError("Order is not a pickup order")
+	}
+
+	if order.Status == models.OrderStatusCancelled || order.Status == models.OrderStatusDelivered {
+		return nil, errors.BadRequestError("Order can no longer be picked up")
+	}
+
+	held, err := s.fraudService.HeldOrders(ctx, []uuid.UUID{order.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if held[order.ID] {
+		return nil, errors.FraudReviewError()
+	}
+
+	code, err := generatePickupCode()
+	if err != nil {
+		return nil, errors.InternalError("Failed to generate pickup code").WithError(err)
+	}
+
+	pickup := &models.OrderPickup{
+		OrderID:          order.ID,
+		PickupLocationID: *order.PickupLocationID,
+		Code:             code,
+	}
+
+	if err := s.repo.SavePickup(ctx, pickup); err != nil {
+		return nil, errors.DatabaseError("Failed to save pickup").WithError(err)
+	}
+
+	if _, err := s.orderRepo.UpdateOrderStatus(ctx, order.ID, models.OrderStatusReadyForPickup); err != nil {
+		return nil, errors.DatabaseError("Failed to update order status").WithError(err)
+	}
+
+	s.notifyCustomer(ctx, order, code)
+
+	return pickup, nil
+}
+
+// notifyCustomer is best effort, the code can be re-issued by marking the order ready again.
+func (s *pickupService) notifyCustomer(ctx context.Context, order *models.Order, code string) {
+	user, err := s.userRepo.GetUserByID(ctx, order.CustomerID)
+	if err != nil {
+		slog.Error("Failed to look up customer for pickup notification",
+			slog.String("orderId", order.ID.String()),
+			slog.String("error", err.Error()))
+
+		return
+	}
+
+	req := &models.EmailNotificationRequest{
+		To:       user.Email,
+		Subject:  "Your order is ready for pickup",
+		Content:  fmt.Sprintf("Order %s is ready for pickup. Show the code %s at the store to collect it.", order.ID, code) + trackingLine(s.trackingService, order.ID),
+		Metadata: map[string]string{"order_id": order.ID.String()},
+	}
+
+	if _, err := s.notificationService.SendEmail(ctx, req); err != nil {
+		slog.Error("Failed to send pickup notification",
+			slog.String("orderId", order.ID.String()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// VerifyPickup checks the code shown by the customer and hands the order over.
+func (s *pickupService) VerifyPickup(ctx context.Context, req *models.VerifyPickupRequest) (*models.Order, error) {
+	pickup, err := s.repo.GetPickupByOrderID(ctx, req.OrderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Pickup not found").WithError(err)
+	}
+
+	if pickup.PickedUpAt != nil {
+		return nil, errors.BadRequestError("Order has already been picked up")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(pickup.Code), []byte(req.Code)) != 1 {
+		return nil, errors.BadRequestError("Invalid pickup code")
+	}
+
+	order, err := s.orderRepo.GetOrderByID(ctx, req.OrderID)
+	if err != nil {
+		return nil, errors.NotFoundError("Order not found").WithError(err)
+	}
+
+	if order.Status != models.OrderStatusReadyForPickup {
+		return nil, errors.BadRequestError("Order is not ready for pickup")
+	}
+
+	if err := s.repo.MarkPickedUp(ctx, req.OrderID); err != nil {
+		return nil, errors.DatabaseError("Failed to record pickup").WithError(err)
+	}
+
+	order, err = s.orderRepo.UpdateOrderStatus(ctx, req.OrderID, models.OrderStatusDelivered)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to update order status").WithError(err)
+	}
+
+	return order, nil
+}
+
+func generatePickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", pickupCodeDigits, n.Int64()), nil
+}
@@ -0,0 +1,200 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type pickupServiceMocks struct {
+	repo         *mocks.MockPickupRepository
+	orderRepo    *mocks.MockOrderRepository
+	userRepo     *mocks.MockUserRepository
+	notification *svcMocks.MockNotificationService
+}
+
+func setupPickupServiceTest(t *testing.T) (service.PickupService, *pickupServiceMocks) {
+	m := &pickupServiceMocks{
+		repo:         mocks.NewMockPickupRepository(t),
+		orderRepo:    mocks.NewMockOrderRepository(t),
+		userRepo:     mocks.NewMockUserRepository(t),
+		notification: svcMocks.NewMockNotificationService(t),
+	}
+
+	return service.NewPickupService(m.repo, m.orderRepo, m.userRepo, m.notification, service.NewOrderTrackingService(nil, nil, testOrderTrackingPolicy), permissiveFraudService(t)), m
+}
+
+func TestUpdatePickupLocation(t *testing.T) {
+	ctx := t.Context()
+	locationID := uuid.New()
+	inactive := false
+
+	t.Run("Success - Deactivate", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.repo.On("GetLocationByID", ctx, locationID).Return(&models.PickupLocation{ID: locationID, Name: "Downtown", Active: true}, nil).Once()
+		m.repo.On("UpdateLocation", ctx, mock.MatchedBy(func(l *models.PickupLocation) bool {
+			return l.ID == locationID && l.Name == "Downtown" && !l.Active
+		})).Return(nil).Once()
+
+		// Act
+		location, err := pickupService.UpdateLocation(ctx, locationID, &models.UpdatePickupLocationRequest{Active: &inactive})
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, location.Active)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.repo.On("GetLocationByID", ctx, locationID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		location, err := pickupService.UpdateLocation(ctx, locationID, &models.UpdatePickupLocationRequest{Active: &inactive})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+		assert.Nil(t, location)
+	})
+}
+
+func TestMarkPickupReady(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	customerID := uuid.New()
+	locationID := uuid.New()
+
+	pickupOrder := func() *models.Order {
+		return &models.Order{
+			ID:               orderID,
+			CustomerID:       customerID,
+			Status:           models.OrderStatusConfirmed,
+			FulfillmentType:  models.FulfillmentPickup,
+			PickupLocationID: &locationID,
+		}
+	}
+
+	t.Run("Success - Code is issued and emailed", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+
+		var code string
+
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(pickupOrder(), nil).Once()
+		m.repo.On("SavePickup", ctx, mock.MatchedBy(func(p *models.OrderPickup) bool {
+			code = p.Code
+
+			return p.OrderID == orderID && p.PickupLocationID == locationID && len(p.Code) == 6
+		})).Return(nil).Once()
+		m.orderRepo.On("UpdateOrderStatus", ctx, orderID, models.OrderStatusReadyForPickup).Return(&models.Order{}, nil).Once()
+		m.userRepo.On("GetUserByID", ctx, customerID).Return(&models.User{ID: customerID, Email: "customer@example.com"}, nil).Once()
+		m.notification.On("SendEmail", ctx, mock.MatchedBy(func(req *models.EmailNotificationRequest) bool {
+			return req.To == "customer@example.com" && strings.Contains(req.Content, code) && strings.Contains(req.Content, "Track your order at https://shop.example.com/track/")
+		})).Return(&models.NotificationResponse{}, nil).Once()
+
+		// Act
+		pickup, err := pickupService.MarkReady(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, orderID, pickup.OrderID)
+	})
+
+	t.Run("Success - Email failure is ignored", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(pickupOrder(), nil).Once()
+		m.repo.On("SavePickup", ctx, mock.Anything).Return(nil).Once()
+		m.orderRepo.On("UpdateOrderStatus", ctx, orderID, models.OrderStatusReadyForPickup).Return(&models.Order{}, nil).Once()
+		m.userRepo.On("GetUserByID", ctx, customerID).Return(&models.User{ID: customerID, Email: "customer@example.com"}, nil).Once()
+		m.notification.On("SendEmail", ctx, mock.Anything).Return(nil, errors.New("sendgrid down")).Once()
+
+		// Act
+		_, err := pickupService.MarkReady(ctx, orderID)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Not a pickup order", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, FulfillmentType: models.FulfillmentShipping}, nil).Once()
+
+		// Act
+		pickup, err := pickupService.MarkReady(ctx, orderID)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		assert.Nil(t, pickup)
+	})
+}
+
+func TestVerifyPickup(t *testing.T) {
+	ctx := t.Context()
+	orderID := uuid.New()
+	req := &models.VerifyPickupRequest{OrderID: orderID, Code: "042137"}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.repo.On("GetPickupByOrderID", ctx, orderID).Return(&models.OrderPickup{OrderID: orderID, Code: "042137"}, nil).Once()
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, Status: models.OrderStatusReadyForPickup}, nil).Once()
+		m.repo.On("MarkPickedUp", ctx, orderID).Return(nil).Once()
+		m.orderRepo.On("UpdateOrderStatus", ctx, orderID, models.OrderStatusDelivered).Return(&models.Order{ID: orderID, Status: models.OrderStatusDelivered}, nil).Once()
+
+		// Act
+		order, err := pickupService.VerifyPickup(ctx, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.OrderStatusDelivered, order.Status)
+	})
+
+	t.Run("Failure - Wrong code", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.repo.On("GetPickupByOrderID", ctx, orderID).Return(&models.OrderPickup{OrderID: orderID, Code: "999999"}, nil).Once()
+
+		// Act
+		order, err := pickupService.VerifyPickup(ctx, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		assert.Nil(t, order)
+	})
+
+	t.Run("Failure - Order not ready", func(t *testing.T) {
+		// Arrange
+		pickupService, m := setupPickupServiceTest(t)
+		m.repo.On("GetPickupByOrderID", ctx, orderID).Return(&models.OrderPickup{OrderID: orderID, Code: "042137"}, nil).Once()
+		m.orderRepo.On("GetOrderByID", ctx, orderID).Return(&models.Order{ID: orderID, Status: models.OrderStatusCancelled}, nil).Once()
+
+		// Act
+		order, err := pickupService.VerifyPickup(ctx, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		assert.Nil(t, order)
+	})
+}
@@ -3,8 +3,16 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
@@ -13,28 +21,75 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-const productTracerName = "ecommerce/productservice"
+const (
+	productTracerName      = "ecommerce/productservice"
+	productFacetsTTL       = time.Minute      // facet counts may lag behind catalog changes by up to this long
+	productAvailabilityTTL = 15 * time.Second // bounds how stale stock is after orders, which do not invalidate it
+	productFacetsNamespace = "product_facets"
+	lowStockThreshold      = 5
+	dispatchCutoffHour     = 14 // UTC, later orders leave the warehouse on the next business day
+	maxPriceBatchSize      = 1000
+	publishBatchSize       = 100
+)
 
 type ProductService interface {
 	CreateProduct(ctx context.Context, req *models.CreateProductRequest) (*models.Product, error)
+	CreateVendorProduct(ctx context.Context, vendorID uuid.UUID, req *models.CreateProductRequest) (*models.Product, error)
 	GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error)
+	LookupProduct(ctx context.Context, sku, barcode string) (*models.Product, error)
 	UpdateProduct(ctx context.Context, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error)
+	UpdateVendorProduct(ctx context.Context, vendorID, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error)
+	SetRelatedProducts(ctx context.Context, id uuid.UUID, req *models.SetRelatedProductsRequest) ([]models.RelatedProduct, error)
+	SetPurchaseLimit(ctx context.Context, id uuid.UUID, req *models.SetPurchaseLimitRequest) (*models.Product, error)
 	ListProducts(ctx context.Context, page, pageSize int) ([]*models.Product, int, error)
+	ListVendorProducts(ctx context.Context, vendorID uuid.UUID, page, pageSize int) ([]*models.Product, int, error)
+	GetProductFacets(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error)
+	GetProductAvailability(ctx context.Context, id uuid.UUID) (*models.ProductAvailability, error)
+	ListProductChanges(ctx context.Context, since string, limit int) (*models.ProductChangeFeed, error)
+	UpdatePrices(ctx context.Context, adminID uuid.UUID, req *models.BulkPriceUpdateRequest) (*models.BulkPriceUpdateResult, error)
+	QuickEditProducts(ctx context.Context, adminID uuid.UUID, edits []models.ProductQuickEdit) (*models.ProductQuickEditResult, error)
+	PublishScheduledProducts(ctx context.Context) (int, error)
+	RunPublisher(ctx context.Context, interval time.Duration)
 }
 type productService struct {
-	repo repository.ProductRepository
+	repo          repository.ProductRepository
+	inventoryRepo repository.InventoryRepository
+	cache         cache.Cache
+	clock         clock.Clock
 }
 
-func NewProductService(repo repository.ProductRepository) ProductService {
-	return &productService{repo: repo}
+func NewProductService(repo repository.ProductRepository, inventoryRepo repository.InventoryRepository, cache cache.Cache, clk clock.Clock) ProductService {
+	return &productService{repo: repo, inventoryRepo: inventoryRepo, cache: cache, clock: clk}
 }
 
 func (s *productService) CreateProduct(ctx context.Context, req *models.CreateProductRequest) (*models.Product, error) {
+	return s.createProduct(ctx, nil, req)
+}
+
+// CreateVendorProduct lists a product sold by the vendor, only the vendor can manage it afterwards.
+func (s *productService) CreateVendorProduct(ctx context.Context, vendorID uuid.UUID, req *models.CreateProductRequest) (*models.Product, error) {
+	return s.createProduct(ctx, &vendorID, req)
+}
+
+func (s *productService) createProduct(ctx context.Context, vendorID *uuid.UUID, req *models.CreateProductRequest) (*models.Product, error) {
 	tracer := otel.Tracer(productTracerName)
 
 	ctx, span := tracer.Start(ctx, "CreateProduct")
 	defer span.End()
 
+	if err := s.checkBarcodeAvailable(ctx, req.Barcode, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	status := req.Status
+	if status == "" {
+		status = models.ProductStatusActive
+	}
+
+	if err := s.checkSchedule(status, req.PublishAt); err != nil {
+		return nil, err
+	}
+
 	product := &models.Product{
 		ID:            uuid.New(),
 		CategoryID:    req.CategoryID,
@@ -43,7 +98,10 @@ func (s *productService) CreateProduct(ctx context.Context, req *models.CreatePr
 		Price:         req.Price,
 		StockQuantity: req.StockQuantity,
 		SKU:           req.SKU,
-		Status:        "active",
+		Barcode:       req.Barcode,
+		Status:        status,
+		VendorID:      vendorID,
+		PublishAt:     req.PublishAt,
 	}
 
 	err := s.repo.CreateProduct(ctx, product)
@@ -78,17 +136,57 @@ func (s *productService) GetProductByID(ctx context.Context, id uuid.UUID) (*mod
 		return nil, appErrors.DatabaseError("Failed to get product").WithError(err)
 	}
 
+	related, err := s.repo.ListRelatedProducts(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to get related products").WithError(err)
+	}
+
+	product.RelatedProducts = related
+
+	images, err := s.repo.ListProductImages(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to get product images").WithError(err)
+	}
+
+	product.Images = images
+
 	return product, nil
 }
 
+// UpdateProduct updates a product of the platform, vendor products are managed by their vendor only.
 func (s *productService) UpdateProduct(ctx context.Context, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error) {
+	return s.updateProduct(ctx, nil, id, req)
+}
+
+// UpdateVendorProduct updates a product owned by the vendor.
+func (s *productService) UpdateVendorProduct(ctx context.Context, vendorID, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error) {
+	return s.updateProduct(ctx, &vendorID, id, req)
+}
+
+func (s *productService) updateProduct(ctx context.Context, vendorID *uuid.UUID, id uuid.UUID, req *models.UpdateProductRequest) (*models.Product, error) {
 	tracer := otel.Tracer(productTracerName)
 	ctx, span := tracer.Start(ctx, "UpdateProduct")
 	span.SetAttributes(attribute.String("product.id", id.String()))
 
 	defer span.End()
 
-	product, err := s.repo.GetProductByID(ctx, id)
+	// Vendors only ever see their own products, the others are reported as not found
+	var product *models.Product
+
+	var err error
+
+	if vendorID != nil {
+		product, err = s.repo.GetVendorProduct(ctx, *vendorID, id)
+	} else {
+		product, err = s.repo.GetProductByID(ctx, id)
+	}
+
 	if err != nil {
 		span.RecordError(err)
 		span.SetAttributes(attribute.Bool("db.error", true))
@@ -96,6 +194,10 @@ func (s *productService) UpdateProduct(ctx context.Context, id uuid.UUID, req *m
 		return nil, appErrors.NotFoundError("Product not found").WithError(err)
 	}
 
+	if vendorID == nil && product.VendorID != nil {
+		return nil, appErrors.ForbiddenError("Product is managed by its vendor")
+	}
+
 	if req.CategoryID != nil {
 		product.CategoryID = *req.CategoryID
 	}
@@ -112,15 +214,46 @@ func (s *productService) UpdateProduct(ctx context.Context, id uuid.UUID, req *m
 		product.Price = *req.Price
 	}
 
+	previousStock := product.StockQuantity
+
 	if req.StockQuantity != nil {
 		product.StockQuantity = *req.StockQuantity
 	}
 
-	if req.Status != nil {
+	if req.Barcode != nil && *req.Barcode != product.Barcode {
+		if err := s.checkBarcodeAvailable(ctx, *req.Barcode, product.ID); err != nil {
+			return nil, err
+		}
+
+		product.Barcode = *req.Barcode
+	}
+
+	if req.Status != nil && *req.Status != product.Status {
+		if !models.CanTransitionProductStatus(product.Status, *req.Status) {
+			return nil, appErrors.InvalidTransitionError(fmt.Sprintf("Product cannot go from %s to %s", product.Status, *req.Status))
+		}
+
 		product.Status = *req.Status
+		product.PublishAt = nil
+	}
+
+	if req.PublishAt != nil {
+		product.PublishAt = req.PublishAt
+	}
+
+	// A product left scheduled keeps its publish time, even one the publisher has not caught up with yet
+	if req.Status != nil || req.PublishAt != nil {
+		if err := s.checkSchedule(product.Status, product.PublishAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if vendorID != nil {
+		err = s.repo.UpdateVendorProduct(ctx, *vendorID, product)
+	} else {
+		err = s.repo.UpdateProduct(ctx, product)
 	}
 
-	err = s.repo.UpdateProduct(ctx, product)
 	if err != nil {
 		span.RecordError(err)
 		span.SetAttributes(attribute.Bool("db.error", true))
@@ -128,9 +261,220 @@ func (s *productService) UpdateProduct(ctx context.Context, id uuid.UUID, req *m
 		return nil, appErrors.DatabaseError("Failed to update product").WithError(err)
 	}
 
+	// Manual stock changes go to the ledger as well, otherwise they would show up as drift
+	if product.StockQuantity != previousStock {
+		movement := &models.StockMovement{
+			ID:        uuid.New(),
+			ProductID: product.ID,
+			Quantity:  previousStock - product.StockQuantity,
+			Reason:    models.StockMovementAdjustment,
+		}
+
+		if err := s.inventoryRepo.RecordMovement(ctx, movement); err != nil {
+			span.RecordError(err)
+			span.SetAttributes(attribute.Bool("db.error", true))
+
+			return nil, appErrors.DatabaseError("Failed to record stock adjustment").WithError(err)
+		}
+	}
+
+	if err := s.cache.Delete(ctx, productAvailabilityCacheKey(id)); err != nil {
+		slog.Warn("Failed to invalidate product availability", slog.String("productId", id.String()), slog.String("error", err.Error()))
+	}
+
 	return product, err
 }
 
+// checkSchedule requires a publish time ahead for a scheduled product, and none for the others.
+func (s *productService) checkSchedule(status string, publishAt *time.Time) error {
+	if status != models.ProductStatusScheduled {
+		if publishAt != nil {
+			return appErrors.ValidationError("Only scheduled products have a publish time")
+		}
+
+		return nil
+	}
+
+	if publishAt == nil || !publishAt.After(s.clock.Now()) {
+		return appErrors.ValidationError("A scheduled product needs a publish time in the future")
+	}
+
+	return nil
+}
+
+// LookupProduct resolves a scanned SKU or barcode to its product, exactly one of them must be given.
+func (s *productService) LookupProduct(ctx context.Context, sku, barcode string) (*models.Product, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "LookupProduct")
+	span.SetAttributes(attribute.String("product.sku", sku), attribute.String("product.barcode", barcode))
+
+	defer span.End()
+
+	var (
+		product *models.Product
+		err     error
+	)
+
+	switch {
+	case sku != "" && barcode != "":
+		return nil, appErrors.BadRequestError("Provide either a SKU or a barcode, not both")
+	case sku != "":
+		product, err = s.repo.GetProductBySKU(ctx, sku)
+	case barcode != "":
+		product, err = s.repo.GetProductByBarcode(ctx, barcode)
+	default:
+		return nil, appErrors.BadRequestError("A SKU or a barcode is required")
+	}
+
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Product not found").WithError(err)
+		}
+
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to look up product").WithError(err)
+	}
+
+	return product, nil
+}
+
+// checkBarcodeAvailable makes sure a barcode resolves to a single product, owner is the product allowed to hold it.
+func (s *productService) checkBarcodeAvailable(ctx context.Context, barcode string, owner uuid.UUID) error {
+	if barcode == "" {
+		return nil
+	}
+
+	existing, err := s.repo.GetProductByBarcode(ctx, barcode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return appErrors.DatabaseError("Failed to check barcode").WithError(err)
+	}
+
+	if existing.ID != owner {
+		return appErrors.DuplicateEntryError("Barcode is already assigned to another product")
+	}
+
+	return nil
+}
+
+// SetRelatedProducts replaces the curated related, upsell and cross-sell lists of the product.
+func (s *productService) SetRelatedProducts(ctx context.Context, id uuid.UUID, req *models.SetRelatedProductsRequest) ([]models.RelatedProduct, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "SetRelatedProducts")
+	span.SetAttributes(attribute.String("product.id", id.String()), attribute.Int("relations", len(req.Relations)))
+
+	defer span.End()
+
+	if _, err := s.repo.GetProductByID(ctx, id); err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Product not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to get product").WithError(err)
+	}
+
+	type relationKey struct {
+		productID    uuid.UUID
+		relationType models.ProductRelationType
+	}
+
+	seen := make(map[relationKey]bool, len(req.Relations))
+	positions := make(map[models.ProductRelationType]int)
+	relations := make([]models.ProductRelation, 0, len(req.Relations))
+
+	for _, input := range req.Relations {
+		if input.ProductID == id {
+			return nil, appErrors.BadRequestError("A product cannot be related to itself")
+		}
+
+		key := relationKey{productID: input.ProductID, relationType: input.Type}
+		if seen[key] {
+			return nil, appErrors.BadRequestError(fmt.Sprintf("Product %s is listed twice as %s", input.ProductID, input.Type))
+		}
+
+		seen[key] = true
+
+		if _, err := s.repo.GetProductByID(ctx, input.ProductID); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, appErrors.BadRequestError(fmt.Sprintf("Related product %s not found", input.ProductID)).WithError(err)
+			}
+
+			return nil, appErrors.DatabaseError("Failed to get related product").WithError(err)
+		}
+
+		relations = append(relations, models.ProductRelation{
+			RelatedProductID: input.ProductID,
+			Type:             input.Type,
+			Position:         positions[input.Type],
+		})
+		positions[input.Type]++
+	}
+
+	if err := s.repo.SetRelatedProducts(ctx, id, relations); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to save related products").WithError(err)
+	}
+
+	related, err := s.repo.ListRelatedProducts(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to get related products").WithError(err)
+	}
+
+	if related == nil {
+		return []models.RelatedProduct{}, nil
+	}
+
+	return related, nil
+}
+
+// SetPurchaseLimit replaces the purchase limits of the product, carts and checkouts enforce them from then on.
+func (s *productService) SetPurchaseLimit(ctx context.Context, id uuid.UUID, req *models.SetPurchaseLimitRequest) (*models.Product, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "SetPurchaseLimit")
+	span.SetAttributes(attribute.String("product.id", id.String()))
+
+	defer span.End()
+
+	product, err := s.repo.GetProductByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Product not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to get product").WithError(err)
+	}
+
+	product.MaxPerOrder = req.MaxPerOrder
+	product.MaxPerCustomer = req.MaxPerCustomer
+	product.LimitPeriodDays = req.LimitPeriodDays
+
+	if err := s.repo.SetPurchaseLimit(ctx, product); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to set purchase limit").WithError(err)
+	}
+
+	return product, nil
+}
+
 // pageSize means "number of products to be displayed per page".
 func (s *productService) ListProducts(ctx context.Context, page, pageSize int) ([]*models.Product, int, error) {
 	tracer := otel.Tracer(productTracerName)
@@ -153,3 +497,617 @@ func (s *productService) ListProducts(ctx context.Context, page, pageSize int) (
 
 	return products, total, nil
 }
+
+// ListProductChanges reads the change feed from the since cursor, an empty cursor starts from the
+// first change. One extra change is fetched to tell whether more are waiting.
+func (s *productService) ListProductChanges(ctx context.Context, since string, limit int) (*models.ProductChangeFeed, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "ListProductChanges")
+	span.SetAttributes(attribute.String("since", since), attribute.Int("limit", limit))
+
+	defer span.End()
+
+	var after int64
+
+	if since != "" {
+		var err error
+
+		after, err = strconv.ParseInt(since, 10, 64)
+		if err != nil || after < 0 {
+			return nil, appErrors.BadRequestError("Invalid change feed cursor")
+		}
+	}
+
+	changes, err := s.repo.ListProductChanges(ctx, after, limit+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to fetch product changes").WithError(err)
+	}
+
+	feed := &models.ProductChangeFeed{Changes: changes, NextCursor: strconv.FormatInt(after, 10)}
+
+	if len(changes) > limit {
+		feed.Changes, feed.HasMore = changes[:limit], true
+	}
+
+	if len(feed.Changes) > 0 {
+		feed.NextCursor = feed.Changes[len(feed.Changes)-1].Cursor
+	}
+
+	return feed, nil
+}
+
+// ListVendorProducts pages through the products of a vendor, including the inactive ones.
+func (s *productService) ListVendorProducts(ctx context.Context, vendorID uuid.UUID, page, pageSize int) ([]*models.Product, int, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "ListVendorProducts")
+	span.SetAttributes(attribute.String("vendor.id", vendorID.String()), attribute.Int("page", page), attribute.Int("pageSize", pageSize))
+
+	defer span.End()
+
+	products, total, err := s.repo.ListVendorProducts(ctx, vendorID, page, pageSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, 0, appErrors.DatabaseError("Failed to fetch vendor products").WithError(err)
+	}
+
+	if products == nil {
+		return []*models.Product{}, 0, nil
+	}
+
+	return products, total, nil
+}
+
+// GetProductFacets counts the catalog per category, price bucket and status. Results are cached
+// per filter set, a cache failure only costs a trip to the database.
+func (s *productService) GetProductFacets(ctx context.Context, filter *models.ProductFilter) (*models.ProductFacets, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "GetProductFacets")
+
+	defer span.End()
+
+	key := productFacetsCacheKey(filter)
+
+	var facets models.ProductFacets
+
+	found, err := s.cache.Get(ctx, key, &facets)
+	if err != nil {
+		slog.Warn("Failed to read product facets from cache", slog.String("key", key), slog.String("error", err.Error()))
+	}
+
+	span.SetAttributes(attribute.Bool("cache.hit", found))
+
+	if found {
+		return &facets, nil
+	}
+
+	result, err := s.repo.GetProductFacets(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		return nil, appErrors.DatabaseError("Failed to fetch product facets").WithError(err)
+	}
+
+	if err := s.cache.Set(ctx, key, result, productFacetsTTL); err != nil {
+		slog.Warn("Failed to cache product facets", slog.String("key", key), slog.String("error", err.Error()))
+	}
+
+	return result, nil
+}
+
+func productFacetsCacheKey(filter *models.ProductFilter) string {
+	key := productFacetsNamespace
+
+	if filter.CategoryID != nil {
+		key += ":category=" + filter.CategoryID.String()
+	}
+
+	if filter.MinPrice != nil {
+		key += fmt.Sprintf(":min=%g", *filter.MinPrice)
+	}
+
+	if filter.MaxPrice != nil {
+		key += fmt.Sprintf(":max=%g", *filter.MaxPrice)
+	}
+
+	if filter.Status != "" {
+		key += ":status=" + filter.Status
+	}
+
+	return key
+}
+
+// availabilitySnapshot is the cached part of the availability, the dispatch date depends on the
+// time of the request and is derived from it on every call.
+type availabilitySnapshot struct {
+	StockQuantity int     `json:"stock_quantity"`
+	Status        string  `json:"status"`
+	Price         float64 `json:"price"`
+}
+
+// GetProductAvailability implements ProductService. It reads through the cache, so that polling
+// product pages only reach the database once per TTL and product.
+func (s *productService) GetProductAvailability(ctx context.Context, id uuid.UUID) (*models.ProductAvailability, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "GetProductAvailability")
+	span.SetAttributes(attribute.String("product.id", id.String()))
+
+	defer span.End()
+
+	key := productAvailabilityCacheKey(id)
+
+	var snapshot availabilitySnapshot
+
+	found, err := s.cache.Get(ctx, key, &snapshot)
+	if err != nil {
+		slog.Warn("Failed to read product availability from cache", slog.String("key", key), slog.String("error", err.Error()))
+	}
+
+	span.SetAttributes(attribute.Bool("cache.hit", found))
+
+	if !found {
+		product, err := s.repo.GetProductByID(ctx, id)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes(attribute.Bool("db.error", true))
+
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, appErrors.NotFoundError("Product not found").WithError(err)
+			}
+
+			return nil, appErrors.DatabaseError("Failed to get product availability").WithError(err)
+		}
+
+		snapshot = availabilitySnapshot{StockQuantity: product.StockQuantity, Status: product.Status, Price: product.Price}
+
+		if err := s.cache.Set(ctx, key, snapshot, productAvailabilityTTL); err != nil {
+			slog.Warn("Failed to cache product availability", slog.String("key", key), slog.String("error", err.Error()))
+		}
+	}
+
+	availability := &models.ProductAvailability{ProductID: id, Price: snapshot.Price}
+
+	switch {
+	case snapshot.Status != models.ProductStatusActive:
+		availability.StockStatus = models.StockStatusUnavailable
+	case snapshot.StockQuantity <= 0:
+		availability.StockStatus = models.StockStatusOutOfStock
+	case snapshot.StockQuantity <= lowStockThreshold:
+		availability.StockStatus = models.StockStatusLowStock
+	default:
+		availability.StockStatus = models.StockStatusInStock
+	}
+
+	if availability.StockStatus == models.StockStatusInStock || availability.StockStatus == models.StockStatusLowStock {
+		availability.EstimatedDispatchDate = estimatedDispatchDate(s.clock.Now()).Format(time.DateOnly)
+	}
+
+	return availability, nil
+}
+
+// PublishScheduledProducts makes the scheduled products whose publish time has come active, batch by
+// batch, and returns how many went live.
+func (s *productService) PublishScheduledProducts(ctx context.Context) (int, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "PublishScheduledProducts")
+
+	defer span.End()
+
+	published := 0
+
+	defer func() {
+		if published == 0 {
+			return
+		}
+
+		if _, err := s.cache.InvalidateNamespace(ctx, productFacetsNamespace); err != nil {
+			slog.Warn("Failed to invalidate product facets", slog.String("error", err.Error()))
+		}
+	}()
+
+	for {
+		ids, err := s.repo.PublishScheduledProducts(ctx, s.clock.Now(), publishBatchSize)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes(attribute.Bool("db.error", true))
+
+			return published, appErrors.DatabaseError("Failed to publish scheduled products").WithError(err)
+		}
+
+		for _, id := range ids {
+			if err := s.cache.Delete(ctx, productAvailabilityCacheKey(id)); err != nil {
+				slog.Warn("Failed to invalidate product availability", slog.String("productId", id.String()), slog.String("error", err.Error()))
+			}
+		}
+
+		published += len(ids)
+
+		if len(ids) < publishBatchSize {
+			span.SetAttributes(attribute.Int("products.published", published))
+
+			return published, nil
+		}
+	}
+}
+
+// RunPublisher publishes the scheduled products every interval until ctx is cancelled.
+func (s *productService) RunPublisher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := s.PublishScheduledProducts(ctx)
+			if err != nil {
+				slog.Error("Scheduled publishing of products failed", slog.String("error", err.Error()))
+			}
+
+			if published > 0 {
+				slog.Info("Scheduled products published", slog.Int("count", published))
+			}
+		}
+	}
+}
+
+// estimatedDispatchDate is the same day before the cutoff, the next business day otherwise.
+func estimatedDispatchDate(now time.Time) time.Time {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if now.Hour() >= dispatchCutoffHour {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return day
+}
+
+// UpdatePrices reprices platform products in a single transaction, each change is audited on the admin.
+// The whole batch is refused if one SKU is unknown, or if a product was repriced while it was applied.
+func (s *productService) UpdatePrices(ctx context.Context, adminID uuid.UUID, req *models.BulkPriceUpdateRequest) (*models.BulkPriceUpdateResult, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "UpdatePrices")
+
+	defer span.End()
+
+	if (len(req.Prices) == 0) == (req.Adjustment == nil) {
+		return nil, appErrors.BadRequestError("Either prices or an adjustment must be given")
+	}
+
+	var (
+		changes []models.PriceChange
+		err     error
+	)
+
+	if req.Adjustment != nil {
+		changes, err = s.adjustedPrices(ctx, req.Adjustment)
+	} else {
+		changes, err = s.listedPrices(ctx, req.Prices)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	result := &models.BulkPriceUpdateResult{BatchID: uuid.New(), Changes: []models.PriceChange{}}
+	entries := make([]*models.AuditEntry, 0, len(changes))
+
+	for _, change := range changes {
+		if change.NewPrice == change.OldPrice {
+			continue
+		}
+
+		details, err := json.Marshal(priceChangeDetails{SKU: change.SKU, OldPrice: change.OldPrice, NewPrice: change.NewPrice, BatchID: result.BatchID})
+		if err != nil {
+			return nil, appErrors.InternalError("Failed to encode price change").WithError(err)
+		}
+
+		entries = append(entries, &models.AuditEntry{
+			ID:          uuid.New(),
+			UserID:      adminID,
+			Action:      models.AuditActionPriceChanged,
+			ReferenceID: &change.ProductID,
+			Details:     details,
+		})
+		result.Changes = append(result.Changes, change)
+	}
+
+	result.Updated = len(result.Changes)
+	span.SetAttributes(attribute.String("batch.id", result.BatchID.String()), attribute.Int("batch.updated", result.Updated))
+
+	if result.Updated == 0 {
+		return result, nil
+	}
+
+	if err := s.repo.UpdatePrices(ctx, result.Changes, entries); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.InvalidTransitionError("Prices changed while the batch was applied, nothing was updated").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to update prices").WithError(err)
+	}
+
+	// The change feed picks the new prices up for search, only the caches are left to invalidate
+	for _, change := range result.Changes {
+		if err := s.cache.Delete(ctx, productAvailabilityCacheKey(change.ProductID)); err != nil {
+			slog.Warn("Failed to invalidate product availability", slog.String("productId", change.ProductID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	if _, err := s.cache.InvalidateNamespace(ctx, productFacetsNamespace); err != nil {
+		slog.Warn("Failed to invalidate product facets", slog.String("error", err.Error()))
+	}
+
+	return result, nil
+}
+
+// priceChangeDetails is the Details of a price change audit entry.
+type priceChangeDetails struct {
+	SKU      string    `json:"sku"`
+	OldPrice float64   `json:"old_price"`
+	NewPrice float64   `json:"new_price"`
+	BatchID  uuid.UUID `json:"batch_id"`
+}
+
+// listedPrices resolves the SKUs of the batch, a SKU given twice or matching no platform product is refused.
+func (s *productService) listedPrices(ctx context.Context, prices []models.SKUPrice) ([]models.PriceChange, error) {
+	newPrices := make(map[string]float64, len(prices))
+	skus := make([]string, 0, len(prices))
+
+	for _, price := range prices {
+		if _, ok := newPrices[price.SKU]; ok {
+			return nil, appErrors.ValidationError("SKU given more than once").WithDetail(price.SKU)
+		}
+
+		newPrices[price.SKU] = roundCents(price.Price)
+		skus = append(skus, price.SKU)
+	}
+
+	changes, err := s.repo.ListPricesBySKU(ctx, skus)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get product prices").WithError(err)
+	}
+
+	for i := range changes {
+		changes[i].NewPrice = newPrices[changes[i].SKU]
+		delete(newPrices, changes[i].SKU)
+	}
+
+	if len(newPrices) > 0 {
+		unknown := make([]string, 0, len(newPrices))
+		for _, sku := range skus {
+			if _, ok := newPrices[sku]; ok {
+				unknown = append(unknown, sku)
+			}
+		}
+
+		return nil, appErrors.ValidationError("Unknown or vendor managed SKUs").WithDetail(strings.Join(unknown, ", "))
+	}
+
+	return changes, nil
+}
+
+// adjustedPrices applies the adjustment to the platform products it matches, at most a batch of them.
+func (s *productService) adjustedPrices(ctx context.Context, adjustment *models.PriceAdjustment) ([]models.PriceChange, error) {
+	changes, err := s.repo.ListPricesByFilter(ctx, adjustment.Filter(), maxPriceBatchSize+1)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get product prices").WithError(err)
+	}
+
+	if len(changes) > maxPriceBatchSize {
+		return nil, appErrors.ValidationError(fmt.Sprintf("Adjustment matches more than %d products, narrow its filter", maxPriceBatchSize))
+	}
+
+	for i := range changes {
+		changes[i].NewPrice = roundCents(changes[i].OldPrice * (1 + adjustment.Percent/100))
+
+		if changes[i].NewPrice <= 0 {
+			return nil, appErrors.ValidationError("Adjustment brings a price to zero").WithDetail(changes[i].SKU)
+		}
+	}
+
+	return changes, nil
+}
+
+// QuickEditProducts sets the price and stock of platform products by SKU in a single transaction. Every
+// row is checked before anything is written, a single failed row leaves all products as they were. Price
+// changes are audited on the admin like the ones of UpdatePrices, stock changes go to the ledger.
+func (s *productService) QuickEditProducts(ctx context.Context, adminID uuid.UUID, edits []models.ProductQuickEdit) (*models.ProductQuickEditResult, error) {
+	tracer := otel.Tracer(productTracerName)
+	ctx, span := tracer.Start(ctx, "QuickEditProducts")
+
+	defer span.End()
+
+	if len(edits) == 0 {
+		return nil, appErrors.BadRequestError("At least one edit must be given")
+	}
+
+	if len(edits) > maxPriceBatchSize {
+		return nil, appErrors.ValidationError(fmt.Sprintf("At most %d edits can be applied at once", maxPriceBatchSize))
+	}
+
+	result := &models.ProductQuickEditResult{BatchID: uuid.New(), Rows: make([]models.ProductQuickEditRow, len(edits))}
+	rowBySKU := make(map[string]int, len(edits))
+	skus := make([]string, 0, len(edits))
+
+	for i, edit := range edits {
+		edit.SKU = strings.TrimSpace(edit.SKU)
+		result.Rows[i] = models.ProductQuickEditRow{Index: i, SKU: edit.SKU}
+
+		if reason := quickEditError(edit); reason != "" {
+			result.Rows[i].Status, result.Rows[i].Error = models.QuickEditFailed, reason
+			continue
+		}
+
+		if _, ok := rowBySKU[edit.SKU]; ok {
+			result.Rows[i].Status, result.Rows[i].Error = models.QuickEditFailed, "SKU given more than once"
+			continue
+		}
+
+		rowBySKU[edit.SKU] = i
+		skus = append(skus, edit.SKU)
+	}
+
+	var current []models.ProductQuickEditChange
+
+	if len(skus) > 0 {
+		var err error
+
+		current, err = s.repo.ListQuickEditsBySKU(ctx, skus)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes(attribute.Bool("db.error", true))
+
+			return nil, appErrors.DatabaseError("Failed to get products to edit").WithError(err)
+		}
+	}
+
+	found := make(map[string]bool, len(current))
+	changes := make([]models.ProductQuickEditChange, 0, len(current))
+	movements := []*models.StockMovement{}
+	entries := []*models.AuditEntry{}
+
+	for _, change := range current {
+		i := rowBySKU[change.SKU]
+		edit := edits[i]
+		row := &result.Rows[i]
+		found[change.SKU] = true
+
+		if edit.Price != nil {
+			change.NewPrice = roundCents(*edit.Price)
+		}
+
+		if edit.Stock != nil {
+			change.NewStock = *edit.Stock
+		}
+
+		row.ProductID = &change.ProductID
+		row.OldPrice, row.NewPrice = &change.OldPrice, &change.NewPrice
+		row.OldStock, row.NewStock = &change.OldStock, &change.NewStock
+
+		if change.NewPrice == change.OldPrice && change.NewStock == change.OldStock {
+			row.Status = models.QuickEditUnchanged
+			continue
+		}
+
+		row.Status = models.QuickEditUpdated
+		changes = append(changes, change)
+
+		if change.NewPrice != change.OldPrice {
+			details, err := json.Marshal(priceChangeDetails{SKU: change.SKU, OldPrice: change.OldPrice, NewPrice: change.NewPrice, BatchID: result.BatchID})
+			if err != nil {
+				return nil, appErrors.InternalError("Failed to encode price change").WithError(err)
+			}
+
+			entries = append(entries, &models.AuditEntry{
+				ID:          uuid.New(),
+				UserID:      adminID,
+				Action:      models.AuditActionPriceChanged,
+				ReferenceID: &change.ProductID,
+				Details:     details,
+			})
+		}
+
+		if change.NewStock != change.OldStock {
+			movements = append(movements, &models.StockMovement{
+				ID:          uuid.New(),
+				ProductID:   change.ProductID,
+				Quantity:    change.OldStock - change.NewStock,
+				Reason:      models.StockMovementAdjustment,
+				ReferenceID: &result.BatchID,
+			})
+		}
+	}
+
+	for _, sku := range skus {
+		if !found[sku] {
+			row := &result.Rows[rowBySKU[sku]]
+			row.Status, row.Error = models.QuickEditFailed, "Unknown or vendor managed SKU"
+		}
+	}
+
+	for i := range result.Rows {
+		if result.Rows[i].Status == models.QuickEditFailed {
+			result.Failed++
+		}
+	}
+
+	span.SetAttributes(attribute.String("batch.id", result.BatchID.String()), attribute.Int("batch.failed", result.Failed))
+
+	// Nothing was written, the rows that would have been updated are reported as they still are
+	if result.Failed > 0 {
+		for i := range result.Rows {
+			if result.Rows[i].Status == models.QuickEditUpdated {
+				result.Rows[i].Status = models.QuickEditUnchanged
+			}
+		}
+
+		return result, nil
+	}
+
+	result.Applied = true
+	result.Updated = len(changes)
+
+	if result.Updated == 0 {
+		return result, nil
+	}
+
+	if err := s.repo.ApplyQuickEdits(ctx, changes, movements, entries); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.Bool("db.error", true))
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.InvalidTransitionError("Products changed while the edits were applied, nothing was updated").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to apply quick edits").WithError(err)
+	}
+
+	for _, change := range changes {
+		if err := s.cache.Delete(ctx, productAvailabilityCacheKey(change.ProductID)); err != nil {
+			slog.Warn("Failed to invalidate product availability", slog.String("productId", change.ProductID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	if _, err := s.cache.InvalidateNamespace(ctx, productFacetsNamespace); err != nil {
+		slog.Warn("Failed to invalidate product facets", slog.String("error", err.Error()))
+	}
+
+	return result, nil
+}
+
+// quickEditError tells what is wrong with the edit on its own, or returns an empty string.
+func quickEditError(edit models.ProductQuickEdit) string {
+	switch {
+	case edit.SKU == "":
+		return "SKU is required"
+	case edit.Price == nil && edit.Stock == nil:
+		return "Price or stock is required"
+	case edit.Price != nil && roundCents(*edit.Price) <= 0:
+		return "Price must be positive"
+	case edit.Stock != nil && *edit.Stock < 0:
+		return "Stock cannot be negative"
+	}
+
+	return ""
+}
+
+func productAvailabilityCacheKey(id uuid.UUID) string {
+	return "product_availability:" + id.String()
+}
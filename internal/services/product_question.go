@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// ProductQuestionService runs the questions and answers of the product pages: customers ask, an admin
+// publishes or rejects the question, and the store or the vendor of the product answers it.
+type ProductQuestionService interface {
+	AskQuestion(ctx context.Context, productID, customerID uuid.UUID, req *models.AskQuestionRequest) (*models.ProductQuestion, error)
+	ListProductQuestions(ctx context.Context, productID uuid.UUID, page, size int) ([]*models.ProductQuestion, int, error)
+	ListQuestions(ctx context.Context, status models.QuestionStatus, page, size int) ([]*models.ProductQuestion, int, error)
+	ListVendorQuestions(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.ProductQuestion, int, error)
+	ModerateQuestion(ctx context.Context, id, adminID uuid.UUID, req *models.ModerateQuestionRequest) (*models.ProductQuestion, error)
+	AnswerQuestion(ctx context.Context, id, userID uuid.UUID, vendorID *uuid.UUID, req *models.AnswerQuestionRequest) (*models.ProductQuestion, error)
+}
+
+// ProductQuestionPolicy limits the questions of a customer and those about a product per rate window,
+// both with the shared rate limiter. The questions about a product are flagged for the moderators
+// past BurstLimit within BurstWindow, a BurstWindow of 0 disables it. A customer asking again what they asked within
+// DuplicateWindow is refused, and the question is flagged when other customers asked the same about
+// the product, 0 disables both.
+type ProductQuestionPolicy struct {
+	UserLimit       int64
+	ProductLimit    int64
+	RateWindow      time.Duration
+	BurstLimit      int
+	BurstWindow     time.Duration
+	DuplicateWindow time.Duration
+}
+
+type productQuestionService struct {
+	repo                repository.ProductQuestionRepository
+	productRepo         repository.ProductRepository
+	userRepo            repository.UserRepository
+	rateLimiter         repository.RateLimitRepository
+	notificationService NotificationService
+	clock               clock.Clock
+	policy              ProductQuestionPolicy
+}
+
+func NewProductQuestionService(repo repository.ProductQuestionRepository, productRepo repository.ProductRepository, userRepo repository.UserRepository, rateLimiter repository.RateLimitRepository, notificationService NotificationService, clk clock.Clock, policy ProductQuestionPolicy) ProductQuestionService {
+	policy.UserLimit = max(policy.UserLimit, 1)
+	policy.ProductLimit = max(policy.ProductLimit, 1)
+	policy.RateWindow = max(policy.RateWindow, time.Second)
+
+	return &productQuestionService{repo: repo, productRepo: productRepo, userRepo: userRepo, rateLimiter: rateLimiter, notificationService: notificationService, clock: clk, policy: policy}
+}
+
+// AskQuestion records the question of a customer about a product for sale, it waits for a moderator
+// before it is shown. The customer and the product are rate limited before anything else.
+func (s *productQuestionService) AskQuestion(ctx context.Context, productID, customerID uuid.UUID, req *models.AskQuestionRequest) (*models.ProductQuestion, error) {
+	if err := s.checkRateLimit(ctx, "product_questions:user:"+customerID.String(), s.policy.UserLimit, "You asked too many questions"); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRateLimit(ctx, "product_questions:product:"+productID.String(), s.policy.ProductLimit, "Too many questions about this product"); err != nil {
+		return nil, err
+	}
+
+	product, err := s.getProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Status != models.ProductStatusActive {
+		return nil, errors.ProductNotForSaleError(product.ID, product.Status)
+	}
+
+	question := &models.ProductQuestion{
+		ID:          uuid.New(),
+		ProductID:   product.ID,
+		CustomerID:  customerID,
+		Question:    req.Question,
+		ContentHash: questionContentHash(req.Question),
+		Status:      models.QuestionStatusPending,
+	}
+
+	question.Flags, err = s.flagQuestion(ctx, question)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(question.Flags) > 0 {
+		slog.Warn("Product question flagged for moderation",
+			slog.String("questionId", question.ID.String()),
+			slog.String("productId", product.ID.String()),
+			slog.Any("flags", question.Flags))
+	}
+
+	if err := s.repo.CreateQuestion(ctx, question); err != nil {
+		return nil, errors.DatabaseError("Failed to create product question").WithError(err)
+	}
+
+	return question, nil
+}
+
+// ListProductQuestions returns the published questions of a product, the newest first.
+func (s *productQuestionService) ListProductQuestions(ctx context.Context, productID uuid.UUID, page, size int) ([]*models.ProductQuestion, int, error) {
+	questions, total, err := s.repo.ListProductQuestions(ctx, productID, models.QuestionStatusPublished, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list product questions").WithError(err)
+	}
+
+	return hideFlags(questions...), total, nil
+}
+
+func (s *productQuestionService) ListQuestions(ctx context.Context, status models.QuestionStatus, page, size int) ([]*models.ProductQuestion, int, error) {
+	questions, total, err := s.repo.ListQuestions(ctx, status, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list product questions").WithError(err)
+	}
+
+	return questions, total, nil
+}
+
+func (s *productQuestionService) ListVendorQuestions(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.ProductQuestion, int, error) {
+	questions, total, err := s.repo.ListVendorQuestions(ctx, vendorID, page, size)
+	if err != nil {
+		return nil, 0, errors.DatabaseError("Failed to list vendor questions").WithError(err)
+	}
+
+	return hideFlags(questions...), total, nil
+}
+
+// ModerateQuestion publishes or rejects a question. A published question can be rejected later on,
+// taking it and its answer off the product page.
+func (s *productQuestionService) ModerateQuestion(ctx context.Context, id, adminID uuid.UUID, req *models.ModerateQuestionRequest) (*models.ProductQuestion, error) {
+	question := &models.ProductQuestion{ID: id, Status: req.Status, ModeratedBy: &adminID}
+
+	if err := s.repo.ModerateQuestion(ctx, question); err != nil {
+		if !stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.DatabaseError("Failed to moderate product question").WithError(err)
+		}
+
+		current, err := s.getQuestion(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, errors.InvalidTransitionError(fmt.Sprintf("Product question is already %s", current.Status))
+	}
+
+	return question, nil
+}
+
+// AnswerQuestion records the answer of the store, or of the vendor of the product when vendorID is
+// set, to a published question. An answer replaces the one before, the customer who asked is emailed
+// the first one.
+func (s *productQuestionService) AnswerQuestion(ctx context.Context, id, userID uuid.UUID, vendorID *uuid.UUID, req *models.AnswerQuestionRequest) (*models.ProductQuestion, error) {
+	current, err := s.getQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.getProduct(ctx, current.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	role := models.RoleAdmin
+
+	if vendorID != nil {
+		if product.VendorID == nil || *product.VendorID != *vendorID {
+			return nil, errors.ForbiddenError("Question is about a product of another seller")
+		}
+
+		role = models.RoleVendor
+	}
+
+	if current.Status != models.QuestionStatusPublished {
+		return nil, errors.InvalidTransitionError(fmt.Sprintf("Product question is %s, only published questions are answered", current.Status))
+	}
+
+	now := s.clock.Now()
+	question := &models.ProductQuestion{ID: id, Answer: req.Answer, AnsweredBy: &userID, AnswererRole: role, AnsweredAt: &now}
+
+	if err := s.repo.AnswerQuestion(ctx, question); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.InvalidTransitionError("Product question is not published anymore")
+		}
+
+		return nil, errors.DatabaseError("Failed to answer product question").WithError(err)
+	}
+
+	if current.AnsweredAt == nil {
+		s.notifyAsker(ctx, question, product)
+	}
+
+	if vendorID != nil {
+		hideFlags(question)
+	}
+
+	return question, nil
+}
+
+func (s *productQuestionService) checkRateLimit(ctx context.Context, key string, limit int64, message string) error {
+	allowed, retryAfter, err := s.rateLimiter.CheckRateLimit(ctx, key, limit, s.policy.RateWindow)
+	if err != nil {
+		return errors.ThirdPartyError("Rate limit check failed").WithError(err)
+	}
+
+	if !allowed {
+		return errors.TooManyRequestsError(fmt.Sprintf("%s, try again in %d seconds", message, retryAfter))
+	}
+
+	return nil
+}
+
+// flagQuestion refuses a question the customer asked already and returns the flags of a question
+// looking like abuse.
+func (s *productQuestionService) flagQuestion(ctx context.Context, question *models.ProductQuestion) ([]string, error) {
+	flags := []string{}
+	now := s.clock.Now()
+
+	if s.policy.DuplicateWindow > 0 {
+		own, others, err := s.repo.CountDuplicateQuestions(ctx, question, now.Add(-s.policy.DuplicateWindow))
+		if err != nil {
+			return nil, errors.DatabaseError("Failed to check for duplicate questions").WithError(err)
+		}
+
+		if own > 0 {
+			return nil, errors.DuplicateEntryError("You asked this question already")
+		}
+
+		if others > 0 {
+			flags = append(flags, models.QuestionFlagDuplicate)
+		}
+	}
+
+	if s.policy.BurstWindow > 0 {
+		recent, err := s.repo.CountRecentProductQuestions(ctx, question.ProductID, now.Add(-s.policy.BurstWindow))
+		if err != nil {
+			return nil, errors.DatabaseError("Failed to count recent product questions").WithError(err)
+		}
+
+		// the question itself is not counted yet
+		if recent >= s.policy.BurstLimit {
+			flags = append(flags, models.QuestionFlagBurst)
+		}
+	}
+
+	return flags, nil
+}
+
+// questionContentHash hashes a question without its case, punctuation and spacing, the same question
+// asked twice hashes the same.
+func questionContentHash(question string) string {
+	words := strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	sum := sha256.Sum256([]byte(strings.Join(words, " ")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// hideFlags keeps the flags of the moderators off the pages of the customers and the vendors.
+func hideFlags(questions ...*models.ProductQuestion) []*models.ProductQuestion {
+	for _, question := range questions {
+		question.Flags = nil
+	}
+
+	return questions
+}
+
+// notifyAsker is best effort, the answer stands when the email cannot be sent.
+func (s *productQuestionService) notifyAsker(ctx context.Context, question *models.ProductQuestion, product *models.Product) {
+	asker, err := s.userRepo.GetUserByID(ctx, question.CustomerID)
+	if err != nil {
+		slog.Error("Failed to get the asker of an answered question",
+			slog.String("questionId", question.ID.String()),
+			slog.String("error", err.Error()))
+
+		return
+	}
+
+	req := &models.EmailNotificationRequest{
+		To:       asker.Email,
+		Subject:  "Your question about " + product.Name + " was answered",
+		Content:  fmt.Sprintf("You asked: %s\n\nThe answer: %s", question.Question, question.Answer),
+		Metadata: map[string]string{"question_id": question.ID.String(), "product_id": product.ID.String()},
+	}
+
+	if _, err := s.notificationService.SendEmail(ctx, req); err != nil {
+		slog.Error("Failed to notify the asker of an answer",
+			slog.String("questionId", question.ID.String()),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (s *productQuestionService) getQuestion(ctx context.Context, id uuid.UUID) (*models.ProductQuestion, error) {
+	question, err := s.repo.GetQuestion(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Product question not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to get product question").WithError(err)
+	}
+
+	return question, nil
+}
+
+func (s *productQuestionService) getProduct(ctx context.Context, id uuid.UUID) (*models.Product, error) {
+	product, err := s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Product not found").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to get product").WithError(err)
+	}
+
+	return product, nil
+}
@@ -0,0 +1,343 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type productQuestionMocks struct {
+	repo                *mocks.MockProductQuestionRepository
+	productRepo         *mocks.MockProductRepository
+	userRepo            *mocks.MockUserRepository
+	rateLimiter         *mocks.MockRateLimitRepository
+	notificationService *svcMocks.MockNotificationService
+}
+
+func setupProductQuestionServiceTest(t *testing.T) (service.ProductQuestionService, *productQuestionMocks) {
+	m := &productQuestionMocks{
+		repo:                mocks.NewMockProductQuestionRepository(t),
+		productRepo:         mocks.NewMockProductRepository(t),
+		userRepo:            mocks.NewMockUserRepository(t),
+		rateLimiter:         mocks.NewMockRateLimitRepository(t),
+		notificationService: svcMocks.NewMockNotificationService(t),
+	}
+
+	policy := service.ProductQuestionPolicy{
+		UserLimit:       5,
+		ProductLimit:    50,
+		RateWindow:      time.Hour,
+		BurstLimit:      10,
+		BurstWindow:     10 * time.Minute,
+		DuplicateWindow: 30 * 24 * time.Hour,
+	}
+
+	return service.NewProductQuestionService(m.repo, m.productRepo, m.userRepo, m.rateLimiter, m.notificationService, clock.NewFake(testNow), policy), m
+}
+
+// allowQuestion lets the question pass the rate limits of the customer and the product.
+func (m *productQuestionMocks) allowQuestion(customerID, productID uuid.UUID) {
+	m.rateLimiter.On("CheckRateLimit", mock.Anything, "product_questions:user:"+customerID.String(), int64(5), time.Hour).Return(true, 0, nil).Once()
+	m.rateLimiter.On("CheckRateLimit", mock.Anything, "product_questions:product:"+productID.String(), int64(50), time.Hour).Return(true, 0, nil).Once()
+}
+
+func TestProductQuestionService_AskQuestion(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+	customerID := uuid.New()
+	req := &models.AskQuestionRequest{Question: "Does it fit a 15 inch laptop?"}
+
+	t.Run("Success - Pending moderation", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.allowQuestion(customerID, productID)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive}, nil).Once()
+		m.repo.On("CountDuplicateQuestions", ctx, mock.Anything, testNow.Add(-30*24*time.Hour)).Return(0, 0, nil).Once()
+		m.repo.On("CountRecentProductQuestions", ctx, productID, testNow.Add(-10*time.Minute)).Return(3, nil).Once()
+		m.repo.On("CreateQuestion", ctx, mock.MatchedBy(func(q *models.ProductQuestion) bool {
+			return q.ProductID == productID && q.CustomerID == customerID && q.Status == models.QuestionStatusPending && q.ContentHash != ""
+		})).Return(nil).Once()
+
+		// Act
+		question, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.QuestionStatusPending, question.Status)
+		assert.Empty(t, question.Flags)
+	})
+
+	t.Run("Success - Burst and duplicate flagged", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.allowQuestion(customerID, productID)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive}, nil).Once()
+		m.repo.On("CountDuplicateQuestions", ctx, mock.Anything, mock.Anything).Return(0, 4, nil).Once()
+		m.repo.On("CountRecentProductQuestions", ctx, productID, mock.Anything).Return(10, nil).Once()
+		m.repo.On("CreateQuestion", ctx, mock.Anything).Return(nil).Once()
+
+		// Act
+		question, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{models.QuestionFlagDuplicate, models.QuestionFlagBurst}, question.Flags)
+	})
+
+	t.Run("Success - Same question hashed alike", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		var hashes []string
+		m.rateLimiter.On("CheckRateLimit", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, 0, nil)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive}, nil)
+		m.repo.On("CountDuplicateQuestions", ctx, mock.Anything, mock.Anything).Return(0, 0, nil)
+		m.repo.On("CountRecentProductQuestions", ctx, productID, mock.Anything).Return(0, nil)
+		m.repo.On("CreateQuestion", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			hashes = append(hashes, args.Get(1).(*models.ProductQuestion).ContentHash)
+		}).Return(nil)
+
+		// Act
+		_, err1 := questionService.AskQuestion(ctx, productID, customerID, &models.AskQuestionRequest{Question: "Does it fit a 15 inch laptop?"})
+		_, err2 := questionService.AskQuestion(ctx, productID, customerID, &models.AskQuestionRequest{Question: "  does it FIT a 15-inch laptop!!"})
+		_, err3 := questionService.AskQuestion(ctx, productID, customerID, &models.AskQuestionRequest{Question: "Does it fit a 17 inch laptop?"})
+
+		// Assert
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		require.NoError(t, err3)
+		assert.Equal(t, hashes[0], hashes[1])
+		assert.NotEqual(t, hashes[0], hashes[2])
+	})
+
+	t.Run("Failure - Customer rate limited", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.rateLimiter.On("CheckRateLimit", mock.Anything, "product_questions:user:"+customerID.String(), int64(5), time.Hour).Return(false, 120, nil).Once()
+
+		// Act
+		_, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeTooManyRequests)
+		m.productRepo.AssertNotCalled(t, "GetProductByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Product rate limited", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.rateLimiter.On("CheckRateLimit", mock.Anything, "product_questions:user:"+customerID.String(), int64(5), time.Hour).Return(true, 0, nil).Once()
+		m.rateLimiter.On("CheckRateLimit", mock.Anything, "product_questions:product:"+productID.String(), int64(50), time.Hour).Return(false, 30, nil).Once()
+
+		// Act
+		_, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeTooManyRequests)
+	})
+
+	t.Run("Failure - Rate limiter down", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.rateLimiter.On("CheckRateLimit", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, 0, errors.New("redis down")).Once()
+
+		// Act
+		_, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeThirdPartyError)
+	})
+
+	t.Run("Failure - Asked already", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.allowQuestion(customerID, productID)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusActive}, nil).Once()
+		m.repo.On("CountDuplicateQuestions", ctx, mock.Anything, mock.Anything).Return(1, 0, nil).Once()
+
+		// Act
+		_, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDuplicateEntry)
+		m.repo.AssertNotCalled(t, "CreateQuestion", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.allowQuestion(customerID, productID)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+
+	t.Run("Failure - Product not for sale", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.allowQuestion(customerID, productID)
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusDiscontinued}, nil).Once()
+
+		// Act
+		_, err := questionService.AskQuestion(ctx, productID, customerID, req)
+
+		// Assert
+		require.Error(t, err)
+		m.repo.AssertNotCalled(t, "CreateQuestion", mock.Anything, mock.Anything)
+	})
+}
+
+func TestProductQuestionService_ModerateQuestion(t *testing.T) {
+	ctx := t.Context()
+	questionID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("Success - Published", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.repo.On("ModerateQuestion", ctx, mock.MatchedBy(func(q *models.ProductQuestion) bool {
+			return q.ID == questionID && q.Status == models.QuestionStatusPublished && *q.ModeratedBy == adminID
+		})).Return(nil).Once()
+
+		// Act
+		question, err := questionService.ModerateQuestion(ctx, questionID, adminID, &models.ModerateQuestionRequest{Status: models.QuestionStatusPublished})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.QuestionStatusPublished, question.Status)
+	})
+
+	t.Run("Failure - Already in that status", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.repo.On("ModerateQuestion", ctx, mock.Anything).Return(sql.ErrNoRows).Once()
+		m.repo.On("GetQuestion", ctx, questionID).Return(&models.ProductQuestion{ID: questionID, Status: models.QuestionStatusRejected}, nil).Once()
+
+		// Act
+		_, err := questionService.ModerateQuestion(ctx, questionID, adminID, &models.ModerateQuestionRequest{Status: models.QuestionStatusRejected})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+	})
+}
+
+func TestProductQuestionService_AnswerQuestion(t *testing.T) {
+	ctx := t.Context()
+	questionID := uuid.New()
+	productID := uuid.New()
+	customerID := uuid.New()
+	userID := uuid.New()
+	vendorID := uuid.New()
+	req := &models.AnswerQuestionRequest{Answer: "Yes, up to 16 inches."}
+	published := func() *models.ProductQuestion {
+		return &models.ProductQuestion{ID: questionID, ProductID: productID, CustomerID: customerID, Question: "Does it fit a 15 inch laptop?", Status: models.QuestionStatusPublished}
+	}
+
+	t.Run("Success - Vendor answer emailed to the asker", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.repo.On("GetQuestion", ctx, questionID).Return(published(), nil).Once()
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, Name: "Laptop Bag", VendorID: &vendorID}, nil).Once()
+		m.repo.On("AnswerQuestion", ctx, mock.MatchedBy(func(q *models.ProductQuestion) bool {
+			return q.ID == questionID && q.AnswererRole == models.RoleVendor && *q.AnsweredBy == userID && q.AnsweredAt.Equal(testNow)
+		})).Run(func(args mock.Arguments) {
+			q := args.Get(1).(*models.ProductQuestion)
+			*q = *published()
+			q.Answer, q.AnswererRole, q.Flags = req.Answer, models.RoleVendor, []string{models.QuestionFlagBurst}
+		}).Return(nil).Once()
+		m.userRepo.On("GetUserByID", ctx, customerID).Return(&models.User{ID: customerID, Email: "asker@example.com"}, nil).Once()
+		m.notificationService.On("SendEmail", ctx, mock.MatchedBy(func(e *models.EmailNotificationRequest) bool {
+			return e.To == "asker@example.com" && e.Subject == "Your question about Laptop Bag was answered"
+		})).Return(&models.NotificationResponse{}, nil).Once()
+
+		// Act
+		question, err := questionService.AnswerQuestion(ctx, questionID, userID, &vendorID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleVendor, question.AnswererRole)
+		assert.Nil(t, question.Flags)
+	})
+
+	t.Run("Success - Answer replaced without a second email", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		answered := published()
+		answeredAt := testNow.Add(-time.Hour)
+		answered.AnsweredAt = &answeredAt
+		m.repo.On("GetQuestion", ctx, questionID).Return(answered, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID}, nil).Once()
+		m.repo.On("AnswerQuestion", ctx, mock.Anything).Return(nil).Once()
+
+		// Act
+		question, err := questionService.AnswerQuestion(ctx, questionID, userID, nil, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleAdmin, question.AnswererRole)
+		m.notificationService.AssertNotCalled(t, "SendEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Failed email does not fail the answer", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		m.repo.On("GetQuestion", ctx, questionID).Return(published(), nil).Once()
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID}, nil).Once()
+		m.repo.On("AnswerQuestion", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(1).(*models.ProductQuestion) = *published()
+		}).Return(nil).Once()
+		m.userRepo.On("GetUserByID", ctx, customerID).Return(&models.User{ID: customerID, Email: "asker@example.com"}, nil).Once()
+		m.notificationService.On("SendEmail", ctx, mock.Anything).Return(nil, errors.New("sendgrid down")).Once()
+
+		// Act
+		_, err := questionService.AnswerQuestion(ctx, questionID, userID, nil, req)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Product of another vendor", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		otherVendor := uuid.New()
+		m.repo.On("GetQuestion", ctx, questionID).Return(published(), nil).Once()
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, VendorID: &otherVendor}, nil).Once()
+
+		// Act
+		_, err := questionService.AnswerQuestion(ctx, questionID, userID, &vendorID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeForbidden)
+		m.repo.AssertNotCalled(t, "AnswerQuestion", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Question pending", func(t *testing.T) {
+		// Arrange
+		questionService, m := setupProductQuestionServiceTest(t)
+		pending := published()
+		pending.Status = models.QuestionStatusPending
+		m.repo.On("GetQuestion", ctx, questionID).Return(pending, nil).Once()
+		m.productRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID}, nil).Once()
+
+		// Act
+		_, err := questionService.AnswerQuestion(ctx, questionID, userID, nil, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+	})
+}
@@ -2,8 +2,13 @@ package service_test
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
+	cacheMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
@@ -11,12 +16,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateProduct(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockProductRepository(t)
-	productService := service.NewProductService(mockRepo)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockCache := cacheMocks.NewMockCache(t)
+	productService := service.NewProductService(mockRepo, mockInventoryRepo, mockCache, clock.NewFake(testNow))
 	ctx := t.Context()
 
 	req := &models.CreateProductRequest{
@@ -76,10 +84,99 @@ func TestCreateProduct(t *testing.T) {
 	})
 }
 
+func TestCreateProduct_DuplicateBarcode(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockProductRepository(t)
+	productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+	req := &models.CreateProductRequest{CategoryID: uuid.New(), Name: "Scanned Product", Price: 5, StockQuantity: 1, SKU: "SCAN-001", Barcode: "4006381333931"}
+
+	mockRepo.On("GetProductByBarcode", mock.Anything, "4006381333931").Return(&models.Product{ID: uuid.New()}, nil).Once()
+
+	// Act
+	product, err := productService.CreateProduct(t.Context(), req)
+
+	// Assert
+	assert.Nil(t, product)
+
+	var appErr *appErrors.AppError
+
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, appErrors.ErrCodeDuplicateEntry, appErr.Code)
+}
+
+func TestLookupProduct(t *testing.T) {
+	ctx := t.Context()
+	product := &models.Product{ID: uuid.New(), SKU: "SCAN-001", Barcode: "4006381333931"}
+
+	t.Run("Success - By SKU", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+		mockRepo.On("GetProductBySKU", mock.Anything, "SCAN-001").Return(product, nil).Once()
+
+		// Act
+		result, err := productService.LookupProduct(ctx, "SCAN-001", "")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, product, result)
+	})
+
+	t.Run("Success - By barcode", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+		mockRepo.On("GetProductByBarcode", mock.Anything, "4006381333931").Return(product, nil).Once()
+
+		// Act
+		result, err := productService.LookupProduct(ctx, "", "4006381333931")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, product, result)
+	})
+
+	t.Run("Failure - Not found", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+		mockRepo.On("GetProductByBarcode", mock.Anything, "0000000000000").Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		result, err := productService.LookupProduct(ctx, "", "0000000000000")
+
+		// Assert
+		assert.Nil(t, result)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Both codes given", func(t *testing.T) {
+		// Arrange
+		productService := service.NewProductService(mocks.NewMockProductRepository(t), mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+
+		// Act
+		result, err := productService.LookupProduct(ctx, "SCAN-001", "4006381333931")
+
+		// Assert
+		assert.Nil(t, result)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
 func TestGetProductByID(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockProductRepository(t)
-	productService := service.NewProductService(mockRepo)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockCache := cacheMocks.NewMockCache(t)
+	productService := service.NewProductService(mockRepo, mockInventoryRepo, mockCache, clock.NewFake(testNow))
 	ctx := t.Context()
 	testID := uuid.New()
 
@@ -90,8 +187,12 @@ func TestGetProductByID(t *testing.T) {
 			Name: "Found Product",
 		}
 
+		related := []models.RelatedProduct{{ProductID: uuid.New(), Name: "Upsell Product", Type: models.ProductRelationUpsell}}
+
 		// Mock Call
 		mockRepo.On("GetProductByID", mock.Anything, testID).Return(expectedProduct, nil).Once()
+		mockRepo.On("ListRelatedProducts", mock.Anything, testID).Return(related, nil).Once()
+		mockRepo.On("ListProductImages", mock.Anything, testID).Return([]string{"https://cdn.test/mug.jpg"}, nil).Once()
 
 		// Act
 		product, err := productService.GetProductByID(ctx, testID)
@@ -100,6 +201,8 @@ func TestGetProductByID(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, product)
 		assert.Equal(t, expectedProduct, product)
+		assert.Equal(t, related, product.RelatedProducts)
+		assert.Equal(t, []string{"https://cdn.test/mug.jpg"}, product.Images)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -142,10 +245,138 @@ func TestGetProductByID(t *testing.T) {
 	})
 }
 
+func TestSetRelatedProducts(t *testing.T) {
+	testID := uuid.New()
+	relatedID := uuid.New()
+	upsellID := uuid.New()
+
+	setup := func(t *testing.T) (*mocks.MockProductRepository, service.ProductService) {
+		t.Helper()
+
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+
+		return mockRepo, productService
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockRepo, productService := setup(t)
+		req := &models.SetRelatedProductsRequest{Relations: []models.ProductRelationInput{
+			{ProductID: relatedID, Type: models.ProductRelationRelated},
+			{ProductID: upsellID, Type: models.ProductRelationUpsell},
+			{ProductID: upsellID, Type: models.ProductRelationRelated},
+		}}
+		expected := []models.RelatedProduct{{ProductID: relatedID, Type: models.ProductRelationRelated}}
+
+		mockRepo.On("GetProductByID", mock.Anything, testID).Return(&models.Product{ID: testID}, nil).Once()
+		mockRepo.On("GetProductByID", mock.Anything, relatedID).Return(&models.Product{ID: relatedID}, nil).Once()
+		mockRepo.On("GetProductByID", mock.Anything, upsellID).Return(&models.Product{ID: upsellID}, nil).Twice()
+		mockRepo.On("SetRelatedProducts", mock.Anything, testID, []models.ProductRelation{
+			{RelatedProductID: relatedID, Type: models.ProductRelationRelated, Position: 0},
+			{RelatedProductID: upsellID, Type: models.ProductRelationUpsell, Position: 0},
+			{RelatedProductID: upsellID, Type: models.ProductRelationRelated, Position: 1},
+		}).Return(nil).Once()
+		mockRepo.On("ListRelatedProducts", mock.Anything, testID).Return(expected, nil).Once()
+
+		// Act
+		related, err := productService.SetRelatedProducts(t.Context(), testID, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, related)
+	})
+
+	t.Run("Failure - Product Not Found", func(t *testing.T) {
+		// Arrange
+		mockRepo, productService := setup(t)
+		mockRepo.On("GetProductByID", mock.Anything, testID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		related, err := productService.SetRelatedProducts(t.Context(), testID, &models.SetRelatedProductsRequest{})
+
+		// Assert
+		assert.Nil(t, related)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Self Reference", func(t *testing.T) {
+		// Arrange
+		mockRepo, productService := setup(t)
+		req := &models.SetRelatedProductsRequest{Relations: []models.ProductRelationInput{
+			{ProductID: testID, Type: models.ProductRelationCrossSell},
+		}}
+
+		mockRepo.On("GetProductByID", mock.Anything, testID).Return(&models.Product{ID: testID}, nil).Once()
+
+		// Act
+		related, err := productService.SetRelatedProducts(t.Context(), testID, req)
+
+		// Assert
+		assert.Nil(t, related)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Duplicate Relation", func(t *testing.T) {
+		// Arrange
+		mockRepo, productService := setup(t)
+		req := &models.SetRelatedProductsRequest{Relations: []models.ProductRelationInput{
+			{ProductID: relatedID, Type: models.ProductRelationRelated},
+			{ProductID: relatedID, Type: models.ProductRelationRelated},
+		}}
+
+		mockRepo.On("GetProductByID", mock.Anything, testID).Return(&models.Product{ID: testID}, nil).Once()
+		mockRepo.On("GetProductByID", mock.Anything, relatedID).Return(&models.Product{ID: relatedID}, nil).Once()
+
+		// Act
+		related, err := productService.SetRelatedProducts(t.Context(), testID, req)
+
+		// Assert
+		assert.Nil(t, related)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Unknown Related Product", func(t *testing.T) {
+		// Arrange
+		mockRepo, productService := setup(t)
+		req := &models.SetRelatedProductsRequest{Relations: []models.ProductRelationInput{
+			{ProductID: relatedID, Type: models.ProductRelationRelated},
+		}}
+
+		mockRepo.On("GetProductByID", mock.Anything, testID).Return(&models.Product{ID: testID}, nil).Once()
+		mockRepo.On("GetProductByID", mock.Anything, relatedID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		related, err := productService.SetRelatedProducts(t.Context(), testID, req)
+
+		// Assert
+		assert.Nil(t, related)
+
+		var appErr *appErrors.AppError
+
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
 func TestUpdateProduct(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockProductRepository(t)
-	productService := service.NewProductService(mockRepo)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockCache := cacheMocks.NewMockCache(t)
+	productService := service.NewProductService(mockRepo, mockInventoryRepo, mockCache, clock.NewFake(testNow))
 	ctx := t.Context()
 	testID := uuid.New()
 
@@ -189,6 +420,10 @@ func TestUpdateProduct(t *testing.T) {
 				p.Status == *req.Status &&
 				p.SKU == existingProduct.SKU
 		})).Return(nil).Once()
+		mockInventoryRepo.On("RecordMovement", mock.Anything, mock.MatchedBy(func(m *models.StockMovement) bool {
+			return m.ProductID == testID && m.Quantity == -10 && m.Reason == models.StockMovementAdjustment
+		})).Return(nil).Once()
+		mockCache.On("Delete", mock.Anything, "product_availability:"+testID.String()).Return(nil).Once()
 
 		// Act
 		updatedProduct, err := productService.UpdateProduct(ctx, testID, req)
@@ -205,6 +440,8 @@ func TestUpdateProduct(t *testing.T) {
 		assert.Equal(t, newStatus, updatedProduct.Status)
 		assert.Equal(t, existingProduct.SKU, updatedProduct.SKU)
 		mockRepo.AssertExpectations(t)
+		mockInventoryRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
 	})
 
 	t.Run("Failure - Product Not Found", func(t *testing.T) {
@@ -246,12 +483,51 @@ func TestUpdateProduct(t *testing.T) {
 
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("Failure - Vendor Product Through Catalog", func(t *testing.T) {
+		// Arrange
+		vendorID := uuid.New()
+		vendorProduct := *existingProduct
+		vendorProduct.VendorID = &vendorID
+		mockRepo.On("GetProductByID", mock.Anything, testID).Return(&vendorProduct, nil).Once()
+
+		// Act
+		updatedProduct, err := productService.UpdateProduct(ctx, testID, req)
+
+		// Assert
+		assert.Nil(t, updatedProduct)
+
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeForbidden, appErr.Code)
+	})
+
+	t.Run("Failure - Product Outside The Vendor Catalog", func(t *testing.T) {
+		// Arrange
+		vendorID := uuid.New()
+		mockRepo.On("GetVendorProduct", mock.Anything, vendorID, testID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		updatedProduct, err := productService.UpdateVendorProduct(ctx, vendorID, testID, req)
+
+		// Assert
+		assert.Nil(t, updatedProduct)
+
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+		mockRepo.AssertNotCalled(t, "UpdateVendorProduct", mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 func TestListProducts(t *testing.T) {
 	// Arrange
 	mockRepo := mocks.NewMockProductRepository(t)
-	productService := service.NewProductService(mockRepo)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockCache := cacheMocks.NewMockCache(t)
+	productService := service.NewProductService(mockRepo, mockInventoryRepo, mockCache, clock.NewFake(testNow))
 	ctx := t.Context()
 	page := 1
 	pageSize := 10
@@ -316,3 +592,793 @@ func TestListProducts(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestListProductChanges(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockProductRepository(t)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	mockCache := cacheMocks.NewMockCache(t)
+	productService := service.NewProductService(mockRepo, mockInventoryRepo, mockCache, clock.NewFake(testNow))
+	ctx := t.Context()
+	productID := uuid.New()
+
+	t.Run("Success - Extra change means more are waiting", func(t *testing.T) {
+		// Arrange
+		changes := []models.ProductChange{
+			{Cursor: "8", ProductID: productID, Operation: models.ProductChangeCreate, Version: 1},
+			{Cursor: "9", ProductID: productID, Operation: models.ProductChangeUpdate, Version: 2},
+			{Cursor: "12", ProductID: productID, Operation: models.ProductChangeDelete, Version: 3},
+		}
+		mockRepo.On("ListProductChanges", mock.Anything, int64(7), 3).Return(changes, nil).Once()
+
+		// Act
+		feed, err := productService.ListProductChanges(ctx, "7", 2)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, changes[:2], feed.Changes)
+		assert.Equal(t, "9", feed.NextCursor)
+		assert.True(t, feed.HasMore)
+	})
+
+	t.Run("Success - No changes keep the cursor", func(t *testing.T) {
+		// Arrange
+		mockRepo.On("ListProductChanges", mock.Anything, int64(12), 101).Return([]models.ProductChange{}, nil).Once()
+
+		// Act
+		feed, err := productService.ListProductChanges(ctx, "12", 100)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, feed.Changes)
+		assert.Equal(t, "12", feed.NextCursor)
+		assert.False(t, feed.HasMore)
+	})
+
+	t.Run("Success - Empty cursor starts from the first change", func(t *testing.T) {
+		// Arrange
+		changes := []models.ProductChange{{Cursor: "1", ProductID: productID, Operation: models.ProductChangeCreate, Version: 1}}
+		mockRepo.On("ListProductChanges", mock.Anything, int64(0), 101).Return(changes, nil).Once()
+
+		// Act
+		feed, err := productService.ListProductChanges(ctx, "", 100)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, changes, feed.Changes)
+		assert.Equal(t, "1", feed.NextCursor)
+		assert.False(t, feed.HasMore)
+	})
+
+	t.Run("Failure - Invalid cursor", func(t *testing.T) {
+		for _, since := range []string{"abc", "-1"} {
+			// Act
+			feed, err := productService.ListProductChanges(ctx, since, 100)
+
+			// Assert
+			var appErr *appErrors.AppError
+
+			require.ErrorAs(t, err, &appErr, since)
+			assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+			assert.Nil(t, feed)
+		}
+	})
+
+	t.Run("Failure - Database Error", func(t *testing.T) {
+		// Arrange
+		mockRepo.On("ListProductChanges", mock.Anything, int64(0), 101).Return(nil, errors.New("connection refused")).Once()
+
+		// Act
+		feed, err := productService.ListProductChanges(ctx, "", 100)
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		assert.Nil(t, feed)
+	})
+}
+
+func TestGetProductFacets(t *testing.T) {
+	ctx := t.Context()
+	categoryID := uuid.New()
+	filter := &models.ProductFilter{CategoryID: &categoryID, Status: "active"}
+	key := "product_facets:category=" + categoryID.String() + ":status=active"
+	facets := &models.ProductFacets{
+		Categories: []models.CategoryFacet{{CategoryID: categoryID, Name: "Books", Count: 3}},
+		Statuses:   []models.StatusFacet{{Status: "active", Count: 3}},
+	}
+
+	t.Run("Success - Cache miss", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		mockCache.On("Get", mock.Anything, key, mock.Anything).Return(false, nil).Once()
+		mockRepo.On("GetProductFacets", mock.Anything, filter).Return(facets, nil).Once()
+		mockCache.On("Set", mock.Anything, key, facets, mock.Anything).Return(nil).Once()
+
+		// Act
+		result, err := productService.GetProductFacets(ctx, filter)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, facets, result)
+	})
+
+	t.Run("Success - Cache hit", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		mockCache.On("Get", mock.Anything, key, mock.Anything).Run(func(args mock.Arguments) {
+			cached, ok := args.Get(2).(*models.ProductFacets)
+			assert.True(t, ok)
+
+			*cached = *facets
+		}).Return(true, nil).Once()
+
+		// Act
+		result, err := productService.GetProductFacets(ctx, filter)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, facets, result)
+	})
+
+	t.Run("Success - Cache unavailable", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		mockCache.On("Get", mock.Anything, key, mock.Anything).Return(false, assert.AnError).Once()
+		mockRepo.On("GetProductFacets", mock.Anything, filter).Return(facets, nil).Once()
+		mockCache.On("Set", mock.Anything, key, facets, mock.Anything).Return(assert.AnError).Once()
+
+		// Act
+		result, err := productService.GetProductFacets(ctx, filter)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, facets, result)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		mockCache.On("Get", mock.Anything, key, mock.Anything).Return(false, nil).Once()
+		mockRepo.On("GetProductFacets", mock.Anything, filter).Return(nil, sql.ErrConnDone).Once()
+
+		// Act
+		result, err := productService.GetProductFacets(ctx, filter)
+
+		// Assert
+		assert.Nil(t, result)
+
+		appErr, ok := err.(*appErrors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
+
+func TestGetProductAvailability(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+	key := "product_availability:" + productID.String()
+
+	// testNow is a Friday morning, before the dispatch cutoff
+	tests := []struct {
+		name           string
+		now            time.Time
+		product        *models.Product
+		expectedStatus models.StockStatus
+		expectedDate   string
+	}{
+		{
+			name:           "In stock before the cutoff",
+			now:            testNow,
+			product:        &models.Product{ID: productID, Price: 19.99, StockQuantity: 42, Status: "active"},
+			expectedStatus: models.StockStatusInStock,
+			expectedDate:   "2025-03-14",
+		},
+		{
+			name:           "Low stock after the Friday cutoff",
+			now:            testNow.Add(6 * time.Hour),
+			product:        &models.Product{ID: productID, Price: 19.99, StockQuantity: 3, Status: "active"},
+			expectedStatus: models.StockStatusLowStock,
+			expectedDate:   "2025-03-17",
+		},
+		{
+			name:           "Out of stock",
+			now:            testNow,
+			product:        &models.Product{ID: productID, Price: 19.99, StockQuantity: 0, Status: "active"},
+			expectedStatus: models.StockStatusOutOfStock,
+		},
+		{
+			name:           "Discontinued",
+			now:            testNow,
+			product:        &models.Product{ID: productID, Price: 19.99, StockQuantity: 10, Status: "discontinued"},
+			expectedStatus: models.StockStatusUnavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockProductRepository(t)
+			mockCache := cacheMocks.NewMockCache(t)
+			productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(tc.now))
+
+			mockCache.On("Get", mock.Anything, key, mock.Anything).Return(false, nil).Once()
+			mockRepo.On("GetProductByID", mock.Anything, productID).Return(tc.product, nil).Once()
+			mockCache.On("Set", mock.Anything, key, mock.Anything, 15*time.Second).Return(nil).Once()
+
+			// Act
+			availability, err := productService.GetProductAvailability(ctx, productID)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, availability.StockStatus)
+			assert.Equal(t, tc.expectedDate, availability.EstimatedDispatchDate)
+			assert.InDelta(t, 19.99, availability.Price, 0)
+		})
+	}
+
+	t.Run("Cache hit skips the database", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		mockCache.On("Get", mock.Anything, key, mock.Anything).
+			Run(func(args mock.Arguments) {
+				raw := []byte(`{"stock_quantity":12,"status":"active","price":5.5}`)
+				require.NoError(t, json.Unmarshal(raw, args.Get(2)))
+			}).
+			Return(true, nil).Once()
+
+		// Act
+		availability, err := productService.GetProductAvailability(ctx, productID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.StockStatusInStock, availability.StockStatus)
+		assert.InDelta(t, 5.5, availability.Price, 0)
+		mockRepo.AssertNotCalled(t, "GetProductByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		mockCache.On("Get", mock.Anything, key, mock.Anything).Return(false, nil).Once()
+		mockRepo.On("GetProductByID", mock.Anything, productID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		availability, err := productService.GetProductAvailability(ctx, productID)
+
+		// Assert
+		assert.Nil(t, availability)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestUpdatePrices(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	first := models.PriceChange{ProductID: uuid.New(), SKU: "SKU-1", OldPrice: 20}
+	second := models.PriceChange{ProductID: uuid.New(), SKU: "SKU-2", OldPrice: 9.99}
+
+	newProductService := func(t *testing.T) (service.ProductService, *mocks.MockProductRepository, *cacheMocks.MockCache) {
+		t.Helper()
+
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+
+		return service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow)), mockRepo, mockCache
+	}
+
+	t.Run("Success - Prices by SKU", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, mockCache := newProductService(t)
+		req := &models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 18.5}, {SKU: "SKU-2", Price: 9.99}}}
+
+		mockRepo.On("ListPricesBySKU", mock.Anything, []string{"SKU-1", "SKU-2"}).Return([]models.PriceChange{first, second}, nil).Once()
+
+		var entries []*models.AuditEntry
+
+		mockRepo.On("UpdatePrices", mock.Anything, []models.PriceChange{{ProductID: first.ProductID, SKU: "SKU-1", OldPrice: 20, NewPrice: 18.5}}, mock.Anything).
+			Run(func(args mock.Arguments) { entries = args.Get(2).([]*models.AuditEntry) }).Return(nil).Once()
+		mockCache.On("Delete", mock.Anything, "product_availability:"+first.ProductID.String()).Return(nil).Once()
+		mockCache.On("InvalidateNamespace", mock.Anything, "product_facets").Return(int64(2), nil).Once()
+
+		// Act
+		result, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Updated, "the unchanged price is left out")
+		require.Len(t, entries, 1)
+		assert.Equal(t, adminID, entries[0].UserID)
+		assert.Equal(t, models.AuditActionPriceChanged, entries[0].Action)
+		assert.Equal(t, first.ProductID, *entries[0].ReferenceID)
+		assert.JSONEq(t, `{"sku":"SKU-1","old_price":20,"new_price":18.5,"batch_id":"`+result.BatchID.String()+`"}`, string(entries[0].Details))
+	})
+
+	t.Run("Success - Percentage adjustment rounds to the cent", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, mockCache := newProductService(t)
+		categoryID := uuid.New()
+		req := &models.BulkPriceUpdateRequest{Adjustment: &models.PriceAdjustment{Percent: -15, CategoryID: &categoryID}}
+
+		mockRepo.On("ListPricesByFilter", mock.Anything, &models.ProductFilter{CategoryID: &categoryID}, 1001).Return([]models.PriceChange{first, second}, nil).Once()
+		mockRepo.On("UpdatePrices", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockCache.On("Delete", mock.Anything, mock.Anything).Return(nil).Twice()
+		mockCache.On("InvalidateNamespace", mock.Anything, "product_facets").Return(int64(0), errors.New("redis down")).Once()
+
+		// Act
+		result, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		require.NoError(t, err, "cache invalidation is best effort")
+		require.Len(t, result.Changes, 2)
+		assert.InDelta(t, 17.0, result.Changes[0].NewPrice, 1e-9)
+		assert.InDelta(t, 8.49, result.Changes[1].NewPrice, 1e-9)
+	})
+
+	t.Run("Success - Nothing to change", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		req := &models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 20}}}
+
+		mockRepo.On("ListPricesBySKU", mock.Anything, []string{"SKU-1"}).Return([]models.PriceChange{first}, nil).Once()
+
+		// Act
+		result, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, result.Updated)
+		mockRepo.AssertNotCalled(t, "UpdatePrices", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Neither or both modes", func(t *testing.T) {
+		productService, _, _ := newProductService(t)
+
+		for _, req := range []*models.BulkPriceUpdateRequest{
+			{},
+			{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 1}}, Adjustment: &models.PriceAdjustment{Percent: 5}},
+		} {
+			// Act
+			_, err := productService.UpdatePrices(ctx, adminID, req)
+
+			// Assert
+			assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+		}
+	})
+
+	t.Run("Failure - Duplicate SKU", func(t *testing.T) {
+		// Arrange
+		productService, _, _ := newProductService(t)
+		req := &models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 18}, {SKU: "SKU-1", Price: 19}}}
+
+		// Act
+		_, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeValidation)
+	})
+
+	t.Run("Failure - Unknown or vendor SKU", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		req := &models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 18}, {SKU: "VENDOR-1", Price: 5}}}
+
+		mockRepo.On("ListPricesBySKU", mock.Anything, []string{"SKU-1", "VENDOR-1"}).Return([]models.PriceChange{first}, nil).Once()
+
+		// Act
+		_, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeValidation, appErr.Code)
+		assert.Equal(t, "VENDOR-1", appErr.Detail)
+		mockRepo.AssertNotCalled(t, "UpdatePrices", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Adjustment matches too many products", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		req := &models.BulkPriceUpdateRequest{Adjustment: &models.PriceAdjustment{Percent: 5}}
+
+		mockRepo.On("ListPricesByFilter", mock.Anything, &models.ProductFilter{}, 1001).Return(make([]models.PriceChange, 1001), nil).Once()
+
+		// Act
+		_, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeValidation)
+	})
+
+	t.Run("Failure - Repriced concurrently", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		req := &models.BulkPriceUpdateRequest{Prices: []models.SKUPrice{{SKU: "SKU-1", Price: 18}}}
+
+		mockRepo.On("ListPricesBySKU", mock.Anything, []string{"SKU-1"}).Return([]models.PriceChange{first}, nil).Once()
+		mockRepo.On("UpdatePrices", mock.Anything, mock.Anything, mock.Anything).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := productService.UpdatePrices(ctx, adminID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+	})
+}
+
+func TestQuickEditProducts(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	first := models.ProductQuickEditChange{ProductID: uuid.New(), SKU: "SKU-1", OldPrice: 20, NewPrice: 20, OldStock: 5, NewStock: 5}
+	second := models.ProductQuickEditChange{ProductID: uuid.New(), SKU: "SKU-2", OldPrice: 9.99, NewPrice: 9.99, OldStock: 0, NewStock: 0}
+
+	newProductService := func(t *testing.T) (service.ProductService, *mocks.MockProductRepository, *cacheMocks.MockCache) {
+		t.Helper()
+
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+
+		return service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow)), mockRepo, mockCache
+	}
+
+	price := func(p float64) *float64 { return &p }
+	stock := func(s int) *int { return &s }
+
+	t.Run("Success - Price and stock in one transaction", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, mockCache := newProductService(t)
+		edits := []models.ProductQuickEdit{{SKU: "SKU-1", Price: price(18.499)}, {SKU: "SKU-2", Stock: stock(12)}}
+
+		mockRepo.On("ListQuickEditsBySKU", mock.Anything, []string{"SKU-1", "SKU-2"}).Return([]models.ProductQuickEditChange{first, second}, nil).Once()
+
+		var (
+			changes   []models.ProductQuickEditChange
+			movements []*models.StockMovement
+			entries   []*models.AuditEntry
+		)
+
+		mockRepo.On("ApplyQuickEdits", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				changes = args.Get(1).([]models.ProductQuickEditChange)
+				movements = args.Get(2).([]*models.StockMovement)
+				entries = args.Get(3).([]*models.AuditEntry)
+			}).Return(nil).Once()
+		mockCache.On("Delete", mock.Anything, mock.Anything).Return(nil).Twice()
+		mockCache.On("InvalidateNamespace", mock.Anything, "product_facets").Return(int64(1), nil).Once()
+
+		// Act
+		result, err := productService.QuickEditProducts(ctx, adminID, edits)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+		assert.Equal(t, 2, result.Updated)
+		assert.Zero(t, result.Failed)
+		require.Len(t, result.Rows, 2)
+		assert.Equal(t, models.QuickEditUpdated, result.Rows[0].Status)
+		assert.InDelta(t, 18.5, *result.Rows[0].NewPrice, 1e-9)
+		assert.Equal(t, 12, *result.Rows[1].NewStock)
+
+		require.Len(t, changes, 2)
+		assert.Equal(t, 5, changes[0].NewStock, "an edit without stock keeps it")
+		require.Len(t, entries, 1, "only the price change is audited")
+		assert.Equal(t, adminID, entries[0].UserID)
+		assert.JSONEq(t, `{"sku":"SKU-1","old_price":20,"new_price":18.5,"batch_id":"`+result.BatchID.String()+`"}`, string(entries[0].Details))
+		require.Len(t, movements, 1, "only the stock change goes to the ledger")
+		assert.Equal(t, second.ProductID, movements[0].ProductID)
+		assert.Equal(t, -12, movements[0].Quantity)
+		assert.Equal(t, result.BatchID, *movements[0].ReferenceID)
+	})
+
+	t.Run("Success - Nothing to change", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		edits := []models.ProductQuickEdit{{SKU: "SKU-1", Price: price(20), Stock: stock(5)}}
+
+		mockRepo.On("ListQuickEditsBySKU", mock.Anything, []string{"SKU-1"}).Return([]models.ProductQuickEditChange{first}, nil).Once()
+
+		// Act
+		result, err := productService.QuickEditProducts(ctx, adminID, edits)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+		assert.Zero(t, result.Updated)
+		assert.Equal(t, models.QuickEditUnchanged, result.Rows[0].Status)
+		mockRepo.AssertNotCalled(t, "ApplyQuickEdits", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - A failed row leaves every product as it was", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		edits := []models.ProductQuickEdit{
+			{SKU: "SKU-1", Price: price(18)},
+			{SKU: "SKU-2"},
+			{SKU: "SKU-3", Price: price(0)},
+			{SKU: "SKU-4", Stock: stock(-1)},
+			{SKU: "SKU-1", Stock: stock(3)},
+			{SKU: "VENDOR-1", Stock: stock(3)},
+		}
+
+		mockRepo.On("ListQuickEditsBySKU", mock.Anything, []string{"SKU-1", "VENDOR-1"}).Return([]models.ProductQuickEditChange{first}, nil).Once()
+
+		// Act
+		result, err := productService.QuickEditProducts(ctx, adminID, edits)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		assert.Zero(t, result.Updated)
+		assert.Equal(t, 5, result.Failed)
+		assert.Equal(t, models.QuickEditUnchanged, result.Rows[0].Status, "nothing was written")
+		assert.Equal(t, "Price or stock is required", result.Rows[1].Error)
+		assert.Equal(t, "Price must be positive", result.Rows[2].Error)
+		assert.Equal(t, "Stock cannot be negative", result.Rows[3].Error)
+		assert.Equal(t, "SKU given more than once", result.Rows[4].Error)
+		assert.Equal(t, "Unknown or vendor managed SKU", result.Rows[5].Error)
+		mockRepo.AssertNotCalled(t, "ApplyQuickEdits", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - No or too many edits", func(t *testing.T) {
+		productService, _, _ := newProductService(t)
+
+		// Act
+		_, err := productService.QuickEditProducts(ctx, adminID, nil)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeBadRequest)
+
+		// Act
+		_, err = productService.QuickEditProducts(ctx, adminID, make([]models.ProductQuickEdit, 1001))
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeValidation)
+	})
+
+	t.Run("Failure - Changed concurrently", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		edits := []models.ProductQuickEdit{{SKU: "SKU-1", Stock: stock(2)}}
+
+		mockRepo.On("ListQuickEditsBySKU", mock.Anything, []string{"SKU-1"}).Return([]models.ProductQuickEditChange{first}, nil).Once()
+		mockRepo.On("ApplyQuickEdits", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := productService.QuickEditProducts(ctx, adminID, edits)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+	})
+}
+
+func TestProductLifecycle(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+	publishAt := testNow.Add(24 * time.Hour)
+
+	newProductService := func(t *testing.T) (service.ProductService, *mocks.MockProductRepository, *cacheMocks.MockCache) {
+		t.Helper()
+
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+
+		return service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow)), mockRepo, mockCache
+	}
+
+	newRequest := func(status string, publishAt *time.Time) *models.CreateProductRequest {
+		return &models.CreateProductRequest{CategoryID: uuid.New(), Name: "Lamp", Price: 30, StockQuantity: 4, SKU: "LAMP-1", Status: status, PublishAt: publishAt}
+	}
+
+	t.Run("Success - Create scheduled product", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, _ := newProductService(t)
+		mockRepo.On("CreateProduct", mock.Anything, mock.MatchedBy(func(p *models.Product) bool {
+			return p.Status == models.ProductStatusScheduled && p.PublishAt.Equal(publishAt)
+		})).Return(nil).Once()
+
+		// Act
+		product, err := productService.CreateProduct(ctx, newRequest(models.ProductStatusScheduled, &publishAt))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.ProductStatusScheduled, product.Status)
+	})
+
+	t.Run("Failure - Invalid schedule on create", func(t *testing.T) {
+		past := testNow.Add(-time.Minute)
+
+		for name, req := range map[string]*models.CreateProductRequest{
+			"scheduled without publish time": newRequest(models.ProductStatusScheduled, nil),
+			"scheduled in the past":          newRequest(models.ProductStatusScheduled, &past),
+			"draft with publish time":        newRequest(models.ProductStatusDraft, &publishAt),
+		} {
+			productService, _, _ := newProductService(t)
+
+			// Act
+			_, err := productService.CreateProduct(ctx, req)
+
+			// Assert
+			var appErr *appErrors.AppError
+
+			require.ErrorAs(t, err, &appErr, name)
+			assert.Equal(t, appErrors.ErrCodeValidation, appErr.Code, name)
+		}
+	})
+
+	t.Run("Success - Publishing early clears the schedule", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, mockCache := newProductService(t)
+		scheduledAt := publishAt
+		active := models.ProductStatusActive
+
+		mockRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusScheduled, PublishAt: &scheduledAt}, nil).Once()
+		mockRepo.On("UpdateProduct", mock.Anything, mock.MatchedBy(func(p *models.Product) bool {
+			return p.Status == models.ProductStatusActive && p.PublishAt == nil
+		})).Return(nil).Once()
+		mockCache.On("Delete", mock.Anything, "product_availability:"+productID.String()).Return(nil).Once()
+
+		// Act
+		_, err := productService.UpdateProduct(ctx, productID, &models.UpdateProductRequest{Status: &active})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Scheduling a draft", func(t *testing.T) {
+		// Arrange
+		productService, mockRepo, mockCache := newProductService(t)
+		scheduled := models.ProductStatusScheduled
+
+		mockRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, Status: models.ProductStatusDraft}, nil).Once()
+		mockRepo.On("UpdateProduct", mock.Anything, mock.MatchedBy(func(p *models.Product) bool {
+			return p.Status == models.ProductStatusScheduled && p.PublishAt.Equal(publishAt)
+		})).Return(nil).Once()
+		mockCache.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+
+		// Act
+		_, err := productService.UpdateProduct(ctx, productID, &models.UpdateProductRequest{Status: &scheduled, PublishAt: &publishAt})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Transition out of the lifecycle", func(t *testing.T) {
+		for _, tc := range []struct{ from, to string }{
+			{models.ProductStatusDiscontinued, models.ProductStatusActive},
+			{models.ProductStatusActive, models.ProductStatusDraft},
+			{models.ProductStatusActive, models.ProductStatusScheduled},
+		} {
+			// Arrange
+			productService, mockRepo, _ := newProductService(t)
+			to := tc.to
+
+			mockRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, Status: tc.from}, nil).Once()
+
+			// Act
+			_, err := productService.UpdateProduct(ctx, productID, &models.UpdateProductRequest{Status: &to, PublishAt: &publishAt})
+
+			// Assert
+			assertAppErrorCode(t, err, appErrors.ErrCodeInvalidTransition)
+		}
+	})
+}
+
+func TestPublishScheduledProducts(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success - Batch by batch", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), mockCache, clock.NewFake(testNow))
+
+		full := make([]uuid.UUID, 100)
+		for i := range full {
+			full[i] = uuid.New()
+		}
+
+		mockRepo.On("PublishScheduledProducts", mock.Anything, testNow, 100).Return(full, nil).Once()
+		mockRepo.On("PublishScheduledProducts", mock.Anything, testNow, 100).Return([]uuid.UUID{uuid.New()}, nil).Once()
+		mockCache.On("Delete", mock.Anything, mock.Anything).Return(nil).Times(101)
+		mockCache.On("InvalidateNamespace", mock.Anything, "product_facets").Return(int64(3), nil).Once()
+
+		// Act
+		published, err := productService.PublishScheduledProducts(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 101, published)
+	})
+
+	t.Run("Success - Nothing due", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+
+		mockRepo.On("PublishScheduledProducts", mock.Anything, testNow, 100).Return([]uuid.UUID{}, nil).Once()
+
+		// Act
+		published, err := productService.PublishScheduledProducts(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Zero(t, published)
+	})
+
+	t.Run("Failure - Database error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+
+		mockRepo.On("PublishScheduledProducts", mock.Anything, testNow, 100).Return(nil, errors.New("connection reset")).Once()
+
+		// Act
+		_, err := productService.PublishScheduledProducts(ctx)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestSetPurchaseLimit(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+
+	t.Run("Success - Replaces the limits", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+		previous := 5
+		maxPerCustomer, periodDays := 2, 30
+
+		mockRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID, MaxPerOrder: &previous}, nil).Once()
+		mockRepo.On("SetPurchaseLimit", mock.Anything, mock.MatchedBy(func(p *models.Product) bool {
+			return p.MaxPerOrder == nil && *p.MaxPerCustomer == 2 && *p.LimitPeriodDays == 30
+		})).Return(nil).Once()
+
+		// Act
+		product, err := productService.SetPurchaseLimit(ctx, productID, &models.SetPurchaseLimitRequest{MaxPerCustomer: &maxPerCustomer, LimitPeriodDays: &periodDays})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, product.MaxPerOrder)
+		assert.Equal(t, 2, *product.MaxPerCustomer)
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockProductRepository(t)
+		productService := service.NewProductService(mockRepo, mocks.NewMockInventoryRepository(t), cacheMocks.NewMockCache(t), clock.NewFake(testNow))
+
+		mockRepo.On("GetProductByID", mock.Anything, productID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := productService.SetPurchaseLimit(ctx, productID, &models.SetPurchaseLimitRequest{})
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeNotFound)
+	})
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// RetentionService purges the data kept past its retention period, see models.RetentionPolicy. Every
+// purged record gets an audit entry on its owner.
+type RetentionService interface {
+	ApplyRetention(ctx context.Context, dryRun bool, actorID *uuid.UUID) (*models.RetentionReport, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// RetentionPolicy sets how long the data is kept, a zero period disables its policy. ScheduledDryRun
+// makes the scheduled runs only report what they would purge, to check the periods before they apply.
+type RetentionPolicy struct {
+	CartMaxAge      time.Duration
+	OrderPIIAge     time.Duration
+	BatchSize       int
+	ScheduledDryRun bool
+}
+
+type retentionService struct {
+	retentionRepo repository.RetentionRepository
+	policy        RetentionPolicy
+	clock         clock.Clock
+}
+
+func NewRetentionService(retentionRepo repository.RetentionRepository, policy RetentionPolicy, clk clock.Clock) RetentionService {
+	policy.BatchSize = max(policy.BatchSize, 1)
+
+	return &retentionService{retentionRepo: retentionRepo, policy: policy, clock: clk}
+}
+
+// ApplyRetention runs the enabled policies, or counts what they would purge in a dry run. A policy
+// failing midway keeps what it purged so far and stops the run, the report is returned with the error.
+func (s *retentionService) ApplyRetention(ctx context.Context, dryRun bool, actorID *uuid.UUID) (*models.RetentionReport, error) {
+	now := s.clock.Now()
+	report := &models.RetentionReport{DryRun: dryRun, Results: []models.RetentionResult{}}
+
+	policies := []struct {
+		policy models.RetentionPolicy
+		age    time.Duration
+		count  func(ctx context.Context, cutoff time.Time) (int, error)
+		purge  func(ctx context.Context, cutoff time.Time, limit int, actorID *uuid.UUID) ([]models.RetentionRecord, error)
+	}{
+		{models.RetentionStaleCarts, s.policy.CartMaxAge, s.retentionRepo.CountStaleCarts, s.retentionRepo.PurgeStaleCarts},
+		{models.RetentionOrderPII, s.policy.OrderPIIAge, s.retentionRepo.CountOrdersToAnonymize, s.retentionRepo.AnonymizeOrders},
+	}
+
+	for _, p := range policies {
+		if p.age <= 0 {
+			continue
+		}
+
+		result := models.RetentionResult{Policy: p.policy, Cutoff: now.Add(-p.age)}
+
+		if dryRun {
+			count, err := p.count(ctx, result.Cutoff)
+			if err != nil {
+				return report, errors.DatabaseError("Failed to count records past retention").WithError(err)
+			}
+
+			result.Count = count
+			report.Results = append(report.Results, result)
+
+			continue
+		}
+
+		// the batches purge the oldest first, until one comes back short
+		for {
+			records, err := p.purge(ctx, result.Cutoff, s.policy.BatchSize, actorID)
+			if err != nil {
+				report.Results = append(report.Results, result)
+
+				return report, errors.DatabaseError("Failed to purge records past retention").WithError(err)
+			}
+
+			result.Count += len(records)
+
+			if len(records) < s.policy.BatchSize {
+				break
+			}
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// Run applies the policies every interval until ctx is cancelled.
+func (s *retentionService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := s.ApplyRetention(ctx, s.policy.ScheduledDryRun, nil)
+			if err != nil {
+				slog.Error("Scheduled retention run failed", slog.String("error", err.Error()))
+			}
+
+			for _, result := range report.Results {
+				if result.Count > 0 {
+					slog.Info("Retention policy applied", slog.String("policy", string(result.Policy)), slog.Bool("dryRun", report.DryRun),
+						slog.Int("count", result.Count), slog.Time("cutoff", result.Cutoff))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetentionPolicy = service.RetentionPolicy{CartMaxAge: 90 * 24 * time.Hour, OrderPIIAge: 7 * 365 * 24 * time.Hour, BatchSize: 2}
+
+func setupRetentionServiceTest(t *testing.T, policy service.RetentionPolicy) (service.RetentionService, *mocks.MockRetentionRepository) {
+	retentionRepo := mocks.NewMockRetentionRepository(t)
+
+	return service.NewRetentionService(retentionRepo, policy, clock.NewFake(testNow)), retentionRepo
+}
+
+func retentionRecords(n int) []models.RetentionRecord {
+	records := make([]models.RetentionRecord, n)
+	for i := range records {
+		records[i] = models.RetentionRecord{ID: uuid.New(), UserID: uuid.New()}
+	}
+
+	return records
+}
+
+func TestApplyRetention(t *testing.T) {
+	ctx := t.Context()
+	cartCutoff := testNow.Add(-testRetentionPolicy.CartMaxAge)
+	orderCutoff := testNow.Add(-testRetentionPolicy.OrderPIIAge)
+	adminID := uuid.New()
+
+	t.Run("Success - Policies purge batch by batch", func(t *testing.T) {
+		// Arrange
+		retentionService, retentionRepo := setupRetentionServiceTest(t, testRetentionPolicy)
+		retentionRepo.On("PurgeStaleCarts", ctx, cartCutoff, 2, &adminID).Return(retentionRecords(2), nil).Once()
+		retentionRepo.On("PurgeStaleCarts", ctx, cartCutoff, 2, &adminID).Return(retentionRecords(1), nil).Once()
+		retentionRepo.On("AnonymizeOrders", ctx, orderCutoff, 2, &adminID).Return(nil, nil).Once()
+
+		// Act
+		report, err := retentionService.ApplyRetention(ctx, false, &adminID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.RetentionReport{Results: []models.RetentionResult{
+			{Policy: models.RetentionStaleCarts, Cutoff: cartCutoff, Count: 3},
+			{Policy: models.RetentionOrderPII, Cutoff: orderCutoff, Count: 0},
+		}}, report)
+	})
+
+	t.Run("Success - Dry run only counts", func(t *testing.T) {
+		// Arrange
+		retentionService, retentionRepo := setupRetentionServiceTest(t, testRetentionPolicy)
+		retentionRepo.On("CountStaleCarts", ctx, cartCutoff).Return(12, nil).Once()
+		retentionRepo.On("CountOrdersToAnonymize", ctx, orderCutoff).Return(4, nil).Once()
+
+		// Act
+		report, err := retentionService.ApplyRetention(ctx, true, &adminID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []models.RetentionResult{
+			{Policy: models.RetentionStaleCarts, Cutoff: cartCutoff, Count: 12},
+			{Policy: models.RetentionOrderPII, Cutoff: orderCutoff, Count: 4},
+		}, report.Results)
+		retentionRepo.AssertNotCalled(t, "PurgeStaleCarts")
+		retentionRepo.AssertNotCalled(t, "AnonymizeOrders")
+	})
+
+	t.Run("Success - Disabled policies are left out", func(t *testing.T) {
+		// Arrange
+		retentionService, retentionRepo := setupRetentionServiceTest(t, service.RetentionPolicy{CartMaxAge: testRetentionPolicy.CartMaxAge})
+		retentionRepo.On("PurgeStaleCarts", ctx, cartCutoff, 1, (*uuid.UUID)(nil)).Return(nil, nil).Once()
+
+		// Act
+		report, err := retentionService.ApplyRetention(ctx, false, nil)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, report.Results, 1)
+		assert.Equal(t, models.RetentionStaleCarts, report.Results[0].Policy)
+	})
+
+	t.Run("Failure - Purge error keeps the partial report", func(t *testing.T) {
+		// Arrange
+		retentionService, retentionRepo := setupRetentionServiceTest(t, testRetentionPolicy)
+		retentionRepo.On("PurgeStaleCarts", ctx, cartCutoff, 2, &adminID).Return(retentionRecords(2), nil).Once()
+		retentionRepo.On("PurgeStaleCarts", ctx, cartCutoff, 2, &adminID).Return(nil, errors.New("db down")).Once()
+
+		// Act
+		report, err := retentionService.ApplyRetention(ctx, false, &adminID)
+
+		// Assert
+		assertAppErrorCode(t, err, "DATABASE_ERROR")
+		assert.Equal(t, []models.RetentionResult{{Policy: models.RetentionStaleCarts, Cutoff: cartCutoff, Count: 2}}, report.Results)
+		retentionRepo.AssertNotCalled(t, "AnonymizeOrders")
+	})
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// poBoxPattern matches the usual spellings of a post office box in a street line: PO Box, P.O. Box,
+// Post Office Box. Words that merely start like one, as in Boxwood Road, are not matched.
+var poBoxPattern = regexp.MustCompile(`(?i)\bp(ost)?\.?\s*o(ffice)?\.?\s*box\b`)
+
+type ShippingService interface {
+	CheckOrder(ctx context.Context, address *models.Address, productIDs []uuid.UUID) error
+	Quote(ctx context.Context, req *models.ShippingQuoteRequest) (*models.ShippingQuote, error)
+	SetProductRestriction(ctx context.Context, productID uuid.UUID, req *models.SetShippingRestrictionRequest) (*models.ProductShippingRestriction, error)
+	GetProductRestriction(ctx context.Context, productID uuid.UUID) (*models.ProductShippingRestriction, error)
+	DeleteProductRestriction(ctx context.Context, productID uuid.UUID) error
+}
+
+// ShippingPolicy holds the restrictions of the store. States and postal codes are given with their
+// country, like US-HI or US-967, postal codes match on their prefix. An empty AllowedCountries ships
+// to every country.
+type ShippingPolicy struct {
+	AllowedCountries     []string
+	BlockedStates        []string
+	BlockPOBoxes         bool
+	EmbargoedPostalCodes []string
+}
+
+type shippingService struct {
+	repo        repository.ShippingRepository
+	productRepo repository.ProductRepository
+	policy      ShippingPolicy
+}
+
+func NewShippingService(repo repository.ShippingRepository, productRepo repository.ProductRepository, policy ShippingPolicy) ShippingService {
+	// entries are compared upper case, the blank entries of an empty environment variable are dropped
+	normalize := func(entries []string) []string {
+		var normalized []string
+
+		for _, entry := range entries {
+			if entry = strings.ToUpper(strings.TrimSpace(entry)); entry != "" {
+				normalized = append(normalized, entry)
+			}
+		}
+
+		return normalized
+	}
+
+	policy.AllowedCountries = normalize(policy.AllowedCountries)
+	policy.BlockedStates = normalize(policy.BlockedStates)
+	policy.EmbargoedPostalCodes = normalize(policy.EmbargoedPostalCodes)
+
+	return &shippingService{repo: repo, productRepo: productRepo, policy: policy}
+}
+
+// CheckOrder implements ShippingService. The restrictions of the store are checked first, then the
+// ones of the products, the first restriction broken is returned with its own error code.
+func (s *shippingService) CheckOrder(ctx context.Context, address *models.Address, productIDs []uuid.UUID) error {
+	_, err := s.check(ctx, address, productIDs)
+
+	return err
+}
+
+func (s *shippingService) Quote(ctx context.Context, req *models.ShippingQuoteRequest) (*models.ShippingQuote, error) {
+	productIDs := make([]uuid.UUID, 0, len(req.Items))
+	for _, item := range req.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+
+	restrictions, err := s.check(ctx, &req.ShippingAddress, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ShippingQuote{
+		ShippingAddress: req.ShippingAddress,
+		Items:           req.Items,
+		Restrictions:    restrictions,
+	}, nil
+}
+
+func (s *shippingService) check(ctx context.Context, address *models.Address, productIDs []uuid.UUID) ([]*models.ProductShippingRestriction, error) {
+	country := strings.ToUpper(strings.TrimSpace(address.Country))
+	isPOBox := poBoxPattern.MatchString(address.Street)
+
+	if len(s.policy.AllowedCountries) > 0 && !slices.Contains(s.policy.AllowedCountries, country) {
+		return nil, errors.ShippingRestrictedError(errors.ErrCodeShippingCountry, "We do not ship to "+country)
+	}
+
+	if slices.Contains(s.policy.BlockedStates, country+"-"+strings.ToUpper(strings.TrimSpace(address.State))) {
+		return nil, errors.ShippingRestrictedError(errors.ErrCodeShippingRegion, fmt.Sprintf("We do not ship to %s, %s", address.State, country))
+	}
+
+	if s.policy.BlockPOBoxes && isPOBox {
+		return nil, errors.ShippingRestrictedError(errors.ErrCodeShippingPOBox, "We do not ship to PO boxes")
+	}
+
+	postalCode := normalizePostalCode(address.PostalCode)
+
+	for _, embargoed := range s.policy.EmbargoedPostalCodes {
+		embargoedCountry, prefix, _ := strings.Cut(embargoed, "-")
+		prefix = normalizePostalCode(prefix)
+
+		if embargoedCountry == country && prefix != "" && strings.HasPrefix(postalCode, prefix) {
+			return nil, errors.ShippingRestrictedError(errors.ErrCodeShippingPostalCode, "We do not ship to postal code "+address.PostalCode)
+		}
+	}
+
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	restrictions, err := s.repo.ListProductRestrictions(ctx, productIDs)
+	if err != nil {
+		return nil, errors.DatabaseError("Failed to fetch shipping restrictions").WithError(err)
+	}
+
+	for _, restriction := range restrictions {
+		if len(restriction.AllowedCountries) > 0 && !slices.Contains(restriction.AllowedCountries, country) {
+			return nil, errors.ShippingRestrictedError(errors.ErrCodeShippingProductLimits,
+				fmt.Sprintf("Product %s cannot be shipped to %s (%s)", restriction.ProductID, country, restriction.Reason))
+		}
+
+		if restriction.NoPOBox && isPOBox {
+			return nil, errors.ShippingRestrictedError(errors.ErrCodeShippingProductLimits,
+				fmt.Sprintf("Product %s cannot be shipped to a PO box (%s)", restriction.ProductID, restriction.Reason))
+		}
+	}
+
+	return restrictions, nil
+}
+
+func (s *shippingService) SetProductRestriction(ctx context.Context, productID uuid.UUID, req *models.SetShippingRestrictionRequest) (*models.ProductShippingRestriction, error) {
+	if _, err := s.productRepo.GetProductByID(ctx, productID); err != nil {
+		return nil, errors.NotFoundError("Product not found").WithError(err)
+	}
+
+	restriction := &models.ProductShippingRestriction{
+		ProductID: productID,
+		Reason:    req.Reason,
+		NoPOBox:   req.NoPOBox,
+	}
+
+	for _, country := range req.AllowedCountries {
+		restriction.AllowedCountries = append(restriction.AllowedCountries, strings.ToUpper(country))
+	}
+
+	if err := s.repo.SetProductRestriction(ctx, restriction); err != nil {
+		return nil, errors.DatabaseError("Failed to save shipping restriction").WithError(err)
+	}
+
+	return restriction, nil
+}
+
+func (s *shippingService) GetProductRestriction(ctx context.Context, productID uuid.UUID) (*models.ProductShippingRestriction, error) {
+	restriction, err := s.repo.GetProductRestriction(ctx, productID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.NotFoundError("Product has no shipping restriction").WithError(err)
+		}
+
+		return nil, errors.DatabaseError("Failed to fetch shipping restriction").WithError(err)
+	}
+
+	return restriction, nil
+}
+
+func (s *shippingService) DeleteProductRestriction(ctx context.Context, productID uuid.UUID) error {
+	if err := s.repo.DeleteProductRestriction(ctx, productID); err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return errors.NotFoundError("Product has no shipping restriction").WithError(err)
+		}
+
+		return errors.DatabaseError("Failed to delete shipping restriction").WithError(err)
+	}
+
+	return nil
+}
+
+// normalizePostalCode drops the spaces and dashes of a postal code, so "SW1A 1AA" matches "SW1A1AA".
+func normalizePostalCode(postalCode string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(postalCode))
+}
@@ -0,0 +1,189 @@
+package service_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testShippingPolicy ships to the US and Canada, except Hawaii, PO boxes and the postal codes of
+// Puerto Rico. The blank entry is what an unset environment variable leaves behind.
+var testShippingPolicy = service.ShippingPolicy{
+	AllowedCountries:     []string{"us", " CA", ""},
+	BlockedStates:        []string{"US-HI"},
+	BlockPOBoxes:         true,
+	EmbargoedPostalCodes: []string{"US-006", "CA-X0A"},
+}
+
+func setupShippingServiceTest(t *testing.T, policy service.ShippingPolicy) (service.ShippingService, *mocks.MockShippingRepository, *mocks.MockProductRepository) {
+	mockRepo := mocks.NewMockShippingRepository(t)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+
+	return service.NewShippingService(mockRepo, mockProductRepo, policy), mockRepo, mockProductRepo
+}
+
+func TestShippingCheckOrder_StoreRestrictions(t *testing.T) {
+	tests := []struct {
+		name    string
+		address models.Address
+		code    string
+	}{
+		{name: "Country not served", address: models.Address{Street: "1 Rue de Rivoli", State: "IDF", PostalCode: "75001", Country: "FR"}, code: appErrors.ErrCodeShippingCountry},
+		{name: "Blocked state", address: models.Address{Street: "1 Kalakaua Ave", State: "hi", PostalCode: "96815", Country: "US"}, code: appErrors.ErrCodeShippingRegion},
+		{name: "PO box", address: models.Address{Street: "P.O. Box 42", State: "NY", PostalCode: "10001", Country: "US"}, code: appErrors.ErrCodeShippingPOBox},
+		{name: "Post office box spelled out", address: models.Address{Street: "Post Office Box 42", State: "NY", PostalCode: "10001", Country: "US"}, code: appErrors.ErrCodeShippingPOBox},
+		{name: "Embargoed postal code", address: models.Address{Street: "1 Calle Luna", State: "PR", PostalCode: "00601", Country: "US"}, code: appErrors.ErrCodeShippingPostalCode},
+		{name: "Embargoed postal code with a space", address: models.Address{Street: "1 Main St", State: "NU", PostalCode: "x0a 0h0", Country: "CA"}, code: appErrors.ErrCodeShippingPostalCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			shippingService, _, _ := setupShippingServiceTest(t, testShippingPolicy)
+
+			// Act
+			err := shippingService.CheckOrder(t.Context(), &tt.address, []uuid.UUID{uuid.New()})
+
+			// Assert
+			var appErr *appErrors.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.code, appErr.Code)
+			assert.Equal(t, http.StatusUnprocessableEntity, appErr.StatusCode)
+		})
+	}
+
+	t.Run("Street that only starts like a PO box", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, _ := setupShippingServiceTest(t, testShippingPolicy)
+		productID := uuid.New()
+		mockRepo.On("ListProductRestrictions", mock.Anything, []uuid.UUID{productID}).Return(nil, nil).Once()
+
+		// Act
+		err := shippingService.CheckOrder(t.Context(), &models.Address{Street: "12 Po Boxwood Rd", State: "NY", PostalCode: "10001", Country: "US"}, []uuid.UUID{productID})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("No store restrictions", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, _ := setupShippingServiceTest(t, service.ShippingPolicy{AllowedCountries: []string{""}})
+		productID := uuid.New()
+		mockRepo.On("ListProductRestrictions", mock.Anything, []uuid.UUID{productID}).Return(nil, nil).Once()
+
+		// Act
+		err := shippingService.CheckOrder(t.Context(), &models.Address{Street: "PO Box 1", PostalCode: "75001", Country: "FR"}, []uuid.UUID{productID})
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestShippingCheckOrder_ProductRestrictions(t *testing.T) {
+	productID := uuid.New()
+	hazardous := &models.ProductShippingRestriction{ProductID: productID, Reason: "hazardous", AllowedCountries: []string{"US"}, NoPOBox: true}
+
+	t.Run("Failure - Country not allowed for the product", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, _ := setupShippingServiceTest(t, service.ShippingPolicy{})
+		mockRepo.On("ListProductRestrictions", mock.Anything, []uuid.UUID{productID}).Return([]*models.ProductShippingRestriction{hazardous}, nil).Once()
+
+		// Act
+		err := shippingService.CheckOrder(t.Context(), &models.Address{Street: "1 Main St", PostalCode: "M5V", Country: "CA"}, []uuid.UUID{productID})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeShippingProductLimits, appErr.Code)
+		assert.Contains(t, appErr.Message, "hazardous")
+	})
+
+	t.Run("Failure - PO box not allowed for the product", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, _ := setupShippingServiceTest(t, service.ShippingPolicy{})
+		mockRepo.On("ListProductRestrictions", mock.Anything, []uuid.UUID{productID}).Return([]*models.ProductShippingRestriction{hazardous}, nil).Once()
+
+		// Act
+		err := shippingService.CheckOrder(t.Context(), &models.Address{Street: "PO Box 7", PostalCode: "10001", Country: "US"}, []uuid.UUID{productID})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeShippingProductLimits, appErr.Code)
+	})
+
+	t.Run("Success - Quote lists the restrictions checked", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, _ := setupShippingServiceTest(t, testShippingPolicy)
+		mockRepo.On("ListProductRestrictions", mock.Anything, []uuid.UUID{productID}).Return([]*models.ProductShippingRestriction{hazardous}, nil).Once()
+
+		// Act
+		quote, err := shippingService.Quote(t.Context(), &models.ShippingQuoteRequest{
+			ShippingAddress: models.Address{Street: "1 Main St", State: "NY", PostalCode: "10001", Country: "US"},
+			Items:           []models.ShippingQuoteItem{{ProductID: productID, Quantity: 1}},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []*models.ProductShippingRestriction{hazardous}, quote.Restrictions)
+	})
+}
+
+func TestShippingProductRestriction(t *testing.T) {
+	productID := uuid.New()
+
+	t.Run("Success - Countries are stored upper case", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, mockProductRepo := setupShippingServiceTest(t, service.ShippingPolicy{})
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(&models.Product{ID: productID}, nil).Once()
+		mockRepo.On("SetProductRestriction", mock.Anything, mock.MatchedBy(func(r *models.ProductShippingRestriction) bool {
+			return r.ProductID == productID && r.Reason == "hazardous" && assert.ObjectsAreEqual([]string{"US", "CA"}, r.AllowedCountries) && r.NoPOBox
+		})).Return(nil).Once()
+
+		// Act
+		restriction, err := shippingService.SetProductRestriction(t.Context(), productID, &models.SetShippingRestrictionRequest{
+			Reason: "hazardous", AllowedCountries: []string{"us", "ca"}, NoPOBox: true,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, productID, restriction.ProductID)
+	})
+
+	t.Run("Failure - Product not found", func(t *testing.T) {
+		// Arrange
+		shippingService, _, mockProductRepo := setupShippingServiceTest(t, service.ShippingPolicy{})
+		mockProductRepo.On("GetProductByID", mock.Anything, productID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := shippingService.SetProductRestriction(t.Context(), productID, &models.SetShippingRestrictionRequest{Reason: "hazardous"})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Delete without restriction", func(t *testing.T) {
+		// Arrange
+		shippingService, mockRepo, _ := setupShippingServiceTest(t, service.ShippingPolicy{})
+		mockRepo.On("DeleteProductRestriction", mock.Anything, productID).Return(sql.ErrNoRows).Once()
+
+		// Act
+		err := shippingService.DeleteProductRestriction(t.Context(), productID)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
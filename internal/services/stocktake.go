@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// StocktakeService runs the cycle counts of the warehouses and corrects their stock from the counts.
+type StocktakeService interface {
+	CreateStocktake(ctx context.Context, adminID uuid.UUID, req *models.CreateStocktakeRequest) (*models.Stocktake, error)
+	GetStocktake(ctx context.Context, id uuid.UUID) (*models.Stocktake, error)
+	ListStocktakes(ctx context.Context, status models.StocktakeStatus, page, size int) ([]*models.Stocktake, int, error)
+	SubmitCounts(ctx context.Context, id uuid.UUID, req *models.SubmitStocktakeCountsRequest) (*models.Stocktake, error)
+	GetVariances(ctx context.Context, id uuid.UUID) (*models.StocktakeVarianceReport, error)
+	ApplyStocktake(ctx context.Context, adminID, id uuid.UUID, req *models.ApplyStocktakeRequest) (*models.ApplyStocktakeResponse, error)
+}
+
+type stocktakeService struct {
+	repo          repository.StocktakeRepository
+	warehouseRepo repository.WarehouseRepository
+}
+
+func NewStocktakeService(repo repository.StocktakeRepository, warehouseRepo repository.WarehouseRepository) StocktakeService {
+	return &stocktakeService{repo: repo, warehouseRepo: warehouseRepo}
+}
+
+// stockAdjustmentDetails are the details of an AuditActionStockAdjusted entry.
+type stockAdjustmentDetails struct {
+	StocktakeID     uuid.UUID `json:"stocktake_id"`
+	WarehouseID     uuid.UUID `json:"warehouse_id"`
+	SKU             string    `json:"sku"`
+	BookQuantity    int       `json:"book_quantity"`
+	CountedQuantity int       `json:"counted_quantity"`
+	Reason          string    `json:"reason"`
+}
+
+func (s *stocktakeService) CreateStocktake(ctx context.Context, adminID uuid.UUID, req *models.CreateStocktakeRequest) (*models.Stocktake, error) {
+	if _, err := s.warehouseRepo.GetWarehouseByID(ctx, req.WarehouseID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Warehouse not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to fetch warehouse").WithError(err)
+	}
+
+	stocktake := &models.Stocktake{
+		ID:          uuid.New(),
+		WarehouseID: req.WarehouseID,
+		CategoryID:  req.CategoryID,
+		CreatedBy:   adminID,
+	}
+
+	if err := s.repo.CreateStocktake(ctx, stocktake); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.BadRequestError("The warehouse stocks no product to count").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to create stocktake").WithError(err)
+	}
+
+	return s.GetStocktake(ctx, stocktake.ID)
+}
+
+func (s *stocktakeService) GetStocktake(ctx context.Context, id uuid.UUID) (*models.Stocktake, error) {
+	stocktake, err := s.repo.GetStocktake(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Stocktake not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to fetch stocktake").WithError(err)
+	}
+
+	return stocktake, nil
+}
+
+func (s *stocktakeService) ListStocktakes(ctx context.Context, status models.StocktakeStatus, page, size int) ([]*models.Stocktake, int, error) {
+	stocktakes, total, err := s.repo.ListStocktakes(ctx, status, page, size)
+	if err != nil {
+		return nil, 0, appErrors.DatabaseError("Failed to list stocktakes").WithError(err)
+	}
+
+	return stocktakes, total, nil
+}
+
+// SubmitCounts records counted quantities on an open stocktake, a product counted again keeps the last
+// count. Every product must be one of the stocktake.
+func (s *stocktakeService) SubmitCounts(ctx context.Context, id uuid.UUID, req *models.SubmitStocktakeCountsRequest) (*models.Stocktake, error) {
+	stocktake, err := s.openStocktake(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(map[uuid.UUID]bool, len(stocktake.Lines))
+	for _, line := range stocktake.Lines {
+		lines[line.ProductID] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.Counts))
+
+	for _, count := range req.Counts {
+		if !lines[count.ProductID] {
+			return nil, appErrors.BadRequestError(fmt.Sprintf("Product %s is not counted by this stocktake", count.ProductID))
+		}
+
+		if seen[count.ProductID] {
+			return nil, appErrors.BadRequestError(fmt.Sprintf("Product %s is counted more than once", count.ProductID))
+		}
+
+		seen[count.ProductID] = true
+	}
+
+	if err := s.repo.SubmitCounts(ctx, id, req.Counts); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.InvalidTransitionError("Stocktake is already applied").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to record stocktake counts").WithError(err)
+	}
+
+	return s.GetStocktake(ctx, id)
+}
+
+// GetVariances compares the counts with the stock the warehouse holds on the books right now.
+func (s *stocktakeService) GetVariances(ctx context.Context, id uuid.UUID) (*models.StocktakeVarianceReport, error) {
+	stocktake, err := s.GetStocktake(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	variances, err := s.repo.ListVariances(ctx, id)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to compute stocktake variances").WithError(err)
+	}
+
+	return &models.StocktakeVarianceReport{
+		StocktakeID: stocktake.ID,
+		Counted:     len(variances),
+		Uncounted:   len(stocktake.Lines) - len(variances),
+		Variances:   variances,
+	}, nil
+}
+
+// ApplyStocktake sets the stock of the warehouse to the counts and closes the stocktake. Every variance
+// needs a reason, it is recorded in the audit entry of the adjustment on the admin, and the stock moved
+// goes to the ledger. Uncounted products are left as they are.
+func (s *stocktakeService) ApplyStocktake(ctx context.Context, adminID, id uuid.UUID, req *models.ApplyStocktakeRequest) (*models.ApplyStocktakeResponse, error) {
+	stocktake, err := s.openStocktake(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := make(map[uuid.UUID]string, len(req.Reasons))
+
+	for _, reason := range req.Reasons {
+		if _, ok := reasons[reason.ProductID]; ok {
+			return nil, appErrors.BadRequestError(fmt.Sprintf("Product %s is given more than one reason", reason.ProductID))
+		}
+
+		reasons[reason.ProductID] = reason.Reason
+	}
+
+	variances, err := s.repo.ListVariances(ctx, id)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to compute stocktake variances").WithError(err)
+	}
+
+	if len(variances) == 0 {
+		return nil, appErrors.BadRequestError("No product of the stocktake has been counted")
+	}
+
+	adjustments := []models.StocktakeAdjustment{}
+	movements := []*models.StockMovement{}
+	entries := []*models.AuditEntry{}
+
+	for _, variance := range variances {
+		if variance.Variance == 0 {
+			continue
+		}
+
+		reason := reasons[variance.ProductID]
+		if reason == "" {
+			return nil, appErrors.BadRequestError(fmt.Sprintf("A reason is required for the variance of %d of SKU %s", variance.Variance, variance.SKU))
+		}
+
+		adjustment := models.StocktakeAdjustment{
+			ProductID:       variance.ProductID,
+			SKU:             variance.SKU,
+			BookQuantity:    variance.BookQuantity,
+			CountedQuantity: variance.CountedQuantity,
+			Reason:          reason,
+		}
+
+		details, err := json.Marshal(stockAdjustmentDetails{
+			StocktakeID:     stocktake.ID,
+			WarehouseID:     stocktake.WarehouseID,
+			SKU:             adjustment.SKU,
+			BookQuantity:    adjustment.BookQuantity,
+			CountedQuantity: adjustment.CountedQuantity,
+			Reason:          adjustment.Reason,
+		})
+		if err != nil {
+			return nil, appErrors.InternalError("Failed to encode stock adjustment").WithError(err)
+		}
+
+		adjustments = append(adjustments, adjustment)
+		movements = append(movements, &models.StockMovement{
+			ID:          uuid.New(),
+			ProductID:   variance.ProductID,
+			Quantity:    -variance.Variance,
+			Reason:      models.StockMovementAdjustment,
+			ReferenceID: &stocktake.ID,
+		})
+		entries = append(entries, &models.AuditEntry{
+			ID:          uuid.New(),
+			UserID:      adminID,
+			Action:      models.AuditActionStockAdjusted,
+			ReferenceID: &variance.ProductID,
+			Details:     details,
+		})
+	}
+
+	stocktake.AppliedBy = &adminID
+
+	if err := s.repo.ApplyStocktake(ctx, stocktake, adjustments, movements, entries); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.InvalidTransitionError("Stock changed while the stocktake was applied, nothing was adjusted; review the variances again").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to apply stocktake").WithError(err)
+	}
+
+	return &models.ApplyStocktakeResponse{Stocktake: stocktake, Adjustments: adjustments}, nil
+}
+
+func (s *stocktakeService) openStocktake(ctx context.Context, id uuid.UUID) (*models.Stocktake, error) {
+	stocktake, err := s.GetStocktake(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if stocktake.Status != models.StocktakeOpen {
+		return nil, appErrors.InvalidTransitionError("Stocktake is already applied")
+	}
+
+	return stocktake, nil
+}
@@ -0,0 +1,233 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupStocktakeServiceTest(t *testing.T) (service.StocktakeService, *mocks.MockStocktakeRepository, *mocks.MockWarehouseRepository) {
+	stocktakeRepo := mocks.NewMockStocktakeRepository(t)
+	warehouseRepo := mocks.NewMockWarehouseRepository(t)
+
+	return service.NewStocktakeService(stocktakeRepo, warehouseRepo), stocktakeRepo, warehouseRepo
+}
+
+func TestStocktakeService_CreateStocktake(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	warehouseID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, warehouseRepo := setupStocktakeServiceTest(t)
+		warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+
+		var created *models.Stocktake
+
+		stocktakeRepo.On("CreateStocktake", ctx, mock.MatchedBy(func(stocktake *models.Stocktake) bool {
+			created = stocktake
+
+			return stocktake.WarehouseID == warehouseID && stocktake.CreatedBy == adminID && stocktake.CategoryID == nil
+		})).Return(nil).Once()
+		stocktakeRepo.On("GetStocktake", ctx, mock.Anything).Return(func(_ context.Context, id uuid.UUID) (*models.Stocktake, error) {
+			return &models.Stocktake{ID: id, Lines: []models.StocktakeLine{{SKU: "SKU-1"}}}, nil
+		}).Once()
+
+		// Act
+		stocktake, err := stocktakeService.CreateStocktake(ctx, adminID, &models.CreateStocktakeRequest{WarehouseID: warehouseID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, stocktake.ID)
+		assert.Len(t, stocktake.Lines, 1)
+	})
+
+	t.Run("Failure - Nothing to count", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, warehouseRepo := setupStocktakeServiceTest(t)
+		warehouseRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+		stocktakeRepo.On("CreateStocktake", ctx, mock.Anything).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := stocktakeService.CreateStocktake(ctx, adminID, &models.CreateStocktakeRequest{WarehouseID: warehouseID})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+}
+
+func TestStocktakeService_SubmitCounts(t *testing.T) {
+	ctx := t.Context()
+	stocktakeID := uuid.New()
+	productID := uuid.New()
+	open := &models.Stocktake{ID: stocktakeID, Status: models.StocktakeOpen, Lines: []models.StocktakeLine{{ProductID: productID, SKU: "SKU-1"}}}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		counts := []models.StocktakeCount{{ProductID: productID, Quantity: 4}}
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(open, nil).Twice()
+		stocktakeRepo.On("SubmitCounts", ctx, stocktakeID, counts).Return(nil).Once()
+
+		// Act
+		_, err := stocktakeService.SubmitCounts(ctx, stocktakeID, &models.SubmitStocktakeCountsRequest{Counts: counts})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Product not in the stocktake", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(open, nil).Once()
+
+		// Act
+		_, err := stocktakeService.SubmitCounts(ctx, stocktakeID, &models.SubmitStocktakeCountsRequest{Counts: []models.StocktakeCount{{ProductID: uuid.New(), Quantity: 1}}})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Already applied", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(&models.Stocktake{ID: stocktakeID, Status: models.StocktakeApplied}, nil).Once()
+
+		// Act
+		_, err := stocktakeService.SubmitCounts(ctx, stocktakeID, &models.SubmitStocktakeCountsRequest{Counts: []models.StocktakeCount{{ProductID: productID, Quantity: 1}}})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeInvalidTransition, appErr.Code)
+	})
+}
+
+func TestStocktakeService_GetVariances(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+	stocktakeID := uuid.New()
+	variances := []models.StocktakeVariance{{SKU: "SKU-1", BookQuantity: 5, CountedQuantity: 3, Variance: -2}}
+	stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(&models.Stocktake{ID: stocktakeID, Lines: make([]models.StocktakeLine, 3)}, nil).Once()
+	stocktakeRepo.On("ListVariances", ctx, stocktakeID).Return(variances, nil).Once()
+
+	// Act
+	report, err := stocktakeService.GetVariances(ctx, stocktakeID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Counted)
+	assert.Equal(t, 2, report.Uncounted)
+	assert.Equal(t, variances, report.Variances)
+}
+
+func TestStocktakeService_ApplyStocktake(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	stocktakeID := uuid.New()
+	warehouseID := uuid.New()
+	missingID := uuid.New()
+	matchingID := uuid.New()
+	variances := []models.StocktakeVariance{
+		{ProductID: missingID, SKU: "SKU-1", BookQuantity: 5, CountedQuantity: 3, Variance: -2},
+		{ProductID: matchingID, SKU: "SKU-2", BookQuantity: 4, CountedQuantity: 4},
+	}
+	open := func() *models.Stocktake {
+		return &models.Stocktake{ID: stocktakeID, WarehouseID: warehouseID, Status: models.StocktakeOpen}
+	}
+
+	t.Run("Success - Variances adjusted with their reason", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(open(), nil).Once()
+		stocktakeRepo.On("ListVariances", ctx, stocktakeID).Return(variances, nil).Once()
+		stocktakeRepo.On("ApplyStocktake", ctx, mock.MatchedBy(func(stocktake *models.Stocktake) bool {
+			return stocktake.AppliedBy != nil && *stocktake.AppliedBy == adminID
+		}), []models.StocktakeAdjustment{{ProductID: missingID, SKU: "SKU-1", BookQuantity: 5, CountedQuantity: 3, Reason: "Damaged"}},
+			mock.MatchedBy(func(movements []*models.StockMovement) bool {
+				return len(movements) == 1 && movements[0].Quantity == 2 && *movements[0].ReferenceID == stocktakeID
+			}),
+			mock.MatchedBy(func(entries []*models.AuditEntry) bool {
+				var details map[string]any
+
+				return len(entries) == 1 && entries[0].Action == models.AuditActionStockAdjusted && *entries[0].ReferenceID == missingID &&
+					json.Unmarshal(entries[0].Details, &details) == nil && details["reason"] == "Damaged"
+			})).Return(nil).Once()
+
+		// Act
+		result, err := stocktakeService.ApplyStocktake(ctx, adminID, stocktakeID, &models.ApplyStocktakeRequest{
+			Reasons: []models.StocktakeAdjustmentReason{{ProductID: missingID, Reason: "Damaged"}},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, result.Adjustments, 1)
+	})
+
+	t.Run("Failure - Variance without reason", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(open(), nil).Once()
+		stocktakeRepo.On("ListVariances", ctx, stocktakeID).Return(variances, nil).Once()
+
+		// Act
+		_, err := stocktakeService.ApplyStocktake(ctx, adminID, stocktakeID, &models.ApplyStocktakeRequest{
+			Reasons: []models.StocktakeAdjustmentReason{{ProductID: matchingID, Reason: "Recounted"}},
+		})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		assert.Contains(t, appErr.Message, "SKU-1")
+	})
+
+	t.Run("Failure - Nothing counted", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(open(), nil).Once()
+		stocktakeRepo.On("ListVariances", ctx, stocktakeID).Return([]models.StocktakeVariance{}, nil).Once()
+
+		// Act
+		_, err := stocktakeService.ApplyStocktake(ctx, adminID, stocktakeID, &models.ApplyStocktakeRequest{})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("Failure - Stock changed concurrently", func(t *testing.T) {
+		// Arrange
+		stocktakeService, stocktakeRepo, _ := setupStocktakeServiceTest(t)
+		stocktakeRepo.On("GetStocktake", ctx, stocktakeID).Return(open(), nil).Once()
+		stocktakeRepo.On("ListVariances", ctx, stocktakeID).Return(variances, nil).Once()
+		stocktakeRepo.On("ApplyStocktake", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := stocktakeService.ApplyStocktake(ctx, adminID, stocktakeID, &models.ApplyStocktakeRequest{
+			Reasons: []models.StocktakeAdjustmentReason{{ProductID: missingID, Reason: "Damaged"}},
+		})
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeInvalidTransition, appErr.Code)
+	})
+}
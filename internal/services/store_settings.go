@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"log/slog"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+const (
+	storeSettingsCacheKey = "store_settings"
+	storeSettingsTTL      = time.Minute // other instances see saved settings within this long
+)
+
+// StoreSettingsService keeps the settings of the store, read by the documents and the emails of the
+// store instead of configuration of their own.
+type StoreSettingsService interface {
+	GetSettings(ctx context.Context) (*models.StoreSettings, error)
+	UpdateSettings(ctx context.Context, adminID uuid.UUID, req *models.UpdateStoreSettingsRequest) (*models.StoreSettings, error)
+	DefaultLocale(ctx context.Context) locale.Locale
+}
+
+type storeSettingsService struct {
+	repo     repository.StoreSettingsRepository
+	cache    cache.Cache
+	defaults models.StoreSettings
+}
+
+// NewStoreSettingsService serves defaults until an admin saves the settings, usually the ones of the configuration.
+func NewStoreSettingsService(repo repository.StoreSettingsRepository, cache cache.Cache, defaults models.StoreSettings) StoreSettingsService {
+	if defaults.TaxIDs == nil {
+		defaults.TaxIDs = []models.TaxID{}
+	}
+
+	return &storeSettingsService{repo: repo, cache: cache, defaults: defaults}
+}
+
+// GetSettings implements StoreSettingsService. The settings are cached, a cache failure only costs a
+// trip to the database.
+func (s *storeSettingsService) GetSettings(ctx context.Context) (*models.StoreSettings, error) {
+	var settings models.StoreSettings
+
+	found, err := s.cache.Get(ctx, storeSettingsCacheKey, &settings)
+	if err != nil {
+		slog.Warn("Failed to read store settings from cache", slog.String("error", err.Error()))
+	}
+
+	if found {
+		return &settings, nil
+	}
+
+	saved, err := s.repo.GetStoreSettings(ctx)
+
+	switch {
+	case stdErrors.Is(err, sql.ErrNoRows):
+		defaults := s.defaults
+		saved = &defaults
+	case err != nil:
+		return nil, errors.DatabaseError("Failed to get store settings").WithError(err)
+	}
+
+	if err := s.cache.Set(ctx, storeSettingsCacheKey, saved, storeSettingsTTL); err != nil {
+		slog.Warn("Failed to cache store settings", slog.String("error", err.Error()))
+	}
+
+	return saved, nil
+}
+
+// UpdateSettings implements StoreSettingsService. Documents issued before keep the seller they were
+// issued with, a new credit note series starts again at 1.
+func (s *storeSettingsService) UpdateSettings(ctx context.Context, adminID uuid.UUID, req *models.UpdateStoreSettingsRequest) (*models.StoreSettings, error) {
+	settings := &models.StoreSettings{
+		StoreName:        req.StoreName,
+		LegalEntity:      req.LegalEntity,
+		Address:          req.Address,
+		TaxIDs:           req.TaxIDs,
+		CreditNoteSeries: req.CreditNoteSeries,
+		DefaultCurrency:  req.DefaultCurrency,
+		DefaultLocale:    req.DefaultLocale,
+		DefaultTimezone:  req.DefaultTimezone,
+		UpdatedBy:        &adminID,
+	}
+
+	if settings.TaxIDs == nil {
+		settings.TaxIDs = []models.TaxID{}
+	}
+
+	if settings.DefaultTimezone == "" {
+		settings.DefaultTimezone = time.UTC.String()
+	}
+
+	if err := s.repo.SaveStoreSettings(ctx, settings); err != nil {
+		return nil, errors.DatabaseError("Failed to save store settings").WithError(err)
+	}
+
+	if err := s.cache.Delete(ctx, storeSettingsCacheKey); err != nil {
+		slog.Warn("Failed to drop cached store settings", slog.String("error", err.Error()))
+	}
+
+	return settings, nil
+}
+
+// DefaultLocale implements StoreSettingsService. It serves whoever did not ask for a locale of their own,
+// the locale of the settings when they can't be read is locale.Default.
+func (s *storeSettingsService) DefaultLocale(ctx context.Context) locale.Locale {
+	settings, err := s.GetSettings(ctx)
+	if err != nil {
+		slog.Warn("Failed to read store locale, using the default", slog.String("error", err.Error()))
+
+		return locale.Default
+	}
+
+	return locale.New(settings.DefaultLocale, settings.DefaultTimezone)
+}
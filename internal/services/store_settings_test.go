@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	cacheMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/cache/mocks"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testStoreDefaults = models.StoreSettings{LegalEntity: "default", CreditNoteSeries: "CN", DefaultCurrency: "usd", DefaultLocale: "en-US"}
+
+func TestStoreSettingsService_GetSettings(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success - Cached", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockCache.On("Get", ctx, "store_settings", mock.AnythingOfType("*models.StoreSettings")).Run(func(args mock.Arguments) {
+			args.Get(2).(*models.StoreSettings).StoreName = "Acme"
+		}).Return(true, nil).Once()
+
+		// Act
+		settings, err := settingsService.GetSettings(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", settings.StoreName)
+		mockRepo.AssertNotCalled(t, "GetStoreSettings")
+	})
+
+	t.Run("Success - Defaults until saved", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockCache.On("Get", ctx, "store_settings", mock.Anything).Return(false, errors.New("redis down")).Once()
+		mockRepo.On("GetStoreSettings", ctx).Return(nil, sql.ErrNoRows).Once()
+		mockCache.On("Set", ctx, "store_settings", mock.AnythingOfType("*models.StoreSettings"), mock.Anything).Return(nil).Once()
+
+		// Act
+		settings, err := settingsService.GetSettings(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "default", settings.LegalEntity)
+		assert.Equal(t, "CN", settings.CreditNoteSeries)
+		assert.Equal(t, []models.TaxID{}, settings.TaxIDs)
+		assert.True(t, settings.UpdatedAt.IsZero())
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockCache.On("Get", ctx, "store_settings", mock.Anything).Return(false, nil).Once()
+		mockRepo.On("GetStoreSettings", ctx).Return(nil, errors.New("db error")).Once()
+
+		// Act
+		settings, err := settingsService.GetSettings(ctx)
+
+		// Assert
+		assert.Nil(t, settings)
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+	})
+}
+
+func TestStoreSettingsService_UpdateSettings(t *testing.T) {
+	ctx := t.Context()
+	adminID := uuid.New()
+	req := &models.UpdateStoreSettingsRequest{
+		StoreName:        "Acme",
+		LegalEntity:      "Acme GmbH",
+		CreditNoteSeries: "GS",
+		DefaultCurrency:  "eur",
+		DefaultLocale:    "de-DE",
+	}
+
+	t.Run("Success - Saved and dropped from cache", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockRepo.On("SaveStoreSettings", ctx, mock.MatchedBy(func(s *models.StoreSettings) bool {
+			return s.LegalEntity == "Acme GmbH" && s.CreditNoteSeries == "GS" && *s.UpdatedBy == adminID && s.TaxIDs != nil && s.DefaultTimezone == "UTC"
+		})).Return(nil).Once()
+		mockCache.On("Delete", ctx, "store_settings").Return(nil).Once()
+
+		// Act
+		settings, err := settingsService.UpdateSettings(ctx, adminID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", settings.StoreName)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockRepo.On("SaveStoreSettings", ctx, mock.Anything).Return(errors.New("db error")).Once()
+
+		// Act
+		_, err := settingsService.UpdateSettings(ctx, adminID, req)
+
+		// Assert
+		assertAppErrorCode(t, err, appErrors.ErrCodeDatabaseError)
+		mockCache.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestStoreSettingsService_DefaultLocale(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Success - Locale of the settings", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockCache.On("Get", ctx, "store_settings", mock.Anything).Return(false, nil).Once()
+		mockRepo.On("GetStoreSettings", ctx).Return(&models.StoreSettings{DefaultLocale: "de-DE", DefaultTimezone: "Europe/Berlin"}, nil).Once()
+		mockCache.On("Set", ctx, "store_settings", mock.Anything, mock.Anything).Return(nil).Once()
+
+		// Act
+		loc := settingsService.DefaultLocale(ctx)
+
+		// Assert
+		assert.Equal(t, "de-DE", loc.Tag)
+		assert.Equal(t, "Europe/Berlin", loc.Location.String())
+	})
+
+	t.Run("Success - Default when the settings can't be read", func(t *testing.T) {
+		// Arrange
+		mockRepo := mocks.NewMockStoreSettingsRepository(t)
+		mockCache := cacheMocks.NewMockCache(t)
+		settingsService := service.NewStoreSettingsService(mockRepo, mockCache, testStoreDefaults)
+		mockCache.On("Get", ctx, "store_settings", mock.Anything).Return(false, nil).Once()
+		mockRepo.On("GetStoreSettings", ctx).Return(nil, errors.New("db error")).Once()
+
+		// Act
+		loc := settingsService.DefaultLocale(ctx)
+
+		// Assert
+		assert.Equal(t, locale.Default, loc)
+	})
+}
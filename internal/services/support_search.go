@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// SupportSearchService finds what a support ticket is about from whatever the customer quoted.
+type SupportSearchService interface {
+	Search(ctx context.Context, text string) (*models.SupportSearchResponse, error)
+}
+
+type supportSearchService struct {
+	repo repository.SupportSearchRepository
+}
+
+func NewSupportSearchService(repo repository.SupportSearchRepository) SupportSearchService {
+	return &supportSearchService{repo: repo}
+}
+
+// supportSearchLimit bounds the matches of a search, the latest are kept.
+const supportSearchLimit = 50
+
+// paymentIntentPrefixes start the IDs Stripe gives payments, checkout sessions included.
+var paymentIntentPrefixes = []string{"pi_", "cs_"}
+
+// Search reads the text as an order or customer ID, an email address, a phone number or a payment
+// intent ID and returns every record it matches, with links to the endpoints support opens next.
+// Orders are numbered by their ID.
+func (s *supportSearchService) Search(ctx context.Context, text string) (*models.SupportSearchResponse, error) {
+	text = strings.TrimSpace(text)
+
+	query, ok := parseSupportSearch(text)
+	if !ok {
+		return nil, appErrors.BadRequestError("Search for an order or customer ID, an email address, a phone number or a payment intent ID")
+	}
+
+	matches, err := s.repo.Search(ctx, query, supportSearchLimit)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to search").WithError(err)
+	}
+
+	for i := range matches {
+		matches[i].Links = supportSearchLinks(&matches[i])
+	}
+
+	return &models.SupportSearchResponse{Query: text, Matches: matches}, nil
+}
+
+func parseSupportSearch(text string) (*models.SupportSearchQuery, bool) {
+	if id, err := uuid.Parse(text); err == nil {
+		return &models.SupportSearchQuery{ID: &id}, true
+	}
+
+	for _, prefix := range paymentIntentPrefixes {
+		if strings.HasPrefix(text, prefix) && len(text) > len(prefix) {
+			return &models.SupportSearchQuery{PaymentIntentID: text}, true
+		}
+	}
+
+	if strings.Contains(text, "@") {
+		return &models.SupportSearchQuery{Email: text}, true
+	}
+
+	if phone, ok := normalizePhone(text); ok {
+		return &models.SupportSearchQuery{Phone: phone}, true
+	}
+
+	return nil, false
+}
+
+// normalizePhone drops the spaces, dashes, dots and brackets people write phone numbers with, the
+// number is matched as typed otherwise. A number has 7 to 15 digits and may start with a plus.
+func normalizePhone(text string) (string, bool) {
+	var b strings.Builder
+
+	digits := 0
+
+	for i, r := range text {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case strings.ContainsRune(" -.()", r): // dropped
+		default:
+			return "", false
+		}
+	}
+
+	return b.String(), digits >= 7 && digits <= 15
+}
+
+func supportSearchLinks(match *models.SupportSearchMatch) map[string]string {
+	links := map[string]string{}
+
+	if match.CustomerID != nil {
+		links["timeline"] = "/api/v1/admin/users/" + match.CustomerID.String() + "/timeline"
+	}
+
+	switch match.Type {
+	case models.SupportSearchOrder:
+		links["refunds"] = "/api/v1/admin/orders/" + match.ID + "/refunds"
+		links["packing_slip"] = "/api/v1/admin/orders/" + match.ID + "/packing-slip"
+	case models.SupportSearchPayment:
+		links["payment"] = "/api/v1/payments/" + url.PathEscape(match.ID)
+	case models.SupportSearchNotifications:
+		if match.MatchedOn == "phone" {
+			links["notifications"] = "/api/v1/admin/notifications?" + url.Values{
+				"type":      {string(models.NotificationTypeSMS)},
+				"recipient": {match.ID},
+			}.Encode()
+		} else {
+			links["notifications"] = "/api/v1/admin/notifications/search?" + url.Values{"recipient": {match.ID}}.Encode()
+		}
+	}
+
+	return links
+}
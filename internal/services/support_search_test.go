@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportSearchService_Search(t *testing.T) {
+	ctx := t.Context()
+	id := uuid.New()
+	customerID := uuid.New()
+
+	t.Run("Success - Queries read by their shape", func(t *testing.T) {
+		tests := []struct {
+			text  string
+			query *models.SupportSearchQuery
+		}{
+			{text: " " + id.String() + " ", query: &models.SupportSearchQuery{ID: &id}},
+			{text: "pi_3Nabc", query: &models.SupportSearchQuery{PaymentIntentID: "pi_3Nabc"}},
+			{text: "cs_test_a1", query: &models.SupportSearchQuery{PaymentIntentID: "cs_test_a1"}},
+			{text: "Jane@Example.com", query: &models.SupportSearchQuery{Email: "Jane@Example.com"}},
+			{text: "+1 (555) 010-2030", query: &models.SupportSearchQuery{Phone: "+15550102030"}},
+		}
+
+		for _, tt := range tests {
+			// Arrange
+			repo := mocks.NewMockSupportSearchRepository(t)
+			searchService := service.NewSupportSearchService(repo)
+			repo.On("Search", ctx, tt.query, 50).Return([]models.SupportSearchMatch{}, nil).Once()
+
+			// Act
+			result, err := searchService.Search(ctx, tt.text)
+
+			// Assert
+			require.NoError(t, err, tt.text)
+			assert.Empty(t, result.Matches)
+		}
+	})
+
+	t.Run("Success - Matches linked", func(t *testing.T) {
+		// Arrange
+		repo := mocks.NewMockSupportSearchRepository(t)
+		searchService := service.NewSupportSearchService(repo)
+		repo.On("Search", ctx, mock.Anything, 50).Return([]models.SupportSearchMatch{
+			{Type: models.SupportSearchOrder, ID: id.String(), MatchedOn: "payment_intent_id", CustomerID: &customerID},
+			{Type: models.SupportSearchPayment, ID: "pi_3Nabc", MatchedOn: "payment_intent_id"},
+			{Type: models.SupportSearchNotifications, ID: "+15550102030", MatchedOn: "phone"},
+		}, nil).Once()
+
+		// Act
+		result, err := searchService.Search(ctx, "pi_3Nabc")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result.Matches, 3)
+		assert.Equal(t, map[string]string{
+			"timeline":     "/api/v1/admin/users/" + customerID.String() + "/timeline",
+			"refunds":      "/api/v1/admin/orders/" + id.String() + "/refunds",
+			"packing_slip": "/api/v1/admin/orders/" + id.String() + "/packing-slip",
+		}, result.Matches[0].Links)
+		assert.Equal(t, map[string]string{"payment": "/api/v1/payments/pi_3Nabc"}, result.Matches[1].Links)
+		assert.Equal(t, map[string]string{"notifications": "/api/v1/admin/notifications?recipient=%2B15550102030&type=sms"}, result.Matches[2].Links)
+	})
+
+	t.Run("Failure - Unrecognised query", func(t *testing.T) {
+		// Arrange
+		searchService := service.NewSupportSearchService(mocks.NewMockSupportSearchRepository(t))
+
+		for _, text := range []string{"order 42", "12345", "pi_"} {
+			// Act
+			_, err := searchService.Search(ctx, text)
+
+			// Assert
+			appErr, ok := appErrors.IsAppError(err)
+			require.True(t, ok, text)
+			assert.Equal(t, appErrors.ErrCodeBadRequest, appErr.Code)
+		}
+	})
+
+	t.Run("Failure - Database error", func(t *testing.T) {
+		// Arrange
+		repo := mocks.NewMockSupportSearchRepository(t)
+		searchService := service.NewSupportSearchService(repo)
+		repo.On("Search", ctx, mock.Anything, 50).Return(nil, errors.New("connection refused")).Once()
+
+		// Act
+		_, err := searchService.Search(ctx, "jane@example.com")
+
+		// Assert
+		appErr, ok := appErrors.IsAppError(err)
+		require.True(t, ok)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
@@ -2,37 +2,94 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appError "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
-	models "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/locale"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/passhash"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"golang.org/x/crypto/bcrypt"
 )
 
 const userTracerName = "ecommerce/userservice"
 
 type UserService interface {
 	Register(ctx context.Context, req *models.RegisterRequest) (*models.User, error)
-	Login(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error)
+	Login(ctx context.Context, req *models.LoginRequest, device models.LoginDevice) (*models.LoginResponse, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetUserTimeline(ctx context.Context, id uuid.UUID, page int, size int) ([]*models.TimelineEvent, int, error)
+	Impersonate(ctx context.Context, actor *models.Claims, id uuid.UUID, req *models.ImpersonationRequest) (*models.ImpersonationResponse, error)
+	ListSessions(ctx context.Context, claims *models.Claims) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, claims *models.Claims, id uuid.UUID) error
+	ChangePassword(ctx context.Context, claims *models.Claims, req *models.ChangePasswordRequest) error
 }
 
+// TokenPolicy signs the tokens handed out at login and impersonation, and names the issuer and audience
+// AuthMiddleware expects them to claim. Empty ones are left out of the tokens.
+type TokenPolicy struct {
+	Key      []byte
+	Issuer   string
+	Audience string
+}
+
+// registeredClaims describes a token issued now, id is the session the token belongs to, if any.
+func (p TokenPolicy) registeredClaims(id string, now, expiresAt time.Time) jwt.RegisteredClaims {
+	claims := jwt.RegisteredClaims{
+		Issuer:    p.Issuer,
+		ID:        id,
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		NotBefore: jwt.NewNumericDate(now),
+		IssuedAt:  jwt.NewNumericDate(now),
+	}
+
+	if p.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{p.Audience}
+	}
+
+	return claims
+}
+
+const (
+	defaultImpersonationDuration = 15 * time.Minute
+	sessionDuration              = 24 * time.Hour
+)
+
 type userService struct {
-	repo      repository.UserRepository
-	redisRepo repository.RateLimitRepository
-	jwtKey    []byte
+	repo                repository.UserRepository
+	redisRepo           repository.RateLimitRepository
+	auditRepo           repository.AuditRepository
+	vendorRepo          repository.VendorRepository
+	sessionRepo         repository.SessionRepository
+	notificationService NotificationService
+	passwordPolicy      PasswordPolicy
+	hasher              *passhash.Hasher
+	tokens              TokenPolicy
+	clock               clock.Clock
 }
 
-func NewUserService(repo repository.UserRepository, redisRepo repository.RateLimitRepository, jwtKey []byte) UserService {
+func NewUserService(repo repository.UserRepository, redisRepo repository.RateLimitRepository, auditRepo repository.AuditRepository, vendorRepo repository.VendorRepository, sessionRepo repository.SessionRepository, notificationService NotificationService, passwordPolicy PasswordPolicy, hasher *passhash.Hasher, tokens TokenPolicy, clk clock.Clock) UserService {
 	return &userService{
-		repo:      repo,
-		redisRepo: redisRepo,
-		jwtKey:    jwtKey,
+		repo:                repo,
+		redisRepo:           redisRepo,
+		auditRepo:           auditRepo,
+		vendorRepo:          vendorRepo,
+		sessionRepo:         sessionRepo,
+		notificationService: notificationService,
+		passwordPolicy:      passwordPolicy,
+		hasher:              hasher,
+		tokens:              tokens,
+		clock:               clk,
 	}
 }
 
@@ -46,8 +103,12 @@ func (s *userService) Register(ctx context.Context, req *models.RegisterRequest)
 		return nil, appError.DuplicateEntryError("Email already registered")
 	}
 
+	if err := s.passwordPolicy.check(ctx, req.Password); err != nil {
+		return nil, err
+	}
+
 	// Hash the password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPassword, err := s.hasher.Hash(req.Password)
 	if err != nil {
 		return nil, appError.InternalError("Failed to secure password").WithError(err)
 	}
@@ -55,7 +116,7 @@ func (s *userService) Register(ctx context.Context, req *models.RegisterRequest)
 	user := &models.User{
 		Name:     req.Name,
 		Email:    req.Email,
-		Password: string(hashedPassword),
+		Password: hashedPassword,
 	}
 
 	err = s.repo.CreateUser(ctx, user)
@@ -66,7 +127,9 @@ func (s *userService) Register(ctx context.Context, req *models.RegisterRequest)
 	return user, err
 }
 
-func (s *userService) Login(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error) {
+// Login opens a session for the device, the token carries its ID. The user is emailed when the
+// device was never seen on the account before, but not on the very first login.
+func (s *userService) Login(ctx context.Context, req *models.LoginRequest, device models.LoginDevice) (*models.LoginResponse, error) {
 	tracer := otel.Tracer(userTracerName)
 
 	ctx, span := tracer.Start(ctx, "LoginUser")
@@ -88,37 +151,82 @@ func (s *userService) Login(ctx context.Context, req *models.LoginRequest) (*mod
 		}, nil
 	}
 
+	invalidCredentials := &models.LoginResponse{
+		Success:        false,
+		Message:        "Invalid email or password",
+		RemainingTries: remaining,
+	}
+
 	// Retrieve the user from the DB and compare the passwords
 	user, err := s.repo.GetUserByEmail(ctx, req.Email)
-	if err != nil || bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)) != nil {
-		return &models.LoginResponse{
-			Success:        false,
-			Message:        "Invalid email or password",
-			RemainingTries: remaining,
-		}, nil
+	if err != nil {
+		return invalidCredentials, nil
+	}
+
+	match, rehash := s.verifyPassword(user, req.Password)
+	if !match {
+		s.audit(ctx, &models.AuditEntry{UserID: user.ID, Action: models.AuditActionLoginFailed})
+
+		return invalidCredentials, nil
+	}
+
+	// Legacy bcrypt hashes, and hashes made with older parameters, are replaced while the password is known
+	if rehash {
+		s.rehashPassword(ctx, user, req.Password)
+	}
+
+	// Vendor tokens carry the vendor ID, every vendor endpoint is scoped to it
+	var vendorID *uuid.UUID
+
+	if user.Role == models.RoleVendor {
+		vendor, err := s.vendorRepo.GetVendorByUserID(ctx, user.ID)
+		if err != nil {
+			return nil, appError.DatabaseError("Failed to get vendor").WithError(err)
+		}
+
+		vendorID = &vendor.ID
+	}
+
+	now := s.clock.Now()
+
+	session := &models.Session{ID: uuid.New(), UserID: user.ID, LoginDevice: device, ExpiresAt: now.Add(sessionDuration)}
+
+	sessions, deviceSessions, countErr := s.sessionRepo.CountDeviceSessions(ctx, user.ID, device.UserAgent)
+	if countErr != nil {
+		slog.Error("Failed to look up the devices of the user", slog.String("userId", user.ID.String()), slog.String("error", countErr.Error()))
+	}
+
+	if err := s.sessionRepo.CreateSession(ctx, session); err != nil {
+		return nil, appError.DatabaseError("Failed to create session").WithError(err)
 	}
 
 	claims := &models.Claims{
-		UserID: user.ID,
-		Email:  user.Email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+		UserID:           user.ID,
+		Email:            user.Email,
+		Role:             user.Role,
+		VendorID:         vendorID,
+		RegisteredClaims: s.tokens.registeredClaims(session.ID.String(), now, session.ExpiresAt),
 	}
 
 	// Generate Token
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	tokenString, err := token.SignedString(s.jwtKey)
+	tokenString, err := token.SignedString(s.tokens.Key)
 	if err != nil {
 		return nil, appError.InternalError("Failed to generate authentication token").WithError(err)
 	}
 
+	details, _ := json.Marshal(device)
+	s.audit(ctx, &models.AuditEntry{UserID: user.ID, Action: models.AuditActionLogin, ReferenceID: &session.ID, Details: details})
+
+	if countErr == nil && sessions > 0 && deviceSessions == 0 {
+		s.notifyNewDevice(ctx, user, session)
+	}
+
 	return &models.LoginResponse{
 		Success:   true,
 		Token:     tokenString,
-		ExpiresIn: int(time.Until(claims.ExpiresAt.Time).Seconds()),
+		ExpiresIn: int(claims.ExpiresAt.Sub(now).Seconds()),
 	}, nil
 }
 
@@ -131,3 +239,204 @@ func (s *userService) GetUserByID(ctx context.Context, id uuid.UUID) (*models.Us
 	// Note: Password is already included in repository query
 	return user, nil
 }
+
+// GetUserTimeline returns the activity of the user across the audit log and the domain tables, most recent first.
+func (s *userService) GetUserTimeline(ctx context.Context, id uuid.UUID, page int, size int) ([]*models.TimelineEvent, int, error) {
+	user, err := s.repo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, 0, appError.NotFoundError("User not found").WithError(err)
+	}
+
+	events, total, err := s.auditRepo.ListUserTimeline(ctx, user, page, size)
+	if err != nil {
+		return nil, 0, appError.DatabaseError("Failed to fetch user timeline").WithError(err)
+	}
+
+	return events, total, nil
+}
+
+// Impersonate issues a short-lived token acting as the customer. The token is read-only unless an admin
+// asks for write access, and it is only handed out once the impersonation is in the audit log.
+func (s *userService) Impersonate(ctx context.Context, actor *models.Claims, id uuid.UUID, req *models.ImpersonationRequest) (*models.ImpersonationResponse, error) {
+	if req.WriteAccess && actor.Role != models.RoleAdmin {
+		return nil, appError.ForbiddenError("Only admins can impersonate with write access")
+	}
+
+	user, err := s.repo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, appError.NotFoundError("User not found").WithError(err)
+	}
+
+	if user.Role != models.RoleCustomer {
+		return nil, appError.ForbiddenError("Only customers can be impersonated")
+	}
+
+	entry := &models.AuditEntry{ID: uuid.New(), UserID: user.ID, ActorID: &actor.UserID, Action: models.AuditActionImpersonated}
+
+	if err := s.auditRepo.RecordEntry(ctx, entry); err != nil {
+		return nil, appError.DatabaseError("Failed to record impersonation").WithError(err)
+	}
+
+	duration := defaultImpersonationDuration
+	if req.DurationMinutes > 0 {
+		duration = time.Duration(req.DurationMinutes) * time.Minute
+	}
+
+	impersonation := models.Impersonation{
+		ActorID:  actor.UserID,
+		ReadOnly: !req.WriteAccess,
+		Reason:   req.Reason,
+	}
+
+	now := s.clock.Now()
+
+	claims := &models.Claims{
+		UserID:           user.ID,
+		Email:            user.Email,
+		Role:             user.Role,
+		Impersonation:    &impersonation,
+		RegisteredClaims: s.tokens.registeredClaims("", now, now.Add(duration)),
+	}
+
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.tokens.Key)
+	if err != nil {
+		return nil, appError.InternalError("Failed to generate impersonation token").WithError(err)
+	}
+
+	return &models.ImpersonationResponse{
+		Token:         tokenString,
+		ExpiresIn:     int(duration.Seconds()),
+		Impersonation: impersonation,
+	}, nil
+}
+
+// ListSessions returns the active sessions of the user, flagging the one of the token in use.
+func (s *userService) ListSessions(ctx context.Context, claims *models.Claims) ([]*models.Session, error) {
+	sessions, err := s.sessionRepo.ListActiveSessions(ctx, claims.UserID)
+	if err != nil {
+		return nil, appError.DatabaseError("Failed to fetch sessions").WithError(err)
+	}
+
+	for _, session := range sessions {
+		session.Current = session.ID.String() == claims.ID
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession signs a device of the user out, its token is refused from then on. Revoking the
+// current session logs the user out.
+func (s *userService) RevokeSession(ctx context.Context, claims *models.Claims, id uuid.UUID) error {
+	if err := s.sessionRepo.RevokeSession(ctx, claims.UserID, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return appError.NotFoundError("Session not found")
+		}
+
+		return appError.DatabaseError("Failed to revoke session").WithError(err)
+	}
+
+	s.audit(ctx, &models.AuditEntry{UserID: claims.UserID, Action: models.AuditActionSessionRevoked, ReferenceID: &id})
+
+	return nil
+}
+
+// ChangePassword replaces the password of the user once the current one is confirmed, the new one has
+// to follow the password policy.
+func (s *userService) ChangePassword(ctx context.Context, claims *models.Claims, req *models.ChangePasswordRequest) error {
+	user, err := s.repo.GetUserByEmail(ctx, claims.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return appError.NotFoundError("User not found")
+		}
+
+		return appError.DatabaseError("Failed to fetch user").WithError(err)
+	}
+
+	if match, _ := s.verifyPassword(user, req.CurrentPassword); !match {
+		return appError.UnauthorizedError("Current password is incorrect")
+	}
+
+	if err := s.passwordPolicy.check(ctx, req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.hasher.Hash(req.NewPassword)
+	if err != nil {
+		return appError.InternalError("Failed to secure password").WithError(err)
+	}
+
+	if err := s.repo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return appError.NotFoundError("User not found")
+		}
+
+		return appError.DatabaseError("Failed to update password").WithError(err)
+	}
+
+	s.audit(ctx, &models.AuditEntry{UserID: user.ID, Action: models.AuditActionPasswordChanged})
+
+	return nil
+}
+
+// verifyPassword tells whether the password is the one of the user, and whether its hash is outdated.
+// A hash that cannot be read matches no password.
+func (s *userService) verifyPassword(user *models.User, password string) (bool, bool) {
+	match, rehash, err := s.hasher.Verify(password, user.Password)
+	if err != nil {
+		slog.Error("Failed to verify password", slog.String("userId", user.ID.String()), slog.String("error", err.Error()))
+
+		return false, false
+	}
+
+	return match, rehash
+}
+
+// rehashPassword is best effort, the login goes through with the outdated hash and retries next time.
+func (s *userService) rehashPassword(ctx context.Context, user *models.User, password string) {
+	hashedPassword, err := s.hasher.Hash(password)
+	if err == nil {
+		err = s.repo.UpdatePassword(ctx, user.ID, hashedPassword)
+	}
+
+	if err != nil {
+		slog.Error("Failed to rehash password", slog.String("userId", user.ID.String()), slog.String("error", err.Error()))
+
+		return
+	}
+
+	metrics.RecordPasswordRehashed()
+}
+
+// notifyNewDevice is best effort, the login goes through when the email cannot be sent.
+func (s *userService) notifyNewDevice(ctx context.Context, user *models.User, session *models.Session) {
+	location := session.IPAddress
+	if session.Country != "" {
+		location += " (" + session.Country + ")"
+	}
+
+	req := &models.EmailNotificationRequest{
+		To:      user.Email,
+		Subject: "New sign-in to your account",
+		Content: fmt.Sprintf("Your account was signed in to from a new device on %s: %s from %s. If this was not you, sign the device out from your sessions and change your password.",
+			locale.FromContext(ctx).FormatDateTime(session.CreatedAt), session.UserAgent, location),
+		Metadata: map[string]string{"session_id": session.ID.String()},
+	}
+
+	if _, err := s.notificationService.SendEmail(ctx, req); err != nil {
+		slog.Error("Failed to send new device notification",
+			slog.String("userId", user.ID.String()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// audit is best effort, a login is not refused because the audit log is unavailable.
+func (s *userService) audit(ctx context.Context, entry *models.AuditEntry) {
+	entry.ID = uuid.New()
+
+	if err := s.auditRepo.RecordEntry(ctx, entry); err != nil {
+		slog.Error("Failed to record audit entry",
+			slog.String("userId", entry.UserID.String()),
+			slog.String("action", string(entry.Action)),
+			slog.String("error", err.Error()))
+	}
+}
@@ -1,14 +1,19 @@
 package service_test
 
 import (
+	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
 	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
 	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/passhash"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -17,13 +22,17 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// testHasher keeps the tests fast, its parameters are far too weak for production.
+var testHasher, _ = passhash.New(passhash.Params{Memory: 64, Iterations: 1, Parallelism: 1})
+
 func TestUserService_Register(t *testing.T) {
 	// Arrange
 	mockUserRepo := mocks.NewMockUserRepository(t)
 	mockRedisRepo := mocks.NewMockRateLimitRepository(t)
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
 	jwtKey := []byte("test-key")
 
-	userService := service.NewUserService(mockUserRepo, mockRedisRepo, jwtKey)
+	userService := service.NewUserService(mockUserRepo, mockRedisRepo, mockAuditRepo, mocks.NewMockVendorRepository(t), mocks.NewMockSessionRepository(t), svcMocks.NewMockNotificationService(t), service.PasswordPolicy{}, testHasher, service.TokenPolicy{Key: jwtKey}, clock.NewFake(testNow))
 
 	t.Run("Success - User Registration", func(t *testing.T) {
 		ctx := t.Context()
@@ -49,9 +58,12 @@ func TestUserService_Register(t *testing.T) {
 		assert.Equal(t, req.Name, user.Name)
 		assert.Equal(t, req.Email, user.Email)
 
-		// Verify that password was hashed by bcrypt
-		err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
+		// Verify that password was hashed with Argon2id
+		assert.True(t, strings.HasPrefix(user.Password, "$argon2id$"))
+
+		match, _, err := testHasher.Verify(req.Password, user.Password)
 		assert.NoError(t, err)
+		assert.True(t, match)
 
 		mockUserRepo.AssertExpectations(t)
 	})
@@ -122,15 +134,20 @@ func TestUserService_Register(t *testing.T) {
 func TestUserService_Login(t *testing.T) {
 	mockUserRepo := mocks.NewMockUserRepository(t)
 	mockRedisRepo := mocks.NewMockRateLimitRepository(t)
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
+	mockVendorRepo := mocks.NewMockVendorRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockNotificationService := svcMocks.NewMockNotificationService(t)
 	jwtKey := []byte("test-key")
+	device := models.LoginDevice{IPAddress: "203.0.113.7", UserAgent: "Mozilla/5.0 (Macintosh)", Country: "DE"}
 
-	userService := service.NewUserService(mockUserRepo, mockRedisRepo, jwtKey)
+	userService := service.NewUserService(mockUserRepo, mockRedisRepo, mockAuditRepo, mockVendorRepo, mockSessionRepo, mockNotificationService, service.PasswordPolicy{}, testHasher, service.TokenPolicy{Key: jwtKey, Issuer: "shop", Audience: "shop-api"}, clock.NewFake(testNow))
 
 	t.Run("Success - Valid Credentials", func(t *testing.T) {
 		// Arrange
 		ctx := t.Context()
 		password := "P@ssword123!"
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		hashedPassword, err := testHasher.Hash(password)
 		require.NoError(t, err)
 
 		req := &models.LoginRequest{
@@ -141,8 +158,9 @@ func TestUserService_Login(t *testing.T) {
 		user := &models.User{
 			ID:       uuid.New(),
 			Email:    req.Email,
-			Password: string(hashedPassword),
+			Password: hashedPassword,
 			Name:     "Test User",
+			Role:     models.RoleAdmin,
 		}
 
 		// Mock Behavior -> rate limit check
@@ -151,8 +169,23 @@ func TestUserService_Login(t *testing.T) {
 		// Mock Behavior -> user exists!
 		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
 
+		// Mock Behavior -> a known device opens a session
+		var session *models.Session
+
+		mockSessionRepo.On("CountDeviceSessions", mock.Anything, user.ID, device.UserAgent).Return(3, 1, nil).Once()
+		mockSessionRepo.On("CreateSession", mock.Anything, mock.MatchedBy(func(s *models.Session) bool {
+			return s.UserID == user.ID && s.LoginDevice == device && s.ExpiresAt.Equal(testNow.Add(24*time.Hour))
+		})).Run(func(args mock.Arguments) {
+			session = args.Get(1).(*models.Session)
+		}).Return(nil).Once()
+
+		// Mock Behavior -> the login is audited with the session
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == user.ID && e.Action == models.AuditActionLogin && e.ReferenceID != nil && *e.ReferenceID == session.ID
+		})).Return(nil).Once()
+
 		// Act
-		resp, err := userService.Login(ctx, req)
+		resp, err := userService.Login(ctx, req, device)
 
 		// Assert
 		assert.NoError(t, err)
@@ -168,23 +201,173 @@ func TestUserService_Login(t *testing.T) {
 		// actual token, where the token be decoded, server/secret-key
 		token, err := jwt.ParseWithClaims(resp.Token, &models.Claims{}, func(_ *jwt.Token) (interface{}, error) {
 			return jwtKey, nil
-		})
+		}, jwt.WithTimeFunc(func() time.Time { return testNow }))
 		assert.NoError(t, err)
 
 		claims, ok := token.Claims.(*models.Claims)
 		assert.True(t, ok)
 		assert.Equal(t, user.ID, claims.UserID)
 		assert.Equal(t, user.Email, claims.Email)
+		assert.Equal(t, user.Role, claims.Role)
+		assert.Equal(t, testNow.Add(24*time.Hour).Unix(), claims.ExpiresAt.Unix())
+		assert.Equal(t, 24*60*60, resp.ExpiresIn)
+		assert.Equal(t, session.ID.String(), claims.ID)
+		assert.Equal(t, "shop", claims.Issuer)
+		assert.Equal(t, jwt.ClaimStrings{"shop-api"}, claims.Audience)
+		assert.Equal(t, testNow.Unix(), claims.NotBefore.Unix())
+		mockNotificationService.AssertNotCalled(t, "SendEmail")
 
 		mockUserRepo.AssertExpectations(t)
 		mockRedisRepo.AssertExpectations(t)
 	})
+	t.Run("Success - Legacy bcrypt hash is replaced", func(t *testing.T) {
+		// Arrange
+		password := "P@ssword123!"
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		req := &models.LoginRequest{Email: "legacy@example.com", Password: password}
+		user := &models.User{ID: uuid.New(), Email: req.Email, Password: string(hashedPassword), Role: models.RoleCustomer}
+
+		mockRedisRepo.On("CheckLoginRateLimit", mock.Anything, req.Email).Return(true, 5, 0, nil).Once()
+		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
+		mockUserRepo.On("UpdatePassword", mock.Anything, user.ID, mock.MatchedBy(func(hash string) bool {
+			match, rehash, err := testHasher.Verify(password, hash)
+
+			return err == nil && match && !rehash
+		})).Return(nil).Once()
+		mockSessionRepo.On("CountDeviceSessions", mock.Anything, user.ID, device.UserAgent).Return(1, 1, nil).Once()
+		mockSessionRepo.On("CreateSession", mock.Anything, mock.AnythingOfType("*models.Session")).Return(nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.Anything).Return(nil).Once()
+
+		// Act
+		resp, err := userService.Login(t.Context(), req, device)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		mockUserRepo.AssertExpectations(t)
+	})
+	t.Run("Success - Failed rehash does not refuse the login", func(t *testing.T) {
+		// Arrange
+		password := "P@ssword123!"
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		req := &models.LoginRequest{Email: "legacy@example.com", Password: password}
+		user := &models.User{ID: uuid.New(), Email: req.Email, Password: string(hashedPassword), Role: models.RoleCustomer}
+
+		mockRedisRepo.On("CheckLoginRateLimit", mock.Anything, req.Email).Return(true, 5, 0, nil).Once()
+		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
+		mockUserRepo.On("UpdatePassword", mock.Anything, user.ID, mock.AnythingOfType("string")).Return(errors.New("db down")).Once()
+		mockSessionRepo.On("CountDeviceSessions", mock.Anything, user.ID, device.UserAgent).Return(1, 1, nil).Once()
+		mockSessionRepo.On("CreateSession", mock.Anything, mock.AnythingOfType("*models.Session")).Return(nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.Anything).Return(nil).Once()
+
+		// Act
+		resp, err := userService.Login(t.Context(), req, device)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+	})
+	t.Run("Success - Vendor token is scoped to the vendor", func(t *testing.T) {
+		// Arrange
+		ctx := t.Context()
+		password := "P@ssword123!"
+		hashedPassword, err := testHasher.Hash(password)
+		require.NoError(t, err)
+
+		req := &models.LoginRequest{Email: "shop@acme.test", Password: password}
+		user := &models.User{ID: uuid.New(), Email: req.Email, Password: hashedPassword, Role: models.RoleVendor}
+		vendorID := uuid.New()
+
+		mockRedisRepo.On("CheckLoginRateLimit", mock.Anything, req.Email).Return(true, 5, 0, nil).Once()
+		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
+		mockVendorRepo.On("GetVendorByUserID", mock.Anything, user.ID).Return(&models.Vendor{ID: vendorID, UserID: user.ID}, nil).Once()
+		mockSessionRepo.On("CountDeviceSessions", mock.Anything, user.ID, device.UserAgent).Return(0, 0, nil).Once()
+		mockSessionRepo.On("CreateSession", mock.Anything, mock.AnythingOfType("*models.Session")).Return(nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.AnythingOfType("*models.AuditEntry")).Return(nil).Once()
+
+		// Act
+		resp, err := userService.Login(ctx, req, device)
+
+		// Assert
+		require.NoError(t, err)
+
+		token, err := jwt.ParseWithClaims(resp.Token, &models.Claims{}, func(_ *jwt.Token) (interface{}, error) {
+			return jwtKey, nil
+		}, jwt.WithTimeFunc(func() time.Time { return testNow }))
+		require.NoError(t, err)
+
+		claims, ok := token.Claims.(*models.Claims)
+		require.True(t, ok)
+		assert.Equal(t, models.RoleVendor, claims.Role)
+		require.NotNil(t, claims.VendorID)
+		assert.Equal(t, vendorID, *claims.VendorID)
+	})
+
+	t.Run("Success - New device is notified", func(t *testing.T) {
+		// Arrange
+		ctx := t.Context()
+		password := "P@ssword123!"
+		hashedPassword, err := testHasher.Hash(password)
+		require.NoError(t, err)
+
+		req := &models.LoginRequest{Email: "test@example.com", Password: password}
+		user := &models.User{ID: uuid.New(), Email: req.Email, Password: hashedPassword, Role: models.RoleCustomer}
+
+		mockRedisRepo.On("CheckLoginRateLimit", mock.Anything, req.Email).Return(true, 5, 0, nil).Once()
+		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
+		mockSessionRepo.On("CountDeviceSessions", mock.Anything, user.ID, device.UserAgent).Return(2, 0, nil).Once()
+		mockSessionRepo.On("CreateSession", mock.Anything, mock.AnythingOfType("*models.Session")).Return(nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.AnythingOfType("*models.AuditEntry")).Return(nil).Once()
+		mockNotificationService.On("SendEmail", mock.Anything, mock.MatchedBy(func(r *models.EmailNotificationRequest) bool {
+			return r.To == user.Email && strings.Contains(r.Content, device.UserAgent) && strings.Contains(r.Content, "203.0.113.7 (DE)")
+		})).Return(nil, errors.New("sendgrid down")).Once()
+
+		// Act
+		resp, err := userService.Login(ctx, req, device)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		mockNotificationService.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Session cannot be created", func(t *testing.T) {
+		// Arrange
+		ctx := t.Context()
+		password := "P@ssword123!"
+		hashedPassword, err := testHasher.Hash(password)
+		require.NoError(t, err)
+
+		req := &models.LoginRequest{Email: "test@example.com", Password: password}
+		user := &models.User{ID: uuid.New(), Email: req.Email, Password: hashedPassword, Role: models.RoleCustomer}
+
+		mockRedisRepo.On("CheckLoginRateLimit", mock.Anything, req.Email).Return(true, 5, 0, nil).Once()
+		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
+		mockSessionRepo.On("CountDeviceSessions", mock.Anything, user.ID, device.UserAgent).Return(0, 0, errors.New("db down")).Once()
+		mockSessionRepo.On("CreateSession", mock.Anything, mock.AnythingOfType("*models.Session")).Return(errors.New("db down")).Once()
+
+		// Act
+		resp, err := userService.Login(ctx, req, device)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, resp)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+
 	t.Run("Failure - Invalid Password", func(t *testing.T) {
 		// Arrange
 		ctx := t.Context()
 		password := "P@ssword123!"
 		wrongPassword := "WrongP@ssword123!"
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		hashedPassword, err := testHasher.Hash(password)
 		require.NoError(t, err)
 
 		req := &models.LoginRequest{
@@ -195,7 +378,7 @@ func TestUserService_Login(t *testing.T) {
 		user := &models.User{
 			ID:       uuid.New(),
 			Email:    req.Email,
-			Password: string(hashedPassword),
+			Password: hashedPassword,
 			Name:     "Test User",
 		}
 
@@ -205,8 +388,13 @@ func TestUserService_Login(t *testing.T) {
 		// Mock Behavior -> user exists, we can't return any error, otherwise we would miss the password check
 		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(user, nil).Once()
 
+		// Mock Behavior -> the failed attempt is audited, a failing audit log doesn't change the outcome
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == user.ID && e.Action == models.AuditActionLoginFailed
+		})).Return(errors.New("db down")).Once()
+
 		// Act
-		resp, err := userService.Login(ctx, req)
+		resp, err := userService.Login(ctx, req, device)
 
 		// Assert
 		assert.NoError(t, err) // no system level failure
@@ -230,7 +418,7 @@ func TestUserService_Login(t *testing.T) {
 		mockRedisRepo.On("CheckLoginRateLimit", mock.Anything, req.Email).Return(false, 0, 30, nil).Once()
 
 		// Act
-		resp, err := userService.Login(ctx, req)
+		resp, err := userService.Login(ctx, req, device)
 
 		// Assert
 		assert.NoError(t, err) // no system level failure
@@ -260,7 +448,7 @@ func TestUserService_Login(t *testing.T) {
 		mockUserRepo.On("GetUserByEmail", mock.Anything, req.Email).Return(nil, errors.New("User not found")).Once()
 
 		// Act
-		resp, err := userService.Login(ctx, req)
+		resp, err := userService.Login(ctx, req, device)
 
 		// Assert
 		assert.NoError(t, err) // no system level failure
@@ -277,9 +465,10 @@ func TestUserService_Login(t *testing.T) {
 func TestUserService_GetUserByID(t *testing.T) {
 	mockUserRepo := mocks.NewMockUserRepository(t)
 	mockRedisRepo := mocks.NewMockRateLimitRepository(t)
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
 	jwtKey := []byte("test-key")
 
-	userService := service.NewUserService(mockUserRepo, mockRedisRepo, jwtKey)
+	userService := service.NewUserService(mockUserRepo, mockRedisRepo, mockAuditRepo, mocks.NewMockVendorRepository(t), mocks.NewMockSessionRepository(t), svcMocks.NewMockNotificationService(t), service.PasswordPolicy{}, testHasher, service.TokenPolicy{Key: jwtKey}, clock.NewFake(testNow))
 
 	t.Run("Success - User Found", func(t *testing.T) {
 		// Arrange
@@ -333,3 +522,286 @@ func TestUserService_GetUserByID(t *testing.T) {
 		mockUserRepo.AssertExpectations(t)
 	})
 }
+
+func TestUserService_GetUserTimeline(t *testing.T) {
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRedisRepo := mocks.NewMockRateLimitRepository(t)
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
+
+	userService := service.NewUserService(mockUserRepo, mockRedisRepo, mockAuditRepo, mocks.NewMockVendorRepository(t), mocks.NewMockSessionRepository(t), svcMocks.NewMockNotificationService(t), service.PasswordPolicy{}, testHasher, service.TokenPolicy{Key: []byte("test-key")}, clock.NewFake(testNow))
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		ctx := t.Context()
+		user := &models.User{ID: uuid.New(), Email: "test@example.com"}
+		events := []*models.TimelineEvent{
+			{Type: models.TimelineLogin, OccurredAt: time.Now()},
+			{Type: models.TimelineRegistered, OccurredAt: time.Now().Add(-time.Hour)},
+		}
+
+		mockUserRepo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil).Once()
+		mockAuditRepo.On("ListUserTimeline", mock.Anything, user, 1, 10).Return(events, 2, nil).Once()
+
+		// Act
+		result, total, err := userService.GetUserTimeline(ctx, user.ID, 1, 10)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, events, result)
+	})
+
+	t.Run("Failure - User not Found", func(t *testing.T) {
+		// Arrange
+		ctx := t.Context()
+		userID := uuid.New()
+
+		mockUserRepo.On("GetUserByID", mock.Anything, userID).Return(nil, errors.New("user not found")).Once()
+
+		// Act
+		result, _, err := userService.GetUserTimeline(ctx, userID, 1, 10)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestUserService_Impersonate(t *testing.T) {
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRedisRepo := mocks.NewMockRateLimitRepository(t)
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
+	jwtKey := []byte("test-key")
+
+	userService := service.NewUserService(mockUserRepo, mockRedisRepo, mockAuditRepo, mocks.NewMockVendorRepository(t), mocks.NewMockSessionRepository(t), svcMocks.NewMockNotificationService(t), service.PasswordPolicy{}, testHasher, service.TokenPolicy{Key: jwtKey}, clock.NewFake(testNow))
+	customer := &models.User{ID: uuid.New(), Email: "customer@example.com", Role: models.RoleCustomer}
+	agent := &models.Claims{UserID: uuid.New(), Role: models.RoleStaff}
+
+	t.Run("Success - Read-only token", func(t *testing.T) {
+		// Arrange
+		ctx := t.Context()
+		req := &models.ImpersonationRequest{Reason: "ticket 42"}
+
+		mockUserRepo.On("GetUserByID", mock.Anything, customer.ID).Return(customer, nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == customer.ID && e.ActorID != nil && *e.ActorID == agent.UserID && e.Action == models.AuditActionImpersonated
+		})).Return(nil).Once()
+
+		// Act
+		resp, err := userService.Impersonate(ctx, agent, customer.ID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 15*60, resp.ExpiresIn)
+
+		token, err := jwt.ParseWithClaims(resp.Token, &models.Claims{}, func(_ *jwt.Token) (interface{}, error) {
+			return jwtKey, nil
+		}, jwt.WithTimeFunc(func() time.Time { return testNow }))
+		require.NoError(t, err)
+
+		claims, ok := token.Claims.(*models.Claims)
+		require.True(t, ok)
+		assert.Equal(t, customer.ID, claims.UserID)
+		assert.Equal(t, models.RoleCustomer, claims.Role)
+		require.NotNil(t, claims.Impersonation)
+		assert.Equal(t, agent.UserID, claims.Impersonation.ActorID)
+		assert.True(t, claims.Impersonation.ReadOnly)
+		assert.Equal(t, testNow.Add(15*time.Minute).Unix(), claims.ExpiresAt.Unix())
+	})
+
+	t.Run("Failure - Staff asks for write access", func(t *testing.T) {
+		// Act
+		resp, err := userService.Impersonate(t.Context(), agent, customer.ID, &models.ImpersonationRequest{Reason: "ticket 42", WriteAccess: true})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, resp)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeForbidden, appErr.Code)
+	})
+
+	t.Run("Failure - Target is not a customer", func(t *testing.T) {
+		// Arrange
+		admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+		mockUserRepo.On("GetUserByID", mock.Anything, admin.ID).Return(admin, nil).Once()
+
+		// Act
+		resp, err := userService.Impersonate(t.Context(), agent, admin.ID, &models.ImpersonationRequest{Reason: "ticket 42"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, resp)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeForbidden, appErr.Code)
+	})
+
+	t.Run("Failure - Audit log unavailable", func(t *testing.T) {
+		// Arrange
+		mockUserRepo.On("GetUserByID", mock.Anything, customer.ID).Return(customer, nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+		// Act
+		resp, err := userService.Impersonate(t.Context(), &models.Claims{UserID: uuid.New(), Role: models.RoleAdmin}, customer.ID, &models.ImpersonationRequest{Reason: "ticket 42", WriteAccess: true})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestUserService_Sessions(t *testing.T) {
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+
+	userService := service.NewUserService(mocks.NewMockUserRepository(t), mocks.NewMockRateLimitRepository(t), mockAuditRepo, mocks.NewMockVendorRepository(t), mockSessionRepo, svcMocks.NewMockNotificationService(t), service.PasswordPolicy{}, testHasher, service.TokenPolicy{Key: []byte("test-key")}, clock.NewFake(testNow))
+	current, other := uuid.New(), uuid.New()
+	claims := &models.Claims{UserID: uuid.New(), RegisteredClaims: jwt.RegisteredClaims{ID: current.String()}}
+
+	t.Run("Success - Current session is flagged", func(t *testing.T) {
+		// Arrange
+		mockSessionRepo.On("ListActiveSessions", mock.Anything, claims.UserID).Return([]*models.Session{{ID: other}, {ID: current}}, nil).Once()
+
+		// Act
+		sessions, err := userService.ListSessions(t.Context(), claims)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+		assert.False(t, sessions[0].Current)
+		assert.True(t, sessions[1].Current)
+	})
+
+	t.Run("Success - Revocation is audited", func(t *testing.T) {
+		// Arrange
+		mockSessionRepo.On("RevokeSession", mock.Anything, claims.UserID, other).Return(nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == claims.UserID && e.Action == models.AuditActionSessionRevoked && *e.ReferenceID == other
+		})).Return(nil).Once()
+
+		// Act
+		err := userService.RevokeSession(t.Context(), claims, other)
+
+		// Assert
+		require.NoError(t, err)
+		mockAuditRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Session of another user", func(t *testing.T) {
+		// Arrange
+		mockSessionRepo.On("RevokeSession", mock.Anything, claims.UserID, other).Return(sql.ErrNoRows).Once()
+
+		// Act
+		err := userService.RevokeSession(t.Context(), claims, other)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("Failure - Database error", func(t *testing.T) {
+		// Arrange
+		mockSessionRepo.On("ListActiveSessions", mock.Anything, claims.UserID).Return(nil, errors.New("db down")).Once()
+
+		// Act
+		sessions, err := userService.ListSessions(t.Context(), claims)
+
+		// Assert
+		assert.Nil(t, sessions)
+
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
+
+func TestUserService_ChangePassword(t *testing.T) {
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuditRepo := mocks.NewMockAuditRepository(t)
+
+	userService := service.NewUserService(mockUserRepo, mocks.NewMockRateLimitRepository(t), mockAuditRepo, mocks.NewMockVendorRepository(t), mocks.NewMockSessionRepository(t), svcMocks.NewMockNotificationService(t), service.PasswordPolicy{MinLength: 8, RequireDigit: true}, testHasher, service.TokenPolicy{Key: []byte("test-key")}, clock.NewFake(testNow))
+
+	hashedPassword, err := testHasher.Hash("old-password1")
+	require.NoError(t, err)
+
+	user := &models.User{ID: uuid.New(), Email: "test@example.com", Password: hashedPassword}
+	claims := &models.Claims{UserID: user.ID, Email: user.Email}
+
+	t.Run("Success - Password is replaced and audited", func(t *testing.T) {
+		// Arrange
+		req := &models.ChangePasswordRequest{CurrentPassword: "old-password1", NewPassword: "new-password2"}
+
+		mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+		mockUserRepo.On("UpdatePassword", mock.Anything, user.ID, mock.MatchedBy(func(hash string) bool {
+			match, _, err := testHasher.Verify(req.NewPassword, hash)
+
+			return err == nil && match
+		})).Return(nil).Once()
+		mockAuditRepo.On("RecordEntry", mock.Anything, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.UserID == user.ID && e.Action == models.AuditActionPasswordChanged
+		})).Return(nil).Once()
+
+		// Act
+		err := userService.ChangePassword(t.Context(), claims, req)
+
+		// Assert
+		require.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+		mockAuditRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Wrong current password", func(t *testing.T) {
+		// Arrange
+		req := &models.ChangePasswordRequest{CurrentPassword: "guess", NewPassword: "new-password2"}
+
+		mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+
+		// Act
+		err := userService.ChangePassword(t.Context(), claims, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeUnauthorized, appErr.Code)
+	})
+
+	t.Run("Failure - New password breaks the policy", func(t *testing.T) {
+		// Arrange
+		req := &models.ChangePasswordRequest{CurrentPassword: "old-password1", NewPassword: "password"}
+
+		mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+
+		// Act
+		err := userService.ChangePassword(t.Context(), claims, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeWeakPassword, appErr.Code)
+		assert.Equal(t, &appErrors.ErrWeakPassword{Violations: []string{"missing_digit"}}, appErr.Meta)
+	})
+
+	t.Run("Failure - Database error", func(t *testing.T) {
+		// Arrange
+		req := &models.ChangePasswordRequest{CurrentPassword: "old-password1", NewPassword: "new-password2"}
+
+		mockUserRepo.On("GetUserByEmail", mock.Anything, user.Email).Return(user, nil).Once()
+		mockUserRepo.On("UpdatePassword", mock.Anything, user.ID, mock.AnythingOfType("string")).Return(errors.New("db down")).Once()
+
+		// Act
+		err := userService.ChangePassword(t.Context(), claims, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
@@ -0,0 +1,521 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+	"github.com/google/uuid"
+)
+
+type VendorService interface {
+	RegisterVendor(ctx context.Context, userID uuid.UUID, req *models.CreateVendorRequest) (*models.Vendor, error)
+	GetVendorByUser(ctx context.Context, userID uuid.UUID) (*models.Vendor, error)
+	GetApprovedVendor(ctx context.Context, vendorID uuid.UUID) (*models.Vendor, error)
+	GetDashboard(ctx context.Context, vendorID uuid.UUID) (*models.VendorDashboard, error)
+	ListVendors(ctx context.Context, status models.VendorStatus) ([]*models.Vendor, error)
+	UpdateVendor(ctx context.Context, id uuid.UUID, req *models.UpdateVendorRequest) (*models.Vendor, error)
+	ListVendorOrders(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorOrder, int, error)
+	ListVendorPayouts(ctx context.Context, vendorID uuid.UUID) ([]*models.VendorPayout, error)
+	CalculatePayouts(ctx context.Context, from, to time.Time) ([]*models.VendorPayout, error)
+	ListPayouts(ctx context.Context, from, to time.Time) ([]*models.VendorPayout, error)
+	AccrueEarnings(ctx context.Context) (int64, error)
+	GetEarnings(ctx context.Context, vendorID uuid.UUID, from, to time.Time) (*models.VendorEarnings, error)
+	ListLedgerEntries(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorLedgerEntry, int, error)
+	CreatePayoutBatch(ctx context.Context) (*models.PayoutBatch, error)
+	GetPayoutBatch(ctx context.Context, id uuid.UUID) (*models.PayoutBatch, error)
+	RunPayouts(ctx context.Context, interval time.Duration)
+}
+
+// VendorPolicy holds the marketplace terms: the commission of new vendors, the currency of the transfers
+// and the smallest balance worth paying out.
+type VendorPolicy struct {
+	DefaultCommissionRate float64
+	PayoutCurrency        string
+	MinPayout             float64
+}
+
+// dashboardWindow is how far back the recent figures of the vendor dashboard look.
+const dashboardWindow = 30 * 24 * time.Hour
+
+type vendorService struct {
+	repo         repository.VendorRepository
+	userRepo     repository.UserRepository
+	stripeClient stripe.Client
+	ledger       LedgerService
+	policy       VendorPolicy
+	clock        clock.Clock
+}
+
+func NewVendorService(repo repository.VendorRepository, userRepo repository.UserRepository, stripeClient stripe.Client, ledger LedgerService, policy VendorPolicy, clk clock.Clock) VendorService {
+	return &vendorService{repo: repo, userRepo: userRepo, stripeClient: stripeClient, ledger: ledger, policy: policy, clock: clk}
+}
+
+// RegisterVendor onboards the user as a vendor, pending until an admin approves it.
+func (s *vendorService) RegisterVendor(ctx context.Context, userID uuid.UUID, req *models.CreateVendorRequest) (*models.Vendor, error) {
+	_, err := s.repo.GetVendorByUserID(ctx, userID)
+	if err == nil {
+		return nil, appErrors.DuplicateEntryError("User is already registered as a vendor")
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, appErrors.DatabaseError("Failed to get vendor").WithError(err)
+	}
+
+	vendor := &models.Vendor{
+		ID:             uuid.New(),
+		UserID:         userID,
+		Name:           req.Name,
+		ContactEmail:   req.ContactEmail,
+		CommissionRate: s.policy.DefaultCommissionRate,
+		Status:         models.VendorStatusPending,
+	}
+
+	if err := s.repo.CreateVendor(ctx, vendor); err != nil {
+		return nil, appErrors.DatabaseError("Failed to create vendor").WithError(err)
+	}
+
+	return vendor, nil
+}
+
+func (s *vendorService) GetVendorByUser(ctx context.Context, userID uuid.UUID) (*models.Vendor, error) {
+	vendor, err := s.repo.GetVendorByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Vendor not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to get vendor").WithError(err)
+	}
+
+	return vendor, nil
+}
+
+// GetApprovedVendor returns the vendor, if it is allowed to sell.
+func (s *vendorService) GetApprovedVendor(ctx context.Context, vendorID uuid.UUID) (*models.Vendor, error) {
+	vendor, err := s.getVendor(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if vendor.Status != models.VendorStatusApproved {
+		return nil, appErrors.ForbiddenError("Vendor is not approved")
+	}
+
+	return vendor, nil
+}
+
+func (s *vendorService) ListVendors(ctx context.Context, status models.VendorStatus) ([]*models.Vendor, error) {
+	vendors, err := s.repo.ListVendors(ctx, status)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch vendors").WithError(err)
+	}
+
+	return vendors, nil
+}
+
+// GetDashboard summarizes the vendor's catalog, orders and balance over the last 30 days.
+func (s *vendorService) GetDashboard(ctx context.Context, vendorID uuid.UUID) (*models.VendorDashboard, error) {
+	vendor, err := s.getVendor(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard, err := s.repo.GetVendorDashboard(ctx, vendorID, s.clock.Now().Add(-dashboardWindow), lowStockThreshold)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get vendor dashboard").WithError(err)
+	}
+
+	dashboard.Status = vendor.Status
+	dashboard.RecentSales = roundCents(dashboard.RecentSales)
+	dashboard.Balance = roundCents(dashboard.Balance)
+
+	return dashboard, nil
+}
+
+func (s *vendorService) getVendor(ctx context.Context, id uuid.UUID) (*models.Vendor, error) {
+	vendor, err := s.repo.GetVendorByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Vendor not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to get vendor").WithError(err)
+	}
+
+	return vendor, nil
+}
+
+// UpdateVendor applies the admin changes. Approving a vendor grants its user the vendor role, which is
+// kept on suspension so the vendor can still follow its orders and payouts.
+func (s *vendorService) UpdateVendor(ctx context.Context, id uuid.UUID, req *models.UpdateVendorRequest) (*models.Vendor, error) {
+	vendor, err := s.getVendor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status != nil {
+		vendor.Status = *req.Status
+	}
+
+	if req.CommissionRate != nil {
+		vendor.CommissionRate = *req.CommissionRate
+	}
+
+	if req.StripeAccountID != nil {
+		vendor.StripeAccountID = *req.StripeAccountID
+	}
+
+	if err := s.repo.UpdateVendor(ctx, vendor); err != nil {
+		return nil, appErrors.DatabaseError("Failed to update vendor").WithError(err)
+	}
+
+	if vendor.Status == models.VendorStatusApproved {
+		if err := s.grantVendorRole(ctx, vendor.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return vendor, nil
+}
+
+// grantVendorRole promotes a customer to vendor, admins keep their role.
+func (s *vendorService) grantVendorRole(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return appErrors.DatabaseError("Failed to get vendor user").WithError(err)
+	}
+
+	if user.Role != models.RoleCustomer {
+		return nil
+	}
+
+	if err := s.userRepo.UpdateUserRole(ctx, userID, models.RoleVendor); err != nil {
+		return appErrors.DatabaseError("Failed to grant vendor role").WithError(err)
+	}
+
+	return nil
+}
+
+func (s *vendorService) ListVendorOrders(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorOrder, int, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if size < 1 || size > 100 {
+		size = 10
+	}
+
+	vendorOrders, total, err := s.repo.ListVendorOrders(ctx, vendorID, page, size)
+	if err != nil {
+		return nil, 0, appErrors.DatabaseError("Failed to fetch vendor orders").WithError(err)
+	}
+
+	return vendorOrders, total, nil
+}
+
+func (s *vendorService) ListVendorPayouts(ctx context.Context, vendorID uuid.UUID) ([]*models.VendorPayout, error) {
+	payouts, err := s.repo.ListPayoutsByVendor(ctx, vendorID)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch vendor payouts").WithError(err)
+	}
+
+	return payouts, nil
+}
+
+// CalculatePayouts computes and stores the payout of every vendor with paid sales in [from, to), using the
+// commission fixed on each sub-order. Running it again for the same period replaces the previous figures.
+func (s *vendorService) CalculatePayouts(ctx context.Context, from, to time.Time) ([]*models.VendorPayout, error) {
+	sales, err := s.repo.GetVendorSales(ctx, from, to)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get vendor sales").WithError(err)
+	}
+
+	payouts := make([]*models.VendorPayout, 0, len(sales))
+
+	for _, entry := range sales {
+		commission := roundCents(entry.Commission)
+
+		payout := &models.VendorPayout{
+			ID:          uuid.New(),
+			VendorID:    entry.VendorID,
+			PeriodStart: from,
+			PeriodEnd:   to,
+			OrderCount:  entry.OrderCount,
+			GrossSales:  roundCents(entry.GrossSales),
+			Commission:  commission,
+			NetAmount:   roundCents(entry.GrossSales - commission),
+		}
+
+		if err := s.repo.SavePayout(ctx, payout); err != nil {
+			return nil, appErrors.DatabaseError("Failed to save vendor payout").WithError(err)
+		}
+
+		payouts = append(payouts, payout)
+	}
+
+	return payouts, nil
+}
+
+func (s *vendorService) ListPayouts(ctx context.Context, from, to time.Time) ([]*models.VendorPayout, error) {
+	payouts, err := s.repo.ListPayoutsByPeriod(ctx, from, to)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch vendor payouts").WithError(err)
+	}
+
+	return payouts, nil
+}
+
+// AccrueEarnings credits the vendors with the sub-orders of the orders paid since the last run.
+func (s *vendorService) AccrueEarnings(ctx context.Context) (int64, error) {
+	created, err := s.repo.AccrueEarnings(ctx)
+	if err != nil {
+		return 0, appErrors.DatabaseError("Failed to accrue vendor earnings").WithError(err)
+	}
+
+	return created, nil
+}
+
+// GetEarnings reports the ledger movements of the vendor over [from, to) along with its current balance.
+func (s *vendorService) GetEarnings(ctx context.Context, vendorID uuid.UUID, from, to time.Time) (*models.VendorEarnings, error) {
+	totals, err := s.repo.GetLedgerTotals(ctx, vendorID, from, to)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get vendor earnings").WithError(err)
+	}
+
+	balance, err := s.repo.GetVendorBalance(ctx, vendorID)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get vendor balance").WithError(err)
+	}
+
+	earnings := &models.VendorEarnings{VendorID: vendorID, From: from, To: to, Balance: roundCents(balance)}
+
+	for _, total := range totals {
+		switch total.Type {
+		case models.VendorLedgerSale:
+			earnings.GrossSales = roundCents(total.Amount)
+			earnings.OrderCount = total.Count
+		case models.VendorLedgerCommission:
+			earnings.Commission = roundCents(-total.Amount)
+		case models.VendorLedgerPayout, models.VendorLedgerPayoutReversal:
+			earnings.PaidOut = roundCents(earnings.PaidOut - total.Amount)
+		}
+	}
+
+	earnings.NetEarnings = roundCents(earnings.GrossSales - earnings.Commission)
+
+	return earnings, nil
+}
+
+func (s *vendorService) ListLedgerEntries(ctx context.Context, vendorID uuid.UUID, page, size int) ([]*models.VendorLedgerEntry, int, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if size < 1 || size > 100 {
+		size = 10
+	}
+
+	entries, total, err := s.repo.ListLedgerEntries(ctx, vendorID, page, size)
+	if err != nil {
+		return nil, 0, appErrors.DatabaseError("Failed to fetch vendor ledger").WithError(err)
+	}
+
+	return entries, total, nil
+}
+
+// CreatePayoutBatch transfers the balance of every vendor owed at least the minimum payout to its Stripe account.
+// The balance is debited before the transfer and credited back if Stripe refuses it, so a crash in between
+// can't pay a vendor twice. Only one batch runs at a time.
+func (s *vendorService) CreatePayoutBatch(ctx context.Context) (*models.PayoutBatch, error) {
+	if _, err := s.AccrueEarnings(ctx); err != nil {
+		return nil, err
+	}
+
+	batch := &models.PayoutBatch{ID: uuid.New(), Items: []models.PayoutBatchItem{}}
+
+	if err := s.repo.CreatePayoutBatch(ctx, batch); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.DuplicateEntryError("A payout batch is already processing").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to create payout batch").WithError(err)
+	}
+
+	s.recordMissedPayouts(ctx)
+
+	balances, err := s.repo.ListPayableBalances(ctx, s.policy.MinPayout)
+	if err != nil {
+		batch.Status = models.PayoutBatchStatusFailed
+		if completeErr := s.repo.CompletePayoutBatch(ctx, batch); completeErr != nil {
+			slog.Error("Failed to close payout batch", slog.String("batchId", batch.ID.String()), slog.String("error", completeErr.Error()))
+		}
+
+		return nil, appErrors.DatabaseError("Failed to list vendor balances").WithError(err)
+	}
+
+	failed := 0
+
+	for _, balance := range balances {
+		item := s.payVendor(ctx, batch.ID, balance)
+
+		if err := s.repo.AddPayoutBatchItem(ctx, &item); err != nil {
+			// the ledger is already right, only the batch report misses the item
+			slog.Error("Failed to save payout batch item", slog.String("batchId", batch.ID.String()),
+				slog.String("vendorId", item.VendorID.String()), slog.String("error", err.Error()))
+		}
+
+		if item.Status == models.PayoutBatchItemFailed {
+			failed++
+		} else {
+			batch.TotalAmount = roundCents(batch.TotalAmount + item.Amount)
+		}
+
+		batch.Items = append(batch.Items, item)
+	}
+
+	switch {
+	case failed == 0:
+		batch.Status = models.PayoutBatchStatusCompleted
+	case failed == len(batch.Items):
+		batch.Status = models.PayoutBatchStatusFailed
+	default:
+		batch.Status = models.PayoutBatchStatusPartial
+	}
+
+	if err := s.repo.CompletePayoutBatch(ctx, batch); err != nil {
+		return nil, appErrors.DatabaseError("Failed to complete payout batch").WithError(err)
+	}
+
+	return batch, nil
+}
+
+// payVendor debits the balance and transfers it, crediting it back when the transfer fails.
+func (s *vendorService) payVendor(ctx context.Context, batchID uuid.UUID, balance models.VendorBalance) models.PayoutBatchItem {
+	item := models.PayoutBatchItem{
+		ID:       uuid.New(),
+		BatchID:  batchID,
+		VendorID: balance.VendorID,
+		Amount:   roundCents(balance.Balance),
+		Status:   models.PayoutBatchItemFailed,
+	}
+
+	debit := &models.VendorLedgerEntry{ID: uuid.New(), VendorID: balance.VendorID, Type: models.VendorLedgerPayout, Amount: -item.Amount, PayoutBatchID: &batchID}
+	if err := s.repo.AddLedgerEntry(ctx, debit); err != nil {
+		item.Error = "failed to debit the balance"
+
+		return item
+	}
+
+	cents := int64(math.Round(item.Amount * 100))
+
+	transfer, err := s.stripeClient.CreateTransfer(cents, s.policy.PayoutCurrency, balance.StripeAccountID, batchID.String(), item.ID.String())
+	if err != nil {
+		item.Error = err.Error()
+
+		credit := &models.VendorLedgerEntry{ID: uuid.New(), VendorID: balance.VendorID, Type: models.VendorLedgerPayoutReversal, Amount: item.Amount, PayoutBatchID: &batchID}
+		if err := s.repo.AddLedgerEntry(ctx, credit); err != nil {
+			slog.Error("Failed to credit back a failed payout", slog.String("vendorId", balance.VendorID.String()),
+				slog.Float64("amount", item.Amount), slog.String("error", err.Error()))
+		}
+
+		return item
+	}
+
+	item.Status = models.PayoutBatchItemPaid
+	item.TransferID = transfer.ID
+
+	// the transfer went through, the payout stays paid and recordMissedPayouts records it later on
+	if err := s.ledger.RecordVendorPayout(ctx, item.ID.String(), s.policy.PayoutCurrency, cents); err != nil {
+		slog.Error("Failed to record vendor payout in the ledger", slog.String("payoutItemId", item.ID.String()),
+			slog.String("transferId", transfer.ID), slog.String("error", err.Error()))
+	}
+
+	return item
+}
+
+// recordMissedPayouts records in the ledger the paid items whose ledger write failed, the transaction is
+// keyed on the item so a payout is never recorded twice.
+func (s *vendorService) recordMissedPayouts(ctx context.Context) {
+	items, err := s.repo.ListUnrecordedPayouts(ctx)
+	if err != nil {
+		slog.Error("Failed to list vendor payouts missing from the ledger", slog.String("error", err.Error()))
+
+		return
+	}
+
+	for _, item := range items {
+		cents := int64(math.Round(item.Amount * 100))
+
+		if err := s.ledger.RecordVendorPayout(ctx, item.ID.String(), s.policy.PayoutCurrency, cents); err != nil {
+			slog.Error("Failed to record vendor payout in the ledger", slog.String("payoutItemId", item.ID.String()),
+				slog.String("transferId", item.TransferID), slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (s *vendorService) GetPayoutBatch(ctx context.Context, id uuid.UUID) (*models.PayoutBatch, error) {
+	batch, err := s.repo.GetPayoutBatch(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Payout batch not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to get payout batch").WithError(err)
+	}
+
+	return batch, nil
+}
+
+// RunPayouts accrues the earnings of paid orders, records the payouts the ledger missed and calculates the
+// payouts of the previous calendar month every interval until ctx is cancelled. Recalculating is harmless,
+// so orders paid late still make it into the payout of the month they were placed.
+func (s *vendorService) RunPayouts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if created, err := s.AccrueEarnings(ctx); err != nil {
+				slog.Error("Scheduled vendor earnings accrual failed", slog.String("error", err.Error()))
+			} else if created > 0 {
+				slog.Info("Vendor earnings accrued", slog.Int64("entries", created))
+			}
+
+			s.recordMissedPayouts(ctx)
+
+			from, to := previousMonth(s.clock.Now())
+
+			payouts, err := s.CalculatePayouts(ctx, from, to)
+			if err != nil {
+				slog.Error("Scheduled vendor payouts failed", slog.String("error", err.Error()))
+
+				continue
+			}
+
+			slog.Info("Vendor payouts calculated", slog.Time("periodStart", from), slog.Int("vendors", len(payouts)))
+		}
+	}
+}
+
+func previousMonth(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return to.AddDate(0, -1, 0), to
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,372 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	stripeMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+const testCommissionRate = 0.1
+
+func setupVendorServiceTest(t *testing.T) (service.VendorService, *mocks.MockVendorRepository) {
+	vendorService, mockRepo, _, _ := setupVendorPayoutTest(t)
+
+	return vendorService, mockRepo
+}
+
+func setupVendorPayoutTest(t *testing.T) (service.VendorService, *mocks.MockVendorRepository, *stripeMocks.MockClient, *svcMocks.MockLedgerService) {
+	vendorService, mockRepo, _, mockStripeClient, mockLedger := newVendorServiceTest(t)
+
+	return vendorService, mockRepo, mockStripeClient, mockLedger
+}
+
+func setupVendorApprovalTest(t *testing.T) (service.VendorService, *mocks.MockVendorRepository, *mocks.MockUserRepository) {
+	vendorService, mockRepo, mockUserRepo, _, _ := newVendorServiceTest(t)
+
+	return vendorService, mockRepo, mockUserRepo
+}
+
+func newVendorServiceTest(t *testing.T) (service.VendorService, *mocks.MockVendorRepository, *mocks.MockUserRepository, *stripeMocks.MockClient, *svcMocks.MockLedgerService) {
+	mockRepo := mocks.NewMockVendorRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockStripeClient := stripeMocks.NewMockClient(t)
+	mockLedger := svcMocks.NewMockLedgerService(t)
+	policy := service.VendorPolicy{DefaultCommissionRate: testCommissionRate, PayoutCurrency: "usd", MinPayout: 10}
+
+	return service.NewVendorService(mockRepo, mockUserRepo, mockStripeClient, mockLedger, policy, clock.NewFake(testNow)), mockRepo, mockUserRepo, mockStripeClient, mockLedger
+}
+
+func TestRegisterVendor(t *testing.T) {
+	ctx := t.Context()
+	userID := uuid.New()
+	req := &models.CreateVendorRequest{Name: "Acme", ContactEmail: "shop@acme.test"}
+
+	t.Run("Success - Pending with default commission", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorByUserID", ctx, userID).Return(nil, sql.ErrNoRows).Once()
+		mockRepo.On("CreateVendor", ctx, mock.MatchedBy(func(v *models.Vendor) bool {
+			return v.UserID == userID && v.Status == models.VendorStatusPending && v.CommissionRate == testCommissionRate
+		})).Return(nil).Once()
+
+		// Act
+		vendor, err := vendorService.RegisterVendor(ctx, userID, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", vendor.Name)
+	})
+
+	t.Run("Failure - Already a vendor", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorByUserID", ctx, userID).Return(&models.Vendor{ID: uuid.New(), UserID: userID}, nil).Once()
+
+		// Act
+		vendor, err := vendorService.RegisterVendor(ctx, userID, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDuplicateEntry, appErr.Code)
+		assert.Nil(t, vendor)
+	})
+}
+
+func TestGetApprovedVendor(t *testing.T) {
+	ctx := t.Context()
+	vendorID := uuid.New()
+
+	t.Run("Success - Approved", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, Status: models.VendorStatusApproved}, nil).Once()
+
+		// Act
+		vendor, err := vendorService.GetApprovedVendor(ctx, vendorID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, vendorID, vendor.ID)
+	})
+
+	t.Run("Failure - Pending", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, Status: models.VendorStatusPending}, nil).Once()
+
+		// Act
+		vendor, err := vendorService.GetApprovedVendor(ctx, vendorID)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeForbidden, appErr.Code)
+		assert.Nil(t, vendor)
+	})
+
+	t.Run("Failure - Not a vendor", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorByID", ctx, vendorID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		_, err := vendorService.GetApprovedVendor(ctx, vendorID)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+	})
+}
+
+func TestUpdateVendor(t *testing.T) {
+	ctx := t.Context()
+	vendorID := uuid.New()
+	userID := uuid.New()
+	approved := models.VendorStatusApproved
+	suspended := models.VendorStatusSuspended
+
+	t.Run("Success - Approval grants the vendor role", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo, mockUserRepo := setupVendorApprovalTest(t)
+		mockRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, UserID: userID, Status: models.VendorStatusPending}, nil).Once()
+		mockRepo.On("UpdateVendor", ctx, mock.AnythingOfType("*models.Vendor")).Return(nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, userID).Return(&models.User{ID: userID, Role: models.RoleCustomer}, nil).Once()
+		mockUserRepo.On("UpdateUserRole", ctx, userID, models.RoleVendor).Return(nil).Once()
+
+		// Act
+		vendor, err := vendorService.UpdateVendor(ctx, vendorID, &models.UpdateVendorRequest{Status: &approved})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.VendorStatusApproved, vendor.Status)
+	})
+
+	t.Run("Success - Admin keeps its role", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo, mockUserRepo := setupVendorApprovalTest(t)
+		mockRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, UserID: userID, Status: models.VendorStatusPending}, nil).Once()
+		mockRepo.On("UpdateVendor", ctx, mock.AnythingOfType("*models.Vendor")).Return(nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, userID).Return(&models.User{ID: userID, Role: models.RoleAdmin}, nil).Once()
+
+		// Act
+		_, err := vendorService.UpdateVendor(ctx, vendorID, &models.UpdateVendorRequest{Status: &approved})
+
+		// Assert
+		require.NoError(t, err)
+		mockUserRepo.AssertNotCalled(t, "UpdateUserRole", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Suspension keeps the vendor role", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo, mockUserRepo := setupVendorApprovalTest(t)
+		mockRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, UserID: userID, Status: models.VendorStatusApproved}, nil).Once()
+		mockRepo.On("UpdateVendor", ctx, mock.AnythingOfType("*models.Vendor")).Return(nil).Once()
+
+		// Act
+		vendor, err := vendorService.UpdateVendor(ctx, vendorID, &models.UpdateVendorRequest{Status: &suspended})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.VendorStatusSuspended, vendor.Status)
+		mockUserRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetVendorDashboard(t *testing.T) {
+	// Arrange
+	vendorService, mockRepo := setupVendorServiceTest(t)
+	ctx := t.Context()
+	vendorID := uuid.New()
+
+	mockRepo.On("GetVendorByID", ctx, vendorID).Return(&models.Vendor{ID: vendorID, Status: models.VendorStatusSuspended}, nil).Once()
+	mockRepo.On("GetVendorDashboard", ctx, vendorID, testNow.AddDate(0, 0, -30), 5).Return(&models.VendorDashboard{
+		VendorID:      vendorID,
+		Products:      12,
+		PendingOrders: 2,
+		RecentSales:   333.333,
+		Balance:       120.456,
+	}, nil).Once()
+
+	// Act
+	dashboard, err := vendorService.GetDashboard(ctx, vendorID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, models.VendorStatusSuspended, dashboard.Status)
+	assert.Equal(t, 12, dashboard.Products)
+	assert.Equal(t, 333.33, dashboard.RecentSales)
+	assert.Equal(t, 120.46, dashboard.Balance)
+}
+
+func TestCalculatePayouts(t *testing.T) {
+	ctx := t.Context()
+	from := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	firstID := uuid.New()
+	secondID := uuid.New()
+
+	t.Run("Success - Commission of the sub-orders", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorSales", ctx, from, to).Return([]models.VendorSales{
+			{VendorID: firstID, GrossSales: 333.33, Commission: 49.9995, OrderCount: 4},
+			{VendorID: secondID, GrossSales: 100.0, Commission: 10.0, OrderCount: 1},
+		}, nil).Once()
+		mockRepo.On("SavePayout", ctx, mock.AnythingOfType("*models.VendorPayout")).Return(nil).Twice()
+
+		// Act
+		payouts, err := vendorService.CalculatePayouts(ctx, from, to)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, payouts, 2)
+		assert.Equal(t, 50.0, payouts[0].Commission)
+		assert.Equal(t, 283.33, payouts[0].NetAmount)
+		assert.Equal(t, 4, payouts[0].OrderCount)
+		assert.Equal(t, 10.0, payouts[1].Commission)
+		assert.Equal(t, 90.0, payouts[1].NetAmount)
+		assert.Equal(t, from, payouts[1].PeriodStart)
+		assert.Equal(t, to, payouts[1].PeriodEnd)
+	})
+
+	t.Run("Failure - Sales query", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo := setupVendorServiceTest(t)
+		mockRepo.On("GetVendorSales", ctx, from, to).Return(nil, sql.ErrConnDone).Once()
+
+		// Act
+		payouts, err := vendorService.CalculatePayouts(ctx, from, to)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		assert.Nil(t, payouts)
+	})
+}
+
+func TestGetVendorEarnings(t *testing.T) {
+	// Arrange
+	vendorService, mockRepo := setupVendorServiceTest(t)
+	ctx := t.Context()
+	vendorID := uuid.New()
+	from := testNow.AddDate(0, 0, -30)
+
+	mockRepo.On("GetLedgerTotals", ctx, vendorID, from, testNow).Return([]models.VendorLedgerTotal{
+		{Type: models.VendorLedgerSale, Amount: 500.0, Count: 5},
+		{Type: models.VendorLedgerCommission, Amount: -50.0, Count: 5},
+		{Type: models.VendorLedgerPayout, Amount: -400.0, Count: 2},
+		{Type: models.VendorLedgerPayoutReversal, Amount: 150.0, Count: 1},
+	}, nil).Once()
+	mockRepo.On("GetVendorBalance", ctx, vendorID).Return(200.0, nil).Once()
+
+	// Act
+	earnings, err := vendorService.GetEarnings(ctx, vendorID, from, testNow)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 5, earnings.OrderCount)
+	assert.Equal(t, 500.0, earnings.GrossSales)
+	assert.Equal(t, 50.0, earnings.Commission)
+	assert.Equal(t, 450.0, earnings.NetEarnings)
+	assert.Equal(t, 250.0, earnings.PaidOut)
+	assert.Equal(t, 200.0, earnings.Balance)
+}
+
+func TestCreatePayoutBatch(t *testing.T) {
+	ctx := t.Context()
+	paidID := uuid.New()
+	refusedID := uuid.New()
+
+	t.Run("Partial - Failed transfer is credited back", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo, mockStripeClient, mockLedger := setupVendorPayoutTest(t)
+		mockRepo.On("AccrueEarnings", ctx).Return(int64(4), nil).Once()
+		mockRepo.On("CreatePayoutBatch", ctx, mock.AnythingOfType("*models.PayoutBatch")).Return(nil).Once()
+		mockRepo.On("ListUnrecordedPayouts", ctx).Return(nil, nil).Once()
+		mockRepo.On("ListPayableBalances", ctx, 10.0).Return([]models.VendorBalance{
+			{VendorID: paidID, StripeAccountID: "acct_paid", Balance: 120.456},
+			{VendorID: refusedID, StripeAccountID: "acct_refused", Balance: 30.0},
+		}, nil).Once()
+		mockRepo.On("AddLedgerEntry", ctx, mock.MatchedBy(func(e *models.VendorLedgerEntry) bool {
+			return e.VendorID == paidID && e.Type == models.VendorLedgerPayout && e.Amount == -120.46
+		})).Return(nil).Once()
+		mockRepo.On("AddLedgerEntry", ctx, mock.MatchedBy(func(e *models.VendorLedgerEntry) bool {
+			return e.VendorID == refusedID && e.Type == models.VendorLedgerPayout && e.Amount == -30.0
+		})).Return(nil).Once()
+		mockRepo.On("AddLedgerEntry", ctx, mock.MatchedBy(func(e *models.VendorLedgerEntry) bool {
+			return e.VendorID == refusedID && e.Type == models.VendorLedgerPayoutReversal && e.Amount == 30.0
+		})).Return(nil).Once()
+		mockStripeClient.On("CreateTransfer", int64(12046), "usd", "acct_paid", mock.Anything, mock.Anything).Return(&stripe.Transfer{ID: "tr_1"}, nil).Once()
+		mockStripeClient.On("CreateTransfer", int64(3000), "usd", "acct_refused", mock.Anything, mock.Anything).Return(nil, errors.New("account restricted")).Once()
+		mockLedger.On("RecordVendorPayout", ctx, mock.AnythingOfType("string"), "usd", int64(12046)).Return(nil).Once()
+		mockRepo.On("AddPayoutBatchItem", ctx, mock.AnythingOfType("*models.PayoutBatchItem")).Return(nil).Twice()
+		mockRepo.On("CompletePayoutBatch", ctx, mock.MatchedBy(func(b *models.PayoutBatch) bool {
+			return b.Status == models.PayoutBatchStatusPartial && b.TotalAmount == 120.46
+		})).Return(nil).Once()
+
+		// Act
+		batch, err := vendorService.CreatePayoutBatch(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, batch.Items, 2)
+		assert.Equal(t, models.PayoutBatchItemPaid, batch.Items[0].Status)
+		assert.Equal(t, "tr_1", batch.Items[0].TransferID)
+		assert.Equal(t, models.PayoutBatchItemFailed, batch.Items[1].Status)
+		assert.Equal(t, "account restricted", batch.Items[1].Error)
+	})
+
+	t.Run("Success - Payout the ledger missed is recorded by the next batch", func(t *testing.T) {
+		// Arrange
+		missedID := uuid.New()
+		vendorService, mockRepo, _, mockLedger := setupVendorPayoutTest(t)
+		mockRepo.On("AccrueEarnings", ctx).Return(int64(0), nil).Once()
+		mockRepo.On("CreatePayoutBatch", ctx, mock.AnythingOfType("*models.PayoutBatch")).Return(nil).Once()
+		mockRepo.On("ListUnrecordedPayouts", ctx).Return([]models.PayoutBatchItem{
+			{ID: missedID, VendorID: paidID, Amount: 120.46, Status: models.PayoutBatchItemPaid, TransferID: "tr_1"},
+		}, nil).Once()
+		mockLedger.On("RecordVendorPayout", ctx, missedID.String(), "usd", int64(12046)).Return(nil).Once()
+		mockRepo.On("ListPayableBalances", ctx, 10.0).Return(nil, nil).Once()
+		mockRepo.On("CompletePayoutBatch", ctx, mock.AnythingOfType("*models.PayoutBatch")).Return(nil).Once()
+
+		// Act
+		batch, err := vendorService.CreatePayoutBatch(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, batch.Items)
+	})
+
+	t.Run("Failure - Another batch processing", func(t *testing.T) {
+		// Arrange
+		vendorService, mockRepo, _, _ := setupVendorPayoutTest(t)
+		mockRepo.On("AccrueEarnings", ctx).Return(int64(0), nil).Once()
+		mockRepo.On("CreatePayoutBatch", ctx, mock.AnythingOfType("*models.PayoutBatch")).Return(sql.ErrNoRows).Once()
+
+		// Act
+		batch, err := vendorService.CreatePayoutBatch(ctx)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDuplicateEntry, appErr.Code)
+		assert.Nil(t, batch)
+	})
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/google/uuid"
+)
+
+type WarehouseService interface {
+	CreateWarehouse(ctx context.Context, req *models.CreateWarehouseRequest) (*models.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]*models.Warehouse, error)
+	GetWarehouseStock(ctx context.Context, warehouseID uuid.UUID) ([]*models.WarehouseStock, error)
+	SetStock(ctx context.Context, warehouseID uuid.UUID, req *models.SetWarehouseStockRequest) (*models.WarehouseStock, error)
+	GetProductStock(ctx context.Context, productID uuid.UUID) ([]*models.WarehouseStock, error)
+	AllocateOrder(ctx context.Context, order *models.Order) ([]*models.StockAllocation, error)
+	GetOrderAllocations(ctx context.Context, orderID uuid.UUID) ([]*models.StockAllocation, error)
+	ReleaseOrderItems(ctx context.Context, orderItemIDs []uuid.UUID) error
+}
+
+type warehouseService struct {
+	repo          repository.WarehouseRepository
+	productRepo   repository.ProductRepository
+	inventoryRepo repository.InventoryRepository
+}
+
+func NewWarehouseService(repo repository.WarehouseRepository, productRepo repository.ProductRepository, inventoryRepo repository.InventoryRepository) WarehouseService {
+	return &warehouseService{repo: repo, productRepo: productRepo, inventoryRepo: inventoryRepo}
+}
+
+func (s *warehouseService) CreateWarehouse(ctx context.Context, req *models.CreateWarehouseRequest) (*models.Warehouse, error) {
+	warehouse := &models.Warehouse{
+		ID:         uuid.New(),
+		Name:       req.Name,
+		Country:    req.Country,
+		State:      req.State,
+		PostalCode: req.PostalCode,
+	}
+
+	if err := s.repo.CreateWarehouse(ctx, warehouse); err != nil {
+		return nil, appErrors.DatabaseError("Failed to create warehouse").WithError(err)
+	}
+
+	return warehouse, nil
+}
+
+func (s *warehouseService) ListWarehouses(ctx context.Context) ([]*models.Warehouse, error) {
+	warehouses, err := s.repo.ListWarehouses(ctx)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch warehouses").WithError(err)
+	}
+
+	return warehouses, nil
+}
+
+func (s *warehouseService) GetWarehouseStock(ctx context.Context, warehouseID uuid.UUID) ([]*models.WarehouseStock, error) {
+	if err := s.ensureWarehouse(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+
+	stock, err := s.repo.ListStockByWarehouse(ctx, warehouseID)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch warehouse stock").WithError(err)
+	}
+
+	return stock, nil
+}
+
+// SetStock sets the stock of a product in a warehouse. The product's total stock moves by the same
+// amount, recorded as an adjustment in the stock ledger.
+func (s *warehouseService) SetStock(ctx context.Context, warehouseID uuid.UUID, req *models.SetWarehouseStockRequest) (*models.WarehouseStock, error) {
+	if err := s.ensureWarehouse(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.GetProductByID(ctx, req.ProductID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFoundError("Product not found").WithError(err)
+		}
+
+		return nil, appErrors.DatabaseError("Failed to get product").WithError(err)
+	}
+
+	current, err := s.repo.GetStock(ctx, warehouseID, req.ProductID)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to get warehouse stock").WithError(err)
+	}
+
+	stock := &models.WarehouseStock{WarehouseID: warehouseID, ProductID: req.ProductID, Quantity: req.Quantity}
+
+	if err := s.repo.SetStock(ctx, stock); err != nil {
+		return nil, appErrors.DatabaseError("Failed to set warehouse stock").WithError(err)
+	}
+
+	delta := req.Quantity - current
+	if delta == 0 {
+		return stock, nil
+	}
+
+	product.StockQuantity += delta
+
+	if err := s.productRepo.UpdateProduct(ctx, product); err != nil {
+		return nil, appErrors.DatabaseError("Failed to update product stock").WithError(err)
+	}
+
+	err = s.inventoryRepo.RecordMovement(ctx, &models.StockMovement{
+		ID:          uuid.New(),
+		ProductID:   req.ProductID,
+		Quantity:    -delta,
+		Reason:      models.StockMovementAdjustment,
+		ReferenceID: &warehouseID,
+	})
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to record stock movement").WithError(err)
+	}
+
+	return stock, nil
+}
+
+func (s *warehouseService) GetProductStock(ctx context.Context, productID uuid.UUID) ([]*models.WarehouseStock, error) {
+	stock, err := s.repo.ListStockByProduct(ctx, productID)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch product stock").WithError(err)
+	}
+
+	return stock, nil
+}
+
+// AllocateOrder picks the warehouses shipping each order item and reserves their stock. The nearest
+// warehouse able to ship the whole quantity is preferred, otherwise the item is split across warehouses
+// from the nearest one. Products not stocked per warehouse are left unallocated.
+func (s *warehouseService) AllocateOrder(ctx context.Context, order *models.Order) ([]*models.StockAllocation, error) {
+	warehouses, err := s.repo.ListWarehouses(ctx)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch warehouses").WithError(err)
+	}
+
+	if len(warehouses) == 0 {
+		return nil, nil
+	}
+
+	byID := make(map[uuid.UUID]*models.Warehouse, len(warehouses))
+	for _, warehouse := range warehouses {
+		byID[warehouse.ID] = warehouse
+	}
+
+	var allocations []*models.StockAllocation
+
+	for _, item := range order.Items {
+		stock, err := s.repo.ListStockByProduct(ctx, item.ProductID)
+		if err != nil {
+			return nil, appErrors.DatabaseError("Failed to fetch product stock").WithError(err)
+		}
+
+		if len(stock) == 0 {
+			continue
+		}
+
+		rankByProximity(stock, byID, order.ShippingAddress)
+
+		picks, ok := pickWarehouses(stock, item.Quantity)
+		if !ok {
+			return nil, appErrors.InsufficientStockError(item.ProductID, totalStock(stock)).WithDetail("Not enough stock left in the warehouses")
+		}
+
+		for _, pick := range picks {
+			if err := s.repo.ReserveStock(ctx, pick.WarehouseID, item.ProductID, pick.Quantity); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					// another order took the stock in the meantime, what it left is unknown
+					return nil, appErrors.InsufficientStockError(item.ProductID, 0).WithDetail("Not enough stock left in the warehouses")
+				}
+
+				return nil, appErrors.DatabaseError("Failed to reserve warehouse stock").WithError(err)
+			}
+
+			allocation := &models.StockAllocation{
+				ID:          uuid.New(),
+				OrderID:     order.ID,
+				OrderItemID: item.ID,
+				ProductID:   item.ProductID,
+				WarehouseID: pick.WarehouseID,
+				Quantity:    pick.Quantity,
+			}
+
+			if err := s.repo.CreateAllocation(ctx, allocation); err != nil {
+				return nil, appErrors.DatabaseError("Failed to record stock allocation").WithError(err)
+			}
+
+			allocations = append(allocations, allocation)
+		}
+	}
+
+	return allocations, nil
+}
+
+func (s *warehouseService) GetOrderAllocations(ctx context.Context, orderID uuid.UUID) ([]*models.StockAllocation, error) {
+	allocations, err := s.repo.ListAllocationsByOrder(ctx, orderID)
+	if err != nil {
+		return nil, appErrors.DatabaseError("Failed to fetch stock allocations").WithError(err)
+	}
+
+	return allocations, nil
+}
+
+func (s *warehouseService) ensureWarehouse(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.GetWarehouseByID(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return appErrors.NotFoundError("Warehouse not found").WithError(err)
+		}
+
+		return appErrors.DatabaseError("Failed to get warehouse").WithError(err)
+	}
+
+	return nil
+}
+
+// rankByProximity sorts the stock from the warehouse closest to the address. Addresses are not
+// geocoded, so closeness is approximated by matching postal code, then state, then country, with
+// the length of the common postal code prefix breaking ties.
+func rankByProximity(stock []*models.WarehouseStock, warehouses map[uuid.UUID]*models.Warehouse, address *models.Address) {
+	distance := func(entry *models.WarehouseStock) (int, int) {
+		warehouse, ok := warehouses[entry.WarehouseID]
+		if !ok || address == nil {
+			return 4, 0
+		}
+
+		prefix := commonPrefixLen(warehouse.PostalCode, address.PostalCode)
+
+		switch {
+		case !strings.EqualFold(warehouse.Country, address.Country):
+			return 3, 0
+		case warehouse.PostalCode == address.PostalCode:
+			return 0, prefix
+		case strings.EqualFold(warehouse.State, address.State):
+			return 1, prefix
+		default:
+			return 2, prefix
+		}
+	}
+
+	sort.SliceStable(stock, func(i, j int) bool {
+		di, pi := distance(stock[i])
+		dj, pj := distance(stock[j])
+
+		if di != dj {
+			return di < dj
+		}
+
+		return pi > pj
+	})
+}
+
+// totalStock is the stock of a product over all warehouses.
+func totalStock(stock []*models.WarehouseStock) int {
+	var total int
+
+	for _, entry := range stock {
+		total += max(entry.Quantity, 0)
+	}
+
+	return total
+}
+
+// pickWarehouses chooses the warehouses to take quantity from, stock being ranked nearest first.
+// It reports false when the warehouses can't cover the quantity together.
+func pickWarehouses(stock []*models.WarehouseStock, quantity int) ([]models.WarehouseStock, bool) {
+	for _, entry := range stock {
+		if entry.Quantity >= quantity {
+			return []models.WarehouseStock{{WarehouseID: entry.WarehouseID, Quantity: quantity}}, true
+		}
+	}
+
+	var picks []models.WarehouseStock
+
+	remaining := quantity
+
+	for _, entry := range stock {
+		if remaining == 0 {
+			break
+		}
+
+		if entry.Quantity <= 0 {
+			continue
+		}
+
+		take := min(entry.Quantity, remaining)
+		picks = append(picks, models.WarehouseStock{WarehouseID: entry.WarehouseID, Quantity: take})
+		remaining -= take
+	}
+
+	return picks, remaining == 0
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+
+	return n
+}
+
+// ReleaseOrderItems puts the stock allocated to the order items back into their warehouses, for items
+// taken out of an order before it ships.
+func (s *warehouseService) ReleaseOrderItems(ctx context.Context, orderItemIDs []uuid.UUID) error {
+	if len(orderItemIDs) == 0 {
+		return nil
+	}
+
+	if err := s.repo.ReleaseAllocations(ctx, orderItemIDs); err != nil {
+		return appErrors.DatabaseError("Failed to release stock allocations").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWarehouseServiceTest(t *testing.T) (service.WarehouseService, *mocks.MockWarehouseRepository, *mocks.MockProductRepository, *mocks.MockInventoryRepository) {
+	mockRepo := mocks.NewMockWarehouseRepository(t)
+	mockProductRepo := mocks.NewMockProductRepository(t)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(t)
+	warehouseService := service.NewWarehouseService(mockRepo, mockProductRepo, mockInventoryRepo)
+
+	return warehouseService, mockRepo, mockProductRepo, mockInventoryRepo
+}
+
+func TestCreateWarehouse(t *testing.T) {
+	ctx := t.Context()
+	req := &models.CreateWarehouseRequest{Name: "Berlin", Country: "DE", State: "BE", PostalCode: "10115"}
+
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+		mockRepo.On("CreateWarehouse", ctx, mock.MatchedBy(func(w *models.Warehouse) bool {
+			return w.ID != uuid.Nil && w.Name == "Berlin" && w.Country == "DE" && w.PostalCode == "10115"
+		})).Return(nil).Once()
+
+		// Act
+		warehouse, err := warehouseService.CreateWarehouse(ctx, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Berlin", warehouse.Name)
+	})
+
+	t.Run("Failure - Repository error", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+		mockRepo.On("CreateWarehouse", ctx, mock.Anything).Return(errors.New("db error")).Once()
+
+		// Act
+		warehouse, err := warehouseService.CreateWarehouse(ctx, req)
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+		assert.Nil(t, warehouse)
+	})
+}
+
+func TestSetWarehouseStock(t *testing.T) {
+	ctx := t.Context()
+	warehouseID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("Success - Product stock follows warehouse stock", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, mockProductRepo, mockInventoryRepo := setupWarehouseServiceTest(t)
+
+		mockRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, StockQuantity: 12}, nil).Once()
+		mockRepo.On("GetStock", ctx, warehouseID, productID).Return(2, nil).Once()
+		mockRepo.On("SetStock", ctx, mock.MatchedBy(func(s *models.WarehouseStock) bool {
+			return s.WarehouseID == warehouseID && s.ProductID == productID && s.Quantity == 7
+		})).Return(nil).Once()
+		mockProductRepo.On("UpdateProduct", ctx, mock.MatchedBy(func(p *models.Product) bool {
+			return p.StockQuantity == 17
+		})).Return(nil).Once()
+		mockInventoryRepo.On("RecordMovement", ctx, mock.MatchedBy(func(m *models.StockMovement) bool {
+			return m.ProductID == productID && m.Quantity == -5 && m.Reason == models.StockMovementAdjustment
+		})).Return(nil).Once()
+
+		// Act
+		stock, err := warehouseService.SetStock(ctx, warehouseID, &models.SetWarehouseStockRequest{ProductID: productID, Quantity: 7})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 7, stock.Quantity)
+	})
+
+	t.Run("Success - Unchanged stock", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, mockProductRepo, _ := setupWarehouseServiceTest(t)
+
+		mockRepo.On("GetWarehouseByID", ctx, warehouseID).Return(&models.Warehouse{ID: warehouseID}, nil).Once()
+		mockProductRepo.On("GetProductByID", ctx, productID).Return(&models.Product{ID: productID, StockQuantity: 12}, nil).Once()
+		mockRepo.On("GetStock", ctx, warehouseID, productID).Return(7, nil).Once()
+		mockRepo.On("SetStock", ctx, mock.Anything).Return(nil).Once()
+
+		// Act
+		_, err := warehouseService.SetStock(ctx, warehouseID, &models.SetWarehouseStockRequest{ProductID: productID, Quantity: 7})
+
+		// Assert
+		require.NoError(t, err)
+		mockProductRepo.AssertNotCalled(t, "UpdateProduct")
+	})
+
+	t.Run("Failure - Warehouse not found", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+		mockRepo.On("GetWarehouseByID", ctx, warehouseID).Return(nil, sql.ErrNoRows).Once()
+
+		// Act
+		stock, err := warehouseService.SetStock(ctx, warehouseID, &models.SetWarehouseStockRequest{ProductID: productID, Quantity: 7})
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeNotFound, appErr.Code)
+		assert.Nil(t, stock)
+	})
+}
+
+func TestAllocateOrder(t *testing.T) {
+	ctx := t.Context()
+	productID := uuid.New()
+
+	local := &models.Warehouse{ID: uuid.New(), Country: "US", State: "CA", PostalCode: "94105"}
+	sameState := &models.Warehouse{ID: uuid.New(), Country: "US", State: "CA", PostalCode: "90001"}
+	abroad := &models.Warehouse{ID: uuid.New(), Country: "DE", State: "BE", PostalCode: "94105"}
+	warehouses := []*models.Warehouse{abroad, sameState, local}
+
+	newOrder := func(quantity int) *models.Order {
+		return &models.Order{
+			ID:              uuid.New(),
+			ShippingAddress: &models.Address{Country: "US", State: "CA", PostalCode: "94107"},
+			Items:           []models.OrderItem{{ID: uuid.New(), ProductID: productID, Quantity: quantity}},
+		}
+	}
+
+	stockOf := func(quantities map[uuid.UUID]int) []*models.WarehouseStock {
+		var stock []*models.WarehouseStock
+		for _, w := range warehouses {
+			stock = append(stock, &models.WarehouseStock{WarehouseID: w.ID, ProductID: productID, Quantity: quantities[w.ID]})
+		}
+
+		return stock
+	}
+
+	t.Run("Success - Nearest warehouse with enough stock", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+		order := newOrder(3)
+
+		mockRepo.On("ListWarehouses", ctx).Return(warehouses, nil).Once()
+		mockRepo.On("ListStockByProduct", ctx, productID).Return(stockOf(map[uuid.UUID]int{local.ID: 2, sameState.ID: 5, abroad.ID: 10}), nil).Once()
+		mockRepo.On("ReserveStock", ctx, sameState.ID, productID, 3).Return(nil).Once()
+		mockRepo.On("CreateAllocation", ctx, mock.AnythingOfType("*models.StockAllocation")).Return(nil).Once()
+
+		// Act
+		allocations, err := warehouseService.AllocateOrder(ctx, order)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, allocations, 1)
+		assert.Equal(t, sameState.ID, allocations[0].WarehouseID)
+		assert.Equal(t, order.Items[0].ID, allocations[0].OrderItemID)
+		assert.Equal(t, 3, allocations[0].Quantity)
+	})
+
+	t.Run("Success - Split across warehouses from the nearest", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+		order := newOrder(6)
+
+		mockRepo.On("ListWarehouses", ctx).Return(warehouses, nil).Once()
+		mockRepo.On("ListStockByProduct", ctx, productID).Return(stockOf(map[uuid.UUID]int{local.ID: 2, sameState.ID: 3, abroad.ID: 4}), nil).Once()
+		mockRepo.On("ReserveStock", ctx, local.ID, productID, 2).Return(nil).Once()
+		mockRepo.On("ReserveStock", ctx, sameState.ID, productID, 3).Return(nil).Once()
+		mockRepo.On("ReserveStock", ctx, abroad.ID, productID, 1).Return(nil).Once()
+		mockRepo.On("CreateAllocation", ctx, mock.AnythingOfType("*models.StockAllocation")).Return(nil).Times(3)
+
+		// Act
+		allocations, err := warehouseService.AllocateOrder(ctx, order)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, allocations, 3)
+		assert.Equal(t, local.ID, allocations[0].WarehouseID)
+		assert.Equal(t, abroad.ID, allocations[2].WarehouseID)
+	})
+
+	t.Run("Success - No warehouses configured", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+		mockRepo.On("ListWarehouses", ctx).Return(nil, nil).Once()
+
+		// Act
+		allocations, err := warehouseService.AllocateOrder(ctx, newOrder(1))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, allocations)
+	})
+
+	t.Run("Failure - Insufficient stock", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+
+		mockRepo.On("ListWarehouses", ctx).Return(warehouses, nil).Once()
+		mockRepo.On("ListStockByProduct", ctx, productID).Return(stockOf(map[uuid.UUID]int{local.ID: 1}), nil).Once()
+
+		// Act
+		allocations, err := warehouseService.AllocateOrder(ctx, newOrder(2))
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeInsufficientStock, appErr.Code)
+		assert.Equal(t, &appErrors.ErrInsufficientStock{ProductID: productID, Available: 1}, appErr.Meta)
+		assert.Nil(t, allocations)
+		mockRepo.AssertNotCalled(t, "ReserveStock")
+	})
+
+	t.Run("Failure - Stock taken concurrently", func(t *testing.T) {
+		// Arrange
+		warehouseService, mockRepo, _, _ := setupWarehouseServiceTest(t)
+
+		mockRepo.On("ListWarehouses", ctx).Return(warehouses, nil).Once()
+		mockRepo.On("ListStockByProduct", ctx, productID).Return(stockOf(map[uuid.UUID]int{local.ID: 5}), nil).Once()
+		mockRepo.On("ReserveStock", ctx, local.ID, productID, 2).Return(sql.ErrNoRows).Once()
+
+		// Act
+		_, err := warehouseService.AllocateOrder(ctx, newOrder(2))
+
+		// Assert
+		var appErr *appErrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeInsufficientStock, appErr.Code)
+	})
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/metrics"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	repository "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/stripe"
+)
+
+const (
+	webhookMaxAttempts  = 3
+	webhookRetryBackoff = time.Second // multiplied by the attempt number
+	webhookMaxFailures  = 5           // failed deliveries before the event is moved to the dead letters
+	webhookLease        = 5 * time.Minute
+	webhookInboxMaxAge  = 7 * 24 * time.Hour // processed events are kept this long
+)
+
+// WebhookQueue takes verified Stripe events off the request path. Events are stored in the inbox before
+// they are acknowledged, then spread over a fixed set of workers by payment intent, so that the events
+// of one payment are processed in order. The events that failed or were still queued when an instance
+// stopped are delivered again from the inbox.
+type WebhookQueue interface {
+	Enqueue(ctx context.Context, event stripe.Event, payload []byte) error
+	Run(ctx context.Context, redeliverInterval time.Duration)
+}
+
+type webhookJob struct {
+	event      stripe.Event
+	enqueuedAt time.Time
+}
+
+type webhookQueue struct {
+	paymentService PaymentService
+	inboxRepo      repository.WebhookInboxRepository
+	deadLetterRepo repository.WebhookDeadLetterRepository
+	shards         []chan webhookJob
+	queueSize      int
+	depth          atomic.Int64
+}
+
+func NewWebhookQueue(paymentService PaymentService, inboxRepo repository.WebhookInboxRepository, deadLetterRepo repository.WebhookDeadLetterRepository, workers, queueSize int) WebhookQueue {
+	workers = max(workers, 1)
+	shardSize := max(queueSize/workers, 1)
+
+	q := &webhookQueue{
+		paymentService: paymentService,
+		inboxRepo:      inboxRepo,
+		deadLetterRepo: deadLetterRepo,
+		shards:         make([]chan webhookJob, workers),
+		queueSize:      shardSize * workers,
+	}
+
+	for i := range q.shards {
+		q.shards[i] = make(chan webhookJob, shardSize)
+	}
+
+	return q
+}
+
+// Enqueue stores the event in the inbox, an event that could not be stored is reported so that Stripe
+// redelivers it. An event already in the inbox is not queued again, its first entry is processed or
+// redelivered on its own. Enqueue never blocks, an event that doesn't fit in the queue waits in the inbox
+// for the next redelivery.
+func (q *webhookQueue) Enqueue(ctx context.Context, event stripe.Event, payload []byte) error {
+	inboxEvent := &models.WebhookInboxEvent{EventID: event.ID, EventType: string(event.Type), Payload: payload}
+
+	saved, err := q.inboxRepo.SaveEvent(ctx, inboxEvent, time.Now().Add(webhookLease))
+	if err != nil {
+		return errors.DatabaseError("Failed to store webhook event").WithError(err)
+	}
+
+	if !saved {
+		slog.Info("Webhook event already received, skipping", slog.String("stripeEventId", event.ID))
+
+		return nil
+	}
+
+	if !q.push(event) {
+		slog.Warn("Webhook queue is full, the event waits for its redelivery", slog.String("stripeEventId", event.ID))
+	}
+
+	return nil
+}
+
+func (q *webhookQueue) push(event stripe.Event) bool {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(webhookOrderingKey(event)))
+
+	select {
+	case q.shards[hash.Sum32()%uint32(len(q.shards))] <- webhookJob{event: event, enqueuedAt: time.Now()}:
+		metrics.SetWebhookQueueDepth(q.depth.Add(1))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// Run processes the queue until ctx is cancelled, then drains the events already accepted. Every
+// redeliverInterval the events of the inbox whose lease expired are queued again.
+func (q *webhookQueue) Run(ctx context.Context, redeliverInterval time.Duration) {
+	if redeliverInterval <= 0 {
+		redeliverInterval = time.Minute
+	}
+
+	var wg sync.WaitGroup
+
+	for _, shard := range q.shards {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			q.work(ctx, shard)
+		}()
+	}
+
+	ticker := time.NewTicker(redeliverInterval)
+	defer ticker.Stop()
+
+	for ctx.Err() == nil {
+		q.redeliver(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+
+	wg.Wait()
+}
+
+// redeliver queues the events left in the inbox by a failure or a stopped instance, and drops the
+// events processed long ago.
+func (q *webhookQueue) redeliver(ctx context.Context) {
+	now := time.Now()
+
+	if deleted, err := q.inboxRepo.DeleteProcessedEvents(ctx, now.Add(-webhookInboxMaxAge)); err != nil {
+		slog.Error("Failed to delete processed webhook events", slog.String("error", err.Error()))
+	} else if deleted > 0 {
+		slog.Info("Processed webhook events deleted", slog.Int64("count", deleted))
+	}
+
+	// only what fits in the queue is claimed, the rest waits for the next redelivery
+	limit := q.queueSize - int(q.depth.Load())
+	if limit <= 0 {
+		return
+	}
+
+	inboxEvents, err := q.inboxRepo.ClaimDueEvents(ctx, now, now.Add(webhookLease), limit)
+	if err != nil {
+		slog.Error("Failed to claim webhook events to redeliver", slog.String("error", err.Error()))
+
+		return
+	}
+
+	for _, inboxEvent := range inboxEvents {
+		var event stripe.Event
+		if err := json.Unmarshal(inboxEvent.Payload, &event); err != nil {
+			// the payload was verified before it was stored, retrying won't make it readable
+			q.deadLetter(ctx, slog.With(slog.String("stripeEventId", inboxEvent.EventID)), &models.WebhookDeadLetter{
+				EventID:   inboxEvent.EventID,
+				EventType: inboxEvent.EventType,
+				Reason:    fmt.Sprintf("stored payload is unreadable: %s", err),
+			})
+
+			continue
+		}
+
+		if !q.push(event) {
+			return
+		}
+	}
+}
+
+func (q *webhookQueue) work(ctx context.Context, shard chan webhookJob) {
+	for {
+		select {
+		case job := <-shard:
+			q.process(ctx, job, webhookMaxAttempts)
+		case <-ctx.Done():
+			// The events still queued are processed once, those failing are delivered again from the inbox
+			for {
+				select {
+				case job := <-shard:
+					q.process(ctx, job, 1)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// process retries a failed event with a linear backoff. Cancelling ctx only cuts the retries short,
+// an attempt in progress is allowed to complete.
+func (q *webhookQueue) process(ctx context.Context, job webhookJob, attempts int) {
+	logger := slog.With(slog.String("stripeEventId", job.event.ID), slog.String("stripeEventType", string(job.event.Type)))
+	handleCtx := context.WithoutCancel(ctx)
+
+	var err error
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = q.paymentService.HandleWebhookEvent(handleCtx, job.event); err == nil {
+			break
+		}
+
+		logger.Warn("Webhook processing attempt failed", slog.Int("attempt", attempt), slog.String("error", err.Error()))
+
+		if attempt < attempts {
+			select {
+			case <-time.After(time.Duration(attempt) * webhookRetryBackoff):
+			case <-ctx.Done():
+				attempts = attempt
+			}
+		}
+	}
+
+	metrics.SetWebhookQueueDepth(q.depth.Add(-1))
+	metrics.RecordWebhookProcessed(string(job.event.Type), time.Since(job.enqueuedAt), err)
+
+	if err == nil {
+		// left unmarked, the event is processed again once its lease expires
+		if err := q.inboxRepo.MarkProcessed(handleCtx, job.event.ID); err != nil {
+			logger.Error("Failed to mark webhook event processed", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	failures, recordErr := q.inboxRepo.RecordFailure(handleCtx, job.event.ID, err.Error())
+	if recordErr != nil {
+		logger.Error("Failed to record webhook event failure, the event is delivered again", slog.String("error", recordErr.Error()))
+
+		return
+	}
+
+	if failures < webhookMaxFailures {
+		logger.Warn("Webhook event failed, the event is delivered again", slog.Int("failures", failures), slog.String("error", err.Error()))
+
+		return
+	}
+
+	deadLetter := &models.WebhookDeadLetter{
+		EventID:   job.event.ID,
+		EventType: string(job.event.Type),
+		Reason:    fmt.Sprintf("processing failed %d times: %s", failures, err),
+	}
+
+	if job.event.Data != nil && json.Valid(job.event.Data.Raw) {
+		deadLetter.Payload = json.RawMessage(job.event.Data.Raw)
+	}
+
+	q.deadLetter(handleCtx, logger, deadLetter)
+}
+
+// deadLetter gives up on the event, it is moved to the dead letters for an admin to review. Until the
+// dead letter is stored the event stays in the redeliveries.
+func (q *webhookQueue) deadLetter(ctx context.Context, logger *slog.Logger, deadLetter *models.WebhookDeadLetter) {
+	if err := q.deadLetterRepo.CreateDeadLetter(ctx, deadLetter); err != nil {
+		logger.Error("Failed to move webhook event to the dead letters", slog.String("error", err.Error()))
+
+		return
+	}
+
+	if err := q.inboxRepo.MarkProcessed(ctx, deadLetter.EventID); err != nil {
+		logger.Error("Failed to mark webhook event processed", slog.String("error", err.Error()))
+	}
+
+	logger.Error("Giving up on webhook event, moved to the dead letters", slog.String("reason", deadLetter.Reason))
+	metrics.RecordWebhookQuarantined(deadLetter.EventType)
+}
+
+// webhookOrderingKey groups the events of a payment: charges and disputes reference their payment
+// intent, payment intent and checkout session events carry it as their own ID.
+func webhookOrderingKey(event stripe.Event) string {
+	if event.Data != nil {
+		if paymentIntentID, ok := event.Data.Object["payment_intent"].(string); ok && paymentIntentID != "" {
+			return paymentIntentID
+		}
+
+		if objectID, ok := event.Data.Object["id"].(string); ok && objectID != "" {
+			return objectID
+		}
+	}
+
+	return event.ID
+}
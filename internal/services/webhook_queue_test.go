@@ -0,0 +1,197 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appErrors "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/repositories/mocks"
+	service "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services"
+	svcMocks "github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/services/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func newWebhookEvent(id string, eventType stripe.EventType, object map[string]any) stripe.Event {
+	return stripe.Event{ID: id, Type: eventType, Data: &stripe.EventData{Object: object}}
+}
+
+// storingInbox accepts every event and its processing.
+func storingInbox(t *testing.T) *mocks.MockWebhookInboxRepository {
+	inboxRepo := mocks.NewMockWebhookInboxRepository(t)
+	inboxRepo.On("SaveEvent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	inboxRepo.On("MarkProcessed", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return inboxRepo
+}
+
+func TestWebhookQueue(t *testing.T) {
+	t.Run("Events of a payment are processed in order", func(t *testing.T) {
+		// Arrange
+		mockPaymentService := svcMocks.NewMockPaymentService(t)
+		queue := service.NewWebhookQueue(mockPaymentService, storingInbox(t), mocks.NewMockWebhookDeadLetterRepository(t), 4, 100)
+
+		events := []stripe.Event{
+			newWebhookEvent("evt_1", "payment_intent.succeeded", map[string]any{"id": "pi_123"}),
+			newWebhookEvent("evt_2", "charge.succeeded", map[string]any{"id": "ch_123", "payment_intent": "pi_123"}),
+			newWebhookEvent("evt_3", "charge.refunded", map[string]any{"id": "ch_123", "payment_intent": "pi_123"}),
+		}
+
+		var processed []string
+
+		mockPaymentService.On("HandleWebhookEvent", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				processed = append(processed, args.Get(1).(stripe.Event).ID)
+			}).
+			Return(nil).Times(len(events))
+
+		for _, event := range events {
+			require.NoError(t, queue.Enqueue(t.Context(), event, []byte(`{}`)))
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		// Act
+		queue.Run(ctx, time.Minute) // a cancelled queue drains what was accepted and returns
+
+		// Assert
+		assert.Equal(t, []string{"evt_1", "evt_2", "evt_3"}, processed)
+	})
+
+	t.Run("Failed events are left for their redelivery", func(t *testing.T) {
+		// Arrange
+		mockPaymentService := svcMocks.NewMockPaymentService(t)
+		inboxRepo := mocks.NewMockWebhookInboxRepository(t)
+		queue := service.NewWebhookQueue(mockPaymentService, inboxRepo, mocks.NewMockWebhookDeadLetterRepository(t), 1, 10)
+
+		event := newWebhookEvent("evt_1", "payment_intent.succeeded", map[string]any{"id": "pi_123"})
+		inboxRepo.On("SaveEvent", mock.Anything, &models.WebhookInboxEvent{EventID: "evt_1", EventType: "payment_intent.succeeded", Payload: []byte(`{}`)}, mock.Anything).Return(true, nil).Once()
+		mockPaymentService.On("HandleWebhookEvent", mock.Anything, event).Return(appErrors.DatabaseError("db down")).Once()
+		inboxRepo.On("RecordFailure", mock.Anything, "evt_1", "db down").Return(1, nil).Once()
+
+		require.NoError(t, queue.Enqueue(t.Context(), event, []byte(`{}`)))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		// Act
+		queue.Run(ctx, time.Minute)
+
+		// Assert
+		mockPaymentService.AssertExpectations(t)
+		inboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("Events failing every delivery are moved to the dead letters", func(t *testing.T) {
+		// Arrange
+		mockPaymentService := svcMocks.NewMockPaymentService(t)
+		inboxRepo := storingInbox(t)
+		deadLetterRepo := mocks.NewMockWebhookDeadLetterRepository(t)
+		queue := service.NewWebhookQueue(mockPaymentService, inboxRepo, deadLetterRepo, 1, 10)
+
+		event := newWebhookEvent("evt_1", "payment_intent.succeeded", map[string]any{"id": "pi_123"})
+		mockPaymentService.On("HandleWebhookEvent", mock.Anything, event).Return(appErrors.DatabaseError("db down")).Once()
+		inboxRepo.On("RecordFailure", mock.Anything, "evt_1", mock.Anything).Return(5, nil).Once()
+		deadLetterRepo.On("CreateDeadLetter", mock.Anything, mock.MatchedBy(func(deadLetter *models.WebhookDeadLetter) bool {
+			return deadLetter.EventID == "evt_1" && deadLetter.Reason == "processing failed 5 times: db down"
+		})).Return(nil).Once()
+
+		require.NoError(t, queue.Enqueue(t.Context(), event, []byte(`{}`)))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		// Act
+		queue.Run(ctx, time.Minute)
+
+		// Assert
+		deadLetterRepo.AssertExpectations(t)
+		inboxRepo.AssertCalled(t, "MarkProcessed", mock.Anything, "evt_1")
+	})
+
+	t.Run("Stored events are redelivered", func(t *testing.T) {
+		// Arrange
+		mockPaymentService := svcMocks.NewMockPaymentService(t)
+		inboxRepo := storingInbox(t)
+		queue := service.NewWebhookQueue(mockPaymentService, inboxRepo, mocks.NewMockWebhookDeadLetterRepository(t), 1, 10)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		inboxRepo.On("DeleteProcessedEvents", mock.Anything, mock.Anything).Return(int64(0), nil)
+		inboxRepo.On("ClaimDueEvents", mock.Anything, mock.Anything, mock.Anything, 10).Return([]*models.WebhookInboxEvent{{
+			EventID:   "evt_1",
+			EventType: "payment_intent.succeeded",
+			Payload:   []byte(`{"id":"evt_1","type":"payment_intent.succeeded","data":{"object":{"id":"pi_123"}}}`),
+		}}, nil).Once()
+		mockPaymentService.On("HandleWebhookEvent", mock.Anything, mock.MatchedBy(func(event stripe.Event) bool {
+			return event.ID == "evt_1" && event.Data.Object["id"] == "pi_123"
+		})).Run(func(mock.Arguments) { cancel() }).Return(nil).Once()
+
+		// Act
+		queue.Run(ctx, time.Hour)
+
+		// Assert
+		inboxRepo.AssertCalled(t, "MarkProcessed", mock.Anything, "evt_1")
+	})
+
+	t.Run("Full queue leaves the event in the inbox", func(t *testing.T) {
+		// Arrange
+		queue := service.NewWebhookQueue(svcMocks.NewMockPaymentService(t), storingInbox(t), mocks.NewMockWebhookDeadLetterRepository(t), 1, 1)
+		require.NoError(t, queue.Enqueue(t.Context(), newWebhookEvent("evt_1", "payment_intent.succeeded", map[string]any{"id": "pi_1"}), []byte(`{}`)))
+
+		// Act
+		err := queue.Enqueue(t.Context(), newWebhookEvent("evt_2", "payment_intent.succeeded", map[string]any{"id": "pi_2"}), []byte(`{}`))
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Event already in the inbox is not queued again", func(t *testing.T) {
+		// Arrange
+		mockPaymentService := svcMocks.NewMockPaymentService(t)
+		inboxRepo := mocks.NewMockWebhookInboxRepository(t)
+		queue := service.NewWebhookQueue(mockPaymentService, inboxRepo, mocks.NewMockWebhookDeadLetterRepository(t), 1, 10)
+
+		event := newWebhookEvent("evt_1", "payment_intent.succeeded", map[string]any{"id": "pi_123"})
+		inboxRepo.On("SaveEvent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+		inboxRepo.On("SaveEvent", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+		mockPaymentService.On("HandleWebhookEvent", mock.Anything, event).Return(nil).Once()
+		inboxRepo.On("MarkProcessed", mock.Anything, "evt_1").Return(nil).Once()
+
+		require.NoError(t, queue.Enqueue(t.Context(), event, []byte(`{}`)))
+		require.NoError(t, queue.Enqueue(t.Context(), event, []byte(`{}`))) // redelivered by Stripe
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		// Act
+		queue.Run(ctx, time.Minute)
+
+		// Assert
+		mockPaymentService.AssertExpectations(t)
+		inboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("Event that can't be stored is refused", func(t *testing.T) {
+		// Arrange
+		inboxRepo := mocks.NewMockWebhookInboxRepository(t)
+		queue := service.NewWebhookQueue(svcMocks.NewMockPaymentService(t), inboxRepo, mocks.NewMockWebhookDeadLetterRepository(t), 1, 1)
+		inboxRepo.On("SaveEvent", mock.Anything, mock.Anything, mock.Anything).Return(false, errors.New("connection refused")).Once()
+
+		// Act
+		err := queue.Enqueue(t.Context(), newWebhookEvent("evt_1", "payment_intent.succeeded", map[string]any{"id": "pi_1"}), []byte(`{}`))
+
+		// Assert
+		var appErr *appErrors.AppError
+
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, appErrors.ErrCodeDatabaseError, appErr.Code)
+	})
+}
@@ -28,6 +28,15 @@ func CreateTestRequestWithContext(method, target string, body io.Reader, userID
 	return req.WithContext(ctx)
 }
 
+// CreateVendorTestRequest is CreateTestRequestWithContext with the claims of a vendor token scoped to vendorID.
+func CreateVendorTestRequest(method, target string, body io.Reader, userID, vendorID uuid.UUID, pathParams map[string]string) *http.Request {
+	req := CreateTestRequestWithContext(method, target, body, userID, pathParams)
+
+	claims := &models.Claims{UserID: userID, Email: "test@example.com", Role: models.RoleVendor, VendorID: &vendorID}
+
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey, claims))
+}
+
 func CreateTestRequestWithoutContext(method, target string, body io.Reader, pathParams map[string]string) *http.Request {
 	req := httptest.NewRequest(method, target, body)
 
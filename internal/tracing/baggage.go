@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Business identifiers carried as baggage and copied onto spans, so traces can be filtered by them.
+// The tenant is not known to this service, it is only forwarded when an upstream gateway puts it in the baggage.
+const (
+	UserIDKey   = "app.user_id"
+	TenantIDKey = "app.tenant_id"
+	OrderIDKey  = "app.order_id"
+)
+
+var identifierKeys = []string{UserIDKey, TenantIDKey, OrderIDKey}
+
+var identifierHashKey atomic.Pointer[[]byte]
+
+// SetIdentifierHashing makes WithIdentifier record a keyed hash of the identifiers instead of their value,
+// traces can still be filtered by hashing the searched ID with the same key. An empty key disables it.
+func SetIdentifierHashing(key string) {
+	if key == "" {
+		identifierHashKey.Store(nil)
+
+		return
+	}
+
+	k := []byte(key)
+	identifierHashKey.Store(&k)
+}
+
+func hashIdentifier(value string) string {
+	key := identifierHashKey.Load()
+	if key == nil {
+		return value
+	}
+
+	mac := hmac.New(sha256.New, *key)
+	mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// SetIdentifier records a business identifier on the span in ctx only, for identifiers scoped to one operation.
+func SetIdentifier(ctx context.Context, key, value string) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(key, hashIdentifier(value)))
+}
+
+// WithIdentifier records a business identifier on the span in ctx and adds it to the baggage,
+// the returned context makes it follow every span started from it, downstream services included.
+func WithIdentifier(ctx context.Context, key, value string) context.Context {
+	value = hashIdentifier(value)
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(key, value))
+
+	member, err := baggage.NewMember(key, value)
+	if err != nil {
+		slog.Debug("failed to create baggage member", slog.String("key", key), slog.String("error", err.Error()))
+
+		return ctx
+	}
+
+	bag, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		slog.Debug("failed to set baggage member", slog.String("key", key), slog.String("error", err.Error()))
+
+		return ctx
+	}
+
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// BaggageProcessor copies the business identifiers found in the baggage of the parent context onto every span it starts.
+type BaggageProcessor struct{}
+
+func NewBaggageProcessor() *BaggageProcessor {
+	return &BaggageProcessor{}
+}
+
+func (p *BaggageProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	bag := baggage.FromContext(parent)
+
+	for _, key := range identifierKeys {
+		if member := bag.Member(key); member.Key() != "" {
+			s.SetAttributes(attribute.String(key, member.Value()))
+		}
+	}
+}
+
+func (p *BaggageProcessor) OnEnd(sdktrace.ReadOnlySpan) {}
+
+func (p *BaggageProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *BaggageProcessor) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package tracing_test
+
+import (
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newBaggageTestProvider(t *testing.T) (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(tracing.NewBaggageProcessor()),
+		sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(exporter)),
+	)
+
+	t.Cleanup(func() { _ = tp.Shutdown(t.Context()) })
+
+	return tp, exporter
+}
+
+func spanAttribute(span tracetest.SpanStub, key string) (string, bool) {
+	for _, attr := range span.Attributes {
+		if attr.Key == attribute.Key(key) {
+			return attr.Value.AsString(), true
+		}
+	}
+
+	return "", false
+}
+
+func TestWithIdentifier(t *testing.T) {
+	t.Run("Identifier is set on the span, the baggage and the child spans", func(t *testing.T) {
+		// Arrange
+		tracing.SetIdentifierHashing("")
+
+		tp, exporter := newBaggageTestProvider(t)
+		tracer := tp.Tracer("test")
+
+		// Act
+		ctx, root := tracer.Start(t.Context(), "root")
+		ctx = tracing.WithIdentifier(ctx, tracing.UserIDKey, "user-1")
+		_, child := tracer.Start(ctx, "child")
+		child.End()
+		root.End()
+
+		// Assert
+		assert.Equal(t, "user-1", baggage.FromContext(ctx).Member(tracing.UserIDKey).Value())
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+
+		for _, span := range spans {
+			value, ok := spanAttribute(span, tracing.UserIDKey)
+			assert.True(t, ok, span.Name)
+			assert.Equal(t, "user-1", value)
+		}
+	})
+
+	t.Run("Identifier is hashed when a key is configured", func(t *testing.T) {
+		// Arrange
+		tracing.SetIdentifierHashing("secret")
+		t.Cleanup(func() { tracing.SetIdentifierHashing("") })
+
+		tp, exporter := newBaggageTestProvider(t)
+		tracer := tp.Tracer("test")
+
+		// Act
+		ctx, root := tracer.Start(t.Context(), "root")
+		ctx = tracing.WithIdentifier(ctx, tracing.UserIDKey, "user-1")
+		tracing.SetIdentifier(ctx, tracing.OrderIDKey, "order-1")
+		root.End()
+
+		// Assert
+		hashed := baggage.FromContext(ctx).Member(tracing.UserIDKey).Value()
+		assert.Len(t, hashed, 32)
+		assert.NotContains(t, hashed, "user-1")
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+
+		userID, _ := spanAttribute(spans[0], tracing.UserIDKey)
+		assert.Equal(t, hashed, userID)
+
+		orderID, _ := spanAttribute(spans[0], tracing.OrderIDKey)
+		assert.Len(t, orderID, 32)
+		assert.NotEqual(t, "order-1", orderID)
+	})
+
+	t.Run("Tenant forwarded by the gateway is copied onto the spans", func(t *testing.T) {
+		// Arrange
+		tp, exporter := newBaggageTestProvider(t)
+		tracer := tp.Tracer("test")
+
+		member, err := baggage.NewMember(tracing.TenantIDKey, "acme")
+		require.NoError(t, err)
+
+		other, err := baggage.NewMember("unrelated", "value")
+		require.NoError(t, err)
+
+		bag, err := baggage.New(member, other)
+		require.NoError(t, err)
+
+		// Act
+		_, span := tracer.Start(baggage.ContextWithBaggage(t.Context(), bag), "request")
+		span.End()
+
+		// Assert
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+
+		tenant, ok := spanAttribute(spans[0], tracing.TenantIDKey)
+		assert.True(t, ok)
+		assert.Equal(t, "acme", tenant)
+
+		_, ok = spanAttribute(spans[0], "unrelated")
+		assert.False(t, ok)
+	})
+}
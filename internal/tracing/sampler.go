@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
+)
+
+type routeRule struct {
+	prefix  string
+	sampler sdktrace.Sampler
+}
+
+// routeSampler picks the sampler of the longest route prefix matching the url.path of the span,
+// spans without a matching rule (or without a path) are left to the fallback sampler.
+type routeSampler struct {
+	rules    []routeRule
+	fallback sdktrace.Sampler
+}
+
+// NewRouteSampler builds a sampler from per-route ratios, 1 always samples the route and 0 drops it.
+func NewRouteSampler(ratios map[string]float64, fallback sdktrace.Sampler) (sdktrace.Sampler, error) {
+	rules := make([]routeRule, 0, len(ratios))
+
+	for prefix, ratio := range ratios {
+		if ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("route %s: sampling ratio must be between 0 and 1, got %v", prefix, ratio)
+		}
+
+		rules = append(rules, routeRule{prefix: prefix, sampler: ratioSampler(ratio)})
+	}
+
+	// Longest prefix first, so the first match is the most specific one
+	sort.Slice(rules, func(i, j int) bool {
+		if len(rules[i].prefix) != len(rules[j].prefix) {
+			return len(rules[i].prefix) > len(rules[j].prefix)
+		}
+
+		return rules[i].prefix < rules[j].prefix
+	})
+
+	return &routeSampler{rules: rules, fallback: fallback}, nil
+}
+
+func ratioSampler(ratio float64) sdktrace.Sampler {
+	switch ratio {
+	case 0:
+		return sdktrace.NeverSample()
+	case 1:
+		return sdktrace.AlwaysSample()
+	default:
+		return sdktrace.TraceIDRatioBased(ratio)
+	}
+}
+
+func (s *routeSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	for _, attr := range p.Attributes {
+		if attr.Key != semconv.URLPathKey {
+			continue
+		}
+
+		path := attr.Value.AsString()
+
+		for _, rule := range s.rules {
+			if strings.HasPrefix(path, rule.prefix) {
+				return rule.sampler.ShouldSample(p)
+			}
+		}
+
+		break
+	}
+
+	return s.fallback.ShouldSample(p)
+}
+
+func (s *routeSampler) Description() string {
+	rules := make([]string, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule.prefix+"="+rule.sampler.Description())
+	}
+
+	return fmt.Sprintf("RouteSampler{%s;fallback=%s}", strings.Join(rules, ","), s.fallback.Description())
+}
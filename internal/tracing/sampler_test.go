@@ -0,0 +1,78 @@
+package tracing_test
+
+import (
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func samplingParams(path string) sdktrace.SamplingParameters {
+	var attrs []attribute.KeyValue
+	if path != "" {
+		attrs = append(attrs, semconv.URLPath(path))
+	}
+
+	return sdktrace.SamplingParameters{
+		TraceID:    trace.TraceID{0x01},
+		Name:       "request",
+		Kind:       trace.SpanKindServer,
+		Attributes: attrs,
+	}
+}
+
+func TestRouteSampler(t *testing.T) {
+	sampler, err := tracing.NewRouteSampler(map[string]float64{
+		"/api/v1/payments":         1,
+		"/api/v1/payments/webhook": 0,
+		"/livez":                   0,
+	}, sdktrace.NeverSample())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected sdktrace.SamplingDecision
+	}{
+		{"Matching route is always sampled", "/api/v1/payments/123", sdktrace.RecordAndSample},
+		{"Longest prefix wins", "/api/v1/payments/webhook", sdktrace.Drop},
+		{"Dropped route", "/livez", sdktrace.Drop},
+		{"Unmatched route uses the fallback", "/api/v1/products", sdktrace.Drop},
+		{"Span without a path uses the fallback", "", sdktrace.Drop},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result := sampler.ShouldSample(samplingParams(tt.path))
+
+			// Assert
+			assert.Equal(t, tt.expected, result.Decision)
+		})
+	}
+
+	t.Run("Fallback sampler applies to unmatched routes", func(t *testing.T) {
+		// Arrange
+		sampler, err := tracing.NewRouteSampler(map[string]float64{"/livez": 0}, sdktrace.AlwaysSample())
+		require.NoError(t, err)
+
+		// Act
+		result := sampler.ShouldSample(samplingParams("/api/v1/products"))
+
+		// Assert
+		assert.Equal(t, sdktrace.RecordAndSample, result.Decision)
+	})
+
+	t.Run("Invalid ratio", func(t *testing.T) {
+		// Act
+		_, err := tracing.NewRouteSampler(map[string]float64{"/api/v1/orders": 1.5}, sdktrace.AlwaysSample())
+
+		// Assert
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,148 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ForceSampleAttribute marks a span whose trace has to be exported even though the sampler dropped it.
+const ForceSampleAttribute = attribute.Key("sampling.force")
+
+const (
+	maxPendingTraces = 1000
+	maxSpansPerTrace = 256
+)
+
+// recordingSampler keeps every span recording, so that a trace can still be exported
+// once the request turns out to be interesting (e.g. slow). Spans not picked by the
+// wrapped sampler are buffered by the ForceSampleProcessor instead of being exported.
+type recordingSampler struct {
+	base sdktrace.Sampler
+}
+
+func NewRecordingSampler(base sdktrace.Sampler) sdktrace.Sampler {
+	return &recordingSampler{base: base}
+}
+
+func (s *recordingSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := s.base.ShouldSample(p)
+	if result.Decision == sdktrace.Drop {
+		result.Decision = sdktrace.RecordOnly
+	}
+
+	return result
+}
+
+func (s *recordingSampler) Description() string {
+	return "RecordingSampler{" + s.base.Description() + "}"
+}
+
+// ForceSampleProcessor forwards sampled spans as usual and holds the unsampled ones
+// until their local root span ends. If the root carries ForceSampleAttribute the whole
+// buffered trace is exported, otherwise it is discarded.
+type ForceSampleProcessor struct {
+	next sdktrace.SpanProcessor
+
+	mu      sync.Mutex
+	pending map[trace.TraceID][]sdktrace.ReadOnlySpan
+}
+
+func NewForceSampleProcessor(next sdktrace.SpanProcessor) *ForceSampleProcessor {
+	return &ForceSampleProcessor{
+		next:    next,
+		pending: make(map[trace.TraceID][]sdktrace.ReadOnlySpan),
+	}
+}
+
+func (p *ForceSampleProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+func (p *ForceSampleProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+
+		return
+	}
+
+	traceID := s.SpanContext().TraceID()
+	isLocalRoot := !s.Parent().IsValid() || s.Parent().IsRemote()
+
+	p.mu.Lock()
+
+	if !isLocalRoot {
+		spans, tracked := p.pending[traceID]
+		if (tracked || len(p.pending) < maxPendingTraces) && len(spans) < maxSpansPerTrace {
+			p.pending[traceID] = append(spans, s)
+		}
+
+		p.mu.Unlock()
+
+		return
+	}
+
+	spans := p.pending[traceID]
+	delete(p.pending, traceID)
+
+	p.mu.Unlock()
+
+	if !isForceSampled(s) {
+		return
+	}
+
+	for _, span := range spans {
+		p.next.OnEnd(sampledSpan{span})
+	}
+
+	p.next.OnEnd(sampledSpan{s})
+}
+
+func (p *ForceSampleProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *ForceSampleProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+func isForceSampled(s sdktrace.ReadOnlySpan) bool {
+	for _, attr := range s.Attributes() {
+		if attr.Key == ForceSampleAttribute && attr.Value.AsBool() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sampledSpan flips the sampled flag, exporters skip spans that are not sampled.
+type sampledSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() trace.SpanContext {
+	return s.ReadOnlySpan.SpanContext().WithTraceFlags(s.ReadOnlySpan.SpanContext().TraceFlags().WithSampled(true))
+}
+
+// ForceSample flags the span in ctx so its trace is exported regardless of the sampling ratio.
+func ForceSample(ctx context.Context) {
+	trace.SpanFromContext(ctx).SetAttributes(ForceSampleAttribute.Bool(true))
+}
+
+// IsExported reports whether the trace of the span in ctx is going to reach the exporter.
+func IsExported(ctx context.Context) bool {
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsSampled() {
+		return true
+	}
+
+	if ro, ok := span.(sdktrace.ReadOnlySpan); ok {
+		return isForceSampled(ro)
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package tracing_test
+
+import (
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestProvider(t *testing.T, sampler sdktrace.Sampler) (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	processor := tracing.NewForceSampleProcessor(sdktrace.NewSimpleSpanProcessor(exporter))
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(tracing.NewRecordingSampler(sampler)),
+		sdktrace.WithSpanProcessor(processor),
+	)
+
+	t.Cleanup(func() { _ = tp.Shutdown(t.Context()) })
+
+	return tp, exporter
+}
+
+func TestForceSampleProcessor(t *testing.T) {
+	t.Run("Sampled traces are exported", func(t *testing.T) {
+		// Arrange
+		tp, exporter := newTestProvider(t, sdktrace.AlwaysSample())
+		tracer := tp.Tracer("test")
+
+		// Act
+		ctx, root := tracer.Start(t.Context(), "root")
+		_, child := tracer.Start(ctx, "child")
+		child.End()
+		root.End()
+
+		// Assert
+		assert.Len(t, exporter.GetSpans(), 2)
+	})
+
+	t.Run("Unsampled traces are dropped", func(t *testing.T) {
+		// Arrange
+		tp, exporter := newTestProvider(t, sdktrace.NeverSample())
+		tracer := tp.Tracer("test")
+
+		// Act
+		ctx, root := tracer.Start(t.Context(), "root")
+		_, child := tracer.Start(ctx, "child")
+		child.End()
+
+		assert.False(t, tracing.IsExported(ctx))
+
+		root.End()
+
+		// Assert
+		assert.Empty(t, exporter.GetSpans())
+	})
+
+	t.Run("Force sampled traces are exported with their children", func(t *testing.T) {
+		// Arrange
+		tp, exporter := newTestProvider(t, sdktrace.NeverSample())
+		tracer := tp.Tracer("test")
+
+		// Act
+		ctx, root := tracer.Start(t.Context(), "root")
+		_, child := tracer.Start(ctx, "child")
+		child.End()
+
+		tracing.ForceSample(ctx)
+		assert.True(t, tracing.IsExported(ctx))
+
+		root.End()
+
+		// Assert
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "child", spans[0].Name)
+		assert.Equal(t, "root", spans[1].Name)
+		assert.True(t, spans[1].SpanContext.IsSampled())
+	})
+}
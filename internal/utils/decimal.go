@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// ParseCents rounds a decimal amount, as printed by a NUMERIC column, to the cent with banker's rounding:
+// ties go to the even cent, so rounding errors cancel out over many amounts instead of drifting upwards.
+// The rounding is done on the exact decimal value, before any conversion to float.
+func ParseCents(value string) (int64, error) {
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return 0, fmt.Errorf("invalid decimal amount %q", value)
+	}
+
+	amount.Mul(amount, big.NewRat(100, 1))
+
+	// QuoRem truncates towards zero, the remainder has the sign of the amount
+	cents, remainder := new(big.Int).QuoRem(amount.Num(), amount.Denom(), new(big.Int))
+
+	switch new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(amount.Denom()) {
+	case 1:
+		cents.Add(cents, big.NewInt(int64(remainder.Sign())))
+	case 0:
+		if cents.Bit(0) == 1 {
+			cents.Add(cents, big.NewInt(int64(remainder.Sign())))
+		}
+	}
+
+	if !cents.IsInt64() {
+		return 0, fmt.Errorf("decimal amount %q is out of range", value)
+	}
+
+	return cents.Int64(), nil
+}
+
+// NumericAmount scans a NUMERIC aggregate into dest rounded to the cent with ParseCents. Revenue reports sum
+// their amounts as NUMERIC rather than float, so their totals match the ledger to the cent however many rows
+// they add up.
+func NumericAmount(dest *float64) sql.Scanner {
+	return numericAmount{dest: dest}
+}
+
+type numericAmount struct {
+	dest *float64
+}
+
+func (n numericAmount) Scan(src any) error {
+	var value string
+
+	switch v := src.(type) {
+	case []byte:
+		value = string(v)
+	case string:
+		value = v
+	case int64:
+		*n.dest = float64(v)
+
+		return nil
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		*n.dest = 0
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported NUMERIC value of type %T", src)
+	}
+
+	cents, err := ParseCents(value)
+	if err != nil {
+		return err
+	}
+
+	*n.dest = float64(cents) / 100
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCents(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		cents int64
+	}{
+		{name: "Whole amount", value: "120", cents: 12000},
+		{name: "Exact cents", value: "250.50", cents: 25050},
+		{name: "Rounds down below the tie", value: "0.1249", cents: 12},
+		{name: "Rounds up above the tie", value: "0.1251", cents: 13},
+		{name: "Tie goes to the even cent below", value: "0.125", cents: 12},
+		{name: "Tie goes to the even cent above", value: "0.135", cents: 14},
+		{name: "Negative tie", value: "-2.345", cents: -234},
+		{name: "Negative above the tie", value: "-2.3451", cents: -235},
+		{name: "Beyond float precision", value: "90071992547409.93", cents: 9007199254740993},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			cents, err := utils.ParseCents(tt.value)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.cents, cents)
+		})
+	}
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		// Act
+		_, err := utils.ParseCents("12,50")
+
+		// Assert
+		require.ErrorContains(t, err, `invalid decimal amount "12,50"`)
+	})
+}
+
+func TestNumericAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    any
+		amount float64
+	}{
+		{name: "NUMERIC text", src: []byte("1234.565"), amount: 1234.56},
+		{name: "String", src: "0.135", amount: 0.14},
+		{name: "Integer", src: int64(42), amount: 42},
+		{name: "Float", src: 19.999, amount: 20},
+		{name: "NULL", src: nil, amount: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			amount := -1.0
+
+			// Act
+			err := utils.NumericAmount(&amount).Scan(tt.src)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tt.amount, amount, 0)
+		})
+	}
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		// Arrange
+		var amount float64
+
+		// Act
+		err := utils.NumericAmount(&amount).Scan(true)
+
+		// Assert
+		require.ErrorContains(t, err, "unsupported NUMERIC value of type bool")
+	})
+}
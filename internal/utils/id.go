@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+)
+
+const (
+	IDVersion4 = 4 // random
+	IDVersion7 = 7 // time-ordered, keeps B-tree index inserts on the rightmost pages
+)
+
+var idVersion atomic.Int32
+
+func init() {
+	idVersion.Store(IDVersion7)
+}
+
+// SetIDVersion selects the UUID version of the identifiers generated by NewID, it is set once at startup.
+func SetIDVersion(version int) error {
+	if version != IDVersion4 && version != IDVersion7 {
+		return fmt.Errorf("unsupported id version %d, expected %d or %d", version, IDVersion4, IDVersion7)
+	}
+
+	idVersion.Store(int32(version))
+
+	return nil
+}
+
+// NewID returns the primary key of a new record. UUIDv7 falls back to a random UUID should the
+// clock based generation fail, both are valid keys for the same columns.
+func NewID() uuid.UUID {
+	if idVersion.Load() == IDVersion7 {
+		if id, err := uuid.NewV7(); err == nil {
+			return id
+		}
+	}
+
+	return uuid.New()
+}
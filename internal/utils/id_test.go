@@ -0,0 +1,71 @@
+package utils_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewID(t *testing.T) {
+	t.Run("Time-ordered by default", func(t *testing.T) {
+		// Act
+		first := utils.NewID()
+		second := utils.NewID()
+
+		// Assert
+		assert.Equal(t, uuid.Version(7), first.Version())
+		assert.Negative(t, bytes.Compare(first[:], second[:]))
+	})
+
+	t.Run("Fallback to random", func(t *testing.T) {
+		// Arrange
+		require.NoError(t, utils.SetIDVersion(utils.IDVersion4))
+		t.Cleanup(func() { _ = utils.SetIDVersion(utils.IDVersion7) })
+
+		// Act
+		id := utils.NewID()
+
+		// Assert
+		assert.Equal(t, uuid.Version(4), id.Version())
+	})
+
+	t.Run("Unsupported version", func(t *testing.T) {
+		// Act
+		err := utils.SetIDVersion(1)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+// benchmarkIndexInsert approximates a primary key index: every key is inserted at its sorted
+// position, so the cost grows with how far from the end the key lands.
+func benchmarkIndexInsert(b *testing.B, version int) {
+	b.Helper()
+
+	require.NoError(b, utils.SetIDVersion(version))
+	b.Cleanup(func() { _ = utils.SetIDVersion(utils.IDVersion7) })
+
+	index := make([]uuid.UUID, 0, b.N)
+
+	b.ResetTimer()
+
+	for range b.N {
+		id := utils.NewID()
+		pos, _ := slices.BinarySearchFunc(index, id, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+		index = slices.Insert(index, pos, id)
+	}
+}
+
+func BenchmarkIndexInsertV4(b *testing.B) {
+	benchmarkIndexInsert(b, utils.IDVersion4)
+}
+
+func BenchmarkIndexInsertV7(b *testing.B) {
+	benchmarkIndexInsert(b, utils.IDVersion7)
+}
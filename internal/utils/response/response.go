@@ -8,6 +8,9 @@ import (
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/errors"
 )
 
+// TraceIDHeader carries the trace of the request, set by the middleware and echoed in error bodies.
+const TraceIDHeader = "X-Trace-Id"
+
 type APIResponse struct {
 	Success bool           `json:"success"`
 	Data    any            `json:"data,omitempty"`
@@ -18,6 +21,8 @@ type ErrorResponse struct {
 	Code    string   `json:"code"`
 	Message string   `json:"message"`
 	Details []string `json:"details,omitempty"`
+	Meta    any      `json:"meta,omitempty"`     // structured details, like the product out of stock
+	TraceID string   `json:"trace_id,omitempty"` // to quote when reporting the error
 }
 
 // interface {} == any.
@@ -49,6 +54,7 @@ func Error(w http.ResponseWriter, err error) {
 		errorResponse = &ErrorResponse{
 			Code:    appErr.Code,
 			Message: appErr.Message,
+			Meta:    appErr.Meta,
 		}
 
 		if appErr.Detail != "" {
@@ -62,6 +68,8 @@ func Error(w http.ResponseWriter, err error) {
 		}
 	}
 
+	errorResponse.TraceID = w.Header().Get(TraceIDHeader)
+
 	response := APIResponse{
 		Success: false,
 		Error:   errorResponse,
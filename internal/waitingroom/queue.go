@@ -0,0 +1,83 @@
+package waitingroom
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Keys of the line, kept apart from the other keys of the Redis instance.
+const (
+	issuedKey   = "waiting_room:issued"   // number of the last ticket issued
+	admittedKey = "waiting_room:admitted" // number of the last ticket let in
+	movedAtKey  = "waiting_room:moved_at" // when the line last moved on, in milliseconds
+)
+
+// moveLine lets in the visitors the rate allows since the line last moved on, after issuing a ticket
+// when ARGV[3] is 1. A line running empty banks a second of admissions at most, so visitors of a quiet
+// room go straight through while a spike still queues. It returns the last ticket issued and let in.
+var moveLine = redis.NewScript(`
+	local now = tonumber(ARGV[1])
+	local rate = tonumber(ARGV[2])
+	local issued = tonumber(redis.call('GET', KEYS[1]) or '0')
+	if ARGV[3] == '1' then
+		issued = redis.call('INCR', KEYS[1])
+	end
+	local admitted = tonumber(redis.call('GET', KEYS[2]) or '0')
+	local movedAt = tonumber(redis.call('GET', KEYS[3]) or '0')
+	local grant = math.floor((now - movedAt) * rate / 1000)
+	if grant > 0 then
+		local cap = issued + rate
+		if admitted + grant >= cap then
+			admitted = math.max(admitted, cap)
+			movedAt = now
+		else
+			admitted = admitted + grant
+			movedAt = movedAt + math.floor(grant * 1000 / rate)
+		end
+		redis.call('SET', KEYS[2], admitted)
+		redis.call('SET', KEYS[3], movedAt)
+	end
+	return {issued, admitted}
+`)
+
+// Queue counts the tickets issued and let in, shared by the instances. The line moves on whenever it is
+// looked at, so no job has to run for it.
+type Queue interface {
+	// Join issues the next ticket, it returns its number and the number of the last ticket let in.
+	Join(ctx context.Context, rate int, now time.Time) (int64, int64, error)
+	// Admitted returns the number of the last ticket let in.
+	Admitted(ctx context.Context, rate int, now time.Time) (int64, error)
+}
+
+type redisQueue struct {
+	client *redis.Client
+}
+
+func NewRedisQueue(client *redis.Client) Queue {
+	return &redisQueue{client: client}
+}
+
+func (q *redisQueue) Join(ctx context.Context, rate int, now time.Time) (int64, int64, error) {
+	result, err := q.move(ctx, rate, now, 1)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to issue waiting room ticket: %w", err)
+	}
+
+	return result[0], result[1], nil
+}
+
+func (q *redisQueue) Admitted(ctx context.Context, rate int, now time.Time) (int64, error) {
+	result, err := q.move(ctx, rate, now, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move waiting room line: %w", err)
+	}
+
+	return result[1], nil
+}
+
+func (q *redisQueue) move(ctx context.Context, rate int, now time.Time, join int) ([]int64, error) {
+	return moveLine.Run(ctx, q.client, []string{issuedKey, admittedKey, movedAtKey}, now.UnixMilli(), rate, join).Int64Slice()
+}
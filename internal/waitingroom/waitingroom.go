@@ -0,0 +1,141 @@
+// Package waitingroom holds the visitors of high-demand routes in line during traffic spikes, like a
+// virtual waiting room: every visitor takes a numbered ticket, and the tickets are let in in order at a
+// set rate. A ticket is a signed token the visitor sends back with every request, so the line itself
+// only counts the tickets issued and let in, in Redis, where every instance sees the same line.
+package waitingroom
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+)
+
+// TokenHeader carries the ticket of a visitor, both ways.
+const TokenHeader = "X-Waiting-Room-Token"
+
+// ticketSignatureSize is the length of the truncated HMAC of a ticket, like the tracking tokens.
+const ticketSignatureSize = 16
+
+// Ticket is the place of a visitor in the line, or its pass once let in.
+type Ticket struct {
+	Token     string
+	Number    int64
+	Position  int64 // tickets to be let in before it, itself included, 0 once admitted
+	Admitted  bool
+	ExpiresAt time.Time
+}
+
+// Room lets the visitors of the routes it guards in at its rate.
+type Room struct {
+	key       []byte
+	rate      int
+	ticketTTL time.Duration
+	passTTL   time.Duration
+	queue     Queue
+	clock     clock.Clock
+}
+
+// NewRoom lets rate visitors in per second, across the instances. A ticket holds its place in line for
+// ticketTTL, and once let in its holder goes through for passTTL.
+func NewRoom(key []byte, rate int, ticketTTL, passTTL time.Duration, queue Queue, clk clock.Clock) *Room {
+	return &Room{key: key, rate: max(rate, 1), ticketTTL: ticketTTL, passTTL: passTTL, queue: queue, clock: clk}
+}
+
+// Enter returns the ticket of the visitor holding token. A visitor without a valid ticket, like one
+// whose ticket expired or was forged, joins the back of the line.
+func (r *Room) Enter(ctx context.Context, token string) (*Ticket, error) {
+	now := r.clock.Now()
+
+	if ticket, ok := r.parse(token, now); ok {
+		if ticket.Admitted {
+			return ticket, nil
+		}
+
+		admitted, err := r.queue.Admitted(ctx, r.rate, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check waiting room: %w", err)
+		}
+
+		return r.place(ticket.Number, admitted, ticket.ExpiresAt, now), nil
+	}
+
+	number, admitted, err := r.queue.Join(ctx, r.rate, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join waiting room: %w", err)
+	}
+
+	return r.place(number, admitted, now.Add(r.ticketTTL), now), nil
+}
+
+// Wait is how long the holder of a ticket still in line is expected to wait, at the rate of the room.
+func (r *Room) Wait(ticket *Ticket) time.Duration {
+	return time.Duration(math.Ceil(float64(ticket.Position)/float64(r.rate))) * time.Second
+}
+
+// place signs the ticket, turned into a pass once the line has reached it.
+func (r *Room) place(number, admitted int64, expiresAt, now time.Time) *Ticket {
+	ticket := &Ticket{Number: number, ExpiresAt: expiresAt}
+
+	if number <= admitted {
+		ticket.Admitted = true
+		ticket.ExpiresAt = now.Add(r.passTTL)
+	} else {
+		ticket.Position = number - admitted
+	}
+
+	ticket.Token = r.sign(ticket)
+
+	return ticket
+}
+
+// sign encodes the ticket as "<number>.<expiry>.<q|a>.<signature>", a queued or admitted one.
+func (r *Room) sign(ticket *Ticket) string {
+	state := "q"
+	if ticket.Admitted {
+		state = "a"
+	}
+
+	claims := strconv.FormatInt(ticket.Number, 10) + "." + strconv.FormatInt(ticket.ExpiresAt.Unix(), 10) + "." + state
+
+	return claims + "." + base64.RawURLEncoding.EncodeToString(r.signature(claims))
+}
+
+func (r *Room) signature(claims string) []byte {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(claims))
+
+	return mac.Sum(nil)[:ticketSignatureSize]
+}
+
+// parse returns the ticket of a token signed by the room and not expired yet.
+func (r *Room) parse(token string, now time.Time) (*Ticket, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return nil, false
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[3])
+	if err != nil || !hmac.Equal(signature, r.signature(strings.Join(parts[:3], "."))) {
+		return nil, false
+	}
+
+	number, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !now.Before(time.Unix(expiry, 0)) {
+		return nil, false
+	}
+
+	return &Ticket{Token: token, Number: number, Admitted: parts[2] == "a", ExpiresAt: time.Unix(expiry, 0)}, true
+}
@@ -0,0 +1,154 @@
+package waitingroom_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/waitingroom"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/waitingroom/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+var testKey = []byte("waiting-room-key")
+
+func TestRoom_Enter(t *testing.T) {
+	t.Run("Success - New visitor joins the back of the line", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clock.NewFake(testNow))
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(125), int64(100), nil).Once()
+
+		// Act
+		ticket, err := room.Enter(t.Context(), "")
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, ticket.Admitted)
+		assert.Equal(t, int64(25), ticket.Position)
+		assert.Equal(t, testNow.Add(time.Hour), ticket.ExpiresAt)
+		assert.Equal(t, 3*time.Second, room.Wait(ticket))
+	})
+
+	t.Run("Success - Quiet room lets the visitor straight in", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clock.NewFake(testNow))
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(7), int64(16), nil).Once()
+
+		// Act
+		ticket, err := room.Enter(t.Context(), "")
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, ticket.Admitted)
+		assert.Equal(t, testNow.Add(15*time.Minute), ticket.ExpiresAt)
+	})
+
+	t.Run("Success - Ticket reached by the line becomes a pass", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		clk := clock.NewFake(testNow)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clk)
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(125), int64(100), nil).Once()
+
+		queued, err := room.Enter(t.Context(), "")
+		require.NoError(t, err)
+
+		clk.Advance(3 * time.Second)
+		queue.On("Admitted", mock.Anything, 10, testNow.Add(3*time.Second)).Return(int64(130), nil).Once()
+
+		// Act
+		ticket, err := room.Enter(t.Context(), queued.Token)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, ticket.Admitted)
+		assert.Equal(t, int64(125), ticket.Number)
+		assert.NotEqual(t, queued.Token, ticket.Token)
+	})
+
+	t.Run("Success - Pass goes through without the line", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		clk := clock.NewFake(testNow)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clk)
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(1), int64(11), nil).Once()
+
+		pass, err := room.Enter(t.Context(), "")
+		require.NoError(t, err)
+
+		clk.Advance(10 * time.Minute)
+
+		// Act
+		ticket, err := room.Enter(t.Context(), pass.Token)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, ticket.Admitted)
+		queue.AssertNotCalled(t, "Admitted", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Expired pass joins the line again", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		clk := clock.NewFake(testNow)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clk)
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(1), int64(11), nil).Once()
+
+		pass, err := room.Enter(t.Context(), "")
+		require.NoError(t, err)
+
+		clk.Advance(16 * time.Minute)
+		queue.On("Join", mock.Anything, 10, testNow.Add(16*time.Minute)).Return(int64(900), int64(500), nil).Once()
+
+		// Act
+		ticket, err := room.Enter(t.Context(), pass.Token)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, ticket.Admitted)
+		assert.Equal(t, int64(400), ticket.Position)
+	})
+
+	t.Run("Success - Forged pass joins the line", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clock.NewFake(testNow))
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(125), int64(100), nil).Once()
+
+		queued, err := room.Enter(t.Context(), "")
+		require.NoError(t, err)
+
+		forged := strings.Replace(queued.Token, ".q.", ".a.", 1)
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(126), int64(100), nil).Once()
+
+		// Act
+		ticket, err := room.Enter(t.Context(), forged)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, ticket.Admitted)
+		assert.Equal(t, int64(126), ticket.Number)
+	})
+
+	t.Run("Failure - Queue unavailable", func(t *testing.T) {
+		// Arrange
+		queue := mocks.NewMockQueue(t)
+		room := waitingroom.NewRoom(testKey, 10, time.Hour, 15*time.Minute, queue, clock.NewFake(testNow))
+		queue.On("Join", mock.Anything, 10, testNow).Return(int64(0), int64(0), errors.New("connection refused")).Once()
+
+		// Act
+		ticket, err := room.Enter(t.Context(), "")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, ticket)
+	})
+}
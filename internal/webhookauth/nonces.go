@@ -0,0 +1,46 @@
+package webhookauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// nonceKeyPrefix keeps the nonces apart from the other keys of the Redis instance.
+const nonceKeyPrefix = "webhook_nonce:"
+
+// NonceStore remembers the deliveries already received, it is shared by the instances so a replay is
+// caught whichever one it reaches.
+type NonceStore interface {
+	// Claim records the nonce for ttl, it reports false when the nonce was already recorded.
+	Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+	// Release forgets the nonce, so a delivery that could not be processed can be redelivered as is.
+	Release(ctx context.Context, nonce string) error
+}
+
+type redisNonceStore struct {
+	client *redis.Client
+}
+
+func NewRedisNonceStore(client *redis.Client) NonceStore {
+	return &redisNonceStore{client: client}
+}
+
+func (s *redisNonceStore) Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	first, err := s.client.SetNX(ctx, nonceKeyPrefix+nonce, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim webhook nonce: %w", err)
+	}
+
+	return first, nil
+}
+
+func (s *redisNonceStore) Release(ctx context.Context, nonce string) error {
+	if err := s.client.Del(ctx, nonceKeyPrefix+nonce).Err(); err != nil {
+		return fmt.Errorf("failed to release webhook nonce: %w", err)
+	}
+
+	return nil
+}
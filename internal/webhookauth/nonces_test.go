@@ -0,0 +1,68 @@
+package webhookauth_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisNonceStore(t *testing.T) {
+	client, redisMock := redismock.NewClientMock()
+	nonces := webhookauth.NewRedisNonceStore(client)
+
+	t.Run("Success - First claim", func(t *testing.T) {
+		// Arrange
+		redisMock.ExpectSetNX("webhook_nonce:carrier:abc", 1, 10*time.Minute).SetVal(true)
+
+		// Act
+		first, err := nonces.Claim(t.Context(), "carrier:abc", 10*time.Minute)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, first)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Nonce already claimed", func(t *testing.T) {
+		// Arrange
+		redisMock.ExpectSetNX("webhook_nonce:carrier:abc", 1, 10*time.Minute).SetVal(false)
+
+		// Act
+		first, err := nonces.Claim(t.Context(), "carrier:abc", 10*time.Minute)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, first)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Failure - Redis error", func(t *testing.T) {
+		// Arrange
+		redisMock.ExpectSetNX("webhook_nonce:carrier:abc", 1, 10*time.Minute).SetErr(errors.New("connection refused"))
+
+		// Act
+		first, err := nonces.Claim(t.Context(), "carrier:abc", 10*time.Minute)
+
+		// Assert
+		require.Error(t, err)
+		assert.False(t, first)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Release", func(t *testing.T) {
+		// Arrange
+		redisMock.ExpectDel("webhook_nonce:carrier:abc").SetVal(1)
+
+		// Act
+		err := nonces.Release(t.Context(), "carrier:abc")
+
+		// Assert
+		require.NoError(t, err)
+		assert.NoError(t, redisMock.ExpectationsWereMet())
+	})
+}
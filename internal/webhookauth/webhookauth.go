@@ -0,0 +1,197 @@
+// Package webhookauth verifies the deliveries of partners signing their webhooks the way Stripe does: an
+// HMAC-SHA256 of "<timestamp>.<payload>" sent as "t=<timestamp>,v1=<hex signature>". The timestamp bounds
+// how old a delivery may be, and every signature is accepted once only, so a captured request can't be
+// replayed either later on or within the tolerance.
+package webhookauth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+)
+
+// SignatureHeader carries the signature of a partner delivery.
+const SignatureHeader = "X-Webhook-Signature"
+
+// DefaultTolerance is how far the timestamp of a delivery may be from now, either way.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrMissingSignature = errors.New("missing webhook signature")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrStaleTimestamp   = errors.New("webhook timestamp outside of the tolerance")
+	ErrReplayed         = errors.New("webhook delivery already received")
+)
+
+// Verifier checks the deliveries of one partner.
+type Verifier struct {
+	name      string
+	secrets   [][]byte
+	tolerance time.Duration
+	nonces    NonceStore
+	clock     clock.Clock
+}
+
+// NewVerifier takes the secrets shared with the partner, several while one is being rotated out. The
+// name keeps the nonces of partners apart, nil nonces turn the replay check off.
+func NewVerifier(name string, secrets []string, tolerance time.Duration, nonces NonceStore, clk clock.Clock) *Verifier {
+	keys := make([][]byte, 0, len(secrets))
+
+	for _, secret := range secrets {
+		if secret != "" {
+			keys = append(keys, []byte(secret))
+		}
+	}
+
+	return &Verifier{name: name, secrets: keys, tolerance: tolerance, nonces: nonces, clock: clk}
+}
+
+// Name is the partner the verifier checks the deliveries of.
+func (v *Verifier) Name() string {
+	return v.name
+}
+
+// Verify accepts a delivery signed with one of the secrets within the tolerance, and only the first time
+// it is seen.
+func (v *Verifier) Verify(ctx context.Context, payload []byte, header string) error {
+	if header == "" {
+		return ErrMissingSignature
+	}
+
+	timestamp, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if len(v.secrets) == 0 {
+		return fmt.Errorf("%w: no secret configured", ErrInvalidSignature)
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if age := v.clock.Now().Sub(signedAt); age > v.tolerance || age < -v.tolerance {
+		return ErrStaleTimestamp
+	}
+
+	matched := v.match(timestamp, payload, signatures)
+	if matched == nil {
+		return ErrInvalidSignature
+	}
+
+	if v.nonces == nil {
+		return nil
+	}
+
+	// Past twice the tolerance the timestamp alone refuses the delivery, the nonce can go
+	first, err := v.nonces.Claim(ctx, v.nonce(matched), 2*v.tolerance)
+	if err != nil {
+		return fmt.Errorf("failed to check webhook nonce: %w", err)
+	}
+
+	if !first {
+		return ErrReplayed
+	}
+
+	return nil
+}
+
+// Forget lets a verified delivery be received again, for one that could not be processed and that the
+// partner will redeliver as is.
+func (v *Verifier) Forget(ctx context.Context, header string) error {
+	if v.nonces == nil {
+		return nil
+	}
+
+	_, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	for _, signature := range signatures {
+		if err := v.nonces.Release(ctx, v.nonce(signature)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) nonce(signature []byte) string {
+	return v.name + ":" + hex.EncodeToString(signature)
+}
+
+// match returns the first of the signatures made with one of the secrets.
+func (v *Verifier) match(timestamp int64, payload []byte, signatures [][]byte) []byte {
+	for _, secret := range v.secrets {
+		expected := computeSignature(secret, timestamp, payload)
+
+		for _, signature := range signatures {
+			if hmac.Equal(expected, signature) {
+				return signature
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sign builds the signature header of a delivery, for partners and tests.
+func Sign(secret string, payload []byte, at time.Time) string {
+	timestamp := at.Unix()
+
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(computeSignature([]byte(secret), timestamp, payload)))
+}
+
+func computeSignature(secret []byte, timestamp int64, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// parseHeader reads "t=<timestamp>,v1=<signature>[,v1=<signature>...]", unknown schemes are skipped.
+func parseHeader(header string) (int64, [][]byte, error) {
+	var (
+		timestamp  int64
+		signatures [][]byte
+	)
+
+	for _, pair := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return 0, nil, fmt.Errorf("%w: malformed header", ErrInvalidSignature)
+		}
+
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: invalid timestamp", ErrInvalidSignature)
+			}
+
+			timestamp = parsed
+		case "v1":
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				continue
+			}
+
+			signatures = append(signatures, signature)
+		}
+	}
+
+	if timestamp == 0 || len(signatures) == 0 {
+		return 0, nil, fmt.Errorf("%w: missing timestamp or signature", ErrInvalidSignature)
+	}
+
+	return timestamp, signatures, nil
+}
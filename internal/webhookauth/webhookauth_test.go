@@ -0,0 +1,148 @@
+package webhookauth_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/clock"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/webhookauth/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+var testPayload = []byte(`{"event":"delivered"}`)
+
+func TestVerifier_Verify(t *testing.T) {
+	verifier := webhookauth.NewVerifier("carrier", []string{"current", "previous"}, webhookauth.DefaultTolerance, nil, clock.NewFake(testNow))
+
+	tests := []struct {
+		name    string
+		header  string
+		payload []byte
+		wantErr error
+	}{
+		{"Success - Current secret", webhookauth.Sign("current", testPayload, testNow), testPayload, nil},
+		{"Success - Secret being rotated out", webhookauth.Sign("previous", testPayload, testNow), testPayload, nil},
+		{"Success - Timestamp within the tolerance", webhookauth.Sign("current", testPayload, testNow.Add(-4*time.Minute)), testPayload, nil},
+		{"Success - One of several signatures", webhookauth.Sign("current", testPayload, testNow) + ",v1=00ff", testPayload, nil},
+		{"Success - Unknown scheme is skipped", webhookauth.Sign("current", testPayload, testNow) + ",v0=abc", testPayload, nil},
+		{"Failure - Missing header", "", testPayload, webhookauth.ErrMissingSignature},
+		{"Failure - Other secret", webhookauth.Sign("guess", testPayload, testNow), testPayload, webhookauth.ErrInvalidSignature},
+		{"Failure - Tampered payload", webhookauth.Sign("current", testPayload, testNow), []byte(`{"event":"bounce"}`), webhookauth.ErrInvalidSignature},
+		{"Failure - Timestamp moved", strings.Replace(webhookauth.Sign("current", testPayload, testNow), fmt.Sprint(testNow.Unix()), fmt.Sprint(testNow.Unix()+1), 1), testPayload, webhookauth.ErrInvalidSignature},
+		{"Failure - Too old", webhookauth.Sign("current", testPayload, testNow.Add(-6*time.Minute)), testPayload, webhookauth.ErrStaleTimestamp},
+		{"Failure - In the future", webhookauth.Sign("current", testPayload, testNow.Add(6*time.Minute)), testPayload, webhookauth.ErrStaleTimestamp},
+		{"Failure - Malformed header", "garbage", testPayload, webhookauth.ErrInvalidSignature},
+		{"Failure - Missing timestamp", "v1=00ff", testPayload, webhookauth.ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := verifier.Verify(t.Context(), tt.payload, tt.header)
+
+			// Assert
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("Failure - No secret configured", func(t *testing.T) {
+		// Arrange
+		unconfigured := webhookauth.NewVerifier("carrier", []string{""}, webhookauth.DefaultTolerance, nil, clock.NewFake(testNow))
+
+		// Act
+		err := unconfigured.Verify(t.Context(), testPayload, webhookauth.Sign("", testPayload, testNow))
+
+		// Assert
+		require.ErrorIs(t, err, webhookauth.ErrInvalidSignature)
+	})
+}
+
+func TestVerifier_Replay(t *testing.T) {
+	header := webhookauth.Sign("current", testPayload, testNow)
+
+	t.Run("Success - First delivery claims its nonce for twice the tolerance", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"current"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(testNow))
+		nonces.On("Claim", mock.Anything, mock.MatchedBy(func(nonce string) bool { return strings.HasPrefix(nonce, "carrier:") }), 10*time.Minute).Return(true, nil).Once()
+
+		// Act
+		err := verifier.Verify(t.Context(), testPayload, header)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Replayed delivery", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"current"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(testNow))
+		nonces.On("Claim", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+
+		// Act
+		err := verifier.Verify(t.Context(), testPayload, header)
+
+		// Assert
+		require.ErrorIs(t, err, webhookauth.ErrReplayed)
+	})
+
+	t.Run("Failure - Nonce store unavailable", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"current"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(testNow))
+		nonces.On("Claim", mock.Anything, mock.Anything, mock.Anything).Return(false, errors.New("connection refused")).Once()
+
+		// Act
+		err := verifier.Verify(t.Context(), testPayload, header)
+
+		// Assert
+		require.Error(t, err)
+		require.NotErrorIs(t, err, webhookauth.ErrReplayed)
+	})
+
+	t.Run("Success - Invalid signature claims no nonce", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"current"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(testNow))
+
+		// Act
+		err := verifier.Verify(t.Context(), testPayload, webhookauth.Sign("guess", testPayload, testNow))
+
+		// Assert
+		require.ErrorIs(t, err, webhookauth.ErrInvalidSignature)
+		nonces.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Forget releases the nonce of the delivery", func(t *testing.T) {
+		// Arrange
+		nonces := mocks.NewMockNonceStore(t)
+		verifier := webhookauth.NewVerifier("carrier", []string{"current"}, webhookauth.DefaultTolerance, nonces, clock.NewFake(testNow))
+
+		var claimed string
+
+		nonces.On("Claim", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) { claimed = args.String(1) }).Return(true, nil).Once()
+		require.NoError(t, verifier.Verify(t.Context(), testPayload, header))
+
+		nonces.On("Release", mock.Anything, mock.Anything).Return(nil).Once()
+
+		// Act
+		err := verifier.Forget(t.Context(), header)
+
+		// Assert
+		require.NoError(t, err)
+		nonces.AssertCalled(t, "Release", mock.Anything, claimed)
+	})
+}
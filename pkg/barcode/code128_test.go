@@ -0,0 +1,71 @@
+package barcode_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/barcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bars renders modules as the widths of their alternating bars and spaces, like the symbol tables.
+func bars(modules []bool) string {
+	var b strings.Builder
+
+	for start := 0; start < len(modules); {
+		end := start
+		for end < len(modules) && modules[end] == modules[start] {
+			end++
+		}
+
+		b.WriteByte(byte('0' + end - start))
+		start = end
+	}
+
+	return b.String()
+}
+
+func TestCode128(t *testing.T) {
+	t.Run("Success - Start, data, checksum and stop", func(t *testing.T) {
+		// Act
+		modules, err := barcode.Code128("A")
+
+		// Assert
+		require.NoError(t, err)
+		// start B, "A" (33), checksum (104 + 33) % 103 = 34, stop
+		assert.Equal(t, "211214"+"111323"+"131123"+"2331112", bars(modules))
+		assert.True(t, modules[0], "the symbol starts with a bar")
+		assert.True(t, modules[len(modules)-1], "the symbol ends with a bar")
+	})
+
+	t.Run("Success - Width grows by a symbol per character", func(t *testing.T) {
+		// Act
+		modules, err := barcode.Code128("SKU-000123")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, modules, 11*(len("SKU-000123")+2)+13)
+	})
+
+	t.Run("Failure - Not printable ASCII", func(t *testing.T) {
+		for _, data := range []string{"", "tab\there", "café"} {
+			// Act
+			_, err := barcode.Code128(data)
+
+			// Assert
+			require.ErrorIs(t, err, barcode.ErrUnencodable, data)
+		}
+	})
+}
+
+func TestSVG(t *testing.T) {
+	// Act
+	svg, err := barcode.SVG("A", 40)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 66 40"`), "46 modules and the quiet zones")
+	assert.Contains(t, svg, `M10 0h2v40h-2z`, "the first bar of the start symbol follows the quiet zone")
+	assert.Equal(t, 13, strings.Count(svg, "M"), "a rectangle per bar")
+}
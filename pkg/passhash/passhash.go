@@ -0,0 +1,134 @@
+// Package passhash hashes passwords with Argon2id and still verifies the bcrypt hashes stored before,
+// so they can be replaced the next time their password is entered.
+//
+// Hashes use the PHC string format, $argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>, and carry their own
+// parameters: a hash made with older parameters keeps verifying and is reported as needing a rehash.
+package passhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	saltLength = 16
+	keyLength  = 32
+)
+
+// ErrInvalidHash is returned for a stored hash that is neither Argon2id nor bcrypt.
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// Params tune the cost of Argon2id. Memory is in KiB; raising any of them slows an attacker down as
+// much as it slows a login down, see BenchmarkHash to pick them for a latency budget.
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// DefaultParams is the second recommended option of RFC 9106, for hosts without 2 GiB per login.
+var DefaultParams = Params{Memory: 64 * 1024, Iterations: 3, Parallelism: 4}
+
+type Hasher struct {
+	params Params
+}
+
+// New refuses parameters argon2 cannot run with: Argon2id needs at least one iteration and one lane,
+// and 8 KiB of memory per lane.
+func New(params Params) (*Hasher, error) {
+	if params.Iterations < 1 || params.Parallelism < 1 || params.Memory < 8*uint32(params.Parallelism) {
+		return nil, fmt.Errorf("invalid Argon2id parameters m=%d,t=%d,p=%d", params.Memory, params.Iterations, params.Parallelism)
+	}
+
+	return &Hasher{params: params}, nil
+}
+
+// Hash returns the Argon2id hash of the password with a random salt.
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, keyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify tells whether the password matches the hash, and whether the hash should be replaced by a new
+// Hash of the password because it is a bcrypt hash or was made with other parameters.
+func (h *Hasher) Verify(password, hash string) (bool, bool, error) {
+	if isBcrypt(hash) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+
+		if err != nil {
+			return false, false, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+		}
+
+		return true, true, nil
+	}
+
+	params, salt, key, err := decode(hash)
+	if err != nil {
+		return false, false, err
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key))) //nolint:gosec // len(key) is checked by decode
+
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, false, nil
+	}
+
+	return true, params != h.params, nil
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func decode(hash string) (Params, []byte, []byte, error) {
+	var params Params
+
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidHash, parts[2])
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+
+	// argon2 panics on these
+	if params.Iterations < 1 || params.Parallelism < 1 {
+		return params, nil, nil, fmt.Errorf("%w: invalid parameters %q", ErrInvalidHash, parts[3])
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 || len(key) > 1024 {
+		return params, nil, nil, fmt.Errorf("%w: invalid key", ErrInvalidHash)
+	}
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,181 @@
+package passhash_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/passhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testParams keep the tests fast, they are far too weak for production.
+var testParams = passhash.Params{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestHasher_Argon2id(t *testing.T) {
+	hasher, err := passhash.New(testParams)
+	require.NoError(t, err)
+
+	hash, err := hasher.Hash("correct horse")
+	require.NoError(t, err)
+
+	t.Run("Success - Hash is a PHC string", func(t *testing.T) {
+		// Assert
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"), hash)
+	})
+
+	t.Run("Success - Hashes are salted", func(t *testing.T) {
+		// Act
+		other, err := hasher.Hash("correct horse")
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, other)
+	})
+
+	t.Run("Success - Matching password", func(t *testing.T) {
+		// Act
+		match, rehash, err := hasher.Verify("correct horse", hash)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.False(t, rehash)
+	})
+
+	t.Run("Success - Wrong password", func(t *testing.T) {
+		// Act
+		match, rehash, err := hasher.Verify("battery staple", hash)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, match)
+		assert.False(t, rehash)
+	})
+
+	t.Run("Success - Other parameters ask for a rehash", func(t *testing.T) {
+		// Arrange
+		stronger, err := passhash.New(passhash.Params{Memory: 128, Iterations: 2, Parallelism: 1})
+		require.NoError(t, err)
+
+		// Act
+		match, rehash, err := stronger.Verify("correct horse", hash)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, rehash)
+	})
+}
+
+func TestHasher_Bcrypt(t *testing.T) {
+	hasher, err := passhash.New(testParams)
+	require.NoError(t, err)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	t.Run("Success - Legacy hash matches and asks for a rehash", func(t *testing.T) {
+		// Act
+		match, rehash, err := hasher.Verify("correct horse", string(hash))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, rehash)
+	})
+
+	t.Run("Success - Wrong password", func(t *testing.T) {
+		// Act
+		match, rehash, err := hasher.Verify("battery staple", string(hash))
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, match)
+		assert.False(t, rehash)
+	})
+}
+
+func TestNew_InvalidParams(t *testing.T) {
+	for _, params := range []passhash.Params{
+		{Memory: 64, Iterations: 0, Parallelism: 1},
+		{Memory: 64, Iterations: 1, Parallelism: 0},
+		{Memory: 16, Iterations: 1, Parallelism: 4},
+	} {
+		// Act
+		hasher, err := passhash.New(params)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, hasher)
+	}
+}
+
+func TestHasher_InvalidHash(t *testing.T) {
+	hasher, err := passhash.New(testParams)
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"Empty":               "",
+		"Unknown algorithm":   "$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5",
+		"Unsupported version": "$argon2id$v=16$m=64,t=1,p=1$c2FsdA$a2V5",
+		"Zero iterations":     "$argon2id$v=19$m=64,t=0,p=1$c2FsdA$a2V5",
+		"Bad key":             "$argon2id$v=19$m=64,t=1,p=1$c2FsdA$!!",
+		"Truncated bcrypt":    "$2a$10$short",
+	}
+
+	for name, hash := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			match, _, err := hasher.Verify("correct horse", hash)
+
+			// Assert
+			require.ErrorIs(t, err, passhash.ErrInvalidHash)
+			assert.False(t, match)
+		})
+	}
+}
+
+// BenchmarkHash times a hash, which is the cost of a login, for a range of parameters. Pick the
+// strongest ones whose ns/op fits the latency budget on the production hardware:
+//
+//	go test -run '^$' -bench Hash -benchmem ./pkg/passhash
+func BenchmarkHash(b *testing.B) {
+	for _, memory := range []uint32{19 * 1024, 46 * 1024, 64 * 1024, 128 * 1024} {
+		for _, iterations := range []uint32{1, 2, 3} {
+			for _, parallelism := range []uint8{1, 4} {
+				params := passhash.Params{Memory: memory, Iterations: iterations, Parallelism: parallelism}
+
+				b.Run(fmt.Sprintf("m=%dMiB,t=%d,p=%d", memory/1024, iterations, parallelism), func(b *testing.B) {
+					hasher, err := passhash.New(params)
+					require.NoError(b, err)
+
+					for range b.N {
+						if _, err := hasher.Hash("correct horse battery staple"); err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+// BenchmarkVerifyBcrypt is the cost of a login with a legacy hash, before it is replaced.
+func BenchmarkVerifyBcrypt(b *testing.B) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse battery staple"), bcrypt.DefaultCost)
+	require.NoError(b, err)
+
+	hasher, err := passhash.New(passhash.DefaultParams)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for range b.N {
+		if _, _, err := hasher.Verify("correct horse battery staple", string(hash)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
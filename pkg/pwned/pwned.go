@@ -0,0 +1,83 @@
+// Package pwned looks passwords up in the Have I Been Pwned breach corpus through its k-anonymity range
+// API, only the first five characters of the SHA-1 of a password ever leave the service.
+package pwned
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // the range API is keyed by SHA-1, nothing is protected with it
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DefaultURL is the public Pwned Passwords API.
+const DefaultURL = "https://api.pwnedpasswords.com"
+
+const prefixLength = 5
+
+// Client tells how many times a password appears in known breaches, 0 when it does not.
+type Client interface {
+	BreachCount(ctx context.Context, password string) (int, error)
+}
+
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewClient(baseURL string, httpClient *http.Client) Client {
+	return &client{baseURL: strings.TrimRight(baseURL, "/"), httpClient: httpClient}
+}
+
+// BreachCount fetches every breached hash sharing the prefix of the password and looks for its suffix.
+// The response is padded with fake hashes, which have a count of 0, so its size tells nothing either.
+func (c *client) BreachCount(ctx context.Context, password string) (int, error) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // see the import
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/range/"+prefix, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build Pwned Passwords request: %w", err)
+	}
+
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to call Pwned Passwords: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+		return 0, fmt.Errorf("pwned passwords returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	// every line is SUFFIX:COUNT
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || lineSuffix != suffix {
+			continue
+		}
+
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Pwned Passwords count %q: %w", count, err)
+		}
+
+		return n, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read Pwned Passwords response: %w", err)
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,65 @@
+package pwned_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/pwned"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8.
+func TestClient_BreachCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+
+		if r.URL.Path != "/range/5BAA6" {
+			http.Error(w, "unexpected prefix", http.StatusBadRequest)
+
+			return
+		}
+
+		fmt.Fprint(w, "003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n011053FD0102E94D6AE2F8B83D76FAF94F6:0\r\n")
+	}))
+	defer server.Close()
+
+	client := pwned.NewClient(server.URL+"/", server.Client())
+
+	t.Run("Success - Breached password", func(t *testing.T) {
+		// Act
+		count, err := client.BreachCount(t.Context(), "password")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 9659365, count)
+	})
+
+	t.Run("Failure - Unexpected status", func(t *testing.T) {
+		// Act
+		count, err := client.BreachCount(t.Context(), "correct horse battery staple")
+
+		// Assert
+		require.ErrorContains(t, err, "returned 400")
+		assert.Zero(t, count)
+	})
+}
+
+func TestClient_BreachCount_NotBreached(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "003D68EB55068C33ACE09247EE4C639306B:3\r\n011053FD0102E94D6AE2F8B83D76FAF94F6:0\r\n")
+	}))
+	defer server.Close()
+
+	client := pwned.NewClient(server.URL, server.Client())
+
+	// Act
+	count, err := client.BreachCount(t.Context(), "password")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,202 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storage"
+	"github.com/sendgrid/sendgrid-go"
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+)
+
+var ErrAttachmentRejected = errors.New("attachment rejected")
+
+// AttachmentOptions limits the attachments of an email, SendGrid itself rejects messages above 30MB.
+type AttachmentOptions struct {
+	Store        storage.ObjectStore
+	MaxSize      int64 // per attachment
+	MaxTotalSize int64
+	AllowedTypes []string
+}
+
+type resolvedAttachment struct {
+	key         string
+	filename    string
+	contentType string
+	size        int64
+}
+
+// ValidateAttachments implements EmailService.
+func (e *emailService) ValidateAttachments(ctx context.Context, attachments []models.EmailAttachment) error {
+	_, err := e.resolveAttachments(ctx, attachments)
+
+	return err
+}
+
+func (e *emailService) resolveAttachments(ctx context.Context, attachments []models.EmailAttachment) ([]resolvedAttachment, error) {
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+
+	if e.attachments.Store == nil {
+		return nil, fmt.Errorf("%w: no object storage configured", ErrAttachmentRejected)
+	}
+
+	resolved := make([]resolvedAttachment, 0, len(attachments))
+
+	var total int64
+
+	for _, attachment := range attachments {
+		if attachment.Filename == "" {
+			return nil, fmt.Errorf("%w: %s has no filename", ErrAttachmentRejected, attachment.Key)
+		}
+
+		info, err := e.attachments.Store.Stat(ctx, attachment.Key)
+		if err != nil {
+			if errors.Is(err, storage.ErrObjectNotFound) {
+				return nil, fmt.Errorf("%w: %w", ErrAttachmentRejected, err)
+			}
+
+			return nil, err
+		}
+
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = info.ContentType
+		}
+
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || !slices.Contains(e.attachments.AllowedTypes, mediaType) {
+			return nil, fmt.Errorf("%w: type %q of %s is not allowed", ErrAttachmentRejected, contentType, attachment.Filename)
+		}
+
+		if info.Size > e.attachments.MaxSize {
+			return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrAttachmentRejected, attachment.Filename, e.attachments.MaxSize)
+		}
+
+		total += info.Size
+		if total > e.attachments.MaxTotalSize {
+			return nil, fmt.Errorf("%w: attachments are larger than %d bytes in total", ErrAttachmentRejected, e.attachments.MaxTotalSize)
+		}
+
+		resolved = append(resolved, resolvedAttachment{key: attachment.Key, filename: attachment.Filename, contentType: mediaType, size: info.Size})
+	}
+
+	return resolved, nil
+}
+
+// sendWithAttachments streams the message to SendGrid, the attachments are base64 encoded
+// on the fly while they are read from storage instead of being buffered in memory.
+func (e *emailService) sendWithAttachments(ctx context.Context, message *mail.SGMailV3, attachments []resolvedAttachment) error {
+	body := mail.GetRequestBody(message)
+	if len(body) == 0 || body[len(body)-1] != '}' {
+		return errors.New("failed to encode email")
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(e.writeAttachments(ctx, pw, body, attachments))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.client.BaseURL, pr)
+	if err != nil {
+		pr.Close()
+
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for name, value := range e.client.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := sendgrid.DefaultClient.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("failed to send email, status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// writeAttachments writes body, a JSON object, with the attachments array spliced in before its closing brace.
+func (e *emailService) writeAttachments(ctx context.Context, w io.Writer, body []byte, attachments []resolvedAttachment) error {
+	if _, err := w.Write(body[:len(body)-1]); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, `,"attachments":[`); err != nil {
+		return err
+	}
+
+	for i, attachment := range attachments {
+		header, err := json.Marshal(struct {
+			Filename    string `json:"filename"`
+			Type        string `json:"type"`
+			Disposition string `json:"disposition"`
+		}{attachment.filename, attachment.contentType, "attachment"})
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			header = append([]byte{','}, header...)
+		}
+
+		if _, err := w.Write(header[:len(header)-1]); err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(w, `,"content":"`); err != nil {
+			return err
+		}
+
+		if err := e.copyAttachment(ctx, w, attachment); err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(w, `"}`); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]}")
+
+	return err
+}
+
+func (e *emailService) copyAttachment(ctx context.Context, w io.Writer, attachment resolvedAttachment) error {
+	r, err := e.attachments.Store.Open(ctx, attachment.key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	encoder := base64.NewEncoder(base64.StdEncoding, w)
+
+	// the object may have grown since it was checked against the limits
+	n, err := io.Copy(encoder, io.LimitReader(r, attachment.size+1))
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %s: %w", attachment.filename, err)
+	}
+
+	if n > attachment.size {
+		return fmt.Errorf("%w: %s changed while it was being sent", ErrAttachmentRejected, attachment.filename)
+	}
+
+	return encoder.Close()
+}
@@ -0,0 +1,114 @@
+package sendgrid_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	sendgrid_client "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailService_Attachments(t *testing.T) {
+	dir := t.TempDir()
+	invoice := strings.Repeat("%PDF-1.7 invoice ", 100)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "inv-1.pdf"), []byte(invoice), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "script.sh"), []byte("#!/bin/sh"), 0o600))
+
+	store, err := storage.NewFileStore(dir)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	options := sendgrid_client.AttachmentOptions{
+		Store:        store,
+		MaxSize:      4096,
+		MaxTotalSize: 4096,
+		AllowedTypes: []string{"application/pdf"},
+	}
+
+	t.Run("Success - Attachments are streamed", func(t *testing.T) {
+		// Arrange
+		var payload struct {
+			Personalizations []json.RawMessage `json:"personalizations"`
+			Attachments      []struct {
+				Filename    string `json:"filename"`
+				Type        string `json:"type"`
+				Disposition string `json:"disposition"`
+				Content     string `json:"content"`
+			} `json:"attachments"`
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer SG.test-api-key", r.Header.Get("Authorization"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		service := sendgrid_client.NewEmailService("SG.test-api-key", "from@example.com", "Test Sender", "", options)
+		service.GetSendGridClient().BaseURL = server.URL
+
+		req := &models.EmailNotificationRequest{
+			To:          "recipient@example.com",
+			Subject:     "Your invoice",
+			Content:     "Please find your invoice attached",
+			Attachments: []models.EmailAttachment{{Key: "inv-1.pdf", Filename: "invoice.pdf"}},
+		}
+
+		// Act
+		err := service.Send(ctx, req)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, payload.Personalizations, 1)
+		require.Len(t, payload.Attachments, 1)
+		assert.Equal(t, "invoice.pdf", payload.Attachments[0].Filename)
+		assert.Equal(t, "application/pdf", payload.Attachments[0].Type)
+		assert.Equal(t, "attachment", payload.Attachments[0].Disposition)
+
+		content, err := base64.StdEncoding.DecodeString(payload.Attachments[0].Content)
+		require.NoError(t, err)
+		assert.Equal(t, invoice, string(content))
+	})
+
+	service := sendgrid_client.NewEmailService("SG.test-api-key", "from@example.com", "Test Sender", "", options)
+
+	tests := []struct {
+		name        string
+		attachments []models.EmailAttachment
+	}{
+		{"Type not allowed", []models.EmailAttachment{{Key: "script.sh", Filename: "script.sh", ContentType: "application/x-sh"}}},
+		{"Missing object", []models.EmailAttachment{{Key: "missing.pdf", Filename: "missing.pdf"}}},
+		{"Missing filename", []models.EmailAttachment{{Key: "inv-1.pdf"}}},
+		{"Total size exceeded", []models.EmailAttachment{{Key: "inv-1.pdf", Filename: "a.pdf"}, {Key: "inv-1.pdf", Filename: "b.pdf"}, {Key: "inv-1.pdf", Filename: "c.pdf"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run("Failure - "+tc.name, func(t *testing.T) {
+			// Act
+			err := service.ValidateAttachments(ctx, tc.attachments)
+
+			// Assert
+			assert.ErrorIs(t, err, sendgrid_client.ErrAttachmentRejected)
+		})
+	}
+
+	t.Run("Failure - No object storage", func(t *testing.T) {
+		// Arrange
+		unconfigured := sendgrid_client.NewEmailService("SG.test-api-key", "from@example.com", "Test Sender", "", sendgrid_client.AttachmentOptions{})
+
+		// Act
+		err := unconfigured.ValidateAttachments(ctx, []models.EmailAttachment{{Key: "inv-1.pdf", Filename: "invoice.pdf"}})
+
+		// Assert
+		assert.ErrorIs(t, err, sendgrid_client.ErrAttachmentRejected)
+	})
+}
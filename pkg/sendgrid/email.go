@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	"github.com/google/uuid"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/sendgrid/sendgrid-go"
 	"github.com/sendgrid/sendgrid-go/helpers/mail"
@@ -13,21 +14,41 @@ import (
 type EmailService interface {
 	Send(ctx context.Context, req *models.EmailNotificationRequest) error
 	GetSendGridClient() *sendgrid.Client
+	ParseEvents(payload []byte, signature, timestamp string) ([]Event, error)
+	ValidateAttachments(ctx context.Context, attachments []models.EmailAttachment) error
 }
 
 type emailService struct {
-	client    *sendgrid.Client
-	fromEmail string
-	fromName  string
+	client           *sendgrid.Client
+	fromEmail        string
+	fromName         string
+	webhookPublicKey string
+	attachments      AttachmentOptions
 }
 
-func NewEmailService(apiKey string, fromEmail string, fromName string) EmailService {
-	return &emailService{client: sendgrid.NewSendClient(apiKey), fromEmail: fromEmail, fromName: fromName}
+func NewEmailService(apiKey string, fromEmail string, fromName string, webhookPublicKey string, attachments AttachmentOptions) EmailService {
+	return &emailService{
+		client:           sendgrid.NewSendClient(apiKey),
+		fromEmail:        fromEmail,
+		fromName:         fromName,
+		webhookPublicKey: webhookPublicKey,
+		attachments:      attachments,
+	}
 }
 
 // Send implements EmailService.
-func (e *emailService) Send(_ context.Context, req *models.EmailNotificationRequest) error {
-	from := mail.NewEmail(e.fromName, e.fromEmail)
+func (e *emailService) Send(ctx context.Context, req *models.EmailNotificationRequest) error {
+	attachments, err := e.resolveAttachments(ctx, req.Attachments)
+	if err != nil {
+		return err
+	}
+
+	fromName := e.fromName
+	if req.FromName != "" {
+		fromName = req.FromName
+	}
+
+	from := mail.NewEmail(fromName, e.fromEmail)
 	to := mail.NewEmail("", req.To)
 
 	message := mail.NewV3Mail()
@@ -45,6 +66,11 @@ func (e *emailService) Send(_ context.Context, req *models.EmailNotificationRequ
 	}
 
 	personalization.Subject = req.Subject
+
+	if req.NotificationID != uuid.Nil {
+		personalization.SetCustomArg(NotificationIDArg, req.NotificationID.String())
+	}
+
 	message.AddPersonalizations(personalization)
 
 	sanitizedPlainText := sanitizeContent(req.Content)
@@ -53,6 +79,10 @@ func (e *emailService) Send(_ context.Context, req *models.EmailNotificationRequ
 	message.AddContent(mail.NewContent("text/plain", sanitizedPlainText))
 	message.AddContent(mail.NewContent("text/html", sanitizedHTMLContent))
 
+	if len(attachments) > 0 {
+		return e.sendWithAttachments(ctx, message, attachments)
+	}
+
 	// send the email
 	response, err := e.client.Send(message)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
 	sendgrid_client "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
+	"github.com/google/uuid"
 	"github.com/sendgrid/sendgrid-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,7 +24,7 @@ func TestNewEmailService(t *testing.T) {
 	fromName := "Test Sender"
 
 	// Act
-	service := sendgrid_client.NewEmailService(apiKey, fromEmail, fromName)
+	service := sendgrid_client.NewEmailService(apiKey, fromEmail, fromName, "", sendgrid_client.AttachmentOptions{})
 
 	// Assert
 	assert.NotNil(t, service)
@@ -31,10 +32,11 @@ func TestNewEmailService(t *testing.T) {
 
 type sendgridV3Payload struct {
 	Personalizations []struct {
-		To      []map[string]string `json:"to"`
-		Cc      []map[string]string `json:"cc,omitempty"`
-		Bcc     []map[string]string `json:"bcc,omitempty"`
-		Subject string              `json:"subject"`
+		To         []map[string]string `json:"to"`
+		Cc         []map[string]string `json:"cc,omitempty"`
+		Bcc        []map[string]string `json:"bcc,omitempty"`
+		Subject    string              `json:"subject"`
+		CustomArgs map[string]string   `json:"custom_args,omitempty"`
 	} `json:"personalizations"`
 	From    map[string]string `json:"from"`
 	Content []struct {
@@ -151,6 +153,40 @@ func TestEmailService_Send(t *testing.T) {
 				assert.Equal(t, "<p>HTML</p>", p.Content[1].Value)
 			},
 		},
+		{
+			name: "Success - Notification ID as custom argument",
+			req: &models.EmailNotificationRequest{
+				To:             "recipient@example.com",
+				Subject:        "Test Subject 5",
+				Content:        "Content",
+				NotificationID: uuid.MustParse("6f1c4f1e-8f43-4b1a-9a52-2f6d8f0b7a11"),
+			},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			},
+			expectedError: "",
+			checkPayload: func(t *testing.T, p sendgridV3Payload) {
+				require.Len(t, p.Personalizations, 1)
+				assert.Equal(t, "6f1c4f1e-8f43-4b1a-9a52-2f6d8f0b7a11", p.Personalizations[0].CustomArgs[sendgrid_client.NotificationIDArg])
+			},
+		},
+		{
+			name: "Success - Sender named after the store",
+			req: &models.EmailNotificationRequest{
+				To:       "recipient@example.com",
+				Subject:  "Test Subject 6",
+				Content:  "Content",
+				FromName: "Acme",
+			},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			},
+			expectedError: "",
+			checkPayload: func(t *testing.T, p sendgridV3Payload) {
+				assert.Equal(t, fromEmail, p.From["email"])
+				assert.Equal(t, "Acme", p.From["name"])
+			},
+		},
 		{
 			name: "Failure - SendGrid API Error (4xx)",
 			req: &models.EmailNotificationRequest{
@@ -191,7 +227,7 @@ func TestEmailService_Send(t *testing.T) {
 
 			startMockServer() // Start the server for this test case
 
-			service := sendgrid_client.NewEmailService(apiKey, fromEmail, fromName)
+			service := sendgrid_client.NewEmailService(apiKey, fromEmail, fromName, "", sendgrid_client.AttachmentOptions{})
 			sgClient := service.GetSendGridClient()
 			sgClient.Request.BaseURL = mockServer.URL
 
@@ -218,7 +254,7 @@ func TestEmailService_Send(t *testing.T) {
 		// Arrange
 		startMockServer()
 
-		service := sendgrid_client.NewEmailService(apiKey, fromEmail, fromName)
+		service := sendgrid_client.NewEmailService(apiKey, fromEmail, fromName, "", sendgrid_client.AttachmentOptions{})
 		sgClient := service.GetSendGridClient()
 		sgClient.Request.BaseURL = mockServer.URL
 		mockServer.Close()
@@ -246,6 +282,14 @@ func (e *testEmailService) Send(_ context.Context, _ *models.EmailNotificationRe
 	return nil
 }
 
+func (e *testEmailService) ParseEvents(_ []byte, _, _ string) ([]sendgrid_client.Event, error) {
+	return nil, nil
+}
+
+func (e *testEmailService) ValidateAttachments(_ context.Context, _ []models.EmailAttachment) error {
+	return nil
+}
+
 func (e *testEmailService) GetSendGridClient() *sendgrid.Client {
 	if e.client == nil {
 		e.client = sendgrid.NewSendClient("dummy-key-for-test-struct")
@@ -0,0 +1,86 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sendgrid/sendgrid-go/helpers/eventwebhook"
+)
+
+const (
+	EventSignatureHeader = eventwebhook.VerificationHTTPHeader
+	EventTimestampHeader = eventwebhook.TimestampHTTPHeader
+
+	// NotificationIDArg is the custom argument carrying the notification ID, SendGrid echoes it back on every event.
+	NotificationIDArg = "notification_id"
+)
+
+// EventTimestampTolerance bounds the age of a signed delivery, so that a captured request can't be replayed later on.
+const EventTimestampTolerance = 10 * time.Minute
+
+var (
+	ErrInvalidEventSignature = errors.New("invalid event webhook signature")
+	ErrInvalidEventPayload   = errors.New("invalid event webhook payload")
+)
+
+const (
+	EventDelivered  = "delivered"
+	EventBounce     = "bounce"
+	EventSpamReport = "spamreport"
+)
+
+// BounceTypeBlocked marks a soft bounce, the address itself is fine and may be retried later.
+const BounceTypeBlocked = "blocked"
+
+// Event is a single entry of an event webhook delivery, only the fields used by the platform are decoded.
+type Event struct {
+	Email          string `json:"email"`
+	Event          string `json:"event"`
+	Timestamp      int64  `json:"timestamp"`
+	SGEventID      string `json:"sg_event_id"`
+	SGMessageID    string `json:"sg_message_id"`
+	Reason         string `json:"reason"`
+	Type           string `json:"type"` // bounce type: "bounce" or "blocked"
+	NotificationID string `json:"notification_id"`
+}
+
+// IsHardBounce reports whether the address is permanently undeliverable.
+func (e Event) IsHardBounce() bool {
+	return e.Event == EventBounce && e.Type != BounceTypeBlocked
+}
+
+// ParseEvents implements EmailService.
+func (e *emailService) ParseEvents(payload []byte, signature, timestamp string) ([]Event, error) {
+	if e.webhookPublicKey == "" {
+		return nil, fmt.Errorf("%w: no verification key configured", ErrInvalidEventSignature)
+	}
+
+	publicKey, err := eventwebhook.ConvertPublicKeyBase64ToECDSA(e.webhookPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse event webhook verification key: %w", err)
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timestamp", ErrInvalidEventSignature)
+	}
+
+	if age := time.Since(time.Unix(signedAt, 0)); age > EventTimestampTolerance || age < -EventTimestampTolerance {
+		return nil, fmt.Errorf("%w: timestamp outside of the tolerance", ErrInvalidEventSignature)
+	}
+
+	valid, err := eventwebhook.VerifySignature(publicKey, payload, signature, timestamp)
+	if err != nil || !valid {
+		return nil, ErrInvalidEventSignature
+	}
+
+	var events []Event
+	if err := json.Unmarshal(payload, &events); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEventPayload, err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,102 @@
+package sendgrid_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"strconv"
+	"testing"
+	"time"
+
+	sendgrid_client "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signEvents(t *testing.T, key *ecdsa.PrivateKey, payload []byte, timestamp string) string {
+	t.Helper()
+
+	digest := sha256.Sum256(append([]byte(timestamp), payload...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func TestEmailService_ParseEvents(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	service := sendgrid_client.NewEmailService("SG.test-api-key", "from@example.com", "Test Sender", base64.StdEncoding.EncodeToString(publicKey), sendgrid_client.AttachmentOptions{})
+	payload := []byte(`[{"email":"user@example.com","event":"bounce","type":"bounce","reason":"550 unknown user","sg_event_id":"evt_1","notification_id":"6f1c4f1e-8f43-4b1a-9a52-2f6d8f0b7a11"}]`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	t.Run("Success", func(t *testing.T) {
+		// Act
+		events, err := service.ParseEvents(payload, signEvents(t, key, payload, now), now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "user@example.com", events[0].Email)
+		assert.Equal(t, "6f1c4f1e-8f43-4b1a-9a52-2f6d8f0b7a11", events[0].NotificationID)
+		assert.True(t, events[0].IsHardBounce())
+	})
+
+	t.Run("Failure - Tampered payload", func(t *testing.T) {
+		// Arrange
+		signature := signEvents(t, key, payload, now)
+
+		// Act
+		_, err := service.ParseEvents([]byte(`[{"email":"other@example.com","event":"bounce"}]`), signature, now)
+
+		// Assert
+		assert.ErrorIs(t, err, sendgrid_client.ErrInvalidEventSignature)
+	})
+
+	t.Run("Failure - Stale timestamp", func(t *testing.T) {
+		// Arrange
+		stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+		// Act
+		_, err := service.ParseEvents(payload, signEvents(t, key, payload, stale), stale)
+
+		// Assert
+		assert.ErrorIs(t, err, sendgrid_client.ErrInvalidEventSignature)
+	})
+
+	t.Run("Failure - Invalid payload", func(t *testing.T) {
+		// Arrange
+		invalid := []byte(`{"event":"delivered"}`)
+
+		// Act
+		_, err := service.ParseEvents(invalid, signEvents(t, key, invalid, now), now)
+
+		// Assert
+		assert.ErrorIs(t, err, sendgrid_client.ErrInvalidEventPayload)
+	})
+
+	t.Run("Failure - No verification key", func(t *testing.T) {
+		// Arrange
+		unconfigured := sendgrid_client.NewEmailService("SG.test-api-key", "from@example.com", "Test Sender", "", sendgrid_client.AttachmentOptions{})
+
+		// Act
+		_, err := unconfigured.ParseEvents(payload, signEvents(t, key, payload, now), now)
+
+		// Assert
+		assert.ErrorIs(t, err, sendgrid_client.ErrInvalidEventSignature)
+	})
+
+	t.Run("Soft bounces are not hard bounces", func(t *testing.T) {
+		// Assert
+		assert.False(t, sendgrid_client.Event{Event: sendgrid_client.EventBounce, Type: sendgrid_client.BounceTypeBlocked}.IsHardBounce())
+		assert.False(t, sendgrid_client.Event{Event: sendgrid_client.EventDelivered}.IsHardBounce())
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/internal/models"
+	sendgrid0 "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
 	"github.com/sendgrid/sendgrid-go"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -85,6 +86,64 @@ func (_c *MockEmailService_GetSendGridClient_Call) RunAndReturn(run func() *send
 	return _c
 }
 
+// ParseEvents provides a mock function for the type MockEmailService
+func (_mock *MockEmailService) ParseEvents(payload []byte, signature string, timestamp string) ([]sendgrid0.Event, error) {
+	ret := _mock.Called(payload, signature, timestamp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseEvents")
+	}
+
+	var r0 []sendgrid0.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, string, string) ([]sendgrid0.Event, error)); ok {
+		return returnFunc(payload, signature, timestamp)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, string, string) []sendgrid0.Event); ok {
+		r0 = returnFunc(payload, signature, timestamp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sendgrid0.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, string, string) error); ok {
+		r1 = returnFunc(payload, signature, timestamp)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEmailService_ParseEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseEvents'
+type MockEmailService_ParseEvents_Call struct {
+	*mock.Call
+}
+
+// ParseEvents is a helper method to define mock.On call
+//   - payload
+//   - signature
+//   - timestamp
+func (_e *MockEmailService_Expecter) ParseEvents(payload interface{}, signature interface{}, timestamp interface{}) *MockEmailService_ParseEvents_Call {
+	return &MockEmailService_ParseEvents_Call{Call: _e.mock.On("ParseEvents", payload, signature, timestamp)}
+}
+
+func (_c *MockEmailService_ParseEvents_Call) Run(run func(payload []byte, signature string, timestamp string)) *MockEmailService_ParseEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_ParseEvents_Call) Return(vs []sendgrid0.Event, err error) *MockEmailService_ParseEvents_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockEmailService_ParseEvents_Call) RunAndReturn(run func(payload []byte, signature string, timestamp string) ([]sendgrid0.Event, error)) *MockEmailService_ParseEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Send provides a mock function for the type MockEmailService
 func (_mock *MockEmailService) Send(ctx context.Context, req *models.EmailNotificationRequest) error {
 	ret := _mock.Called(ctx, req)
@@ -130,3 +189,49 @@ func (_c *MockEmailService_Send_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateAttachments provides a mock function for the type MockEmailService
+func (_mock *MockEmailService) ValidateAttachments(ctx context.Context, attachments []models.EmailAttachment) error {
+	ret := _mock.Called(ctx, attachments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAttachments")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.EmailAttachment) error); ok {
+		r0 = returnFunc(ctx, attachments)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEmailService_ValidateAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateAttachments'
+type MockEmailService_ValidateAttachments_Call struct {
+	*mock.Call
+}
+
+// ValidateAttachments is a helper method to define mock.On call
+//   - ctx
+//   - attachments
+func (_e *MockEmailService_Expecter) ValidateAttachments(ctx interface{}, attachments interface{}) *MockEmailService_ValidateAttachments_Call {
+	return &MockEmailService_ValidateAttachments_Call{Call: _e.mock.On("ValidateAttachments", ctx, attachments)}
+}
+
+func (_c *MockEmailService_ValidateAttachments_Call) Run(run func(ctx context.Context, attachments []models.EmailAttachment)) *MockEmailService_ValidateAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.EmailAttachment))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_ValidateAttachments_Call) Return(err error) *MockEmailService_ValidateAttachments_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEmailService_ValidateAttachments_Call) RunAndReturn(run func(ctx context.Context, attachments []models.EmailAttachment) error) *MockEmailService_ValidateAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,103 @@
+package sendgrid
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrInvalidSuppressionExport = errors.New("invalid suppression export")
+
+const byteOrderMark = "\ufeff"
+
+// Suppression is an entry of a suppression list exported from SendGrid, only the fields used by the
+// platform are decoded.
+type Suppression struct {
+	Email  string `json:"email"`
+	Reason string `json:"reason"`
+}
+
+// ParseSuppressionExport reads a suppression list exported from SendGrid, either the CSV download of the
+// app, with an email column and an optional reason column, or the JSON array the suppression API lists.
+// Rows without an address are left out.
+func ParseSuppressionExport(r io.Reader) ([]Suppression, error) {
+	reader := bufio.NewReader(r)
+
+	// spreadsheets save the CSV with a byte order mark
+	if bom, _ := reader.Peek(len(byteOrderMark)); string(bom) == byteOrderMark {
+		_, _ = reader.Discard(len(byteOrderMark))
+	}
+
+	// the API lists are JSON arrays, anything else is read as CSV
+	start, err := reader.Peek(1)
+	for err == nil && bytes.ContainsAny(start, " \t\r\n") {
+		_, _ = reader.Discard(1)
+		start, err = reader.Peek(1)
+	}
+
+	if err == nil && start[0] == '[' {
+		var suppressions []Suppression
+		if err := json.NewDecoder(reader).Decode(&suppressions); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSuppressionExport, err)
+		}
+
+		return withAddress(suppressions), nil
+	}
+
+	records, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSuppressionExport, err)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: no header row", ErrInvalidSuppressionExport)
+	}
+
+	emailColumn, reasonColumn := -1, -1
+
+	for i, name := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "email":
+			emailColumn = i
+		case "reason":
+			reasonColumn = i
+		}
+	}
+
+	if emailColumn < 0 {
+		return nil, fmt.Errorf("%w: no email column", ErrInvalidSuppressionExport)
+	}
+
+	suppressions := make([]Suppression, 0, len(records)-1)
+
+	for _, record := range records[1:] {
+		suppression := Suppression{Email: record[emailColumn]}
+		if reasonColumn >= 0 {
+			suppression.Reason = record[reasonColumn]
+		}
+
+		suppressions = append(suppressions, suppression)
+	}
+
+	return withAddress(suppressions), nil
+}
+
+func withAddress(suppressions []Suppression) []Suppression {
+	kept := suppressions[:0]
+
+	for _, suppression := range suppressions {
+		suppression.Email = strings.TrimSpace(suppression.Email)
+		suppression.Reason = strings.TrimSpace(suppression.Reason)
+
+		if suppression.Email != "" {
+			kept = append(kept, suppression)
+		}
+	}
+
+	return kept
+}
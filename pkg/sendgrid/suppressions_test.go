@@ -0,0 +1,67 @@
+package sendgrid_test
+
+import (
+	"strings"
+	"testing"
+
+	sendgrid_client "github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/sendgrid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSuppressionExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		export  string
+		want    []sendgrid_client.Suppression
+		wantErr bool
+	}{
+		{
+			name:   "CSV download with a byte order mark",
+			export: "\ufeffstatus,email,reason,created\r\n5.1.1, user@example.com ,550 unknown user,1700000000\r\n4.0.0,,,1700000001\r\n",
+			want:   []sendgrid_client.Suppression{{Email: "user@example.com", Reason: "550 unknown user"}},
+		},
+		{
+			name:   "CSV download without a reason",
+			export: "email,created\nuser@example.com,1700000000\n",
+			want:   []sendgrid_client.Suppression{{Email: "user@example.com"}},
+		},
+		{
+			name:   "API list",
+			export: ` [{"created":1700000000,"email":"user@example.com","ip":"10.0.0.1"},{"created":1700000001,"email":"other@example.com","reason":"invalid"}]`,
+			want:   []sendgrid_client.Suppression{{Email: "user@example.com"}, {Email: "other@example.com", Reason: "invalid"}},
+		},
+		{
+			name:    "No email column",
+			export:  "address\nuser@example.com\n",
+			wantErr: true,
+		},
+		{
+			name:    "Empty export",
+			export:  "",
+			wantErr: true,
+		},
+		{
+			name:    "Malformed API list",
+			export:  `[{"email":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			suppressions, err := sendgrid_client.ParseSuppressionExport(strings.NewReader(tt.export))
+
+			// Assert
+			if tt.wantErr {
+				assert.ErrorIs(t, err, sendgrid_client.ErrInvalidSuppressionExport)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, suppressions)
+		})
+	}
+}
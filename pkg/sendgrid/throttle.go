@@ -0,0 +1,89 @@
+package sendgrid
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Plan is the sending quota of a SendGrid plan.
+type Plan struct {
+	Limit  int
+	Period time.Duration
+}
+
+const month = 30 * 24 * time.Hour
+
+// Plans maps the plan names accepted in the configuration to their quota.
+var Plans = map[string]Plan{
+	"free":            {Limit: 100, Period: 24 * time.Hour},
+	"essentials-50k":  {Limit: 50_000, Period: month},
+	"essentials-100k": {Limit: 100_000, Period: month},
+	"pro-100k":        {Limit: 100_000, Period: month},
+	"pro-300k":        {Limit: 300_000, Period: month},
+	"pro-700k":        {Limit: 700_000, Period: month},
+	"pro-1.5m":        {Limit: 1_500_000, Period: month},
+}
+
+// Throttle is a token bucket spreading the quota of a plan evenly over its period. Burst sends can
+// go out back to back, after that one send is allowed every Period/Limit. A nil Throttle allows everything.
+type Throttle struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	clock  func() time.Time
+}
+
+// NewThrottle returns the throttle of the named plan, or nil when no plan is configured.
+func NewThrottle(planName string, burst int) (*Throttle, error) {
+	if planName == "" {
+		return nil, nil
+	}
+
+	plan, ok := Plans[planName]
+	if !ok {
+		return nil, fmt.Errorf("unknown sendgrid plan: %s", planName)
+	}
+
+	return newThrottle(plan, burst, time.Now), nil
+}
+
+func newThrottle(plan Plan, burst int, clock func() time.Time) *Throttle {
+	burst = max(burst, 1)
+
+	return &Throttle{
+		rate:   float64(plan.Limit) / plan.Period.Seconds(),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   clock(),
+		clock:  clock,
+	}
+}
+
+// Take consumes a token if one is available. Otherwise nothing is consumed and the time until the next
+// token is returned.
+func (t *Throttle) Take() (bool, time.Duration) {
+	if t == nil {
+		return true, 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock()
+	t.tokens = min(t.burst, t.tokens+now.Sub(t.last).Seconds()*t.rate)
+	t.last = now
+
+	if t.tokens >= 1 {
+		t.tokens--
+
+		return true, 0
+	}
+
+	wait := (1 - t.tokens) / t.rate
+
+	return false, time.Duration(math.Ceil(wait * float64(time.Second)))
+}
@@ -0,0 +1,53 @@
+package sendgrid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Run("Spends the burst then refills at the plan rate", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+		throttle := newThrottle(Plan{Limit: 60, Period: time.Minute}, 2, func() time.Time { return now })
+
+		// Act
+		first, _ := throttle.Take()
+		second, _ := throttle.Take()
+		third, wait := throttle.Take()
+
+		now = now.Add(time.Second)
+		afterRefill, _ := throttle.Take()
+
+		// Assert
+		assert.True(t, first)
+		assert.True(t, second)
+		assert.False(t, third)
+		assert.Equal(t, time.Second, wait)
+		assert.True(t, afterRefill)
+	})
+
+	t.Run("No plan disables the throttle", func(t *testing.T) {
+		// Arrange
+		throttle, err := NewThrottle("", 0)
+		require.NoError(t, err)
+
+		// Act
+		ok, wait := throttle.Take()
+
+		// Assert
+		assert.True(t, ok)
+		assert.Zero(t, wait)
+	})
+
+	t.Run("Unknown plan", func(t *testing.T) {
+		// Act
+		_, err := NewThrottle("enterprise", 10)
+
+		// Assert
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// ObjectStore gives streaming access to stored objects (e.g. generated invoices), so that
+// callers never have to hold a whole object in memory.
+type ObjectStore interface {
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+type fileStore struct {
+	root *os.Root
+}
+
+// NewFileStore serves objects from a local directory, keys are slash separated paths below it.
+// Keys can't escape the directory, even through symlinks.
+func NewFileStore(dir string) (ObjectStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory %s: %w", dir, err)
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open storage directory %s: %w", dir, err)
+	}
+
+	return &fileStore{root: root}, nil
+}
+
+func (s *fileStore) Stat(_ context.Context, key string) (ObjectInfo, error) {
+	name, err := objectPath(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	info, err := s.root.Stat(name)
+	if err != nil {
+		return ObjectInfo{}, wrapNotFound(key, err)
+	}
+
+	if info.IsDir() {
+		return ObjectInfo{}, fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+	}
+
+	return ObjectInfo{Size: info.Size(), ContentType: mime.TypeByExtension(path.Ext(name))}, nil
+}
+
+func (s *fileStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	name, err := objectPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.root.Open(name)
+	if err != nil {
+		return nil, wrapNotFound(key, err)
+	}
+
+	return f, nil
+}
+
+func objectPath(key string) (string, error) {
+	name := path.Clean(strings.TrimPrefix(key, "/"))
+	if name == "." || !fs.ValidPath(name) {
+		return "", fmt.Errorf("%w: invalid key %q", ErrObjectNotFound, key)
+	}
+
+	return filepath.FromSlash(name), nil
+}
+
+func wrapNotFound(key string, err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+	}
+
+	return fmt.Errorf("failed to access object %s: %w", key, err)
+}
@@ -0,0 +1,65 @@
+package storage_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "invoices"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invoices", "inv-1.pdf"), []byte("%PDF-1.7"), 0o600))
+
+	store, err := storage.NewFileStore(dir)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+
+	t.Run("Stat", func(t *testing.T) {
+		// Act
+		info, err := store.Stat(ctx, "invoices/inv-1.pdf")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), info.Size)
+		assert.Equal(t, "application/pdf", info.ContentType)
+	})
+
+	t.Run("Open", func(t *testing.T) {
+		// Act
+		r, err := store.Open(ctx, "/invoices/inv-1.pdf")
+		require.NoError(t, err)
+
+		defer r.Close()
+
+		content, err := io.ReadAll(r)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "%PDF-1.7", string(content))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		// Act
+		_, err := store.Stat(ctx, "invoices/missing.pdf")
+
+		// Assert
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+
+	t.Run("Keys can't escape the directory", func(t *testing.T) {
+		for _, key := range []string{"../secret.txt", "invoices/../../secret.txt", "", "invoices"} {
+			// Act
+			_, err := store.Stat(ctx, key)
+
+			// Assert
+			assert.ErrorIs(t, err, storage.ErrObjectNotFound, key)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package storefront
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	shopifyAPIVersion   = "2024-10"
+	shopifyPageSize     = 250
+	shopifyDefaultTitle = "Default Title" // title Shopify gives the variant of a product without options
+)
+
+// shopifyNextLink extracts the next page from the Link header of the cursor based pagination.
+var shopifyNextLink = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+type shopifyClient struct {
+	storeURL    string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// NewShopifyClient reads the catalog through the Admin REST API of the store (e.g. https://acme.myshopify.com)
+// with the access token of a custom app allowed to read products.
+func NewShopifyClient(storeURL, accessToken string, httpClient *http.Client) Client {
+	return &shopifyClient{storeURL: strings.TrimRight(storeURL, "/"), accessToken: accessToken, httpClient: httpClient}
+}
+
+type shopifyProduct struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	BodyHTML    string `json:"body_html"`
+	ProductType string `json:"product_type"`
+	Status      string `json:"status"`
+	Images      []struct {
+		Src string `json:"src"`
+	} `json:"images"`
+	Variants []struct {
+		ID                int64  `json:"id"`
+		Title             string `json:"title"`
+		SKU               string `json:"sku"`
+		Barcode           string `json:"barcode"`
+		Price             string `json:"price"`
+		InventoryQuantity int    `json:"inventory_quantity"`
+	} `json:"variants"`
+}
+
+func (c *shopifyClient) ListProducts(ctx context.Context) ([]Product, error) {
+	next := fmt.Sprintf("%s/admin/api/%s/products.json?limit=%d", c.storeURL, shopifyAPIVersion, shopifyPageSize)
+
+	var products []Product
+
+	for next != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build Shopify request: %w", err)
+		}
+
+		req.Header.Set("X-Shopify-Access-Token", c.accessToken)
+		req.Header.Set("Accept", "application/json")
+
+		var page struct {
+			Products []shopifyProduct `json:"products"`
+		}
+
+		resp, err := getJSON(c.httpClient, req, &page)
+		if err != nil {
+			return nil, fmt.Errorf("shopify: %w", err)
+		}
+
+		for _, p := range page.Products {
+			product, err := p.toProduct()
+			if err != nil {
+				return nil, fmt.Errorf("shopify: product %d: %w", p.ID, err)
+			}
+
+			products = append(products, product)
+		}
+
+		next = ""
+		if match := shopifyNextLink.FindStringSubmatch(resp.Header.Get("Link")); match != nil {
+			next = match[1]
+		}
+	}
+
+	return products, nil
+}
+
+func (p *shopifyProduct) toProduct() (Product, error) {
+	product := Product{
+		ExternalID:  strconv.FormatInt(p.ID, 10),
+		Title:       p.Title,
+		Description: p.BodyHTML,
+		ProductType: p.ProductType,
+		Status:      p.Status,
+	}
+
+	for _, image := range p.Images {
+		product.Images = append(product.Images, image.Src)
+	}
+
+	for _, v := range p.Variants {
+		price, err := strconv.ParseFloat(v.Price, 64)
+		if err != nil {
+			return Product{}, fmt.Errorf("invalid price %q of variant %d", v.Price, v.ID)
+		}
+
+		title := v.Title
+		if title == shopifyDefaultTitle {
+			title = ""
+		}
+
+		product.Variants = append(product.Variants, Variant{
+			ExternalID: strconv.FormatInt(v.ID, 10),
+			Title:      title,
+			SKU:        v.SKU,
+			Barcode:    v.Barcode,
+			Price:      price,
+			Stock:      v.InventoryQuantity,
+		})
+	}
+
+	return product, nil
+}
@@ -0,0 +1,60 @@
+// Package storefront reads the catalog of an external shop through its REST API, so that it can be
+// imported into the platform.
+package storefront
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Product is a product of the external shop, the fields every supported platform has in common.
+type Product struct {
+	ExternalID  string
+	Title       string
+	Description string
+	ProductType string // Shopify product type, first WooCommerce category
+	Status      string
+	Images      []string // image URLs, main image first
+	Variants    []Variant
+}
+
+// Variant is a purchasable option of a product. Products without options have a single variant
+// with an empty title.
+type Variant struct {
+	ExternalID string
+	Title      string
+	SKU        string
+	Barcode    string
+	Price      float64
+	Stock      int
+}
+
+// Client lists the whole catalog of a shop, following the pagination of its API.
+type Client interface {
+	ListProducts(ctx context.Context) ([]Product, error)
+}
+
+// getJSON decodes the response of a GET request, any non 2xx status is an error.
+func getJSON(httpClient *http.Client, req *http.Request, dest any) (*http.Response, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+		return nil, fmt.Errorf("%s returned %d: %s", req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", req.URL.Path, err)
+	}
+
+	return resp, nil
+}
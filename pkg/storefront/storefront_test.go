@@ -0,0 +1,130 @@
+package storefront_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aaravmahajanofficial/scalable-ecommerce-platform/pkg/storefront"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShopifyClient_ListProducts(t *testing.T) {
+	t.Run("Success - Follows the next link", func(t *testing.T) {
+		// Arrange
+		var server *httptest.Server
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "shpat_test", r.Header.Get("X-Shopify-Access-Token"))
+			assert.Equal(t, "/admin/api/2024-10/products.json", r.URL.Path)
+
+			if r.URL.Query().Get("page_info") == "" {
+				w.Header().Set("Link", fmt.Sprintf(`<%s/admin/api/2024-10/products.json?limit=250&page_info=p2>; rel="next"`, server.URL))
+				fmt.Fprint(w, `{"products":[{"id":1,"title":"Mug","body_html":"<p>Ceramic</p>","product_type":"Kitchen","status":"active",
+					"images":[{"src":"https://cdn.test/mug.jpg"}],
+					"variants":[{"id":11,"title":"Default Title","sku":"MUG-1","barcode":"4006381333931","price":"12.50","inventory_quantity":7}]}]}`)
+
+				return
+			}
+
+			w.Header().Set("Link", fmt.Sprintf(`<%s/admin/api/2024-10/products.json?limit=250&page_info=p1>; rel="previous"`, server.URL))
+			fmt.Fprint(w, `{"products":[{"id":2,"title":"Shirt","status":"draft",
+				"variants":[{"id":21,"title":"S","sku":"SHIRT-S","price":"20.00","inventory_quantity":1},{"id":22,"title":"M","sku":"SHIRT-M","price":"20.00","inventory_quantity":0}]}]}`)
+		}))
+		defer server.Close()
+
+		client := storefront.NewShopifyClient(server.URL+"/", "shpat_test", server.Client())
+
+		// Act
+		products, err := client.ListProducts(t.Context())
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+		assert.Equal(t, storefront.Product{
+			ExternalID:  "1",
+			Title:       "Mug",
+			Description: "<p>Ceramic</p>",
+			ProductType: "Kitchen",
+			Status:      "active",
+			Images:      []string{"https://cdn.test/mug.jpg"},
+			Variants:    []storefront.Variant{{ExternalID: "11", SKU: "MUG-1", Barcode: "4006381333931", Price: 12.5, Stock: 7}},
+		}, products[0])
+		require.Len(t, products[1].Variants, 2)
+		assert.Equal(t, "M", products[1].Variants[1].Title)
+	})
+
+	t.Run("Failure - Unauthorized", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, `{"errors":"Invalid API key or access token"}`, http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client := storefront.NewShopifyClient(server.URL, "wrong", server.Client())
+
+		// Act
+		products, err := client.ListProducts(t.Context())
+
+		// Assert
+		require.ErrorContains(t, err, "returned 401")
+		assert.Nil(t, products)
+	})
+}
+
+func TestWooCommerceClient_ListProducts(t *testing.T) {
+	t.Run("Success - Simple and variable products", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key, secret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "ck_test", key)
+			assert.Equal(t, "cs_test", secret)
+
+			w.Header().Set("X-WP-TotalPages", "2")
+
+			switch {
+			case r.URL.Path == "/wp-json/wc/v3/products" && r.URL.Query().Get("page") == "1":
+				fmt.Fprint(w, `[{"id":5,"name":"Mug","type":"simple","status":"publish","sku":"MUG-1","price":"12.5","stock_quantity":3,
+					"categories":[{"name":"Kitchen"},{"name":"Gifts"}],"images":[{"src":"https://cdn.test/mug.jpg"}]}]`)
+			case r.URL.Path == "/wp-json/wc/v3/products" && r.URL.Query().Get("page") == "2":
+				fmt.Fprint(w, `[{"id":6,"name":"Shirt","type":"variable","status":"publish","price":"20","stock_quantity":null}]`)
+			case r.URL.Path == "/wp-json/wc/v3/products/6/variations":
+				fmt.Fprint(w, `[{"id":61,"sku":"SHIRT-S-RED","price":"20","stock_quantity":null,"attributes":[{"option":"S"},{"option":"Red"}]}]`)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		defer server.Close()
+
+		client := storefront.NewWooCommerceClient(server.URL, "ck_test", "cs_test", server.Client())
+
+		// Act
+		products, err := client.ListProducts(t.Context())
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+		assert.Equal(t, "Kitchen", products[0].ProductType)
+		assert.Equal(t, []storefront.Variant{{ExternalID: "5", SKU: "MUG-1", Price: 12.5, Stock: 3}}, products[0].Variants)
+		assert.Equal(t, []storefront.Variant{{ExternalID: "61", Title: "S / Red", SKU: "SHIRT-S-RED", Price: 20}}, products[1].Variants)
+	})
+
+	t.Run("Failure - Invalid price", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `[{"id":5,"name":"Mug","type":"simple","price":"twelve"}]`)
+		}))
+		defer server.Close()
+
+		client := storefront.NewWooCommerceClient(server.URL, "ck_test", "cs_test", server.Client())
+
+		// Act
+		_, err := client.ListProducts(t.Context())
+
+		// Assert
+		require.ErrorContains(t, err, `invalid price "twelve"`)
+	})
+}
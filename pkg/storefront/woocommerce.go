@@ -0,0 +1,180 @@
+package storefront
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const wooCommercePageSize = 100
+
+type wooCommerceClient struct {
+	storeURL       string
+	consumerKey    string
+	consumerSecret string
+	httpClient     *http.Client
+}
+
+// NewWooCommerceClient reads the catalog through the WooCommerce REST API (v3) of the store, authenticated
+// with a read-only API key. The store must be served over HTTPS for basic authentication.
+func NewWooCommerceClient(storeURL, consumerKey, consumerSecret string, httpClient *http.Client) Client {
+	return &wooCommerceClient{
+		storeURL:       strings.TrimRight(storeURL, "/"),
+		consumerKey:    consumerKey,
+		consumerSecret: consumerSecret,
+		httpClient:     httpClient,
+	}
+}
+
+type wooCommerceProduct struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Status        string `json:"status"`
+	Description   string `json:"description"`
+	SKU           string `json:"sku"`
+	Price         string `json:"price"`
+	StockQuantity *int   `json:"stock_quantity"`
+	Categories    []struct {
+		Name string `json:"name"`
+	} `json:"categories"`
+	Images []struct {
+		Src string `json:"src"`
+	} `json:"images"`
+}
+
+type wooCommerceVariation struct {
+	ID            int64  `json:"id"`
+	SKU           string `json:"sku"`
+	Price         string `json:"price"`
+	StockQuantity *int   `json:"stock_quantity"`
+	Attributes    []struct {
+		Option string `json:"option"`
+	} `json:"attributes"`
+}
+
+func (c *wooCommerceClient) ListProducts(ctx context.Context) ([]Product, error) {
+	var products []Product
+
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		var batch []wooCommerceProduct
+
+		resp, err := c.get(ctx, fmt.Sprintf("/wp-json/wc/v3/products?per_page=%d&page=%d", wooCommercePageSize, page), &batch)
+		if err != nil {
+			return nil, err
+		}
+
+		if total, err := strconv.Atoi(resp.Header.Get("X-WP-TotalPages")); err == nil {
+			totalPages = total
+		}
+
+		for _, p := range batch {
+			product, err := c.toProduct(ctx, &p)
+			if err != nil {
+				return nil, fmt.Errorf("woocommerce: product %d: %w", p.ID, err)
+			}
+
+			products = append(products, product)
+		}
+	}
+
+	return products, nil
+}
+
+func (c *wooCommerceClient) toProduct(ctx context.Context, p *wooCommerceProduct) (Product, error) {
+	product := Product{
+		ExternalID:  strconv.FormatInt(p.ID, 10),
+		Title:       p.Name,
+		Description: p.Description,
+		Status:      p.Status,
+	}
+
+	if len(p.Categories) > 0 {
+		product.ProductType = p.Categories[0].Name
+	}
+
+	for _, image := range p.Images {
+		product.Images = append(product.Images, image.Src)
+	}
+
+	if p.Type != "variable" {
+		price, err := parseWooCommercePrice(p.Price)
+		if err != nil {
+			return Product{}, err
+		}
+
+		product.Variants = []Variant{{ExternalID: product.ExternalID, SKU: p.SKU, Price: price, Stock: derefStock(p.StockQuantity)}}
+
+		return product, nil
+	}
+
+	var variations []wooCommerceVariation
+
+	if _, err := c.get(ctx, fmt.Sprintf("/wp-json/wc/v3/products/%d/variations?per_page=%d", p.ID, wooCommercePageSize), &variations); err != nil {
+		return Product{}, err
+	}
+
+	for _, v := range variations {
+		price, err := parseWooCommercePrice(v.Price)
+		if err != nil {
+			return Product{}, fmt.Errorf("variation %d: %w", v.ID, err)
+		}
+
+		options := make([]string, 0, len(v.Attributes))
+		for _, attribute := range v.Attributes {
+			options = append(options, attribute.Option)
+		}
+
+		product.Variants = append(product.Variants, Variant{
+			ExternalID: strconv.FormatInt(v.ID, 10),
+			Title:      strings.Join(options, " / "),
+			SKU:        v.SKU,
+			Price:      price,
+			Stock:      derefStock(v.StockQuantity),
+		})
+	}
+
+	return product, nil
+}
+
+func (c *wooCommerceClient) get(ctx context.Context, path string, dest any) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.storeURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build WooCommerce request: %w", err)
+	}
+
+	req.SetBasicAuth(c.consumerKey, c.consumerSecret)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := getJSON(c.httpClient, req, dest)
+	if err != nil {
+		return nil, fmt.Errorf("woocommerce: %w", err)
+	}
+
+	return resp, nil
+}
+
+// parseWooCommercePrice reads the current price, products without a price are returned at 0.
+func parseWooCommercePrice(price string) (float64, error) {
+	if price == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price %q", price)
+	}
+
+	return value, nil
+}
+
+// derefStock treats products whose stock is not managed by WooCommerce as out of stock.
+func derefStock(quantity *int) int {
+	if quantity == nil {
+		return 0
+	}
+
+	return *quantity
+}
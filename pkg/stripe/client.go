@@ -6,13 +6,36 @@ import (
 	"strconv"
 
 	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/balancetransaction"
+	"github.com/stripe/stripe-go/v81/checkout/session"
 	"github.com/stripe/stripe-go/v81/paymentintent"
 	"github.com/stripe/stripe-go/v81/paymentmethod"
 	"github.com/stripe/stripe-go/v81/refund"
+	"github.com/stripe/stripe-go/v81/transfer"
 	"github.com/stripe/stripe-go/v81/webhook"
 )
 
-type Event = stripe.Event
+type (
+	Event              = stripe.Event
+	Dispute            = stripe.Dispute
+	PaymentIntent      = stripe.PaymentIntent
+	Charge             = stripe.Charge
+	CheckoutSession    = stripe.CheckoutSession
+	BalanceTransaction = stripe.BalanceTransaction
+	Transfer           = stripe.Transfer
+)
+
+const CheckoutSessionPaymentStatusUnpaid = stripe.CheckoutSessionPaymentStatusUnpaid
+
+const (
+	PaymentIntentStatusCanceled              = stripe.PaymentIntentStatusCanceled
+	PaymentIntentStatusProcessing            = stripe.PaymentIntentStatusProcessing
+	PaymentIntentStatusRequiresAction        = stripe.PaymentIntentStatusRequiresAction
+	PaymentIntentStatusRequiresCapture       = stripe.PaymentIntentStatusRequiresCapture
+	PaymentIntentStatusRequiresConfirmation  = stripe.PaymentIntentStatusRequiresConfirmation
+	PaymentIntentStatusRequiresPaymentMethod = stripe.PaymentIntentStatusRequiresPaymentMethod
+	PaymentIntentStatusSucceeded             = stripe.PaymentIntentStatusSucceeded
+)
 
 // defines the methods that any of payment client must implement.
 type Client interface {
@@ -21,22 +44,34 @@ type Client interface {
 	CreatePaymentMethodFromToken(paymentMethodID string) (*stripe.PaymentMethod, error)
 	AttachPaymentMethodToIntent(paymentMethodID, paymentIntentID string) error
 	ConfirmPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error)
+	CancelPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error)
+	GetPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error)
 	RefundPayment(paymentIntentID string, amount int64) (*stripe.Refund, error)
 	VerifyWebhookSignature(payload []byte, signature string) (Event, error)
+	CreateCheckoutSession(amount int64, currency string, description string, customerID string) (*stripe.CheckoutSession, error)
+	GetBalanceTransaction(balanceTransactionID string) (*stripe.BalanceTransaction, error)
+	CreateTransfer(amount int64, currency string, destination string, transferGroup string, idempotencyKey string) (*stripe.Transfer, error)
+}
+
+// CheckoutURLs are the pages Stripe redirects to once the hosted checkout page is left.
+type CheckoutURLs struct {
+	SuccessURL string
+	CancelURL  string
 }
 
 // stripeClient is the implementation of the Client interface.
 type stripeClient struct {
 	webhookSecret string
+	checkoutURLs  CheckoutURLs
 }
 
 // type paypalClient struct {}
 
-func NewStripeClient(apiKey string, webhookSecret string) Client {
+func NewStripeClient(apiKey string, webhookSecret string, checkoutURLs CheckoutURLs) Client {
 	stripe.Key = apiKey
 
 	// since *stripeClient is impplementing Client, it will automatically get converted to the Client interface
-	return &stripeClient{webhookSecret: webhookSecret}
+	return &stripeClient{webhookSecret: webhookSecret, checkoutURLs: checkoutURLs}
 }
 
 // PaymentIntent == "planned payment" or order waiting for payment.
@@ -104,6 +139,16 @@ func (s *stripeClient) ConfirmPaymentIntent(paymentIntentID string) (*stripe.Pay
 	return paymentintent.Confirm(paymentIntentID, params)
 }
 
+// CancelPaymentIntent implements Client.
+func (s *stripeClient) CancelPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error) {
+	return paymentintent.Cancel(paymentIntentID, nil)
+}
+
+// GetPaymentIntent implements Client.
+func (s *stripeClient) GetPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error) {
+	return paymentintent.Get(paymentIntentID, nil)
+}
+
 // RefundPayment implements Client.
 func (s *stripeClient) RefundPayment(paymentIntentID string, amount int64) (*stripe.Refund, error) {
 	params := &stripe.RefundParams{
@@ -123,6 +168,80 @@ func (s *stripeClient) VerifyWebhookSignature(payload []byte, signature string)
 	return webhook.ConstructEvent(payload, signature, s.webhookSecret)
 }
 
+// CreateCheckoutSession implements Client.
+// Checkout Session == Stripe hosted payment page, the payment intent is created by Stripe once the customer pays.
+func (s *stripeClient) CreateCheckoutSession(amount int64, currency string, description string, customerID string) (*stripe.CheckoutSession, error) {
+	if s.checkoutURLs.SuccessURL == "" || s.checkoutURLs.CancelURL == "" {
+		return nil, errors.New("checkout success and cancel URLs not configured")
+	}
+
+	params := &stripe.CheckoutSessionParams{
+		Mode:       stripe.String(string(stripe.CheckoutSessionModePayment)),
+		SuccessURL: stripe.String(s.checkoutURLs.SuccessURL),
+		CancelURL:  stripe.String(s.checkoutURLs.CancelURL),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+					Currency:   stripe.String(currency),
+					UnitAmount: stripe.Int64(amount),
+					ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+						Name: stripe.String(description),
+					},
+				},
+				Quantity: stripe.Int64(1),
+			},
+		},
+	}
+
+	if customerID != "" {
+		params.ClientReferenceID = stripe.String(customerID)
+	}
+
+	return session.New(params)
+}
+
+// GetBalanceTransaction implements Client.
+// Balance Transaction == the money movement of a settled charge, with the Stripe fee and the net amount.
+func (s *stripeClient) GetBalanceTransaction(balanceTransactionID string) (*stripe.BalanceTransaction, error) {
+	return balancetransaction.Get(balanceTransactionID, nil)
+}
+
+// CreateTransfer implements Client.
+// Transfer == money moved from the platform balance to a Stripe Connect account, used to pay vendors.
+func (s *stripeClient) CreateTransfer(amount int64, currency string, destination string, transferGroup string, idempotencyKey string) (*stripe.Transfer, error) {
+	params := &stripe.TransferParams{
+		Amount:        stripe.Int64(amount),
+		Currency:      stripe.String(currency),
+		Destination:   stripe.String(destination),
+		TransferGroup: stripe.String(transferGroup),
+	}
+	params.SetIdempotencyKey(idempotencyKey)
+
+	return transfer.New(params)
+}
+
+// DeclineCode reports whether err is a card declined by its issuer, with the reason given by Stripe,
+// like insufficient_funds. Card errors without a decline code, like an expired card, give their error code.
+func DeclineCode(err error) (string, bool) {
+	var stripeErr *stripe.Error
+	if !errors.As(err, &stripeErr) || stripeErr.Type != stripe.ErrorTypeCard {
+		return "", false
+	}
+
+	if stripeErr.DeclineCode != "" {
+		return string(stripeErr.DeclineCode), true
+	}
+
+	return string(stripeErr.Code), true
+}
+
+// IsNotFound reports whether err is Stripe answering that the requested object does not exist.
+func IsNotFound(err error) bool {
+	var stripeErr *stripe.Error
+
+	return errors.As(err, &stripeErr) && stripeErr.Code == stripe.ErrorCodeResourceMissing
+}
+
 // 1️⃣ Create a Payment Intent
 // → "I want to charge $100 for order #123"
 // 2️⃣ Create a Payment Method
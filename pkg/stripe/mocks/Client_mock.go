@@ -83,6 +83,62 @@ func (_c *MockClient_AttachPaymentMethodToIntent_Call) RunAndReturn(run func(pay
 	return _c
 }
 
+// CancelPaymentIntent provides a mock function for the type MockClient
+func (_mock *MockClient) CancelPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error) {
+	ret := _mock.Called(paymentIntentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelPaymentIntent")
+	}
+
+	var r0 *stripe.PaymentIntent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*stripe.PaymentIntent, error)); ok {
+		return returnFunc(paymentIntentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *stripe.PaymentIntent); ok {
+		r0 = returnFunc(paymentIntentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stripe.PaymentIntent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(paymentIntentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CancelPaymentIntent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPaymentIntent'
+type MockClient_CancelPaymentIntent_Call struct {
+	*mock.Call
+}
+
+// CancelPaymentIntent is a helper method to define mock.On call
+//   - paymentIntentID
+func (_e *MockClient_Expecter) CancelPaymentIntent(paymentIntentID interface{}) *MockClient_CancelPaymentIntent_Call {
+	return &MockClient_CancelPaymentIntent_Call{Call: _e.mock.On("CancelPaymentIntent", paymentIntentID)}
+}
+
+func (_c *MockClient_CancelPaymentIntent_Call) Run(run func(paymentIntentID string)) *MockClient_CancelPaymentIntent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_CancelPaymentIntent_Call) Return(paymentIntent *stripe.PaymentIntent, err error) *MockClient_CancelPaymentIntent_Call {
+	_c.Call.Return(paymentIntent, err)
+	return _c
+}
+
+func (_c *MockClient_CancelPaymentIntent_Call) RunAndReturn(run func(paymentIntentID string) (*stripe.PaymentIntent, error)) *MockClient_CancelPaymentIntent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConfirmPaymentIntent provides a mock function for the type MockClient
 func (_mock *MockClient) ConfirmPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error) {
 	ret := _mock.Called(paymentIntentID)
@@ -139,6 +195,65 @@ func (_c *MockClient_ConfirmPaymentIntent_Call) RunAndReturn(run func(paymentInt
 	return _c
 }
 
+// CreateCheckoutSession provides a mock function for the type MockClient
+func (_mock *MockClient) CreateCheckoutSession(amount int64, currency string, description string, customerID string) (*stripe.CheckoutSession, error) {
+	ret := _mock.Called(amount, currency, description, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCheckoutSession")
+	}
+
+	var r0 *stripe.CheckoutSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string, string, string) (*stripe.CheckoutSession, error)); ok {
+		return returnFunc(amount, currency, description, customerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string, string, string) *stripe.CheckoutSession); ok {
+		r0 = returnFunc(amount, currency, description, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stripe.CheckoutSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string, string, string) error); ok {
+		r1 = returnFunc(amount, currency, description, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CreateCheckoutSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCheckoutSession'
+type MockClient_CreateCheckoutSession_Call struct {
+	*mock.Call
+}
+
+// CreateCheckoutSession is a helper method to define mock.On call
+//   - amount
+//   - currency
+//   - description
+//   - customerID
+func (_e *MockClient_Expecter) CreateCheckoutSession(amount interface{}, currency interface{}, description interface{}, customerID interface{}) *MockClient_CreateCheckoutSession_Call {
+	return &MockClient_CreateCheckoutSession_Call{Call: _e.mock.On("CreateCheckoutSession", amount, currency, description, customerID)}
+}
+
+func (_c *MockClient_CreateCheckoutSession_Call) Run(run func(amount int64, currency string, description string, customerID string)) *MockClient_CreateCheckoutSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateCheckoutSession_Call) Return(checkoutSession *stripe.CheckoutSession, err error) *MockClient_CreateCheckoutSession_Call {
+	_c.Call.Return(checkoutSession, err)
+	return _c
+}
+
+func (_c *MockClient_CreateCheckoutSession_Call) RunAndReturn(run func(amount int64, currency string, description string, customerID string) (*stripe.CheckoutSession, error)) *MockClient_CreateCheckoutSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePaymentIntent provides a mock function for the type MockClient
 func (_mock *MockClient) CreatePaymentIntent(amount int64, currency string, description string, customerID string) (*stripe.PaymentIntent, error) {
 	ret := _mock.Called(amount, currency, description, customerID)
@@ -313,6 +428,178 @@ func (_c *MockClient_CreatePaymentMethodFromToken_Call) RunAndReturn(run func(pa
 	return _c
 }
 
+// CreateTransfer provides a mock function for the type MockClient
+func (_mock *MockClient) CreateTransfer(amount int64, currency string, destination string, transferGroup string, idempotencyKey string) (*stripe.Transfer, error) {
+	ret := _mock.Called(amount, currency, destination, transferGroup, idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransfer")
+	}
+
+	var r0 *stripe.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string, string, string, string) (*stripe.Transfer, error)); ok {
+		return returnFunc(amount, currency, destination, transferGroup, idempotencyKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string, string, string, string) *stripe.Transfer); ok {
+		r0 = returnFunc(amount, currency, destination, transferGroup, idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stripe.Transfer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string, string, string, string) error); ok {
+		r1 = returnFunc(amount, currency, destination, transferGroup, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type MockClient_CreateTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateTransfer is a helper method to define mock.On call
+//   - amount
+//   - currency
+//   - destination
+//   - transferGroup
+//   - idempotencyKey
+func (_e *MockClient_Expecter) CreateTransfer(amount interface{}, currency interface{}, destination interface{}, transferGroup interface{}, idempotencyKey interface{}) *MockClient_CreateTransfer_Call {
+	return &MockClient_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", amount, currency, destination, transferGroup, idempotencyKey)}
+}
+
+func (_c *MockClient_CreateTransfer_Call) Run(run func(amount int64, currency string, destination string, transferGroup string, idempotencyKey string)) *MockClient_CreateTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateTransfer_Call) Return(transfer *stripe.Transfer, err error) *MockClient_CreateTransfer_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *MockClient_CreateTransfer_Call) RunAndReturn(run func(amount int64, currency string, destination string, transferGroup string, idempotencyKey string) (*stripe.Transfer, error)) *MockClient_CreateTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalanceTransaction provides a mock function for the type MockClient
+func (_mock *MockClient) GetBalanceTransaction(balanceTransactionID string) (*stripe.BalanceTransaction, error) {
+	ret := _mock.Called(balanceTransactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceTransaction")
+	}
+
+	var r0 *stripe.BalanceTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*stripe.BalanceTransaction, error)); ok {
+		return returnFunc(balanceTransactionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *stripe.BalanceTransaction); ok {
+		r0 = returnFunc(balanceTransactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stripe.BalanceTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(balanceTransactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetBalanceTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceTransaction'
+type MockClient_GetBalanceTransaction_Call struct {
+	*mock.Call
+}
+
+// GetBalanceTransaction is a helper method to define mock.On call
+//   - balanceTransactionID
+func (_e *MockClient_Expecter) GetBalanceTransaction(balanceTransactionID interface{}) *MockClient_GetBalanceTransaction_Call {
+	return &MockClient_GetBalanceTransaction_Call{Call: _e.mock.On("GetBalanceTransaction", balanceTransactionID)}
+}
+
+func (_c *MockClient_GetBalanceTransaction_Call) Run(run func(balanceTransactionID string)) *MockClient_GetBalanceTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetBalanceTransaction_Call) Return(balanceTransaction *stripe.BalanceTransaction, err error) *MockClient_GetBalanceTransaction_Call {
+	_c.Call.Return(balanceTransaction, err)
+	return _c
+}
+
+func (_c *MockClient_GetBalanceTransaction_Call) RunAndReturn(run func(balanceTransactionID string) (*stripe.BalanceTransaction, error)) *MockClient_GetBalanceTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentIntent provides a mock function for the type MockClient
+func (_mock *MockClient) GetPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error) {
+	ret := _mock.Called(paymentIntentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentIntent")
+	}
+
+	var r0 *stripe.PaymentIntent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*stripe.PaymentIntent, error)); ok {
+		return returnFunc(paymentIntentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *stripe.PaymentIntent); ok {
+		r0 = returnFunc(paymentIntentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stripe.PaymentIntent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(paymentIntentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetPaymentIntent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentIntent'
+type MockClient_GetPaymentIntent_Call struct {
+	*mock.Call
+}
+
+// GetPaymentIntent is a helper method to define mock.On call
+//   - paymentIntentID
+func (_e *MockClient_Expecter) GetPaymentIntent(paymentIntentID interface{}) *MockClient_GetPaymentIntent_Call {
+	return &MockClient_GetPaymentIntent_Call{Call: _e.mock.On("GetPaymentIntent", paymentIntentID)}
+}
+
+func (_c *MockClient_GetPaymentIntent_Call) Run(run func(paymentIntentID string)) *MockClient_GetPaymentIntent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetPaymentIntent_Call) Return(paymentIntent *stripe.PaymentIntent, err error) *MockClient_GetPaymentIntent_Call {
+	_c.Call.Return(paymentIntent, err)
+	return _c
+}
+
+func (_c *MockClient_GetPaymentIntent_Call) RunAndReturn(run func(paymentIntentID string) (*stripe.PaymentIntent, error)) *MockClient_GetPaymentIntent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefundPayment provides a mock function for the type MockClient
 func (_mock *MockClient) RefundPayment(paymentIntentID string, amount int64) (*stripe.Refund, error) {
 	ret := _mock.Called(paymentIntentID, amount)